@@ -39,5 +39,5 @@ This allows you to track and manage repositories that were cloned outside of Clo
 func init() {
 	rootCmd.AddCommand(addCmd)
 	addCmd.Flags().BoolVarP(&addYes, "yes", "y", false, "Skip confirmation prompt")
-	addCmd.Flags().StringVar(&addName, "name", "", "Optional display name")
+	addCmd.Flags().StringVar(&addName, "name", "", "Alias to refer to the repository by in other commands")
 }
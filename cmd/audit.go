@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/audit"
+	"github.com/spf13/cobra"
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Inspect the clonr audit log",
+	Long: `Inspect audit records kept by clonr.
+
+Every decryption of notification channel secrets (Slack, Gmail, Teams, ...)
+and Slack account tokens is recorded together with the requesting command and
+the client process that accessed it.`,
+}
+
+var auditSecretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Show when and what accessed your secrets",
+	Long: `Show when channel secrets and tokens were decrypted and by which command.
+
+Examples:
+  clonr audit secrets                   # Last 50 accesses
+  clonr audit secrets --since 24h       # Accesses in the last day
+  clonr audit secrets --type slack      # Only Slack secrets
+  clonr audit secrets --profile work    # Only the "work" profile
+  clonr audit secrets --json            # Output as JSON`,
+	RunE: runAuditSecrets,
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditSecretsCmd)
+
+	auditSecretsCmd.Flags().IntP("limit", "n", 50, "Maximum number of entries to show (0 = all)")
+	auditSecretsCmd.Flags().Duration("since", 0, "Only show entries newer than this duration (e.g. 24h)")
+	auditSecretsCmd.Flags().StringP("profile", "p", "", "Only show entries for this profile")
+	auditSecretsCmd.Flags().StringP("type", "t", "", "Only show entries for this secret type (slack, gmail, ...)")
+	auditSecretsCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runAuditSecrets(cmd *cobra.Command, _ []string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	since, _ := cmd.Flags().GetDuration("since")
+	profile, _ := cmd.Flags().GetString("profile")
+	secretType, _ := cmd.Flags().GetString("type")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	filter := audit.Filter{
+		Profile: profile,
+		Type:    secretType,
+		Limit:   limit,
+	}
+
+	if since > 0 {
+		filter.Since = time.Now().Add(-since)
+	}
+
+	events, err := audit.Read(filter)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(events)
+	}
+
+	if len(events) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No secret access recorded.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tPROFILE\tTYPE\tKEYS\tCOMMAND\tCLIENT\tRESULT")
+
+	for _, ev := range events {
+		result := "ok"
+		if !ev.Success {
+			result = "failed"
+		}
+
+		command := ev.Command
+		if command == "" {
+			command = "-"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			ev.Time.Local().Format("2006-01-02 15:04:05"),
+			ev.Profile,
+			ev.Type,
+			strings.Join(ev.Keys, ","),
+			command,
+			ev.Client,
+			result,
+		)
+	}
+
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintf(os.Stdout, "\nAudit log: %s\n", audit.LogPath())
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup [repo...]",
+	Short: "Back up repositories to a directory or S3",
+	Long: `Bundle repositories into tar.gz archives and store them in a local
+directory or an S3-compatible bucket.
+
+Each archive holds a git bundle of all refs and a metadata.json with the
+tracked repository, HEAD, branch and remotes. Uncommitted changes are not
+included. Restore with 'clonr restore'.
+
+Archives are stored as <host>/<owner>/<repo>/<repo>-<timestamp>.tar.gz. After
+each backup, that repository's older backups outside the retention policy
+are deleted: a backup is kept while it is one of the newest --keep-last or
+younger than --keep-days, and the newest is never deleted.
+
+The destination and retention default to 'clonr backup config'. S3
+destinations (s3://bucket/prefix) use AWS_ACCESS_KEY_ID,
+AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN and AWS_REGION; set AWS_ENDPOINT_URL
+for MinIO, R2 or other S3-compatible storage.
+
+The repository defaults to the one containing the current directory.
+
+Examples:
+  clonr backup --dest ~/backups                  # Current repository
+  clonr backup api web --dest s3://my-bucket/git # By name, to S3
+  clonr backup --all --keep-last 7               # Everything, keep 7 per repo
+  clonr backup --all -w work --dry-run           # Preview a workspace`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runBackup,
+}
+
+var backupListCmd = &cobra.Command{
+	Use:   "list [repo]",
+	Short: "List stored backups",
+	Long: `List the backups stored at the destination, grouped by repository.
+
+Examples:
+  clonr backup list                     # Every backup at the configured destination
+  clonr backup list api                 # Backups of one repository
+  clonr backup list --dest s3://bucket  # Another destination`,
+	Aliases: []string{"ls"},
+	Args:    cobra.MaximumNArgs(1),
+	RunE:    runBackupList,
+}
+
+var backupConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show or set the backup destination and retention",
+	Long: `Show the default backup destination and retention policy, or change
+them with flags. Use 0 to disable a retention rule; with both disabled every
+backup is kept.
+
+Examples:
+  clonr backup config                                   # Show
+  clonr backup config --dest s3://my-bucket/git         # Set destination
+  clonr backup config --keep-last 10 --keep-days 30     # Set retention`,
+	Args: cobra.NoArgs,
+	RunE: runBackupConfig,
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.AddCommand(backupListCmd)
+	backupCmd.AddCommand(backupConfigCmd)
+
+	backupCmd.Flags().Bool("all", false, "Back up every tracked repository")
+	backupCmd.Flags().StringP("workspace", "w", "", "With --all, only repositories in this workspace")
+	backupCmd.Flags().Bool("dry-run", false, "Show what would be stored and pruned without doing it")
+	backupCmd.Flags().Bool("json", false, "Output as JSON")
+	addBackupPolicyFlags(backupCmd)
+
+	backupListCmd.Flags().String("dest", "", "Backup destination (default: configured)")
+	backupListCmd.Flags().Bool("json", false, "Output as JSON")
+
+	addBackupPolicyFlags(backupConfigCmd)
+}
+
+// addBackupPolicyFlags adds the destination and retention flags
+func addBackupPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().String("dest", "", "Directory or s3://bucket/prefix")
+	cmd.Flags().Int("keep-last", 0, "Keep the newest N backups per repository (0 = no limit)")
+	cmd.Flags().Int("keep-days", 0, "Keep backups younger than N days (0 = no limit)")
+}
+
+// backupPolicy returns the configured backup settings overridden by flags
+func backupPolicy(cmd *cobra.Command) (model.BackupConfig, error) {
+	policy, err := core.GetBackupConfig()
+	if err != nil {
+		return policy, err
+	}
+
+	if cmd.Flags().Changed("dest") {
+		policy.Dest, _ = cmd.Flags().GetString("dest")
+	}
+
+	if cmd.Flags().Changed("keep-last") {
+		policy.KeepLast, _ = cmd.Flags().GetInt("keep-last")
+	}
+
+	if cmd.Flags().Changed("keep-days") {
+		policy.KeepDays, _ = cmd.Flags().GetInt("keep-days")
+	}
+
+	return policy, nil
+}
+
+func runBackup(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool("all")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if all && len(args) > 0 {
+		return fmt.Errorf("use either repositories or --all, not both")
+	}
+
+	if workspace != "" && !all {
+		return fmt.Errorf("--workspace requires --all")
+	}
+
+	policy, err := backupPolicy(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := core.BackupOptions{
+		Repos:     args,
+		All:       all,
+		Workspace: workspace,
+		Config:    policy,
+		DryRun:    dryRun,
+	}
+
+	if !jsonOutput {
+		_, _ = fmt.Fprintf(os.Stdout, "Backing up to %s (%s)\n\n",
+			core.BackupDestination(policy.Dest), core.DescribeRetention(policy))
+
+		opts.Progress = printBackupResult(dryRun)
+	}
+
+	// An interrupted run still reports the repositories already backed up
+	results, stopErr := core.BackupRepos(cmd.Context(), opts)
+	if stopErr != nil && cmd.Context().Err() == nil && !errors.Is(stopErr, core.ErrJobCancelled) {
+		return stopErr
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+
+		return stopErr
+	}
+
+	var (
+		failed int
+		pruned int
+		total  int64
+	)
+
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+
+		pruned += len(r.Pruned)
+		total += r.Size
+	}
+
+	switch {
+	case dryRun:
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("\nDry run: %d repositories would be backed up, %d old backups pruned", len(results), pruned)))
+	default:
+		_, _ = fmt.Fprintf(os.Stdout, "\nBacked up %d of %d repositories (%s), pruned %d old backups\n",
+			len(results)-failed, len(results), formatBytes(total), pruned)
+	}
+
+	if stopErr != nil {
+		return fmt.Errorf("backup stopped: %w", stopErr)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d backups failed", failed)
+	}
+
+	return nil
+}
+
+// printBackupResult returns a progress callback printing one repository
+func printBackupResult(dryRun bool) func(core.BackupResult) {
+	return func(r core.BackupResult) {
+		switch {
+		case r.Error != "":
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", errStyle.Render("✗"), r.URL, r.Error)
+			return
+		case dryRun:
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s → %s\n", warnStyle.Render("~"), r.URL, r.Key)
+		default:
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s → %s (%s)\n", okStyle.Render("✓"), r.URL, r.Key, formatBytes(r.Size))
+		}
+
+		for _, key := range r.Pruned {
+			_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  pruned "+key))
+		}
+	}
+}
+
+func runBackupList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	policy, err := backupPolicy(cmd)
+	if err != nil {
+		return err
+	}
+
+	var repo *model.Repository
+
+	if len(args) > 0 {
+		repo, err = core.ResolveRepo(args[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	backups, err := core.ListBackups(cmd.Context(), policy.Dest, repo)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(backups)
+	}
+
+	if len(backups) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No backups in %s\n", core.BackupDestination(policy.Dest))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "BACKUP\tSIZE\tCREATED")
+
+	for _, b := range backups {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", b.Key, formatBytes(b.Size), formatAge(b.Time))
+	}
+
+	return w.Flush()
+}
+
+func runBackupConfig(cmd *cobra.Command, _ []string) error {
+	policy, err := backupPolicy(cmd)
+	if err != nil {
+		return err
+	}
+
+	changed := cmd.Flags().Changed("dest") || cmd.Flags().Changed("keep-last") || cmd.Flags().Changed("keep-days")
+	if changed {
+		if err := core.SaveBackupConfig(policy); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s Backup settings saved\n", okStyle.Render("✓"))
+	}
+
+	dest := policy.Dest
+	if dest == "" {
+		dest = dimStyle.Render("not set")
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Destination: %s\n", dest)
+	_, _ = fmt.Fprintf(os.Stdout, "Retention:   %s\n", core.DescribeRetention(policy))
+
+	return nil
+}
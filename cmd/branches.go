@@ -19,6 +19,9 @@ var branchesCmd = &cobra.Command{
 If no path is provided, shows a repository selector first.
 Use arrow keys to navigate, Enter to checkout a branch, and / to filter.
 
+Use 'clonr branches overview' to see the current branch of every repository
+and 'clonr branches switch' to check out a branch across repositories.
+
 Examples:
   clonr branches                    # Select repo then list branches
   clonr branches /path/to/repo      # List branches for specific repo
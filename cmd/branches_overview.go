@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var branchesOverviewCmd = &cobra.Command{
+	Use:   "overview",
+	Short: "Show the current branch of every repository",
+	Long: `Show the checked out branch of every tracked repository.
+
+Repositories that are not on their default branch (as advertised by
+origin/HEAD, or a local main/master) are highlighted.
+
+Examples:
+  clonr branches overview                 # All repositories
+  clonr branches overview -w work         # One workspace
+  clonr branches overview --off-default   # Only repos off their default branch
+  clonr branches overview --json`,
+	Aliases: []string{"ov"},
+	Args:    cobra.NoArgs,
+	RunE:    runBranchesOverview,
+}
+
+var branchesSwitchCmd = &cobra.Command{
+	Use:   "switch <branch> [repo...]",
+	Short: "Check out a branch across repositories",
+	Long: `Check out a named branch in the given repositories, or with --all in
+every repository (of a workspace with --workspace).
+
+Repositories with uncommitted changes, already on the branch, or without a
+local or origin branch of that name are skipped. A branch that only exists
+on origin is checked out as a new local tracking branch.
+
+Examples:
+  clonr branches switch develop api web      # Two repositories
+  clonr branches switch main --all -w work   # Every repository of a workspace
+  clonr branches switch main --all           # Every repository`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runBranchesSwitch,
+}
+
+var (
+	branchesWorkspace  string
+	branchesOffDefault bool
+	branchesJSON       bool
+	branchesSwitchAll  bool
+)
+
+func init() {
+	branchesCmd.AddCommand(branchesOverviewCmd)
+	branchesCmd.AddCommand(branchesSwitchCmd)
+
+	branchesOverviewCmd.Flags().StringVarP(&branchesWorkspace, "workspace", "w", "", "Only repositories in this workspace")
+	branchesOverviewCmd.Flags().BoolVar(&branchesOffDefault, "off-default", false, "Only repositories not on their default branch")
+	branchesOverviewCmd.Flags().BoolVar(&branchesJSON, "json", false, "Output as JSON")
+
+	branchesSwitchCmd.Flags().StringVarP(&branchesWorkspace, "workspace", "w", "", "Only repositories in this workspace")
+	branchesSwitchCmd.Flags().BoolVar(&branchesSwitchAll, "all", false, "Switch every repository")
+	branchesSwitchCmd.Flags().BoolVar(&branchesJSON, "json", false, "Output as JSON")
+}
+
+func runBranchesOverview(_ *cobra.Command, _ []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.GetRepos(branchesWorkspace, false)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	overview := core.BranchOverview(repos)
+
+	if branchesOffDefault {
+		filtered := overview[:0]
+
+		for _, b := range overview {
+			if !b.OnDefault() {
+				filtered = append(filtered, b)
+			}
+		}
+
+		overview = filtered
+	}
+
+	if branchesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(overview)
+	}
+
+	if len(overview) == 0 {
+		if branchesOffDefault {
+			_, _ = fmt.Fprintln(os.Stdout, "All repositories are on their default branch.")
+			return nil
+		}
+
+		printEmptyResult("repositories", "clonr clone <url>")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tWORKSPACE\tBRANCH\tDEFAULT")
+
+	offDefault := 0
+
+	for i := range overview {
+		b := &overview[i]
+
+		branch := okStyle.Render(b.Branch)
+
+		switch {
+		case b.Error != "":
+			branch = errStyle.Render(b.Error)
+		case b.Detached:
+			branch = warnStyle.Render("(detached HEAD)")
+		case !b.OnDefault():
+			branch = warnStyle.Render(b.Branch)
+		}
+
+		if !b.OnDefault() {
+			offDefault++
+		}
+
+		defaultBranch := b.DefaultBranch
+		if defaultBranch == "" {
+			defaultBranch = dimStyle.Render("-")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", b.URL, b.Workspace, branch, defaultBranch)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if offDefault > 0 && !branchesOffDefault {
+		_, _ = fmt.Fprintf(os.Stdout, "\n%d of %d repositories are not on their default branch\n", offDefault, len(overview))
+	}
+
+	return nil
+}
+
+func runBranchesSwitch(_ *cobra.Command, args []string) error {
+	branch, queries := args[0], args[1:]
+
+	if branchesSwitchAll == (len(queries) > 0) {
+		return fmt.Errorf("give either repositories or --all")
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.GetRepos(branchesWorkspace, false)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	if !branchesSwitchAll {
+		repos, err = core.MatchRepos(repos, queries)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(repos) == 0 {
+		printEmptyResult("repositories", "clonr clone <url>")
+		return nil
+	}
+
+	results := core.SwitchBranchInRepos(repos, branch)
+
+	if branchesJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(results)
+	}
+
+	return printBranchSwitchResults(results, branch)
+}
+
+// printBranchSwitchResults prints one line per repository followed by totals
+func printBranchSwitchResults(results []core.BranchSwitchResult, branch string) error {
+	switched, skipped, failed := 0, 0, 0
+
+	for _, r := range results {
+		switch {
+		case r.Error != "":
+			failed++
+
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", errStyle.Render("✗"), r.URL, r.Error)
+		case r.Skipped != "":
+			skipped++
+
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", dimStyle.Render("-"), r.URL, dimStyle.Render(r.Skipped))
+		default:
+			switched++
+
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s -> %s\n", okStyle.Render("✓"), r.URL, r.Previous, branch)
+		}
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\nSwitched: %d  Skipped: %d  Failed: %d\n", switched, skipped, failed)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to switch %d repositories", failed)
+	}
+
+	return nil
+}
@@ -40,9 +40,25 @@ In interactive mode, you will be prompted to select a profile for authentication
 Each profile has an associated workspace, so selecting a profile also sets the
 destination workspace. Use --profile to specify a profile directly.
 
+MONOREPO SUBDIRECTORIES:
+Use --subdir to track one directory of a large repository as its own entry.
+The repository is cloned with --filter=blob:none --sparse and only the given
+directory is checked out. Each subdirectory is tracked separately, so the same
+monorepo can be tracked once per service.
+
 WORKSPACE SELECTION:
-If no profile is selected or the profile has no workspace, you'll be prompted
-to select a workspace in interactive mode. Use --workspace to specify directly.`,
+Workspaces can route clones by URL pattern (see 'clonr workspace add --pattern').
+When the repository matches a pattern, its workspace and the profile bound to
+that workspace are used without prompting. Otherwise, if no profile is
+selected or the profile has no workspace and several
+workspaces exist, you'll be prompted to select one in interactive mode. Each
+workspace shows the path the repository will be cloned to. Use --workspace to
+specify directly. The prompt is skipped when a target directory is given.
+
+SUBMODULES:
+Use --recurse-submodules to clone the submodules as well. A repository cloned
+without them is reported with the command fetching them; 'clonr status' shows
+submodules that are not at the commit recorded by the repository.`,
 	Example: `  # Clone using owner/repo format (prompts for profile)
   clonr clone btcsuite/btcd
 
@@ -68,7 +84,16 @@ to select a workspace in interactive mode. Use --workspace to specify directly.`
   clonr clone owner/repo --profile work --workspace personal
 
   # Clone non-interactively (uses active profile and workspace)
-  clonr clone owner/repo --no-tui`,
+  clonr clone owner/repo --no-tui
+
+  # Track a single service from a monorepo (sparse clone)
+  clonr clone org/monorepo --subdir services/api
+
+  # Clone a repository together with its submodules
+  clonr clone owner/repo --recurse-submodules
+
+  # Clone under an alias, then open it with 'clonr open api'
+  clonr clone acme/api-gateway --name api`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runClone,
 }
@@ -79,6 +104,9 @@ func init() {
 	cloneCmd.Flags().Bool("no-tui", false, "Non-interactive mode (no TUI, useful for scripts)")
 	cloneCmd.Flags().StringP("workspace", "w", "", "Workspace to clone into")
 	cloneCmd.Flags().StringP("profile", "p", "", "Profile to use for authentication")
+	cloneCmd.Flags().String("subdir", "", "Track only this subdirectory of a monorepo (sparse clone)")
+	cloneCmd.Flags().Bool("recurse-submodules", false, "Clone the submodules as well")
+	cloneCmd.Flags().String("name", "", "Alias to refer to the repository by in other commands")
 }
 
 func runClone(cmd *cobra.Command, args []string) error {
@@ -86,10 +114,16 @@ func runClone(cmd *cobra.Command, args []string) error {
 	noTUI, _ := cmd.Flags().GetBool("no-tui")
 	workspace, _ := cmd.Flags().GetString("workspace")
 	profile, _ := cmd.Flags().GetString("profile")
+	subdir, _ := cmd.Flags().GetString("subdir")
+	recurseSubmodules, _ := cmd.Flags().GetBool("recurse-submodules")
+	alias, _ := cmd.Flags().GetString("name")
 
 	opts := core.CloneOptions{
-		Force:     force,
-		Workspace: workspace,
+		Force:             force,
+		Workspace:         workspace,
+		Subdir:            subdir,
+		RecurseSubmodules: recurseSubmodules,
+		Alias:             alias,
 	}
 
 	// Get a client to check profiles and workspaces
@@ -98,6 +132,21 @@ func runClone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Route by workspace URL patterns; the workspace's bound profile is used
+	// unless one was given
+	if workspace == "" {
+		if match, bound := matchCloneWorkspace(client, args[0]); match != nil {
+			workspace = match.Workspace.Name
+			opts.Workspace = workspace
+
+			if profile == "" {
+				profile = bound
+			}
+
+			_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("Workspace '%s' matched by pattern '%s'", workspace, match.Pattern)))
+		}
+	}
+
 	// If profile specified via a flag, set it as active
 	if profile != "" {
 		if err := client.SetActiveProfile(profile); err != nil {
@@ -161,7 +210,10 @@ func runClone(cmd *cobra.Command, args []string) error {
 	}
 
 	// If workspace still not set and TUI mode, check if we need workspace selection
-	if opts.Workspace == "" && workspace == "" && !noTUI {
+	// The workspace only matters when no target directory was given
+	dirName := core.CloneDirName(args, opts.Subdir)
+
+	if opts.Workspace == "" && workspace == "" && !noTUI && dirName != "" {
 		workspaces, err := client.ListWorkspaces()
 		if err != nil {
 			return fmt.Errorf("failed to list workspaces: %w", err)
@@ -174,7 +226,7 @@ func runClone(cmd *cobra.Command, args []string) error {
 			}
 		} else if len(workspaces) > 1 {
 			// Multiple workspaces exist - show selection TUI
-			m, err := cli.NewWorkspaceSelectorForClone()
+			m, err := cli.NewWorkspaceSelectorForClone(dirName)
 			if err != nil {
 				return err
 			}
@@ -218,8 +270,12 @@ func runClone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if result.Workspace != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "Workspace '%s' → %s\n", result.Workspace, result.TargetPath)
+	}
+
 	// Authentication is handled via credential helper (clonr auth git-credential)
-	m := cli.NewCloneModel(result.CloneURL, result.TargetPath)
+	m := cli.NewCloneModel(result.CloneURL, result.TargetPath, result.GitArgs...)
 	p := tea.NewProgram(m)
 
 	finalModel, err := p.Run()
@@ -232,6 +288,10 @@ func runClone(cmd *cobra.Command, args []string) error {
 		return cloneModel.Error()
 	}
 
+	if err := core.ApplySparseCheckout(result); err != nil {
+		return err
+	}
+
 	return core.SaveClonedRepoFromResult(result)
 }
 
@@ -302,3 +362,34 @@ func createWorkspaceFromSelection(client *grpc.Client, ws *model.Workspace) erro
 
 	return nil
 }
+
+// matchCloneWorkspace returns the workspace whose URL patterns match the
+// repository argument, and the name of the profile bound to that workspace.
+func matchCloneWorkspace(client ClientInterface, repoArg string) (*core.WorkspaceMatch, string) {
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return nil, ""
+	}
+
+	if cfg, err := client.GetConfig(); err == nil {
+		repoArg = core.ExpandURLRewrites(repoArg, cfg.URLRewrites)
+	}
+
+	match := core.MatchWorkspace(workspaces, core.RepoKey(repoArg))
+	if match == nil {
+		return nil, ""
+	}
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		return match, ""
+	}
+
+	for _, p := range profiles {
+		if p.Workspace == match.Workspace.Name {
+			return match, p.Name
+		}
+	}
+
+	return match, ""
+}
@@ -31,7 +31,10 @@ var configCmd = &cobra.Command{
 	Long: `Commands for managing clonr configuration.
 
 Available Commands:
-  editor    Manage custom editors`,
+  editor       Manage custom editors
+  concurrency  Limit parallel git operations and API calls
+  ignore       Skip paths and URLs in map, the monitors and org mirrors
+  keys         Remap the keys of the interactive views`,
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
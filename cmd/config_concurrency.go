@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var configConcurrencyCmd = &cobra.Command{
+	Use:   "concurrency",
+	Short: "Limit parallel git operations and API calls",
+	Long: `Show or set how many git operations and API calls clonr runs at once.
+
+Bulk operations (updating every repository, mirroring an organization,
+cloning) share one job queue per clonr process, so working on hundreds of
+repositories does not exhaust file handles, bandwidth or API rate limits.
+
+Without flags, the current limits are shown. A limit of 0 restores the
+default (4 git operations, 8 API calls); the maximum is 64.
+
+Examples:
+  clonr config concurrency
+  clonr config concurrency --git 8
+  clonr config concurrency --git 2 --api 4
+  clonr config concurrency --git 0       # Back to the default`,
+	Args: cobra.NoArgs,
+	RunE: runConfigConcurrency,
+}
+
+func init() {
+	configCmd.AddCommand(configConcurrencyCmd)
+	configConcurrencyCmd.Flags().Int("git", 0, "Maximum parallel git clones, pulls and fetches")
+	configConcurrencyCmd.Flags().Int("api", 0, "Maximum parallel hosting provider API calls")
+}
+
+func runConfigConcurrency(cmd *cobra.Command, _ []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	limits := cfg.Concurrency
+
+	if cmd.Flags().Changed("git") {
+		limits.GitOps, _ = cmd.Flags().GetInt("git")
+	}
+
+	if cmd.Flags().Changed("api") {
+		limits.APICalls, _ = cmd.Flags().GetInt("api")
+	}
+
+	if limits != cfg.Concurrency {
+		if err := core.SaveConcurrencyConfig(limits); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("✓ Concurrency limits saved"))
+	}
+
+	effective := core.EffectiveConcurrency(limits)
+	_, _ = fmt.Fprintf(os.Stdout, "Git operations: %d\n", effective.GitOps)
+	_, _ = fmt.Fprintf(os.Stdout, "API calls:      %d\n", effective.APICalls)
+
+	return nil
+}
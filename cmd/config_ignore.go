@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var configIgnoreCmd = &cobra.Command{
+	Use:   "ignore",
+	Short: "Skip paths and URLs in map, the monitors and org mirrors",
+	Long: `Show or edit the ignore list. Repositories matching it are skipped by
+'clonr map' (and --watch), the server monitors (disk budgets, fleet report)
+and 'clonr org mirror', so vendored checkouts, archived junk and
+third-party clones stay out of the way.
+
+Path rules are glob patterns. A rule without a slash matches any directory
+name in the path ("third_party", "*.bak"); one with a slash matches a
+directory and everything below it ("~/archive", "/src/*/vendor").
+
+URL rules are glob patterns matched against host/owner/name, ignoring the
+scheme, user, .git suffix and case. A rule matches every repository below
+it ("github.com/vendor-org", "*/*/archive-*").
+
+Examples:
+  clonr config ignore                                 # Show the ignore list
+  clonr config ignore add ~/archive third_party
+  clonr config ignore add --url github.com/vendor-org
+  clonr config ignore remove third_party`,
+	Args: cobra.NoArgs,
+	RunE: runConfigIgnore,
+}
+
+var configIgnoreAddCmd = &cobra.Command{
+	Use:   "add <pattern>...",
+	Short: "Add rules to the ignore list",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runConfigIgnoreAdd,
+}
+
+var configIgnoreRemoveCmd = &cobra.Command{
+	Use:   "remove <pattern>...",
+	Short: "Remove rules from the ignore list",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runConfigIgnoreRemove,
+}
+
+func init() {
+	configCmd.AddCommand(configIgnoreCmd)
+	configIgnoreCmd.AddCommand(configIgnoreAddCmd, configIgnoreRemoveCmd)
+
+	configIgnoreAddCmd.Flags().Bool("url", false, "Add URL rules instead of path rules")
+}
+
+func runConfigIgnore(_ *cobra.Command, _ []string) error {
+	rules, err := core.LoadIgnoreRules()
+	if err != nil {
+		return err
+	}
+
+	if rules.IsZero() {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("The ignore list is empty"))
+		return nil
+	}
+
+	for _, rule := range rules.Paths {
+		_, _ = fmt.Fprintf(os.Stdout, "path  %s\n", rule)
+	}
+
+	for _, rule := range rules.URLs {
+		_, _ = fmt.Fprintf(os.Stdout, "url   %s\n", rule)
+	}
+
+	return nil
+}
+
+func runConfigIgnoreAdd(cmd *cobra.Command, args []string) error {
+	urls, _ := cmd.Flags().GetBool("url")
+
+	if err := core.AddIgnoreRules(urls, args); err != nil {
+		return err
+	}
+
+	kind := "path"
+	if urls {
+		kind = "url"
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Ignoring %s: %s\n", okStyle.Render("✓"), kind, strings.Join(args, " "))
+
+	return nil
+}
+
+func runConfigIgnoreRemove(_ *cobra.Command, args []string) error {
+	missing, err := core.RemoveIgnoreRules(args)
+	if err != nil {
+		return err
+	}
+
+	if len(missing) == len(args) {
+		return fmt.Errorf("not on the ignore list: %s", strings.Join(missing, " "))
+	}
+
+	if len(missing) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "%s\n", dimStyle.Render("Not on the ignore list: "+strings.Join(missing, " ")))
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("✓ Ignore list updated"))
+
+	return nil
+}
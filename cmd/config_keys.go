@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var configKeysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "Remap the keys of the interactive views",
+	Long: `Show or remap the keys of the interactive repository list and menu.
+
+Actions:
+  select    Pick the highlighted item (default: enter)
+  favorite  Mark or unmark the highlighted repository (default: *)
+  delete    Remove the highlighted repository from clonr; press twice (default: x)
+  open      Open the highlighted repository in the editor (default: o)
+  filter    Start filtering the list (default: /)
+
+Keys use bubbletea names: a letter, enter, ctrl+o, alt+f, ... Navigation,
+grouping, sorting, help and quit keys (q, esc, tab, space, arrows, j/k, ...)
+are reserved. The help footer of each view shows the keys in use.
+
+Examples:
+  clonr config keys                      # Show the keys of every action
+  clonr config keys set favorite ctrl+f F
+  clonr config keys reset favorite       # Back to the default key
+  clonr config keys reset                # Reset every action`,
+	Args: cobra.NoArgs,
+	RunE: runConfigKeys,
+}
+
+var configKeysSetCmd = &cobra.Command{
+	Use:   "set <action> <key>...",
+	Short: "Bind keys to an action",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runConfigKeysSet,
+}
+
+var configKeysResetCmd = &cobra.Command{
+	Use:   "reset [action]",
+	Short: "Restore the default keys of an action, or of all actions",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runConfigKeysReset,
+}
+
+func init() {
+	configCmd.AddCommand(configKeysCmd)
+	configKeysCmd.AddCommand(configKeysSetCmd, configKeysResetCmd)
+}
+
+func runConfigKeys(_ *cobra.Command, _ []string) error {
+	keys := core.EffectiveKeys(core.LoadKeyMap())
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ACTION\tKEYS\tDEFAULT")
+
+	for _, action := range core.KeyActions {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", action, strings.Join(keys[action], " "), strings.Join(core.DefaultKeys[action], " "))
+	}
+
+	return w.Flush()
+}
+
+func runConfigKeysSet(_ *cobra.Command, args []string) error {
+	action, err := core.ParseKeyAction(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := core.SetKeyBinding(action, args[1:]); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", okStyle.Render("✓"), action, strings.Join(args[1:], " "))
+
+	return nil
+}
+
+func runConfigKeysReset(_ *cobra.Command, args []string) error {
+	var action core.KeyAction
+
+	if len(args) == 1 {
+		parsed, err := core.ParseKeyAction(args[0])
+		if err != nil {
+			return err
+		}
+
+		action = parsed
+	}
+
+	if err := core.ResetKeyBindings(action); err != nil {
+		return err
+	}
+
+	if action == "" {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("✓ Every action uses its default keys"))
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", okStyle.Render("✓"), action, strings.Join(core.DefaultKeys[action], " "))
+	}
+
+	return nil
+}
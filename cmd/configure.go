@@ -18,7 +18,10 @@ var (
 var configureCmd = &cobra.Command{
 	Use:   "configure",
 	Short: "Configure clonr settings",
-	Long:  `Interactively configure Clonr settings such as default clone directory, editor, and server port.`,
+	Long: `Interactively configure Clonr settings such as default clone directory,
+editor, server port, concurrency limits, notification routes and server TLS.
+
+Fields are validated before saving and the changes are shown for review.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if showConfig {
 			return core.ShowConfig()
@@ -34,7 +37,14 @@ var configureCmd = &cobra.Command{
 
 		_, _ = fmt.Fprintln(os.Stdout, "\nStarting interactive configuration...")
 
-		m, err := cli.NewConfigureModel()
+		var draft *cli.ConfigureDraft
+
+		var saved cli.ConfigureDraft
+		if offerResume(core.ConfigureDraft, "configuration", &saved) {
+			draft = &saved
+		}
+
+		m, err := cli.NewConfigureModel(draft)
 		if err != nil {
 			return err
 		}
@@ -46,6 +56,16 @@ var configureCmd = &cobra.Command{
 		}
 
 		configModel := finalModel.(*cli.ConfigureModel)
+
+		// Keep unsaved edits so the next 'clonr configure' can pick them up
+		if unsaved := configModel.Draft(); unsaved != nil {
+			if err := core.SaveWizardDraft(core.ConfigureDraft, unsaved); err == nil {
+				_, _ = fmt.Fprintln(os.Stdout, "Unsaved changes kept; run 'clonr configure' to resume.")
+			}
+		} else {
+			_ = core.DeleteWizardDraft(core.ConfigureDraft)
+		}
+
 		if configModel.Err != nil {
 			return configModel.Err
 		}
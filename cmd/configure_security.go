@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/spf13/cobra"
+)
+
+var configureSecurityCmd = &cobra.Command{
+	Use:   "security",
+	Short: "Choose how the keystore is protected without a TPM",
+	Long: `Choose how the root key of the keystore, which encrypts tokens and
+secrets, is protected on machines without a TPM:
+
+  machine     Derived from machine-specific data (default). No prompts, but
+              anyone who can read the clonr data directory can decrypt it.
+  passphrase  Derived from a passphrase with Argon2id. The passphrase is
+              asked for once per command, read from CLONR_PASSPHRASE, or
+              printed by --passphrase-command, for example from the system
+              keyring.
+
+Every stored secret is re-encrypted when the protection changes. Machines
+with a TPM always seal the keystore with it.
+
+Examples:
+  clonr configure security                     # Show the current protection
+  clonr configure security --protection passphrase
+  clonr configure security --protection passphrase \
+    --passphrase-command 'secret-tool lookup service clonr'
+  clonr configure security --protection machine`,
+	Args: cobra.NoArgs,
+	RunE: runConfigureSecurity,
+}
+
+func init() {
+	configureCmd.AddCommand(configureSecurityCmd)
+
+	configureSecurityCmd.Flags().String("protection", "", "Keystore protection: machine or passphrase")
+	configureSecurityCmd.Flags().String("passphrase-command", "", "Command printing the passphrase, e.g. from the system keyring")
+	configureSecurityCmd.Flags().Bool("json", false, "Output the current protection as JSON")
+}
+
+// KeyProtectionStatus is the JSON output of 'clonr configure security'
+type KeyProtectionStatus struct {
+	TPM               bool   `json:"tpm"`
+	Protection        string `json:"protection"`
+	PassphraseCommand string `json:"passphrase_command,omitempty"`
+}
+
+func runConfigureSecurity(cmd *cobra.Command, _ []string) error {
+	protection, _ := cmd.Flags().GetString("protection")
+	command, _ := cmd.Flags().GetString("passphrase-command")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if protection == "" {
+		if command != "" {
+			return fmt.Errorf("--passphrase-command requires --protection passphrase")
+		}
+
+		return showKeyProtection(jsonOutput)
+	}
+
+	var passphrase string
+
+	if protection == tpm.ProtectionPassphrase && command == "" {
+		var err error
+
+		if passphrase, err = readPassword("New keystore passphrase: "); err != nil {
+			return err
+		}
+
+		confirm, err := readPassword("Confirm passphrase: ")
+		if err != nil {
+			return err
+		}
+
+		if passphrase != confirm {
+			return fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	if err := core.SetKeyProtection(protection, passphrase, command); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Keystore protection set to"), protection)
+
+	if protection == tpm.ProtectionPassphrase && command == "" {
+		_, _ = fmt.Fprintf(os.Stdout, "Set %s for commands run without a terminal, such as the server.\n", tpm.PassphraseEnv)
+	}
+
+	return nil
+}
+
+// showKeyProtection prints how the keystore root key is protected
+func showKeyProtection(jsonOutput bool) error {
+	status := KeyProtectionStatus{TPM: tpm.IsTPMAvailable(), Protection: "tpm"}
+
+	if !status.TPM {
+		protection, err := tpm.LoadKeyProtection()
+		if err != nil {
+			return err
+		}
+
+		status.Protection = protection.Mode
+		status.PassphraseCommand = protection.PassphraseCommand
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(status)
+	}
+
+	switch status.Protection {
+	case "tpm":
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("The keystore is sealed with the TPM"))
+	case tpm.ProtectionPassphrase:
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("The keystore is protected by a passphrase"))
+
+		if status.PassphraseCommand != "" {
+			_, _ = fmt.Fprintf(os.Stdout, "Passphrase command: %s\n", status.PassphraseCommand)
+		}
+	default:
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render("The keystore is protected by a key derived from this machine"))
+		_, _ = fmt.Fprintln(os.Stdout, "Use a passphrase with 'clonr configure security --protection passphrase'")
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var contextCmd = &cobra.Command{
+	Use:   "context [dir]",
+	Short: "Show the effective clonr context for a directory",
+	Long: `Show everything that applies to clonr commands run in a directory (default:
+the current one) and where each value comes from:
+
+  - the tracked repository containing the directory, or the git repository
+    that is not tracked yet
+  - the workspace: the repository's, one matched by URL pattern, or the
+    active workspace
+  - the profile bound to that workspace, or the active profile, and where a
+    GitHub token would be taken from
+  - the git identity commits get, and the config file that sets it
+  - configuration values, marked default, config or workspace:<name>
+  - environment variables clonr reads (secrets masked) or injects into git
+  - the server the client talks to and how it was found
+
+Examples:
+  clonr context
+  clonr context ~/src/api
+  clonr context --json | jq .workspace`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runContext,
+}
+
+func init() {
+	rootCmd.AddCommand(contextCmd)
+	contextCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runContext(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	ec, err := core.ResolveContext(argOrEmpty(args, 0))
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(ec)
+	}
+
+	none := dimStyle.Render("none")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Directory:\t%s\n", ec.Dir)
+
+	switch {
+	case ec.Repository != nil:
+		_, _ = fmt.Fprintf(w, "Repository:\t%s %s\n", ec.Repository.URL, dimStyle.Render("("+ec.Repository.Path+")"))
+	case ec.GitRoot != "":
+		_, _ = fmt.Fprintf(w, "Repository:\t%s %s\n", ec.GitRoot, warnStyle.Render("(not tracked)"))
+	default:
+		_, _ = fmt.Fprintf(w, "Repository:\t%s\n", dimStyle.Render("not a git repository"))
+	}
+
+	_, _ = fmt.Fprintf(w, "Workspace:\t%s\n", withSource(ec.Workspace, ec.WorkspaceSource, none))
+	_, _ = fmt.Fprintf(w, "Profile:\t%s\n", withSource(ec.Profile, ec.ProfileSource, none))
+	_, _ = fmt.Fprintf(w, "Token from:\t%s\n", ec.TokenSource)
+
+	if id := ec.Identity; id != nil {
+		identity := none
+		if id.Name != "" || id.Email != "" {
+			identity = fmt.Sprintf("%s <%s>", id.Name, id.Email)
+		}
+
+		if id.SignCommits {
+			identity += ", signed"
+		}
+
+		_, _ = fmt.Fprintf(w, "Identity:\t%s\n", withSource(identity, id.Origin, none))
+	}
+
+	_, _ = fmt.Fprintf(w, "Server:\t%s\n", withSource(ec.Server, ec.ServerSource, none))
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(os.Stdout, "\nSettings:")
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, s := range ec.Settings {
+		source := s.Source
+		if source == core.ContextSourceDefault {
+			source = dimStyle.Render(source)
+		}
+
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", s.Key, s.Value, source)
+	}
+
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(os.Stdout, "\nEnvironment:")
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, e := range ec.Env {
+		purpose := e.Purpose
+		if e.Injected {
+			purpose += dimStyle.Render(" (injected into git)")
+		}
+
+		_, _ = fmt.Fprintf(w, "  %s\t%s\t%s\n", e.Name, e.Value, purpose)
+	}
+
+	_ = w.Flush()
+
+	if len(ec.Warnings) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout)
+	}
+
+	for _, warning := range ec.Warnings {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", warnStyle.Render("Warning:"), warning)
+	}
+
+	return nil
+}
+
+// withSource formats a value followed by where it came from
+func withSource(value, source, empty string) string {
+	if value == "" {
+		return empty
+	}
+
+	if source == "" {
+		return value
+	}
+
+	return value + " " + dimStyle.Render("("+source+")")
+}
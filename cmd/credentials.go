@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var credentialsCmd = &cobra.Command{
+	Use:     "credentials",
+	Aliases: []string{"creds"},
+	Short:   "Inspect stored credentials",
+	Long: `Inspect credentials stored in clonr profiles.
+
+Token expiry is recorded when credentials are added: GitHub fine-grained and
+expiring tokens, OAuth tokens without a refresh token, and rotating Slack tokens.
+The server sends reminders through the configured notification channels
+14, 7, 3 and 1 days before a credential expires, and once it has expired.`,
+}
+
+var credentialsStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show days until each credential expires",
+	Long: `Show all stored credentials with their expiration date and days left.
+
+Examples:
+  clonr credentials status            # Expiry table
+  clonr credentials status --check    # Re-read GitHub token expiry first
+  clonr credentials status --json     # Output as JSON`,
+	RunE: runCredentialsStatus,
+}
+
+var credentialsRemindCmd = &cobra.Command{
+	Use:   "remind",
+	Short: "Send reminders for expiring credentials now",
+	Long: `Send reminders for credentials that are expiring or have expired.
+
+Reminders go to the configured notification channels. Each credential is
+reminded once per threshold, so running this repeatedly does not spam.
+
+Examples:
+  clonr credentials remind            # Send due reminders
+  clonr credentials remind --dry-run  # Show what would be sent`,
+	RunE: runCredentialsRemind,
+}
+
+func init() {
+	rootCmd.AddCommand(credentialsCmd)
+	credentialsCmd.AddCommand(credentialsStatusCmd)
+	credentialsCmd.AddCommand(credentialsRemindCmd)
+
+	credentialsStatusCmd.Flags().Bool("check", false, "Query GitHub for the current token expiry of each profile")
+	credentialsStatusCmd.Flags().Bool("json", false, "Output as JSON")
+
+	credentialsRemindCmd.Flags().Bool("dry-run", false, "Show due reminders without sending them")
+}
+
+func runCredentialsStatus(cmd *cobra.Command, _ []string) error {
+	check, _ := cmd.Flags().GetBool("check")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if check {
+		if err := refreshGitHubExpiry(cmd.Context()); err != nil {
+			return err
+		}
+	}
+
+	statuses, err := core.ListCredentialStatus()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(statuses)
+	}
+
+	if len(statuses) == 0 {
+		printEmptyResult("credentials", "clonr profile add <name>")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROFILE\tTYPE\tNAME\tEXPIRES\tDAYS LEFT\tSTATUS")
+
+	for _, s := range statuses {
+		expires, days := "-", "-"
+		if !s.ExpiresAt.IsZero() {
+			expires = s.ExpiresAt.Local().Format("2006-01-02 15:04")
+			days = fmt.Sprintf("%d", s.DaysLeft)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.Profile, s.Type, s.Name, expires, days, formatCredentialState(s.State))
+	}
+
+	return w.Flush()
+}
+
+func runCredentialsRemind(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	pm, err := core.NewProfileManager()
+	if err != nil {
+		return err
+	}
+
+	profiles, err := pm.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	dispatcher, err := core.NewReminderDispatcher()
+	if err != nil {
+		return err
+	}
+
+	if dispatcher == nil && !dryRun {
+		return fmt.Errorf("no notification channel configured\nSet one up with: clonr slack notify add --webhook <url>")
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	due, err := core.SendCredentialReminders(ctx, profiles, dispatcher, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if len(due) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No reminders due.")
+		return nil
+	}
+
+	verb := "Sent"
+	if dryRun {
+		verb = "Would send"
+	}
+
+	for _, s := range due {
+		_, _ = fmt.Fprintf(os.Stdout, "%s reminder: %s/%s %s (%s, %d days left)\n",
+			verb, s.Profile, s.Type, s.Name, s.State, s.DaysLeft)
+	}
+
+	return nil
+}
+
+// refreshGitHubExpiry updates the stored token expiry of every profile from GitHub
+func refreshGitHubExpiry(ctx context.Context) error {
+	pm, err := core.NewProfileManager()
+	if err != nil {
+		return err
+	}
+
+	profiles, err := pm.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	for _, p := range profiles {
+		if _, err := pm.RefreshTokenExpiry(ctx, p.Name); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("Warning: profile %s: %v", p.Name, err)))
+		}
+	}
+
+	return nil
+}
+
+// formatCredentialState colors a credential state for table output
+func formatCredentialState(state core.CredentialState) string {
+	switch state {
+	case core.CredentialExpired:
+		return errStyle.Render(string(state))
+	case core.CredentialExpiring:
+		return warnStyle.Render(string(state))
+	case core.CredentialOK:
+		return okStyle.Render(string(state))
+	default:
+		return dimStyle.Render(string(state))
+	}
+}
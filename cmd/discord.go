@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+// discordDefaultChannelID is the channel ID of a Discord webhook added
+// without --name
+const discordDefaultChannelID = "discord"
+
+// discordCmd is the top-level discord command
+var discordCmd = &cobra.Command{
+	Use:   "discord",
+	Short: "Discord integration",
+	Long: `Send clonr notifications to Discord channels.
+
+Discord webhooks are stored as notification channels of the active profile,
+encrypted with the profile (TPM-backed when available), and receive the
+events of the unified notification dispatcher (see 'clonr notify').
+
+Notification Commands:
+  notify       Manage Discord webhook notifications
+
+Examples:
+  clonr discord notify add --webhook https://discord.com/api/webhooks/...
+  clonr discord notify test
+  clonr discord notify remove`,
+	Annotations: map[string]string{networkAnnotation: "Discord"},
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+// discordNotifyCmd manages Discord notifications (webhooks)
+var discordNotifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Manage Discord notifications",
+	Long: `Manage Discord webhook notifications for clonr events.
+
+Subcommands:
+  add      Add a webhook for notifications
+  test     Send a test notification
+  remove   Remove a webhook
+
+Examples:
+  clonr discord notify add --webhook https://discord.com/api/webhooks/123/abc
+  clonr discord notify add --webhook https://discord.com/api/webhooks/456/def --name discord-ci --events ci-fail,sync-fail
+  clonr discord notify test
+  clonr discord notify remove --name discord-ci`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+// discordNotifyAddCmd adds a webhook for notifications
+var discordNotifyAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a Discord webhook for notifications",
+	Long: `Configure Discord notifications using a webhook URL.
+
+Create a webhook in your Discord server:
+  1. Open the channel settings → Integrations → Webhooks
+  2. Create a New Webhook and pick the channel
+  3. Copy the webhook URL
+
+The webhook is added to the active profile as channel "discord"; use --name
+to add more than one. With --events only the listed event types are sent.
+
+Examples:
+  clonr discord notify add --webhook https://discord.com/api/webhooks/123/abc
+  clonr discord notify add --webhook https://discord.com/api/webhooks/... --username clonr
+  clonr discord notify add --webhook https://discord.com/api/webhooks/... --name discord-ci --events ci-fail,sync-fail`,
+	RunE: runDiscordNotifyAdd,
+}
+
+// discordNotifyTestCmd sends a test notification
+var discordNotifyTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a test notification",
+	Long: `Send a test notification to verify the webhook configuration.
+
+Examples:
+  clonr discord notify test
+  clonr discord notify test --name discord-ci`,
+	RunE: runDiscordNotifyTest,
+}
+
+// discordNotifyRemoveCmd removes a webhook
+var discordNotifyRemoveCmd = &cobra.Command{
+	Use:     "remove",
+	Aliases: []string{"rm"},
+	Short:   "Remove a Discord webhook",
+	Long: `Remove a Discord webhook from the active profile.
+
+Examples:
+  clonr discord notify remove
+  clonr discord notify remove --name discord-ci --force`,
+	RunE: runDiscordNotifyRemove,
+}
+
+func init() {
+	rootCmd.AddCommand(discordCmd)
+	discordCmd.AddCommand(discordNotifyCmd)
+	discordNotifyCmd.AddCommand(discordNotifyAddCmd)
+	discordNotifyCmd.AddCommand(discordNotifyTestCmd)
+	discordNotifyCmd.AddCommand(discordNotifyRemoveCmd)
+
+	discordNotifyAddCmd.Flags().String("webhook", "", "Discord webhook URL (required)")
+	discordNotifyAddCmd.Flags().String("username", "", "Name to post as instead of the webhook name")
+	discordNotifyAddCmd.Flags().StringSlice("events", nil, "Event types to send (default all)")
+
+	for _, cmd := range []*cobra.Command{discordNotifyAddCmd, discordNotifyTestCmd, discordNotifyRemoveCmd} {
+		cmd.Flags().String("name", discordDefaultChannelID, "Channel ID of the webhook")
+	}
+
+	discordNotifyRemoveCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+}
+
+func runDiscordNotifyAdd(cmd *cobra.Command, _ []string) error {
+	webhook, _ := cmd.Flags().GetString("webhook")
+	username, _ := cmd.Flags().GetString("username")
+	events, _ := cmd.Flags().GetStringSlice("events")
+	name, _ := cmd.Flags().GetString("name")
+
+	if err := notify.ValidateDiscordWebhookURL(webhook); err != nil {
+		return err
+	}
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Sending test notification..."))
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := notify.NewDiscordSender(webhook, username).Test(ctx); err != nil {
+		return fmt.Errorf("webhook test failed: %w", err)
+	}
+
+	channel := &model.NotifyChannel{
+		ID:   name,
+		Type: model.ChannelDiscord,
+		Name: "Discord - " + name,
+		Config: map[string]string{
+			"webhook_url": webhook,
+			"username":    username,
+		},
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	for _, event := range events {
+		channel.Events = append(channel.Events, model.EventConfig{Event: strings.TrimSpace(event)})
+	}
+
+	// Save to profile (AddNotifyChannel encrypts the webhook URL)
+	if err := pm.AddNotifyChannel(profile.Name, channel); err != nil {
+		return fmt.Errorf("failed to save Discord webhook: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Discord webhook %q added to profile %q!", name, profile.Name)))
+
+	if len(events) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Events: %s\n", strings.Join(events, ", "))
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "\nRoute events with: clonr notify route set <event> "+name)
+
+	return nil
+}
+
+func runDiscordNotifyTest(cmd *cobra.Command, _ []string) error {
+	name, _ := cmd.Flags().GetString("name")
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	channel, err := discordChannel(pm, profile.Name, name)
+	if err != nil {
+		return err
+	}
+
+	config, err := pm.DecryptChannelConfig(profile.Name, channel)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt Discord config: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Sending test notification..."))
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := notify.NewDiscordSender(config["webhook_url"], config["username"]).Test(ctx); err != nil {
+		return fmt.Errorf("test failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Test notification sent successfully!"))
+
+	return nil
+}
+
+func runDiscordNotifyRemove(cmd *cobra.Command, _ []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	force, _ := cmd.Flags().GetBool("force")
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	if _, err := discordChannel(pm, profile.Name, name); err != nil {
+		return err
+	}
+
+	if !force {
+		if !promptConfirm(fmt.Sprintf("Remove Discord webhook %q? [y/N]: ", name)) {
+			_, _ = fmt.Fprintln(os.Stdout, "Cancelled.")
+			return nil
+		}
+	}
+
+	if err := pm.RemoveNotifyChannel(profile.Name, name); err != nil {
+		return fmt.Errorf("failed to remove webhook: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Discord webhook %q removed.", name)))
+
+	return nil
+}
+
+// discordChannel returns the Discord webhook channel with the given ID
+func discordChannel(pm *core.ProfileManager, profileName, name string) (*model.NotifyChannel, error) {
+	channel, err := pm.GetNotifyChannel(profileName, name)
+	if err != nil || channel.Type != model.ChannelDiscord {
+		return nil, fmt.Errorf("no Discord webhook %q configured; add with: clonr discord notify add --webhook <url>", name)
+	}
+
+	return channel, nil
+}
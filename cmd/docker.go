@@ -0,0 +1,364 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(dockerCmd)
+
+	dockerCmd.AddCommand(dockerEnvCmd)
+	dockerCmd.AddCommand(dockerUpCmd)
+	dockerCmd.AddCommand(dockerDownCmd)
+	dockerCmd.AddCommand(dockerPsCmd)
+
+	dockerEnvCmd.Flags().String("image", "", "Container image")
+	dockerEnvCmd.Flags().StringArray("env", nil, "Environment variable KEY=VALUE (repeatable)")
+	dockerEnvCmd.Flags().StringArray("mount", nil, "Mount SOURCE:TARGET[:ro]; SOURCE is a path or tracked repository (repeatable)")
+	dockerEnvCmd.Flags().StringArray("port", nil, "Published port [host_ip:]host:container (repeatable)")
+	dockerEnvCmd.Flags().String("workdir", "", "Working directory in the container")
+	dockerEnvCmd.Flags().Bool("clear", false, "Remove the environment from the profile")
+	dockerEnvCmd.Flags().Bool("json", false, "Output as JSON")
+
+	dockerUpCmd.Flags().Bool("render", false, "Print a compose file instead of starting the container")
+	dockerUpCmd.Flags().StringP("output", "o", "", "Write the compose file of --render to a file")
+}
+
+var dockerCmd = &cobra.Command{
+	Use:   "docker",
+	Short: "Run development containers of docker profiles",
+	Long: `Run a development container defined in a docker profile, with tracked
+repositories mounted into it.
+
+A docker profile holds registry credentials ('clonr profile docker add');
+'clonr docker env' adds the container to run: the image, environment
+variables, mounts and published ports. Mount sources are host paths or
+tracked repositories, resolved to their clone when the container starts.
+
+Available Commands:
+  env          Show or define the container of a docker profile
+  up           Start the container, or render it as a compose file
+  down         Stop and remove the container
+  ps           List the containers started by clonr
+
+Examples:
+  clonr docker env go --image golang:1.25 --mount clonr:/work --workdir /work -- sleep infinity
+  clonr docker up go
+  clonr docker up go --render -o compose.yaml
+  clonr docker ps
+  clonr docker down go`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var dockerEnvCmd = &cobra.Command{
+	Use:   "env <profile> [-- command...]",
+	Short: "Show or define the container of a docker profile",
+	Long: `Show the development container of a docker profile, or change it with the
+flags. Flags given replace the matching setting; arguments after -- replace
+the command of the image.
+
+Examples:
+  clonr docker env go
+  clonr docker env go --image golang:1.25 --mount clonr:/work --workdir /work -- sleep infinity
+  clonr docker env web --image node:22 --env NODE_ENV=development --port 3000:3000 --mount ~/src/web:/app
+  clonr docker env go --clear`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runDockerEnv,
+}
+
+var dockerUpCmd = &cobra.Command{
+	Use:   "up <profile>",
+	Short: "Start the development container of a docker profile",
+	Long: `Start the development container of a docker profile in the background.
+
+The container is named clonr-<profile> and labeled with the profile. With
+--render, a compose file of the container is printed instead, for use with
+'docker compose'. Log in with 'clonr profile docker login' to pull private
+images.
+
+Examples:
+  clonr docker up go
+  clonr docker up go --render
+  clonr docker up go --render -o compose.yaml && docker compose -f compose.yaml up -d`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDockerUp,
+}
+
+var dockerDownCmd = &cobra.Command{
+	Use:   "down <profile>",
+	Short: "Stop and remove the development container of a docker profile",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runDockerDown,
+}
+
+var dockerPsCmd = &cobra.Command{
+	Use:   "ps [profile]",
+	Short: "List the development containers started by clonr",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runDockerPs,
+}
+
+func runDockerEnv(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	clearEnv, _ := cmd.Flags().GetBool("clear")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	// Arguments after -- are the command
+	var command []string
+	if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+		if dash > 1 {
+			return fmt.Errorf("unexpected arguments before --: %s", strings.Join(args[1:dash], " "))
+		}
+
+		command = args[dash:]
+	} else if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments: %s; put the container command after --", strings.Join(args[1:], " "))
+	}
+
+	client, profile, err := dockerGetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	changed := clearEnv || command != nil
+	for _, flag := range []string{"image", "env", "mount", "port", "workdir"} {
+		changed = changed || cmd.Flags().Changed(flag)
+	}
+
+	if !changed {
+		if jsonOutput {
+			return outputJSON(profile.Environment)
+		}
+
+		if profile.Environment == nil {
+			_, _ = fmt.Fprintf(os.Stdout, "Docker profile %s has no container; define one with: clonr docker env %s --image <image>\n", name, name)
+			return nil
+		}
+
+		printDockerEnvironment(profile.Environment)
+
+		return nil
+	}
+
+	if clearEnv {
+		profile.Environment = nil
+	} else {
+		env, err := dockerApplyEnvFlags(cmd, profile.Environment, command)
+		if err != nil {
+			return err
+		}
+
+		profile.Environment = env
+	}
+
+	if err := client.SaveDockerProfile(profile); err != nil {
+		return fmt.Errorf("failed to save docker profile: %w", err)
+	}
+
+	if clearEnv {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Removed the container of docker profile %s", name)))
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Saved the container of docker profile %s", name)))
+	printDockerEnvironment(profile.Environment)
+
+	return nil
+}
+
+// dockerApplyEnvFlags returns a copy of env with the settings given as flags
+func dockerApplyEnvFlags(cmd *cobra.Command, env *model.DockerEnvironment, command []string) (*model.DockerEnvironment, error) {
+	updated := model.DockerEnvironment{}
+	if env != nil {
+		updated = *env
+	}
+
+	if cmd.Flags().Changed("image") {
+		updated.Image, _ = cmd.Flags().GetString("image")
+	}
+
+	if cmd.Flags().Changed("env") {
+		updated.Env, _ = cmd.Flags().GetStringArray("env")
+
+		for _, e := range updated.Env {
+			if key, _, ok := strings.Cut(e, "="); !ok || key == "" {
+				return nil, fmt.Errorf("invalid environment variable %q: use KEY=VALUE", e)
+			}
+		}
+	}
+
+	if cmd.Flags().Changed("mount") {
+		specs, _ := cmd.Flags().GetStringArray("mount")
+
+		updated.Mounts = nil
+
+		for _, spec := range specs {
+			mount, err := core.ParseDockerMount(spec)
+			if err != nil {
+				return nil, err
+			}
+
+			updated.Mounts = append(updated.Mounts, mount)
+		}
+	}
+
+	if cmd.Flags().Changed("port") {
+		updated.Ports, _ = cmd.Flags().GetStringArray("port")
+	}
+
+	if cmd.Flags().Changed("workdir") {
+		updated.Workdir, _ = cmd.Flags().GetString("workdir")
+	}
+
+	if command != nil {
+		updated.Command = command
+	}
+
+	if updated.Image == "" {
+		return nil, fmt.Errorf("the container needs an image; set it with --image")
+	}
+
+	return &updated, nil
+}
+
+func runDockerUp(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	render, _ := cmd.Flags().GetBool("render")
+	output, _ := cmd.Flags().GetString("output")
+
+	if output != "" && !render {
+		return fmt.Errorf("--output needs --render")
+	}
+
+	_, profile, err := dockerGetProfile(name)
+	if err != nil {
+		return err
+	}
+
+	env := profile.Environment
+	if env == nil {
+		return fmt.Errorf("docker profile %s has no container; define one with: clonr docker env %s --image <image>", name, name)
+	}
+
+	mounts, err := core.ResolveDockerMounts(env.Mounts)
+	if err != nil {
+		return err
+	}
+
+	if render {
+		compose := core.RenderDockerCompose(name, env, mounts)
+		if output == "" {
+			_, err := os.Stdout.Write(compose)
+			return err
+		}
+
+		if err := os.WriteFile(output, compose, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", output, err)
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Wrote "+output))
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render(fmt.Sprintf("Starting %s from %s...", core.DockerContainerName(name), env.Image)))
+
+	if err := dockerRun(core.DockerRunArgs(name, env, mounts)...); err != nil {
+		return fmt.Errorf("failed to start the container of %s: %w", name, err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Started %s", core.DockerContainerName(name))))
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("Open a shell with: docker exec -it %s sh", core.DockerContainerName(name))))
+
+	return nil
+}
+
+func runDockerDown(_ *cobra.Command, args []string) error {
+	container := core.DockerContainerName(args[0])
+
+	if err := dockerRun("rm", "--force", container); err != nil {
+		return fmt.Errorf("failed to remove %s: %w", container, err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Removed "+container))
+
+	return nil
+}
+
+func runDockerPs(_ *cobra.Command, args []string) error {
+	filter := "label=" + core.DockerProfileLabel
+	if len(args) > 0 {
+		filter += "=" + args[0]
+	}
+
+	format := fmt.Sprintf("table {{.Names}}\t{{.Label %q}}\t{{.Image}}\t{{.Status}}\t{{.Ports}}", core.DockerProfileLabel)
+
+	return dockerRun("ps", "--all", "--filter", filter, "--format", format)
+}
+
+// dockerGetProfile loads a docker profile by name
+func dockerGetProfile(name string) (*grpc.Client, *model.DockerProfile, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	profile, err := client.GetDockerProfile(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get docker profile: %w", err)
+	}
+
+	if profile == nil {
+		return nil, nil, fmt.Errorf("docker profile '%s' not found; create it with: clonr profile docker add %s", name, name)
+	}
+
+	return client, profile, nil
+}
+
+// dockerRun runs the docker CLI with its output on the terminal
+func dockerRun(args ...string) error {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return fmt.Errorf("docker not found in PATH")
+	}
+
+	c := exec.Command("docker", args...)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+
+	return c.Run()
+}
+
+// printDockerEnvironment shows the container of a docker profile
+func printDockerEnvironment(env *model.DockerEnvironment) {
+	_, _ = fmt.Fprintf(os.Stdout, "Image:    %s\n", env.Image)
+
+	if env.Workdir != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "Workdir:  %s\n", env.Workdir)
+	}
+
+	if len(env.Command) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Command:  %s\n", strings.Join(env.Command, " "))
+	}
+
+	for _, e := range env.Env {
+		_, _ = fmt.Fprintf(os.Stdout, "Env:      %s\n", e)
+	}
+
+	for _, mount := range env.Mounts {
+		_, _ = fmt.Fprintf(os.Stdout, "Mount:    %s\n", core.FormatDockerMount(mount))
+	}
+
+	for _, port := range env.Ports {
+		_, _ = fmt.Fprintf(os.Stdout, "Port:     %s\n", port)
+	}
+}
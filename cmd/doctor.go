@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the health of tracked repositories",
+	Long: `Verify that every repository tracked by clonr is still healthy.
+
+For each repository the following checks are performed:
+  - The stored path still exists on disk
+  - The path is a valid git repository
+  - The origin remote matches the stored URL
+  - The remote is reachable (git ls-remote)
+
+Use --fix to prune entries whose path is gone and re-map entries whose
+origin remote no longer matches the stored URL.
+
+Examples:
+  clonr doctor                  # Report problems
+  clonr doctor --fix            # Prune or re-map stale entries
+  clonr doctor --skip-remote    # Skip network reachability checks
+  clonr doctor --json           # Output report as JSON`,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().Bool("fix", false, "Prune or re-map stale entries in the database")
+	doctorCmd.Flags().Bool("skip-remote", false, "Skip remote reachability checks")
+	doctorCmd.Flags().Duration("timeout", core.TimeoutShort, "Timeout for each remote check")
+	doctorCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runDoctor(cmd *cobra.Command, _ []string) error {
+	fix, _ := cmd.Flags().GetBool("fix")
+	skipRemote, _ := cmd.Flags().GetBool("skip-remote")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if !jsonOutput {
+		_, _ = fmt.Fprintln(os.Stderr, "Checking repositories...")
+	}
+
+	report, err := core.RunDoctor(core.DoctorOptions{
+		Fix:           fix,
+		CheckRemote:   !skipRemote,
+		RemoteTimeout: timeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(report)
+	}
+
+	for _, check := range report.Checks {
+		if check.Healthy() && check.Fixed == "" {
+			continue
+		}
+
+		status := "✗"
+		if check.Healthy() {
+			status = "✓"
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", status, check.URL)
+		_, _ = fmt.Fprintf(os.Stdout, "    Path: %s\n", check.Path)
+
+		for _, issue := range check.Issues {
+			_, _ = fmt.Fprintf(os.Stdout, "    - %s\n", issue.Message)
+		}
+
+		if check.Fixed != "" {
+			_, _ = fmt.Fprintf(os.Stdout, "    Fixed: %s\n", check.Fixed)
+		}
+
+		if check.FixError != "" {
+			_, _ = fmt.Fprintf(os.Stdout, "    Fix failed: %s\n", check.FixError)
+		}
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\n%d repositories checked: %d healthy, %d with issues\n",
+		report.Total, report.Healthy, report.Unhealthy)
+
+	if fix {
+		_, _ = fmt.Fprintf(os.Stdout, "Pruned: %d, Re-mapped: %d\n", report.Pruned, report.Remapped)
+	} else if report.Unhealthy > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "Run 'clonr doctor --fix' to prune or re-map stale entries")
+	}
+
+	return nil
+}
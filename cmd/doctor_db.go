@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/server/grpc"
+	"github.com/inovacc/clonr/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var doctorDBCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Check database responsiveness",
+	Long: `Time a few common database reads through the server to spot a sluggish
+database.
+
+With --perf, show the timings the server has collected for every database
+operation since it started: call counts, errors, average and slowest
+duration, and the most recent calls slower than the server's
+--slow-store-threshold. The same data is served in Prometheus format at
+/metrics on the web server.
+
+Examples:
+  clonr doctor db                 # Quick responsiveness check
+  clonr doctor db --perf          # Per-operation timings
+  clonr doctor db --perf --top 5  # Five most expensive operations
+  clonr doctor db --perf --reset  # Show, then clear the timings`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorDB,
+}
+
+var (
+	doctorDBPerf  bool
+	doctorDBTop   int
+	doctorDBReset bool
+	doctorDBJSON  bool
+)
+
+func init() {
+	doctorCmd.AddCommand(doctorDBCmd)
+
+	doctorDBCmd.Flags().BoolVar(&doctorDBPerf, "perf", false, "Show per-operation timings collected by the server")
+	doctorDBCmd.Flags().IntVar(&doctorDBTop, "top", 15, "Number of operations to show with --perf (0 for all)")
+	doctorDBCmd.Flags().BoolVar(&doctorDBReset, "reset", false, "Clear the collected timings after showing them")
+	doctorDBCmd.Flags().BoolVar(&doctorDBJSON, "json", false, "Output as JSON")
+}
+
+// dbProbe is one timed read of the responsiveness check
+type dbProbe struct {
+	Operation string        `json:"operation"`
+	Duration  time.Duration `json:"duration"`
+	Items     int           `json:"items"`
+	Error     string        `json:"error,omitempty"`
+}
+
+func runDoctorDB(_ *cobra.Command, _ []string) error {
+	if doctorDBPerf {
+		return runDoctorDBPerf()
+	}
+
+	client, err := clientgrpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	probe := func(name string, fn func() (int, error)) dbProbe {
+		start := time.Now()
+		n, err := fn()
+
+		p := dbProbe{Operation: name, Duration: time.Since(start), Items: n}
+		if err != nil {
+			p.Error = err.Error()
+		}
+
+		return p
+	}
+
+	probes := []dbProbe{
+		probe("Ping", func() (int, error) { return 0, client.Ping() }),
+		probe("GetAllRepos", func() (int, error) {
+			repos, err := client.GetAllRepos()
+			return len(repos), err
+		}),
+		probe("ListProfiles", func() (int, error) {
+			profiles, err := client.ListProfiles()
+			return len(profiles), err
+		}),
+		probe("ListWorkspaces", func() (int, error) {
+			workspaces, err := client.ListWorkspaces()
+			return len(workspaces), err
+		}),
+	}
+
+	if doctorDBJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(probes)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "OPERATION\tITEMS\tDURATION\tSTATUS")
+
+	slow := false
+
+	for _, p := range probes {
+		status := okStyle.Render("ok")
+
+		switch {
+		case p.Error != "":
+			status = errStyle.Render(p.Error)
+		case p.Duration >= store.DefaultSlowThreshold:
+			status = warnStyle.Render("slow")
+			slow = true
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", p.Operation, p.Items, p.Duration.Round(time.Microsecond), status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if slow {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("\nRun 'clonr doctor db --perf' to see which operations are slow"))
+	}
+
+	return nil
+}
+
+// runDoctorDBPerf prints the store timings collected by the server
+func runDoctorDBPerf() error {
+	endpoint, err := storeMetricsURL()
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	resp, err := httpClient.Get(endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to fetch store metrics: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch store metrics: %s", resp.Status)
+	}
+
+	var snap store.MetricsSnapshot
+	if err := json.NewDecoder(resp.Body).Decode(&snap); err != nil {
+		return fmt.Errorf("failed to decode store metrics: %w", err)
+	}
+
+	if doctorDBJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(snap); err != nil {
+			return err
+		}
+	} else if err := printStoreMetrics(snap); err != nil {
+		return err
+	}
+
+	if doctorDBReset {
+		req, err := http.NewRequest(http.MethodDelete, endpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to reset store metrics: %w", err)
+		}
+
+		_ = resp.Body.Close()
+
+		if !doctorDBJSON {
+			_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("\nTimings cleared"))
+		}
+	}
+
+	return nil
+}
+
+// printStoreMetrics prints the per-operation table and recent slow calls
+func printStoreMetrics(snap store.MetricsSnapshot) error {
+	threshold := "disabled"
+	if snap.Threshold > 0 {
+		threshold = snap.Threshold.String()
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Database operations since %s (slow threshold %s)\n\n", snap.Since.Format(time.DateTime), threshold)
+
+	if len(snap.Ops) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("No database operations recorded yet"))
+		return nil
+	}
+
+	ops := snap.Ops
+	if doctorDBTop > 0 && len(ops) > doctorDBTop {
+		ops = ops[:doctorDBTop]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "OPERATION\tCALLS\tERRORS\tAVG\tMAX\tTOTAL\tSLOW")
+
+	for _, op := range ops {
+		slow := fmt.Sprint(op.Slow)
+		if op.Slow > 0 {
+			slow = warnStyle.Render(slow)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\t%s\n", op.Method, op.Calls, op.Errors,
+			op.Avg().Round(time.Microsecond), op.Max.Round(time.Microsecond), op.Total.Round(time.Millisecond), slow)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(snap.Ops) > len(ops) {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("... %d more (use --top 0 to show all)", len(snap.Ops)-len(ops))))
+	}
+
+	if len(snap.Slow) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\n%s\n", warnStyle.Render("Recent slow operations:"))
+
+	for _, s := range snap.Slow {
+		line := fmt.Sprintf("  %s  %-28s %s", s.At.Format(time.DateTime), s.Method, s.Duration.Round(time.Millisecond))
+		if s.Error != "" {
+			line += "  " + errStyle.Render(s.Error)
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout, line)
+	}
+
+	return nil
+}
+
+// storeMetricsURL returns the store metrics endpoint of the running server's
+// web server. The endpoint only answers loopback requests.
+func storeMetricsURL() (string, error) {
+	info := grpc.IsServerRunning()
+	if info == nil || info.WebAddress == "" {
+		return "", fmt.Errorf("the clonr web server is not running; start it with 'clonr server start'")
+	}
+
+	u, err := url.Parse(info.WebAddress)
+	if err != nil {
+		return "", fmt.Errorf("invalid web server address %q: %w", info.WebAddress, err)
+	}
+
+	if ip := net.ParseIP(u.Hostname()); ip != nil && ip.IsUnspecified() {
+		u.Host = net.JoinHostPort("127.0.0.1", u.Port())
+	}
+
+	return u.JoinPath("/api/store/metrics").String(), nil
+}
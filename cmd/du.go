@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var duCmd = &cobra.Command{
+	Use:   "du",
+	Short: "Show disk usage per repository",
+	Long: `Show the on-disk size of every tracked repository, including its .git
+directory, with totals per workspace.
+
+With --prune-suggestions, only repositories untouched for --months months
+are listed, largest first, with the space removing them would free. A
+repository is touched by a commit or by a checkout or staging change;
+fetches by 'clonr update' do not count. Favorites are never suggested.
+
+Sort keys:
+  size       Largest first (default)
+  name       By URL
+  workspace  By workspace, then largest first
+  activity   Least recently used first
+
+Examples:
+  clonr du                            # All repositories
+  clonr du -w work --sort activity    # One workspace, stale first
+  clonr du --prune-suggestions        # Untouched for 6 months
+  clonr du --prune-suggestions --months 12
+  clonr du --json`,
+	Args: cobra.NoArgs,
+	RunE: runDu,
+}
+
+var (
+	duWorkspace string
+	duSort      string
+	duPrune     bool
+	duMonths    int
+	duJSON      bool
+)
+
+func init() {
+	rootCmd.AddCommand(duCmd)
+
+	duCmd.Flags().StringVarP(&duWorkspace, "workspace", "w", "", "Only repositories in this workspace")
+	duCmd.Flags().StringVarP(&duSort, "sort", "s", "size", "Sort by size, name, workspace or activity")
+	duCmd.Flags().BoolVar(&duPrune, "prune-suggestions", false, "List repositories untouched for --months months")
+	duCmd.Flags().IntVar(&duMonths, "months", 6, "Months without activity before a repository is suggested for pruning")
+	duCmd.Flags().BoolVar(&duJSON, "json", false, "Output as JSON")
+}
+
+func runDu(_ *cobra.Command, _ []string) error {
+	sortBy, err := core.ParseDiskUsageSort(duSort)
+	if err != nil {
+		return err
+	}
+
+	if duMonths <= 0 {
+		return fmt.Errorf("--months must be positive")
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.GetRepos(duWorkspace, false)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	if len(repos) == 0 {
+		printEmptyResult("repositories", "clonr clone <url>")
+		return nil
+	}
+
+	report := core.DiskUsage(repos)
+	core.SortRepoUsage(report.Repos, sortBy)
+
+	if duPrune {
+		return printPruneSuggestions(core.SuggestPrune(report.Repos, time.Now().AddDate(0, -duMonths, 0)))
+	}
+
+	if duJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(report)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tWORKSPACE\tSIZE\t.GIT\tLAST ACTIVITY")
+
+	for _, u := range report.Repos {
+		printRepoUsage(w, u)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKSPACE\tREPOS\tSIZE")
+
+	for _, ws := range report.Workspaces {
+		name := ws.Workspace
+		if name == "" {
+			name = dimStyle.Render("(none)")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", name, ws.Repos, core.FormatSize(ws.Bytes))
+	}
+
+	_, _ = fmt.Fprintf(w, "TOTAL\t%d\t%s\n", len(report.Repos), core.FormatSize(report.Total))
+
+	return w.Flush()
+}
+
+// printRepoUsage prints one row of the disk usage table
+func printRepoUsage(w *tabwriter.Writer, u core.RepoUsage) {
+	if u.Missing {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t-\t-\n", u.URL, u.Workspace, errStyle.Render("missing"))
+		return
+	}
+
+	activity := dimStyle.Render("never")
+	if !u.LastActivity.IsZero() {
+		activity = formatAge(u.LastActivity)
+	}
+
+	_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", u.URL, u.Workspace, core.FormatSize(u.Bytes), core.FormatSize(u.GitBytes), activity)
+}
+
+// printPruneSuggestions prints repositories that could be removed to free space
+func printPruneSuggestions(s *core.PruneSuggestion) error {
+	if duJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(s)
+	}
+
+	if len(s.Repos) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No repositories untouched for %d months.\n", duMonths)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tWORKSPACE\tSIZE\t.GIT\tLAST ACTIVITY")
+
+	for _, u := range s.Repos {
+		printRepoUsage(w, u)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\n%s %d repositories untouched for %d months, %s reclaimable\n",
+		warnStyle.Render("Prune suggestions:"), len(s.Repos), duMonths, core.FormatSize(s.Reclaimable))
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Stop tracking with 'clonr remove <url>', then delete the directory"))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Output formats for report-style commands
+const (
+	formatTable    = "table"
+	formatJSON     = "json"
+	formatCSV      = "csv"
+	formatMarkdown = "md"
+)
+
+// parseOutputFormat validates a --format value
+func parseOutputFormat(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "":
+		return "", nil
+	case formatTable:
+		return formatTable, nil
+	case formatJSON:
+		return formatJSON, nil
+	case formatCSV:
+		return formatCSV, nil
+	case formatMarkdown, "markdown":
+		return formatMarkdown, nil
+	default:
+		return "", fmt.Errorf("invalid format %q (valid: table, json, csv, md)", format)
+	}
+}
+
+// writeExport writes rows as CSV or as a Markdown table
+func writeExport(w io.Writer, format string, headers []string, rows [][]string) error {
+	if format == formatCSV {
+		return writeCSV(w, headers, rows)
+	}
+
+	return writeMarkdownTable(w, headers, rows)
+}
+
+// writeCSV writes a header line followed by the rows
+func writeCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// markdownEscaper escapes characters that would break a Markdown table cell
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+// writeMarkdownTable writes a GitHub-flavored Markdown table
+func writeMarkdownTable(w io.Writer, headers []string, rows [][]string) error {
+	var sb strings.Builder
+
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+
+		for _, cell := range cells {
+			sb.WriteString(" ")
+			sb.WriteString(markdownEscaper.Replace(cell))
+			sb.WriteString(" |")
+		}
+
+		sb.WriteString("\n")
+	}
+
+	writeRow(headers)
+
+	sb.WriteString("|")
+
+	for range headers {
+		sb.WriteString(" --- |")
+	}
+
+	sb.WriteString("\n")
+
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "", want: ""},
+		{in: "CSV", want: formatCSV},
+		{in: "markdown", want: formatMarkdown},
+		{in: "md", want: formatMarkdown},
+		{in: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseOutputFormat(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOutputFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseOutputFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteExport(t *testing.T) {
+	headers := []string{"Name", "Path"}
+	rows := [][]string{
+		{"acme/api", "/src/api"},
+		{"acme/a|b", "/src/with, comma"},
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: formatCSV,
+			want:   "Name,Path\nacme/api,/src/api\nacme/a|b,\"/src/with, comma\"\n",
+		},
+		{
+			format: formatMarkdown,
+			want: "| Name | Path |\n| --- | --- |\n" +
+				"| acme/api | /src/api |\n| acme/a\\|b | /src/with, comma |\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var sb strings.Builder
+
+			if err := writeExport(&sb, tt.format, headers, rows); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := sb.String(); got != tt.want {
+				t.Errorf("writeExport() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
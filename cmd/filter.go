@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var filterCmd = &cobra.Command{
+	Use:     "filter",
+	Aliases: []string{"view", "views"},
+	Short:   "Manage saved repository filters (smart lists)",
+	Long: `Save named repository filters and recall them like smart playlists.
+
+A filter combines workspace, favorites and text criteria evaluated by the
+server with on-disk checks: uncommitted changes (--dirty) and project language
+detected from manifest files such as go.mod, Cargo.toml or package.json.
+
+Open a saved filter with 'clonr list --view <name>', or press v in the
+interactive list to pick one.
+
+Available Commands:
+  save         Create or update a saved filter
+  list         List saved filters
+  show         Show the repositories matching a filter
+  delete       Delete a saved filter`,
+}
+
+var filterSaveCmd = &cobra.Command{
+	Use:   "save <name>",
+	Short: "Create or update a saved filter",
+	Long: `Create or update a saved filter.
+
+Saving an existing name replaces its criteria.
+
+Examples:
+  clonr filter save dirty-go --dirty --language go --workspace work \
+      --description "dirty go repos in work workspace"
+  clonr filter save favs --favorites --sort updated
+  clonr filter save infra --query terraform
+  clonr filter save forks --kind fork`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFilterSave,
+}
+
+var filterListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List saved filters",
+	Long: `List saved filters and their criteria.
+
+Examples:
+  clonr filter list
+  clonr filter list --json`,
+	Args: cobra.NoArgs,
+	RunE: runFilterList,
+}
+
+var filterShowCmd = &cobra.Command{
+	Use:   "show <name>",
+	Short: "Show the repositories matching a filter",
+	Long: `Print the repositories matching a saved filter, one path per line.
+
+Examples:
+  clonr filter show dirty-go
+  clonr filter show dirty-go --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFilterShow,
+}
+
+var filterDeleteCmd = &cobra.Command{
+	Use:     "delete <name>",
+	Aliases: []string{"rm"},
+	Short:   "Delete a saved filter",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runFilterDelete,
+}
+
+func init() {
+	rootCmd.AddCommand(filterCmd)
+	filterCmd.AddCommand(filterSaveCmd)
+	filterCmd.AddCommand(filterListCmd)
+	filterCmd.AddCommand(filterShowCmd)
+	filterCmd.AddCommand(filterDeleteCmd)
+
+	filterSaveCmd.Flags().String("description", "", "Description shown in the view picker")
+	filterSaveCmd.Flags().StringP("workspace", "w", "", "Only repositories in this workspace")
+	filterSaveCmd.Flags().Bool("favorites", false, "Only favorite repositories")
+	filterSaveCmd.Flags().StringP("query", "q", "", "Only repositories whose URL or path contains this text")
+	filterSaveCmd.Flags().Bool("dirty", false, "Only repositories with uncommitted changes")
+	filterSaveCmd.Flags().StringP("language", "l", "", "Only repositories of this language (go, rust, python, ...)")
+	filterSaveCmd.Flags().String("sort", "", "Sort by: name, cloned, updated, commits, recent, changes")
+	filterSaveCmd.Flags().String("kind", "", "Only repositories of this kind: source, fork, mirror, archive, template")
+
+	filterListCmd.Flags().Bool("json", false, "Output as JSON")
+	filterShowCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runFilterSave(cmd *cobra.Command, args []string) error {
+	filter := &model.SavedFilter{Name: args[0]}
+
+	filter.Description, _ = cmd.Flags().GetString("description")
+	filter.Workspace, _ = cmd.Flags().GetString("workspace")
+	filter.FavoritesOnly, _ = cmd.Flags().GetBool("favorites")
+	filter.Query, _ = cmd.Flags().GetString("query")
+	filter.Dirty, _ = cmd.Flags().GetBool("dirty")
+	filter.Language, _ = cmd.Flags().GetString("language")
+	filter.Sort, _ = cmd.Flags().GetString("sort")
+
+	kind, _ := cmd.Flags().GetString("kind")
+	filter.Kind = model.RepoKind(kind)
+
+	if err := core.SaveFilter(filter); err != nil {
+		return fmt.Errorf("failed to save filter: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Saved filter '%s': %s", filter.Name, filter.Summary())))
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("Open it with: clonr list --view %s", filter.Name)))
+
+	return nil
+}
+
+func runFilterList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	filters, err := core.ListFilters()
+	if err != nil {
+		return fmt.Errorf("failed to list filters: %w", err)
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(filters)
+	}
+
+	if len(filters) == 0 {
+		printEmptyResult("saved filters", "clonr filter save <name> --dirty --language go")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tCRITERIA\tDESCRIPTION")
+
+	for _, f := range filters {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", f.Name, f.Summary(), f.Description)
+	}
+
+	return w.Flush()
+}
+
+func runFilterShow(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	filter, err := core.GetFilter(args[0])
+	if err != nil {
+		return err
+	}
+
+	repos, err := core.ViewRepos(filter)
+	if err != nil {
+		return fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(repos)
+	}
+
+	for _, r := range repos {
+		_, _ = fmt.Fprintln(os.Stdout, r.Path)
+	}
+
+	return nil
+}
+
+func runFilterDelete(_ *cobra.Command, args []string) error {
+	if err := core.DeleteFilter(args[0]); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Deleted filter '%s'", args[0])))
+
+	return nil
+}
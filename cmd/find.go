@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var findCmd = &cobra.Command{
+	Use:   "find [query...]",
+	Short: "Fuzzy find a repository and print its path",
+	Long: `Fuzzy find a repository and print its path.
+
+Opens an fzf-style finder: start typing to filter, use up/down (or
+ctrl+p/ctrl+n) to move and enter to select. Space-separated terms must all
+match. Matching ignores case unless the query contains an uppercase letter.
+
+The finder is drawn on stderr, so the selected path can be captured:
+
+  cd "$(clonr find)"
+
+Examples:
+  clonr find                     # Open the finder
+  clonr find api                 # Open the finder with a query
+  clonr find -1 clonr            # Print directly if only one repo matches
+  clonr find --filter gh cli     # Print all matches, best first, no UI
+  clonr find --favorites         # Search favorites only`,
+	RunE: runFind,
+}
+
+func init() {
+	rootCmd.AddCommand(findCmd)
+	findCmd.Flags().Bool("favorites", false, "Search only favorite repositories")
+	findCmd.Flags().BoolP("select-1", "1", false, "Print the match without opening the finder if there is only one")
+	findCmd.Flags().BoolP("filter", "f", false, "Print all matching paths, best match first, without opening the finder")
+}
+
+func runFind(cmd *cobra.Command, args []string) error {
+	favoritesOnly, _ := cmd.Flags().GetBool("favorites")
+	selectOne, _ := cmd.Flags().GetBool("select-1")
+	filterOnly, _ := cmd.Flags().GetBool("filter")
+
+	query := strings.Join(args, " ")
+
+	if filterOnly || selectOne {
+		repos, err := core.ListReposFiltered(favoritesOnly)
+		if err != nil {
+			return err
+		}
+
+		matches := cli.FuzzyFindRepos(repos, query)
+
+		if filterOnly {
+			if len(matches) == 0 {
+				return fmt.Errorf("no repository matches %q", query)
+			}
+
+			for _, repo := range matches {
+				_, _ = fmt.Fprintln(os.Stdout, repo.Path)
+			}
+
+			return nil
+		}
+
+		if len(matches) == 1 {
+			_, _ = fmt.Fprintln(os.Stdout, matches[0].Path)
+			return nil
+		}
+	}
+
+	m, err := cli.NewRepoList(favoritesOnly)
+	if err != nil {
+		return err
+	}
+
+	p := tea.NewProgram(m.WithFuzzy(query), tea.WithOutput(os.Stderr))
+
+	finalModel, err := p.Run()
+	if err != nil {
+		return err
+	}
+
+	if repo := finalModel.(cli.RepoListModel).GetSelectedRepo(); repo != nil {
+		_, _ = fmt.Fprintln(os.Stdout, repo.Path)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var forkCmd = &cobra.Command{
+	Use:   "fork <repository>",
+	Short: "Fork a GitHub repository and clone the fork",
+	Long: `Fork a GitHub repository, clone the fork and track it.
+
+The fork is cloned like 'clonr clone' (workspace routing, profile identity),
+the original repository is added as the "upstream" remote and recorded as
+the upstream of the fork, so 'clonr sync-fork' can bring it up to date.
+Forking an already forked repository reuses the existing fork.
+
+Examples:
+  clonr fork cli/cli
+  clonr fork https://github.com/cli/cli --org my-org
+  clonr fork cli/cli --name gh-cli --default-branch-only
+  clonr fork cli/cli --protocol ssh --workspace oss`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{networkAnnotation: "GitHub API"},
+	RunE:        runFork,
+}
+
+var syncForkCmd = &cobra.Command{
+	Use:   "sync-fork [repository]",
+	Short: "Merge or rebase a fork onto its upstream",
+	Long: `Bring the current branch of a fork up to date with its upstream.
+
+The "upstream" remote is fetched (and added from the upstream recorded by
+'clonr fork' if missing), then the upstream branch of the same name, or the
+upstream default branch, is merged or, with --rebase, rebased onto. The
+working tree must have no uncommitted changes; conflicts are left to resolve
+with git.
+
+Without a repository, the repository in the current directory is synced.
+
+Examples:
+  clonr sync-fork
+  clonr sync-fork cli --rebase --push
+  clonr sync-fork cli --branch trunk`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSyncFork,
+}
+
+func init() {
+	rootCmd.AddCommand(forkCmd)
+	rootCmd.AddCommand(syncForkCmd)
+
+	forkCmd.Flags().String("org", "", "Fork into this organization instead of your account")
+	forkCmd.Flags().String("name", "", "Name of the fork (default: the repository name)")
+	forkCmd.Flags().Bool("default-branch-only", false, "Fork only the default branch")
+	forkCmd.Flags().StringP("workspace", "w", "", "Workspace to clone into")
+	forkCmd.Flags().String("protocol", "", "Protocol of the remotes: https or ssh (default: https)")
+	forkCmd.Flags().String("token", "", "GitHub token (default: auto-detect)")
+	forkCmd.Flags().String("profile", "", "Profile whose token to use")
+	forkCmd.Flags().Bool("json", false, "Output as JSON")
+
+	syncForkCmd.Flags().String("branch", "", "Upstream branch (default: the current branch, else the upstream default branch)")
+	syncForkCmd.Flags().Bool("rebase", false, "Rebase onto the upstream branch instead of merging it")
+	syncForkCmd.Flags().Bool("push", false, "Push the synced branch to origin (with --force-with-lease after --rebase)")
+	syncForkCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runFork(cmd *cobra.Command, args []string) error {
+	var opts core.ForkOptions
+
+	opts.Organization, _ = cmd.Flags().GetString("org")
+	opts.Name, _ = cmd.Flags().GetString("name")
+	opts.DefaultBranchOnly, _ = cmd.Flags().GetBool("default-branch-only")
+	opts.Workspace, _ = cmd.Flags().GetString("workspace")
+	opts.Protocol, _ = cmd.Flags().GetString("protocol")
+	tokenFlag, _ := cmd.Flags().GetString("token")
+	profile, _ := cmd.Flags().GetString("profile")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if opts.Protocol != "" && opts.Protocol != "https" && opts.Protocol != "ssh" {
+		return fmt.Errorf("invalid protocol %q: use https or ssh", opts.Protocol)
+	}
+
+	token, _, err := core.ResolveGitHubToken(tokenFlag, profile)
+	if err != nil {
+		return err
+	}
+
+	if !jsonOutput {
+		_, _ = fmt.Fprintf(os.Stderr, "Forking %s...\n", args[0])
+	}
+
+	result, err := core.ForkRepo(token, args[0], opts)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(result)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Forked %s to %s", result.UpstreamURL, result.URL)))
+	_, _ = fmt.Fprintf(os.Stdout, "  Path:     %s\n", result.Path)
+	_, _ = fmt.Fprintf(os.Stdout, "  Upstream: %s (remote %q)\n", result.UpstreamURL, core.UpstreamRemote)
+
+	if result.Workspace != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "  Workspace: %s\n", result.Workspace)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Update it from upstream with: clonr sync-fork"))
+
+	return nil
+}
+
+func runSyncFork(cmd *cobra.Command, args []string) error {
+	var opts core.SyncForkOptions
+
+	opts.Branch, _ = cmd.Flags().GetString("branch")
+	opts.Rebase, _ = cmd.Flags().GetBool("rebase")
+	opts.Push, _ = cmd.Flags().GetBool("push")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var query string
+	if len(args) > 0 {
+		query = args[0]
+	}
+
+	repo, err := core.ResolveRepo(query)
+	if err != nil {
+		return err
+	}
+
+	result, err := core.SyncFork(repo, opts)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(result)
+	}
+
+	if result.Commits == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s is up to date with %s\n", okStyle.Render("✓"), result.Branch, result.Upstream)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s %d commit(s) from %s\n", okStyle.Render("✓"), result.Branch, result.Method, result.Commits, result.Upstream)
+	}
+
+	if result.Pushed {
+		_, _ = fmt.Fprintf(os.Stdout, "  Pushed %s to origin\n", result.Branch)
+	}
+
+	return nil
+}
@@ -19,14 +19,15 @@ var ghCmd = &cobra.Command{
 
 Available Commands:
   issues        Manage GitHub issues (list, create, close)
-  pr            Check pull request status
+  prs           List pull requests
+  pr            View, check out and check the status of pull requests
   actions       Check GitHub Actions workflow status
   release       Manage GitHub releases (create, download)
   contributors  View contributors and their activity journey
 
 Repository Detection:
   Commands auto-detect the repository from the current directory,
-  or you can specify it explicitly as owner/repo.
+  or you can specify it explicitly as owner/repo or a tracked repository name.
 
 Authentication:
   Uses GitHub token from (in priority order):
@@ -36,6 +37,7 @@ Authentication:
   4. GH_TOKEN environment variable
   5. Active clonr profile token
   6. gh CLI authentication`,
+	Annotations: map[string]string{networkAnnotation: "GitHub API"},
 }
 
 func init() {
@@ -17,7 +17,7 @@ var issuesCmd = &cobra.Command{
 
 Repository Detection:
   Commands auto-detect the repository from the current directory,
-  or you can specify it explicitly as owner/repo.
+  or you can specify it explicitly as owner/repo or a tracked repository name.
 
 Examples:
   clonr gh issues list                    # List issues in current repo
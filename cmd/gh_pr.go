@@ -12,17 +12,71 @@ import (
 
 var prCmd = &cobra.Command{
 	Use:   "pr",
-	Short: "Check pull request status",
-	Long: `Check the status of pull requests in a repository.
+	Short: "View, check out and check the status of pull requests",
+	Long: `View, check out and check the status of pull requests in a repository.
 
 Repository Detection:
   Commands auto-detect the repository from the current directory,
-  or you can specify it explicitly as owner/repo.
+  or you can specify it explicitly as owner/repo or a tracked repository name.
 
 Examples:
   clonr gh pr status                    # List open PRs in current repo
   clonr gh pr status 123                # Check specific PR status
-  clonr gh pr status owner/repo         # List PRs in specified repo`,
+  clonr gh pr status owner/repo         # List PRs in specified repo
+  clonr gh pr view 123                  # Show PR #123 with its description
+  clonr gh pr checkout 123              # Check out PR #123 locally`,
+}
+
+var prsCmd = &cobra.Command{
+	Use:   "prs [owner/repo]",
+	Short: "List pull requests for a repository",
+	Long: `List pull requests for a repository.
+
+By default, lists open pull requests. Use --state to filter by state.
+
+Examples:
+  clonr gh prs                          # List open PRs in current repo
+  clonr gh prs myproject                # List open PRs of a tracked repo
+  clonr gh prs owner/repo --state all   # List all PRs (open + closed)
+  clonr gh prs --base main              # Filter by base branch`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPRs,
+}
+
+var prViewCmd = &cobra.Command{
+	Use:   "view <pr-number> [owner/repo]",
+	Short: "Show a pull request",
+	Long: `Show a pull request with its description, reviews and checks.
+
+Examples:
+  clonr gh pr view 123                  # Show PR #123 in current repo
+  clonr gh pr view 123 owner/repo       # Show PR in specified repo
+  clonr gh pr view 123 --web            # Open PR #123 in the browser`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runPRView,
+}
+
+var prCheckoutCmd = &cobra.Command{
+	Use:   "checkout <pr-number> [owner/repo]",
+	Short: "Check out a pull request locally",
+	Long: `Fetch the head of a pull request into a local branch and check it out.
+
+The repository in the current directory is used when one of its remotes
+points to the PR's repository; otherwise the tracked clone is used. The
+head is fetched from the base repository, so PRs from forks work without
+adding a remote. The local branch is named after the PR branch, or pr-<number>
+when that would clash with the base branch.
+
+If the local branch already exists it is fast-forwarded; use --force to
+reset it to the PR head when it has diverged.
+
+Examples:
+  clonr gh pr checkout 123              # Check out PR #123 in current repo
+  clonr gh pr checkout 123 myproject    # Check out PR in a tracked repo
+  clonr gh pr checkout 123 --branch review-123
+  clonr gh pr checkout 123 --force      # Discard local changes to the branch`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runPRCheckout,
 }
 
 var prStatusCmd = &cobra.Command{
@@ -46,7 +100,10 @@ Examples:
 
 func init() {
 	ghCmd.AddCommand(prCmd)
+	ghCmd.AddCommand(prsCmd)
 	prCmd.AddCommand(prStatusCmd)
+	prCmd.AddCommand(prViewCmd)
+	prCmd.AddCommand(prCheckoutCmd)
 
 	// Status flags
 	addGHCommonFlags(prStatusCmd)
@@ -56,6 +113,142 @@ func init() {
 	prStatusCmd.Flags().String("sort", "created", "Sort by: created, updated, popularity, long-running")
 	prStatusCmd.Flags().String("order", "desc", "Sort order: asc, desc")
 	prStatusCmd.Flags().Int("limit", 30, "Maximum number of PRs to list (0 = unlimited)")
+
+	// List flags
+	addGHCommonFlags(prsCmd)
+	prsCmd.Flags().String("state", "open", "Filter by state: open, closed, all")
+	prsCmd.Flags().String("base", "", "Filter by base branch")
+	prsCmd.Flags().String("head", "", "Filter by head branch (user:branch)")
+	prsCmd.Flags().String("sort", "created", "Sort by: created, updated, popularity, long-running")
+	prsCmd.Flags().String("order", "desc", "Sort order: asc, desc")
+	prsCmd.Flags().Int("limit", 30, "Maximum number of PRs to list (0 = unlimited)")
+
+	// View flags
+	addGHCommonFlags(prViewCmd)
+	prViewCmd.Flags().BoolP("web", "w", false, "Open the pull request in the browser")
+
+	// Checkout flags
+	addGHCommonFlags(prCheckoutCmd)
+	prCheckoutCmd.Flags().StringP("branch", "b", "", "Local branch name (default: the PR branch)")
+	prCheckoutCmd.Flags().BoolP("force", "f", false, "Reset the local branch to the PR head if it has diverged")
+}
+
+func runPRs(cmd *cobra.Command, args []string) error {
+	flags := extractGHFlags(cmd)
+	state, _ := cmd.Flags().GetString("state")
+	base, _ := cmd.Flags().GetString("base")
+	head, _ := cmd.Flags().GetString("head")
+	sortBy, _ := cmd.Flags().GetString("sort")
+	order, _ := cmd.Flags().GetString("order")
+	limit, _ := cmd.Flags().GetInt("limit")
+
+	token, _, err := core.ResolveGitHubToken(flags.Token, flags.Profile)
+	if err != nil {
+		return err
+	}
+
+	owner, repo, err := detectRepo(args, flags.Repo, "Specify a repository with: clonr gh prs owner/repo")
+	if err != nil {
+		return err
+	}
+
+	return listPRs(token, owner, repo, flags.JSON, state, base, head, sortBy, order, limit)
+}
+
+func runPRView(cmd *cobra.Command, args []string) error {
+	flags := extractGHFlags(cmd)
+	web, _ := cmd.Flags().GetBool("web")
+
+	prNumber, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+	if err != nil || prNumber <= 0 {
+		return fmt.Errorf("invalid PR number: %s", args[0])
+	}
+
+	owner, repo, err := detectRepo(args[1:], flags.Repo, "Specify a repository with: clonr gh pr view 123 owner/repo")
+	if err != nil {
+		return err
+	}
+
+	if web {
+		url := fmt.Sprintf("https://github.com/%s/%s/pull/%d", owner, repo, prNumber)
+		_, _ = fmt.Fprintf(os.Stderr, "Opening %s\n", url)
+
+		return core.OpenBrowser(url)
+	}
+
+	token, _, err := core.ResolveGitHubToken(flags.Token, flags.Profile)
+	if err != nil {
+		return err
+	}
+
+	if !flags.JSON {
+		_, _ = fmt.Fprintf(os.Stderr, "Fetching PR #%d from %s/%s...\n", prNumber, owner, repo)
+	}
+
+	status, err := core.GetPRStatus(token, owner, repo, prNumber, core.PRStatusOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get PR: %w", err)
+	}
+
+	if flags.JSON {
+		return outputJSON(status)
+	}
+
+	printPRDetail(status)
+
+	if body := strings.TrimSpace(status.Body); body != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s\n", body)
+	}
+
+	return nil
+}
+
+func runPRCheckout(cmd *cobra.Command, args []string) error {
+	flags := extractGHFlags(cmd)
+	branch, _ := cmd.Flags().GetString("branch")
+	force, _ := cmd.Flags().GetBool("force")
+
+	prNumber, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+	if err != nil || prNumber <= 0 {
+		return fmt.Errorf("invalid PR number: %s", args[0])
+	}
+
+	token, _, err := core.ResolveGitHubToken(flags.Token, flags.Profile)
+	if err != nil {
+		return err
+	}
+
+	owner, repo, err := detectRepo(args[1:], flags.Repo, "Specify a repository with: clonr gh pr checkout 123 owner/repo")
+	if err != nil {
+		return err
+	}
+
+	if !flags.JSON {
+		_, _ = fmt.Fprintf(os.Stderr, "Fetching PR #%d from %s/%s...\n", prNumber, owner, repo)
+	}
+
+	result, err := core.CheckoutPR(token, owner, repo, prNumber, core.PRCheckoutOptions{
+		Branch: branch,
+		Force:  force,
+	})
+	if err != nil {
+		return err
+	}
+
+	if flags.JSON {
+		return outputJSON(result)
+	}
+
+	action := "Checked out"
+	if result.Updated {
+		action = "Updated and checked out"
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s #%d %s\n", okStyle.Render(action), result.PR.Number, result.PR.Title)
+	_, _ = fmt.Fprintf(os.Stdout, "  Branch: %s (%s → %s)\n", result.Branch, result.PR.Branch, result.PR.BaseBranch)
+	_, _ = fmt.Fprintf(os.Stdout, "  Path:   %s\n", dimStyle.Render(result.Path))
+
+	return nil
 }
 
 func runPRStatus(cmd *cobra.Command, args []string) error {
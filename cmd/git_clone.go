@@ -25,6 +25,8 @@ Supports multiple repository formats:
   - git@host:owner/repo  Clone using SSH URL
 
 Uses the active clonr profile for authentication with private repositories.
+Repositories matching a workspace URL pattern are cloned into that workspace
+with the workspace's profile.
 
 Examples:
   clonr git clone owner/repo
@@ -60,6 +62,21 @@ func runGitClone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Route by workspace URL patterns; the workspace's bound profile is used
+	// unless one was given
+	if workspace == "" {
+		if match, bound := matchCloneWorkspace(client, args[0]); match != nil {
+			workspace = match.Workspace.Name
+			opts.Workspace = workspace
+
+			if profile == "" {
+				profile = bound
+			}
+
+			_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("Workspace '%s' matched by pattern '%s'", workspace, match.Pattern)))
+		}
+	}
+
 	// Set profile if specified
 	if profile != "" {
 		if err := client.SetActiveProfile(profile); err != nil {
@@ -118,7 +135,10 @@ func runGitClone(cmd *cobra.Command, args []string) error {
 	}
 
 	// Interactive workspace selection
-	if opts.Workspace == "" && workspace == "" && !noTUI {
+	// The workspace only matters when no target directory was given
+	dirName := core.CloneDirName(args, opts.Subdir)
+
+	if opts.Workspace == "" && workspace == "" && !noTUI && dirName != "" {
 		workspaces, err := client.ListWorkspaces()
 		if err != nil {
 			return fmt.Errorf("failed to list workspaces: %w", err)
@@ -129,7 +149,7 @@ func runGitClone(cmd *cobra.Command, args []string) error {
 				return err
 			}
 		} else if len(workspaces) > 1 {
-			m, err := cli.NewWorkspaceSelectorForClone()
+			m, err := cli.NewWorkspaceSelectorForClone(dirName)
 			if err != nil {
 				return err
 			}
@@ -171,6 +191,10 @@ func runGitClone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if result.Workspace != "" {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("Workspace '%s' → %s", result.Workspace, result.TargetPath)))
+	}
+
 	// Clone with TUI
 	m := cli.NewCloneModel(result.CloneURL, result.TargetPath)
 	p := tea.NewProgram(m)
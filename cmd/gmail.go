@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/auth"
+	"github.com/inovacc/clonr/internal/cli"
 	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/gdrive"
 	"github.com/inovacc/clonr/internal/gmail"
@@ -30,11 +35,15 @@ func init() {
 	gmailCmd.AddCommand(gmailMessagesCmd)
 	gmailCmd.AddCommand(gmailReadCmd)
 	gmailCmd.AddCommand(gmailSearchCmd)
+	gmailCmd.AddCommand(gmailSendCmd)
 	gmailCmd.AddCommand(gmailAttachmentsCmd)
 	gmailCmd.AddCommand(gmailDownloadCmd)
 	gmailCmd.AddCommand(gmailCalendarCmd)
 	gmailCmd.AddCommand(gmailDriveCmd)
 	gmailCmd.AddCommand(gmailDriveDownloadCmd)
+	gmailCmd.AddCommand(gmailDriveLsCmd)
+	gmailCmd.AddCommand(gmailDriveUploadCmd)
+	gmailCmd.AddCommand(gmailWatchCmd)
 
 	// Add command flags
 	gmailAddCmd.Flags().String("client-id", "", "Google OAuth Client ID")
@@ -42,8 +51,10 @@ func init() {
 	gmailAddCmd.Flags().StringP("token", "t", "", "Access token (skip OAuth flow)")
 	gmailAddCmd.Flags().StringP("refresh-token", "r", "", "Refresh token for token rotation")
 	gmailAddCmd.Flags().Int("port", 8339, "Local callback server port for OAuth")
-	gmailAddCmd.Flags().String("scopes", "", "OAuth scopes (comma-separated)")
+	gmailAddCmd.Flags().String("scopes", "", "OAuth scopes to request and require (comma-separated)")
 	gmailAddCmd.Flags().String("name", "gmail", "Name for the Gmail channel configuration")
+	gmailAddCmd.Flags().Bool("device-code", false, "Authorize from another device instead of a local browser")
+	gmailAddCmd.Flags().Bool("allow-modify", false, "Request the gmail.modify scope and enable archive, mark-read and label")
 
 	// Remove command flags
 	gmailRemoveCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
@@ -76,6 +87,14 @@ func init() {
 	// Drive flags
 	gmailDriveCmd.Flags().Bool("json", false, "Output as JSON")
 	gmailDriveDownloadCmd.Flags().StringP("output", "o", "", "Output directory (default: current directory)")
+	gmailDriveLsCmd.Flags().Bool("json", false, "Output as JSON")
+	gmailDriveLsCmd.Flags().BoolP("browse", "b", false, "Browse folders interactively and download files")
+	gmailDriveLsCmd.Flags().StringP("output", "o", "", "Output directory for --browse downloads (default: current directory)")
+	gmailDriveUploadCmd.Flags().String("folder", "", "ID of the Drive folder to upload into (default: My Drive)")
+	gmailDriveUploadCmd.Flags().String("name", "", "File name in Drive (default: local file name)")
+	gmailDriveUploadCmd.Flags().String("update", "", "ID of an existing file to replace the content of")
+	gmailDriveUploadCmd.Flags().Bool("json", false, "Output as JSON")
+	gmailDriveUploadCmd.MarkFlagsMutuallyExclusive("folder", "update")
 }
 
 var gmailCmd = &cobra.Command{
@@ -92,13 +111,23 @@ Operation Commands:
   profile      Show Gmail account profile
   labels       List Gmail labels
   messages     List recent messages
+  browse       Browse the mailbox interactively
   read         Read a specific message
   search       Search messages
+  send         Send an email from the connected account
   attachments  List attachments in a message
   download     Download an attachment
   calendar     Show calendar events in a message
   drive        List Google Drive links in a message
   drive-download  Download a file from Google Drive
+  drive-ls        List or browse a Google Drive folder
+  drive-upload    Upload a file to Google Drive
+  watch        Notify or run a hook when matching emails arrive
+
+Write Commands (accounts added with --allow-modify):
+  archive      Remove messages from the inbox
+  mark-read    Mark messages as read or unread
+  label        Add or remove labels of messages
 
 Examples:
   # Setup
@@ -111,10 +140,18 @@ Examples:
   clonr gmail messages
   clonr gmail messages --limit 20 --label INBOX
   clonr gmail read <message-id>
+  clonr gmail browse
   clonr gmail search "from:someone@example.com"
+  clonr gmail send --to dev@example.com --subject "Status" --body-file status.txt
   clonr gmail calendar <message-id>
   clonr gmail drive <message-id>
-  clonr gmail drive-download <file-id>`,
+  clonr gmail drive-download <file-id>
+  clonr gmail drive-ls --browse
+  clonr gmail drive-upload backup.tar.gz --folder <folder-id>
+  clonr gmail watch --query "from:notifications@github.com"
+  clonr gmail archive <message-id>
+  clonr gmail label add Work <message-id>`,
+	Annotations: map[string]string{networkAnnotation: "Gmail"},
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
@@ -136,6 +173,13 @@ By default, this starts an OAuth flow where you:
 
 Alternatively, provide an access token directly with --token to skip OAuth.
 
+On a console or server without a browser, use --device-code: clonr shows a
+code to enter at https://www.google.com/device from any other device and
+waits until it is authorized. This needs an OAuth client of type "TVs and
+Limited Input devices", and Google only grants some scopes to such clients;
+if it rejects the Gmail scopes, run the flow on another machine and pass the
+token with --token.
+
 Prerequisites for OAuth:
   1. Go to https://console.cloud.google.com/apis/credentials
   2. Create an OAuth 2.0 Client ID (Desktop or Web application)
@@ -145,11 +189,20 @@ Prerequisites for OAuth:
 
 Required Scopes:
   - https://www.googleapis.com/auth/gmail.readonly
+  - https://www.googleapis.com/auth/gmail.send (for notifications)
   - https://www.googleapis.com/auth/userinfo.email
+  - https://www.googleapis.com/auth/drive.readonly (for drive-download)
+  - https://www.googleapis.com/auth/drive.file (for drive-upload)
+
+The integration is read-only. With --allow-modify the gmail.modify scope is
+requested as well and the archive, mark-read and label commands are enabled
+for the account.
 
 Examples:
   clonr gmail add --client-id <id> --client-secret <secret>
+  clonr gmail add --client-id <id> --client-secret <secret> --allow-modify
   clonr gmail add --token <access_token>
+  clonr gmail add --device-code --client-id <id> --client-secret <secret>
   GOOGLE_CLIENT_ID=xxx GOOGLE_CLIENT_SECRET=yyy clonr gmail add`,
 	RunE: runGmailAdd,
 }
@@ -305,45 +358,123 @@ Examples:
 	RunE: runGmailDriveDownload,
 }
 
+var gmailDriveLsCmd = &cobra.Command{
+	Use:   "drive-ls [folder-id]",
+	Short: "List or browse a Google Drive folder",
+	Long: `List the files in a Google Drive folder, or the root of My Drive without
+a folder ID. The folder ID is the last part of the folder URL in Drive.
+
+With --browse, an interactive browser opens: enter opens a folder or
+downloads the highlighted file, space marks files to download together,
+backspace goes to the parent folder and / filters by name.
+
+Examples:
+  clonr gmail drive-ls
+  clonr gmail drive-ls 1AbCdEfGhIjKlMnOpQrStUvWxYz
+  clonr gmail drive-ls --json
+  clonr gmail drive-ls --browse -o ~/Downloads`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runGmailDriveLs,
+}
+
+var gmailDriveUploadCmd = &cobra.Command{
+	Use:   "drive-upload <file>",
+	Short: "Upload a file to Google Drive",
+	Long: `Upload a file to Google Drive, such as a backup archive.
+
+Files are sent with a resumable upload in chunks, so a dropped connection
+resumes where it stopped instead of starting over. With --update the content
+of an existing file is replaced and Drive keeps the old one as a revision.
+
+The folder ID is the last part of the folder URL in Drive. Uploading needs
+the drive.file scope, which only allows access to files clonr created;
+reconnect accounts added without it with 'clonr gmail add'.
+
+Examples:
+  clonr gmail drive-upload backup.tar.gz
+  clonr gmail drive-upload backup.tar.gz --folder 1AbCdEfGhIjKlMnOpQrStUvWxYz
+  clonr gmail drive-upload backup.tar.gz --update 1BxiMVs0XRA5nFMdKvBdBZjgmUUqptlbs`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGmailDriveUpload,
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
 
 func gmailGetClient() (*gmail.Client, error) {
+	client, _, err := gmailGetClientConfig()
+
+	return client, err
+}
+
+// gmailGetModifyClient returns a client for commands that change messages,
+// which only accounts added with --allow-modify may run
+func gmailGetModifyClient() (*gmail.Client, error) {
+	client, config, err := gmailGetClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if config[gmailAllowModifyKey] != "true" {
+		return nil, fmt.Errorf("the Gmail integration is read-only; reconnect it with: clonr gmail add --allow-modify")
+	}
+
+	return client, nil
+}
+
+// gmailGetClientConfig returns a client of the Gmail account of the active
+// profile and the decrypted account config
+func gmailGetClientConfig() (*gmail.Client, map[string]string, error) {
 	pm, err := core.NewProfileManager()
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to server: %w", err)
+		return nil, nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
 
 	profile, err := pm.GetActiveProfile()
 	if err != nil {
-		return nil, fmt.Errorf("no active profile")
+		return nil, nil, fmt.Errorf("no active profile")
 	}
 
 	channel, err := pm.GetNotifyChannelByType(profile.Name, model.ChannelGmail)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get Gmail config: %w", err)
+		return nil, nil, fmt.Errorf("failed to get Gmail config: %w", err)
 	}
 
 	if channel == nil {
-		return nil, fmt.Errorf("no Gmail integration configured; add with: clonr gmail add")
+		return nil, nil, fmt.Errorf("no Gmail integration configured; add with: clonr gmail add")
 	}
 
 	config, err := pm.DecryptChannelConfig(profile.Name, channel)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decrypt Gmail config: %w", err)
+		return nil, nil, fmt.Errorf("failed to decrypt Gmail config: %w", err)
 	}
 
 	accessToken := config["access_token"]
 	if accessToken == "" {
-		return nil, fmt.Errorf("no access token found in Gmail config")
+		return nil, nil, fmt.Errorf("no access token found in Gmail config")
 	}
 
 	return gmail.NewClient(accessToken, gmail.ClientOptions{
-		RefreshToken: config["refresh_token"],
-		ClientID:     config["client_id"],
-		ClientSecret: config["client_secret"],
-	}), nil
+		RefreshToken:   config["refresh_token"],
+		ClientID:       config["client_id"],
+		ClientSecret:   config["client_secret"],
+		OnTokenRefresh: gmailTokenSaver(pm, profile.Name, channel.ID),
+	}), config, nil
+}
+
+// gmailTokenSaver stores tokens refreshed by the Gmail client back in the
+// profile, so the next command does not need to refresh again
+func gmailTokenSaver(pm *core.ProfileManager, profileName, channelID string) func(*gmail.OAuthResult) {
+	return func(token *gmail.OAuthResult) {
+		err := pm.UpdateNotifyChannelConfig(profileName, channelID, map[string]string{
+			"access_token":  token.AccessToken,
+			"refresh_token": token.RefreshToken,
+		})
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("Failed to save refreshed Gmail token: %v", err)))
+		}
+	}
 }
 
 func gmailGetDriveClient() (*gdrive.Client, error) {
@@ -395,6 +526,8 @@ func runGmailAdd(cmd *cobra.Command, _ []string) error {
 	port, _ := cmd.Flags().GetInt("port")
 	scopes, _ := cmd.Flags().GetString("scopes")
 	channelName, _ := cmd.Flags().GetString("name")
+	deviceCode, _ := cmd.Flags().GetBool("device-code")
+	allowModify, _ := cmd.Flags().GetBool("allow-modify")
 
 	// Get profile manager and active profile
 	pm, err := core.NewProfileManager()
@@ -409,9 +542,30 @@ func runGmailAdd(cmd *cobra.Command, _ []string) error {
 
 	_, _ = fmt.Fprintf(os.Stdout, "Adding Gmail to profile %q\n\n", profile.Name)
 
+	// Parse scopes if provided
+	var scopeList []string
+	if scopes != "" {
+		scopeList = gmailParseScopes(scopes)
+	}
+
+	if allowModify {
+		if len(scopeList) == 0 {
+			scopeList = slices.Clone(gmail.DefaultScopes)
+		}
+
+		if !slices.Contains(scopeList, gmail.ModifyScope) {
+			scopeList = append(scopeList, gmail.ModifyScope)
+		}
+	}
+
+	requiredScopes := scopeList
+	if len(requiredScopes) == 0 {
+		requiredScopes = gmail.DefaultScopes
+	}
+
 	// If token provided directly, skip OAuth
 	if token != "" {
-		return gmailAddWithToken(pm, profile, token, refreshToken, channelName)
+		return gmailAddWithToken(pm, profile, token, refreshToken, channelName, requiredScopes, allowModify)
 	}
 
 	// Try environment variables if flags not provided
@@ -447,12 +601,6 @@ Also configure OAuth consent screen and enable Gmail API:
   3. Add redirect URI: http://localhost:%d/gmail/callback`, port)
 	}
 
-	// Parse scopes if provided
-	var scopeList []string
-	if scopes != "" {
-		scopeList = gmailParseScopes(scopes)
-	}
-
 	// Run OAuth flow
 	config := gmail.OAuthConfig{
 		ClientID:     clientID,
@@ -461,13 +609,26 @@ Also configure OAuth consent screen and enable Gmail API:
 		Scopes:       scopeList,
 	}
 
-	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Starting Gmail OAuth flow..."))
-	_, _ = fmt.Fprintln(os.Stdout, "")
-	_, _ = fmt.Fprintln(os.Stdout, "A browser window will open for authorization.")
-	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Waiting for authorization (timeout: 5 minutes)..."))
-	_, _ = fmt.Fprintln(os.Stdout, "")
+	var result *gmail.OAuthResult
+
+	if deviceCode {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Starting Gmail device authorization..."))
+
+		_, _ = fmt.Fprintln(os.Stdout, "")
+
+		result, err = gmail.RunDeviceFlow(cmd.Context(), config, func(code *auth.DeviceCode) {
+			printDeviceCode("Google", code.UserCode, code.VerificationURI)
+		})
+	} else {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Starting Gmail OAuth flow..."))
+		_, _ = fmt.Fprintln(os.Stdout, "")
+		_, _ = fmt.Fprintln(os.Stdout, "A browser window will open for authorization.")
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Waiting for authorization (timeout: 5 minutes)..."))
+		_, _ = fmt.Fprintln(os.Stdout, "")
+
+		result, err = gmail.RunOAuthFlow(cmd.Context(), config, core.OpenBrowser)
+	}
 
-	result, err := gmail.RunOAuthFlow(cmd.Context(), config, core.OpenBrowser)
 	if err != nil {
 		return fmt.Errorf("OAuth flow failed: %w", err)
 	}
@@ -488,6 +649,9 @@ Also configure OAuth consent screen and enable Gmail API:
 	_, _ = fmt.Fprintf(os.Stdout, "  Scopes:   %s\n", result.Scope)
 	_, _ = fmt.Fprintln(os.Stdout, "")
 
+	grantedScopes := gmail.ParseScopes(result.Scope)
+	printScopeCheck(core.CheckScopes(grantedScopes, requiredScopes, nil))
+
 	// Create NotifyChannel for Gmail
 	notifyChannel := &model.NotifyChannel{
 		ID:   channelName,
@@ -499,12 +663,17 @@ Also configure OAuth consent screen and enable Gmail API:
 			"email":         tokenInfo.Email,
 			"client_id":     clientID,
 			"client_secret": clientSecret,
+			"scopes":        strings.Join(grantedScopes, ","),
 		},
 		Enabled:   true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	if allowModify {
+		notifyChannel.Config[gmailAllowModifyKey] = "true"
+	}
+
 	// Save to profile (AddNotifyChannel encrypts sensitive fields)
 	if err := pm.AddNotifyChannel(profile.Name, notifyChannel); err != nil {
 		return fmt.Errorf("failed to save Gmail credentials: %w", err)
@@ -520,7 +689,7 @@ Also configure OAuth consent screen and enable Gmail API:
 	return nil
 }
 
-func gmailAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, refreshToken, channelName string) error {
+func gmailAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, refreshToken, channelName string, requiredScopes []string, allowModify bool) error {
 	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Validating access token..."))
 
 	// Validate token and get user info
@@ -534,17 +703,45 @@ func gmailAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, r
 	_, _ = fmt.Fprintln(os.Stdout, "")
 	_, _ = fmt.Fprintf(os.Stdout, "  Email:    %s\n", tokenInfo.Email)
 	_, _ = fmt.Fprintf(os.Stdout, "  Verified: %t\n", tokenInfo.EmailVerified)
+
+	var (
+		grantedScopes []string
+		expiresAt     time.Time
+	)
+
+	accessInfo, err := gmail.InspectToken(context.Background(), token)
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("  Could not read token scopes: %v", err)))
+	} else {
+		grantedScopes = accessInfo.Scopes
+		expiresAt = accessInfo.ExpiresAt
+		_, _ = fmt.Fprintf(os.Stdout, "  Scopes:   %s\n", strings.Join(grantedScopes, " "))
+	}
+
 	_, _ = fmt.Fprintln(os.Stdout, "")
 
+	printScopeCheck(core.CheckScopes(grantedScopes, requiredScopes, nil))
+
 	// Create NotifyChannel for Gmail
 	config := map[string]string{
 		"access_token": token,
 		"email":        tokenInfo.Email,
 	}
 
-	// Add refresh token if provided
+	if grantedScopes != nil {
+		config["scopes"] = strings.Join(grantedScopes, ",")
+	}
+
+	if allowModify {
+		config[gmailAllowModifyKey] = "true"
+	}
+
+	// Add refresh token if provided; without one the access token expiry matters
 	if refreshToken != "" {
 		config["refresh_token"] = refreshToken
+	} else if !expiresAt.IsZero() {
+		config[core.ChannelExpiresAtKey] = expiresAt.UTC().Format(time.RFC3339)
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("  Access token expires %s and no refresh token was given", expiresAt.Local().Format("2006-01-02 15:04"))))
 	}
 
 	notifyChannel := &model.NotifyChannel{
@@ -656,19 +853,21 @@ func runGmailStatus(cmd *cobra.Command, _ []string) error {
 
 	if jsonOutput {
 		type gmailStatus struct {
-			Configured bool   `json:"configured"`
-			Profile    string `json:"profile"`
-			Email      string `json:"email,omitempty"`
-			Enabled    bool   `json:"enabled"`
-			CreatedAt  string `json:"created_at"`
+			Configured  bool   `json:"configured"`
+			Profile     string `json:"profile"`
+			Email       string `json:"email,omitempty"`
+			Enabled     bool   `json:"enabled"`
+			AllowModify bool   `json:"allow_modify"`
+			CreatedAt   string `json:"created_at"`
 		}
 
 		status := gmailStatus{
-			Configured: true,
-			Profile:    profile.Name,
-			Email:      config["email"],
-			Enabled:    channel.Enabled,
-			CreatedAt:  channel.CreatedAt.Format(time.RFC3339),
+			Configured:  true,
+			Profile:     profile.Name,
+			Email:       config["email"],
+			Enabled:     channel.Enabled,
+			AllowModify: config[gmailAllowModifyKey] == "true",
+			CreatedAt:   channel.CreatedAt.Format(time.RFC3339),
 		}
 
 		return outputJSON(status)
@@ -679,6 +878,7 @@ func runGmailStatus(cmd *cobra.Command, _ []string) error {
 	printBoxLine("Profile", profile.Name)
 	printBoxLine("Email", config["email"])
 	printBoxLine("Enabled", fmt.Sprintf("%t", channel.Enabled))
+	printBoxLine("Modify", fmt.Sprintf("%t", config[gmailAllowModifyKey] == "true"))
 	printBoxLine("Added", channel.CreatedAt.Format("2006-01-02 15:04:05"))
 	printBoxFooter()
 
@@ -688,38 +888,21 @@ func runGmailStatus(cmd *cobra.Command, _ []string) error {
 
 	accessToken := config["access_token"]
 	if accessToken != "" {
-		client := gmail.NewClient(accessToken, gmail.ClientOptions{})
+		saveToken := gmailTokenSaver(pm, profile.Name, channel.ID)
+
+		client := gmail.NewClient(accessToken, gmail.ClientOptions{
+			RefreshToken: config["refresh_token"],
+			ClientID:     config["client_id"],
+			ClientSecret: config["client_secret"],
+			OnTokenRefresh: func(token *gmail.OAuthResult) {
+				saveToken(token)
+				_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Token expired; refreshed and saved"))
+			},
+		})
 
 		gmailProfile, err := client.GetProfile(context.Background())
 		if err != nil {
-			// Try to refresh token if available
-			refreshToken := config["refresh_token"]
-			clientID := config["client_id"]
-			clientSecret := config["client_secret"]
-
-			if refreshToken != "" && clientID != "" && clientSecret != "" {
-				_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Token expired, attempting refresh..."))
-
-				newToken, refreshErr := gmail.RefreshAccessToken(context.Background(), clientID, clientSecret, refreshToken)
-				if refreshErr != nil {
-					_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Connection failed: %v", err)))
-					_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Token refresh failed: %v", refreshErr)))
-				} else {
-					// Update stored token
-					channel.Config["access_token"] = newToken.AccessToken
-					if newToken.RefreshToken != "" {
-						channel.Config["refresh_token"] = newToken.RefreshToken
-					}
-
-					if saveErr := pm.AddNotifyChannel(profile.Name, channel); saveErr != nil {
-						_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Failed to save refreshed token: %v", saveErr)))
-					} else {
-						_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Token refreshed and saved"))
-					}
-				}
-			} else {
-				_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Connection failed: %v", err)))
-			}
+			_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Connection failed: %v", err)))
 		} else {
 			_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Connection OK"))
 			_, _ = fmt.Fprintf(os.Stdout, "  Messages: %d\n", gmailProfile.MessagesTotal)
@@ -1405,10 +1588,86 @@ func runGmailDriveDownload(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get file info: %w", err)
 	}
 
+	return gmailDriveSave(context.Background(), driveClient, file, outputDir)
+}
+
+func runGmailDriveLs(cmd *cobra.Command, args []string) error {
+	folderID := ""
+	if len(args) > 0 {
+		folderID = args[0]
+	}
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	browse, _ := cmd.Flags().GetBool("browse")
+	outputDir, _ := cmd.Flags().GetString("output")
+
+	driveClient, err := gmailGetDriveClient()
+	if err != nil {
+		return err
+	}
+
+	if browse {
+		p := tea.NewProgram(cli.NewDriveBrowser(driveClient, folderID))
+
+		finalModel, err := p.Run()
+		if err != nil {
+			return err
+		}
+
+		result := finalModel.(cli.DriveBrowserModel)
+		if result.Error() != nil {
+			return fmt.Errorf("failed to list folder: %w", result.Error())
+		}
+
+		for _, file := range result.GetSelectedFiles() {
+			if err := gmailDriveSave(cmd.Context(), driveClient, &file, outputDir); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	files, err := driveClient.ListFolder(cmd.Context(), folderID)
+	if err != nil {
+		return fmt.Errorf("failed to list folder: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(files)
+	}
+
+	if len(files) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "Folder is empty.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tSIZE\tMODIFIED\tID")
+
+	for _, file := range files {
+		name, size := file.Name, gmailFormatFileSizeInt64(file.Size)
+
+		switch {
+		case file.IsFolder():
+			name, size = name+"/", "-"
+		case file.IsGoogleDoc():
+			size = "-"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", name, size, file.ModifiedTime.Format("2006-01-02 15:04"), file.ID)
+	}
+
+	return w.Flush()
+}
+
+// gmailDriveSave downloads a Drive file into outputDir, exporting Google
+// Docs, and prints where it was saved
+func gmailDriveSave(ctx context.Context, driveClient *gdrive.Client, file *gdrive.File, outputDir string) error {
 	_, _ = fmt.Fprintf(os.Stdout, "Downloading %s...\n", file.Name)
 
 	// Download the file
-	data, err := driveClient.DownloadFile(context.Background(), fileID)
+	data, err := driveClient.DownloadFile(ctx, file.ID)
 	if err != nil {
 		return fmt.Errorf("failed to download file: %w", err)
 	}
@@ -1440,6 +1699,80 @@ func runGmailDriveDownload(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runGmailDriveUpload(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	folderID, _ := cmd.Flags().GetString("folder")
+	name, _ := cmd.Flags().GetString("name")
+	updateID, _ := cmd.Flags().GetString("update")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat file: %w", err)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory; archive it first", path)
+	}
+
+	driveClient, err := gmailGetDriveClient()
+	if err != nil {
+		return err
+	}
+
+	opts := gdrive.UploadOptions{
+		Name:     name,
+		FolderID: folderID,
+	}
+
+	if !jsonOutput {
+		opts.OnProgress = func(sent, total int64) {
+			_, _ = fmt.Fprintf(os.Stderr, "\rUploading %s... %s / %s", filepath.Base(path),
+				gmailFormatFileSizeInt64(sent), gmailFormatFileSizeInt64(total))
+		}
+	}
+
+	var file *gdrive.File
+
+	if updateID != "" {
+		file, err = driveClient.UpdateFile(cmd.Context(), updateID, f, info.Size(), opts)
+	} else {
+		if opts.Name == "" {
+			opts.Name = filepath.Base(path)
+		}
+
+		file, err = driveClient.UploadFile(cmd.Context(), f, info.Size(), opts)
+	}
+
+	if !jsonOutput {
+		_, _ = fmt.Fprintln(os.Stderr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	if jsonOutput {
+		return outputJSON(file)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Uploaded: %s (%s)", file.Name, gmailFormatFileSizeInt64(info.Size()))))
+	_, _ = fmt.Fprintf(os.Stdout, "File ID: %s\n", file.ID)
+
+	if file.WebViewLink != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "Link:    %s\n", file.WebViewLink)
+	}
+
+	return nil
+}
+
 // ============================================================================
 // Utility Functions
 // ============================================================================
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+var gmailBrowseCmd = &cobra.Command{
+	Use:   "browse",
+	Short: "Browse the mailbox interactively",
+	Long: `Browse Gmail messages in an interactive terminal view.
+
+Messages of a label are listed on the left and the selected message is
+previewed on the right. More messages load as the selection reaches the end
+of the list.
+
+Keys:
+  ↑/↓          Select a message
+  enter, tab   Focus the preview to scroll it (esc or tab to go back)
+  l            Switch label
+  d            Download the attachments of the message
+  r            Reload the label
+  /            Filter the loaded messages
+  q            Quit
+
+Attachments are saved into --output and never replace existing files.
+
+Examples:
+  clonr gmail browse
+  clonr gmail browse --label SENT
+  clonr gmail browse -o ~/Downloads`,
+	RunE: runGmailBrowse,
+}
+
+func init() {
+	gmailCmd.AddCommand(gmailBrowseCmd)
+
+	gmailBrowseCmd.Flags().StringP("label", "l", "INBOX", "Label to open (INBOX, SENT, etc.)")
+	gmailBrowseCmd.Flags().StringP("output", "o", "", "Output directory for attachments (default: current directory)")
+}
+
+func runGmailBrowse(cmd *cobra.Command, _ []string) error {
+	label, _ := cmd.Flags().GetString("label")
+	outputDir, _ := cmd.Flags().GetString("output")
+
+	client, err := gmailGetClient()
+	if err != nil {
+		return err
+	}
+
+	finalModel, err := tea.NewProgram(cli.NewGmailBrowser(client, label, outputDir), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+
+	if err := finalModel.(cli.GmailBrowserModel).Error(); err != nil {
+		return fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	return nil
+}
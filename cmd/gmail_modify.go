@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/spf13/cobra"
+)
+
+// gmailAllowModifyKey is the Gmail account config key enabling the commands
+// that change messages
+const gmailAllowModifyKey = "allow_modify"
+
+var gmailArchiveCmd = &cobra.Command{
+	Use:   "archive <message-id...>",
+	Short: "Remove messages from the inbox",
+	Long: `Archive messages by removing them from the inbox. They stay in All Mail
+and keep their other labels.
+
+Changing messages needs an account added with --allow-modify.
+
+Examples:
+  clonr gmail archive 19c2d20451b4bb54
+  clonr gmail archive 19c2d20451b4bb54 19c2d20451b4bb55`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runGmailArchive,
+}
+
+var gmailMarkReadCmd = &cobra.Command{
+	Use:   "mark-read <message-id...>",
+	Short: "Mark messages as read or unread",
+	Long: `Mark messages as read, or as unread with --unread.
+
+Changing messages needs an account added with --allow-modify.
+
+Examples:
+  clonr gmail mark-read 19c2d20451b4bb54
+  clonr gmail mark-read --unread 19c2d20451b4bb54`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runGmailMarkRead,
+}
+
+var gmailLabelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Add or remove labels of messages",
+	Long: `Add or remove a label of messages. The label is given by name or ID as
+listed by 'clonr gmail labels'.
+
+Changing messages needs an account added with --allow-modify.
+
+Examples:
+  clonr gmail label add Work 19c2d20451b4bb54
+  clonr gmail label remove Work 19c2d20451b4bb54 19c2d20451b4bb55
+  clonr gmail label add STARRED 19c2d20451b4bb54`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var gmailLabelAddCmd = &cobra.Command{
+	Use:   "add <label> <message-id...>",
+	Short: "Add a label to messages",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runGmailLabel(cmd, args, true)
+	},
+}
+
+var gmailLabelRemoveCmd = &cobra.Command{
+	Use:   "remove <label> <message-id...>",
+	Short: "Remove a label from messages",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runGmailLabel(cmd, args, false)
+	},
+}
+
+func init() {
+	gmailCmd.AddCommand(gmailArchiveCmd)
+	gmailCmd.AddCommand(gmailMarkReadCmd)
+	gmailCmd.AddCommand(gmailLabelCmd)
+	gmailLabelCmd.AddCommand(gmailLabelAddCmd)
+	gmailLabelCmd.AddCommand(gmailLabelRemoveCmd)
+
+	gmailMarkReadCmd.Flags().Bool("unread", false, "Mark the messages as unread instead")
+}
+
+func runGmailArchive(cmd *cobra.Command, args []string) error {
+	client, err := gmailGetModifyClient()
+	if err != nil {
+		return err
+	}
+
+	return gmailModifyEach(cmd.Context(), args, "Archived", func(ctx context.Context, id string) error {
+		_, err := client.ArchiveMessage(ctx, id)
+		return err
+	})
+}
+
+func runGmailMarkRead(cmd *cobra.Command, args []string) error {
+	unread, _ := cmd.Flags().GetBool("unread")
+
+	client, err := gmailGetModifyClient()
+	if err != nil {
+		return err
+	}
+
+	action := "Marked as read"
+	if unread {
+		action = "Marked as unread"
+	}
+
+	return gmailModifyEach(cmd.Context(), args, action, func(ctx context.Context, id string) error {
+		_, err := client.MarkRead(ctx, id, !unread)
+		return err
+	})
+}
+
+func runGmailLabel(cmd *cobra.Command, args []string, add bool) error {
+	client, err := gmailGetModifyClient()
+	if err != nil {
+		return err
+	}
+
+	labels, err := client.ListLabels(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	label, ok := gmail.FindLabel(labels, args[0])
+	if !ok {
+		return fmt.Errorf("label %q not found; list labels with: clonr gmail labels", args[0])
+	}
+
+	action := "Removed " + label.Name + " from"
+	if add {
+		action = "Labeled " + label.Name + ":"
+	}
+
+	return gmailModifyEach(cmd.Context(), args[1:], action, func(ctx context.Context, id string) error {
+		if add {
+			_, err := client.ModifyMessage(ctx, id, []string{label.ID}, nil)
+			return err
+		}
+
+		_, err := client.ModifyMessage(ctx, id, nil, []string{label.ID})
+
+		return err
+	})
+}
+
+// gmailModifyEach applies a change to each message, reporting every message
+// and continuing past failures
+func gmailModifyEach(ctx context.Context, ids []string, action string, modify func(context.Context, string) error) error {
+	var failed []string
+
+	for _, id := range ids {
+		if err := modify(ctx, id); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("Failed to change %s: %v", id, err)))
+			failed = append(failed, id)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render(action), id)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to change %d of %d messages: %s", len(failed), len(ids), strings.Join(failed, ", "))
+	}
+
+	return nil
+}
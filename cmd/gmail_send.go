@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var gmailSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Send an email from the connected account",
+	Long: `Send an email from the Gmail account of the active profile.
+
+The body comes from --body, --body-file or standard input, so the output of
+any command can be mailed. Without --to the message goes to the connected
+account itself. Sending needs the gmail.send scope; reconnect accounts added
+without it with 'clonr gmail add'.
+
+Examples:
+  clonr gmail send --subject "Hello" --body "Sent from clonr"
+  clonr report fleet --preview | clonr gmail send --to team@example.com --subject "Fleet report"
+  clonr gmail send --to dev@example.com --subject "Status" --body-file status.txt --html-file status.html
+  clonr gmail send --subject "Backup" --body "Attached" --attach backup.json`,
+	Args: cobra.NoArgs,
+	RunE: runGmailSend,
+}
+
+func init() {
+	gmailSendCmd.Flags().StringSlice("to", nil, "Recipients (default the connected account)")
+	gmailSendCmd.Flags().StringSlice("cc", nil, "Cc recipients")
+	gmailSendCmd.Flags().StringSlice("bcc", nil, "Bcc recipients")
+	gmailSendCmd.Flags().StringP("subject", "s", "", "Subject (required)")
+	gmailSendCmd.Flags().StringP("body", "b", "", "Plain text body")
+	gmailSendCmd.Flags().String("body-file", "", "Read the plain text body from a file (- for stdin)")
+	gmailSendCmd.Flags().String("html-file", "", "Read an HTML version of the body from a file")
+	gmailSendCmd.Flags().StringArray("attach", nil, "File to attach (repeatable)")
+	gmailSendCmd.Flags().Bool("json", false, "Output the sent message as JSON")
+	_ = gmailSendCmd.MarkFlagRequired("subject")
+	gmailSendCmd.MarkFlagsMutuallyExclusive("body", "body-file")
+}
+
+func runGmailSend(cmd *cobra.Command, _ []string) error {
+	to, _ := cmd.Flags().GetStringSlice("to")
+	cc, _ := cmd.Flags().GetStringSlice("cc")
+	bcc, _ := cmd.Flags().GetStringSlice("bcc")
+	subject, _ := cmd.Flags().GetString("subject")
+	body, _ := cmd.Flags().GetString("body")
+	bodyFile, _ := cmd.Flags().GetString("body-file")
+	htmlFile, _ := cmd.Flags().GetString("html-file")
+	attachments, _ := cmd.Flags().GetStringArray("attach")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	switch {
+	case bodyFile == "-", bodyFile == "" && body == "" && !term.IsTerminal(int(os.Stdin.Fd())):
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read body from stdin: %w", err)
+		}
+
+		body = string(data)
+	case bodyFile != "":
+		data, err := os.ReadFile(bodyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read body file: %w", err)
+		}
+
+		body = string(data)
+	}
+
+	msg := &gmail.OutgoingMessage{
+		To:      to,
+		Cc:      cc,
+		Bcc:     bcc,
+		Subject: subject,
+		Body:    body,
+	}
+
+	if htmlFile != "" {
+		data, err := os.ReadFile(htmlFile)
+		if err != nil {
+			return fmt.Errorf("failed to read HTML file: %w", err)
+		}
+
+		msg.HTMLBody = string(data)
+	}
+
+	if strings.TrimSpace(msg.Body) == "" && msg.HTMLBody == "" {
+		return fmt.Errorf("message body is empty; pass --body, --body-file or pipe it in")
+	}
+
+	for _, path := range attachments {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read attachment: %w", err)
+		}
+
+		msg.Attachments = append(msg.Attachments, gmail.OutgoingAttachment{Filename: path, Data: data})
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 2*time.Minute)
+	defer cancel()
+
+	sent, err := gmailSend(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(sent)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s to %s %s\n",
+		okStyle.Render("Email sent"), strings.Join(msg.To, ", "), dimStyle.Render("("+sent.ID+")"))
+
+	return nil
+}
+
+// gmailSend sends msg from the Gmail account of the active profile, to the
+// account itself when msg has no recipients
+func gmailSend(ctx context.Context, msg *gmail.OutgoingMessage) (*gmail.SentMessage, error) {
+	client, err := gmailGetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.To)+len(msg.Cc)+len(msg.Bcc) == 0 {
+		profile, err := client.GetProfile(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Gmail profile: %w", err)
+		}
+
+		msg.To = []string{profile.EmailAddress}
+	}
+
+	sent, err := client.Send(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var gmailWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Notify or run a hook when matching emails arrive",
+	Long: `Have the clonr server poll Gmail for new messages matching a search, such
+as GitHub notification emails, and send a notification or run a hook for
+every one that arrives.
+
+The server polls each watch on its interval and remembers the last mailbox
+history ID it saw, so only mail arriving after the watch was added triggers
+it. Notifications use the gmail-message event (see 'clonr notify'). Hooks run
+with the platform shell and get the message in the environment:
+
+  CLONR_GMAIL_WATCH, CLONR_GMAIL_MESSAGE_ID, CLONR_GMAIL_THREAD_ID,
+  CLONR_GMAIL_FROM, CLONR_GMAIL_SUBJECT, CLONR_GMAIL_DATE, CLONR_GMAIL_SNIPPET
+
+Running the same command again with the same --name changes the watch.
+
+Examples:
+  clonr gmail watch --query "from:notifications@github.com"
+  clonr gmail watch --query "from:notifications@github.com subject:failed" --name ci --interval 2m
+  clonr gmail watch --query "label:deploys" --exec ./on-deploy.sh --no-notify
+  clonr gmail watch list
+  clonr gmail watch remove ci`,
+	Args: cobra.NoArgs,
+	RunE: runGmailWatch,
+}
+
+var gmailWatchListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List Gmail watches",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE:    runGmailWatchList,
+}
+
+var gmailWatchRemoveCmd = &cobra.Command{
+	Use:     "remove <name>",
+	Short:   "Stop a Gmail watch",
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runGmailWatchRemove,
+}
+
+func init() {
+	gmailWatchCmd.AddCommand(gmailWatchListCmd)
+	gmailWatchCmd.AddCommand(gmailWatchRemoveCmd)
+
+	gmailWatchCmd.Flags().StringP("query", "q", "", "Gmail search new messages must match (required)")
+	gmailWatchCmd.Flags().String("name", "", "Watch name (default derived from the query)")
+	gmailWatchCmd.Flags().Duration("interval", model.DefaultGmailWatchInterval, "Time between polls")
+	gmailWatchCmd.Flags().String("exec", "", "Shell command to run for every matching message")
+	gmailWatchCmd.Flags().Bool("no-notify", false, "Do not send notifications, only run the hook")
+	gmailWatchCmd.Flags().String("profile", "", "Profile whose Gmail account to watch (default active)")
+	_ = gmailWatchCmd.MarkFlagRequired("query")
+
+	gmailWatchListCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runGmailWatch(cmd *cobra.Command, _ []string) error {
+	query, _ := cmd.Flags().GetString("query")
+	name, _ := cmd.Flags().GetString("name")
+	interval, _ := cmd.Flags().GetDuration("interval")
+	command, _ := cmd.Flags().GetString("exec")
+	noNotify, _ := cmd.Flags().GetBool("no-notify")
+	profile, _ := cmd.Flags().GetString("profile")
+
+	watch := &model.GmailWatch{
+		Name:     name,
+		Profile:  profile,
+		Query:    query,
+		Interval: interval,
+		Notify:   !noNotify,
+		Exec:     command,
+	}
+
+	if err := core.AddGmailWatch(watch); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Watching Gmail as"), watch.Name)
+	_, _ = fmt.Fprintf(os.Stdout, "Query:    %s\n", watch.Query)
+	_, _ = fmt.Fprintf(os.Stdout, "Profile:  %s\n", watch.Profile)
+	_, _ = fmt.Fprintf(os.Stdout, "Interval: %s\n", watch.Interval)
+
+	if watch.Exec != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "Hook:     %s\n", watch.Exec)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("The server polls the watch while it runs; new mail from now on triggers it"))
+
+	return nil
+}
+
+func runGmailWatchList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	watches, err := core.ListGmailWatches()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(watches)
+	}
+
+	if len(watches) == 0 {
+		printEmptyResult("Gmail watches", "clonr gmail watch --query <query>")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tQUERY\tEVERY\tACTION\tLAST CHECK\tLAST MATCH")
+
+	for _, watch := range watches {
+		action := "notify"
+
+		switch {
+		case watch.Notify && watch.Exec != "":
+			action = "notify, hook"
+		case watch.Exec != "":
+			action = "hook"
+		}
+
+		lastCheck := gmailWatchAge(watch.LastCheckedAt)
+		if watch.LastError != "" {
+			lastCheck = errStyle.Render("failed " + lastCheck)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			watch.Name, watch.Query, watch.Interval, action, lastCheck, gmailWatchAge(watch.LastMatchAt))
+	}
+
+	return w.Flush()
+}
+
+func runGmailWatchRemove(_ *cobra.Command, args []string) error {
+	removed, err := core.RemoveGmailWatch(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		return fmt.Errorf("no Gmail watch named %s", args[0])
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Stopped Gmail watch"), args[0])
+
+	return nil
+}
+
+// gmailWatchAge formats the age of a watch timestamp, or "never"
+func gmailWatchAge(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+
+	return formatAge(t)
+}
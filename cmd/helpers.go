@@ -4,8 +4,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/ratelimit"
+	"golang.org/x/term"
 )
 
 // formatTokenStorage returns a human-readable string for the token storage type
@@ -20,6 +27,33 @@ func formatTokenStorage(ts model.TokenStorage) string {
 	}
 }
 
+// printScopeCheck warns about missing or excessive token scopes
+func printScopeCheck(check core.ScopeCheck) {
+	if check.Unknown {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render("  Warning: token scopes could not be determined"))
+		return
+	}
+
+	if len(check.Missing) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render("  Warning: token is missing required scopes: "+strings.Join(check.Missing, ", ")))
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Some commands may fail until the token is re-issued with these scopes."))
+	}
+
+	if len(check.Excess) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Note: token has scopes clonr does not need: "+strings.Join(check.Excess, ", ")))
+	}
+}
+
+// printDeviceCode shows the user code of a device flow and where to enter it
+func printDeviceCode(service, code, verificationURL string) {
+	_, _ = fmt.Fprintf(os.Stdout, "%s OAuth Authentication\n", service)
+	_, _ = fmt.Fprintln(os.Stdout, strings.Repeat("-", 40))
+	_, _ = fmt.Fprintf(os.Stdout, "\n1. Copy this code: %s\n\n", okStyle.Render(code))
+	_, _ = fmt.Fprintf(os.Stdout, "2. Open on any device: %s\n\n", verificationURL)
+	_, _ = fmt.Fprintln(os.Stdout, "3. Paste the code and authorize clonr")
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("\nWaiting for authorization (Ctrl+C to cancel)..."))
+}
+
 // promptConfirm asks the user for confirmation and returns true if they confirm
 // prompt should include the question (e.g., "Delete this file? [y/N]: ")
 func promptConfirm(prompt string) bool {
@@ -32,6 +66,23 @@ func promptConfirm(prompt string) bool {
 	return response == "y" || response == "Y"
 }
 
+// offerResume loads the saved draft of an interrupted flow into state and asks
+// whether to continue it. A declined draft is discarded.
+func offerResume(name, flow string, state any) bool {
+	savedAt, ok, err := core.LoadWizardDraft(name, state)
+	if err != nil || !ok {
+		return false
+	}
+
+	if promptConfirm(fmt.Sprintf("Resume the unfinished %s from %s? [y/N]: ", flow, formatAge(savedAt))) {
+		return true
+	}
+
+	_ = core.DeleteWizardDraft(name)
+
+	return false
+}
+
 // expandPath expands ~ to the user's home directory and returns an absolute path
 func expandPath(path string) (string, error) {
 	if len(path) == 0 {
@@ -129,3 +180,52 @@ func printInfoBox(title string, items map[string]string, order []string) {
 
 	printBoxFooter()
 }
+
+// autoStartEnv starts a server without asking when set to a true value
+const autoStartEnv = "CLONR_AUTO_START"
+
+// confirmServerStart asks whether to start a server in the background when
+// none is running. It does not ask with --auto-start or CLONR_AUTO_START,
+// or when stdin is not a terminal, so scripts keep working.
+func confirmServerStart() bool {
+	envAutoStart, _ := strconv.ParseBool(os.Getenv(autoStartEnv))
+
+	if !autoStart && !envAutoStart && term.IsTerminal(int(os.Stdin.Fd())) {
+		_, _ = fmt.Fprint(os.Stderr, "No clonr server is running. Start one in the background? [Y/n]: ")
+
+		var response string
+
+		_, _ = fmt.Scanln(&response)
+
+		if response = strings.ToLower(strings.TrimSpace(response)); response != "" && response != "y" && response != "yes" {
+			return false
+		}
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render("Starting a clonr server in the background (stop it with 'clonr server stop')..."))
+
+	return true
+}
+
+// promptKeystorePassphrase asks for the passphrase protecting the keystore.
+// Without a terminal it fails rather than reading stdin meant for the command.
+func promptKeystorePassphrase() (string, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", tpm.ErrPassphraseRequired
+	}
+
+	return readPassword("Keystore passphrase: ")
+}
+
+// printRateLimitWait tells the user why an API request is stalled. It
+// writes to stderr so JSON output on stdout stays clean.
+func printRateLimitWait(e ratelimit.Event) {
+	wait := e.Wait.Round(time.Second)
+
+	msg := fmt.Sprintf("%s is close to its rate limit, waiting %s", e.Host, wait)
+	if e.Retrying() {
+		msg = fmt.Sprintf("%s rate limited, retrying in %s (attempt %d/%d)", e.Host, wait, e.Attempt, e.MaxRetries)
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(msg))
+}
@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/spf13/cobra"
+)
+
+var identityCmd = &cobra.Command{
+	Use:   "identity",
+	Short: "Manage per-profile git commit identities",
+	Long: `Manage the git user.name and user.email bound to each profile.
+
+When a profile defines an identity, clonr writes it to the repository-local
+git config after every clone. The profile bound to the clone's workspace wins;
+otherwise the default profile is used. Repositories cloned earlier can be
+updated with 'clonr identity apply'.
+
+Available Commands:
+  set          Set the git identity of a profile
+  show         Show profile identities and the current repository's identity
+  apply        Write profile identities to existing repositories`,
+}
+
+var identitySetCmd = &cobra.Command{
+	Use:   "set <profile>",
+	Short: "Set the git identity of a profile",
+	Long: `Set the git user.name and user.email applied to repositories of a profile.
+
+Pass an empty value to clear a field.
+
+Examples:
+  clonr identity set work --name "Jane Doe" --email jane@company.com
+  clonr identity set personal --email jane@example.com
+  clonr identity set work --name "" --email ""    # Clear the identity`,
+	Args: cobra.ExactArgs(1),
+	RunE: runIdentitySet,
+}
+
+var identityShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show profile identities and the current repository's identity",
+	Long: `Show the git identity configured for each profile and, when run inside
+a repository, its local user.name and user.email.
+
+Examples:
+  clonr identity show
+  clonr identity show --json`,
+	Args: cobra.NoArgs,
+	RunE: runIdentityShow,
+}
+
+var identityApplyCmd = &cobra.Command{
+	Use:   "apply [path...]",
+	Short: "Write profile identities to existing repositories",
+	Long: `Write the matching profile's git identity to the local config of
+existing repositories.
+
+Without arguments the current repository is updated. Each repository gets the
+identity of the profile bound to its workspace, falling back to the default
+profile, unless --profile is given.
+
+Examples:
+  clonr identity apply                        # Current repository
+  clonr identity apply ~/src/api ~/src/web    # Specific repositories
+  clonr identity apply --all                  # Every tracked repository
+  clonr identity apply --workspace work       # Repositories in a workspace
+  clonr identity apply --all --profile work   # Force a profile's identity`,
+	RunE: runIdentityApply,
+}
+
+func init() {
+	rootCmd.AddCommand(identityCmd)
+	identityCmd.AddCommand(identitySetCmd)
+	identityCmd.AddCommand(identityShowCmd)
+	identityCmd.AddCommand(identityApplyCmd)
+
+	identitySetCmd.Flags().String("name", "", "Git user.name")
+	identitySetCmd.Flags().String("email", "", "Git user.email")
+
+	identityShowCmd.Flags().Bool("json", false, "Output as JSON")
+
+	identityApplyCmd.Flags().Bool("all", false, "Apply to every tracked repository")
+	identityApplyCmd.Flags().StringP("workspace", "w", "", "Apply to repositories in this workspace")
+	identityApplyCmd.Flags().StringP("profile", "p", "", "Apply this profile's identity instead of resolving per repository")
+	identityApplyCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runIdentitySet(cmd *cobra.Command, args []string) error {
+	if !cmd.Flags().Changed("name") && !cmd.Flags().Changed("email") {
+		return fmt.Errorf("at least one of --name or --email is required")
+	}
+
+	pm, err := core.NewProfileManager()
+	if err != nil {
+		return err
+	}
+
+	profile, err := pm.GetProfile(args[0])
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("name") {
+		profile.GitName, _ = cmd.Flags().GetString("name")
+	}
+
+	if cmd.Flags().Changed("email") {
+		profile.GitEmail, _ = cmd.Flags().GetString("email")
+	}
+
+	if err := pm.UpdateProfile(profile); err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Git identity for profile '%s': %s", profile.Name, formatIdentity(profile.GitName, profile.GitEmail))))
+
+	return nil
+}
+
+type identityShowOutput struct {
+	Profiles []identityProfileRow `json:"profiles"`
+	Repo     *core.IdentityResult `json:"repository,omitempty"`
+}
+
+type identityProfileRow struct {
+	Profile   string `json:"profile"`
+	Workspace string `json:"workspace,omitempty"`
+	Default   bool   `json:"default"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+}
+
+func runIdentityShow(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	pm, err := core.NewProfileManager()
+	if err != nil {
+		return err
+	}
+
+	profiles, err := pm.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	var out identityShowOutput
+
+	for _, p := range profiles {
+		out.Profiles = append(out.Profiles, identityProfileRow{
+			Profile:   p.Name,
+			Workspace: p.Workspace,
+			Default:   p.Default,
+			Name:      p.GitName,
+			Email:     p.GitEmail,
+		})
+	}
+
+	if root, err := git.RepoRoot(cmd.Context()); err == nil {
+		name, email, err := core.RepoIdentity(cmd.Context(), root)
+		if err != nil {
+			return err
+		}
+
+		out.Repo = &core.IdentityResult{Path: root, Name: name, Email: email}
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(out)
+	}
+
+	if len(out.Profiles) == 0 {
+		printEmptyResult("profiles", "clonr profile add <name>")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "PROFILE\tWORKSPACE\tIDENTITY")
+
+	for _, row := range out.Profiles {
+		name := row.Profile
+		if row.Default {
+			name += " *"
+		}
+
+		workspace := row.Workspace
+		if workspace == "" {
+			workspace = "-"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", name, workspace, formatIdentity(row.Name, row.Email))
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if out.Repo != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "\nRepository %s\n  %s\n", out.Repo.Path, formatIdentity(out.Repo.Name, out.Repo.Email))
+	}
+
+	return nil
+}
+
+func runIdentityApply(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool("all")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	profile, _ := cmd.Flags().GetString("profile")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if all && len(args) > 0 {
+		return fmt.Errorf("--all cannot be combined with repository paths")
+	}
+
+	opts := core.IdentityApplyOptions{
+		Profile:   profile,
+		Workspace: workspace,
+		Paths:     args,
+	}
+
+	if !all && workspace == "" && len(args) == 0 {
+		root, err := git.RepoRoot(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("not in a git repository (use --all or pass a path)")
+		}
+
+		opts.Paths = []string{root}
+	}
+
+	results, err := core.ApplyIdentities(opts)
+	if err != nil {
+		if errors.Is(err, core.ErrProfileNotFound) {
+			return fmt.Errorf("profile '%s' not found", profile)
+		}
+
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(results)
+	}
+
+	if len(results) == 0 {
+		printEmptyResult("repositories", "clonr clone <url>")
+		return nil
+	}
+
+	failed := 0
+
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s: %s\n", errStyle.Render("✗"), r.Path, r.Error)
+
+			continue
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s %s\n", okStyle.Render("✓"), r.Path,
+			dimStyle.Render(fmt.Sprintf("(%s: %s)", r.Profile, formatIdentity(r.Name, r.Email))))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to apply identity to %d of %d repositories", failed, len(results))
+	}
+
+	return nil
+}
+
+func formatIdentity(name, email string) string {
+	switch {
+	case name == "" && email == "":
+		return "(not set)"
+	case email == "":
+		return name
+	case name == "":
+		return "<" + email + ">"
+	default:
+		return fmt.Sprintf("%s <%s>", name, email)
+	}
+}
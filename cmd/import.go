@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import settings from other tools",
+	Long:  `Import existing settings from other tools into clonr.`,
+}
+
+var importGitConfigCmd = &cobra.Command{
+	Use:   "gitconfig",
+	Short: "Import identity, signing and URL rewrites from git config",
+	Long: `Read your global git config and map it into clonr so an existing setup
+keeps working:
+
+  - user.name, user.email, user.signingkey, gpg.format and commit.gpgsign
+    become the git identity of the default profile (or --profile)
+  - [includeIf "gitdir:..."] identities go to the profile bound to the
+    workspace with that path; the workspace is created if missing
+  - [includeIf "hasconfig:remote.*.url:..."] identities add the URL as a
+    pattern on a workspace named after the included file
+    (~/.gitconfig-work becomes "work")
+  - url.<base>.insteadOf rules are applied to clonr clone arguments
+  - credential helpers are listed; git keeps using them
+
+Fields already set in clonr are kept unless --overwrite is given. SSH hosts
+used by rewrites are checked against known_hosts.
+
+Examples:
+  clonr import gitconfig --dry-run          # Show what would change
+  clonr import gitconfig                    # Import the global git config
+  clonr import gitconfig --file ~/.gitconfig-work --profile work
+  clonr import gitconfig --overwrite --json`,
+	Args: cobra.NoArgs,
+	RunE: runImportGitConfig,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importGitConfigCmd)
+
+	importGitConfigCmd.Flags().String("file", "", "Git config file to read (default: global config)")
+	importGitConfigCmd.Flags().String("profile", "", "Profile receiving the global identity (default: default profile)")
+	importGitConfigCmd.Flags().Bool("dry-run", false, "Show what would be imported without saving")
+	importGitConfigCmd.Flags().Bool("overwrite", false, "Replace identity fields that are already set")
+	importGitConfigCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runImportGitConfig(cmd *cobra.Command, _ []string) error {
+	file, _ := cmd.Flags().GetString("file")
+	profile, _ := cmd.Flags().GetString("profile")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	overwrite, _ := cmd.Flags().GetBool("overwrite")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	res, err := core.ImportGitConfig(cmd.Context(), core.GitConfigImportOptions{
+		File:      file,
+		Profile:   profile,
+		Overwrite: overwrite,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(res)
+	}
+
+	if len(res.Changes) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No identity, includeIf or url.insteadOf settings found in %s\n", res.Source)
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Importing from %s\n\n", res.Source)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KIND\tTARGET\tSETTING\tSTATUS")
+
+	imported := 0
+
+	for _, c := range res.Changes {
+		status := okStyle.Render("imported")
+
+		switch {
+		case c.Skipped != "":
+			status = dimStyle.Render("skipped: " + c.Skipped)
+		case dryRun:
+			status = warnStyle.Render("would import")
+			imported++
+		default:
+			imported++
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Kind, c.Target, c.Detail, status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, warning := range res.Warnings {
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s %s", warnStyle.Render("Warning:"), warning)
+	}
+
+	if len(res.Warnings) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout)
+	}
+
+	switch {
+	case dryRun:
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("\nDry run: %d setting(s) would be imported", imported)))
+	case imported > 0:
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s\n", okStyle.Render(fmt.Sprintf("Imported %d setting(s)", imported)))
+	}
+
+	return nil
+}
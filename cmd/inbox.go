@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/inbox"
+	"github.com/spf13/cobra"
+)
+
+var inboxCmd = &cobra.Command{
+	Use:   "inbox",
+	Short: "Show unread email and Slack messages in one inbox",
+	Long: `Show unread Gmail messages, Slack mentions and Slack direct messages of the
+active profile in one chronological inbox, newest first.
+
+Slack mentions are found with search, which needs a user token with the
+search:read scope; with a bot token only direct messages are shown. Sources
+that are not configured are skipped.
+
+With --interactive the inbox opens in a terminal view with a preview pane;
+enter opens the selected message in Gmail or Slack.
+
+Examples:
+  clonr inbox
+  clonr inbox --since 7d --limit 50
+  clonr inbox --source slack
+  clonr inbox -i
+  clonr inbox --json`,
+	Args:        cobra.NoArgs,
+	Annotations: map[string]string{networkAnnotation: "Gmail and Slack"},
+	RunE:        runInbox,
+}
+
+func init() {
+	rootCmd.AddCommand(inboxCmd)
+
+	inboxCmd.Flags().String("since", "24h", "Show messages since duration (e.g., 24h, 7d)")
+	inboxCmd.Flags().IntP("limit", "n", 20, "Maximum number of messages of each source")
+	inboxCmd.Flags().StringSlice("source", []string{"gmail", "slack"}, "Sources to include: gmail, slack")
+	inboxCmd.Flags().StringP("account", "a", "", "Slack account to use")
+	inboxCmd.Flags().BoolP("interactive", "i", false, "Open the inbox in an interactive view")
+	inboxCmd.Flags().Bool("json", false, "Output as JSON")
+	inboxCmd.MarkFlagsMutuallyExclusive("interactive", "json")
+}
+
+func runInbox(cmd *cobra.Command, _ []string) error {
+	since, _ := cmd.Flags().GetString("since")
+	limit, _ := cmd.Flags().GetInt("limit")
+	sources, _ := cmd.Flags().GetStringSlice("source")
+	interactive, _ := cmd.Flags().GetBool("interactive")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	for _, source := range sources {
+		if source != string(inbox.SourceGmail) && source != string(inbox.SourceSlack) {
+			return fmt.Errorf("invalid source %q: use gmail or slack", source)
+		}
+	}
+
+	duration, err := slackParseDuration(since)
+	if err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+
+	after := time.Now().Add(-duration)
+
+	// A source is required when it was asked for alone
+	explicit := cmd.Flags().Changed("source")
+
+	type fetch func(ctx context.Context) ([]inbox.Item, error)
+
+	var (
+		names   []string
+		fetches []fetch
+	)
+
+	if slices.Contains(sources, string(inbox.SourceGmail)) {
+		client, err := gmailGetClient()
+
+		switch {
+		case err == nil:
+			names = append(names, "Gmail")
+			fetches = append(fetches, func(ctx context.Context) ([]inbox.Item, error) {
+				return inbox.GmailItems(ctx, client, after, limit)
+			})
+		case explicit:
+			return err
+		default:
+			inboxSkipped("Gmail", err)
+		}
+	}
+
+	if slices.Contains(sources, string(inbox.SourceSlack)) {
+		client, err := slackGetClient(cmd)
+
+		switch {
+		case err == nil:
+			names = append(names, "Slack")
+			fetches = append(fetches, func(ctx context.Context) ([]inbox.Item, error) {
+				return inbox.SlackItems(ctx, client, after, limit)
+			})
+		case explicit:
+			return err
+		default:
+			inboxSkipped("Slack", err)
+		}
+	}
+
+	if len(fetches) == 0 {
+		return fmt.Errorf("no Gmail or Slack integration configured; add one with: clonr gmail add or clonr slack add")
+	}
+
+	if !jsonOutput {
+		_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render(fmt.Sprintf("Fetching %s...", strings.Join(names, " and "))))
+	}
+
+	// Fetch the sources in parallel; a failing source leaves the others
+	results := make([][]inbox.Item, len(fetches))
+	errs := make([]error, len(fetches))
+
+	var wg sync.WaitGroup
+
+	for i, fetch := range fetches {
+		wg.Go(func() {
+			results[i], errs[i] = fetch(cmd.Context())
+		})
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("%s: %v", names[i], err)))
+		}
+	}
+
+	items := inbox.Merge(results...)
+
+	switch {
+	case jsonOutput:
+		return outputJSON(items)
+	case interactive:
+		_, err := tea.NewProgram(cli.NewInbox(items), tea.WithAltScreen()).Run()
+		return err
+	}
+
+	if len(items) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Nothing new since %s\n", since)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	for _, item := range items {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			item.Source.Icon(), item.Time.Local().Format("Jan 02 15:04"), inboxTruncate(item.From, 30), inboxTruncate(item.Where, 24), item.Title)
+	}
+
+	return w.Flush()
+}
+
+// inboxSkipped notes a source left out because it is not configured
+func inboxSkipped(name string, err error) {
+	_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render(fmt.Sprintf("Skipping %s: %v", name, err)))
+}
+
+// inboxTruncate cuts s to n runes
+func inboxTruncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n-1]) + "…"
+	}
+
+	return s
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// exitInterrupted is the exit code of a command stopped by Ctrl+C (128 + SIGINT)
+const exitInterrupted = 130
+
+// interruptGrace is how long an interrupted command may take to stop before
+// the process exits anyway, e.g. when it is blocked on a prompt
+const interruptGrace = 10 * time.Second
+
+var (
+	errInterrupted     = errors.New("interrupted")
+	errMaxTimeExceeded = errors.New("--max-time exceeded")
+)
+
+var (
+	// interruptHint is set once a command that stops gracefully is running
+	interruptHint atomic.Bool
+
+	// cancelMaxTime releases the --max-time timer
+	cancelMaxTime context.CancelFunc
+)
+
+// interruptContext returns a context cancelled with errInterrupted on the
+// first Ctrl+C or SIGTERM. Commands then stop their git processes and
+// report what they finished; a second Ctrl+C exits immediately, and so does
+// a command that has not returned within interruptGrace. The server
+// commands handle signals themselves and are not cut short.
+func interruptContext() (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	done := make(chan struct{})
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-sigs:
+		case <-done:
+			return
+		}
+
+		// Restore the default handling so a second signal terminates
+		signal.Stop(sigs)
+		cancel(errInterrupted)
+
+		if !interruptHint.Load() {
+			return
+		}
+
+		_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render("\nInterrupted, stopping... (press Ctrl+C again to quit immediately)"))
+
+		select {
+		case <-done:
+		case <-time.After(interruptGrace):
+			os.Exit(exitInterrupted)
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sigs)
+		close(done)
+		cancel(context.Canceled)
+	}
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Follow and cancel long-running operations",
+	Long: `Bulk updates, organization mirrors (--no-tui) and backups are tracked as
+jobs, with their progress and log, so they can be followed or cancelled from
+another terminal.
+
+A running job that stopped reporting progress is shown as stale: its
+process was most likely killed.
+
+Examples:
+  clonr jobs                     # Recent jobs
+  clonr jobs show 3fa2           # Progress and log of a job (ID prefix)
+  clonr jobs attach 3fa2         # Follow a running job live
+  clonr jobs cancel 3fa2         # Ask a running job to stop`,
+	RunE: runJobsList,
+}
+
+var jobsListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List recent jobs",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE:    runJobsList,
+}
+
+var jobsShowCmd = &cobra.Command{
+	Use:   "show <id>",
+	Short: "Show the progress and log of a job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runJobsShow,
+}
+
+var jobsCancelCmd = &cobra.Command{
+	Use:   "cancel <id>",
+	Short: "Ask a running job to stop",
+	Long: `Ask a running job to stop. The job notices within a few seconds, stops
+its running git processes and records what it processed so far.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJobsCancel,
+}
+
+var jobsAttachCmd = &cobra.Command{
+	Use:   "attach <id>",
+	Short: "Follow the live progress of a running job",
+	Long: `Show the progress and newest log lines of a job until it finishes.
+Press q to detach; the job keeps running.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runJobsAttach,
+}
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+	jobsCmd.AddCommand(jobsListCmd, jobsShowCmd, jobsCancelCmd, jobsAttachCmd)
+
+	for _, c := range []*cobra.Command{jobsCmd, jobsListCmd} {
+		c.Flags().IntP("limit", "n", 20, "Show at most this many jobs (0 for all)")
+		c.Flags().String("format", "", "Output format: table, json, csv, md")
+	}
+
+	jobsShowCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runJobsList(cmd *cobra.Command, _ []string) error {
+	limit, _ := cmd.Flags().GetInt("limit")
+	formatFlag, _ := cmd.Flags().GetString("format")
+
+	format, err := parseOutputFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := core.ListJobs(limit)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	switch format {
+	case formatJSON:
+		return outputJSON(jobs)
+	case formatCSV, formatMarkdown:
+		headers := []string{"ID", "KIND", "STATE", "DONE", "FAILED", "TOTAL", "STARTED", "DESCRIPTION"}
+
+		rows := make([][]string, 0, len(jobs))
+		for _, job := range jobs {
+			rows = append(rows, []string{
+				job.ID, job.Kind, jobState(&job, now),
+				strconv.Itoa(job.Done), strconv.Itoa(job.Failed), strconv.Itoa(job.Total),
+				job.StartedAt.Format(time.RFC3339), job.Description,
+			})
+		}
+
+		return writeExport(os.Stdout, format, headers, rows)
+	}
+
+	if len(jobs) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No jobs recorded")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tKIND\tSTATE\tPROGRESS\tSTARTED\tDESCRIPTION")
+
+	for _, job := range jobs {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			job.ID, job.Kind, renderJobState(&job, now), jobProgress(&job), formatAge(job.StartedAt), job.Description)
+	}
+
+	return w.Flush()
+}
+
+func runJobsShow(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	job, err := core.FindJob(args[0])
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(job)
+	}
+
+	now := time.Now()
+
+	_, _ = fmt.Fprintf(os.Stdout, "Job %s: %s %s\n", job.ID, job.Kind, job.Description)
+	_, _ = fmt.Fprintf(os.Stdout, "  State:    %s\n", renderJobState(job, now))
+	_, _ = fmt.Fprintf(os.Stdout, "  Progress: %s\n", jobProgress(job))
+	_, _ = fmt.Fprintf(os.Stdout, "  Started:  %s (%s)\n", job.StartedAt.Local().Format(time.DateTime), formatAge(job.StartedAt))
+
+	if job.FinishedAt != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "  Finished: %s (took %s)\n", job.FinishedAt.Local().Format(time.DateTime), job.FinishedAt.Sub(job.StartedAt).Round(time.Second))
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "  Updated:  %s\n", formatAge(job.UpdatedAt))
+	}
+
+	if job.Error != "" {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render("  Error:    "+job.Error))
+	}
+
+	if len(job.Log) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "\nLog:")
+
+		for _, line := range job.Log {
+			_, _ = fmt.Fprintln(os.Stdout, "  "+line)
+		}
+	}
+
+	return nil
+}
+
+func runJobsCancel(_ *cobra.Command, args []string) error {
+	job, err := core.CancelJob(args[0])
+	if err != nil {
+		return err
+	}
+
+	if job.Finished() {
+		_, _ = fmt.Fprintf(os.Stdout, "%s Job %s (%s) stopped reporting progress; marked cancelled\n", okStyle.Render("✓"), job.ID, job.Kind)
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Cancellation requested for job %s (%s)\n", okStyle.Render("✓"), job.ID, job.Kind)
+
+	return nil
+}
+
+func runJobsAttach(cmd *cobra.Command, args []string) error {
+	job, err := core.FindJob(args[0])
+	if err != nil {
+		return err
+	}
+
+	m := cli.NewJobAttachModel(job)
+
+	finalModel, err := tea.NewProgram(m, tea.WithContext(cmd.Context())).Run()
+	if err != nil && !errors.Is(err, tea.ErrProgramKilled) {
+		return fmt.Errorf("UI error: %w", err)
+	}
+
+	attached := finalModel.(*cli.JobAttachModel)
+	if attached.Err() != nil {
+		return attached.Err()
+	}
+
+	if attached.Detached() {
+		_, _ = fmt.Fprintf(os.Stdout, "Detached; follow again with 'clonr jobs attach %s'\n", job.ID)
+		return nil
+	}
+
+	if job := attached.Job(); job.State == model.JobFailed {
+		return fmt.Errorf("job %s failed: %s", job.ID, job.Error)
+	}
+
+	return nil
+}
+
+// jobState returns the state of a job, "stale" for a running job whose
+// process stopped reporting
+func jobState(job *model.Job, now time.Time) string {
+	switch {
+	case core.JobStale(job, now):
+		return "stale"
+	case job.State == model.JobRunning && job.CancelRequested:
+		return "cancelling"
+	default:
+		return string(job.State)
+	}
+}
+
+func renderJobState(job *model.Job, now time.Time) string {
+	state := jobState(job, now)
+
+	switch job.State {
+	case model.JobSucceeded:
+		return okStyle.Render(state)
+	case model.JobRunning:
+		if state == "stale" || state == "cancelling" {
+			return warnStyle.Render(state)
+		}
+
+		return state
+	default:
+		return warnStyle.Render(state)
+	}
+}
+
+func jobProgress(job *model.Job) string {
+	progress := fmt.Sprintf("%d/%d", job.Done, job.Total)
+	if job.Failed > 0 {
+		progress += fmt.Sprintf(" (%d failed)", job.Failed)
+	}
+
+	return progress
+}
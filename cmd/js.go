@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var jsCmd = &cobra.Command{
+	Use:   "js",
+	Short: "JavaScript package helpers for tracked repositories",
+	Long: `Helpers for JavaScript/TypeScript packages spread across tracked repositories.
+
+Package managers are detected from lock files:
+  pnpm-lock.yaml   pnpm (links via pnpm.overrides in package.json)
+  yarn.lock        yarn (yarn link)
+  otherwise        npm  (npm link)`,
+}
+
+var jsLinkCmd = &cobra.Command{
+	Use:   "link",
+	Short: "Link JS packages across repositories for local development",
+	Long: `Detect JS packages across tracked repositories and link packages that
+depend on each other, so changes in one repository are picked up by the others
+without publishing.
+
+Examples:
+  clonr js link                         # Link packages across all repositories
+  clonr js link --workspace frontend    # Only repositories in a workspace
+  clonr js link --dry-run               # Show what would be linked`,
+	RunE: runJSLink,
+}
+
+var jsUnlinkCmd = &cobra.Command{
+	Use:   "unlink",
+	Short: "Remove links created by 'clonr js link'",
+	Long: `Restore packages linked with 'clonr js link' to their registry versions.
+
+Examples:
+  clonr js unlink                       # Restore all linked packages
+  clonr js unlink --workspace frontend  # Only repositories in a workspace`,
+	RunE: runJSUnlink,
+}
+
+func init() {
+	rootCmd.AddCommand(jsCmd)
+	jsCmd.AddCommand(jsLinkCmd)
+	jsCmd.AddCommand(jsUnlinkCmd)
+
+	jsLinkCmd.Flags().StringP("workspace", "w", "", "Only link repositories in this workspace")
+	jsLinkCmd.Flags().Bool("dry-run", false, "Show what would be linked without changing anything")
+
+	jsUnlinkCmd.Flags().StringP("workspace", "w", "", "Only unlink repositories in this workspace")
+	jsUnlinkCmd.Flags().Bool("dry-run", false, "Show what would be unlinked without changing anything")
+}
+
+func runJSLink(cmd *cobra.Command, _ []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	plans, err := core.LinkJSPackages(core.JSLinkOptions{
+		Workspace: workspace,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(plans) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No cross-repository JS dependencies found.")
+		return nil
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintln(os.Stdout, "Dry run - the following links would be created:")
+	}
+
+	for _, plan := range plans {
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s (%s)\n", plan.Consumer.Name, plan.Consumer.Manager)
+		_, _ = fmt.Fprintf(os.Stdout, "  %s\n", plan.Consumer.Path)
+
+		for _, link := range plan.Links {
+			_, _ = fmt.Fprintf(os.Stdout, "  -> %s  %s\n", link.Name, link.Path)
+		}
+	}
+
+	if !dryRun {
+		_, _ = fmt.Fprintf(os.Stdout, "\nLinked %d package(s). Run 'clonr js unlink' to restore.\n", len(plans))
+	}
+
+	return nil
+}
+
+func runJSUnlink(cmd *cobra.Command, _ []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	paths, err := core.UnlinkJSPackages(core.JSLinkOptions{
+		Workspace: workspace,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(paths) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No linked packages found.")
+		return nil
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintln(os.Stdout, "Dry run - the following packages would be restored:")
+	}
+
+	for _, path := range paths {
+		_, _ = fmt.Fprintf(os.Stdout, "  %s\n", path)
+	}
+
+	if !dryRun {
+		_, _ = fmt.Fprintf(os.Stdout, "Restored %d package(s).\n", len(paths))
+	}
+
+	return nil
+}
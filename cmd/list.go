@@ -1,15 +1,19 @@
 package cmd
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/inovacc/clonr/internal/cli"
 	"github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
 	"github.com/spf13/cobra"
 )
 
@@ -18,34 +22,84 @@ var listCmd = &cobra.Command{
 	Short: "Interactively list all repositories",
 	Long: `Display all managed repositories in an interactive list.
 
-Use arrow keys to navigate and Enter to select actions.
+Use arrow keys to navigate and Enter to select actions. Space marks
+repositories (ctrl+a marks all shown) and 'a' opens the actions on the
+marked ones: favorite, move to workspace, tag, update or remove. Removing
+asks for confirmation with a summary of the repositories first.
+
+Press 'i' to show the details of the highlighted repository beside the
+list: path, branch, last commit, ahead/behind counts, tags and notes.
 
 Output Modes:
   (default)     Interactive TUI mode
   --table       Formatted table view
   --json        JSON output
+  --format csv  CSV with the selected columns, for spreadsheets
+  --format md   Markdown table with the selected columns, for wiki pages
 
 Sorting Options:
   --sort name     Sort alphabetically by URL
+  --sort frecency Sort by how often and how recently repositories were
+                  opened or updated (the interactive list's default)
   --sort cloned   Sort by clone date (newest first)
   --sort updated  Sort by last update date (newest first)
   --sort commits  Sort by total commit count (highest first)
   --sort recent   Sort by recent commits in last 30 days (highest first)
   --sort changes  Sort by total changes (additions + deletions)
+  --sort size     Sort by size on disk (largest first)
+  --sort ahead    Sort by commits ahead of upstream (highest first)
+  --sort behind   Sort by commits behind upstream (highest first)
+
+Columns (--columns, comma-separated):
+  name, path, workspace, fav   Shown by default
+  kind                         source, fork, mirror, archive or template
+  tags                         Latest git tag
+  updated                      Last update date
+  ahead-behind                 Commits ahead/behind upstream
+  size                         Size on disk
+  ci                           Latest GitHub Actions run
+  stats                        Commit statistics
+
+  Columns apply to the table and to the interactive list, where s cycles the
+  sort order from frecency to name, updated and cloned. Add --save to keep
+  --columns and --sort as your defaults.
 
 Filtering Options:
   --workspace <name>  Filter by workspace
+  --kind <kind>       Filter by kind (see 'clonr repo classify')
   --workspaces        Browse repos grouped by workspace (interactive)
   --favorites         Show only favorite repositories
+  --view <name>       Show a saved filter (see 'clonr filter save');
+                      press v in the interactive list to pick one
+
+Grouping (interactive):
+  --group workspace   Group by workspace
+  --group host        Group by git host (github.com, gitlab.com, ...)
+  --group favorite    Group favorites separately
+
+  In the list, press tab to change grouping, space or enter on a
+  section header to collapse/expand it, and z to collapse/expand all.
+
+Fuzzy Finder (interactive):
+  --fuzzy             Start typing to filter fzf-style, best match first
+                      (see also 'clonr find')
 
 Examples:
   clonr list                          # Interactive list
   clonr list --table                  # Table view
   clonr list --table --stats          # Table with commit statistics
   clonr list --workspaces             # Browse by workspace with switching
+  clonr list --group host             # Interactive list grouped by host
+  clonr list --fuzzy                  # Fuzzy finder mode
   clonr list --workspace personal     # Filter by workspace
+  clonr list --kind fork              # Only forks
+  clonr list --view dirty-go          # Saved filter
   clonr list --sort commits --stats   # Sort by commits with stats
-  clonr list --json --stats           # JSON output with stats`,
+  clonr list -t --columns name,ahead-behind,size,ci --sort size
+  clonr list --columns name,workspace,updated --save   # Save defaults
+  clonr list --json --stats           # JSON output with stats
+  clonr list --format csv > repos.csv # Spreadsheet export
+  clonr list --format md --columns name,workspace,ci`,
 	RunE: runList,
 }
 
@@ -53,27 +107,85 @@ func init() {
 	rootCmd.AddCommand(listCmd)
 	listCmd.Flags().Bool("favorites", false, "Show only favorite repositories")
 	listCmd.Flags().StringP("workspace", "w", "", "Filter by workspace")
+	listCmd.Flags().String("kind", "", "Filter by kind: source, fork, mirror, archive, template")
 	listCmd.Flags().Bool("workspaces", false, "Browse repos grouped by workspace (interactive)")
-	listCmd.Flags().String("sort", "", "Sort by: name, cloned, updated, commits, recent, changes")
+	listCmd.Flags().String("sort", "", "Sort by: name, frecency, cloned, updated, commits, recent, changes, size, ahead, behind")
 	listCmd.Flags().Bool("stats", false, "Include commit statistics (slower)")
 	listCmd.Flags().Bool("json", false, "Output as JSON")
 	listCmd.Flags().BoolP("table", "t", false, "Output as formatted table")
+	listCmd.Flags().String("format", "", "Output format: table, json, csv, md")
+	listCmd.Flags().String("group", "", "Group interactive list by: workspace, host, favorite")
+	listCmd.Flags().Bool("fuzzy", false, "Start the interactive list in fuzzy finder mode")
+	listCmd.Flags().String("view", "", "Show the repositories matching a saved filter")
+	listCmd.Flags().StringSlice("columns", nil, "Columns to show: name, path, workspace, fav, kind, tags, updated, ahead-behind, size, ci, stats")
+	listCmd.Flags().Bool("save", false, "Save --columns and --sort as the default list preferences")
 }
 
 func runList(cmd *cobra.Command, args []string) error {
 	favoritesOnly, _ := cmd.Flags().GetBool("favorites")
 	workspace, _ := cmd.Flags().GetString("workspace")
+	kindFlag, _ := cmd.Flags().GetString("kind")
 	workspacesMode, _ := cmd.Flags().GetBool("workspaces")
 	sortBy, _ := cmd.Flags().GetString("sort")
 	withStats, _ := cmd.Flags().GetBool("stats")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	tableOutput, _ := cmd.Flags().GetBool("table")
+	formatFlag, _ := cmd.Flags().GetString("format")
+	group, _ := cmd.Flags().GetString("group")
+	fuzzy, _ := cmd.Flags().GetBool("fuzzy")
+	view, _ := cmd.Flags().GetString("view")
+	columnNames, _ := cmd.Flags().GetStringSlice("columns")
+	save, _ := cmd.Flags().GetBool("save")
+
+	groupBy, err := cli.ParseRepoGroupBy(group)
+	if err != nil {
+		return err
+	}
+
+	var kind model.RepoKind
+	if kindFlag != "" {
+		if kind, err = model.ParseRepoKind(kindFlag); err != nil {
+			return err
+		}
+	}
 
-	// If sorting by commits/recent/changes, we need stats
-	if sortBy == "commits" || sortBy == "recent" || sortBy == "changes" {
+	format, err := parseOutputFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case format == formatJSON:
+		jsonOutput, format = true, ""
+	case format == "" && tableOutput:
+		format = formatTable
+	}
+
+	columns, sortKey, err := resolveListPreferences(columnNames, sortBy)
+	if err != nil {
+		return err
+	}
+
+	if save {
+		if err := core.SaveListPreferences(columns, sortKey); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintln(os.Stderr, okStyle.Render(fmt.Sprintf("Saved list preferences: columns %s, sort %s",
+			joinListColumns(columns), cmp.Or(string(sortKey), string(core.SortByName)))))
+	}
+
+	// If sorting by commits/recent/changes or showing stats, we need stats
+	if core.SortNeedsStats(sortKey) || slices.Contains(columns, core.ColumnStats) {
 		withStats = true
 	}
 
+	tableColumns := tableListColumns(columns, withStats)
+
+	if view != "" {
+		return runListView(view, groupBy, fuzzy, jsonOutput, format, columns, tableColumns)
+	}
+
 	// Workspaces mode - interactive workspace browser
 	if workspacesMode {
 		if jsonOutput {
@@ -84,13 +196,13 @@ func runList(cmd *cobra.Command, args []string) error {
 	}
 
 	// Table view mode
-	if tableOutput {
-		return listReposTable(favoritesOnly, workspace, sortBy, withStats)
+	if format != "" {
+		return listReposTable(favoritesOnly, workspace, kind, cmp.Or(sortKey, core.SortByName), withStats, tableColumns, format)
 	}
 
 	// Non-interactive mode with JSON, sort, or workspace filter
-	if jsonOutput || sortBy != "" || workspace != "" {
-		return listReposNonInteractive(favoritesOnly, workspace, sortBy, withStats, jsonOutput)
+	if jsonOutput || sortBy != "" || workspace != "" || kind != "" {
+		return listReposNonInteractive(favoritesOnly, workspace, kind, cmp.Or(sortKey, core.SortByName), withStats, jsonOutput, columns)
 	}
 
 	// Interactive mode
@@ -99,6 +211,96 @@ func runList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	m = m.WithGroupBy(groupBy).WithColumns(columns, cmp.Or(sortKey, core.SortByFrecency))
+	if fuzzy {
+		m = m.WithFuzzy("")
+	}
+
+	p := tea.NewProgram(m)
+	_, err = p.Run()
+
+	return err
+}
+
+// tableListColumns returns the table columns: the configured ones or the
+// defaults, plus stats when requested
+func tableListColumns(columns []core.ListColumn, withStats bool) []core.ListColumn {
+	if len(columns) == 0 {
+		columns = slices.Clone(core.DefaultListColumns)
+	}
+
+	if withStats && !slices.Contains(columns, core.ColumnStats) {
+		columns = append(columns, core.ColumnStats)
+	}
+
+	return columns
+}
+
+// resolveListPreferences returns the list columns and sort key from the
+// flags, falling back to the saved preferences. Columns are nil and the sort
+// key empty when neither is set.
+func resolveListPreferences(columnNames []string, sortBy string) ([]core.ListColumn, core.SortBy, error) {
+	columns, sortKey := core.ListPreferences()
+
+	if len(columnNames) > 0 {
+		parsed, err := core.ParseListColumns(columnNames)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(parsed) > 0 {
+			columns = parsed
+		}
+	}
+
+	if sortBy != "" {
+		parsed, err := core.ParseListSort(sortBy)
+		if err != nil {
+			return nil, "", err
+		}
+
+		sortKey = parsed
+	}
+
+	return slices.Clone(columns), sortKey, nil
+}
+
+// runListView lists the repositories matching a saved filter
+func runListView(name string, groupBy cli.RepoGroupBy, fuzzy, jsonOutput bool, format string, columns, tableColumns []core.ListColumn) error {
+	filter, err := core.GetFilter(name)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput || format != "" {
+		repos, err := core.ViewRepos(filter)
+		if err != nil {
+			return fmt.Errorf("failed to list repos: %w", err)
+		}
+
+		if format != "" {
+			columns = tableColumns
+		}
+
+		core.LoadRepoDetails(repos, columns, core.SortBy(filter.Sort))
+
+		if format != "" {
+			return printReposFormatted(repos, tableColumns, format)
+		}
+
+		return printRepos(repos, true)
+	}
+
+	m, err := cli.NewRepoListForView(filter)
+	if err != nil {
+		return err
+	}
+
+	m = m.WithGroupBy(groupBy).WithColumns(columns, "")
+	if fuzzy {
+		m = m.WithFuzzy("")
+	}
+
 	p := tea.NewProgram(m)
 	_, err = p.Run()
 
@@ -195,26 +397,7 @@ func listReposGroupedByWorkspace() error {
 	return enc.Encode(result)
 }
 
-func listReposNonInteractive(favoritesOnly bool, workspace, sortBy string, withStats, jsonOutput bool) error {
-	var sort core.SortBy
-
-	switch sortBy {
-	case "name":
-		sort = core.SortByName
-	case "cloned":
-		sort = core.SortByClonedAt
-	case "updated":
-		sort = core.SortByUpdatedAt
-	case "commits":
-		sort = core.SortByCommits
-	case "recent":
-		sort = core.SortByRecentCommits
-	case "changes":
-		sort = core.SortByChanges
-	default:
-		sort = core.SortByName
-	}
-
+func listReposNonInteractive(favoritesOnly bool, workspace string, kind model.RepoKind, sort core.SortBy, withStats, jsonOutput bool, columns []core.ListColumn) error {
 	if !jsonOutput {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repositories")
 
@@ -229,11 +412,16 @@ func listReposNonInteractive(favoritesOnly bool, workspace, sortBy string, withS
 		_, _ = fmt.Fprintf(os.Stderr, "...\n")
 	}
 
-	repos, err := core.ListReposWithStatsAndWorkspace(favoritesOnly, workspace, sort, withStats)
+	repos, err := listReposWithDetails(favoritesOnly, workspace, kind, sort, withStats, columns)
 	if err != nil {
-		return fmt.Errorf("failed to list repos: %w", err)
+		return err
 	}
 
+	return printRepos(repos, jsonOutput)
+}
+
+// printRepos prints repositories as JSON or as a plain text listing
+func printRepos(repos []core.RepoWithStats, jsonOutput bool) error {
 	if jsonOutput {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -278,26 +466,7 @@ func listReposNonInteractive(favoritesOnly bool, workspace, sortBy string, withS
 	return nil
 }
 
-func listReposTable(favoritesOnly bool, workspace, sortBy string, withStats bool) error {
-	var sort core.SortBy
-
-	switch sortBy {
-	case "name":
-		sort = core.SortByName
-	case "cloned":
-		sort = core.SortByClonedAt
-	case "updated":
-		sort = core.SortByUpdatedAt
-	case "commits":
-		sort = core.SortByCommits
-	case "recent":
-		sort = core.SortByRecentCommits
-	case "changes":
-		sort = core.SortByChanges
-	default:
-		sort = core.SortByName
-	}
-
+func listReposTable(favoritesOnly bool, workspace string, kind model.RepoKind, sort core.SortBy, withStats bool, columns []core.ListColumn, format string) error {
 	_, _ = fmt.Fprintf(os.Stderr, "Fetching repositories")
 
 	if workspace != "" {
@@ -310,114 +479,206 @@ func listReposTable(favoritesOnly bool, workspace, sortBy string, withStats bool
 
 	_, _ = fmt.Fprintf(os.Stderr, "...\n")
 
-	repos, err := core.ListReposWithStatsAndWorkspace(favoritesOnly, workspace, sort, withStats)
+	repos, err := listReposWithDetails(favoritesOnly, workspace, kind, sort, withStats, columns)
 	if err != nil {
-		return fmt.Errorf("failed to list repos: %w", err)
+		return err
 	}
 
-	if len(repos) == 0 {
-		_, _ = fmt.Fprintln(os.Stdout, "No repositories found")
+	return printReposFormatted(repos, columns, format)
+}
+
+// printReposFormatted prints repositories as a table, CSV or Markdown
+func printReposFormatted(repos []core.RepoWithStats, columns []core.ListColumn, format string) error {
+	if format == formatTable {
+		printReposTable(repos, columns)
+
 		return nil
 	}
 
-	// Calculate column widths
-	nameWidth := 4 // "Name" header
-	pathWidth := 4 // "Path" header
-	wsWidth := 9   // "Workspace" header
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = columnHeader(col)
+	}
 
-	for _, r := range repos {
-		name := extractRepoName(r.URL)
-		if len(name) > nameWidth {
-			nameWidth = len(name)
-		}
+	rows := make([][]string, len(repos))
 
-		shortPath := shortenPath(r.Path, 40)
-		if len(shortPath) > pathWidth {
-			pathWidth = len(shortPath)
-		}
+	for r, repo := range repos {
+		rows[r] = make([]string, len(columns))
 
-		if len(r.Workspace) > wsWidth {
-			wsWidth = len(r.Workspace)
+		for i, col := range columns {
+			rows[r][i] = columnValue(repo, col)
 		}
 	}
 
-	// Cap column widths
-	if nameWidth > 35 {
-		nameWidth = 35
+	return writeExport(os.Stdout, format, headers, rows)
+}
+
+// listReposWithDetails lists repositories with the stats and details needed
+// by the columns and sort key
+func listReposWithDetails(favoritesOnly bool, workspace string, kind model.RepoKind, sort core.SortBy, withStats bool, columns []core.ListColumn) ([]core.RepoWithStats, error) {
+	repos, err := core.ListReposWithStatsAndWorkspace(favoritesOnly, workspace, sort, withStats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	if kind != "" {
+		repos = slices.DeleteFunc(repos, func(r core.RepoWithStats) bool { return r.Kind != kind })
+	}
+
+	if core.ColumnsNeedDetails(columns) || core.SortNeedsDetails(sort) {
+		core.LoadRepoDetails(repos, columns, sort)
+		core.SortRepos(repos, sort)
+	}
+
+	return repos, nil
+}
+
+// columnWidthCaps limits the width of columns with long values
+var columnWidthCaps = map[core.ListColumn]int{
+	core.ColumnName:      35,
+	core.ColumnPath:      45,
+	core.ColumnWorkspace: 20,
+	core.ColumnTags:      20,
+}
+
+// printReposTable prints repositories as a formatted table with the given columns
+func printReposTable(repos []core.RepoWithStats, columns []core.ListColumn) {
+	if len(repos) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories found")
+		return
 	}
 
-	if pathWidth > 45 {
-		pathWidth = 45
+	widths := make([]int, len(columns))
+	headers := make([]string, len(columns))
+
+	for i, col := range columns {
+		headers[i] = columnHeader(col)
+		widths[i] = lipgloss.Width(headers[i])
 	}
 
-	if wsWidth > 20 {
-		wsWidth = 20
+	rows := make([][]string, len(repos))
+
+	for r, repo := range repos {
+		rows[r] = make([]string, len(columns))
+
+		for i, col := range columns {
+			cell := columnValue(repo, col)
+
+			switch {
+			case cell == "" && col != core.ColumnFavorite:
+				cell = "-"
+			case col == core.ColumnPath:
+				cell = shortenPath(cell, 40)
+			}
+
+			if limit, ok := columnWidthCaps[col]; ok {
+				cell = truncateString(cell, limit)
+			}
+
+			rows[r][i] = cell
+			widths[i] = max(widths[i], lipgloss.Width(cell))
+		}
 	}
 
-	// Print header
 	_, _ = fmt.Fprintf(os.Stdout, "\nRepositories (%d)\n\n", len(repos))
 
-	if withStats {
-		_, _ = fmt.Fprintf(os.Stdout, "  %-*s │ %-*s │ %-*s │ %s │ %s\n",
-			nameWidth, "Name",
-			pathWidth, "Path",
-			wsWidth, "Workspace",
-			"Fav",
-			"Stats")
-		_, _ = fmt.Fprintf(os.Stdout, "  %s─┼─%s─┼─%s─┼─%s─┼─%s\n",
-			strings.Repeat("─", nameWidth),
-			strings.Repeat("─", pathWidth),
-			strings.Repeat("─", wsWidth),
-			strings.Repeat("─", 3),
-			strings.Repeat("─", 30))
-	} else {
-		_, _ = fmt.Fprintf(os.Stdout, "  %-*s │ %-*s │ %-*s │ %s\n",
-			nameWidth, "Name",
-			pathWidth, "Path",
-			wsWidth, "Workspace",
-			"Fav")
-		_, _ = fmt.Fprintf(os.Stdout, "  %s─┼─%s─┼─%s─┼─%s\n",
-			strings.Repeat("─", nameWidth),
-			strings.Repeat("─", pathWidth),
-			strings.Repeat("─", wsWidth),
-			strings.Repeat("─", 3))
-	}
-
-	// Print rows
-	for _, r := range repos {
-		name := truncateString(extractRepoName(r.URL), nameWidth)
-		shortPath := truncateString(shortenPath(r.Path, 40), pathWidth)
-		ws := truncateString(r.Workspace, wsWidth)
+	separators := make([]string, len(columns))
+	for i, w := range widths {
+		separators[i] = strings.Repeat("─", w)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "  %s\n", joinTableCells(headers, widths))
+	_, _ = fmt.Fprintf(os.Stdout, "  %s\n", strings.Join(separators, "─┼─"))
+
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(os.Stdout, "  %s\n", joinTableCells(row, widths))
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout)
+}
+
+// joinTableCells pads cells to their column widths, leaving the last one unpadded
+func joinTableCells(cells []string, widths []int) string {
+	padded := make([]string, len(cells))
 
-		if ws == "" {
-			ws = "-"
+	for i, cell := range cells {
+		if i < len(cells)-1 {
+			cell += strings.Repeat(" ", widths[i]-lipgloss.Width(cell))
 		}
 
-		fav := " "
+		padded[i] = cell
+	}
+
+	return strings.Join(padded, " │ ")
+}
+
+// columnHeader returns the table header of a column
+func columnHeader(col core.ListColumn) string {
+	switch col {
+	case core.ColumnFavorite:
+		return "Fav"
+	case core.ColumnAheadBehind:
+		return "Sync"
+	case core.ColumnCI:
+		return "CI"
+	default:
+		name := string(col)
+
+		return strings.ToUpper(name[:1]) + name[1:]
+	}
+}
+
+// columnValue returns the cell of a column for a repository, or "" if unknown
+func columnValue(r core.RepoWithStats, col core.ListColumn) string {
+	value := ""
+
+	switch col {
+	case core.ColumnName:
+		value = extractRepoName(r.URL)
+	case core.ColumnPath:
+		value = r.Path
+	case core.ColumnWorkspace:
+		value = r.Workspace
+	case core.ColumnKind:
+		value = string(r.Kind)
+	case core.ColumnFavorite:
 		if r.Favorite {
-			fav = "*"
+			return "*"
 		}
-
-		if withStats && r.Stats != nil {
-			stats := formatCompactStats(r.Stats)
-			_, _ = fmt.Fprintf(os.Stdout, "  %-*s │ %-*s │ %-*s │  %s  │ %s\n",
-				nameWidth, name,
-				pathWidth, shortPath,
-				wsWidth, ws,
-				fav,
-				stats)
-		} else {
-			_, _ = fmt.Fprintf(os.Stdout, "  %-*s │ %-*s │ %-*s │  %s\n",
-				nameWidth, name,
-				pathWidth, shortPath,
-				wsWidth, ws,
-				fav)
+	case core.ColumnUpdated:
+		if !r.UpdatedAt.IsZero() {
+			value = r.UpdatedAt.Format("2006-01-02")
+		}
+	case core.ColumnStats:
+		if r.Stats != nil {
+			value = formatCompactStats(r.Stats)
+		}
+	case core.ColumnAheadBehind:
+		return core.FormatAheadBehind(r.Details)
+	}
+
+	if r.Details != nil {
+		switch col {
+		case core.ColumnTags:
+			value = r.Details.LatestTag
+		case core.ColumnSize:
+			value = core.FormatSize(r.Details.Size)
+		case core.ColumnCI:
+			value = r.Details.CI
 		}
 	}
 
-	_, _ = fmt.Fprintln(os.Stdout)
+	return value
+}
 
-	return nil
+// joinListColumns formats columns as a comma-separated list
+func joinListColumns(columns []core.ListColumn) string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = string(c)
+	}
+
+	return strings.Join(names, ",")
 }
 
 // extractRepoName extracts the repository name from a URL
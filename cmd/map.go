@@ -1,24 +1,49 @@
 package cmd
 
 import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
 	"github.com/inovacc/clonr/internal/core"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var mapCmd = &cobra.Command{
-	Use:   "map [directory]",
+	Use:   "map [directory...]",
 	Short: "Scan directory for existing Git repositories",
 	Long: `Recursively scan a directory to find existing Git repositories and register them with Clonr for management.
 
 By default, common directories like node_modules, vendor, and build folders are skipped to improve performance.
 
+--exclude takes directory names or glob patterns. A pattern without a slash
+matches directory names anywhere ("tmp-*", ".*"); one with a slash matches
+the path relative to the scanned directory ("archive/*").
+
+Paths and remote URLs on the ignore list ('clonr config ignore') are skipped
+unless --no-ignore is given.
+
+New repositories are added to the workspace given with --workspace. While
+scanning, the number of directories visited is shown on a terminal.
+
+With --watch, clonr keeps watching the directories after the scan and
+registers the repositories cloned or moved into them, until interrupted.
+Several directories can be watched at once; without one, the default clone
+directory is watched.
+
 Examples:
-  clonr map                           # Scan current directory
-  clonr map ~/projects                # Scan specific directory
-  clonr map --dry-run ~/projects      # Preview without adding
-  clonr map --depth 3 ~/projects      # Limit scan depth
-  clonr map --json ~/projects         # Output as JSON
-  clonr map --no-exclude ~/projects   # Don't skip common directories`,
+  clonr map                                # Scan current directory
+  clonr map ~/projects                     # Scan specific directory
+  clonr map --dry-run ~/projects           # Preview without adding
+  clonr map --max-depth 3 ~/projects       # Limit scan depth
+  clonr map --exclude 'tmp-*' ~/projects   # Skip directories by pattern
+  clonr map --workspace work ~/work        # Add new repositories to a workspace
+  clonr map --watch ~/code ~/work          # Register new clones as they appear
+  clonr map --json ~/projects              # Output as JSON
+  clonr map --no-exclude ~/projects        # Don't skip common directories
+  clonr map --no-ignore ~/projects         # Don't apply the ignore list`,
 	RunE: runMap,
 }
 
@@ -26,20 +51,40 @@ func init() {
 	rootCmd.AddCommand(mapCmd)
 
 	mapCmd.Flags().Bool("dry-run", false, "Show what would be added without actually adding")
+	mapCmd.Flags().Int("max-depth", 0, "Maximum directory depth to scan (0 = unlimited)")
 	mapCmd.Flags().Int("depth", 0, "Maximum directory depth to scan (0 = unlimited)")
+	_ = mapCmd.Flags().MarkDeprecated("depth", "use --max-depth instead")
 	mapCmd.Flags().Bool("json", false, "Output results as JSON")
 	mapCmd.Flags().BoolP("verbose", "v", false, "Show verbose output including skipped directories")
 	mapCmd.Flags().Bool("no-exclude", false, "Don't skip common directories (node_modules, vendor, etc.)")
-	mapCmd.Flags().StringSlice("exclude", nil, "Additional directories to exclude")
+	mapCmd.Flags().StringSlice("exclude", nil, "Additional directory names or glob patterns to exclude")
+	mapCmd.Flags().Bool("no-ignore", false, "Don't skip the paths and URLs of the ignore list")
+	mapCmd.Flags().StringP("workspace", "w", "", "Workspace to add new repositories to")
+	mapCmd.Flags().Bool("watch", false, "Keep watching for new repositories after the scan")
 }
 
 func runMap(cmd *cobra.Command, args []string) error {
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
-	maxDepth, _ := cmd.Flags().GetInt("depth")
+	maxDepth, _ := cmd.Flags().GetInt("max-depth")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	verbose, _ := cmd.Flags().GetBool("verbose")
 	noExclude, _ := cmd.Flags().GetBool("no-exclude")
 	extraExclude, _ := cmd.Flags().GetStringSlice("exclude")
+	noIgnore, _ := cmd.Flags().GetBool("no-ignore")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	watch, _ := cmd.Flags().GetBool("watch")
+
+	if watch && jsonOutput {
+		return fmt.Errorf("--watch cannot be used with --json")
+	}
+
+	if !watch && len(args) > 1 {
+		return fmt.Errorf("only one directory can be mapped at a time without --watch")
+	}
+
+	if cmd.Flags().Changed("depth") {
+		maxDepth, _ = cmd.Flags().GetInt("depth")
+	}
 
 	// Build exclude list
 	var excludeDirs []string
@@ -51,12 +96,53 @@ func runMap(cmd *cobra.Command, args []string) error {
 	excludeDirs = append(excludeDirs, extraExclude...)
 
 	opts := core.MapOptions{
-		DryRun:   dryRun,
-		MaxDepth: maxDepth,
-		Exclude:  excludeDirs,
-		JSON:     jsonOutput,
-		Verbose:  verbose,
+		DryRun:    dryRun,
+		MaxDepth:  maxDepth,
+		Exclude:   excludeDirs,
+		JSON:      jsonOutput,
+		Verbose:   verbose,
+		Workspace: workspace,
+	}
+
+	if !noIgnore {
+		rules, err := core.LoadIgnoreRules()
+		if err != nil {
+			return err
+		}
+
+		opts.Ignore = rules
+	}
+
+	if watch {
+		return core.WatchRepos(cmd.Context(), args, opts)
+	}
+
+	if jsonOutput || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return core.MapReposWithOptions(cmd.Context(), args, opts)
+	}
+
+	// Keep the progress line below the repositories logged while scanning
+	out := log.Writer()
+	log.SetOutput(clearLineWriter{out})
+
+	defer log.SetOutput(out)
+
+	opts.OnProgress = func(p core.MapProgress) {
+		_, _ = fmt.Fprintf(os.Stderr, "\r\033[KScanning... %d directories, %d repositories", p.Dirs, p.Repos)
+	}
+
+	return core.MapReposWithOptions(cmd.Context(), args, opts)
+}
+
+// clearLineWriter clears the progress line before each write
+type clearLineWriter struct {
+	w io.Writer
+}
+
+func (c clearLineWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(c.w, "\r\033[K"); err != nil {
+		return 0, err
 	}
 
-	return core.MapReposWithOptions(args, opts)
+	return c.w.Write(p)
 }
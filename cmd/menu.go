@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var menuCmd = &cobra.Command{
+	Use:   "menu",
+	Short: "Pick a command or a pinned repository from a menu",
+	Long: `Show the main menu of clonr. The repositories pinned with 'clonr pin'
+come first and open in the configured editor with the key of their number;
+the other entries run the matching command.`,
+	Args: cobra.NoArgs,
+	RunE: runMenu,
+}
+
+func init() {
+	rootCmd.AddCommand(menuCmd)
+}
+
+func runMenu(cmd *cobra.Command, _ []string) error {
+	// The menu stays usable when the pins cannot be loaded
+	pinned, err := core.ListPinnedRepos()
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s %v\n", warnStyle.Render("Pinned repositories unavailable:"), err)
+	}
+
+	finalModel, err := tea.NewProgram(cli.NewMainMenu(pinned)).Run()
+	if err != nil {
+		return fmt.Errorf("UI error: %w", err)
+	}
+
+	m := finalModel.(cli.MainMenuModel)
+
+	if repo := m.GetPinnedRepo(); repo != nil {
+		return openInEditor(repo)
+	}
+
+	switch choice := m.GetChoice(); choice {
+	case "", "exit":
+		return nil
+	case "update":
+		core.UpdateAllRepos(cmd.Context())
+
+		return nil
+	default:
+		return runMenuCommand(cmd, choice)
+	}
+}
+
+// runMenuCommand runs the command named by a menu entry without arguments,
+// or tells how to run it when it needs some
+func runMenuCommand(cmd *cobra.Command, name string) error {
+	sub, _, err := rootCmd.Find([]string{name})
+	if err != nil || sub == rootCmd {
+		return fmt.Errorf("unknown menu entry %q", name)
+	}
+
+	if sub.ValidateArgs(nil) != nil || (sub.RunE == nil && sub.Run == nil) {
+		_, _ = fmt.Fprintf(os.Stdout, "Run 'clonr %s'\n", sub.Use)
+
+		if sub.HasSubCommands() {
+			_, _ = fmt.Fprintf(os.Stdout, "See 'clonr %s --help' for its commands\n", sub.Name())
+		}
+
+		return nil
+	}
+
+	sub.SetContext(cmd.Context())
+
+	if sub.RunE != nil {
+		return sub.RunE(sub, nil)
+	}
+
+	sub.Run(sub, nil)
+
+	return nil
+}
@@ -6,13 +6,14 @@ import (
 
 // mirrorCmd is a backwards-compatible alias for 'org mirror'
 var mirrorCmd = &cobra.Command{
-	Use:        "mirror <org_name>",
-	Short:      "Mirror all repositories from a GitHub organization (alias for 'org mirror')",
-	Long:       `This is an alias for 'clonr org mirror'. Use 'clonr org mirror --help' for full documentation.`,
-	Args:       cobra.ExactArgs(1),
-	RunE:       runMirror,
-	Deprecated: "use 'clonr org mirror' instead",
-	Hidden:     false, // Still show in help but mark as deprecated
+	Use:         "mirror <org_name>",
+	Short:       "Mirror all repositories from a GitHub organization (alias for 'org mirror')",
+	Long:        `This is an alias for 'clonr org mirror'. Use 'clonr org mirror --help' for full documentation.`,
+	Args:        cobra.ExactArgs(1),
+	Annotations: map[string]string{networkAnnotation: "GitHub API"},
+	RunE:        runMirror,
+	Deprecated:  "use 'clonr org mirror' instead",
+	Hidden:      false, // Still show in help but mark as deprecated
 }
 
 func init() {
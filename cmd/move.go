@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var moveCmd = &cobra.Command{
+	Use:     "move <repo> <new-path>",
+	Aliases: []string{"mv"},
+	Short:   "Move a repository to a new location on disk",
+	Long: `Physically move a tracked repository directory and update its stored path.
+
+The repository can be given as its URL, local path, owner/repo or repository
+name. If the new path is an existing directory, the repository is moved into
+it keeping its directory name. If updating the database fails, the directory
+is moved back to its original location.
+
+Examples:
+  clonr move clonr ~/src/tools/clonr
+  clonr move inovacc/clonr ~/archive/
+  clonr move https://github.com/inovacc/clonr /mnt/work/clonr`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMove,
+}
+
+func init() {
+	rootCmd.AddCommand(moveCmd)
+}
+
+func runMove(_ *cobra.Command, args []string) error {
+	newPath, err := expandPath(args[1])
+	if err != nil {
+		return err
+	}
+
+	result, err := core.MoveRepo(args[0], newPath)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "✓ Moved %s\n", result.URL)
+	_, _ = fmt.Fprintf(os.Stdout, "  %s -> %s\n", result.OldPath, result.NewPath)
+
+	return nil
+}
@@ -3,20 +3,231 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
 	"github.com/spf13/cobra"
 )
 
 var nerdsCmd = &cobra.Command{
 	Use:   "nerds [name]",
 	Short: "Display repository statistics",
-	Long:  `Show detailed statistics and metrics for all repositories or a specific repository.`,
+	Long: `Show detailed statistics and metrics for all repositories or a specific repository.
+
+Available Commands:
+  heatmap       Show a contribution-style heatmap of commit activity
+  contributors  Rank authors by commits and lines changed across repositories`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintln(os.Stdout, "Nerds command - to be implemented")
 		return nil
 	},
 }
 
+var nerdsHeatmapCmd = &cobra.Command{
+	Use:   "heatmap [name]",
+	Short: "Show a contribution-style heatmap of commit activity",
+	Long: `Render the commits of a repository, or of all repositories of a workspace,
+as a contribution-style heatmap: one column per week, one row per weekday,
+darker cells for busier days.
+
+Commits reachable from HEAD are counted by author date.
+
+Examples:
+  clonr nerds heatmap                       # All repositories, last year
+  clonr nerds heatmap cli                   # One repository
+  clonr nerds heatmap -w work --since 12w   # A workspace, last 12 weeks
+  clonr nerds heatmap --author alice@example.com`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runNerdsHeatmap,
+}
+
+var nerdsContributorsCmd = &cobra.Command{
+	Use:   "contributors [name]",
+	Short: "Rank authors by commits and lines changed across repositories",
+	Long: `Aggregate the commit count and lines changed of each author across all
+repositories, the repositories of a workspace, or a single repository.
+
+Non-merge commits reachable from HEAD are counted. Authors are identified by
+email after .mailmap is applied; an author counts once per repository.
+
+Examples:
+  clonr nerds contributors --workspace work
+  clonr nerds contributors -w work --since 90d --limit 10
+  clonr nerds contributors -w work --format csv > contributors.csv
+  clonr nerds contributors cli --format json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runNerdsContributors,
+}
+
 func init() {
 	rootCmd.AddCommand(nerdsCmd)
+	nerdsCmd.AddCommand(nerdsHeatmapCmd)
+	nerdsCmd.AddCommand(nerdsContributorsCmd)
+
+	nerdsHeatmapCmd.Flags().StringP("workspace", "w", "", "Aggregate the repositories of this workspace")
+	nerdsHeatmapCmd.Flags().String("since", "52w", "Show activity since duration (e.g., 12w, 90d)")
+	nerdsHeatmapCmd.Flags().String("author", "", "Only commits whose author name or email matches")
+	nerdsHeatmapCmd.Flags().Bool("no-color", false, "Draw with ASCII characters instead of colors")
+	nerdsHeatmapCmd.Flags().Bool("json", false, "Output the commits per day as JSON")
+
+	nerdsContributorsCmd.Flags().StringP("workspace", "w", "", "Aggregate the repositories of this workspace")
+	nerdsContributorsCmd.Flags().String("since", "", "Only commits since duration (e.g., 12w, 90d; default: all history)")
+	nerdsContributorsCmd.Flags().Int("limit", 0, "Show the top contributors only")
+	nerdsContributorsCmd.Flags().String("format", "", "Output format: table, json, csv, md")
+}
+
+// nerdsRepos returns the repository named by args, or the repositories of
+// workspace (all repositories when empty)
+func nerdsRepos(args []string, workspace string) ([]model.Repository, error) {
+	if len(args) > 0 {
+		repo, err := core.ResolveRepo(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return []model.Repository{*repo}, nil
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	repos, err := client.GetRepos(workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	return repos, nil
+}
+
+func runNerdsHeatmap(cmd *cobra.Command, args []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	since, _ := cmd.Flags().GetString("since")
+	author, _ := cmd.Flags().GetString("author")
+	noColor, _ := cmd.Flags().GetBool("no-color")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	duration, err := slackParseDuration(since)
+	if err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+
+	repos, err := nerdsRepos(args, workspace)
+	if err != nil {
+		return err
+	}
+
+	heatmap := core.BuildCommitHeatmap(repos, core.CommitHeatmapOptions{
+		Since:  time.Now().Add(-duration),
+		Author: author,
+	})
+
+	if jsonOutput {
+		return outputJSON(heatmap)
+	}
+
+	glyphs := [5]string{"·", "░", "▒", "▓", "█"}
+	if !noColor {
+		for level, color := range []string{"238", "22", "28", "34", "46"} {
+			glyphs[level] = lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render("■")
+		}
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, core.RenderCommitHeatmap(heatmap, glyphs))
+
+	summary := fmt.Sprintf("\n%d commits in %d repositories since %s", heatmap.Total, heatmap.Repos, heatmap.Since.Format("2006-01-02"))
+	if author != "" {
+		summary += fmt.Sprintf(" by %s", author)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, summary)
+	_, _ = fmt.Fprintf(os.Stdout, "Less %s More\n", glyphs[0]+" "+glyphs[1]+" "+glyphs[2]+" "+glyphs[3]+" "+glyphs[4])
+
+	if len(heatmap.Skipped) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Skipped %d repositories whose history could not be read", len(heatmap.Skipped))))
+	}
+
+	return nil
+}
+
+func runNerdsContributors(cmd *cobra.Command, args []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	since, _ := cmd.Flags().GetString("since")
+	limit, _ := cmd.Flags().GetInt("limit")
+	formatFlag, _ := cmd.Flags().GetString("format")
+
+	format, err := parseOutputFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	var opts core.ContributorLeaderboardOptions
+
+	opts.Limit = limit
+
+	if since != "" {
+		duration, err := slackParseDuration(since)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %w", err)
+		}
+
+		opts.Since = time.Now().Add(-duration)
+	}
+
+	repos, err := nerdsRepos(args, workspace)
+	if err != nil {
+		return err
+	}
+
+	board := core.BuildContributorLeaderboard(repos, opts)
+
+	if format == formatJSON {
+		return outputJSON(board)
+	}
+
+	headers := []string{"RANK", "AUTHOR", "EMAIL", "COMMITS", "ADDED", "DELETED", "CHANGED", "REPOS", "LAST COMMIT"}
+	rows := make([][]string, len(board.Contributors))
+
+	for i, c := range board.Contributors {
+		rows[i] = []string{
+			strconv.Itoa(i + 1), c.Name, c.Email, strconv.Itoa(c.Commits),
+			strconv.Itoa(c.LinesAdded), strconv.Itoa(c.LinesDeleted), strconv.Itoa(c.LinesChanged()),
+			strconv.Itoa(c.Repos), c.LastCommit.Format("2006-01-02"),
+		}
+	}
+
+	if format == formatCSV || format == formatMarkdown {
+		return writeExport(os.Stdout, format, headers, rows)
+	}
+
+	if len(rows) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No commits found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("\n%d contributors across %d repositories", len(rows), board.Repos)))
+
+	if len(board.Skipped) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Skipped %d repositories whose history could not be read", len(board.Skipped))))
+	}
+
+	return nil
 }
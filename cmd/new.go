@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var newCmd = &cobra.Command{
+	Use:   "new <template> <name>",
+	Short: "Create a repository from a template repository",
+	Long: `Create a new repository from a tracked template repository.
+
+The template is cloned from its local copy without its history, and these
+substitutions are made in file contents and file names:
+
+  {{project_name}}   the name of the new repository
+  {{module_path}}    the Go module path of the new repository
+  <template module>  the module path in the template's go.mod, if any
+
+Binary files are left untouched. The result is committed to a new git
+repository whose origin is <host>/<owner>/<name> on the template's host,
+and registered with clonr. Nothing is pushed; create the remote repository
+and run 'git push -u origin HEAD' when ready.
+
+Templates are repositories classified as templates (see 'clonr repo
+classify'); use --any-repo to start from any tracked repository.
+
+Examples:
+  clonr new acme/go-service billing
+  clonr new go-service billing --owner me --module example.com/me/billing
+  clonr new go-service billing --workspace work
+  clonr new go-service billing --dir ~/src/billing`,
+	Args: cobra.ExactArgs(2),
+	RunE: runNew,
+}
+
+func init() {
+	rootCmd.AddCommand(newCmd)
+	newCmd.Flags().String("owner", "", "Owner of the new repository (default: the template's)")
+	newCmd.Flags().String("module", "", "Go module path (default: <host>/<owner>/<name>)")
+	newCmd.Flags().StringP("workspace", "w", "", "Workspace to create the repository in (default: active workspace)")
+	newCmd.Flags().String("dir", "", "Target directory (default: <workspace>/<name>)")
+	newCmd.Flags().Bool("any-repo", false, "Use a repository not classified as a template")
+	newCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runNew(cmd *cobra.Command, args []string) error {
+	var opts core.NewFromTemplateOptions
+
+	opts.Owner, _ = cmd.Flags().GetString("owner")
+	opts.Module, _ = cmd.Flags().GetString("module")
+	opts.Workspace, _ = cmd.Flags().GetString("workspace")
+	opts.Dir, _ = cmd.Flags().GetString("dir")
+	opts.AnyRepo, _ = cmd.Flags().GetBool("any-repo")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	result, err := core.NewFromTemplate(args[0], args[1], opts)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		return outputJSON(result)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Created %s from %s", result.URL, result.Template)))
+	_, _ = fmt.Fprintf(os.Stdout, "  Path:      %s\n", result.Path)
+	_, _ = fmt.Fprintf(os.Stdout, "  Module:    %s\n", result.Module)
+
+	if result.Workspace != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "  Workspace: %s\n", result.Workspace)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "  Files substituted: %d\n", result.Files)
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Create the remote repository, then push with: git push -u origin HEAD"))
+
+	return nil
+}
@@ -0,0 +1,481 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+// notifyWebhookDefaultID is the channel ID of a webhook added without --name
+const notifyWebhookDefaultID = "webhook"
+
+var notifyCmd = &cobra.Command{
+	Use:   "notify",
+	Short: "Route repository events to notification channels",
+	Long: `Clone completions, available updates, sync failures and monitor errors are
+sent to every enabled notification channel: the Slack webhook of
+'clonr slack notify' (channel ID slack-notify) and the enabled channels of
+the active profile.
+
+Routes limit the channels an event type goes to. A route names channel IDs
+or channel types (slack, gmail, discord, webhook); a route for '*' applies
+to event types without a route of their own, and a route without channels
+mutes the event.
+
+Event types: clone, pull, push, commit, update-available, sync-fail,
+monitor-error, ci-pass, ci-fail, release, credential-expiry, fleet-report,
+disk-budget, client-registration
+
+Examples:
+  clonr notify channels
+  clonr notify route set sync-fail slack gmail
+  clonr notify route set '*' slack-notify
+  clonr notify route set clone --mute
+  clonr notify route rm clone
+  clonr notify webhook add --url https://automation.example.com/hooks/clonr
+  clonr notify test`,
+}
+
+var notifyChannelsCmd = &cobra.Command{
+	Use:   "channels",
+	Short: "List the channels notifications are sent to",
+	Args:  cobra.NoArgs,
+	RunE:  runNotifyChannels,
+}
+
+var notifyTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a test notification to every channel",
+	Args:  cobra.NoArgs,
+	RunE:  runNotifyTest,
+}
+
+var notifyRouteCmd = &cobra.Command{
+	Use:   "route",
+	Short: "Manage per-event notification routes",
+}
+
+var notifyRouteListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List notification routes",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE:    runNotifyRouteList,
+}
+
+var notifyRouteSetCmd = &cobra.Command{
+	Use:   "set <event> [channel...]",
+	Short: "Send an event type to the given channels only",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runNotifyRouteSet,
+}
+
+var notifyRouteRemoveCmd = &cobra.Command{
+	Use:     "rm <event>",
+	Short:   "Send an event type to every channel again",
+	Aliases: []string{"remove"},
+	Args:    cobra.ExactArgs(1),
+	RunE:    runNotifyRouteRemove,
+}
+
+var notifyWebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Send notifications to any URL as JSON",
+	Long: `Post notifications as JSON to any URL, to integrate clonr with
+self-hosted automation.
+
+Every request carries the event type in the X-Clonr-Event header. With a
+secret, requests also carry X-Clonr-Timestamp (Unix seconds) and
+X-Clonr-Signature-256: "sha256=" and the hex HMAC-SHA256 of the timestamp, a
+dot and the body, keyed with the secret. Receivers should recompute it and
+reject old timestamps.
+
+Examples:
+  clonr notify webhook add --url https://automation.example.com/hooks/clonr --generate-secret
+  clonr notify webhook add --url https://ci.example.com/hook --name ci --header "Authorization: Bearer xyz" --events ci-fail
+  clonr notify webhook test --name ci
+  clonr notify webhook remove --name ci`,
+}
+
+var notifyWebhookAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a webhook notification channel",
+	Long: `Add a webhook notification channel to the active profile. The URL must
+answer a test notification with a 2xx status.
+
+The channel ID is "webhook" unless set with --name.`,
+	Args: cobra.NoArgs,
+	RunE: runNotifyWebhookAdd,
+}
+
+var notifyWebhookTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a test notification to a webhook",
+	Args:  cobra.NoArgs,
+	RunE:  runNotifyWebhookTest,
+}
+
+var notifyWebhookRemoveCmd = &cobra.Command{
+	Use:     "remove",
+	Aliases: []string{"rm"},
+	Short:   "Remove a webhook notification channel",
+	Args:    cobra.NoArgs,
+	RunE:    runNotifyWebhookRemove,
+}
+
+func init() {
+	rootCmd.AddCommand(notifyCmd)
+	notifyCmd.AddCommand(notifyChannelsCmd)
+	notifyCmd.AddCommand(notifyTestCmd)
+	notifyCmd.AddCommand(notifyRouteCmd)
+	notifyRouteCmd.AddCommand(notifyRouteListCmd)
+	notifyRouteCmd.AddCommand(notifyRouteSetCmd)
+	notifyRouteCmd.AddCommand(notifyRouteRemoveCmd)
+	notifyCmd.AddCommand(notifyWebhookCmd)
+	notifyWebhookCmd.AddCommand(notifyWebhookAddCmd)
+	notifyWebhookCmd.AddCommand(notifyWebhookTestCmd)
+	notifyWebhookCmd.AddCommand(notifyWebhookRemoveCmd)
+
+	notifyChannelsCmd.Flags().Bool("json", false, "Output as JSON")
+	notifyRouteListCmd.Flags().Bool("json", false, "Output as JSON")
+	notifyRouteSetCmd.Flags().Bool("mute", false, "Send the event to no channel")
+
+	notifyWebhookAddCmd.Flags().String("url", "", "URL to send notifications to (required)")
+	notifyWebhookAddCmd.Flags().String("method", http.MethodPost, "HTTP method")
+	notifyWebhookAddCmd.Flags().StringArray("header", nil, "Extra request header as 'Name: value' (repeatable)")
+	notifyWebhookAddCmd.Flags().String("secret", "", "Secret to sign requests with")
+	notifyWebhookAddCmd.Flags().Bool("generate-secret", false, "Sign requests with a new random secret")
+	notifyWebhookAddCmd.Flags().StringSlice("events", nil, "Event types to send (default all)")
+	notifyWebhookAddCmd.MarkFlagsMutuallyExclusive("secret", "generate-secret")
+
+	for _, cmd := range []*cobra.Command{notifyWebhookAddCmd, notifyWebhookTestCmd, notifyWebhookRemoveCmd} {
+		cmd.Flags().String("name", notifyWebhookDefaultID, "Channel ID of the webhook")
+	}
+
+	notifyWebhookRemoveCmd.Flags().BoolP("force", "f", false, "Skip confirmation")
+}
+
+func runNotifyChannels(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	router, err := core.NewNotifier()
+	if err != nil {
+		return err
+	}
+
+	type channelInfo struct {
+		ID     string   `json:"id"`
+		Type   string   `json:"type"`
+		Events []string `json:"events,omitempty"`
+	}
+
+	channels := make([]channelInfo, 0, len(router.Channels()))
+	for _, ch := range router.Channels() {
+		channels = append(channels, channelInfo{ID: ch.ID, Type: ch.Type, Events: ch.Events})
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(channels)
+	}
+
+	if len(channels) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No notification channels are enabled.")
+		_, _ = fmt.Fprintln(os.Stdout, "Add one with: clonr slack notify add, clonr discord notify add or clonr gmail add")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tTYPE\tEVENTS")
+
+	for _, ch := range channels {
+		events := "all"
+		if len(ch.Events) > 0 {
+			events = strings.Join(ch.Events, ", ")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", ch.ID, ch.Type, events)
+	}
+
+	return w.Flush()
+}
+
+func runNotifyTest(_ *cobra.Command, _ []string) error {
+	router, err := core.NewNotifier()
+	if err != nil {
+		return err
+	}
+
+	if len(router.Channels()) == 0 {
+		return fmt.Errorf("no notification channels are enabled")
+	}
+
+	if err := router.Test(context.Background()); err != nil {
+		return fmt.Errorf("test notification failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s\n", okStyle.Render(fmt.Sprintf("Test notification sent to %d channel(s)", len(router.Channels()))))
+
+	return nil
+}
+
+func runNotifyRouteList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	routes, err := core.ListNotifyRoutes()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(routes)
+	}
+
+	if len(routes) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No notification routes; events go to every channel.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "EVENT\tCHANNELS")
+
+	for _, r := range routes {
+		channels := strings.Join(r.Channels, ", ")
+		if channels == "" {
+			channels = dimStyle.Render("muted")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", r.Event, channels)
+	}
+
+	return w.Flush()
+}
+
+func runNotifyRouteSet(cmd *cobra.Command, args []string) error {
+	mute, _ := cmd.Flags().GetBool("mute")
+
+	event, channels := args[0], args[1:]
+
+	switch {
+	case mute && len(channels) > 0:
+		return fmt.Errorf("--mute cannot be combined with channels")
+	case !mute && len(channels) == 0:
+		return fmt.Errorf("name at least one channel, or use --mute to send %s to none", event)
+	}
+
+	if err := core.SetNotifyRoute(event, channels); err != nil {
+		return err
+	}
+
+	target := strings.Join(channels, ", ")
+	if mute {
+		target = "no channel"
+	}
+
+	label := event + " events"
+	if event == notify.RouteAll {
+		label = "Events without a route"
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render(label+" now go to"), target)
+
+	return nil
+}
+
+func runNotifyRouteRemove(_ *cobra.Command, args []string) error {
+	removed, err := core.RemoveNotifyRoute(args[0])
+	if err != nil {
+		return err
+	}
+
+	if !removed {
+		_, _ = fmt.Fprintf(os.Stdout, "No route for %s\n", args[0])
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Removed the route for"), args[0])
+
+	return nil
+}
+
+func runNotifyWebhookAdd(cmd *cobra.Command, _ []string) error {
+	webhookURL, _ := cmd.Flags().GetString("url")
+	method, _ := cmd.Flags().GetString("method")
+	headerLines, _ := cmd.Flags().GetStringArray("header")
+	secret, _ := cmd.Flags().GetString("secret")
+	generate, _ := cmd.Flags().GetBool("generate-secret")
+	events, _ := cmd.Flags().GetStringSlice("events")
+	name, _ := cmd.Flags().GetString("name")
+
+	if err := notify.ValidateNotifyWebhookURL(webhookURL); err != nil {
+		return err
+	}
+
+	headers, err := notify.ParseWebhookHeaders(headerLines)
+	if err != nil {
+		return err
+	}
+
+	if generate {
+		secret = rand.Text()
+	}
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	method = strings.ToUpper(method)
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Sending test notification..."))
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	if err := notify.NewWebhookSender(name, webhookURL, method, headers, secret).Test(ctx); err != nil {
+		return fmt.Errorf("webhook test failed: %w", err)
+	}
+
+	channel := &model.NotifyChannel{
+		ID:   name,
+		Type: model.ChannelWebhook,
+		Name: "Webhook - " + name,
+		Config: map[string]string{
+			"webhook_url": webhookURL,
+			"method":      method,
+			"headers":     strings.Join(headerLines, "\n"),
+			"hmac_secret": secret,
+		},
+		Enabled:   true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	for _, event := range events {
+		channel.Events = append(channel.Events, model.EventConfig{Event: strings.TrimSpace(event)})
+	}
+
+	// Save to profile (AddNotifyChannel encrypts the URL, headers and secret)
+	if err := pm.AddNotifyChannel(profile.Name, channel); err != nil {
+		return fmt.Errorf("failed to save webhook: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Webhook %q added to profile %q!", name, profile.Name)))
+
+	if generate {
+		_, _ = fmt.Fprintf(os.Stdout, "Signing secret: %s\n", secret)
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Store it with the receiver; it is not shown again"))
+	}
+
+	return nil
+}
+
+func runNotifyWebhookTest(cmd *cobra.Command, _ []string) error {
+	name, _ := cmd.Flags().GetString("name")
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	channel, err := notifyWebhookChannel(pm, profile.Name, name)
+	if err != nil {
+		return err
+	}
+
+	config, err := pm.DecryptChannelConfig(profile.Name, channel)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt webhook config: %w", err)
+	}
+
+	headers, err := notify.ParseWebhookHeaders(strings.Split(config["headers"], "\n"))
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Sending test notification..."))
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+	defer cancel()
+
+	sender := notify.NewWebhookSender(name, config["webhook_url"], config["method"], headers, config["hmac_secret"])
+	if err := sender.Test(ctx); err != nil {
+		return fmt.Errorf("test failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Test notification sent successfully!"))
+
+	return nil
+}
+
+func runNotifyWebhookRemove(cmd *cobra.Command, _ []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	force, _ := cmd.Flags().GetBool("force")
+
+	pm, profile, err := notifyProfile()
+	if err != nil {
+		return err
+	}
+
+	if _, err := notifyWebhookChannel(pm, profile.Name, name); err != nil {
+		return err
+	}
+
+	if !force {
+		if !promptConfirm(fmt.Sprintf("Remove webhook %q? [y/N]: ", name)) {
+			_, _ = fmt.Fprintln(os.Stdout, "Cancelled.")
+			return nil
+		}
+	}
+
+	if err := pm.RemoveNotifyChannel(profile.Name, name); err != nil {
+		return fmt.Errorf("failed to remove webhook: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Webhook %q removed.", name)))
+
+	return nil
+}
+
+// notifyWebhookChannel returns the webhook channel with the given ID
+func notifyWebhookChannel(pm *core.ProfileManager, profileName, name string) (*model.NotifyChannel, error) {
+	channel, err := pm.GetNotifyChannel(profileName, name)
+	if err != nil || channel.Type != model.ChannelWebhook {
+		return nil, fmt.Errorf("no webhook %q configured; add with: clonr notify webhook add --url <url>", name)
+	}
+
+	return channel, nil
+}
+
+// notifyProfile returns the active profile, which notification channels
+// are stored in
+func notifyProfile() (*core.ProfileManager, *model.Profile, error) {
+	pm, err := core.NewProfileManager()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	profile, err := pm.GetActiveProfile()
+	if err != nil {
+		return nil, nil, fmt.Errorf("no active profile")
+	}
+
+	return pm, profile, nil
+}
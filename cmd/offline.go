@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var offlineCmd = &cobra.Command{
+	Use:   "offline",
+	Short: "Turn air-gapped mode on or off",
+	Long: `Air-gapped mode disables every network integration of clonr for
+regulated environments: the GitHub API ('gh', 'org', profile login), Slack,
+Gmail, Outlook, Teams, Jira, ZenHub and Linear commands are refused, outgoing
+notifications are dropped and the web server rejects its integration
+endpoints. Local git and database features keep working, and so do git
+operations against remotes you configured.
+
+The mode is enabled for every clonr process using this data directory by
+'clonr offline enable', or for a single run by --offline or CLONR_OFFLINE=1.
+
+Examples:
+  clonr offline enable
+  clonr offline status
+  clonr offline disable
+  clonr gh issues list --offline   # Refused`,
+	Args: cobra.NoArgs,
+	RunE: runOfflineStatus,
+}
+
+var offlineEnableCmd = &cobra.Command{
+	Use:   "enable",
+	Short: "Disable network integrations until 'clonr offline disable'",
+	Args:  cobra.NoArgs,
+	RunE:  func(_ *cobra.Command, _ []string) error { return setOffline(true) },
+}
+
+var offlineDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Allow network integrations again",
+	Args:  cobra.NoArgs,
+	RunE:  func(_ *cobra.Command, _ []string) error { return setOffline(false) },
+}
+
+var offlineStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show whether air-gapped mode is enabled",
+	Args:  cobra.NoArgs,
+	RunE:  runOfflineStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(offlineCmd)
+	offlineCmd.AddCommand(offlineEnableCmd)
+	offlineCmd.AddCommand(offlineDisableCmd)
+	offlineCmd.AddCommand(offlineStatusCmd)
+
+	offlineCmd.Flags().Bool("json", false, "Output as JSON")
+	offlineStatusCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+// OfflineStatus is the JSON output of 'clonr offline status'
+type OfflineStatus struct {
+	Enabled bool   `json:"enabled"`
+	Source  string `json:"source,omitempty"`
+}
+
+func setOffline(enabled bool) error {
+	if err := core.SetOffline(enabled); err != nil {
+		return err
+	}
+
+	if enabled {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Air-gapped mode enabled: network integrations are disabled"))
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Air-gapped mode disabled"))
+
+	if source := core.OfflineSource(); source != "" {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("It is still enabled by %s", offlineSourceName(source))))
+	}
+
+	return nil
+}
+
+func runOfflineStatus(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	status := OfflineStatus{Source: core.OfflineSource()}
+	status.Enabled = status.Source != ""
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(status)
+	}
+
+	if !status.Enabled {
+		_, _ = fmt.Fprintln(os.Stdout, "Air-gapped mode is off")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s (%s)\n", warnStyle.Render("Air-gapped mode is on"), offlineSourceName(status.Source))
+
+	return nil
+}
+
+// checkNetworkAllowed refuses cmd when it, or a command it belongs to, uses
+// a network integration while air-gapped mode is enabled
+func checkNetworkAllowed(cmd *cobra.Command) error {
+	for c := cmd; c != nil; c = c.Parent() {
+		integration, ok := c.Annotations[networkAnnotation]
+		if !ok {
+			continue
+		}
+
+		source := core.OfflineSource()
+		if source == "" {
+			return nil
+		}
+
+		return fmt.Errorf("%w\n'%s' uses %s; air-gapped mode is enabled by %s",
+			core.ErrOffline, cmd.CommandPath(), integration, offlineSourceName(source))
+	}
+
+	return nil
+}
+
+// offlineSourceName describes what enabled air-gapped mode
+func offlineSourceName(source string) string {
+	switch source {
+	case core.OfflineSourceFlag:
+		return "--offline"
+	case core.OfflineSourceEnv:
+		return core.OfflineEnv
+	default:
+		return "'clonr offline enable' (turn off with 'clonr offline disable')"
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+func TestCheckNetworkAllowed(t *testing.T) {
+	parent := &cobra.Command{Use: "gh", Annotations: map[string]string{networkAnnotation: "GitHub API"}}
+	child := &cobra.Command{Use: "issues"}
+	local := &cobra.Command{Use: "status"}
+
+	parent.AddCommand(child)
+
+	t.Setenv(core.OfflineEnv, "")
+
+	if err := checkNetworkAllowed(child); err != nil && core.OfflineSource() != core.OfflineSourceSetting {
+		t.Errorf("checkNetworkAllowed() error = %v while online", err)
+	}
+
+	t.Setenv(core.OfflineEnv, "true")
+
+	if err := checkNetworkAllowed(child); !errors.Is(err, core.ErrOffline) {
+		t.Errorf("checkNetworkAllowed(gh issues) error = %v, want %v", err, core.ErrOffline)
+	}
+
+	if err := checkNetworkAllowed(local); err != nil {
+		t.Errorf("checkNetworkAllowed(status) error = %v for a local command", err)
+	}
+}
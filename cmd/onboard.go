@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/params"
+	"github.com/inovacc/clonr/internal/server/grpc"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// onboardServicePort is the port of the server installed as a service
+// during onboarding, the default of 'clonr server install-service'
+const onboardServicePort = 50051
+
+var onboardCmd = &cobra.Command{
+	Use:   "onboard",
+	Short: "Set up clonr step by step",
+	Long: `Walk through the first setup of clonr:
+
+  1. Pick the directory new clones go to
+  2. Pick the editor, detected from $VISUAL, $EDITOR and installed editors
+  3. Optionally install the server as a service started at login
+  4. Optionally map the repositories already cloned in common code
+     directories (~/code, ~/src, ~/projects, ...)
+  5. Optionally mirror a GitHub organization (not offered when offline)
+
+Running 'clonr' without a command starts this automatically when nothing
+is configured and no repository is tracked yet.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runOnboarding(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(onboardCmd)
+}
+
+// runRoot starts the onboarding on the first run and shows the help
+// otherwise
+func runRoot(cmd *cobra.Command, _ []string) error {
+	if !isFirstRun() {
+		return cmd.Help()
+	}
+
+	return runOnboarding(cmd)
+}
+
+// isFirstRun reports whether clonr runs interactively for the first time.
+// A stopped server is not started to find out once a local database
+// exists, so 'clonr' alone keeps showing the help without prompting.
+func isFirstRun() bool {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false
+	}
+
+	if grpc.IsServerRunning() == nil {
+		if _, err := os.Stat(filepath.Join(params.AppdataDir, "clonr.db")); err == nil {
+			return false
+		}
+	}
+
+	needed, err := core.NeedsOnboarding()
+
+	return err == nil && needed
+}
+
+// runOnboarding runs the onboarding TUI and then carries out the choices,
+// reporting each step
+func runOnboarding(cmd *cobra.Command) error {
+	m := cli.NewOnboardingModel(core.FindCodeDirs(), checkNetworkAllowed(orgMirrorCmd) == nil)
+
+	finalModel, err := tea.NewProgram(m).Run()
+	if err != nil {
+		return fmt.Errorf("UI error: %w", err)
+	}
+
+	plan := finalModel.(*cli.OnboardingModel).Plan()
+	if plan == nil {
+		_, _ = fmt.Fprintln(os.Stdout, "Setup cancelled; run 'clonr onboard' to start again.")
+		return nil
+	}
+
+	if err := core.SaveOnboardingConfig(plan.CloneDir, plan.Editor); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("✓ Configuration saved"))
+
+	// The remaining steps are optional; a failure is reported and the
+	// setup goes on
+	var errs []error
+
+	for _, dir := range plan.MapDirs {
+		_, _ = fmt.Fprintf(os.Stdout, "\nMapping %s...\n", dir)
+
+		if err := core.MapReposWithOptions(cmd.Context(), []string{dir}, core.MapOptions{Exclude: core.DefaultExcludeDirs}); err != nil {
+			errs = append(errs, fmt.Errorf("map %s: %w", dir, err))
+		}
+	}
+
+	if plan.Org != "" {
+		_, _ = fmt.Fprintln(os.Stdout)
+
+		orgMirrorCmd.SetContext(cmd.Context())
+
+		if err := runMirror(orgMirrorCmd, []string{plan.Org}); err != nil {
+			errs = append(errs, fmt.Errorf("mirror %s: %w", plan.Org, err))
+		}
+	}
+
+	if plan.InstallService {
+		_, _ = fmt.Fprintln(os.Stdout)
+
+		if err := installOnboardingService(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("setup finished with errors:\n%w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("\n✓ Clonr is ready. Try 'clonr list' or 'clonr clone <url>'."))
+
+	return nil
+}
+
+// installOnboardingService hands the server over to the service manager:
+// the background server used during setup is stopped so the service can
+// own the database. It is installed for the current user where supported.
+func installOnboardingService() error {
+	s, err := newServerService(onboardServicePort, runtime.GOOS != "windows")
+	if err != nil {
+		return err
+	}
+
+	if err := installService(s); err != nil {
+		if runtime.GOOS == "windows" {
+			return fmt.Errorf("%w\n\nRun 'clonr server install-service --now' from an elevated shell", err)
+		}
+
+		return err
+	}
+
+	if info := grpc.IsServerRunning(); info != nil {
+		if err := stopServer(info, 30*time.Second); err != nil {
+			return err
+		}
+	}
+
+	return startService(s)
+}
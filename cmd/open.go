@@ -7,47 +7,68 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/inovacc/clonr/internal/cli"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
 )
 
 var openCmd = &cobra.Command{
-	Use:   "open",
+	Use:   "open [repository]",
 	Short: "Open a repository in your configured editor",
-	Long:  `Interactively select a repository to open in your configured editor. The editor can be configured using the 'clonr configure' command.`,
+	Long: `Open a repository in your configured editor. The repository can be given by
+URL, path, owner/name, name or alias; without one it is selected
+interactively. The editor can be configured using the 'clonr configure' command.`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		m, err := cli.NewRepoList(false)
-		if err != nil {
+		selected, err := selectOpenRepo(args)
+		if err != nil || selected == nil {
 			return err
 		}
-		p := tea.NewProgram(m)
-		finalModel, err := p.Run()
-		if err != nil {
-			return err
-		}
-		repoModel := finalModel.(cli.RepoListModel)
-		selected := repoModel.GetSelectedRepo()
-		if selected == nil {
-			return nil
-		}
-		db := store.GetDB()
-		cfg, err := db.GetConfig()
-		if err != nil {
-			return fmt.Errorf("failed to get config: %w", err)
-		}
-		if cfg.Editor == "" {
-			return fmt.Errorf("no editor configured. Run 'clonr configure' to set an editor")
-		}
-		_, _ = fmt.Fprintf(os.Stdout, "Opening %s in %s...\n", selected.Path, cfg.Editor)
-		execCmd := exec.Command(cfg.Editor, selected.Path)
-		if err := execCmd.Start(); err != nil {
-			return fmt.Errorf("failed to open editor: %w", err)
-		}
-		_, _ = fmt.Fprintf(os.Stdout, "✓ Opened %s\n", selected.URL)
-		return nil
+		return openInEditor(selected)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(openCmd)
 }
+
+// openInEditor opens repo in the configured editor and records the access
+func openInEditor(repo *model.Repository) error {
+	db := store.GetDB()
+	cfg, err := db.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+	if cfg.Editor == "" {
+		return fmt.Errorf("no editor configured. Run 'clonr configure' to set an editor")
+	}
+	_, _ = fmt.Fprintf(os.Stdout, "Opening %s in %s...\n", repo.Path, cfg.Editor)
+	execCmd := exec.Command(cfg.Editor, repo.Path)
+	if err := execCmd.Start(); err != nil {
+		return fmt.Errorf("failed to open editor: %w", err)
+	}
+	core.RecordRepoAccess(repo.URL)
+	_, _ = fmt.Fprintf(os.Stdout, "✓ Opened %s\n", repo.URL)
+	return nil
+}
+
+// selectOpenRepo resolves the repository given to open, or lets the user
+// pick one; nil means the selection was cancelled
+func selectOpenRepo(args []string) (*model.Repository, error) {
+	if len(args) > 0 {
+		return core.ResolveRepo(args[0])
+	}
+
+	m, err := cli.NewRepoList(false)
+	if err != nil {
+		return nil, err
+	}
+
+	finalModel, err := tea.NewProgram(m).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return finalModel.(cli.RepoListModel).GetSelectedRepo(), nil
+}
@@ -12,6 +12,7 @@ var orgCmd = &cobra.Command{
 Available Commands:
   list    List your GitHub organizations
   mirror  Mirror all repositories from an organization`,
+	Annotations: map[string]string{networkAnnotation: "GitHub API"},
 }
 
 func init() {
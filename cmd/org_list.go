@@ -38,13 +38,22 @@ Examples:
   clonr org list --include-user
 
   # JSON output for scripting
-  clonr org list --json`,
+  clonr org list --json
+
+  # Skip the response cache, or keep responses for an hour
+  clonr org list --no-cache
+  clonr org list --cache-ttl 1h`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Get flags
 		token, _ := cmd.Flags().GetString("token")
 		includeUser, _ := cmd.Flags().GetBool("include-user")
 		jsonOutput, _ := cmd.Flags().GetBool("json")
 
+		cache, err := cacheOptions(cmd)
+		if err != nil {
+			return err
+		}
+
 		// Resolve token
 		token, tokenSource, err := core.ResolveGitHubToken(token, "")
 		if err != nil {
@@ -60,6 +69,7 @@ Examples:
 
 		opts := core.ListOrganizationsOptions{
 			IncludeUser: includeUser,
+			Cache:       cache,
 		}
 
 		orgs, err := core.ListOrganizations(token, opts)
@@ -194,4 +204,5 @@ func init() {
 	orgListCmd.Flags().String("token", "", "GitHub personal access token")
 	orgListCmd.Flags().Bool("include-user", false, "Include personal repositories as pseudo-organization")
 	orgListCmd.Flags().Bool("json", false, "Output in JSON format")
+	addCacheFlags(orgListCmd)
 }
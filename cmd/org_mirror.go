@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"regexp"
+	"slices"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -153,7 +156,7 @@ func runMirror(cmd *cobra.Command, args []string) error {
 	// Call core logic to prepare mirror operation
 	_, _ = fmt.Fprintf(os.Stdout, "Fetching repositories from organization '%s'...\n", orgName)
 
-	mirrorPlan, err := core.PrepareMirror(orgName, token, opts)
+	mirrorPlan, err := core.PrepareMirror(cmd.Context(), orgName, token, opts)
 	if err != nil {
 		return fmt.Errorf("failed to prepare mirror: %w", err)
 	}
@@ -166,6 +169,29 @@ func runMirror(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Check for --no-tui flag
+	noTUI, _ := cmd.Flags().GetBool("no-tui")
+
+	// Offer to skip the repositories an interrupted TUI run already mirrored
+	draftName := core.OrgMirrorDraft(orgName)
+
+	var draft mirrorDraft
+
+	if !dryRun && !noTUI && offerResume(draftName, "mirror of "+orgName, &draft) {
+		mirrorPlan.Repos = slices.DeleteFunc(mirrorPlan.Repos, func(r core.MirrorRepo) bool {
+			return slices.Contains(draft.Done, r.Name)
+		})
+
+		if len(mirrorPlan.Repos) == 0 {
+			_ = core.DeleteWizardDraft(draftName)
+			_, _ = fmt.Fprintln(os.Stdout, "\nNothing left to mirror.")
+
+			return nil
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "Resuming: %d already mirrored, %d left\n", len(draft.Done), len(mirrorPlan.Repos))
+	}
+
 	if dryRun {
 		// Print what would be done and exit
 		core.PrintDryRunPlan(mirrorPlan)
@@ -177,20 +203,23 @@ func runMirror(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	// Check for --no-tui flag
-	noTUI, _ := cmd.Flags().GetBool("no-tui")
-
 	if noTUI {
 		// Batch mode (no TUI)
 		_, _ = fmt.Fprintf(os.Stdout, "\nMirroring %d repositories (parallel: %d)...\n\n", len(mirrorPlan.Repos), parallel)
 
+		job, ctx := core.TrackJob(cmd.Context(), "mirror", orgName, len(mirrorPlan.Repos))
+
 		batchOpts := core.MirrorBatchOptions{
 			Plan:   mirrorPlan,
 			Logger: logger,
+			Job:    job,
 		}
 
-		result, err := core.ExecuteMirrorBatch(batchOpts)
-		if err != nil {
+		result, err := core.ExecuteMirrorBatch(ctx, batchOpts)
+
+		job.Finish(err)
+
+		if result == nil {
 			return fmt.Errorf("mirror failed: %w", err)
 		}
 
@@ -200,6 +229,10 @@ func runMirror(cmd *cobra.Command, args []string) error {
 			core.LogMirrorSummary(result.Results, logger)
 		}
 
+		if result.Interrupted {
+			return fmt.Errorf("mirror stopped with %d repositories not mirrored: %w", result.NotStarted, err)
+		}
+
 		if result.Failed > 0 {
 			return fmt.Errorf("%d repositories failed to mirror", result.Failed)
 		}
@@ -208,11 +241,12 @@ func runMirror(cmd *cobra.Command, args []string) error {
 	}
 
 	// Launch TUI
-	m := cli.NewMirrorModel(mirrorPlan)
-	p := tea.NewProgram(m)
+	m := cli.NewMirrorModel(cmd.Context(), mirrorPlan)
+	p := tea.NewProgram(m, tea.WithContext(cmd.Context()))
 
+	// A cancelled context (SIGTERM, --max-time) ends the TUI like quitting it
 	finalModel, err := p.Run()
-	if err != nil {
+	if err != nil && (!errors.Is(err, tea.ErrProgramKilled) || errors.Is(err, tea.ErrProgramPanic)) {
 		return fmt.Errorf("UI error: %w", err)
 	}
 
@@ -222,15 +256,38 @@ func runMirror(cmd *cobra.Command, args []string) error {
 		return mirrorModel.Error()
 	}
 
+	if mirrorModel.Interrupted() {
+		for _, r := range mirrorModel.Results() {
+			if r.Success {
+				draft.Done = append(draft.Done, r.Repo.Name)
+			}
+		}
+
+		if err := core.SaveWizardDraft(draftName, draft); err == nil {
+			_, _ = fmt.Fprintf(os.Stdout, "Interrupted. Run 'clonr org mirror %s' again to resume.\n", orgName)
+		}
+	} else {
+		_ = core.DeleteWizardDraft(draftName)
+	}
+
 	core.PrintMirrorSummary(mirrorModel.Results())
 
 	if jsonOutput {
 		core.LogMirrorSummary(mirrorModel.Results(), logger)
 	}
 
+	if mirrorModel.Interrupted() && cmd.Context().Err() != nil {
+		return context.Cause(cmd.Context())
+	}
+
 	return nil
 }
 
+// mirrorDraft is the saved progress of an interrupted 'org mirror' TUI run
+type mirrorDraft struct {
+	Done []string `json:"done"` // Names of the repositories mirrored successfully
+}
+
 // setupMirrorLogger creates a configured slog.Logger
 func setupMirrorLogger(levelStr string, jsonOutput bool) *slog.Logger {
 	var level slog.Level
@@ -88,6 +88,7 @@ Examples:
   clonr outlook messages --folder sentitems
   clonr outlook read <message-id>
   clonr outlook search "project update"`,
+	Annotations: map[string]string{networkAnnotation: "Outlook"},
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var pinCmd = &cobra.Command{
+	Use:   "pin [repo]",
+	Short: "Pin a repository to the main menu",
+	Long: fmt.Sprintf(`Pin a repository to the top of the main menu ('clonr menu'), where it
+opens in the configured editor with one key: the first pin with 1, the
+second with 2 and so on. Up to %d repositories can be pinned.
+
+Without a repository the one in the current directory is pinned.
+
+Examples:
+  clonr pin                 # Repository in the current directory
+  clonr pin api             # By name, alias, URL or path
+  clonr pin --list
+  clonr unpin api`, model.MaxPinnedRepos),
+	Args: cobra.MaximumNArgs(1),
+	RunE: runPin,
+}
+
+var unpinCmd = &cobra.Command{
+	Use:   "unpin [repo]",
+	Short: "Remove a repository from the main menu",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  func(_ *cobra.Command, args []string) error { return setPinned(argOrEmpty(args, 0), false) },
+}
+
+func init() {
+	rootCmd.AddCommand(pinCmd)
+	rootCmd.AddCommand(unpinCmd)
+
+	pinCmd.Flags().BoolP("list", "l", false, "List the pinned repositories")
+	pinCmd.Flags().Bool("json", false, "Output the list as JSON")
+}
+
+func runPin(cmd *cobra.Command, args []string) error {
+	list, _ := cmd.Flags().GetBool("list")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if !list && !jsonOutput {
+		return setPinned(argOrEmpty(args, 0), true)
+	}
+
+	if len(args) > 0 {
+		return fmt.Errorf("--list takes no repository")
+	}
+
+	repos, err := core.ListPinnedRepos()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(repos)
+	}
+
+	if len(repos) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories are pinned.")
+		_, _ = fmt.Fprintln(os.Stdout, "Pin one with: clonr pin <repo>")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KEY\tURL\tPATH")
+
+	for i, repo := range repos {
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\n", i+1, repo.URL, repo.Path)
+	}
+
+	_ = w.Flush()
+
+	return nil
+}
+
+func setPinned(query string, pinned bool) error {
+	repo, err := core.ResolveRepo(query)
+	if err != nil {
+		return err
+	}
+
+	changed, err := core.SetRepoPinned(repo, pinned)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !changed && pinned:
+		_, _ = fmt.Fprintf(os.Stdout, "%s is already pinned\n", repo.URL)
+	case !changed:
+		_, _ = fmt.Fprintf(os.Stdout, "%s is not pinned\n", repo.URL)
+	case pinned:
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Pinned"), repo.URL)
+	default:
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Unpinned"), repo.URL)
+	}
+
+	return nil
+}
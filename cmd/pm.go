@@ -36,6 +36,7 @@ Authentication:
     1. --token flag
     2. LINEAR_API_KEY environment variable
     3. ~/.config/clonr/linear.json config file`,
+	Annotations: map[string]string{networkAnnotation: "Jira, ZenHub and Linear"},
 }
 
 func init() {
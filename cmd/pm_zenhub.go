@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/inovacc/clonr/internal/core"
@@ -37,6 +38,8 @@ Authentication:
   3. ~/.config/clonr/zenhub.json config file
 
   GitHub token is used for repository ID lookup (auto-detected).
+  Repository IDs are cached for a week; use --refresh to look one up again,
+  e.g. after a repository was renamed or transferred.
 
 Examples:
   clonr pm zenhub issues owner/repo
@@ -59,9 +62,13 @@ Shows:
   - Total story points per pipeline
   - Optionally full issue details with --details flag
 
+The board is cached for 5 minutes (see --cache-ttl); use --no-cache to
+fetch it fresh.
+
 Examples:
   clonr pm zenhub board owner/repo
   clonr pm zenhub board owner/repo --details
+  clonr pm zenhub board owner/repo --no-cache
   clonr pm zenhub board --repo owner/repo --json`,
 	RunE: runZenHubBoard,
 }
@@ -205,22 +212,27 @@ func init() {
 	addPMCommonFlags(zenhubBoardCmd)
 	zenhubBoardCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubBoardCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubBoardCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 	zenhubBoardCmd.Flags().Bool("details", false, "Include issue details in output")
+	addCacheFlags(zenhubBoardCmd)
 
 	// Epics flags
 	addPMCommonFlags(zenhubEpicsCmd)
 	zenhubEpicsCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubEpicsCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubEpicsCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 
 	// Issue flags
 	addPMCommonFlags(zenhubIssueCmd)
 	zenhubIssueCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubIssueCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubIssueCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 
 	// Workspaces flags
 	addPMCommonFlags(zenhubWorkspacesCmd)
 	zenhubWorkspacesCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubWorkspacesCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubWorkspacesCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 
 	// Auth flags
 	zenhubAuthCmd.Flags().Bool("zenhub", false, "Open only ZenHub token page")
@@ -230,6 +242,7 @@ func init() {
 	addPMCommonFlags(zenhubIssuesCmd)
 	zenhubIssuesCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubIssuesCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubIssuesCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 	zenhubIssuesCmd.Flags().String("pipeline", "", "Filter by ZenHub pipeline name")
 	zenhubIssuesCmd.Flags().String("estimate", "", "Filter by estimate range (e.g., '3-8' or '5+')")
 	zenhubIssuesCmd.Flags().Int("epic", 0, "Filter by parent epic number")
@@ -241,12 +254,14 @@ func init() {
 	addPMCommonFlags(zenhubEpicDetailCmd)
 	zenhubEpicDetailCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubEpicDetailCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubEpicDetailCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 	zenhubEpicDetailCmd.Flags().Bool("include-closed", true, "Include closed child issues")
 
 	// Move flags
 	addPMCommonFlags(zenhubMoveCmd)
 	zenhubMoveCmd.Flags().String("repo", "", "Repository (owner/repo)")
 	zenhubMoveCmd.Flags().String("gh-token", "", "GitHub token (for repo ID lookup)")
+	zenhubMoveCmd.Flags().Bool("refresh", false, "Refetch the cached GitHub repository ID")
 	zenhubMoveCmd.Flags().String("pipeline", "", "Target pipeline name (required)")
 	zenhubMoveCmd.Flags().String("position", "top", "Position in pipeline (top, bottom, or numeric index)")
 	_ = zenhubMoveCmd.MarkFlagRequired("pipeline")
@@ -256,10 +271,16 @@ func runZenHubBoard(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 	showDetails, _ := cmd.Flags().GetBool("details")
 
+	cache, err := cacheOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Get repo argument
 	var repoArg string
 	if len(args) > 0 {
@@ -297,7 +318,7 @@ func runZenHubBoard(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -318,7 +339,11 @@ func runZenHubBoard(cmd *cobra.Command, args []string) error {
 		Logger:              logger,
 	}
 
-	board, err := zenhub.GetZenHubBoard(zhClient, repoID, fmt.Sprintf("%s/%s", owner, repo), opts)
+	cacheKey := []string{core.CacheKeyHash(zhToken), strconv.FormatInt(repoID, 10), strconv.FormatBool(showDetails)}
+
+	board, err := core.CachedQuery("zenhub-boards", cacheKey, cache, func() (*zenhub.ZenHubBoardData, error) {
+		return zenhub.GetZenHubBoard(zhClient, repoID, fmt.Sprintf("%s/%s", owner, repo), opts)
+	})
 	if err != nil {
 		return fmt.Errorf("failed to fetch board: %w", err)
 	}
@@ -367,6 +392,7 @@ func runZenHubEpics(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 
@@ -407,7 +433,7 @@ func runZenHubEpics(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -459,6 +485,7 @@ func runZenHubIssue(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 
@@ -513,7 +540,7 @@ func runZenHubIssue(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -607,6 +634,7 @@ func runZenHubWorkspaces(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 
@@ -647,7 +675,7 @@ func runZenHubWorkspaces(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -711,6 +739,7 @@ func runZenHubIssues(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 	pipelineFilter, _ := cmd.Flags().GetString("pipeline")
@@ -757,7 +786,7 @@ func runZenHubIssues(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -935,6 +964,7 @@ func runZenHubEpicDetail(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 	includeClosed, _ := cmd.Flags().GetBool("include-closed")
@@ -990,7 +1020,7 @@ func runZenHubEpicDetail(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -1097,6 +1127,7 @@ func runZenHubMove(cmd *cobra.Command, args []string) error {
 	// Get flags
 	tokenFlag, _ := cmd.Flags().GetString("token")
 	ghTokenFlag, _ := cmd.Flags().GetString("gh-token")
+	refresh, _ := cmd.Flags().GetBool("refresh")
 	repoFlag, _ := cmd.Flags().GetString("repo")
 	outputJson, _ := cmd.Flags().GetBool("json")
 	pipelineFlag, _ := cmd.Flags().GetString("pipeline")
@@ -1152,7 +1183,7 @@ func runZenHubMove(cmd *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stderr, "Fetching repository ID for %s/%s...\n", owner, repo)
 	}
 
-	repoID, err := zenhub.GetGitHubRepoID(ghToken, owner, repo, logger)
+	repoID, err := core.GetGitHubRepoID(ghToken, owner, repo, core.GitHubRepoIDOptions{Refresh: refresh, Logger: logger})
 	if err != nil {
 		return fmt.Errorf("failed to get repository ID: %w", err)
 	}
@@ -86,6 +86,11 @@ By default, this will start an OAuth device flow where you:
 Alternatively, you can provide a Personal Access Token (PAT) directly
 using the --token flag to skip the OAuth flow.
 
+On a console or server without a browser, --device-code uses only the
+device flow: enter the displayed code at the GitHub URL from any other
+device. It needs the client ID of an OAuth App with device flow enabled,
+given with --client-id or GITHUB_OAUTH_CLIENT_ID.
+
 The token will be stored securely in your system keyring if available,
 or encrypted in the database as a fallback.
 
@@ -96,16 +101,20 @@ Examples:
   clonr profile add work --workspace work
   clonr profile add personal --workspace personal --host github.com
   clonr profile add enterprise --workspace corp --host github.mycompany.com
-  clonr profile add myprofile --workspace dev --token ghp_xxxxxxxxxxxx`,
-	Args: cobra.ExactArgs(1),
-	RunE: runProfileAdd,
+  clonr profile add myprofile --workspace dev --token ghp_xxxxxxxxxxxx
+  clonr profile add server --workspace dev --device-code --client-id Iv1.xxxxxxxx`,
+	Annotations: map[string]string{networkAnnotation: "GitHub authentication"},
+	Args:        cobra.ExactArgs(1),
+	RunE:        runProfileAdd,
 }
 
 var (
-	profileAddHost      string
-	profileAddScopes    []string
-	profileAddToken     string
-	profileAddWorkspace string
+	profileAddHost       string
+	profileAddScopes     []string
+	profileAddToken      string
+	profileAddWorkspace  string
+	profileAddDeviceCode bool
+	profileAddClientID   string
 )
 
 func init() {
@@ -115,6 +124,8 @@ func init() {
 	profileAddCmd.Flags().StringSliceVar(&profileAddScopes, "scopes", nil, "OAuth scopes (default: repo,read:org,gist,read:user,user:email)")
 	profileAddCmd.Flags().StringVar(&profileAddToken, "token", "", "Personal Access Token (skip OAuth flow)")
 	profileAddCmd.Flags().StringVar(&profileAddWorkspace, "workspace", "", "Associated workspace (required)")
+	profileAddCmd.Flags().BoolVar(&profileAddDeviceCode, "device-code", false, "Authorize from another device using only the device flow")
+	profileAddCmd.Flags().StringVar(&profileAddClientID, "client-id", "", "OAuth App client ID for --device-code (default: $GITHUB_OAUTH_CLIENT_ID)")
 
 	_ = profileAddCmd.MarkFlagRequired("workspace")
 }
@@ -158,7 +169,10 @@ func runProfileAdd(_ *cobra.Command, args []string) error {
 	_, _ = fmt.Fprintf(os.Stdout, "Host: %s\n", profileAddHost)
 	_, _ = fmt.Fprintf(os.Stdout, "Workspace: %s\n", profileAddWorkspace)
 
-	var token, username string
+	var (
+		token, username string
+		expiresAt       time.Time
+	)
 
 	// Check if PAT was provided
 	if profileAddToken != "" {
@@ -168,18 +182,29 @@ func runProfileAdd(_ *cobra.Command, args []string) error {
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 
-		valid, user, err := core.ValidateToken(ctx, profileAddToken, profileAddHost)
+		info, err := core.InspectToken(ctx, profileAddToken, profileAddHost)
 		if err != nil {
 			return fmt.Errorf("failed to validate token: %w", err)
 		}
 
-		if !valid {
+		if info == nil {
 			return fmt.Errorf("invalid or expired token")
 		}
 
 		token = profileAddToken
-		username = user
+		username = info.Username
+		expiresAt = info.ExpiresAt
 		_, _ = fmt.Fprintf(os.Stdout, "Token validated for user: %s\n", username)
+
+		if !expiresAt.IsZero() {
+			_, _ = fmt.Fprintf(os.Stdout, "Token expires: %s\n", expiresAt.Local().Format("2006-01-02"))
+		}
+
+		printScopeCheck(core.CheckScopes(info.Scopes, scopes, core.GitHubImpliedScopes))
+
+		if info.Scopes != nil {
+			scopes = info.Scopes
+		}
 	} else {
 		_, _ = fmt.Fprintf(os.Stdout, "Scopes: %s\n\n", strings.Join(scopes, ", "))
 
@@ -187,15 +212,28 @@ func runProfileAdd(_ *cobra.Command, args []string) error {
 		flow := core.NewOAuthFlow(profileAddHost, scopes)
 
 		flow.OnDeviceCode(func(code, url string) {
-			_, _ = fmt.Fprintln(os.Stdout, "GitHub OAuth Authentication")
-			_, _ = fmt.Fprintln(os.Stdout, strings.Repeat("-", 40))
-			_, _ = fmt.Fprintf(os.Stdout, "\n1. Copy this code: %s\n\n", code)
-			_, _ = fmt.Fprintf(os.Stdout, "2. Open: %s\n\n", url)
-			_, _ = fmt.Fprintln(os.Stdout, "3. Paste the code and authorize clonr")
-			_, _ = fmt.Fprintln(os.Stdout, "\nWaiting for authorization...")
+			printDeviceCode("GitHub", code, url)
 		})
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		timeout := 5 * time.Minute
+
+		if profileAddDeviceCode {
+			clientID := profileAddClientID
+			if clientID == "" {
+				clientID = os.Getenv("GITHUB_OAUTH_CLIENT_ID")
+			}
+
+			if clientID == "" {
+				return fmt.Errorf("--device-code needs the client ID of an OAuth App with device flow enabled: pass --client-id or set GITHUB_OAUTH_CLIENT_ID")
+			}
+
+			flow.UseDeviceCode(clientID)
+
+			// Device codes stay valid for 15 minutes; polling stops when they expire
+			timeout = 15 * time.Minute
+		}
+
+		ctx, cancel := context.WithTimeout(core.BaseContext(), timeout)
 		defer cancel()
 
 		result, err := flow.Run(ctx)
@@ -203,9 +241,12 @@ func runProfileAdd(_ *cobra.Command, args []string) error {
 			return fmt.Errorf("OAuth authentication failed: %w", err)
 		}
 
+		printScopeCheck(core.CheckScopes(result.Scopes, scopes, core.GitHubImpliedScopes))
+
 		token = result.Token
 		username = result.Username
 		scopes = result.Scopes
+		expiresAt = result.ExpiresAt
 	}
 
 	// Encrypt and store the token
@@ -240,6 +281,7 @@ func runProfileAdd(_ *cobra.Command, args []string) error {
 		CreatedAt:      time.Now(),
 		LastUsedAt:     time.Now(),
 		Workspace:      profileAddWorkspace,
+		TokenExpiresAt: expiresAt,
 	}
 
 	// Save profile to BoltDB
@@ -353,5 +353,10 @@ func runDockerProfileStatus(_ *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stdout, "Last used: %s\n", profile.LastUsedAt.Format(time.RFC3339))
 	}
 
+	if profile.Environment != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "\nContainer (clonr docker up %s):\n", profile.Name)
+		printDockerEnvironment(profile.Environment)
+	}
+
 	return nil
 }
@@ -7,11 +7,15 @@ import (
 var repoCmd = &cobra.Command{
 	Use:   "repo",
 	Short: "Repository operations",
-	Long: `Commands for opening and editing repositories.
+	Long: `Commands for opening, editing, classifying and annotating repositories.
 
 Available Commands:
-  open    Open repository folder in file manager
-  edit    Open repository in selected editor`,
+  open      Open repository folder in file manager
+  edit      Open repository in selected editor
+  classify  Classify repositories as source, fork, mirror, archive or template
+  remotes   Show the remotes tracked for a repository
+  notes     Show or set the notes of a repository
+  alias     Show or set the alias of a repository`,
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var repoAliasCmd = &cobra.Command{
+	Use:   "alias <repository> [name]",
+	Short: "Show or set the alias of a repository",
+	Long: `Show or set a short name for a repository. Commands taking a repository
+accept its alias in place of the URL, e.g. 'clonr open api'. An alias is
+unique: setting one already used by another repository fails.
+
+Aliases are lower case and may contain letters, digits, '.', '_' and '-',
+starting with a letter or digit. They can also be set when cloning or
+adding a repository with --name.
+
+Examples:
+  clonr repo alias acme/api-gateway api
+  clonr repo alias api
+  clonr repo alias api --clear`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runRepoAlias,
+}
+
+func init() {
+	repoCmd.AddCommand(repoAliasCmd)
+	repoAliasCmd.Flags().Bool("clear", false, "Remove the alias")
+}
+
+func runRepoAlias(cmd *cobra.Command, args []string) error {
+	clearAlias, _ := cmd.Flags().GetBool("clear")
+
+	repo, err := core.ResolveRepo(args[0])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case clearAlias && len(args) > 1:
+		return fmt.Errorf("--clear does not take a name")
+
+	case clearAlias:
+		if err := core.SetRepoAlias(repo.URL, ""); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s Cleared the alias of %s\n", okStyle.Render("✓"), repo.URL)
+
+	case len(args) > 1:
+		alias, err := model.ParseRepoAlias(args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := core.SetRepoAlias(repo.URL, alias); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s is now known as %s\n", okStyle.Render("✓"), repo.URL, alias)
+
+	case repo.Alias == "":
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("No alias; set one with 'clonr repo alias "+args[0]+" <name>'"))
+
+	default:
+		_, _ = fmt.Fprintln(os.Stdout, repo.Alias)
+	}
+
+	return nil
+}
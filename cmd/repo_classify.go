@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var repoClassifyCmd = &cobra.Command{
+	Use:   "classify",
+	Short: "Classify repositories as source, fork, mirror, archive or template",
+	Long: `Record the kind of each tracked repository.
+
+The kind comes from the GitHub API (archived, mirror, template and fork
+flags) and, for other hosts or when the API is unavailable, from the local
+clone: a mirror clone is a mirror and a clone with an "upstream" remote is a
+fork. New clones are classified automatically. In air-gapped mode
+(--offline) only the local clone is used.
+
+Kinds change default behavior:
+  archive    skipped by bulk updates and pushes
+  mirror     skipped by bulk pushes ('clonr workspace exec -- git push')
+
+Filter by kind with 'clonr list --kind', 'clonr filter save --kind' and
+'clonr workspace exec --kind'.
+
+Examples:
+  clonr repo classify                   # Classify unclassified repositories
+  clonr repo classify --refresh         # Reclassify everything
+  clonr repo classify -w work --dry-run # Preview one workspace
+  clonr repo classify --offline         # Local heuristics only, no GitHub API`,
+	Args: cobra.NoArgs,
+	RunE: runRepoClassify,
+}
+
+func init() {
+	repoCmd.AddCommand(repoClassifyCmd)
+	repoClassifyCmd.Flags().StringP("workspace", "w", "", "Only repositories in this workspace")
+	repoClassifyCmd.Flags().Bool("refresh", false, "Reclassify repositories that already have a kind")
+	repoClassifyCmd.Flags().Bool("dry-run", false, "Show the classification without saving it")
+	repoClassifyCmd.Flags().String("token", "", "GitHub token (default: auto-detect)")
+	repoClassifyCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runRepoClassify(cmd *cobra.Command, _ []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	refresh, _ := cmd.Flags().GetBool("refresh")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	token, _ := cmd.Flags().GetString("token")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	results, err := core.ClassifyRepos(cmd.Context(), core.ClassifyOptions{
+		Workspace: workspace,
+		Refresh:   refresh,
+		Token:     token,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(results)
+	}
+
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "All repositories are classified (use --refresh to reclassify)")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tKIND\tSOURCE\tSTATUS")
+
+	changed := 0
+
+	for _, r := range results {
+		status := dimStyle.Render("unchanged")
+
+		switch {
+		case r.Warning != "":
+			status = warnStyle.Render(r.Warning)
+		case r.Changed():
+			status = okStyle.Render("updated")
+			if r.Previous != "" {
+				status = okStyle.Render("was " + string(r.Previous))
+			}
+		}
+
+		if r.Changed() {
+			changed++
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.URL, r.Kind, r.Source, status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("\nDry run: %d of %d repositories would change", changed, len(results))))
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "\nClassified %d repositories, %d changed\n", len(results), changed)
+	}
+
+	return nil
+}
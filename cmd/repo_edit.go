@@ -104,6 +104,8 @@ func runRepoEdit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	core.RecordPathAccess(repoPath)
+
 	_, _ = fmt.Fprintf(os.Stdout, "Opened %s in %s\n", repoPath, editorCmd)
 
 	return nil
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var repoNotesCmd = &cobra.Command{
+	Use:   "notes <repository> [text]",
+	Short: "Show or set the notes of a repository",
+	Long: `Show or set free text kept with a repository, such as why it was cloned or
+how to build it. The notes are shown in the detail pane of 'clonr list'
+(press i).
+
+Examples:
+  clonr repo notes cli
+  clonr repo notes cli "Fork of upstream; rebase weekly"
+  clonr repo notes cli --clear`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runRepoNotes,
+}
+
+func init() {
+	repoCmd.AddCommand(repoNotesCmd)
+	repoNotesCmd.Flags().Bool("clear", false, "Remove the notes")
+}
+
+func runRepoNotes(cmd *cobra.Command, args []string) error {
+	clearNotes, _ := cmd.Flags().GetBool("clear")
+
+	repo, err := core.ResolveRepo(args[0])
+	if err != nil {
+		return err
+	}
+
+	text := strings.Join(args[1:], " ")
+
+	switch {
+	case clearNotes && text != "":
+		return fmt.Errorf("--clear does not take notes")
+
+	case clearNotes:
+		if err := core.SetRepoNotes(repo.URL, ""); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s Cleared the notes of %s\n", okStyle.Render("✓"), repo.URL)
+
+	case text != "":
+		if err := core.SetRepoNotes(repo.URL, text); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "%s Saved the notes of %s\n", okStyle.Render("✓"), repo.URL)
+
+	case repo.Notes == "":
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("No notes; set them with 'clonr repo notes "+args[0]+" <text>'"))
+
+	default:
+		_, _ = fmt.Fprintln(os.Stdout, repo.Notes)
+	}
+
+	return nil
+}
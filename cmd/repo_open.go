@@ -38,6 +38,8 @@ func runRepoOpen(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		core.RecordPathAccess(path)
+
 		_, _ = fmt.Fprintf(os.Stdout, "Opened %s in file manager\n", path)
 
 		return nil
@@ -69,6 +71,8 @@ func runRepoOpen(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	core.RecordRepoAccess(selected.URL)
+
 	_, _ = fmt.Fprintf(os.Stdout, "Opened %s in file manager\n", selected.Path)
 
 	return nil
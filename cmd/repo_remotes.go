@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var repoRemotesCmd = &cobra.Command{
+	Use:   "remotes [repository]",
+	Short: "Show the remotes tracked for a repository",
+	Long: `Show the git remotes recorded for a repository besides its URL, such as
+the upstream of a fork or push mirrors.
+
+Remotes are recorded by 'clonr fork' and refreshed by 'clonr update'; use
+--refresh to read them from the clone now. A repository recorded as a remote
+of a tracked clone is not cloned again without --force.
+
+Without a repository, the repository in the current directory is shown.
+
+Examples:
+  clonr repo remotes
+  clonr repo remotes cli --refresh
+  clonr repo remotes --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runRepoRemotes,
+}
+
+func init() {
+	repoCmd.AddCommand(repoRemotesCmd)
+	repoRemotesCmd.Flags().Bool("refresh", false, "Read the remotes from the clone and record them")
+	repoRemotesCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runRepoRemotes(cmd *cobra.Command, args []string) error {
+	refresh, _ := cmd.Flags().GetBool("refresh")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var query string
+	if len(args) > 0 {
+		query = args[0]
+	}
+
+	repo, err := core.ResolveRepo(query)
+	if err != nil {
+		return err
+	}
+
+	if refresh {
+		if _, err := core.RefreshRepoRemotes(repo); err != nil {
+			return err
+		}
+	}
+
+	if jsonOutput {
+		return outputJSON(repo.Remotes)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%-10s %s\n", "origin", repo.URL)
+
+	for _, remote := range repo.Remotes {
+		_, _ = fmt.Fprintf(os.Stdout, "%-10s %s\n", remote.Name, remote.URL)
+	}
+
+	if len(repo.Remotes) == 0 && !refresh {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("No other remotes recorded; read them from the clone with --refresh"))
+	}
+
+	return nil
+}
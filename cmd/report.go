@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/spf13/cobra"
+)
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate reports about tracked repositories",
+	Long: `Generate reports about the repositories tracked by clonr.
+
+Available Commands:
+  fleet    Weekly digest of growth, update failures, stale branches and disk usage`,
+}
+
+var reportFleetCmd = &cobra.Command{
+	Use:   "fleet",
+	Short: "Send the fleet report now or preview it",
+	Long: `Compile the fleet report and send it to the configured notification channels.
+
+The report covers:
+  - Repository growth since the last report
+  - Repositories whose last update (mirror or pull) failed
+  - Local branches without commits for --stale-days
+  - Disk usage, with the largest repositories
+
+The server sends this report automatically once a week when a notification
+channel is configured. Use --preview to print the digest without sending it,
+or --email to mail it from the Gmail account of the active profile instead.
+
+Examples:
+  clonr report fleet --preview           # Print the digest
+  clonr report fleet --preview --json    # Print the report as JSON
+  clonr report fleet                     # Send the report now
+  clonr report fleet --email me@example.com  # Mail the digest
+  clonr report fleet --stale-days 30     # Treat branches idle for 30 days as stale`,
+	RunE: runReportFleet,
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportFleetCmd)
+
+	reportFleetCmd.Flags().Bool("preview", false, "Print the report instead of sending it")
+	reportFleetCmd.Flags().Bool("json", false, "Print the report as JSON (implies --preview)")
+	reportFleetCmd.Flags().Int("stale-days", 90, "Days without commits before a branch is stale")
+	reportFleetCmd.Flags().Int("top", 10, "Number of largest repositories to list")
+	reportFleetCmd.Flags().StringSlice("email", nil, "Mail the report to these addresses via Gmail instead")
+}
+
+func runReportFleet(cmd *cobra.Command, _ []string) error {
+	preview, _ := cmd.Flags().GetBool("preview")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	staleDays, _ := cmd.Flags().GetInt("stale-days")
+	top, _ := cmd.Flags().GetInt("top")
+	emailTo, _ := cmd.Flags().GetStringSlice("email")
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return fmt.Errorf("failed to list repos: %w", err)
+	}
+
+	opts := core.DefaultFleetReportOptions()
+	opts.StaleAfter = time.Duration(staleDays) * 24 * time.Hour
+	opts.Top = top
+
+	report, err := core.BuildFleetReport(repos, opts)
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(report)
+	}
+
+	if preview {
+		_, _ = fmt.Fprint(os.Stdout, core.FormatFleetReport(report))
+		return nil
+	}
+
+	if len(emailTo) > 0 {
+		ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+		defer cancel()
+
+		_, err := gmailSend(ctx, &gmail.OutgoingMessage{
+			To:      emailTo,
+			Subject: "clonr fleet report - " + time.Now().Format("2006-01-02"),
+			Body:    core.FormatFleetReport(report),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "Fleet report mailed to %s (%d repositories).\n", strings.Join(emailTo, ", "), report.TotalRepos)
+
+		return nil
+	}
+
+	dispatcher, err := core.NewReminderDispatcher()
+	if err != nil {
+		return err
+	}
+
+	if dispatcher == nil {
+		return fmt.Errorf("no notification channel configured\nSet one up with: clonr slack notify add --webhook <url>")
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), time.Minute)
+	defer cancel()
+
+	if err := core.SendFleetReport(ctx, report, dispatcher); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Fleet report sent (%d repositories).\n", report.TotalRepos)
+
+	return nil
+}
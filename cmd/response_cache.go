@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// addCacheFlags adds the --no-cache and --cache-ttl flags of a command
+// whose API responses go through the response cache
+func addCacheFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-cache", false, "Fetch fresh data instead of a cached response")
+	cmd.Flags().Duration("cache-ttl", core.DefaultResponseCacheTTL, "Age at which a cached response is fetched again")
+}
+
+// cacheOptions returns the response cache options set by addCacheFlags
+func cacheOptions(cmd *cobra.Command) (core.CacheOptions, error) {
+	noCache, _ := cmd.Flags().GetBool("no-cache")
+	ttl, _ := cmd.Flags().GetDuration("cache-ttl")
+
+	if ttl <= 0 {
+		return core.CacheOptions{}, fmt.Errorf("--cache-ttl must be positive")
+	}
+
+	return core.CacheOptions{NoCache: noCache, TTL: ttl}, nil
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <backup>",
+	Short: "Restore a repository from a backup",
+	Long: `Re-create a repository from a 'clonr backup' archive and register it
+again with its original URL, workspace, favorite flag and kind.
+
+The backup is one of:
+  - a local archive (.tar.gz)
+  - a local git bundle with a metadata sidecar (<name>.bundle.json,
+    <name>.json or metadata.json next to it)
+  - a key from 'clonr backup list' at the backup destination
+  - a repository directory (host/owner/repo) for its newest backup
+
+All refs, the checked-out branch and the remotes are restored. The original
+path is used unless --path is given; the target directory must not exist or
+be empty. A repository still tracked at a path that no longer exists is
+pointed at the restored clone.
+
+Examples:
+  clonr restore github.com/acme/api                         # Newest backup
+  clonr restore github.com/acme/api --dest s3://my-bucket/git
+  clonr restore ~/backups/github.com/acme/api/api-20261016T120000Z.tar.gz
+  clonr restore api.bundle --path ~/src/api --no-register`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRestore,
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().String("dest", "", "Backup destination to look keys up in (default: configured)")
+	restoreCmd.Flags().String("path", "", "Where to restore (default: the original path)")
+	restoreCmd.Flags().StringP("workspace", "w", "", "Workspace to register in (default: the original workspace)")
+	restoreCmd.Flags().Bool("no-register", false, "Restore the files without registering the repository")
+	restoreCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runRestore(cmd *cobra.Command, args []string) error {
+	dest, _ := cmd.Flags().GetString("dest")
+	path, _ := cmd.Flags().GetString("path")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	noRegister, _ := cmd.Flags().GetBool("no-register")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	res, err := core.RestoreBackup(cmd.Context(), core.RestoreOptions{
+		Source:     args[0],
+		Dest:       dest,
+		Path:       path,
+		Workspace:  workspace,
+		NoRegister: noRegister,
+	})
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(res)
+	}
+
+	at := res.Branch
+	if at == "" {
+		at = shortID(res.Head)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Restored %s to %s (%s)\n", okStyle.Render("✓"), res.URL, res.Path, at)
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  from "+res.Source))
+
+	switch {
+	case res.Registered && res.Workspace != "":
+		_, _ = fmt.Fprintf(os.Stdout, "  Registered in workspace %s\n", res.Workspace)
+	case res.Registered:
+		_, _ = fmt.Fprintln(os.Stdout, "  Registered")
+	default:
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Not registered (add it with 'clonr add')"))
+	}
+
+	for _, warning := range res.Warnings {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", warnStyle.Render("Warning:"), warning)
+	}
+
+	return nil
+}
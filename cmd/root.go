@@ -1,17 +1,48 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/inovacc/clonr/internal/application"
+	"github.com/inovacc/clonr/internal/audit"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/inovacc/clonr/internal/ratelimit"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
 )
 
+// exclusiveStoreAnnotation marks commands that need exclusive database access
+const exclusiveStoreAnnotation = "clonr/exclusive-store"
+
+// networkAnnotation marks commands, and with them their subcommands, that
+// use a network integration; its value names the integration. They are
+// refused in air-gapped mode.
+const networkAnnotation = "clonr/network"
+
+// notifyFlushTimeout bounds how long a command waits at exit for its
+// notifications to be delivered
+const notifyFlushTimeout = 5 * time.Second
+
 var (
 	initOnce sync.Once
+
+	// maxTime limits how long a command may run (--max-time)
+	maxTime time.Duration
+
+	// offline enables air-gapped mode for this invocation (--offline)
+	offline bool
+
+	// autoStart starts a server without asking when none is running (--auto-start)
+	autoStart bool
+
+	// serverName selects a named server from 'clonr server remote' (--server)
+	serverName string
 )
 
 var rootCmd = &cobra.Command{
@@ -19,18 +50,75 @@ var rootCmd = &cobra.Command{
 	Short: "A Git repository manager",
 	Long: `Clonr is a command-line tool for managing Git repositories efficiently.
 It provides an interactive interface for cloning, organizing, and working with
-multiple repositories.`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+multiple repositories.
+
+Run without a command the first time to be guided through the setup.`,
+	RunE: runRoot,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Attribute audited secret access to the running command
+		audit.SetCommand(cmd.CommandPath())
+
+		if offline {
+			core.ForceOffline()
+		}
+
+		if err := checkNetworkAllowed(cmd); err != nil {
+			return err
+		}
+
+		ratelimit.SetNotifier(printRateLimitWait)
+
+		// The server must own the database; it opens it itself once any
+		// previous instance has stopped. It handles signals on its own.
+		if _, ok := cmd.Annotations[exclusiveStoreAnnotation]; ok {
+			store.SetOpenMode(store.OpenExclusive)
+			return nil
+		}
+
+		// Cancel git processes and server requests when the command is
+		// interrupted or runs out of time
+		if maxTime > 0 {
+			ctx, cancel := context.WithTimeoutCause(cmd.Context(), maxTime, errMaxTimeExceeded)
+			cancelMaxTime = cancel
+
+			cmd.SetContext(ctx)
+		}
+
+		interruptHint.Store(true)
+		core.SetBaseContext(cmd.Context())
+		grpc.SetBaseContext(cmd.Context())
+		grpc.SetStartPrompt(confirmServerStart)
+		grpc.SelectServer(serverName)
+
 		// Initialize TPM with database storage (runs once)
 		initOnce.Do(func() {
 			// Configure TPM to use SQLite for sealed key storage
 			tpm.SetDBStore(store.GetDB())
+			tpm.SetPassphrasePrompt(promptKeystorePassphrase)
 		})
+
+		return nil
 	},
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	ctx, stop := interruptContext()
+
+	err := rootCmd.ExecuteContext(ctx)
+
+	core.FlushNotifications(notifyFlushTimeout)
+
+	stop()
+
+	if cancelMaxTime != nil {
+		cancelMaxTime()
+	}
+
+	if err != nil {
+		if errors.Is(context.Cause(ctx), errInterrupted) {
+			os.Exit(exitInterrupted)
+		}
+
 		os.Exit(1)
 	}
 }
@@ -41,5 +129,8 @@ func GetRootCmd() *cobra.Command {
 }
 
 func init() {
-	// Global flags can be added here if needed
+	rootCmd.PersistentFlags().DurationVar(&maxTime, "max-time", 0, "Cancel the command after this long, e.g. 10m (0 = no limit)")
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false, "Run air-gapped: refuse network integrations (also CLONR_OFFLINE=1)")
+	rootCmd.PersistentFlags().BoolVar(&autoStart, "auto-start", false, "Start a server in the background without asking when none is running (also CLONR_AUTO_START=1)")
+	rootCmd.PersistentFlags().StringVar(&serverName, "server", "", "Use the named server from 'clonr server remote list' (also CLONR_SERVER_PROFILE)")
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -22,6 +21,8 @@ Uses gitleaks rules to detect:
 - Cloud provider credentials
 - Database connection strings
 
+To scan tracked repositories by name, or all of them, use 'clonr scan secrets'.
+
 Examples:
   clonr scan                      # Scan current directory
   clonr scan /path/to/repo        # Scan specific path
@@ -34,8 +35,8 @@ func init() {
 	scanCmd.Flags().BoolVar(&scanGitHistory, "git", false, "Scan git history instead of files")
 }
 
-func runScan(_ *cobra.Command, args []string) error {
-	ctx := context.Background()
+func runScan(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
 
 	// Determine path to scan
 	path := "."
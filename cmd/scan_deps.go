@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var scanDepsCmd = &cobra.Command{
+	Use:   "deps [repository]",
+	Short: "Inventory the dependencies of each repository",
+	Long: `Read the dependency manifests of each tracked repository and record the
+dependencies and versions they declare.
+
+Supported manifests: go.mod, package.json, requirements*.txt, Cargo.toml,
+composer.json and Gemfile, anywhere in the working tree except hidden and
+vendored directories (node_modules, vendor, ...).
+
+Each scan is compared with the last recorded inventory of the repository and
+the added, removed and changed dependencies are shown. A new inventory is
+recorded when it differs, so changes can be followed over time.
+
+Examples:
+  clonr scan deps                           # All repositories
+  clonr scan deps -w work                   # Repositories of a workspace
+  clonr scan deps cli --list                # Every dependency of a repository
+  clonr scan deps --format csv > deps.csv   # Full inventory as CSV`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runScanDeps,
+}
+
+func init() {
+	scanCmd.AddCommand(scanDepsCmd)
+	scanDepsCmd.Flags().StringP("workspace", "w", "", "Only repositories in this workspace")
+	scanDepsCmd.Flags().Bool("list", false, "List every dependency instead of the changes")
+	scanDepsCmd.Flags().Bool("dry-run", false, "Show the inventory without recording it")
+	scanDepsCmd.Flags().String("format", "", "Output format: table, json, csv, md")
+}
+
+func runScanDeps(cmd *cobra.Command, args []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	list, _ := cmd.Flags().GetBool("list")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	formatFlag, _ := cmd.Flags().GetString("format")
+
+	format, err := parseOutputFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	repos, err := nerdsRepos(args, workspace)
+	if err != nil {
+		return err
+	}
+
+	results, err := core.ScanDependencies(cmd.Context(), repos, core.DependencyScanOptions{DryRun: dryRun})
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case formatJSON:
+		return outputJSON(results)
+	case formatCSV, formatMarkdown:
+		headers := []string{"REPOSITORY", "MANIFEST", "ECOSYSTEM", "NAME", "VERSION"}
+
+		var rows [][]string
+
+		for _, r := range results {
+			for _, d := range r.Dependencies {
+				rows = append(rows, []string{r.URL, d.Manifest, d.Ecosystem, d.Name, d.Version})
+			}
+		}
+
+		return writeExport(os.Stdout, format, headers, rows)
+	}
+
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories found")
+		return nil
+	}
+
+	if list {
+		return printDependencyList(results)
+	}
+
+	return printDependencyScan(results, dryRun)
+}
+
+func printDependencyScan(results []core.DependencyScanResult, dryRun bool) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tDEPENDENCIES\tCHANGES")
+
+	changed := 0
+
+	for _, r := range results {
+		var status string
+
+		switch {
+		case r.Error != "":
+			status = warnStyle.Render(r.Error)
+		case r.Previous == nil:
+			status = dimStyle.Render("first scan")
+		case len(r.Changes) == 0:
+			status = okStyle.Render("unchanged")
+		default:
+			changed++
+
+			var added, removed, updated int
+
+			for _, c := range r.Changes {
+				switch c.Kind {
+				case core.DependencyAdded:
+					added++
+				case core.DependencyRemoved:
+					removed++
+				default:
+					updated++
+				}
+			}
+
+			status = warnStyle.Render(fmt.Sprintf("+%d -%d ~%d since %s", added, removed, updated, r.Previous.Format("2006-01-02")))
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", r.URL, len(r.Dependencies), status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if len(r.Changes) == 0 && len(r.Warnings) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "\n%s\n", r.URL)
+
+		for _, c := range r.Changes {
+			switch c.Kind {
+			case core.DependencyAdded:
+				_, _ = fmt.Fprintf(os.Stdout, "  + %s %s (%s)\n", c.Name, c.To, c.Manifest)
+			case core.DependencyRemoved:
+				_, _ = fmt.Fprintf(os.Stdout, "  - %s %s (%s)\n", c.Name, c.From, c.Manifest)
+			default:
+				_, _ = fmt.Fprintf(os.Stdout, "  ~ %s %s → %s (%s)\n", c.Name, c.From, c.To, c.Manifest)
+			}
+		}
+
+		for _, warning := range r.Warnings {
+			_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render("  ⚠ "+warning))
+		}
+	}
+
+	summary := fmt.Sprintf("\nScanned %d repositories, %d changed", len(results), changed)
+	if dryRun {
+		summary += " (dry run, nothing recorded)"
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, summary)
+
+	return nil
+}
+
+func printDependencyList(results []core.DependencyScanResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tMANIFEST\tECOSYSTEM\tNAME\tVERSION")
+
+	for _, r := range results {
+		for _, d := range r.Dependencies {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.URL, d.Manifest, d.Ecosystem, d.Name, d.Version)
+		}
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var scanLicensesCmd = &cobra.Command{
+	Use:   "licenses",
+	Short: "Detect and record the license of each repository",
+	Long: `Detect the license of each tracked repository and record it.
+
+The license comes from the GitHub API and, for other hosts or when the API is
+unavailable, from the LICENSE/COPYING file of the clone; --no-api and
+air-gapped mode (--offline) use the file only. Licenses are shown as SPDX
+identifiers; "none" means no license was found and "other" that it was not
+recognized.
+
+Repositories without a license, with an unrecognized one, or with a license
+outside --allow or inside --deny are flagged.
+
+Examples:
+  clonr scan licenses                              # All repositories
+  clonr scan licenses -w work --no-api             # License files only
+  clonr scan licenses --allow MIT,Apache-2.0,BSD-3-Clause
+  clonr scan licenses --deny AGPL-3.0,GPL-3.0 --strict`,
+	Args: cobra.NoArgs,
+	RunE: runScanLicenses,
+}
+
+func init() {
+	scanCmd.AddCommand(scanLicensesCmd)
+	scanLicensesCmd.Flags().StringP("workspace", "w", "", "Only repositories in this workspace")
+	scanLicensesCmd.Flags().StringSlice("allow", nil, "Licenses permitted (SPDX identifiers); others are flagged as incompatible")
+	scanLicensesCmd.Flags().StringSlice("deny", nil, "Licenses flagged as incompatible (SPDX identifiers)")
+	scanLicensesCmd.Flags().Bool("no-api", false, "Use license files only, without the GitHub API")
+	scanLicensesCmd.Flags().Bool("dry-run", false, "Show the licenses without saving them")
+	scanLicensesCmd.Flags().Bool("strict", false, "Exit with an error when a repository is flagged")
+	scanLicensesCmd.Flags().String("token", "", "GitHub token (default: auto-detect)")
+	scanLicensesCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runScanLicenses(cmd *cobra.Command, _ []string) error {
+	var opts core.LicenseScanOptions
+
+	opts.Workspace, _ = cmd.Flags().GetString("workspace")
+	opts.Allow, _ = cmd.Flags().GetStringSlice("allow")
+	opts.Deny, _ = cmd.Flags().GetStringSlice("deny")
+	opts.Offline, _ = cmd.Flags().GetBool("no-api")
+	opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
+	opts.Token, _ = cmd.Flags().GetString("token")
+	strict, _ := cmd.Flags().GetBool("strict")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	results, err := core.ScanLicenses(cmd.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	flagged := 0
+
+	for _, r := range results {
+		if r.Issue != "" {
+			flagged++
+		}
+	}
+
+	if jsonOutput {
+		if err := outputJSON(results); err != nil {
+			return err
+		}
+	} else if err := printLicenseScan(results, flagged, opts.DryRun); err != nil {
+		return err
+	}
+
+	if strict && flagged > 0 {
+		return fmt.Errorf("%d repositories with license issues", flagged)
+	}
+
+	return nil
+}
+
+func printLicenseScan(results []core.LicenseScanResult, flagged int, dryRun bool) error {
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tLICENSE\tSOURCE\tSTATUS")
+
+	for _, r := range results {
+		status := okStyle.Render("ok")
+
+		switch {
+		case r.Issue != "":
+			status = warnStyle.Render(r.Issue)
+		case r.Warning != "":
+			status = warnStyle.Render(r.Warning)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.URL, r.License, r.Source, status)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("\nScanned %d repositories, %d flagged", len(results), flagged)
+	if dryRun {
+		summary += " (dry run, nothing saved)"
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, summary)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var scanSecretsCmd = &cobra.Command{
+	Use:   "secrets [repository]",
+	Short: "Scan tracked repositories for leaked secrets",
+	Long: `Scan tracked repositories for hardcoded secrets and credentials.
+
+The built-in gitleaks rules combine regular expressions and entropy checks
+to detect cloud provider keys (AWS, GCP, Azure), API tokens, private keys,
+passwords and connection strings. Each repository's .gitleaksignore is
+honored. Secrets are redacted in the report.
+
+The working tree is scanned; use --history to scan every commit instead.
+Without a repository, the repository in the current directory is scanned;
+use --all (optionally with --workspace) to scan every tracked repository.
+
+The command exits with an error when secrets are found, for CI use.
+
+Examples:
+  clonr scan secrets                       # Current repository
+  clonr scan secrets cli --history         # A repository's history
+  clonr scan secrets --all -w work         # Every repository of a workspace
+  clonr scan secrets --all --format json > findings.json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runScanSecrets,
+}
+
+func init() {
+	scanCmd.AddCommand(scanSecretsCmd)
+	scanSecretsCmd.Flags().Bool("all", false, "Scan every tracked repository")
+	scanSecretsCmd.Flags().StringP("workspace", "w", "", "With --all, only repositories in this workspace")
+	scanSecretsCmd.Flags().Bool("history", false, "Scan the git history instead of the working tree")
+	scanSecretsCmd.Flags().String("format", "", "Output format: table, json, csv, md")
+}
+
+func runScanSecrets(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool("all")
+	workspace, _ := cmd.Flags().GetString("workspace")
+	history, _ := cmd.Flags().GetBool("history")
+	formatFlag, _ := cmd.Flags().GetString("format")
+
+	format, err := parseOutputFormat(formatFlag)
+	if err != nil {
+		return err
+	}
+
+	if all && len(args) > 0 {
+		return fmt.Errorf("give either a repository or --all")
+	}
+
+	var repos []model.Repository
+
+	if all {
+		client, err := grpc.GetClient()
+		if err != nil {
+			return err
+		}
+
+		if repos, err = client.GetRepos(workspace, false); err != nil {
+			return fmt.Errorf("failed to get repositories: %w", err)
+		}
+	} else {
+		var query string
+		if len(args) > 0 {
+			query = args[0]
+		}
+
+		repo, err := core.ResolveRepo(query)
+		if err != nil {
+			return err
+		}
+
+		repos = []model.Repository{*repo}
+	}
+
+	if format == "" || format == formatTable {
+		_, _ = fmt.Fprintf(os.Stderr, "🔍 Scanning %d repositories for secrets...\n", len(repos))
+	}
+
+	results, err := core.ScanRepoSecrets(cmd.Context(), repos, core.SecretScanOptions{History: history})
+	if err != nil {
+		return err
+	}
+
+	leaking, findings, failed := 0, 0, 0
+
+	for _, r := range results {
+		if len(r.Findings) > 0 {
+			leaking++
+			findings += len(r.Findings)
+		}
+
+		if r.Error != "" {
+			failed++
+		}
+	}
+
+	switch format {
+	case formatJSON:
+		if err := outputJSON(results); err != nil {
+			return err
+		}
+	case formatCSV, formatMarkdown:
+		headers := []string{"REPOSITORY", "FILE", "LINE", "RULE", "DESCRIPTION", "COMMIT", "SECRET"}
+
+		var rows [][]string
+
+		for _, r := range results {
+			for _, f := range r.Findings {
+				rows = append(rows, []string{r.URL, f.File, strconv.Itoa(f.Line), f.RuleID, f.Description, f.Commit, f.Secret})
+			}
+		}
+
+		if err := writeExport(os.Stdout, format, headers, rows); err != nil {
+			return err
+		}
+	default:
+		printSecretScan(results)
+
+		if findings == 0 {
+			_, _ = fmt.Fprintln(os.Stdout, "✅ No secrets detected!")
+		} else {
+			_, _ = fmt.Fprintf(os.Stdout, "❌ Found %d secret(s) in %d of %d repositories\n", findings, leaking, len(results))
+		}
+	}
+
+	if findings > 0 {
+		return fmt.Errorf("secrets detected in %d repositories", leaking)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d repositories could not be scanned", failed)
+	}
+
+	return nil
+}
+
+func printSecretScan(results []core.SecretScanResult) {
+	for _, r := range results {
+		switch {
+		case r.Error != "":
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", warnStyle.Render("⚠"), r.Path)
+			_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  "+r.Error))
+		case len(r.Findings) > 0:
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", warnStyle.Render("✗"), r.Path)
+
+			for _, f := range r.Findings {
+				location := fmt.Sprintf("%s:%d", f.File, f.Line)
+				if f.Commit != "" {
+					location += " @ " + f.Commit[:min(len(f.Commit), 8)]
+				}
+
+				_, _ = fmt.Fprintf(os.Stdout, "  %s  %s (%s)\n", location, f.Description, f.RuleID)
+				_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("    "+f.Secret))
+			}
+		default:
+			_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("✓"), r.Path)
+		}
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout)
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var securityCmd = &cobra.Command{
+	Use:   "security",
+	Short: "Manage the encryption keys protecting stored secrets",
+}
+
+var securityRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Generate new encryption keys and re-encrypt every stored secret",
+	Long: `Generate new sealed encryption keys and re-encrypt every secret clonr
+stores with them: profile tokens, notification channel configs, docker
+profile tokens, Slack credentials and vault entries.
+
+Every secret is decrypted first, so a secret that cannot be read aborts the
+rotation before anything changes. Secrets stored with the legacy format or
+in plain text are moved to keystore encryption, and the old TPM master key
+of the legacy format is deleted once no secret uses it.
+
+Saved standalone connections are not rotated: they are encrypted with the
+local password given to 'clonr standalone connect'. Connect again with a new
+password to change their keys.
+
+Examples:
+  clonr security rotate-key --dry-run
+  clonr security rotate-key
+  clonr security rotate-key --yes --json`,
+	Args: cobra.NoArgs,
+	RunE: runSecurityRotateKey,
+}
+
+func init() {
+	rootCmd.AddCommand(securityCmd)
+	securityCmd.AddCommand(securityRotateKeyCmd)
+
+	securityRotateKeyCmd.Flags().Bool("dry-run", false, "Show the secrets that would be re-encrypted without changing anything")
+	securityRotateKeyCmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	securityRotateKeyCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runSecurityRotateKey(cmd *cobra.Command, _ []string) error {
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	yes, _ := cmd.Flags().GetBool("yes")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if !dryRun && !yes && !jsonOutput {
+		if !promptConfirm("Rotate the encryption keys and re-encrypt all stored secrets? [y/N]: ") {
+			_, _ = fmt.Fprintln(os.Stdout, "Cancelled.")
+			return nil
+		}
+	}
+
+	report, err := core.RotateEncryptionKey(dryRun)
+	if err != nil && report == nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if encErr := enc.Encode(report); encErr != nil {
+			return encErr
+		}
+	} else {
+		printKeyRotation(report)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("%d secrets could not be re-encrypted; the old keys were kept", report.Failed)
+	}
+
+	return nil
+}
+
+// printKeyRotation prints the secrets of a key rotation followed by the totals
+func printKeyRotation(report *core.KeyRotationReport) {
+	if len(report.Items) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No stored secrets to re-encrypt.")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KIND\tNAME\tFORMAT\tSTATUS")
+
+	for _, item := range report.Items {
+		status := okStyle.Render("re-encrypted")
+
+		switch {
+		case report.DryRun:
+			status = dimStyle.Render("would re-encrypt")
+		case item.Error != "":
+			status = errStyle.Render(item.Error)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Kind, item.Name, item.Format, status)
+	}
+
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	if report.DryRun {
+		_, _ = fmt.Fprintf(os.Stdout, "Dry run: %d secrets in %d scopes (%s) would be re-encrypted\n",
+			report.Rotated, len(report.Scopes), strings.Join(report.Scopes, ", "))
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Rotated keys of %d scopes: %d re-encrypted, %d failed\n",
+		len(report.Scopes), report.Rotated, report.Failed)
+
+	if report.LegacyKeyDeleted {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Deleted the old TPM master key"))
+	}
+}
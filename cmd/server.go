@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"log/slog"
@@ -10,31 +11,56 @@ import (
 	"os/exec"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/inovacc/clonr/internal/actionsdb"
+	"github.com/inovacc/clonr/internal/audit"
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/process"
 	"github.com/inovacc/clonr/internal/server/grpc"
 	"github.com/inovacc/clonr/internal/server/web"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var actionsWorker *actionsdb.Worker
 var rotationScheduler *grpc.RotationScheduler
 var webServer *web.Server
 
+// credentialReminderInterval is how often the server checks for expiring credentials
+const credentialReminderInterval = 6 * time.Hour
+
+// diskBudgetCheckInterval is how often the server measures workspaces against their disk budget
+const diskBudgetCheckInterval = 6 * time.Hour
+
+// fleetReportCheckInterval is how often the server checks whether the weekly fleet report is due
+const fleetReportCheckInterval = time.Hour
+
+// gmailWatchCheckInterval is how often the server checks for due Gmail watches
+const gmailWatchCheckInterval = time.Minute
+
 var (
 	serverPort        int
 	serverIdleTimeout time.Duration
 	serverMaxRuntime  time.Duration
 	procs             = process.NewProcess()
 	serverWebPort     int
+	serverWebHost     string
 	serverNoWeb       bool
 	serverOpenBrowser bool
+	serverSlowStoreOp time.Duration
+	serverTLSCert     string
+	serverTLSKey      string
+	serverTLSClientCA string
+	serverRequireAuth bool
+	serverSocket      string
+	serverNoSocket    bool
 )
 
 var serverCmd = &cobra.Command{
@@ -53,29 +79,59 @@ By default, both gRPC and web servers are started:
 - Web server: port 8080 (configurable with --web-port)
 
 Use --no-web to disable the web server.
+Use --web-host 0.0.0.0 to serve 'clonr share' links to other machines;
+remote clients can only open share links, not the rest of the web UI.
 Use --open-browser to auto-open the web UI in your browser.
+Database operations slower than --slow-store-threshold are logged; timings
+are served at /metrics and shown by 'clonr doctor db --perf'.
 
 The server will shutdown when any of these conditions are met:
 - Interrupted with Ctrl+C or SIGTERM
 - Idle timeout reached (default: 5 minutes of no requests)
 - Max runtime reached (default: 1 hour)
 
-Use --idle-timeout=0 and --max-runtime=0 to run indefinitely.`,
-	RunE: runServerStart,
+Use --idle-timeout=0 and --max-runtime=0 to run indefinitely.
+
+The gRPC server is plaintext and trusts every client by default, which is
+only safe on a single machine. To serve clients on other machines:
+- --tls-cert/--tls-key serve gRPC over TLS; without them, the certificate
+  set with 'clonr configure' is used
+- --tls-client-ca verifies client certificates against a CA (mTLS)
+- --require-auth makes remote clients present a verified client certificate
+  or an API token ('clonr server token create'); local clients are trusted
+
+Local clients connect over a unix socket next to server.json (also on
+Windows 10 and later), which only the current user can open. Use --socket
+to choose its path or --no-socket to serve TCP only.`,
+	Annotations: map[string]string{exclusiveStoreAnnotation: ""},
+	RunE:        runServerStart,
 }
 
 var serverStopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Stop the running gRPC server",
-	Long:  `Stop the Clonr gRPC server by sending a termination signal to the running process.`,
-	RunE:  runServerStop,
+	Long: `Stop the Clonr gRPC server gracefully: it finishes in-flight requests,
+stops the web server and background workers, and removes server.json.
+
+The server is asked to stop over gRPC, which also works on Windows; when it
+does not answer, it is sent a termination signal instead.`,
+	RunE: runServerStop,
 }
 
 var serverRestartCmd = &cobra.Command{
 	Use:   "restart",
 	Short: "Restart the gRPC server",
-	Long:  `Restart the Clonr gRPC server by stopping the current instance and starting a new one.`,
-	RunE:  runServerRestart,
+	Long: `Restart the Clonr gRPC server by stopping the current instance and starting a new one.
+
+Without options, the server restarts with the options it was started with.
+Pass options (the same as 'clonr server start') to restart with a new
+configuration instead.
+
+Examples:
+  clonr server restart
+  clonr server restart --port 50052 --idle-timeout 0`,
+	Annotations: map[string]string{exclusiveStoreAnnotation: ""},
+	RunE:        runServerRestart,
 }
 
 var serverStatusCmd = &cobra.Command{
@@ -99,25 +155,41 @@ func init() {
 
 	serverStartCmd.Flags().IntVarP(&serverPort, "port", "p", 50051, "gRPC server port")
 	serverStartCmd.Flags().IntVar(&serverWebPort, "web-port", 8080, "Web server port")
+	serverStartCmd.Flags().StringVar(&serverWebHost, "web-host", "127.0.0.1", "Web server listen address")
 	serverStartCmd.Flags().BoolVar(&serverNoWeb, "no-web", false, "Disable web server")
 	serverStartCmd.Flags().BoolVar(&serverOpenBrowser, "open-browser", false, "Auto-open browser when web server starts")
 	serverStartCmd.Flags().DurationVar(&serverIdleTimeout, "idle-timeout", 5*time.Minute, "Shutdown after being idle for this duration (0 to disable)")
 	serverStartCmd.Flags().DurationVar(&serverMaxRuntime, "max-runtime", 1*time.Hour, "Maximum server runtime before auto-shutdown (0 to disable)")
+	serverStartCmd.Flags().DurationVar(&serverSlowStoreOp, "slow-store-threshold", store.DefaultSlowThreshold, "Log database operations slower than this (0 to disable)")
+	serverStartCmd.Flags().StringVar(&serverTLSCert, "tls-cert", "", "TLS certificate file for the gRPC server")
+	serverStartCmd.Flags().StringVar(&serverTLSKey, "tls-key", "", "TLS private key file for the gRPC server")
+	serverStartCmd.Flags().StringVar(&serverTLSClientCA, "tls-client-ca", "", "CA bundle to verify client certificates against (mTLS)")
+	serverStartCmd.Flags().BoolVar(&serverRequireAuth, "require-auth", false, "Require a client certificate or API token from remote clients")
+	serverStartCmd.Flags().StringVar(&serverSocket, "socket", "", "Unix socket path for local clients (default: clonr.sock in the local data directory)")
+	serverStartCmd.Flags().BoolVar(&serverNoSocket, "no-socket", false, "Do not listen on a unix socket")
 
 	serverStopCmd.Flags().DurationVar(&stopTimeout, "timeout", 30*time.Second, "Timeout waiting for server to stop")
 
 	serverRestartCmd.Flags().IntVarP(&serverPort, "port", "p", 50051, "gRPC server port")
 	serverRestartCmd.Flags().IntVar(&serverWebPort, "web-port", 8080, "Web server port")
+	serverRestartCmd.Flags().StringVar(&serverWebHost, "web-host", "127.0.0.1", "Web server listen address")
 	serverRestartCmd.Flags().BoolVar(&serverNoWeb, "no-web", false, "Disable web server")
 	serverRestartCmd.Flags().BoolVar(&serverOpenBrowser, "open-browser", false, "Auto-open browser when web server starts")
 	serverRestartCmd.Flags().DurationVar(&serverIdleTimeout, "idle-timeout", 5*time.Minute, "Shutdown after being idle for this duration (0 to disable)")
 	serverRestartCmd.Flags().DurationVar(&serverMaxRuntime, "max-runtime", 1*time.Hour, "Maximum server runtime before auto-shutdown (0 to disable)")
+	serverRestartCmd.Flags().DurationVar(&serverSlowStoreOp, "slow-store-threshold", store.DefaultSlowThreshold, "Log database operations slower than this (0 to disable)")
+	serverRestartCmd.Flags().StringVar(&serverTLSCert, "tls-cert", "", "TLS certificate file for the gRPC server")
+	serverRestartCmd.Flags().StringVar(&serverTLSKey, "tls-key", "", "TLS private key file for the gRPC server")
+	serverRestartCmd.Flags().StringVar(&serverTLSClientCA, "tls-client-ca", "", "CA bundle to verify client certificates against (mTLS)")
+	serverRestartCmd.Flags().BoolVar(&serverRequireAuth, "require-auth", false, "Require a client certificate or API token from remote clients")
+	serverRestartCmd.Flags().StringVar(&serverSocket, "socket", "", "Unix socket path for local clients (default: clonr.sock in the local data directory)")
+	serverRestartCmd.Flags().BoolVar(&serverNoSocket, "no-socket", false, "Do not listen on a unix socket")
 	serverRestartCmd.Flags().DurationVar(&restartTimeout, "timeout", 30*time.Second, "Timeout waiting for server to stop before restart")
-}
 
-func runServerStart(cmd *cobra.Command, args []string) error {
-	_, _ = cmd, args
+	serverStatusCmd.Flags().Bool("json", false, "Output as JSON")
+}
 
+func runServerStart(cmd *cobra.Command, _ []string) error {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
 	// Check if the server is already running - silent abort if so
@@ -125,8 +197,33 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	store.DefaultMetrics().SetSlowThreshold(serverSlowStoreOp)
+
 	db := store.GetDB()
 
+	// Without --tls-cert, serve the certificate set with 'clonr configure'
+	if serverTLSCert == "" && serverTLSKey == "" && serverTLSClientCA == "" {
+		if cfg, err := db.GetConfig(); err == nil {
+			serverTLSCert, serverTLSKey, serverTLSClientCA = cfg.ServerTLS.CertFile, cfg.ServerTLS.KeyFile, cfg.ServerTLS.ClientCAFile
+		}
+	}
+
+	security, err := grpc.NewSecurityConfig(serverTLSCert, serverTLSKey, serverTLSClientCA, serverRequireAuth)
+	if err != nil {
+		return err
+	}
+
+	if security.RequireAuth && security.TLS == nil {
+		log.Printf("Warning: --require-auth without --tls-cert; remote clients cannot send API tokens in plaintext")
+	}
+
+	initOnce.Do(func() {
+		tpm.SetDBStore(db)
+	})
+
+	// Secrets decrypted by the web server are attributed to the server process
+	audit.SetRole("server")
+
 	// Use configured port if default not overridden
 	if serverPort == 50051 {
 		cfg, err := db.GetConfig()
@@ -149,7 +246,22 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 		log.Printf("Server info written to local data directory")
 	}
 
-	srvWithHealth := grpc.NewServer(db, serverIdleTimeout)
+	if err := grpc.SetServerFlags(serverStartFlags(cmd)); err != nil {
+		log.Printf("Warning: failed to record server options: %v", err)
+	}
+
+	socketLis := listenServerSocket()
+
+	if security.TLS != nil {
+		if err := grpc.SetServerTLSCert(serverTLSCert); err != nil {
+			log.Printf("Warning: failed to record TLS certificate: %v", err)
+		}
+
+		log.Printf("gRPC server serving TLS (client certificates verified: %t, auth required: %t)",
+			security.TLS.ClientCAs != nil, security.RequireAuth)
+	}
+
+	srvWithHealth := grpc.NewServer(db, serverIdleTimeout, security)
 
 	// Start idle tracker if enabled
 	if srvWithHealth.IdleTracker.IsEnabled() {
@@ -178,6 +290,19 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	if socketLis != nil {
+		go func() {
+			log.Printf("Serving local clients on unix socket %s", socketLis.Addr())
+
+			if err := srvWithHealth.GRPCServer.Serve(socketLis); err != nil {
+				log.Printf("Unix socket server error: %v", err)
+			}
+		}()
+	}
+
+	// Serve standalone syncs to other machines
+	stopStandaloneServer := startStandaloneServer(db, security)
+
 	// Create cancellable context for web server
 	webCtx, webCancel := context.WithCancel(context.Background())
 	defer webCancel()
@@ -186,7 +311,7 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 	if !serverNoWeb {
 		webConfig := web.Config{
 			Port:        serverWebPort,
-			Host:        "127.0.0.1",
+			Host:        serverWebHost,
 			OpenBrowser: serverOpenBrowser,
 		}
 
@@ -202,7 +327,11 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 				}
 			}()
 
-			log.Printf("Web server starting on http://127.0.0.1:%d", serverWebPort)
+			log.Printf("Web server starting on %s", webServer.Address())
+
+			if err := grpc.SetServerWebAddress(webServer.Address()); err != nil {
+				log.Printf("Warning: failed to record web server address: %v", err)
+			}
 		}
 	}
 
@@ -214,6 +343,18 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 	// Start key rotation scheduler
 	startRotationScheduler(db)
 
+	// Start credential expiry reminders
+	go runCredentialReminders(webCtx, db)
+
+	// Start workspace disk budget monitor
+	go runDiskBudgetMonitor(webCtx, db)
+
+	// Start weekly fleet report
+	go runFleetReports(webCtx, db)
+
+	// Start polling Gmail watches
+	go runGmailWatches(webCtx, db)
+
 	// Wait for a shutdown signal (OS signal, idle timeout, or max runtime)
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -221,6 +362,8 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 	select {
 	case <-quit:
 		log.Println("Received shutdown signal...")
+	case <-srvWithHealth.Service.ShutdownRequested():
+		log.Println("Shutdown requested by a client...")
 	case <-srvWithHealth.IdleTracker.ShutdownChan():
 		log.Printf("Server idle for %v, shutting down...", serverIdleTimeout)
 	case <-maxRuntimeChan:
@@ -245,11 +388,17 @@ func runServerStart(cmd *cobra.Command, args []string) error {
 	// Stop actions worker
 	stopActionsWorker()
 
+	// Stop standalone sync service
+	stopStandaloneServer()
+
 	log.Println("Shutting down server...")
 
 	// Set health status to NOT_SERVING before shutdown (per guide)
 	srvWithHealth.HealthServer.SetServingStatus("", 2) // 2 = NOT_SERVING
 
+	// End long-lived watch streams so GracefulStop does not wait on them
+	srvWithHealth.Service.StopWatchers()
+
 	// Start graceful stop with timeout (per guide)
 	stopChan := make(chan struct{})
 
@@ -281,16 +430,8 @@ func runServerStop(_ *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	_, _ = fmt.Fprintf(os.Stdout, "Stopping server (PID: %d)...\n", info.PID)
-
-	// Send termination signal to the process
-	if err := terminateProcess(info.PID); err != nil {
-		return fmt.Errorf("failed to stop server: %w", err)
-	}
-
-	// Wait for the process to exit
-	if err := waitForProcessExit(info.PID, stopTimeout); err != nil {
-		return fmt.Errorf("server did not stop within timeout: %w", err)
+	if err := stopServer(info, stopTimeout); err != nil {
+		return err
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "Server stopped successfully")
@@ -303,18 +444,15 @@ func runServerRestart(cmd *cobra.Command, args []string) error {
 
 	// If the server is running, stop it first
 	if info != nil {
-		_, _ = fmt.Fprintf(os.Stdout, "Stopping server (PID: %d)...\n", info.PID)
-
-		if err := terminateProcess(info.PID); err != nil {
-			return fmt.Errorf("failed to stop server: %w", err)
-		}
-
-		// Wait for the process to exit
-		if err := waitForProcessExit(info.PID, restartTimeout); err != nil {
-			return fmt.Errorf("server did not stop within timeout: %w", err)
+		if err := stopServer(info, restartTimeout); err != nil {
+			return err
 		}
 
 		_, _ = fmt.Fprintln(os.Stdout, "Server stopped")
+
+		if err := reuseServerFlags(cmd, info.Flags); err != nil {
+			return err
+		}
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "Starting server...")
@@ -323,8 +461,21 @@ func runServerRestart(cmd *cobra.Command, args []string) error {
 	return runServerStart(cmd, args)
 }
 
-func runServerStatus(_ *cobra.Command, _ []string) error {
+func runServerStatus(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
 	info := grpc.IsServerRunning()
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(struct {
+			Running bool             `json:"running"`
+			Server  *grpc.ServerInfo `json:"server,omitempty"`
+		}{Running: info != nil, Server: info})
+	}
+
 	if info == nil {
 		_, _ = fmt.Fprintln(os.Stdout, "Server status: stopped")
 		return nil
@@ -332,10 +483,87 @@ func runServerStatus(_ *cobra.Command, _ []string) error {
 
 	_, _ = fmt.Fprintln(os.Stdout, "Server status: running")
 	_, _ = fmt.Fprintf(os.Stdout, "  Address: %s\n", info.Address)
+
+	if info.Socket != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "  Socket: %s\n", info.Socket)
+	}
+
+	if info.WebAddress != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "  Web: %s\n", info.WebAddress)
+	}
+
+	if info.TLSCertFile != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "  TLS: %s\n", info.TLSCertFile)
+	}
+
 	_, _ = fmt.Fprintf(os.Stdout, "  PID: %d\n", info.PID)
 	_, _ = fmt.Fprintf(os.Stdout, "  Started: %s\n", info.StartedAt.Format(time.RFC3339))
 	_, _ = fmt.Fprintf(os.Stdout, "  Uptime: %s\n", time.Since(info.StartedAt).Round(time.Second))
 
+	if len(info.Flags) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "  Options: %s\n", strings.Join(info.Flags, " "))
+	}
+
+	return nil
+}
+
+// stopServer asks the server to stop gracefully over gRPC, falling back to
+// a termination signal, waits for it to exit and removes its server.json
+// when it did not
+func stopServer(info *grpc.ServerInfo, timeout time.Duration) error {
+	_, _ = fmt.Fprintf(os.Stdout, "Stopping server (PID: %d)...\n", info.PID)
+
+	addr := info.Address
+	if info.Socket != "" {
+		addr = "unix:" + info.Socket
+	}
+
+	if err := clientgrpc.RequestShutdown(addr); err != nil {
+		if err := terminateProcess(info.PID); err != nil {
+			return fmt.Errorf("failed to stop server: %w", err)
+		}
+	}
+
+	// Wait for the process to exit
+	if err := waitForProcessExit(info.PID, timeout); err != nil {
+		return fmt.Errorf("server did not stop within timeout: %w", err)
+	}
+
+	// A server that was killed leaves its server info behind
+	if current, err := grpc.ReadServerInfo(); err == nil && current.PID == info.PID {
+		grpc.RemoveServerInfo()
+	}
+
+	return nil
+}
+
+// serverStartFlags returns the server options set on cmd, leaving out the
+// stop timeout of 'clonr server restart'
+func serverStartFlags(cmd *cobra.Command) []string {
+	var flags []string
+
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if f.Changed && f.Name != "timeout" {
+			flags = append(flags, "--"+f.Name+"="+f.Value.String())
+		}
+	})
+
+	return flags
+}
+
+// reuseServerFlags applies the options the previous server was started
+// with, unless server options were passed to restart
+func reuseServerFlags(cmd *cobra.Command, previous []string) error {
+	if len(previous) == 0 || len(serverStartFlags(cmd)) > 0 {
+		return nil
+	}
+
+	if err := cmd.Flags().Parse(previous); err != nil {
+		return fmt.Errorf("failed to reuse the previous server options: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Reusing options: %s\n", strings.Join(previous, " "))
+
 	return nil
 }
 
@@ -411,6 +639,11 @@ func startActionsWorker() error {
 	config := actionsdb.DefaultWorkerConfig()
 	actionsWorker = actionsdb.NewWorker(actionsDB, tokenFunc, config)
 	actionsWorker.WithLogger(slog.Default())
+	actionsWorker.OnFailure(func(item *actionsdb.QueueItem) {
+		repo := fmt.Sprintf("%s/%s", item.RepoOwner, item.RepoName)
+		core.NotifyMonitorError(context.Background(), "GitHub Actions", repo,
+			fmt.Errorf("gave up checking commit %s after %d attempts: %s", item.CommitSHA, item.RetryCount, item.Error))
+	})
 
 	// Start the worker
 	ctx := context.Background()
@@ -460,7 +693,223 @@ func stopRotationScheduler() {
 	}
 }
 
+// runCredentialReminders periodically notifies about expiring credentials until ctx is cancelled
+func runCredentialReminders(ctx context.Context, db store.Store) {
+	ticker := time.NewTicker(credentialReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		sendCredentialReminders(ctx, db)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendCredentialReminders sends due credential reminders, if a notification channel is configured
+func sendCredentialReminders(ctx context.Context, db store.Store) {
+	dispatcher, err := core.NewReminderDispatcher()
+	if err != nil {
+		slog.Debug("credential reminders: failed to load notification channels", "error", err)
+		return
+	}
+
+	if dispatcher == nil {
+		return
+	}
+
+	profiles, err := db.ListProfiles()
+	if err != nil {
+		log.Printf("Warning: failed to list profiles for credential reminders: %v", err)
+		return
+	}
+
+	due, err := core.SendCredentialReminders(ctx, profiles, dispatcher, false)
+	if err != nil {
+		log.Printf("Warning: failed to send credential reminders: %v", err)
+		return
+	}
+
+	if len(due) > 0 {
+		log.Printf("Sent %d credential expiry reminder(s)", len(due))
+	}
+}
+
+// runDiskBudgetMonitor periodically warns about workspaces over their disk budget until ctx is cancelled
+func runDiskBudgetMonitor(ctx context.Context, db store.Store) {
+	ticker := time.NewTicker(diskBudgetCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		checkDiskBudgets(ctx, db)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkDiskBudgets logs and notifies workspaces that crossed a disk budget threshold
+func checkDiskBudgets(ctx context.Context, db store.Store) {
+	workspaces, err := db.ListWorkspaces()
+	if err != nil {
+		log.Printf("Warning: failed to list workspaces for disk budget check: %v", err)
+		return
+	}
+
+	repos, err := monitoredRepos(db)
+	if err != nil {
+		log.Printf("Warning: failed to list repositories for disk budget check: %v", err)
+		return
+	}
+
+	statuses := core.CheckDiskBudgets(workspaces, repos, time.Now())
+	if len(statuses) == 0 {
+		return
+	}
+
+	dispatcher, err := core.NewReminderDispatcher()
+	if err != nil {
+		slog.Debug("disk budget: failed to load notification channels", "error", err)
+	}
+
+	due, err := core.SendDiskBudgetAlerts(ctx, statuses, dispatcher)
+	if err != nil {
+		log.Printf("Warning: failed to send disk budget alerts: %v", err)
+	}
+
+	for _, s := range due {
+		log.Printf("Warning: workspace %s uses %s of its %s disk budget (%d%%)",
+			s.Workspace, core.FormatSize(s.Used), core.FormatSize(s.Budget), s.Percent)
+	}
+}
+
+// runFleetReports sends the weekly fleet report when it is due until ctx is cancelled
+func runFleetReports(ctx context.Context, db store.Store) {
+	ticker := time.NewTicker(fleetReportCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		sendFleetReport(ctx, db)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runGmailWatches polls due Gmail watches until ctx is cancelled
+func runGmailWatches(ctx context.Context, db store.Store) {
+	ticker := time.NewTicker(gmailWatchCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		core.RunGmailWatches(ctx, db)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendFleetReport sends the fleet report if it is due and a notification channel is configured
+func sendFleetReport(ctx context.Context, db store.Store) {
+	due, err := core.FleetReportDue(time.Now())
+	if err != nil {
+		log.Printf("Warning: failed to check fleet report schedule: %v", err)
+		return
+	}
+
+	if !due {
+		return
+	}
+
+	dispatcher, err := core.NewReminderDispatcher()
+	if err != nil {
+		slog.Debug("fleet report: failed to load notification channels", "error", err)
+		return
+	}
+
+	if dispatcher == nil {
+		return
+	}
+
+	repos, err := monitoredRepos(db)
+	if err != nil {
+		log.Printf("Warning: failed to list repositories for fleet report: %v", err)
+		return
+	}
+
+	report, err := core.BuildFleetReport(repos, core.DefaultFleetReportOptions())
+	if err != nil {
+		log.Printf("Warning: failed to build fleet report: %v", err)
+		return
+	}
+
+	if err := core.SendFleetReport(ctx, report, dispatcher); err != nil {
+		log.Printf("Warning: failed to send fleet report: %v", err)
+		return
+	}
+
+	log.Printf("Sent fleet report (%d repositories)", report.TotalRepos)
+}
+
+// monitoredRepos returns the repositories watched by the background
+// monitors, leaving out those on the ignore list
+func monitoredRepos(db store.Store) ([]model.Repository, error) {
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := db.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return core.FilterIgnoredRepos(repos, cfg.Ignore), nil
+}
+
 // stopWebServer stops the web server
+// startStandaloneServer serves the standalone sync service on its own port
+// when this instance is a standalone server. It returns the function
+// stopping it, which does nothing when the service did not start.
+func startStandaloneServer(db store.Store, security grpc.SecurityConfig) func() {
+	config, err := db.GetStandaloneConfig()
+	if err != nil || config == nil || !config.Enabled || !config.IsServer {
+		return func() {}
+	}
+
+	addr := fmt.Sprintf(":%d", config.SyncPort())
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Printf("Warning: standalone sync disabled: failed to listen on %s: %v", addr, err)
+		return func() {}
+	}
+
+	srv := grpc.NewStandaloneServer(db, security)
+
+	go func() {
+		log.Printf("Serving standalone sync on %s", addr)
+
+		if err := srv.Serve(lis); err != nil {
+			log.Printf("Standalone sync server error: %v", err)
+		}
+	}()
+
+	return srv.GracefulStop
+}
+
 func stopWebServer() {
 	if webServer != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -473,3 +922,35 @@ func stopWebServer() {
 		}
 	}
 }
+
+// listenServerSocket listens on the unix socket for local clients and
+// records it in the server info file. Failures are logged: clients fall
+// back to the TCP port.
+func listenServerSocket() net.Listener {
+	if serverNoSocket {
+		return nil
+	}
+
+	path := serverSocket
+	if path == "" {
+		p, err := grpc.SocketPath()
+		if err != nil {
+			log.Printf("Warning: unix socket disabled: %v", err)
+			return nil
+		}
+
+		path = p
+	}
+
+	lis, err := grpc.ListenSocket(path)
+	if err != nil {
+		log.Printf("Warning: unix socket disabled: %v", err)
+		return nil
+	}
+
+	if err := grpc.SetServerSocket(path); err != nil {
+		log.Printf("Warning: failed to record unix socket: %v", err)
+	}
+
+	return lis
+}
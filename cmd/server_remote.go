@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/spf13/cobra"
+)
+
+var remoteProfile grpc.ServerProfile
+
+var serverRemoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "Manage named servers, such as a remote team server",
+	Long: `Manage named servers the client can connect to besides the local one.
+Select one for a command with --server <name>, or for a shell with
+CLONR_SERVER_PROFILE=<name>; without either, clonr uses the local server.
+
+Connections are kept per server, and remote servers are never started on
+demand. Profiles are stored in client.json, readable only by you, since
+they can hold API tokens.
+
+Examples:
+  clonr server remote add team clonr.example.com:50051 --tls-ca team-ca.pem --token clonr_...
+  clonr list --server team
+  CLONR_SERVER_PROFILE=team clonr status
+  clonr server remote list
+  clonr server remote remove team`,
+}
+
+var serverRemoteAddCmd = &cobra.Command{
+	Use:   "add <name> <address>",
+	Short: "Add or replace a named server",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runServerRemoteAdd,
+}
+
+var serverRemoteListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List named servers",
+	Args:  cobra.NoArgs,
+	RunE:  runServerRemoteList,
+}
+
+var serverRemoteRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a named server",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runServerRemoteRemove,
+}
+
+func init() {
+	serverCmd.AddCommand(serverRemoteCmd)
+	serverRemoteCmd.AddCommand(serverRemoteAddCmd)
+	serverRemoteCmd.AddCommand(serverRemoteListCmd)
+	serverRemoteCmd.AddCommand(serverRemoteRemoveCmd)
+
+	serverRemoteAddCmd.Flags().StringVar(&remoteProfile.TLSCA, "tls-ca", "", "CA bundle to verify the server certificate")
+	serverRemoteAddCmd.Flags().StringVar(&remoteProfile.TLSCert, "tls-cert", "", "Client certificate for mTLS")
+	serverRemoteAddCmd.Flags().StringVar(&remoteProfile.TLSKey, "tls-key", "", "Client certificate key for mTLS")
+	serverRemoteAddCmd.Flags().StringVar(&remoteProfile.Token, "token", "", "API token from 'clonr server token create' on the server")
+
+	serverRemoteListCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runServerRemoteAdd(_ *cobra.Command, args []string) error {
+	profile := remoteProfile
+	profile.Address = args[1]
+
+	if profile.Token != "" && profile.TLSCA == "" && profile.TLSCert == "" {
+		return fmt.Errorf("--token requires TLS: pass --tls-ca with the CA of the server certificate")
+	}
+
+	if err := grpc.SaveServerProfile(args[0], profile); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %q (%s)\n", okStyle.Render("Saved server"), args[0], profile.Address)
+	_, _ = fmt.Fprintf(os.Stdout, "Use it with --server %s or %s=%s\n", args[0], grpc.ServerProfileEnv, args[0])
+
+	return nil
+}
+
+// remoteListEntry is a named server as listed, without its token
+type remoteListEntry struct {
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	TLS      bool   `json:"tls"`
+	MTLS     bool   `json:"mtls"`
+	HasToken bool   `json:"has_token"`
+}
+
+func runServerRemoteList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	profiles, err := grpc.ListServerProfiles()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]remoteListEntry, 0, len(profiles))
+	for name, p := range profiles {
+		entries = append(entries, remoteListEntry{
+			Name:     name,
+			Address:  p.Address,
+			TLS:      p.TLSCA != "" || p.TLSCert != "",
+			MTLS:     p.TLSCert != "",
+			HasToken: p.Token != "",
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b remoteListEntry) int { return strings.Compare(a.Name, b.Name) })
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No named servers. Add one with 'clonr server remote add <name> <address>'")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tADDRESS\tAUTH")
+
+	for _, e := range entries {
+		auth := "none"
+
+		switch {
+		case e.MTLS:
+			auth = "client certificate"
+		case e.HasToken:
+			auth = "API token"
+		case e.TLS:
+			auth = "TLS only"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", e.Name, e.Address, auth)
+	}
+
+	return w.Flush()
+}
+
+func runServerRemoteRemove(_ *cobra.Command, args []string) error {
+	if err := grpc.RemoveServerProfile(args[0]); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %q\n", okStyle.Render("Removed server"), args[0])
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+
+	"github.com/kardianos/service"
+	"github.com/spf13/cobra"
+)
+
+// serverServiceName is the name the server is registered under with the
+// service manager, shared with 'clonr service'
+const serverServiceName = "ClonrServer"
+
+var (
+	installServicePort int
+	installServiceUser bool
+	installServiceNow  bool
+	runServicePort     int
+)
+
+var serverInstallServiceCmd = &cobra.Command{
+	Use:   "install-service",
+	Short: "Start the server at boot or login",
+	Long: `Register the clonr server with the service manager of this system so it
+starts automatically and runs without idle or runtime limits:
+
+- Linux: a systemd unit (ClonrServer.service)
+- macOS: a launchd plist (LaunchDaemons, or LaunchAgents with --user)
+- Windows: a Windows service (ClonrServer)
+
+A system service starts at boot and needs administrator rights. With --user
+it is installed for the current user only and starts at login (systemd user
+units and launchd agents; not available on Windows).
+
+Examples:
+  sudo clonr server install-service --now
+  clonr server install-service --user --now
+  clonr server uninstall-service --user`,
+	Args: cobra.NoArgs,
+	RunE: runServerInstallService,
+}
+
+var serverUninstallServiceCmd = &cobra.Command{
+	Use:   "uninstall-service",
+	Short: "Stop and remove the server service",
+	Args:  cobra.NoArgs,
+	RunE:  runServerUninstallService,
+}
+
+// serverRunServiceCmd is what the service manager runs: it reports to the
+// service manager and supervises 'clonr server start'. It must not open the
+// database the server it starts needs to own.
+var serverRunServiceCmd = &cobra.Command{
+	Use:         "run-service",
+	Short:       "Run the server under the service manager",
+	Hidden:      true,
+	Args:        cobra.NoArgs,
+	Annotations: map[string]string{exclusiveStoreAnnotation: ""},
+	RunE:        runServerRunService,
+}
+
+func init() {
+	serverCmd.AddCommand(serverInstallServiceCmd)
+	serverCmd.AddCommand(serverUninstallServiceCmd)
+	serverCmd.AddCommand(serverRunServiceCmd)
+
+	serverInstallServiceCmd.Flags().IntVarP(&installServicePort, "port", "p", 50051, "gRPC server port")
+	serverInstallServiceCmd.Flags().BoolVar(&installServiceUser, "user", false, "Install for the current user, starting at login")
+	serverInstallServiceCmd.Flags().BoolVar(&installServiceNow, "now", false, "Also start the service now")
+
+	serverUninstallServiceCmd.Flags().BoolVar(&installServiceUser, "user", false, "Remove the service of the current user")
+
+	serverRunServiceCmd.Flags().IntVarP(&runServicePort, "port", "p", 50051, "gRPC server port")
+}
+
+// newServerService returns the clonr server service for the service manager
+func newServerService(port int, user bool) (service.Service, error) {
+	if user && runtime.GOOS == "windows" {
+		return nil, fmt.Errorf("--user is not supported on Windows; run an elevated shell instead")
+	}
+
+	svcConfig := &service.Config{
+		Name:        serverServiceName,
+		DisplayName: "Clonr Repository Server",
+		Description: "Clonr gRPC server for managing Git repository metadata",
+		Arguments:   []string{"server", "run-service", "--port", strconv.Itoa(port)},
+		Option: service.KeyValue{
+			"UserService": user,
+			"RunAtLoad":   true,
+		},
+	}
+
+	s, err := service.New(&program{port: port}, svcConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service: %w", err)
+	}
+
+	return s, nil
+}
+
+func runServerInstallService(_ *cobra.Command, _ []string) error {
+	s, err := newServerService(installServicePort, installServiceUser)
+	if err != nil {
+		return err
+	}
+
+	if err := installService(s); err != nil {
+		if !installServiceUser && runtime.GOOS != "windows" {
+			return fmt.Errorf("%w\n\nInstalling a system service needs root; use sudo, or --user to install it for yourself", err)
+		}
+
+		return err
+	}
+
+	if !installServiceNow {
+		return nil
+	}
+
+	return startService(s)
+}
+
+func runServerUninstallService(_ *cobra.Command, _ []string) error {
+	s, err := newServerService(installServicePort, installServiceUser)
+	if err != nil {
+		return err
+	}
+
+	return uninstallService(s)
+}
+
+func runServerRunService(_ *cobra.Command, _ []string) error {
+	s, err := newServerService(runServicePort, false)
+	if err != nil {
+		return err
+	}
+
+	logger, err := s.Logger(nil)
+	if err == nil {
+		serviceLogger = logger
+	}
+
+	if err := s.Run(); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "service failed: %v\n", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/params"
+	sshserver "github.com/inovacc/clonr/internal/server/ssh"
+	"github.com/spf13/cobra"
+)
+
+const (
+	sshAuthorizedKeysFile = "ssh_authorized_keys"
+	sshHostKeyFile        = "ssh_host_ed25519_key"
+)
+
+var (
+	sshPort           int
+	sshHost           string
+	sshAuthorizedKeys string
+)
+
+var serverSSHCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "Serve the read-only repository catalog over SSH",
+	Long: `Serve the repository list TUI to teammates over SSH, so they can browse
+the catalog from anywhere without installing clonr:
+
+  ssh -p 2222 clonr.example.com
+
+Guests can browse, filter, group and sort repositories and switch saved
+views. The session is read-only: selecting a repository shows its clone
+command instead of acting on it.
+
+Only public keys listed in the authorized keys file (authorized_keys
+format, one key per line) can connect. The default file is
+ssh_authorized_keys in the clonr config directory; it is re-read for
+every connection. A host key is generated on first start.
+
+The clonr server must be running; the SSH server runs in the foreground
+until interrupted.
+
+Examples:
+  clonr server ssh
+  clonr server ssh --port 2222 --host 0.0.0.0
+  clonr server ssh --authorized-keys ~/.ssh/team_keys`,
+	Args: cobra.NoArgs,
+	RunE: runServerSSH,
+}
+
+func init() {
+	serverCmd.AddCommand(serverSSHCmd)
+
+	serverSSHCmd.Flags().IntVarP(&sshPort, "port", "p", 2222, "SSH server port")
+	serverSSHCmd.Flags().StringVar(&sshHost, "host", "0.0.0.0", "SSH server listen address")
+	serverSSHCmd.Flags().StringVar(&sshAuthorizedKeys, "authorized-keys", "", "Authorized keys file (default: ssh_authorized_keys in the config directory)")
+}
+
+func runServerSSH(_ *cobra.Command, _ []string) error {
+	keysPath := sshAuthorizedKeys
+	if keysPath == "" {
+		keysPath = filepath.Join(params.AppdataDir, sshAuthorizedKeysFile)
+	}
+
+	keys, err := sshserver.LoadAuthorizedKeys(keysPath)
+	if err != nil || len(keys) == 0 {
+		return fmt.Errorf("no authorized keys in %s: add your teammates' public keys, one per line", keysPath)
+	}
+
+	// Fail early instead of in every guest session
+	if _, err := clientgrpc.GetClient(); err != nil {
+		return err
+	}
+
+	srv, err := sshserver.New(sshserver.Config{
+		Host:               sshHost,
+		Port:               sshPort,
+		HostKeyPath:        filepath.Join(params.AppdataDir, sshHostKeyFile),
+		AuthorizedKeysPath: keysPath,
+	}, func() (tea.Model, error) {
+		m, err := cli.NewGuestRepoList()
+		return m, err
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("SSH server listening on %s (%d authorized keys in %s)", srv.Address(), len(keys), keysPath)
+
+	return srv.Start(ctx)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// newRestartTestCmd returns a command with a subset of the restart options
+func newRestartTestCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "restart"}
+	cmd.Flags().Int("port", 50051, "")
+	cmd.Flags().Bool("no-web", false, "")
+	cmd.Flags().Duration("timeout", 30*time.Second, "")
+
+	return cmd
+}
+
+func TestReuseServerFlags(t *testing.T) {
+	previous := []string{"--port=50052", "--no-web=true"}
+
+	cmd := newRestartTestCmd()
+	if err := cmd.Flags().Set("timeout", "5s"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reuseServerFlags(cmd, previous); err != nil {
+		t.Fatalf("reuseServerFlags() error = %v", err)
+	}
+
+	if port, _ := cmd.Flags().GetInt("port"); port != 50052 {
+		t.Errorf("port = %d after reusing %v, want 50052", port, previous)
+	}
+
+	// Flags are visited in name order
+	want := []string{"--no-web=true", "--port=50052"}
+	if got := serverStartFlags(cmd); !slices.Equal(got, want) {
+		t.Errorf("serverStartFlags() = %v, want %v", got, want)
+	}
+}
+
+func TestReuseServerFlagsNewConfig(t *testing.T) {
+	cmd := newRestartTestCmd()
+	if err := cmd.Flags().Set("port", "50053"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reuseServerFlags(cmd, []string{"--port=50052", "--no-web=true"}); err != nil {
+		t.Fatalf("reuseServerFlags() error = %v", err)
+	}
+
+	if noWeb, _ := cmd.Flags().GetBool("no-web"); noWeb {
+		t.Error("previous options were reused although new ones were passed")
+	}
+
+	if port, _ := cmd.Flags().GetInt("port"); port != 50053 {
+		t.Errorf("port = %d, want the new 50053", port)
+	}
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var tokenExpires time.Duration
+
+var serverTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage API tokens of remote clients",
+	Long: `Manage the API tokens that authenticate clients on other machines when the
+server runs with --require-auth. Tokens are stored hashed: a token is shown
+once when it is created and cannot be recovered later.
+
+Remote clients send the token set in CLONR_TOKEN, and only over TLS, so
+they also need CLONR_TLS_CA to verify the server certificate. Tokens can
+only be managed on the server machine.
+
+Examples:
+  clonr server token create laptop
+  clonr server token create ci --expires 720h
+  clonr server token list
+  clonr server token revoke laptop`,
+}
+
+var serverTokenCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create an API token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runServerTokenCreate,
+}
+
+var serverTokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List API tokens",
+	Args:  cobra.NoArgs,
+	RunE:  runServerTokenList,
+}
+
+var serverTokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <name>",
+	Short: "Revoke an API token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runServerTokenRevoke,
+}
+
+func init() {
+	serverCmd.AddCommand(serverTokenCmd)
+	serverTokenCmd.AddCommand(serverTokenCreateCmd)
+	serverTokenCmd.AddCommand(serverTokenListCmd)
+	serverTokenCmd.AddCommand(serverTokenRevokeCmd)
+
+	serverTokenCreateCmd.Flags().DurationVar(&tokenExpires, "expires", 0, "How long the token stays valid (0 for no expiry)")
+	serverTokenListCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runServerTokenCreate(_ *cobra.Command, args []string) error {
+	secret, token, err := core.CreateAPIToken(args[0], tokenExpires)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, secret)
+	_, _ = fmt.Fprintf(os.Stderr, "%s %q (%s)\n", okStyle.Render("Created API token"), token.Name, tokenExpiry(token.ExpiresAt))
+	_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render("Copy it now: it cannot be shown again"))
+
+	return nil
+}
+
+func runServerTokenList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	tokens, err := core.ListAPITokens()
+	if err != nil {
+		return err
+	}
+
+	// Hashes are of no use outside the server
+	for i := range tokens {
+		tokens[i].Hash = ""
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(tokens)
+	}
+
+	if len(tokens) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No API tokens. Create one with 'clonr server token create <name>'")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tCREATED\tEXPIRES\tLAST USED")
+
+	now := time.Now()
+
+	for _, t := range tokens {
+		expires := tokenExpiry(t.ExpiresAt)
+		if t.Expired(now) {
+			expires = errStyle.Render("expired")
+		}
+
+		lastUsed := "never"
+		if !t.LastUsedAt.IsZero() {
+			lastUsed = formatAge(t.LastUsedAt)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Name, formatAge(t.CreatedAt), expires, lastUsed)
+	}
+
+	return w.Flush()
+}
+
+func runServerTokenRevoke(_ *cobra.Command, args []string) error {
+	if err := core.RevokeAPIToken(args[0]); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %q\n", okStyle.Render("Revoked API token"), args[0])
+
+	return nil
+}
+
+// tokenExpiry describes when a token expires
+func tokenExpiry(expiresAt time.Time) string {
+	if expiresAt.IsZero() {
+		return "never expires"
+	}
+
+	return "expires " + expiresAt.Format("Jan 02 2006 15:04")
+}
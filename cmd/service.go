@@ -5,8 +5,10 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"time"
 
 	"github.com/inovacc/clonr/internal/application"
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/kardianos/service"
 	"github.com/spf13/cobra"
 )
@@ -40,38 +42,64 @@ func init() {
 	serviceCmd.Flags().IntVarP(&servicePort, "port", "p", 50051, "Port for the server to listen on")
 }
 
+// serviceLogger reports errors of the server run by the service manager
+var serviceLogger service.Logger = service.ConsoleLogger
+
 // Program implements the service.Interface
 type program struct {
 	port int
+	cmd  *exec.Cmd
+	done chan struct{}
 }
 
 func (p *program) Start(s service.Service) error {
 	// Start should not block. Do the actual work async.
+	clonrPath, err := findClonrExecutable()
+	if err != nil {
+		return fmt.Errorf("failed to find clonr: %w", err)
+	}
+
+	// Services run until the service manager stops them
+	args := []string{"server", "start", "--port", fmt.Sprintf("%d", p.port), "--idle-timeout", "0", "--max-runtime", "0"}
+	p.cmd = exec.Command(clonrPath, args...)
+	p.cmd.Stdout = os.Stdout
+	p.cmd.Stderr = os.Stderr
+
+	if err := p.cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start server: %w", err)
+	}
+
+	p.done = make(chan struct{})
+
 	go p.run()
+
 	return nil
 }
 
 func (p *program) run() {
-	// This is where the actual service work happens
-	// Execute: clonr server start --port <port>
-	clonrPath, err := findClonrExecutable()
-	if err != nil {
-		_ = service.ConsoleLogger.Errorf("Failed to find clonr: %v", err)
-		return
-	}
+	defer close(p.done)
 
-	args := []string{"server", "start", "--port", fmt.Sprintf("%d", p.port), "--idle-timeout", "0"}
-	cmd := exec.Command(clonrPath, args...)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
-		_ = service.ConsoleLogger.Errorf("Server exited with error: %v", err)
+	if err := p.cmd.Wait(); err != nil {
+		_ = serviceLogger.Errorf("Server exited with error: %v", err)
 	}
 }
 
 func (p *program) Stop(s service.Service) error {
-	// Stop should not block. Return with a few seconds.
+	if p.cmd == nil || p.done == nil {
+		return nil
+	}
+
+	// Let the server drain in-flight requests, then make sure it is gone
+	if err := clientgrpc.RequestShutdown(fmt.Sprintf("localhost:%d", p.port)); err != nil {
+		_ = p.cmd.Process.Kill()
+	}
+
+	select {
+	case <-p.done:
+	case <-time.After(30 * time.Second):
+		_ = p.cmd.Process.Kill()
+	}
+
 	return nil
 }
 
@@ -106,25 +134,25 @@ func runService(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("please specify only one operation at a time")
 	}
 
-	// Setup service configuration
-	svcConfig := &service.Config{
-		Name:        "ClonrServer",
-		DisplayName: "Clonr Repository Server",
-		Description: "Clonr gRPC server for managing Git repository metadata",
-		Arguments:   []string{"server", "start", "--port", fmt.Sprintf("%d", servicePort), "--idle-timeout", "0"},
-	}
-
-	prg := &program{port: servicePort}
-
-	s, err := service.New(prg, svcConfig)
+	s, err := newServerService(servicePort, false)
 	if err != nil {
-		return fmt.Errorf("failed to create service: %w", err)
+		return err
 	}
 
 	// Handle the requested operation
 	switch {
 	case serviceInstall:
-		return installService(s)
+		if err := installService(s); err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout, "\nTo start the service, run:")
+		_, _ = fmt.Fprintln(os.Stdout, "  clonr service --start")
+		_, _ = fmt.Fprintln(os.Stdout, "\nOr use your system's service manager:")
+		_, _ = fmt.Fprintf(os.Stdout, "  Windows: sc start %s\n", serverServiceName)
+		_, _ = fmt.Fprintf(os.Stdout, "  Linux:   sudo systemctl start %s\n", serverServiceName)
+
+		return nil
 	case serviceUninstall:
 		return uninstallService(s)
 	case serviceStart:
@@ -147,7 +175,7 @@ func installService(s service.Service) error {
 
 	_, _ = fmt.Fprintf(os.Stdout, "Installing clonr server service...\n")
 	_, _ = fmt.Fprintf(os.Stdout, "Executable: %s\n", clonrPath)
-	_, _ = fmt.Fprintf(os.Stdout, "Command: clonr server start --port %d\n", servicePort)
+	_, _ = fmt.Fprintf(os.Stdout, "Service manager: %s\n", s.Platform())
 
 	err = s.Install()
 	if err != nil {
@@ -155,11 +183,6 @@ func installService(s service.Service) error {
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "✓ Service installed successfully!")
-	_, _ = fmt.Fprintln(os.Stdout, "\nTo start the service, run:")
-	_, _ = fmt.Fprintln(os.Stdout, "  clonr service --start")
-	_, _ = fmt.Fprintln(os.Stdout, "\nOr use your system's service manager:")
-	_, _ = fmt.Fprintf(os.Stdout, "  Windows: sc start ClonrServer\n")
-	_, _ = fmt.Fprintf(os.Stdout, "  Linux:   sudo systemctl start clonr\n")
 
 	return nil
 }
@@ -189,7 +212,7 @@ func startService(s service.Service) error {
 	}
 
 	_, _ = fmt.Fprintln(os.Stdout, "✓ Service started successfully!")
-	_, _ = fmt.Fprintf(os.Stdout, "\nServer is running on port %d\n", servicePort)
+	_, _ = fmt.Fprintln(os.Stdout, "\nServer is running")
 	_, _ = fmt.Fprintln(os.Stdout, "You can now use clonr commands:")
 	_, _ = fmt.Fprintln(os.Stdout, "  clonr list")
 	_, _ = fmt.Fprintln(os.Stdout, "  clonr clone https://github.com/user/repo")
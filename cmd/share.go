@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/server/grpc"
+	"github.com/inovacc/clonr/internal/standalone"
+	"github.com/spf13/cobra"
+)
+
+var (
+	shareExpires time.Duration
+	shareNote    string
+	shareBaseURL string
+)
+
+var shareCmd = &cobra.Command{
+	Use:   "share [repo]",
+	Short: "Create a one-time link showing repository information",
+	Long: `Create a signed, one-time link that shows read-only information about a
+repository: its URL, checked out and default branch, your notes and setup
+instructions. Send it to a teammate who has no access to your clonr server.
+
+The link is served by the web server of 'clonr server start' and can be
+opened once before it expires. To make it reachable from other machines,
+start the server with --web-host 0.0.0.0; other machines can then open
+share links but not the rest of the web UI.
+
+The repository defaults to the one containing the current directory.
+
+Examples:
+  clonr share api
+  clonr share api --expires 2h
+  clonr share api --note "Ask #backend for the .env file"
+  clonr share api --base-url https://clonr.example.com`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runShare,
+}
+
+func init() {
+	rootCmd.AddCommand(shareCmd)
+
+	shareCmd.Flags().DurationVar(&shareExpires, "expires", core.DefaultShareTTL, "How long the link stays valid")
+	shareCmd.Flags().StringVarP(&shareNote, "note", "m", "", "Note shown with the repository information")
+	shareCmd.Flags().StringVar(&shareBaseURL, "base-url", "", "Base URL of the web server (default: address of the running server)")
+}
+
+func runShare(_ *cobra.Command, args []string) error {
+	if shareExpires <= 0 {
+		return fmt.Errorf("--expires must be positive")
+	}
+
+	baseURL, err := shareServerURL()
+	if err != nil {
+		return err
+	}
+
+	repo, err := core.ResolveRepo(argOrEmpty(args, 0))
+	if err != nil {
+		return err
+	}
+
+	link, err := core.CreateShareLink(*repo, shareNote, shareExpires)
+	if err != nil {
+		return err
+	}
+
+	shareURL, err := core.ShareURL(baseURL, link)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, shareURL)
+	_, _ = fmt.Fprintf(os.Stderr, "%s %s, expires %s, can be opened once\n",
+		okStyle.Render("Share link for"), repo.URL, link.ExpiresAt.Format("Jan 02 15:04"))
+
+	if u, err := url.Parse(baseURL); err == nil {
+		if ip := net.ParseIP(u.Hostname()); u.Hostname() == "localhost" || (ip != nil && ip.IsLoopback()) {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render("The web server only listens on this machine; restart it with --web-host 0.0.0.0 to share with others"))
+		}
+	}
+
+	return nil
+}
+
+// shareServerURL returns the base URL share links point to. An unspecified
+// listen address (0.0.0.0) is replaced with this machine's LAN address.
+func shareServerURL() (string, error) {
+	if shareBaseURL != "" {
+		return shareBaseURL, nil
+	}
+
+	info := grpc.IsServerRunning()
+	if info == nil || info.WebAddress == "" {
+		return "", fmt.Errorf("the clonr web server is not running (start it with 'clonr server start'), or pass --base-url")
+	}
+
+	u, err := url.Parse(info.WebAddress)
+	if err != nil {
+		return "", fmt.Errorf("invalid web server address %q: %w", info.WebAddress, err)
+	}
+
+	if ip := net.ParseIP(u.Hostname()); ip != nil && ip.IsUnspecified() {
+		host, err := standalone.GetLocalIP()
+		if err != nil {
+			return "", fmt.Errorf("could not detect local IP, use --base-url: %w", err)
+		}
+
+		u.Host = net.JoinHostPort(host, u.Port())
+	}
+
+	return u.String(), nil
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var shellInitCmd = &cobra.Command{
+	Use:       "shell-init <bash|zsh|fish|powershell>",
+	Short:     "Print shell integration (ccd function)",
+	ValidArgs: []string{"bash", "zsh", "fish", "powershell", "pwsh"},
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	Long: `Print shell integration for your shell.
+
+Defines a 'ccd' function that opens the fuzzy repository finder and changes
+into the selected repository. Arguments are used as the initial query; if only
+one repository matches, ccd jumps there without opening the finder.
+
+Setup:
+  bash        echo 'eval "$(clonr shell-init bash)"' >> ~/.bashrc
+  zsh         echo 'eval "$(clonr shell-init zsh)"' >> ~/.zshrc
+  fish        echo 'clonr shell-init fish | source' >> ~/.config/fish/config.fish
+  powershell  Add-Content $PROFILE 'Invoke-Expression (& clonr shell-init powershell | Out-String)'
+
+Examples:
+  ccd                 # Pick a repository interactively
+  ccd api             # Jump to the repository matching "api"`,
+	RunE: runShellInit,
+}
+
+const posixShellInit = `# clonr shell integration
+ccd() {
+  local dir
+  dir="$(command clonr find -1 "$@")" || return
+  [ -n "$dir" ] && cd -- "$dir"
+}
+`
+
+const fishShellInit = `# clonr shell integration
+function ccd --description 'cd into a repository tracked by clonr'
+    set -l dir (command clonr find -1 $argv); or return
+    test -n "$dir"; and cd -- $dir
+end
+`
+
+const powershellShellInit = `# clonr shell integration
+function ccd {
+    $dir = & clonr find -1 @args
+    if ($LASTEXITCODE -eq 0 -and $dir) {
+        Set-Location -LiteralPath $dir
+    }
+}
+`
+
+func init() {
+	rootCmd.AddCommand(shellInitCmd)
+}
+
+func runShellInit(_ *cobra.Command, args []string) error {
+	script, err := shellInitScript(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprint(os.Stdout, script)
+
+	return nil
+}
+
+// shellInitScript returns the ccd integration script for the given shell
+func shellInitScript(shell string) (string, error) {
+	switch shell {
+	case "bash", "zsh":
+		return posixShellInit, nil
+	case "fish":
+		return fishShellInit, nil
+	case "powershell", "pwsh":
+		return powershellShellInit, nil
+	default:
+		return "", fmt.Errorf("unsupported shell %q (supported: bash, zsh, fish, powershell)", shell)
+	}
+}
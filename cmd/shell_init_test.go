@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShellInitScript(t *testing.T) {
+	tests := []struct {
+		shell   string
+		want    string
+		wantErr bool
+	}{
+		{shell: "bash", want: "ccd() {"},
+		{shell: "zsh", want: "ccd() {"},
+		{shell: "fish", want: "function ccd"},
+		{shell: "powershell", want: "function ccd {"},
+		{shell: "pwsh", want: "function ccd {"},
+		{shell: "tcsh", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.shell, func(t *testing.T) {
+			got, err := shellInitScript(tt.shell)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("shellInitScript(%q) error = %v, wantErr %v", tt.shell, err, tt.wantErr)
+			}
+
+			if !tt.wantErr && (!strings.Contains(got, tt.want) || !strings.Contains(got, "clonr find -1")) {
+				t.Errorf("shellInitScript(%q) = %q, want it to define ccd using clonr find", tt.shell, got)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
 	"github.com/inovacc/clonr/internal/slack"
 	"github.com/spf13/cobra"
 )
@@ -34,9 +35,15 @@ Authentication Commands:
 Operation Commands:
   channels     List Slack channels
   messages     Read messages from a channel
+  dms          List and read direct messages
+  browse       Browse channels and messages interactively
   search       Search for messages
   thread       View thread replies
   users        List workspace users
+  send         Send a message to a channel
+  reply        Reply in a message thread
+  download     Download files attached to messages
+  export       Export channel history to JSONL or Markdown
 
 Notification Commands:
   notify       Manage Slack notifications (webhooks)
@@ -47,7 +54,10 @@ Examples:
   clonr slack status
   clonr slack channels
   clonr slack messages --channel general
-  clonr slack search "deployment"`,
+  clonr slack dms alice
+  clonr slack search "deployment"
+  clonr slack send --channel dev --text "Deployed v1.2"`,
+	Annotations: map[string]string{networkAnnotation: "Slack"},
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
@@ -72,7 +82,10 @@ Prerequisites for OAuth:
   3. Add required Bot Token Scopes:
      - channels:read, channels:history
      - groups:read, groups:history
+     - im:read, im:history, mpim:read, mpim:history (for dms)
+     - files:read (for download)
      - search:read, users:read
+     - chat:write (for send and reply)
   4. Get Client ID and Client Secret from "Basic Information"
 
 Examples:
@@ -162,10 +175,32 @@ Examples:
   clonr slack messages --channel general
   clonr slack messages --channel C01234567 --limit 50
   clonr slack messages --channel dev --since 24h
+  clonr slack messages --channel @alice
   clonr slack messages --channel general --json`,
 	RunE: runSlackMessages,
 }
 
+// slackDMsCmd lists and reads direct messages
+var slackDMsCmd = &cobra.Command{
+	Use:   "dms [user[,user...]]",
+	Short: "List and read direct messages",
+	Long: `List direct messages and group direct messages, or read the
+conversation with a user.
+
+Users are named by username, display name, full name or ID. Several
+comma-separated users read the group direct message including all of them.
+Direct messages can also be read with: clonr slack messages --channel @user
+
+Examples:
+  clonr slack dms
+  clonr slack dms alice
+  clonr slack dms alice --since 24h
+  clonr slack dms alice,bob --limit 50
+  clonr slack dms --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSlackDMs,
+}
+
 // slackSearchCmd searches for messages
 var slackSearchCmd = &cobra.Command{
 	Use:   "search <query>",
@@ -223,6 +258,44 @@ Examples:
 	RunE: runSlackUsers,
 }
 
+// slackSendCmd sends a message to a channel
+var slackSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Send a message to a channel",
+	Long: `Send a message to a Slack channel as the bot.
+
+The bot token needs the chat:write scope, and the bot must be a member of
+private channels it posts to.
+
+Rich messages can be sent with Block Kit blocks and legacy attachments,
+given as JSON or as @file. With --event, the message is formatted like a
+clonr notification for a repository event; --text then overrides the
+fallback text.
+
+Examples:
+  clonr slack send --channel general --text "Deployed v1.2"
+  clonr slack send --channel C01234567 --text "Build done" --blocks @blocks.json
+  clonr slack send --channel dev --event push --repo inovacc/clonr --branch main
+  clonr slack send --channel dev --text "hello" --json`,
+	RunE: runSlackSend,
+}
+
+// slackReplyCmd replies in a message thread
+var slackReplyCmd = &cobra.Command{
+	Use:   "reply",
+	Short: "Reply in a message thread",
+	Long: `Reply to a message in its thread.
+
+Requires the channel and the parent message timestamp (ts), as shown by
+the messages, search and thread commands. Use --broadcast to also show the
+reply in the channel.
+
+Examples:
+  clonr slack reply --channel general --ts 1234567890.123456 --text "On it"
+  clonr slack reply --channel C01234567 --ts 1234567890.123456 --text "Fixed" --broadcast`,
+	RunE: runSlackReply,
+}
+
 // slackNotifyCmd manages Slack notifications (webhooks)
 var slackNotifyCmd = &cobra.Command{
 	Use:   "notify",
@@ -331,9 +404,12 @@ func init() {
 	slackCmd.AddCommand(slackAccountsCmd)
 	slackCmd.AddCommand(slackChannelsCmd)
 	slackCmd.AddCommand(slackMessagesCmd)
+	slackCmd.AddCommand(slackDMsCmd)
 	slackCmd.AddCommand(slackSearchCmd)
 	slackCmd.AddCommand(slackThreadCmd)
 	slackCmd.AddCommand(slackUsersCmd)
+	slackCmd.AddCommand(slackSendCmd)
+	slackCmd.AddCommand(slackReplyCmd)
 	slackCmd.AddCommand(slackNotifyCmd)
 
 	// Notify subcommands
@@ -351,7 +427,7 @@ func init() {
 	slackAddCmd.Flags().StringP("refresh-token", "r", "", "Refresh token for token rotation")
 	slackAddCmd.Flags().StringP("channel", "c", "#general", "Default channel")
 	slackAddCmd.Flags().Int("port", 8338, "Local callback server port for OAuth")
-	slackAddCmd.Flags().String("scopes", "", "OAuth scopes (comma-separated)")
+	slackAddCmd.Flags().String("scopes", "", "OAuth scopes to request and require (comma-separated)")
 	slackAddCmd.Flags().String("name", "slack", "Name for the Slack account")
 	slackAddCmd.Flags().StringP("profile", "p", "", "Profile to add to (default: active)")
 
@@ -373,17 +449,27 @@ func init() {
 	slackChannelsCmd.Flags().Bool("archived", false, "Include archived channels")
 	slackChannelsCmd.Flags().Int("limit", 100, "Maximum channels to return")
 	slackChannelsCmd.Flags().StringP("account", "a", "", "Slack account to use")
+	addCacheFlags(slackChannelsCmd)
 
 	// Messages flags
 	slackMessagesCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
 	slackMessagesCmd.Flags().Bool("json", false, "Output as JSON")
-	slackMessagesCmd.Flags().StringP("channel", "c", "", "Channel name or ID (required)")
+	slackMessagesCmd.Flags().StringP("channel", "c", "", "Channel name or ID, or @user for a direct message (required)")
 	slackMessagesCmd.Flags().Int("limit", 20, "Maximum messages to return")
 	slackMessagesCmd.Flags().String("since", "", "Show messages since duration (e.g., 24h, 7d)")
 	slackMessagesCmd.Flags().String("before", "", "Show messages before timestamp")
 	slackMessagesCmd.Flags().StringP("account", "a", "", "Slack account to use")
 	_ = slackMessagesCmd.MarkFlagRequired("channel")
 
+	// DMs flags
+	slackDMsCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
+	slackDMsCmd.Flags().Bool("json", false, "Output as JSON")
+	slackDMsCmd.Flags().Int("limit", 20, "Maximum messages to return")
+	slackDMsCmd.Flags().String("since", "", "Show messages since duration (e.g., 24h, 7d)")
+	slackDMsCmd.Flags().String("before", "", "Show messages before timestamp")
+	slackDMsCmd.Flags().StringP("account", "a", "", "Slack account to use")
+	addCacheFlags(slackDMsCmd)
+
 	// Search flags
 	slackSearchCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
 	slackSearchCmd.Flags().Bool("json", false, "Output as JSON")
@@ -409,6 +495,25 @@ func init() {
 	slackUsersCmd.Flags().Int("limit", 100, "Maximum users to return")
 	slackUsersCmd.Flags().StringP("account", "a", "", "Slack account to use")
 
+	// Send and reply flags
+	for _, c := range []*cobra.Command{slackSendCmd, slackReplyCmd} {
+		c.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
+		c.Flags().Bool("json", false, "Output as JSON")
+		c.Flags().StringP("channel", "c", "", "Channel name or ID (required)")
+		c.Flags().String("text", "", "Message text")
+		c.Flags().String("blocks", "", "Block Kit blocks as JSON or @file")
+		c.Flags().String("attachments", "", "Legacy attachments as JSON or @file")
+		c.Flags().String("event", "", "Format as a notification for this repository event (push, clone, pull, commit, ci-pass, ci-fail, error)")
+		c.Flags().String("repo", "", "Repository of the --event notification")
+		c.Flags().String("branch", "", "Branch of the --event notification")
+		c.Flags().StringP("account", "a", "", "Slack account to use")
+		_ = c.MarkFlagRequired("channel")
+	}
+
+	slackReplyCmd.Flags().String("ts", "", "Parent message timestamp (required)")
+	slackReplyCmd.Flags().Bool("broadcast", false, "Also show the reply in the channel")
+	_ = slackReplyCmd.MarkFlagRequired("ts")
+
 	// Notify add flags
 	slackNotifyAddCmd.Flags().String("webhook", "", "Slack webhook URL")
 	slackNotifyAddCmd.Flags().String("channel", "", "Default channel for notifications")
@@ -460,9 +565,15 @@ func runSlackAdd(cmd *cobra.Command, _ []string) error {
 
 	_, _ = fmt.Fprintf(os.Stdout, "Adding Slack to profile %q\n\n", profile.Name)
 
+	// Scopes clonr needs from the token
+	requiredScopes := core.ParseScopes(slack.DefaultScopes)
+	if scopes != "" {
+		requiredScopes = core.ParseScopes(scopes)
+	}
+
 	// If token provided directly, skip OAuth
 	if token != "" {
-		return slackAddWithToken(pm, profile, token, refreshToken, channel, accountName)
+		return slackAddWithToken(pm, profile, token, refreshToken, clientID, clientSecret, channel, accountName, requiredScopes)
 	}
 
 	// Try environment variables if flags not provided
@@ -528,6 +639,9 @@ Also configure OAuth redirect URL:
 	_, _ = fmt.Fprintf(os.Stdout, "  Scopes:     %s\n", result.Scope)
 	_, _ = fmt.Fprintln(os.Stdout, "")
 
+	grantedScopes := core.ParseScopes(result.Scope)
+	printScopeCheck(core.CheckScopes(grantedScopes, requiredScopes, nil))
+
 	// Create NotifyChannel for Slack
 	notifyChannel := &model.NotifyChannel{
 		ID:   accountName,
@@ -540,12 +654,30 @@ Also configure OAuth redirect URL:
 			"workspace_name":  result.Team.Name,
 			"bot_user_id":     result.BotUserID,
 			"app_id":          result.AppID,
+			"scopes":          strings.Join(grantedScopes, ","),
 		},
 		Enabled:   true,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	// Rotating tokens expire; store what is needed to refresh them and the
+	// expiry for reminders
+	if result.RefreshToken != "" {
+		notifyChannel.Config["refresh_token"] = result.RefreshToken
+		notifyChannel.Config["client_id"] = clientID
+		notifyChannel.Config["client_secret"] = clientSecret
+	}
+
+	if result.ExpiresIn > 0 {
+		obtainedAt := result.ObtainedAt
+		if obtainedAt.IsZero() {
+			obtainedAt = time.Now()
+		}
+
+		notifyChannel.Config[core.ChannelExpiresAtKey] = obtainedAt.Add(time.Duration(result.ExpiresIn) * time.Second).UTC().Format(time.RFC3339)
+	}
+
 	// Save to profile
 	if err := pm.AddNotifyChannel(profile.Name, notifyChannel); err != nil {
 		return fmt.Errorf("failed to save Slack credentials: %w", err)
@@ -561,7 +693,7 @@ Also configure OAuth redirect URL:
 	return nil
 }
 
-func slackAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, refreshToken, channel, accountName string) error {
+func slackAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, refreshToken, clientID, clientSecret, channel, accountName string, requiredScopes []string) error {
 	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Validating bot token..."))
 
 	// Create a temporary client to validate the token
@@ -577,8 +709,15 @@ func slackAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, r
 	_, _ = fmt.Fprintln(os.Stdout, "")
 	_, _ = fmt.Fprintf(os.Stdout, "  Workspace:  %s (%s)\n", authResult.Team, authResult.TeamID)
 	_, _ = fmt.Fprintf(os.Stdout, "  Bot User:   %s (%s)\n", authResult.User, authResult.UserID)
+
+	if authResult.Scopes != nil {
+		_, _ = fmt.Fprintf(os.Stdout, "  Scopes:     %s\n", strings.Join(authResult.Scopes, ","))
+	}
+
 	_, _ = fmt.Fprintln(os.Stdout, "")
 
+	printScopeCheck(core.CheckScopes(authResult.Scopes, requiredScopes, nil))
+
 	// Create NotifyChannel for Slack
 	config := map[string]string{
 		"bot_token":       token,
@@ -588,9 +727,19 @@ func slackAddWithToken(pm *core.ProfileManager, profile *model.Profile, token, r
 		"bot_user_id":     authResult.UserID,
 	}
 
-	// Add refresh token if provided
+	if authResult.Scopes != nil {
+		config["scopes"] = strings.Join(authResult.Scopes, ",")
+	}
+
+	// Add refresh token if provided; with the app credentials the token is
+	// rotated automatically
 	if refreshToken != "" {
 		config["refresh_token"] = refreshToken
+
+		if clientID != "" && clientSecret != "" {
+			config["client_id"] = clientID
+			config["client_secret"] = clientSecret
+		}
 	}
 
 	notifyChannel := &model.NotifyChannel{
@@ -787,7 +936,14 @@ func runSlackStatus(cmd *cobra.Command, args []string) error {
 
 	token := config["bot_token"]
 	if token != "" {
-		client := slack.NewClient(token, slack.ClientOptions{})
+		opts := slackRotationOptions(pm, profile.Name, channel.ID, config, nil)
+		saveToken := opts.OnTokenRefresh
+		opts.OnTokenRefresh = func(token *slack.OAuthResult) {
+			saveToken(token)
+			_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("Token expired; rotated and saved"))
+		}
+
+		client := slack.NewClient(token, opts)
 
 		if _, err := client.AuthTest(context.Background()); err != nil {
 			_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("Connection failed: %v", err)))
@@ -889,9 +1045,10 @@ func slackGetClient(cmd *cobra.Command) (*slack.Client, error) {
 		return slack.NewClient(tokenFlag, slack.ClientOptions{}), nil
 	}
 
-	// Try stored token
-	token, _, err := slack.ResolveSlackToken("")
-	if err == nil && token != "" {
+	// Try stored token; a token of the active profile is loaded below with
+	// its rotation credentials
+	token, source, err := slack.ResolveSlackToken("")
+	if err == nil && token != "" && !strings.HasPrefix(source, "profile:") {
 		var logger *slog.Logger
 		if jsonOutput {
 			logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
@@ -941,7 +1098,39 @@ func slackGetClient(cmd *cobra.Command) (*slack.Client, error) {
 		logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
 	}
 
-	return slack.NewClient(token, slack.ClientOptions{Logger: logger}), nil
+	return slack.NewClient(token, slackRotationOptions(pm, profile.Name, channel.ID, config, logger)), nil
+}
+
+// slackRotationOptions returns client options refreshing a rotating token
+// from a decrypted channel config and storing rotated tokens back in the
+// profile, so the next command does not need to refresh again
+func slackRotationOptions(pm *core.ProfileManager, profileName, channelID string, config map[string]string, logger *slog.Logger) slack.ClientOptions {
+	opts := slack.ClientOptions{
+		Logger:       logger,
+		RefreshToken: config["refresh_token"],
+		ClientID:     config["client_id"],
+		ClientSecret: config["client_secret"],
+		OnTokenRefresh: func(token *slack.OAuthResult) {
+			updates := map[string]string{
+				"bot_token":     token.AccessToken,
+				"refresh_token": token.RefreshToken,
+			}
+
+			if token.ExpiresIn > 0 {
+				updates[core.ChannelExpiresAtKey] = token.ObtainedAt.Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339)
+			}
+
+			if err := pm.UpdateNotifyChannelConfig(profileName, channelID, updates); err != nil {
+				_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("Failed to save rotated Slack token: %v", err)))
+			}
+		},
+	}
+
+	if expiresAt, err := time.Parse(time.RFC3339, config[core.ChannelExpiresAtKey]); err == nil {
+		opts.ExpiresAt = expiresAt
+	}
+
+	return opts
 }
 
 func runSlackChannels(cmd *cobra.Command, _ []string) error {
@@ -950,6 +1139,11 @@ func runSlackChannels(cmd *cobra.Command, _ []string) error {
 	includeArchived, _ := cmd.Flags().GetBool("archived")
 	limit, _ := cmd.Flags().GetInt("limit")
 
+	cache, err := cacheOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	client, err := slackGetClient(cmd)
 	if err != nil {
 		return err
@@ -966,11 +1160,11 @@ func runSlackChannels(cmd *cobra.Command, _ []string) error {
 	}
 
 	// List channels
-	result, err := client.ListChannels(cmd.Context(), slack.ListChannelsOptions{
+	channels, err := slackListChannels(cmd.Context(), client, slack.ListChannelsOptions{
 		Types:           types,
 		ExcludeArchived: !includeArchived,
 		Limit:           limit,
-	})
+	}, cache)
 	if err != nil {
 		return fmt.Errorf("failed to list channels: %w", err)
 	}
@@ -980,19 +1174,19 @@ func runSlackChannels(cmd *cobra.Command, _ []string) error {
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 
-		return enc.Encode(result.Channels)
+		return enc.Encode(channels)
 	}
 
-	if len(result.Channels) == 0 {
+	if len(channels) == 0 {
 		_, _ = fmt.Fprintln(os.Stdout, "No channels found")
 		return nil
 	}
 
-	_, _ = fmt.Fprintf(os.Stdout, "\nSlack Channels (%d)\n\n", len(result.Channels))
+	_, _ = fmt.Fprintf(os.Stdout, "\nSlack Channels (%d)\n\n", len(channels))
 	_, _ = fmt.Fprintf(os.Stdout, "  %-12s │ %-25s │ %-8s │ %s\n", "ID", "Name", "Members", "Topic")
 	_, _ = fmt.Fprintln(os.Stdout, "  ─────────────┼───────────────────────────┼──────────┼─────────────────────")
 
-	for _, ch := range result.Channels {
+	for _, ch := range channels {
 		name := ch.Name
 		if len(name) > 25 {
 			name = name[:22] + "..."
@@ -1018,9 +1212,6 @@ func runSlackChannels(cmd *cobra.Command, _ []string) error {
 func runSlackMessages(cmd *cobra.Command, _ []string) error {
 	outputJSON, _ := cmd.Flags().GetBool("json")
 	channel, _ := cmd.Flags().GetString("channel")
-	limit, _ := cmd.Flags().GetInt("limit")
-	since, _ := cmd.Flags().GetString("since")
-	before, _ := cmd.Flags().GetString("before")
 
 	client, err := slackGetClient(cmd)
 	if err != nil {
@@ -1033,8 +1224,24 @@ func runSlackMessages(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	label := channel
+	if !strings.HasPrefix(channel, "@") {
+		label = "#" + strings.TrimPrefix(channel, "#")
+	}
+
+	return slackShowHistory(cmd, client, channelID, label)
+}
+
+// slackShowHistory prints the messages of a conversation, read with the
+// json, limit, since and before flags of cmd
+func slackShowHistory(cmd *cobra.Command, client *slack.Client, channelID, label string) error {
+	outputJSON, _ := cmd.Flags().GetBool("json")
+	limit, _ := cmd.Flags().GetInt("limit")
+	since, _ := cmd.Flags().GetString("since")
+	before, _ := cmd.Flags().GetString("before")
+
 	if !outputJSON {
-		_, _ = fmt.Fprintf(os.Stderr, dimStyle.Render("Fetching messages from %s...\n"), channel)
+		_, _ = fmt.Fprintf(os.Stderr, dimStyle.Render("Fetching messages from %s...\n"), label)
 	}
 
 	// Build options
@@ -1063,24 +1270,10 @@ func runSlackMessages(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to get messages: %w", err)
 	}
 
-	// Fetch user info for display names
-	userCache := make(map[string]string)
-
+	// Resolve display names
+	var userNames map[string]string
 	if !outputJSON {
-		for _, msg := range result.Messages {
-			if msg.User != "" {
-				if _, ok := userCache[msg.User]; !ok {
-					if user, userErr := client.GetUser(cmd.Context(), msg.User); userErr == nil {
-						userCache[msg.User] = user.Profile.DisplayName
-						if userCache[msg.User] == "" {
-							userCache[msg.User] = user.Name
-						}
-					} else {
-						userCache[msg.User] = msg.User
-					}
-				}
-			}
-		}
+		userNames = slackUserNames(cmd.Context(), client, result.Messages)
 	}
 
 	// Output
@@ -1096,7 +1289,7 @@ func runSlackMessages(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	_, _ = fmt.Fprintf(os.Stdout, "\nMessages from #%s (%d)\n\n", channel, len(result.Messages))
+	_, _ = fmt.Fprintf(os.Stdout, "\nMessages from %s (%d)\n\n", label, len(result.Messages))
 
 	// Messages are returned newest first, reverse for chronological display
 	for i := len(result.Messages) - 1; i >= 0; i-- {
@@ -1105,7 +1298,7 @@ func runSlackMessages(cmd *cobra.Command, _ []string) error {
 		ts, _ := slack.ParseTimestamp(msg.Timestamp)
 		timeStr := ts.Format("Jan 02 15:04")
 
-		userName := userCache[msg.User]
+		userName := userNames[msg.User]
 		if userName == "" {
 			userName = msg.User
 			if msg.BotProfile != nil {
@@ -1158,6 +1351,73 @@ func runSlackMessages(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func runSlackDMs(cmd *cobra.Command, args []string) error {
+	outputJSON, _ := cmd.Flags().GetBool("json")
+
+	cache, err := cacheOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := slackGetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Read the conversation with the given users
+	if len(args) > 0 {
+		names := strings.Split(strings.TrimPrefix(args[0], "@"), ",")
+
+		channelID, err := slackResolveDM(cmd.Context(), client, names, outputJSON)
+		if err != nil {
+			return err
+		}
+
+		return slackShowHistory(cmd, client, channelID, "@"+strings.Join(names, ", @"))
+	}
+
+	if !outputJSON {
+		_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render("Fetching direct messages..."))
+	}
+
+	conversations, users, err := slackListDMs(cmd.Context(), client, cache)
+	if err != nil {
+		return err
+	}
+
+	// Output
+	if outputJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(conversations)
+	}
+
+	if len(conversations) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No direct messages found")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\nDirect Messages (%d)\n\n", len(conversations))
+	_, _ = fmt.Fprintf(os.Stdout, "  %-12s │ %-6s │ %s\n", "ID", "Type", "With")
+	_, _ = fmt.Fprintln(os.Stdout, "  ─────────────┼────────┼─────────────────────")
+
+	for _, ch := range conversations {
+		kind, with := "dm", ch.User
+		if user, ok := users[ch.User]; ok {
+			with = user.DisplayName()
+		}
+
+		if ch.IsMpIM {
+			kind, with = "group", strings.Join(ch.GroupMembers(), ", ")
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "  %-12s │ %-6s │ %s\n", ch.ID, kind, with)
+	}
+
+	return nil
+}
+
 func runSlackSearch(cmd *cobra.Command, args []string) error {
 	outputJSON, _ := cmd.Flags().GetBool("json")
 	limit, _ := cmd.Flags().GetInt("limit")
@@ -1236,6 +1496,145 @@ func runSlackSearch(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runSlackSend(cmd *cobra.Command, _ []string) error {
+	return slackPostMessage(cmd, "")
+}
+
+func runSlackReply(cmd *cobra.Command, _ []string) error {
+	threadTS, _ := cmd.Flags().GetString("ts")
+
+	return slackPostMessage(cmd, threadTS)
+}
+
+// slackPostMessage sends the message described by the send/reply flags,
+// in the thread of threadTS when set
+func slackPostMessage(cmd *cobra.Command, threadTS string) error {
+	outputJSON, _ := cmd.Flags().GetBool("json")
+	channel, _ := cmd.Flags().GetString("channel")
+	broadcast, _ := cmd.Flags().GetBool("broadcast")
+
+	client, err := slackGetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	channelID, err := slackResolveChannelID(cmd.Context(), client, channel, outputJSON)
+	if err != nil {
+		return err
+	}
+
+	opts, err := slackMessageOptions(cmd, channelID)
+	if err != nil {
+		return err
+	}
+
+	opts.ThreadTS = threadTS
+	opts.ReplyBroadcast = broadcast && threadTS != ""
+
+	result, err := client.PostMessage(cmd.Context(), opts)
+	if err != nil {
+		if strings.Contains(err.Error(), "missing_scope") {
+			return fmt.Errorf("failed to send message: %w (the bot token needs the chat:write scope)", err)
+		}
+
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	if outputJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(result)
+	}
+
+	if threadTS != "" {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Replied in thread %s of %s", threadTS, channel)))
+	} else {
+		_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Message sent to %s", channel)))
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "  ts: %s\n", result.Timestamp)
+
+	return nil
+}
+
+// slackMessageOptions builds the message from the text, blocks, attachments
+// and event flags
+func slackMessageOptions(cmd *cobra.Command, channelID string) (slack.PostMessageOptions, error) {
+	text, _ := cmd.Flags().GetString("text")
+	blocks, _ := cmd.Flags().GetString("blocks")
+	attachments, _ := cmd.Flags().GetString("attachments")
+	eventType, _ := cmd.Flags().GetString("event")
+	repo, _ := cmd.Flags().GetString("repo")
+	branch, _ := cmd.Flags().GetString("branch")
+
+	opts := slack.PostMessageOptions{Channel: channelID}
+
+	if eventType != "" {
+		event := notify.NewEvent(eventType).WithRepository(repo).WithBranch(branch)
+		formatted := notify.FormatSlackMessage(event, channelID)
+
+		opts.Text = formatted.Text
+		if len(formatted.Blocks) > 0 {
+			opts.Blocks = formatted.Blocks
+		}
+
+		if len(formatted.Attachments) > 0 {
+			opts.Attachments = formatted.Attachments
+		}
+	}
+
+	if text != "" {
+		opts.Text = text
+	}
+
+	if blocks != "" {
+		raw, err := slackReadJSONFlag("blocks", blocks)
+		if err != nil {
+			return opts, err
+		}
+
+		opts.Blocks = raw
+	}
+
+	if attachments != "" {
+		raw, err := slackReadJSONFlag("attachments", attachments)
+		if err != nil {
+			return opts, err
+		}
+
+		opts.Attachments = raw
+	}
+
+	if opts.Text == "" && opts.Blocks == nil && opts.Attachments == nil {
+		return opts, fmt.Errorf("nothing to send: use --text, --blocks, --attachments or --event")
+	}
+
+	return opts, nil
+}
+
+// slackReadJSONFlag returns the JSON array given inline or, prefixed with
+// @, in a file
+func slackReadJSONFlag(name, value string) (json.RawMessage, error) {
+	data := []byte(value)
+
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		var err error
+
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read --%s file: %w", name, err)
+		}
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("--%s must be a JSON array: %w", name, err)
+	}
+
+	return json.RawMessage(data), nil
+}
+
 func runSlackThread(cmd *cobra.Command, _ []string) error {
 	outputJSON, _ := cmd.Flags().GetBool("json")
 	channel, _ := cmd.Flags().GetString("channel")
@@ -1267,24 +1666,10 @@ func runSlackThread(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to get thread: %w", err)
 	}
 
-	// Fetch user info for display names
-	userCache := make(map[string]string)
-
+	// Resolve display names
+	var userNames map[string]string
 	if !outputJSON {
-		for _, msg := range result.Messages {
-			if msg.User != "" {
-				if _, ok := userCache[msg.User]; !ok {
-					if user, userErr := client.GetUser(cmd.Context(), msg.User); userErr == nil {
-						userCache[msg.User] = user.Profile.DisplayName
-						if userCache[msg.User] == "" {
-							userCache[msg.User] = user.Name
-						}
-					} else {
-						userCache[msg.User] = msg.User
-					}
-				}
-			}
-		}
+		userNames = slackUserNames(cmd.Context(), client, result.Messages)
 	}
 
 	// Output
@@ -1306,7 +1691,7 @@ func runSlackThread(cmd *cobra.Command, _ []string) error {
 		ts, _ := slack.ParseTimestamp(msg.Timestamp)
 		timeStr := ts.Format("Jan 02 15:04")
 
-		userName := userCache[msg.User]
+		userName := userNames[msg.User]
 		if userName == "" {
 			userName = msg.User
 		}
@@ -1626,8 +2011,31 @@ func runSlackNotifyDisable(_ *cobra.Command, _ []string) error {
 // Helper Functions
 // =============================================================================
 
+// slackUserNames returns the display names of the authors of messages,
+// keyed by user ID
+func slackUserNames(ctx context.Context, client *slack.Client, messages []slack.Message) map[string]string {
+	userIDs := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		userIDs = append(userIDs, msg.User)
+	}
+
+	users := client.GetUsers(ctx, userIDs)
+
+	names := make(map[string]string, len(users))
+	for id, user := range users {
+		names[id] = user.DisplayName()
+	}
+
+	return names
+}
+
 // slackResolveChannelID resolves a channel name to its ID.
 func slackResolveChannelID(ctx context.Context, client *slack.Client, channel string, quiet bool) (string, error) {
+	// @user or @user1,user2 names a direct message
+	if names, ok := strings.CutPrefix(channel, "@"); ok {
+		return slackResolveDM(ctx, client, strings.Split(names, ","), quiet)
+	}
+
 	// If it looks like an ID (starts with C, G, or D), use it directly
 	if len(channel) > 0 && (channel[0] == 'C' || channel[0] == 'G' || channel[0] == 'D') {
 		return channel, nil
@@ -1640,24 +2048,87 @@ func slackResolveChannelID(ctx context.Context, client *slack.Client, channel st
 		_, _ = fmt.Fprintf(os.Stderr, dimStyle.Render("Looking up channel #%s...\n"), channel)
 	}
 
-	// List channels to find the ID
-	result, err := client.ListChannels(ctx, slack.ListChannelsOptions{
+	// List channels to find the ID, refetching a cached list that predates
+	// the channel
+	opts := slack.ListChannelsOptions{
 		Types: "public_channel,private_channel",
 		Limit: 1000,
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to list channels: %w", err)
 	}
 
-	for _, ch := range result.Channels {
-		if ch.Name == channel {
-			return ch.ID, nil
+	for _, noCache := range []bool{false, true} {
+		channels, err := slackListChannels(ctx, client, opts, core.CacheOptions{NoCache: noCache})
+		if err != nil {
+			return "", fmt.Errorf("failed to list channels: %w", err)
+		}
+
+		for _, ch := range channels {
+			if ch.Name == channel {
+				return ch.ID, nil
+			}
 		}
 	}
 
 	return "", fmt.Errorf("channel #%s not found", channel)
 }
 
+// slackResolveDM returns the ID of the direct message with a user, or of the
+// group direct message with several users
+func slackResolveDM(ctx context.Context, client *slack.Client, names []string, quiet bool) (string, error) {
+	label := "@" + strings.Join(names, ", @")
+
+	if !quiet {
+		_, _ = fmt.Fprintf(os.Stderr, dimStyle.Render("Looking up direct message with %s...\n"), label)
+	}
+
+	// Refetch a cached list that predates the conversation
+	for _, noCache := range []bool{false, true} {
+		conversations, users, err := slackListDMs(ctx, client, core.CacheOptions{NoCache: noCache})
+		if err != nil {
+			return "", err
+		}
+
+		if ch, ok := slack.FindDirectMessage(conversations, users, names); ok {
+			return ch.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no direct message with %s found", label)
+}
+
+// slackListDMs lists the direct and group direct messages, with the users
+// of the direct messages keyed by ID
+func slackListDMs(ctx context.Context, client *slack.Client, cache core.CacheOptions) ([]slack.Channel, map[string]*slack.User, error) {
+	conversations, err := slackListChannels(ctx, client, slack.ListChannelsOptions{
+		Types:           slack.DirectMessageTypes,
+		ExcludeArchived: true,
+		Limit:           1000,
+	}, cache)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list direct messages: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(conversations))
+	for _, ch := range conversations {
+		userIDs = append(userIDs, ch.User)
+	}
+
+	return conversations, client.GetUsers(ctx, userIDs), nil
+}
+
+// slackListChannels lists channels through the response cache.
+func slackListChannels(ctx context.Context, client *slack.Client, opts slack.ListChannelsOptions, cache core.CacheOptions) ([]slack.Channel, error) {
+	key := []string{client.CacheScope(), opts.Types, strconv.FormatBool(opts.ExcludeArchived), strconv.Itoa(opts.Limit)}
+
+	return core.CachedQuery("slack-channels", key, cache, func() ([]slack.Channel, error) {
+		result, err := client.ListChannels(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Channels, nil
+	})
+}
+
 // slackParseDuration parses duration strings like "24h", "7d", "2w".
 func slackParseDuration(s string) (time.Duration, error) {
 	// Check for days/weeks suffix
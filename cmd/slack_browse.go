@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+// slackBrowseCmd browses channels interactively
+var slackBrowseCmd = &cobra.Command{
+	Use:   "browse",
+	Short: "Browse channels and messages interactively",
+	Long: `Browse Slack channels in an interactive terminal view.
+
+Channels are listed on the left and the messages of the open channel on the
+right. Type / in the channel list to filter channels.
+
+Keys in the messages pane:
+  ↑/↓, j/k     Select a message
+  t, enter     Expand or collapse the thread of the message
+  /            Search the loaded messages
+  r            Reply in the thread of the message
+  pgup/pgdown  Scroll
+  tab, esc     Back to the channel list
+  q            Quit
+
+Examples:
+  clonr slack browse
+  clonr slack browse --account work`,
+	RunE: runSlackBrowse,
+}
+
+func init() {
+	slackCmd.AddCommand(slackBrowseCmd)
+
+	slackBrowseCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
+	slackBrowseCmd.Flags().StringP("account", "a", "", "Slack account to use")
+}
+
+func runSlackBrowse(cmd *cobra.Command, _ []string) error {
+	client, err := slackGetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	finalModel, err := tea.NewProgram(cli.NewSlackBrowser(client), tea.WithAltScreen()).Run()
+	if err != nil {
+		return err
+	}
+
+	if err := finalModel.(cli.SlackBrowserModel).Error(); err != nil {
+		return fmt.Errorf("failed to list channels: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/slack"
+	"github.com/spf13/cobra"
+)
+
+// slackDownloadCmd downloads files shared in Slack
+var slackDownloadCmd = &cobra.Command{
+	Use:   "download [file-id...]",
+	Short: "Download files attached to messages",
+	Long: `Download the files attached to a message, or files by ID.
+
+The files are saved under their Slack name in the output directory. Files
+larger than --max-size are refused, existing files are only replaced with
+--force, and files stored outside Slack (Google Drive, Dropbox) are linked
+rather than downloaded.
+
+Requires the files:read scope.
+
+Examples:
+  clonr slack download --channel general --ts 1234567890.123456
+  clonr slack download --channel @alice --ts 1234567890.123456 -o ~/Downloads
+  clonr slack download F01234567
+  clonr slack download F01234567 --max-size 1GB --force`,
+	RunE: runSlackDownload,
+}
+
+func init() {
+	slackCmd.AddCommand(slackDownloadCmd)
+
+	slackDownloadCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
+	slackDownloadCmd.Flags().StringP("channel", "c", "", "Channel name or ID of the message, or @user for a direct message")
+	slackDownloadCmd.Flags().String("ts", "", "Timestamp of the message")
+	slackDownloadCmd.Flags().StringP("output", "o", "", "Output directory (default: current directory)")
+	slackDownloadCmd.Flags().String("max-size", "100MB", "Largest file to download")
+	slackDownloadCmd.Flags().BoolP("force", "f", false, "Overwrite existing files")
+	slackDownloadCmd.Flags().StringP("account", "a", "", "Slack account to use")
+	slackDownloadCmd.MarkFlagsRequiredTogether("channel", "ts")
+}
+
+func runSlackDownload(cmd *cobra.Command, args []string) error {
+	channel, _ := cmd.Flags().GetString("channel")
+	ts, _ := cmd.Flags().GetString("ts")
+	outputDir, _ := cmd.Flags().GetString("output")
+	maxSizeFlag, _ := cmd.Flags().GetString("max-size")
+	force, _ := cmd.Flags().GetBool("force")
+
+	if (len(args) == 0) == (channel == "") {
+		return fmt.Errorf("specify file IDs or a message with --channel and --ts")
+	}
+
+	maxSize, err := core.ParseSize(maxSizeFlag)
+	if err != nil {
+		return err
+	}
+
+	client, err := slackGetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+
+	var files []slack.File
+
+	if channel != "" {
+		channelID, err := slackResolveChannelID(ctx, client, channel, false)
+		if err != nil {
+			return err
+		}
+
+		msg, err := client.GetMessage(ctx, channelID, ts)
+		if err != nil {
+			return fmt.Errorf("failed to get message: %w", err)
+		}
+
+		if len(msg.Files) == 0 {
+			return fmt.Errorf("message %s has no files", ts)
+		}
+
+		files = msg.Files
+	} else {
+		for _, id := range args {
+			files = append(files, slack.File{ID: id})
+		}
+	}
+
+	if outputDir != "" {
+		if err := os.MkdirAll(outputDir, 0o750); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	failed := 0
+
+	for _, file := range files {
+		if err := slackSaveFile(cmd, client, file, outputDir, maxSize, force); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render(fmt.Sprintf("Skipped %s: %v", file.ID, err)))
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d files not downloaded", failed, len(files))
+	}
+
+	return nil
+}
+
+// slackSaveFile downloads a file into outputDir. Message attachments may
+// lack their download URLs, so the metadata is always fetched first. The
+// file is written to a temporary file and renamed into place, so a failed
+// or refused download never leaves a partial file behind.
+func slackSaveFile(cmd *cobra.Command, client *slack.Client, file slack.File, outputDir string, maxSize int64, force bool) error {
+	ctx := cmd.Context()
+
+	info, err := client.GetFile(ctx, file.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get file info: %w", err)
+	}
+
+	outputPath := filepath.Join(outputDir, slack.SafeFileName(info.Name, info.ID))
+
+	if _, err := os.Stat(outputPath); err == nil && !force {
+		return fmt.Errorf("%s already exists; use --force to overwrite it", outputPath)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, dimStyle.Render("Downloading %s (%s)...\n"), info.Name, core.FormatSize(info.Size))
+
+	tmp, err := os.CreateTemp(filepath.Dir(outputPath), ".slack-download-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+
+	n, err := client.DownloadFile(ctx, info, tmp, maxSize)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), outputPath)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+
+		if errors.Is(err, slack.ErrFileTooLarge) {
+			return fmt.Errorf("%w; raise --max-size to download it", err)
+		}
+
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Saved: %s (%s)", outputPath, core.FormatSize(n))))
+
+	return nil
+}
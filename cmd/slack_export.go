@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/slack"
+	"github.com/spf13/cobra"
+)
+
+// slackExportCmd exports the history of a channel
+var slackExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export channel history to JSONL or Markdown",
+	Long: `Export the messages of a channel, including the replies of every thread,
+for backup or search indexing.
+
+All history pages are followed, so the export is complete for the time range.
+JSON Lines holds one message per line with its author and time resolved;
+Markdown is a readable transcript with thread replies quoted under their
+parent. The format follows the --out extension (.md for Markdown) unless
+--format is given.
+
+Examples:
+  clonr slack export --channel general --since 30d --out general.jsonl
+  clonr slack export --channel dev --since 7d --out dev.md
+  clonr slack export --channel @alice --format markdown
+  clonr slack export --channel C01234567 --no-threads --out archive.jsonl`,
+	RunE: runSlackExport,
+}
+
+func init() {
+	slackCmd.AddCommand(slackExportCmd)
+
+	slackExportCmd.Flags().StringP("token", "t", "", "Bot token (overrides stored)")
+	slackExportCmd.Flags().StringP("channel", "c", "", "Channel name or ID, or @user for a direct message (required)")
+	slackExportCmd.Flags().String("since", "", "Export messages since duration (e.g., 24h, 30d; default: all history)")
+	slackExportCmd.Flags().String("before", "", "Export messages before timestamp")
+	slackExportCmd.Flags().StringP("out", "o", "", "Output file (default: stdout)")
+	slackExportCmd.Flags().String("format", "", "Output format: jsonl or markdown (default: from --out extension, else jsonl)")
+	slackExportCmd.Flags().Bool("no-threads", false, "Export only top-level messages")
+	slackExportCmd.Flags().StringP("account", "a", "", "Slack account to use")
+	_ = slackExportCmd.MarkFlagRequired("channel")
+}
+
+func runSlackExport(cmd *cobra.Command, _ []string) error {
+	channel, _ := cmd.Flags().GetString("channel")
+	since, _ := cmd.Flags().GetString("since")
+	before, _ := cmd.Flags().GetString("before")
+	out, _ := cmd.Flags().GetString("out")
+	format, _ := cmd.Flags().GetString("format")
+	noThreads, _ := cmd.Flags().GetBool("no-threads")
+
+	if format == "" {
+		format = "jsonl"
+
+		switch strings.ToLower(filepath.Ext(out)) {
+		case ".md", ".markdown":
+			format = "markdown"
+		}
+	}
+
+	if format != "jsonl" && format != "markdown" {
+		return fmt.Errorf("invalid format %q: use jsonl or markdown", format)
+	}
+
+	opts := slack.ExportOptions{
+		Latest:      before,
+		SkipThreads: noThreads,
+		OnProgress: func(messages int) {
+			_, _ = fmt.Fprintf(os.Stderr, "\rFetched %d messages...", messages)
+		},
+	}
+
+	if since != "" {
+		duration, err := slackParseDuration(since)
+		if err != nil {
+			return fmt.Errorf("invalid duration: %w", err)
+		}
+
+		opts.Oldest = slack.FormatTimestamp(time.Now().Add(-duration))
+	}
+
+	client, err := slackGetClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts.Channel, err = slackResolveChannelID(cmd.Context(), client, channel, false)
+	if err != nil {
+		return err
+	}
+
+	messages, err := client.ExportChannel(cmd.Context(), opts)
+
+	_, _ = fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return fmt.Errorf("failed to export %s: %w", channel, err)
+	}
+
+	title := channel
+	if !strings.HasPrefix(channel, "@") {
+		title = "#" + strings.TrimPrefix(channel, "#")
+	}
+
+	write := func(w io.Writer) error {
+		if format == "markdown" {
+			return slack.WriteMarkdown(w, title, messages)
+		}
+
+		return slack.WriteJSONL(w, messages)
+	}
+
+	if out == "" {
+		return write(os.Stdout)
+	}
+
+	if err := slackWriteExport(out, write); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("Exported %d messages from %s to %s", len(messages), title, out)))
+
+	return nil
+}
+
+// slackWriteExport writes an export to path through a temporary file, so an
+// earlier export is only replaced by a complete one
+func slackWriteExport(path string, write func(io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".slack-export-*")
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	err = write(tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return nil
+}
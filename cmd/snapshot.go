@@ -18,6 +18,9 @@ The snapshot includes:
   - Current git branch for each repository
   - Configuration settings
 
+To save and restore the working state of a single repository, use the
+create, list, restore and delete subcommands.
+
 Examples:
   clonr snapshot                     # Output to stdout
   clonr snapshot -o backup.json      # Write to file
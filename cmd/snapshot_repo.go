@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create [repo]",
+	Short: "Save the working state of a repository",
+	Long: `Record the current branch, HEAD and all uncommitted changes (including
+untracked files) of a repository, so you can switch to another task and
+restore the exact working state later.
+
+Changes are stashed and the working tree is left clean, unless --keep is
+given. The repository defaults to the one containing the current directory.
+
+Examples:
+  clonr snapshot create                       # Current repository
+  clonr snapshot create api -m "wip: retries" # By name, with a message
+  clonr snapshot create api --keep            # Keep the working tree as is`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSnapshotCreate,
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list [repo]",
+	Short: "List repository snapshots",
+	Long: `List the snapshots of a repository, newest first.
+
+Examples:
+  clonr snapshot list            # Current repository
+  clonr snapshot list api        # By name
+  clonr snapshot list --all      # Every repository`,
+	Aliases: []string{"ls"},
+	Args:    cobra.MaximumNArgs(1),
+	RunE:    runSnapshotList,
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore [repo] [snapshot-id]",
+	Short: "Restore the working state of a repository",
+	Long: `Check out the recorded branch and re-apply the recorded uncommitted
+changes. Without a snapshot ID the newest snapshot is restored; IDs may be
+abbreviated to any unique prefix.
+
+The working tree must be clean. If the branch moved since the snapshot it is
+checked out at its current tip; use --exact to detach at the recorded HEAD.
+
+Examples:
+  clonr snapshot restore                 # Newest snapshot of current repository
+  clonr snapshot restore api 3f2a        # A specific snapshot
+  clonr snapshot restore api --exact     # At the recorded commit`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: runSnapshotRestore,
+}
+
+var snapshotDeleteCmd = &cobra.Command{
+	Use:   "delete <repo> <snapshot-id>",
+	Short: "Delete a repository snapshot",
+	Long: `Delete a repository snapshot and the stashed changes it holds.
+
+Examples:
+  clonr snapshot delete api 3f2a`,
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(2),
+	RunE:    runSnapshotDelete,
+}
+
+var (
+	snapshotCreateMessage string
+	snapshotCreateKeep    bool
+	snapshotListAll       bool
+	snapshotListJSON      bool
+	snapshotRestoreExact  bool
+)
+
+func init() {
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+	snapshotCmd.AddCommand(snapshotDeleteCmd)
+
+	snapshotCreateCmd.Flags().StringVarP(&snapshotCreateMessage, "message", "m", "", "Describe the snapshot")
+	snapshotCreateCmd.Flags().BoolVar(&snapshotCreateKeep, "keep", false, "Keep uncommitted changes in the working tree")
+
+	snapshotListCmd.Flags().BoolVar(&snapshotListAll, "all", false, "List snapshots of all repositories")
+	snapshotListCmd.Flags().BoolVar(&snapshotListJSON, "json", false, "Output as JSON")
+
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotRestoreExact, "exact", false, "Detach at the recorded HEAD even if the branch has moved")
+}
+
+func runSnapshotCreate(_ *cobra.Command, args []string) error {
+	repo, err := core.ResolveRepo(argOrEmpty(args, 0))
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := core.CreateRepoSnapshot(repo, core.CreateRepoSnapshotOptions{
+		Message: snapshotCreateMessage,
+		Keep:    snapshotCreateKeep,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Saved snapshot %s of %s (%s)\n",
+		okStyle.Render("✓"), shortID(snapshot.ID), repo.URL, snapshotState(snapshot))
+
+	switch {
+	case snapshot.Stash == "":
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  No uncommitted changes"))
+	case snapshotCreateKeep:
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Uncommitted changes kept in the working tree"))
+	default:
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Uncommitted changes stashed; working tree is clean"))
+	}
+
+	return nil
+}
+
+func runSnapshotList(_ *cobra.Command, args []string) error {
+	var repo *model.Repository
+
+	if !snapshotListAll {
+		var err error
+
+		repo, err = core.ResolveRepo(argOrEmpty(args, 0))
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshots, err := core.ListRepoSnapshots(repo)
+	if err != nil {
+		return err
+	}
+
+	if snapshotListJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(snapshots)
+	}
+
+	if len(snapshots) == 0 {
+		printEmptyResult("snapshots", "clonr snapshot create [repo]")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	if snapshotListAll {
+		_, _ = fmt.Fprintln(w, "ID\tREPOSITORY\tSTATE\tCHANGES\tCREATED\tMESSAGE")
+	} else {
+		_, _ = fmt.Fprintln(w, "ID\tSTATE\tCHANGES\tCREATED\tMESSAGE")
+	}
+
+	for i := range snapshots {
+		s := &snapshots[i]
+
+		changes := dimStyle.Render("clean")
+		if s.Stash != "" {
+			changes = warnStyle.Render("stashed")
+		}
+
+		if snapshotListAll {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				shortID(s.ID), s.RepoURL, snapshotState(s), changes, formatAge(s.CreatedAt), s.Message)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				shortID(s.ID), snapshotState(s), changes, formatAge(s.CreatedAt), s.Message)
+		}
+	}
+
+	return w.Flush()
+}
+
+func runSnapshotRestore(_ *cobra.Command, args []string) error {
+	repo, err := core.ResolveRepo(argOrEmpty(args, 0))
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := core.ListRepoSnapshots(repo)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := core.FindRepoSnapshot(snapshots, argOrEmpty(args, 1))
+	if err != nil {
+		return err
+	}
+
+	result, err := core.RestoreRepoSnapshot(snapshot, core.RestoreRepoSnapshotOptions{
+		Exact: snapshotRestoreExact,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Restored snapshot %s of %s (%s)\n",
+		okStyle.Render("✓"), shortID(snapshot.ID), repo.URL, snapshotState(snapshot))
+
+	if result.BranchMoved {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf(
+			"  Branch %s has moved since the snapshot; use --exact to restore at %s", snapshot.Branch, shortID(snapshot.Head))))
+	}
+
+	if result.AppliedChanges {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("  Uncommitted changes re-applied"))
+	}
+
+	return nil
+}
+
+func runSnapshotDelete(_ *cobra.Command, args []string) error {
+	repo, err := core.ResolveRepo(args[0])
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := core.ListRepoSnapshots(repo)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := core.FindRepoSnapshot(snapshots, args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := core.DeleteRepoSnapshot(snapshot); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s Deleted snapshot %s of %s\n", okStyle.Render("✓"), shortID(snapshot.ID), repo.URL)
+
+	return nil
+}
+
+// snapshotState describes the recorded branch and HEAD of a snapshot
+func snapshotState(s *model.RepoSnapshot) string {
+	if s.Branch == "" {
+		return "detached at " + shortID(s.Head)
+	}
+
+	return s.Branch + " @ " + shortID(s.Head)
+}
+
+// shortID abbreviates a snapshot ID or commit SHA for display
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+
+	return id
+}
+
+// argOrEmpty returns args[i], or "" if it was not given
+func argOrEmpty(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+
+	return ""
+}
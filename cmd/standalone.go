@@ -16,14 +16,16 @@ Source Instance (Server):
   clonr standalone init     - Initialize standalone mode and generate sync key
   clonr standalone status   - Show current standalone status
   clonr standalone rotate   - Generate new sync key (invalidates old connections)
-  clonr standalone clients  - List connected clients
-  clonr standalone revoke   - Revoke a client's access
+  clonr standalone clients  - List, approve, reject and revoke clients
+  clonr standalone pair     - Show a QR code to pair another machine
   clonr standalone disable  - Disable standalone mode
 
 Destination Instance (Client):
   clonr standalone connect     - Connect to a standalone instance
+  clonr standalone pair --scan - Pair with a standalone instance from its QR code
   clonr standalone list        - List all connections
   clonr standalone sync        - Sync data from a connection
+  clonr standalone filter      - Choose what a connection syncs
   clonr standalone disconnect  - Remove a connection`,
 }
 
@@ -1,12 +1,9 @@
 package cmd
 
 import (
-	"bufio"
 	"fmt"
 	"os"
-	"strings"
 
-	"github.com/inovacc/clonr/internal/standalone"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +27,8 @@ The encryption key is used to:
 - Ensure only that client can decrypt their own sensitive data
 - Allow normal storage of non-sensitive data (repositories, workspaces)
 
+This is the same as 'clonr standalone clients approve'.
+
 Examples:
   # List pending client connections
   clonr standalone accept --list
@@ -53,14 +52,8 @@ func init() {
 func runStandaloneAccept(_ *cobra.Command, args []string) error {
 	db := store.GetDB()
 
-	// Get standalone config
-	config, err := db.GetStandaloneConfig()
-	if err != nil {
-		return fmt.Errorf("not in standalone mode: %w", err)
-	}
-
-	if !config.IsServer {
-		return fmt.Errorf("this command is only available on standalone server instances")
+	if _, err := standaloneServerConfig(db); err != nil {
+		return err
 	}
 
 	// Get pending registrations
@@ -78,11 +71,16 @@ func runStandaloneAccept(_ *cobra.Command, args []string) error {
 
 		_, _ = fmt.Fprintf(os.Stdout, "Pending client registrations (%d):\n\n", len(pending))
 		for _, reg := range pending {
-			_, _ = fmt.Fprintf(os.Stdout, "  Client ID: %s\n", reg.ClientID[:8])
+			_, _ = fmt.Fprintf(os.Stdout, "  Client ID: %s\n", shortID(reg.ClientID))
 			_, _ = fmt.Fprintf(os.Stdout, "    Name: %s\n", reg.ClientName)
 			_, _ = fmt.Fprintf(os.Stdout, "    Machine: %s (%s/%s)\n",
 				reg.MachineInfo.Hostname, reg.MachineInfo.OS, reg.MachineInfo.Arch)
 			_, _ = fmt.Fprintf(os.Stdout, "    Clonr Version: %s\n", reg.MachineInfo.ClonrVersion)
+
+			if reg.Fingerprint != "" {
+				_, _ = fmt.Fprintf(os.Stdout, "    Fingerprint: %s\n", reg.Fingerprint)
+			}
+
 			_, _ = fmt.Fprintf(os.Stdout, "    Initiated: %s\n", reg.InitiatedAt.Format("2006-01-02 15:04:05"))
 			_, _ = fmt.Fprintf(os.Stdout, "    State: %s\n", reg.State)
 			_, _ = fmt.Fprintln(os.Stdout)
@@ -94,105 +92,10 @@ func runStandaloneAccept(_ *cobra.Command, args []string) error {
 	}
 
 	// Find the client to accept
-	var target *standalone.ClientRegistration
-
-	if acceptClientID != "" {
-		// Find by ID prefix
-		for i := range pending {
-			if strings.HasPrefix(pending[i].ClientID, acceptClientID) {
-				target = pending[i]
-				break
-			}
-		}
-
-		if target == nil {
-			return fmt.Errorf("no pending client found with ID prefix: %s", acceptClientID)
-		}
-	} else if len(pending) == 1 {
-		// Auto-select if only one pending
-		target = pending[0]
-	} else if len(pending) == 0 {
-		return fmt.Errorf("no pending client registrations")
-	} else {
-		// Multiple pending, must specify
-		_, _ = fmt.Fprintf(os.Stderr, "Multiple pending registrations (%d). Specify --client <id>:\n\n", len(pending))
-		for _, reg := range pending {
-			_, _ = fmt.Fprintf(os.Stderr, "  %s - %s (%s)\n",
-				reg.ClientID[:8], reg.ClientName, reg.MachineInfo.Hostname)
-		}
-
-		return fmt.Errorf("specify --client to choose which client to accept")
-	}
-
-	// Display client info
-	_, _ = fmt.Fprintln(os.Stdout)
-
-	printBoxHeader("CLIENT REGISTRATION")
-	printBoxLine("Client ID", target.ClientID[:8])
-	printBoxLine("Name", truncateString(target.ClientName, 48))
-	printBoxLine("Hostname", truncateString(target.MachineInfo.Hostname, 48))
-	printBoxLine("Platform", fmt.Sprintf("%s/%s", target.MachineInfo.OS, target.MachineInfo.Arch))
-	printBoxLine("Version", target.MachineInfo.ClonrVersion)
-	printBoxFooter()
-
-	_, _ = fmt.Fprintln(os.Stdout)
-
-	// Get the encryption key from user
-	var displayKey string
-	if len(args) > 0 {
-		displayKey = args[0]
-	} else {
-		_, _ = fmt.Fprint(os.Stderr, "Enter the encryption key displayed on the client: ")
-		reader := bufio.NewReader(os.Stdin)
-
-		input, err := reader.ReadString('\n')
-		if err != nil {
-			return fmt.Errorf("failed to read key: %w", err)
-		}
-
-		displayKey = strings.TrimSpace(input)
-	}
-
-	if displayKey == "" {
-		return fmt.Errorf("encryption key is required")
-	}
-
-	// Create server handshake manager and register the client
-	serverHandshake := standalone.NewServerHandshake()
-
-	// Re-add the pending client to the handshake manager
-	// (in a real implementation, this would be persisted)
-	_, _ = serverHandshake.InitiateHandshake(target)
-
-	// Register the client with the provided key
-	registeredClient, err := serverHandshake.RegisterClient(target.ClientID, displayKey)
+	target, err := selectPendingRegistration(pending, acceptClientID)
 	if err != nil {
-		return fmt.Errorf("failed to register client: %w", err)
+		return err
 	}
 
-	// Save the registered client
-	if err := db.SaveRegisteredClient(registeredClient); err != nil {
-		return fmt.Errorf("failed to save client registration: %w", err)
-	}
-
-	// Remove from pending
-	if err := db.RemovePendingRegistration(target.ClientID); err != nil {
-		_, _ = fmt.Fprintf(os.Stderr, "Warning: failed to remove pending registration: %v\n", err)
-	}
-
-	_, _ = fmt.Fprintln(os.Stdout)
-
-	printBoxHeader("CLIENT REGISTERED")
-	printBoxLine("Client", registeredClient.ClientName)
-	printBoxLine("Key Hint", registeredClient.KeyHint)
-	printBoxLine("Status", registeredClient.Status)
-	printBoxFooter()
-
-	_, _ = fmt.Fprintln(os.Stdout)
-
-	_, _ = fmt.Fprintln(os.Stdout, "The client can now sync data with this instance.")
-	_, _ = fmt.Fprintln(os.Stdout, "Sensitive data (tokens, credentials) will be encrypted with the client's key.")
-	_, _ = fmt.Fprintln(os.Stdout, "Repository data will be stored normally for easy access.")
-
-	return nil
+	return approvePendingClient(db, target, argOrEmpty(args, 0))
 }
@@ -1,51 +1,153 @@
 package cmd
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/inovacc/clonr/internal/standalone"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
 )
 
 var (
 	clientsShowAll bool
+
+	clientsAutoHosts []string
+	clientsAutoMax   int
+	clientsAutoOff   bool
 )
 
 var standaloneClientsCmd = &cobra.Command{
 	Use:   "clients",
-	Short: "List registered clients",
-	Long: `List all clients that have registered with this standalone instance.
+	Short: "Manage registered clients",
+	Long: `List, approve, reject and revoke the clients of this standalone instance.
+
+Each client has its own encryption key for sensitive data. A new client
+shows its key and a fingerprint when it connects; approving it means
+entering that key here, and the fingerprint printed after approval must
+match the one on the client. The key hint shows the first few characters
+of the key hash to tell the keys of clients apart.
+
+Clients whose hostname matches the auto-approve policy are registered
+without approval. Every registration request is sent as a
+client-registration notification (see 'clonr notify').
 
-Each client has its own encryption key for sensitive data. The key hint
-shows the first few characters to help identify which key belongs to which client.
+Without a subcommand the registered clients are listed.
 
 Examples:
-  # List all registered clients
+  # List registered and pending clients
   clonr standalone clients
 
-  # Show all details including suspended clients
-  clonr standalone clients --all`,
+  # Include suspended and revoked clients
+  clonr standalone clients list --all
+
+  # Approve a pending client with the key it shows
+  clonr standalone clients approve abc12345 1a2b-3c4d-...
+
+  # Reject a pending client, revoke a registered one
+  clonr standalone clients reject abc12345
+  clonr standalone clients revoke def67890
+
+  # Approve build machines automatically, up to 10 active clients
+  clonr standalone clients auto-approve --host 'build-*' --max 10`,
+	Args: cobra.NoArgs,
 	RunE: runStandaloneClients,
 }
 
+var standaloneClientsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered and pending clients",
+	Args:  cobra.NoArgs,
+	RunE:  runStandaloneClients,
+}
+
+var standaloneClientsApproveCmd = &cobra.Command{
+	Use:   "approve [client-id] [display-key]",
+	Short: "Approve a pending client with its key",
+	Long: `Approve a pending client by entering the encryption key it displays.
+
+The client ID is the first characters of the ID shown by 'clonr standalone
+clients'; it can be left out when a single client is pending. Without a
+key it is read from the terminal.
+
+When the client sent a fingerprint with its request, the key must match it.`,
+	Args: cobra.MaximumNArgs(2),
+	RunE: runStandaloneClientsApprove,
+}
+
+var standaloneClientsRejectCmd = &cobra.Command{
+	Use:   "reject <client-id>",
+	Short: "Reject a pending client",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runStandaloneClientsReject,
+}
+
+var standaloneClientsRevokeCmd = &cobra.Command{
+	Use:   "revoke <client-id>",
+	Short: "Revoke a registered client's access",
+	Long: `Revoke a registered client's access.
+
+The client stays listed with --all, and a client with the same ID cannot
+register again; it has to connect with a new standalone key.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStandaloneClientsRevoke,
+}
+
+var standaloneClientsAutoApproveCmd = &cobra.Command{
+	Use:   "auto-approve",
+	Short: "Show or set the auto-approve policy",
+	Long: `Show or set the clients registered without manual approval.
+
+A client whose hostname matches one of the --host glob patterns is
+registered as soon as it connects, until --max clients are active.
+Hostnames are reported by the clients themselves, so only use the policy
+on networks you trust. Without flags the current policy is shown.`,
+	Example: `  clonr standalone clients auto-approve --host 'build-*' --host 'ci-*.corp' --max 10
+  clonr standalone clients auto-approve --off`,
+	Args: cobra.NoArgs,
+	RunE: runStandaloneClientsAutoApprove,
+}
+
 func init() {
 	standaloneCmd.AddCommand(standaloneClientsCmd)
+	standaloneClientsCmd.AddCommand(standaloneClientsListCmd)
+	standaloneClientsCmd.AddCommand(standaloneClientsApproveCmd)
+	standaloneClientsCmd.AddCommand(standaloneClientsRejectCmd)
+	standaloneClientsCmd.AddCommand(standaloneClientsRevokeCmd)
+	standaloneClientsCmd.AddCommand(standaloneClientsAutoApproveCmd)
 
-	standaloneClientsCmd.Flags().BoolVar(&clientsShowAll, "all", false, "Show all clients including suspended")
-}
+	standaloneClientsCmd.PersistentFlags().BoolVar(&clientsShowAll, "all", false, "Show all clients including suspended and revoked")
 
-func runStandaloneClients(_ *cobra.Command, _ []string) error {
-	db := store.GetDB()
+	standaloneClientsAutoApproveCmd.Flags().StringSliceVar(&clientsAutoHosts, "host", nil, "Hostname glob pattern to approve automatically (repeatable)")
+	standaloneClientsAutoApproveCmd.Flags().IntVar(&clientsAutoMax, "max", 0, "Stop approving automatically at this many active clients (0 = no limit)")
+	standaloneClientsAutoApproveCmd.Flags().BoolVar(&clientsAutoOff, "off", false, "Approve every client manually")
+	standaloneClientsAutoApproveCmd.MarkFlagsMutuallyExclusive("off", "host")
+	standaloneClientsAutoApproveCmd.MarkFlagsMutuallyExclusive("off", "max")
+}
 
-	// Get standalone config
+// standaloneServerConfig returns the standalone configuration of a server
+// instance
+func standaloneServerConfig(db store.Store) (*standalone.StandaloneConfig, error) {
 	config, err := db.GetStandaloneConfig()
 	if err != nil {
-		return fmt.Errorf("not in standalone mode: %w", err)
+		return nil, fmt.Errorf("not in standalone mode: %w", err)
+	}
+
+	if config == nil || !config.IsServer {
+		return nil, fmt.Errorf("this command is only available on standalone server instances")
 	}
 
-	if !config.IsServer {
-		return fmt.Errorf("this command is only available on standalone server instances")
+	return config, nil
+}
+
+func runStandaloneClients(_ *cobra.Command, _ []string) error {
+	db := store.GetDB()
+
+	if _, err := standaloneServerConfig(db); err != nil {
+		return err
 	}
 
 	// Get registered clients
@@ -54,7 +156,12 @@ func runStandaloneClients(_ *cobra.Command, _ []string) error {
 		return fmt.Errorf("failed to list clients: %w", err)
 	}
 
-	if len(clients) == 0 {
+	pending, err := db.ListPendingRegistrations()
+	if err != nil {
+		return fmt.Errorf("failed to list pending registrations: %w", err)
+	}
+
+	if len(clients) == 0 && len(pending) == 0 {
 		_, _ = fmt.Fprintln(os.Stdout, "No registered clients")
 		_, _ = fmt.Fprintln(os.Stdout)
 		_, _ = fmt.Fprintln(os.Stdout, "Clients can connect using: clonr standalone connect <key>")
@@ -89,15 +196,23 @@ func runStandaloneClients(_ *cobra.Command, _ []string) error {
 		}
 
 		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", statusIcon, client.ClientName)
-		_, _ = fmt.Fprintf(os.Stdout, "    ID: %s\n", client.ClientID[:8])
+		_, _ = fmt.Fprintf(os.Stdout, "    ID: %s\n", shortID(client.ClientID))
 		_, _ = fmt.Fprintf(os.Stdout, "    Key Hint: %s\n", client.KeyHint)
+
+		if client.Fingerprint != "" {
+			_, _ = fmt.Fprintf(os.Stdout, "    Fingerprint: %s\n", client.Fingerprint)
+		}
+
 		_, _ = fmt.Fprintf(os.Stdout, "    Status: %s\n", client.Status)
 		_, _ = fmt.Fprintf(os.Stdout, "    Machine: %s (%s/%s)\n",
 			client.MachineInfo.Hostname,
 			client.MachineInfo.OS,
 			client.MachineInfo.Arch)
 		_, _ = fmt.Fprintf(os.Stdout, "    Registered: %s\n", client.RegisteredAt.Format("2006-01-02 15:04:05"))
-		_, _ = fmt.Fprintf(os.Stdout, "    Last Seen: %s\n", client.LastSeenAt.Format("2006-01-02 15:04:05"))
+
+		if !client.LastSeenAt.IsZero() {
+			_, _ = fmt.Fprintf(os.Stdout, "    Last Seen: %s\n", client.LastSeenAt.Format("2006-01-02 15:04:05"))
+		}
 
 		_, _ = fmt.Fprintf(os.Stdout, "    Sync Count: %d\n", client.SyncCount)
 		if client.LastIP != "" {
@@ -108,17 +223,271 @@ func runStandaloneClients(_ *cobra.Command, _ []string) error {
 	}
 
 	// Also show pending if any
-	pending, _ := db.ListPendingRegistrations()
 	if len(pending) > 0 {
 		_, _ = fmt.Fprintf(os.Stdout, "Pending registrations (%d):\n", len(pending))
 		for _, p := range pending {
-			_, _ = fmt.Fprintf(os.Stdout, "  - %s (%s) - awaiting key entry\n",
-				p.ClientName, p.ClientID[:8])
+			_, _ = fmt.Fprintf(os.Stdout, "  - %s (%s) from %s", p.ClientName, shortID(p.ClientID), p.MachineInfo.Hostname)
+
+			if p.Fingerprint != "" {
+				_, _ = fmt.Fprintf(os.Stdout, ", fingerprint %s", p.Fingerprint)
+			}
+
+			_, _ = fmt.Fprintln(os.Stdout)
 		}
 
 		_, _ = fmt.Fprintln(os.Stdout)
-		_, _ = fmt.Fprintln(os.Stdout, "To accept a pending client: clonr standalone accept")
+		_, _ = fmt.Fprintln(os.Stdout, "To approve a pending client: clonr standalone clients approve <id>")
+		_, _ = fmt.Fprintln(os.Stdout, "To reject one:               clonr standalone clients reject <id>")
+	}
+
+	return nil
+}
+
+func runStandaloneClientsApprove(_ *cobra.Command, args []string) error {
+	db := store.GetDB()
+
+	if _, err := standaloneServerConfig(db); err != nil {
+		return err
+	}
+
+	pending, err := db.ListPendingRegistrations()
+	if err != nil {
+		return fmt.Errorf("failed to list pending registrations: %w", err)
+	}
+
+	target, err := selectPendingRegistration(pending, argOrEmpty(args, 0))
+	if err != nil {
+		return err
+	}
+
+	return approvePendingClient(db, target, argOrEmpty(args, 1))
+}
+
+func runStandaloneClientsReject(_ *cobra.Command, args []string) error {
+	db := store.GetDB()
+
+	if _, err := standaloneServerConfig(db); err != nil {
+		return err
+	}
+
+	pending, err := db.ListPendingRegistrations()
+	if err != nil {
+		return fmt.Errorf("failed to list pending registrations: %w", err)
+	}
+
+	target, err := findByIDPrefix(pending, args[0], func(r *standalone.ClientRegistration) string { return r.ClientID })
+	if err != nil {
+		return fmt.Errorf("pending client %s: %w", args[0], err)
+	}
+
+	if err := db.RemovePendingRegistration(target.ClientID); err != nil {
+		return fmt.Errorf("failed to remove pending registration: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("✓ Rejected %s (%s)", target.ClientName, shortID(target.ClientID))))
+
+	return nil
+}
+
+func runStandaloneClientsRevoke(_ *cobra.Command, args []string) error {
+	db := store.GetDB()
+
+	if _, err := standaloneServerConfig(db); err != nil {
+		return err
 	}
 
+	clients, err := db.ListRegisteredClients()
+	if err != nil {
+		return fmt.Errorf("failed to list clients: %w", err)
+	}
+
+	client, err := findByIDPrefix(clients, args[0], func(c *standalone.RegisteredClient) string { return c.ClientID })
+	if err != nil {
+		return fmt.Errorf("client %s: %w", args[0], err)
+	}
+
+	if client.Status == "revoked" {
+		_, _ = fmt.Fprintf(os.Stdout, "%s (%s) is already revoked\n", client.ClientName, shortID(client.ClientID))
+		return nil
+	}
+
+	client.Status = "revoked"
+
+	if err := db.SaveRegisteredClient(client); err != nil {
+		return fmt.Errorf("failed to revoke client: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render(fmt.Sprintf("✓ Revoked %s (%s)", client.ClientName, shortID(client.ClientID))))
+
+	return nil
+}
+
+func runStandaloneClientsAutoApprove(cmd *cobra.Command, _ []string) error {
+	db := store.GetDB()
+
+	config, err := standaloneServerConfig(db)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("host") && !cmd.Flags().Changed("max") && !clientsAutoOff {
+		_, _ = fmt.Fprintf(os.Stdout, "Auto-approve: %s\n", config.AutoApprove)
+		return nil
+	}
+
+	policy := config.AutoApprove
+
+	switch {
+	case clientsAutoOff:
+		policy = standalone.AutoApprovePolicy{}
+	default:
+		if cmd.Flags().Changed("host") {
+			policy.Hostnames = clientsAutoHosts
+		}
+
+		if cmd.Flags().Changed("max") {
+			policy.MaxClients = clientsAutoMax
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	config.AutoApprove = policy
+
+	if err := db.SaveStandaloneConfig(config); err != nil {
+		return fmt.Errorf("failed to save standalone config: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, okStyle.Render("✓ Auto-approve: "+policy.String()))
+
+	return nil
+}
+
+// selectPendingRegistration returns the pending registration whose ID
+// starts with prefix, or the only one when prefix is empty
+func selectPendingRegistration(pending []*standalone.ClientRegistration, prefix string) (*standalone.ClientRegistration, error) {
+	if prefix != "" {
+		target, err := findByIDPrefix(pending, prefix, func(r *standalone.ClientRegistration) string { return r.ClientID })
+		if err != nil {
+			return nil, fmt.Errorf("pending client %s: %w", prefix, err)
+		}
+
+		return target, nil
+	}
+
+	switch len(pending) {
+	case 0:
+		return nil, fmt.Errorf("no pending client registrations")
+	case 1:
+		return pending[0], nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Multiple pending registrations (%d):\n\n", len(pending))
+	for _, reg := range pending {
+		_, _ = fmt.Fprintf(os.Stderr, "  %s - %s (%s)\n",
+			shortID(reg.ClientID), reg.ClientName, reg.MachineInfo.Hostname)
+	}
+
+	return nil, fmt.Errorf("specify the ID of the client to approve")
+}
+
+// findByIDPrefix returns the item whose ID starts with prefix, refusing an
+// ambiguous prefix
+func findByIDPrefix[T any](items []T, prefix string, id func(T) string) (T, error) {
+	var (
+		found T
+		n     int
+	)
+
+	for _, item := range items {
+		if strings.HasPrefix(id(item), prefix) {
+			found = item
+			n++
+		}
+	}
+
+	switch n {
+	case 0:
+		return found, errors.New("not found")
+	case 1:
+		return found, nil
+	default:
+		var zero T
+		return zero, fmt.Errorf("ID prefix matches %d clients; give more characters", n)
+	}
+}
+
+// approvePendingClient registers target with the key shown on the client,
+// read from the terminal when displayKey is empty
+func approvePendingClient(db store.Store, target *standalone.ClientRegistration, displayKey string) error {
+	// Display client info
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	printBoxHeader("CLIENT REGISTRATION")
+	printBoxLine("Client ID", shortID(target.ClientID))
+	printBoxLine("Name", truncateString(target.ClientName, 48))
+	printBoxLine("Hostname", truncateString(target.MachineInfo.Hostname, 48))
+	printBoxLine("Platform", fmt.Sprintf("%s/%s", target.MachineInfo.OS, target.MachineInfo.Arch))
+	printBoxLine("Version", target.MachineInfo.ClonrVersion)
+
+	if target.Fingerprint != "" {
+		printBoxLine("Fingerprint", target.Fingerprint)
+	}
+
+	printBoxFooter()
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	// Get the encryption key from user
+	if displayKey == "" {
+		_, _ = fmt.Fprint(os.Stderr, "Enter the encryption key displayed on the client: ")
+		reader := bufio.NewReader(os.Stdin)
+
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read key: %w", err)
+		}
+
+		displayKey = strings.TrimSpace(input)
+	}
+
+	if displayKey == "" {
+		return fmt.Errorf("encryption key is required")
+	}
+
+	// Register the client with the provided key
+	registeredClient, err := standalone.ApproveRegistration(target, displayKey)
+	if err != nil {
+		return fmt.Errorf("failed to register client: %w", err)
+	}
+
+	// Save the registered client
+	if err := db.SaveRegisteredClient(registeredClient); err != nil {
+		return fmt.Errorf("failed to save client registration: %w", err)
+	}
+
+	// Remove from pending
+	if err := db.RemovePendingRegistration(target.ClientID); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Warning: failed to remove pending registration: %v\n", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	printBoxHeader("CLIENT REGISTERED")
+	printBoxLine("Client", registeredClient.ClientName)
+	printBoxLine("Key Hint", registeredClient.KeyHint)
+	printBoxLine("Fingerprint", registeredClient.Fingerprint)
+	printBoxLine("Status", registeredClient.Status)
+	printBoxFooter()
+
+	_, _ = fmt.Fprintln(os.Stdout)
+
+	_, _ = fmt.Fprintln(os.Stdout, "Check that the fingerprint matches the one shown on the client.")
+	_, _ = fmt.Fprintln(os.Stdout, "The client can now sync data with this instance.")
+	_, _ = fmt.Fprintln(os.Stdout, "Sensitive data (tokens, credentials) will be encrypted with the client's key.")
+	_, _ = fmt.Fprintln(os.Stdout, "Repository data will be stored normally for easy access.")
+
 	return nil
 }
@@ -6,6 +6,9 @@ import (
 	"os"
 	"strings"
 
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/standalone"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
@@ -24,11 +27,11 @@ var standaloneConnectCmd = &cobra.Command{
 This command initiates a handshake with a standalone server:
 
 1. You provide the standalone key (from 'clonr standalone init' on server)
-2. Your client sends identification info to the server
-3. Server issues a challenge
-4. Your client generates a secure encryption key
-5. The key is displayed - you must enter it on the server
-6. Once verified, the connection is established
+2. Your client generates a secure encryption key
+3. The key and identification info are sent to the server
+4. A server whose auto-approve policy allows this machine registers it at once
+5. Otherwise the key is displayed - you must enter it on the server
+6. Once approved, the connection is established
 
 The encryption key ensures that sensitive data (like tokens) synced from
 the server can only be decrypted by you. Repositories are synced normally.
@@ -68,7 +71,11 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 		keyData = strings.TrimSpace(string(data))
 	} else if len(args) > 0 {
 		keyData = args[0]
-	} else {
+	}
+
+	// An interrupted connect continues with the key already entered on the server
+	keyData, handshake := resumeConnect(keyData)
+	if keyData == "" {
 		return fmt.Errorf("provide a standalone key as argument or use --file")
 	}
 
@@ -93,7 +100,9 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 
 	// Generate connection name if not provided
 	name := connectName
-	if name == "" {
+	if handshake != nil {
+		name = handshake.GetRegistration().ClientName
+	} else if name == "" {
 		name = fmt.Sprintf("server-%s", key.InstanceID[:8])
 	}
 
@@ -102,40 +111,75 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 	_, _ = fmt.Fprintf(os.Stderr, "  Instance: %s\n", key.InstanceID[:8])
 	_, _ = fmt.Fprintln(os.Stderr)
 
-	// Start handshake
-	machineInfo := standalone.GenerateMachineInfo("dev") // Version will be set at build time
-	handshake := standalone.NewHandshake(name, machineInfo)
+	var displayKey string
 
-	_, _ = fmt.Fprintf(os.Stderr, "Starting handshake...\n")
-	_, _ = fmt.Fprintf(os.Stderr, "  Client ID: %s\n", handshake.GetRegistration().ClientID[:8])
-	_, _ = fmt.Fprintln(os.Stderr)
+	if handshake != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Resuming handshake...\n")
+		_, _ = fmt.Fprintf(os.Stderr, "  Client ID: %s\n", handshake.GetRegistration().ClientID[:8])
+		_, _ = fmt.Fprintln(os.Stderr)
 
-	// Generate encryption key
-	displayKey, err := handshake.GenerateKey()
-	if err != nil {
-		return fmt.Errorf("failed to generate encryption key: %w", err)
+		displayKey = standalone.FormatDisplayKey(handshake.GetDisplayKey())
+	} else {
+		// Start handshake
+		machineInfo := standalone.GenerateMachineInfo("dev") // Version will be set at build time
+		handshake = standalone.NewHandshake(name, machineInfo)
+
+		_, _ = fmt.Fprintf(os.Stderr, "Starting handshake...\n")
+		_, _ = fmt.Fprintf(os.Stderr, "  Client ID: %s\n", handshake.GetRegistration().ClientID[:8])
+		_, _ = fmt.Fprintln(os.Stderr)
+
+		// Generate encryption key
+		displayKey, err = handshake.GenerateKey()
+		if err != nil {
+			return fmt.Errorf("failed to generate encryption key: %w", err)
+		}
 	}
 
-	// Display the key prominently
-	_, _ = fmt.Fprintln(os.Stdout)
+	// Keep the key so an interrupted connect does not need a new one on the server
+	saveConnectDraft(keyData, handshake)
 
-	printBoxHeader("ENCRYPTION KEY")
+	// Send the key to the server: it registers the client at once when its
+	// auto-approve policy allows, and keeps the request for approval otherwise
+	fingerprint := standalone.ClientFingerprint(handshake.GetRegistration().ClientID, handshake.GetFullKey())
 
-	_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString("", boxWidth-2))
-	_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString(displayKey, boxWidth-2))
-	_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString("", boxWidth-2))
-	_, _ = fmt.Fprintln(os.Stdout, "╠══════════════════════════════════════════════════════════════╣")
-	_, _ = fmt.Fprintln(os.Stdout, "║  Enter this key on the server to complete registration.     ║")
-	_, _ = fmt.Fprintln(os.Stdout, "║  Run on server: clonr standalone accept                     ║")
+	approved, serverFingerprint, err := clientgrpc.RegisterStandalone(key, handshake.GetRegistration(), displayKey)
 
-	printBoxFooter()
+	switch {
+	case err != nil:
+		_, _ = fmt.Fprintf(os.Stderr, "Could not send the key to the server: %v\n", err)
+		_, _ = fmt.Fprintln(os.Stderr, "Enter it on the server instead.")
+	case serverFingerprint != fingerprint:
+		return fmt.Errorf("server fingerprint %s does not match this client (%s); not connecting", serverFingerprint, fingerprint)
+	case approved:
+		_, _ = fmt.Fprintf(os.Stderr, "Approved by the server (fingerprint %s)\n", fingerprint)
+	}
 
-	_, _ = fmt.Fprintln(os.Stdout)
+	if !approved {
+		// Display the key prominently
+		_, _ = fmt.Fprintln(os.Stdout)
+
+		printBoxHeader("ENCRYPTION KEY")
+
+		_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString("", boxWidth-2))
+		_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString(displayKey, boxWidth-2))
+		_, _ = fmt.Fprintf(os.Stdout, "║%s║\n", centerString("", boxWidth-2))
+		_, _ = fmt.Fprintln(os.Stdout, "╠══════════════════════════════════════════════════════════════╣")
+		printBoxLine("Client ID", shortID(handshake.GetRegistration().ClientID))
+		printBoxLine("Fingerprint", fingerprint)
+		_, _ = fmt.Fprintln(os.Stdout, "╠══════════════════════════════════════════════════════════════╣")
+		_, _ = fmt.Fprintln(os.Stdout, "║  Enter this key on the server to complete registration.      ║")
+		_, _ = fmt.Fprintln(os.Stdout, "║  Run on server: clonr standalone clients approve             ║")
+		_, _ = fmt.Fprintln(os.Stdout, "║  The server shows the fingerprint above once approved.       ║")
 
-	// Wait for user confirmation
-	_, _ = fmt.Fprint(os.Stderr, "Press Enter after entering the key on the server...")
-	reader := bufio.NewReader(os.Stdin)
-	_, _ = reader.ReadString('\n')
+		printBoxFooter()
+
+		_, _ = fmt.Fprintln(os.Stdout)
+
+		// Wait for user confirmation
+		_, _ = fmt.Fprint(os.Stderr, "Press Enter after entering the key on the server...")
+		reader := bufio.NewReader(os.Stdin)
+		_, _ = reader.ReadString('\n')
+	}
 
 	// Get local password to encrypt stored credentials
 	_, _ = fmt.Fprintln(os.Stderr)
@@ -179,6 +223,7 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 	// For now, store in the existing encrypted fields
 	conn.APIKeyEncrypted = encryptedClientKey
 	conn.LocalSalt = localSalt
+	conn.ClientID = handshake.GetRegistration().ClientID
 	conn.SyncStatus = standalone.StatusConnected
 
 	// Save connection
@@ -188,6 +233,8 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 
 	handshake.Complete()
 
+	_ = core.DeleteWizardDraft(core.ConnectDraft)
+
 	_, _ = fmt.Fprintln(os.Stdout)
 	_, _ = fmt.Fprintln(os.Stdout, "Connection established successfully!")
 	_, _ = fmt.Fprintf(os.Stdout, "  Name: %s\n", conn.Name)
@@ -199,3 +246,70 @@ func runStandaloneConnect(_ *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// connectDraft is the saved progress of an interrupted 'standalone connect'.
+// The standalone key and the display key are encrypted like profile tokens.
+type connectDraft struct {
+	Name       string `json:"name"`
+	ClientID   string `json:"client_id"`
+	Key        []byte `json:"key"`
+	DisplayKey []byte `json:"display_key"`
+}
+
+// saveConnectDraft stores the handshake once its key has been shown
+func saveConnectDraft(keyData string, handshake *standalone.Handshake) {
+	reg := handshake.GetRegistration()
+
+	encryptedKey, err := tpm.EncryptToken(keyData, reg.ClientName, "standalone")
+	if err != nil {
+		return
+	}
+
+	encryptedDisplayKey, err := tpm.EncryptToken(handshake.GetDisplayKey(), reg.ClientName, "standalone")
+	if err != nil {
+		return
+	}
+
+	_ = core.SaveWizardDraft(core.ConnectDraft, connectDraft{
+		Name:       reg.ClientName,
+		ClientID:   reg.ClientID,
+		Key:        encryptedKey,
+		DisplayKey: encryptedDisplayKey,
+	})
+}
+
+// resumeConnect offers to continue an interrupted connect. keyData is the key
+// given on the command line, if any; a draft for another key is left alone.
+// It returns the standalone key and the resumed handshake, or keyData and nil
+// to start over.
+func resumeConnect(keyData string) (string, *standalone.Handshake) {
+	var draft connectDraft
+
+	savedAt, ok, err := core.LoadWizardDraft(core.ConnectDraft, &draft)
+	if err != nil || !ok {
+		return keyData, nil
+	}
+
+	savedKey, err := tpm.DecryptToken(draft.Key, draft.Name, "standalone")
+	if err != nil {
+		return keyData, nil
+	}
+
+	if keyData != "" && keyData != savedKey {
+		return keyData, nil
+	}
+
+	displayKey, err := tpm.DecryptToken(draft.DisplayKey, draft.Name, "standalone")
+	if err != nil {
+		return keyData, nil
+	}
+
+	if !promptConfirm(fmt.Sprintf("Resume connecting %s from %s? [y/N]: ", draft.Name, formatAge(savedAt))) {
+		_ = core.DeleteWizardDraft(core.ConnectDraft)
+		return keyData, nil
+	}
+
+	machineInfo := standalone.GenerateMachineInfo("dev")
+
+	return savedKey, standalone.ResumeHandshake(draft.ClientID, draft.Name, machineInfo, displayKey)
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/inovacc/clonr/internal/standalone"
+	"github.com/inovacc/clonr/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var standaloneFilterCmd = &cobra.Command{
+	Use:   "filter <connection>",
+	Short: "Choose what a connection syncs",
+	Long: `Show or set the selective sync filter of a connection.
+
+The filter names the data types to sync (profiles, workspaces, repos,
+config) and the workspaces whose settings and repositories to sync. It is
+sent with every 'clonr standalone sync' request: the source sends only the
+selected data and this instance drops anything else it receives. Without a filter everything
+is synced. Changing the filter makes the next sync a full one.
+
+Examples:
+  # Show the filter
+  clonr standalone filter home-server
+
+  # Sync only repositories and workspaces, of the work workspace
+  clonr standalone filter home-server --types repos,workspaces --workspace work
+
+  # Sync everything again
+  clonr standalone filter home-server --clear`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStandaloneFilter,
+}
+
+func init() {
+	standaloneCmd.AddCommand(standaloneFilterCmd)
+
+	standaloneFilterCmd.Flags().StringSlice("types", nil, "Data types to sync: profiles, workspaces, repos, config")
+	standaloneFilterCmd.Flags().StringSlice("workspace", nil, "Workspace to sync, with its repositories (repeatable)")
+	standaloneFilterCmd.Flags().Bool("clear", false, "Remove the filter and sync everything")
+}
+
+func runStandaloneFilter(cmd *cobra.Command, args []string) error {
+	db := store.GetDB()
+
+	conn, err := db.GetStandaloneConnection(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	clearFilter, _ := cmd.Flags().GetBool("clear")
+	types, _ := cmd.Flags().GetStringSlice("types")
+	workspaces, _ := cmd.Flags().GetStringSlice("workspace")
+
+	changeTypes, changeWorkspaces := cmd.Flags().Changed("types"), cmd.Flags().Changed("workspace")
+
+	if clearFilter && (changeTypes || changeWorkspaces) {
+		return fmt.Errorf("--clear cannot be combined with --types or --workspace")
+	}
+
+	if !clearFilter && !changeTypes && !changeWorkspaces {
+		_, _ = fmt.Fprintf(os.Stdout, "%s syncs %s\n", conn.Name, conn.SyncFilter)
+		return nil
+	}
+
+	filter := standalone.SyncFilter{}
+
+	if !clearFilter {
+		filter = conn.SyncFilter
+
+		if changeTypes {
+			filter.DataTypes = types
+		}
+
+		if changeWorkspaces {
+			filter.Workspaces = workspaces
+		}
+	}
+
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	conn.SetSyncFilter(filter)
+
+	if err := db.SaveStandaloneConnection(conn); err != nil {
+		return fmt.Errorf("failed to save connection: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s now syncs %s\n", okStyle.Render("✓"), conn.Name, conn.SyncFilter)
+
+	return nil
+}
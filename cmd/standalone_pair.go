@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/params"
+	"github.com/inovacc/clonr/internal/server/grpc"
+	"github.com/inovacc/clonr/internal/standalone"
+	"github.com/inovacc/clonr/internal/store"
+	"github.com/skip2/go-qrcode"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pairScan bool
+	pairName string
+	pairHost string
+	pairPort int
+	pairTTL  time.Duration
+)
+
+var standalonePairCmd = &cobra.Command{
+	Use:   "pair [pairing-link]",
+	Short: "Pair another machine using a QR code",
+	Long: `Pair a second machine with this standalone server without typing keys.
+
+On the server, 'clonr standalone pair' shows a QR code encoding the server
+address, the instance fingerprint and a one-time pairing token. The token
+expires after 10 minutes (see --ttl) and can be redeemed only once.
+
+On the second machine, 'clonr standalone pair --scan' reads the pairing link
+from a QR scanner (handheld scanners type it like a keyboard), from a phone
+scan pasted at the prompt, or from the argument. It registers the machine
+with the server directly, so no 'clonr standalone accept' step is needed.
+The server fingerprint is verified before the connection is saved.
+
+The clonr server must be running on the server machine and reachable from
+the second machine.
+
+Examples:
+  # On the server: show the pairing QR code
+  clonr standalone pair
+
+  # On the second machine: scan and register
+  clonr standalone pair --scan
+
+  # Pass the link directly
+  clonr standalone pair --scan "clonr-pair://192.168.1.10:50051?..."`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runStandalonePair,
+}
+
+func init() {
+	standaloneCmd.AddCommand(standalonePairCmd)
+
+	standalonePairCmd.Flags().BoolVar(&pairScan, "scan", false, "Read a pairing link and register this machine")
+	standalonePairCmd.Flags().StringVarP(&pairName, "name", "n", "", "Connection name (default: auto-generated)")
+	standalonePairCmd.Flags().StringVar(&pairHost, "host", "", "Server address in the pairing link (auto-detected if not specified)")
+	standalonePairCmd.Flags().IntVarP(&pairPort, "port", "p", 0, "Server port in the pairing link (default: running server's port)")
+	standalonePairCmd.Flags().DurationVar(&pairTTL, "ttl", standalone.DefaultPairingTTL, "How long the pairing link stays valid")
+}
+
+func runStandalonePair(_ *cobra.Command, args []string) error {
+	if pairScan {
+		return runStandalonePairScan(args)
+	}
+
+	if len(args) > 0 {
+		return fmt.Errorf("a pairing link can only be given with --scan")
+	}
+
+	return runStandalonePairShow()
+}
+
+// runStandalonePairShow creates a one-time pairing invite and renders it as a QR code
+func runStandalonePairShow() error {
+	db := store.GetDB()
+
+	config, err := db.GetStandaloneConfig()
+	if err != nil || config == nil || !config.Enabled {
+		return fmt.Errorf("standalone mode is not initialized (run 'clonr standalone init' first)")
+	}
+
+	if !config.IsServer {
+		return fmt.Errorf("this command is only available on standalone server instances")
+	}
+
+	port := pairPort
+	if port == 0 {
+		info := grpc.IsServerRunning()
+		if info == nil {
+			return fmt.Errorf("the clonr server is not running (start it with 'clonr server start')")
+		}
+
+		port = info.Port
+	}
+
+	host := pairHost
+	if host == "" {
+		host, err = standalone.GetLocalIP()
+		if err != nil {
+			return fmt.Errorf("could not detect local IP, use --host: %w", err)
+		}
+	}
+
+	invite, err := standalone.NewPairingInvite(config, host, port, pairTTL)
+	if err != nil {
+		return err
+	}
+
+	if err := standalone.SavePairingToken(filepath.Join(params.AppdataDir, standalone.PairingTokensFile), invite); err != nil {
+		return err
+	}
+
+	link := invite.URI()
+
+	qr, err := qrcode.New(link, qrcode.Medium)
+	if err != nil {
+		return fmt.Errorf("failed to generate QR code: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, qr.ToSmallString(false))
+	_, _ = fmt.Fprintf(os.Stdout, "Fingerprint: %s\n", invite.Fingerprint)
+	_, _ = fmt.Fprintf(os.Stdout, "Expires:     %s\n", invite.ExpiresAt.Format("15:04:05"))
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "On the other machine run: clonr standalone pair --scan")
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Pairing link: "+link))
+
+	return nil
+}
+
+// runStandalonePairScan redeems a pairing link and saves the connection
+func runStandalonePairScan(args []string) error {
+	db := store.GetDB()
+
+	var link string
+
+	if len(args) > 0 {
+		link = args[0]
+	} else {
+		_, _ = fmt.Fprint(os.Stderr, "Scan the QR code or paste the pairing link: ")
+
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("failed to read pairing link: %w", err)
+		}
+
+		link = strings.TrimSpace(line)
+	}
+
+	invite, err := standalone.ParsePairingURI(link)
+	if err != nil {
+		return err
+	}
+
+	if invite.IsExpired() {
+		return fmt.Errorf("pairing link expired at %s (run 'clonr standalone pair' on the server again)", invite.ExpiresAt.Format("15:04:05"))
+	}
+
+	name := pairName
+	if name == "" {
+		name = "server-" + strings.ToLower(strings.ReplaceAll(invite.Fingerprint, "-", "")[:8])
+	}
+
+	localPassword, err := readArchivePassword("Enter a local password to secure this connection: ")
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+
+	if len(localPassword) < 8 {
+		return fmt.Errorf("password must be at least 8 characters")
+	}
+
+	confirm, err := readArchivePassword("Confirm password: ")
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+
+	if localPassword != confirm {
+		return fmt.Errorf("passwords do not match")
+	}
+
+	handshake := standalone.NewHandshake(name, standalone.GenerateMachineInfo("dev"))
+
+	displayKey, err := handshake.GenerateKey()
+	if err != nil {
+		return fmt.Errorf("failed to generate encryption key: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Pairing with %s...\n", invite.Address())
+
+	instanceID, fingerprint, syncPort, err := clientgrpc.PairDevice(invite, handshake.GetRegistration(), displayKey)
+	if err != nil {
+		return fmt.Errorf("pairing failed: %w", err)
+	}
+
+	if fingerprint != invite.Fingerprint {
+		return fmt.Errorf("server fingerprint %s does not match the pairing link (%s); not saving the connection", fingerprint, invite.Fingerprint)
+	}
+
+	existing, _ := db.ListStandaloneConnections()
+	for _, conn := range existing {
+		if conn.InstanceID == instanceID {
+			return fmt.Errorf("already connected to instance %s (connection: %s)", shortID(instanceID), conn.Name)
+		}
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Server verified (fingerprint %s)\n", fingerprint)
+
+	conn, err := standalone.NewPairedConnection(name, instanceID, invite, handshake.GetFullKey(), localPassword)
+	if err != nil {
+		return fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	// Syncs go to the sync service, not to the server the invite points to
+	conn.ClientID = handshake.GetRegistration().ClientID
+	if syncPort > 0 {
+		conn.Port = syncPort
+	}
+
+	if err := db.SaveStandaloneConnection(conn); err != nil {
+		return fmt.Errorf("failed to save connection: %w", err)
+	}
+
+	handshake.Complete()
+
+	_, _ = fmt.Fprintln(os.Stdout)
+	_, _ = fmt.Fprintln(os.Stdout, "Paired successfully!")
+	_, _ = fmt.Fprintf(os.Stdout, "  Name: %s\n", conn.Name)
+	_, _ = fmt.Fprintf(os.Stdout, "  Instance: %s\n", shortID(conn.InstanceID))
+
+	return nil
+}
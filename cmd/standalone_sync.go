@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/standalone"
+	"github.com/inovacc/clonr/internal/store"
+	"github.com/spf13/cobra"
+)
+
+var standaloneSyncCmd = &cobra.Command{
+	Use:   "sync <connection>",
+	Short: "Sync data from a connection",
+	Long: `Fetch the data changed on a standalone instance since the last sync.
+
+The instance must have approved this machine. Only the changes since the
+last sync are fetched, limited to the filter of the connection (see
+'clonr standalone filter'). The data stays encrypted with the key of this
+machine until it is decrypted.
+
+Examples:
+  clonr standalone sync home-server`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStandaloneSync,
+}
+
+func init() {
+	standaloneCmd.AddCommand(standaloneSyncCmd)
+}
+
+func runStandaloneSync(_ *cobra.Command, args []string) error {
+	db := store.GetDB()
+
+	conn, err := db.GetStandaloneConnection(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	if conn.ClientID == "" {
+		return fmt.Errorf("connection %s has no client registration; connect again with 'clonr standalone connect'", conn.Name)
+	}
+
+	password, err := readArchivePassword("Enter local password: ")
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+
+	clientKey, err := standalone.DecryptClientKey(conn, password)
+	if err != nil {
+		return err
+	}
+
+	defer standalone.SecureZero(clientKey)
+
+	_, _ = fmt.Fprintf(os.Stderr, "Syncing from %s:%d...\n", conn.Host, conn.Port)
+
+	pkg, err := clientgrpc.SyncStandalone(conn, clientKey)
+	if err != nil {
+		conn.SyncStatus = standalone.StatusError
+		conn.UpdatedAt = time.Now()
+		_ = db.SaveStandaloneConnection(conn)
+
+		return fmt.Errorf("sync failed: %w", err)
+	}
+
+	stored, removed, err := standalone.ApplySyncPackage(db, conn, pkg)
+	if err != nil {
+		return err
+	}
+
+	conn.SyncStatus = standalone.StatusConnected
+	conn.UpdatedAt = time.Now()
+
+	if err := db.SaveStandaloneConnection(conn); err != nil {
+		return fmt.Errorf("failed to save connection: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s synced: %d stored, %d removed\n", okStyle.Render("✓"), conn.Name, stored, removed)
+
+	return nil
+}
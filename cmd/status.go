@@ -3,20 +3,118 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"text/tabwriter"
 
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
 	"github.com/spf13/cobra"
 )
 
 var statusCmd = &cobra.Command{
 	Use:   "status [name]",
 	Short: "Show git status of repositories",
-	Long:  `Display the git status of all managed repositories or a specific repository.`,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		_, _ = fmt.Fprintln(os.Stdout, "Status command - to be implemented")
-		return nil
-	},
+	Long: `Display the git status of all managed repositories or a specific repository.
+
+For each repository the current branch, the number of uncommitted changes and
+its submodules are shown. Submodules that are not checked out at the commit
+recorded by the repository (or not cloned at all) are listed with a warning.
+
+Examples:
+  clonr status
+  clonr status cli
+  clonr status -w work --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runStatus,
 }
 
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	statusCmd.Flags().StringP("workspace", "w", "", "Only repositories in this workspace")
+	statusCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	workspace, _ := cmd.Flags().GetString("workspace")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var repos []model.Repository
+
+	if len(args) > 0 {
+		repo, err := core.ResolveRepo(args[0])
+		if err != nil {
+			return err
+		}
+
+		repos = []model.Repository{*repo}
+	} else {
+		client, err := grpc.GetClient()
+		if err != nil {
+			return err
+		}
+
+		repos, err = client.GetRepos(workspace, false)
+		if err != nil {
+			return fmt.Errorf("failed to get repositories: %w", err)
+		}
+	}
+
+	statuses := make([]core.RepoStatus, len(repos))
+	for i := range repos {
+		statuses[i] = core.GetRepoStatus(&repos[i])
+	}
+
+	if jsonOutput {
+		return outputJSON(statuses)
+	}
+
+	if len(statuses) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "REPOSITORY\tBRANCH\tCHANGES\tSUBMODULES")
+
+	var outOfSync []core.RepoStatus
+
+	for _, s := range statuses {
+		if s.Error != "" {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Path, "-", "-", warnStyle.Render(s.Error))
+			continue
+		}
+
+		changes := okStyle.Render("clean")
+		if s.Changes > 0 {
+			changes = warnStyle.Render(fmt.Sprintf("%d", s.Changes))
+		}
+
+		submodules := dimStyle.Render("-")
+
+		switch n := len(s.SubmodulesOutOfSync()); {
+		case n > 0:
+			submodules = warnStyle.Render(fmt.Sprintf("%d (%d out of sync)", len(s.Submodules), n))
+			outOfSync = append(outOfSync, s)
+		case len(s.Submodules) > 0:
+			submodules = okStyle.Render(fmt.Sprintf("%d ✓", len(s.Submodules)))
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Path, s.Branch, changes, submodules)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, s := range outOfSync {
+		_, _ = fmt.Fprintln(os.Stdout, warnStyle.Render(fmt.Sprintf("\n⚠ Submodules out of sync in %s:", s.Path)))
+
+		for _, sub := range s.SubmodulesOutOfSync() {
+			_, _ = fmt.Fprintf(os.Stdout, "  %s (%s)\n", sub.Path, sub.State)
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render(fmt.Sprintf("  Sync them with: git -C %s submodule update --init --recursive", s.Path)))
+	}
+
+	return nil
 }
@@ -86,6 +86,7 @@ Examples:
   clonr teams channels <team-id>
   clonr teams messages <team-id> <channel-id>
   clonr teams chats`,
+	Annotations: map[string]string{networkAnnotation: "Microsoft Teams"},
 	Run: func(cmd *cobra.Command, args []string) {
 		_ = cmd.Help()
 	},
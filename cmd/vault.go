@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var vaultCmd = &cobra.Command{
+	Use:   "vault",
+	Short: "Store arbitrary secrets encrypted with the TPM",
+	Long: `Store arbitrary secrets such as API tokens and registry passwords in the
+clonr database, encrypted the same way as profile tokens: sealed with the
+TPM, or with the keystore when no TPM is available.
+
+Keys are environment variable names, so secrets can be injected into the
+environment of commands with 'clonr vault exec' or the --secret flag of
+'clonr workspace exec'. Every decryption is recorded in the audit log.
+
+Examples:
+  clonr vault set NPM_TOKEN                       # Prompts for the value
+  echo "$PASSWORD" | clonr vault set REGISTRY_PASSWORD
+  clonr vault get NPM_TOKEN
+  clonr vault list
+  clonr vault rm NPM_TOKEN
+  clonr vault exec NPM_TOKEN -- npm publish
+  clonr workspace exec work --secret NPM_TOKEN -- npm publish`,
+}
+
+var vaultSetCmd = &cobra.Command{
+	Use:   "set <key>",
+	Short: "Store a secret, reading its value from the terminal or stdin",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runVaultSet,
+}
+
+var vaultGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the decrypted value of a secret",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runVaultGet,
+}
+
+var vaultListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List stored secrets without their values",
+	Args:    cobra.NoArgs,
+	RunE:    runVaultList,
+}
+
+var vaultRmCmd = &cobra.Command{
+	Use:     "rm <key>",
+	Aliases: []string{"remove"},
+	Short:   "Remove a secret",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runVaultRm,
+}
+
+var vaultExecCmd = &cobra.Command{
+	Use:   "exec [key...] -- <command> [args...]",
+	Short: "Run a command with secrets in its environment",
+	Long: `Run a command with vault secrets added to its environment. Without keys,
+every secret in the vault is added. Use it to give hooks and scripts access
+to secrets without writing them to disk.
+
+Examples:
+  clonr vault exec NPM_TOKEN -- npm publish
+  clonr vault exec -- ./deploy.sh`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.ArgsLenAtDash() < 0 || cmd.ArgsLenAtDash() == len(args) {
+			return fmt.Errorf("usage: clonr vault exec [key...] -- <command> [args...]")
+		}
+
+		return nil
+	},
+	RunE: runVaultExec,
+}
+
+func init() {
+	rootCmd.AddCommand(vaultCmd)
+	vaultCmd.AddCommand(vaultSetCmd)
+	vaultCmd.AddCommand(vaultGetCmd)
+	vaultCmd.AddCommand(vaultListCmd)
+	vaultCmd.AddCommand(vaultRmCmd)
+	vaultCmd.AddCommand(vaultExecCmd)
+
+	vaultListCmd.Flags().Bool("json", false, "Output as JSON")
+}
+
+func runVaultSet(_ *cobra.Command, args []string) error {
+	key := args[0]
+
+	if err := core.ValidateVaultKey(key); err != nil {
+		return err
+	}
+
+	value, err := readPassword(fmt.Sprintf("Value for %s: ", key))
+	if err != nil {
+		return err
+	}
+
+	if err := core.SetVaultSecret(key, value); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Stored secret"), key)
+
+	return nil
+}
+
+func runVaultGet(_ *cobra.Command, args []string) error {
+	value, err := core.GetVaultSecret(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, value)
+
+	return nil
+}
+
+// VaultSecretInfo is the JSON output of 'clonr vault list'
+type VaultSecretInfo struct {
+	Key       string `json:"key"`
+	Encrypted bool   `json:"encrypted"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func runVaultList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	secrets, err := core.ListVaultSecrets()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		infos := make([]VaultSecretInfo, 0, len(secrets))
+		for i := range secrets {
+			infos = append(infos, vaultSecretInfo(&secrets[i]))
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(infos)
+	}
+
+	if len(secrets) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No secrets. Store one with 'clonr vault set <key>'")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "KEY\tUPDATED\tSTORAGE")
+
+	for i := range secrets {
+		storage := "encrypted"
+		if !core.IsVaultSealed(&secrets[i]) {
+			storage = warnStyle.Render("plain text")
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", secrets[i].Key, formatAge(secrets[i].UpdatedAt), storage)
+	}
+
+	return w.Flush()
+}
+
+func runVaultRm(_ *cobra.Command, args []string) error {
+	if err := core.RemoveVaultSecret(args[0]); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Removed secret"), args[0])
+
+	return nil
+}
+
+func runVaultExec(cmd *cobra.Command, args []string) error {
+	dash := cmd.ArgsLenAtDash()
+	keys, command := args[:dash], args[dash:]
+
+	if len(keys) == 0 {
+		secrets, err := core.ListVaultSecrets()
+		if err != nil {
+			return err
+		}
+
+		for _, s := range secrets {
+			keys = append(keys, s.Key)
+		}
+	}
+
+	env, err := core.VaultEnv(keys)
+	if err != nil {
+		return err
+	}
+
+	c := exec.CommandContext(cmd.Context(), command[0], command[1:]...)
+	c.Env = append(os.Environ(), env...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+
+	return c.Run()
+}
+
+// vaultSecretInfo describes a secret without its value
+func vaultSecretInfo(secret *model.VaultSecret) VaultSecretInfo {
+	return VaultSecretInfo{
+		Key:       secret.Key,
+		Encrypted: core.IsVaultSealed(secret),
+		CreatedAt: secret.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: secret.UpdatedAt.Format(time.RFC3339),
+	}
+}
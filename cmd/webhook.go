@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"text/tabwriter"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/spf13/cobra"
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Pull repositories when GitHub or GitLab push webhooks arrive",
+	Long: `Let the clonr web server pull tracked repositories as soon as they are
+pushed to, instead of waiting for the next update.
+
+Point a push webhook of the repository on GitHub or GitLab at the web server:
+
+  GitHub: <web server>/webhooks/github  (content type application/json)
+  GitLab: <web server>/webhooks/gitlab
+
+and use the secret shown by 'clonr webhook secret'. GitHub requests must carry
+a valid X-Hub-Signature-256 signature and GitLab requests the secret as their
+X-Gitlab-Token; other requests are rejected. Only repositories enabled with
+'clonr webhook enable' are pulled.
+
+The web server must be reachable by the Git host: start it with
+--web-host 0.0.0.0, typically behind a reverse proxy or tunnel. Webhook
+endpoints are the only ones besides share links served to other machines.
+
+Examples:
+  clonr webhook enable            # Repository in the current directory
+  clonr webhook enable api        # By name, URL or path
+  clonr webhook disable api
+  clonr webhook list
+  clonr webhook secret --rotate`,
+}
+
+var webhookEnableCmd = &cobra.Command{
+	Use:   "enable [repo]",
+	Short: "Pull a repository when a push webhook for it arrives",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  func(_ *cobra.Command, args []string) error { return setWebhook(argOrEmpty(args, 0), true) },
+}
+
+var webhookDisableCmd = &cobra.Command{
+	Use:   "disable [repo]",
+	Short: "Stop pulling a repository on push webhooks",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  func(_ *cobra.Command, args []string) error { return setWebhook(argOrEmpty(args, 0), false) },
+}
+
+var webhookListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List repositories pulled on push webhooks",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE:    runWebhookList,
+}
+
+var webhookSecretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Show the secret to configure webhooks with",
+	Long: `Show the secret GitHub signs webhooks with and GitLab sends as their
+token. With --rotate a new secret is generated; webhooks still using the old
+one are rejected until they are updated.`,
+	Args: cobra.NoArgs,
+	RunE: runWebhookSecret,
+}
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookEnableCmd)
+	webhookCmd.AddCommand(webhookDisableCmd)
+	webhookCmd.AddCommand(webhookListCmd)
+	webhookCmd.AddCommand(webhookSecretCmd)
+
+	webhookListCmd.Flags().Bool("json", false, "Output as JSON")
+	webhookSecretCmd.Flags().Bool("rotate", false, "Generate a new secret")
+}
+
+func setWebhook(query string, enabled bool) error {
+	repo, err := core.ResolveRepo(query)
+	if err != nil {
+		return err
+	}
+
+	changed, err := core.SetWebhookEnabled(repo, enabled)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !changed && enabled:
+		_, _ = fmt.Fprintf(os.Stdout, "Webhooks are already enabled for %s\n", repo.URL)
+	case !changed:
+		_, _ = fmt.Fprintf(os.Stdout, "Webhooks are not enabled for %s\n", repo.URL)
+	case enabled:
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Webhooks enabled for"), repo.URL)
+		printWebhookEndpoints()
+	default:
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", okStyle.Render("Webhooks disabled for"), repo.URL)
+	}
+
+	return nil
+}
+
+func runWebhookList(cmd *cobra.Command, _ []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	repos, err := core.ListWebhookRepos()
+	if err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(repos)
+	}
+
+	if len(repos) == 0 {
+		_, _ = fmt.Fprintln(os.Stdout, "No repositories have webhooks enabled.")
+		_, _ = fmt.Fprintln(os.Stdout, "Enable one with: clonr webhook enable <repo>")
+
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "URL\tPATH\tUPDATED")
+
+	for _, repo := range repos {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", repo.URL, repo.Path, formatAge(repo.UpdatedAt))
+	}
+
+	_ = w.Flush()
+
+	_, _ = fmt.Fprintln(os.Stdout)
+	printWebhookEndpoints()
+
+	return nil
+}
+
+func runWebhookSecret(cmd *cobra.Command, _ []string) error {
+	rotate, _ := cmd.Flags().GetBool("rotate")
+
+	var (
+		secret string
+		err    error
+	)
+
+	if rotate {
+		secret, err = core.RotateWebhookSecret()
+	} else {
+		secret, err = core.WebhookSecret()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, secret)
+
+	if rotate {
+		_, _ = fmt.Fprintln(os.Stderr, warnStyle.Render("Update the secret of every configured webhook; the old one is no longer accepted"))
+	}
+
+	return nil
+}
+
+// printWebhookEndpoints shows the payload URLs of the running web server
+func printWebhookEndpoints() {
+	base, err := shareServerURL()
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Start the web server with 'clonr server start' to receive webhooks"))
+		return
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "GitHub payload URL: %s\n", u.JoinPath("/webhooks", core.WebhookGitHub))
+	_, _ = fmt.Fprintf(os.Stdout, "GitLab payload URL: %s\n", u.JoinPath("/webhooks", core.WebhookGitLab))
+	_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Secret: clonr webhook secret"))
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -23,7 +24,11 @@ var workspaceCmd = &cobra.Command{
 	Long: `Manage workspaces for organizing repositories.
 
 Workspaces allow you to logically separate repositories (e.g., work, personal, corporate).
-Each workspace has its own base clone directory.`,
+Each workspace has its own base clone directory.
+
+URL patterns route clones automatically: with a pattern such as
+github.com/my-company/* on the work workspace, 'clonr clone' of any
+my-company repository lands in work without prompting.`,
 }
 
 var workspaceAddCmd = &cobra.Command{
@@ -33,7 +38,8 @@ var workspaceAddCmd = &cobra.Command{
 
 Examples:
   clonr workspace add personal --path ~/clonr/personal
-  clonr workspace add work --path ~/clonr/work --description "Work projects"`,
+  clonr workspace add work --path ~/clonr/work --description "Work projects"
+  clonr workspace add work --path ~/clonr/work --pattern "github.com/my-company/*"`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWorkspaceAdd,
 }
@@ -106,14 +112,16 @@ var workspaceEditCmd = &cobra.Command{
 	Short: "Edit a workspace",
 	Long: `Edit an existing workspace's properties.
 
-You can modify the workspace name, path, or description.
+You can modify the workspace name, path, description, or URL patterns.
 At least one flag must be provided.
 
 Examples:
   clonr workspace edit personal --name private
   clonr workspace edit work --path ~/new/work/path
   clonr workspace edit work --description "Updated description"
-  clonr workspace edit personal --name private --description "Private projects"`,
+  clonr workspace edit personal --name private --description "Private projects"
+  clonr workspace edit work --add-pattern "gitlab.com/my-company/*"
+  clonr workspace edit work --remove-pattern "github.com/old-org/*"`,
 	Args: cobra.ExactArgs(1),
 	RunE: runWorkspaceEdit,
 }
@@ -130,6 +138,23 @@ Examples:
 	RunE: runWorkspaceInfo,
 }
 
+var workspaceMatchCmd = &cobra.Command{
+	Use:   "match <url>",
+	Short: "Show which workspace a repository URL is routed to",
+	Long: `Show which workspace a repository would be cloned into based on the
+workspaces' URL patterns.
+
+Patterns are globs matched against host/owner/repo. A pattern without
+wildcards also matches every repository below it. When several patterns
+match, the most specific one wins.
+
+Examples:
+  clonr workspace match https://github.com/my-company/api
+  clonr workspace match git@gitlab.com:team/infra.git`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorkspaceMatch,
+}
+
 var workspaceMapCmd = &cobra.Command{
 	Use:   "map <name>",
 	Short: "Scan workspace directory for Git repositories",
@@ -150,12 +175,15 @@ Examples:
 var (
 	workspaceAddPath        string
 	workspaceAddDescription string
+	workspaceAddPatterns    []string
 	workspaceClonePath      string
 	workspaceCloneDesc      string
 	workspaceListJSON       bool
 	workspaceEditName       string
 	workspaceEditPath       string
 	workspaceEditDesc       string
+	workspaceEditAddPattern []string
+	workspaceEditRmPattern  []string
 	workspaceInfoJSON       bool
 	workspaceMapDryRun      bool
 	workspaceMapDepth       int
@@ -176,10 +204,12 @@ func init() {
 	workspaceCmd.AddCommand(workspaceEditCmd)
 	workspaceCmd.AddCommand(workspaceInfoCmd)
 	workspaceCmd.AddCommand(workspaceMapCmd)
+	workspaceCmd.AddCommand(workspaceMatchCmd)
 
 	// Add flags
 	workspaceAddCmd.Flags().StringVar(&workspaceAddPath, "path", "", "Base directory for this workspace (required)")
 	workspaceAddCmd.Flags().StringVar(&workspaceAddDescription, "description", "", "Description of the workspace")
+	workspaceAddCmd.Flags().StringArrayVar(&workspaceAddPatterns, "pattern", nil, "URL pattern routing clones to this workspace (repeatable)")
 
 	workspaceListCmd.Flags().BoolVar(&workspaceListJSON, "json", false, "Output as JSON")
 
@@ -189,6 +219,8 @@ func init() {
 	workspaceEditCmd.Flags().StringVar(&workspaceEditName, "name", "", "New name for the workspace")
 	workspaceEditCmd.Flags().StringVar(&workspaceEditPath, "path", "", "New path for the workspace")
 	workspaceEditCmd.Flags().StringVar(&workspaceEditDesc, "description", "", "New description for the workspace")
+	workspaceEditCmd.Flags().StringArrayVar(&workspaceEditAddPattern, "add-pattern", nil, "Add a URL pattern (repeatable)")
+	workspaceEditCmd.Flags().StringArrayVar(&workspaceEditRmPattern, "remove-pattern", nil, "Remove a URL pattern (repeatable)")
 
 	workspaceInfoCmd.Flags().BoolVar(&workspaceInfoJSON, "json", false, "Output as JSON")
 
@@ -228,6 +260,11 @@ func runWorkspaceAdd(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	patterns, err := normalizeURLPatterns(workspaceAddPatterns)
+	if err != nil {
+		return err
+	}
+
 	// Create directory if it doesn't exist
 	if _, err := os.Stat(absPath); os.IsNotExist(err) {
 		if err := os.MkdirAll(absPath, 0755); err != nil {
@@ -242,6 +279,7 @@ func runWorkspaceAdd(_ *cobra.Command, args []string) error {
 		Name:        name,
 		Description: workspaceAddDescription,
 		Path:        absPath,
+		URLPatterns: patterns,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -252,6 +290,11 @@ func runWorkspaceAdd(_ *cobra.Command, args []string) error {
 
 	_, _ = fmt.Fprintf(os.Stdout, "Workspace '%s' created\n", name)
 	_, _ = fmt.Fprintf(os.Stdout, "Path: %s\n", absPath)
+
+	if len(patterns) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "URL patterns: %s\n", strings.Join(patterns, ", "))
+	}
+
 	_, _ = fmt.Fprintf(os.Stdout, "Associate a profile with: clonr profile add <name> --workspace %s\n", name)
 
 	return nil
@@ -259,11 +302,12 @@ func runWorkspaceAdd(_ *cobra.Command, args []string) error {
 
 // WorkspaceListItem represents a workspace in JSON output
 type WorkspaceListItem struct {
-	Name        string `json:"name"`
-	Path        string `json:"path"`
-	Description string `json:"description,omitempty"`
-	RepoCount   int    `json:"repo_count"`
-	Profiles    int    `json:"profiles"`
+	Name        string   `json:"name"`
+	Path        string   `json:"path"`
+	Description string   `json:"description,omitempty"`
+	RepoCount   int      `json:"repo_count"`
+	Profiles    int      `json:"profiles"`
+	URLPatterns []string `json:"url_patterns,omitempty"`
 }
 
 func runWorkspaceList(_ *cobra.Command, _ []string) error {
@@ -308,6 +352,7 @@ func runWorkspaceList(_ *cobra.Command, _ []string) error {
 				Description: w.Description,
 				RepoCount:   repoCount,
 				Profiles:    profileCount,
+				URLPatterns: w.URLPatterns,
 			})
 		}
 
@@ -328,6 +373,10 @@ func runWorkspaceList(_ *cobra.Command, _ []string) error {
 			_, _ = fmt.Fprintf(os.Stdout, "    Description: %s\n", w.Description)
 		}
 
+		if len(w.URLPatterns) > 0 {
+			_, _ = fmt.Fprintf(os.Stdout, "    Patterns: %s\n", strings.Join(w.URLPatterns, ", "))
+		}
+
 		// Count repos in workspace
 		repoCount := countReposInWorkspace(client, w.Name, w.Path, allRepos)
 		if repoCount > 0 {
@@ -566,8 +615,9 @@ func runWorkspaceEdit(_ *cobra.Command, args []string) error {
 	name := args[0]
 
 	// Check if at least one flag is provided
-	if workspaceEditName == "" && workspaceEditPath == "" && workspaceEditDesc == "" {
-		return fmt.Errorf("at least one of --name, --path, or --description must be provided")
+	if workspaceEditName == "" && workspaceEditPath == "" && workspaceEditDesc == "" &&
+		len(workspaceEditAddPattern) == 0 && len(workspaceEditRmPattern) == 0 {
+		return fmt.Errorf("at least one of --name, --path, --description, --add-pattern, or --remove-pattern must be provided")
 	}
 
 	client, err := grpc.GetClient()
@@ -651,6 +701,14 @@ func runWorkspaceEdit(_ *cobra.Command, args []string) error {
 		workspace.Description = workspaceEditDesc
 	}
 
+	// Update URL patterns if provided
+	patternChanges, err := editURLPatterns(workspace, workspaceEditAddPattern, workspaceEditRmPattern)
+	if err != nil {
+		return err
+	}
+
+	changes = append(changes, patternChanges...)
+
 	// No actual changes
 	if len(changes) == 0 {
 		_, _ = fmt.Fprintln(os.Stdout, "No changes to apply.")
@@ -711,6 +769,7 @@ type WorkspaceInfoItem struct {
 	RepoCount   int       `json:"repo_count"`
 	Repos       []string  `json:"repos,omitempty"`
 	Profiles    []string  `json:"profiles,omitempty"`
+	URLPatterns []string  `json:"url_patterns,omitempty"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 	DiskUsage   string    `json:"disk_usage,omitempty"`
@@ -795,6 +854,7 @@ func runWorkspaceInfo(_ *cobra.Command, args []string) error {
 			RepoCount:   len(repos),
 			Repos:       repos,
 			Profiles:    profileNames,
+			URLPatterns: workspace.URLPatterns,
 			CreatedAt:   workspace.CreatedAt,
 			UpdatedAt:   workspace.UpdatedAt,
 			DiskUsage:   diskUsage,
@@ -825,6 +885,10 @@ func runWorkspaceInfo(_ *cobra.Command, args []string) error {
 		_, _ = fmt.Fprintf(os.Stdout, "Profiles: %s\n", strings.Join(profileNames, ", "))
 	}
 
+	if len(workspace.URLPatterns) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "URL Patterns: %s\n", strings.Join(workspace.URLPatterns, ", "))
+	}
+
 	if diskUsage != "" {
 		_, _ = fmt.Fprintf(os.Stdout, "Disk Usage: %s\n", diskUsage)
 	}
@@ -913,7 +977,7 @@ func isPathWithin(childPath, parentPath string) bool {
 	return strings.HasPrefix(child, parent)
 }
 
-func runWorkspaceMap(_ *cobra.Command, args []string) error {
+func runWorkspaceMap(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
 	client, err := grpc.GetClient()
@@ -948,5 +1012,87 @@ func runWorkspaceMap(_ *cobra.Command, args []string) error {
 		Workspace: name,
 	}
 
-	return core.MapReposWithOptions([]string{workspace.Path}, opts)
+	return core.MapReposWithOptions(cmd.Context(), []string{workspace.Path}, opts)
+}
+
+// normalizeURLPatterns validates URL patterns and drops duplicates
+func normalizeURLPatterns(patterns []string) ([]string, error) {
+	var result []string
+
+	for _, p := range patterns {
+		normalized, err := core.ValidateURLPattern(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(result, normalized) {
+			result = append(result, normalized)
+		}
+	}
+
+	return result, nil
+}
+
+// editURLPatterns adds and removes URL patterns on a workspace and returns
+// the change descriptions
+func editURLPatterns(workspace *model.Workspace, add, remove []string) ([]string, error) {
+	var changes []string
+
+	for _, p := range remove {
+		normalized := core.NormalizeURLPattern(p)
+
+		i := slices.Index(workspace.URLPatterns, normalized)
+		if i < 0 {
+			return nil, fmt.Errorf("workspace '%s' has no URL pattern '%s'", workspace.Name, p)
+		}
+
+		workspace.URLPatterns = slices.Delete(workspace.URLPatterns, i, i+1)
+		changes = append(changes, "removed pattern: "+normalized)
+	}
+
+	added, err := normalizeURLPatterns(add)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range added {
+		if slices.Contains(workspace.URLPatterns, p) {
+			continue
+		}
+
+		workspace.URLPatterns = append(workspace.URLPatterns, p)
+		changes = append(changes, "added pattern: "+p)
+	}
+
+	return changes, nil
+}
+
+func runWorkspaceMatch(_ *cobra.Command, args []string) error {
+	key := core.RepoKey(args[0])
+	if key == "" {
+		return fmt.Errorf("invalid repository URL: %s", args[0])
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	match := core.MatchWorkspace(workspaces, key)
+	if match == nil {
+		_, _ = fmt.Fprintf(os.Stdout, "%s matches no workspace pattern\n", key)
+		_, _ = fmt.Fprintln(os.Stdout, dimStyle.Render("Clones use the selected profile's workspace or the active workspace."))
+
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "%s → workspace '%s' (pattern '%s')\n", key, match.Workspace.Name, match.Pattern)
+	_, _ = fmt.Fprintf(os.Stdout, "Path: %s\n", match.Workspace.Path)
+
+	return nil
 }
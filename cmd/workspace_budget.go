@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var workspaceBudgetCmd = &cobra.Command{
+	Use:   "budget [name]",
+	Short: "Set or check the disk budget of workspaces",
+	Long: `Set a disk budget for a workspace's clone directory, or show usage
+against the configured budgets.
+
+The server checks budgets every few hours and warns through the configured
+notification channels when usage crosses 80%, 90% and 100%, suggesting
+non-favorite repositories untouched for 30 days as prune candidates.
+
+Sizes accept KB, MB, GB and TB suffixes (powers of 1024).
+
+Examples:
+  clonr workspace budget                    # Usage of all workspaces with a budget
+  clonr workspace budget work               # Usage of one workspace
+  clonr workspace budget work --set 20GB    # Set a 20 GB budget
+  clonr workspace budget work --clear       # Remove the budget`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkspaceBudget,
+}
+
+var (
+	workspaceBudgetSet   string
+	workspaceBudgetClear bool
+	workspaceBudgetJSON  bool
+)
+
+func init() {
+	workspaceCmd.AddCommand(workspaceBudgetCmd)
+
+	workspaceBudgetCmd.Flags().StringVar(&workspaceBudgetSet, "set", "", "Set the disk budget, e.g. 20GB")
+	workspaceBudgetCmd.Flags().BoolVar(&workspaceBudgetClear, "clear", false, "Remove the disk budget")
+	workspaceBudgetCmd.Flags().BoolVar(&workspaceBudgetJSON, "json", false, "Output as JSON")
+	workspaceBudgetCmd.MarkFlagsMutuallyExclusive("set", "clear")
+}
+
+func runWorkspaceBudget(_ *cobra.Command, args []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	if workspaceBudgetSet != "" || workspaceBudgetClear {
+		if len(args) == 0 {
+			return fmt.Errorf("workspace name is required with --set or --clear")
+		}
+
+		return setWorkspaceBudget(client, args[0])
+	}
+
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	if len(args) > 0 {
+		var selected []model.Workspace
+
+		for _, ws := range workspaces {
+			if ws.Name == args[0] {
+				selected = append(selected, ws)
+			}
+		}
+
+		if len(selected) == 0 {
+			return fmt.Errorf("workspace '%s' not found", args[0])
+		}
+
+		workspaces = selected
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	statuses := core.CheckDiskBudgets(workspaces, repos, time.Now())
+
+	if workspaceBudgetJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(statuses)
+	}
+
+	if len(statuses) == 0 {
+		printEmptyResult("disk budgets", "clonr workspace budget <name> --set 20GB")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "WORKSPACE\tUSED\tBUDGET\tUSAGE")
+
+	for _, s := range statuses {
+		usage := fmt.Sprintf("%d%%", s.Percent)
+
+		switch {
+		case s.Percent >= 100:
+			usage = errStyle.Render(usage)
+		case s.OverThreshold():
+			usage = warnStyle.Render(usage)
+		default:
+			usage = okStyle.Render(usage)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Workspace, core.FormatSize(s.Used), core.FormatSize(s.Budget), usage)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, s := range statuses {
+		if len(s.PruneCandidates) == 0 {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "\nPrune candidates in %s (not updated for 30+ days):\n", s.Workspace)
+
+		for _, c := range s.PruneCandidates {
+			_, _ = fmt.Fprintf(os.Stdout, "  %10s  %s\n", core.FormatSize(c.Bytes), c.URL)
+		}
+	}
+
+	return nil
+}
+
+// setWorkspaceBudget sets or clears the disk budget of a workspace
+func setWorkspaceBudget(client *grpc.Client, name string) error {
+	workspace, err := client.GetWorkspace(name)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	if workspace == nil {
+		return fmt.Errorf("workspace '%s' not found", name)
+	}
+
+	workspace.DiskBudget = 0
+
+	if workspaceBudgetSet != "" {
+		budget, err := core.ParseSize(workspaceBudgetSet)
+		if err != nil {
+			return err
+		}
+
+		if budget == 0 {
+			return fmt.Errorf("budget must be greater than zero (use --clear to remove it)")
+		}
+
+		workspace.DiskBudget = budget
+	}
+
+	if err := client.SaveWorkspace(workspace); err != nil {
+		return fmt.Errorf("failed to save workspace: %w", err)
+	}
+
+	if workspace.DiskBudget == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Removed disk budget from workspace '%s'\n", name)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "Set disk budget of workspace '%s' to %s\n", name, core.FormatSize(workspace.DiskBudget))
+	}
+
+	return nil
+}
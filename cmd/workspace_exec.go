@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/spf13/cobra"
+)
+
+var workspaceExecCmd = &cobra.Command{
+	Use:   "exec <name> -- <command>",
+	Short: "Run a shell command in every repository of a workspace",
+	Long: `Run a shell command in every repository of a workspace concurrently.
+
+The command runs through the platform shell (sh -c, or cmd /C on Windows)
+with the repository as working directory. Output is printed per repository
+once it finishes, followed by a summary. The exit status is non-zero if the
+command failed in any repository.
+
+Push commands (git push, clonr push) skip mirror and archive repositories;
+pass --kind to target a kind explicitly.
+
+Examples:
+  clonr workspace exec work -- git status --short
+  clonr workspace exec work --parallel 4 -- make test
+  clonr workspace exec work --fail-fast -- go vet ./...
+  clonr workspace exec work --json -- git rev-parse HEAD
+  clonr workspace exec work --kind fork -- git fetch upstream
+  clonr workspace exec work --secret NPM_TOKEN -- npm publish`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if cmd.ArgsLenAtDash() != 1 || len(args) < 2 {
+			return fmt.Errorf("usage: clonr workspace exec <name> -- <command>")
+		}
+
+		return nil
+	},
+	RunE: runWorkspaceExec,
+}
+
+var (
+	workspaceExecParallel int
+	workspaceExecFailFast bool
+	workspaceExecJSON     bool
+	workspaceExecKind     string
+	workspaceExecSecrets  []string
+)
+
+func init() {
+	workspaceCmd.AddCommand(workspaceExecCmd)
+
+	workspaceExecCmd.Flags().IntVarP(&workspaceExecParallel, "parallel", "p", 0, "Number of repositories processed concurrently (default: number of CPUs)")
+	workspaceExecCmd.Flags().BoolVar(&workspaceExecFailFast, "fail-fast", false, "Stop after the first repository where the command fails")
+	workspaceExecCmd.Flags().BoolVar(&workspaceExecJSON, "json", false, "Output a JSON summary")
+	workspaceExecCmd.Flags().StringVar(&workspaceExecKind, "kind", "", "Only repositories of this kind: source, fork, mirror, archive, template")
+	workspaceExecCmd.Flags().StringSliceVar(&workspaceExecSecrets, "secret", nil, "Vault secret to add to the command environment (repeatable)")
+}
+
+func runWorkspaceExec(cmd *cobra.Command, args []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	command := strings.Join(args[1:], " ")
+
+	workspace, err := client.GetWorkspace(name)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	if workspace == nil {
+		return fmt.Errorf("workspace '%s' not found", name)
+	}
+
+	repos, err := client.GetRepos(workspace.Name, false)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	if len(repos) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No repositories in workspace '%s'.\n", workspace.Name)
+		return nil
+	}
+
+	repos, err = filterExecRepos(repos, command, workspaceExecKind)
+	if err != nil {
+		return err
+	}
+
+	if len(repos) == 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "No matching repositories in workspace '%s'.\n", workspace.Name)
+		return nil
+	}
+
+	env, err := core.VaultEnv(workspaceExecSecrets)
+	if err != nil {
+		return err
+	}
+
+	summary := core.ExecInRepos(cmd.Context(), repos, command, core.ExecOptions{
+		Parallel: workspaceExecParallel,
+		FailFast: workspaceExecFailFast,
+		Env:      env,
+	})
+
+	if workspaceExecJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(summary); err != nil {
+			return err
+		}
+	} else {
+		printExecSummary(summary)
+	}
+
+	if summary.Failed > 0 {
+		return fmt.Errorf("command failed in %d of %d repositories", summary.Failed, len(summary.Results))
+	}
+
+	return nil
+}
+
+// printExecSummary prints per-repository output followed by the totals
+func printExecSummary(summary *core.ExecSummary) {
+	for _, r := range summary.Results {
+		var status string
+
+		switch {
+		case r.Skipped:
+			status = dimStyle.Render("skipped")
+		case r.Success():
+			status = okStyle.Render("ok")
+		case r.Error != "":
+			status = errStyle.Render(r.Error)
+		default:
+			status = errStyle.Render(fmt.Sprintf("exit %d", r.ExitCode))
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "==> %s [%s]\n", r.Path, status)
+
+		if out := strings.TrimRight(r.Output, "\n"); out != "" {
+			_, _ = fmt.Fprintln(os.Stdout, out)
+		}
+
+		_, _ = fmt.Fprintln(os.Stdout)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Succeeded: %d  Failed: %d  Skipped: %d  (%s)\n",
+		summary.Succeeded, summary.Failed, summary.Skipped,
+		(time.Duration(summary.Duration) * time.Millisecond).Round(time.Millisecond))
+}
+
+// filterExecRepos keeps the repositories of the requested kind. Without a
+// kind, push commands leave out mirrors and archives.
+func filterExecRepos(repos []model.Repository, command, kindFlag string) ([]model.Repository, error) {
+	if kindFlag != "" {
+		kind, err := model.ParseRepoKind(kindFlag)
+		if err != nil {
+			return nil, err
+		}
+
+		return slices.DeleteFunc(repos, func(r model.Repository) bool { return r.Kind != kind }), nil
+	}
+
+	if !core.IsPushCommand(command) {
+		return repos, nil
+	}
+
+	kept := slices.DeleteFunc(repos, func(r model.Repository) bool { return r.Kind.SkipsPush() })
+	if skipped := len(repos) - len(kept); skipped > 0 && !workspaceExecJSON {
+		_, _ = fmt.Fprintln(os.Stderr, dimStyle.Render(fmt.Sprintf("Skipping %d mirror/archive repositories for push (use --kind to include them)", skipped)))
+	}
+
+	return kept, nil
+}
@@ -44,6 +44,7 @@ type Worker struct {
 	stopCh     chan struct{}
 	stoppedCh  chan struct{}
 	onComplete func(*WorkflowRun) // Callback when a workflow completes
+	onFailure  func(*QueueItem)   // Callback when an item gives up after its retries
 }
 
 // NewWorker creates a new actions monitoring worker
@@ -70,6 +71,12 @@ func (w *Worker) OnComplete(fn func(*WorkflowRun)) *Worker {
 	return w
 }
 
+// OnFailure sets a callback for when checking a push fails for good
+func (w *Worker) OnFailure(fn func(*QueueItem)) *Worker {
+	w.onFailure = fn
+	return w
+}
+
 // Start begins the monitoring worker
 func (w *Worker) Start(ctx context.Context) error {
 	w.mu.Lock()
@@ -347,6 +354,10 @@ func (w *Worker) handleCheckError(item *QueueItem, err error) error {
 			"push_id", item.PushID,
 			"repo", fmt.Sprintf("%s/%s", item.RepoOwner, item.RepoName),
 			"retries", item.RetryCount)
+
+		if w.onFailure != nil {
+			w.onFailure(item)
+		}
 	} else {
 		// Exponential backoff
 		backoff := w.config.RetryBackoff * time.Duration(1<<uint(item.RetryCount-1))
@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/api_token.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// APIToken authorizes remote clients; only its SHA-256 hash is stored
+type APIToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash          string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"` // Hex-encoded SHA-256 of the token
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // Unset if the token never expires
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // Unset if never used
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_v1_api_token_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *APIToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *APIToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// SaveAPIToken RPC messages
+type SaveAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAPITokenRequest) Reset() {
+	*x = SaveAPITokenRequest{}
+	mi := &file_v1_api_token_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAPITokenRequest) ProtoMessage() {}
+
+func (x *SaveAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*SaveAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveAPITokenRequest) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type SaveAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAPITokenResponse) Reset() {
+	*x = SaveAPITokenResponse{}
+	mi := &file_v1_api_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAPITokenResponse) ProtoMessage() {}
+
+func (x *SaveAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*SaveAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveAPITokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetAPITokenByHash RPC messages
+type GetAPITokenByHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPITokenByHashRequest) Reset() {
+	*x = GetAPITokenByHashRequest{}
+	mi := &file_v1_api_token_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPITokenByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPITokenByHashRequest) ProtoMessage() {}
+
+func (x *GetAPITokenByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPITokenByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetAPITokenByHashRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAPITokenByHashRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetAPITokenByHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Unset when no token has the hash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPITokenByHashResponse) Reset() {
+	*x = GetAPITokenByHashResponse{}
+	mi := &file_v1_api_token_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPITokenByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPITokenByHashResponse) ProtoMessage() {}
+
+func (x *GetAPITokenByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPITokenByHashResponse.ProtoReflect.Descriptor instead.
+func (*GetAPITokenByHashResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetAPITokenByHashResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+// ListAPITokens RPC messages
+type ListAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensRequest) Reset() {
+	*x = ListAPITokensRequest{}
+	mi := &file_v1_api_token_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensRequest) ProtoMessage() {}
+
+func (x *ListAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*ListAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{5}
+}
+
+type ListAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPITokensResponse) Reset() {
+	*x = ListAPITokensResponse{}
+	mi := &file_v1_api_token_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPITokensResponse) ProtoMessage() {}
+
+func (x *ListAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*ListAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAPITokensResponse) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// DeleteAPIToken RPC messages
+type DeleteAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPITokenRequest) Reset() {
+	*x = DeleteAPITokenRequest{}
+	mi := &file_v1_api_token_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPITokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPITokenRequest) ProtoMessage() {}
+
+func (x *DeleteAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteAPITokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAPITokenResponse) Reset() {
+	*x = DeleteAPITokenResponse{}
+	mi := &file_v1_api_token_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAPITokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAPITokenResponse) ProtoMessage() {}
+
+func (x *DeleteAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_api_token_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_v1_api_token_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAPITokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_api_token_proto protoreflect.FileDescriptor
+
+const file_v1_api_token_proto_rawDesc = "" +
+	"\n" +
+	"\x12v1/api_token.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x01\n" +
+	"\bAPIToken\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"?\n" +
+	"\x13SaveAPITokenRequest\x12(\n" +
+	"\x05token\x18\x01 \x01(\v2\x12.clonr.v1.APITokenR\x05token\"0\n" +
+	"\x14SaveAPITokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\x18GetAPITokenByHashRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\"E\n" +
+	"\x19GetAPITokenByHashResponse\x12(\n" +
+	"\x05token\x18\x01 \x01(\v2\x12.clonr.v1.APITokenR\x05token\"\x16\n" +
+	"\x14ListAPITokensRequest\"C\n" +
+	"\x15ListAPITokensResponse\x12*\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x12.clonr.v1.APITokenR\x06tokens\"+\n" +
+	"\x15DeleteAPITokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x16DeleteAPITokenResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x90\x01\n" +
+	"\fcom.clonr.v1B\rApiTokenProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_api_token_proto_rawDescOnce sync.Once
+	file_v1_api_token_proto_rawDescData []byte
+)
+
+func file_v1_api_token_proto_rawDescGZIP() []byte {
+	file_v1_api_token_proto_rawDescOnce.Do(func() {
+		file_v1_api_token_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_api_token_proto_rawDesc), len(file_v1_api_token_proto_rawDesc)))
+	})
+	return file_v1_api_token_proto_rawDescData
+}
+
+var file_v1_api_token_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_api_token_proto_goTypes = []any{
+	(*APIToken)(nil),                  // 0: clonr.v1.APIToken
+	(*SaveAPITokenRequest)(nil),       // 1: clonr.v1.SaveAPITokenRequest
+	(*SaveAPITokenResponse)(nil),      // 2: clonr.v1.SaveAPITokenResponse
+	(*GetAPITokenByHashRequest)(nil),  // 3: clonr.v1.GetAPITokenByHashRequest
+	(*GetAPITokenByHashResponse)(nil), // 4: clonr.v1.GetAPITokenByHashResponse
+	(*ListAPITokensRequest)(nil),      // 5: clonr.v1.ListAPITokensRequest
+	(*ListAPITokensResponse)(nil),     // 6: clonr.v1.ListAPITokensResponse
+	(*DeleteAPITokenRequest)(nil),     // 7: clonr.v1.DeleteAPITokenRequest
+	(*DeleteAPITokenResponse)(nil),    // 8: clonr.v1.DeleteAPITokenResponse
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+}
+var file_v1_api_token_proto_depIdxs = []int32{
+	9, // 0: clonr.v1.APIToken.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: clonr.v1.APIToken.expires_at:type_name -> google.protobuf.Timestamp
+	9, // 2: clonr.v1.APIToken.last_used_at:type_name -> google.protobuf.Timestamp
+	0, // 3: clonr.v1.SaveAPITokenRequest.token:type_name -> clonr.v1.APIToken
+	0, // 4: clonr.v1.GetAPITokenByHashResponse.token:type_name -> clonr.v1.APIToken
+	0, // 5: clonr.v1.ListAPITokensResponse.tokens:type_name -> clonr.v1.APIToken
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_api_token_proto_init() }
+func file_v1_api_token_proto_init() {
+	if File_v1_api_token_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_api_token_proto_rawDesc), len(file_v1_api_token_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_api_token_proto_goTypes,
+		DependencyIndexes: file_v1_api_token_proto_depIdxs,
+		MessageInfos:      file_v1_api_token_proto_msgTypes,
+	}.Build()
+	File_v1_api_token_proto = out.File
+	file_v1_api_token_proto_goTypes = nil
+	file_v1_api_token_proto_depIdxs = nil
+}
@@ -24,18 +24,33 @@ var File_v1_clonr_proto protoreflect.FileDescriptor
 
 const file_v1_clonr_proto_rawDesc = "" +
 	"\n" +
-	"\x0ev1/clonr.proto\x12\bclonr.v1\x1a\x0fv1/common.proto\x1a\x13v1/repository.proto\x1a\x0fv1/config.proto\x1a\x10v1/profile.proto\x1a\x17v1/docker_profile.proto\x1a\x12v1/workspace.proto2\x93\x16\n" +
+	"\x0ev1/clonr.proto\x12\bclonr.v1\x1a\x0fv1/common.proto\x1a\x13v1/repository.proto\x1a\x0fv1/config.proto\x1a\x10v1/profile.proto\x1a\x17v1/docker_profile.proto\x1a\x12v1/workspace.proto\x1a\x15v1/saved_filter.proto\x1a\x16v1/repo_snapshot.proto\x1a\x15v1/wizard_draft.proto\x1a\x12v1/api_token.proto\x1a\x15v1/vault_secret.proto\x1a\x14v1/gmail_watch.proto\x1a\x17v1/github_repo_id.proto\x1a\x1dv1/dependency_inventory.proto\x1a\x13v1/share_link.proto\x1a\fv1/job.proto\x1a\x10v1/pairing.proto2\xe26\n" +
 	"\fClonrService\x12(\n" +
-	"\x04Ping\x12\x0f.clonr.v1.Empty\x1a\x0f.clonr.v1.Empty\x12A\n" +
+	"\x04Ping\x12\x0f.clonr.v1.Empty\x1a\x0f.clonr.v1.Empty\x12,\n" +
+	"\bShutdown\x12\x0f.clonr.v1.Empty\x1a\x0f.clonr.v1.Empty\x12A\n" +
 	"\bSaveRepo\x12\x19.clonr.v1.SaveRepoRequest\x1a\x1a.clonr.v1.SaveRepoResponse\x12V\n" +
 	"\x0fRepoExistsByURL\x12 .clonr.v1.RepoExistsByURLRequest\x1a!.clonr.v1.RepoExistsByURLResponse\x12Y\n" +
 	"\x10RepoExistsByPath\x12!.clonr.v1.RepoExistsByPathRequest\x1a\".clonr.v1.RepoExistsByPathResponse\x12h\n" +
 	"\x15InsertRepoIfNotExists\x12&.clonr.v1.InsertRepoIfNotExistsRequest\x1a'.clonr.v1.InsertRepoIfNotExistsResponse\x12J\n" +
 	"\vGetAllRepos\x12\x1c.clonr.v1.GetAllReposRequest\x1a\x1d.clonr.v1.GetAllReposResponse\x12A\n" +
-	"\bGetRepos\x12\x19.clonr.v1.GetReposRequest\x1a\x1a.clonr.v1.GetReposResponse\x12O\n" +
-	"\x10SetFavoriteByURL\x12\x1c.clonr.v1.SetFavoriteRequest\x1a\x1d.clonr.v1.SetFavoriteResponse\x12b\n" +
+	"\bGetRepos\x12\x19.clonr.v1.GetReposRequest\x1a\x1a.clonr.v1.GetReposResponse\x12D\n" +
+	"\tListRepos\x12\x1a.clonr.v1.ListReposRequest\x1a\x1b.clonr.v1.ListReposResponse\x12O\n" +
+	"\x10SetFavoriteByURL\x12\x1c.clonr.v1.SetFavoriteRequest\x1a\x1d.clonr.v1.SetFavoriteResponse\x12J\n" +
+	"\vSetRepoKind\x12\x1c.clonr.v1.SetRepoKindRequest\x1a\x1d.clonr.v1.SetRepoKindResponse\x12V\n" +
+	"\x0fSetRepoUpstream\x12 .clonr.v1.SetRepoUpstreamRequest\x1a!.clonr.v1.SetRepoUpstreamResponse\x12S\n" +
+	"\x0eSetRepoLicense\x12\x1f.clonr.v1.SetRepoLicenseRequest\x1a .clonr.v1.SetRepoLicenseResponse\x12J\n" +
+	"\vSetRepoTags\x12\x1c.clonr.v1.SetRepoTagsRequest\x1a\x1d.clonr.v1.SetRepoTagsResponse\x12M\n" +
+	"\fSetRepoNotes\x12\x1d.clonr.v1.SetRepoNotesRequest\x1a\x1e.clonr.v1.SetRepoNotesResponse\x12M\n" +
+	"\fSetRepoAlias\x12\x1d.clonr.v1.SetRepoAliasRequest\x1a\x1e.clonr.v1.SetRepoAliasResponse\x12Y\n" +
+	"\x10RecordRepoAccess\x12!.clonr.v1.RecordRepoAccessRequest\x1a\".clonr.v1.RecordRepoAccessResponse\x12S\n" +
+	"\x0eSetRepoRemotes\x12\x1f.clonr.v1.SetRepoRemotesRequest\x1a .clonr.v1.SetRepoRemotesResponse\x12_\n" +
+	"\x12GetRepoByRemoteURL\x12#.clonr.v1.GetRepoByRemoteURLRequest\x1a$.clonr.v1.GetRepoByRemoteURLResponse\x12P\n" +
+	"\rGetRepoDetail\x12\x1e.clonr.v1.GetRepoDetailRequest\x1a\x1f.clonr.v1.GetRepoDetailResponse\x12b\n" +
 	"\x13UpdateRepoTimestamp\x12$.clonr.v1.UpdateRepoTimestampRequest\x1a%.clonr.v1.UpdateRepoTimestampResponse\x12V\n" +
-	"\x0fRemoveRepoByURL\x12 .clonr.v1.RemoveRepoByURLRequest\x1a!.clonr.v1.RemoveRepoByURLResponse\x12D\n" +
+	"\x0fRemoveRepoByURL\x12 .clonr.v1.RemoveRepoByURLRequest\x1a!.clonr.v1.RemoveRepoByURLResponse\x12S\n" +
+	"\x0eUpdateRepoPath\x12\x1f.clonr.v1.UpdateRepoPathRequest\x1a .clonr.v1.UpdateRepoPathResponse\x12P\n" +
+	"\rUpdateRepoURL\x12\x1e.clonr.v1.UpdateRepoURLRequest\x1a\x1f.clonr.v1.UpdateRepoURLResponse\x12J\n" +
+	"\x0fWatchRepoEvents\x12 .clonr.v1.WatchRepoEventsRequest\x1a\x13.clonr.v1.RepoEvent0\x01\x12D\n" +
 	"\tGetConfig\x12\x1a.clonr.v1.GetConfigRequest\x1a\x1b.clonr.v1.GetConfigResponse\x12G\n" +
 	"\n" +
 	"SaveConfig\x12\x1b.clonr.v1.SaveConfigRequest\x1a\x1c.clonr.v1.SaveConfigResponse\x12J\n" +
@@ -51,7 +66,44 @@ const file_v1_clonr_proto_rawDesc = "" +
 	"\x10GetDockerProfile\x12!.clonr.v1.GetDockerProfileRequest\x1a\".clonr.v1.GetDockerProfileResponse\x12_\n" +
 	"\x12ListDockerProfiles\x12#.clonr.v1.ListDockerProfilesRequest\x1a$.clonr.v1.ListDockerProfilesResponse\x12b\n" +
 	"\x13DeleteDockerProfile\x12$.clonr.v1.DeleteDockerProfileRequest\x1a%.clonr.v1.DeleteDockerProfileResponse\x12b\n" +
-	"\x13DockerProfileExists\x12$.clonr.v1.DockerProfileExistsRequest\x1a%.clonr.v1.DockerProfileExistsResponse\x12P\n" +
+	"\x13DockerProfileExists\x12$.clonr.v1.DockerProfileExistsRequest\x1a%.clonr.v1.DockerProfileExistsResponse\x12G\n" +
+	"\n" +
+	"SaveFilter\x12\x1b.clonr.v1.SaveFilterRequest\x1a\x1c.clonr.v1.SaveFilterResponse\x12D\n" +
+	"\tGetFilter\x12\x1a.clonr.v1.GetFilterRequest\x1a\x1b.clonr.v1.GetFilterResponse\x12J\n" +
+	"\vListFilters\x12\x1c.clonr.v1.ListFiltersRequest\x1a\x1d.clonr.v1.ListFiltersResponse\x12M\n" +
+	"\fDeleteFilter\x12\x1d.clonr.v1.DeleteFilterRequest\x1a\x1e.clonr.v1.DeleteFilterResponse\x12Y\n" +
+	"\x10SaveRepoSnapshot\x12!.clonr.v1.SaveRepoSnapshotRequest\x1a\".clonr.v1.SaveRepoSnapshotResponse\x12V\n" +
+	"\x0fGetRepoSnapshot\x12 .clonr.v1.GetRepoSnapshotRequest\x1a!.clonr.v1.GetRepoSnapshotResponse\x12\\\n" +
+	"\x11ListRepoSnapshots\x12\".clonr.v1.ListRepoSnapshotsRequest\x1a#.clonr.v1.ListRepoSnapshotsResponse\x12_\n" +
+	"\x12DeleteRepoSnapshot\x12#.clonr.v1.DeleteRepoSnapshotRequest\x1a$.clonr.v1.DeleteRepoSnapshotResponse\x12V\n" +
+	"\x0fSaveWizardDraft\x12 .clonr.v1.SaveWizardDraftRequest\x1a!.clonr.v1.SaveWizardDraftResponse\x12S\n" +
+	"\x0eGetWizardDraft\x12\x1f.clonr.v1.GetWizardDraftRequest\x1a .clonr.v1.GetWizardDraftResponse\x12\\\n" +
+	"\x11DeleteWizardDraft\x12\".clonr.v1.DeleteWizardDraftRequest\x1a#.clonr.v1.DeleteWizardDraftResponse\x12M\n" +
+	"\fSaveAPIToken\x12\x1d.clonr.v1.SaveAPITokenRequest\x1a\x1e.clonr.v1.SaveAPITokenResponse\x12\\\n" +
+	"\x11GetAPITokenByHash\x12\".clonr.v1.GetAPITokenByHashRequest\x1a#.clonr.v1.GetAPITokenByHashResponse\x12P\n" +
+	"\rListAPITokens\x12\x1e.clonr.v1.ListAPITokensRequest\x1a\x1f.clonr.v1.ListAPITokensResponse\x12S\n" +
+	"\x0eDeleteAPIToken\x12\x1f.clonr.v1.DeleteAPITokenRequest\x1a .clonr.v1.DeleteAPITokenResponse\x12V\n" +
+	"\x0fSaveVaultSecret\x12 .clonr.v1.SaveVaultSecretRequest\x1a!.clonr.v1.SaveVaultSecretResponse\x12S\n" +
+	"\x0eGetVaultSecret\x12\x1f.clonr.v1.GetVaultSecretRequest\x1a .clonr.v1.GetVaultSecretResponse\x12Y\n" +
+	"\x10ListVaultSecrets\x12!.clonr.v1.ListVaultSecretsRequest\x1a\".clonr.v1.ListVaultSecretsResponse\x12\\\n" +
+	"\x11DeleteVaultSecret\x12\".clonr.v1.DeleteVaultSecretRequest\x1a#.clonr.v1.DeleteVaultSecretResponse\x12S\n" +
+	"\x0eSaveGmailWatch\x12\x1f.clonr.v1.SaveGmailWatchRequest\x1a .clonr.v1.SaveGmailWatchResponse\x12P\n" +
+	"\rGetGmailWatch\x12\x1e.clonr.v1.GetGmailWatchRequest\x1a\x1f.clonr.v1.GetGmailWatchResponse\x12Y\n" +
+	"\x10ListGmailWatches\x12!.clonr.v1.ListGmailWatchesRequest\x1a\".clonr.v1.ListGmailWatchesResponse\x12Y\n" +
+	"\x10DeleteGmailWatch\x12!.clonr.v1.DeleteGmailWatchRequest\x1a\".clonr.v1.DeleteGmailWatchResponse\x12Y\n" +
+	"\x10SaveGitHubRepoID\x12!.clonr.v1.SaveGitHubRepoIDRequest\x1a\".clonr.v1.SaveGitHubRepoIDResponse\x12V\n" +
+	"\x0fGetGitHubRepoID\x12 .clonr.v1.GetGitHubRepoIDRequest\x1a!.clonr.v1.GetGitHubRepoIDResponse\x12n\n" +
+	"\x17SaveDependencyInventory\x12(.clonr.v1.SaveDependencyInventoryRequest\x1a).clonr.v1.SaveDependencyInventoryResponse\x12t\n" +
+	"\x19ListDependencyInventories\x12*.clonr.v1.ListDependencyInventoriesRequest\x1a+.clonr.v1.ListDependencyInventoriesResponse\x12P\n" +
+	"\rSaveShareLink\x12\x1e.clonr.v1.SaveShareLinkRequest\x1a\x1f.clonr.v1.SaveShareLinkResponse\x12M\n" +
+	"\fGetShareLink\x12\x1d.clonr.v1.GetShareLinkRequest\x1a\x1e.clonr.v1.GetShareLinkResponse\x12Y\n" +
+	"\x10ConsumeShareLink\x12!.clonr.v1.ConsumeShareLinkRequest\x1a\".clonr.v1.ConsumeShareLinkResponse\x12>\n" +
+	"\aSaveJob\x12\x18.clonr.v1.SaveJobRequest\x1a\x19.clonr.v1.SaveJobResponse\x12;\n" +
+	"\x06GetJob\x12\x17.clonr.v1.GetJobRequest\x1a\x18.clonr.v1.GetJobResponse\x12A\n" +
+	"\bListJobs\x12\x19.clonr.v1.ListJobsRequest\x1a\x1a.clonr.v1.ListJobsResponse\x12D\n" +
+	"\tCancelJob\x12\x1a.clonr.v1.CancelJobRequest\x1a\x1b.clonr.v1.CancelJobResponse\x12G\n" +
+	"\n" +
+	"PairDevice\x12\x1b.clonr.v1.PairDeviceRequest\x1a\x1c.clonr.v1.PairDeviceResponse\x12P\n" +
 	"\rSaveWorkspace\x12\x1e.clonr.v1.SaveWorkspaceRequest\x1a\x1f.clonr.v1.SaveWorkspaceResponse\x12M\n" +
 	"\fGetWorkspace\x12\x1d.clonr.v1.GetWorkspaceRequest\x1a\x1e.clonr.v1.GetWorkspaceResponse\x12_\n" +
 	"\x12GetActiveWorkspace\x12#.clonr.v1.GetActiveWorkspaceRequest\x1a$.clonr.v1.GetActiveWorkspaceResponse\x12_\n" +
@@ -65,144 +117,342 @@ const file_v1_clonr_proto_rawDesc = "" +
 	"ClonrProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
 
 var file_v1_clonr_proto_goTypes = []any{
-	(*Empty)(nil),                         // 0: clonr.v1.Empty
-	(*SaveRepoRequest)(nil),               // 1: clonr.v1.SaveRepoRequest
-	(*RepoExistsByURLRequest)(nil),        // 2: clonr.v1.RepoExistsByURLRequest
-	(*RepoExistsByPathRequest)(nil),       // 3: clonr.v1.RepoExistsByPathRequest
-	(*InsertRepoIfNotExistsRequest)(nil),  // 4: clonr.v1.InsertRepoIfNotExistsRequest
-	(*GetAllReposRequest)(nil),            // 5: clonr.v1.GetAllReposRequest
-	(*GetReposRequest)(nil),               // 6: clonr.v1.GetReposRequest
-	(*SetFavoriteRequest)(nil),            // 7: clonr.v1.SetFavoriteRequest
-	(*UpdateRepoTimestampRequest)(nil),    // 8: clonr.v1.UpdateRepoTimestampRequest
-	(*RemoveRepoByURLRequest)(nil),        // 9: clonr.v1.RemoveRepoByURLRequest
-	(*GetConfigRequest)(nil),              // 10: clonr.v1.GetConfigRequest
-	(*SaveConfigRequest)(nil),             // 11: clonr.v1.SaveConfigRequest
-	(*SaveProfileRequest)(nil),            // 12: clonr.v1.SaveProfileRequest
-	(*GetProfileRequest)(nil),             // 13: clonr.v1.GetProfileRequest
-	(*GetActiveProfileRequest)(nil),       // 14: clonr.v1.GetActiveProfileRequest
-	(*SetActiveProfileRequest)(nil),       // 15: clonr.v1.SetActiveProfileRequest
-	(*ListProfilesRequest)(nil),           // 16: clonr.v1.ListProfilesRequest
-	(*DeleteProfileRequest)(nil),          // 17: clonr.v1.DeleteProfileRequest
-	(*ProfileExistsRequest)(nil),          // 18: clonr.v1.ProfileExistsRequest
-	(*SaveDockerProfileRequest)(nil),      // 19: clonr.v1.SaveDockerProfileRequest
-	(*GetDockerProfileRequest)(nil),       // 20: clonr.v1.GetDockerProfileRequest
-	(*ListDockerProfilesRequest)(nil),     // 21: clonr.v1.ListDockerProfilesRequest
-	(*DeleteDockerProfileRequest)(nil),    // 22: clonr.v1.DeleteDockerProfileRequest
-	(*DockerProfileExistsRequest)(nil),    // 23: clonr.v1.DockerProfileExistsRequest
-	(*SaveWorkspaceRequest)(nil),          // 24: clonr.v1.SaveWorkspaceRequest
-	(*GetWorkspaceRequest)(nil),           // 25: clonr.v1.GetWorkspaceRequest
-	(*GetActiveWorkspaceRequest)(nil),     // 26: clonr.v1.GetActiveWorkspaceRequest
-	(*SetActiveWorkspaceRequest)(nil),     // 27: clonr.v1.SetActiveWorkspaceRequest
-	(*ListWorkspacesRequest)(nil),         // 28: clonr.v1.ListWorkspacesRequest
-	(*DeleteWorkspaceRequest)(nil),        // 29: clonr.v1.DeleteWorkspaceRequest
-	(*WorkspaceExistsRequest)(nil),        // 30: clonr.v1.WorkspaceExistsRequest
-	(*GetReposByWorkspaceRequest)(nil),    // 31: clonr.v1.GetReposByWorkspaceRequest
-	(*UpdateRepoWorkspaceRequest)(nil),    // 32: clonr.v1.UpdateRepoWorkspaceRequest
-	(*SaveRepoResponse)(nil),              // 33: clonr.v1.SaveRepoResponse
-	(*RepoExistsByURLResponse)(nil),       // 34: clonr.v1.RepoExistsByURLResponse
-	(*RepoExistsByPathResponse)(nil),      // 35: clonr.v1.RepoExistsByPathResponse
-	(*InsertRepoIfNotExistsResponse)(nil), // 36: clonr.v1.InsertRepoIfNotExistsResponse
-	(*GetAllReposResponse)(nil),           // 37: clonr.v1.GetAllReposResponse
-	(*GetReposResponse)(nil),              // 38: clonr.v1.GetReposResponse
-	(*SetFavoriteResponse)(nil),           // 39: clonr.v1.SetFavoriteResponse
-	(*UpdateRepoTimestampResponse)(nil),   // 40: clonr.v1.UpdateRepoTimestampResponse
-	(*RemoveRepoByURLResponse)(nil),       // 41: clonr.v1.RemoveRepoByURLResponse
-	(*GetConfigResponse)(nil),             // 42: clonr.v1.GetConfigResponse
-	(*SaveConfigResponse)(nil),            // 43: clonr.v1.SaveConfigResponse
-	(*SaveProfileResponse)(nil),           // 44: clonr.v1.SaveProfileResponse
-	(*GetProfileResponse)(nil),            // 45: clonr.v1.GetProfileResponse
-	(*GetActiveProfileResponse)(nil),      // 46: clonr.v1.GetActiveProfileResponse
-	(*SetActiveProfileResponse)(nil),      // 47: clonr.v1.SetActiveProfileResponse
-	(*ListProfilesResponse)(nil),          // 48: clonr.v1.ListProfilesResponse
-	(*DeleteProfileResponse)(nil),         // 49: clonr.v1.DeleteProfileResponse
-	(*ProfileExistsResponse)(nil),         // 50: clonr.v1.ProfileExistsResponse
-	(*SaveDockerProfileResponse)(nil),     // 51: clonr.v1.SaveDockerProfileResponse
-	(*GetDockerProfileResponse)(nil),      // 52: clonr.v1.GetDockerProfileResponse
-	(*ListDockerProfilesResponse)(nil),    // 53: clonr.v1.ListDockerProfilesResponse
-	(*DeleteDockerProfileResponse)(nil),   // 54: clonr.v1.DeleteDockerProfileResponse
-	(*DockerProfileExistsResponse)(nil),   // 55: clonr.v1.DockerProfileExistsResponse
-	(*SaveWorkspaceResponse)(nil),         // 56: clonr.v1.SaveWorkspaceResponse
-	(*GetWorkspaceResponse)(nil),          // 57: clonr.v1.GetWorkspaceResponse
-	(*GetActiveWorkspaceResponse)(nil),    // 58: clonr.v1.GetActiveWorkspaceResponse
-	(*SetActiveWorkspaceResponse)(nil),    // 59: clonr.v1.SetActiveWorkspaceResponse
-	(*ListWorkspacesResponse)(nil),        // 60: clonr.v1.ListWorkspacesResponse
-	(*DeleteWorkspaceResponse)(nil),       // 61: clonr.v1.DeleteWorkspaceResponse
-	(*WorkspaceExistsResponse)(nil),       // 62: clonr.v1.WorkspaceExistsResponse
-	(*GetReposByWorkspaceResponse)(nil),   // 63: clonr.v1.GetReposByWorkspaceResponse
-	(*UpdateRepoWorkspaceResponse)(nil),   // 64: clonr.v1.UpdateRepoWorkspaceResponse
+	(*Empty)(nil),                             // 0: clonr.v1.Empty
+	(*SaveRepoRequest)(nil),                   // 1: clonr.v1.SaveRepoRequest
+	(*RepoExistsByURLRequest)(nil),            // 2: clonr.v1.RepoExistsByURLRequest
+	(*RepoExistsByPathRequest)(nil),           // 3: clonr.v1.RepoExistsByPathRequest
+	(*InsertRepoIfNotExistsRequest)(nil),      // 4: clonr.v1.InsertRepoIfNotExistsRequest
+	(*GetAllReposRequest)(nil),                // 5: clonr.v1.GetAllReposRequest
+	(*GetReposRequest)(nil),                   // 6: clonr.v1.GetReposRequest
+	(*ListReposRequest)(nil),                  // 7: clonr.v1.ListReposRequest
+	(*SetFavoriteRequest)(nil),                // 8: clonr.v1.SetFavoriteRequest
+	(*SetRepoKindRequest)(nil),                // 9: clonr.v1.SetRepoKindRequest
+	(*SetRepoUpstreamRequest)(nil),            // 10: clonr.v1.SetRepoUpstreamRequest
+	(*SetRepoLicenseRequest)(nil),             // 11: clonr.v1.SetRepoLicenseRequest
+	(*SetRepoTagsRequest)(nil),                // 12: clonr.v1.SetRepoTagsRequest
+	(*SetRepoNotesRequest)(nil),               // 13: clonr.v1.SetRepoNotesRequest
+	(*SetRepoAliasRequest)(nil),               // 14: clonr.v1.SetRepoAliasRequest
+	(*RecordRepoAccessRequest)(nil),           // 15: clonr.v1.RecordRepoAccessRequest
+	(*SetRepoRemotesRequest)(nil),             // 16: clonr.v1.SetRepoRemotesRequest
+	(*GetRepoByRemoteURLRequest)(nil),         // 17: clonr.v1.GetRepoByRemoteURLRequest
+	(*GetRepoDetailRequest)(nil),              // 18: clonr.v1.GetRepoDetailRequest
+	(*UpdateRepoTimestampRequest)(nil),        // 19: clonr.v1.UpdateRepoTimestampRequest
+	(*RemoveRepoByURLRequest)(nil),            // 20: clonr.v1.RemoveRepoByURLRequest
+	(*UpdateRepoPathRequest)(nil),             // 21: clonr.v1.UpdateRepoPathRequest
+	(*UpdateRepoURLRequest)(nil),              // 22: clonr.v1.UpdateRepoURLRequest
+	(*WatchRepoEventsRequest)(nil),            // 23: clonr.v1.WatchRepoEventsRequest
+	(*GetConfigRequest)(nil),                  // 24: clonr.v1.GetConfigRequest
+	(*SaveConfigRequest)(nil),                 // 25: clonr.v1.SaveConfigRequest
+	(*SaveProfileRequest)(nil),                // 26: clonr.v1.SaveProfileRequest
+	(*GetProfileRequest)(nil),                 // 27: clonr.v1.GetProfileRequest
+	(*GetActiveProfileRequest)(nil),           // 28: clonr.v1.GetActiveProfileRequest
+	(*SetActiveProfileRequest)(nil),           // 29: clonr.v1.SetActiveProfileRequest
+	(*ListProfilesRequest)(nil),               // 30: clonr.v1.ListProfilesRequest
+	(*DeleteProfileRequest)(nil),              // 31: clonr.v1.DeleteProfileRequest
+	(*ProfileExistsRequest)(nil),              // 32: clonr.v1.ProfileExistsRequest
+	(*SaveDockerProfileRequest)(nil),          // 33: clonr.v1.SaveDockerProfileRequest
+	(*GetDockerProfileRequest)(nil),           // 34: clonr.v1.GetDockerProfileRequest
+	(*ListDockerProfilesRequest)(nil),         // 35: clonr.v1.ListDockerProfilesRequest
+	(*DeleteDockerProfileRequest)(nil),        // 36: clonr.v1.DeleteDockerProfileRequest
+	(*DockerProfileExistsRequest)(nil),        // 37: clonr.v1.DockerProfileExistsRequest
+	(*SaveFilterRequest)(nil),                 // 38: clonr.v1.SaveFilterRequest
+	(*GetFilterRequest)(nil),                  // 39: clonr.v1.GetFilterRequest
+	(*ListFiltersRequest)(nil),                // 40: clonr.v1.ListFiltersRequest
+	(*DeleteFilterRequest)(nil),               // 41: clonr.v1.DeleteFilterRequest
+	(*SaveRepoSnapshotRequest)(nil),           // 42: clonr.v1.SaveRepoSnapshotRequest
+	(*GetRepoSnapshotRequest)(nil),            // 43: clonr.v1.GetRepoSnapshotRequest
+	(*ListRepoSnapshotsRequest)(nil),          // 44: clonr.v1.ListRepoSnapshotsRequest
+	(*DeleteRepoSnapshotRequest)(nil),         // 45: clonr.v1.DeleteRepoSnapshotRequest
+	(*SaveWizardDraftRequest)(nil),            // 46: clonr.v1.SaveWizardDraftRequest
+	(*GetWizardDraftRequest)(nil),             // 47: clonr.v1.GetWizardDraftRequest
+	(*DeleteWizardDraftRequest)(nil),          // 48: clonr.v1.DeleteWizardDraftRequest
+	(*SaveAPITokenRequest)(nil),               // 49: clonr.v1.SaveAPITokenRequest
+	(*GetAPITokenByHashRequest)(nil),          // 50: clonr.v1.GetAPITokenByHashRequest
+	(*ListAPITokensRequest)(nil),              // 51: clonr.v1.ListAPITokensRequest
+	(*DeleteAPITokenRequest)(nil),             // 52: clonr.v1.DeleteAPITokenRequest
+	(*SaveVaultSecretRequest)(nil),            // 53: clonr.v1.SaveVaultSecretRequest
+	(*GetVaultSecretRequest)(nil),             // 54: clonr.v1.GetVaultSecretRequest
+	(*ListVaultSecretsRequest)(nil),           // 55: clonr.v1.ListVaultSecretsRequest
+	(*DeleteVaultSecretRequest)(nil),          // 56: clonr.v1.DeleteVaultSecretRequest
+	(*SaveGmailWatchRequest)(nil),             // 57: clonr.v1.SaveGmailWatchRequest
+	(*GetGmailWatchRequest)(nil),              // 58: clonr.v1.GetGmailWatchRequest
+	(*ListGmailWatchesRequest)(nil),           // 59: clonr.v1.ListGmailWatchesRequest
+	(*DeleteGmailWatchRequest)(nil),           // 60: clonr.v1.DeleteGmailWatchRequest
+	(*SaveGitHubRepoIDRequest)(nil),           // 61: clonr.v1.SaveGitHubRepoIDRequest
+	(*GetGitHubRepoIDRequest)(nil),            // 62: clonr.v1.GetGitHubRepoIDRequest
+	(*SaveDependencyInventoryRequest)(nil),    // 63: clonr.v1.SaveDependencyInventoryRequest
+	(*ListDependencyInventoriesRequest)(nil),  // 64: clonr.v1.ListDependencyInventoriesRequest
+	(*SaveShareLinkRequest)(nil),              // 65: clonr.v1.SaveShareLinkRequest
+	(*GetShareLinkRequest)(nil),               // 66: clonr.v1.GetShareLinkRequest
+	(*ConsumeShareLinkRequest)(nil),           // 67: clonr.v1.ConsumeShareLinkRequest
+	(*SaveJobRequest)(nil),                    // 68: clonr.v1.SaveJobRequest
+	(*GetJobRequest)(nil),                     // 69: clonr.v1.GetJobRequest
+	(*ListJobsRequest)(nil),                   // 70: clonr.v1.ListJobsRequest
+	(*CancelJobRequest)(nil),                  // 71: clonr.v1.CancelJobRequest
+	(*PairDeviceRequest)(nil),                 // 72: clonr.v1.PairDeviceRequest
+	(*SaveWorkspaceRequest)(nil),              // 73: clonr.v1.SaveWorkspaceRequest
+	(*GetWorkspaceRequest)(nil),               // 74: clonr.v1.GetWorkspaceRequest
+	(*GetActiveWorkspaceRequest)(nil),         // 75: clonr.v1.GetActiveWorkspaceRequest
+	(*SetActiveWorkspaceRequest)(nil),         // 76: clonr.v1.SetActiveWorkspaceRequest
+	(*ListWorkspacesRequest)(nil),             // 77: clonr.v1.ListWorkspacesRequest
+	(*DeleteWorkspaceRequest)(nil),            // 78: clonr.v1.DeleteWorkspaceRequest
+	(*WorkspaceExistsRequest)(nil),            // 79: clonr.v1.WorkspaceExistsRequest
+	(*GetReposByWorkspaceRequest)(nil),        // 80: clonr.v1.GetReposByWorkspaceRequest
+	(*UpdateRepoWorkspaceRequest)(nil),        // 81: clonr.v1.UpdateRepoWorkspaceRequest
+	(*SaveRepoResponse)(nil),                  // 82: clonr.v1.SaveRepoResponse
+	(*RepoExistsByURLResponse)(nil),           // 83: clonr.v1.RepoExistsByURLResponse
+	(*RepoExistsByPathResponse)(nil),          // 84: clonr.v1.RepoExistsByPathResponse
+	(*InsertRepoIfNotExistsResponse)(nil),     // 85: clonr.v1.InsertRepoIfNotExistsResponse
+	(*GetAllReposResponse)(nil),               // 86: clonr.v1.GetAllReposResponse
+	(*GetReposResponse)(nil),                  // 87: clonr.v1.GetReposResponse
+	(*ListReposResponse)(nil),                 // 88: clonr.v1.ListReposResponse
+	(*SetFavoriteResponse)(nil),               // 89: clonr.v1.SetFavoriteResponse
+	(*SetRepoKindResponse)(nil),               // 90: clonr.v1.SetRepoKindResponse
+	(*SetRepoUpstreamResponse)(nil),           // 91: clonr.v1.SetRepoUpstreamResponse
+	(*SetRepoLicenseResponse)(nil),            // 92: clonr.v1.SetRepoLicenseResponse
+	(*SetRepoTagsResponse)(nil),               // 93: clonr.v1.SetRepoTagsResponse
+	(*SetRepoNotesResponse)(nil),              // 94: clonr.v1.SetRepoNotesResponse
+	(*SetRepoAliasResponse)(nil),              // 95: clonr.v1.SetRepoAliasResponse
+	(*RecordRepoAccessResponse)(nil),          // 96: clonr.v1.RecordRepoAccessResponse
+	(*SetRepoRemotesResponse)(nil),            // 97: clonr.v1.SetRepoRemotesResponse
+	(*GetRepoByRemoteURLResponse)(nil),        // 98: clonr.v1.GetRepoByRemoteURLResponse
+	(*GetRepoDetailResponse)(nil),             // 99: clonr.v1.GetRepoDetailResponse
+	(*UpdateRepoTimestampResponse)(nil),       // 100: clonr.v1.UpdateRepoTimestampResponse
+	(*RemoveRepoByURLResponse)(nil),           // 101: clonr.v1.RemoveRepoByURLResponse
+	(*UpdateRepoPathResponse)(nil),            // 102: clonr.v1.UpdateRepoPathResponse
+	(*UpdateRepoURLResponse)(nil),             // 103: clonr.v1.UpdateRepoURLResponse
+	(*RepoEvent)(nil),                         // 104: clonr.v1.RepoEvent
+	(*GetConfigResponse)(nil),                 // 105: clonr.v1.GetConfigResponse
+	(*SaveConfigResponse)(nil),                // 106: clonr.v1.SaveConfigResponse
+	(*SaveProfileResponse)(nil),               // 107: clonr.v1.SaveProfileResponse
+	(*GetProfileResponse)(nil),                // 108: clonr.v1.GetProfileResponse
+	(*GetActiveProfileResponse)(nil),          // 109: clonr.v1.GetActiveProfileResponse
+	(*SetActiveProfileResponse)(nil),          // 110: clonr.v1.SetActiveProfileResponse
+	(*ListProfilesResponse)(nil),              // 111: clonr.v1.ListProfilesResponse
+	(*DeleteProfileResponse)(nil),             // 112: clonr.v1.DeleteProfileResponse
+	(*ProfileExistsResponse)(nil),             // 113: clonr.v1.ProfileExistsResponse
+	(*SaveDockerProfileResponse)(nil),         // 114: clonr.v1.SaveDockerProfileResponse
+	(*GetDockerProfileResponse)(nil),          // 115: clonr.v1.GetDockerProfileResponse
+	(*ListDockerProfilesResponse)(nil),        // 116: clonr.v1.ListDockerProfilesResponse
+	(*DeleteDockerProfileResponse)(nil),       // 117: clonr.v1.DeleteDockerProfileResponse
+	(*DockerProfileExistsResponse)(nil),       // 118: clonr.v1.DockerProfileExistsResponse
+	(*SaveFilterResponse)(nil),                // 119: clonr.v1.SaveFilterResponse
+	(*GetFilterResponse)(nil),                 // 120: clonr.v1.GetFilterResponse
+	(*ListFiltersResponse)(nil),               // 121: clonr.v1.ListFiltersResponse
+	(*DeleteFilterResponse)(nil),              // 122: clonr.v1.DeleteFilterResponse
+	(*SaveRepoSnapshotResponse)(nil),          // 123: clonr.v1.SaveRepoSnapshotResponse
+	(*GetRepoSnapshotResponse)(nil),           // 124: clonr.v1.GetRepoSnapshotResponse
+	(*ListRepoSnapshotsResponse)(nil),         // 125: clonr.v1.ListRepoSnapshotsResponse
+	(*DeleteRepoSnapshotResponse)(nil),        // 126: clonr.v1.DeleteRepoSnapshotResponse
+	(*SaveWizardDraftResponse)(nil),           // 127: clonr.v1.SaveWizardDraftResponse
+	(*GetWizardDraftResponse)(nil),            // 128: clonr.v1.GetWizardDraftResponse
+	(*DeleteWizardDraftResponse)(nil),         // 129: clonr.v1.DeleteWizardDraftResponse
+	(*SaveAPITokenResponse)(nil),              // 130: clonr.v1.SaveAPITokenResponse
+	(*GetAPITokenByHashResponse)(nil),         // 131: clonr.v1.GetAPITokenByHashResponse
+	(*ListAPITokensResponse)(nil),             // 132: clonr.v1.ListAPITokensResponse
+	(*DeleteAPITokenResponse)(nil),            // 133: clonr.v1.DeleteAPITokenResponse
+	(*SaveVaultSecretResponse)(nil),           // 134: clonr.v1.SaveVaultSecretResponse
+	(*GetVaultSecretResponse)(nil),            // 135: clonr.v1.GetVaultSecretResponse
+	(*ListVaultSecretsResponse)(nil),          // 136: clonr.v1.ListVaultSecretsResponse
+	(*DeleteVaultSecretResponse)(nil),         // 137: clonr.v1.DeleteVaultSecretResponse
+	(*SaveGmailWatchResponse)(nil),            // 138: clonr.v1.SaveGmailWatchResponse
+	(*GetGmailWatchResponse)(nil),             // 139: clonr.v1.GetGmailWatchResponse
+	(*ListGmailWatchesResponse)(nil),          // 140: clonr.v1.ListGmailWatchesResponse
+	(*DeleteGmailWatchResponse)(nil),          // 141: clonr.v1.DeleteGmailWatchResponse
+	(*SaveGitHubRepoIDResponse)(nil),          // 142: clonr.v1.SaveGitHubRepoIDResponse
+	(*GetGitHubRepoIDResponse)(nil),           // 143: clonr.v1.GetGitHubRepoIDResponse
+	(*SaveDependencyInventoryResponse)(nil),   // 144: clonr.v1.SaveDependencyInventoryResponse
+	(*ListDependencyInventoriesResponse)(nil), // 145: clonr.v1.ListDependencyInventoriesResponse
+	(*SaveShareLinkResponse)(nil),             // 146: clonr.v1.SaveShareLinkResponse
+	(*GetShareLinkResponse)(nil),              // 147: clonr.v1.GetShareLinkResponse
+	(*ConsumeShareLinkResponse)(nil),          // 148: clonr.v1.ConsumeShareLinkResponse
+	(*SaveJobResponse)(nil),                   // 149: clonr.v1.SaveJobResponse
+	(*GetJobResponse)(nil),                    // 150: clonr.v1.GetJobResponse
+	(*ListJobsResponse)(nil),                  // 151: clonr.v1.ListJobsResponse
+	(*CancelJobResponse)(nil),                 // 152: clonr.v1.CancelJobResponse
+	(*PairDeviceResponse)(nil),                // 153: clonr.v1.PairDeviceResponse
+	(*SaveWorkspaceResponse)(nil),             // 154: clonr.v1.SaveWorkspaceResponse
+	(*GetWorkspaceResponse)(nil),              // 155: clonr.v1.GetWorkspaceResponse
+	(*GetActiveWorkspaceResponse)(nil),        // 156: clonr.v1.GetActiveWorkspaceResponse
+	(*SetActiveWorkspaceResponse)(nil),        // 157: clonr.v1.SetActiveWorkspaceResponse
+	(*ListWorkspacesResponse)(nil),            // 158: clonr.v1.ListWorkspacesResponse
+	(*DeleteWorkspaceResponse)(nil),           // 159: clonr.v1.DeleteWorkspaceResponse
+	(*WorkspaceExistsResponse)(nil),           // 160: clonr.v1.WorkspaceExistsResponse
+	(*GetReposByWorkspaceResponse)(nil),       // 161: clonr.v1.GetReposByWorkspaceResponse
+	(*UpdateRepoWorkspaceResponse)(nil),       // 162: clonr.v1.UpdateRepoWorkspaceResponse
 }
 var file_v1_clonr_proto_depIdxs = []int32{
-	0,  // 0: clonr.v1.ClonrService.Ping:input_type -> clonr.v1.Empty
-	1,  // 1: clonr.v1.ClonrService.SaveRepo:input_type -> clonr.v1.SaveRepoRequest
-	2,  // 2: clonr.v1.ClonrService.RepoExistsByURL:input_type -> clonr.v1.RepoExistsByURLRequest
-	3,  // 3: clonr.v1.ClonrService.RepoExistsByPath:input_type -> clonr.v1.RepoExistsByPathRequest
-	4,  // 4: clonr.v1.ClonrService.InsertRepoIfNotExists:input_type -> clonr.v1.InsertRepoIfNotExistsRequest
-	5,  // 5: clonr.v1.ClonrService.GetAllRepos:input_type -> clonr.v1.GetAllReposRequest
-	6,  // 6: clonr.v1.ClonrService.GetRepos:input_type -> clonr.v1.GetReposRequest
-	7,  // 7: clonr.v1.ClonrService.SetFavoriteByURL:input_type -> clonr.v1.SetFavoriteRequest
-	8,  // 8: clonr.v1.ClonrService.UpdateRepoTimestamp:input_type -> clonr.v1.UpdateRepoTimestampRequest
-	9,  // 9: clonr.v1.ClonrService.RemoveRepoByURL:input_type -> clonr.v1.RemoveRepoByURLRequest
-	10, // 10: clonr.v1.ClonrService.GetConfig:input_type -> clonr.v1.GetConfigRequest
-	11, // 11: clonr.v1.ClonrService.SaveConfig:input_type -> clonr.v1.SaveConfigRequest
-	12, // 12: clonr.v1.ClonrService.SaveProfile:input_type -> clonr.v1.SaveProfileRequest
-	13, // 13: clonr.v1.ClonrService.GetProfile:input_type -> clonr.v1.GetProfileRequest
-	14, // 14: clonr.v1.ClonrService.GetActiveProfile:input_type -> clonr.v1.GetActiveProfileRequest
-	15, // 15: clonr.v1.ClonrService.SetActiveProfile:input_type -> clonr.v1.SetActiveProfileRequest
-	16, // 16: clonr.v1.ClonrService.ListProfiles:input_type -> clonr.v1.ListProfilesRequest
-	17, // 17: clonr.v1.ClonrService.DeleteProfile:input_type -> clonr.v1.DeleteProfileRequest
-	18, // 18: clonr.v1.ClonrService.ProfileExists:input_type -> clonr.v1.ProfileExistsRequest
-	19, // 19: clonr.v1.ClonrService.SaveDockerProfile:input_type -> clonr.v1.SaveDockerProfileRequest
-	20, // 20: clonr.v1.ClonrService.GetDockerProfile:input_type -> clonr.v1.GetDockerProfileRequest
-	21, // 21: clonr.v1.ClonrService.ListDockerProfiles:input_type -> clonr.v1.ListDockerProfilesRequest
-	22, // 22: clonr.v1.ClonrService.DeleteDockerProfile:input_type -> clonr.v1.DeleteDockerProfileRequest
-	23, // 23: clonr.v1.ClonrService.DockerProfileExists:input_type -> clonr.v1.DockerProfileExistsRequest
-	24, // 24: clonr.v1.ClonrService.SaveWorkspace:input_type -> clonr.v1.SaveWorkspaceRequest
-	25, // 25: clonr.v1.ClonrService.GetWorkspace:input_type -> clonr.v1.GetWorkspaceRequest
-	26, // 26: clonr.v1.ClonrService.GetActiveWorkspace:input_type -> clonr.v1.GetActiveWorkspaceRequest
-	27, // 27: clonr.v1.ClonrService.SetActiveWorkspace:input_type -> clonr.v1.SetActiveWorkspaceRequest
-	28, // 28: clonr.v1.ClonrService.ListWorkspaces:input_type -> clonr.v1.ListWorkspacesRequest
-	29, // 29: clonr.v1.ClonrService.DeleteWorkspace:input_type -> clonr.v1.DeleteWorkspaceRequest
-	30, // 30: clonr.v1.ClonrService.WorkspaceExists:input_type -> clonr.v1.WorkspaceExistsRequest
-	31, // 31: clonr.v1.ClonrService.GetReposByWorkspace:input_type -> clonr.v1.GetReposByWorkspaceRequest
-	32, // 32: clonr.v1.ClonrService.UpdateRepoWorkspace:input_type -> clonr.v1.UpdateRepoWorkspaceRequest
-	0,  // 33: clonr.v1.ClonrService.Ping:output_type -> clonr.v1.Empty
-	33, // 34: clonr.v1.ClonrService.SaveRepo:output_type -> clonr.v1.SaveRepoResponse
-	34, // 35: clonr.v1.ClonrService.RepoExistsByURL:output_type -> clonr.v1.RepoExistsByURLResponse
-	35, // 36: clonr.v1.ClonrService.RepoExistsByPath:output_type -> clonr.v1.RepoExistsByPathResponse
-	36, // 37: clonr.v1.ClonrService.InsertRepoIfNotExists:output_type -> clonr.v1.InsertRepoIfNotExistsResponse
-	37, // 38: clonr.v1.ClonrService.GetAllRepos:output_type -> clonr.v1.GetAllReposResponse
-	38, // 39: clonr.v1.ClonrService.GetRepos:output_type -> clonr.v1.GetReposResponse
-	39, // 40: clonr.v1.ClonrService.SetFavoriteByURL:output_type -> clonr.v1.SetFavoriteResponse
-	40, // 41: clonr.v1.ClonrService.UpdateRepoTimestamp:output_type -> clonr.v1.UpdateRepoTimestampResponse
-	41, // 42: clonr.v1.ClonrService.RemoveRepoByURL:output_type -> clonr.v1.RemoveRepoByURLResponse
-	42, // 43: clonr.v1.ClonrService.GetConfig:output_type -> clonr.v1.GetConfigResponse
-	43, // 44: clonr.v1.ClonrService.SaveConfig:output_type -> clonr.v1.SaveConfigResponse
-	44, // 45: clonr.v1.ClonrService.SaveProfile:output_type -> clonr.v1.SaveProfileResponse
-	45, // 46: clonr.v1.ClonrService.GetProfile:output_type -> clonr.v1.GetProfileResponse
-	46, // 47: clonr.v1.ClonrService.GetActiveProfile:output_type -> clonr.v1.GetActiveProfileResponse
-	47, // 48: clonr.v1.ClonrService.SetActiveProfile:output_type -> clonr.v1.SetActiveProfileResponse
-	48, // 49: clonr.v1.ClonrService.ListProfiles:output_type -> clonr.v1.ListProfilesResponse
-	49, // 50: clonr.v1.ClonrService.DeleteProfile:output_type -> clonr.v1.DeleteProfileResponse
-	50, // 51: clonr.v1.ClonrService.ProfileExists:output_type -> clonr.v1.ProfileExistsResponse
-	51, // 52: clonr.v1.ClonrService.SaveDockerProfile:output_type -> clonr.v1.SaveDockerProfileResponse
-	52, // 53: clonr.v1.ClonrService.GetDockerProfile:output_type -> clonr.v1.GetDockerProfileResponse
-	53, // 54: clonr.v1.ClonrService.ListDockerProfiles:output_type -> clonr.v1.ListDockerProfilesResponse
-	54, // 55: clonr.v1.ClonrService.DeleteDockerProfile:output_type -> clonr.v1.DeleteDockerProfileResponse
-	55, // 56: clonr.v1.ClonrService.DockerProfileExists:output_type -> clonr.v1.DockerProfileExistsResponse
-	56, // 57: clonr.v1.ClonrService.SaveWorkspace:output_type -> clonr.v1.SaveWorkspaceResponse
-	57, // 58: clonr.v1.ClonrService.GetWorkspace:output_type -> clonr.v1.GetWorkspaceResponse
-	58, // 59: clonr.v1.ClonrService.GetActiveWorkspace:output_type -> clonr.v1.GetActiveWorkspaceResponse
-	59, // 60: clonr.v1.ClonrService.SetActiveWorkspace:output_type -> clonr.v1.SetActiveWorkspaceResponse
-	60, // 61: clonr.v1.ClonrService.ListWorkspaces:output_type -> clonr.v1.ListWorkspacesResponse
-	61, // 62: clonr.v1.ClonrService.DeleteWorkspace:output_type -> clonr.v1.DeleteWorkspaceResponse
-	62, // 63: clonr.v1.ClonrService.WorkspaceExists:output_type -> clonr.v1.WorkspaceExistsResponse
-	63, // 64: clonr.v1.ClonrService.GetReposByWorkspace:output_type -> clonr.v1.GetReposByWorkspaceResponse
-	64, // 65: clonr.v1.ClonrService.UpdateRepoWorkspace:output_type -> clonr.v1.UpdateRepoWorkspaceResponse
-	33, // [33:66] is the sub-list for method output_type
-	0,  // [0:33] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: clonr.v1.ClonrService.Ping:input_type -> clonr.v1.Empty
+	0,   // 1: clonr.v1.ClonrService.Shutdown:input_type -> clonr.v1.Empty
+	1,   // 2: clonr.v1.ClonrService.SaveRepo:input_type -> clonr.v1.SaveRepoRequest
+	2,   // 3: clonr.v1.ClonrService.RepoExistsByURL:input_type -> clonr.v1.RepoExistsByURLRequest
+	3,   // 4: clonr.v1.ClonrService.RepoExistsByPath:input_type -> clonr.v1.RepoExistsByPathRequest
+	4,   // 5: clonr.v1.ClonrService.InsertRepoIfNotExists:input_type -> clonr.v1.InsertRepoIfNotExistsRequest
+	5,   // 6: clonr.v1.ClonrService.GetAllRepos:input_type -> clonr.v1.GetAllReposRequest
+	6,   // 7: clonr.v1.ClonrService.GetRepos:input_type -> clonr.v1.GetReposRequest
+	7,   // 8: clonr.v1.ClonrService.ListRepos:input_type -> clonr.v1.ListReposRequest
+	8,   // 9: clonr.v1.ClonrService.SetFavoriteByURL:input_type -> clonr.v1.SetFavoriteRequest
+	9,   // 10: clonr.v1.ClonrService.SetRepoKind:input_type -> clonr.v1.SetRepoKindRequest
+	10,  // 11: clonr.v1.ClonrService.SetRepoUpstream:input_type -> clonr.v1.SetRepoUpstreamRequest
+	11,  // 12: clonr.v1.ClonrService.SetRepoLicense:input_type -> clonr.v1.SetRepoLicenseRequest
+	12,  // 13: clonr.v1.ClonrService.SetRepoTags:input_type -> clonr.v1.SetRepoTagsRequest
+	13,  // 14: clonr.v1.ClonrService.SetRepoNotes:input_type -> clonr.v1.SetRepoNotesRequest
+	14,  // 15: clonr.v1.ClonrService.SetRepoAlias:input_type -> clonr.v1.SetRepoAliasRequest
+	15,  // 16: clonr.v1.ClonrService.RecordRepoAccess:input_type -> clonr.v1.RecordRepoAccessRequest
+	16,  // 17: clonr.v1.ClonrService.SetRepoRemotes:input_type -> clonr.v1.SetRepoRemotesRequest
+	17,  // 18: clonr.v1.ClonrService.GetRepoByRemoteURL:input_type -> clonr.v1.GetRepoByRemoteURLRequest
+	18,  // 19: clonr.v1.ClonrService.GetRepoDetail:input_type -> clonr.v1.GetRepoDetailRequest
+	19,  // 20: clonr.v1.ClonrService.UpdateRepoTimestamp:input_type -> clonr.v1.UpdateRepoTimestampRequest
+	20,  // 21: clonr.v1.ClonrService.RemoveRepoByURL:input_type -> clonr.v1.RemoveRepoByURLRequest
+	21,  // 22: clonr.v1.ClonrService.UpdateRepoPath:input_type -> clonr.v1.UpdateRepoPathRequest
+	22,  // 23: clonr.v1.ClonrService.UpdateRepoURL:input_type -> clonr.v1.UpdateRepoURLRequest
+	23,  // 24: clonr.v1.ClonrService.WatchRepoEvents:input_type -> clonr.v1.WatchRepoEventsRequest
+	24,  // 25: clonr.v1.ClonrService.GetConfig:input_type -> clonr.v1.GetConfigRequest
+	25,  // 26: clonr.v1.ClonrService.SaveConfig:input_type -> clonr.v1.SaveConfigRequest
+	26,  // 27: clonr.v1.ClonrService.SaveProfile:input_type -> clonr.v1.SaveProfileRequest
+	27,  // 28: clonr.v1.ClonrService.GetProfile:input_type -> clonr.v1.GetProfileRequest
+	28,  // 29: clonr.v1.ClonrService.GetActiveProfile:input_type -> clonr.v1.GetActiveProfileRequest
+	29,  // 30: clonr.v1.ClonrService.SetActiveProfile:input_type -> clonr.v1.SetActiveProfileRequest
+	30,  // 31: clonr.v1.ClonrService.ListProfiles:input_type -> clonr.v1.ListProfilesRequest
+	31,  // 32: clonr.v1.ClonrService.DeleteProfile:input_type -> clonr.v1.DeleteProfileRequest
+	32,  // 33: clonr.v1.ClonrService.ProfileExists:input_type -> clonr.v1.ProfileExistsRequest
+	33,  // 34: clonr.v1.ClonrService.SaveDockerProfile:input_type -> clonr.v1.SaveDockerProfileRequest
+	34,  // 35: clonr.v1.ClonrService.GetDockerProfile:input_type -> clonr.v1.GetDockerProfileRequest
+	35,  // 36: clonr.v1.ClonrService.ListDockerProfiles:input_type -> clonr.v1.ListDockerProfilesRequest
+	36,  // 37: clonr.v1.ClonrService.DeleteDockerProfile:input_type -> clonr.v1.DeleteDockerProfileRequest
+	37,  // 38: clonr.v1.ClonrService.DockerProfileExists:input_type -> clonr.v1.DockerProfileExistsRequest
+	38,  // 39: clonr.v1.ClonrService.SaveFilter:input_type -> clonr.v1.SaveFilterRequest
+	39,  // 40: clonr.v1.ClonrService.GetFilter:input_type -> clonr.v1.GetFilterRequest
+	40,  // 41: clonr.v1.ClonrService.ListFilters:input_type -> clonr.v1.ListFiltersRequest
+	41,  // 42: clonr.v1.ClonrService.DeleteFilter:input_type -> clonr.v1.DeleteFilterRequest
+	42,  // 43: clonr.v1.ClonrService.SaveRepoSnapshot:input_type -> clonr.v1.SaveRepoSnapshotRequest
+	43,  // 44: clonr.v1.ClonrService.GetRepoSnapshot:input_type -> clonr.v1.GetRepoSnapshotRequest
+	44,  // 45: clonr.v1.ClonrService.ListRepoSnapshots:input_type -> clonr.v1.ListRepoSnapshotsRequest
+	45,  // 46: clonr.v1.ClonrService.DeleteRepoSnapshot:input_type -> clonr.v1.DeleteRepoSnapshotRequest
+	46,  // 47: clonr.v1.ClonrService.SaveWizardDraft:input_type -> clonr.v1.SaveWizardDraftRequest
+	47,  // 48: clonr.v1.ClonrService.GetWizardDraft:input_type -> clonr.v1.GetWizardDraftRequest
+	48,  // 49: clonr.v1.ClonrService.DeleteWizardDraft:input_type -> clonr.v1.DeleteWizardDraftRequest
+	49,  // 50: clonr.v1.ClonrService.SaveAPIToken:input_type -> clonr.v1.SaveAPITokenRequest
+	50,  // 51: clonr.v1.ClonrService.GetAPITokenByHash:input_type -> clonr.v1.GetAPITokenByHashRequest
+	51,  // 52: clonr.v1.ClonrService.ListAPITokens:input_type -> clonr.v1.ListAPITokensRequest
+	52,  // 53: clonr.v1.ClonrService.DeleteAPIToken:input_type -> clonr.v1.DeleteAPITokenRequest
+	53,  // 54: clonr.v1.ClonrService.SaveVaultSecret:input_type -> clonr.v1.SaveVaultSecretRequest
+	54,  // 55: clonr.v1.ClonrService.GetVaultSecret:input_type -> clonr.v1.GetVaultSecretRequest
+	55,  // 56: clonr.v1.ClonrService.ListVaultSecrets:input_type -> clonr.v1.ListVaultSecretsRequest
+	56,  // 57: clonr.v1.ClonrService.DeleteVaultSecret:input_type -> clonr.v1.DeleteVaultSecretRequest
+	57,  // 58: clonr.v1.ClonrService.SaveGmailWatch:input_type -> clonr.v1.SaveGmailWatchRequest
+	58,  // 59: clonr.v1.ClonrService.GetGmailWatch:input_type -> clonr.v1.GetGmailWatchRequest
+	59,  // 60: clonr.v1.ClonrService.ListGmailWatches:input_type -> clonr.v1.ListGmailWatchesRequest
+	60,  // 61: clonr.v1.ClonrService.DeleteGmailWatch:input_type -> clonr.v1.DeleteGmailWatchRequest
+	61,  // 62: clonr.v1.ClonrService.SaveGitHubRepoID:input_type -> clonr.v1.SaveGitHubRepoIDRequest
+	62,  // 63: clonr.v1.ClonrService.GetGitHubRepoID:input_type -> clonr.v1.GetGitHubRepoIDRequest
+	63,  // 64: clonr.v1.ClonrService.SaveDependencyInventory:input_type -> clonr.v1.SaveDependencyInventoryRequest
+	64,  // 65: clonr.v1.ClonrService.ListDependencyInventories:input_type -> clonr.v1.ListDependencyInventoriesRequest
+	65,  // 66: clonr.v1.ClonrService.SaveShareLink:input_type -> clonr.v1.SaveShareLinkRequest
+	66,  // 67: clonr.v1.ClonrService.GetShareLink:input_type -> clonr.v1.GetShareLinkRequest
+	67,  // 68: clonr.v1.ClonrService.ConsumeShareLink:input_type -> clonr.v1.ConsumeShareLinkRequest
+	68,  // 69: clonr.v1.ClonrService.SaveJob:input_type -> clonr.v1.SaveJobRequest
+	69,  // 70: clonr.v1.ClonrService.GetJob:input_type -> clonr.v1.GetJobRequest
+	70,  // 71: clonr.v1.ClonrService.ListJobs:input_type -> clonr.v1.ListJobsRequest
+	71,  // 72: clonr.v1.ClonrService.CancelJob:input_type -> clonr.v1.CancelJobRequest
+	72,  // 73: clonr.v1.ClonrService.PairDevice:input_type -> clonr.v1.PairDeviceRequest
+	73,  // 74: clonr.v1.ClonrService.SaveWorkspace:input_type -> clonr.v1.SaveWorkspaceRequest
+	74,  // 75: clonr.v1.ClonrService.GetWorkspace:input_type -> clonr.v1.GetWorkspaceRequest
+	75,  // 76: clonr.v1.ClonrService.GetActiveWorkspace:input_type -> clonr.v1.GetActiveWorkspaceRequest
+	76,  // 77: clonr.v1.ClonrService.SetActiveWorkspace:input_type -> clonr.v1.SetActiveWorkspaceRequest
+	77,  // 78: clonr.v1.ClonrService.ListWorkspaces:input_type -> clonr.v1.ListWorkspacesRequest
+	78,  // 79: clonr.v1.ClonrService.DeleteWorkspace:input_type -> clonr.v1.DeleteWorkspaceRequest
+	79,  // 80: clonr.v1.ClonrService.WorkspaceExists:input_type -> clonr.v1.WorkspaceExistsRequest
+	80,  // 81: clonr.v1.ClonrService.GetReposByWorkspace:input_type -> clonr.v1.GetReposByWorkspaceRequest
+	81,  // 82: clonr.v1.ClonrService.UpdateRepoWorkspace:input_type -> clonr.v1.UpdateRepoWorkspaceRequest
+	0,   // 83: clonr.v1.ClonrService.Ping:output_type -> clonr.v1.Empty
+	0,   // 84: clonr.v1.ClonrService.Shutdown:output_type -> clonr.v1.Empty
+	82,  // 85: clonr.v1.ClonrService.SaveRepo:output_type -> clonr.v1.SaveRepoResponse
+	83,  // 86: clonr.v1.ClonrService.RepoExistsByURL:output_type -> clonr.v1.RepoExistsByURLResponse
+	84,  // 87: clonr.v1.ClonrService.RepoExistsByPath:output_type -> clonr.v1.RepoExistsByPathResponse
+	85,  // 88: clonr.v1.ClonrService.InsertRepoIfNotExists:output_type -> clonr.v1.InsertRepoIfNotExistsResponse
+	86,  // 89: clonr.v1.ClonrService.GetAllRepos:output_type -> clonr.v1.GetAllReposResponse
+	87,  // 90: clonr.v1.ClonrService.GetRepos:output_type -> clonr.v1.GetReposResponse
+	88,  // 91: clonr.v1.ClonrService.ListRepos:output_type -> clonr.v1.ListReposResponse
+	89,  // 92: clonr.v1.ClonrService.SetFavoriteByURL:output_type -> clonr.v1.SetFavoriteResponse
+	90,  // 93: clonr.v1.ClonrService.SetRepoKind:output_type -> clonr.v1.SetRepoKindResponse
+	91,  // 94: clonr.v1.ClonrService.SetRepoUpstream:output_type -> clonr.v1.SetRepoUpstreamResponse
+	92,  // 95: clonr.v1.ClonrService.SetRepoLicense:output_type -> clonr.v1.SetRepoLicenseResponse
+	93,  // 96: clonr.v1.ClonrService.SetRepoTags:output_type -> clonr.v1.SetRepoTagsResponse
+	94,  // 97: clonr.v1.ClonrService.SetRepoNotes:output_type -> clonr.v1.SetRepoNotesResponse
+	95,  // 98: clonr.v1.ClonrService.SetRepoAlias:output_type -> clonr.v1.SetRepoAliasResponse
+	96,  // 99: clonr.v1.ClonrService.RecordRepoAccess:output_type -> clonr.v1.RecordRepoAccessResponse
+	97,  // 100: clonr.v1.ClonrService.SetRepoRemotes:output_type -> clonr.v1.SetRepoRemotesResponse
+	98,  // 101: clonr.v1.ClonrService.GetRepoByRemoteURL:output_type -> clonr.v1.GetRepoByRemoteURLResponse
+	99,  // 102: clonr.v1.ClonrService.GetRepoDetail:output_type -> clonr.v1.GetRepoDetailResponse
+	100, // 103: clonr.v1.ClonrService.UpdateRepoTimestamp:output_type -> clonr.v1.UpdateRepoTimestampResponse
+	101, // 104: clonr.v1.ClonrService.RemoveRepoByURL:output_type -> clonr.v1.RemoveRepoByURLResponse
+	102, // 105: clonr.v1.ClonrService.UpdateRepoPath:output_type -> clonr.v1.UpdateRepoPathResponse
+	103, // 106: clonr.v1.ClonrService.UpdateRepoURL:output_type -> clonr.v1.UpdateRepoURLResponse
+	104, // 107: clonr.v1.ClonrService.WatchRepoEvents:output_type -> clonr.v1.RepoEvent
+	105, // 108: clonr.v1.ClonrService.GetConfig:output_type -> clonr.v1.GetConfigResponse
+	106, // 109: clonr.v1.ClonrService.SaveConfig:output_type -> clonr.v1.SaveConfigResponse
+	107, // 110: clonr.v1.ClonrService.SaveProfile:output_type -> clonr.v1.SaveProfileResponse
+	108, // 111: clonr.v1.ClonrService.GetProfile:output_type -> clonr.v1.GetProfileResponse
+	109, // 112: clonr.v1.ClonrService.GetActiveProfile:output_type -> clonr.v1.GetActiveProfileResponse
+	110, // 113: clonr.v1.ClonrService.SetActiveProfile:output_type -> clonr.v1.SetActiveProfileResponse
+	111, // 114: clonr.v1.ClonrService.ListProfiles:output_type -> clonr.v1.ListProfilesResponse
+	112, // 115: clonr.v1.ClonrService.DeleteProfile:output_type -> clonr.v1.DeleteProfileResponse
+	113, // 116: clonr.v1.ClonrService.ProfileExists:output_type -> clonr.v1.ProfileExistsResponse
+	114, // 117: clonr.v1.ClonrService.SaveDockerProfile:output_type -> clonr.v1.SaveDockerProfileResponse
+	115, // 118: clonr.v1.ClonrService.GetDockerProfile:output_type -> clonr.v1.GetDockerProfileResponse
+	116, // 119: clonr.v1.ClonrService.ListDockerProfiles:output_type -> clonr.v1.ListDockerProfilesResponse
+	117, // 120: clonr.v1.ClonrService.DeleteDockerProfile:output_type -> clonr.v1.DeleteDockerProfileResponse
+	118, // 121: clonr.v1.ClonrService.DockerProfileExists:output_type -> clonr.v1.DockerProfileExistsResponse
+	119, // 122: clonr.v1.ClonrService.SaveFilter:output_type -> clonr.v1.SaveFilterResponse
+	120, // 123: clonr.v1.ClonrService.GetFilter:output_type -> clonr.v1.GetFilterResponse
+	121, // 124: clonr.v1.ClonrService.ListFilters:output_type -> clonr.v1.ListFiltersResponse
+	122, // 125: clonr.v1.ClonrService.DeleteFilter:output_type -> clonr.v1.DeleteFilterResponse
+	123, // 126: clonr.v1.ClonrService.SaveRepoSnapshot:output_type -> clonr.v1.SaveRepoSnapshotResponse
+	124, // 127: clonr.v1.ClonrService.GetRepoSnapshot:output_type -> clonr.v1.GetRepoSnapshotResponse
+	125, // 128: clonr.v1.ClonrService.ListRepoSnapshots:output_type -> clonr.v1.ListRepoSnapshotsResponse
+	126, // 129: clonr.v1.ClonrService.DeleteRepoSnapshot:output_type -> clonr.v1.DeleteRepoSnapshotResponse
+	127, // 130: clonr.v1.ClonrService.SaveWizardDraft:output_type -> clonr.v1.SaveWizardDraftResponse
+	128, // 131: clonr.v1.ClonrService.GetWizardDraft:output_type -> clonr.v1.GetWizardDraftResponse
+	129, // 132: clonr.v1.ClonrService.DeleteWizardDraft:output_type -> clonr.v1.DeleteWizardDraftResponse
+	130, // 133: clonr.v1.ClonrService.SaveAPIToken:output_type -> clonr.v1.SaveAPITokenResponse
+	131, // 134: clonr.v1.ClonrService.GetAPITokenByHash:output_type -> clonr.v1.GetAPITokenByHashResponse
+	132, // 135: clonr.v1.ClonrService.ListAPITokens:output_type -> clonr.v1.ListAPITokensResponse
+	133, // 136: clonr.v1.ClonrService.DeleteAPIToken:output_type -> clonr.v1.DeleteAPITokenResponse
+	134, // 137: clonr.v1.ClonrService.SaveVaultSecret:output_type -> clonr.v1.SaveVaultSecretResponse
+	135, // 138: clonr.v1.ClonrService.GetVaultSecret:output_type -> clonr.v1.GetVaultSecretResponse
+	136, // 139: clonr.v1.ClonrService.ListVaultSecrets:output_type -> clonr.v1.ListVaultSecretsResponse
+	137, // 140: clonr.v1.ClonrService.DeleteVaultSecret:output_type -> clonr.v1.DeleteVaultSecretResponse
+	138, // 141: clonr.v1.ClonrService.SaveGmailWatch:output_type -> clonr.v1.SaveGmailWatchResponse
+	139, // 142: clonr.v1.ClonrService.GetGmailWatch:output_type -> clonr.v1.GetGmailWatchResponse
+	140, // 143: clonr.v1.ClonrService.ListGmailWatches:output_type -> clonr.v1.ListGmailWatchesResponse
+	141, // 144: clonr.v1.ClonrService.DeleteGmailWatch:output_type -> clonr.v1.DeleteGmailWatchResponse
+	142, // 145: clonr.v1.ClonrService.SaveGitHubRepoID:output_type -> clonr.v1.SaveGitHubRepoIDResponse
+	143, // 146: clonr.v1.ClonrService.GetGitHubRepoID:output_type -> clonr.v1.GetGitHubRepoIDResponse
+	144, // 147: clonr.v1.ClonrService.SaveDependencyInventory:output_type -> clonr.v1.SaveDependencyInventoryResponse
+	145, // 148: clonr.v1.ClonrService.ListDependencyInventories:output_type -> clonr.v1.ListDependencyInventoriesResponse
+	146, // 149: clonr.v1.ClonrService.SaveShareLink:output_type -> clonr.v1.SaveShareLinkResponse
+	147, // 150: clonr.v1.ClonrService.GetShareLink:output_type -> clonr.v1.GetShareLinkResponse
+	148, // 151: clonr.v1.ClonrService.ConsumeShareLink:output_type -> clonr.v1.ConsumeShareLinkResponse
+	149, // 152: clonr.v1.ClonrService.SaveJob:output_type -> clonr.v1.SaveJobResponse
+	150, // 153: clonr.v1.ClonrService.GetJob:output_type -> clonr.v1.GetJobResponse
+	151, // 154: clonr.v1.ClonrService.ListJobs:output_type -> clonr.v1.ListJobsResponse
+	152, // 155: clonr.v1.ClonrService.CancelJob:output_type -> clonr.v1.CancelJobResponse
+	153, // 156: clonr.v1.ClonrService.PairDevice:output_type -> clonr.v1.PairDeviceResponse
+	154, // 157: clonr.v1.ClonrService.SaveWorkspace:output_type -> clonr.v1.SaveWorkspaceResponse
+	155, // 158: clonr.v1.ClonrService.GetWorkspace:output_type -> clonr.v1.GetWorkspaceResponse
+	156, // 159: clonr.v1.ClonrService.GetActiveWorkspace:output_type -> clonr.v1.GetActiveWorkspaceResponse
+	157, // 160: clonr.v1.ClonrService.SetActiveWorkspace:output_type -> clonr.v1.SetActiveWorkspaceResponse
+	158, // 161: clonr.v1.ClonrService.ListWorkspaces:output_type -> clonr.v1.ListWorkspacesResponse
+	159, // 162: clonr.v1.ClonrService.DeleteWorkspace:output_type -> clonr.v1.DeleteWorkspaceResponse
+	160, // 163: clonr.v1.ClonrService.WorkspaceExists:output_type -> clonr.v1.WorkspaceExistsResponse
+	161, // 164: clonr.v1.ClonrService.GetReposByWorkspace:output_type -> clonr.v1.GetReposByWorkspaceResponse
+	162, // 165: clonr.v1.ClonrService.UpdateRepoWorkspace:output_type -> clonr.v1.UpdateRepoWorkspaceResponse
+	83,  // [83:166] is the sub-list for method output_type
+	0,   // [0:83] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_v1_clonr_proto_init() }
@@ -216,6 +466,17 @@ func file_v1_clonr_proto_init() {
 	file_v1_profile_proto_init()
 	file_v1_docker_profile_proto_init()
 	file_v1_workspace_proto_init()
+	file_v1_saved_filter_proto_init()
+	file_v1_repo_snapshot_proto_init()
+	file_v1_wizard_draft_proto_init()
+	file_v1_api_token_proto_init()
+	file_v1_vault_secret_proto_init()
+	file_v1_gmail_watch_proto_init()
+	file_v1_github_repo_id_proto_init()
+	file_v1_dependency_inventory_proto_init()
+	file_v1_share_link_proto_init()
+	file_v1_job_proto_init()
+	file_v1_pairing_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
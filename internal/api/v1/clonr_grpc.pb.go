@@ -19,39 +19,89 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ClonrService_Ping_FullMethodName                  = "/clonr.v1.ClonrService/Ping"
-	ClonrService_SaveRepo_FullMethodName              = "/clonr.v1.ClonrService/SaveRepo"
-	ClonrService_RepoExistsByURL_FullMethodName       = "/clonr.v1.ClonrService/RepoExistsByURL"
-	ClonrService_RepoExistsByPath_FullMethodName      = "/clonr.v1.ClonrService/RepoExistsByPath"
-	ClonrService_InsertRepoIfNotExists_FullMethodName = "/clonr.v1.ClonrService/InsertRepoIfNotExists"
-	ClonrService_GetAllRepos_FullMethodName           = "/clonr.v1.ClonrService/GetAllRepos"
-	ClonrService_GetRepos_FullMethodName              = "/clonr.v1.ClonrService/GetRepos"
-	ClonrService_SetFavoriteByURL_FullMethodName      = "/clonr.v1.ClonrService/SetFavoriteByURL"
-	ClonrService_UpdateRepoTimestamp_FullMethodName   = "/clonr.v1.ClonrService/UpdateRepoTimestamp"
-	ClonrService_RemoveRepoByURL_FullMethodName       = "/clonr.v1.ClonrService/RemoveRepoByURL"
-	ClonrService_GetConfig_FullMethodName             = "/clonr.v1.ClonrService/GetConfig"
-	ClonrService_SaveConfig_FullMethodName            = "/clonr.v1.ClonrService/SaveConfig"
-	ClonrService_SaveProfile_FullMethodName           = "/clonr.v1.ClonrService/SaveProfile"
-	ClonrService_GetProfile_FullMethodName            = "/clonr.v1.ClonrService/GetProfile"
-	ClonrService_GetActiveProfile_FullMethodName      = "/clonr.v1.ClonrService/GetActiveProfile"
-	ClonrService_SetActiveProfile_FullMethodName      = "/clonr.v1.ClonrService/SetActiveProfile"
-	ClonrService_ListProfiles_FullMethodName          = "/clonr.v1.ClonrService/ListProfiles"
-	ClonrService_DeleteProfile_FullMethodName         = "/clonr.v1.ClonrService/DeleteProfile"
-	ClonrService_ProfileExists_FullMethodName         = "/clonr.v1.ClonrService/ProfileExists"
-	ClonrService_SaveDockerProfile_FullMethodName     = "/clonr.v1.ClonrService/SaveDockerProfile"
-	ClonrService_GetDockerProfile_FullMethodName      = "/clonr.v1.ClonrService/GetDockerProfile"
-	ClonrService_ListDockerProfiles_FullMethodName    = "/clonr.v1.ClonrService/ListDockerProfiles"
-	ClonrService_DeleteDockerProfile_FullMethodName   = "/clonr.v1.ClonrService/DeleteDockerProfile"
-	ClonrService_DockerProfileExists_FullMethodName   = "/clonr.v1.ClonrService/DockerProfileExists"
-	ClonrService_SaveWorkspace_FullMethodName         = "/clonr.v1.ClonrService/SaveWorkspace"
-	ClonrService_GetWorkspace_FullMethodName          = "/clonr.v1.ClonrService/GetWorkspace"
-	ClonrService_GetActiveWorkspace_FullMethodName    = "/clonr.v1.ClonrService/GetActiveWorkspace"
-	ClonrService_SetActiveWorkspace_FullMethodName    = "/clonr.v1.ClonrService/SetActiveWorkspace"
-	ClonrService_ListWorkspaces_FullMethodName        = "/clonr.v1.ClonrService/ListWorkspaces"
-	ClonrService_DeleteWorkspace_FullMethodName       = "/clonr.v1.ClonrService/DeleteWorkspace"
-	ClonrService_WorkspaceExists_FullMethodName       = "/clonr.v1.ClonrService/WorkspaceExists"
-	ClonrService_GetReposByWorkspace_FullMethodName   = "/clonr.v1.ClonrService/GetReposByWorkspace"
-	ClonrService_UpdateRepoWorkspace_FullMethodName   = "/clonr.v1.ClonrService/UpdateRepoWorkspace"
+	ClonrService_Ping_FullMethodName                      = "/clonr.v1.ClonrService/Ping"
+	ClonrService_Shutdown_FullMethodName                  = "/clonr.v1.ClonrService/Shutdown"
+	ClonrService_SaveRepo_FullMethodName                  = "/clonr.v1.ClonrService/SaveRepo"
+	ClonrService_RepoExistsByURL_FullMethodName           = "/clonr.v1.ClonrService/RepoExistsByURL"
+	ClonrService_RepoExistsByPath_FullMethodName          = "/clonr.v1.ClonrService/RepoExistsByPath"
+	ClonrService_InsertRepoIfNotExists_FullMethodName     = "/clonr.v1.ClonrService/InsertRepoIfNotExists"
+	ClonrService_GetAllRepos_FullMethodName               = "/clonr.v1.ClonrService/GetAllRepos"
+	ClonrService_GetRepos_FullMethodName                  = "/clonr.v1.ClonrService/GetRepos"
+	ClonrService_ListRepos_FullMethodName                 = "/clonr.v1.ClonrService/ListRepos"
+	ClonrService_SetFavoriteByURL_FullMethodName          = "/clonr.v1.ClonrService/SetFavoriteByURL"
+	ClonrService_SetRepoKind_FullMethodName               = "/clonr.v1.ClonrService/SetRepoKind"
+	ClonrService_SetRepoUpstream_FullMethodName           = "/clonr.v1.ClonrService/SetRepoUpstream"
+	ClonrService_SetRepoLicense_FullMethodName            = "/clonr.v1.ClonrService/SetRepoLicense"
+	ClonrService_SetRepoTags_FullMethodName               = "/clonr.v1.ClonrService/SetRepoTags"
+	ClonrService_SetRepoNotes_FullMethodName              = "/clonr.v1.ClonrService/SetRepoNotes"
+	ClonrService_SetRepoAlias_FullMethodName              = "/clonr.v1.ClonrService/SetRepoAlias"
+	ClonrService_RecordRepoAccess_FullMethodName          = "/clonr.v1.ClonrService/RecordRepoAccess"
+	ClonrService_SetRepoRemotes_FullMethodName            = "/clonr.v1.ClonrService/SetRepoRemotes"
+	ClonrService_GetRepoByRemoteURL_FullMethodName        = "/clonr.v1.ClonrService/GetRepoByRemoteURL"
+	ClonrService_GetRepoDetail_FullMethodName             = "/clonr.v1.ClonrService/GetRepoDetail"
+	ClonrService_UpdateRepoTimestamp_FullMethodName       = "/clonr.v1.ClonrService/UpdateRepoTimestamp"
+	ClonrService_RemoveRepoByURL_FullMethodName           = "/clonr.v1.ClonrService/RemoveRepoByURL"
+	ClonrService_UpdateRepoPath_FullMethodName            = "/clonr.v1.ClonrService/UpdateRepoPath"
+	ClonrService_UpdateRepoURL_FullMethodName             = "/clonr.v1.ClonrService/UpdateRepoURL"
+	ClonrService_WatchRepoEvents_FullMethodName           = "/clonr.v1.ClonrService/WatchRepoEvents"
+	ClonrService_GetConfig_FullMethodName                 = "/clonr.v1.ClonrService/GetConfig"
+	ClonrService_SaveConfig_FullMethodName                = "/clonr.v1.ClonrService/SaveConfig"
+	ClonrService_SaveProfile_FullMethodName               = "/clonr.v1.ClonrService/SaveProfile"
+	ClonrService_GetProfile_FullMethodName                = "/clonr.v1.ClonrService/GetProfile"
+	ClonrService_GetActiveProfile_FullMethodName          = "/clonr.v1.ClonrService/GetActiveProfile"
+	ClonrService_SetActiveProfile_FullMethodName          = "/clonr.v1.ClonrService/SetActiveProfile"
+	ClonrService_ListProfiles_FullMethodName              = "/clonr.v1.ClonrService/ListProfiles"
+	ClonrService_DeleteProfile_FullMethodName             = "/clonr.v1.ClonrService/DeleteProfile"
+	ClonrService_ProfileExists_FullMethodName             = "/clonr.v1.ClonrService/ProfileExists"
+	ClonrService_SaveDockerProfile_FullMethodName         = "/clonr.v1.ClonrService/SaveDockerProfile"
+	ClonrService_GetDockerProfile_FullMethodName          = "/clonr.v1.ClonrService/GetDockerProfile"
+	ClonrService_ListDockerProfiles_FullMethodName        = "/clonr.v1.ClonrService/ListDockerProfiles"
+	ClonrService_DeleteDockerProfile_FullMethodName       = "/clonr.v1.ClonrService/DeleteDockerProfile"
+	ClonrService_DockerProfileExists_FullMethodName       = "/clonr.v1.ClonrService/DockerProfileExists"
+	ClonrService_SaveFilter_FullMethodName                = "/clonr.v1.ClonrService/SaveFilter"
+	ClonrService_GetFilter_FullMethodName                 = "/clonr.v1.ClonrService/GetFilter"
+	ClonrService_ListFilters_FullMethodName               = "/clonr.v1.ClonrService/ListFilters"
+	ClonrService_DeleteFilter_FullMethodName              = "/clonr.v1.ClonrService/DeleteFilter"
+	ClonrService_SaveRepoSnapshot_FullMethodName          = "/clonr.v1.ClonrService/SaveRepoSnapshot"
+	ClonrService_GetRepoSnapshot_FullMethodName           = "/clonr.v1.ClonrService/GetRepoSnapshot"
+	ClonrService_ListRepoSnapshots_FullMethodName         = "/clonr.v1.ClonrService/ListRepoSnapshots"
+	ClonrService_DeleteRepoSnapshot_FullMethodName        = "/clonr.v1.ClonrService/DeleteRepoSnapshot"
+	ClonrService_SaveWizardDraft_FullMethodName           = "/clonr.v1.ClonrService/SaveWizardDraft"
+	ClonrService_GetWizardDraft_FullMethodName            = "/clonr.v1.ClonrService/GetWizardDraft"
+	ClonrService_DeleteWizardDraft_FullMethodName         = "/clonr.v1.ClonrService/DeleteWizardDraft"
+	ClonrService_SaveAPIToken_FullMethodName              = "/clonr.v1.ClonrService/SaveAPIToken"
+	ClonrService_GetAPITokenByHash_FullMethodName         = "/clonr.v1.ClonrService/GetAPITokenByHash"
+	ClonrService_ListAPITokens_FullMethodName             = "/clonr.v1.ClonrService/ListAPITokens"
+	ClonrService_DeleteAPIToken_FullMethodName            = "/clonr.v1.ClonrService/DeleteAPIToken"
+	ClonrService_SaveVaultSecret_FullMethodName           = "/clonr.v1.ClonrService/SaveVaultSecret"
+	ClonrService_GetVaultSecret_FullMethodName            = "/clonr.v1.ClonrService/GetVaultSecret"
+	ClonrService_ListVaultSecrets_FullMethodName          = "/clonr.v1.ClonrService/ListVaultSecrets"
+	ClonrService_DeleteVaultSecret_FullMethodName         = "/clonr.v1.ClonrService/DeleteVaultSecret"
+	ClonrService_SaveGmailWatch_FullMethodName            = "/clonr.v1.ClonrService/SaveGmailWatch"
+	ClonrService_GetGmailWatch_FullMethodName             = "/clonr.v1.ClonrService/GetGmailWatch"
+	ClonrService_ListGmailWatches_FullMethodName          = "/clonr.v1.ClonrService/ListGmailWatches"
+	ClonrService_DeleteGmailWatch_FullMethodName          = "/clonr.v1.ClonrService/DeleteGmailWatch"
+	ClonrService_SaveGitHubRepoID_FullMethodName          = "/clonr.v1.ClonrService/SaveGitHubRepoID"
+	ClonrService_GetGitHubRepoID_FullMethodName           = "/clonr.v1.ClonrService/GetGitHubRepoID"
+	ClonrService_SaveDependencyInventory_FullMethodName   = "/clonr.v1.ClonrService/SaveDependencyInventory"
+	ClonrService_ListDependencyInventories_FullMethodName = "/clonr.v1.ClonrService/ListDependencyInventories"
+	ClonrService_SaveShareLink_FullMethodName             = "/clonr.v1.ClonrService/SaveShareLink"
+	ClonrService_GetShareLink_FullMethodName              = "/clonr.v1.ClonrService/GetShareLink"
+	ClonrService_ConsumeShareLink_FullMethodName          = "/clonr.v1.ClonrService/ConsumeShareLink"
+	ClonrService_SaveJob_FullMethodName                   = "/clonr.v1.ClonrService/SaveJob"
+	ClonrService_GetJob_FullMethodName                    = "/clonr.v1.ClonrService/GetJob"
+	ClonrService_ListJobs_FullMethodName                  = "/clonr.v1.ClonrService/ListJobs"
+	ClonrService_CancelJob_FullMethodName                 = "/clonr.v1.ClonrService/CancelJob"
+	ClonrService_PairDevice_FullMethodName                = "/clonr.v1.ClonrService/PairDevice"
+	ClonrService_SaveWorkspace_FullMethodName             = "/clonr.v1.ClonrService/SaveWorkspace"
+	ClonrService_GetWorkspace_FullMethodName              = "/clonr.v1.ClonrService/GetWorkspace"
+	ClonrService_GetActiveWorkspace_FullMethodName        = "/clonr.v1.ClonrService/GetActiveWorkspace"
+	ClonrService_SetActiveWorkspace_FullMethodName        = "/clonr.v1.ClonrService/SetActiveWorkspace"
+	ClonrService_ListWorkspaces_FullMethodName            = "/clonr.v1.ClonrService/ListWorkspaces"
+	ClonrService_DeleteWorkspace_FullMethodName           = "/clonr.v1.ClonrService/DeleteWorkspace"
+	ClonrService_WorkspaceExists_FullMethodName           = "/clonr.v1.ClonrService/WorkspaceExists"
+	ClonrService_GetReposByWorkspace_FullMethodName       = "/clonr.v1.ClonrService/GetReposByWorkspace"
+	ClonrService_UpdateRepoWorkspace_FullMethodName       = "/clonr.v1.ClonrService/UpdateRepoWorkspace"
 )
 
 // ClonrServiceClient is the client API for ClonrService service.
@@ -62,6 +112,8 @@ const (
 type ClonrServiceClient interface {
 	// Health check - corresponds to Ping()
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Graceful shutdown requested by 'clonr server stop' (local clients only)
+	Shutdown(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Repository operations
 	SaveRepo(ctx context.Context, in *SaveRepoRequest, opts ...grpc.CallOption) (*SaveRepoResponse, error)
 	RepoExistsByURL(ctx context.Context, in *RepoExistsByURLRequest, opts ...grpc.CallOption) (*RepoExistsByURLResponse, error)
@@ -69,9 +121,23 @@ type ClonrServiceClient interface {
 	InsertRepoIfNotExists(ctx context.Context, in *InsertRepoIfNotExistsRequest, opts ...grpc.CallOption) (*InsertRepoIfNotExistsResponse, error)
 	GetAllRepos(ctx context.Context, in *GetAllReposRequest, opts ...grpc.CallOption) (*GetAllReposResponse, error)
 	GetRepos(ctx context.Context, in *GetReposRequest, opts ...grpc.CallOption) (*GetReposResponse, error)
+	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
 	SetFavoriteByURL(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error)
+	SetRepoKind(ctx context.Context, in *SetRepoKindRequest, opts ...grpc.CallOption) (*SetRepoKindResponse, error)
+	SetRepoUpstream(ctx context.Context, in *SetRepoUpstreamRequest, opts ...grpc.CallOption) (*SetRepoUpstreamResponse, error)
+	SetRepoLicense(ctx context.Context, in *SetRepoLicenseRequest, opts ...grpc.CallOption) (*SetRepoLicenseResponse, error)
+	SetRepoTags(ctx context.Context, in *SetRepoTagsRequest, opts ...grpc.CallOption) (*SetRepoTagsResponse, error)
+	SetRepoNotes(ctx context.Context, in *SetRepoNotesRequest, opts ...grpc.CallOption) (*SetRepoNotesResponse, error)
+	SetRepoAlias(ctx context.Context, in *SetRepoAliasRequest, opts ...grpc.CallOption) (*SetRepoAliasResponse, error)
+	RecordRepoAccess(ctx context.Context, in *RecordRepoAccessRequest, opts ...grpc.CallOption) (*RecordRepoAccessResponse, error)
+	SetRepoRemotes(ctx context.Context, in *SetRepoRemotesRequest, opts ...grpc.CallOption) (*SetRepoRemotesResponse, error)
+	GetRepoByRemoteURL(ctx context.Context, in *GetRepoByRemoteURLRequest, opts ...grpc.CallOption) (*GetRepoByRemoteURLResponse, error)
+	GetRepoDetail(ctx context.Context, in *GetRepoDetailRequest, opts ...grpc.CallOption) (*GetRepoDetailResponse, error)
 	UpdateRepoTimestamp(ctx context.Context, in *UpdateRepoTimestampRequest, opts ...grpc.CallOption) (*UpdateRepoTimestampResponse, error)
 	RemoveRepoByURL(ctx context.Context, in *RemoveRepoByURLRequest, opts ...grpc.CallOption) (*RemoveRepoByURLResponse, error)
+	UpdateRepoPath(ctx context.Context, in *UpdateRepoPathRequest, opts ...grpc.CallOption) (*UpdateRepoPathResponse, error)
+	UpdateRepoURL(ctx context.Context, in *UpdateRepoURLRequest, opts ...grpc.CallOption) (*UpdateRepoURLResponse, error)
+	WatchRepoEvents(ctx context.Context, in *WatchRepoEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RepoEvent], error)
 	// Configuration operations
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	SaveConfig(ctx context.Context, in *SaveConfigRequest, opts ...grpc.CallOption) (*SaveConfigResponse, error)
@@ -89,6 +155,52 @@ type ClonrServiceClient interface {
 	ListDockerProfiles(ctx context.Context, in *ListDockerProfilesRequest, opts ...grpc.CallOption) (*ListDockerProfilesResponse, error)
 	DeleteDockerProfile(ctx context.Context, in *DeleteDockerProfileRequest, opts ...grpc.CallOption) (*DeleteDockerProfileResponse, error)
 	DockerProfileExists(ctx context.Context, in *DockerProfileExistsRequest, opts ...grpc.CallOption) (*DockerProfileExistsResponse, error)
+	// Saved filter operations
+	SaveFilter(ctx context.Context, in *SaveFilterRequest, opts ...grpc.CallOption) (*SaveFilterResponse, error)
+	GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*GetFilterResponse, error)
+	ListFilters(ctx context.Context, in *ListFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error)
+	DeleteFilter(ctx context.Context, in *DeleteFilterRequest, opts ...grpc.CallOption) (*DeleteFilterResponse, error)
+	// Repository snapshot operations
+	SaveRepoSnapshot(ctx context.Context, in *SaveRepoSnapshotRequest, opts ...grpc.CallOption) (*SaveRepoSnapshotResponse, error)
+	GetRepoSnapshot(ctx context.Context, in *GetRepoSnapshotRequest, opts ...grpc.CallOption) (*GetRepoSnapshotResponse, error)
+	ListRepoSnapshots(ctx context.Context, in *ListRepoSnapshotsRequest, opts ...grpc.CallOption) (*ListRepoSnapshotsResponse, error)
+	DeleteRepoSnapshot(ctx context.Context, in *DeleteRepoSnapshotRequest, opts ...grpc.CallOption) (*DeleteRepoSnapshotResponse, error)
+	// Wizard draft operations
+	SaveWizardDraft(ctx context.Context, in *SaveWizardDraftRequest, opts ...grpc.CallOption) (*SaveWizardDraftResponse, error)
+	GetWizardDraft(ctx context.Context, in *GetWizardDraftRequest, opts ...grpc.CallOption) (*GetWizardDraftResponse, error)
+	DeleteWizardDraft(ctx context.Context, in *DeleteWizardDraftRequest, opts ...grpc.CallOption) (*DeleteWizardDraftResponse, error)
+	// API token operations (local clients only)
+	SaveAPIToken(ctx context.Context, in *SaveAPITokenRequest, opts ...grpc.CallOption) (*SaveAPITokenResponse, error)
+	GetAPITokenByHash(ctx context.Context, in *GetAPITokenByHashRequest, opts ...grpc.CallOption) (*GetAPITokenByHashResponse, error)
+	ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error)
+	DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error)
+	// Vault secret operations
+	SaveVaultSecret(ctx context.Context, in *SaveVaultSecretRequest, opts ...grpc.CallOption) (*SaveVaultSecretResponse, error)
+	GetVaultSecret(ctx context.Context, in *GetVaultSecretRequest, opts ...grpc.CallOption) (*GetVaultSecretResponse, error)
+	ListVaultSecrets(ctx context.Context, in *ListVaultSecretsRequest, opts ...grpc.CallOption) (*ListVaultSecretsResponse, error)
+	DeleteVaultSecret(ctx context.Context, in *DeleteVaultSecretRequest, opts ...grpc.CallOption) (*DeleteVaultSecretResponse, error)
+	// Gmail watch operations
+	SaveGmailWatch(ctx context.Context, in *SaveGmailWatchRequest, opts ...grpc.CallOption) (*SaveGmailWatchResponse, error)
+	GetGmailWatch(ctx context.Context, in *GetGmailWatchRequest, opts ...grpc.CallOption) (*GetGmailWatchResponse, error)
+	ListGmailWatches(ctx context.Context, in *ListGmailWatchesRequest, opts ...grpc.CallOption) (*ListGmailWatchesResponse, error)
+	DeleteGmailWatch(ctx context.Context, in *DeleteGmailWatchRequest, opts ...grpc.CallOption) (*DeleteGmailWatchResponse, error)
+	// GitHub repository ID cache operations
+	SaveGitHubRepoID(ctx context.Context, in *SaveGitHubRepoIDRequest, opts ...grpc.CallOption) (*SaveGitHubRepoIDResponse, error)
+	GetGitHubRepoID(ctx context.Context, in *GetGitHubRepoIDRequest, opts ...grpc.CallOption) (*GetGitHubRepoIDResponse, error)
+	// Dependency inventory operations
+	SaveDependencyInventory(ctx context.Context, in *SaveDependencyInventoryRequest, opts ...grpc.CallOption) (*SaveDependencyInventoryResponse, error)
+	ListDependencyInventories(ctx context.Context, in *ListDependencyInventoriesRequest, opts ...grpc.CallOption) (*ListDependencyInventoriesResponse, error)
+	// Share link operations
+	SaveShareLink(ctx context.Context, in *SaveShareLinkRequest, opts ...grpc.CallOption) (*SaveShareLinkResponse, error)
+	GetShareLink(ctx context.Context, in *GetShareLinkRequest, opts ...grpc.CallOption) (*GetShareLinkResponse, error)
+	ConsumeShareLink(ctx context.Context, in *ConsumeShareLinkRequest, opts ...grpc.CallOption) (*ConsumeShareLinkResponse, error)
+	// Job operations
+	SaveJob(ctx context.Context, in *SaveJobRequest, opts ...grpc.CallOption) (*SaveJobResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// Standalone device pairing
+	PairDevice(ctx context.Context, in *PairDeviceRequest, opts ...grpc.CallOption) (*PairDeviceResponse, error)
 	// Workspace operations
 	SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*SaveWorkspaceResponse, error)
 	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error)
@@ -119,6 +231,16 @@ func (c *clonrServiceClient) Ping(ctx context.Context, in *Empty, opts ...grpc.C
 	return out, nil
 }
 
+func (c *clonrServiceClient) Shutdown(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ClonrService_Shutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clonrServiceClient) SaveRepo(ctx context.Context, in *SaveRepoRequest, opts ...grpc.CallOption) (*SaveRepoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveRepoResponse)
@@ -179,6 +301,16 @@ func (c *clonrServiceClient) GetRepos(ctx context.Context, in *GetReposRequest,
 	return out, nil
 }
 
+func (c *clonrServiceClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListRepos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clonrServiceClient) SetFavoriteByURL(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetFavoriteResponse)
@@ -189,6 +321,106 @@ func (c *clonrServiceClient) SetFavoriteByURL(ctx context.Context, in *SetFavori
 	return out, nil
 }
 
+func (c *clonrServiceClient) SetRepoKind(ctx context.Context, in *SetRepoKindRequest, opts ...grpc.CallOption) (*SetRepoKindResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoKindResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoKind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoUpstream(ctx context.Context, in *SetRepoUpstreamRequest, opts ...grpc.CallOption) (*SetRepoUpstreamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoUpstreamResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoUpstream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoLicense(ctx context.Context, in *SetRepoLicenseRequest, opts ...grpc.CallOption) (*SetRepoLicenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoLicenseResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoLicense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoTags(ctx context.Context, in *SetRepoTagsRequest, opts ...grpc.CallOption) (*SetRepoTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoTagsResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoNotes(ctx context.Context, in *SetRepoNotesRequest, opts ...grpc.CallOption) (*SetRepoNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoNotesResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoAlias(ctx context.Context, in *SetRepoAliasRequest, opts ...grpc.CallOption) (*SetRepoAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoAliasResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) RecordRepoAccess(ctx context.Context, in *RecordRepoAccessRequest, opts ...grpc.CallOption) (*RecordRepoAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordRepoAccessResponse)
+	err := c.cc.Invoke(ctx, ClonrService_RecordRepoAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) SetRepoRemotes(ctx context.Context, in *SetRepoRemotesRequest, opts ...grpc.CallOption) (*SetRepoRemotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRepoRemotesResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetRepoRemotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) GetRepoByRemoteURL(ctx context.Context, in *GetRepoByRemoteURLRequest, opts ...grpc.CallOption) (*GetRepoByRemoteURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepoByRemoteURLResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetRepoByRemoteURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) GetRepoDetail(ctx context.Context, in *GetRepoDetailRequest, opts ...grpc.CallOption) (*GetRepoDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepoDetailResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetRepoDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clonrServiceClient) UpdateRepoTimestamp(ctx context.Context, in *UpdateRepoTimestampRequest, opts ...grpc.CallOption) (*UpdateRepoTimestampResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRepoTimestampResponse)
@@ -209,6 +441,45 @@ func (c *clonrServiceClient) RemoveRepoByURL(ctx context.Context, in *RemoveRepo
 	return out, nil
 }
 
+func (c *clonrServiceClient) UpdateRepoPath(ctx context.Context, in *UpdateRepoPathRequest, opts ...grpc.CallOption) (*UpdateRepoPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRepoPathResponse)
+	err := c.cc.Invoke(ctx, ClonrService_UpdateRepoPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) UpdateRepoURL(ctx context.Context, in *UpdateRepoURLRequest, opts ...grpc.CallOption) (*UpdateRepoURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRepoURLResponse)
+	err := c.cc.Invoke(ctx, ClonrService_UpdateRepoURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clonrServiceClient) WatchRepoEvents(ctx context.Context, in *WatchRepoEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RepoEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClonrService_ServiceDesc.Streams[0], ClonrService_WatchRepoEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRepoEventsRequest, RepoEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClonrService_WatchRepoEventsClient = grpc.ServerStreamingClient[RepoEvent]
+
 func (c *clonrServiceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetConfigResponse)
@@ -349,699 +620,2154 @@ func (c *clonrServiceClient) DockerProfileExists(ctx context.Context, in *Docker
 	return out, nil
 }
 
-func (c *clonrServiceClient) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*SaveWorkspaceResponse, error) {
+func (c *clonrServiceClient) SaveFilter(ctx context.Context, in *SaveFilterRequest, opts ...grpc.CallOption) (*SaveFilterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SaveWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_SaveWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(SaveFilterResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error) {
+func (c *clonrServiceClient) GetFilter(ctx context.Context, in *GetFilterRequest, opts ...grpc.CallOption) (*GetFilterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_GetWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(GetFilterResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) GetActiveWorkspace(ctx context.Context, in *GetActiveWorkspaceRequest, opts ...grpc.CallOption) (*GetActiveWorkspaceResponse, error) {
+func (c *clonrServiceClient) ListFilters(ctx context.Context, in *ListFiltersRequest, opts ...grpc.CallOption) (*ListFiltersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetActiveWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_GetActiveWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(ListFiltersResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListFilters_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) SetActiveWorkspace(ctx context.Context, in *SetActiveWorkspaceRequest, opts ...grpc.CallOption) (*SetActiveWorkspaceResponse, error) {
+func (c *clonrServiceClient) DeleteFilter(ctx context.Context, in *DeleteFilterRequest, opts ...grpc.CallOption) (*DeleteFilterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SetActiveWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_SetActiveWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(DeleteFilterResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteFilter_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error) {
+func (c *clonrServiceClient) SaveRepoSnapshot(ctx context.Context, in *SaveRepoSnapshotRequest, opts ...grpc.CallOption) (*SaveRepoSnapshotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListWorkspacesResponse)
-	err := c.cc.Invoke(ctx, ClonrService_ListWorkspaces_FullMethodName, in, out, cOpts...)
+	out := new(SaveRepoSnapshotResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveRepoSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error) {
+func (c *clonrServiceClient) GetRepoSnapshot(ctx context.Context, in *GetRepoSnapshotRequest, opts ...grpc.CallOption) (*GetRepoSnapshotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_DeleteWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(GetRepoSnapshotResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetRepoSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) WorkspaceExists(ctx context.Context, in *WorkspaceExistsRequest, opts ...grpc.CallOption) (*WorkspaceExistsResponse, error) {
+func (c *clonrServiceClient) ListRepoSnapshots(ctx context.Context, in *ListRepoSnapshotsRequest, opts ...grpc.CallOption) (*ListRepoSnapshotsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(WorkspaceExistsResponse)
-	err := c.cc.Invoke(ctx, ClonrService_WorkspaceExists_FullMethodName, in, out, cOpts...)
+	out := new(ListRepoSnapshotsResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListRepoSnapshots_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) GetReposByWorkspace(ctx context.Context, in *GetReposByWorkspaceRequest, opts ...grpc.CallOption) (*GetReposByWorkspaceResponse, error) {
+func (c *clonrServiceClient) DeleteRepoSnapshot(ctx context.Context, in *DeleteRepoSnapshotRequest, opts ...grpc.CallOption) (*DeleteRepoSnapshotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetReposByWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_GetReposByWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(DeleteRepoSnapshotResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteRepoSnapshot_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *clonrServiceClient) UpdateRepoWorkspace(ctx context.Context, in *UpdateRepoWorkspaceRequest, opts ...grpc.CallOption) (*UpdateRepoWorkspaceResponse, error) {
+func (c *clonrServiceClient) SaveWizardDraft(ctx context.Context, in *SaveWizardDraftRequest, opts ...grpc.CallOption) (*SaveWizardDraftResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateRepoWorkspaceResponse)
-	err := c.cc.Invoke(ctx, ClonrService_UpdateRepoWorkspace_FullMethodName, in, out, cOpts...)
+	out := new(SaveWizardDraftResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveWizardDraft_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ClonrServiceServer is the server API for ClonrService service.
-// All implementations must embed UnimplementedClonrServiceServer
-// for forward compatibility.
-//
-// ClonrService defines all database operations for Clonr
-type ClonrServiceServer interface {
-	// Health check - corresponds to Ping()
-	Ping(context.Context, *Empty) (*Empty, error)
-	// Repository operations
-	SaveRepo(context.Context, *SaveRepoRequest) (*SaveRepoResponse, error)
-	RepoExistsByURL(context.Context, *RepoExistsByURLRequest) (*RepoExistsByURLResponse, error)
-	RepoExistsByPath(context.Context, *RepoExistsByPathRequest) (*RepoExistsByPathResponse, error)
-	InsertRepoIfNotExists(context.Context, *InsertRepoIfNotExistsRequest) (*InsertRepoIfNotExistsResponse, error)
-	GetAllRepos(context.Context, *GetAllReposRequest) (*GetAllReposResponse, error)
-	GetRepos(context.Context, *GetReposRequest) (*GetReposResponse, error)
-	SetFavoriteByURL(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error)
-	UpdateRepoTimestamp(context.Context, *UpdateRepoTimestampRequest) (*UpdateRepoTimestampResponse, error)
-	RemoveRepoByURL(context.Context, *RemoveRepoByURLRequest) (*RemoveRepoByURLResponse, error)
-	// Configuration operations
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error)
-	// Profile operations
-	SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error)
-	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
-	GetActiveProfile(context.Context, *GetActiveProfileRequest) (*GetActiveProfileResponse, error)
-	SetActiveProfile(context.Context, *SetActiveProfileRequest) (*SetActiveProfileResponse, error)
-	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
-	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
-	ProfileExists(context.Context, *ProfileExistsRequest) (*ProfileExistsResponse, error)
-	// Docker profile operations
-	SaveDockerProfile(context.Context, *SaveDockerProfileRequest) (*SaveDockerProfileResponse, error)
-	GetDockerProfile(context.Context, *GetDockerProfileRequest) (*GetDockerProfileResponse, error)
-	ListDockerProfiles(context.Context, *ListDockerProfilesRequest) (*ListDockerProfilesResponse, error)
-	DeleteDockerProfile(context.Context, *DeleteDockerProfileRequest) (*DeleteDockerProfileResponse, error)
-	DockerProfileExists(context.Context, *DockerProfileExistsRequest) (*DockerProfileExistsResponse, error)
-	// Workspace operations
-	SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*SaveWorkspaceResponse, error)
-	GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error)
-	GetActiveWorkspace(context.Context, *GetActiveWorkspaceRequest) (*GetActiveWorkspaceResponse, error)
-	SetActiveWorkspace(context.Context, *SetActiveWorkspaceRequest) (*SetActiveWorkspaceResponse, error)
-	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
-	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
-	WorkspaceExists(context.Context, *WorkspaceExistsRequest) (*WorkspaceExistsResponse, error)
-	GetReposByWorkspace(context.Context, *GetReposByWorkspaceRequest) (*GetReposByWorkspaceResponse, error)
-	UpdateRepoWorkspace(context.Context, *UpdateRepoWorkspaceRequest) (*UpdateRepoWorkspaceResponse, error)
-	mustEmbedUnimplementedClonrServiceServer()
+func (c *clonrServiceClient) GetWizardDraft(ctx context.Context, in *GetWizardDraftRequest, opts ...grpc.CallOption) (*GetWizardDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWizardDraftResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetWizardDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedClonrServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedClonrServiceServer struct{}
+func (c *clonrServiceClient) DeleteWizardDraft(ctx context.Context, in *DeleteWizardDraftRequest, opts ...grpc.CallOption) (*DeleteWizardDraftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWizardDraftResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteWizardDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedClonrServiceServer) Ping(context.Context, *Empty) (*Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method Ping not implemented")
+func (c *clonrServiceClient) SaveAPIToken(ctx context.Context, in *SaveAPITokenRequest, opts ...grpc.CallOption) (*SaveAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveAPITokenResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SaveRepo(context.Context, *SaveRepoRequest) (*SaveRepoResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveRepo not implemented")
+
+func (c *clonrServiceClient) GetAPITokenByHash(ctx context.Context, in *GetAPITokenByHashRequest, opts ...grpc.CallOption) (*GetAPITokenByHashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAPITokenByHashResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetAPITokenByHash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) RepoExistsByURL(context.Context, *RepoExistsByURLRequest) (*RepoExistsByURLResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RepoExistsByURL not implemented")
+
+func (c *clonrServiceClient) ListAPITokens(ctx context.Context, in *ListAPITokensRequest, opts ...grpc.CallOption) (*ListAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPITokensResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) RepoExistsByPath(context.Context, *RepoExistsByPathRequest) (*RepoExistsByPathResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RepoExistsByPath not implemented")
+
+func (c *clonrServiceClient) DeleteAPIToken(ctx context.Context, in *DeleteAPITokenRequest, opts ...grpc.CallOption) (*DeleteAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAPITokenResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) InsertRepoIfNotExists(context.Context, *InsertRepoIfNotExistsRequest) (*InsertRepoIfNotExistsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method InsertRepoIfNotExists not implemented")
+
+func (c *clonrServiceClient) SaveVaultSecret(ctx context.Context, in *SaveVaultSecretRequest, opts ...grpc.CallOption) (*SaveVaultSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveVaultSecretResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveVaultSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetAllRepos(context.Context, *GetAllReposRequest) (*GetAllReposResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetAllRepos not implemented")
+
+func (c *clonrServiceClient) GetVaultSecret(ctx context.Context, in *GetVaultSecretRequest, opts ...grpc.CallOption) (*GetVaultSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVaultSecretResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetVaultSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetRepos(context.Context, *GetReposRequest) (*GetReposResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRepos not implemented")
+
+func (c *clonrServiceClient) ListVaultSecrets(ctx context.Context, in *ListVaultSecretsRequest, opts ...grpc.CallOption) (*ListVaultSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaultSecretsResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListVaultSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SetFavoriteByURL(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetFavoriteByURL not implemented")
+
+func (c *clonrServiceClient) DeleteVaultSecret(ctx context.Context, in *DeleteVaultSecretRequest, opts ...grpc.CallOption) (*DeleteVaultSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVaultSecretResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteVaultSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) UpdateRepoTimestamp(context.Context, *UpdateRepoTimestampRequest) (*UpdateRepoTimestampResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRepoTimestamp not implemented")
+
+func (c *clonrServiceClient) SaveGmailWatch(ctx context.Context, in *SaveGmailWatchRequest, opts ...grpc.CallOption) (*SaveGmailWatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveGmailWatchResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveGmailWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) RemoveRepoByURL(context.Context, *RemoveRepoByURLRequest) (*RemoveRepoByURLResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RemoveRepoByURL not implemented")
+
+func (c *clonrServiceClient) GetGmailWatch(ctx context.Context, in *GetGmailWatchRequest, opts ...grpc.CallOption) (*GetGmailWatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGmailWatchResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetGmailWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetConfig not implemented")
+
+func (c *clonrServiceClient) ListGmailWatches(ctx context.Context, in *ListGmailWatchesRequest, opts ...grpc.CallOption) (*ListGmailWatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGmailWatchesResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListGmailWatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveConfig not implemented")
+
+func (c *clonrServiceClient) DeleteGmailWatch(ctx context.Context, in *DeleteGmailWatchRequest, opts ...grpc.CallOption) (*DeleteGmailWatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGmailWatchResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteGmailWatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveProfile not implemented")
+
+func (c *clonrServiceClient) SaveGitHubRepoID(ctx context.Context, in *SaveGitHubRepoIDRequest, opts ...grpc.CallOption) (*SaveGitHubRepoIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveGitHubRepoIDResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveGitHubRepoID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
+
+func (c *clonrServiceClient) GetGitHubRepoID(ctx context.Context, in *GetGitHubRepoIDRequest, opts ...grpc.CallOption) (*GetGitHubRepoIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGitHubRepoIDResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetGitHubRepoID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetActiveProfile(context.Context, *GetActiveProfileRequest) (*GetActiveProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetActiveProfile not implemented")
+
+func (c *clonrServiceClient) SaveDependencyInventory(ctx context.Context, in *SaveDependencyInventoryRequest, opts ...grpc.CallOption) (*SaveDependencyInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveDependencyInventoryResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveDependencyInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SetActiveProfile(context.Context, *SetActiveProfileRequest) (*SetActiveProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetActiveProfile not implemented")
+
+func (c *clonrServiceClient) ListDependencyInventories(ctx context.Context, in *ListDependencyInventoriesRequest, opts ...grpc.CallOption) (*ListDependencyInventoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDependencyInventoriesResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListDependencyInventories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListProfiles not implemented")
+
+func (c *clonrServiceClient) SaveShareLink(ctx context.Context, in *SaveShareLinkRequest, opts ...grpc.CallOption) (*SaveShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveShareLinkResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteProfile not implemented")
+
+func (c *clonrServiceClient) GetShareLink(ctx context.Context, in *GetShareLinkRequest, opts ...grpc.CallOption) (*GetShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShareLinkResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) ProfileExists(context.Context, *ProfileExistsRequest) (*ProfileExistsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ProfileExists not implemented")
+
+func (c *clonrServiceClient) ConsumeShareLink(ctx context.Context, in *ConsumeShareLinkRequest, opts ...grpc.CallOption) (*ConsumeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeShareLinkResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ConsumeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SaveDockerProfile(context.Context, *SaveDockerProfileRequest) (*SaveDockerProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveDockerProfile not implemented")
+
+func (c *clonrServiceClient) SaveJob(ctx context.Context, in *SaveJobRequest, opts ...grpc.CallOption) (*SaveJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveJobResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetDockerProfile(context.Context, *GetDockerProfileRequest) (*GetDockerProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetDockerProfile not implemented")
+
+func (c *clonrServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) ListDockerProfiles(context.Context, *ListDockerProfilesRequest) (*ListDockerProfilesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListDockerProfiles not implemented")
+
+func (c *clonrServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) DeleteDockerProfile(context.Context, *DeleteDockerProfileRequest) (*DeleteDockerProfileResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteDockerProfile not implemented")
+
+func (c *clonrServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, ClonrService_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) DockerProfileExists(context.Context, *DockerProfileExistsRequest) (*DockerProfileExistsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DockerProfileExists not implemented")
+
+func (c *clonrServiceClient) PairDevice(ctx context.Context, in *PairDeviceRequest, opts ...grpc.CallOption) (*PairDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PairDeviceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_PairDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*SaveWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SaveWorkspace not implemented")
+
+func (c *clonrServiceClient) SaveWorkspace(ctx context.Context, in *SaveWorkspaceRequest, opts ...grpc.CallOption) (*SaveWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SaveWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetWorkspace not implemented")
+
+func (c *clonrServiceClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*GetWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetActiveWorkspace(context.Context, *GetActiveWorkspaceRequest) (*GetActiveWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetActiveWorkspace not implemented")
+
+func (c *clonrServiceClient) GetActiveWorkspace(ctx context.Context, in *GetActiveWorkspaceRequest, opts ...grpc.CallOption) (*GetActiveWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActiveWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetActiveWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) SetActiveWorkspace(context.Context, *SetActiveWorkspaceRequest) (*SetActiveWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetActiveWorkspace not implemented")
+
+func (c *clonrServiceClient) SetActiveWorkspace(ctx context.Context, in *SetActiveWorkspaceRequest, opts ...grpc.CallOption) (*SetActiveWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetActiveWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_SetActiveWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListWorkspaces not implemented")
+
+func (c *clonrServiceClient) ListWorkspaces(ctx context.Context, in *ListWorkspacesRequest, opts ...grpc.CallOption) (*ListWorkspacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkspacesResponse)
+	err := c.cc.Invoke(ctx, ClonrService_ListWorkspaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteWorkspace not implemented")
+
+func (c *clonrServiceClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_DeleteWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) WorkspaceExists(context.Context, *WorkspaceExistsRequest) (*WorkspaceExistsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method WorkspaceExists not implemented")
+
+func (c *clonrServiceClient) WorkspaceExists(ctx context.Context, in *WorkspaceExistsRequest, opts ...grpc.CallOption) (*WorkspaceExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceExistsResponse)
+	err := c.cc.Invoke(ctx, ClonrService_WorkspaceExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) GetReposByWorkspace(context.Context, *GetReposByWorkspaceRequest) (*GetReposByWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetReposByWorkspace not implemented")
+
+func (c *clonrServiceClient) GetReposByWorkspace(ctx context.Context, in *GetReposByWorkspaceRequest, opts ...grpc.CallOption) (*GetReposByWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReposByWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_GetReposByWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedClonrServiceServer) UpdateRepoWorkspace(context.Context, *UpdateRepoWorkspaceRequest) (*UpdateRepoWorkspaceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRepoWorkspace not implemented")
+
+func (c *clonrServiceClient) UpdateRepoWorkspace(ctx context.Context, in *UpdateRepoWorkspaceRequest, opts ...grpc.CallOption) (*UpdateRepoWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRepoWorkspaceResponse)
+	err := c.cc.Invoke(ctx, ClonrService_UpdateRepoWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClonrServiceServer is the server API for ClonrService service.
+// All implementations must embed UnimplementedClonrServiceServer
+// for forward compatibility.
+//
+// ClonrService defines all database operations for Clonr
+type ClonrServiceServer interface {
+	// Health check - corresponds to Ping()
+	Ping(context.Context, *Empty) (*Empty, error)
+	// Graceful shutdown requested by 'clonr server stop' (local clients only)
+	Shutdown(context.Context, *Empty) (*Empty, error)
+	// Repository operations
+	SaveRepo(context.Context, *SaveRepoRequest) (*SaveRepoResponse, error)
+	RepoExistsByURL(context.Context, *RepoExistsByURLRequest) (*RepoExistsByURLResponse, error)
+	RepoExistsByPath(context.Context, *RepoExistsByPathRequest) (*RepoExistsByPathResponse, error)
+	InsertRepoIfNotExists(context.Context, *InsertRepoIfNotExistsRequest) (*InsertRepoIfNotExistsResponse, error)
+	GetAllRepos(context.Context, *GetAllReposRequest) (*GetAllReposResponse, error)
+	GetRepos(context.Context, *GetReposRequest) (*GetReposResponse, error)
+	ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error)
+	SetFavoriteByURL(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error)
+	SetRepoKind(context.Context, *SetRepoKindRequest) (*SetRepoKindResponse, error)
+	SetRepoUpstream(context.Context, *SetRepoUpstreamRequest) (*SetRepoUpstreamResponse, error)
+	SetRepoLicense(context.Context, *SetRepoLicenseRequest) (*SetRepoLicenseResponse, error)
+	SetRepoTags(context.Context, *SetRepoTagsRequest) (*SetRepoTagsResponse, error)
+	SetRepoNotes(context.Context, *SetRepoNotesRequest) (*SetRepoNotesResponse, error)
+	SetRepoAlias(context.Context, *SetRepoAliasRequest) (*SetRepoAliasResponse, error)
+	RecordRepoAccess(context.Context, *RecordRepoAccessRequest) (*RecordRepoAccessResponse, error)
+	SetRepoRemotes(context.Context, *SetRepoRemotesRequest) (*SetRepoRemotesResponse, error)
+	GetRepoByRemoteURL(context.Context, *GetRepoByRemoteURLRequest) (*GetRepoByRemoteURLResponse, error)
+	GetRepoDetail(context.Context, *GetRepoDetailRequest) (*GetRepoDetailResponse, error)
+	UpdateRepoTimestamp(context.Context, *UpdateRepoTimestampRequest) (*UpdateRepoTimestampResponse, error)
+	RemoveRepoByURL(context.Context, *RemoveRepoByURLRequest) (*RemoveRepoByURLResponse, error)
+	UpdateRepoPath(context.Context, *UpdateRepoPathRequest) (*UpdateRepoPathResponse, error)
+	UpdateRepoURL(context.Context, *UpdateRepoURLRequest) (*UpdateRepoURLResponse, error)
+	WatchRepoEvents(*WatchRepoEventsRequest, grpc.ServerStreamingServer[RepoEvent]) error
+	// Configuration operations
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error)
+	// Profile operations
+	SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error)
+	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
+	GetActiveProfile(context.Context, *GetActiveProfileRequest) (*GetActiveProfileResponse, error)
+	SetActiveProfile(context.Context, *SetActiveProfileRequest) (*SetActiveProfileResponse, error)
+	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
+	ProfileExists(context.Context, *ProfileExistsRequest) (*ProfileExistsResponse, error)
+	// Docker profile operations
+	SaveDockerProfile(context.Context, *SaveDockerProfileRequest) (*SaveDockerProfileResponse, error)
+	GetDockerProfile(context.Context, *GetDockerProfileRequest) (*GetDockerProfileResponse, error)
+	ListDockerProfiles(context.Context, *ListDockerProfilesRequest) (*ListDockerProfilesResponse, error)
+	DeleteDockerProfile(context.Context, *DeleteDockerProfileRequest) (*DeleteDockerProfileResponse, error)
+	DockerProfileExists(context.Context, *DockerProfileExistsRequest) (*DockerProfileExistsResponse, error)
+	// Saved filter operations
+	SaveFilter(context.Context, *SaveFilterRequest) (*SaveFilterResponse, error)
+	GetFilter(context.Context, *GetFilterRequest) (*GetFilterResponse, error)
+	ListFilters(context.Context, *ListFiltersRequest) (*ListFiltersResponse, error)
+	DeleteFilter(context.Context, *DeleteFilterRequest) (*DeleteFilterResponse, error)
+	// Repository snapshot operations
+	SaveRepoSnapshot(context.Context, *SaveRepoSnapshotRequest) (*SaveRepoSnapshotResponse, error)
+	GetRepoSnapshot(context.Context, *GetRepoSnapshotRequest) (*GetRepoSnapshotResponse, error)
+	ListRepoSnapshots(context.Context, *ListRepoSnapshotsRequest) (*ListRepoSnapshotsResponse, error)
+	DeleteRepoSnapshot(context.Context, *DeleteRepoSnapshotRequest) (*DeleteRepoSnapshotResponse, error)
+	// Wizard draft operations
+	SaveWizardDraft(context.Context, *SaveWizardDraftRequest) (*SaveWizardDraftResponse, error)
+	GetWizardDraft(context.Context, *GetWizardDraftRequest) (*GetWizardDraftResponse, error)
+	DeleteWizardDraft(context.Context, *DeleteWizardDraftRequest) (*DeleteWizardDraftResponse, error)
+	// API token operations (local clients only)
+	SaveAPIToken(context.Context, *SaveAPITokenRequest) (*SaveAPITokenResponse, error)
+	GetAPITokenByHash(context.Context, *GetAPITokenByHashRequest) (*GetAPITokenByHashResponse, error)
+	ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error)
+	DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error)
+	// Vault secret operations
+	SaveVaultSecret(context.Context, *SaveVaultSecretRequest) (*SaveVaultSecretResponse, error)
+	GetVaultSecret(context.Context, *GetVaultSecretRequest) (*GetVaultSecretResponse, error)
+	ListVaultSecrets(context.Context, *ListVaultSecretsRequest) (*ListVaultSecretsResponse, error)
+	DeleteVaultSecret(context.Context, *DeleteVaultSecretRequest) (*DeleteVaultSecretResponse, error)
+	// Gmail watch operations
+	SaveGmailWatch(context.Context, *SaveGmailWatchRequest) (*SaveGmailWatchResponse, error)
+	GetGmailWatch(context.Context, *GetGmailWatchRequest) (*GetGmailWatchResponse, error)
+	ListGmailWatches(context.Context, *ListGmailWatchesRequest) (*ListGmailWatchesResponse, error)
+	DeleteGmailWatch(context.Context, *DeleteGmailWatchRequest) (*DeleteGmailWatchResponse, error)
+	// GitHub repository ID cache operations
+	SaveGitHubRepoID(context.Context, *SaveGitHubRepoIDRequest) (*SaveGitHubRepoIDResponse, error)
+	GetGitHubRepoID(context.Context, *GetGitHubRepoIDRequest) (*GetGitHubRepoIDResponse, error)
+	// Dependency inventory operations
+	SaveDependencyInventory(context.Context, *SaveDependencyInventoryRequest) (*SaveDependencyInventoryResponse, error)
+	ListDependencyInventories(context.Context, *ListDependencyInventoriesRequest) (*ListDependencyInventoriesResponse, error)
+	// Share link operations
+	SaveShareLink(context.Context, *SaveShareLinkRequest) (*SaveShareLinkResponse, error)
+	GetShareLink(context.Context, *GetShareLinkRequest) (*GetShareLinkResponse, error)
+	ConsumeShareLink(context.Context, *ConsumeShareLinkRequest) (*ConsumeShareLinkResponse, error)
+	// Job operations
+	SaveJob(context.Context, *SaveJobRequest) (*SaveJobResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// Standalone device pairing
+	PairDevice(context.Context, *PairDeviceRequest) (*PairDeviceResponse, error)
+	// Workspace operations
+	SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*SaveWorkspaceResponse, error)
+	GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error)
+	GetActiveWorkspace(context.Context, *GetActiveWorkspaceRequest) (*GetActiveWorkspaceResponse, error)
+	SetActiveWorkspace(context.Context, *SetActiveWorkspaceRequest) (*SetActiveWorkspaceResponse, error)
+	ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error)
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
+	WorkspaceExists(context.Context, *WorkspaceExistsRequest) (*WorkspaceExistsResponse, error)
+	GetReposByWorkspace(context.Context, *GetReposByWorkspaceRequest) (*GetReposByWorkspaceResponse, error)
+	UpdateRepoWorkspace(context.Context, *UpdateRepoWorkspaceRequest) (*UpdateRepoWorkspaceResponse, error)
+	mustEmbedUnimplementedClonrServiceServer()
+}
+
+// UnimplementedClonrServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClonrServiceServer struct{}
+
+func (UnimplementedClonrServiceServer) Ping(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedClonrServiceServer) Shutdown(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveRepo(context.Context, *SaveRepoRequest) (*SaveRepoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveRepo not implemented")
+}
+func (UnimplementedClonrServiceServer) RepoExistsByURL(context.Context, *RepoExistsByURLRequest) (*RepoExistsByURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepoExistsByURL not implemented")
+}
+func (UnimplementedClonrServiceServer) RepoExistsByPath(context.Context, *RepoExistsByPathRequest) (*RepoExistsByPathResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RepoExistsByPath not implemented")
+}
+func (UnimplementedClonrServiceServer) InsertRepoIfNotExists(context.Context, *InsertRepoIfNotExistsRequest) (*InsertRepoIfNotExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InsertRepoIfNotExists not implemented")
+}
+func (UnimplementedClonrServiceServer) GetAllRepos(context.Context, *GetAllReposRequest) (*GetAllReposResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAllRepos not implemented")
+}
+func (UnimplementedClonrServiceServer) GetRepos(context.Context, *GetReposRequest) (*GetReposResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRepos not implemented")
+}
+func (UnimplementedClonrServiceServer) ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRepos not implemented")
+}
+func (UnimplementedClonrServiceServer) SetFavoriteByURL(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFavoriteByURL not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoKind(context.Context, *SetRepoKindRequest) (*SetRepoKindResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoKind not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoUpstream(context.Context, *SetRepoUpstreamRequest) (*SetRepoUpstreamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoUpstream not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoLicense(context.Context, *SetRepoLicenseRequest) (*SetRepoLicenseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoLicense not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoTags(context.Context, *SetRepoTagsRequest) (*SetRepoTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoTags not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoNotes(context.Context, *SetRepoNotesRequest) (*SetRepoNotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoNotes not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoAlias(context.Context, *SetRepoAliasRequest) (*SetRepoAliasResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoAlias not implemented")
+}
+func (UnimplementedClonrServiceServer) RecordRepoAccess(context.Context, *RecordRepoAccessRequest) (*RecordRepoAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordRepoAccess not implemented")
+}
+func (UnimplementedClonrServiceServer) SetRepoRemotes(context.Context, *SetRepoRemotesRequest) (*SetRepoRemotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRepoRemotes not implemented")
+}
+func (UnimplementedClonrServiceServer) GetRepoByRemoteURL(context.Context, *GetRepoByRemoteURLRequest) (*GetRepoByRemoteURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRepoByRemoteURL not implemented")
+}
+func (UnimplementedClonrServiceServer) GetRepoDetail(context.Context, *GetRepoDetailRequest) (*GetRepoDetailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRepoDetail not implemented")
+}
+func (UnimplementedClonrServiceServer) UpdateRepoTimestamp(context.Context, *UpdateRepoTimestampRequest) (*UpdateRepoTimestampResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRepoTimestamp not implemented")
+}
+func (UnimplementedClonrServiceServer) RemoveRepoByURL(context.Context, *RemoveRepoByURLRequest) (*RemoveRepoByURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveRepoByURL not implemented")
+}
+func (UnimplementedClonrServiceServer) UpdateRepoPath(context.Context, *UpdateRepoPathRequest) (*UpdateRepoPathResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRepoPath not implemented")
+}
+func (UnimplementedClonrServiceServer) UpdateRepoURL(context.Context, *UpdateRepoURLRequest) (*UpdateRepoURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRepoURL not implemented")
+}
+func (UnimplementedClonrServiceServer) WatchRepoEvents(*WatchRepoEventsRequest, grpc.ServerStreamingServer[RepoEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchRepoEvents not implemented")
+}
+func (UnimplementedClonrServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveConfig(context.Context, *SaveConfigRequest) (*SaveConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveConfig not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveProfile(context.Context, *SaveProfileRequest) (*SaveProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) GetActiveProfile(context.Context, *GetActiveProfileRequest) (*GetActiveProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) SetActiveProfile(context.Context, *SetActiveProfileRequest) (*SetActiveProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetActiveProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) ProfileExists(context.Context, *ProfileExistsRequest) (*ProfileExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProfileExists not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveDockerProfile(context.Context, *SaveDockerProfileRequest) (*SaveDockerProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveDockerProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) GetDockerProfile(context.Context, *GetDockerProfileRequest) (*GetDockerProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDockerProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) ListDockerProfiles(context.Context, *ListDockerProfilesRequest) (*ListDockerProfilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDockerProfiles not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteDockerProfile(context.Context, *DeleteDockerProfileRequest) (*DeleteDockerProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDockerProfile not implemented")
+}
+func (UnimplementedClonrServiceServer) DockerProfileExists(context.Context, *DockerProfileExistsRequest) (*DockerProfileExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DockerProfileExists not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveFilter(context.Context, *SaveFilterRequest) (*SaveFilterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveFilter not implemented")
+}
+func (UnimplementedClonrServiceServer) GetFilter(context.Context, *GetFilterRequest) (*GetFilterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFilter not implemented")
+}
+func (UnimplementedClonrServiceServer) ListFilters(context.Context, *ListFiltersRequest) (*ListFiltersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFilters not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteFilter(context.Context, *DeleteFilterRequest) (*DeleteFilterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFilter not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveRepoSnapshot(context.Context, *SaveRepoSnapshotRequest) (*SaveRepoSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveRepoSnapshot not implemented")
+}
+func (UnimplementedClonrServiceServer) GetRepoSnapshot(context.Context, *GetRepoSnapshotRequest) (*GetRepoSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRepoSnapshot not implemented")
+}
+func (UnimplementedClonrServiceServer) ListRepoSnapshots(context.Context, *ListRepoSnapshotsRequest) (*ListRepoSnapshotsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRepoSnapshots not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteRepoSnapshot(context.Context, *DeleteRepoSnapshotRequest) (*DeleteRepoSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRepoSnapshot not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveWizardDraft(context.Context, *SaveWizardDraftRequest) (*SaveWizardDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveWizardDraft not implemented")
+}
+func (UnimplementedClonrServiceServer) GetWizardDraft(context.Context, *GetWizardDraftRequest) (*GetWizardDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWizardDraft not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteWizardDraft(context.Context, *DeleteWizardDraftRequest) (*DeleteWizardDraftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWizardDraft not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveAPIToken(context.Context, *SaveAPITokenRequest) (*SaveAPITokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveAPIToken not implemented")
+}
+func (UnimplementedClonrServiceServer) GetAPITokenByHash(context.Context, *GetAPITokenByHashRequest) (*GetAPITokenByHashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAPITokenByHash not implemented")
+}
+func (UnimplementedClonrServiceServer) ListAPITokens(context.Context, *ListAPITokensRequest) (*ListAPITokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAPITokens not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteAPIToken(context.Context, *DeleteAPITokenRequest) (*DeleteAPITokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAPIToken not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveVaultSecret(context.Context, *SaveVaultSecretRequest) (*SaveVaultSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveVaultSecret not implemented")
+}
+func (UnimplementedClonrServiceServer) GetVaultSecret(context.Context, *GetVaultSecretRequest) (*GetVaultSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVaultSecret not implemented")
+}
+func (UnimplementedClonrServiceServer) ListVaultSecrets(context.Context, *ListVaultSecretsRequest) (*ListVaultSecretsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVaultSecrets not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteVaultSecret(context.Context, *DeleteVaultSecretRequest) (*DeleteVaultSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVaultSecret not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveGmailWatch(context.Context, *SaveGmailWatchRequest) (*SaveGmailWatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveGmailWatch not implemented")
+}
+func (UnimplementedClonrServiceServer) GetGmailWatch(context.Context, *GetGmailWatchRequest) (*GetGmailWatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGmailWatch not implemented")
+}
+func (UnimplementedClonrServiceServer) ListGmailWatches(context.Context, *ListGmailWatchesRequest) (*ListGmailWatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGmailWatches not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteGmailWatch(context.Context, *DeleteGmailWatchRequest) (*DeleteGmailWatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteGmailWatch not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveGitHubRepoID(context.Context, *SaveGitHubRepoIDRequest) (*SaveGitHubRepoIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveGitHubRepoID not implemented")
+}
+func (UnimplementedClonrServiceServer) GetGitHubRepoID(context.Context, *GetGitHubRepoIDRequest) (*GetGitHubRepoIDResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGitHubRepoID not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveDependencyInventory(context.Context, *SaveDependencyInventoryRequest) (*SaveDependencyInventoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveDependencyInventory not implemented")
+}
+func (UnimplementedClonrServiceServer) ListDependencyInventories(context.Context, *ListDependencyInventoriesRequest) (*ListDependencyInventoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDependencyInventories not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveShareLink(context.Context, *SaveShareLinkRequest) (*SaveShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveShareLink not implemented")
+}
+func (UnimplementedClonrServiceServer) GetShareLink(context.Context, *GetShareLinkRequest) (*GetShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShareLink not implemented")
+}
+func (UnimplementedClonrServiceServer) ConsumeShareLink(context.Context, *ConsumeShareLinkRequest) (*ConsumeShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConsumeShareLink not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveJob(context.Context, *SaveJobRequest) (*SaveJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveJob not implemented")
+}
+func (UnimplementedClonrServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedClonrServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedClonrServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedClonrServiceServer) PairDevice(context.Context, *PairDeviceRequest) (*PairDeviceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PairDevice not implemented")
+}
+func (UnimplementedClonrServiceServer) SaveWorkspace(context.Context, *SaveWorkspaceRequest) (*SaveWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) GetWorkspace(context.Context, *GetWorkspaceRequest) (*GetWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) GetActiveWorkspace(context.Context, *GetActiveWorkspaceRequest) (*GetActiveWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetActiveWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) SetActiveWorkspace(context.Context, *SetActiveWorkspaceRequest) (*SetActiveWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetActiveWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) ListWorkspaces(context.Context, *ListWorkspacesRequest) (*ListWorkspacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkspaces not implemented")
+}
+func (UnimplementedClonrServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) WorkspaceExists(context.Context, *WorkspaceExistsRequest) (*WorkspaceExistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WorkspaceExists not implemented")
+}
+func (UnimplementedClonrServiceServer) GetReposByWorkspace(context.Context, *GetReposByWorkspaceRequest) (*GetReposByWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReposByWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) UpdateRepoWorkspace(context.Context, *UpdateRepoWorkspaceRequest) (*UpdateRepoWorkspaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRepoWorkspace not implemented")
+}
+func (UnimplementedClonrServiceServer) mustEmbedUnimplementedClonrServiceServer() {}
+func (UnimplementedClonrServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeClonrServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClonrServiceServer will
+// result in compilation errors.
+type UnsafeClonrServiceServer interface {
+	mustEmbedUnimplementedClonrServiceServer()
+}
+
+func RegisterClonrServiceServer(s grpc.ServiceRegistrar, srv ClonrServiceServer) {
+	// If the following call panics, it indicates UnimplementedClonrServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClonrService_ServiceDesc, srv)
+}
+
+func _ClonrService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).Ping(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_Shutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).Shutdown(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveRepo(ctx, req.(*SaveRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_RepoExistsByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoExistsByURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).RepoExistsByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_RepoExistsByURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).RepoExistsByURL(ctx, req.(*RepoExistsByURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_RepoExistsByPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoExistsByPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).RepoExistsByPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_RepoExistsByPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).RepoExistsByPath(ctx, req.(*RepoExistsByPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_InsertRepoIfNotExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRepoIfNotExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).InsertRepoIfNotExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_InsertRepoIfNotExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).InsertRepoIfNotExists(ctx, req.(*InsertRepoIfNotExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetAllRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetAllRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetAllRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetAllRepos(ctx, req.(*GetAllReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetRepos(ctx, req.(*GetReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ListRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ListRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ListRepos(ctx, req.(*ListReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetFavoriteByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetFavoriteByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetFavoriteByURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetFavoriteByURL(ctx, req.(*SetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoKind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoKindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoKind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoKind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoKind(ctx, req.(*SetRepoKindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoUpstream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoUpstreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoUpstream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoUpstream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoUpstream(ctx, req.(*SetRepoUpstreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoLicense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoLicense(ctx, req.(*SetRepoLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoTags(ctx, req.(*SetRepoTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoNotes(ctx, req.(*SetRepoNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoAlias(ctx, req.(*SetRepoAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_RecordRepoAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRepoAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).RecordRepoAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_RecordRepoAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).RecordRepoAccess(ctx, req.(*RecordRepoAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetRepoRemotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoRemotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetRepoRemotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetRepoRemotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetRepoRemotes(ctx, req.(*SetRepoRemotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetRepoByRemoteURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoByRemoteURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetRepoByRemoteURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetRepoByRemoteURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetRepoByRemoteURL(ctx, req.(*GetRepoByRemoteURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetRepoDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetRepoDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetRepoDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetRepoDetail(ctx, req.(*GetRepoDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_UpdateRepoTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepoTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).UpdateRepoTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_UpdateRepoTimestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).UpdateRepoTimestamp(ctx, req.(*UpdateRepoTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_RemoveRepoByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRepoByURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).RemoveRepoByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_RemoveRepoByURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).RemoveRepoByURL(ctx, req.(*RemoveRepoByURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_UpdateRepoPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepoPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).UpdateRepoPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_UpdateRepoPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).UpdateRepoPath(ctx, req.(*UpdateRepoPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_UpdateRepoURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepoURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).UpdateRepoURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_UpdateRepoURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).UpdateRepoURL(ctx, req.(*UpdateRepoURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_WatchRepoEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRepoEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClonrServiceServer).WatchRepoEvents(m, &grpc.GenericServerStream[WatchRepoEventsRequest, RepoEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClonrService_WatchRepoEventsServer = grpc.ServerStreamingServer[RepoEvent]
+
+func _ClonrService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveConfig(ctx, req.(*SaveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveProfile(ctx, req.(*SaveProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetActiveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetActiveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetActiveProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetActiveProfile(ctx, req.(*GetActiveProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SetActiveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActiveProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SetActiveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SetActiveProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SetActiveProfile(ctx, req.(*SetActiveProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ListProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ListProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ListProfiles(ctx, req.(*ListProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ProfileExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ProfileExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ProfileExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ProfileExists(ctx, req.(*ProfileExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDockerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveDockerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveDockerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveDockerProfile(ctx, req.(*SaveDockerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDockerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetDockerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetDockerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetDockerProfile(ctx, req.(*GetDockerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ListDockerProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDockerProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ListDockerProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ListDockerProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ListDockerProfiles(ctx, req.(*ListDockerProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_DeleteDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDockerProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DeleteDockerProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DeleteDockerProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DeleteDockerProfile(ctx, req.(*DeleteDockerProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_DockerProfileExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DockerProfileExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DockerProfileExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DockerProfileExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DockerProfileExists(ctx, req.(*DockerProfileExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveFilter(ctx, req.(*SaveFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetFilter(ctx, req.(*GetFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ListFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ListFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ListFilters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ListFilters(ctx, req.(*ListFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_DeleteFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DeleteFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DeleteFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DeleteFilter(ctx, req.(*DeleteFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveRepoSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveRepoSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveRepoSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveRepoSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveRepoSnapshot(ctx, req.(*SaveRepoSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_GetRepoSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetRepoSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetRepoSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetRepoSnapshot(ctx, req.(*GetRepoSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_ListRepoSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepoSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).ListRepoSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_ListRepoSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).ListRepoSnapshots(ctx, req.(*ListRepoSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_DeleteRepoSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DeleteRepoSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DeleteRepoSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DeleteRepoSnapshot(ctx, req.(*DeleteRepoSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClonrService_SaveWizardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWizardDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).SaveWizardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_SaveWizardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).SaveWizardDraft(ctx, req.(*SaveWizardDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedClonrServiceServer) mustEmbedUnimplementedClonrServiceServer() {}
-func (UnimplementedClonrServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeClonrServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ClonrServiceServer will
-// result in compilation errors.
-type UnsafeClonrServiceServer interface {
-	mustEmbedUnimplementedClonrServiceServer()
+func _ClonrService_GetWizardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWizardDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).GetWizardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_GetWizardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).GetWizardDraft(ctx, req.(*GetWizardDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterClonrServiceServer(s grpc.ServiceRegistrar, srv ClonrServiceServer) {
-	// If the following call panics, it indicates UnimplementedClonrServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ClonrService_DeleteWizardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWizardDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ClonrService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ClonrServiceServer).DeleteWizardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClonrService_DeleteWizardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClonrServiceServer).DeleteWizardDraft(ctx, req.(*DeleteWizardDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _ClonrService_SaveAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).Ping(ctx, in)
+		return srv.(ClonrServiceServer).SaveAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_Ping_FullMethodName,
+		FullMethod: ClonrService_SaveAPIToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).Ping(ctx, req.(*Empty))
+		return srv.(ClonrServiceServer).SaveAPIToken(ctx, req.(*SaveAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SaveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveRepoRequest)
+func _ClonrService_GetAPITokenByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPITokenByHashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SaveRepo(ctx, in)
+		return srv.(ClonrServiceServer).GetAPITokenByHash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SaveRepo_FullMethodName,
+		FullMethod: ClonrService_GetAPITokenByHash_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SaveRepo(ctx, req.(*SaveRepoRequest))
+		return srv.(ClonrServiceServer).GetAPITokenByHash(ctx, req.(*GetAPITokenByHashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_RepoExistsByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoExistsByURLRequest)
+func _ClonrService_ListAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPITokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).RepoExistsByURL(ctx, in)
+		return srv.(ClonrServiceServer).ListAPITokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_RepoExistsByURL_FullMethodName,
+		FullMethod: ClonrService_ListAPITokens_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).RepoExistsByURL(ctx, req.(*RepoExistsByURLRequest))
+		return srv.(ClonrServiceServer).ListAPITokens(ctx, req.(*ListAPITokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_RepoExistsByPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepoExistsByPathRequest)
+func _ClonrService_DeleteAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPITokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).RepoExistsByPath(ctx, in)
+		return srv.(ClonrServiceServer).DeleteAPIToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_RepoExistsByPath_FullMethodName,
+		FullMethod: ClonrService_DeleteAPIToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).RepoExistsByPath(ctx, req.(*RepoExistsByPathRequest))
+		return srv.(ClonrServiceServer).DeleteAPIToken(ctx, req.(*DeleteAPITokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_InsertRepoIfNotExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InsertRepoIfNotExistsRequest)
+func _ClonrService_SaveVaultSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveVaultSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).InsertRepoIfNotExists(ctx, in)
+		return srv.(ClonrServiceServer).SaveVaultSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_InsertRepoIfNotExists_FullMethodName,
+		FullMethod: ClonrService_SaveVaultSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).InsertRepoIfNotExists(ctx, req.(*InsertRepoIfNotExistsRequest))
+		return srv.(ClonrServiceServer).SaveVaultSecret(ctx, req.(*SaveVaultSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetAllRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllReposRequest)
+func _ClonrService_GetVaultSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetAllRepos(ctx, in)
+		return srv.(ClonrServiceServer).GetVaultSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetAllRepos_FullMethodName,
+		FullMethod: ClonrService_GetVaultSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetAllRepos(ctx, req.(*GetAllReposRequest))
+		return srv.(ClonrServiceServer).GetVaultSecret(ctx, req.(*GetVaultSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReposRequest)
+func _ClonrService_ListVaultSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultSecretsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetRepos(ctx, in)
+		return srv.(ClonrServiceServer).ListVaultSecrets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetRepos_FullMethodName,
+		FullMethod: ClonrService_ListVaultSecrets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetRepos(ctx, req.(*GetReposRequest))
+		return srv.(ClonrServiceServer).ListVaultSecrets(ctx, req.(*ListVaultSecretsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SetFavoriteByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetFavoriteRequest)
+func _ClonrService_DeleteVaultSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVaultSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SetFavoriteByURL(ctx, in)
+		return srv.(ClonrServiceServer).DeleteVaultSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SetFavoriteByURL_FullMethodName,
+		FullMethod: ClonrService_DeleteVaultSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SetFavoriteByURL(ctx, req.(*SetFavoriteRequest))
+		return srv.(ClonrServiceServer).DeleteVaultSecret(ctx, req.(*DeleteVaultSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_UpdateRepoTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRepoTimestampRequest)
+func _ClonrService_SaveGmailWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveGmailWatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).UpdateRepoTimestamp(ctx, in)
+		return srv.(ClonrServiceServer).SaveGmailWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_UpdateRepoTimestamp_FullMethodName,
+		FullMethod: ClonrService_SaveGmailWatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).UpdateRepoTimestamp(ctx, req.(*UpdateRepoTimestampRequest))
+		return srv.(ClonrServiceServer).SaveGmailWatch(ctx, req.(*SaveGmailWatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_RemoveRepoByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRepoByURLRequest)
+func _ClonrService_GetGmailWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGmailWatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).RemoveRepoByURL(ctx, in)
+		return srv.(ClonrServiceServer).GetGmailWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_RemoveRepoByURL_FullMethodName,
+		FullMethod: ClonrService_GetGmailWatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).RemoveRepoByURL(ctx, req.(*RemoveRepoByURLRequest))
+		return srv.(ClonrServiceServer).GetGmailWatch(ctx, req.(*GetGmailWatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _ClonrService_ListGmailWatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGmailWatchesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetConfig(ctx, in)
+		return srv.(ClonrServiceServer).ListGmailWatches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetConfig_FullMethodName,
+		FullMethod: ClonrService_ListGmailWatches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(ClonrServiceServer).ListGmailWatches(ctx, req.(*ListGmailWatchesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SaveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveConfigRequest)
+func _ClonrService_DeleteGmailWatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGmailWatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SaveConfig(ctx, in)
+		return srv.(ClonrServiceServer).DeleteGmailWatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SaveConfig_FullMethodName,
+		FullMethod: ClonrService_DeleteGmailWatch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SaveConfig(ctx, req.(*SaveConfigRequest))
+		return srv.(ClonrServiceServer).DeleteGmailWatch(ctx, req.(*DeleteGmailWatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveProfileRequest)
+func _ClonrService_SaveGitHubRepoID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveGitHubRepoIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SaveProfile(ctx, in)
+		return srv.(ClonrServiceServer).SaveGitHubRepoID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SaveProfile_FullMethodName,
+		FullMethod: ClonrService_SaveGitHubRepoID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SaveProfile(ctx, req.(*SaveProfileRequest))
+		return srv.(ClonrServiceServer).SaveGitHubRepoID(ctx, req.(*SaveGitHubRepoIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProfileRequest)
+func _ClonrService_GetGitHubRepoID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitHubRepoIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetProfile(ctx, in)
+		return srv.(ClonrServiceServer).GetGitHubRepoID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetProfile_FullMethodName,
+		FullMethod: ClonrService_GetGitHubRepoID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+		return srv.(ClonrServiceServer).GetGitHubRepoID(ctx, req.(*GetGitHubRepoIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetActiveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetActiveProfileRequest)
+func _ClonrService_SaveDependencyInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDependencyInventoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetActiveProfile(ctx, in)
+		return srv.(ClonrServiceServer).SaveDependencyInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetActiveProfile_FullMethodName,
+		FullMethod: ClonrService_SaveDependencyInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetActiveProfile(ctx, req.(*GetActiveProfileRequest))
+		return srv.(ClonrServiceServer).SaveDependencyInventory(ctx, req.(*SaveDependencyInventoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SetActiveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetActiveProfileRequest)
+func _ClonrService_ListDependencyInventories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDependencyInventoriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SetActiveProfile(ctx, in)
+		return srv.(ClonrServiceServer).ListDependencyInventories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SetActiveProfile_FullMethodName,
+		FullMethod: ClonrService_ListDependencyInventories_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SetActiveProfile(ctx, req.(*SetActiveProfileRequest))
+		return srv.(ClonrServiceServer).ListDependencyInventories(ctx, req.(*ListDependencyInventoriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProfilesRequest)
+func _ClonrService_SaveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).ListProfiles(ctx, in)
+		return srv.(ClonrServiceServer).SaveShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_ListProfiles_FullMethodName,
+		FullMethod: ClonrService_SaveShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).ListProfiles(ctx, req.(*ListProfilesRequest))
+		return srv.(ClonrServiceServer).SaveShareLink(ctx, req.(*SaveShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProfileRequest)
+func _ClonrService_GetShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).DeleteProfile(ctx, in)
+		return srv.(ClonrServiceServer).GetShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_DeleteProfile_FullMethodName,
+		FullMethod: ClonrService_GetShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+		return srv.(ClonrServiceServer).GetShareLink(ctx, req.(*GetShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_ProfileExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileExistsRequest)
+func _ClonrService_ConsumeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).ProfileExists(ctx, in)
+		return srv.(ClonrServiceServer).ConsumeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_ProfileExists_FullMethodName,
+		FullMethod: ClonrService_ConsumeShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).ProfileExists(ctx, req.(*ProfileExistsRequest))
+		return srv.(ClonrServiceServer).ConsumeShareLink(ctx, req.(*ConsumeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_SaveDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveDockerProfileRequest)
+func _ClonrService_SaveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).SaveDockerProfile(ctx, in)
+		return srv.(ClonrServiceServer).SaveJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_SaveDockerProfile_FullMethodName,
+		FullMethod: ClonrService_SaveJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).SaveDockerProfile(ctx, req.(*SaveDockerProfileRequest))
+		return srv.(ClonrServiceServer).SaveJob(ctx, req.(*SaveJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_GetDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDockerProfileRequest)
+func _ClonrService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).GetDockerProfile(ctx, in)
+		return srv.(ClonrServiceServer).GetJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_GetDockerProfile_FullMethodName,
+		FullMethod: ClonrService_GetJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).GetDockerProfile(ctx, req.(*GetDockerProfileRequest))
+		return srv.(ClonrServiceServer).GetJob(ctx, req.(*GetJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_ListDockerProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDockerProfilesRequest)
+func _ClonrService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).ListDockerProfiles(ctx, in)
+		return srv.(ClonrServiceServer).ListJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_ListDockerProfiles_FullMethodName,
+		FullMethod: ClonrService_ListJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).ListDockerProfiles(ctx, req.(*ListDockerProfilesRequest))
+		return srv.(ClonrServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_DeleteDockerProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDockerProfileRequest)
+func _ClonrService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).DeleteDockerProfile(ctx, in)
+		return srv.(ClonrServiceServer).CancelJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_DeleteDockerProfile_FullMethodName,
+		FullMethod: ClonrService_CancelJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).DeleteDockerProfile(ctx, req.(*DeleteDockerProfileRequest))
+		return srv.(ClonrServiceServer).CancelJob(ctx, req.(*CancelJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClonrService_DockerProfileExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DockerProfileExistsRequest)
+func _ClonrService_PairDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PairDeviceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClonrServiceServer).DockerProfileExists(ctx, in)
+		return srv.(ClonrServiceServer).PairDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClonrService_DockerProfileExists_FullMethodName,
+		FullMethod: ClonrService_PairDevice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClonrServiceServer).DockerProfileExists(ctx, req.(*DockerProfileExistsRequest))
+		return srv.(ClonrServiceServer).PairDevice(ctx, req.(*PairDeviceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1219,6 +2945,10 @@ var ClonrService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _ClonrService_Ping_Handler,
 		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _ClonrService_Shutdown_Handler,
+		},
 		{
 			MethodName: "SaveRepo",
 			Handler:    _ClonrService_SaveRepo_Handler,
@@ -1243,10 +2973,54 @@ var ClonrService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRepos",
 			Handler:    _ClonrService_GetRepos_Handler,
 		},
+		{
+			MethodName: "ListRepos",
+			Handler:    _ClonrService_ListRepos_Handler,
+		},
 		{
 			MethodName: "SetFavoriteByURL",
 			Handler:    _ClonrService_SetFavoriteByURL_Handler,
 		},
+		{
+			MethodName: "SetRepoKind",
+			Handler:    _ClonrService_SetRepoKind_Handler,
+		},
+		{
+			MethodName: "SetRepoUpstream",
+			Handler:    _ClonrService_SetRepoUpstream_Handler,
+		},
+		{
+			MethodName: "SetRepoLicense",
+			Handler:    _ClonrService_SetRepoLicense_Handler,
+		},
+		{
+			MethodName: "SetRepoTags",
+			Handler:    _ClonrService_SetRepoTags_Handler,
+		},
+		{
+			MethodName: "SetRepoNotes",
+			Handler:    _ClonrService_SetRepoNotes_Handler,
+		},
+		{
+			MethodName: "SetRepoAlias",
+			Handler:    _ClonrService_SetRepoAlias_Handler,
+		},
+		{
+			MethodName: "RecordRepoAccess",
+			Handler:    _ClonrService_RecordRepoAccess_Handler,
+		},
+		{
+			MethodName: "SetRepoRemotes",
+			Handler:    _ClonrService_SetRepoRemotes_Handler,
+		},
+		{
+			MethodName: "GetRepoByRemoteURL",
+			Handler:    _ClonrService_GetRepoByRemoteURL_Handler,
+		},
+		{
+			MethodName: "GetRepoDetail",
+			Handler:    _ClonrService_GetRepoDetail_Handler,
+		},
 		{
 			MethodName: "UpdateRepoTimestamp",
 			Handler:    _ClonrService_UpdateRepoTimestamp_Handler,
@@ -1255,6 +3029,14 @@ var ClonrService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRepoByURL",
 			Handler:    _ClonrService_RemoveRepoByURL_Handler,
 		},
+		{
+			MethodName: "UpdateRepoPath",
+			Handler:    _ClonrService_UpdateRepoPath_Handler,
+		},
+		{
+			MethodName: "UpdateRepoURL",
+			Handler:    _ClonrService_UpdateRepoURL_Handler,
+		},
 		{
 			MethodName: "GetConfig",
 			Handler:    _ClonrService_GetConfig_Handler,
@@ -1311,6 +3093,146 @@ var ClonrService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DockerProfileExists",
 			Handler:    _ClonrService_DockerProfileExists_Handler,
 		},
+		{
+			MethodName: "SaveFilter",
+			Handler:    _ClonrService_SaveFilter_Handler,
+		},
+		{
+			MethodName: "GetFilter",
+			Handler:    _ClonrService_GetFilter_Handler,
+		},
+		{
+			MethodName: "ListFilters",
+			Handler:    _ClonrService_ListFilters_Handler,
+		},
+		{
+			MethodName: "DeleteFilter",
+			Handler:    _ClonrService_DeleteFilter_Handler,
+		},
+		{
+			MethodName: "SaveRepoSnapshot",
+			Handler:    _ClonrService_SaveRepoSnapshot_Handler,
+		},
+		{
+			MethodName: "GetRepoSnapshot",
+			Handler:    _ClonrService_GetRepoSnapshot_Handler,
+		},
+		{
+			MethodName: "ListRepoSnapshots",
+			Handler:    _ClonrService_ListRepoSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteRepoSnapshot",
+			Handler:    _ClonrService_DeleteRepoSnapshot_Handler,
+		},
+		{
+			MethodName: "SaveWizardDraft",
+			Handler:    _ClonrService_SaveWizardDraft_Handler,
+		},
+		{
+			MethodName: "GetWizardDraft",
+			Handler:    _ClonrService_GetWizardDraft_Handler,
+		},
+		{
+			MethodName: "DeleteWizardDraft",
+			Handler:    _ClonrService_DeleteWizardDraft_Handler,
+		},
+		{
+			MethodName: "SaveAPIToken",
+			Handler:    _ClonrService_SaveAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAPITokenByHash",
+			Handler:    _ClonrService_GetAPITokenByHash_Handler,
+		},
+		{
+			MethodName: "ListAPITokens",
+			Handler:    _ClonrService_ListAPITokens_Handler,
+		},
+		{
+			MethodName: "DeleteAPIToken",
+			Handler:    _ClonrService_DeleteAPIToken_Handler,
+		},
+		{
+			MethodName: "SaveVaultSecret",
+			Handler:    _ClonrService_SaveVaultSecret_Handler,
+		},
+		{
+			MethodName: "GetVaultSecret",
+			Handler:    _ClonrService_GetVaultSecret_Handler,
+		},
+		{
+			MethodName: "ListVaultSecrets",
+			Handler:    _ClonrService_ListVaultSecrets_Handler,
+		},
+		{
+			MethodName: "DeleteVaultSecret",
+			Handler:    _ClonrService_DeleteVaultSecret_Handler,
+		},
+		{
+			MethodName: "SaveGmailWatch",
+			Handler:    _ClonrService_SaveGmailWatch_Handler,
+		},
+		{
+			MethodName: "GetGmailWatch",
+			Handler:    _ClonrService_GetGmailWatch_Handler,
+		},
+		{
+			MethodName: "ListGmailWatches",
+			Handler:    _ClonrService_ListGmailWatches_Handler,
+		},
+		{
+			MethodName: "DeleteGmailWatch",
+			Handler:    _ClonrService_DeleteGmailWatch_Handler,
+		},
+		{
+			MethodName: "SaveGitHubRepoID",
+			Handler:    _ClonrService_SaveGitHubRepoID_Handler,
+		},
+		{
+			MethodName: "GetGitHubRepoID",
+			Handler:    _ClonrService_GetGitHubRepoID_Handler,
+		},
+		{
+			MethodName: "SaveDependencyInventory",
+			Handler:    _ClonrService_SaveDependencyInventory_Handler,
+		},
+		{
+			MethodName: "ListDependencyInventories",
+			Handler:    _ClonrService_ListDependencyInventories_Handler,
+		},
+		{
+			MethodName: "SaveShareLink",
+			Handler:    _ClonrService_SaveShareLink_Handler,
+		},
+		{
+			MethodName: "GetShareLink",
+			Handler:    _ClonrService_GetShareLink_Handler,
+		},
+		{
+			MethodName: "ConsumeShareLink",
+			Handler:    _ClonrService_ConsumeShareLink_Handler,
+		},
+		{
+			MethodName: "SaveJob",
+			Handler:    _ClonrService_SaveJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _ClonrService_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _ClonrService_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _ClonrService_CancelJob_Handler,
+		},
+		{
+			MethodName: "PairDevice",
+			Handler:    _ClonrService_PairDevice_Handler,
+		},
 		{
 			MethodName: "SaveWorkspace",
 			Handler:    _ClonrService_SaveWorkspace_Handler,
@@ -1348,6 +3270,12 @@ var ClonrService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ClonrService_UpdateRepoWorkspace_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchRepoEvents",
+			Handler:       _ClonrService_WatchRepoEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "v1/clonr.proto",
 }
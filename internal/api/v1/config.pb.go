@@ -29,6 +29,17 @@ type Config struct {
 	Terminal        string                 `protobuf:"bytes,3,opt,name=terminal,proto3" json:"terminal,omitempty"`
 	MonitorInterval int32                  `protobuf:"varint,4,opt,name=monitor_interval,json=monitorInterval,proto3" json:"monitor_interval,omitempty"`
 	ServerPort      int32                  `protobuf:"varint,5,opt,name=server_port,json=serverPort,proto3" json:"server_port,omitempty"`
+	ListColumns     []string               `protobuf:"bytes,6,rep,name=list_columns,json=listColumns,proto3" json:"list_columns,omitempty"`
+	ListSort        string                 `protobuf:"bytes,7,opt,name=list_sort,json=listSort,proto3" json:"list_sort,omitempty"`
+	UrlRewrites     []*URLRewrite          `protobuf:"bytes,8,rep,name=url_rewrites,json=urlRewrites,proto3" json:"url_rewrites,omitempty"`     // Clone argument prefix rewrites (git insteadOf)
+	Backup          *BackupConfig          `protobuf:"bytes,9,opt,name=backup,proto3" json:"backup,omitempty"`                                  // Backup destination and retention
+	Webhooks        []string               `protobuf:"bytes,10,rep,name=webhooks,proto3" json:"webhooks,omitempty"`                             // Repository URLs pulled on push webhooks
+	NotifyRoutes    []*NotifyRoute         `protobuf:"bytes,11,rep,name=notify_routes,json=notifyRoutes,proto3" json:"notify_routes,omitempty"` // Notification channels per event type
+	Concurrency     *ConcurrencyConfig     `protobuf:"bytes,12,opt,name=concurrency,proto3" json:"concurrency,omitempty"`                       // Parallel job limits
+	ServerTls       *ServerTLSConfig       `protobuf:"bytes,13,opt,name=server_tls,json=serverTls,proto3" json:"server_tls,omitempty"`          // Default gRPC server certificate
+	Keymap          *KeyMapConfig          `protobuf:"bytes,14,opt,name=keymap,proto3" json:"keymap,omitempty"`                                 // Remapped keys of the interactive views
+	Ignore          *IgnoreConfig          `protobuf:"bytes,15,opt,name=ignore,proto3" json:"ignore,omitempty"`                                 // Checkouts skipped by map, monitors and org mirrors
+	PinnedRepos     []string               `protobuf:"bytes,16,rep,name=pinned_repos,json=pinnedRepos,proto3" json:"pinned_repos,omitempty"`    // Repository URLs pinned to the main menu
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -98,6 +109,494 @@ func (x *Config) GetServerPort() int32 {
 	return 0
 }
 
+func (x *Config) GetListColumns() []string {
+	if x != nil {
+		return x.ListColumns
+	}
+	return nil
+}
+
+func (x *Config) GetListSort() string {
+	if x != nil {
+		return x.ListSort
+	}
+	return ""
+}
+
+func (x *Config) GetUrlRewrites() []*URLRewrite {
+	if x != nil {
+		return x.UrlRewrites
+	}
+	return nil
+}
+
+func (x *Config) GetBackup() *BackupConfig {
+	if x != nil {
+		return x.Backup
+	}
+	return nil
+}
+
+func (x *Config) GetWebhooks() []string {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *Config) GetNotifyRoutes() []*NotifyRoute {
+	if x != nil {
+		return x.NotifyRoutes
+	}
+	return nil
+}
+
+func (x *Config) GetConcurrency() *ConcurrencyConfig {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *Config) GetServerTls() *ServerTLSConfig {
+	if x != nil {
+		return x.ServerTls
+	}
+	return nil
+}
+
+func (x *Config) GetKeymap() *KeyMapConfig {
+	if x != nil {
+		return x.Keymap
+	}
+	return nil
+}
+
+func (x *Config) GetIgnore() *IgnoreConfig {
+	if x != nil {
+		return x.Ignore
+	}
+	return nil
+}
+
+func (x *Config) GetPinnedRepos() []string {
+	if x != nil {
+		return x.PinnedRepos
+	}
+	return nil
+}
+
+// NotifyRoute sends the events of one type to the listed notification channels
+type NotifyRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`       // Event type, or "*" for every type
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // Channel IDs or types; empty mutes the event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyRoute) Reset() {
+	*x = NotifyRoute{}
+	mi := &file_v1_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRoute) ProtoMessage() {}
+
+func (x *NotifyRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRoute.ProtoReflect.Descriptor instead.
+func (*NotifyRoute) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotifyRoute) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *NotifyRoute) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// URLRewrite replaces the instead_of prefix of a repository URL with base
+type URLRewrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	InsteadOf     string                 `protobuf:"bytes,2,opt,name=instead_of,json=insteadOf,proto3" json:"instead_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *URLRewrite) Reset() {
+	*x = URLRewrite{}
+	mi := &file_v1_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *URLRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*URLRewrite) ProtoMessage() {}
+
+func (x *URLRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use URLRewrite.ProtoReflect.Descriptor instead.
+func (*URLRewrite) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *URLRewrite) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *URLRewrite) GetInsteadOf() string {
+	if x != nil {
+		return x.InsteadOf
+	}
+	return ""
+}
+
+// BackupConfig is the destination and retention policy of repository backups
+type BackupConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dest          string                 `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`                          // Local directory or s3://bucket/prefix
+	KeepLast      int32                  `protobuf:"varint,2,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"` // Newest backups kept per repository (0 = no limit)
+	KeepDays      int32                  `protobuf:"varint,3,opt,name=keep_days,json=keepDays,proto3" json:"keep_days,omitempty"` // Backups younger than this are kept (0 = no limit)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupConfig) Reset() {
+	*x = BackupConfig{}
+	mi := &file_v1_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupConfig) ProtoMessage() {}
+
+func (x *BackupConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupConfig.ProtoReflect.Descriptor instead.
+func (*BackupConfig) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BackupConfig) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *BackupConfig) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *BackupConfig) GetKeepDays() int32 {
+	if x != nil {
+		return x.KeepDays
+	}
+	return 0
+}
+
+// ConcurrencyConfig limits the jobs of each kind running at once (0 = default)
+type ConcurrencyConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GitOps        int32                  `protobuf:"varint,1,opt,name=git_ops,json=gitOps,proto3" json:"git_ops,omitempty"`       // git clones, pulls and fetches
+	ApiCalls      int32                  `protobuf:"varint,2,opt,name=api_calls,json=apiCalls,proto3" json:"api_calls,omitempty"` // Requests to hosting provider APIs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConcurrencyConfig) Reset() {
+	*x = ConcurrencyConfig{}
+	mi := &file_v1_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConcurrencyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyConfig) ProtoMessage() {}
+
+func (x *ConcurrencyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyConfig.ProtoReflect.Descriptor instead.
+func (*ConcurrencyConfig) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConcurrencyConfig) GetGitOps() int32 {
+	if x != nil {
+		return x.GitOps
+	}
+	return 0
+}
+
+func (x *ConcurrencyConfig) GetApiCalls() int32 {
+	if x != nil {
+		return x.ApiCalls
+	}
+	return 0
+}
+
+// ServerTLSConfig is the certificate of the gRPC server
+type ServerTLSConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CertFile      string                 `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile       string                 `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	ClientCaFile  string                 `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"` // Verify client certificates against this CA (mTLS)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerTLSConfig) Reset() {
+	*x = ServerTLSConfig{}
+	mi := &file_v1_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerTLSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerTLSConfig) ProtoMessage() {}
+
+func (x *ServerTLSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerTLSConfig.ProtoReflect.Descriptor instead.
+func (*ServerTLSConfig) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ServerTLSConfig) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *ServerTLSConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *ServerTLSConfig) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+// KeyMapConfig lists the keys of each remappable TUI action; empty keeps the defaults
+type KeyMapConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Select        []string               `protobuf:"bytes,1,rep,name=select,proto3" json:"select,omitempty"`
+	Favorite      []string               `protobuf:"bytes,2,rep,name=favorite,proto3" json:"favorite,omitempty"`
+	Delete        []string               `protobuf:"bytes,3,rep,name=delete,proto3" json:"delete,omitempty"`
+	Open          []string               `protobuf:"bytes,4,rep,name=open,proto3" json:"open,omitempty"`
+	Filter        []string               `protobuf:"bytes,5,rep,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyMapConfig) Reset() {
+	*x = KeyMapConfig{}
+	mi := &file_v1_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyMapConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyMapConfig) ProtoMessage() {}
+
+func (x *KeyMapConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyMapConfig.ProtoReflect.Descriptor instead.
+func (*KeyMapConfig) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KeyMapConfig) GetSelect() []string {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *KeyMapConfig) GetFavorite() []string {
+	if x != nil {
+		return x.Favorite
+	}
+	return nil
+}
+
+func (x *KeyMapConfig) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *KeyMapConfig) GetOpen() []string {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *KeyMapConfig) GetFilter() []string {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// IgnoreConfig lists glob patterns of ignored clone paths and repository URLs
+type IgnoreConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []string               `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	Urls          []string               `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IgnoreConfig) Reset() {
+	*x = IgnoreConfig{}
+	mi := &file_v1_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IgnoreConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IgnoreConfig) ProtoMessage() {}
+
+func (x *IgnoreConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IgnoreConfig.ProtoReflect.Descriptor instead.
+func (*IgnoreConfig) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IgnoreConfig) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *IgnoreConfig) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
 // GetConfig RPC messages
 type GetConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -107,7 +606,7 @@ type GetConfigRequest struct {
 
 func (x *GetConfigRequest) Reset() {
 	*x = GetConfigRequest{}
-	mi := &file_v1_config_proto_msgTypes[1]
+	mi := &file_v1_config_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -119,7 +618,7 @@ func (x *GetConfigRequest) String() string {
 func (*GetConfigRequest) ProtoMessage() {}
 
 func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[1]
+	mi := &file_v1_config_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -132,7 +631,7 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{1}
+	return file_v1_config_proto_rawDescGZIP(), []int{8}
 }
 
 type GetConfigResponse struct {
@@ -144,7 +643,7 @@ type GetConfigResponse struct {
 
 func (x *GetConfigResponse) Reset() {
 	*x = GetConfigResponse{}
-	mi := &file_v1_config_proto_msgTypes[2]
+	mi := &file_v1_config_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +655,7 @@ func (x *GetConfigResponse) String() string {
 func (*GetConfigResponse) ProtoMessage() {}
 
 func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[2]
+	mi := &file_v1_config_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +668,7 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{2}
+	return file_v1_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetConfigResponse) GetConfig() *Config {
@@ -189,7 +688,7 @@ type SaveConfigRequest struct {
 
 func (x *SaveConfigRequest) Reset() {
 	*x = SaveConfigRequest{}
-	mi := &file_v1_config_proto_msgTypes[3]
+	mi := &file_v1_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +700,7 @@ func (x *SaveConfigRequest) String() string {
 func (*SaveConfigRequest) ProtoMessage() {}
 
 func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[3]
+	mi := &file_v1_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +713,7 @@ func (x *SaveConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveConfigRequest) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{3}
+	return file_v1_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SaveConfigRequest) GetConfig() *Config {
@@ -233,7 +732,7 @@ type SaveConfigResponse struct {
 
 func (x *SaveConfigResponse) Reset() {
 	*x = SaveConfigResponse{}
-	mi := &file_v1_config_proto_msgTypes[4]
+	mi := &file_v1_config_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +744,7 @@ func (x *SaveConfigResponse) String() string {
 func (*SaveConfigResponse) ProtoMessage() {}
 
 func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[4]
+	mi := &file_v1_config_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +757,7 @@ func (x *SaveConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveConfigResponse.ProtoReflect.Descriptor instead.
 func (*SaveConfigResponse) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{4}
+	return file_v1_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SaveConfigResponse) GetSuccess() bool {
@@ -272,14 +771,55 @@ var File_v1_config_proto protoreflect.FileDescriptor
 
 const file_v1_config_proto_rawDesc = "" +
 	"\n" +
-	"\x0fv1/config.proto\x12\bclonr.v1\"\xb4\x01\n" +
+	"\x0fv1/config.proto\x12\bclonr.v1\"\xb1\x05\n" +
 	"\x06Config\x12*\n" +
 	"\x11default_clone_dir\x18\x01 \x01(\tR\x0fdefaultCloneDir\x12\x16\n" +
 	"\x06editor\x18\x02 \x01(\tR\x06editor\x12\x1a\n" +
 	"\bterminal\x18\x03 \x01(\tR\bterminal\x12)\n" +
 	"\x10monitor_interval\x18\x04 \x01(\x05R\x0fmonitorInterval\x12\x1f\n" +
 	"\vserver_port\x18\x05 \x01(\x05R\n" +
-	"serverPort\"\x12\n" +
+	"serverPort\x12!\n" +
+	"\flist_columns\x18\x06 \x03(\tR\vlistColumns\x12\x1b\n" +
+	"\tlist_sort\x18\a \x01(\tR\blistSort\x127\n" +
+	"\furl_rewrites\x18\b \x03(\v2\x14.clonr.v1.URLRewriteR\vurlRewrites\x12.\n" +
+	"\x06backup\x18\t \x01(\v2\x16.clonr.v1.BackupConfigR\x06backup\x12\x1a\n" +
+	"\bwebhooks\x18\n" +
+	" \x03(\tR\bwebhooks\x12:\n" +
+	"\rnotify_routes\x18\v \x03(\v2\x15.clonr.v1.NotifyRouteR\fnotifyRoutes\x12=\n" +
+	"\vconcurrency\x18\f \x01(\v2\x1b.clonr.v1.ConcurrencyConfigR\vconcurrency\x128\n" +
+	"\n" +
+	"server_tls\x18\r \x01(\v2\x19.clonr.v1.ServerTLSConfigR\tserverTls\x12.\n" +
+	"\x06keymap\x18\x0e \x01(\v2\x16.clonr.v1.KeyMapConfigR\x06keymap\x12.\n" +
+	"\x06ignore\x18\x0f \x01(\v2\x16.clonr.v1.IgnoreConfigR\x06ignore\x12!\n" +
+	"\fpinned_repos\x18\x10 \x03(\tR\vpinnedRepos\"?\n" +
+	"\vNotifyRoute\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\"?\n" +
+	"\n" +
+	"URLRewrite\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x1d\n" +
+	"\n" +
+	"instead_of\x18\x02 \x01(\tR\tinsteadOf\"\\\n" +
+	"\fBackupConfig\x12\x12\n" +
+	"\x04dest\x18\x01 \x01(\tR\x04dest\x12\x1b\n" +
+	"\tkeep_last\x18\x02 \x01(\x05R\bkeepLast\x12\x1b\n" +
+	"\tkeep_days\x18\x03 \x01(\x05R\bkeepDays\"I\n" +
+	"\x11ConcurrencyConfig\x12\x17\n" +
+	"\agit_ops\x18\x01 \x01(\x05R\x06gitOps\x12\x1b\n" +
+	"\tapi_calls\x18\x02 \x01(\x05R\bapiCalls\"o\n" +
+	"\x0fServerTLSConfig\x12\x1b\n" +
+	"\tcert_file\x18\x01 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x02 \x01(\tR\akeyFile\x12$\n" +
+	"\x0eclient_ca_file\x18\x03 \x01(\tR\fclientCaFile\"\x86\x01\n" +
+	"\fKeyMapConfig\x12\x16\n" +
+	"\x06select\x18\x01 \x03(\tR\x06select\x12\x1a\n" +
+	"\bfavorite\x18\x02 \x03(\tR\bfavorite\x12\x16\n" +
+	"\x06delete\x18\x03 \x03(\tR\x06delete\x12\x12\n" +
+	"\x04open\x18\x04 \x03(\tR\x04open\x12\x16\n" +
+	"\x06filter\x18\x05 \x03(\tR\x06filter\"8\n" +
+	"\fIgnoreConfig\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\x12\x12\n" +
+	"\x04urls\x18\x02 \x03(\tR\x04urls\"\x12\n" +
 	"\x10GetConfigRequest\"=\n" +
 	"\x11GetConfigResponse\x12(\n" +
 	"\x06config\x18\x01 \x01(\v2\x10.clonr.v1.ConfigR\x06config\"=\n" +
@@ -301,22 +841,36 @@ func file_v1_config_proto_rawDescGZIP() []byte {
 	return file_v1_config_proto_rawDescData
 }
 
-var file_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_v1_config_proto_goTypes = []any{
 	(*Config)(nil),             // 0: clonr.v1.Config
-	(*GetConfigRequest)(nil),   // 1: clonr.v1.GetConfigRequest
-	(*GetConfigResponse)(nil),  // 2: clonr.v1.GetConfigResponse
-	(*SaveConfigRequest)(nil),  // 3: clonr.v1.SaveConfigRequest
-	(*SaveConfigResponse)(nil), // 4: clonr.v1.SaveConfigResponse
+	(*NotifyRoute)(nil),        // 1: clonr.v1.NotifyRoute
+	(*URLRewrite)(nil),         // 2: clonr.v1.URLRewrite
+	(*BackupConfig)(nil),       // 3: clonr.v1.BackupConfig
+	(*ConcurrencyConfig)(nil),  // 4: clonr.v1.ConcurrencyConfig
+	(*ServerTLSConfig)(nil),    // 5: clonr.v1.ServerTLSConfig
+	(*KeyMapConfig)(nil),       // 6: clonr.v1.KeyMapConfig
+	(*IgnoreConfig)(nil),       // 7: clonr.v1.IgnoreConfig
+	(*GetConfigRequest)(nil),   // 8: clonr.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),  // 9: clonr.v1.GetConfigResponse
+	(*SaveConfigRequest)(nil),  // 10: clonr.v1.SaveConfigRequest
+	(*SaveConfigResponse)(nil), // 11: clonr.v1.SaveConfigResponse
 }
 var file_v1_config_proto_depIdxs = []int32{
-	0, // 0: clonr.v1.GetConfigResponse.config:type_name -> clonr.v1.Config
-	0, // 1: clonr.v1.SaveConfigRequest.config:type_name -> clonr.v1.Config
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: clonr.v1.Config.url_rewrites:type_name -> clonr.v1.URLRewrite
+	3, // 1: clonr.v1.Config.backup:type_name -> clonr.v1.BackupConfig
+	1, // 2: clonr.v1.Config.notify_routes:type_name -> clonr.v1.NotifyRoute
+	4, // 3: clonr.v1.Config.concurrency:type_name -> clonr.v1.ConcurrencyConfig
+	5, // 4: clonr.v1.Config.server_tls:type_name -> clonr.v1.ServerTLSConfig
+	6, // 5: clonr.v1.Config.keymap:type_name -> clonr.v1.KeyMapConfig
+	7, // 6: clonr.v1.Config.ignore:type_name -> clonr.v1.IgnoreConfig
+	0, // 7: clonr.v1.GetConfigResponse.config:type_name -> clonr.v1.Config
+	0, // 8: clonr.v1.SaveConfigRequest.config:type_name -> clonr.v1.Config
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_v1_config_proto_init() }
@@ -330,7 +884,7 @@ func file_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_config_proto_rawDesc), len(file_v1_config_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
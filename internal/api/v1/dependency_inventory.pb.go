@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/dependency_inventory.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Dependency is one dependency declared in a repository manifest
+type Dependency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ecosystem     string                 `protobuf:"bytes,1,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"` // e.g. go, npm, pypi, cargo
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`   // Declared version or constraint, empty when unpinned
+	Manifest      string                 `protobuf:"bytes,4,opt,name=manifest,proto3" json:"manifest,omitempty"` // Manifest path relative to the repository
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dependency) Reset() {
+	*x = Dependency{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dependency) ProtoMessage() {}
+
+func (x *Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dependency.ProtoReflect.Descriptor instead.
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Dependency) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *Dependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Dependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Dependency) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+// DependencyInventory is the dependencies of a repository at one point in time
+type DependencyInventory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Dependencies  []*Dependency          `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	ScannedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyInventory) Reset() {
+	*x = DependencyInventory{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyInventory) ProtoMessage() {}
+
+func (x *DependencyInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyInventory.ProtoReflect.Descriptor instead.
+func (*DependencyInventory) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DependencyInventory) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *DependencyInventory) GetDependencies() []*Dependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *DependencyInventory) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+// SaveDependencyInventory RPC messages
+type SaveDependencyInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventory     *DependencyInventory   `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveDependencyInventoryRequest) Reset() {
+	*x = SaveDependencyInventoryRequest{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveDependencyInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDependencyInventoryRequest) ProtoMessage() {}
+
+func (x *SaveDependencyInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDependencyInventoryRequest.ProtoReflect.Descriptor instead.
+func (*SaveDependencyInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveDependencyInventoryRequest) GetInventory() *DependencyInventory {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+type SaveDependencyInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveDependencyInventoryResponse) Reset() {
+	*x = SaveDependencyInventoryResponse{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveDependencyInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDependencyInventoryResponse) ProtoMessage() {}
+
+func (x *SaveDependencyInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDependencyInventoryResponse.ProtoReflect.Descriptor instead.
+func (*SaveDependencyInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SaveDependencyInventoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListDependencyInventories RPC messages
+type ListDependencyInventoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Newest inventories to return, 0 for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependencyInventoriesRequest) Reset() {
+	*x = ListDependencyInventoriesRequest{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependencyInventoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependencyInventoriesRequest) ProtoMessage() {}
+
+func (x *ListDependencyInventoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependencyInventoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDependencyInventoriesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListDependencyInventoriesRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ListDependencyInventoriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDependencyInventoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inventories   []*DependencyInventory `protobuf:"bytes,1,rep,name=inventories,proto3" json:"inventories,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDependencyInventoriesResponse) Reset() {
+	*x = ListDependencyInventoriesResponse{}
+	mi := &file_v1_dependency_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDependencyInventoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDependencyInventoriesResponse) ProtoMessage() {}
+
+func (x *ListDependencyInventoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_dependency_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDependencyInventoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDependencyInventoriesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_dependency_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDependencyInventoriesResponse) GetInventories() []*DependencyInventory {
+	if x != nil {
+		return x.Inventories
+	}
+	return nil
+}
+
+var File_v1_dependency_inventory_proto protoreflect.FileDescriptor
+
+const file_v1_dependency_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x1dv1/dependency_inventory.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"t\n" +
+	"\n" +
+	"Dependency\x12\x1c\n" +
+	"\tecosystem\x18\x01 \x01(\tR\tecosystem\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1a\n" +
+	"\bmanifest\x18\x04 \x01(\tR\bmanifest\"\xa5\x01\n" +
+	"\x13DependencyInventory\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\x128\n" +
+	"\fdependencies\x18\x02 \x03(\v2\x14.clonr.v1.DependencyR\fdependencies\x129\n" +
+	"\n" +
+	"scanned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"]\n" +
+	"\x1eSaveDependencyInventoryRequest\x12;\n" +
+	"\tinventory\x18\x01 \x01(\v2\x1d.clonr.v1.DependencyInventoryR\tinventory\";\n" +
+	"\x1fSaveDependencyInventoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	" ListDependencyInventoriesRequest\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"d\n" +
+	"!ListDependencyInventoriesResponse\x12?\n" +
+	"\vinventories\x18\x01 \x03(\v2\x1d.clonr.v1.DependencyInventoryR\vinventoriesB\x9b\x01\n" +
+	"\fcom.clonr.v1B\x18DependencyInventoryProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_dependency_inventory_proto_rawDescOnce sync.Once
+	file_v1_dependency_inventory_proto_rawDescData []byte
+)
+
+func file_v1_dependency_inventory_proto_rawDescGZIP() []byte {
+	file_v1_dependency_inventory_proto_rawDescOnce.Do(func() {
+		file_v1_dependency_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_dependency_inventory_proto_rawDesc), len(file_v1_dependency_inventory_proto_rawDesc)))
+	})
+	return file_v1_dependency_inventory_proto_rawDescData
+}
+
+var file_v1_dependency_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_dependency_inventory_proto_goTypes = []any{
+	(*Dependency)(nil),                        // 0: clonr.v1.Dependency
+	(*DependencyInventory)(nil),               // 1: clonr.v1.DependencyInventory
+	(*SaveDependencyInventoryRequest)(nil),    // 2: clonr.v1.SaveDependencyInventoryRequest
+	(*SaveDependencyInventoryResponse)(nil),   // 3: clonr.v1.SaveDependencyInventoryResponse
+	(*ListDependencyInventoriesRequest)(nil),  // 4: clonr.v1.ListDependencyInventoriesRequest
+	(*ListDependencyInventoriesResponse)(nil), // 5: clonr.v1.ListDependencyInventoriesResponse
+	(*timestamppb.Timestamp)(nil),             // 6: google.protobuf.Timestamp
+}
+var file_v1_dependency_inventory_proto_depIdxs = []int32{
+	0, // 0: clonr.v1.DependencyInventory.dependencies:type_name -> clonr.v1.Dependency
+	6, // 1: clonr.v1.DependencyInventory.scanned_at:type_name -> google.protobuf.Timestamp
+	1, // 2: clonr.v1.SaveDependencyInventoryRequest.inventory:type_name -> clonr.v1.DependencyInventory
+	1, // 3: clonr.v1.ListDependencyInventoriesResponse.inventories:type_name -> clonr.v1.DependencyInventory
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v1_dependency_inventory_proto_init() }
+func file_v1_dependency_inventory_proto_init() {
+	if File_v1_dependency_inventory_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_dependency_inventory_proto_rawDesc), len(file_v1_dependency_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_dependency_inventory_proto_goTypes,
+		DependencyIndexes: file_v1_dependency_inventory_proto_depIdxs,
+		MessageInfos:      file_v1_dependency_inventory_proto_msgTypes,
+	}.Build()
+	File_v1_dependency_inventory_proto = out.File
+	file_v1_dependency_inventory_proto_goTypes = nil
+	file_v1_dependency_inventory_proto_depIdxs = nil
+}
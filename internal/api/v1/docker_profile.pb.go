@@ -32,6 +32,7 @@ type DockerProfile struct {
 	TokenStorage   string                 `protobuf:"bytes,5,opt,name=token_storage,json=tokenStorage,proto3" json:"token_storage,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	Environment    *DockerEnvironment     `protobuf:"bytes,8,opt,name=environment,proto3" json:"environment,omitempty"` // Development container run by 'clonr docker up'
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -115,6 +116,159 @@ func (x *DockerProfile) GetLastUsedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DockerProfile) GetEnvironment() *DockerEnvironment {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+// DockerEnvironment is a development container run from a docker profile
+type DockerEnvironment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Env           []string               `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty"` // KEY=VALUE
+	Mounts        []*DockerMount         `protobuf:"bytes,3,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	Ports         []string               `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"` // [host_ip:]host:container
+	Workdir       string                 `protobuf:"bytes,5,opt,name=workdir,proto3" json:"workdir,omitempty"`
+	Command       []string               `protobuf:"bytes,6,rep,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DockerEnvironment) Reset() {
+	*x = DockerEnvironment{}
+	mi := &file_v1_docker_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DockerEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerEnvironment) ProtoMessage() {}
+
+func (x *DockerEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_docker_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerEnvironment.ProtoReflect.Descriptor instead.
+func (*DockerEnvironment) Descriptor() ([]byte, []int) {
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DockerEnvironment) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *DockerEnvironment) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *DockerEnvironment) GetMounts() []*DockerMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *DockerEnvironment) GetPorts() []string {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *DockerEnvironment) GetWorkdir() string {
+	if x != nil {
+		return x.Workdir
+	}
+	return ""
+}
+
+func (x *DockerEnvironment) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+// DockerMount binds a host path or tracked repository into the container
+type DockerMount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // Host path or repository name, URL or path
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DockerMount) Reset() {
+	*x = DockerMount{}
+	mi := &file_v1_docker_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DockerMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerMount) ProtoMessage() {}
+
+func (x *DockerMount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_docker_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerMount.ProtoReflect.Descriptor instead.
+func (*DockerMount) Descriptor() ([]byte, []int) {
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DockerMount) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DockerMount) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DockerMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
 // SaveDockerProfile RPC messages
 type SaveDockerProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -125,7 +279,7 @@ type SaveDockerProfileRequest struct {
 
 func (x *SaveDockerProfileRequest) Reset() {
 	*x = SaveDockerProfileRequest{}
-	mi := &file_v1_docker_profile_proto_msgTypes[1]
+	mi := &file_v1_docker_profile_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -137,7 +291,7 @@ func (x *SaveDockerProfileRequest) String() string {
 func (*SaveDockerProfileRequest) ProtoMessage() {}
 
 func (x *SaveDockerProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[1]
+	mi := &file_v1_docker_profile_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -150,7 +304,7 @@ func (x *SaveDockerProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveDockerProfileRequest.ProtoReflect.Descriptor instead.
 func (*SaveDockerProfileRequest) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{1}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SaveDockerProfileRequest) GetProfile() *DockerProfile {
@@ -169,7 +323,7 @@ type SaveDockerProfileResponse struct {
 
 func (x *SaveDockerProfileResponse) Reset() {
 	*x = SaveDockerProfileResponse{}
-	mi := &file_v1_docker_profile_proto_msgTypes[2]
+	mi := &file_v1_docker_profile_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -181,7 +335,7 @@ func (x *SaveDockerProfileResponse) String() string {
 func (*SaveDockerProfileResponse) ProtoMessage() {}
 
 func (x *SaveDockerProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[2]
+	mi := &file_v1_docker_profile_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,7 +348,7 @@ func (x *SaveDockerProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveDockerProfileResponse.ProtoReflect.Descriptor instead.
 func (*SaveDockerProfileResponse) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{2}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SaveDockerProfileResponse) GetSuccess() bool {
@@ -214,7 +368,7 @@ type GetDockerProfileRequest struct {
 
 func (x *GetDockerProfileRequest) Reset() {
 	*x = GetDockerProfileRequest{}
-	mi := &file_v1_docker_profile_proto_msgTypes[3]
+	mi := &file_v1_docker_profile_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +380,7 @@ func (x *GetDockerProfileRequest) String() string {
 func (*GetDockerProfileRequest) ProtoMessage() {}
 
 func (x *GetDockerProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[3]
+	mi := &file_v1_docker_profile_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +393,7 @@ func (x *GetDockerProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDockerProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetDockerProfileRequest) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{3}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetDockerProfileRequest) GetName() string {
@@ -258,7 +412,7 @@ type GetDockerProfileResponse struct {
 
 func (x *GetDockerProfileResponse) Reset() {
 	*x = GetDockerProfileResponse{}
-	mi := &file_v1_docker_profile_proto_msgTypes[4]
+	mi := &file_v1_docker_profile_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -270,7 +424,7 @@ func (x *GetDockerProfileResponse) String() string {
 func (*GetDockerProfileResponse) ProtoMessage() {}
 
 func (x *GetDockerProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[4]
+	mi := &file_v1_docker_profile_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -283,7 +437,7 @@ func (x *GetDockerProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDockerProfileResponse.ProtoReflect.Descriptor instead.
 func (*GetDockerProfileResponse) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{4}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetDockerProfileResponse) GetProfile() *DockerProfile {
@@ -302,7 +456,7 @@ type ListDockerProfilesRequest struct {
 
 func (x *ListDockerProfilesRequest) Reset() {
 	*x = ListDockerProfilesRequest{}
-	mi := &file_v1_docker_profile_proto_msgTypes[5]
+	mi := &file_v1_docker_profile_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -314,7 +468,7 @@ func (x *ListDockerProfilesRequest) String() string {
 func (*ListDockerProfilesRequest) ProtoMessage() {}
 
 func (x *ListDockerProfilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[5]
+	mi := &file_v1_docker_profile_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +481,7 @@ func (x *ListDockerProfilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDockerProfilesRequest.ProtoReflect.Descriptor instead.
 func (*ListDockerProfilesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{5}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{7}
 }
 
 type ListDockerProfilesResponse struct {
@@ -339,7 +493,7 @@ type ListDockerProfilesResponse struct {
 
 func (x *ListDockerProfilesResponse) Reset() {
 	*x = ListDockerProfilesResponse{}
-	mi := &file_v1_docker_profile_proto_msgTypes[6]
+	mi := &file_v1_docker_profile_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +505,7 @@ func (x *ListDockerProfilesResponse) String() string {
 func (*ListDockerProfilesResponse) ProtoMessage() {}
 
 func (x *ListDockerProfilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[6]
+	mi := &file_v1_docker_profile_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +518,7 @@ func (x *ListDockerProfilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListDockerProfilesResponse.ProtoReflect.Descriptor instead.
 func (*ListDockerProfilesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{6}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListDockerProfilesResponse) GetProfiles() []*DockerProfile {
@@ -384,7 +538,7 @@ type DeleteDockerProfileRequest struct {
 
 func (x *DeleteDockerProfileRequest) Reset() {
 	*x = DeleteDockerProfileRequest{}
-	mi := &file_v1_docker_profile_proto_msgTypes[7]
+	mi := &file_v1_docker_profile_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -396,7 +550,7 @@ func (x *DeleteDockerProfileRequest) String() string {
 func (*DeleteDockerProfileRequest) ProtoMessage() {}
 
 func (x *DeleteDockerProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[7]
+	mi := &file_v1_docker_profile_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +563,7 @@ func (x *DeleteDockerProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDockerProfileRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDockerProfileRequest) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{7}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteDockerProfileRequest) GetName() string {
@@ -428,7 +582,7 @@ type DeleteDockerProfileResponse struct {
 
 func (x *DeleteDockerProfileResponse) Reset() {
 	*x = DeleteDockerProfileResponse{}
-	mi := &file_v1_docker_profile_proto_msgTypes[8]
+	mi := &file_v1_docker_profile_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -440,7 +594,7 @@ func (x *DeleteDockerProfileResponse) String() string {
 func (*DeleteDockerProfileResponse) ProtoMessage() {}
 
 func (x *DeleteDockerProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[8]
+	mi := &file_v1_docker_profile_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +607,7 @@ func (x *DeleteDockerProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDockerProfileResponse.ProtoReflect.Descriptor instead.
 func (*DeleteDockerProfileResponse) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{8}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteDockerProfileResponse) GetSuccess() bool {
@@ -473,7 +627,7 @@ type DockerProfileExistsRequest struct {
 
 func (x *DockerProfileExistsRequest) Reset() {
 	*x = DockerProfileExistsRequest{}
-	mi := &file_v1_docker_profile_proto_msgTypes[9]
+	mi := &file_v1_docker_profile_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +639,7 @@ func (x *DockerProfileExistsRequest) String() string {
 func (*DockerProfileExistsRequest) ProtoMessage() {}
 
 func (x *DockerProfileExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[9]
+	mi := &file_v1_docker_profile_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +652,7 @@ func (x *DockerProfileExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerProfileExistsRequest.ProtoReflect.Descriptor instead.
 func (*DockerProfileExistsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{9}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DockerProfileExistsRequest) GetName() string {
@@ -517,7 +671,7 @@ type DockerProfileExistsResponse struct {
 
 func (x *DockerProfileExistsResponse) Reset() {
 	*x = DockerProfileExistsResponse{}
-	mi := &file_v1_docker_profile_proto_msgTypes[10]
+	mi := &file_v1_docker_profile_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +683,7 @@ func (x *DockerProfileExistsResponse) String() string {
 func (*DockerProfileExistsResponse) ProtoMessage() {}
 
 func (x *DockerProfileExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_docker_profile_proto_msgTypes[10]
+	mi := &file_v1_docker_profile_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +696,7 @@ func (x *DockerProfileExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerProfileExistsResponse.ProtoReflect.Descriptor instead.
 func (*DockerProfileExistsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_docker_profile_proto_rawDescGZIP(), []int{10}
+	return file_v1_docker_profile_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DockerProfileExistsResponse) GetExists() bool {
@@ -556,7 +710,7 @@ var File_v1_docker_profile_proto protoreflect.FileDescriptor
 
 const file_v1_docker_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x17v1/docker_profile.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x02\n" +
+	"\x17v1/docker_profile.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x02\n" +
 	"\rDockerProfile\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bregistry\x18\x02 \x01(\tR\bregistry\x12\x1a\n" +
@@ -566,7 +720,19 @@ const file_v1_docker_profile_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
 	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUsedAt\"M\n" +
+	"lastUsedAt\x12=\n" +
+	"\venvironment\x18\b \x01(\v2\x1b.clonr.v1.DockerEnvironmentR\venvironment\"\xb4\x01\n" +
+	"\x11DockerEnvironment\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12\x10\n" +
+	"\x03env\x18\x02 \x03(\tR\x03env\x12-\n" +
+	"\x06mounts\x18\x03 \x03(\v2\x15.clonr.v1.DockerMountR\x06mounts\x12\x14\n" +
+	"\x05ports\x18\x04 \x03(\tR\x05ports\x12\x18\n" +
+	"\aworkdir\x18\x05 \x01(\tR\aworkdir\x12\x18\n" +
+	"\acommand\x18\x06 \x03(\tR\acommand\"Z\n" +
+	"\vDockerMount\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\"M\n" +
 	"\x18SaveDockerProfileRequest\x121\n" +
 	"\aprofile\x18\x01 \x01(\v2\x17.clonr.v1.DockerProfileR\aprofile\"5\n" +
 	"\x19SaveDockerProfileResponse\x12\x18\n" +
@@ -600,32 +766,36 @@ func file_v1_docker_profile_proto_rawDescGZIP() []byte {
 	return file_v1_docker_profile_proto_rawDescData
 }
 
-var file_v1_docker_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_v1_docker_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_v1_docker_profile_proto_goTypes = []any{
 	(*DockerProfile)(nil),               // 0: clonr.v1.DockerProfile
-	(*SaveDockerProfileRequest)(nil),    // 1: clonr.v1.SaveDockerProfileRequest
-	(*SaveDockerProfileResponse)(nil),   // 2: clonr.v1.SaveDockerProfileResponse
-	(*GetDockerProfileRequest)(nil),     // 3: clonr.v1.GetDockerProfileRequest
-	(*GetDockerProfileResponse)(nil),    // 4: clonr.v1.GetDockerProfileResponse
-	(*ListDockerProfilesRequest)(nil),   // 5: clonr.v1.ListDockerProfilesRequest
-	(*ListDockerProfilesResponse)(nil),  // 6: clonr.v1.ListDockerProfilesResponse
-	(*DeleteDockerProfileRequest)(nil),  // 7: clonr.v1.DeleteDockerProfileRequest
-	(*DeleteDockerProfileResponse)(nil), // 8: clonr.v1.DeleteDockerProfileResponse
-	(*DockerProfileExistsRequest)(nil),  // 9: clonr.v1.DockerProfileExistsRequest
-	(*DockerProfileExistsResponse)(nil), // 10: clonr.v1.DockerProfileExistsResponse
-	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
+	(*DockerEnvironment)(nil),           // 1: clonr.v1.DockerEnvironment
+	(*DockerMount)(nil),                 // 2: clonr.v1.DockerMount
+	(*SaveDockerProfileRequest)(nil),    // 3: clonr.v1.SaveDockerProfileRequest
+	(*SaveDockerProfileResponse)(nil),   // 4: clonr.v1.SaveDockerProfileResponse
+	(*GetDockerProfileRequest)(nil),     // 5: clonr.v1.GetDockerProfileRequest
+	(*GetDockerProfileResponse)(nil),    // 6: clonr.v1.GetDockerProfileResponse
+	(*ListDockerProfilesRequest)(nil),   // 7: clonr.v1.ListDockerProfilesRequest
+	(*ListDockerProfilesResponse)(nil),  // 8: clonr.v1.ListDockerProfilesResponse
+	(*DeleteDockerProfileRequest)(nil),  // 9: clonr.v1.DeleteDockerProfileRequest
+	(*DeleteDockerProfileResponse)(nil), // 10: clonr.v1.DeleteDockerProfileResponse
+	(*DockerProfileExistsRequest)(nil),  // 11: clonr.v1.DockerProfileExistsRequest
+	(*DockerProfileExistsResponse)(nil), // 12: clonr.v1.DockerProfileExistsResponse
+	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
 }
 var file_v1_docker_profile_proto_depIdxs = []int32{
-	11, // 0: clonr.v1.DockerProfile.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: clonr.v1.DockerProfile.last_used_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: clonr.v1.SaveDockerProfileRequest.profile:type_name -> clonr.v1.DockerProfile
-	0,  // 3: clonr.v1.GetDockerProfileResponse.profile:type_name -> clonr.v1.DockerProfile
-	0,  // 4: clonr.v1.ListDockerProfilesResponse.profiles:type_name -> clonr.v1.DockerProfile
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	13, // 0: clonr.v1.DockerProfile.created_at:type_name -> google.protobuf.Timestamp
+	13, // 1: clonr.v1.DockerProfile.last_used_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: clonr.v1.DockerProfile.environment:type_name -> clonr.v1.DockerEnvironment
+	2,  // 3: clonr.v1.DockerEnvironment.mounts:type_name -> clonr.v1.DockerMount
+	0,  // 4: clonr.v1.SaveDockerProfileRequest.profile:type_name -> clonr.v1.DockerProfile
+	0,  // 5: clonr.v1.GetDockerProfileResponse.profile:type_name -> clonr.v1.DockerProfile
+	0,  // 6: clonr.v1.ListDockerProfilesResponse.profiles:type_name -> clonr.v1.DockerProfile
+	7,  // [7:7] is the sub-list for method output_type
+	7,  // [7:7] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_v1_docker_profile_proto_init() }
@@ -639,7 +809,7 @@ func file_v1_docker_profile_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_docker_profile_proto_rawDesc), len(file_v1_docker_profile_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
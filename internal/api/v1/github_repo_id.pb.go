@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/github_repo_id.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GitHubRepoID is a cached GitHub repository ID
+type GitHubRepoID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"` // Lowercased owner/repo
+	RepoId        int64                  `protobuf:"varint,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	CachedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cached_at,json=cachedAt,proto3" json:"cached_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubRepoID) Reset() {
+	*x = GitHubRepoID{}
+	mi := &file_v1_github_repo_id_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubRepoID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubRepoID) ProtoMessage() {}
+
+func (x *GitHubRepoID) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_github_repo_id_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubRepoID.ProtoReflect.Descriptor instead.
+func (*GitHubRepoID) Descriptor() ([]byte, []int) {
+	return file_v1_github_repo_id_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GitHubRepoID) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *GitHubRepoID) GetRepoId() int64 {
+	if x != nil {
+		return x.RepoId
+	}
+	return 0
+}
+
+func (x *GitHubRepoID) GetCachedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CachedAt
+	}
+	return nil
+}
+
+// SaveGitHubRepoID RPC messages
+type SaveGitHubRepoIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *GitHubRepoID          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGitHubRepoIDRequest) Reset() {
+	*x = SaveGitHubRepoIDRequest{}
+	mi := &file_v1_github_repo_id_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGitHubRepoIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGitHubRepoIDRequest) ProtoMessage() {}
+
+func (x *SaveGitHubRepoIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_github_repo_id_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGitHubRepoIDRequest.ProtoReflect.Descriptor instead.
+func (*SaveGitHubRepoIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_github_repo_id_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveGitHubRepoIDRequest) GetEntry() *GitHubRepoID {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SaveGitHubRepoIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGitHubRepoIDResponse) Reset() {
+	*x = SaveGitHubRepoIDResponse{}
+	mi := &file_v1_github_repo_id_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGitHubRepoIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGitHubRepoIDResponse) ProtoMessage() {}
+
+func (x *SaveGitHubRepoIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_github_repo_id_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGitHubRepoIDResponse.ProtoReflect.Descriptor instead.
+func (*SaveGitHubRepoIDResponse) Descriptor() ([]byte, []int) {
+	return file_v1_github_repo_id_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveGitHubRepoIDResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetGitHubRepoID RPC messages
+type GetGitHubRepoIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FullName      string                 `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGitHubRepoIDRequest) Reset() {
+	*x = GetGitHubRepoIDRequest{}
+	mi := &file_v1_github_repo_id_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGitHubRepoIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGitHubRepoIDRequest) ProtoMessage() {}
+
+func (x *GetGitHubRepoIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_github_repo_id_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGitHubRepoIDRequest.ProtoReflect.Descriptor instead.
+func (*GetGitHubRepoIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_github_repo_id_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGitHubRepoIDRequest) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+type GetGitHubRepoIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *GitHubRepoID          `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"` // Unset when the repository is not cached
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGitHubRepoIDResponse) Reset() {
+	*x = GetGitHubRepoIDResponse{}
+	mi := &file_v1_github_repo_id_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGitHubRepoIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGitHubRepoIDResponse) ProtoMessage() {}
+
+func (x *GetGitHubRepoIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_github_repo_id_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGitHubRepoIDResponse.ProtoReflect.Descriptor instead.
+func (*GetGitHubRepoIDResponse) Descriptor() ([]byte, []int) {
+	return file_v1_github_repo_id_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGitHubRepoIDResponse) GetEntry() *GitHubRepoID {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+var File_v1_github_repo_id_proto protoreflect.FileDescriptor
+
+const file_v1_github_repo_id_proto_rawDesc = "" +
+	"\n" +
+	"\x17v1/github_repo_id.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"}\n" +
+	"\fGitHubRepoID\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\x12\x17\n" +
+	"\arepo_id\x18\x02 \x01(\x03R\x06repoId\x127\n" +
+	"\tcached_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcachedAt\"G\n" +
+	"\x17SaveGitHubRepoIDRequest\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.clonr.v1.GitHubRepoIDR\x05entry\"4\n" +
+	"\x18SaveGitHubRepoIDResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
+	"\x16GetGitHubRepoIDRequest\x12\x1b\n" +
+	"\tfull_name\x18\x01 \x01(\tR\bfullName\"G\n" +
+	"\x17GetGitHubRepoIDResponse\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.clonr.v1.GitHubRepoIDR\x05entryB\x94\x01\n" +
+	"\fcom.clonr.v1B\x11GithubRepoIdProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_github_repo_id_proto_rawDescOnce sync.Once
+	file_v1_github_repo_id_proto_rawDescData []byte
+)
+
+func file_v1_github_repo_id_proto_rawDescGZIP() []byte {
+	file_v1_github_repo_id_proto_rawDescOnce.Do(func() {
+		file_v1_github_repo_id_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_github_repo_id_proto_rawDesc), len(file_v1_github_repo_id_proto_rawDesc)))
+	})
+	return file_v1_github_repo_id_proto_rawDescData
+}
+
+var file_v1_github_repo_id_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_github_repo_id_proto_goTypes = []any{
+	(*GitHubRepoID)(nil),             // 0: clonr.v1.GitHubRepoID
+	(*SaveGitHubRepoIDRequest)(nil),  // 1: clonr.v1.SaveGitHubRepoIDRequest
+	(*SaveGitHubRepoIDResponse)(nil), // 2: clonr.v1.SaveGitHubRepoIDResponse
+	(*GetGitHubRepoIDRequest)(nil),   // 3: clonr.v1.GetGitHubRepoIDRequest
+	(*GetGitHubRepoIDResponse)(nil),  // 4: clonr.v1.GetGitHubRepoIDResponse
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+}
+var file_v1_github_repo_id_proto_depIdxs = []int32{
+	5, // 0: clonr.v1.GitHubRepoID.cached_at:type_name -> google.protobuf.Timestamp
+	0, // 1: clonr.v1.SaveGitHubRepoIDRequest.entry:type_name -> clonr.v1.GitHubRepoID
+	0, // 2: clonr.v1.GetGitHubRepoIDResponse.entry:type_name -> clonr.v1.GitHubRepoID
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_github_repo_id_proto_init() }
+func file_v1_github_repo_id_proto_init() {
+	if File_v1_github_repo_id_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_github_repo_id_proto_rawDesc), len(file_v1_github_repo_id_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_github_repo_id_proto_goTypes,
+		DependencyIndexes: file_v1_github_repo_id_proto_depIdxs,
+		MessageInfos:      file_v1_github_repo_id_proto_msgTypes,
+	}.Build()
+	File_v1_github_repo_id_proto = out.File
+	file_v1_github_repo_id_proto_goTypes = nil
+	file_v1_github_repo_id_proto_depIdxs = nil
+}
@@ -0,0 +1,609 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/gmail_watch.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GmailWatch is a Gmail search the server polls for new messages
+type GmailWatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Profile         string                 `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Query           string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Notify          bool                   `protobuf:"varint,5,opt,name=notify,proto3" json:"notify,omitempty"`
+	Exec            string                 `protobuf:"bytes,6,opt,name=exec,proto3" json:"exec,omitempty"`                            // Shell command run for every matching message
+	HistoryId       string                 `protobuf:"bytes,7,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"` // Mailbox history ID the next poll starts after
+	LastCheckedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	LastMatchAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_match_at,json=lastMatchAt,proto3" json:"last_match_at,omitempty"`
+	LastError       string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GmailWatch) Reset() {
+	*x = GmailWatch{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GmailWatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GmailWatch) ProtoMessage() {}
+
+func (x *GmailWatch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GmailWatch.ProtoReflect.Descriptor instead.
+func (*GmailWatch) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GmailWatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *GmailWatch) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *GmailWatch) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetHistoryId() string {
+	if x != nil {
+		return x.HistoryId
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetLastCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return nil
+}
+
+func (x *GmailWatch) GetLastMatchAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMatchAt
+	}
+	return nil
+}
+
+func (x *GmailWatch) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *GmailWatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GmailWatch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SaveGmailWatch RPC messages
+type SaveGmailWatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watch         *GmailWatch            `protobuf:"bytes,1,opt,name=watch,proto3" json:"watch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGmailWatchRequest) Reset() {
+	*x = SaveGmailWatchRequest{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGmailWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGmailWatchRequest) ProtoMessage() {}
+
+func (x *SaveGmailWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGmailWatchRequest.ProtoReflect.Descriptor instead.
+func (*SaveGmailWatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveGmailWatchRequest) GetWatch() *GmailWatch {
+	if x != nil {
+		return x.Watch
+	}
+	return nil
+}
+
+type SaveGmailWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveGmailWatchResponse) Reset() {
+	*x = SaveGmailWatchResponse{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveGmailWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveGmailWatchResponse) ProtoMessage() {}
+
+func (x *SaveGmailWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveGmailWatchResponse.ProtoReflect.Descriptor instead.
+func (*SaveGmailWatchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveGmailWatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetGmailWatch RPC messages
+type GetGmailWatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGmailWatchRequest) Reset() {
+	*x = GetGmailWatchRequest{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGmailWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGmailWatchRequest) ProtoMessage() {}
+
+func (x *GetGmailWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGmailWatchRequest.ProtoReflect.Descriptor instead.
+func (*GetGmailWatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGmailWatchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetGmailWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watch         *GmailWatch            `protobuf:"bytes,1,opt,name=watch,proto3" json:"watch,omitempty"` // Unset when no watch has the name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGmailWatchResponse) Reset() {
+	*x = GetGmailWatchResponse{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGmailWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGmailWatchResponse) ProtoMessage() {}
+
+func (x *GetGmailWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGmailWatchResponse.ProtoReflect.Descriptor instead.
+func (*GetGmailWatchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGmailWatchResponse) GetWatch() *GmailWatch {
+	if x != nil {
+		return x.Watch
+	}
+	return nil
+}
+
+// ListGmailWatches RPC messages
+type ListGmailWatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGmailWatchesRequest) Reset() {
+	*x = ListGmailWatchesRequest{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGmailWatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGmailWatchesRequest) ProtoMessage() {}
+
+func (x *ListGmailWatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGmailWatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListGmailWatchesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{5}
+}
+
+type ListGmailWatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watches       []*GmailWatch          `protobuf:"bytes,1,rep,name=watches,proto3" json:"watches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGmailWatchesResponse) Reset() {
+	*x = ListGmailWatchesResponse{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGmailWatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGmailWatchesResponse) ProtoMessage() {}
+
+func (x *ListGmailWatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGmailWatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListGmailWatchesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListGmailWatchesResponse) GetWatches() []*GmailWatch {
+	if x != nil {
+		return x.Watches
+	}
+	return nil
+}
+
+// DeleteGmailWatch RPC messages
+type DeleteGmailWatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGmailWatchRequest) Reset() {
+	*x = DeleteGmailWatchRequest{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGmailWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGmailWatchRequest) ProtoMessage() {}
+
+func (x *DeleteGmailWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGmailWatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGmailWatchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteGmailWatchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteGmailWatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGmailWatchResponse) Reset() {
+	*x = DeleteGmailWatchResponse{}
+	mi := &file_v1_gmail_watch_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGmailWatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGmailWatchResponse) ProtoMessage() {}
+
+func (x *DeleteGmailWatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_gmail_watch_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGmailWatchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGmailWatchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_gmail_watch_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteGmailWatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_gmail_watch_proto protoreflect.FileDescriptor
+
+const file_v1_gmail_watch_proto_rawDesc = "" +
+	"\n" +
+	"\x14v1/gmail_watch.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x03\n" +
+	"\n" +
+	"GmailWatch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x03R\x0fintervalSeconds\x12\x16\n" +
+	"\x06notify\x18\x05 \x01(\bR\x06notify\x12\x12\n" +
+	"\x04exec\x18\x06 \x01(\tR\x04exec\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\a \x01(\tR\thistoryId\x12B\n" +
+	"\x0flast_checked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckedAt\x12>\n" +
+	"\rlast_match_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vlastMatchAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"C\n" +
+	"\x15SaveGmailWatchRequest\x12*\n" +
+	"\x05watch\x18\x01 \x01(\v2\x14.clonr.v1.GmailWatchR\x05watch\"2\n" +
+	"\x16SaveGmailWatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x14GetGmailWatchRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x15GetGmailWatchResponse\x12*\n" +
+	"\x05watch\x18\x01 \x01(\v2\x14.clonr.v1.GmailWatchR\x05watch\"\x19\n" +
+	"\x17ListGmailWatchesRequest\"J\n" +
+	"\x18ListGmailWatchesResponse\x12.\n" +
+	"\awatches\x18\x01 \x03(\v2\x14.clonr.v1.GmailWatchR\awatches\"-\n" +
+	"\x17DeleteGmailWatchRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"4\n" +
+	"\x18DeleteGmailWatchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x92\x01\n" +
+	"\fcom.clonr.v1B\x0fGmailWatchProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_gmail_watch_proto_rawDescOnce sync.Once
+	file_v1_gmail_watch_proto_rawDescData []byte
+)
+
+func file_v1_gmail_watch_proto_rawDescGZIP() []byte {
+	file_v1_gmail_watch_proto_rawDescOnce.Do(func() {
+		file_v1_gmail_watch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_gmail_watch_proto_rawDesc), len(file_v1_gmail_watch_proto_rawDesc)))
+	})
+	return file_v1_gmail_watch_proto_rawDescData
+}
+
+var file_v1_gmail_watch_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_gmail_watch_proto_goTypes = []any{
+	(*GmailWatch)(nil),               // 0: clonr.v1.GmailWatch
+	(*SaveGmailWatchRequest)(nil),    // 1: clonr.v1.SaveGmailWatchRequest
+	(*SaveGmailWatchResponse)(nil),   // 2: clonr.v1.SaveGmailWatchResponse
+	(*GetGmailWatchRequest)(nil),     // 3: clonr.v1.GetGmailWatchRequest
+	(*GetGmailWatchResponse)(nil),    // 4: clonr.v1.GetGmailWatchResponse
+	(*ListGmailWatchesRequest)(nil),  // 5: clonr.v1.ListGmailWatchesRequest
+	(*ListGmailWatchesResponse)(nil), // 6: clonr.v1.ListGmailWatchesResponse
+	(*DeleteGmailWatchRequest)(nil),  // 7: clonr.v1.DeleteGmailWatchRequest
+	(*DeleteGmailWatchResponse)(nil), // 8: clonr.v1.DeleteGmailWatchResponse
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+}
+var file_v1_gmail_watch_proto_depIdxs = []int32{
+	9, // 0: clonr.v1.GmailWatch.last_checked_at:type_name -> google.protobuf.Timestamp
+	9, // 1: clonr.v1.GmailWatch.last_match_at:type_name -> google.protobuf.Timestamp
+	9, // 2: clonr.v1.GmailWatch.created_at:type_name -> google.protobuf.Timestamp
+	9, // 3: clonr.v1.GmailWatch.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 4: clonr.v1.SaveGmailWatchRequest.watch:type_name -> clonr.v1.GmailWatch
+	0, // 5: clonr.v1.GetGmailWatchResponse.watch:type_name -> clonr.v1.GmailWatch
+	0, // 6: clonr.v1.ListGmailWatchesResponse.watches:type_name -> clonr.v1.GmailWatch
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_v1_gmail_watch_proto_init() }
+func file_v1_gmail_watch_proto_init() {
+	if File_v1_gmail_watch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_gmail_watch_proto_rawDesc), len(file_v1_gmail_watch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_gmail_watch_proto_goTypes,
+		DependencyIndexes: file_v1_gmail_watch_proto_depIdxs,
+		MessageInfos:      file_v1_gmail_watch_proto_msgTypes,
+	}.Build()
+	File_v1_gmail_watch_proto = out.File
+	file_v1_gmail_watch_proto_goTypes = nil
+	file_v1_gmail_watch_proto_depIdxs = nil
+}
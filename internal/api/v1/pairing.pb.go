@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/pairing.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PairDevice RPC messages.
+// A second machine redeems a one-time pairing token shown as a QR code on
+// the standalone server and registers its client encryption key.
+type PairDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                             // One-time pairing token from the pairing link
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`       // Unique ID of the pairing machine
+	ClientName    string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"` // Human-readable name of the pairing machine
+	DisplayKey    string                 `protobuf:"bytes,4,opt,name=display_key,json=displayKey,proto3" json:"display_key,omitempty"` // Client encryption key (display format)
+	Hostname      string                 `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
+	Arch          string                 `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
+	ClonrVersion  string                 `protobuf:"bytes,8,opt,name=clonr_version,json=clonrVersion,proto3" json:"clonr_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairDeviceRequest) Reset() {
+	*x = PairDeviceRequest{}
+	mi := &file_v1_pairing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairDeviceRequest) ProtoMessage() {}
+
+func (x *PairDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pairing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairDeviceRequest.ProtoReflect.Descriptor instead.
+func (*PairDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_pairing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PairDeviceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetDisplayKey() string {
+	if x != nil {
+		return x.DisplayKey
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *PairDeviceRequest) GetClonrVersion() string {
+	if x != nil {
+		return x.ClonrVersion
+	}
+	return ""
+}
+
+type PairDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"` // Standalone instance ID of the server
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                 // Instance fingerprint, must match the pairing link
+	SyncPort      int32                  `protobuf:"varint,3,opt,name=sync_port,json=syncPort,proto3" json:"sync_port,omitempty"`      // Port of the standalone sync service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairDeviceResponse) Reset() {
+	*x = PairDeviceResponse{}
+	mi := &file_v1_pairing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairDeviceResponse) ProtoMessage() {}
+
+func (x *PairDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_pairing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairDeviceResponse.ProtoReflect.Descriptor instead.
+func (*PairDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_v1_pairing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PairDeviceResponse) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *PairDeviceResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *PairDeviceResponse) GetSyncPort() int32 {
+	if x != nil {
+		return x.SyncPort
+	}
+	return 0
+}
+
+var File_v1_pairing_proto protoreflect.FileDescriptor
+
+const file_v1_pairing_proto_rawDesc = "" +
+	"\n" +
+	"\x10v1/pairing.proto\x12\bclonr.v1\"\xed\x01\n" +
+	"\x11PairDeviceRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x03 \x01(\tR\n" +
+	"clientName\x12\x1f\n" +
+	"\vdisplay_key\x18\x04 \x01(\tR\n" +
+	"displayKey\x12\x1a\n" +
+	"\bhostname\x18\x05 \x01(\tR\bhostname\x12\x0e\n" +
+	"\x02os\x18\x06 \x01(\tR\x02os\x12\x12\n" +
+	"\x04arch\x18\a \x01(\tR\x04arch\x12#\n" +
+	"\rclonr_version\x18\b \x01(\tR\fclonrVersion\"t\n" +
+	"\x12PairDeviceResponse\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x1b\n" +
+	"\tsync_port\x18\x03 \x01(\x05R\bsyncPortB\x8f\x01\n" +
+	"\fcom.clonr.v1B\fPairingProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_pairing_proto_rawDescOnce sync.Once
+	file_v1_pairing_proto_rawDescData []byte
+)
+
+func file_v1_pairing_proto_rawDescGZIP() []byte {
+	file_v1_pairing_proto_rawDescOnce.Do(func() {
+		file_v1_pairing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_pairing_proto_rawDesc), len(file_v1_pairing_proto_rawDesc)))
+	})
+	return file_v1_pairing_proto_rawDescData
+}
+
+var file_v1_pairing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_pairing_proto_goTypes = []any{
+	(*PairDeviceRequest)(nil),  // 0: clonr.v1.PairDeviceRequest
+	(*PairDeviceResponse)(nil), // 1: clonr.v1.PairDeviceResponse
+}
+var file_v1_pairing_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v1_pairing_proto_init() }
+func file_v1_pairing_proto_init() {
+	if File_v1_pairing_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_pairing_proto_rawDesc), len(file_v1_pairing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_pairing_proto_goTypes,
+		DependencyIndexes: file_v1_pairing_proto_depIdxs,
+		MessageInfos:      file_v1_pairing_proto_msgTypes,
+	}.Build()
+	File_v1_pairing_proto = out.File
+	file_v1_pairing_proto_goTypes = nil
+	file_v1_pairing_proto_depIdxs = nil
+}
@@ -24,20 +24,26 @@ const (
 
 // Profile represents a GitHub authentication profile
 type Profile struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Host           string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	User           string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	TokenStorage   string                 `protobuf:"bytes,4,opt,name=token_storage,json=tokenStorage,proto3" json:"token_storage,omitempty"`
-	Scopes         []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	Active         bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
-	EncryptedToken []byte                 `protobuf:"bytes,7,opt,name=encrypted_token,json=encryptedToken,proto3" json:"encrypted_token,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUsedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
-	Workspace      string                 `protobuf:"bytes,10,opt,name=workspace,proto3" json:"workspace,omitempty"`                                 // Associated workspace name
-	NotifyChannels []*NotifyChannel       `protobuf:"bytes,11,rep,name=notify_channels,json=notifyChannels,proto3" json:"notify_channels,omitempty"` // Notification channels (Slack, etc.)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host             string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	User             string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	TokenStorage     string                 `protobuf:"bytes,4,opt,name=token_storage,json=tokenStorage,proto3" json:"token_storage,omitempty"`
+	Scopes           []string               `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	Active           bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	EncryptedToken   []byte                 `protobuf:"bytes,7,opt,name=encrypted_token,json=encryptedToken,proto3" json:"encrypted_token,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	Workspace        string                 `protobuf:"bytes,10,opt,name=workspace,proto3" json:"workspace,omitempty"`                                         // Associated workspace name
+	NotifyChannels   []*NotifyChannel       `protobuf:"bytes,11,rep,name=notify_channels,json=notifyChannels,proto3" json:"notify_channels,omitempty"`         // Notification channels (Slack, etc.)
+	TokenExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=token_expires_at,json=tokenExpiresAt,proto3" json:"token_expires_at,omitempty"`       // Token expiration (unset = no expiry)
+	GitName          string                 `protobuf:"bytes,13,opt,name=git_name,json=gitName,proto3" json:"git_name,omitempty"`                              // Repo-local git user.name
+	GitEmail         string                 `protobuf:"bytes,14,opt,name=git_email,json=gitEmail,proto3" json:"git_email,omitempty"`                           // Repo-local git user.email
+	GitSigningKey    string                 `protobuf:"bytes,15,opt,name=git_signing_key,json=gitSigningKey,proto3" json:"git_signing_key,omitempty"`          // Repo-local git user.signingkey
+	GitSigningFormat string                 `protobuf:"bytes,16,opt,name=git_signing_format,json=gitSigningFormat,proto3" json:"git_signing_format,omitempty"` // Repo-local git gpg.format
+	GitSignCommits   bool                   `protobuf:"varint,17,opt,name=git_sign_commits,json=gitSignCommits,proto3" json:"git_sign_commits,omitempty"`      // Repo-local git commit.gpgsign
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Profile) Reset() {
@@ -147,6 +153,48 @@ func (x *Profile) GetNotifyChannels() []*NotifyChannel {
 	return nil
 }
 
+func (x *Profile) GetTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TokenExpiresAt
+	}
+	return nil
+}
+
+func (x *Profile) GetGitName() string {
+	if x != nil {
+		return x.GitName
+	}
+	return ""
+}
+
+func (x *Profile) GetGitEmail() string {
+	if x != nil {
+		return x.GitEmail
+	}
+	return ""
+}
+
+func (x *Profile) GetGitSigningKey() string {
+	if x != nil {
+		return x.GitSigningKey
+	}
+	return ""
+}
+
+func (x *Profile) GetGitSigningFormat() string {
+	if x != nil {
+		return x.GitSigningFormat
+	}
+	return ""
+}
+
+func (x *Profile) GetGitSignCommits() bool {
+	if x != nil {
+		return x.GitSignCommits
+	}
+	return false
+}
+
 // NotifyChannel represents a notification channel configuration
 type NotifyChannel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -851,7 +899,7 @@ var File_v1_profile_proto protoreflect.FileDescriptor
 
 const file_v1_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x10v1/profile.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x03\n" +
+	"\x10v1/profile.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x05\n" +
 	"\aProfile\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
@@ -866,7 +914,13 @@ const file_v1_profile_proto_rawDesc = "" +
 	"lastUsedAt\x12\x1c\n" +
 	"\tworkspace\x18\n" +
 	" \x01(\tR\tworkspace\x12@\n" +
-	"\x0fnotify_channels\x18\v \x03(\v2\x17.clonr.v1.NotifyChannelR\x0enotifyChannels\"\xcf\x02\n" +
+	"\x0fnotify_channels\x18\v \x03(\v2\x17.clonr.v1.NotifyChannelR\x0enotifyChannels\x12D\n" +
+	"\x10token_expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0etokenExpiresAt\x12\x19\n" +
+	"\bgit_name\x18\r \x01(\tR\agitName\x12\x1b\n" +
+	"\tgit_email\x18\x0e \x01(\tR\bgitEmail\x12&\n" +
+	"\x0fgit_signing_key\x18\x0f \x01(\tR\rgitSigningKey\x12,\n" +
+	"\x12git_signing_format\x18\x10 \x01(\tR\x10gitSigningFormat\x12(\n" +
+	"\x10git_sign_commits\x18\x11 \x01(\bR\x0egitSignCommits\"\xcf\x02\n" +
 	"\rNotifyChannel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -945,18 +999,19 @@ var file_v1_profile_proto_depIdxs = []int32{
 	17, // 0: clonr.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
 	17, // 1: clonr.v1.Profile.last_used_at:type_name -> google.protobuf.Timestamp
 	1,  // 2: clonr.v1.Profile.notify_channels:type_name -> clonr.v1.NotifyChannel
-	16, // 3: clonr.v1.NotifyChannel.config:type_name -> clonr.v1.NotifyChannel.ConfigEntry
-	17, // 4: clonr.v1.NotifyChannel.created_at:type_name -> google.protobuf.Timestamp
-	17, // 5: clonr.v1.NotifyChannel.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 6: clonr.v1.SaveProfileRequest.profile:type_name -> clonr.v1.Profile
-	0,  // 7: clonr.v1.GetProfileResponse.profile:type_name -> clonr.v1.Profile
-	0,  // 8: clonr.v1.GetActiveProfileResponse.profile:type_name -> clonr.v1.Profile
-	0,  // 9: clonr.v1.ListProfilesResponse.profiles:type_name -> clonr.v1.Profile
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	17, // 3: clonr.v1.Profile.token_expires_at:type_name -> google.protobuf.Timestamp
+	16, // 4: clonr.v1.NotifyChannel.config:type_name -> clonr.v1.NotifyChannel.ConfigEntry
+	17, // 5: clonr.v1.NotifyChannel.created_at:type_name -> google.protobuf.Timestamp
+	17, // 6: clonr.v1.NotifyChannel.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: clonr.v1.SaveProfileRequest.profile:type_name -> clonr.v1.Profile
+	0,  // 8: clonr.v1.GetProfileResponse.profile:type_name -> clonr.v1.Profile
+	0,  // 9: clonr.v1.GetActiveProfileResponse.profile:type_name -> clonr.v1.Profile
+	0,  // 10: clonr.v1.ListProfilesResponse.profiles:type_name -> clonr.v1.Profile
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_v1_profile_proto_init() }
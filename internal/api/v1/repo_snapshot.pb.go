@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/repo_snapshot.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RepoSnapshot is the saved working state of a repository
+type RepoSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoUrl       string                 `protobuf:"bytes,2,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Branch        string                 `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"` // Empty when HEAD was detached
+	Head          string                 `protobuf:"bytes,6,opt,name=head,proto3" json:"head,omitempty"`     // Commit SHA of HEAD
+	Stash         string                 `protobuf:"bytes,7,opt,name=stash,proto3" json:"stash,omitempty"`   // Stash commit SHA of dirty changes, empty if clean
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoSnapshot) Reset() {
+	*x = RepoSnapshot{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoSnapshot) ProtoMessage() {}
+
+func (x *RepoSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoSnapshot.ProtoReflect.Descriptor instead.
+func (*RepoSnapshot) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepoSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetHead() string {
+	if x != nil {
+		return x.Head
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetStash() string {
+	if x != nil {
+		return x.Stash
+	}
+	return ""
+}
+
+func (x *RepoSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// SaveRepoSnapshot RPC messages
+type SaveRepoSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *RepoSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRepoSnapshotRequest) Reset() {
+	*x = SaveRepoSnapshotRequest{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRepoSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRepoSnapshotRequest) ProtoMessage() {}
+
+func (x *SaveRepoSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRepoSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SaveRepoSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveRepoSnapshotRequest) GetSnapshot() *RepoSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type SaveRepoSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveRepoSnapshotResponse) Reset() {
+	*x = SaveRepoSnapshotResponse{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveRepoSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveRepoSnapshotResponse) ProtoMessage() {}
+
+func (x *SaveRepoSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveRepoSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SaveRepoSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveRepoSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetRepoSnapshot RPC messages
+type GetRepoSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoSnapshotRequest) Reset() {
+	*x = GetRepoSnapshotRequest{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoSnapshotRequest) ProtoMessage() {}
+
+func (x *GetRepoSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRepoSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetRepoSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *RepoSnapshot          `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoSnapshotResponse) Reset() {
+	*x = GetRepoSnapshotResponse{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoSnapshotResponse) ProtoMessage() {}
+
+func (x *GetRepoSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetRepoSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRepoSnapshotResponse) GetSnapshot() *RepoSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// ListRepoSnapshots RPC messages
+type ListRepoSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoUrl       string                 `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"` // Empty lists snapshots of all repositories
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepoSnapshotsRequest) Reset() {
+	*x = ListRepoSnapshotsRequest{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepoSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepoSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListRepoSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepoSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListRepoSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRepoSnapshotsRequest) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+type ListRepoSnapshotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*RepoSnapshot        `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRepoSnapshotsResponse) Reset() {
+	*x = ListRepoSnapshotsResponse{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRepoSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRepoSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListRepoSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRepoSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListRepoSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListRepoSnapshotsResponse) GetSnapshots() []*RepoSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// DeleteRepoSnapshot RPC messages
+type DeleteRepoSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepoSnapshotRequest) Reset() {
+	*x = DeleteRepoSnapshotRequest{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepoSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoSnapshotRequest) ProtoMessage() {}
+
+func (x *DeleteRepoSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepoSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteRepoSnapshotRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteRepoSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRepoSnapshotResponse) Reset() {
+	*x = DeleteRepoSnapshotResponse{}
+	mi := &file_v1_repo_snapshot_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRepoSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoSnapshotResponse) ProtoMessage() {}
+
+func (x *DeleteRepoSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repo_snapshot_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRepoSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repo_snapshot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteRepoSnapshotResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_repo_snapshot_proto protoreflect.FileDescriptor
+
+const file_v1_repo_snapshot_proto_rawDesc = "" +
+	"\n" +
+	"\x16v1/repo_snapshot.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe4\x01\n" +
+	"\fRepoSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\brepo_url\x18\x02 \x01(\tR\arepoUrl\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04head\x18\x06 \x01(\tR\x04head\x12\x14\n" +
+	"\x05stash\x18\a \x01(\tR\x05stash\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\x17SaveRepoSnapshotRequest\x122\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x16.clonr.v1.RepoSnapshotR\bsnapshot\"4\n" +
+	"\x18SaveRepoSnapshotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
+	"\x16GetRepoSnapshotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"M\n" +
+	"\x17GetRepoSnapshotResponse\x122\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x16.clonr.v1.RepoSnapshotR\bsnapshot\"5\n" +
+	"\x18ListRepoSnapshotsRequest\x12\x19\n" +
+	"\brepo_url\x18\x01 \x01(\tR\arepoUrl\"Q\n" +
+	"\x19ListRepoSnapshotsResponse\x124\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x16.clonr.v1.RepoSnapshotR\tsnapshots\"+\n" +
+	"\x19DeleteRepoSnapshotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x1aDeleteRepoSnapshotResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x94\x01\n" +
+	"\fcom.clonr.v1B\x11RepoSnapshotProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_repo_snapshot_proto_rawDescOnce sync.Once
+	file_v1_repo_snapshot_proto_rawDescData []byte
+)
+
+func file_v1_repo_snapshot_proto_rawDescGZIP() []byte {
+	file_v1_repo_snapshot_proto_rawDescOnce.Do(func() {
+		file_v1_repo_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_repo_snapshot_proto_rawDesc), len(file_v1_repo_snapshot_proto_rawDesc)))
+	})
+	return file_v1_repo_snapshot_proto_rawDescData
+}
+
+var file_v1_repo_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_repo_snapshot_proto_goTypes = []any{
+	(*RepoSnapshot)(nil),               // 0: clonr.v1.RepoSnapshot
+	(*SaveRepoSnapshotRequest)(nil),    // 1: clonr.v1.SaveRepoSnapshotRequest
+	(*SaveRepoSnapshotResponse)(nil),   // 2: clonr.v1.SaveRepoSnapshotResponse
+	(*GetRepoSnapshotRequest)(nil),     // 3: clonr.v1.GetRepoSnapshotRequest
+	(*GetRepoSnapshotResponse)(nil),    // 4: clonr.v1.GetRepoSnapshotResponse
+	(*ListRepoSnapshotsRequest)(nil),   // 5: clonr.v1.ListRepoSnapshotsRequest
+	(*ListRepoSnapshotsResponse)(nil),  // 6: clonr.v1.ListRepoSnapshotsResponse
+	(*DeleteRepoSnapshotRequest)(nil),  // 7: clonr.v1.DeleteRepoSnapshotRequest
+	(*DeleteRepoSnapshotResponse)(nil), // 8: clonr.v1.DeleteRepoSnapshotResponse
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+}
+var file_v1_repo_snapshot_proto_depIdxs = []int32{
+	9, // 0: clonr.v1.RepoSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: clonr.v1.SaveRepoSnapshotRequest.snapshot:type_name -> clonr.v1.RepoSnapshot
+	0, // 2: clonr.v1.GetRepoSnapshotResponse.snapshot:type_name -> clonr.v1.RepoSnapshot
+	0, // 3: clonr.v1.ListRepoSnapshotsResponse.snapshots:type_name -> clonr.v1.RepoSnapshot
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_v1_repo_snapshot_proto_init() }
+func file_v1_repo_snapshot_proto_init() {
+	if File_v1_repo_snapshot_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_repo_snapshot_proto_rawDesc), len(file_v1_repo_snapshot_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_repo_snapshot_proto_goTypes,
+		DependencyIndexes: file_v1_repo_snapshot_proto_depIdxs,
+		MessageInfos:      file_v1_repo_snapshot_proto_msgTypes,
+	}.Build()
+	File_v1_repo_snapshot_proto = out.File
+	file_v1_repo_snapshot_proto_goTypes = nil
+	file_v1_repo_snapshot_proto_depIdxs = nil
+}
@@ -34,6 +34,15 @@ type Repository struct {
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	LastChecked   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
 	Workspace     string                 `protobuf:"bytes,9,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Kind          string                 `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`                                     // source, fork, mirror, archive, template; empty = not classified
+	UpstreamUrl   string                 `protobuf:"bytes,11,opt,name=upstream_url,json=upstreamUrl,proto3" json:"upstream_url,omitempty"`    // repository a fork was created from
+	Remotes       []*RepoRemote          `protobuf:"bytes,12,rep,name=remotes,proto3" json:"remotes,omitempty"`                               // git remotes other than the primary URL
+	License       string                 `protobuf:"bytes,13,opt,name=license,proto3" json:"license,omitempty"`                               // SPDX identifier, none or other; empty = not scanned
+	Tags          []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`                                     // free-form labels, sorted
+	Notes         string                 `protobuf:"bytes,15,opt,name=notes,proto3" json:"notes,omitempty"`                                   // free text kept with the repository
+	Alias         string                 `protobuf:"bytes,16,opt,name=alias,proto3" json:"alias,omitempty"`                                   // unique short name accepted instead of the URL
+	AccessCount   int32                  `protobuf:"varint,17,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`   // times the repository was opened or updated
+	LastAccessed  *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_accessed,json=lastAccessed,proto3" json:"last_accessed,omitempty"` // zero until first accessed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,6 +140,247 @@ func (x *Repository) GetWorkspace() string {
 	return ""
 }
 
+func (x *Repository) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Repository) GetUpstreamUrl() string {
+	if x != nil {
+		return x.UpstreamUrl
+	}
+	return ""
+}
+
+func (x *Repository) GetRemotes() []*RepoRemote {
+	if x != nil {
+		return x.Remotes
+	}
+	return nil
+}
+
+func (x *Repository) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *Repository) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Repository) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Repository) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *Repository) GetAccessCount() int32 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+func (x *Repository) GetLastAccessed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessed
+	}
+	return nil
+}
+
+// RepoRemote is a git remote of a repository
+type RepoRemote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoRemote) Reset() {
+	*x = RepoRemote{}
+	mi := &file_v1_repository_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoRemote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoRemote) ProtoMessage() {}
+
+func (x *RepoRemote) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoRemote.ProtoReflect.Descriptor instead.
+func (*RepoRemote) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepoRemote) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RepoRemote) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// RepoDetail is a repository with the state of its clone
+type RepoDetail struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Repository        *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Branch            string                 `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`                           // HEAD when detached
+	LastCommit        string                 `protobuf:"bytes,3,opt,name=last_commit,json=lastCommit,proto3" json:"last_commit,omitempty"` // short hash; empty without commits
+	LastCommitSubject string                 `protobuf:"bytes,4,opt,name=last_commit_subject,json=lastCommitSubject,proto3" json:"last_commit_subject,omitempty"`
+	LastCommitAuthor  string                 `protobuf:"bytes,5,opt,name=last_commit_author,json=lastCommitAuthor,proto3" json:"last_commit_author,omitempty"`
+	LastCommitAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_commit_at,json=lastCommitAt,proto3" json:"last_commit_at,omitempty"`
+	LatestTag         string                 `protobuf:"bytes,7,opt,name=latest_tag,json=latestTag,proto3" json:"latest_tag,omitempty"`
+	HasUpstream       bool                   `protobuf:"varint,8,opt,name=has_upstream,json=hasUpstream,proto3" json:"has_upstream,omitempty"`
+	Ahead             int32                  `protobuf:"varint,9,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	Behind            int32                  `protobuf:"varint,10,opt,name=behind,proto3" json:"behind,omitempty"`
+	GitError          string                 `protobuf:"bytes,11,opt,name=git_error,json=gitError,proto3" json:"git_error,omitempty"` // why the clone could not be read
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RepoDetail) Reset() {
+	*x = RepoDetail{}
+	mi := &file_v1_repository_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoDetail) ProtoMessage() {}
+
+func (x *RepoDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoDetail.ProtoReflect.Descriptor instead.
+func (*RepoDetail) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepoDetail) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *RepoDetail) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RepoDetail) GetLastCommit() string {
+	if x != nil {
+		return x.LastCommit
+	}
+	return ""
+}
+
+func (x *RepoDetail) GetLastCommitSubject() string {
+	if x != nil {
+		return x.LastCommitSubject
+	}
+	return ""
+}
+
+func (x *RepoDetail) GetLastCommitAuthor() string {
+	if x != nil {
+		return x.LastCommitAuthor
+	}
+	return ""
+}
+
+func (x *RepoDetail) GetLastCommitAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCommitAt
+	}
+	return nil
+}
+
+func (x *RepoDetail) GetLatestTag() string {
+	if x != nil {
+		return x.LatestTag
+	}
+	return ""
+}
+
+func (x *RepoDetail) GetHasUpstream() bool {
+	if x != nil {
+		return x.HasUpstream
+	}
+	return false
+}
+
+func (x *RepoDetail) GetAhead() int32 {
+	if x != nil {
+		return x.Ahead
+	}
+	return 0
+}
+
+func (x *RepoDetail) GetBehind() int32 {
+	if x != nil {
+		return x.Behind
+	}
+	return 0
+}
+
+func (x *RepoDetail) GetGitError() string {
+	if x != nil {
+		return x.GitError
+	}
+	return ""
+}
+
 // SaveRepo RPC messages
 type SaveRepoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -143,7 +393,7 @@ type SaveRepoRequest struct {
 
 func (x *SaveRepoRequest) Reset() {
 	*x = SaveRepoRequest{}
-	mi := &file_v1_repository_proto_msgTypes[1]
+	mi := &file_v1_repository_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -155,7 +405,7 @@ func (x *SaveRepoRequest) String() string {
 func (*SaveRepoRequest) ProtoMessage() {}
 
 func (x *SaveRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[1]
+	mi := &file_v1_repository_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,7 +418,7 @@ func (x *SaveRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveRepoRequest.ProtoReflect.Descriptor instead.
 func (*SaveRepoRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{1}
+	return file_v1_repository_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SaveRepoRequest) GetUrl() string {
@@ -201,7 +451,7 @@ type SaveRepoResponse struct {
 
 func (x *SaveRepoResponse) Reset() {
 	*x = SaveRepoResponse{}
-	mi := &file_v1_repository_proto_msgTypes[2]
+	mi := &file_v1_repository_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +463,7 @@ func (x *SaveRepoResponse) String() string {
 func (*SaveRepoResponse) ProtoMessage() {}
 
 func (x *SaveRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[2]
+	mi := &file_v1_repository_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +476,7 @@ func (x *SaveRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveRepoResponse.ProtoReflect.Descriptor instead.
 func (*SaveRepoResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{2}
+	return file_v1_repository_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SaveRepoResponse) GetSuccess() bool {
@@ -246,7 +496,7 @@ type RepoExistsByURLRequest struct {
 
 func (x *RepoExistsByURLRequest) Reset() {
 	*x = RepoExistsByURLRequest{}
-	mi := &file_v1_repository_proto_msgTypes[3]
+	mi := &file_v1_repository_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +508,7 @@ func (x *RepoExistsByURLRequest) String() string {
 func (*RepoExistsByURLRequest) ProtoMessage() {}
 
 func (x *RepoExistsByURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[3]
+	mi := &file_v1_repository_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +521,7 @@ func (x *RepoExistsByURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoExistsByURLRequest.ProtoReflect.Descriptor instead.
 func (*RepoExistsByURLRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{3}
+	return file_v1_repository_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RepoExistsByURLRequest) GetUrl() string {
@@ -290,7 +540,7 @@ type RepoExistsByURLResponse struct {
 
 func (x *RepoExistsByURLResponse) Reset() {
 	*x = RepoExistsByURLResponse{}
-	mi := &file_v1_repository_proto_msgTypes[4]
+	mi := &file_v1_repository_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +552,7 @@ func (x *RepoExistsByURLResponse) String() string {
 func (*RepoExistsByURLResponse) ProtoMessage() {}
 
 func (x *RepoExistsByURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[4]
+	mi := &file_v1_repository_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +565,7 @@ func (x *RepoExistsByURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoExistsByURLResponse.ProtoReflect.Descriptor instead.
 func (*RepoExistsByURLResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{4}
+	return file_v1_repository_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RepoExistsByURLResponse) GetExists() bool {
@@ -335,7 +585,7 @@ type RepoExistsByPathRequest struct {
 
 func (x *RepoExistsByPathRequest) Reset() {
 	*x = RepoExistsByPathRequest{}
-	mi := &file_v1_repository_proto_msgTypes[5]
+	mi := &file_v1_repository_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +597,7 @@ func (x *RepoExistsByPathRequest) String() string {
 func (*RepoExistsByPathRequest) ProtoMessage() {}
 
 func (x *RepoExistsByPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[5]
+	mi := &file_v1_repository_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +610,7 @@ func (x *RepoExistsByPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoExistsByPathRequest.ProtoReflect.Descriptor instead.
 func (*RepoExistsByPathRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{5}
+	return file_v1_repository_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RepoExistsByPathRequest) GetPath() string {
@@ -379,7 +629,7 @@ type RepoExistsByPathResponse struct {
 
 func (x *RepoExistsByPathResponse) Reset() {
 	*x = RepoExistsByPathResponse{}
-	mi := &file_v1_repository_proto_msgTypes[6]
+	mi := &file_v1_repository_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +641,7 @@ func (x *RepoExistsByPathResponse) String() string {
 func (*RepoExistsByPathResponse) ProtoMessage() {}
 
 func (x *RepoExistsByPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[6]
+	mi := &file_v1_repository_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +654,7 @@ func (x *RepoExistsByPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoExistsByPathResponse.ProtoReflect.Descriptor instead.
 func (*RepoExistsByPathResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{6}
+	return file_v1_repository_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RepoExistsByPathResponse) GetExists() bool {
@@ -425,7 +675,7 @@ type InsertRepoIfNotExistsRequest struct {
 
 func (x *InsertRepoIfNotExistsRequest) Reset() {
 	*x = InsertRepoIfNotExistsRequest{}
-	mi := &file_v1_repository_proto_msgTypes[7]
+	mi := &file_v1_repository_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +687,7 @@ func (x *InsertRepoIfNotExistsRequest) String() string {
 func (*InsertRepoIfNotExistsRequest) ProtoMessage() {}
 
 func (x *InsertRepoIfNotExistsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[7]
+	mi := &file_v1_repository_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +700,7 @@ func (x *InsertRepoIfNotExistsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertRepoIfNotExistsRequest.ProtoReflect.Descriptor instead.
 func (*InsertRepoIfNotExistsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{7}
+	return file_v1_repository_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InsertRepoIfNotExistsRequest) GetUrl() string {
@@ -476,7 +726,7 @@ type InsertRepoIfNotExistsResponse struct {
 
 func (x *InsertRepoIfNotExistsResponse) Reset() {
 	*x = InsertRepoIfNotExistsResponse{}
-	mi := &file_v1_repository_proto_msgTypes[8]
+	mi := &file_v1_repository_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -488,7 +738,7 @@ func (x *InsertRepoIfNotExistsResponse) String() string {
 func (*InsertRepoIfNotExistsResponse) ProtoMessage() {}
 
 func (x *InsertRepoIfNotExistsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[8]
+	mi := &file_v1_repository_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +751,7 @@ func (x *InsertRepoIfNotExistsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InsertRepoIfNotExistsResponse.ProtoReflect.Descriptor instead.
 func (*InsertRepoIfNotExistsResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{8}
+	return file_v1_repository_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InsertRepoIfNotExistsResponse) GetInserted() bool {
@@ -520,7 +770,7 @@ type GetAllReposRequest struct {
 
 func (x *GetAllReposRequest) Reset() {
 	*x = GetAllReposRequest{}
-	mi := &file_v1_repository_proto_msgTypes[9]
+	mi := &file_v1_repository_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -532,7 +782,7 @@ func (x *GetAllReposRequest) String() string {
 func (*GetAllReposRequest) ProtoMessage() {}
 
 func (x *GetAllReposRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[9]
+	mi := &file_v1_repository_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +795,7 @@ func (x *GetAllReposRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllReposRequest.ProtoReflect.Descriptor instead.
 func (*GetAllReposRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{9}
+	return file_v1_repository_proto_rawDescGZIP(), []int{11}
 }
 
 type GetAllReposResponse struct {
@@ -557,7 +807,7 @@ type GetAllReposResponse struct {
 
 func (x *GetAllReposResponse) Reset() {
 	*x = GetAllReposResponse{}
-	mi := &file_v1_repository_proto_msgTypes[10]
+	mi := &file_v1_repository_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +819,7 @@ func (x *GetAllReposResponse) String() string {
 func (*GetAllReposResponse) ProtoMessage() {}
 
 func (x *GetAllReposResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[10]
+	mi := &file_v1_repository_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +832,7 @@ func (x *GetAllReposResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllReposResponse.ProtoReflect.Descriptor instead.
 func (*GetAllReposResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{10}
+	return file_v1_repository_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetAllReposResponse) GetRepositories() []*Repository {
@@ -603,7 +853,7 @@ type GetReposRequest struct {
 
 func (x *GetReposRequest) Reset() {
 	*x = GetReposRequest{}
-	mi := &file_v1_repository_proto_msgTypes[11]
+	mi := &file_v1_repository_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +865,7 @@ func (x *GetReposRequest) String() string {
 func (*GetReposRequest) ProtoMessage() {}
 
 func (x *GetReposRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[11]
+	mi := &file_v1_repository_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +878,7 @@ func (x *GetReposRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReposRequest.ProtoReflect.Descriptor instead.
 func (*GetReposRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{11}
+	return file_v1_repository_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetReposRequest) GetFavoritesOnly() bool {
@@ -654,7 +904,7 @@ type GetReposResponse struct {
 
 func (x *GetReposResponse) Reset() {
 	*x = GetReposResponse{}
-	mi := &file_v1_repository_proto_msgTypes[12]
+	mi := &file_v1_repository_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +916,7 @@ func (x *GetReposResponse) String() string {
 func (*GetReposResponse) ProtoMessage() {}
 
 func (x *GetReposResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[12]
+	mi := &file_v1_repository_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +929,7 @@ func (x *GetReposResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReposResponse.ProtoReflect.Descriptor instead.
 func (*GetReposResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{12}
+	return file_v1_repository_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetReposResponse) GetRepositories() []*Repository {
@@ -689,30 +939,34 @@ func (x *GetReposResponse) GetRepositories() []*Repository {
 	return nil
 }
 
-// SetFavorite RPC messages
-type SetFavoriteRequest struct {
+// ListRepos RPC messages
+type ListReposRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Favorite      bool                   `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default 100, max 1000
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from the previous response
+	FavoritesOnly bool                   `protobuf:"varint,3,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`
+	Workspace     string                 `protobuf:"bytes,4,opt,name=workspace,proto3" json:"workspace,omitempty"` // empty = all workspaces
+	Query         string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`         // case-insensitive substring of URL or path
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`           // empty = all kinds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetFavoriteRequest) Reset() {
-	*x = SetFavoriteRequest{}
-	mi := &file_v1_repository_proto_msgTypes[13]
+func (x *ListReposRequest) Reset() {
+	*x = ListReposRequest{}
+	mi := &file_v1_repository_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetFavoriteRequest) String() string {
+func (x *ListReposRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFavoriteRequest) ProtoMessage() {}
+func (*ListReposRequest) ProtoMessage() {}
 
-func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[13]
+func (x *ListReposRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,47 +977,1134 @@ func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFavoriteRequest.ProtoReflect.Descriptor instead.
-func (*SetFavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListReposRequest.ProtoReflect.Descriptor instead.
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SetFavoriteRequest) GetUrl() string {
+func (x *ListReposRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Url
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *SetFavoriteRequest) GetFavorite() bool {
+func (x *ListReposRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListReposRequest) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *ListReposRequest) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *ListReposRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListReposRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type ListReposResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repositories  []*Repository          `protobuf:"bytes,1,rep,name=repositories,proto3" json:"repositories,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // number of repositories matching the filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReposResponse) Reset() {
+	*x = ListReposResponse{}
+	mi := &file_v1_repository_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReposResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReposResponse) ProtoMessage() {}
+
+func (x *ListReposResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReposResponse.ProtoReflect.Descriptor instead.
+func (*ListReposResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListReposResponse) GetRepositories() []*Repository {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+func (x *ListReposResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListReposResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// SetFavorite RPC messages
+type SetFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Favorite      bool                   `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavoriteRequest) Reset() {
+	*x = SetFavoriteRequest{}
+	mi := &file_v1_repository_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteRequest) ProtoMessage() {}
+
+func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*SetFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetFavoriteRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetFavoriteRequest) GetFavorite() bool {
 	if x != nil {
 		return x.Favorite
 	}
-	return false
+	return false
+}
+
+type SetFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFavoriteResponse) Reset() {
+	*x = SetFavoriteResponse{}
+	mi := &file_v1_repository_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteResponse) ProtoMessage() {}
+
+func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*SetFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoKind RPC messages
+type SetRepoKindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // empty clears the classification
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoKindRequest) Reset() {
+	*x = SetRepoKindRequest{}
+	mi := &file_v1_repository_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoKindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoKindRequest) ProtoMessage() {}
+
+func (x *SetRepoKindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoKindRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoKindRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetRepoKindRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoKindRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type SetRepoKindResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoKindResponse) Reset() {
+	*x = SetRepoKindResponse{}
+	mi := &file_v1_repository_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoKindResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoKindResponse) ProtoMessage() {}
+
+func (x *SetRepoKindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoKindResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoKindResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetRepoKindResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoUpstream RPC messages
+type SetRepoUpstreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	UpstreamUrl   string                 `protobuf:"bytes,2,opt,name=upstream_url,json=upstreamUrl,proto3" json:"upstream_url,omitempty"` // empty clears the upstream
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoUpstreamRequest) Reset() {
+	*x = SetRepoUpstreamRequest{}
+	mi := &file_v1_repository_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoUpstreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoUpstreamRequest) ProtoMessage() {}
+
+func (x *SetRepoUpstreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoUpstreamRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoUpstreamRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetRepoUpstreamRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoUpstreamRequest) GetUpstreamUrl() string {
+	if x != nil {
+		return x.UpstreamUrl
+	}
+	return ""
+}
+
+type SetRepoUpstreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoUpstreamResponse) Reset() {
+	*x = SetRepoUpstreamResponse{}
+	mi := &file_v1_repository_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoUpstreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoUpstreamResponse) ProtoMessage() {}
+
+func (x *SetRepoUpstreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoUpstreamResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoUpstreamResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetRepoUpstreamResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoLicense RPC messages
+type SetRepoLicenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	License       string                 `protobuf:"bytes,2,opt,name=license,proto3" json:"license,omitempty"` // empty clears the license
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoLicenseRequest) Reset() {
+	*x = SetRepoLicenseRequest{}
+	mi := &file_v1_repository_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoLicenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoLicenseRequest) ProtoMessage() {}
+
+func (x *SetRepoLicenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoLicenseRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoLicenseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetRepoLicenseRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoLicenseRequest) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+type SetRepoLicenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoLicenseResponse) Reset() {
+	*x = SetRepoLicenseResponse{}
+	mi := &file_v1_repository_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoLicenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoLicenseResponse) ProtoMessage() {}
+
+func (x *SetRepoLicenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoLicenseResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoLicenseResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetRepoLicenseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoTags RPC messages
+type SetRepoTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Tags          []string               `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"` // replaces the current tags; empty clears them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoTagsRequest) Reset() {
+	*x = SetRepoTagsRequest{}
+	mi := &file_v1_repository_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoTagsRequest) ProtoMessage() {}
+
+func (x *SetRepoTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoTagsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetRepoTagsRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetRepoTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoTagsResponse) Reset() {
+	*x = SetRepoTagsResponse{}
+	mi := &file_v1_repository_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoTagsResponse) ProtoMessage() {}
+
+func (x *SetRepoTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoTagsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetRepoTagsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoNotes RPC messages
+type SetRepoNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"` // empty clears the notes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoNotesRequest) Reset() {
+	*x = SetRepoNotesRequest{}
+	mi := &file_v1_repository_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoNotesRequest) ProtoMessage() {}
+
+func (x *SetRepoNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoNotesRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoNotesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetRepoNotesRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoNotesRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type SetRepoNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoNotesResponse) Reset() {
+	*x = SetRepoNotesResponse{}
+	mi := &file_v1_repository_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoNotesResponse) ProtoMessage() {}
+
+func (x *SetRepoNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoNotesResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoNotesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetRepoNotesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoAlias RPC messages
+type SetRepoAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Alias         string                 `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"` // empty removes the alias
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoAliasRequest) Reset() {
+	*x = SetRepoAliasRequest{}
+	mi := &file_v1_repository_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoAliasRequest) ProtoMessage() {}
+
+func (x *SetRepoAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoAliasRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoAliasRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetRepoAliasRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoAliasRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type SetRepoAliasResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoAliasResponse) Reset() {
+	*x = SetRepoAliasResponse{}
+	mi := &file_v1_repository_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoAliasResponse) ProtoMessage() {}
+
+func (x *SetRepoAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoAliasResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoAliasResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetRepoAliasResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RecordRepoAccess RPC messages
+type RecordRepoAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRepoAccessRequest) Reset() {
+	*x = RecordRepoAccessRequest{}
+	mi := &file_v1_repository_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRepoAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRepoAccessRequest) ProtoMessage() {}
+
+func (x *RecordRepoAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRepoAccessRequest.ProtoReflect.Descriptor instead.
+func (*RecordRepoAccessRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RecordRepoAccessRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type RecordRepoAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordRepoAccessResponse) Reset() {
+	*x = RecordRepoAccessResponse{}
+	mi := &file_v1_repository_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRepoAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRepoAccessResponse) ProtoMessage() {}
+
+func (x *RecordRepoAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRepoAccessResponse.ProtoReflect.Descriptor instead.
+func (*RecordRepoAccessResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RecordRepoAccessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetRepoRemotes RPC messages
+type SetRepoRemotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Remotes       []*RepoRemote          `protobuf:"bytes,2,rep,name=remotes,proto3" json:"remotes,omitempty"` // replaces all recorded remotes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoRemotesRequest) Reset() {
+	*x = SetRepoRemotesRequest{}
+	mi := &file_v1_repository_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoRemotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoRemotesRequest) ProtoMessage() {}
+
+func (x *SetRepoRemotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoRemotesRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoRemotesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetRepoRemotesRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SetRepoRemotesRequest) GetRemotes() []*RepoRemote {
+	if x != nil {
+		return x.Remotes
+	}
+	return nil
+}
+
+type SetRepoRemotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoRemotesResponse) Reset() {
+	*x = SetRepoRemotesResponse{}
+	mi := &file_v1_repository_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoRemotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoRemotesResponse) ProtoMessage() {}
+
+func (x *SetRepoRemotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoRemotesResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoRemotesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetRepoRemotesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetRepoDetail RPC messages
+type GetRepoDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoDetailRequest) Reset() {
+	*x = GetRepoDetailRequest{}
+	mi := &file_v1_repository_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoDetailRequest) ProtoMessage() {}
+
+func (x *GetRepoDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoDetailRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetRepoDetailRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetRepoDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Detail        *RepoDetail            `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoDetailResponse) Reset() {
+	*x = GetRepoDetailResponse{}
+	mi := &file_v1_repository_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoDetailResponse) ProtoMessage() {}
+
+func (x *GetRepoDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetRepoDetailResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetRepoDetailResponse) GetDetail() *RepoDetail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+// GetRepoByRemoteURL RPC messages
+type GetRepoByRemoteURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoByRemoteURLRequest) Reset() {
+	*x = GetRepoByRemoteURLRequest{}
+	mi := &file_v1_repository_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoByRemoteURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoByRemoteURLRequest) ProtoMessage() {}
+
+func (x *GetRepoByRemoteURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type SetFavoriteResponse struct {
+// Deprecated: Use GetRepoByRemoteURLRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoByRemoteURLRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRepoByRemoteURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetRepoByRemoteURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"` // unset when no repository has the remote
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetFavoriteResponse) Reset() {
-	*x = SetFavoriteResponse{}
-	mi := &file_v1_repository_proto_msgTypes[14]
+func (x *GetRepoByRemoteURLResponse) Reset() {
+	*x = GetRepoByRemoteURLResponse{}
+	mi := &file_v1_repository_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetFavoriteResponse) String() string {
+func (x *GetRepoByRemoteURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetFavoriteResponse) ProtoMessage() {}
+func (*GetRepoByRemoteURLResponse) ProtoMessage() {}
 
-func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[14]
+func (x *GetRepoByRemoteURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,16 +2115,16 @@ func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetFavoriteResponse.ProtoReflect.Descriptor instead.
-func (*SetFavoriteResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetRepoByRemoteURLResponse.ProtoReflect.Descriptor instead.
+func (*GetRepoByRemoteURLResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SetFavoriteResponse) GetSuccess() bool {
+func (x *GetRepoByRemoteURLResponse) GetRepository() *Repository {
 	if x != nil {
-		return x.Success
+		return x.Repository
 	}
-	return false
+	return nil
 }
 
 // UpdateRepoTimestamp RPC messages
@@ -796,7 +2137,7 @@ type UpdateRepoTimestampRequest struct {
 
 func (x *UpdateRepoTimestampRequest) Reset() {
 	*x = UpdateRepoTimestampRequest{}
-	mi := &file_v1_repository_proto_msgTypes[15]
+	mi := &file_v1_repository_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +2149,7 @@ func (x *UpdateRepoTimestampRequest) String() string {
 func (*UpdateRepoTimestampRequest) ProtoMessage() {}
 
 func (x *UpdateRepoTimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[15]
+	mi := &file_v1_repository_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +2162,7 @@ func (x *UpdateRepoTimestampRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRepoTimestampRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRepoTimestampRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{15}
+	return file_v1_repository_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *UpdateRepoTimestampRequest) GetUrl() string {
@@ -840,7 +2181,7 @@ type UpdateRepoTimestampResponse struct {
 
 func (x *UpdateRepoTimestampResponse) Reset() {
 	*x = UpdateRepoTimestampResponse{}
-	mi := &file_v1_repository_proto_msgTypes[16]
+	mi := &file_v1_repository_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -852,7 +2193,7 @@ func (x *UpdateRepoTimestampResponse) String() string {
 func (*UpdateRepoTimestampResponse) ProtoMessage() {}
 
 func (x *UpdateRepoTimestampResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[16]
+	mi := &file_v1_repository_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +2206,7 @@ func (x *UpdateRepoTimestampResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRepoTimestampResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRepoTimestampResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{16}
+	return file_v1_repository_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpdateRepoTimestampResponse) GetSuccess() bool {
@@ -885,7 +2226,7 @@ type RemoveRepoByURLRequest struct {
 
 func (x *RemoveRepoByURLRequest) Reset() {
 	*x = RemoveRepoByURLRequest{}
-	mi := &file_v1_repository_proto_msgTypes[17]
+	mi := &file_v1_repository_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +2238,7 @@ func (x *RemoveRepoByURLRequest) String() string {
 func (*RemoveRepoByURLRequest) ProtoMessage() {}
 
 func (x *RemoveRepoByURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[17]
+	mi := &file_v1_repository_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +2251,7 @@ func (x *RemoveRepoByURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRepoByURLRequest.ProtoReflect.Descriptor instead.
 func (*RemoveRepoByURLRequest) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{17}
+	return file_v1_repository_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *RemoveRepoByURLRequest) GetUrl() string {
@@ -929,7 +2270,7 @@ type RemoveRepoByURLResponse struct {
 
 func (x *RemoveRepoByURLResponse) Reset() {
 	*x = RemoveRepoByURLResponse{}
-	mi := &file_v1_repository_proto_msgTypes[18]
+	mi := &file_v1_repository_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +2282,7 @@ func (x *RemoveRepoByURLResponse) String() string {
 func (*RemoveRepoByURLResponse) ProtoMessage() {}
 
 func (x *RemoveRepoByURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_repository_proto_msgTypes[18]
+	mi := &file_v1_repository_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +2295,7 @@ func (x *RemoveRepoByURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveRepoByURLResponse.ProtoReflect.Descriptor instead.
 func (*RemoveRepoByURLResponse) Descriptor() ([]byte, []int) {
-	return file_v1_repository_proto_rawDescGZIP(), []int{18}
+	return file_v1_repository_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RemoveRepoByURLResponse) GetSuccess() bool {
@@ -964,11 +2305,303 @@ func (x *RemoveRepoByURLResponse) GetSuccess() bool {
 	return false
 }
 
+// UpdateRepoPath RPC messages
+type UpdateRepoPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRepoPathRequest) Reset() {
+	*x = UpdateRepoPathRequest{}
+	mi := &file_v1_repository_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRepoPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepoPathRequest) ProtoMessage() {}
+
+func (x *UpdateRepoPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepoPathRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRepoPathRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateRepoPathRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateRepoPathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UpdateRepoPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRepoPathResponse) Reset() {
+	*x = UpdateRepoPathResponse{}
+	mi := &file_v1_repository_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRepoPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepoPathResponse) ProtoMessage() {}
+
+func (x *UpdateRepoPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepoPathResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRepoPathResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateRepoPathResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// UpdateRepoURL RPC messages
+type UpdateRepoURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldUrl        string                 `protobuf:"bytes,1,opt,name=old_url,json=oldUrl,proto3" json:"old_url,omitempty"`
+	NewUrl        string                 `protobuf:"bytes,2,opt,name=new_url,json=newUrl,proto3" json:"new_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRepoURLRequest) Reset() {
+	*x = UpdateRepoURLRequest{}
+	mi := &file_v1_repository_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRepoURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepoURLRequest) ProtoMessage() {}
+
+func (x *UpdateRepoURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepoURLRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRepoURLRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateRepoURLRequest) GetOldUrl() string {
+	if x != nil {
+		return x.OldUrl
+	}
+	return ""
+}
+
+func (x *UpdateRepoURLRequest) GetNewUrl() string {
+	if x != nil {
+		return x.NewUrl
+	}
+	return ""
+}
+
+type UpdateRepoURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRepoURLResponse) Reset() {
+	*x = UpdateRepoURLResponse{}
+	mi := &file_v1_repository_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRepoURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRepoURLResponse) ProtoMessage() {}
+
+func (x *UpdateRepoURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRepoURLResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRepoURLResponse) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateRepoURLResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// WatchRepoEvents RPC messages
+type WatchRepoEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRepoEventsRequest) Reset() {
+	*x = WatchRepoEventsRequest{}
+	mi := &file_v1_repository_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRepoEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRepoEventsRequest) ProtoMessage() {}
+
+func (x *WatchRepoEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRepoEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchRepoEventsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{47}
+}
+
+// RepoEvent describes a change to a tracked repository
+type RepoEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // added, removed, updated
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoEvent) Reset() {
+	*x = RepoEvent{}
+	mi := &file_v1_repository_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoEvent) ProtoMessage() {}
+
+func (x *RepoEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_repository_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoEvent.ProtoReflect.Descriptor instead.
+func (*RepoEvent) Descriptor() ([]byte, []int) {
+	return file_v1_repository_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RepoEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RepoEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
 var File_v1_repository_proto protoreflect.FileDescriptor
 
 const file_v1_repository_proto_rawDesc = "" +
 	"\n" +
-	"\x13v1/repository.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x02\n" +
+	"\x13v1/repository.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x04\n" +
 	"\n" +
 	"Repository\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
@@ -980,7 +2613,39 @@ const file_v1_repository_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
 	"\flast_checked\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastChecked\x12\x1c\n" +
-	"\tworkspace\x18\t \x01(\tR\tworkspace\"U\n" +
+	"\tworkspace\x18\t \x01(\tR\tworkspace\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x12!\n" +
+	"\fupstream_url\x18\v \x01(\tR\vupstreamUrl\x12.\n" +
+	"\aremotes\x18\f \x03(\v2\x14.clonr.v1.RepoRemoteR\aremotes\x12\x18\n" +
+	"\alicense\x18\r \x01(\tR\alicense\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12\x14\n" +
+	"\x05notes\x18\x0f \x01(\tR\x05notes\x12\x14\n" +
+	"\x05alias\x18\x10 \x01(\tR\x05alias\x12!\n" +
+	"\faccess_count\x18\x11 \x01(\x05R\vaccessCount\x12?\n" +
+	"\rlast_accessed\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\flastAccessed\"2\n" +
+	"\n" +
+	"RepoRemote\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\xa8\x03\n" +
+	"\n" +
+	"RepoDetail\x124\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2\x14.clonr.v1.RepositoryR\n" +
+	"repository\x12\x16\n" +
+	"\x06branch\x18\x02 \x01(\tR\x06branch\x12\x1f\n" +
+	"\vlast_commit\x18\x03 \x01(\tR\n" +
+	"lastCommit\x12.\n" +
+	"\x13last_commit_subject\x18\x04 \x01(\tR\x11lastCommitSubject\x12,\n" +
+	"\x12last_commit_author\x18\x05 \x01(\tR\x10lastCommitAuthor\x12@\n" +
+	"\x0elast_commit_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastCommitAt\x12\x1d\n" +
+	"\n" +
+	"latest_tag\x18\a \x01(\tR\tlatestTag\x12!\n" +
+	"\fhas_upstream\x18\b \x01(\bR\vhasUpstream\x12\x14\n" +
+	"\x05ahead\x18\t \x01(\x05R\x05ahead\x12\x16\n" +
+	"\x06behind\x18\n" +
+	" \x01(\x05R\x06behind\x12\x1b\n" +
+	"\tgit_error\x18\v \x01(\tR\bgitError\"U\n" +
 	"\x0fSaveRepoRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
@@ -1007,12 +2672,74 @@ const file_v1_repository_proto_rawDesc = "" +
 	"\x0efavorites_only\x18\x01 \x01(\bR\rfavoritesOnly\x12\x1c\n" +
 	"\tworkspace\x18\x02 \x01(\tR\tworkspace\"L\n" +
 	"\x10GetReposResponse\x128\n" +
-	"\frepositories\x18\x01 \x03(\v2\x14.clonr.v1.RepositoryR\frepositories\"B\n" +
+	"\frepositories\x18\x01 \x03(\v2\x14.clonr.v1.RepositoryR\frepositories\"\xbd\x01\n" +
+	"\x10ListReposRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\x0efavorites_only\x18\x03 \x01(\bR\rfavoritesOnly\x12\x1c\n" +
+	"\tworkspace\x18\x04 \x01(\tR\tworkspace\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\"\x94\x01\n" +
+	"\x11ListReposResponse\x128\n" +
+	"\frepositories\x18\x01 \x03(\v2\x14.clonr.v1.RepositoryR\frepositories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"B\n" +
 	"\x12SetFavoriteRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1a\n" +
 	"\bfavorite\x18\x02 \x01(\bR\bfavorite\"/\n" +
 	"\x13SetFavoriteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\".\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\":\n" +
+	"\x12SetRepoKindRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"/\n" +
+	"\x13SetRepoKindResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x16SetRepoUpstreamRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12!\n" +
+	"\fupstream_url\x18\x02 \x01(\tR\vupstreamUrl\"3\n" +
+	"\x17SetRepoUpstreamResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"C\n" +
+	"\x15SetRepoLicenseRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\alicense\x18\x02 \x01(\tR\alicense\"2\n" +
+	"\x16SetRepoLicenseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\":\n" +
+	"\x12SetRepoTagsRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"/\n" +
+	"\x13SetRepoTagsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x13SetRepoNotesRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05notes\x18\x02 \x01(\tR\x05notes\"0\n" +
+	"\x14SetRepoNotesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x13SetRepoAliasRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05alias\x18\x02 \x01(\tR\x05alias\"0\n" +
+	"\x14SetRepoAliasResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
+	"\x17RecordRepoAccessRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"4\n" +
+	"\x18RecordRepoAccessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Y\n" +
+	"\x15SetRepoRemotesRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12.\n" +
+	"\aremotes\x18\x02 \x03(\v2\x14.clonr.v1.RepoRemoteR\aremotes\"2\n" +
+	"\x16SetRepoRemotesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
+	"\x14GetRepoDetailRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"E\n" +
+	"\x15GetRepoDetailResponse\x12,\n" +
+	"\x06detail\x18\x01 \x01(\v2\x14.clonr.v1.RepoDetailR\x06detail\"-\n" +
+	"\x19GetRepoByRemoteURLRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"R\n" +
+	"\x1aGetRepoByRemoteURLResponse\x124\n" +
+	"\n" +
+	"repository\x18\x01 \x01(\v2\x14.clonr.v1.RepositoryR\n" +
+	"repository\".\n" +
 	"\x1aUpdateRepoTimestampRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"7\n" +
 	"\x1bUpdateRepoTimestampResponse\x12\x18\n" +
@@ -1020,7 +2747,22 @@ const file_v1_repository_proto_rawDesc = "" +
 	"\x16RemoveRepoByURLRequest\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"3\n" +
 	"\x17RemoveRepoByURLResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccessB\x92\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x15UpdateRepoPathRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"2\n" +
+	"\x16UpdateRepoPathResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"H\n" +
+	"\x14UpdateRepoURLRequest\x12\x17\n" +
+	"\aold_url\x18\x01 \x01(\tR\x06oldUrl\x12\x17\n" +
+	"\anew_url\x18\x02 \x01(\tR\x06newUrl\"1\n" +
+	"\x15UpdateRepoURLResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\x16WatchRepoEventsRequest\"a\n" +
+	"\tRepoEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04timeB\x92\x01\n" +
 	"\fcom.clonr.v1B\x0fRepositoryProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
 
 var (
@@ -1035,40 +2777,79 @@ func file_v1_repository_proto_rawDescGZIP() []byte {
 	return file_v1_repository_proto_rawDescData
 }
 
-var file_v1_repository_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_v1_repository_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_v1_repository_proto_goTypes = []any{
 	(*Repository)(nil),                    // 0: clonr.v1.Repository
-	(*SaveRepoRequest)(nil),               // 1: clonr.v1.SaveRepoRequest
-	(*SaveRepoResponse)(nil),              // 2: clonr.v1.SaveRepoResponse
-	(*RepoExistsByURLRequest)(nil),        // 3: clonr.v1.RepoExistsByURLRequest
-	(*RepoExistsByURLResponse)(nil),       // 4: clonr.v1.RepoExistsByURLResponse
-	(*RepoExistsByPathRequest)(nil),       // 5: clonr.v1.RepoExistsByPathRequest
-	(*RepoExistsByPathResponse)(nil),      // 6: clonr.v1.RepoExistsByPathResponse
-	(*InsertRepoIfNotExistsRequest)(nil),  // 7: clonr.v1.InsertRepoIfNotExistsRequest
-	(*InsertRepoIfNotExistsResponse)(nil), // 8: clonr.v1.InsertRepoIfNotExistsResponse
-	(*GetAllReposRequest)(nil),            // 9: clonr.v1.GetAllReposRequest
-	(*GetAllReposResponse)(nil),           // 10: clonr.v1.GetAllReposResponse
-	(*GetReposRequest)(nil),               // 11: clonr.v1.GetReposRequest
-	(*GetReposResponse)(nil),              // 12: clonr.v1.GetReposResponse
-	(*SetFavoriteRequest)(nil),            // 13: clonr.v1.SetFavoriteRequest
-	(*SetFavoriteResponse)(nil),           // 14: clonr.v1.SetFavoriteResponse
-	(*UpdateRepoTimestampRequest)(nil),    // 15: clonr.v1.UpdateRepoTimestampRequest
-	(*UpdateRepoTimestampResponse)(nil),   // 16: clonr.v1.UpdateRepoTimestampResponse
-	(*RemoveRepoByURLRequest)(nil),        // 17: clonr.v1.RemoveRepoByURLRequest
-	(*RemoveRepoByURLResponse)(nil),       // 18: clonr.v1.RemoveRepoByURLResponse
-	(*timestamppb.Timestamp)(nil),         // 19: google.protobuf.Timestamp
+	(*RepoRemote)(nil),                    // 1: clonr.v1.RepoRemote
+	(*RepoDetail)(nil),                    // 2: clonr.v1.RepoDetail
+	(*SaveRepoRequest)(nil),               // 3: clonr.v1.SaveRepoRequest
+	(*SaveRepoResponse)(nil),              // 4: clonr.v1.SaveRepoResponse
+	(*RepoExistsByURLRequest)(nil),        // 5: clonr.v1.RepoExistsByURLRequest
+	(*RepoExistsByURLResponse)(nil),       // 6: clonr.v1.RepoExistsByURLResponse
+	(*RepoExistsByPathRequest)(nil),       // 7: clonr.v1.RepoExistsByPathRequest
+	(*RepoExistsByPathResponse)(nil),      // 8: clonr.v1.RepoExistsByPathResponse
+	(*InsertRepoIfNotExistsRequest)(nil),  // 9: clonr.v1.InsertRepoIfNotExistsRequest
+	(*InsertRepoIfNotExistsResponse)(nil), // 10: clonr.v1.InsertRepoIfNotExistsResponse
+	(*GetAllReposRequest)(nil),            // 11: clonr.v1.GetAllReposRequest
+	(*GetAllReposResponse)(nil),           // 12: clonr.v1.GetAllReposResponse
+	(*GetReposRequest)(nil),               // 13: clonr.v1.GetReposRequest
+	(*GetReposResponse)(nil),              // 14: clonr.v1.GetReposResponse
+	(*ListReposRequest)(nil),              // 15: clonr.v1.ListReposRequest
+	(*ListReposResponse)(nil),             // 16: clonr.v1.ListReposResponse
+	(*SetFavoriteRequest)(nil),            // 17: clonr.v1.SetFavoriteRequest
+	(*SetFavoriteResponse)(nil),           // 18: clonr.v1.SetFavoriteResponse
+	(*SetRepoKindRequest)(nil),            // 19: clonr.v1.SetRepoKindRequest
+	(*SetRepoKindResponse)(nil),           // 20: clonr.v1.SetRepoKindResponse
+	(*SetRepoUpstreamRequest)(nil),        // 21: clonr.v1.SetRepoUpstreamRequest
+	(*SetRepoUpstreamResponse)(nil),       // 22: clonr.v1.SetRepoUpstreamResponse
+	(*SetRepoLicenseRequest)(nil),         // 23: clonr.v1.SetRepoLicenseRequest
+	(*SetRepoLicenseResponse)(nil),        // 24: clonr.v1.SetRepoLicenseResponse
+	(*SetRepoTagsRequest)(nil),            // 25: clonr.v1.SetRepoTagsRequest
+	(*SetRepoTagsResponse)(nil),           // 26: clonr.v1.SetRepoTagsResponse
+	(*SetRepoNotesRequest)(nil),           // 27: clonr.v1.SetRepoNotesRequest
+	(*SetRepoNotesResponse)(nil),          // 28: clonr.v1.SetRepoNotesResponse
+	(*SetRepoAliasRequest)(nil),           // 29: clonr.v1.SetRepoAliasRequest
+	(*SetRepoAliasResponse)(nil),          // 30: clonr.v1.SetRepoAliasResponse
+	(*RecordRepoAccessRequest)(nil),       // 31: clonr.v1.RecordRepoAccessRequest
+	(*RecordRepoAccessResponse)(nil),      // 32: clonr.v1.RecordRepoAccessResponse
+	(*SetRepoRemotesRequest)(nil),         // 33: clonr.v1.SetRepoRemotesRequest
+	(*SetRepoRemotesResponse)(nil),        // 34: clonr.v1.SetRepoRemotesResponse
+	(*GetRepoDetailRequest)(nil),          // 35: clonr.v1.GetRepoDetailRequest
+	(*GetRepoDetailResponse)(nil),         // 36: clonr.v1.GetRepoDetailResponse
+	(*GetRepoByRemoteURLRequest)(nil),     // 37: clonr.v1.GetRepoByRemoteURLRequest
+	(*GetRepoByRemoteURLResponse)(nil),    // 38: clonr.v1.GetRepoByRemoteURLResponse
+	(*UpdateRepoTimestampRequest)(nil),    // 39: clonr.v1.UpdateRepoTimestampRequest
+	(*UpdateRepoTimestampResponse)(nil),   // 40: clonr.v1.UpdateRepoTimestampResponse
+	(*RemoveRepoByURLRequest)(nil),        // 41: clonr.v1.RemoveRepoByURLRequest
+	(*RemoveRepoByURLResponse)(nil),       // 42: clonr.v1.RemoveRepoByURLResponse
+	(*UpdateRepoPathRequest)(nil),         // 43: clonr.v1.UpdateRepoPathRequest
+	(*UpdateRepoPathResponse)(nil),        // 44: clonr.v1.UpdateRepoPathResponse
+	(*UpdateRepoURLRequest)(nil),          // 45: clonr.v1.UpdateRepoURLRequest
+	(*UpdateRepoURLResponse)(nil),         // 46: clonr.v1.UpdateRepoURLResponse
+	(*WatchRepoEventsRequest)(nil),        // 47: clonr.v1.WatchRepoEventsRequest
+	(*RepoEvent)(nil),                     // 48: clonr.v1.RepoEvent
+	(*timestamppb.Timestamp)(nil),         // 49: google.protobuf.Timestamp
 }
 var file_v1_repository_proto_depIdxs = []int32{
-	19, // 0: clonr.v1.Repository.cloned_at:type_name -> google.protobuf.Timestamp
-	19, // 1: clonr.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
-	19, // 2: clonr.v1.Repository.last_checked:type_name -> google.protobuf.Timestamp
-	0,  // 3: clonr.v1.GetAllReposResponse.repositories:type_name -> clonr.v1.Repository
-	0,  // 4: clonr.v1.GetReposResponse.repositories:type_name -> clonr.v1.Repository
-	5,  // [5:5] is the sub-list for method output_type
-	5,  // [5:5] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	49, // 0: clonr.v1.Repository.cloned_at:type_name -> google.protobuf.Timestamp
+	49, // 1: clonr.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 2: clonr.v1.Repository.last_checked:type_name -> google.protobuf.Timestamp
+	1,  // 3: clonr.v1.Repository.remotes:type_name -> clonr.v1.RepoRemote
+	49, // 4: clonr.v1.Repository.last_accessed:type_name -> google.protobuf.Timestamp
+	0,  // 5: clonr.v1.RepoDetail.repository:type_name -> clonr.v1.Repository
+	49, // 6: clonr.v1.RepoDetail.last_commit_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: clonr.v1.GetAllReposResponse.repositories:type_name -> clonr.v1.Repository
+	0,  // 8: clonr.v1.GetReposResponse.repositories:type_name -> clonr.v1.Repository
+	0,  // 9: clonr.v1.ListReposResponse.repositories:type_name -> clonr.v1.Repository
+	1,  // 10: clonr.v1.SetRepoRemotesRequest.remotes:type_name -> clonr.v1.RepoRemote
+	2,  // 11: clonr.v1.GetRepoDetailResponse.detail:type_name -> clonr.v1.RepoDetail
+	0,  // 12: clonr.v1.GetRepoByRemoteURLResponse.repository:type_name -> clonr.v1.Repository
+	49, // 13: clonr.v1.RepoEvent.time:type_name -> google.protobuf.Timestamp
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_v1_repository_proto_init() }
@@ -1082,7 +2863,7 @@ func file_v1_repository_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_repository_proto_rawDesc), len(file_v1_repository_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
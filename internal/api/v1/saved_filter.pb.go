@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/saved_filter.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SavedFilter is a named repository filter (smart list)
+type SavedFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Workspace     string                 `protobuf:"bytes,3,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	FavoritesOnly bool                   `protobuf:"varint,4,opt,name=favorites_only,json=favoritesOnly,proto3" json:"favorites_only,omitempty"`
+	Query         string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	Dirty         bool                   `protobuf:"varint,6,opt,name=dirty,proto3" json:"dirty,omitempty"`      // Only repositories with uncommitted changes
+	Language      string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"` // Project language detected from manifest files
+	Sort          string                 `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`         // name, cloned, updated
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Kind          string                 `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"` // Repository kind: source, fork, mirror, archive, template
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedFilter) Reset() {
+	*x = SavedFilter{}
+	mi := &file_v1_saved_filter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedFilter) ProtoMessage() {}
+
+func (x *SavedFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedFilter.ProtoReflect.Descriptor instead.
+func (*SavedFilter) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetFavoritesOnly() bool {
+	if x != nil {
+		return x.FavoritesOnly
+	}
+	return false
+}
+
+func (x *SavedFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetDirty() bool {
+	if x != nil {
+		return x.Dirty
+	}
+	return false
+}
+
+func (x *SavedFilter) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SavedFilter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SavedFilter) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// SaveFilter RPC messages
+type SaveFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *SavedFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveFilterRequest) Reset() {
+	*x = SaveFilterRequest{}
+	mi := &file_v1_saved_filter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveFilterRequest) ProtoMessage() {}
+
+func (x *SaveFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveFilterRequest.ProtoReflect.Descriptor instead.
+func (*SaveFilterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveFilterRequest) GetFilter() *SavedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type SaveFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveFilterResponse) Reset() {
+	*x = SaveFilterResponse{}
+	mi := &file_v1_saved_filter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveFilterResponse) ProtoMessage() {}
+
+func (x *SaveFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveFilterResponse.ProtoReflect.Descriptor instead.
+func (*SaveFilterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveFilterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetFilter RPC messages
+type GetFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilterRequest) Reset() {
+	*x = GetFilterRequest{}
+	mi := &file_v1_saved_filter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterRequest) ProtoMessage() {}
+
+func (x *GetFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetFilterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFilterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *SavedFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilterResponse) Reset() {
+	*x = GetFilterResponse{}
+	mi := &file_v1_saved_filter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilterResponse) ProtoMessage() {}
+
+func (x *GetFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilterResponse.ProtoReflect.Descriptor instead.
+func (*GetFilterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFilterResponse) GetFilter() *SavedFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// ListFilters RPC messages
+type ListFiltersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiltersRequest) Reset() {
+	*x = ListFiltersRequest{}
+	mi := &file_v1_saved_filter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiltersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiltersRequest) ProtoMessage() {}
+
+func (x *ListFiltersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiltersRequest.ProtoReflect.Descriptor instead.
+func (*ListFiltersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{5}
+}
+
+type ListFiltersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filters       []*SavedFilter         `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFiltersResponse) Reset() {
+	*x = ListFiltersResponse{}
+	mi := &file_v1_saved_filter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiltersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiltersResponse) ProtoMessage() {}
+
+func (x *ListFiltersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiltersResponse.ProtoReflect.Descriptor instead.
+func (*ListFiltersResponse) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFiltersResponse) GetFilters() []*SavedFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+// DeleteFilter RPC messages
+type DeleteFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterRequest) Reset() {
+	*x = DeleteFilterRequest{}
+	mi := &file_v1_saved_filter_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterRequest) ProtoMessage() {}
+
+func (x *DeleteFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteFilterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterResponse) Reset() {
+	*x = DeleteFilterResponse{}
+	mi := &file_v1_saved_filter_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterResponse) ProtoMessage() {}
+
+func (x *DeleteFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_saved_filter_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFilterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_saved_filter_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteFilterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_saved_filter_proto protoreflect.FileDescriptor
+
+const file_v1_saved_filter_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/saved_filter.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x02\n" +
+	"\vSavedFilter\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tworkspace\x18\x03 \x01(\tR\tworkspace\x12%\n" +
+	"\x0efavorites_only\x18\x04 \x01(\bR\rfavoritesOnly\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x14\n" +
+	"\x05dirty\x18\x06 \x01(\bR\x05dirty\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sort\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04kind\x18\v \x01(\tR\x04kind\"B\n" +
+	"\x11SaveFilterRequest\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.clonr.v1.SavedFilterR\x06filter\".\n" +
+	"\x12SaveFilterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
+	"\x10GetFilterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"B\n" +
+	"\x11GetFilterResponse\x12-\n" +
+	"\x06filter\x18\x01 \x01(\v2\x15.clonr.v1.SavedFilterR\x06filter\"\x14\n" +
+	"\x12ListFiltersRequest\"F\n" +
+	"\x13ListFiltersResponse\x12/\n" +
+	"\afilters\x18\x01 \x03(\v2\x15.clonr.v1.SavedFilterR\afilters\")\n" +
+	"\x13DeleteFilterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"0\n" +
+	"\x14DeleteFilterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x93\x01\n" +
+	"\fcom.clonr.v1B\x10SavedFilterProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_saved_filter_proto_rawDescOnce sync.Once
+	file_v1_saved_filter_proto_rawDescData []byte
+)
+
+func file_v1_saved_filter_proto_rawDescGZIP() []byte {
+	file_v1_saved_filter_proto_rawDescOnce.Do(func() {
+		file_v1_saved_filter_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_saved_filter_proto_rawDesc), len(file_v1_saved_filter_proto_rawDesc)))
+	})
+	return file_v1_saved_filter_proto_rawDescData
+}
+
+var file_v1_saved_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_saved_filter_proto_goTypes = []any{
+	(*SavedFilter)(nil),           // 0: clonr.v1.SavedFilter
+	(*SaveFilterRequest)(nil),     // 1: clonr.v1.SaveFilterRequest
+	(*SaveFilterResponse)(nil),    // 2: clonr.v1.SaveFilterResponse
+	(*GetFilterRequest)(nil),      // 3: clonr.v1.GetFilterRequest
+	(*GetFilterResponse)(nil),     // 4: clonr.v1.GetFilterResponse
+	(*ListFiltersRequest)(nil),    // 5: clonr.v1.ListFiltersRequest
+	(*ListFiltersResponse)(nil),   // 6: clonr.v1.ListFiltersResponse
+	(*DeleteFilterRequest)(nil),   // 7: clonr.v1.DeleteFilterRequest
+	(*DeleteFilterResponse)(nil),  // 8: clonr.v1.DeleteFilterResponse
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_v1_saved_filter_proto_depIdxs = []int32{
+	9, // 0: clonr.v1.SavedFilter.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: clonr.v1.SavedFilter.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: clonr.v1.SaveFilterRequest.filter:type_name -> clonr.v1.SavedFilter
+	0, // 3: clonr.v1.GetFilterResponse.filter:type_name -> clonr.v1.SavedFilter
+	0, // 4: clonr.v1.ListFiltersResponse.filters:type_name -> clonr.v1.SavedFilter
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_saved_filter_proto_init() }
+func file_v1_saved_filter_proto_init() {
+	if File_v1_saved_filter_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_saved_filter_proto_rawDesc), len(file_v1_saved_filter_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_saved_filter_proto_goTypes,
+		DependencyIndexes: file_v1_saved_filter_proto_depIdxs,
+		MessageInfos:      file_v1_saved_filter_proto_msgTypes,
+	}.Build()
+	File_v1_saved_filter_proto = out.File
+	file_v1_saved_filter_proto_goTypes = nil
+	file_v1_saved_filter_proto_depIdxs = nil
+}
@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/share_link.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ShareLink is a one-time link to read-only information about a repository
+type ShareLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RepoUrl       string                 `protobuf:"bytes,2,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLink) Reset() {
+	*x = ShareLink{}
+	mi := &file_v1_share_link_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLink) ProtoMessage() {}
+
+func (x *ShareLink) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShareLink) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShareLink) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *ShareLink) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShareLink) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShareLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// SaveShareLink RPC messages
+type SaveShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *ShareLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveShareLinkRequest) Reset() {
+	*x = SaveShareLinkRequest{}
+	mi := &file_v1_share_link_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShareLinkRequest) ProtoMessage() {}
+
+func (x *SaveShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*SaveShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveShareLinkRequest) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type SaveShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveShareLinkResponse) Reset() {
+	*x = SaveShareLinkResponse{}
+	mi := &file_v1_share_link_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveShareLinkResponse) ProtoMessage() {}
+
+func (x *SaveShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*SaveShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveShareLinkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetShareLink RPC messages
+type GetShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareLinkRequest) Reset() {
+	*x = GetShareLinkRequest{}
+	mi := &file_v1_share_link_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinkRequest) ProtoMessage() {}
+
+func (x *GetShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*GetShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetShareLinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *ShareLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"` // Unset when the link does not exist
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareLinkResponse) Reset() {
+	*x = GetShareLinkResponse{}
+	mi := &file_v1_share_link_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinkResponse) ProtoMessage() {}
+
+func (x *GetShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*GetShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetShareLinkResponse) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+// ConsumeShareLink RPC messages
+type ConsumeShareLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeShareLinkRequest) Reset() {
+	*x = ConsumeShareLinkRequest{}
+	mi := &file_v1_share_link_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeShareLinkRequest) ProtoMessage() {}
+
+func (x *ConsumeShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConsumeShareLinkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConsumeShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *ShareLink             `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"` // Unset when the link does not exist or was already consumed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeShareLinkResponse) Reset() {
+	*x = ConsumeShareLinkResponse{}
+	mi := &file_v1_share_link_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeShareLinkResponse) ProtoMessage() {}
+
+func (x *ConsumeShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_share_link_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_v1_share_link_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConsumeShareLinkResponse) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+var File_v1_share_link_proto protoreflect.FileDescriptor
+
+const file_v1_share_link_proto_rawDesc = "" +
+	"\n" +
+	"\x13v1/share_link.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc0\x01\n" +
+	"\tShareLink\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\brepo_url\x18\x02 \x01(\tR\arepoUrl\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"?\n" +
+	"\x14SaveShareLinkRequest\x12'\n" +
+	"\x04link\x18\x01 \x01(\v2\x13.clonr.v1.ShareLinkR\x04link\"1\n" +
+	"\x15SaveShareLinkResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"%\n" +
+	"\x13GetShareLinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x14GetShareLinkResponse\x12'\n" +
+	"\x04link\x18\x01 \x01(\v2\x13.clonr.v1.ShareLinkR\x04link\")\n" +
+	"\x17ConsumeShareLinkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x18ConsumeShareLinkResponse\x12'\n" +
+	"\x04link\x18\x01 \x01(\v2\x13.clonr.v1.ShareLinkR\x04linkB\x91\x01\n" +
+	"\fcom.clonr.v1B\x0eShareLinkProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_share_link_proto_rawDescOnce sync.Once
+	file_v1_share_link_proto_rawDescData []byte
+)
+
+func file_v1_share_link_proto_rawDescGZIP() []byte {
+	file_v1_share_link_proto_rawDescOnce.Do(func() {
+		file_v1_share_link_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_share_link_proto_rawDesc), len(file_v1_share_link_proto_rawDesc)))
+	})
+	return file_v1_share_link_proto_rawDescData
+}
+
+var file_v1_share_link_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_share_link_proto_goTypes = []any{
+	(*ShareLink)(nil),                // 0: clonr.v1.ShareLink
+	(*SaveShareLinkRequest)(nil),     // 1: clonr.v1.SaveShareLinkRequest
+	(*SaveShareLinkResponse)(nil),    // 2: clonr.v1.SaveShareLinkResponse
+	(*GetShareLinkRequest)(nil),      // 3: clonr.v1.GetShareLinkRequest
+	(*GetShareLinkResponse)(nil),     // 4: clonr.v1.GetShareLinkResponse
+	(*ConsumeShareLinkRequest)(nil),  // 5: clonr.v1.ConsumeShareLinkRequest
+	(*ConsumeShareLinkResponse)(nil), // 6: clonr.v1.ConsumeShareLinkResponse
+	(*timestamppb.Timestamp)(nil),    // 7: google.protobuf.Timestamp
+}
+var file_v1_share_link_proto_depIdxs = []int32{
+	7, // 0: clonr.v1.ShareLink.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: clonr.v1.ShareLink.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 2: clonr.v1.SaveShareLinkRequest.link:type_name -> clonr.v1.ShareLink
+	0, // 3: clonr.v1.GetShareLinkResponse.link:type_name -> clonr.v1.ShareLink
+	0, // 4: clonr.v1.ConsumeShareLinkResponse.link:type_name -> clonr.v1.ShareLink
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_share_link_proto_init() }
+func file_v1_share_link_proto_init() {
+	if File_v1_share_link_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_share_link_proto_rawDesc), len(file_v1_share_link_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_share_link_proto_goTypes,
+		DependencyIndexes: file_v1_share_link_proto_depIdxs,
+		MessageInfos:      file_v1_share_link_proto_msgTypes,
+	}.Build()
+	File_v1_share_link_proto = out.File
+	file_v1_share_link_proto_goTypes = nil
+	file_v1_share_link_proto_depIdxs = nil
+}
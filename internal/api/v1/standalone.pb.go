@@ -27,6 +27,7 @@ type AuthenticateRequest struct {
 	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`             // Base58-encoded API key from standalone key
 	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`       // Unique ID of the destination instance
 	ClientName    string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"` // Human-readable name of the destination
+	ClientKey     []byte                 `protobuf:"bytes,4,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`    // Client encryption key; must match the registered fingerprint
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,6 +83,13 @@ func (x *AuthenticateRequest) GetClientName() string {
 	return ""
 }
 
+func (x *AuthenticateRequest) GetClientKey() []byte {
+	if x != nil {
+		return x.ClientKey
+	}
+	return nil
+}
+
 // AuthenticateResponse contains the session token for subsequent requests.
 type AuthenticateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -273,6 +281,170 @@ func (x *RefreshTokenResponse) GetError() string {
 	return ""
 }
 
+// RegisterRequest submits the destination's client encryption key. The
+// source registers the client at once when its auto-approve policy allows
+// it, and keeps the request pending for the operator otherwise.
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`             // Base58-encoded API key from standalone key
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`       // Unique ID of the destination instance
+	ClientName    string                 `protobuf:"bytes,3,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"` // Human-readable name of the destination
+	DisplayKey    string                 `protobuf:"bytes,4,opt,name=display_key,json=displayKey,proto3" json:"display_key,omitempty"` // Client encryption key (display format)
+	Hostname      string                 `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Os            string                 `protobuf:"bytes,6,opt,name=os,proto3" json:"os,omitempty"`
+	Arch          string                 `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
+	ClonrVersion  string                 `protobuf:"bytes,8,opt,name=clonr_version,json=clonrVersion,proto3" json:"clonr_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_v1_standalone_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_standalone_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_standalone_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RegisterRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetDisplayKey() string {
+	if x != nil {
+		return x.DisplayKey
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetClonrVersion() string {
+	if x != nil {
+		return x.ClonrVersion
+	}
+	return ""
+}
+
+// RegisterResponse tells whether the client was registered.
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approved      bool                   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`      // False while the request awaits approval
+	Fingerprint   string                 `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"` // Client fingerprint computed from the received key
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`             // Error message if the request was refused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_v1_standalone_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_standalone_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_v1_standalone_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegisterResponse) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *RegisterResponse) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // PingResponse provides basic connectivity info.
 type PingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -286,7 +458,7 @@ type PingResponse struct {
 
 func (x *PingResponse) Reset() {
 	*x = PingResponse{}
-	mi := &file_v1_standalone_proto_msgTypes[4]
+	mi := &file_v1_standalone_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +470,7 @@ func (x *PingResponse) String() string {
 func (*PingResponse) ProtoMessage() {}
 
 func (x *PingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[4]
+	mi := &file_v1_standalone_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +483,7 @@ func (x *PingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{4}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PingResponse) GetInstanceId() string {
@@ -352,7 +524,7 @@ type GetStatusRequest struct {
 
 func (x *GetStatusRequest) Reset() {
 	*x = GetStatusRequest{}
-	mi := &file_v1_standalone_proto_msgTypes[5]
+	mi := &file_v1_standalone_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +536,7 @@ func (x *GetStatusRequest) String() string {
 func (*GetStatusRequest) ProtoMessage() {}
 
 func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[5]
+	mi := &file_v1_standalone_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +549,7 @@ func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetStatusRequest) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{5}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetStatusRequest) GetSessionToken() string {
@@ -395,13 +567,14 @@ type GetStatusResponse struct {
 	LastSync          int64                  `protobuf:"varint,3,opt,name=last_sync,json=lastSync,proto3" json:"last_sync,omitempty"` // Unix timestamp of last sync
 	Stats             *SyncStats             `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
 	Clients           []*ConnectedClient     `protobuf:"bytes,5,rep,name=clients,proto3" json:"clients,omitempty"`
+	Revisions         map[string]int64       `protobuf:"bytes,6,rep,name=revisions,proto3" json:"revisions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Current revision of each bucket
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetStatusResponse) Reset() {
 	*x = GetStatusResponse{}
-	mi := &file_v1_standalone_proto_msgTypes[6]
+	mi := &file_v1_standalone_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +586,7 @@ func (x *GetStatusResponse) String() string {
 func (*GetStatusResponse) ProtoMessage() {}
 
 func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[6]
+	mi := &file_v1_standalone_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +599,7 @@ func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetStatusResponse) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{6}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetStatusResponse) GetInstanceId() string {
@@ -464,6 +637,13 @@ func (x *GetStatusResponse) GetClients() []*ConnectedClient {
 	return nil
 }
 
+func (x *GetStatusResponse) GetRevisions() map[string]int64 {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
 // SyncStats tracks sync statistics.
 type SyncStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -477,7 +657,7 @@ type SyncStats struct {
 
 func (x *SyncStats) Reset() {
 	*x = SyncStats{}
-	mi := &file_v1_standalone_proto_msgTypes[7]
+	mi := &file_v1_standalone_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +669,7 @@ func (x *SyncStats) String() string {
 func (*SyncStats) ProtoMessage() {}
 
 func (x *SyncStats) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[7]
+	mi := &file_v1_standalone_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +682,7 @@ func (x *SyncStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncStats.ProtoReflect.Descriptor instead.
 func (*SyncStats) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{7}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SyncStats) GetProfiles() int32 {
@@ -548,7 +728,7 @@ type ConnectedClient struct {
 
 func (x *ConnectedClient) Reset() {
 	*x = ConnectedClient{}
-	mi := &file_v1_standalone_proto_msgTypes[8]
+	mi := &file_v1_standalone_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -560,7 +740,7 @@ func (x *ConnectedClient) String() string {
 func (*ConnectedClient) ProtoMessage() {}
 
 func (x *ConnectedClient) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[8]
+	mi := &file_v1_standalone_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +753,7 @@ func (x *ConnectedClient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectedClient.ProtoReflect.Descriptor instead.
 func (*ConnectedClient) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{8}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ConnectedClient) GetId() string {
@@ -622,15 +802,17 @@ func (x *ConnectedClient) GetSyncCount() int32 {
 type SyncRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	SessionToken   string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
-	SinceTimestamp int64                  `protobuf:"varint,2,opt,name=since_timestamp,json=sinceTimestamp,proto3" json:"since_timestamp,omitempty"` // For incremental sync (0 = full sync)
-	ItemTypes      []string               `protobuf:"bytes,3,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`                 // Filter: ["profiles", "workspaces", "repos", "config"]
+	SinceTimestamp int64                  `protobuf:"varint,2,opt,name=since_timestamp,json=sinceTimestamp,proto3" json:"since_timestamp,omitempty"`                                                                           // Superseded by since_revisions
+	ItemTypes      []string               `protobuf:"bytes,3,rep,name=item_types,json=itemTypes,proto3" json:"item_types,omitempty"`                                                                                           // Filter: ["profiles", "workspaces", "repos", "config"]
+	Workspaces     []string               `protobuf:"bytes,4,rep,name=workspaces,proto3" json:"workspaces,omitempty"`                                                                                                          // Filter: workspaces and their repos to sync (empty = all)
+	SinceRevisions map[string]int64       `protobuf:"bytes,5,rep,name=since_revisions,json=sinceRevisions,proto3" json:"since_revisions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Last acknowledged revision per bucket (missing = full sync)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SyncRequest) Reset() {
 	*x = SyncRequest{}
-	mi := &file_v1_standalone_proto_msgTypes[9]
+	mi := &file_v1_standalone_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +824,7 @@ func (x *SyncRequest) String() string {
 func (*SyncRequest) ProtoMessage() {}
 
 func (x *SyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[9]
+	mi := &file_v1_standalone_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +837,7 @@ func (x *SyncRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
 func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{9}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SyncRequest) GetSessionToken() string {
@@ -679,6 +861,20 @@ func (x *SyncRequest) GetItemTypes() []string {
 	return nil
 }
 
+func (x *SyncRequest) GetWorkspaces() []string {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *SyncRequest) GetSinceRevisions() map[string]int64 {
+	if x != nil {
+		return x.SinceRevisions
+	}
+	return nil
+}
+
 // EncryptedData represents a single encrypted item.
 type EncryptedData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -687,13 +883,16 @@ type EncryptedData struct {
 	EncryptedData []byte                 `protobuf:"bytes,3,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"` // AES-256-GCM encrypted JSON
 	Nonce         []byte                 `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`                                      // GCM nonce
 	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`            // Unix timestamp of last update
+	Workspace     string                 `protobuf:"bytes,6,opt,name=workspace,proto3" json:"workspace,omitempty"`                              // Workspace of a repo, name of a workspace
+	Revision      int64                  `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`                               // Bucket revision the item last changed at
+	Deleted       bool                   `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`                                 // Tombstone of a removed item, without data
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EncryptedData) Reset() {
 	*x = EncryptedData{}
-	mi := &file_v1_standalone_proto_msgTypes[10]
+	mi := &file_v1_standalone_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +904,7 @@ func (x *EncryptedData) String() string {
 func (*EncryptedData) ProtoMessage() {}
 
 func (x *EncryptedData) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[10]
+	mi := &file_v1_standalone_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +917,7 @@ func (x *EncryptedData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncryptedData.ProtoReflect.Descriptor instead.
 func (*EncryptedData) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{10}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EncryptedData) GetId() string {
@@ -756,22 +955,46 @@ func (x *EncryptedData) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *EncryptedData) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *EncryptedData) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EncryptedData) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 // SyncChunk is used for streaming full sync data.
 type SyncChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "profile", "workspace", "repo", "config"
 	EncryptedData []byte                 `protobuf:"bytes,2,opt,name=encrypted_data,json=encryptedData,proto3" json:"encrypted_data,omitempty"`
 	Nonce         []byte                 `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Sequence      int32                  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"` // Chunk sequence number
-	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`       // Total chunks expected
-	Id            string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`              // Item identifier
+	Sequence      int32                  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`  // Chunk sequence number
+	Total         int32                  `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`        // Total chunks expected
+	Id            string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`               // Item identifier
+	Workspace     string                 `protobuf:"bytes,7,opt,name=workspace,proto3" json:"workspace,omitempty"` // Workspace of a repo, name of a workspace
+	Revision      int64                  `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`  // Bucket revision the item last changed at
+	Deleted       bool                   `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`    // Tombstone of a removed item, without data
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncChunk) Reset() {
 	*x = SyncChunk{}
-	mi := &file_v1_standalone_proto_msgTypes[11]
+	mi := &file_v1_standalone_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +1006,7 @@ func (x *SyncChunk) String() string {
 func (*SyncChunk) ProtoMessage() {}
 
 func (x *SyncChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[11]
+	mi := &file_v1_standalone_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1019,7 @@ func (x *SyncChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncChunk.ProtoReflect.Descriptor instead.
 func (*SyncChunk) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{11}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SyncChunk) GetType() string {
@@ -841,6 +1064,27 @@ func (x *SyncChunk) GetId() string {
 	return ""
 }
 
+func (x *SyncChunk) GetWorkspace() string {
+	if x != nil {
+		return x.Workspace
+	}
+	return ""
+}
+
+func (x *SyncChunk) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SyncChunk) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
 // StandaloneKey represents the key shared between instances.
 // This is not transmitted over gRPC but used for initial setup.
 type StandaloneKey struct {
@@ -861,7 +1105,7 @@ type StandaloneKey struct {
 
 func (x *StandaloneKey) Reset() {
 	*x = StandaloneKey{}
-	mi := &file_v1_standalone_proto_msgTypes[12]
+	mi := &file_v1_standalone_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -873,7 +1117,7 @@ func (x *StandaloneKey) String() string {
 func (*StandaloneKey) ProtoMessage() {}
 
 func (x *StandaloneKey) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[12]
+	mi := &file_v1_standalone_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1130,7 @@ func (x *StandaloneKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StandaloneKey.ProtoReflect.Descriptor instead.
 func (*StandaloneKey) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{12}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StandaloneKey) GetVersion() int32 {
@@ -971,13 +1215,14 @@ type StandaloneConfig struct {
 	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ExpiresAt     int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	Capabilities  []string               `protobuf:"bytes,9,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	AutoApprove   *AutoApprovePolicy     `protobuf:"bytes,10,opt,name=auto_approve,json=autoApprove,proto3" json:"auto_approve,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StandaloneConfig) Reset() {
 	*x = StandaloneConfig{}
-	mi := &file_v1_standalone_proto_msgTypes[13]
+	mi := &file_v1_standalone_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1234,7 @@ func (x *StandaloneConfig) String() string {
 func (*StandaloneConfig) ProtoMessage() {}
 
 func (x *StandaloneConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[13]
+	mi := &file_v1_standalone_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1247,7 @@ func (x *StandaloneConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StandaloneConfig.ProtoReflect.Descriptor instead.
 func (*StandaloneConfig) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{13}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StandaloneConfig) GetEnabled() bool {
@@ -1068,6 +1313,66 @@ func (x *StandaloneConfig) GetCapabilities() []string {
 	return nil
 }
 
+func (x *StandaloneConfig) GetAutoApprove() *AutoApprovePolicy {
+	if x != nil {
+		return x.AutoApprove
+	}
+	return nil
+}
+
+// AutoApprovePolicy selects the clients registered without manual approval.
+type AutoApprovePolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostnames     []string               `protobuf:"bytes,1,rep,name=hostnames,proto3" json:"hostnames,omitempty"`                      // Glob patterns of client hostnames
+	MaxClients    int32                  `protobuf:"varint,2,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"` // Active clients above which approval is manual (0 = no limit)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoApprovePolicy) Reset() {
+	*x = AutoApprovePolicy{}
+	mi := &file_v1_standalone_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoApprovePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoApprovePolicy) ProtoMessage() {}
+
+func (x *AutoApprovePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_standalone_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoApprovePolicy.ProtoReflect.Descriptor instead.
+func (*AutoApprovePolicy) Descriptor() ([]byte, []int) {
+	return file_v1_standalone_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AutoApprovePolicy) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *AutoApprovePolicy) GetMaxClients() int32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
 // StandaloneConnection represents a connection at the destination.
 type StandaloneConnection struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
@@ -1084,13 +1389,15 @@ type StandaloneConnection struct {
 	SyncedItems           *SyncStats             `protobuf:"bytes,11,opt,name=synced_items,json=syncedItems,proto3" json:"synced_items,omitempty"`
 	CreatedAt             int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt             int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SyncFilter            *SyncFilter            `protobuf:"bytes,14,opt,name=sync_filter,json=syncFilter,proto3" json:"sync_filter,omitempty"`
+	AckedRevisions        map[string]int64       `protobuf:"bytes,15,rep,name=acked_revisions,json=ackedRevisions,proto3" json:"acked_revisions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Last applied revision per bucket
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *StandaloneConnection) Reset() {
 	*x = StandaloneConnection{}
-	mi := &file_v1_standalone_proto_msgTypes[14]
+	mi := &file_v1_standalone_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1102,7 +1409,7 @@ func (x *StandaloneConnection) String() string {
 func (*StandaloneConnection) ProtoMessage() {}
 
 func (x *StandaloneConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_standalone_proto_msgTypes[14]
+	mi := &file_v1_standalone_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1422,7 @@ func (x *StandaloneConnection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StandaloneConnection.ProtoReflect.Descriptor instead.
 func (*StandaloneConnection) Descriptor() ([]byte, []int) {
-	return file_v1_standalone_proto_rawDescGZIP(), []int{14}
+	return file_v1_standalone_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StandaloneConnection) GetName() string {
@@ -1209,16 +1516,85 @@ func (x *StandaloneConnection) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *StandaloneConnection) GetSyncFilter() *SyncFilter {
+	if x != nil {
+		return x.SyncFilter
+	}
+	return nil
+}
+
+func (x *StandaloneConnection) GetAckedRevisions() map[string]int64 {
+	if x != nil {
+		return x.AckedRevisions
+	}
+	return nil
+}
+
+// SyncFilter selects the data a connection syncs; empty lists sync everything.
+type SyncFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataTypes     []string               `protobuf:"bytes,1,rep,name=data_types,json=dataTypes,proto3" json:"data_types,omitempty"` // "profiles", "workspaces", "repos", "config"
+	Workspaces    []string               `protobuf:"bytes,2,rep,name=workspaces,proto3" json:"workspaces,omitempty"`                // Workspaces and their repos
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncFilter) Reset() {
+	*x = SyncFilter{}
+	mi := &file_v1_standalone_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncFilter) ProtoMessage() {}
+
+func (x *SyncFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_standalone_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncFilter.ProtoReflect.Descriptor instead.
+func (*SyncFilter) Descriptor() ([]byte, []int) {
+	return file_v1_standalone_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SyncFilter) GetDataTypes() []string {
+	if x != nil {
+		return x.DataTypes
+	}
+	return nil
+}
+
+func (x *SyncFilter) GetWorkspaces() []string {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
 var File_v1_standalone_proto protoreflect.FileDescriptor
 
 const file_v1_standalone_proto_rawDesc = "" +
 	"\n" +
-	"\x13v1/standalone.proto\x12\bclonr.v1\x1a\x0fv1/common.proto\x1a\x10v1/profile.proto\x1a\x12v1/workspace.proto\x1a\x0fv1/config.proto\"l\n" +
+	"\x13v1/standalone.proto\x12\bclonr.v1\x1a\x0fv1/common.proto\x1a\x10v1/profile.proto\x1a\x12v1/workspace.proto\x1a\x0fv1/config.proto\"\x8b\x01\n" +
 	"\x13AuthenticateRequest\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x1b\n" +
 	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
 	"\vclient_name\x18\x03 \x01(\tR\n" +
-	"clientName\"\x8a\x01\n" +
+	"clientName\x12\x1d\n" +
+	"\n" +
+	"client_key\x18\x04 \x01(\fR\tclientKey\"\x8a\x01\n" +
 	"\x14AuthenticateResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x12\x1d\n" +
@@ -1233,7 +1609,22 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"\rsession_token\x18\x02 \x01(\tR\fsessionToken\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"\x8e\x01\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xee\x01\n" +
+	"\x0fRegisterRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1f\n" +
+	"\vclient_name\x18\x03 \x01(\tR\n" +
+	"clientName\x12\x1f\n" +
+	"\vdisplay_key\x18\x04 \x01(\tR\n" +
+	"displayKey\x12\x1a\n" +
+	"\bhostname\x18\x05 \x01(\tR\bhostname\x12\x0e\n" +
+	"\x02os\x18\x06 \x01(\tR\x02os\x12\x12\n" +
+	"\x04arch\x18\a \x01(\tR\x04arch\x12#\n" +
+	"\rclonr_version\x18\b \x01(\tR\fclonrVersion\"f\n" +
+	"\x10RegisterResponse\x12\x1a\n" +
+	"\bapproved\x18\x01 \x01(\bR\bapproved\x12 \n" +
+	"\vfingerprint\x18\x02 \x01(\tR\vfingerprint\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x8e\x01\n" +
 	"\fPingResponse\x12\x1f\n" +
 	"\vinstance_id\x18\x01 \x01(\tR\n" +
 	"instanceId\x12\x1f\n" +
@@ -1242,14 +1633,18 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12\"\n" +
 	"\fcapabilities\x18\x04 \x03(\tR\fcapabilities\"7\n" +
 	"\x10GetStatusRequest\x12#\n" +
-	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xe0\x01\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"\xe8\x02\n" +
 	"\x11GetStatusResponse\x12\x1f\n" +
 	"\vinstance_id\x18\x01 \x01(\tR\n" +
 	"instanceId\x12-\n" +
 	"\x12standalone_enabled\x18\x02 \x01(\bR\x11standaloneEnabled\x12\x1b\n" +
 	"\tlast_sync\x18\x03 \x01(\x03R\blastSync\x12)\n" +
 	"\x05stats\x18\x04 \x01(\v2\x13.clonr.v1.SyncStatsR\x05stats\x123\n" +
-	"\aclients\x18\x05 \x03(\v2\x19.clonr.v1.ConnectedClientR\aclients\"u\n" +
+	"\aclients\x18\x05 \x03(\v2\x19.clonr.v1.ConnectedClientR\aclients\x12H\n" +
+	"\trevisions\x18\x06 \x03(\v2*.clonr.v1.GetStatusResponse.RevisionsEntryR\trevisions\x1a<\n" +
+	"\x0eRevisionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"u\n" +
 	"\tSyncStats\x12\x1a\n" +
 	"\bprofiles\x18\x01 \x01(\x05R\bprofiles\x12\x1e\n" +
 	"\n" +
@@ -1265,26 +1660,39 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"\fconnected_at\x18\x04 \x01(\x03R\vconnectedAt\x12\x1b\n" +
 	"\tlast_seen\x18\x05 \x01(\x03R\blastSeen\x12\x1d\n" +
 	"\n" +
-	"sync_count\x18\x06 \x01(\x05R\tsyncCount\"z\n" +
+	"sync_count\x18\x06 \x01(\x05R\tsyncCount\"\xb1\x02\n" +
 	"\vSyncRequest\x12#\n" +
 	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12'\n" +
 	"\x0fsince_timestamp\x18\x02 \x01(\x03R\x0esinceTimestamp\x12\x1d\n" +
 	"\n" +
-	"item_types\x18\x03 \x03(\tR\titemTypes\"\x8f\x01\n" +
+	"item_types\x18\x03 \x03(\tR\titemTypes\x12\x1e\n" +
+	"\n" +
+	"workspaces\x18\x04 \x03(\tR\n" +
+	"workspaces\x12R\n" +
+	"\x0fsince_revisions\x18\x05 \x03(\v2).clonr.v1.SyncRequest.SinceRevisionsEntryR\x0esinceRevisions\x1aA\n" +
+	"\x13SinceRevisionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xe3\x01\n" +
 	"\rEncryptedData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
 	"\x0eencrypted_data\x18\x03 \x01(\fR\rencryptedData\x12\x14\n" +
 	"\x05nonce\x18\x04 \x01(\fR\x05nonce\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\x9e\x01\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x1c\n" +
+	"\tworkspace\x18\x06 \x01(\tR\tworkspace\x12\x1a\n" +
+	"\brevision\x18\a \x01(\x03R\brevision\x12\x18\n" +
+	"\adeleted\x18\b \x01(\bR\adeleted\"\xf2\x01\n" +
 	"\tSyncChunk\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12%\n" +
 	"\x0eencrypted_data\x18\x02 \x01(\fR\rencryptedData\x12\x14\n" +
 	"\x05nonce\x18\x03 \x01(\fR\x05nonce\x12\x1a\n" +
 	"\bsequence\x18\x04 \x01(\x05R\bsequence\x12\x14\n" +
 	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x0e\n" +
-	"\x02id\x18\x06 \x01(\tR\x02id\"\xc2\x02\n" +
+	"\x02id\x18\x06 \x01(\tR\x02id\x12\x1c\n" +
+	"\tworkspace\x18\a \x01(\tR\tworkspace\x12\x1a\n" +
+	"\brevision\x18\b \x01(\x03R\brevision\x12\x18\n" +
+	"\adeleted\x18\t \x01(\bR\adeleted\"\xc2\x02\n" +
 	"\rStandaloneKey\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1f\n" +
 	"\vinstance_id\x18\x02 \x01(\tR\n" +
@@ -1299,7 +1707,7 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\"\n" +
 	"\fcapabilities\x18\n" +
-	" \x03(\tR\fcapabilities\"\x9e\x02\n" +
+	" \x03(\tR\fcapabilities\"\xde\x02\n" +
 	"\x10StandaloneConfig\x12\x18\n" +
 	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1f\n" +
 	"\vinstance_id\x18\x02 \x01(\tR\n" +
@@ -1313,7 +1721,13 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"expires_at\x18\b \x01(\x03R\texpiresAt\x12\"\n" +
-	"\fcapabilities\x18\t \x03(\tR\fcapabilities\"\xda\x03\n" +
+	"\fcapabilities\x18\t \x03(\tR\fcapabilities\x12>\n" +
+	"\fauto_approve\x18\n" +
+	" \x01(\v2\x1b.clonr.v1.AutoApprovePolicyR\vautoApprove\"R\n" +
+	"\x11AutoApprovePolicy\x12\x1c\n" +
+	"\thostnames\x18\x01 \x03(\tR\thostnames\x12\x1f\n" +
+	"\vmax_clients\x18\x02 \x01(\x05R\n" +
+	"maxClients\"\xb1\x05\n" +
 	"\x14StandaloneConnection\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
 	"\vinstance_id\x18\x02 \x01(\tR\n" +
@@ -1333,10 +1747,24 @@ const file_v1_standalone_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\x03R\tupdatedAt2\xe5\x04\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\x125\n" +
+	"\vsync_filter\x18\x0e \x01(\v2\x14.clonr.v1.SyncFilterR\n" +
+	"syncFilter\x12[\n" +
+	"\x0facked_revisions\x18\x0f \x03(\v22.clonr.v1.StandaloneConnection.AckedRevisionsEntryR\x0eackedRevisions\x1aA\n" +
+	"\x13AckedRevisionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"K\n" +
+	"\n" +
+	"SyncFilter\x12\x1d\n" +
+	"\n" +
+	"data_types\x18\x01 \x03(\tR\tdataTypes\x12\x1e\n" +
+	"\n" +
+	"workspaces\x18\x02 \x03(\tR\n" +
+	"workspaces2\xa8\x05\n" +
 	"\x11StandaloneService\x12M\n" +
 	"\fAuthenticate\x12\x1d.clonr.v1.AuthenticateRequest\x1a\x1e.clonr.v1.AuthenticateResponse\x12M\n" +
-	"\fRefreshToken\x12\x1d.clonr.v1.RefreshTokenRequest\x1a\x1e.clonr.v1.RefreshTokenResponse\x12/\n" +
+	"\fRefreshToken\x12\x1d.clonr.v1.RefreshTokenRequest\x1a\x1e.clonr.v1.RefreshTokenResponse\x12A\n" +
+	"\bRegister\x12\x19.clonr.v1.RegisterRequest\x1a\x1a.clonr.v1.RegisterResponse\x12/\n" +
 	"\x04Ping\x12\x0f.clonr.v1.Empty\x1a\x16.clonr.v1.PingResponse\x12D\n" +
 	"\tGetStatus\x12\x1a.clonr.v1.GetStatusRequest\x1a\x1b.clonr.v1.GetStatusResponse\x12@\n" +
 	"\fSyncProfiles\x12\x15.clonr.v1.SyncRequest\x1a\x17.clonr.v1.EncryptedData0\x01\x12B\n" +
@@ -1359,52 +1787,66 @@ func file_v1_standalone_proto_rawDescGZIP() []byte {
 	return file_v1_standalone_proto_rawDescData
 }
 
-var file_v1_standalone_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_v1_standalone_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_v1_standalone_proto_goTypes = []any{
 	(*AuthenticateRequest)(nil),  // 0: clonr.v1.AuthenticateRequest
 	(*AuthenticateResponse)(nil), // 1: clonr.v1.AuthenticateResponse
 	(*RefreshTokenRequest)(nil),  // 2: clonr.v1.RefreshTokenRequest
 	(*RefreshTokenResponse)(nil), // 3: clonr.v1.RefreshTokenResponse
-	(*PingResponse)(nil),         // 4: clonr.v1.PingResponse
-	(*GetStatusRequest)(nil),     // 5: clonr.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),    // 6: clonr.v1.GetStatusResponse
-	(*SyncStats)(nil),            // 7: clonr.v1.SyncStats
-	(*ConnectedClient)(nil),      // 8: clonr.v1.ConnectedClient
-	(*SyncRequest)(nil),          // 9: clonr.v1.SyncRequest
-	(*EncryptedData)(nil),        // 10: clonr.v1.EncryptedData
-	(*SyncChunk)(nil),            // 11: clonr.v1.SyncChunk
-	(*StandaloneKey)(nil),        // 12: clonr.v1.StandaloneKey
-	(*StandaloneConfig)(nil),     // 13: clonr.v1.StandaloneConfig
-	(*StandaloneConnection)(nil), // 14: clonr.v1.StandaloneConnection
-	(*Empty)(nil),                // 15: clonr.v1.Empty
+	(*RegisterRequest)(nil),      // 4: clonr.v1.RegisterRequest
+	(*RegisterResponse)(nil),     // 5: clonr.v1.RegisterResponse
+	(*PingResponse)(nil),         // 6: clonr.v1.PingResponse
+	(*GetStatusRequest)(nil),     // 7: clonr.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),    // 8: clonr.v1.GetStatusResponse
+	(*SyncStats)(nil),            // 9: clonr.v1.SyncStats
+	(*ConnectedClient)(nil),      // 10: clonr.v1.ConnectedClient
+	(*SyncRequest)(nil),          // 11: clonr.v1.SyncRequest
+	(*EncryptedData)(nil),        // 12: clonr.v1.EncryptedData
+	(*SyncChunk)(nil),            // 13: clonr.v1.SyncChunk
+	(*StandaloneKey)(nil),        // 14: clonr.v1.StandaloneKey
+	(*StandaloneConfig)(nil),     // 15: clonr.v1.StandaloneConfig
+	(*AutoApprovePolicy)(nil),    // 16: clonr.v1.AutoApprovePolicy
+	(*StandaloneConnection)(nil), // 17: clonr.v1.StandaloneConnection
+	(*SyncFilter)(nil),           // 18: clonr.v1.SyncFilter
+	nil,                          // 19: clonr.v1.GetStatusResponse.RevisionsEntry
+	nil,                          // 20: clonr.v1.SyncRequest.SinceRevisionsEntry
+	nil,                          // 21: clonr.v1.StandaloneConnection.AckedRevisionsEntry
+	(*Empty)(nil),                // 22: clonr.v1.Empty
 }
 var file_v1_standalone_proto_depIdxs = []int32{
-	7,  // 0: clonr.v1.GetStatusResponse.stats:type_name -> clonr.v1.SyncStats
-	8,  // 1: clonr.v1.GetStatusResponse.clients:type_name -> clonr.v1.ConnectedClient
-	7,  // 2: clonr.v1.StandaloneConnection.synced_items:type_name -> clonr.v1.SyncStats
-	0,  // 3: clonr.v1.StandaloneService.Authenticate:input_type -> clonr.v1.AuthenticateRequest
-	2,  // 4: clonr.v1.StandaloneService.RefreshToken:input_type -> clonr.v1.RefreshTokenRequest
-	15, // 5: clonr.v1.StandaloneService.Ping:input_type -> clonr.v1.Empty
-	5,  // 6: clonr.v1.StandaloneService.GetStatus:input_type -> clonr.v1.GetStatusRequest
-	9,  // 7: clonr.v1.StandaloneService.SyncProfiles:input_type -> clonr.v1.SyncRequest
-	9,  // 8: clonr.v1.StandaloneService.SyncWorkspaces:input_type -> clonr.v1.SyncRequest
-	9,  // 9: clonr.v1.StandaloneService.SyncRepos:input_type -> clonr.v1.SyncRequest
-	9,  // 10: clonr.v1.StandaloneService.SyncConfig:input_type -> clonr.v1.SyncRequest
-	9,  // 11: clonr.v1.StandaloneService.FullSync:input_type -> clonr.v1.SyncRequest
-	1,  // 12: clonr.v1.StandaloneService.Authenticate:output_type -> clonr.v1.AuthenticateResponse
-	3,  // 13: clonr.v1.StandaloneService.RefreshToken:output_type -> clonr.v1.RefreshTokenResponse
-	4,  // 14: clonr.v1.StandaloneService.Ping:output_type -> clonr.v1.PingResponse
-	6,  // 15: clonr.v1.StandaloneService.GetStatus:output_type -> clonr.v1.GetStatusResponse
-	10, // 16: clonr.v1.StandaloneService.SyncProfiles:output_type -> clonr.v1.EncryptedData
-	10, // 17: clonr.v1.StandaloneService.SyncWorkspaces:output_type -> clonr.v1.EncryptedData
-	10, // 18: clonr.v1.StandaloneService.SyncRepos:output_type -> clonr.v1.EncryptedData
-	10, // 19: clonr.v1.StandaloneService.SyncConfig:output_type -> clonr.v1.EncryptedData
-	11, // 20: clonr.v1.StandaloneService.FullSync:output_type -> clonr.v1.SyncChunk
-	12, // [12:21] is the sub-list for method output_type
-	3,  // [3:12] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	9,  // 0: clonr.v1.GetStatusResponse.stats:type_name -> clonr.v1.SyncStats
+	10, // 1: clonr.v1.GetStatusResponse.clients:type_name -> clonr.v1.ConnectedClient
+	19, // 2: clonr.v1.GetStatusResponse.revisions:type_name -> clonr.v1.GetStatusResponse.RevisionsEntry
+	20, // 3: clonr.v1.SyncRequest.since_revisions:type_name -> clonr.v1.SyncRequest.SinceRevisionsEntry
+	16, // 4: clonr.v1.StandaloneConfig.auto_approve:type_name -> clonr.v1.AutoApprovePolicy
+	9,  // 5: clonr.v1.StandaloneConnection.synced_items:type_name -> clonr.v1.SyncStats
+	18, // 6: clonr.v1.StandaloneConnection.sync_filter:type_name -> clonr.v1.SyncFilter
+	21, // 7: clonr.v1.StandaloneConnection.acked_revisions:type_name -> clonr.v1.StandaloneConnection.AckedRevisionsEntry
+	0,  // 8: clonr.v1.StandaloneService.Authenticate:input_type -> clonr.v1.AuthenticateRequest
+	2,  // 9: clonr.v1.StandaloneService.RefreshToken:input_type -> clonr.v1.RefreshTokenRequest
+	4,  // 10: clonr.v1.StandaloneService.Register:input_type -> clonr.v1.RegisterRequest
+	22, // 11: clonr.v1.StandaloneService.Ping:input_type -> clonr.v1.Empty
+	7,  // 12: clonr.v1.StandaloneService.GetStatus:input_type -> clonr.v1.GetStatusRequest
+	11, // 13: clonr.v1.StandaloneService.SyncProfiles:input_type -> clonr.v1.SyncRequest
+	11, // 14: clonr.v1.StandaloneService.SyncWorkspaces:input_type -> clonr.v1.SyncRequest
+	11, // 15: clonr.v1.StandaloneService.SyncRepos:input_type -> clonr.v1.SyncRequest
+	11, // 16: clonr.v1.StandaloneService.SyncConfig:input_type -> clonr.v1.SyncRequest
+	11, // 17: clonr.v1.StandaloneService.FullSync:input_type -> clonr.v1.SyncRequest
+	1,  // 18: clonr.v1.StandaloneService.Authenticate:output_type -> clonr.v1.AuthenticateResponse
+	3,  // 19: clonr.v1.StandaloneService.RefreshToken:output_type -> clonr.v1.RefreshTokenResponse
+	5,  // 20: clonr.v1.StandaloneService.Register:output_type -> clonr.v1.RegisterResponse
+	6,  // 21: clonr.v1.StandaloneService.Ping:output_type -> clonr.v1.PingResponse
+	8,  // 22: clonr.v1.StandaloneService.GetStatus:output_type -> clonr.v1.GetStatusResponse
+	12, // 23: clonr.v1.StandaloneService.SyncProfiles:output_type -> clonr.v1.EncryptedData
+	12, // 24: clonr.v1.StandaloneService.SyncWorkspaces:output_type -> clonr.v1.EncryptedData
+	12, // 25: clonr.v1.StandaloneService.SyncRepos:output_type -> clonr.v1.EncryptedData
+	12, // 26: clonr.v1.StandaloneService.SyncConfig:output_type -> clonr.v1.EncryptedData
+	13, // 27: clonr.v1.StandaloneService.FullSync:output_type -> clonr.v1.SyncChunk
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_v1_standalone_proto_init() }
@@ -1422,7 +1864,7 @@ func file_v1_standalone_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_standalone_proto_rawDesc), len(file_v1_standalone_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
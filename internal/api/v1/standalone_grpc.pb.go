@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	StandaloneService_Authenticate_FullMethodName   = "/clonr.v1.StandaloneService/Authenticate"
 	StandaloneService_RefreshToken_FullMethodName   = "/clonr.v1.StandaloneService/RefreshToken"
+	StandaloneService_Register_FullMethodName       = "/clonr.v1.StandaloneService/Register"
 	StandaloneService_Ping_FullMethodName           = "/clonr.v1.StandaloneService/Ping"
 	StandaloneService_GetStatus_FullMethodName      = "/clonr.v1.StandaloneService/GetStatus"
 	StandaloneService_SyncProfiles_FullMethodName   = "/clonr.v1.StandaloneService/SyncProfiles"
@@ -41,6 +42,8 @@ type StandaloneServiceClient interface {
 	// Authentication
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Registration - a new client submits its encryption key for approval
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
 	// Status
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PingResponse, error)
 	GetStatus(ctx context.Context, in *GetStatusRequest, opts ...grpc.CallOption) (*GetStatusResponse, error)
@@ -81,6 +84,16 @@ func (c *standaloneServiceClient) RefreshToken(ctx context.Context, in *RefreshT
 	return out, nil
 }
 
+func (c *standaloneServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, StandaloneService_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *standaloneServiceClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PingResponse)
@@ -198,6 +211,8 @@ type StandaloneServiceServer interface {
 	// Authentication
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Registration - a new client submits its encryption key for approval
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
 	// Status
 	Ping(context.Context, *Empty) (*PingResponse, error)
 	GetStatus(context.Context, *GetStatusRequest) (*GetStatusResponse, error)
@@ -224,6 +239,9 @@ func (UnimplementedStandaloneServiceServer) Authenticate(context.Context, *Authe
 func (UnimplementedStandaloneServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
 }
+func (UnimplementedStandaloneServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Register not implemented")
+}
 func (UnimplementedStandaloneServiceServer) Ping(context.Context, *Empty) (*PingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Ping not implemented")
 }
@@ -302,6 +320,24 @@ func _StandaloneService_RefreshToken_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StandaloneService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StandaloneServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StandaloneService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StandaloneServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StandaloneService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -415,6 +451,10 @@ var StandaloneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _StandaloneService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "Register",
+			Handler:    _StandaloneService_Register_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _StandaloneService_Ping_Handler,
@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/vault_secret.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VaultSecret is an arbitrary secret sealed by the client before it is stored
+type VaultSecret struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Encrypted value
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultSecret) Reset() {
+	*x = VaultSecret{}
+	mi := &file_v1_vault_secret_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultSecret) ProtoMessage() {}
+
+func (x *VaultSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultSecret.ProtoReflect.Descriptor instead.
+func (*VaultSecret) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VaultSecret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *VaultSecret) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *VaultSecret) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VaultSecret) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SaveVaultSecret RPC messages
+type SaveVaultSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *VaultSecret           `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveVaultSecretRequest) Reset() {
+	*x = SaveVaultSecretRequest{}
+	mi := &file_v1_vault_secret_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveVaultSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVaultSecretRequest) ProtoMessage() {}
+
+func (x *SaveVaultSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVaultSecretRequest.ProtoReflect.Descriptor instead.
+func (*SaveVaultSecretRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveVaultSecretRequest) GetSecret() *VaultSecret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type SaveVaultSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveVaultSecretResponse) Reset() {
+	*x = SaveVaultSecretResponse{}
+	mi := &file_v1_vault_secret_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveVaultSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveVaultSecretResponse) ProtoMessage() {}
+
+func (x *SaveVaultSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveVaultSecretResponse.ProtoReflect.Descriptor instead.
+func (*SaveVaultSecretResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveVaultSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetVaultSecret RPC messages
+type GetVaultSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultSecretRequest) Reset() {
+	*x = GetVaultSecretRequest{}
+	mi := &file_v1_vault_secret_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultSecretRequest) ProtoMessage() {}
+
+func (x *GetVaultSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultSecretRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetVaultSecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetVaultSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *VaultSecret           `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"` // Unset when no secret has the key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultSecretResponse) Reset() {
+	*x = GetVaultSecretResponse{}
+	mi := &file_v1_vault_secret_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultSecretResponse) ProtoMessage() {}
+
+func (x *GetVaultSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetVaultSecretResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVaultSecretResponse) GetSecret() *VaultSecret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+// ListVaultSecrets RPC messages
+type ListVaultSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultSecretsRequest) Reset() {
+	*x = ListVaultSecretsRequest{}
+	mi := &file_v1_vault_secret_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultSecretsRequest) ProtoMessage() {}
+
+func (x *ListVaultSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListVaultSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{5}
+}
+
+type ListVaultSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*VaultSecret         `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultSecretsResponse) Reset() {
+	*x = ListVaultSecretsResponse{}
+	mi := &file_v1_vault_secret_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultSecretsResponse) ProtoMessage() {}
+
+func (x *ListVaultSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListVaultSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListVaultSecretsResponse) GetSecrets() []*VaultSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// DeleteVaultSecret RPC messages
+type DeleteVaultSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVaultSecretRequest) Reset() {
+	*x = DeleteVaultSecretRequest{}
+	mi := &file_v1_vault_secret_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVaultSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultSecretRequest) ProtoMessage() {}
+
+func (x *DeleteVaultSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVaultSecretRequest) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteVaultSecretRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteVaultSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVaultSecretResponse) Reset() {
+	*x = DeleteVaultSecretResponse{}
+	mi := &file_v1_vault_secret_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVaultSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultSecretResponse) ProtoMessage() {}
+
+func (x *DeleteVaultSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_vault_secret_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVaultSecretResponse) Descriptor() ([]byte, []int) {
+	return file_v1_vault_secret_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteVaultSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_vault_secret_proto protoreflect.FileDescriptor
+
+const file_v1_vault_secret_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/vault_secret.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xab\x01\n" +
+	"\vVaultSecret\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"G\n" +
+	"\x16SaveVaultSecretRequest\x12-\n" +
+	"\x06secret\x18\x01 \x01(\v2\x15.clonr.v1.VaultSecretR\x06secret\"3\n" +
+	"\x17SaveVaultSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\")\n" +
+	"\x15GetVaultSecretRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"G\n" +
+	"\x16GetVaultSecretResponse\x12-\n" +
+	"\x06secret\x18\x01 \x01(\v2\x15.clonr.v1.VaultSecretR\x06secret\"\x19\n" +
+	"\x17ListVaultSecretsRequest\"K\n" +
+	"\x18ListVaultSecretsResponse\x12/\n" +
+	"\asecrets\x18\x01 \x03(\v2\x15.clonr.v1.VaultSecretR\asecrets\",\n" +
+	"\x18DeleteVaultSecretRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"5\n" +
+	"\x19DeleteVaultSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x93\x01\n" +
+	"\fcom.clonr.v1B\x10VaultSecretProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_vault_secret_proto_rawDescOnce sync.Once
+	file_v1_vault_secret_proto_rawDescData []byte
+)
+
+func file_v1_vault_secret_proto_rawDescGZIP() []byte {
+	file_v1_vault_secret_proto_rawDescOnce.Do(func() {
+		file_v1_vault_secret_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_vault_secret_proto_rawDesc), len(file_v1_vault_secret_proto_rawDesc)))
+	})
+	return file_v1_vault_secret_proto_rawDescData
+}
+
+var file_v1_vault_secret_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_v1_vault_secret_proto_goTypes = []any{
+	(*VaultSecret)(nil),               // 0: clonr.v1.VaultSecret
+	(*SaveVaultSecretRequest)(nil),    // 1: clonr.v1.SaveVaultSecretRequest
+	(*SaveVaultSecretResponse)(nil),   // 2: clonr.v1.SaveVaultSecretResponse
+	(*GetVaultSecretRequest)(nil),     // 3: clonr.v1.GetVaultSecretRequest
+	(*GetVaultSecretResponse)(nil),    // 4: clonr.v1.GetVaultSecretResponse
+	(*ListVaultSecretsRequest)(nil),   // 5: clonr.v1.ListVaultSecretsRequest
+	(*ListVaultSecretsResponse)(nil),  // 6: clonr.v1.ListVaultSecretsResponse
+	(*DeleteVaultSecretRequest)(nil),  // 7: clonr.v1.DeleteVaultSecretRequest
+	(*DeleteVaultSecretResponse)(nil), // 8: clonr.v1.DeleteVaultSecretResponse
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+}
+var file_v1_vault_secret_proto_depIdxs = []int32{
+	9, // 0: clonr.v1.VaultSecret.created_at:type_name -> google.protobuf.Timestamp
+	9, // 1: clonr.v1.VaultSecret.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: clonr.v1.SaveVaultSecretRequest.secret:type_name -> clonr.v1.VaultSecret
+	0, // 3: clonr.v1.GetVaultSecretResponse.secret:type_name -> clonr.v1.VaultSecret
+	0, // 4: clonr.v1.ListVaultSecretsResponse.secrets:type_name -> clonr.v1.VaultSecret
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_vault_secret_proto_init() }
+func file_v1_vault_secret_proto_init() {
+	if File_v1_vault_secret_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_vault_secret_proto_rawDesc), len(file_v1_vault_secret_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_vault_secret_proto_goTypes,
+		DependencyIndexes: file_v1_vault_secret_proto_depIdxs,
+		MessageInfos:      file_v1_vault_secret_proto_msgTypes,
+	}.Build()
+	File_v1_vault_secret_proto = out.File
+	file_v1_vault_secret_proto_goTypes = nil
+	file_v1_vault_secret_proto_depIdxs = nil
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: v1/wizard_draft.proto
+
+package clonrv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WizardDraft is the saved input of an interrupted interactive flow
+type WizardDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"` // Flow-specific JSON state
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WizardDraft) Reset() {
+	*x = WizardDraft{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WizardDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WizardDraft) ProtoMessage() {}
+
+func (x *WizardDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WizardDraft.ProtoReflect.Descriptor instead.
+func (*WizardDraft) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WizardDraft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WizardDraft) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WizardDraft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SaveWizardDraft RPC messages
+type SaveWizardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *WizardDraft           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveWizardDraftRequest) Reset() {
+	*x = SaveWizardDraftRequest{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveWizardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveWizardDraftRequest) ProtoMessage() {}
+
+func (x *SaveWizardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveWizardDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveWizardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SaveWizardDraftRequest) GetDraft() *WizardDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type SaveWizardDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveWizardDraftResponse) Reset() {
+	*x = SaveWizardDraftResponse{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveWizardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveWizardDraftResponse) ProtoMessage() {}
+
+func (x *SaveWizardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveWizardDraftResponse.ProtoReflect.Descriptor instead.
+func (*SaveWizardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SaveWizardDraftResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetWizardDraft RPC messages
+type GetWizardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWizardDraftRequest) Reset() {
+	*x = GetWizardDraftRequest{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWizardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWizardDraftRequest) ProtoMessage() {}
+
+func (x *GetWizardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWizardDraftRequest.ProtoReflect.Descriptor instead.
+func (*GetWizardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWizardDraftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetWizardDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *WizardDraft           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"` // Unset when no draft is saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWizardDraftResponse) Reset() {
+	*x = GetWizardDraftResponse{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWizardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWizardDraftResponse) ProtoMessage() {}
+
+func (x *GetWizardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWizardDraftResponse.ProtoReflect.Descriptor instead.
+func (*GetWizardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWizardDraftResponse) GetDraft() *WizardDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+// DeleteWizardDraft RPC messages
+type DeleteWizardDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWizardDraftRequest) Reset() {
+	*x = DeleteWizardDraftRequest{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWizardDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWizardDraftRequest) ProtoMessage() {}
+
+func (x *DeleteWizardDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWizardDraftRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWizardDraftRequest) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteWizardDraftRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteWizardDraftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWizardDraftResponse) Reset() {
+	*x = DeleteWizardDraftResponse{}
+	mi := &file_v1_wizard_draft_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWizardDraftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWizardDraftResponse) ProtoMessage() {}
+
+func (x *DeleteWizardDraftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_wizard_draft_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWizardDraftResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWizardDraftResponse) Descriptor() ([]byte, []int) {
+	return file_v1_wizard_draft_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteWizardDraftResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_v1_wizard_draft_proto protoreflect.FileDescriptor
+
+const file_v1_wizard_draft_proto_rawDesc = "" +
+	"\n" +
+	"\x15v1/wizard_draft.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"p\n" +
+	"\vWizardDraft\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"E\n" +
+	"\x16SaveWizardDraftRequest\x12+\n" +
+	"\x05draft\x18\x01 \x01(\v2\x15.clonr.v1.WizardDraftR\x05draft\"3\n" +
+	"\x17SaveWizardDraftResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
+	"\x15GetWizardDraftRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
+	"\x16GetWizardDraftResponse\x12+\n" +
+	"\x05draft\x18\x01 \x01(\v2\x15.clonr.v1.WizardDraftR\x05draft\".\n" +
+	"\x18DeleteWizardDraftRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"5\n" +
+	"\x19DeleteWizardDraftResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccessB\x93\x01\n" +
+	"\fcom.clonr.v1B\x10WizardDraftProtoP\x01Z0github.com/inovacc/clonr/internal/api/v1;clonrv1\xa2\x02\x03CXX\xaa\x02\bClonr.V1\xca\x02\bClonr\\V1\xe2\x02\x14Clonr\\V1\\GPBMetadata\xea\x02\tClonr::V1b\x06proto3"
+
+var (
+	file_v1_wizard_draft_proto_rawDescOnce sync.Once
+	file_v1_wizard_draft_proto_rawDescData []byte
+)
+
+func file_v1_wizard_draft_proto_rawDescGZIP() []byte {
+	file_v1_wizard_draft_proto_rawDescOnce.Do(func() {
+		file_v1_wizard_draft_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v1_wizard_draft_proto_rawDesc), len(file_v1_wizard_draft_proto_rawDesc)))
+	})
+	return file_v1_wizard_draft_proto_rawDescData
+}
+
+var file_v1_wizard_draft_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_v1_wizard_draft_proto_goTypes = []any{
+	(*WizardDraft)(nil),               // 0: clonr.v1.WizardDraft
+	(*SaveWizardDraftRequest)(nil),    // 1: clonr.v1.SaveWizardDraftRequest
+	(*SaveWizardDraftResponse)(nil),   // 2: clonr.v1.SaveWizardDraftResponse
+	(*GetWizardDraftRequest)(nil),     // 3: clonr.v1.GetWizardDraftRequest
+	(*GetWizardDraftResponse)(nil),    // 4: clonr.v1.GetWizardDraftResponse
+	(*DeleteWizardDraftRequest)(nil),  // 5: clonr.v1.DeleteWizardDraftRequest
+	(*DeleteWizardDraftResponse)(nil), // 6: clonr.v1.DeleteWizardDraftResponse
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+}
+var file_v1_wizard_draft_proto_depIdxs = []int32{
+	7, // 0: clonr.v1.WizardDraft.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: clonr.v1.SaveWizardDraftRequest.draft:type_name -> clonr.v1.WizardDraft
+	0, // 2: clonr.v1.GetWizardDraftResponse.draft:type_name -> clonr.v1.WizardDraft
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_wizard_draft_proto_init() }
+func file_v1_wizard_draft_proto_init() {
+	if File_v1_wizard_draft_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_wizard_draft_proto_rawDesc), len(file_v1_wizard_draft_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_wizard_draft_proto_goTypes,
+		DependencyIndexes: file_v1_wizard_draft_proto_depIdxs,
+		MessageInfos:      file_v1_wizard_draft_proto_msgTypes,
+	}.Build()
+	File_v1_wizard_draft_proto = out.File
+	file_v1_wizard_draft_proto_goTypes = nil
+	file_v1_wizard_draft_proto_depIdxs = nil
+}
@@ -24,15 +24,17 @@ const (
 
 // Workspace represents a logical grouping of repositories
 type Workspace struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Path            string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Active          bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UrlPatterns     []string               `protobuf:"bytes,7,rep,name=url_patterns,json=urlPatterns,proto3" json:"url_patterns,omitempty"`                // Globs routing clones here, e.g. github.com/my-company/*
+	DiskBudgetBytes int64                  `protobuf:"varint,8,opt,name=disk_budget_bytes,json=diskBudgetBytes,proto3" json:"disk_budget_bytes,omitempty"` // Disk budget for the workspace's repositories (0 = none)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Workspace) Reset() {
@@ -107,6 +109,20 @@ func (x *Workspace) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Workspace) GetUrlPatterns() []string {
+	if x != nil {
+		return x.UrlPatterns
+	}
+	return nil
+}
+
+func (x *Workspace) GetDiskBudgetBytes() int64 {
+	if x != nil {
+		return x.DiskBudgetBytes
+	}
+	return 0
+}
+
 // SaveWorkspace RPC messages
 type SaveWorkspaceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -904,7 +920,7 @@ var File_v1_workspace_proto protoreflect.FileDescriptor
 
 const file_v1_workspace_proto_rawDesc = "" +
 	"\n" +
-	"\x12v1/workspace.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x01\n" +
+	"\x12v1/workspace.proto\x12\bclonr.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x02\n" +
 	"\tWorkspace\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
@@ -913,7 +929,9 @@ const file_v1_workspace_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"I\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\furl_patterns\x18\a \x03(\tR\vurlPatterns\x12*\n" +
+	"\x11disk_budget_bytes\x18\b \x01(\x03R\x0fdiskBudgetBytes\"I\n" +
 	"\x14SaveWorkspaceRequest\x121\n" +
 	"\tworkspace\x18\x01 \x01(\v2\x13.clonr.v1.WorkspaceR\tworkspace\"1\n" +
 	"\x15SaveWorkspaceResponse\x12\x18\n" +
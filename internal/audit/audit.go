@@ -0,0 +1,222 @@
+// Package audit records access to sensitive data such as decrypted channel
+// secrets. Events are appended as JSON lines to a log file in the clonr data
+// directory, so both the CLI and the server process can write to it.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inovacc/clonr/internal/params"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionDecrypt = "decrypt"
+)
+
+// Event is a single audit log entry.
+type Event struct {
+	// Time is when the access happened
+	Time time.Time `json:"time"`
+
+	// Action is what was done (e.g. decrypt)
+	Action string `json:"action"`
+
+	// Resource identifies the kind of secret (channel, slack_account, vault)
+	Resource string `json:"resource"`
+
+	// Profile is the profile or account owning the secret
+	Profile string `json:"profile"`
+
+	// Type is the channel or secret type (slack, gmail, ...)
+	Type string `json:"type"`
+
+	// Name is the channel or account name
+	Name string `json:"name,omitempty"`
+
+	// Keys lists the sensitive config keys that were decrypted
+	Keys []string `json:"keys,omitempty"`
+
+	// Command is the clonr command that requested the secret
+	Command string `json:"command,omitempty"`
+
+	// Client identifies the requesting process (cli or server, user, host, pid)
+	Client string `json:"client"`
+
+	// Success reports whether decryption succeeded
+	Success bool `json:"success"`
+}
+
+// Filter selects events when reading the audit log.
+type Filter struct {
+	Since   time.Time // Only events at or after this time (zero = all)
+	Profile string    // Only events for this profile (empty = all)
+	Type    string    // Only events for this type (empty = all)
+	Limit   int       // Maximum number of most recent events (0 = all)
+}
+
+const secretsLogFile = "secrets.log"
+
+var (
+	mu      sync.Mutex
+	command string
+	role    = "cli"
+	logPath string
+)
+
+// SetCommand sets the command name recorded with subsequent events.
+func SetCommand(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	command = name
+}
+
+// SetRole sets the process role recorded in the client identity (cli or server).
+func SetRole(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	role = name
+}
+
+// SetLogPath overrides the audit log location (used in tests).
+func SetLogPath(path string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	logPath = path
+}
+
+// LogPath returns the path of the secrets audit log.
+func LogPath() string {
+	if logPath != "" {
+		return logPath
+	}
+
+	return filepath.Join(params.AppdataDir, "audit", secretsLogFile)
+}
+
+// RecordDecrypt records a decryption of secret values.
+// Failures to write the audit log never block the caller.
+func RecordDecrypt(resource, profile, secretType, name string, keys []string, success bool) {
+	_ = Record(Event{
+		Action:   ActionDecrypt,
+		Resource: resource,
+		Profile:  profile,
+		Type:     secretType,
+		Name:     name,
+		Keys:     keys,
+		Success:  success,
+	})
+}
+
+// Record appends an event to the audit log, filling in time, command and client.
+func Record(ev Event) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	if ev.Command == "" {
+		ev.Command = command
+	}
+
+	if ev.Client == "" {
+		ev.Client = clientIdentity()
+	}
+
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	path := LogPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create audit directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
+
+// Read returns events from the audit log matching the filter, oldest first.
+func Read(filter Filter) ([]Event, error) {
+	f, err := os.Open(LogPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	var events []Event
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev Event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			continue // Skip corrupt lines
+		}
+
+		if !filter.Since.IsZero() && ev.Time.Before(filter.Since) {
+			continue
+		}
+
+		if filter.Profile != "" && ev.Profile != filter.Profile {
+			continue
+		}
+
+		if filter.Type != "" && !strings.EqualFold(ev.Type, filter.Type) {
+			continue
+		}
+
+		events = append(events, ev)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	if filter.Limit > 0 && len(events) > filter.Limit {
+		events = events[len(events)-filter.Limit:]
+	}
+
+	return events, nil
+}
+
+// clientIdentity describes the current process as role:user@host (pid N).
+func clientIdentity() string {
+	username := "unknown"
+	if u, err := user.Current(); err == nil {
+		username = u.Username
+	}
+
+	hostname, _ := os.Hostname()
+
+	return fmt.Sprintf("%s:%s@%s (pid %d)", role, username, hostname, os.Getpid())
+}
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordAndRead(t *testing.T) {
+	SetLogPath(filepath.Join(t.TempDir(), "secrets.log"))
+	t.Cleanup(func() { SetLogPath("") })
+
+	SetCommand("clonr slack send")
+
+	RecordDecrypt("channel", "work", "slack", "team", []string{"bot_token"}, true)
+	RecordDecrypt("channel", "work", "gmail", "inbox", []string{"access_token", "refresh_token"}, true)
+	RecordDecrypt("channel", "personal", "slack", "home", []string{"bot_token"}, false)
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{name: "all", filter: Filter{}, want: 3},
+		{name: "by profile", filter: Filter{Profile: "work"}, want: 2},
+		{name: "by type", filter: Filter{Type: "SLACK"}, want: 2},
+		{name: "limit", filter: Filter{Limit: 1}, want: 1},
+		{name: "since future", filter: Filter{Since: time.Now().Add(time.Hour)}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := Read(tt.filter)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+
+			if len(events) != tt.want {
+				t.Errorf("Read() returned %d events, want %d", len(events), tt.want)
+			}
+		})
+	}
+
+	events, err := Read(Filter{Limit: 1})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	ev := events[0]
+	if ev.Profile != "personal" || ev.Success {
+		t.Errorf("last event = %+v, want failed access for personal", ev)
+	}
+
+	if ev.Command != "clonr slack send" {
+		t.Errorf("Command = %q, want %q", ev.Command, "clonr slack send")
+	}
+
+	if ev.Client == "" || ev.Action != ActionDecrypt {
+		t.Errorf("event missing client or action: %+v", ev)
+	}
+}
+
+func TestReadMissingLog(t *testing.T) {
+	SetLogPath(filepath.Join(t.TempDir(), "missing.log"))
+	t.Cleanup(func() { SetLogPath("") })
+
+	events, err := Read(Filter{})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+
+	if len(events) != 0 {
+		t.Errorf("Read() returned %d events, want 0", len(events))
+	}
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+// APITokenPrefix starts every API token of the clonr server, so leaked
+// tokens are easy to recognize
+const APITokenPrefix = "clonr_"
+
+// NewAPIToken generates a random API token and returns it with its hash.
+// Only the hash is stored; the token is shown to the user once.
+func NewAPIToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	token = APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return token, HashAPIToken(token), nil
+}
+
+// HashAPIToken returns the hex-encoded SHA-256 hash an API token is stored as
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
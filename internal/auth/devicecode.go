@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrDeviceCodeExpired is returned when the user code expires before it is entered
+	ErrDeviceCodeExpired = errors.New("device code expired")
+
+	// ErrDeviceAccessDenied is returned when the user denies the authorization
+	ErrDeviceAccessDenied = errors.New("authorization denied by user")
+)
+
+var (
+	// defaultPollInterval is used when the provider does not send an interval
+	defaultPollInterval = 5 * time.Second
+
+	// slowDownStep is added to the interval on each slow_down response (RFC 8628 §3.5)
+	slowDownStep = 5 * time.Second
+)
+
+// DeviceFlowConfig describes an OAuth 2.0 device authorization grant (RFC 8628),
+// used on consoles and servers where no browser can reach a localhost callback.
+type DeviceFlowConfig struct {
+	DeviceCodeURL string
+	TokenURL      string
+	ClientID      string
+	ClientSecret  string // Required by Google, unused by GitHub
+	Scopes        []string
+	HTTPClient    *http.Client
+}
+
+// DeviceCode is the code the user enters at the verification URL.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+
+	// VerificationURIComplete embeds the user code, when the provider supports it
+	VerificationURIComplete string `json:"verification_uri_complete"`
+
+	// VerificationURL is the name Google uses for verification_uri
+	VerificationURL string `json:"verification_url"`
+}
+
+// DeviceToken is the token issued once the user authorized the device.
+type DeviceToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	Scope        string `json:"scope"`
+	IDToken      string `json:"id_token"`
+}
+
+// deviceTokenResponse is a token response, which carries an error code while
+// the authorization is pending
+type deviceTokenResponse struct {
+	DeviceToken
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+// RunDeviceFlow requests a device code, passes it to display and polls until
+// the user authorizes the device, denies it, the code expires or ctx is done.
+func RunDeviceFlow(ctx context.Context, config DeviceFlowConfig, display func(*DeviceCode)) (*DeviceToken, error) {
+	code, err := RequestDeviceCode(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if display != nil {
+		display(code)
+	}
+
+	return PollDeviceToken(ctx, config, code)
+}
+
+// RequestDeviceCode starts a device flow and returns the code to show the user.
+func RequestDeviceCode(ctx context.Context, config DeviceFlowConfig) (*DeviceCode, error) {
+	data := url.Values{}
+	data.Set("client_id", config.ClientID)
+
+	if len(config.Scopes) > 0 {
+		data.Set("scope", strings.Join(config.Scopes, " "))
+	}
+
+	body, status, err := postForm(ctx, config, config.DeviceCodeURL, data)
+	if err != nil {
+		return nil, fmt.Errorf("device code request failed: %w", err)
+	}
+
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("device code request error %d: %s", status, string(body))
+	}
+
+	var code DeviceCode
+	if err := json.Unmarshal(body, &code); err != nil {
+		return nil, fmt.Errorf("failed to decode device code response: %w", err)
+	}
+
+	if code.VerificationURI == "" {
+		code.VerificationURI = code.VerificationURL
+	}
+
+	if code.DeviceCode == "" || code.UserCode == "" || code.VerificationURI == "" {
+		return nil, fmt.Errorf("incomplete device code response: %s", string(body))
+	}
+
+	return &code, nil
+}
+
+// PollDeviceToken polls the token endpoint at the interval the provider asks
+// for until the device code is authorized.
+func PollDeviceToken(ctx context.Context, config DeviceFlowConfig, code *DeviceCode) (*DeviceToken, error) {
+	interval := defaultPollInterval
+	if code.Interval > 0 {
+		interval = time.Duration(code.Interval) * time.Second
+	}
+
+	var deadline <-chan time.Time
+
+	if code.ExpiresIn > 0 {
+		timer := time.NewTimer(time.Duration(code.ExpiresIn) * time.Second)
+		defer timer.Stop()
+
+		deadline = timer.C
+	}
+
+	data := url.Values{}
+	data.Set("client_id", config.ClientID)
+	data.Set("device_code", code.DeviceCode)
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+
+	if config.ClientSecret != "" {
+		data.Set("client_secret", config.ClientSecret)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, ErrDeviceCodeExpired
+		case <-time.After(interval):
+		}
+
+		body, status, err := postForm(ctx, config, config.TokenURL, data)
+		if err != nil {
+			return nil, fmt.Errorf("token request failed: %w", err)
+		}
+
+		var resp deviceTokenResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("token request error %d: %s", status, string(body))
+		}
+
+		switch resp.Error {
+		case "":
+			if resp.AccessToken == "" {
+				return nil, fmt.Errorf("token request error %d: %s", status, string(body))
+			}
+
+			return &resp.DeviceToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownStep
+			if resp.Interval > 0 {
+				interval = max(interval, time.Duration(resp.Interval)*time.Second)
+			}
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		case "access_denied":
+			return nil, ErrDeviceAccessDenied
+		default:
+			if resp.ErrorDescription != "" {
+				return nil, fmt.Errorf("token request error: %s: %s", resp.Error, resp.ErrorDescription)
+			}
+
+			return nil, fmt.Errorf("token request error: %s", resp.Error)
+		}
+	}
+}
+
+// postForm posts form data and returns the response body and status. JSON
+// is requested because GitHub answers form-encoded by default.
+func postForm(ctx context.Context, config DeviceFlowConfig, endpoint string, data url.Values) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := config.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return body, resp.StatusCode, nil
+}
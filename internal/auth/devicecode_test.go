@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// deviceServer serves a device code endpoint and a token endpoint that
+// answers with the given errors before issuing a token
+func deviceServer(t *testing.T, pending []string) (*httptest.Server, *int) {
+	t.Helper()
+
+	polls := 0
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST /device", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != "client" || r.FormValue("scope") != "a b" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"device_code":"dev","user_code":"ABCD-EFGH","verification_url":"https://example.com/device","expires_in":60,"interval":0}`))
+	})
+
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("device_code") != "dev" || r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if polls < len(pending) {
+			polls++
+
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"` + pending[polls-1] + `"}`))
+
+			return
+		}
+
+		polls++
+		_, _ = w.Write([]byte(`{"access_token":"tok","refresh_token":"ref","scope":"a b"}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv, &polls
+}
+
+func fastPolling(t *testing.T) {
+	t.Helper()
+
+	interval, step := defaultPollInterval, slowDownStep
+	defaultPollInterval, slowDownStep = time.Millisecond, time.Millisecond
+
+	t.Cleanup(func() { defaultPollInterval, slowDownStep = interval, step })
+}
+
+func TestRunDeviceFlow(t *testing.T) {
+	fastPolling(t)
+
+	srv, polls := deviceServer(t, []string{"authorization_pending", "slow_down", "authorization_pending"})
+
+	var shown *DeviceCode
+
+	token, err := RunDeviceFlow(context.Background(), DeviceFlowConfig{
+		DeviceCodeURL: srv.URL + "/device",
+		TokenURL:      srv.URL + "/token",
+		ClientID:      "client",
+		Scopes:        []string{"a", "b"},
+	}, func(code *DeviceCode) { shown = code })
+	if err != nil {
+		t.Fatalf("RunDeviceFlow() error = %v", err)
+	}
+
+	if shown == nil || shown.UserCode != "ABCD-EFGH" || shown.VerificationURI != "https://example.com/device" {
+		t.Errorf("RunDeviceFlow() displayed %+v", shown)
+	}
+
+	if token.AccessToken != "tok" || token.RefreshToken != "ref" {
+		t.Errorf("RunDeviceFlow() = %+v", token)
+	}
+
+	if *polls != 4 {
+		t.Errorf("token endpoint polled %d times, want 4", *polls)
+	}
+}
+
+func TestRunDeviceFlowErrors(t *testing.T) {
+	fastPolling(t)
+
+	tests := []struct {
+		name    string
+		pending []string
+		want    error
+	}{
+		{"denied", []string{"authorization_pending", "access_denied"}, ErrDeviceAccessDenied},
+		{"expired", []string{"expired_token"}, ErrDeviceCodeExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := deviceServer(t, tt.pending)
+
+			_, err := RunDeviceFlow(context.Background(), DeviceFlowConfig{
+				DeviceCodeURL: srv.URL + "/device",
+				TokenURL:      srv.URL + "/token",
+				ClientID:      "client",
+				Scopes:        []string{"a", "b"},
+			}, nil)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("RunDeviceFlow() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPollDeviceTokenCancelled(t *testing.T) {
+	srv, polls := deviceServer(t, []string{"authorization_pending"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := PollDeviceToken(ctx, DeviceFlowConfig{TokenURL: srv.URL + "/token"}, &DeviceCode{DeviceCode: "dev", Interval: 5})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PollDeviceToken() error = %v, want %v", err, context.Canceled)
+	}
+
+	if *polls != 0 {
+		t.Errorf("token endpoint polled %d times after cancellation", *polls)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -18,10 +20,18 @@ var (
 	pathStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
 )
 
+// cancelCloneKey stops waiting for a clone; it is not remappable
+var cancelCloneKey = key.NewBinding(
+	key.WithKeys("ctrl+c"),
+	key.WithHelp("ctrl+c", "cancel"),
+)
+
 type CloneModel struct {
 	spinner spinner.Model
+	help    help.Model
 	url     string
 	path    string
+	flags   []string
 	cloning bool
 	done    bool
 	err     error
@@ -33,15 +43,17 @@ type cloneCompleteMsg struct {
 
 // NewCloneModel creates a new clone model
 // Authentication is handled via clonr's credential helper
-func NewCloneModel(url, path string) CloneModel {
+func NewCloneModel(url, path string, flags ...string) CloneModel {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = spinnerStyle
 
 	return CloneModel{
 		spinner: s,
+		help:    help.New(),
 		url:     url,
 		path:    path,
+		flags:   flags,
 		cloning: true,
 	}
 }
@@ -54,7 +66,7 @@ func (m CloneModel) cloneRepo() tea.Msg {
 	// Use git client with a credential helper for authentication
 	client := git.NewClient()
 
-	err := client.Clone(context.Background(), m.url, m.path)
+	err := client.Clone(context.Background(), m.url, m.path, m.flags...)
 	if err != nil {
 		return cloneCompleteMsg{err: err}
 	}
@@ -65,7 +77,7 @@ func (m CloneModel) cloneRepo() tea.Msg {
 func (m CloneModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch keyMsg := msg.(type) {
 	case tea.KeyMsg:
-		if keyMsg.String() == "ctrl+c" {
+		if key.Matches(keyMsg, cancelCloneKey) {
 			return m, tea.Quit
 		}
 
@@ -101,7 +113,8 @@ func (m CloneModel) View() string {
 	}
 
 	if m.cloning {
-		return fmt.Sprintf("\n  %s Cloning %s\n  %s\n\n", m.spinner.View(), urlStyle.Render(m.url), pathStyle.Render("→ "+m.path))
+		return fmt.Sprintf("\n  %s Cloning %s\n  %s\n\n  %s\n", m.spinner.View(), urlStyle.Render(m.url), pathStyle.Render("→ "+m.path),
+			m.help.ShortHelpView([]key.Binding{cancelCloneKey}))
 	}
 
 	return ""
@@ -1,13 +1,17 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/model"
 )
 
@@ -24,15 +28,197 @@ var (
 	blurredButton = fmt.Sprintf("[ %s ]", blurredStyle.Render("Submit"))
 )
 
+// configField is a setting edited by the configure form
+type configField struct {
+	label       string
+	placeholder string
+	charLimit   int
+
+	// value returns the setting as text, apply stores valid text in cfg
+	value    func(cfg *model.Config) string
+	apply    func(cfg *model.Config, value string)
+	validate func(value string) error
+}
+
+// Indexes of the fields in configFields
+const (
+	fieldCloneDir = iota
+	fieldEditor
+	fieldTerminal
+	fieldMonitorInterval
+	fieldServerPort
+	fieldGitOps
+	fieldAPICalls
+	fieldNotifyRoutes
+	fieldTLSCert
+	fieldTLSKey
+	fieldTLSCA
+)
+
+// configFields returns the settings of the configure form
+func configFields() []configField {
+	return []configField{
+		{
+			label: "Default Clone Directory:", placeholder: "~/clonr", charLimit: 256,
+			value:    func(c *model.Config) string { return c.DefaultCloneDir },
+			apply:    func(c *model.Config, v string) { c.DefaultCloneDir = v },
+			validate: validateRequired,
+		},
+		{
+			label: "Default Editor:", placeholder: "code, vim, etc.", charLimit: 256,
+			value:    func(c *model.Config) string { return c.Editor },
+			apply:    func(c *model.Config, v string) { c.Editor = v },
+			validate: validateCommand,
+		},
+		{
+			label: "Default Terminal:", placeholder: "terminal application (optional)", charLimit: 256,
+			value:    func(c *model.Config) string { return c.Terminal },
+			apply:    func(c *model.Config, v string) { c.Terminal = v },
+			validate: optional(validateCommand),
+		},
+		{
+			label: "Monitor Interval (seconds):", placeholder: "300", charLimit: 10,
+			value:    func(c *model.Config) string { return strconv.Itoa(c.MonitorInterval) },
+			apply:    func(c *model.Config, v string) { c.MonitorInterval, _ = strconv.Atoi(v) },
+			validate: validateRange(10, 86400),
+		},
+		{
+			label: "Server Port:", placeholder: "4000", charLimit: 5,
+			value:    func(c *model.Config) string { return strconv.Itoa(c.ServerPort) },
+			apply:    func(c *model.Config, v string) { c.ServerPort, _ = strconv.Atoi(v) },
+			validate: validateRange(1, 65535),
+		},
+		{
+			label: "Concurrent Git Operations:", placeholder: fmt.Sprintf("%d (default)", core.DefaultMaxGitJobs), charLimit: 2,
+			value:    func(c *model.Config) string { return formatLimit(c.Concurrency.GitOps) },
+			apply:    func(c *model.Config, v string) { c.Concurrency.GitOps, _ = strconv.Atoi(v) },
+			validate: optional(validateRange(1, core.MaxJobLimit)),
+		},
+		{
+			label: "Concurrent API Calls:", placeholder: fmt.Sprintf("%d (default)", core.DefaultMaxAPIJobs), charLimit: 2,
+			value:    func(c *model.Config) string { return formatLimit(c.Concurrency.APICalls) },
+			apply:    func(c *model.Config, v string) { c.Concurrency.APICalls, _ = strconv.Atoi(v) },
+			validate: optional(validateRange(1, core.MaxJobLimit)),
+		},
+		{
+			label: "Notification Routes:", placeholder: "ci-fail=slack,gmail; push=  (empty: every channel)", charLimit: 1024,
+			value:    func(c *model.Config) string { return core.FormatNotifyRoutes(c.NotifyRoutes) },
+			apply:    func(c *model.Config, v string) { c.NotifyRoutes, _ = core.ParseNotifyRoutes(v) },
+			validate: func(v string) error { _, err := core.ParseNotifyRoutes(v); return err },
+		},
+		{
+			label: "Server TLS Certificate:", placeholder: "PEM file (optional)", charLimit: 1024,
+			value:    func(c *model.Config) string { return c.ServerTLS.CertFile },
+			apply:    func(c *model.Config, v string) { c.ServerTLS.CertFile = v },
+			validate: optional(validateFile),
+		},
+		{
+			label: "Server TLS Key:", placeholder: "PEM file, required with a certificate", charLimit: 1024,
+			value:    func(c *model.Config) string { return c.ServerTLS.KeyFile },
+			apply:    func(c *model.Config, v string) { c.ServerTLS.KeyFile = v },
+			validate: optional(validateFile),
+		},
+		{
+			label: "Server TLS Client CA:", placeholder: "PEM bundle to verify clients against (optional)", charLimit: 1024,
+			value:    func(c *model.Config) string { return c.ServerTLS.ClientCAFile },
+			apply:    func(c *model.Config, v string) { c.ServerTLS.ClientCAFile = v },
+			validate: optional(validateFile),
+		},
+	}
+}
+
+// formatLimit shows an unset concurrency limit as an empty field
+func formatLimit(n int) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(n)
+}
+
+func validateRequired(v string) error {
+	if strings.TrimSpace(v) == "" {
+		return errors.New("required")
+	}
+
+	return nil
+}
+
+func validateCommand(v string) error {
+	if err := validateRequired(v); err != nil {
+		return err
+	}
+
+	if !core.IsEditorInstalled(v) {
+		return fmt.Errorf("%s not found in PATH", v)
+	}
+
+	return nil
+}
+
+func validateFile(v string) error {
+	info, err := os.Stat(v)
+	if err != nil {
+		return fmt.Errorf("cannot read %s", v)
+	}
+
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", v)
+	}
+
+	return nil
+}
+
+func validateRange(lo, hi int) func(string) error {
+	return func(v string) error {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || n < lo || n > hi {
+			return fmt.Errorf("must be a number from %d to %d", lo, hi)
+		}
+
+		return nil
+	}
+}
+
+// optional accepts an empty value and checks any other with validate
+func optional(validate func(string) error) func(string) error {
+	return func(v string) error {
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+
+		return validate(v)
+	}
+}
+
 type ConfigureModel struct {
 	focusIndex int
+	fields     []configField
 	inputs     []textinput.Model
+	errs       []error  // Validation error of each field, shown once submitted
+	loaded     []string // Field values before editing
+	hints      []string // Detected installed editors and terminals, per field
+	base       *model.Config
 	client     *grpc.Client
-	Saved      bool
-	Err        error
+
+	// Review before saving: the config to save and how it differs
+	reviewing bool
+	pending   *model.Config
+	changes   []core.ConfigChange
+
+	Saved bool
+	Err   error
+}
+
+// ConfigureDraft is the unsaved input of an interrupted configure session
+type ConfigureDraft struct {
+	Values []string `json:"values"`
 }
 
-func NewConfigureModel() (ConfigureModel, error) {
+// NewConfigureModel creates the configure form from the current config.
+// A non-nil draft restores the input of an interrupted session. An unset
+// editor or terminal defaults to the first one installed.
+func NewConfigureModel(draft *ConfigureDraft) (ConfigureModel, error) {
 	client, err := grpc.GetClient()
 	if err != nil {
 		return ConfigureModel{}, fmt.Errorf("failed to connect to server: %w", err)
@@ -44,46 +230,89 @@ func NewConfigureModel() (ConfigureModel, error) {
 		return ConfigureModel{}, err
 	}
 
+	fields := configFields()
+
 	m := ConfigureModel{
-		inputs: make([]textinput.Model, 5),
+		fields: fields,
+		inputs: make([]textinput.Model, len(fields)),
+		errs:   make([]error, len(fields)),
+		hints:  make([]string, len(fields)),
+		base:   cfg,
 		client: client,
 	}
 
-	var t textinput.Model
-	for i := range m.inputs {
-		t = textinput.New()
+	var editors []string
+
+	for _, e := range core.DefaultEditors {
+		if core.IsEditorInstalled(e.Command) {
+			editors = append(editors, e.Command)
+		}
+	}
+
+	for _, e := range cfg.CustomEditors {
+		if core.IsEditorInstalled(e.Command) {
+			editors = append(editors, e.Command)
+		}
+	}
+
+	detected := map[int][]string{fieldEditor: editors, fieldTerminal: core.InstalledTerminals()}
+
+	for i, f := range fields {
+		t := textinput.New()
 		t.Cursor.Style = cursorStyle
-		t.CharLimit = 256
+		t.CharLimit = f.charLimit
+		t.Placeholder = f.placeholder
+		t.SetValue(f.value(cfg))
+
+		if found := detected[i]; len(found) > 0 {
+			m.hints[i] = "installed: " + strings.Join(found, ", ")
 
-		switch i {
-		case 0:
-			t.Placeholder = "~/clonr"
-			t.SetValue(cfg.DefaultCloneDir)
+			if t.Value() == "" {
+				t.SetValue(found[0])
+			}
+		}
+
+		if i == fieldCloneDir {
 			t.Focus()
 			t.PromptStyle = focusedStyle
 			t.TextStyle = focusedStyle
-		case 1:
-			t.Placeholder = "code, vim, etc."
-			t.SetValue(cfg.Editor)
-		case 2:
-			t.Placeholder = "terminal application (optional)"
-			t.SetValue(cfg.Terminal)
-		case 3:
-			t.Placeholder = "300"
-			t.CharLimit = 10
-			t.SetValue(strconv.Itoa(cfg.MonitorInterval))
-		case 4:
-			t.Placeholder = "4000"
-			t.CharLimit = 5
-			t.SetValue(strconv.Itoa(cfg.ServerPort))
 		}
 
 		m.inputs[i] = t
+		m.loaded = append(m.loaded, t.Value())
+	}
+
+	if draft != nil && len(draft.Values) == len(m.inputs) {
+		for i, value := range draft.Values {
+			m.inputs[i].SetValue(value)
+		}
 	}
 
 	return m, nil
 }
 
+// Draft returns the input to keep when the session ended without saving, or
+// nil when nothing was changed
+func (m *ConfigureModel) Draft() *ConfigureDraft {
+	if m.Saved {
+		return nil
+	}
+
+	values := make([]string, len(m.inputs))
+	changed := false
+
+	for i := range m.inputs {
+		values[i] = m.inputs[i].Value()
+		changed = changed || values[i] != m.loaded[i]
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return &ConfigureDraft{Values: values}
+}
+
 func (m *ConfigureModel) Init() tea.Cmd {
 	return textinput.Blink
 }
@@ -97,6 +326,10 @@ func (m *ConfigureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.Err = msg.err
 		return m, tea.Quit
 	case tea.KeyMsg:
+		if m.reviewing {
+			return m.updateReview(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "esc":
 			return m, tea.Quit
@@ -104,9 +337,10 @@ func (m *ConfigureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "tab", "shift+tab", "enter", "up", "down":
 			s := msg.String()
 
-			// Submit on enter when on submitted button
+			// Review the changes on enter when on the submit button
 			if s == "enter" && m.focusIndex == len(m.inputs) {
-				return m, m.saveConfig
+				m.review()
+				return m, nil
 			}
 
 			// Cycle indexes
@@ -122,23 +356,7 @@ func (m *ConfigureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.focusIndex = len(m.inputs)
 			}
 
-			cmds := make([]tea.Cmd, len(m.inputs))
-			for i := 0; i <= len(m.inputs)-1; i++ {
-				if i == m.focusIndex {
-					// Set focused state
-					cmds[i] = m.inputs[i].Focus()
-					m.inputs[i].PromptStyle = focusedStyle
-					m.inputs[i].TextStyle = focusedStyle
-
-					continue
-				}
-				// Remove the focused state
-				m.inputs[i].Blur()
-				m.inputs[i].PromptStyle = noStyle
-				m.inputs[i].TextStyle = noStyle
-			}
-
-			return m, tea.Batch(cmds...)
+			return m, m.focus(m.focusIndex)
 		}
 	}
 
@@ -148,6 +366,48 @@ func (m *ConfigureModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// updateReview confirms or leaves the review of the changes
+func (m *ConfigureModel) updateReview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "enter", "y":
+		if len(m.changes) == 0 {
+			return m, tea.Quit
+		}
+
+		return m, m.saveConfig
+	case "esc", "n", "backspace":
+		m.reviewing = false
+	}
+
+	return m, nil
+}
+
+// focus moves the focus to input i, or to the submit button
+func (m *ConfigureModel) focus(i int) tea.Cmd {
+	m.focusIndex = i
+
+	cmds := make([]tea.Cmd, len(m.inputs))
+
+	for j := range m.inputs {
+		if j == i {
+			// Set focused state
+			cmds[j] = m.inputs[j].Focus()
+			m.inputs[j].PromptStyle = focusedStyle
+			m.inputs[j].TextStyle = focusedStyle
+
+			continue
+		}
+		// Remove the focused state
+		m.inputs[j].Blur()
+		m.inputs[j].PromptStyle = noStyle
+		m.inputs[j].TextStyle = noStyle
+	}
+
+	return tea.Batch(cmds...)
+}
+
 func (m *ConfigureModel) updateInputs(msg tea.Msg) tea.Cmd {
 	cmds := make([]tea.Cmd, len(m.inputs))
 
@@ -160,6 +420,58 @@ func (m *ConfigureModel) updateInputs(msg tea.Msg) tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// review validates the form and, when valid, shows the changes to save.
+// Otherwise the focus moves to the first invalid field.
+func (m *ConfigureModel) review() {
+	values := make([]string, len(m.inputs))
+	for i := range m.inputs {
+		values[i] = strings.TrimSpace(m.inputs[i].Value())
+	}
+
+	m.errs = validateConfigForm(m.fields, values)
+
+	for i, err := range m.errs {
+		if err != nil {
+			m.focus(i)
+			return
+		}
+	}
+
+	pending := *m.base
+	for i, f := range m.fields {
+		f.apply(&pending, values[i])
+	}
+
+	m.pending = &pending
+	m.changes = core.DiffConfig(m.base, &pending)
+	m.reviewing = true
+}
+
+// validateConfigForm checks each value with its field and the TLS files
+// together, returning the error of each field
+func validateConfigForm(fields []configField, values []string) []error {
+	errs := make([]error, len(fields))
+
+	for i, f := range fields {
+		errs[i] = f.validate(values[i])
+	}
+
+	cert, key, ca := values[fieldTLSCert], values[fieldTLSKey], values[fieldTLSCA]
+
+	switch {
+	case cert != "" && key == "" && errs[fieldTLSKey] == nil:
+		errs[fieldTLSKey] = errors.New("required with a certificate")
+	case cert == "" && key != "" && errs[fieldTLSCert] == nil:
+		errs[fieldTLSCert] = errors.New("required with a key")
+	}
+
+	if cert == "" && ca != "" && errs[fieldTLSCert] == nil {
+		errs[fieldTLSCert] = errors.New("required to verify client certificates")
+	}
+
+	return errs
+}
+
 func (m *ConfigureModel) View() string {
 	if m.Saved {
 		return lipgloss.NewStyle().
@@ -178,47 +490,68 @@ func (m *ConfigureModel) View() string {
 		Bold(true).
 		Foreground(lipgloss.Color("205"))
 
+	if m.reviewing {
+		return m.reviewView(headerStyle)
+	}
+
 	s := headerStyle.Render("Configure Clonr Settings") + "\n"
 	s += blurredStyle.Render("Edit the fields below and press Tab to navigate") + "\n\n"
-	s += fmt.Sprintf(fmtV1, blurredStyle.Render("Default Clone Directory:"), m.inputs[0].View())
-	s += fmt.Sprintf(fmtV1, blurredStyle.Render("Default Editor:"), m.inputs[1].View())
-	s += fmt.Sprintf(fmtV1, blurredStyle.Render("Default Terminal:"), m.inputs[2].View())
-	s += fmt.Sprintf(fmtV1, blurredStyle.Render("Monitor Interval (seconds):"), m.inputs[3].View())
-	s += fmt.Sprintf(fmtV1, blurredStyle.Render("Server Port:"), m.inputs[4].View())
+
+	for i, f := range m.fields {
+		field := m.inputs[i].View()
+
+		switch {
+		case m.errs[i] != nil:
+			field += "\n " + errorStyle.Render("✗ "+m.errs[i].Error())
+		case m.hints[i] != "":
+			field += "\n " + blurredStyle.Render(m.hints[i])
+		}
+
+		s += fmt.Sprintf(fmtV1, blurredStyle.Render(f.label), field)
+	}
 
 	button := &blurredButton
 	if m.focusIndex == len(m.inputs) {
 		button = &focusedButton
 	}
 
-	s += fmt.Sprintf("\n\n %s\n\n", *button)
-	s += helpStyleConfigure.Render(" tab/shift+tab: navigate • enter: submit • esc: quit")
+	s += fmt.Sprintf("\n %s\n\n", *button)
+	s += helpStyleConfigure.Render(" tab/shift+tab: navigate • enter: review changes • esc: quit")
 
 	return s
 }
 
-func (m *ConfigureModel) saveConfig() tea.Msg {
-	// Parse monitor interval
-	monitorInterval, err := strconv.Atoi(m.inputs[3].Value())
-	if err != nil {
-		monitorInterval = 300
-	}
+// reviewView shows the changes to confirm before saving
+func (m *ConfigureModel) reviewView(headerStyle lipgloss.Style) string {
+	s := headerStyle.Render("Review Changes") + "\n\n"
 
-	// Parse server port
-	serverPort, err := strconv.Atoi(m.inputs[4].Value())
-	if err != nil {
-		serverPort = 4000
+	if len(m.changes) == 0 {
+		s += blurredStyle.Render(" Nothing changed.") + "\n\n"
+		s += helpStyleConfigure.Render(" enter: quit • esc: back to the form")
+
+		return s
 	}
 
-	cfg := &model.Config{
-		DefaultCloneDir: m.inputs[0].Value(),
-		Editor:          m.inputs[1].Value(),
-		Terminal:        m.inputs[2].Value(),
-		MonitorInterval: monitorInterval,
-		ServerPort:      serverPort,
+	for _, c := range m.changes {
+		from, to := c.From, c.To
+		if from == "" {
+			from = "(unset)"
+		}
+
+		if to == "" {
+			to = "(unset)"
+		}
+
+		s += fmt.Sprintf(" %s\n   %s\n   %s\n\n", c.Setting, errorStyle.Render("- "+from), successStyle.Render("+ "+to))
 	}
 
-	if err := m.client.SaveConfig(cfg); err != nil {
+	s += helpStyleConfigure.Render(" enter: save • esc: back to the form")
+
+	return s
+}
+
+func (m *ConfigureModel) saveConfig() tea.Msg {
+	if err := m.client.SaveConfig(m.pending); err != nil {
 		return errMsg{err}
 	}
 
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateConfigForm(t *testing.T) {
+	dir := t.TempDir()
+
+	cert := filepath.Join(dir, "cert.pem")
+	if err := os.WriteFile(cert, []byte("cert"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fields := configFields()
+
+	valid := func() []string {
+		values := make([]string, len(fields))
+		values[fieldCloneDir] = dir
+		values[fieldEditor] = "sh"
+		values[fieldMonitorInterval] = "300"
+		values[fieldServerPort] = "4000"
+
+		return values
+	}
+
+	tests := []struct {
+		name    string
+		set     map[int]string
+		invalid []int
+	}{
+		{name: "valid", invalid: nil},
+		{name: "missing clone dir", set: map[int]string{fieldCloneDir: " "}, invalid: []int{fieldCloneDir}},
+		{name: "editor not installed", set: map[int]string{fieldEditor: "no-such-editor-xyz"}, invalid: []int{fieldEditor}},
+		{name: "port out of range", set: map[int]string{fieldServerPort: "70000"}, invalid: []int{fieldServerPort}},
+		{name: "interval not a number", set: map[int]string{fieldMonitorInterval: "5m"}, invalid: []int{fieldMonitorInterval}},
+		{name: "limit too high", set: map[int]string{fieldGitOps: "500"}, invalid: []int{fieldGitOps}},
+		{name: "bad route", set: map[int]string{fieldNotifyRoutes: "ci-fail"}, invalid: []int{fieldNotifyRoutes}},
+		{name: "certificate without key", set: map[int]string{fieldTLSCert: cert}, invalid: []int{fieldTLSKey}},
+		{name: "client CA without certificate", set: map[int]string{fieldTLSCA: cert}, invalid: []int{fieldTLSCert}},
+		{name: "missing key file", set: map[int]string{fieldTLSCert: cert, fieldTLSKey: filepath.Join(dir, "key.pem")}, invalid: []int{fieldTLSKey}},
+		{name: "certificate and key", set: map[int]string{fieldTLSCert: cert, fieldTLSKey: cert}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := valid()
+			for i, v := range tt.set {
+				values[i] = v
+			}
+
+			errs := validateConfigForm(fields, values)
+
+			for i, err := range errs {
+				wantErr := false
+
+				for _, j := range tt.invalid {
+					wantErr = wantErr || i == j
+				}
+
+				if (err != nil) != wantErr {
+					t.Errorf("%s: error = %v, want error %v", fields[i].label, err, wantErr)
+				}
+			}
+		})
+	}
+}
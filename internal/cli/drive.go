@@ -0,0 +1,321 @@
+package cli
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/gdrive"
+)
+
+var (
+	driveFolderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Bold(true)
+	driveMarkedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+)
+
+var (
+	driveMarkKey = key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "mark"),
+	)
+	driveOpenKey = key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "open/download"),
+	)
+	driveUpKey = key.NewBinding(
+		key.WithKeys("backspace"),
+		key.WithHelp("backspace", "parent folder"),
+	)
+)
+
+type driveItem struct {
+	file   gdrive.File
+	marked bool
+}
+
+func (i driveItem) Title() string {
+	if i.file.IsFolder() {
+		return driveFolderStyle.Render(i.file.Name + "/")
+	}
+
+	if i.marked {
+		return driveMarkedStyle.Render("✓ " + i.file.Name)
+	}
+
+	return i.file.Name
+}
+
+func (i driveItem) Description() string {
+	if i.file.IsFolder() {
+		return "folder"
+	}
+
+	modified := i.file.ModifiedTime.Format("2006-01-02 15:04")
+
+	if i.file.IsGoogleDoc() {
+		return fmt.Sprintf("Google document (exported as %s) • %s",
+			strings.TrimPrefix(gdrive.GetExportExtension(i.file.MimeType), "."), modified)
+	}
+
+	return fmt.Sprintf("%s • %s", core.FormatSize(i.file.Size), modified)
+}
+
+func (i driveItem) FilterValue() string {
+	return i.file.Name
+}
+
+// driveFolder is a folder on the path of the browser
+type driveFolder struct {
+	id   string
+	name string
+}
+
+// driveFolderMsg carries the files of a loaded folder
+type driveFolderMsg struct {
+	files []gdrive.File
+	err   error
+}
+
+// DriveBrowserModel is the Bubbletea model for browsing Google Drive folders
+// and picking files to download.
+type DriveBrowserModel struct {
+	client   *gdrive.Client
+	list     list.Model
+	spinner  spinner.Model
+	path     []driveFolder
+	marked   map[string]gdrive.File
+	selected []gdrive.File
+	loading  bool
+	err      error
+	quitting bool
+}
+
+// NewDriveBrowser creates a Drive browser starting in the given folder, or
+// the root of My Drive when folderID is empty.
+func NewDriveBrowser(client *gdrive.Client, folderID string) DriveBrowserModel {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.SetShowStatusBar(true)
+	l.SetFilteringEnabled(true)
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{driveOpenKey, driveMarkKey, driveUpKey}
+	}
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = spinnerStyle
+
+	root := driveFolder{id: folderID, name: "My Drive"}
+	if folderID != "" {
+		root.name = folderID
+	}
+
+	m := DriveBrowserModel{
+		client:  client,
+		list:    l,
+		spinner: s,
+		path:    []driveFolder{root},
+		marked:  make(map[string]gdrive.File),
+		loading: true,
+	}
+	m.list.Title = m.title()
+
+	return m
+}
+
+func (m DriveBrowserModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.loadFolder())
+}
+
+// loadFolder lists the folder at the end of the path
+func (m DriveBrowserModel) loadFolder() tea.Cmd {
+	folderID := m.path[len(m.path)-1].id
+
+	return func() tea.Msg {
+		files, err := m.client.ListFolder(context.Background(), folderID)
+
+		return driveFolderMsg{files: files, err: err}
+	}
+}
+
+// title shows the path of the current folder
+func (m DriveBrowserModel) title() string {
+	names := make([]string, len(m.path))
+	for i, folder := range m.path {
+		names[i] = folder.name
+	}
+
+	return "Drive: " + strings.Join(names, " / ")
+}
+
+func (m DriveBrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch keyMsg := msg.(type) {
+	case tea.WindowSizeMsg:
+		h, v := docStyle.GetFrameSize()
+		m.list.SetSize(keyMsg.Width-h, keyMsg.Height-v)
+
+		return m, nil
+
+	case driveFolderMsg:
+		m.loading = false
+
+		if keyMsg.err != nil {
+			m.err = keyMsg.err
+			return m, tea.Quit
+		}
+
+		items := make([]list.Item, len(keyMsg.files))
+		for i, file := range keyMsg.files {
+			_, marked := m.marked[file.ID]
+			items[i] = driveItem{file: file, marked: marked}
+		}
+
+		m.list.Title = m.title()
+		m.list.ResetFilter()
+		m.list.ResetSelected()
+
+		return m, m.list.SetItems(items)
+
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+
+		m.spinner, cmd = m.spinner.Update(keyMsg)
+
+		return m, cmd
+
+	case tea.KeyMsg:
+		if keyMsg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		if m.loading {
+			if keyMsg.String() == "q" || keyMsg.String() == "esc" {
+				m.quitting = true
+				return m, tea.Quit
+			}
+
+			return m, nil
+		}
+
+		// Let the list handle all keys while typing a filter
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		switch keyMsg.String() {
+		case "q", "esc":
+			m.quitting = true
+
+			return m, tea.Quit
+
+		case " ":
+			return m, m.toggleMark()
+
+		case "backspace":
+			if len(m.path) == 1 {
+				return m, nil
+			}
+
+			m.path = m.path[:len(m.path)-1]
+			m.loading = true
+
+			return m, tea.Batch(m.spinner.Tick, m.loadFolder())
+
+		case "enter":
+			i, ok := m.list.SelectedItem().(driveItem)
+			if !ok {
+				return m, nil
+			}
+
+			if i.file.IsFolder() {
+				m.path = append(m.path, driveFolder{id: i.file.ID, name: i.file.Name})
+				m.loading = true
+
+				return m, tea.Batch(m.spinner.Tick, m.loadFolder())
+			}
+
+			// Download the marked files, or the highlighted one if none are marked
+			if len(m.marked) == 0 {
+				m.selected = []gdrive.File{i.file}
+			} else {
+				for _, file := range m.marked {
+					m.selected = append(m.selected, file)
+				}
+
+				slices.SortFunc(m.selected, func(a, b gdrive.File) int {
+					return cmp.Compare(a.Name, b.Name)
+				})
+			}
+
+			return m, tea.Quit
+		}
+	}
+
+	if m.loading {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	m.list, cmd = m.list.Update(msg)
+
+	return m, cmd
+}
+
+// toggleMark marks or unmarks the highlighted file for download
+func (m *DriveBrowserModel) toggleMark() tea.Cmd {
+	i, ok := m.list.SelectedItem().(driveItem)
+	if !ok || i.file.IsFolder() {
+		return nil
+	}
+
+	i.marked = !i.marked
+	if i.marked {
+		m.marked[i.file.ID] = i.file
+	} else {
+		delete(m.marked, i.file.ID)
+	}
+
+	cmd := m.list.SetItem(m.list.GlobalIndex(), i)
+	m.list.CursorDown()
+
+	return tea.Batch(cmd, m.list.NewStatusMessage(fmt.Sprintf("%d file(s) marked", len(m.marked))))
+}
+
+func (m DriveBrowserModel) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	if m.loading {
+		return fmt.Sprintf("\n  %s Loading %s\n\n", m.spinner.View(), urlStyle.Render(m.path[len(m.path)-1].name))
+	}
+
+	return docStyle.Render(m.list.View())
+}
+
+// GetSelectedFiles returns the files picked for download
+func (m DriveBrowserModel) GetSelectedFiles() []gdrive.File {
+	return m.selected
+}
+
+// Error returns the error that stopped the browser
+func (m DriveBrowserModel) Error() error {
+	return m.err
+}
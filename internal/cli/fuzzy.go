@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Scoring weights for fuzzy matching, modeled after fzf
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+	bonusBoundary     = 8
+	bonusCamel        = 7
+	bonusConsecutive  = 4
+	bonusFirstChar    = 2
+)
+
+// fuzzyMatch matches pattern against text fzf-style: every pattern rune must
+// appear in order. Matching is case-insensitive unless the pattern contains an
+// uppercase letter. It returns the score and the matched rune positions.
+func fuzzyMatch(pattern, text string) (int, []int, bool) {
+	p := []rune(pattern)
+	if len(p) == 0 {
+		return 0, nil, true
+	}
+
+	t := []rune(text)
+	caseSensitive := strings.IndexFunc(pattern, unicode.IsUpper) >= 0
+
+	eq := func(a, b rune) bool {
+		if caseSensitive {
+			return a == b
+		}
+
+		return unicode.ToLower(a) == unicode.ToLower(b)
+	}
+
+	// Forward scan finds where the earliest match ends
+	pi, end := 0, -1
+
+	for i, r := range t {
+		if eq(r, p[pi]) {
+			pi++
+			if pi == len(p) {
+				end = i
+
+				break
+			}
+		}
+	}
+
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	// Backward scan from the end finds the tightest start
+	pi, start := len(p)-1, end
+
+	for i := end; i >= 0; i-- {
+		if eq(t[i], p[pi]) {
+			pi--
+			if pi < 0 {
+				start = i
+
+				break
+			}
+		}
+	}
+
+	// Score the window, preferring boundary and consecutive matches
+	positions := make([]int, 0, len(p))
+	score, pi, inGap, consecutive := 0, 0, false, 0
+
+	for i := start; i <= end && pi < len(p); i++ {
+		if !eq(t[i], p[pi]) {
+			if inGap {
+				score += scoreGapExtension
+			} else {
+				score += scoreGapStart
+			}
+
+			inGap, consecutive = true, 0
+
+			continue
+		}
+
+		bonus := charBonus(t, i)
+		if consecutive > 0 {
+			bonus = max(bonus, bonusConsecutive)
+		}
+
+		if pi == 0 {
+			bonus *= bonusFirstChar
+		}
+
+		score += scoreMatch + bonus
+		positions = append(positions, i)
+		inGap = false
+		consecutive++
+		pi++
+	}
+
+	return score, positions, true
+}
+
+// charBonus rewards matches at the start of a word or path segment
+func charBonus(t []rune, i int) int {
+	if i == 0 {
+		return bonusBoundary
+	}
+
+	prev, cur := t[i-1], t[i]
+
+	switch {
+	case strings.ContainsRune("/-_.:@ ", prev):
+		return bonusBoundary
+	case unicode.IsLower(prev) && unicode.IsUpper(cur):
+		return bonusCamel
+	default:
+		return 0
+	}
+}
+
+type fuzzyRank struct {
+	list.Rank
+	score  int
+	length int
+}
+
+// FuzzyFilter is a list.FilterFunc ranking items fzf-style. Space-separated
+// terms must all match; results are ordered by score, then by length.
+func FuzzyFilter(term string, targets []string) []list.Rank {
+	terms := strings.Fields(term)
+
+	ranks := make([]fuzzyRank, 0, len(targets))
+
+	for i, target := range targets {
+		total, matched, ok := 0, []int(nil), true
+
+		for _, tm := range terms {
+			score, positions, found := fuzzyMatch(tm, target)
+			if !found {
+				ok = false
+
+				break
+			}
+
+			total += score
+			matched = append(matched, positions...)
+		}
+
+		if !ok {
+			continue
+		}
+
+		sort.Ints(matched)
+
+		ranks = append(ranks, fuzzyRank{
+			Rank:   list.Rank{Index: i, MatchedIndexes: dedupInts(matched)},
+			score:  total,
+			length: len(target),
+		})
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		if ranks[i].score != ranks[j].score {
+			return ranks[i].score > ranks[j].score
+		}
+
+		return ranks[i].length < ranks[j].length
+	})
+
+	result := make([]list.Rank, len(ranks))
+	for i, r := range ranks {
+		result[i] = r.Rank
+	}
+
+	return result
+}
+
+// FuzzyFindRepos returns the repositories whose URL matches query, best match first
+func FuzzyFindRepos(repos []model.Repository, query string) []model.Repository {
+	targets := make([]string, len(repos))
+	for i, repo := range repos {
+		targets[i] = repoItem{repo: repo}.FilterValue()
+	}
+
+	ranks := FuzzyFilter(query, targets)
+
+	found := make([]model.Repository, len(ranks))
+	for i, r := range ranks {
+		found[i] = repos[r.Index]
+	}
+
+	return found
+}
+
+func dedupInts(s []int) []int {
+	out := s[:0]
+
+	for i, v := range s {
+		if i == 0 || v != s[i-1] {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
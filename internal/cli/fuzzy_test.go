@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		pattern       string
+		text          string
+		wantOK        bool
+		wantPositions []int
+	}{
+		{pattern: "", text: "anything", wantOK: true},
+		{pattern: "abc", text: "a-b-c", wantOK: true, wantPositions: []int{0, 2, 4}},
+		{pattern: "ABC", text: "abc", wantOK: false},
+		{pattern: "abc", text: "ABC", wantOK: true, wantPositions: []int{0, 1, 2}},
+		{pattern: "cba", text: "abc", wantOK: false},
+		{pattern: "cli", text: "github.com/x/clonr-cli", wantOK: true, wantPositions: []int{19, 20, 21}},
+	}
+
+	for _, tt := range tests {
+		_, positions, ok := fuzzyMatch(tt.pattern, tt.text)
+		if ok != tt.wantOK {
+			t.Errorf("fuzzyMatch(%q, %q) ok = %v, want %v", tt.pattern, tt.text, ok, tt.wantOK)
+			continue
+		}
+
+		if ok && !slices.Equal(positions, tt.wantPositions) {
+			t.Errorf("fuzzyMatch(%q, %q) positions = %v, want %v", tt.pattern, tt.text, positions, tt.wantPositions)
+		}
+	}
+}
+
+func TestFuzzyFindRepos(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/backend-service"},
+		{URL: "https://github.com/inovacc/clonr"},
+		{URL: "https://github.com/acme/cli"},
+		{URL: "https://gitlab.com/acme/clients"},
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "clonr", want: []string{"https://github.com/inovacc/clonr"}},
+		{query: "cli", want: []string{"https://github.com/acme/cli", "https://gitlab.com/acme/clients"}},
+		{query: "acme svc", want: []string{"https://github.com/acme/backend-service"}},
+		{query: "zzz", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		found := FuzzyFindRepos(repos, tt.query)
+
+		got := make([]string, len(found))
+		for i, r := range found {
+			got[i] = r.URL
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("FuzzyFindRepos(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,643 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/gmail"
+)
+
+var (
+	gmailUnreadStyle = lipgloss.NewStyle().Bold(true)
+	gmailHeaderStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Bold(true)
+)
+
+// gmailPageSize is the number of messages loaded at a time
+const gmailPageSize = 50
+
+// gmailHelp is the key help of the browser
+const gmailHelp = "enter/tab preview • l labels • d download attachments • r reload • / filter • q quit"
+
+type gmailMessageItem struct {
+	msg *gmail.Message
+}
+
+func (i gmailMessageItem) unread() bool {
+	return slices.Contains(i.msg.LabelIDs, "UNREAD")
+}
+
+func (i gmailMessageItem) Title() string {
+	subject := i.msg.Headers["subject"]
+	if subject == "" {
+		subject = "(no subject)"
+	}
+
+	if i.unread() {
+		return gmailUnreadStyle.Render("● " + subject)
+	}
+
+	return subject
+}
+
+func (i gmailMessageItem) Description() string {
+	return i.msg.Headers["from"] + " • " + i.msg.Headers["date"]
+}
+
+func (i gmailMessageItem) FilterValue() string {
+	return i.msg.Headers["subject"] + " " + i.msg.Headers["from"]
+}
+
+type gmailLabelItem struct {
+	label gmail.Label
+}
+
+func (i gmailLabelItem) Title() string {
+	return i.label.Name
+}
+
+func (i gmailLabelItem) Description() string {
+	if i.label.MessagesUnread > 0 {
+		return fmt.Sprintf("%s label • %d unread", i.label.Type, i.label.MessagesUnread)
+	}
+
+	return i.label.Type + " label"
+}
+
+func (i gmailLabelItem) FilterValue() string {
+	return i.label.Name
+}
+
+// gmailPageMsg carries a page of messages of a label
+type gmailPageMsg struct {
+	label    string
+	messages []*gmail.Message
+	nextPage string
+	more     bool // The page continues the loaded messages
+	err      error
+}
+
+// gmailLabelsMsg carries the labels of the mailbox
+type gmailLabelsMsg struct {
+	labels []gmail.Label
+	err    error
+}
+
+// gmailPreviewMsg carries a full message
+type gmailPreviewMsg struct {
+	msg *gmail.Message
+	err error
+}
+
+// gmailSavedMsg reports downloaded attachments
+type gmailSavedMsg struct {
+	paths []string
+	err   error
+}
+
+// GmailBrowserModel is the Bubbletea model for browsing a Gmail mailbox:
+// the messages of a label in a list pane and the selected message in a
+// preview pane, with label switching and attachment downloads.
+type GmailBrowserModel struct {
+	client    *gmail.Client
+	list      list.Model
+	labels    list.Model
+	preview   viewport.Model
+	spinner   spinner.Model
+	label     string
+	outputDir string
+	nextPage  string
+	full      map[string]*gmail.Message
+	previewID string
+
+	pickingLabel bool
+	previewFocus bool
+	loading      bool
+	loadingMore  bool
+	status       string
+	err          error
+	quitting     bool
+	width        int
+	height       int
+}
+
+// NewGmailBrowser creates a Gmail browser showing the messages of label.
+// Attachments are downloaded into outputDir, or the current directory when
+// it is empty.
+func NewGmailBrowser(client *gmail.Client, label, outputDir string) GmailBrowserModel {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.SetShowHelp(false)
+	l.SetFilteringEnabled(true)
+
+	labels := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	labels.Title = "Labels"
+	labels.SetShowHelp(false)
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = spinnerStyle
+
+	m := GmailBrowserModel{
+		client:    client,
+		list:      l,
+		labels:    labels,
+		preview:   viewport.New(0, 0),
+		spinner:   s,
+		label:     label,
+		outputDir: outputDir,
+		full:      make(map[string]*gmail.Message),
+		loading:   true,
+	}
+	m.list.Title = label
+
+	return m
+}
+
+func (m GmailBrowserModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.loadPage(""))
+}
+
+// loadPage lists a page of messages of the label with their headers
+func (m GmailBrowserModel) loadPage(pageToken string) tea.Cmd {
+	client, label := m.client, m.label
+
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		resp, err := client.ListMessages(ctx, gmail.ListMessagesOptions{
+			MaxResults: gmailPageSize,
+			LabelIDs:   []string{label},
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return gmailPageMsg{label: label, err: err}
+		}
+
+		ids := make([]string, len(resp.Messages))
+		for i, ref := range resp.Messages {
+			ids[i] = ref.ID
+		}
+
+		messages := slices.DeleteFunc(client.GetMessages(ctx, ids, "metadata"), func(msg *gmail.Message) bool {
+			return msg == nil
+		})
+
+		return gmailPageMsg{label: label, messages: messages, nextPage: resp.NextPageToken, more: pageToken != ""}
+	}
+}
+
+// loadLabels lists the labels of the mailbox
+func (m GmailBrowserModel) loadLabels() tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		labels, err := client.ListLabels(context.Background())
+
+		// System labels first, then user labels by name
+		slices.SortFunc(labels, func(a, b gmail.Label) int {
+			if a.Type != b.Type {
+				return strings.Compare(b.Type, a.Type)
+			}
+
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		return gmailLabelsMsg{labels: labels, err: err}
+	}
+}
+
+// loadPreview fetches the full message with the given ID
+func (m GmailBrowserModel) loadPreview(id string) tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		msg, err := client.GetMessage(context.Background(), id, "full")
+
+		return gmailPreviewMsg{msg: msg, err: err}
+	}
+}
+
+// saveAttachments downloads the attachments of a message into the output
+// directory, never replacing existing files
+func (m GmailBrowserModel) saveAttachments(msg *gmail.Message) tea.Cmd {
+	client, outputDir := m.client, m.outputDir
+
+	return func() tea.Msg {
+		var paths []string
+
+		for _, att := range client.GetMessageAttachments(msg) {
+			data, err := client.GetAttachment(context.Background(), msg.ID, att.ID)
+			if err != nil {
+				return gmailSavedMsg{paths: paths, err: fmt.Errorf("failed to download %s: %w", att.Filename, err)}
+			}
+
+			path := filepath.Join(outputDir, attachmentFileName(att))
+
+			f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return gmailSavedMsg{paths: paths, err: fmt.Errorf("failed to save %s: %w", att.Filename, err)}
+			}
+
+			_, err = f.Write(data)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+
+			if err != nil {
+				_ = os.Remove(path)
+				return gmailSavedMsg{paths: paths, err: fmt.Errorf("failed to save %s: %w", att.Filename, err)}
+			}
+
+			paths = append(paths, path)
+		}
+
+		return gmailSavedMsg{paths: paths}
+	}
+}
+
+// attachmentFileName returns the name of an attachment reduced to a plain
+// file name that cannot escape the output directory
+func attachmentFileName(att gmail.Attachment) string {
+	name := filepath.Base(strings.ReplaceAll(att.Filename, "\\", "/"))
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return att.ID
+	}
+
+	return name
+}
+
+func (m GmailBrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+
+		return m, nil
+
+	case gmailPageMsg:
+		if msg.label != m.label {
+			return m, nil
+		}
+
+		m.loading, m.loadingMore = false, false
+
+		if msg.err != nil {
+			if !msg.more {
+				m.err = msg.err
+				return m, tea.Quit
+			}
+
+			m.status = "Failed to load more messages: " + msg.err.Error()
+
+			return m, nil
+		}
+
+		items := m.list.Items()
+		if !msg.more {
+			items = nil
+		}
+
+		for _, message := range msg.messages {
+			items = append(items, gmailMessageItem{msg: message})
+		}
+
+		m.nextPage = msg.nextPage
+		cmd := m.list.SetItems(items)
+
+		return m, tea.Batch(cmd, m.selectionChanged())
+
+	case gmailLabelsMsg:
+		m.loading = false
+
+		if msg.err != nil {
+			m.pickingLabel = false
+			m.status = "Failed to list labels: " + msg.err.Error()
+
+			return m, nil
+		}
+
+		items := make([]list.Item, len(msg.labels))
+		for i, label := range msg.labels {
+			items[i] = gmailLabelItem{label: label}
+		}
+
+		return m, m.labels.SetItems(items)
+
+	case gmailPreviewMsg:
+		if msg.err != nil {
+			m.status = "Failed to load message: " + msg.err.Error()
+			return m, nil
+		}
+
+		m.full[msg.msg.ID] = msg.msg
+		if msg.msg.ID == m.previewID {
+			m.renderPreview()
+		}
+
+		return m, nil
+
+	case gmailSavedMsg:
+		switch {
+		case msg.err != nil:
+			m.status = msg.err.Error()
+		case len(msg.paths) == 0:
+			m.status = "No attachments"
+		default:
+			m.status = "Saved " + strings.Join(msg.paths, ", ")
+		}
+
+		return m, nil
+
+	case spinner.TickMsg:
+		if !m.loading && !m.loadingMore {
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+
+		m.spinner, cmd = m.spinner.Update(msg)
+
+		return m, cmd
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		switch {
+		case m.pickingLabel:
+			return m.updateLabels(msg)
+		case m.previewFocus:
+			return m.updatePreview(msg)
+		default:
+			return m.updateList(msg)
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.list, cmd = m.list.Update(msg)
+
+	return m, cmd
+}
+
+// updateList handles keys in the message list
+func (m GmailBrowserModel) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Let the list handle all keys while typing a filter
+	if m.list.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "q", "esc":
+			if m.list.FilterState() == list.FilterApplied {
+				break
+			}
+
+			m.quitting = true
+
+			return m, tea.Quit
+
+		case "enter", "tab":
+			if m.previewID != "" {
+				m.previewFocus = true
+			}
+
+			return m, nil
+
+		case "l":
+			m.pickingLabel = true
+			if len(m.labels.Items()) > 0 {
+				return m, nil
+			}
+
+			m.loading = true
+
+			return m, tea.Batch(m.spinner.Tick, m.loadLabels())
+
+		case "r":
+			return m.switchLabel(m.label)
+
+		case "d":
+			return m, m.downloadAttachments()
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.list, cmd = m.list.Update(msg)
+
+	return m, tea.Batch(cmd, m.selectionChanged())
+}
+
+// updatePreview handles keys in the preview pane
+func (m GmailBrowserModel) updatePreview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		m.quitting = true
+
+		return m, tea.Quit
+
+	case "esc", "tab":
+		m.previewFocus = false
+
+		return m, nil
+
+	case "d":
+		return m, m.downloadAttachments()
+	}
+
+	var cmd tea.Cmd
+
+	m.preview, cmd = m.preview.Update(msg)
+
+	return m, cmd
+}
+
+// updateLabels handles keys in the label picker
+func (m GmailBrowserModel) updateLabels(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.labels.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "esc", "q":
+			m.pickingLabel = false
+
+			return m, nil
+
+		case "enter":
+			i, ok := m.labels.SelectedItem().(gmailLabelItem)
+			if !ok {
+				return m, nil
+			}
+
+			m.pickingLabel = false
+
+			return m.switchLabel(i.label.ID)
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.labels, cmd = m.labels.Update(msg)
+
+	return m, cmd
+}
+
+// switchLabel loads the messages of a label from the first page
+func (m GmailBrowserModel) switchLabel(label string) (tea.Model, tea.Cmd) {
+	m.label = label
+	m.list.Title = label
+	m.list.ResetFilter()
+	m.list.ResetSelected()
+	m.nextPage = ""
+	m.previewID = ""
+	m.previewFocus = false
+	m.status = ""
+	m.loading = true
+	m.preview.SetContent("")
+
+	return m, tea.Batch(m.spinner.Tick, m.list.SetItems(nil), m.loadPage(""))
+}
+
+// selectionChanged previews the selected message and loads the next page
+// when the selection reaches the end of the list
+func (m *GmailBrowserModel) selectionChanged() tea.Cmd {
+	var cmds []tea.Cmd
+
+	if m.nextPage != "" && !m.loadingMore && m.list.FilterState() == list.Unfiltered &&
+		m.list.Index() >= len(m.list.Items())-1 {
+		m.loadingMore = true
+		cmds = append(cmds, m.spinner.Tick, m.loadPage(m.nextPage))
+	}
+
+	i, ok := m.list.SelectedItem().(gmailMessageItem)
+	if !ok || i.msg.ID == m.previewID {
+		return tea.Batch(cmds...)
+	}
+
+	m.previewID = i.msg.ID
+	m.renderPreview()
+
+	if _, ok := m.full[i.msg.ID]; !ok {
+		cmds = append(cmds, m.loadPreview(i.msg.ID))
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// downloadAttachments saves the attachments of the previewed message
+func (m *GmailBrowserModel) downloadAttachments() tea.Cmd {
+	full, ok := m.full[m.previewID]
+	if !ok {
+		return nil
+	}
+
+	m.status = "Downloading attachments..."
+
+	return m.saveAttachments(full)
+}
+
+// resize lays the panes out for the window size
+func (m *GmailBrowserModel) resize() {
+	listWidth := min(60, max(30, m.width*2/5))
+	paneHeight := max(m.height-3, 3) // borders and the status line
+
+	m.list.SetSize(listWidth, paneHeight)
+	m.labels.SetSize(listWidth, paneHeight)
+	m.preview.Width = max(m.width-listWidth-4, 10)
+	m.preview.Height = paneHeight
+
+	m.renderPreview()
+}
+
+// renderPreview fills the preview pane with the selected message
+func (m *GmailBrowserModel) renderPreview() {
+	if m.previewID == "" {
+		m.preview.SetContent(dimStyle.Render("No message selected"))
+		return
+	}
+
+	full, ok := m.full[m.previewID]
+	if !ok {
+		m.preview.SetContent(dimStyle.Render("Loading message..."))
+		return
+	}
+
+	var b strings.Builder
+
+	b.WriteString(gmailHeaderStyle.Render(full.Headers["subject"]) + "\n\n")
+
+	for _, h := range []struct{ name, key string }{{"From", "from"}, {"To", "to"}, {"Cc", "cc"}, {"Date", "date"}} {
+		if value := full.Headers[h.key]; value != "" {
+			b.WriteString(dimStyle.Render(fmt.Sprintf("%-5s ", h.name+":")) + value + "\n")
+		}
+	}
+
+	if attachments := m.client.GetMessageAttachments(full); len(attachments) > 0 {
+		b.WriteString("\n")
+
+		for _, att := range attachments {
+			b.WriteString(fmt.Sprintf("📎 %s %s\n", att.Filename, dimStyle.Render("("+core.FormatSize(int64(att.Size))+")")))
+		}
+
+		b.WriteString(dimStyle.Render("Press d to download") + "\n")
+	}
+
+	body := m.client.GetMessageBody(full)
+	if strings.TrimSpace(body) == "" {
+		body = full.Snippet + "\n\n" + dimStyle.Render("(no plain text body; read it with: clonr gmail read "+full.ID+" --html)")
+	}
+
+	b.WriteString("\n" + lipgloss.NewStyle().Width(m.preview.Width).Render(strings.ReplaceAll(body, "\r\n", "\n")))
+
+	m.preview.SetContent(b.String())
+	m.preview.GotoTop()
+}
+
+func (m GmailBrowserModel) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	if m.loading && len(m.list.Items()) == 0 && !m.pickingLabel {
+		return fmt.Sprintf("\n  %s Loading %s\n\n", m.spinner.View(), urlStyle.Render(m.label))
+	}
+
+	listPane, previewPane := focusedPaneStyle, paneStyle
+	if m.previewFocus {
+		listPane, previewPane = paneStyle, focusedPaneStyle
+	}
+
+	left := m.list.View()
+	if m.pickingLabel {
+		left = m.labels.View()
+	}
+
+	panes := lipgloss.JoinHorizontal(lipgloss.Top, listPane.Render(left), previewPane.Render(m.preview.View()))
+
+	footer := dimStyle.Render(gmailHelp)
+	if m.loadingMore || (m.loading && m.pickingLabel) {
+		footer = m.spinner.View() + " " + footer
+	}
+
+	if m.status != "" {
+		footer = m.status + "  " + footer
+	}
+
+	return panes + "\n" + footer
+}
+
+// Error returns the error that stopped the browser
+func (m GmailBrowserModel) Error() error {
+	return m.err
+}
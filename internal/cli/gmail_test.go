@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/gmail"
+)
+
+func TestGmailBrowserPreviewAndLabels(t *testing.T) {
+	var model tea.Model = NewGmailBrowser(gmail.NewClient("token", gmail.ClientOptions{}), "INBOX", "")
+
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model, cmd := model.Update(gmailPageMsg{
+		label: "INBOX",
+		messages: []*gmail.Message{
+			{ID: "m1", LabelIDs: []string{"INBOX", "UNREAD"}, Headers: map[string]string{"subject": "Invoice", "from": "billing@example.com"}},
+			{ID: "m2", LabelIDs: []string{"INBOX"}, Headers: map[string]string{"subject": "Lunch"}},
+		},
+		nextPage: "page2",
+	})
+
+	m := model.(GmailBrowserModel)
+	if m.previewID != "m1" || cmd == nil {
+		t.Fatalf("first page did not preview the first message: previewID %q", m.previewID)
+	}
+
+	// A page of another label is stale
+	model, _ = model.Update(gmailPageMsg{label: "SENT", messages: []*gmail.Message{{ID: "s1"}}})
+	if n := len(model.(GmailBrowserModel).list.Items()); n != 2 {
+		t.Fatalf("stale page replaced the list: %d items", n)
+	}
+
+	// Reaching the end of the list loads the next page
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if m = model.(GmailBrowserModel); m.previewID != "m2" || !m.loadingMore {
+		t.Fatalf("down: previewID %q, loadingMore %v", m.previewID, m.loadingMore)
+	}
+
+	model, _ = model.Update(gmailPageMsg{label: "INBOX", more: true, messages: []*gmail.Message{{ID: "m3", Headers: map[string]string{}}}})
+	if n := len(model.(GmailBrowserModel).list.Items()); n != 3 {
+		t.Fatalf("next page not appended: %d items", n)
+	}
+
+	// Picking a label reloads from the first page
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	model, _ = model.Update(gmailLabelsMsg{labels: []gmail.Label{{ID: "SENT", Name: "SENT", Type: "system"}}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	m = model.(GmailBrowserModel)
+	if m.label != "SENT" || m.pickingLabel || !m.loading || len(m.list.Items()) != 0 {
+		t.Errorf("label switch: label %q, picking %v, loading %v, items %d", m.label, m.pickingLabel, m.loading, len(m.list.Items()))
+	}
+}
+
+func TestAttachmentFileName(t *testing.T) {
+	tests := map[string]string{
+		"report.pdf":       "report.pdf",
+		"../../etc/passwd": "passwd",
+		`..\..\evil.exe`:   "evil.exe",
+		"..":               "att1",
+		"":                 "att1",
+	}
+
+	for name, want := range tests {
+		if got := attachmentFileName(gmail.Attachment{ID: "att1", Filename: name}); got != want {
+			t.Errorf("attachmentFileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
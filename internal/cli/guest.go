@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GuestRepoListModel is the read-only repository list served to guests of
+// 'clonr server ssh'. Guests can browse, filter, group and sort the catalog;
+// selecting a repository shows how to clone it instead of acting on it.
+type GuestRepoListModel struct {
+	RepoListModel
+}
+
+// NewGuestRepoList creates the read-only repository list for a guest session
+func NewGuestRepoList() (GuestRepoListModel, error) {
+	m, err := NewRepoList(false)
+	if err != nil {
+		return GuestRepoListModel{}, err
+	}
+
+	m.title = "Clonr catalog (read-only)"
+	m.list.Title = m.title
+
+	return GuestRepoListModel{RepoListModel: m}, nil
+}
+
+func (m GuestRepoListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "enter" && m.picker == nil && m.list.FilterState() != list.Filtering {
+		if i, ok := m.list.SelectedItem().(repoItem); ok {
+			return m, m.list.NewStatusMessage(fmt.Sprintf("git clone %s", i.repo.URL))
+		}
+	}
+
+	next, cmd := m.RepoListModel.Update(msg)
+	m.RepoListModel = next.(RepoListModel)
+
+	return m, cmd
+}
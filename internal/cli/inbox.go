@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/inbox"
+)
+
+// inboxHelp is the key help of the inbox
+const inboxHelp = "↑/↓ select • enter open • tab scroll preview • / filter • q quit"
+
+type inboxListItem struct {
+	item inbox.Item
+}
+
+func (i inboxListItem) Title() string {
+	return i.item.Source.Icon() + " " + i.item.Title
+}
+
+func (i inboxListItem) Description() string {
+	return fmt.Sprintf("%s • %s • %s", i.item.From, i.item.Where, formatInboxTime(i.item))
+}
+
+func (i inboxListItem) FilterValue() string {
+	return i.item.Title + " " + i.item.From + " " + i.item.Where
+}
+
+// InboxModel is the Bubbletea model of the unified inbox: the items in a
+// list pane and the selected item in a preview pane. Enter opens the item
+// in Gmail or Slack.
+type InboxModel struct {
+	list         list.Model
+	preview      viewport.Model
+	previewFocus bool
+	selected     int
+	status       string
+	quitting     bool
+	width        int
+	height       int
+}
+
+// NewInbox creates an inbox showing items in the given order.
+func NewInbox(items []inbox.Item) InboxModel {
+	listItems := make([]list.Item, len(items))
+	for i, item := range items {
+		listItems[i] = inboxListItem{item: item}
+	}
+
+	l := list.New(listItems, list.NewDefaultDelegate(), 0, 0)
+	l.Title = fmt.Sprintf("Inbox (%d)", len(items))
+	l.SetShowHelp(false)
+
+	return InboxModel{
+		list:     l,
+		preview:  viewport.New(0, 0),
+		selected: -1,
+	}
+}
+
+func (m InboxModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m InboxModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		if m.previewFocus {
+			switch msg.String() {
+			case "q":
+				m.quitting = true
+				return m, tea.Quit
+			case "esc", "tab":
+				m.previewFocus = false
+				return m, nil
+			}
+
+			var cmd tea.Cmd
+
+			m.preview, cmd = m.preview.Update(msg)
+
+			return m, cmd
+		}
+
+		if m.list.FilterState() != list.Filtering {
+			switch msg.String() {
+			case "q":
+				m.quitting = true
+				return m, tea.Quit
+			case "esc":
+				if m.list.FilterState() == list.Unfiltered {
+					m.quitting = true
+					return m, tea.Quit
+				}
+			case "tab":
+				if m.list.SelectedItem() != nil {
+					m.previewFocus = true
+				}
+
+				return m, nil
+			case "enter":
+				m.open()
+				return m, nil
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.list, cmd = m.list.Update(msg)
+	m.renderPreview()
+
+	return m, cmd
+}
+
+// open opens the selected item in the browser or the Slack app
+func (m *InboxModel) open() {
+	i, ok := m.list.SelectedItem().(inboxListItem)
+	if !ok || i.item.Link == "" {
+		return
+	}
+
+	if err := core.OpenBrowser(i.item.Link); err != nil {
+		m.status = "Failed to open: " + err.Error()
+		return
+	}
+
+	m.status = "Opened " + i.item.Link
+}
+
+// resize lays the panes out for the window size
+func (m *InboxModel) resize() {
+	listWidth := min(70, max(30, m.width/2))
+	paneHeight := max(m.height-3, 3) // borders and the status line
+
+	m.list.SetSize(listWidth, paneHeight)
+	m.preview.Width = max(m.width-listWidth-4, 10)
+	m.preview.Height = paneHeight
+
+	m.selected = -1
+	m.renderPreview()
+}
+
+// renderPreview fills the preview pane when the selection changed
+func (m *InboxModel) renderPreview() {
+	i, ok := m.list.SelectedItem().(inboxListItem)
+	if !ok {
+		m.selected = -1
+		m.preview.SetContent(dimStyle.Render("Nothing to read"))
+
+		return
+	}
+
+	if m.list.GlobalIndex() == m.selected {
+		return
+	}
+
+	m.selected = m.list.GlobalIndex()
+
+	var b strings.Builder
+
+	b.WriteString(urlStyle.Render(i.item.Source.Icon()+" "+i.item.Title) + "\n\n")
+	b.WriteString(dimStyle.Render("From:  ") + i.item.From + "\n")
+	b.WriteString(dimStyle.Render("In:    ") + i.item.Where + "\n")
+	b.WriteString(dimStyle.Render("Time:  ") + formatInboxTime(i.item) + "\n")
+
+	if i.item.Link != "" {
+		b.WriteString(dimStyle.Render("Link:  "+i.item.Link) + "\n")
+	}
+
+	b.WriteString("\n" + lipgloss.NewStyle().Width(m.preview.Width).Render(i.item.Text))
+
+	m.preview.SetContent(b.String())
+	m.preview.GotoTop()
+}
+
+func (m InboxModel) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	listPane, previewPane := focusedPaneStyle, paneStyle
+	if m.previewFocus {
+		listPane, previewPane = paneStyle, focusedPaneStyle
+	}
+
+	panes := lipgloss.JoinHorizontal(lipgloss.Top, listPane.Render(m.list.View()), previewPane.Render(m.preview.View()))
+
+	footer := dimStyle.Render(inboxHelp)
+	if m.status != "" {
+		footer = m.status + "  " + footer
+	}
+
+	return panes + "\n" + footer
+}
+
+// formatInboxTime formats the local time of an item
+func formatInboxTime(item inbox.Item) string {
+	if item.Time.IsZero() {
+		return "unknown time"
+	}
+
+	return item.Time.Local().Format("Jan 02 15:04")
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// jobRefreshInterval is how often an attached job is read again
+const jobRefreshInterval = 500 * time.Millisecond
+
+// jobLogLines is how many of the newest log lines are shown
+const jobLogLines = 10
+
+type jobMsg struct {
+	job *model.Job
+	err error
+}
+
+// JobAttachModel follows the progress of a job run by another process
+// until it finishes. Quitting detaches without stopping the job.
+type JobAttachModel struct {
+	id       string
+	job      *model.Job
+	err      error
+	progress progress.Model
+	detached bool
+}
+
+// NewJobAttachModel follows job, starting from its given state
+func NewJobAttachModel(job *model.Job) *JobAttachModel {
+	return &JobAttachModel{
+		id:       job.ID,
+		job:      job,
+		progress: progress.New(progress.WithDefaultGradient()),
+	}
+}
+
+func (m *JobAttachModel) Init() tea.Cmd {
+	return m.refresh(0)
+}
+
+// refresh reads the job again after delay
+func (m *JobAttachModel) refresh(delay time.Duration) tea.Cmd {
+	id := m.id
+
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		job, err := core.FindJob(id)
+		return jobMsg{job: job, err: err}
+	})
+}
+
+func (m *JobAttachModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			m.detached = true
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.progress.Width = max(msg.Width-20, 10)
+
+	case jobMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+
+		m.job = msg.job
+
+		if m.job.Finished() {
+			return m, tea.Quit
+		}
+
+		return m, m.refresh(jobRefreshInterval)
+	}
+
+	return m, nil
+}
+
+func (m *JobAttachModel) View() string {
+	job := m.job
+
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(boldStyle.Render(fmt.Sprintf("Job %s: %s", job.ID, job.Kind)))
+
+	if job.Description != "" {
+		b.WriteString(dimStyle.Render(" " + job.Description))
+	}
+
+	b.WriteString("\n\n")
+
+	pct := 0.0
+	if job.Total > 0 {
+		pct = float64(job.Done) / float64(job.Total)
+	}
+
+	b.WriteString(m.progress.ViewAs(pct))
+	b.WriteString(dimStyle.Render(fmt.Sprintf(" %d/%d", job.Done, job.Total)))
+
+	if job.Failed > 0 {
+		b.WriteString(errorStyle.Render(fmt.Sprintf("  %d failed", job.Failed)))
+	}
+
+	b.WriteString("\n\n")
+
+	for _, line := range job.Log[max(len(job.Log)-jobLogLines, 0):] {
+		b.WriteString(dimStyle.Render("  " + line))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+
+	switch {
+	case job.Finished():
+		b.WriteString(boldStyle.Render(fmt.Sprintf("Job %s", job.State)))
+	case core.JobStale(job, time.Now()):
+		b.WriteString(warningStyle.Render("No progress reported for a while; the process running the job may be gone"))
+	case job.CancelRequested:
+		b.WriteString(warningStyle.Render("Cancelling..."))
+	default:
+		b.WriteString(dimStyle.Render("q: detach (the job keeps running)"))
+	}
+
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// Job returns the last state read of the job
+func (m *JobAttachModel) Job() *model.Job {
+	return m.job
+}
+
+// Detached reports whether the user quit before the job finished
+func (m *JobAttachModel) Detached() bool {
+	return m.detached
+}
+
+// Err returns the error that stopped following the job
+func (m *JobAttachModel) Err() error {
+	return m.err
+}
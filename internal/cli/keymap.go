@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// KeyMap holds the remappable keys of the interactive views, set with
+// 'clonr config keys'
+type KeyMap struct {
+	Select   key.Binding
+	Favorite key.Binding
+	Delete   key.Binding
+	Open     key.Binding
+	Filter   key.Binding
+}
+
+// NewKeyMap returns the bindings of cfg, with the default keys of the
+// actions it does not remap
+func NewKeyMap(cfg model.KeyMapConfig) KeyMap {
+	keys := core.EffectiveKeys(cfg)
+
+	binding := func(action core.KeyAction, help string) key.Binding {
+		return key.NewBinding(
+			key.WithKeys(keys[action]...),
+			key.WithHelp(keyHelp(keys[action]), help),
+		)
+	}
+
+	return KeyMap{
+		Select:   binding(core.KeySelect, "select"),
+		Favorite: binding(core.KeyFavorite, "favorite"),
+		Delete:   binding(core.KeyDelete, "remove"),
+		Open:     binding(core.KeyOpen, "open in editor"),
+		Filter:   binding(core.KeyFilter, "filter"),
+	}
+}
+
+// DefaultKeyMap returns the bindings with no key remapped
+func DefaultKeyMap() KeyMap {
+	return NewKeyMap(model.KeyMapConfig{})
+}
+
+// loadKeyMap returns the bindings of the saved configuration
+func loadKeyMap() KeyMap {
+	return NewKeyMap(core.LoadKeyMap())
+}
+
+// keyHelp shows keys the way the list help does, "enter/ctrl+o"
+func keyHelp(keys []string) string {
+	return strings.Join(keys, "/")
+}
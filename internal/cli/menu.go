@@ -3,10 +3,15 @@ package cli
 import (
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
 )
 
 var (
@@ -17,10 +22,16 @@ var (
 	helpStyle         = list.DefaultStyles().HelpStyle.PaddingLeft(4).PaddingBottom(1)
 )
 
+// menuOpenPinned is the action of the pinned repository entries
+const menuOpenPinned = "open-pinned"
+
 type menuItem struct {
 	title       string
 	description string
 	action      string
+
+	// repo is the repository of a pinned entry
+	repo *model.Repository
 }
 
 func (i menuItem) FilterValue() string { return i.title }
@@ -50,6 +61,8 @@ func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list
 
 type MainMenuModel struct {
 	list         list.Model
+	keys         KeyMap
+	pinnedKeys   key.Binding
 	choice       string
 	quitting     bool
 	selectedItem menuItem
@@ -67,19 +80,29 @@ func (m MainMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch keypress := keyMsg.String(); keypress {
-		case "ctrl+c", "q":
+		switch {
+		case keyMsg.String() == "ctrl+c" || keyMsg.String() == "q":
 			m.quitting = true
 
 			return m, tea.Quit
 
-		case "enter":
+		case key.Matches(keyMsg, m.keys.Select):
 			i, ok := m.list.SelectedItem().(menuItem)
 			if ok {
 				m.selectedItem = i
 				m.choice = i.action
 			}
 
+			return m, tea.Quit
+
+		case key.Matches(keyMsg, m.pinnedKeys):
+			// The digit is the number the pinned entry is shown with
+			n, _ := strconv.Atoi(keyMsg.String())
+			if i, ok := m.list.Items()[n-1].(menuItem); ok {
+				m.selectedItem = i
+				m.choice = i.action
+			}
+
 			return m, tea.Quit
 		}
 	}
@@ -107,8 +130,37 @@ func (m MainMenuModel) GetChoice() string {
 	return m.choice
 }
 
-func NewMainMenu() MainMenuModel {
-	items := []list.Item{
+// GetPinnedRepo returns the pinned repository chosen, or nil when another
+// entry was
+func (m MainMenuModel) GetPinnedRepo() *model.Repository {
+	if m.choice != menuOpenPinned {
+		return nil
+	}
+
+	return m.selectedItem.repo
+}
+
+// NewMainMenu creates the main menu. The pinned repositories come first
+// and open with the digit key of their number.
+func NewMainMenu(pinned []model.Repository) MainMenuModel {
+	return newMainMenu(pinned, loadKeyMap())
+}
+
+func newMainMenu(pinned []model.Repository, keys KeyMap) MainMenuModel {
+	pinned = pinned[:min(len(pinned), model.MaxPinnedRepos)]
+
+	items := make([]list.Item, 0, len(pinned)+16)
+
+	for i := range pinned {
+		items = append(items, menuItem{
+			title:       "Open " + pinnedTitle(pinned[i]),
+			description: pinned[i].Path,
+			action:      menuOpenPinned,
+			repo:        &pinned[i],
+		})
+	}
+
+	items = append(items,
 		menuItem{title: "Clone Repository", description: "Clone a Git repository", action: "clone"},
 		menuItem{title: "List Repositories", description: "List all managed repositories", action: "list"},
 		menuItem{title: "List Branches", description: "List and switch branches", action: "branches"},
@@ -125,7 +177,7 @@ func NewMainMenu() MainMenuModel {
 		menuItem{title: "Manage Profiles", description: "GitHub authentication profiles", action: "profile"},
 		menuItem{title: "Start Server", description: "Start API server", action: "server"},
 		menuItem{title: "Exit", description: "Exit clonr", action: "exit"},
-	}
+	)
 
 	const defaultWidth = 20
 
@@ -137,5 +189,46 @@ func NewMainMenu() MainMenuModel {
 	l.Styles.PaginationStyle = paginationStyle
 	l.Styles.HelpStyle = helpStyle
 
-	return MainMenuModel{list: l}
+	pinnedKeys := pinnedKeyBinding(len(pinned))
+
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.Select, pinnedKeys}
+	}
+
+	return MainMenuModel{list: l, keys: keys, pinnedKeys: pinnedKeys}
+}
+
+// pinnedKeyBinding binds the digits 1 to n, disabled without pins
+func pinnedKeyBinding(n int) key.Binding {
+	digits := make([]string, n)
+	for i := range digits {
+		digits[i] = strconv.Itoa(i + 1)
+	}
+
+	help := "1"
+	if n > 1 {
+		help = "1-" + digits[n-1]
+	}
+
+	binding := key.NewBinding(
+		key.WithKeys(digits...),
+		key.WithHelp(help, "open pinned"),
+	)
+	binding.SetEnabled(n > 0)
+
+	return binding
+}
+
+// pinnedTitle names a pinned repository by its alias, or owner/name
+func pinnedTitle(repo model.Repository) string {
+	if repo.Alias != "" {
+		return repo.Alias
+	}
+
+	u, err := git.ParseURL(repo.URL)
+	if err != nil {
+		return repo.URL
+	}
+
+	return strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
 }
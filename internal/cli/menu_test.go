@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestMainMenuPinnedRepos(t *testing.T) {
+	pinned := []model.Repository{
+		{URL: "https://github.com/acme/api", Path: "/src/api"},
+		{URL: "git@github.com:acme/web.git", Path: "/src/web", Alias: "site"},
+	}
+
+	digit := func(r rune) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}} }
+
+	m := newMainMenu(pinned, DefaultKeyMap())
+
+	if got := m.list.Items()[1].(menuItem).title; got != "Open site" {
+		t.Errorf("second entry = %q, want the alias of the pinned repository", got)
+	}
+
+	if got := m.list.Items()[0].(menuItem).title; got != "Open acme/api" {
+		t.Errorf("first entry = %q, want owner/name", got)
+	}
+
+	next, _ := m.Update(digit('2'))
+	if repo := next.(MainMenuModel).GetPinnedRepo(); repo == nil || repo.Path != "/src/web" {
+		t.Errorf("GetPinnedRepo() after 2 = %v, want the second pin", repo)
+	}
+
+	// Digits beyond the pins move nothing and open nothing
+	next, _ = m.Update(digit('3'))
+	if next.(MainMenuModel).GetChoice() != "" {
+		t.Errorf("choice after 3 = %q, want none", next.(MainMenuModel).GetChoice())
+	}
+
+	// Enter opens the highlighted pin too
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if next.(MainMenuModel).GetPinnedRepo() == nil {
+		t.Error("enter on the first entry opened no pinned repository")
+	}
+
+	m = newMainMenu(nil, DefaultKeyMap())
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := next.(MainMenuModel); got.GetChoice() != "clone" || got.GetPinnedRepo() != nil {
+		t.Errorf("choice = %q without pins, want clone and no repository", got.GetChoice())
+	}
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -50,10 +51,13 @@ type MirrorModel struct {
 	paused bool
 	err    error
 
+	// Cancelled on quit to stop workers and their git processes
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	// Channels for worker coordination
 	workQueue chan core.MirrorRepo
 	resultCh  chan core.MirrorResult
-	doneCh    chan struct{}
 }
 
 type activeOperation struct {
@@ -81,14 +85,18 @@ type mirrorStartMsg struct {
 
 type mirrorDoneMsg struct{}
 
-// NewMirrorModel creates a new mirror TUI model
-func NewMirrorModel(plan *core.MirrorPlan) *MirrorModel {
+// NewMirrorModel creates a new mirror TUI model. Quitting, or cancelling
+// ctx, stops the running clones and pulls.
+func NewMirrorModel(ctx context.Context, plan *core.MirrorPlan) *MirrorModel {
+	ctx, cancel := context.WithCancel(ctx)
+
 	m := &MirrorModel{
 		plan:      plan,
 		total:     len(plan.Repos),
+		ctx:       ctx,
+		cancel:    cancel,
 		workQueue: make(chan core.MirrorRepo, len(plan.Repos)),
 		resultCh:  make(chan core.MirrorResult, len(plan.Repos)),
-		doneCh:    make(chan struct{}),
 		activity:  make([]activityItem, 0, 10),
 		active:    make(map[string]activeOperation),
 		results:   make([]core.MirrorResult, 0, len(plan.Repos)),
@@ -118,9 +126,8 @@ func (m *MirrorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch keyMsg.String() {
 		case "q", "ctrl+c":
-			// Close channels to stop workers
-			close(m.workQueue)
-			close(m.doneCh)
+			// Stop workers and their git processes
+			m.cancel()
 
 			return m, tea.Quit
 		case "p":
@@ -311,7 +318,7 @@ func (m *MirrorModel) startWorkers() tea.Cmd {
 
 						result := m.processRepo(repo)
 						m.resultCh <- result
-					case <-m.doneCh:
+					case <-m.ctx.Done():
 						return
 					}
 				}
@@ -361,7 +368,9 @@ func (m *MirrorModel) processRepo(repo core.MirrorRepo) core.MirrorResult {
 	switch repo.Action {
 	case "clone":
 		err = m.executeWithNetworkRetry(func() error {
-			return core.MirrorCloneRepo(repo.URL, repo.Path, m.plan.Shallow)
+			return core.Jobs().Do(m.ctx, core.JobGit, func() error {
+				return core.MirrorCloneRepo(m.ctx, repo.URL, repo.Path, m.plan.Shallow)
+			})
 		}, &retryCount)
 		if err == nil {
 			err = core.SaveMirroredRepo(repo.URL, repo.Path)
@@ -375,7 +384,9 @@ func (m *MirrorModel) processRepo(repo core.MirrorRepo) core.MirrorResult {
 		}
 
 		err = m.executeWithNetworkRetry(func() error {
-			return core.MirrorUpdateRepo(repo.URL, repo.Path, m.plan.DirtyStrategy, logger)
+			return core.Jobs().Do(m.ctx, core.JobGit, func() error {
+				return core.MirrorUpdateRepo(m.ctx, repo.URL, repo.Path, m.plan.DirtyStrategy, logger)
+			})
 		}, &retryCount)
 		if err == nil {
 			err = core.SaveMirroredRepo(repo.URL, repo.Path)
@@ -413,7 +424,7 @@ func (m *MirrorModel) executeWithNetworkRetry(op func() error, retryCount *int)
 		}
 
 		// Check if it's a network error
-		if !core.IsNetworkError(err) {
+		if !core.IsNetworkError(err) || m.ctx.Err() != nil {
 			return err // Non-retryable error
 		}
 
@@ -423,7 +434,11 @@ func (m *MirrorModel) executeWithNetworkRetry(op func() error, retryCount *int)
 		// Exponential backoff: 1s, 2s, 4s...
 		backoff := min(time.Duration(1<<attempt)*time.Second, 30*time.Second)
 
-		time.Sleep(backoff)
+		select {
+		case <-time.After(backoff):
+		case <-m.ctx.Done():
+			return m.ctx.Err()
+		}
 	}
 
 	return &core.NetworkError{
@@ -477,3 +492,8 @@ func (m *MirrorModel) Error() error {
 func (m *MirrorModel) Results() []core.MirrorResult {
 	return m.results
 }
+
+// Interrupted reports whether the user quit before every repository was processed
+func (m *MirrorModel) Interrupted() bool {
+	return !m.done
+}
@@ -0,0 +1,384 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+var onboardHeaderStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+
+// onboardStep is a page of the first-run onboarding
+type onboardStep int
+
+const (
+	onboardCloneDir onboardStep = iota
+	onboardEditor
+	onboardService
+	onboardMap
+	onboardOrg
+	onboardReview
+)
+
+// OnboardingPlan is what the user chose during onboarding, carried out by
+// the caller once the TUI has quit
+type OnboardingPlan struct {
+	CloneDir       string
+	Editor         string
+	InstallService bool
+	MapDirs        []string // Directories to scan for existing clones
+	Org            string   // GitHub organization to mirror, empty to skip
+}
+
+// OnboardingModel walks a new user through the first setup of clonr
+type OnboardingModel struct {
+	step     onboardStep
+	cloneDir textinput.Model
+	editor   textinput.Model
+	org      textinput.Model
+	service  bool
+	codeDirs []string
+	mapped   map[int]bool
+	cursor   int
+	offerOrg bool
+	detected string // Editor found installed, suggested by default
+	err      error
+
+	plan *OnboardingPlan
+}
+
+// NewOnboardingModel creates the onboarding with codeDirs offered for
+// mapping. The organization import is only offered when offerOrg is set,
+// as it needs the GitHub API.
+func NewOnboardingModel(codeDirs []string, offerOrg bool) *OnboardingModel {
+	newInput := func(placeholder, value string) textinput.Model {
+		t := textinput.New()
+		t.Cursor.Style = cursorStyle
+		t.PromptStyle = focusedStyle
+		t.TextStyle = focusedStyle
+		t.Placeholder = placeholder
+		t.CharLimit = 256
+		t.SetValue(value)
+
+		return t
+	}
+
+	detected := core.DetectEditor()
+
+	m := &OnboardingModel{
+		cloneDir: newInput("~/clonr", model.DefaultConfig().DefaultCloneDir),
+		editor:   newInput("code, vim, etc.", detected),
+		org:      newInput("organization name (optional)", ""),
+		codeDirs: codeDirs,
+		mapped:   make(map[int]bool),
+		offerOrg: offerOrg,
+		detected: detected,
+	}
+
+	// Existing clones are mapped unless deselected
+	for i := range codeDirs {
+		m.mapped[i] = true
+	}
+
+	m.cloneDir.Focus()
+
+	return m
+}
+
+// Plan returns the choices confirmed by the user, or nil when the
+// onboarding was left before the end
+func (m *OnboardingModel) Plan() *OnboardingPlan {
+	return m.plan
+}
+
+func (m *OnboardingModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *OnboardingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, m.updateInput(msg)
+	}
+
+	switch key.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		if m.step == onboardCloneDir {
+			return m, tea.Quit
+		}
+
+		return m, m.goTo(m.prevStep())
+	case "enter":
+		if m.step == onboardReview {
+			m.plan = m.buildPlan()
+			return m, tea.Quit
+		}
+
+		if m.err = m.validate(); m.err != nil {
+			return m, nil
+		}
+
+		return m, m.goTo(m.nextStep())
+	}
+
+	switch m.step {
+	case onboardService:
+		switch key.String() {
+		case "y":
+			m.service = true
+		case "n":
+			m.service = false
+		case " ", "left", "right", "tab":
+			m.service = !m.service
+		}
+
+		return m, nil
+	case onboardMap:
+		switch key.String() {
+		case "up", "k":
+			m.cursor = max(m.cursor-1, 0)
+		case "down", "j":
+			m.cursor = min(m.cursor+1, len(m.codeDirs)-1)
+		case " ":
+			m.mapped[m.cursor] = !m.mapped[m.cursor]
+		}
+
+		return m, nil
+	case onboardReview:
+		return m, nil
+	}
+
+	m.err = nil
+
+	return m, m.updateInput(msg)
+}
+
+// updateInput passes msg to the text input of the current step, if any
+func (m *OnboardingModel) updateInput(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch m.step {
+	case onboardCloneDir:
+		m.cloneDir, cmd = m.cloneDir.Update(msg)
+	case onboardEditor:
+		m.editor, cmd = m.editor.Update(msg)
+	case onboardOrg:
+		m.org, cmd = m.org.Update(msg)
+	}
+
+	return cmd
+}
+
+// validate checks the input of the current step before moving on
+func (m *OnboardingModel) validate() error {
+	switch m.step {
+	case onboardCloneDir:
+		return validateRequired(m.cloneDir.Value())
+	case onboardEditor:
+		return optional(validateCommand)(strings.TrimSpace(m.editor.Value()))
+	case onboardOrg:
+		if org := strings.TrimSpace(m.org.Value()); org != "" {
+			return core.ValidateOrgName(org)
+		}
+	}
+
+	return nil
+}
+
+// nextStep is the step after the current one, skipping those with nothing
+// to offer
+func (m *OnboardingModel) nextStep() onboardStep {
+	step := m.step + 1
+
+	for step < onboardReview && m.skipped(step) {
+		step++
+	}
+
+	return step
+}
+
+// prevStep is the step before the current one, skipping those with nothing
+// to offer
+func (m *OnboardingModel) prevStep() onboardStep {
+	step := m.step - 1
+
+	for step > onboardCloneDir && m.skipped(step) {
+		step--
+	}
+
+	return step
+}
+
+func (m *OnboardingModel) skipped(step onboardStep) bool {
+	return (step == onboardMap && len(m.codeDirs) == 0) || (step == onboardOrg && !m.offerOrg)
+}
+
+// goTo moves to step, focusing its text input
+func (m *OnboardingModel) goTo(step onboardStep) tea.Cmd {
+	m.step = step
+	m.err = nil
+
+	m.cloneDir.Blur()
+	m.editor.Blur()
+	m.org.Blur()
+
+	switch step {
+	case onboardCloneDir:
+		return m.cloneDir.Focus()
+	case onboardEditor:
+		return m.editor.Focus()
+	case onboardOrg:
+		return m.org.Focus()
+	}
+
+	return nil
+}
+
+// buildPlan collects the choices made on every step
+func (m *OnboardingModel) buildPlan() *OnboardingPlan {
+	plan := &OnboardingPlan{
+		CloneDir:       strings.TrimSpace(m.cloneDir.Value()),
+		Editor:         strings.TrimSpace(m.editor.Value()),
+		InstallService: m.service,
+	}
+
+	for i, dir := range m.codeDirs {
+		if m.mapped[i] {
+			plan.MapDirs = append(plan.MapDirs, dir)
+		}
+	}
+
+	if m.offerOrg {
+		plan.Org = strings.TrimSpace(m.org.Value())
+	}
+
+	return plan
+}
+
+func (m *OnboardingModel) View() string {
+	if m.plan != nil {
+		return ""
+	}
+
+	s := onboardHeaderStyle.Render("Welcome to Clonr") + "\n"
+	s += blurredStyle.Render(fmt.Sprintf("Let's get you set up (step %d of %d)", m.position(), m.steps())) + "\n\n"
+
+	help := "enter: next • esc: back"
+
+	switch m.step {
+	case onboardCloneDir:
+		s += " Where should new clones go?\n\n " + m.cloneDir.View() + "\n"
+		help = "enter: next • esc: quit"
+	case onboardEditor:
+		s += " Which editor opens repositories?\n\n " + m.editor.View() + "\n"
+
+		if m.detected != "" {
+			s += " " + blurredStyle.Render("detected: "+m.detected) + "\n"
+		} else {
+			s += " " + blurredStyle.Render("no editor detected; leave empty to set it later") + "\n"
+		}
+	case onboardService:
+		s += " Start the clonr server automatically at login?\n\n"
+		s += " " + onboardChoice("Yes, install it as a service", m.service) + "\n"
+		s += " " + onboardChoice("No, start it when needed", !m.service) + "\n"
+		help = "space/y/n: choose • enter: next • esc: back"
+	case onboardMap:
+		s += " Track the repositories already cloned in these directories?\n\n"
+
+		for i, dir := range m.codeDirs {
+			cursor := "  "
+			if i == m.cursor {
+				cursor = focusedStyle.Render("> ")
+			}
+
+			s += " " + cursor + onboardChoice(dir, m.mapped[i]) + "\n"
+		}
+
+		help = "space: toggle • enter: next • esc: back"
+	case onboardOrg:
+		s += " Mirror the repositories of a GitHub organization?\n\n " + m.org.View() + "\n"
+		s += " " + blurredStyle.Render("leave empty to skip") + "\n"
+	case onboardReview:
+		s += m.reviewView()
+		help = "enter: set up • esc: back"
+	}
+
+	if m.err != nil {
+		s += "\n " + errorStyle.Render("✗ "+m.err.Error()) + "\n"
+	}
+
+	return s + "\n" + helpStyleConfigure.Render(" "+help+" • ctrl+c: quit") + "\n"
+}
+
+// reviewView summarizes the choices before they are carried out
+func (m *OnboardingModel) reviewView() string {
+	plan := m.buildPlan()
+
+	editor := plan.Editor
+	if editor == "" {
+		editor = "(not set)"
+	}
+
+	lines := []string{
+		"Clone directory: " + plan.CloneDir,
+		"Editor:          " + editor,
+	}
+
+	if plan.InstallService {
+		lines = append(lines, "Server:          installed as a service")
+	} else {
+		lines = append(lines, "Server:          started when needed")
+	}
+
+	if len(plan.MapDirs) > 0 {
+		lines = append(lines, "Map:             "+strings.Join(plan.MapDirs, ", "))
+	}
+
+	if plan.Org != "" {
+		lines = append(lines, "Mirror:          "+plan.Org)
+	}
+
+	return " " + strings.Join(lines, "\n ") + "\n"
+}
+
+// position is the number of the current step among those shown
+func (m *OnboardingModel) position() int {
+	n := 1
+
+	for step := onboardCloneDir; step < m.step; step++ {
+		if !m.skipped(step) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// steps is the number of steps shown
+func (m *OnboardingModel) steps() int {
+	n := 0
+
+	for step := onboardCloneDir; step <= onboardReview; step++ {
+		if !m.skipped(step) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// onboardChoice renders an option with a checkbox
+func onboardChoice(label string, selected bool) string {
+	if selected {
+		return successStyle.Render("[x] ") + label
+	}
+
+	return blurredStyle.Render("[ ] ") + label
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestOnboardingPlan(t *testing.T) {
+	m := NewOnboardingModel([]string{"/home/me/code", "/home/me/src"}, false)
+	m.editor.SetValue("sh")
+
+	press := func(msg tea.KeyMsg) {
+		t.Helper()
+
+		next, _ := m.Update(msg)
+		m = next.(*OnboardingModel)
+	}
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	// An empty clone directory keeps the first step
+	m.cloneDir.SetValue("")
+	press(enter)
+
+	if m.step != onboardCloneDir || m.err == nil {
+		t.Fatalf("step = %d, err = %v; want the clone directory refused", m.step, m.err)
+	}
+
+	m.cloneDir.SetValue("~/clonr")
+	press(enter)
+	press(enter)
+
+	if m.step != onboardService {
+		t.Fatalf("step = %d, want the service choice", m.step)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	press(enter)
+
+	// Deselect the first code directory
+	press(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	press(enter)
+
+	// The organization import is skipped when not offered
+	if m.step != onboardReview {
+		t.Fatalf("step = %d, want the review", m.step)
+	}
+
+	if got, want := m.position(), m.steps(); got != want || want != 5 {
+		t.Errorf("review is step %d of %d, want 5 of 5", got, want)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.step != onboardMap {
+		t.Fatalf("step = %d after esc, want the map choice", m.step)
+	}
+
+	press(enter)
+	press(enter)
+
+	plan := m.Plan()
+	if plan == nil {
+		t.Fatal("Plan() = nil after confirming")
+	}
+
+	if plan.CloneDir != "~/clonr" || plan.Editor != "sh" || !plan.InstallService || !slices.Equal(plan.MapDirs, []string{"/home/me/src"}) || plan.Org != "" {
+		t.Errorf("Plan() = %+v", plan)
+	}
+}
@@ -0,0 +1,524 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+var (
+	markKey = key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "mark"),
+	)
+	markAllKey = key.NewBinding(
+		key.WithKeys("ctrl+a"),
+		key.WithHelp("ctrl+a", "mark/unmark all"),
+	)
+	bulkKey = key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "actions"),
+	)
+
+	bulkTitleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+	bulkHintStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+)
+
+// maxConfirmRepos is how many repositories the confirmation screen lists
+const maxConfirmRepos = 10
+
+// bulkStage is the step of a bulk action in progress
+type bulkStage int
+
+const (
+	bulkPickAction    bulkStage = iota // choosing the action
+	bulkPickWorkspace                  // choosing the workspace to move to
+	bulkEnterTags                      // typing the tags to add or remove
+	bulkConfirm                        // reviewing a destructive action
+)
+
+// bulkAction is an action applied to every marked repository
+type bulkAction string
+
+const (
+	bulkFavorite   bulkAction = "favorite"
+	bulkUnfavorite bulkAction = "unfavorite"
+	bulkMove       bulkAction = "move"
+	bulkTag        bulkAction = "tag"
+	bulkUpdate     bulkAction = "update"
+	bulkRemove     bulkAction = "remove"
+)
+
+// bulkActionItem is an entry of the bulk action menu
+type bulkActionItem struct {
+	action      bulkAction
+	title       string
+	description string
+}
+
+func (i bulkActionItem) Title() string       { return i.title }
+func (i bulkActionItem) Description() string { return i.description }
+func (i bulkActionItem) FilterValue() string { return i.title }
+
+var bulkActionItems = []list.Item{
+	bulkActionItem{bulkFavorite, "Favorite", "Mark as favorite"},
+	bulkActionItem{bulkUnfavorite, "Unfavorite", "Unmark as favorite"},
+	bulkActionItem{bulkMove, "Move to workspace…", "Assign to another workspace; files stay in place"},
+	bulkActionItem{bulkTag, "Tag…", "Add or remove tags"},
+	bulkActionItem{bulkUpdate, "Update", "Pull the latest changes"},
+	bulkActionItem{bulkRemove, "Remove…", "Remove from clonr; files are kept"},
+}
+
+// bulkState is a bulk action being set up on the marked repositories
+type bulkState struct {
+	stage      bulkStage
+	repos      []model.Repository
+	actions    list.Model
+	workspaces list.Model
+	tags       textinput.Model
+	err        error // invalid tags, shown under the input
+}
+
+// bulkWorkspacesMsg carries the workspaces to pick the move target from
+type bulkWorkspacesMsg struct {
+	workspaces []model.Workspace
+	err        error
+}
+
+// toggleMark marks or unmarks the highlighted repository and moves down
+func (m *RepoListModel) toggleMark(repo model.Repository) tea.Cmd {
+	if m.marked[repo.URL] {
+		delete(m.marked, repo.URL)
+	} else {
+		m.marked[repo.URL] = true
+	}
+
+	cmd := m.refreshItems()
+	m.list.CursorDown()
+
+	return cmd
+}
+
+// toggleMarkAll marks every shown repository, or unmarks them all if they
+// are all marked already
+func (m *RepoListModel) toggleMarkAll() tea.Cmd {
+	var shown []string
+
+	for _, item := range m.list.VisibleItems() {
+		if r, ok := item.(repoItem); ok {
+			shown = append(shown, r.repo.URL)
+		}
+	}
+
+	allMarked := len(shown) > 0
+
+	for _, u := range shown {
+		if !m.marked[u] {
+			allMarked = false
+
+			break
+		}
+	}
+
+	for _, u := range shown {
+		if allMarked {
+			delete(m.marked, u)
+		} else {
+			m.marked[u] = true
+		}
+	}
+
+	return m.refreshItems()
+}
+
+// pruneMarks forgets the marks of repositories no longer listed
+func (m *RepoListModel) pruneMarks() {
+	listed := make(map[string]bool, len(m.repos))
+	for _, repo := range m.repos {
+		listed[repo.URL] = true
+	}
+
+	for u := range m.marked {
+		if !listed[u] {
+			delete(m.marked, u)
+		}
+	}
+}
+
+// bulkTargets returns the marked repositories, or the highlighted one when
+// none are marked
+func (m RepoListModel) bulkTargets() []model.Repository {
+	var repos []model.Repository
+
+	for _, repo := range m.repos {
+		if m.marked[repo.URL] {
+			repos = append(repos, repo)
+		}
+	}
+
+	if len(repos) == 0 {
+		if i, ok := m.list.SelectedItem().(repoItem); ok {
+			repos = append(repos, i.repo)
+		}
+	}
+
+	return repos
+}
+
+// openBulk opens the bulk action menu for the marked repositories
+func (m RepoListModel) openBulk() (tea.Model, tea.Cmd) {
+	repos := m.bulkTargets()
+	if len(repos) == 0 {
+		return m, m.list.NewStatusMessage("Mark repositories with space first")
+	}
+
+	actions := list.New(bulkActionItems, list.NewDefaultDelegate(), m.list.Width(), m.list.Height())
+	actions.Title = "Actions on " + countRepos(len(repos))
+	actions.SetShowStatusBar(false)
+	actions.SetFilteringEnabled(false)
+
+	tags := textinput.New()
+	tags.Placeholder = "tag, other-tag, -removed-tag"
+	tags.CharLimit = 200
+	tags.Width = 50
+
+	m.bulk = &bulkState{stage: bulkPickAction, repos: repos, actions: actions, tags: tags}
+
+	return m, nil
+}
+
+// updateBulk handles messages while a bulk action is being set up
+func (m RepoListModel) updateBulk(msg tea.Msg) (tea.Model, tea.Cmd) {
+	b := *m.bulk
+
+	if wsMsg, ok := msg.(bulkWorkspacesMsg); ok {
+		if wsMsg.err != nil {
+			m.bulk = nil
+
+			return m, m.list.NewStatusMessage(errorStyle.Render(fmt.Sprintf("Failed to load workspaces: %v", wsMsg.err)))
+		}
+
+		b.workspaces = newBulkWorkspacePicker(wsMsg.workspaces, m.list.Width(), m.list.Height())
+		b.stage = bulkPickWorkspace
+		m.bulk = &b
+
+		return m, nil
+	}
+
+	keyMsg, isKey := msg.(tea.KeyMsg)
+	if isKey && keyMsg.String() == "ctrl+c" {
+		m.quitting = true
+
+		return m, m.quit()
+	}
+
+	var cmd tea.Cmd
+
+	switch b.stage {
+	case bulkPickAction:
+		if isKey {
+			switch keyMsg.String() {
+			case "esc", "q", "a":
+				m.bulk = nil
+
+				return m, nil
+
+			case "enter":
+				item, _ := b.actions.SelectedItem().(bulkActionItem)
+
+				return m.chooseBulkAction(b, item.action)
+			}
+		}
+
+		b.actions, cmd = b.actions.Update(msg)
+
+	case bulkPickWorkspace:
+		if isKey && b.workspaces.FilterState() != list.Filtering {
+			switch keyMsg.String() {
+			case "esc", "q":
+				b.stage = bulkPickAction
+				m.bulk = &b
+
+				return m, nil
+
+			case "enter":
+				item, ok := b.workspaces.SelectedItem().(WorkspaceItem)
+				if !ok {
+					return m, nil
+				}
+
+				m.bulk = nil
+
+				return m, m.runBulk(fmt.Sprintf("Moving %s to %s…", countRepos(len(b.repos)), item.workspace.Name), moveRepos(b.repos, item.workspace.Name))
+			}
+		}
+
+		b.workspaces, cmd = b.workspaces.Update(msg)
+
+	case bulkEnterTags:
+		if isKey {
+			switch keyMsg.String() {
+			case "esc":
+				b.stage = bulkPickAction
+				b.err = nil
+				b.tags.Blur()
+				m.bulk = &b
+
+				return m, nil
+
+			case "enter":
+				add, remove, err := core.ParseTagChanges(b.tags.Value())
+				if err != nil {
+					b.err = err
+					m.bulk = &b
+
+					return m, nil
+				}
+
+				m.bulk = nil
+
+				return m, m.runBulk(fmt.Sprintf("Tagging %s…", countRepos(len(b.repos))), tagRepos(b.repos, add, remove))
+			}
+		}
+
+		b.tags, cmd = b.tags.Update(msg)
+
+	case bulkConfirm:
+		if isKey {
+			switch keyMsg.String() {
+			case "y", "enter":
+				m.bulk = nil
+
+				return m, m.runBulk(fmt.Sprintf("Removing %s…", countRepos(len(b.repos))), removeRepos(b.repos))
+
+			case "n", "esc", "q":
+				b.stage = bulkPickAction
+				m.bulk = &b
+
+				return m, nil
+			}
+		}
+	}
+
+	m.bulk = &b
+
+	return m, cmd
+}
+
+// chooseBulkAction runs the chosen action, or moves on to the step it needs
+func (m RepoListModel) chooseBulkAction(b bulkState, action bulkAction) (tea.Model, tea.Cmd) {
+	switch action {
+	case bulkFavorite, bulkUnfavorite:
+		m.bulk = nil
+
+		return m, m.runBulk("Updating favorites…", favoriteRepos(b.repos, action == bulkFavorite))
+
+	case bulkUpdate:
+		m.bulk = nil
+
+		return m, m.runBulk(fmt.Sprintf("Updating %s…", countRepos(len(b.repos))), updateRepos(b.repos))
+
+	case bulkMove:
+		m.bulk = &b
+
+		return m, loadBulkWorkspaces()
+
+	case bulkTag:
+		b.stage = bulkEnterTags
+		b.err = nil
+		b.tags.SetValue("")
+		cmd := b.tags.Focus()
+		m.bulk = &b
+
+		return m, cmd
+
+	case bulkRemove:
+		b.stage = bulkConfirm
+		m.bulk = &b
+
+		return m, nil
+	}
+
+	m.bulk = &b
+
+	return m, nil
+}
+
+// runBulk shows status while cmd applies a bulk action
+func (m RepoListModel) runBulk(status string, cmd tea.Cmd) tea.Cmd {
+	return tea.Batch(m.list.NewStatusMessage(status), cmd)
+}
+
+// viewBulk renders the step of the bulk action in progress
+func (m RepoListModel) viewBulk() string {
+	b := m.bulk
+
+	switch b.stage {
+	case bulkPickWorkspace:
+		return docStyle.Render(b.workspaces.View())
+
+	case bulkEnterTags:
+		s := bulkTitleStyle.Render("Tag "+countRepos(len(b.repos))) + "\n\n" +
+			bulkHintStyle.Render("Comma or space separated; prefix a tag with - to remove it") + "\n\n" +
+			b.tags.View()
+
+		if b.err != nil {
+			s += "\n\n" + errorStyle.Render("✗ "+b.err.Error())
+		}
+
+		return docStyle.Render(s + "\n\n" + bulkHintStyle.Render("enter apply · esc back"))
+
+	case bulkConfirm:
+		return docStyle.Render(confirmRemoveView(b.repos))
+	}
+
+	return docStyle.Render(b.actions.View())
+}
+
+// confirmRemoveView summarizes the repositories about to be removed
+func confirmRemoveView(repos []model.Repository) string {
+	var s strings.Builder
+
+	s.WriteString(bulkTitleStyle.Render(fmt.Sprintf("Remove %s from clonr?", countRepos(len(repos)))))
+	s.WriteString("\n\n")
+
+	for i, repo := range repos {
+		if i == maxConfirmRepos {
+			fmt.Fprintf(&s, "  … and %d more\n", len(repos)-maxConfirmRepos)
+
+			break
+		}
+
+		fmt.Fprintf(&s, "  %s %s\n", urlStyle.Render(repo.URL), pathStyle.Render(repo.Path))
+	}
+
+	s.WriteString("\n" + warningStyle.Render("The files on disk are kept.") + "\n\n")
+	s.WriteString(bulkHintStyle.Render("y/enter remove · n/esc back"))
+
+	return s.String()
+}
+
+// newBulkWorkspacePicker lists the workspaces to move repositories to
+func newBulkWorkspacePicker(workspaces []model.Workspace, width, height int) list.Model {
+	items := make([]list.Item, len(workspaces))
+	for i, ws := range workspaces {
+		items[i] = WorkspaceItem{workspace: ws}
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), width, height)
+	l.Title = "Move to Workspace"
+	l.SetShowStatusBar(false)
+
+	return l
+}
+
+// loadBulkWorkspaces fetches the workspaces in the background
+func loadBulkWorkspaces() tea.Cmd {
+	return func() tea.Msg {
+		client, err := grpc.GetClient()
+		if err != nil {
+			return bulkWorkspacesMsg{err: err}
+		}
+
+		workspaces, err := client.ListWorkspaces()
+
+		return bulkWorkspacesMsg{workspaces: workspaces, err: err}
+	}
+}
+
+// bulkApply runs fn on each repository and reports how many succeeded with
+// format, whose %s is replaced by the count of repositories
+func bulkApply(repos []model.Repository, format string, fn func(model.Repository) error) tea.Cmd {
+	return func() tea.Msg {
+		var errs []error
+
+		for _, repo := range repos {
+			if err := fn(repo); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", repo.URL, err))
+			}
+		}
+
+		return bulkOutcome(format, len(repos)-len(errs), errors.Join(errs...))
+	}
+}
+
+// bulkOutcome reports a bulk action done on done repositories. Only the
+// first failure fits the status line; the others are counted.
+func bulkOutcome(format string, done int, err error) repoActionMsg {
+	status := fmt.Sprintf(format, countRepos(done))
+	if err == nil {
+		return repoActionMsg{status: status, changed: done > 0}
+	}
+
+	first, rest, _ := strings.Cut(err.Error(), "\n")
+	if rest != "" {
+		first += fmt.Sprintf(" (and %d more)", strings.Count(rest, "\n")+1)
+	}
+
+	return repoActionMsg{err: fmt.Errorf("%s; failed %s", status, first), changed: done > 0}
+}
+
+func favoriteRepos(repos []model.Repository, fav bool) tea.Cmd {
+	format := "★ Marked %s as favorite"
+	if !fav {
+		format = "☆ Unmarked %s as favorite"
+	}
+
+	return bulkApply(repos, format, func(repo model.Repository) error {
+		return core.SetFavoriteByURL(repo.URL, fav)
+	})
+}
+
+func moveRepos(repos []model.Repository, workspace string) tea.Cmd {
+	return bulkApply(repos, "Moved %s to "+strings.ReplaceAll(workspace, "%", "%%"), func(repo model.Repository) error {
+		if repo.Workspace == workspace {
+			return nil
+		}
+
+		client, err := grpc.GetClient()
+		if err != nil {
+			return err
+		}
+
+		return client.UpdateRepoWorkspace(repo.URL, workspace)
+	})
+}
+
+func tagRepos(repos []model.Repository, add, remove []string) tea.Cmd {
+	return bulkApply(repos, "Tagged %s", func(repo model.Repository) error {
+		return core.SetRepoTags(repo.URL, core.ApplyTagChanges(repo.Tags, add, remove))
+	})
+}
+
+func removeRepos(repos []model.Repository) tea.Cmd {
+	return bulkApply(repos, "Removed %s", func(repo model.Repository) error {
+		return core.RemoveRepo(repo.URL)
+	})
+}
+
+func updateRepos(repos []model.Repository) tea.Cmd {
+	return func() tea.Msg {
+		done, err := core.UpdateRepos(context.Background(), repos)
+
+		return bulkOutcome("Updated %s", done, err)
+	}
+}
+
+// countRepos returns "1 repository" or "n repositories"
+func countRepos(n int) string {
+	if n == 1 {
+		return "1 repository"
+	}
+
+	return fmt.Sprintf("%d repositories", n)
+}
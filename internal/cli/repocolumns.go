@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+var sortKey = key.NewBinding(
+	key.WithKeys("s"),
+	key.WithHelp("s", "sort"),
+)
+
+// listSortCycle is the order the s key steps through, from the default
+// frecency order. Sorts that need commit statistics are left out because the
+// interactive list does not load them.
+var listSortCycle = []core.SortBy{
+	core.SortByFrecency, core.SortByName, core.SortByUpdatedAt, core.SortByClonedAt,
+	core.SortBySize, core.SortByAhead, core.SortByBehind,
+}
+
+// repoColumns holds the configured columns and the details loaded for them,
+// keyed by repository path
+type repoColumns struct {
+	columns []core.ListColumn
+	details map[string]*core.RepoDetails
+}
+
+// describe renders a repository's description line from the configured columns
+func (c *repoColumns) describe(repo model.Repository) string {
+	details := c.details[repo.Path]
+	parts := make([]string, 0, len(c.columns))
+
+	for _, col := range c.columns {
+		var part string
+
+		switch col {
+		case core.ColumnPath:
+			part = repo.Path
+		case core.ColumnWorkspace:
+			if repo.Workspace != "" {
+				part = "Workspace: " + repo.Workspace
+			}
+		case core.ColumnKind:
+			if repo.Kind != "" {
+				part = "Kind: " + string(repo.Kind)
+			}
+		case core.ColumnUpdated:
+			if !repo.UpdatedAt.IsZero() {
+				part = "Updated: " + repo.UpdatedAt.Format("2006-01-02 15:04")
+			}
+		case core.ColumnTags:
+			if details != nil && details.LatestTag != "" {
+				part = "Tag: " + details.LatestTag
+			}
+		case core.ColumnAheadBehind:
+			if details != nil && details.HasUpstream {
+				part = "Sync: " + core.FormatAheadBehind(details)
+			}
+		case core.ColumnSize:
+			if details != nil {
+				part = "Size: " + core.FormatSize(details.Size)
+			}
+		case core.ColumnCI:
+			if details != nil && details.CI != "" {
+				part = "CI: " + details.CI
+			}
+		}
+
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, " | ")
+}
+
+// detailsLoadedMsg carries repository details computed in the background
+type detailsLoadedMsg struct {
+	details map[string]*core.RepoDetails
+}
+
+// WithColumns sets the columns shown in each row's description and the sort
+// order. An empty sort keeps the current order.
+func (m RepoListModel) WithColumns(columns []core.ListColumn, sortBy core.SortBy) RepoListModel {
+	m.cols = &repoColumns{columns: columns, details: make(map[string]*core.RepoDetails)}
+	m.sortBy = sortBy
+	m.refreshItems()
+
+	return m
+}
+
+// loadDetails computes the details of repositories that do not have them yet
+func (m RepoListModel) loadDetails() tea.Cmd {
+	if m.cols == nil || (!core.ColumnsNeedDetails(m.cols.columns) && !core.SortNeedsDetails(m.sortBy)) {
+		return nil
+	}
+
+	var pending []core.RepoWithStats
+
+	for _, repo := range m.repos {
+		if _, ok := m.cols.details[repo.Path]; !ok {
+			pending = append(pending, core.RepoWithStats{Repository: repo})
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	columns, sortBy := m.cols.columns, m.sortBy
+
+	return func() tea.Msg {
+		core.LoadRepoDetails(pending, columns, sortBy)
+
+		details := make(map[string]*core.RepoDetails, len(pending))
+		for _, r := range pending {
+			details[r.Path] = r.Details
+		}
+
+		return detailsLoadedMsg{details: details}
+	}
+}
+
+// cycleSort switches to the next sort order
+func (m *RepoListModel) cycleSort() tea.Cmd {
+	i := slices.Index(listSortCycle, m.sortBy)
+	m.sortBy = listSortCycle[(i+1)%len(listSortCycle)]
+
+	if m.cols == nil {
+		m.cols = &repoColumns{details: make(map[string]*core.RepoDetails)}
+	}
+
+	// Details loaded for other columns may lack the values this sort needs
+	if core.SortNeedsDetails(m.sortBy) {
+		clear(m.cols.details)
+	}
+
+	cmd := m.refreshItems()
+
+	return tea.Batch(cmd, m.list.NewStatusMessage(fmt.Sprintf("Sort: %s", m.sortBy)), m.loadDetails())
+}
+
+// sortedRepos returns the repositories in the active sort order
+func (m RepoListModel) sortedRepos() []model.Repository {
+	if m.sortBy == "" {
+		return m.repos
+	}
+
+	withDetails := make([]core.RepoWithStats, len(m.repos))
+	for i, repo := range m.repos {
+		withDetails[i] = core.RepoWithStats{Repository: repo}
+		if m.cols != nil {
+			withDetails[i].Details = m.cols.details[repo.Path]
+		}
+	}
+
+	core.SortRepos(withDetails, m.sortBy)
+
+	repos := make([]model.Repository, len(withDetails))
+	for i, r := range withDetails {
+		repos[i] = r.Repository
+	}
+
+	return repos
+}
@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+var detailKey = key.NewBinding(
+	key.WithKeys("i"),
+	key.WithHelp("i", "details"),
+)
+
+var (
+	detailPaneStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(lipgloss.Color("240")).
+			PaddingLeft(2)
+
+	detailLabelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(11)
+)
+
+// minDetailWidth keeps the pane readable in narrow terminals
+const minDetailWidth = 32
+
+// detailPane caches the details of the repositories highlighted while the
+// pane is open, keyed by URL
+type detailPane struct {
+	details map[string]*model.RepoDetail
+	errs    map[string]error
+	loading map[string]bool
+}
+
+func newDetailPane() *detailPane {
+	return &detailPane{
+		details: make(map[string]*model.RepoDetail),
+		errs:    make(map[string]error),
+		loading: make(map[string]bool),
+	}
+}
+
+// forget drops the cached details of url, or of every repository when url
+// is empty, so they are read again
+func (p *detailPane) forget(url string) {
+	if url == "" {
+		clear(p.details)
+		clear(p.errs)
+
+		return
+	}
+
+	delete(p.details, url)
+	delete(p.errs, url)
+}
+
+// repoDetailMsg carries the details of a repository read by the server
+type repoDetailMsg struct {
+	url    string
+	detail *model.RepoDetail
+	err    error
+}
+
+// fetchRepoDetail asks the server for the details of a repository
+func fetchRepoDetail(url string) tea.Cmd {
+	return func() tea.Msg {
+		detail, err := core.GetRepoDetail(url)
+
+		return repoDetailMsg{url: url, detail: detail, err: err}
+	}
+}
+
+// loadSelectedDetail fetches the details of the highlighted repository when
+// the pane is open and they are not loaded or loading yet
+func (m RepoListModel) loadSelectedDetail() tea.Cmd {
+	if m.detail == nil {
+		return nil
+	}
+
+	i, ok := m.list.SelectedItem().(repoItem)
+	if !ok {
+		return nil
+	}
+
+	u := i.repo.URL
+	if m.detail.details[u] != nil || m.detail.errs[u] != nil || m.detail.loading[u] {
+		return nil
+	}
+
+	m.detail.loading[u] = true
+
+	return fetchRepoDetail(u)
+}
+
+// toggleDetail opens or closes the detail pane
+func (m *RepoListModel) toggleDetail() {
+	if m.detail != nil {
+		m.detail = nil
+	} else {
+		m.detail = newDetailPane()
+	}
+
+	m.resize()
+}
+
+// resize fits the list, and the detail pane when open, to the window
+func (m *RepoListModel) resize() {
+	h, v := docStyle.GetFrameSize()
+	width, height := m.width-h, m.height-v
+
+	if m.picker != nil {
+		m.picker.SetSize(width, height)
+	}
+
+	if m.bulk != nil {
+		m.bulk.actions.SetSize(width, height)
+		m.bulk.workspaces.SetSize(width, height)
+	}
+
+	if m.detail != nil {
+		width -= m.detailWidth()
+	}
+
+	m.list.SetSize(width, height)
+}
+
+// detailWidth is the width of the detail pane, two fifths of the window
+func (m RepoListModel) detailWidth() int {
+	return max(m.width*2/5, minDetailWidth)
+}
+
+// viewDetail renders the detail pane for the highlighted repository
+func (m RepoListModel) viewDetail() string {
+	var body string
+
+	i, ok := m.list.SelectedItem().(repoItem)
+
+	switch {
+	case !ok:
+		body = pathStyle.Render("Highlight a repository to see its details")
+	case m.detail.errs[i.repo.URL] != nil:
+		body = urlStyle.Render(i.repo.URL) + "\n\n" + errorStyle.Render(m.detail.errs[i.repo.URL].Error())
+	case m.detail.details[i.repo.URL] == nil:
+		body = urlStyle.Render(i.repo.URL) + "\n\n" + pathStyle.Render("Loading…")
+	default:
+		body = renderRepoDetail(m.detail.details[i.repo.URL])
+	}
+
+	_, v := docStyle.GetFrameSize()
+
+	// The border and padding take 3 columns of the pane
+	return detailPaneStyle.Width(m.detailWidth() - 3).Height(m.height - v).Render(body)
+}
+
+// renderRepoDetail lays out the details of a repository, one field a line
+func renderRepoDetail(d *model.RepoDetail) string {
+	repo := d.Repository
+	lines := []string{urlStyle.Render(repo.URL), ""}
+
+	field := func(label, value string) {
+		lines = append(lines, detailLabelStyle.Render(label)+value)
+	}
+
+	field("Path", repo.Path)
+
+	if repo.Alias != "" {
+		field("Alias", repo.Alias)
+	}
+
+	if repo.Workspace != "" {
+		field("Workspace", repo.Workspace)
+	}
+
+	if d.GitError != "" {
+		lines = append(lines, "", errorStyle.Render(d.GitError))
+	} else {
+		field("Branch", d.Branch)
+
+		if d.LastCommit == "" {
+			field("Commit", pathStyle.Render("none"))
+		} else {
+			field("Commit", d.LastCommit+" "+d.LastCommitSubject)
+			field("", pathStyle.Render(d.LastCommitAuthor+", "+d.LastCommitAt.Local().Format("2006-01-02 15:04")))
+		}
+
+		field("Sync", syncSummary(d))
+
+		if d.LatestTag != "" {
+			field("Git tag", d.LatestTag)
+		}
+	}
+
+	if len(repo.Tags) > 0 {
+		field("Tags", strings.Join(repo.Tags, ", "))
+	}
+
+	if repo.Notes != "" {
+		lines = append(lines, "", detailLabelStyle.Render("Notes"), repo.Notes)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// syncSummary describes how far the branch is from its upstream
+func syncSummary(d *model.RepoDetail) string {
+	switch {
+	case !d.HasUpstream:
+		return pathStyle.Render("no upstream")
+	case d.Ahead == 0 && d.Behind == 0:
+		return successStyle.Render("up to date")
+	default:
+		return fmt.Sprintf("↑%d ↓%d", d.Ahead, d.Behind)
+	}
+}
@@ -1,12 +1,18 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/git"
 	"github.com/inovacc/clonr/internal/model"
 )
 
@@ -14,20 +20,81 @@ var (
 	docStyle = lipgloss.NewStyle().Margin(1, 2)
 )
 
+// RepoGroupBy controls how repositories are grouped in the list
+type RepoGroupBy int
+
+const (
+	// GroupNone shows a flat list
+	GroupNone RepoGroupBy = iota
+	// GroupByWorkspace groups repositories by workspace
+	GroupByWorkspace
+	// GroupByHost groups repositories by git host (github.com, gitlab.com, ...)
+	GroupByHost
+	// GroupByFavorite groups favorites separately from other repositories
+	GroupByFavorite
+)
+
+const (
+	noWorkspaceGroup = "(no workspace)"
+	favoritesGroup   = "Favorites"
+	othersGroup      = "Others"
+)
+
+// String returns the name used for the grouping mode
+func (g RepoGroupBy) String() string {
+	switch g {
+	case GroupByWorkspace:
+		return "workspace"
+	case GroupByHost:
+		return "host"
+	case GroupByFavorite:
+		return "favorite"
+	default:
+		return "none"
+	}
+}
+
+// ParseRepoGroupBy parses a grouping mode name (none, workspace, host, favorite)
+func ParseRepoGroupBy(s string) (RepoGroupBy, error) {
+	switch strings.ToLower(s) {
+	case "", "none":
+		return GroupNone, nil
+	case "workspace":
+		return GroupByWorkspace, nil
+	case "host", "provider":
+		return GroupByHost, nil
+	case "favorite", "favorites":
+		return GroupByFavorite, nil
+	default:
+		return GroupNone, fmt.Errorf("invalid group %q (valid: none, workspace, host, favorite)", s)
+	}
+}
+
 type repoItem struct {
-	repo model.Repository
+	repo   model.Repository
+	cols   *repoColumns // configured columns, nil for the default description
+	marked bool         // selected for a bulk action
 }
 
 func (i repoItem) Title() string {
+	mark := ""
+	if i.marked {
+		mark = "◉ "
+	}
+
 	fav := ""
 	if i.repo.Favorite {
 		fav = "⭐ "
 	}
 
-	return fmt.Sprintf("%s%s", fav, i.repo.URL)
+	return fmt.Sprintf("%s%s%s", mark, fav, i.repo.URL)
 }
 
 func (i repoItem) Description() string {
+	if i.cols != nil && len(i.cols.columns) > 0 {
+		return i.cols.describe(i.repo)
+	}
+
 	desc := i.repo.Path
 
 	if !i.repo.ClonedAt.IsZero() {
@@ -40,6 +107,10 @@ func (i repoItem) Description() string {
 		}
 	}
 
+	if len(i.repo.Tags) > 0 {
+		desc = fmt.Sprintf("%s | Tags: %s", desc, strings.Join(i.repo.Tags, ", "))
+	}
+
 	return desc
 }
 
@@ -47,41 +118,401 @@ func (i repoItem) FilterValue() string {
 	return i.repo.URL
 }
 
+// groupItem is a collapsible section header in a grouped list
+type groupItem struct {
+	name      string
+	count     int
+	collapsed bool
+}
+
+func (i groupItem) Title() string {
+	arrow := "▾"
+	if i.collapsed {
+		arrow = "▸"
+	}
+
+	return fmt.Sprintf("%s %s (%d)", arrow, i.name, i.count)
+}
+
+func (i groupItem) Description() string {
+	if i.collapsed {
+		return "collapsed - press space to expand"
+	}
+
+	return strings.Repeat("─", 20)
+}
+
+// FilterValue is empty so section headers never match a filter
+func (i groupItem) FilterValue() string {
+	return ""
+}
+
+var (
+	groupKey = key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "group by"),
+	)
+	toggleGroupKey = key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "collapse/expand"),
+	)
+	toggleAllKey = key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "collapse/expand all"),
+	)
+)
+
+// repoEventMsg signals that repositories changed on the server
+type repoEventMsg struct {
+	event model.RepoEvent
+}
+
+// reposReloadedMsg carries the repository list reloaded after a change
+type reposReloadedMsg struct {
+	repos []model.Repository
+	err   error
+}
+
+// repoPageMsg carries a page of repositories prefetched in the background
+type repoPageMsg struct {
+	gen  int
+	page *grpc.RepoPage
+	err  error
+}
+
+// repoWatcher forwards server repository events to the TUI
+type repoWatcher struct {
+	events chan model.RepoEvent
+	cancel context.CancelFunc
+}
+
+// startRepoWatcher subscribes to repository events, or returns nil if the
+// server cannot be reached. Bursts of events are coalesced into one reload.
+func startRepoWatcher() *repoWatcher {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &repoWatcher{events: make(chan model.RepoEvent, 1), cancel: cancel}
+
+	go func() {
+		defer close(w.events)
+
+		_ = client.WatchRepoEvents(ctx, func(event model.RepoEvent) {
+			select {
+			case w.events <- event:
+			default:
+				// A reload is already pending
+			}
+		})
+	}()
+
+	return w
+}
+
+// wait returns a command that delivers the next repository event
+func (w *repoWatcher) wait() tea.Cmd {
+	if w == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		event, ok := <-w.events
+		if !ok {
+			return nil
+		}
+
+		return repoEventMsg{event: event}
+	}
+}
+
+func (w *repoWatcher) stop() {
+	if w != nil {
+		w.cancel()
+	}
+}
+
 type RepoListModel struct {
-	list         list.Model
-	selectedRepo *model.Repository
-	action       string
-	err          error
-	quitting     bool
+	list          list.Model
+	repos         []model.Repository
+	favoritesOnly bool
+	view          *model.SavedFilter // active saved filter, nil for all repositories
+	cols          *repoColumns       // configured columns, nil for the default description
+	sortBy        core.SortBy        // active sort order, empty keeps the server order
+	picker        *list.Model        // saved view picker, nil when closed
+	marked        map[string]bool    // URLs of the repositories marked for a bulk action
+	bulk          *bulkState         // bulk action being set up, nil when closed
+	detail        *detailPane        // detail pane of the highlighted repository, nil when hidden
+	width         int                // window size, shared by the list and the detail pane
+	height        int
+	watcher       *repoWatcher
+	total         int    // repositories on the server matching the list filter
+	nextPage      string // token of the next page to prefetch, empty when fully loaded
+	loadGen       int    // incremented on reload so stale pages are dropped
+	title         string
+	groupBy       RepoGroupBy
+	collapsed     map[string]bool
+	fuzzy         bool
+	keys          KeyMap
+	pendingDelete string // URL of the repository awaiting a second delete key
+	selectedRepo  *model.Repository
+	action        string
+	err           error
+	quitting      bool
 }
 
 func (m RepoListModel) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.watcher.wait(), m.fetchNextPage(), m.loadDetails())
+}
+
+// fetchNextPage loads the next page of repositories in the background
+func (m RepoListModel) fetchNextPage() tea.Cmd {
+	if m.nextPage == "" {
+		return nil
+	}
+
+	gen, token := m.loadGen, m.nextPage
+	filter := model.RepoFilter{FavoritesOnly: m.favoritesOnly}
+
+	return func() tea.Msg {
+		page, err := core.ListReposPage(filter, token)
+
+		return repoPageMsg{gen: gen, page: page, err: err}
+	}
+}
+
+// quit stops watching for repository events and exits the program
+func (m RepoListModel) quit() tea.Cmd {
+	m.watcher.stop()
+
+	return tea.Quit
+}
+
+// reloadRepos fetches the current repositories after a change on the server
+func reloadRepos(favoritesOnly bool) tea.Cmd {
+	return func() tea.Msg {
+		repos, err := core.ListReposPaged(model.RepoFilter{FavoritesOnly: favoritesOnly})
+
+		return reposReloadedMsg{repos: repos, err: err}
+	}
 }
 
 func (m RepoListModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := m.update(msg)
+
+	// The detail pane follows the highlight, loading details on first view
+	if lm, ok := next.(RepoListModel); ok {
+		return lm, tea.Batch(cmd, lm.loadSelectedDetail())
+	}
+
+	return next, cmd
+}
+
+func (m RepoListModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.picker != nil {
+		switch msg.(type) {
+		case tea.WindowSizeMsg, repoEventMsg, reposReloadedMsg, repoPageMsg, repoDetailMsg:
+		default:
+			return m.updatePicker(msg)
+		}
+	}
+
+	if m.bulk != nil {
+		switch msg.(type) {
+		case tea.WindowSizeMsg, repoEventMsg, reposReloadedMsg, repoPageMsg, detailsLoadedMsg, repoActionMsg, repoDetailMsg:
+		default:
+			return m.updateBulk(msg)
+		}
+	}
+
 	switch keyMsg := msg.(type) {
 	case tea.WindowSizeMsg:
-		h, v := docStyle.GetFrameSize()
-		m.list.SetSize(keyMsg.Width-h, keyMsg.Height-v)
+		m.width, m.height = keyMsg.Width, keyMsg.Height
+		m.resize()
+
+		return m, nil
+
+	case repoDetailMsg:
+		if m.detail == nil {
+			return m, nil
+		}
+
+		delete(m.detail.loading, keyMsg.url)
+
+		if keyMsg.err != nil {
+			m.detail.errs[keyMsg.url] = keyMsg.err
+		} else {
+			m.detail.details[keyMsg.url] = keyMsg.detail
+		}
+
+		return m, nil
+
+	case viewsLoadedMsg:
+		if keyMsg.err != nil {
+			return m, m.list.NewStatusMessage(fmt.Sprintf("Failed to load saved views: %v", keyMsg.err))
+		}
+
+		picker := newViewPicker(keyMsg.filters, m.list.Width(), m.list.Height())
+		m.picker = &picker
 
 		return m, nil
 
+	case detailsLoadedMsg:
+		if m.cols == nil {
+			return m, nil
+		}
+
+		for path, details := range keyMsg.details {
+			m.cols.details[path] = details
+		}
+
+		return m, m.refreshItems()
+
+	case repoActionMsg:
+		status := m.list.NewStatusMessage(keyMsg.status)
+		if keyMsg.err != nil {
+			status = m.list.NewStatusMessage(errorStyle.Render(keyMsg.err.Error()))
+		}
+
+		// The watcher reloads the list on the change; without it, reload now
+		if keyMsg.changed && m.watcher == nil {
+			return m, tea.Batch(status, m.reload())
+		}
+
+		return m, status
+
+	case repoEventMsg:
+		if m.detail != nil {
+			m.detail.forget(keyMsg.event.URL)
+		}
+
+		status := m.list.NewStatusMessage(fmt.Sprintf("↻ %s %s", keyMsg.event.URL, keyMsg.event.Type))
+
+		return m, tea.Batch(status, m.reload())
+
+	case reposReloadedMsg:
+		var cmd tea.Cmd
+
+		if keyMsg.err == nil {
+			m.repos = keyMsg.repos
+			m.total = len(keyMsg.repos)
+			m.nextPage = ""
+			m.loadGen++
+			m.pruneMarks()
+
+			if m.detail != nil {
+				m.detail.forget("")
+			}
+			cmd = tea.Batch(m.refreshItems(), m.loadDetails())
+		}
+
+		return m, tea.Batch(cmd, m.watcher.wait())
+
+	case repoPageMsg:
+		if keyMsg.gen != m.loadGen {
+			return m, nil
+		}
+
+		if keyMsg.err != nil {
+			m.nextPage = ""
+
+			return m, m.list.NewStatusMessage(fmt.Sprintf("Failed to load more repositories: %v", keyMsg.err))
+		}
+
+		m.repos = append(m.repos, keyMsg.page.Repositories...)
+		m.total = keyMsg.page.TotalSize
+		m.nextPage = keyMsg.page.NextPageToken
+		cmd := m.refreshItems()
+
+		return m, tea.Batch(cmd, m.fetchNextPage(), m.loadDetails())
+
 	case tea.KeyMsg:
+		// In fuzzy mode the query is always active, fzf-style
+		if m.fuzzy && m.list.FilterState() == list.Filtering {
+			if next, cmd, handled := m.updateFuzzy(keyMsg); handled {
+				return next, cmd
+			}
+
+			break
+		}
+
+		// Let the list handle all keys while typing a filter
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		// Only a delete key right after another one removes the repository
+		pending := m.pendingDelete
+		m.pendingDelete = ""
+
+		if next, cmd, handled := m.updateActions(keyMsg, pending); handled {
+			return next, cmd
+		}
+
 		switch keyMsg.String() {
-		case "ctrl+c", "q", "esc":
+		case "esc":
+			// The first esc drops the marks
+			if len(m.marked) > 0 {
+				clear(m.marked)
+
+				return m, m.refreshItems()
+			}
+
 			m.quitting = true
 
-			return m, tea.Quit
+			return m, m.quit()
+
+		case "ctrl+c", "q":
+			m.quitting = true
 
-		case "enter":
-			i, ok := m.list.SelectedItem().(repoItem)
-			if ok {
-				m.selectedRepo = &i.repo
-				m.action = "selected"
+			return m, m.quit()
+
+		case "a":
+			return m.openBulk()
+
+		case "ctrl+a":
+			return m, m.toggleMarkAll()
+
+		case "i":
+			m.toggleDetail()
+
+			return m, nil
+
+		case "v":
+			return m, loadViews()
+
+		case "s":
+			return m, m.cycleSort()
+
+		case "tab":
+			m.groupBy = (m.groupBy + 1) % (GroupByFavorite + 1)
+			m.collapsed = make(map[string]bool)
+			m.refreshItems()
+			m.list.Select(0)
+
+			return m, nil
+
+		case " ":
+			switch i := m.list.SelectedItem().(type) {
+			case groupItem:
+				m.collapsed[i.name] = !m.collapsed[i.name]
+				m.refreshItems()
+			case repoItem:
+				return m, m.toggleMark(i.repo)
 			}
 
-			return m, tea.Quit
+			return m, nil
+
+		case "z":
+			if m.groupBy != GroupNone {
+				m.toggleAllGroups()
+				m.refreshItems()
+				m.list.Select(0)
+			}
+
+			return m, nil
 		}
 	}
 
@@ -101,33 +532,427 @@ func (m RepoListModel) View() string {
 		return fmt.Sprintf("Error: %v\n", m.err)
 	}
 
+	if m.picker != nil {
+		return docStyle.Render(m.picker.View())
+	}
+
+	if m.bulk != nil {
+		return m.viewBulk()
+	}
+
+	m.list.Title = m.titleWithCount()
+
+	if m.detail != nil {
+		return docStyle.Render(lipgloss.JoinHorizontal(lipgloss.Top, m.list.View(), m.viewDetail()))
+	}
+
 	return docStyle.Render(m.list.View())
 }
 
+// titleWithCount returns the list title with loaded, filtered and total counts
+func (m RepoListModel) titleWithCount() string {
+	title := m.title
+	if m.groupBy != GroupNone {
+		title = fmt.Sprintf("%s by %s", m.title, m.groupBy)
+	}
+
+	total := max(m.total, len(m.repos))
+
+	if m.list.FilterState() != list.Unfiltered && m.list.FilterValue() != "" {
+		shown := 0
+
+		for _, item := range m.list.VisibleItems() {
+			if _, ok := item.(repoItem); ok {
+				shown++
+			}
+		}
+
+		title = fmt.Sprintf("%s (%d of %d)", title, shown, total)
+	} else {
+		title = fmt.Sprintf("%s (%d)", title, total)
+	}
+
+	if m.nextPage != "" {
+		title = fmt.Sprintf("%s · loading %d/%d", title, len(m.repos), total)
+	}
+
+	if len(m.marked) > 0 {
+		title = fmt.Sprintf("%s · %d marked", title, len(m.marked))
+	}
+
+	return title
+}
+
 func (m RepoListModel) GetSelectedRepo() *model.Repository {
 	return m.selectedRepo
 }
 
+// updateFuzzy handles navigation and selection keys while typing a fuzzy query
+func (m RepoListModel) updateFuzzy(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "ctrl+c", "esc":
+		m.quitting = true
+
+		return m, m.quit(), true
+
+	case "up", "ctrl+p", "ctrl+k":
+		m.list.CursorUp()
+
+		return m, nil, true
+
+	case "down", "ctrl+n", "ctrl+j":
+		m.list.CursorDown()
+
+		return m, nil, true
+
+	case "enter":
+		if i, ok := m.list.SelectedItem().(repoItem); ok {
+			m.selectedRepo = &i.repo
+			m.action = "selected"
+
+			return m, m.quit(), true
+		}
+
+		return m, nil, true
+	}
+
+	return m, nil, false
+}
+
+// repoActionMsg reports the outcome of an action on a repository
+type repoActionMsg struct {
+	status  string
+	changed bool // the repository list changed on the server
+	err     error
+}
+
+// updateActions handles the remappable keys outside of fuzzy typing. A
+// repository is only removed when the delete key is pressed on it while
+// pending, the URL asked to confirm by the previous key, matches.
+func (m RepoListModel) updateActions(msg tea.KeyMsg, pending string) (tea.Model, tea.Cmd, bool) {
+	switch {
+	case key.Matches(msg, m.keys.Select):
+		switch i := m.list.SelectedItem().(type) {
+		case groupItem:
+			m.collapsed[i.name] = !m.collapsed[i.name]
+			m.refreshItems()
+
+			return m, nil, true
+		case repoItem:
+			m.selectedRepo = &i.repo
+			m.action = "selected"
+		}
+
+		return m, m.quit(), true
+
+	case key.Matches(msg, m.keys.Favorite):
+		i, ok := m.list.SelectedItem().(repoItem)
+		if !ok {
+			return m, nil, true
+		}
+
+		return m, toggleFavorite(i.repo), true
+
+	case key.Matches(msg, m.keys.Open):
+		i, ok := m.list.SelectedItem().(repoItem)
+		if !ok {
+			return m, nil, true
+		}
+
+		return m, openRepo(i.repo), true
+
+	case key.Matches(msg, m.keys.Delete):
+		i, ok := m.list.SelectedItem().(repoItem)
+		if !ok {
+			return m, nil, true
+		}
+
+		if pending != i.repo.URL {
+			m.pendingDelete = i.repo.URL
+
+			return m, m.list.NewStatusMessage(warningStyle.Render(fmt.Sprintf("Press %s again to remove %s from clonr (files are kept)", m.keys.Delete.Help().Key, i.repo.URL))), true
+		}
+
+		return m, removeRepo(i.repo), true
+	}
+
+	return m, nil, false
+}
+
+// toggleFavorite marks or unmarks repo as favorite
+func toggleFavorite(repo model.Repository) tea.Cmd {
+	return func() tea.Msg {
+		if err := core.SetFavoriteByURL(repo.URL, !repo.Favorite); err != nil {
+			return repoActionMsg{err: err}
+		}
+
+		status := "★ Marked " + repo.URL + " as favorite"
+		if repo.Favorite {
+			status = "☆ Unmarked " + repo.URL + " as favorite"
+		}
+
+		return repoActionMsg{status: status, changed: true}
+	}
+}
+
+// openRepo opens repo in the configured editor
+func openRepo(repo model.Repository) tea.Cmd {
+	return func() tea.Msg {
+		editor, err := core.OpenInConfiguredEditor(repo.Path)
+		if err != nil {
+			return repoActionMsg{err: err}
+		}
+
+		core.RecordRepoAccess(repo.URL)
+
+		return repoActionMsg{status: fmt.Sprintf("Opened %s in %s", repo.Path, editor)}
+	}
+}
+
+// removeRepo removes repo from clonr, leaving its files in place
+func removeRepo(repo model.Repository) tea.Cmd {
+	return func() tea.Msg {
+		if err := core.RemoveRepo(repo.URL); err != nil {
+			return repoActionMsg{err: err}
+		}
+
+		return repoActionMsg{status: "Removed " + repo.URL, changed: true}
+	}
+}
+
+// WithKeyMap returns the model using keys for its remappable actions
+func (m RepoListModel) WithKeyMap(keys KeyMap) RepoListModel {
+	if m.err != nil {
+		return m
+	}
+
+	m.keys = keys
+	m.list.KeyMap.Filter = keys.Filter
+	m.list.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.Select, keys.Favorite, keys.Open, markKey, bulkKey, detailKey, groupKey, viewKey, sortKey}
+	}
+	m.list.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.Select, keys.Favorite, keys.Delete, keys.Open, markKey, markAllKey, bulkKey, detailKey, groupKey, toggleGroupKey, toggleAllKey, viewKey, sortKey}
+	}
+
+	return m
+}
+
+// WithFuzzy returns the model in fzf-style fuzzy finder mode: typing filters
+// immediately, results are ranked by match quality and enter picks the top match.
+func (m RepoListModel) WithFuzzy(query string) RepoListModel {
+	if m.err != nil {
+		return m
+	}
+
+	m.fuzzy = true
+	m.list.Filter = FuzzyFilter
+	m.list.KeyMap.AcceptWhileFiltering.SetHelp("enter", "select")
+	m.list.KeyMap.CancelWhileFiltering.SetHelp("esc", "quit")
+	m.list.SetFilterText(query)
+	m.list.SetFilterState(list.Filtering)
+
+	return m
+}
+
+// WithGroupBy returns the model with the given grouping applied
+func (m RepoListModel) WithGroupBy(groupBy RepoGroupBy) RepoListModel {
+	if m.err != nil {
+		return m
+	}
+
+	m.groupBy = groupBy
+	m.collapsed = make(map[string]bool)
+	m.refreshItems()
+
+	return m
+}
+
+// refreshItems rebuilds the list items for the current grouping and collapse state.
+// The returned command re-applies an active filter.
+func (m *RepoListModel) refreshItems() tea.Cmd {
+	items := buildRepoItems(m.sortedRepos(), m.groupBy, m.collapsed)
+
+	for i, item := range items {
+		if r, ok := item.(repoItem); ok {
+			r.cols = m.cols
+			r.marked = m.marked[r.repo.URL]
+			items[i] = r
+		}
+	}
+
+	cmd := m.list.SetItems(items)
+
+	if n := len(m.list.VisibleItems()); n > 0 && m.list.Index() >= n {
+		m.list.Select(n - 1)
+	}
+
+	return cmd
+}
+
+// toggleAllGroups collapses every group, or expands them all if all are collapsed
+func (m *RepoListModel) toggleAllGroups() {
+	names, _ := groupRepos(m.repos, m.groupBy)
+
+	allCollapsed := true
+
+	for _, name := range names {
+		if !m.collapsed[name] {
+			allCollapsed = false
+
+			break
+		}
+	}
+
+	for _, name := range names {
+		m.collapsed[name] = !allCollapsed
+	}
+}
+
+// buildRepoItems returns list items, with a header before each group when grouping
+func buildRepoItems(repos []model.Repository, groupBy RepoGroupBy, collapsed map[string]bool) []list.Item {
+	if groupBy == GroupNone {
+		items := make([]list.Item, len(repos))
+		for i, repo := range repos {
+			items[i] = repoItem{repo: repo}
+		}
+
+		return items
+	}
+
+	names, groups := groupRepos(repos, groupBy)
+	items := make([]list.Item, 0, len(repos)+len(names))
+
+	for _, name := range names {
+		items = append(items, groupItem{name: name, count: len(groups[name]), collapsed: collapsed[name]})
+
+		if collapsed[name] {
+			continue
+		}
+
+		for _, repo := range groups[name] {
+			items = append(items, repoItem{repo: repo})
+		}
+	}
+
+	return items
+}
+
+// groupRepos splits repositories into named groups, returning the ordered group names
+func groupRepos(repos []model.Repository, groupBy RepoGroupBy) ([]string, map[string][]model.Repository) {
+	groups := make(map[string][]model.Repository)
+
+	for _, repo := range repos {
+		name := repoGroupName(repo, groupBy)
+		groups[name] = append(groups[name], repo)
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		return groupRank(names[i]) < groupRank(names[j]) ||
+			groupRank(names[i]) == groupRank(names[j]) && names[i] < names[j]
+	})
+
+	return names, groups
+}
+
+// groupRank keeps favorites first and catch-all groups last
+func groupRank(name string) int {
+	switch name {
+	case favoritesGroup:
+		return 0
+	case othersGroup, noWorkspaceGroup:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func repoGroupName(repo model.Repository, groupBy RepoGroupBy) string {
+	switch groupBy {
+	case GroupByWorkspace:
+		if repo.Workspace == "" {
+			return noWorkspaceGroup
+		}
+
+		return repo.Workspace
+	case GroupByHost:
+		u, err := git.ParseURL(repo.URL)
+		if err != nil || u.Hostname() == "" {
+			return othersGroup
+		}
+
+		return strings.ToLower(u.Hostname())
+	case GroupByFavorite:
+		if repo.Favorite {
+			return favoritesGroup
+		}
+
+		return othersGroup
+	default:
+		return ""
+	}
+}
+
+// NewRepoList creates the interactive repository list, sorted by frecency.
+// The first page of repositories is loaded immediately and the rest are
+// prefetched in the background.
 func NewRepoList(favoritesOnly bool) (RepoListModel, error) {
-	repos, err := core.ListReposFiltered(favoritesOnly)
+	page, err := core.ListReposPage(model.RepoFilter{FavoritesOnly: favoritesOnly}, "")
 	if err != nil {
 		return RepoListModel{err: err}, err
 	}
 
-	items := make([]list.Item, len(repos))
-	for i, repo := range repos {
-		items[i] = repoItem{repo: repo}
+	m := newRepoListModel(page.Repositories, repoListTitle(favoritesOnly, nil))
+	m.favoritesOnly = favoritesOnly
+	m.sortBy = core.SortByFrecency
+	m.total = page.TotalSize
+	m.nextPage = page.NextPageToken
+
+	return m.WithKeyMap(loadKeyMap()), nil
+}
+
+// NewRepoListForView creates the interactive repository list showing the
+// repositories that match a saved filter.
+func NewRepoListForView(filter *model.SavedFilter) (RepoListModel, error) {
+	found, err := core.ViewRepos(filter)
+	if err != nil {
+		return RepoListModel{err: err}, err
 	}
 
-	l := list.New(items, list.NewDefaultDelegate(), 0, 0)
-	if favoritesOnly {
-		l.Title = "Favorite Repositories"
-	} else {
-		l.Title = "All Repositories"
+	repos := make([]model.Repository, len(found))
+	for i, r := range found {
+		repos[i] = r.Repository
 	}
 
+	m := newRepoListModel(repos, repoListTitle(false, filter))
+	m.view = filter
+	m.total = len(repos)
+
+	return m.WithKeyMap(loadKeyMap()), nil
+}
+
+func newRepoListModel(repos []model.Repository, title string) RepoListModel {
+	items := buildRepoItems(repos, GroupNone, nil)
+
+	l := list.New(items, list.NewDefaultDelegate(), 0, 0)
+	l.Title = title
+
 	l.SetShowStatusBar(true)
 	l.SetFilteringEnabled(true)
 
-	return RepoListModel{list: l}, nil
+	m := RepoListModel{
+		list:      l,
+		repos:     repos,
+		watcher:   startRepoWatcher(),
+		title:     title,
+		collapsed: make(map[string]bool),
+		marked:    make(map[string]bool),
+	}
+
+	return m.WithKeyMap(DefaultKeyMap())
 }
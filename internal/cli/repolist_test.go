@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestRepoListTitleWithCount(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/api"},
+		{URL: "https://github.com/acme/web"},
+		{URL: "https://github.com/acme/api-docs"},
+	}
+
+	tests := []struct {
+		name     string
+		total    int
+		nextPage string
+		groupBy  RepoGroupBy
+		filter   string
+		want     string
+	}{
+		{name: "fully loaded", total: 3, want: "All Repositories (3)"},
+		{name: "loading", total: 1200, nextPage: "token", want: "All Repositories (1200) · loading 3/1200"},
+		{name: "grouped", total: 3, groupBy: GroupByHost, want: "All Repositories by host (3)"},
+		{name: "filtered", total: 3, filter: "api", want: "All Repositories (2 of 3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := RepoListModel{
+				list:     list.New(buildRepoItems(repos, tt.groupBy, nil), list.NewDefaultDelegate(), 0, 0),
+				repos:    repos,
+				title:    "All Repositories",
+				total:    tt.total,
+				nextPage: tt.nextPage,
+				groupBy:  tt.groupBy,
+			}
+
+			if tt.filter != "" {
+				m.list.SetFilterText(tt.filter)
+			}
+
+			if got := m.titleWithCount(); got != tt.want {
+				t.Errorf("titleWithCount() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepoListRemappedKeys(t *testing.T) {
+	repos := []model.Repository{{URL: "https://github.com/acme/api"}, {URL: "https://github.com/acme/web"}}
+
+	m := RepoListModel{
+		list:      list.New(buildRepoItems(repos, GroupNone, nil), list.NewDefaultDelegate(), 0, 0),
+		repos:     repos,
+		collapsed: make(map[string]bool),
+	}.WithKeyMap(NewKeyMap(model.KeyMapConfig{Select: []string{"ctrl+o"}, Delete: []string{"ctrl+d"}}))
+
+	// The default delete key does nothing once remapped
+	next, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if m = next.(RepoListModel); m.pendingDelete != "" {
+		t.Fatal("x started a removal after delete was remapped")
+	}
+
+	// The first delete key only asks for confirmation
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if m = next.(RepoListModel); m.pendingDelete != repos[0].URL {
+		t.Fatalf("pendingDelete = %q, want %q", m.pendingDelete, repos[0].URL)
+	}
+
+	// Any other key cancels it
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if m = next.(RepoListModel); m.pendingDelete != "" {
+		t.Fatalf("pendingDelete = %q after another key, want none", m.pendingDelete)
+	}
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m = next.(RepoListModel); m.GetSelectedRepo() != nil {
+		t.Fatal("enter selected a repository after select was remapped")
+	}
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlO})
+	if got := next.(RepoListModel).GetSelectedRepo(); got == nil || got.URL != repos[1].URL {
+		t.Fatalf("ctrl+o selected %v, want %s", got, repos[1].URL)
+	}
+}
+
+func TestRepoListBulkRemoveConfirmation(t *testing.T) {
+	repos := []model.Repository{{URL: "https://github.com/acme/api"}, {URL: "https://github.com/acme/web"}, {URL: "https://github.com/acme/cli"}}
+
+	m := RepoListModel{
+		list:      list.New(buildRepoItems(repos, GroupNone, nil), list.NewDefaultDelegate(), 0, 0),
+		repos:     repos,
+		collapsed: make(map[string]bool),
+		marked:    make(map[string]bool),
+	}.WithKeyMap(DefaultKeyMap())
+
+	press := func(msg tea.KeyMsg) {
+		t.Helper()
+
+		next, _ := m.Update(msg)
+		m = next.(RepoListModel)
+	}
+
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}
+
+	// Space marks the highlighted repository and moves on to the next one
+	press(space)
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(space)
+
+	if !m.marked[repos[0].URL] || m.marked[repos[1].URL] || !m.marked[repos[2].URL] {
+		t.Fatalf("marked = %v, want api and cli", m.marked)
+	}
+
+	if title := m.titleWithCount(); !strings.Contains(title, "2 marked") {
+		t.Errorf("title = %q, want the number of marked repositories", title)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+
+	if m.bulk == nil || len(m.bulk.repos) != 2 {
+		t.Fatalf("bulk = %+v, want the actions on the 2 marked repositories", m.bulk)
+	}
+
+	// Remove is the last action and asks for confirmation first
+	for range len(bulkActionItems) - 1 {
+		press(tea.KeyMsg{Type: tea.KeyDown})
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.bulk == nil || m.bulk.stage != bulkConfirm {
+		t.Fatalf("bulk = %+v, want the removal confirmation", m.bulk)
+	}
+
+	view := m.View()
+	for _, want := range []string{"Remove 2 repositories", repos[0].URL, repos[2].URL} {
+		if !strings.Contains(view, want) {
+			t.Errorf("confirmation view lacks %q:\n%s", want, view)
+		}
+	}
+
+	if strings.Contains(view, repos[1].URL) {
+		t.Errorf("confirmation view lists the unmarked %s", repos[1].URL)
+	}
+
+	// Backing out returns to the actions with the marks kept
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+
+	if m.bulk == nil || m.bulk.stage != bulkPickAction {
+		t.Fatalf("bulk = %+v after n, want the action menu", m.bulk)
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if m.bulk != nil || len(m.marked) != 0 || m.quitting {
+		t.Errorf("after esc twice: bulk = %v, marked = %v, quitting = %v; want closed, no marks, still running", m.bulk, m.marked, m.quitting)
+	}
+}
+
+func TestBulkOutcome(t *testing.T) {
+	if msg := bulkOutcome("Tagged %s", 3, nil); msg.err != nil || msg.status != "Tagged 3 repositories" || !msg.changed {
+		t.Errorf("bulkOutcome(success) = %+v", msg)
+	}
+
+	err := errors.Join(errors.New("a: failed"), errors.New("b: failed"), errors.New("c: failed"))
+
+	msg := bulkOutcome("Removed %s", 1, err)
+	if msg.err == nil || msg.err.Error() != "Removed 1 repository; failed a: failed (and 2 more)" || !msg.changed {
+		t.Errorf("bulkOutcome(failures) = %+v", msg)
+	}
+}
+
+func TestRepoListDetailPane(t *testing.T) {
+	repos := []model.Repository{{URL: "https://github.com/acme/api", Notes: "Deploys on merge"}, {URL: "https://github.com/acme/web"}}
+
+	m := RepoListModel{
+		list:      list.New(buildRepoItems(repos, GroupNone, nil), list.NewDefaultDelegate(), 0, 0),
+		repos:     repos,
+		collapsed: make(map[string]bool),
+		marked:    make(map[string]bool),
+	}.WithKeyMap(DefaultKeyMap())
+
+	next, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = next.(RepoListModel)
+	fullWidth := m.list.Width()
+
+	// Opening the pane narrows the list and loads the highlighted repository
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	m = next.(RepoListModel)
+
+	if m.detail == nil || m.list.Width() >= fullWidth {
+		t.Fatalf("after i: detail = %v, list width %d of %d; want the pane open and the list narrower", m.detail, m.list.Width(), fullWidth)
+	}
+
+	if cmd == nil || !m.detail.loading[repos[0].URL] {
+		t.Fatal("the details of the highlighted repository were not requested")
+	}
+
+	if view := m.View(); !strings.Contains(view, "Loading") {
+		t.Errorf("pane while loading:\n%s", view)
+	}
+
+	next, _ = m.Update(repoDetailMsg{url: repos[0].URL, detail: &model.RepoDetail{
+		Repository: repos[0], Branch: "main", LastCommit: "abc1234", LastCommitSubject: "Fix login",
+		HasUpstream: true, Behind: 2,
+	}})
+	m = next.(RepoListModel)
+
+	view := m.View()
+	for _, want := range []string{"main", "abc1234 Fix login", "↑0 ↓2", "Deploys on merge"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("pane lacks %q:\n%s", want, view)
+		}
+	}
+
+	// A change on the server reads the details again
+	next, _ = m.Update(repoEventMsg{event: model.RepoEvent{Type: model.RepoEventUpdated, URL: repos[0].URL}})
+	if m = next.(RepoListModel); m.detail.details[repos[0].URL] != nil || !m.detail.loading[repos[0].URL] {
+		t.Error("the details were not reloaded after the repository changed")
+	}
+
+	next, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if m = next.(RepoListModel); m.detail != nil || m.list.Width() != fullWidth {
+		t.Errorf("after closing: detail = %v, list width %d, want %d", m.detail, m.list.Width(), fullWidth)
+	}
+}
@@ -0,0 +1,757 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/inovacc/clonr/internal/slack"
+)
+
+var (
+	slackAuthorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true)
+	slackTimeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	slackSelectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("170")).Bold(true)
+	slackHeaderStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("86")).Bold(true)
+	paneStyle          = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(lipgloss.Color("240"))
+	focusedPaneStyle   = paneStyle.BorderForeground(lipgloss.Color("205"))
+)
+
+// slackHelp is the key help of the browser
+const slackHelp = "enter open • tab switch pane • ↑/↓ select • t thread • / search • r reply • q quit"
+
+type slackChannelItem struct {
+	channel slack.Channel
+}
+
+func (i slackChannelItem) Title() string {
+	if i.channel.IsPrivate {
+		return "🔒 " + i.channel.Name
+	}
+
+	return "# " + i.channel.Name
+}
+
+func (i slackChannelItem) Description() string {
+	if i.channel.Topic.Value != "" {
+		return i.channel.Topic.Value
+	}
+
+	return fmt.Sprintf("%d members", i.channel.NumMembers)
+}
+
+func (i slackChannelItem) FilterValue() string {
+	return i.channel.Name
+}
+
+// slackPane is a pane of the browser
+type slackPane int
+
+const (
+	slackChannelsPane slackPane = iota
+	slackMessagesPane
+)
+
+// slackInputMode is what the text input is used for
+type slackInputMode int
+
+const (
+	slackNoInput slackInputMode = iota
+	slackSearchInput
+	slackReplyInput
+)
+
+// slackChannelsMsg carries the loaded channels
+type slackChannelsMsg struct {
+	channels []slack.Channel
+	err      error
+}
+
+// slackHistoryMsg carries the messages of a channel, oldest first
+type slackHistoryMsg struct {
+	channelID string
+	messages  []slack.Message
+	users     map[string]*slack.User
+	err       error
+}
+
+// slackThreadMsg carries the replies of a thread
+type slackThreadMsg struct {
+	channelID string
+	threadTS  string
+	replies   []slack.Message
+	users     map[string]*slack.User
+	err       error
+}
+
+// slackPostedMsg reports a sent reply
+type slackPostedMsg struct {
+	channelID string
+	threadTS  string
+	err       error
+}
+
+// SlackBrowserModel is the Bubbletea model for browsing Slack channels:
+// channels in a list pane, the messages of the open channel in a
+// scrollable pane, with thread expansion, message search and replies.
+type SlackBrowserModel struct {
+	client   *slack.Client
+	channels list.Model
+	messages viewport.Model
+	input    textinput.Model
+	spinner  spinner.Model
+
+	focus     slackPane
+	inputMode slackInputMode
+	channel   *slack.Channel
+	history   []slack.Message
+	threads   map[string][]slack.Message
+	expanded  map[string]bool
+	names     map[string]string
+	search    string
+	selected  int
+	loading   bool
+	status    string
+	err       error
+	quitting  bool
+	width     int
+	height    int
+}
+
+// NewSlackBrowser creates a Slack browser for the workspace of client.
+func NewSlackBrowser(client *slack.Client) SlackBrowserModel {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Channels"
+	l.SetShowHelp(false)
+	l.SetFilteringEnabled(true)
+
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = spinnerStyle
+
+	input := textinput.New()
+	input.CharLimit = 4000
+
+	return SlackBrowserModel{
+		client:   client,
+		channels: l,
+		messages: viewport.New(0, 0),
+		input:    input,
+		spinner:  s,
+		threads:  make(map[string][]slack.Message),
+		expanded: make(map[string]bool),
+		names:    make(map[string]string),
+		loading:  true,
+	}
+}
+
+func (m SlackBrowserModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.loadChannels())
+}
+
+// loadChannels lists the public and private channels
+func (m SlackBrowserModel) loadChannels() tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		result, err := client.ListChannels(context.Background(), slack.ListChannelsOptions{
+			Types:           "public_channel,private_channel",
+			ExcludeArchived: true,
+			Limit:           1000,
+		})
+		if err != nil {
+			return slackChannelsMsg{err: err}
+		}
+
+		slices.SortFunc(result.Channels, func(a, b slack.Channel) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		return slackChannelsMsg{channels: result.Channels}
+	}
+}
+
+// loadHistory fetches the recent messages of a channel
+func (m SlackBrowserModel) loadHistory(channelID string) tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		result, err := client.GetChannelHistory(ctx, slack.GetChannelHistoryOptions{Channel: channelID, Limit: 100})
+		if err != nil {
+			return slackHistoryMsg{channelID: channelID, err: err}
+		}
+
+		// Messages are returned newest first
+		slices.Reverse(result.Messages)
+
+		return slackHistoryMsg{
+			channelID: channelID,
+			messages:  result.Messages,
+			users:     client.GetUsers(ctx, messageAuthors(result.Messages)),
+		}
+	}
+}
+
+// loadThread fetches the replies of a thread
+func (m SlackBrowserModel) loadThread(channelID, threadTS string) tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		ctx := context.Background()
+
+		result, err := client.GetThreadReplies(ctx, slack.GetThreadRepliesOptions{
+			Channel:  channelID,
+			ThreadTS: threadTS,
+			Limit:    200,
+		})
+		if err != nil {
+			return slackThreadMsg{channelID: channelID, threadTS: threadTS, err: err}
+		}
+
+		// The first message is the parent
+		replies := slices.DeleteFunc(result.Messages, func(msg slack.Message) bool {
+			return msg.Timestamp == threadTS
+		})
+
+		return slackThreadMsg{
+			channelID: channelID,
+			threadTS:  threadTS,
+			replies:   replies,
+			users:     client.GetUsers(ctx, messageAuthors(replies)),
+		}
+	}
+}
+
+// postReply replies in a thread
+func (m SlackBrowserModel) postReply(channelID, threadTS, text string) tea.Cmd {
+	client := m.client
+
+	return func() tea.Msg {
+		_, err := client.PostMessage(context.Background(), slack.PostMessageOptions{
+			Channel:  channelID,
+			Text:     text,
+			ThreadTS: threadTS,
+		})
+
+		return slackPostedMsg{channelID: channelID, threadTS: threadTS, err: err}
+	}
+}
+
+func messageAuthors(messages []slack.Message) []string {
+	ids := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.User)
+	}
+
+	return ids
+}
+
+func (m SlackBrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+
+		return m, nil
+
+	case slackChannelsMsg:
+		m.loading = false
+
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+
+		items := make([]list.Item, len(msg.channels))
+		for i, ch := range msg.channels {
+			items[i] = slackChannelItem{channel: ch}
+		}
+
+		return m, m.channels.SetItems(items)
+
+	case slackHistoryMsg:
+		if m.channel == nil || msg.channelID != m.channel.ID {
+			return m, nil
+		}
+
+		m.loading = false
+
+		if msg.err != nil {
+			m.status = "Failed to load messages: " + msg.err.Error()
+			return m, nil
+		}
+
+		m.history = msg.messages
+		m.addNames(msg.users)
+		m.selected = len(m.visible()) - 1
+		m.render()
+
+		return m, nil
+
+	case slackThreadMsg:
+		if m.channel == nil || msg.channelID != m.channel.ID {
+			return m, nil
+		}
+
+		m.loading = false
+
+		if msg.err != nil {
+			m.status = "Failed to load thread: " + msg.err.Error()
+			return m, nil
+		}
+
+		m.threads[msg.threadTS] = msg.replies
+		m.expanded[msg.threadTS] = true
+
+		// A first reply turns a message into a thread
+		for i := range m.history {
+			if m.history[i].Timestamp == msg.threadTS {
+				m.history[i].ReplyCount = len(msg.replies)
+			}
+		}
+
+		m.addNames(msg.users)
+		m.render()
+
+		return m, nil
+
+	case slackPostedMsg:
+		if msg.err != nil {
+			m.status = "Failed to send reply: " + msg.err.Error()
+			return m, nil
+		}
+
+		m.status = "Reply sent"
+
+		if m.channel == nil || msg.channelID != m.channel.ID {
+			return m, nil
+		}
+
+		m.loading = true
+
+		return m, tea.Batch(m.spinner.Tick, m.loadThread(msg.channelID, msg.threadTS))
+
+	case spinner.TickMsg:
+		if !m.loading {
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+
+		m.spinner, cmd = m.spinner.Update(msg)
+
+		return m, cmd
+
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.quitting = true
+			return m, tea.Quit
+		}
+
+		if m.inputMode != slackNoInput {
+			return m.updateInput(msg)
+		}
+
+		if m.focus == slackMessagesPane {
+			return m.updateMessages(msg)
+		}
+
+		return m.updateChannels(msg)
+	}
+
+	// Other messages drive the list filter and the input cursor
+	var listCmd, inputCmd tea.Cmd
+
+	m.channels, listCmd = m.channels.Update(msg)
+	m.input, inputCmd = m.input.Update(msg)
+
+	return m, tea.Batch(listCmd, inputCmd)
+}
+
+// updateChannels handles keys in the channels pane
+func (m SlackBrowserModel) updateChannels(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Let the list handle all keys while typing a filter
+	if m.channels.FilterState() != list.Filtering {
+		switch msg.String() {
+		case "q", "esc":
+			if m.channels.FilterState() == list.FilterApplied {
+				break
+			}
+
+			m.quitting = true
+
+			return m, tea.Quit
+
+		case "tab":
+			if m.channel != nil {
+				m.focus = slackMessagesPane
+			}
+
+			return m, nil
+
+		case "enter":
+			i, ok := m.channels.SelectedItem().(slackChannelItem)
+			if !ok {
+				return m, nil
+			}
+
+			ch := i.channel
+			m.channel = &ch
+			m.history = nil
+			m.threads = make(map[string][]slack.Message)
+			m.expanded = make(map[string]bool)
+			m.search = ""
+			m.status = ""
+			m.focus = slackMessagesPane
+			m.loading = true
+			m.render()
+
+			return m, tea.Batch(m.spinner.Tick, m.loadHistory(ch.ID))
+		}
+	}
+
+	var cmd tea.Cmd
+
+	m.channels, cmd = m.channels.Update(msg)
+
+	return m, cmd
+}
+
+// updateMessages handles keys in the messages pane
+func (m SlackBrowserModel) updateMessages(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	visible := m.visible()
+
+	switch msg.String() {
+	case "q":
+		m.quitting = true
+
+		return m, tea.Quit
+
+	case "esc":
+		if m.search != "" {
+			m.search = ""
+			m.selected = len(m.visible()) - 1
+			m.render()
+
+			return m, nil
+		}
+
+		m.focus = slackChannelsPane
+
+		return m, nil
+
+	case "tab":
+		m.focus = slackChannelsPane
+
+		return m, nil
+
+	case "up", "k":
+		if m.selected > 0 {
+			m.selected--
+			m.render()
+		}
+
+		return m, nil
+
+	case "down", "j":
+		if m.selected < len(visible)-1 {
+			m.selected++
+			m.render()
+		}
+
+		return m, nil
+
+	case "enter", "t":
+		if m.selected < 0 || m.selected >= len(visible) {
+			return m, nil
+		}
+
+		selected := visible[m.selected]
+		if selected.ReplyCount == 0 {
+			return m, nil
+		}
+
+		if m.expanded[selected.Timestamp] {
+			m.expanded[selected.Timestamp] = false
+			m.render()
+
+			return m, nil
+		}
+
+		if _, ok := m.threads[selected.Timestamp]; ok {
+			m.expanded[selected.Timestamp] = true
+			m.render()
+
+			return m, nil
+		}
+
+		m.loading = true
+
+		return m, tea.Batch(m.spinner.Tick, m.loadThread(m.channel.ID, selected.Timestamp))
+
+	case "/":
+		m.inputMode = slackSearchInput
+		m.input.Placeholder = "Search messages"
+		m.input.SetValue(m.search)
+
+		return m, m.input.Focus()
+
+	case "r":
+		if m.selected < 0 || m.selected >= len(visible) {
+			return m, nil
+		}
+
+		m.inputMode = slackReplyInput
+		m.input.Placeholder = "Reply in thread"
+		m.input.SetValue("")
+
+		return m, m.input.Focus()
+	}
+
+	var cmd tea.Cmd
+
+	m.messages, cmd = m.messages.Update(msg)
+
+	return m, cmd
+}
+
+// updateInput handles keys while searching or writing a reply
+func (m SlackBrowserModel) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		if m.inputMode == slackSearchInput {
+			m.search = ""
+			m.selected = len(m.visible()) - 1
+			m.render()
+		}
+
+		m.inputMode = slackNoInput
+		m.input.Blur()
+
+		return m, nil
+
+	case "enter":
+		mode := m.inputMode
+		text := strings.TrimSpace(m.input.Value())
+
+		m.inputMode = slackNoInput
+		m.input.Blur()
+
+		if mode == slackReplyInput && text != "" {
+			parent := m.visible()[m.selected]
+			threadTS := parent.ThreadTS
+			if threadTS == "" {
+				threadTS = parent.Timestamp
+			}
+
+			m.status = "Sending reply..."
+
+			return m, m.postReply(m.channel.ID, threadTS, text)
+		}
+
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+
+	m.input, cmd = m.input.Update(msg)
+
+	// Search as the query is typed
+	if m.inputMode == slackSearchInput && m.input.Value() != m.search {
+		m.search = m.input.Value()
+		m.selected = len(m.visible()) - 1
+		m.render()
+	}
+
+	return m, cmd
+}
+
+// addNames adds the display names of users
+func (m *SlackBrowserModel) addNames(users map[string]*slack.User) {
+	for id, user := range users {
+		m.names[id] = user.DisplayName()
+	}
+}
+
+// author returns the name shown for the author of a message
+func (m SlackBrowserModel) author(msg slack.Message) string {
+	if name, ok := m.names[msg.User]; ok {
+		return name
+	}
+
+	if msg.BotProfile != nil {
+		return msg.BotProfile.Name + " (bot)"
+	}
+
+	return msg.User
+}
+
+// visible returns the messages of the open channel matching the search
+func (m SlackBrowserModel) visible() []slack.Message {
+	if m.search == "" {
+		return m.history
+	}
+
+	query := strings.ToLower(m.search)
+
+	var matched []slack.Message
+
+	for _, msg := range m.history {
+		if strings.Contains(strings.ToLower(msg.Text), query) || strings.Contains(strings.ToLower(m.author(msg)), query) {
+			matched = append(matched, msg)
+		}
+	}
+
+	return matched
+}
+
+// resize lays the panes out for the window size
+func (m *SlackBrowserModel) resize() {
+	listWidth := min(40, max(24, m.width/3))
+	paneHeight := max(m.height-3, 3) // borders and the status line
+
+	m.channels.SetSize(listWidth, paneHeight)
+	m.messages.Width = max(m.width-listWidth-4, 10)
+	m.messages.Height = paneHeight - 1 // channel header
+
+	m.render()
+}
+
+// render fills the messages pane and scrolls the selected message into view
+func (m *SlackBrowserModel) render() {
+	visible := m.visible()
+	if len(visible) == 0 {
+		m.messages.SetContent(slackTimeStyle.Render("No messages"))
+		return
+	}
+
+	m.selected = min(max(m.selected, 0), len(visible)-1)
+
+	bodyStyle := lipgloss.NewStyle().PaddingLeft(4).Width(m.messages.Width)
+	replyStyle := lipgloss.NewStyle().PaddingLeft(8).Width(m.messages.Width)
+
+	var (
+		b                        strings.Builder
+		selectedTop, selectedEnd int
+	)
+
+	line := 0
+	write := func(s string) {
+		b.WriteString(s)
+		b.WriteString("\n")
+		line += strings.Count(s, "\n") + 1
+	}
+
+	for i, msg := range visible {
+		if i == m.selected {
+			selectedTop = line
+		}
+
+		marker, author := "  ", slackAuthorStyle.Render(m.author(msg))
+		if i == m.selected {
+			marker, author = slackSelectedStyle.Render("▶ "), slackSelectedStyle.Render(m.author(msg))
+		}
+
+		write(fmt.Sprintf("%s%s  %s", marker, author, slackTimeStyle.Render(formatSlackTime(msg.Timestamp))))
+		write(bodyStyle.Render(msg.Text))
+
+		if msg.ReplyCount > 0 {
+			if m.expanded[msg.Timestamp] {
+				for _, reply := range m.threads[msg.Timestamp] {
+					write(fmt.Sprintf("      %s  %s", slackAuthorStyle.Render(m.author(reply)), slackTimeStyle.Render(formatSlackTime(reply.Timestamp))))
+					write(replyStyle.Render(reply.Text))
+				}
+			} else {
+				write(slackTimeStyle.Render(fmt.Sprintf("    💬 %d replies (t to expand)", msg.ReplyCount)))
+			}
+		}
+
+		if i == m.selected {
+			selectedEnd = line
+		}
+
+		write("")
+	}
+
+	m.messages.SetContent(strings.TrimSuffix(b.String(), "\n"))
+
+	// Keep the selected message in view
+	switch {
+	case selectedTop < m.messages.YOffset:
+		m.messages.SetYOffset(selectedTop)
+	case selectedEnd > m.messages.YOffset+m.messages.Height:
+		m.messages.SetYOffset(selectedEnd - m.messages.Height)
+	}
+}
+
+// formatSlackTime formats a message timestamp for display
+func formatSlackTime(ts string) string {
+	t, err := slack.ParseTimestamp(ts)
+	if err != nil {
+		return ts
+	}
+
+	return t.Format("Jan 02 15:04")
+}
+
+func (m SlackBrowserModel) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	if m.err != nil {
+		return fmt.Sprintf("Error: %v\n", m.err)
+	}
+
+	if m.loading && len(m.channels.Items()) == 0 {
+		return fmt.Sprintf("\n  %s Loading channels\n\n", m.spinner.View())
+	}
+
+	channelsPane, messagesPane := paneStyle, focusedPaneStyle
+	if m.focus == slackChannelsPane {
+		channelsPane, messagesPane = focusedPaneStyle, paneStyle
+	}
+
+	header := "Select a channel"
+	if m.channel != nil {
+		header = "#" + m.channel.Name
+	}
+
+	if m.search != "" {
+		header += slackTimeStyle.Render(fmt.Sprintf("  (search: %s)", m.search))
+	}
+
+	if m.loading {
+		header += " " + m.spinner.View()
+	}
+
+	panes := lipgloss.JoinHorizontal(lipgloss.Top,
+		channelsPane.Render(m.channels.View()),
+		messagesPane.Render(lipgloss.JoinVertical(lipgloss.Left, slackHeaderStyle.Render(header), m.messages.View())),
+	)
+
+	footer := slackTimeStyle.Render(slackHelp)
+
+	switch {
+	case m.inputMode != slackNoInput:
+		footer = m.input.View()
+	case m.status != "":
+		footer = m.status + "  " + footer
+	}
+
+	return panes + "\n" + footer
+}
+
+// Error returns the error that stopped the browser
+func (m SlackBrowserModel) Error() error {
+	return m.err
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/slack"
+)
+
+func TestSlackBrowserSearchAndThreads(t *testing.T) {
+	var model tea.Model = NewSlackBrowser(nil)
+
+	model, _ = model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model, _ = model.Update(slackChannelsMsg{channels: []slack.Channel{{ID: "C1", Name: "general"}}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	m := model.(SlackBrowserModel)
+	if m.channel == nil || m.channel.ID != "C1" || m.focus != slackMessagesPane {
+		t.Fatalf("enter did not open #general: channel %v, focus %v", m.channel, m.focus)
+	}
+
+	model, _ = model.Update(slackHistoryMsg{
+		channelID: "C1",
+		messages: []slack.Message{
+			{User: "U1", Text: "deploy started", Timestamp: "1700000100.000100", ThreadTS: "1700000100.000100", ReplyCount: 1},
+			{User: "U2", Text: "lunch?", Timestamp: "1700000200.000100"},
+		},
+		users: map[string]*slack.User{"U1": {ID: "U1", Name: "alice"}},
+	})
+
+	m = model.(SlackBrowserModel)
+	if m.selected != 1 || m.author(m.history[0]) != "alice" {
+		t.Fatalf("history: selected %d, author %q; want the newest message and resolved names", m.selected, m.author(m.history[0]))
+	}
+
+	// Search selects the matching message
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("deploy")})
+
+	m = model.(SlackBrowserModel)
+	if visible := m.visible(); len(visible) != 1 || visible[0].Text != "deploy started" {
+		t.Fatalf("search %q shows %v", m.search, visible)
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Expanding the thread loads its replies
+	model, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if cmd == nil || !model.(SlackBrowserModel).loading {
+		t.Fatal("t did not load the thread")
+	}
+
+	model, _ = model.Update(slackThreadMsg{
+		channelID: "C1",
+		threadTS:  "1700000100.000100",
+		replies:   []slack.Message{{User: "U1", Text: "deploy done", Timestamp: "1700000150.000100"}},
+	})
+
+	m = model.(SlackBrowserModel)
+	if !m.expanded["1700000100.000100"] || !strings.Contains(m.messages.View(), "deploy done") {
+		t.Errorf("thread not expanded:\n%s", m.messages.View())
+	}
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+var viewKey = key.NewBinding(
+	key.WithKeys("v"),
+	key.WithHelp("v", "saved views"),
+)
+
+// viewItem is a saved filter in the view picker. A nil filter shows all repositories.
+type viewItem struct {
+	filter *model.SavedFilter
+}
+
+func (i viewItem) Title() string {
+	if i.filter == nil {
+		return "All repositories"
+	}
+
+	return i.filter.Name
+}
+
+func (i viewItem) Description() string {
+	if i.filter == nil {
+		return "Clear the active view"
+	}
+
+	if i.filter.Description != "" {
+		return i.filter.Description
+	}
+
+	return i.filter.Summary()
+}
+
+func (i viewItem) FilterValue() string {
+	if i.filter == nil {
+		return ""
+	}
+
+	return i.filter.Name
+}
+
+// viewsLoadedMsg carries the saved filters for the view picker
+type viewsLoadedMsg struct {
+	filters []model.SavedFilter
+	err     error
+}
+
+// loadViews fetches the saved filters in the background
+func loadViews() tea.Cmd {
+	return func() tea.Msg {
+		filters, err := core.ListFilters()
+
+		return viewsLoadedMsg{filters: filters, err: err}
+	}
+}
+
+// loadViewRepos fetches the repositories matching a saved filter
+func loadViewRepos(filter *model.SavedFilter) tea.Cmd {
+	return func() tea.Msg {
+		found, err := core.ViewRepos(filter)
+		if err != nil {
+			return reposReloadedMsg{err: err}
+		}
+
+		repos := make([]model.Repository, len(found))
+		for i, r := range found {
+			repos[i] = r.Repository
+		}
+
+		return reposReloadedMsg{repos: repos}
+	}
+}
+
+// newViewPicker returns a list of the saved filters, sized like the repository list
+func newViewPicker(filters []model.SavedFilter, width, height int) list.Model {
+	items := make([]list.Item, 0, len(filters)+1)
+	items = append(items, viewItem{})
+
+	for i := range filters {
+		items = append(items, viewItem{filter: &filters[i]})
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), width, height)
+	l.Title = "Saved Views"
+	l.SetShowStatusBar(false)
+
+	return l
+}
+
+// updatePicker handles keys while the view picker is open
+func (m RepoListModel) updatePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && m.picker.FilterState() != list.Filtering {
+		switch keyMsg.String() {
+		case "ctrl+c":
+			m.quitting = true
+
+			return m, m.quit()
+
+		case "esc", "q", "v":
+			m.picker = nil
+
+			return m, nil
+
+		case "enter":
+			item, _ := m.picker.SelectedItem().(viewItem)
+			m.picker = nil
+
+			return m, m.applyView(item.filter)
+		}
+	}
+
+	var cmd tea.Cmd
+
+	picker, cmd := m.picker.Update(msg)
+	m.picker = &picker
+
+	return m, cmd
+}
+
+// applyView switches the list to a saved filter, or back to all repositories
+func (m *RepoListModel) applyView(filter *model.SavedFilter) tea.Cmd {
+	m.view = filter
+	m.nextPage = ""
+	m.loadGen++
+	m.title = repoListTitle(m.favoritesOnly, filter)
+	m.list.ResetFilter()
+
+	return tea.Batch(m.list.NewStatusMessage("Loading "+viewItem{filter: filter}.Title()+"…"), m.reload())
+}
+
+// reload fetches the repositories for the active view
+func (m RepoListModel) reload() tea.Cmd {
+	if m.view != nil {
+		return loadViewRepos(m.view)
+	}
+
+	return reloadRepos(m.favoritesOnly)
+}
+
+// repoListTitle returns the list title for the active view
+func repoListTitle(favoritesOnly bool, view *model.SavedFilter) string {
+	switch {
+	case view != nil:
+		return "View: " + view.Name
+	case favoritesOnly:
+		return "Favorite Repositories"
+	default:
+		return "All Repositories"
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -27,6 +28,7 @@ var (
 type WorkspaceItem struct {
 	workspace model.Workspace
 	isNew     bool
+	target    string // directory cloned into, shown as the resolved destination
 }
 
 func (i WorkspaceItem) Title() string {
@@ -47,6 +49,10 @@ func (i WorkspaceItem) Description() string {
 		return "Create a new workspace for organizing repositories"
 	}
 
+	if i.target != "" {
+		return workspacePathStyle.Render("→ " + filepath.Join(i.workspace.Path, i.target))
+	}
+
 	return workspacePathStyle.Render(i.workspace.Path)
 }
 
@@ -120,8 +126,9 @@ func NewWorkspaceSelector(allowCreate bool) (WorkspaceSelectorModel, error) {
 }
 
 // NewWorkspaceSelectorForClone creates a workspace selector that returns the
-// active workspace if the user quits without selecting
-func NewWorkspaceSelectorForClone() (WorkspaceSelectorModel, error) {
+// active workspace if the user quits without selecting. When dirName is set,
+// each workspace shows the path the repository would be cloned to.
+func NewWorkspaceSelectorForClone(dirName string) (WorkspaceSelectorModel, error) {
 	m, err := NewWorkspaceSelector(true)
 	if err != nil {
 		return m, err
@@ -129,6 +136,19 @@ func NewWorkspaceSelectorForClone() (WorkspaceSelectorModel, error) {
 
 	m.returnNewOnQuit = false
 
+	if dirName != "" {
+		items := m.list.Items()
+		for i, item := range items {
+			if ws, ok := item.(WorkspaceItem); ok && !ws.isNew {
+				ws.target = dirName
+				items[i] = ws
+			}
+		}
+
+		m.list.SetItems(items)
+		m.list.Title = fmt.Sprintf("Clone %s into workspace", dirName)
+	}
+
 	return m, nil
 }
 
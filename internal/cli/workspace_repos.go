@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -35,6 +36,9 @@ var (
 				MarginTop(1)
 )
 
+// workspaceSortCycle is the order the o key steps through
+var workspaceSortCycle = []core.SortBy{core.SortByFrecency, core.SortByName, core.SortByClonedAt}
+
 // WorkspaceRepoItem wraps a repository for display in the workspace view
 type WorkspaceRepoItem struct {
 	repo model.Repository
@@ -64,6 +68,7 @@ type WorkspaceReposModel struct {
 	repoList         list.Model
 	repos            map[string][]model.Repository
 	selectedRepo     *model.Repository
+	sortBy           core.SortBy
 	quitting         bool
 	err              error
 	width            int
@@ -126,6 +131,7 @@ func NewWorkspaceReposModel() (WorkspaceReposModel, error) {
 		workspaces:       workspaces,
 		currentWorkspace: activeIdx,
 		repos:            reposByWorkspace,
+		sortBy:           core.SortByFrecency,
 	}
 
 	m = m.withUpdatedRepoList()
@@ -139,11 +145,17 @@ func (m WorkspaceReposModel) withUpdatedRepoList() WorkspaceReposModel {
 		wsName = m.workspaces[m.currentWorkspace].Name
 	}
 
-	repos := m.repos[wsName]
+	repos := make([]core.RepoWithStats, len(m.repos[wsName]))
+	for i, repo := range m.repos[wsName] {
+		repos[i] = core.RepoWithStats{Repository: repo}
+	}
+
+	core.SortRepos(repos, m.sortBy)
+
 	items := make([]list.Item, len(repos))
 
 	for i, repo := range repos {
-		items[i] = WorkspaceRepoItem{repo: repo}
+		items[i] = WorkspaceRepoItem{repo: repo.Repository}
 	}
 
 	delegate := list.NewDefaultDelegate()
@@ -237,6 +249,13 @@ func (m WorkspaceReposModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 			return m, tea.Quit
 
+		case "o":
+			i := slices.Index(workspaceSortCycle, m.sortBy)
+			m.sortBy = workspaceSortCycle[(i+1)%len(workspaceSortCycle)]
+			m = m.withUpdatedRepoList()
+
+			return m, m.repoList.NewStatusMessage(fmt.Sprintf("Sort: %s", m.sortBy))
+
 		case "s":
 			// Set the current workspace as active
 			if len(m.workspaces) > 0 {
@@ -307,7 +326,7 @@ func (m WorkspaceReposModel) View() string {
 		Render(fmt.Sprintf("Path: %s%s", currentWs.Path, activeMarker))
 
 	// Help text
-	help := workspaceHelpStyle.Render("tab/←→: switch workspace • s: set active • enter: select • /: filter • q: quit")
+	help := workspaceHelpStyle.Render("tab/←→: switch workspace • s: set active • o: sort • enter: select • /: filter • q: quit")
 
 	// Combine all parts
 	header := fmt.Sprintf("%s\n%s\n%s", tabs.String(), title, pathInfo)
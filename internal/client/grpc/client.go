@@ -2,7 +2,9 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"sync"
 	"time"
@@ -12,85 +14,159 @@ import (
 	"github.com/inovacc/clonr/internal/model"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
+// connection is the cached client of one server, connected on first use
+type connection struct {
+	once   sync.Once
+	client *Client
+	err    error
+}
+
+var (
+	connectionsMu sync.Mutex
+	connections   = map[string]*connection{}
+)
+
 var (
-	once      sync.Once
-	client    *Client
-	errClient error
+	baseCtxMu sync.RWMutex
+	baseCtx   = context.Background()
 )
 
+// SetBaseContext sets the context every request is derived from. When it is
+// cancelled, requests in flight are cancelled on the server too.
+func SetBaseContext(ctx context.Context) {
+	baseCtxMu.Lock()
+	defer baseCtxMu.Unlock()
+
+	baseCtx = ctx
+}
+
+// baseContext returns the context set by SetBaseContext
+func baseContext() context.Context {
+	baseCtxMu.RLock()
+	defer baseCtxMu.RUnlock()
+
+	return baseCtx
+}
+
 // Client wraps the gRPC client and provides methods matching the store.Store interface
 type Client struct {
-	conn    *grpc.ClientConn
-	service v1.ClonrServiceClient
-	timeout time.Duration
+	conn       *grpc.ClientConn
+	service    v1.ClonrServiceClient
+	timeout    time.Duration
+	addr       string
+	addrSource string
 }
 
-// GetClient returns the singleton gRPC client instance
+// GetClient returns the client of the selected server: the one named by
+// SelectServer or CLONR_SERVER_PROFILE, or else the discovered local server.
+// Connections are cached per server.
 func GetClient() (*Client, error) {
-	once.Do(lazyLoad)
+	return GetClientFor(selectedServer())
+}
 
-	if errClient != nil {
-		return nil, errClient
+// GetClientFor returns the client of the server profile called name, or of
+// the discovered local server when name is empty
+func GetClientFor(name string) (*Client, error) {
+	connectionsMu.Lock()
+
+	conn, ok := connections[name]
+	if !ok {
+		conn = &connection{}
+		connections[name] = conn
 	}
 
-	return client, nil
+	connectionsMu.Unlock()
+
+	conn.once.Do(func() {
+		if name == "" {
+			conn.client, conn.err = connectLocal()
+		} else {
+			conn.client, conn.err = connectProfile(name)
+		}
+	})
+
+	return conn.client, conn.err
 }
 
-func lazyLoad() {
-	addr := discoverServerAddress()
+// connectLocal connects to the discovered server, starting one on demand
+func connectLocal() (*Client, error) {
+	addr, source := discoverServer()
 
-	// Use grpc.NewClient (v1.78.0+) instead of deprecated DialContext
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts, err := dialOptions(addr)
 	if err != nil {
-		errClient = fmt.Errorf("failed to create gRPC client: %w", err)
-		return
+		return nil, err
 	}
 
-	// Perform health check to trigger connection
-	healthClient := healthpb.NewHealthClient(conn)
-
-	healthCtx, healthCancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer healthCancel()
+	// Use grpc.NewClient (v1.78.0+) instead of deprecated DialContext
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
 
-	resp, err := healthClient.Check(healthCtx, &healthpb.HealthCheckRequest{})
-	if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+	if !checkHealth(conn) {
 		_ = conn.Close()
 
-		// Server not running - try to start one automatically
-		if startErr := startOnDemandServer(defaultServerPort); startErr != nil {
-			errClient = fmt.Errorf("failed to start on-demand server: %w", startErr)
-			return
+		// Server not running - start one, unless it is remote or the user declines
+		if err := confirmStartServer(addr, source); err != nil {
+			return nil, err
+		}
+
+		if err := startOnDemandServer(defaultServerPort); err != nil {
+			return nil, fmt.Errorf("failed to start on-demand server: %w", err)
 		}
 
 		// Wait for the server to be ready
-		addr = fmt.Sprintf("localhost:%d", defaultServerPort)
-		if waitErr := waitForServer(addr); waitErr != nil {
-			errClient = fmt.Errorf("server started but not ready: %w", waitErr)
-			return
+		addr, source = fmt.Sprintf("localhost:%d", defaultServerPort), ServerSourceOnDemand
+		if err := waitForServer(addr); err != nil {
+			return nil, fmt.Errorf("server started but not ready: %w", err)
 		}
 
 		// Reconnect to the now-running server
-		conn, err = grpc.NewClient(addr,
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
+		if opts, err = dialOptions(addr); err != nil {
+			return nil, err
+		}
+
+		conn, err = grpc.NewClient(addr, opts...)
 		if err != nil {
-			errClient = fmt.Errorf("failed to connect to started server: %w", err)
-			return
+			return nil, fmt.Errorf("failed to connect to started server: %w", err)
 		}
 	}
 
-	client = &Client{
-		conn:    conn,
-		service: v1.NewClonrServiceClient(conn),
-		timeout: 30 * time.Second,
+	return newClient(conn, addr, source), nil
+}
+
+// checkHealth reports whether the server behind conn is serving; the health
+// check also establishes the connection
+func checkHealth(conn *grpc.ClientConn) bool {
+	healthCtx, healthCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer healthCancel()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(healthCtx, &healthpb.HealthCheckRequest{})
+
+	return err == nil && resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+}
+
+// newClient wraps a connection to the server at addr
+func newClient(conn *grpc.ClientConn, addr, source string) *Client {
+	return &Client{
+		conn:       conn,
+		service:    v1.NewClonrServiceClient(conn),
+		timeout:    30 * time.Second,
+		addr:       addr,
+		addrSource: source,
 	}
 }
 
+// Address returns the server address the client is connected to and how it
+// was found (one of the ServerSource constants)
+func (c *Client) Address() (addr, source string) {
+	return c.addr, c.addrSource
+}
+
 // Close closes the gRPC connection
 func (c *Client) Close() error {
 	if c.conn != nil {
@@ -102,7 +178,7 @@ func (c *Client) Close() error {
 
 // Ping verifies the connection to the server
 func (c *Client) Ping() error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	_, err := c.service.Ping(ctx, &v1.Empty{})
@@ -117,7 +193,7 @@ func (c *Client) SaveRepo(u *url.URL, path string) error {
 
 // SaveRepoWithWorkspace saves a repository with workspace to the database via gRPC
 func (c *Client) SaveRepoWithWorkspace(u *url.URL, path string, workspace string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SaveRepo(ctx, &v1.SaveRepoRequest{
@@ -138,7 +214,7 @@ func (c *Client) SaveRepoWithWorkspace(u *url.URL, path string, workspace string
 
 // RepoExistsByURL checks if a repository exists by URL
 func (c *Client) RepoExistsByURL(u *url.URL) (bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.RepoExistsByURL(ctx, &v1.RepoExistsByURLRequest{
@@ -153,7 +229,7 @@ func (c *Client) RepoExistsByURL(u *url.URL) (bool, error) {
 
 // RepoExistsByPath checks if a repository exists by path
 func (c *Client) RepoExistsByPath(path string) (bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.RepoExistsByPath(ctx, &v1.RepoExistsByPathRequest{
@@ -168,7 +244,7 @@ func (c *Client) RepoExistsByPath(path string) (bool, error) {
 
 // InsertRepoIfNotExists inserts a repository if it doesn't exist
 func (c *Client) InsertRepoIfNotExists(u *url.URL, path string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	urlStr := ""
@@ -193,7 +269,7 @@ func (c *Client) InsertRepoIfNotExists(u *url.URL, path string) error {
 
 // GetAllRepos retrieves all repositories
 func (c *Client) GetAllRepos() ([]model.Repository, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetAllRepos(ctx, &v1.GetAllReposRequest{})
@@ -211,7 +287,7 @@ func (c *Client) GetAllRepos() ([]model.Repository, error) {
 
 // GetRepos retrieves repositories with optional filtering
 func (c *Client) GetRepos(workspace string, favoritesOnly bool) ([]model.Repository, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetRepos(ctx, &v1.GetReposRequest{
@@ -230,9 +306,46 @@ func (c *Client) GetRepos(workspace string, favoritesOnly bool) ([]model.Reposit
 	return repos, nil
 }
 
+// RepoPage is one page of repositories returned by ListRepos
+type RepoPage struct {
+	Repositories  []model.Repository
+	NextPageToken string // empty on the last page
+	TotalSize     int    // number of repositories matching the filter
+}
+
+// ListRepos retrieves one page of repositories matching filter.
+// Pass the previous page's NextPageToken to continue; a pageSize of 0 uses the server default.
+func (c *Client) ListRepos(filter model.RepoFilter, pageSize int, pageToken string) (*RepoPage, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListRepos(ctx, &v1.ListReposRequest{
+		PageSize:      int32(pageSize),
+		PageToken:     pageToken,
+		FavoritesOnly: filter.FavoritesOnly,
+		Workspace:     filter.Workspace,
+		Query:         filter.Query,
+		Kind:          string(filter.Kind),
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	repos := make([]model.Repository, len(resp.GetRepositories()))
+	for i, pr := range resp.GetRepositories() {
+		repos[i] = mapper.ProtoToModelRepository(pr)
+	}
+
+	return &RepoPage{
+		Repositories:  repos,
+		NextPageToken: resp.GetNextPageToken(),
+		TotalSize:     int(resp.GetTotalSize()),
+	}, nil
+}
+
 // SetFavoriteByURL marks or unmarks a repository as favorite
 func (c *Client) SetFavoriteByURL(urlStr string, fav bool) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SetFavoriteByURL(ctx, &v1.SetFavoriteRequest{
@@ -250,9 +363,209 @@ func (c *Client) SetFavoriteByURL(urlStr string, fav bool) error {
 	return nil
 }
 
+// SetRepoKind records the classification of a repository
+func (c *Client) SetRepoKind(urlStr string, kind model.RepoKind) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoKind(ctx, &v1.SetRepoKindRequest{
+		Url:  urlStr,
+		Kind: string(kind),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoUpstream records the repository a fork was created from
+func (c *Client) SetRepoUpstream(urlStr, upstreamURL string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoUpstream(ctx, &v1.SetRepoUpstreamRequest{
+		Url:         urlStr,
+		UpstreamUrl: upstreamURL,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoLicense records the detected license of a repository
+func (c *Client) SetRepoLicense(urlStr, license string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoLicense(ctx, &v1.SetRepoLicenseRequest{
+		Url:     urlStr,
+		License: license,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoTags replaces the tags of a repository
+func (c *Client) SetRepoTags(urlStr string, tags []string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoTags(ctx, &v1.SetRepoTagsRequest{
+		Url:  urlStr,
+		Tags: tags,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoNotes replaces the notes of a repository
+func (c *Client) SetRepoNotes(urlStr, notes string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoNotes(ctx, &v1.SetRepoNotesRequest{
+		Url:   urlStr,
+		Notes: notes,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoAlias sets the alias of a repository; an empty alias removes it
+func (c *Client) SetRepoAlias(urlStr, alias string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SetRepoAlias(ctx, &v1.SetRepoAliasRequest{
+		Url:   urlStr,
+		Alias: alias,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// RecordRepoAccess counts an access to a repository and records its time
+func (c *Client) RecordRepoAccess(urlStr string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.RecordRepoAccess(ctx, &v1.RecordRepoAccessRequest{Url: urlStr})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SetRepoRemotes replaces the additional remotes recorded for a repository
+func (c *Client) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	protoRemotes := make([]*v1.RepoRemote, len(remotes))
+	for i, r := range remotes {
+		protoRemotes[i] = &v1.RepoRemote{Name: r.Name, Url: r.URL}
+	}
+
+	resp, err := c.service.SetRepoRemotes(ctx, &v1.SetRepoRemotesRequest{
+		Url:     urlStr,
+		Remotes: protoRemotes,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetRepoByRemoteURL returns the repository having a remote with the given
+// URL, or nil when there is none
+func (c *Client) GetRepoByRemoteURL(urlStr string) (*model.Repository, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetRepoByRemoteURL(ctx, &v1.GetRepoByRemoteURLRequest{
+		Url: urlStr,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	if resp.GetRepository() == nil {
+		return nil, nil
+	}
+
+	repo := mapper.ProtoToModelRepository(resp.GetRepository())
+
+	return &repo, nil
+}
+
+// GetRepoDetail returns a repository with the state of its clone, read by
+// the server
+func (c *Client) GetRepoDetail(urlStr string) (*model.RepoDetail, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetRepoDetail(ctx, &v1.GetRepoDetailRequest{
+		Url: urlStr,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelRepoDetail(resp.GetDetail()), nil
+}
+
 // UpdateRepoTimestamp updates the timestamp for a repository
 func (c *Client) UpdateRepoTimestamp(urlStr string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.UpdateRepoTimestamp(ctx, &v1.UpdateRepoTimestampRequest{
@@ -271,7 +584,7 @@ func (c *Client) UpdateRepoTimestamp(urlStr string) error {
 
 // RemoveRepoByURL removes a repository by URL
 func (c *Client) RemoveRepoByURL(u *url.URL) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.RemoveRepoByURL(ctx, &v1.RemoveRepoByURLRequest{
@@ -288,9 +601,72 @@ func (c *Client) RemoveRepoByURL(u *url.URL) error {
 	return nil
 }
 
+// UpdateRepoURL changes the URL of a tracked repository, keeping the rest
+// of its record
+func (c *Client) UpdateRepoURL(oldURL, newURL string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.UpdateRepoURL(ctx, &v1.UpdateRepoURLRequest{
+		OldUrl: oldURL,
+		NewUrl: newURL,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// UpdateRepoPath updates the local path of a tracked repository
+func (c *Client) UpdateRepoPath(urlStr string, path string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.UpdateRepoPath(ctx, &v1.UpdateRepoPathRequest{
+		Url:  urlStr,
+		Path: path,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// WatchRepoEvents calls fn for every repository change until ctx is
+// canceled or the server ends the stream.
+func (c *Client) WatchRepoEvents(ctx context.Context, fn func(model.RepoEvent)) error {
+	stream, err := c.service.WatchRepoEvents(ctx, &v1.WatchRepoEventsRequest{})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			return handleGRPCError(err)
+		}
+
+		fn(mapper.ProtoToModelRepoEvent(event))
+	}
+}
+
 // GetConfig retrieves the application configuration
 func (c *Client) GetConfig() (*model.Config, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetConfig(ctx, &v1.GetConfigRequest{})
@@ -307,7 +683,7 @@ func (c *Client) GetConfig() (*model.Config, error) {
 
 // SaveConfig saves the application configuration
 func (c *Client) SaveConfig(cfg *model.Config) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SaveConfig(ctx, &v1.SaveConfigRequest{
@@ -356,7 +732,7 @@ func handleGRPCError(err error) error {
 
 // SaveProfile saves or updates a profile via gRPC
 func (c *Client) SaveProfile(profile *model.Profile) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SaveProfile(ctx, &v1.SaveProfileRequest{
@@ -375,7 +751,7 @@ func (c *Client) SaveProfile(profile *model.Profile) error {
 
 // GetProfile retrieves a profile by name
 func (c *Client) GetProfile(name string) (*model.Profile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetProfile(ctx, &v1.GetProfileRequest{
@@ -390,7 +766,7 @@ func (c *Client) GetProfile(name string) (*model.Profile, error) {
 
 // GetActiveProfile retrieves the currently active profile
 func (c *Client) GetActiveProfile() (*model.Profile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetActiveProfile(ctx, &v1.GetActiveProfileRequest{})
@@ -403,7 +779,7 @@ func (c *Client) GetActiveProfile() (*model.Profile, error) {
 
 // SetActiveProfile sets the active profile by name
 func (c *Client) SetActiveProfile(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SetActiveProfile(ctx, &v1.SetActiveProfileRequest{
@@ -422,7 +798,7 @@ func (c *Client) SetActiveProfile(name string) error {
 
 // ListProfiles retrieves all profiles
 func (c *Client) ListProfiles() ([]model.Profile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.ListProfiles(ctx, &v1.ListProfilesRequest{})
@@ -440,7 +816,7 @@ func (c *Client) ListProfiles() ([]model.Profile, error) {
 
 // DeleteProfile removes a profile by name
 func (c *Client) DeleteProfile(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.DeleteProfile(ctx, &v1.DeleteProfileRequest{
@@ -459,7 +835,7 @@ func (c *Client) DeleteProfile(name string) error {
 
 // ProfileExists checks if a profile exists by name
 func (c *Client) ProfileExists(name string) (bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.ProfileExists(ctx, &v1.ProfileExistsRequest{
@@ -474,7 +850,7 @@ func (c *Client) ProfileExists(name string) (bool, error) {
 
 // SaveDockerProfile saves or updates a docker profile via gRPC
 func (c *Client) SaveDockerProfile(profile *model.DockerProfile) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SaveDockerProfile(ctx, &v1.SaveDockerProfileRequest{
@@ -493,7 +869,7 @@ func (c *Client) SaveDockerProfile(profile *model.DockerProfile) error {
 
 // GetDockerProfile retrieves a docker profile by name
 func (c *Client) GetDockerProfile(name string) (*model.DockerProfile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetDockerProfile(ctx, &v1.GetDockerProfileRequest{
@@ -508,7 +884,7 @@ func (c *Client) GetDockerProfile(name string) (*model.DockerProfile, error) {
 
 // ListDockerProfiles retrieves all docker profiles
 func (c *Client) ListDockerProfiles() ([]model.DockerProfile, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.ListDockerProfiles(ctx, &v1.ListDockerProfilesRequest{})
@@ -526,7 +902,7 @@ func (c *Client) ListDockerProfiles() ([]model.DockerProfile, error) {
 
 // DeleteDockerProfile removes a docker profile by name
 func (c *Client) DeleteDockerProfile(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.DeleteDockerProfile(ctx, &v1.DeleteDockerProfileRequest{
@@ -543,9 +919,613 @@ func (c *Client) DeleteDockerProfile(name string) error {
 	return nil
 }
 
+// SaveFilter saves or updates a saved repository filter via gRPC
+func (c *Client) SaveFilter(filter *model.SavedFilter) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveFilter(ctx, &v1.SaveFilterRequest{
+		Filter: mapper.ModelToProtoSavedFilter(filter),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetFilter retrieves a saved repository filter by name
+func (c *Client) GetFilter(name string) (*model.SavedFilter, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetFilter(ctx, &v1.GetFilterRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelSavedFilter(resp.GetFilter()), nil
+}
+
+// ListFilters retrieves all saved repository filters
+func (c *Client) ListFilters() ([]model.SavedFilter, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListFilters(ctx, &v1.ListFiltersRequest{})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	filters := make([]model.SavedFilter, len(resp.GetFilters()))
+	for i, f := range resp.GetFilters() {
+		filters[i] = *mapper.ProtoToModelSavedFilter(f)
+	}
+
+	return filters, nil
+}
+
+// DeleteFilter removes a saved repository filter by name
+func (c *Client) DeleteFilter(name string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteFilter(ctx, &v1.DeleteFilterRequest{
+		Name: name,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveRepoSnapshot saves a repository snapshot via gRPC
+func (c *Client) SaveRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveRepoSnapshot(ctx, &v1.SaveRepoSnapshotRequest{
+		Snapshot: mapper.ModelToProtoRepoSnapshot(snapshot),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetRepoSnapshot retrieves a repository snapshot by ID
+func (c *Client) GetRepoSnapshot(id string) (*model.RepoSnapshot, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetRepoSnapshot(ctx, &v1.GetRepoSnapshotRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelRepoSnapshot(resp.GetSnapshot()), nil
+}
+
+// ListRepoSnapshots retrieves the snapshots of a repository, newest first.
+// An empty repoURL lists the snapshots of all repositories.
+func (c *Client) ListRepoSnapshots(repoURL string) ([]model.RepoSnapshot, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListRepoSnapshots(ctx, &v1.ListRepoSnapshotsRequest{
+		RepoUrl: repoURL,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	snapshots := make([]model.RepoSnapshot, len(resp.GetSnapshots()))
+	for i, s := range resp.GetSnapshots() {
+		snapshots[i] = *mapper.ProtoToModelRepoSnapshot(s)
+	}
+
+	return snapshots, nil
+}
+
+// DeleteRepoSnapshot removes a repository snapshot by ID
+func (c *Client) DeleteRepoSnapshot(id string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteRepoSnapshot(ctx, &v1.DeleteRepoSnapshotRequest{
+		Id: id,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveWizardDraft saves the draft state of an interactive flow via gRPC
+func (c *Client) SaveWizardDraft(draft *model.WizardDraft) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveWizardDraft(ctx, &v1.SaveWizardDraftRequest{
+		Draft: mapper.ModelToProtoWizardDraft(draft),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetWizardDraft retrieves the draft state of an interactive flow. It returns
+// nil when no draft is saved.
+func (c *Client) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetWizardDraft(ctx, &v1.GetWizardDraftRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelWizardDraft(resp.GetDraft()), nil
+}
+
+// DeleteWizardDraft removes the draft state of an interactive flow
+func (c *Client) DeleteWizardDraft(name string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteWizardDraft(ctx, &v1.DeleteWizardDraftRequest{
+		Name: name,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveAPIToken saves or replaces an API token via gRPC
+func (c *Client) SaveAPIToken(token *model.APIToken) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveAPIToken(ctx, &v1.SaveAPITokenRequest{
+		Token: mapper.ModelToProtoAPIToken(token),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetAPITokenByHash retrieves the API token with a hash. It returns nil
+// when no token has it.
+func (c *Client) GetAPITokenByHash(hash string) (*model.APIToken, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetAPITokenByHash(ctx, &v1.GetAPITokenByHashRequest{
+		Hash: hash,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelAPIToken(resp.GetToken()), nil
+}
+
+// ListAPITokens returns all API tokens
+func (c *Client) ListAPITokens() ([]model.APIToken, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListAPITokens(ctx, &v1.ListAPITokensRequest{})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	tokens := make([]model.APIToken, 0, len(resp.GetTokens()))
+	for _, t := range resp.GetTokens() {
+		tokens = append(tokens, *mapper.ProtoToModelAPIToken(t))
+	}
+
+	return tokens, nil
+}
+
+// DeleteAPIToken revokes an API token by name
+func (c *Client) DeleteAPIToken(name string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteAPIToken(ctx, &v1.DeleteAPITokenRequest{
+		Name: name,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveVaultSecret saves or replaces a vault secret via gRPC
+func (c *Client) SaveVaultSecret(secret *model.VaultSecret) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveVaultSecret(ctx, &v1.SaveVaultSecretRequest{
+		Secret: mapper.ModelToProtoVaultSecret(secret),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetVaultSecret retrieves a vault secret by key. It returns nil when no
+// secret has the key.
+func (c *Client) GetVaultSecret(key string) (*model.VaultSecret, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetVaultSecret(ctx, &v1.GetVaultSecretRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelVaultSecret(resp.GetSecret()), nil
+}
+
+// ListVaultSecrets returns all vault secrets
+func (c *Client) ListVaultSecrets() ([]model.VaultSecret, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListVaultSecrets(ctx, &v1.ListVaultSecretsRequest{})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	secrets := make([]model.VaultSecret, 0, len(resp.GetSecrets()))
+	for _, s := range resp.GetSecrets() {
+		secrets = append(secrets, *mapper.ProtoToModelVaultSecret(s))
+	}
+
+	return secrets, nil
+}
+
+// DeleteVaultSecret removes a vault secret by key
+func (c *Client) DeleteVaultSecret(key string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteVaultSecret(ctx, &v1.DeleteVaultSecretRequest{
+		Key: key,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveGmailWatch saves or replaces a Gmail watch via gRPC
+func (c *Client) SaveGmailWatch(watch *model.GmailWatch) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveGmailWatch(ctx, &v1.SaveGmailWatchRequest{
+		Watch: mapper.ModelToProtoGmailWatch(watch),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetGmailWatch retrieves a Gmail watch by name. It returns nil when no
+// watch has the name.
+func (c *Client) GetGmailWatch(name string) (*model.GmailWatch, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetGmailWatch(ctx, &v1.GetGmailWatchRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelGmailWatch(resp.GetWatch()), nil
+}
+
+// ListGmailWatches returns all Gmail watches
+func (c *Client) ListGmailWatches() ([]model.GmailWatch, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListGmailWatches(ctx, &v1.ListGmailWatchesRequest{})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	watches := make([]model.GmailWatch, 0, len(resp.GetWatches()))
+	for _, w := range resp.GetWatches() {
+		watches = append(watches, *mapper.ProtoToModelGmailWatch(w))
+	}
+
+	return watches, nil
+}
+
+// DeleteGmailWatch removes a Gmail watch by name
+func (c *Client) DeleteGmailWatch(name string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.DeleteGmailWatch(ctx, &v1.DeleteGmailWatchRequest{
+		Name: name,
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// SaveGitHubRepoID caches a GitHub repository ID via gRPC
+func (c *Client) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveGitHubRepoID(ctx, &v1.SaveGitHubRepoIDRequest{
+		Entry: mapper.ModelToProtoGitHubRepoID(entry),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetGitHubRepoID retrieves a cached GitHub repository ID by owner/repo. It
+// returns nil when the repository is not cached.
+func (c *Client) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetGitHubRepoID(ctx, &v1.GetGitHubRepoIDRequest{
+		FullName: fullName,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelGitHubRepoID(resp.GetEntry()), nil
+}
+
+// SaveDependencyInventory records the dependencies of a repository via gRPC
+func (c *Client) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveDependencyInventory(ctx, &v1.SaveDependencyInventoryRequest{
+		Inventory: mapper.ModelToProtoDependencyInventory(inventory),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// ListDependencyInventories retrieves the dependency inventories of a
+// repository, newest first, keeping at most limit (all when limit <= 0)
+func (c *Client) ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListDependencyInventories(ctx, &v1.ListDependencyInventoriesRequest{
+		RepoUrl: repoURL,
+		Limit:   int32(max(limit, 0)),
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	inventories := make([]model.DependencyInventory, len(resp.GetInventories()))
+	for i, inv := range resp.GetInventories() {
+		inventories[i] = *mapper.ProtoToModelDependencyInventory(inv)
+	}
+
+	return inventories, nil
+}
+
+// SaveShareLink records a share link via gRPC
+func (c *Client) SaveShareLink(link *model.ShareLink) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveShareLink(ctx, &v1.SaveShareLinkRequest{
+		Link: mapper.ModelToProtoShareLink(link),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetShareLink retrieves a share link by ID via gRPC, or nil
+func (c *Client) GetShareLink(id string) (*model.ShareLink, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetShareLink(ctx, &v1.GetShareLinkRequest{Id: id})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelShareLink(resp.GetLink()), nil
+}
+
+// ConsumeShareLink removes a share link via gRPC and returns it, or nil
+// when it does not exist
+func (c *Client) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ConsumeShareLink(ctx, &v1.ConsumeShareLinkRequest{Id: id})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelShareLink(resp.GetLink()), nil
+}
+
+// SaveJob records the progress of a job via gRPC
+func (c *Client) SaveJob(job *model.Job) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.SaveJob(ctx, &v1.SaveJobRequest{
+		Job: mapper.ModelToProtoJob(job),
+	})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
+// GetJob retrieves a job by ID via gRPC, or nil
+func (c *Client) GetJob(id string) (*model.Job, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.GetJob(ctx, &v1.GetJobRequest{Id: id})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	return mapper.ProtoToModelJob(resp.GetJob()), nil
+}
+
+// ListJobs returns the newest jobs first via gRPC, at most limit of them
+func (c *Client) ListJobs(limit int) ([]model.Job, error) {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.ListJobs(ctx, &v1.ListJobsRequest{Limit: int32(limit)})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	jobs := make([]model.Job, 0, len(resp.GetJobs()))
+	for _, protoJob := range resp.GetJobs() {
+		jobs = append(jobs, *mapper.ProtoToModelJob(protoJob))
+	}
+
+	return jobs, nil
+}
+
+// CancelJob asks a job to stop via gRPC
+func (c *Client) CancelJob(id string) error {
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
+	defer cancel()
+
+	resp, err := c.service.CancelJob(ctx, &v1.CancelJobRequest{Id: id})
+	if err != nil {
+		return handleGRPCError(err)
+	}
+
+	if !resp.GetSuccess() {
+		return fmt.Errorf("operation failed")
+	}
+
+	return nil
+}
+
 // DockerProfileExists checks if a docker profile exists by name
 func (c *Client) DockerProfileExists(name string) (bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.DockerProfileExists(ctx, &v1.DockerProfileExistsRequest{
@@ -560,7 +1540,7 @@ func (c *Client) DockerProfileExists(name string) (bool, error) {
 
 // SaveWorkspace saves or updates a workspace via gRPC
 func (c *Client) SaveWorkspace(workspace *model.Workspace) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SaveWorkspace(ctx, &v1.SaveWorkspaceRequest{
@@ -579,7 +1559,7 @@ func (c *Client) SaveWorkspace(workspace *model.Workspace) error {
 
 // GetWorkspace retrieves a workspace by name
 func (c *Client) GetWorkspace(name string) (*model.Workspace, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetWorkspace(ctx, &v1.GetWorkspaceRequest{
@@ -594,7 +1574,7 @@ func (c *Client) GetWorkspace(name string) (*model.Workspace, error) {
 
 // GetActiveWorkspace retrieves the currently active workspace
 func (c *Client) GetActiveWorkspace() (*model.Workspace, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetActiveWorkspace(ctx, &v1.GetActiveWorkspaceRequest{})
@@ -607,7 +1587,7 @@ func (c *Client) GetActiveWorkspace() (*model.Workspace, error) {
 
 // SetActiveWorkspace sets the active workspace by name
 func (c *Client) SetActiveWorkspace(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.SetActiveWorkspace(ctx, &v1.SetActiveWorkspaceRequest{
@@ -626,7 +1606,7 @@ func (c *Client) SetActiveWorkspace(name string) error {
 
 // ListWorkspaces retrieves all workspaces
 func (c *Client) ListWorkspaces() ([]model.Workspace, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.ListWorkspaces(ctx, &v1.ListWorkspacesRequest{})
@@ -644,7 +1624,7 @@ func (c *Client) ListWorkspaces() ([]model.Workspace, error) {
 
 // DeleteWorkspace removes a workspace by name
 func (c *Client) DeleteWorkspace(name string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.DeleteWorkspace(ctx, &v1.DeleteWorkspaceRequest{
@@ -663,7 +1643,7 @@ func (c *Client) DeleteWorkspace(name string) error {
 
 // WorkspaceExists checks if a workspace exists by name
 func (c *Client) WorkspaceExists(name string) (bool, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.WorkspaceExists(ctx, &v1.WorkspaceExistsRequest{
@@ -678,7 +1658,7 @@ func (c *Client) WorkspaceExists(name string) (bool, error) {
 
 // GetReposByWorkspace retrieves all repository URLs in a workspace
 func (c *Client) GetReposByWorkspace(workspace string) ([]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.GetReposByWorkspace(ctx, &v1.GetReposByWorkspaceRequest{
@@ -693,7 +1673,7 @@ func (c *Client) GetReposByWorkspace(workspace string) ([]string, error) {
 
 // UpdateRepoWorkspace updates the workspace for a repository
 func (c *Client) UpdateRepoWorkspace(urlStr string, workspace string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	ctx, cancel := context.WithTimeout(baseContext(), c.timeout)
 	defer cancel()
 
 	resp, err := c.service.UpdateRepoWorkspace(ctx, &v1.UpdateRepoWorkspaceRequest{
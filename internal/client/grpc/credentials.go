@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/application"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Environment variables configuring authentication to a remote server
+const (
+	// TokenEnv holds an API token created by 'clonr server token create'
+	TokenEnv = "CLONR_TOKEN"
+	// TLSCAEnv names the CA bundle the server certificate is verified against
+	TLSCAEnv = "CLONR_TLS_CA"
+	// TLSCertEnv and TLSKeyEnv name the client certificate used for mTLS
+	TLSCertEnv = "CLONR_TLS_CERT"
+	TLSKeyEnv  = "CLONR_TLS_KEY"
+)
+
+// ErrTokenWithoutTLS is returned when an API token would be sent in plaintext
+var ErrTokenWithoutTLS = errors.New("an API token requires TLS: set the CA of the server certificate (" + TLSCAEnv + ")")
+
+// transportSettings are the TLS files and API token used to reach a server
+type transportSettings struct {
+	CA    string
+	Cert  string
+	Key   string
+	Token string
+}
+
+// envTransport returns the transport settings of the environment, trusting
+// the certificate of a local server serving TLS
+func envTransport() transportSettings {
+	t := transportSettings{
+		CA:    os.Getenv(TLSCAEnv),
+		Cert:  os.Getenv(TLSCertEnv),
+		Key:   os.Getenv(TLSKeyEnv),
+		Token: os.Getenv(TokenEnv),
+	}
+
+	if t.CA == "" {
+		t.CA = localServerCert()
+	}
+
+	return t
+}
+
+// dialOptions returns the transport credentials, and the API token when one
+// is set, used to connect to the server at addr
+func dialOptions(addr string) ([]grpc.DialOption, error) {
+	return dialOptionsWith(addr, envTransport())
+}
+
+// dialOptionsWith returns the dial options of addr for transport settings
+func dialOptionsWith(addr string, t transportSettings) ([]grpc.DialOption, error) {
+	tlsConfig, err := clientTLSConfig(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if tlsConfig == nil {
+		// Local clients are trusted by the server, so the token is not needed
+		if t.Token != "" && !isLoopbackAddress(addr) {
+			return nil, ErrTokenWithoutTLS
+		}
+
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+	if t.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(t.Token)))
+	}
+
+	return opts, nil
+}
+
+// clientTLSConfig builds the TLS configuration of transport settings. It
+// returns nil when the server is reached in plaintext.
+func clientTLSConfig(t transportSettings) (*tls.Config, error) {
+	if t.CA == "" && t.Cert == "" {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if t.CA != "" {
+		pem, err := os.ReadFile(t.CA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read server CA: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", t.CA)
+		}
+
+		cfg.RootCAs = pool
+	}
+
+	if t.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// localServerCert returns the TLS certificate of the server recorded in the
+// server info file, or "" when it serves plaintext
+func localServerCert() string {
+	dataDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	data, err := os.ReadFile(filepath.Join(dataDir, application.AppName, "server.json"))
+	if err != nil {
+		return ""
+	}
+
+	var info ServerInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return ""
+	}
+
+	return info.TLSCertFile
+}
+
+// isLoopbackAddress reports whether addr (host:port or a unix socket) is on
+// this machine
+func isLoopbackAddress(addr string) bool {
+	if strings.HasPrefix(addr, socketScheme) {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// bearerToken sends an API token with every call
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity keeps the token from being sent in plaintext
+func (t bearerToken) RequireTransportSecurity() bool {
+	return true
+}
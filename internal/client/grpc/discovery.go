@@ -3,17 +3,19 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/inovacc/clonr/internal/application"
 	"github.com/inovacc/clonr/internal/process"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -29,6 +31,8 @@ var procs = process.NewProcess()
 type ClientConfig struct {
 	ServerAddress  string `json:"server_address"`
 	TimeoutSeconds int    `json:"timeout_seconds"`
+	// Servers are named servers selected with --server or CLONR_SERVER_PROFILE
+	Servers map[string]ServerProfile `json:"servers,omitempty"`
 }
 
 // ServerInfo contains information about a running server (matches grpc.ServerInfo)
@@ -37,19 +41,55 @@ type ServerInfo struct {
 	Port      int       `json:"port"`
 	PID       int       `json:"pid"`
 	StartedAt time.Time `json:"started_at"`
+	// TLSCertFile is the certificate of a server serving TLS
+	TLSCertFile string `json:"tls_cert_file,omitempty"`
+	// Socket is the unix socket the server also listens on
+	Socket string `json:"socket,omitempty"`
+}
+
+// Server address sources reported by discoverServer
+const (
+	ServerSourceEnv        = "CLONR_SERVER"
+	ServerSourceInfoFile   = "server.json"
+	ServerSourceSocket     = "unix socket"
+	ServerSourceProbe      = "port probe"
+	ServerSourceClientFile = "client.json"
+	ServerSourceDefault    = "default"
+	ServerSourceOnDemand   = "started on demand"
+	ServerSourceProfile    = "server profile"
+)
+
+// socketFile is the unix socket of the server, next to server.json
+const socketFile = "clonr.sock"
+
+// socketScheme prefixes gRPC targets of unix sockets
+const socketScheme = "unix:"
+
+// socketTarget returns the gRPC target of the unix socket at path
+func socketTarget(path string) string {
+	return socketScheme + path
 }
 
 // discoverServerAddress determines the server address to connect to
+func discoverServerAddress() string {
+	addr, _ := discoverServer()
+
+	return addr
+}
+
+// discoverServer determines the server address to connect to and where it
+// came from.
 // Priority:
 // 1. CLONR_SERVER environment variable (if set, use it directly)
-// 2. ~/.config/clonr/server.json (written by running server)
-// 3. Probe common ports for a running server (50051-50055)
+// 2. ~/.config/clonr/server.json (written by running server), preferring
+// its unix socket
+// 3. The default unix socket, then common ports (50051-50055)
 // 4. ~/.config/clonr/client.json config file
 // 5. Default: localhost:50051
-func discoverServerAddress() string {
+func discoverServer() (string, string) {
 	// 1. Check environment variable - if explicitly set, trust it
 	if addr := os.Getenv("CLONR_SERVER"); addr != "" {
-		return addr
+		return addr, ServerSourceEnv
 	}
 
 	// 2. Check the server info file (written by server when it starts)
@@ -63,8 +103,12 @@ func discoverServerAddress() string {
 				// First check if the PID is a running clonr process (fast, no network)
 				if isClonrProcessRunning(info.PID) {
 					// Process exists, verify it's responding via gRPC
+					if info.Socket != "" && isServerRunning(socketTarget(info.Socket)) {
+						return socketTarget(info.Socket), ServerSourceInfoFile
+					}
+
 					if isServerRunning(info.Address) {
-						return info.Address
+						return info.Address, ServerSourceInfoFile
 					}
 				}
 				// Server info exists but server not running - clean up stale file
@@ -73,12 +117,19 @@ func discoverServerAddress() string {
 		}
 	}
 
-	// 3. Probe common ports to find a running server
+	// 3. Probe the default unix socket, then common ports to find a running server
+	if dataDir != "" {
+		target := socketTarget(filepath.Join(dataDir, application.AppName, socketFile))
+		if isServerRunning(target) {
+			return target, ServerSourceSocket
+		}
+	}
+
 	commonPorts := []int{50051, 50052, 50053, 50054, 50055}
 	for _, port := range commonPorts {
 		addr := fmt.Sprintf("localhost:%d", port)
 		if isServerRunning(addr) {
-			return addr
+			return addr, ServerSourceProbe
 		}
 	}
 
@@ -91,14 +142,14 @@ func discoverServerAddress() string {
 			if err := json.Unmarshal(data, &cfg); err == nil && cfg.ServerAddress != "" {
 				// Verify the configured server is actually running
 				if isServerRunning(cfg.ServerAddress) {
-					return cfg.ServerAddress
+					return cfg.ServerAddress, ServerSourceClientFile
 				}
 			}
 		}
 	}
 
 	// 5. Default fallback
-	return "localhost:50051"
+	return "localhost:50051", ServerSourceDefault
 }
 
 // there isClonrProcessRunning checks if a clonr server with the given PID is running.
@@ -118,8 +169,13 @@ func isClonrProcessRunning(pid int) bool {
 
 // there isServerRunning checks if a gRPC server is running at the given address
 func isServerRunning(address string) bool {
-	// First, a quick TCP port check
-	conn, err := net.DialTimeout("tcp", address, 500*time.Millisecond)
+	// First, a quick check that the port or socket accepts connections
+	network, dialAddr := "tcp", address
+	if path, ok := strings.CutPrefix(address, socketScheme); ok {
+		network, dialAddr = "unix", path
+	}
+
+	conn, err := net.DialTimeout(network, dialAddr, 500*time.Millisecond)
 	if err != nil {
 		return false
 	}
@@ -127,9 +183,12 @@ func isServerRunning(address string) bool {
 	_ = conn.Close()
 
 	// Port is open, now verify it's actually a healthy gRPC server using health check (per guide)
-	grpcConn, err := grpc.NewClient(address,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	opts, err := dialOptions(address)
+	if err != nil {
+		return false
+	}
+
+	grpcConn, err := grpc.NewClient(address, opts...)
 	if err != nil {
 		return false
 	}
@@ -152,6 +211,35 @@ func isServerRunning(address string) bool {
 	return resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
 }
 
+// ErrServerNotRunning is returned when no server is running and the user
+// declined to start one
+var ErrServerNotRunning = errors.New("no clonr server is running: start one with 'clonr server start' or pass --auto-start")
+
+// startPrompt decides whether to start a server when none is running
+var startPrompt atomic.Pointer[func() bool]
+
+// SetStartPrompt sets the function asked whether to start a server in the
+// background when none is running. Without one, a server is started
+// without asking.
+func SetStartPrompt(prompt func() bool) {
+	startPrompt.Store(&prompt)
+}
+
+// confirmStartServer returns nil when a local server may be started
+// because none is running at addr
+func confirmStartServer(addr, source string) error {
+	// A server configured explicitly on another machine cannot be started here
+	if source == ServerSourceEnv && !isLoopbackAddress(addr) {
+		return fmt.Errorf("server %s (%s) is not reachable", addr, ServerSourceEnv)
+	}
+
+	if prompt := startPrompt.Load(); prompt != nil && *prompt != nil && !(*prompt)() {
+		return ErrServerNotRunning
+	}
+
+	return nil
+}
+
 // startOnDemandServer spawns a detached clonr server process
 func startOnDemandServer(port int) error {
 	exePath, err := os.Executable()
@@ -195,30 +283,15 @@ func waitForServer(address string) error {
 
 // SaveServerAddress saves the server address to the config file
 func SaveServerAddress(address string) error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	configDir := filepath.Join(homeDir, ".config", application.AppName)
-	if err := os.MkdirAll(configDir, 0755); err != nil {
-		return fmt.Errorf("failed to create config directory: %w", err)
-	}
-
-	cfg := ClientConfig{
-		ServerAddress:  address,
-		TimeoutSeconds: 30,
-	}
-
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	cfg, err := loadClientConfig()
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return err
 	}
 
-	configPath := filepath.Join(configDir, "client.json")
-	if err := os.WriteFile(configPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write config file: %w", err)
+	cfg.ServerAddress = address
+	if cfg.TimeoutSeconds == 0 {
+		cfg.TimeoutSeconds = 30
 	}
 
-	return nil
+	return saveClientConfig(cfg)
 }
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -247,6 +248,7 @@ func TestIsServerRunning_InvalidAddress(t *testing.T) {
 		{"empty address", "", false},
 		{"unreachable port", "localhost:65000", false},
 		{"invalid host", "nonexistent.invalid.host:50051", false},
+		{"missing socket", socketTarget(filepath.Join(t.TempDir(), "clonr.sock")), false},
 	}
 
 	for _, tt := range tests {
@@ -257,3 +259,23 @@ func TestIsServerRunning_InvalidAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestConfirmStartServer(t *testing.T) {
+	t.Cleanup(func() { SetStartPrompt(nil) })
+
+	if err := confirmStartServer("clonr.example.com:50051", ServerSourceEnv); err == nil {
+		t.Error("confirmStartServer() allowed starting a server for a remote CLONR_SERVER")
+	}
+
+	SetStartPrompt(func() bool { return false })
+
+	if err := confirmStartServer("localhost:50051", ServerSourceDefault); !errors.Is(err, ErrServerNotRunning) {
+		t.Errorf("confirmStartServer() error = %v when declined, want %v", err, ErrServerNotRunning)
+	}
+
+	SetStartPrompt(func() bool { return true })
+
+	if err := confirmStartServer("localhost:50051", ServerSourceEnv); err != nil {
+		t.Errorf("confirmStartServer() error = %v when accepted", err)
+	}
+}
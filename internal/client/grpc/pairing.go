@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/standalone"
+	"google.golang.org/grpc"
+)
+
+// pairTimeout bounds the pairing request to a remote server
+const pairTimeout = 15 * time.Second
+
+// PairDevice redeems a pairing invite with the standalone server it points
+// to and registers this machine with its client encryption key. It returns
+// the server's instance ID and fingerprint, which callers must compare with
+// the one in the invite, and the port of its sync service.
+func PairDevice(invite *standalone.PairingInvite, reg *standalone.ClientRegistration, displayKey string) (instanceID, fingerprint string, syncPort int, err error) {
+	opts, err := dialOptions(invite.Address())
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	conn, err := grpc.NewClient(invite.Address(), opts...)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), pairTimeout)
+	defer cancel()
+
+	resp, err := v1.NewClonrServiceClient(conn).PairDevice(ctx, &v1.PairDeviceRequest{
+		Token:        invite.Token,
+		ClientId:     reg.ClientID,
+		ClientName:   reg.ClientName,
+		DisplayKey:   displayKey,
+		Hostname:     reg.MachineInfo.Hostname,
+		Os:           reg.MachineInfo.OS,
+		Arch:         reg.MachineInfo.Arch,
+		ClonrVersion: reg.MachineInfo.ClonrVersion,
+	})
+	if err != nil {
+		return "", "", 0, handleGRPCError(err)
+	}
+
+	return resp.GetInstanceId(), resp.GetFingerprint(), int(resp.GetSyncPort()), nil
+}
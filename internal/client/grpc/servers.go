@@ -0,0 +1,214 @@
+package grpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/application"
+	"google.golang.org/grpc"
+)
+
+// ServerProfileEnv selects a server profile when --server is not given
+const ServerProfileEnv = "CLONR_SERVER_PROFILE"
+
+// ErrServerProfileNotFound is returned for an unknown server profile
+var ErrServerProfileNotFound = errors.New("server profile not found")
+
+// ServerProfile is a named server the client can connect to, such as a
+// remote team server, with the credentials it requires
+type ServerProfile struct {
+	Address string `json:"address"`
+	TLSCA   string `json:"tls_ca,omitempty"`
+	TLSCert string `json:"tls_cert,omitempty"`
+	TLSKey  string `json:"tls_key,omitempty"`
+	Token   string `json:"token,omitempty"`
+}
+
+var (
+	selectedMu   sync.RWMutex
+	selectedName string
+)
+
+// SelectServer makes GetClient connect to the server profile called name;
+// an empty name selects CLONR_SERVER_PROFILE or the local server
+func SelectServer(name string) {
+	selectedMu.Lock()
+	defer selectedMu.Unlock()
+
+	selectedName = name
+}
+
+// selectedServer returns the name of the selected server profile, or "" for
+// the local server
+func selectedServer() string {
+	selectedMu.RLock()
+	defer selectedMu.RUnlock()
+
+	if selectedName != "" {
+		return selectedName
+	}
+
+	return os.Getenv(ServerProfileEnv)
+}
+
+// connectProfile connects to the server of a server profile. Remote servers
+// are never started on demand.
+func connectProfile(name string) (*Client, error) {
+	profile, err := GetServerProfile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credentials of the profile take precedence over the environment; the
+	// certificate of the local server is not trusted for remote servers
+	t := transportSettings{CA: profile.TLSCA, Cert: profile.TLSCert, Key: profile.TLSKey, Token: profile.Token}
+
+	if t.CA == "" && t.Cert == "" {
+		t.CA, t.Cert, t.Key = os.Getenv(TLSCAEnv), os.Getenv(TLSCertEnv), os.Getenv(TLSKeyEnv)
+	}
+
+	if t.Token == "" {
+		t.Token = os.Getenv(TokenEnv)
+	}
+
+	opts, err := dialOptionsWith(profile.Address, t)
+	if err != nil {
+		return nil, fmt.Errorf("server %q: %w", name, err)
+	}
+
+	conn, err := grpc.NewClient(profile.Address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	if !checkHealth(conn) {
+		_ = conn.Close()
+		return nil, fmt.Errorf("server %q at %s is not reachable", name, profile.Address)
+	}
+
+	return newClient(conn, profile.Address, ServerSourceProfile+" "+name), nil
+}
+
+// GetServerProfile returns the server profile called name
+func GetServerProfile(name string) (*ServerProfile, error) {
+	cfg, err := loadClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := cfg.Servers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (add it with 'clonr server remote add')", ErrServerProfileNotFound, name)
+	}
+
+	return &profile, nil
+}
+
+// ListServerProfiles returns the server profiles by name
+func ListServerProfiles() (map[string]ServerProfile, error) {
+	cfg, err := loadClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return cfg.Servers, nil
+}
+
+// SaveServerProfile adds or replaces the server profile called name
+func SaveServerProfile(name string, profile ServerProfile) error {
+	if name == "" || profile.Address == "" {
+		return errors.New("server profile name and address are required")
+	}
+
+	cfg, err := loadClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Servers == nil {
+		cfg.Servers = map[string]ServerProfile{}
+	}
+
+	cfg.Servers[name] = profile
+
+	return saveClientConfig(cfg)
+}
+
+// RemoveServerProfile deletes the server profile called name
+func RemoveServerProfile(name string) error {
+	cfg, err := loadClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := cfg.Servers[name]; !ok {
+		return fmt.Errorf("%w: %q", ErrServerProfileNotFound, name)
+	}
+
+	delete(cfg.Servers, name)
+
+	return saveClientConfig(cfg)
+}
+
+// clientConfigPath returns the path of client.json
+func clientConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, ".config", application.AppName, "client.json"), nil
+}
+
+// loadClientConfig reads client.json; a missing file is an empty config
+func loadClientConfig() (*ClientConfig, error) {
+	path, err := clientConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &ClientConfig{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client config: %w", err)
+	}
+
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse client config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// saveClientConfig writes client.json, readable only by the current user
+// because server profiles can hold API tokens
+func saveClientConfig(cfg *ClientConfig) error {
+	path, err := clientConfigPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
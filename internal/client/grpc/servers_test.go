@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestServerProfiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("USERPROFILE", os.Getenv("HOME"))
+
+	if err := SaveServerAddress("localhost:50052"); err != nil {
+		t.Fatalf("SaveServerAddress() error = %v", err)
+	}
+
+	team := ServerProfile{Address: "clonr.example.com:50051", TLSCA: "ca.pem", Token: "clonr_secret"}
+	if err := SaveServerProfile("team", team); err != nil {
+		t.Fatalf("SaveServerProfile() error = %v", err)
+	}
+
+	got, err := GetServerProfile("team")
+	if err != nil {
+		t.Fatalf("GetServerProfile() error = %v", err)
+	}
+
+	if *got != team {
+		t.Errorf("GetServerProfile() = %+v, want %+v", *got, team)
+	}
+
+	// Saving the local address keeps the server profiles
+	if err := SaveServerAddress("localhost:50053"); err != nil {
+		t.Fatalf("SaveServerAddress() error = %v", err)
+	}
+
+	if profiles, _ := ListServerProfiles(); len(profiles) != 1 {
+		t.Errorf("ListServerProfiles() = %v after SaveServerAddress(), want the team server", profiles)
+	}
+
+	if err := RemoveServerProfile("team"); err != nil {
+		t.Fatalf("RemoveServerProfile() error = %v", err)
+	}
+
+	if _, err := GetServerProfile("team"); !errors.Is(err, ErrServerProfileNotFound) {
+		t.Errorf("GetServerProfile() error = %v after removal, want %v", err, ErrServerProfileNotFound)
+	}
+
+	if err := RemoveServerProfile("team"); !errors.Is(err, ErrServerProfileNotFound) {
+		t.Errorf("RemoveServerProfile() error = %v for an unknown server, want %v", err, ErrServerProfileNotFound)
+	}
+}
+
+func TestSelectedServer(t *testing.T) {
+	t.Cleanup(func() { SelectServer("") })
+	t.Setenv(ServerProfileEnv, "team")
+
+	if got := selectedServer(); got != "team" {
+		t.Errorf("selectedServer() = %q, want %q from %s", got, "team", ServerProfileEnv)
+	}
+
+	SelectServer("lab")
+
+	if got := selectedServer(); got != "lab" {
+		t.Errorf("selectedServer() = %q, want %q from SelectServer", got, "lab")
+	}
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"google.golang.org/grpc"
+)
+
+// shutdownTimeout bounds the shutdown request; the server drains in-flight
+// requests after replying
+const shutdownTimeout = 5 * time.Second
+
+// RequestShutdown asks the server at addr to stop gracefully. Unlike a
+// signal it also works on Windows, where processes cannot be interrupted.
+// It returns once the server accepted the request, not once it stopped.
+func RequestShutdown(addr string) error {
+	opts, err := dialOptions(addr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if _, err := v1.NewClonrServiceClient(conn).Shutdown(ctx, &v1.Empty{}); err != nil {
+		return handleGRPCError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/mapper"
+	"github.com/inovacc/clonr/internal/standalone"
+	"google.golang.org/grpc"
+)
+
+// standaloneSyncTimeout bounds a sync with a standalone server
+const standaloneSyncTimeout = 5 * time.Minute
+
+// RegisterStandalone sends the encryption key of reg to the standalone
+// server of key. It reports whether the server approved the client at once
+// and the fingerprint it computed from the key, which callers compare with
+// their own; an unapproved client waits for 'clonr standalone clients approve'.
+func RegisterStandalone(key *standalone.StandaloneKey, reg *standalone.ClientRegistration, displayKey string) (approved bool, fingerprint string, err error) {
+	addr := net.JoinHostPort(key.Host, strconv.Itoa(key.Port))
+
+	opts, err := dialOptions(addr)
+	if err != nil {
+		return false, "", err
+	}
+
+	cc, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	defer func() { _ = cc.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), pairTimeout)
+	defer cancel()
+
+	resp, err := v1.NewStandaloneServiceClient(cc).Register(ctx, mapper.RegisterRequestFor(reg, key.APIKey, displayKey))
+	if err != nil {
+		return false, "", handleGRPCError(err)
+	}
+
+	if resp.GetError() != "" {
+		return false, "", fmt.Errorf("registration refused: %s", resp.GetError())
+	}
+
+	return resp.GetApproved(), resp.GetFingerprint(), nil
+}
+
+// SyncStandalone authenticates with the standalone server of conn using the
+// client key it registered with and fetches the changes since the revisions
+// conn acknowledged, limited to its filter. The returned package is applied
+// with standalone.ApplySyncPackage.
+func SyncStandalone(conn *standalone.StandaloneConnection, clientKey []byte) (*standalone.SyncPackage, error) {
+	addr := net.JoinHostPort(conn.Host, strconv.Itoa(conn.Port))
+
+	opts, err := dialOptions(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	defer func() { _ = cc.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), standaloneSyncTimeout)
+	defer cancel()
+
+	client := v1.NewStandaloneServiceClient(cc)
+
+	auth, err := client.Authenticate(ctx, &v1.AuthenticateRequest{
+		ClientId:  conn.ClientID,
+		ClientKey: clientKey,
+	})
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	pkg := &standalone.SyncPackage{
+		Version:    2,
+		InstanceID: conn.InstanceID,
+		CreatedAt:  time.Now(),
+		Revisions:  make(map[string]int64),
+	}
+
+	req := mapper.SyncRequestFor(conn, auth.GetSessionToken())
+
+	for _, bucket := range conn.SyncFilter.ItemTypes() {
+		items, err := syncStandaloneBucket(ctx, client, bucket, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sync %s: %w", bucket, err)
+		}
+
+		for _, item := range items {
+			pkg.Revisions[bucket] = max(pkg.Revisions[bucket], item.Revision)
+		}
+
+		pkg.Items = append(pkg.Items, items...)
+	}
+
+	return pkg, nil
+}
+
+// syncStandaloneBucket fetches the changed records of one bucket
+func syncStandaloneBucket(ctx context.Context, client v1.StandaloneServiceClient, bucket string, req *v1.SyncRequest) ([]standalone.SyncPackageItem, error) {
+	if bucket == standalone.CapabilityConfig {
+		data, err := client.SyncConfig(ctx, req)
+		if err != nil {
+			return nil, handleGRPCError(err)
+		}
+
+		// An empty message means the config did not change
+		if data.GetId() == "" {
+			return nil, nil
+		}
+
+		return []standalone.SyncPackageItem{mapper.ProtoToSyncPackageItem(data)}, nil
+	}
+
+	var (
+		stream grpc.ServerStreamingClient[v1.EncryptedData]
+		err    error
+	)
+
+	switch bucket {
+	case standalone.CapabilityProfiles:
+		stream, err = client.SyncProfiles(ctx, req)
+	case standalone.CapabilityWorkspaces:
+		stream, err = client.SyncWorkspaces(ctx, req)
+	case standalone.CapabilityRepos:
+		stream, err = client.SyncRepos(ctx, req)
+	default:
+		return nil, fmt.Errorf("unknown data type %q", bucket)
+	}
+
+	if err != nil {
+		return nil, handleGRPCError(err)
+	}
+
+	var items []standalone.SyncPackageItem
+
+	for {
+		data, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return items, nil
+			}
+
+			return nil, handleGRPCError(err)
+		}
+
+		items = append(items, mapper.ProtoToSyncPackageItem(data))
+	}
+}
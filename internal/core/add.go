@@ -14,11 +14,12 @@ import (
 // AddOptions holds optional parameters for adding a repo.
 type AddOptions struct {
 	Yes  bool   // skip confirmation (handled at CLI level)
-	Name string // reserved for future use
+	Name string // alias to track the repository under (optional)
 }
 
-// AddRepo validates the path is a git repo and registers it in the DB if not present.
-func AddRepo(path string, _ AddOptions) (string, error) {
+// AddRepo validates the path is a git repo and registers it in the DB if not
+// present, under the alias opts.Name when set.
+func AddRepo(path string, opts AddOptions) (string, error) {
 	if path == "" {
 		return "", errors.New("path is required")
 	}
@@ -53,15 +54,29 @@ func AddRepo(path string, _ AddOptions) (string, error) {
 		return "", fmt.Errorf("failed to connect to server: %w", err)
 	}
 
+	id := abs
+	if remote != nil {
+		id = remote.String()
+	}
+
+	// Refuse a taken alias before registering
+	if opts.Name != "" {
+		if opts.Name, err = checkRepoAlias(client, opts.Name, id); err != nil {
+			return "", err
+		}
+	}
+
 	if err := client.InsertRepoIfNotExists(remote, abs); err != nil {
 		return "", err
 	}
 
-	if remote != nil {
-		return remote.String(), nil
+	if opts.Name != "" {
+		if err := client.SetRepoAlias(id, opts.Name); err != nil {
+			return "", fmt.Errorf("added, but failed to set the alias: %w", err)
+		}
 	}
 
-	return abs, nil
+	return id, nil
 }
 
 // bytesTrimSpace is a tiny helper to avoid importing strings for a single use.
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/auth"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// CreateAPIToken creates an API token authenticating remote clients of the
+// gRPC server. It returns the token, which is not stored and cannot be shown
+// again. A ttl of zero creates a token that never expires.
+func CreateAPIToken(name string, ttl time.Duration) (string, *model.APIToken, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, fmt.Errorf("token name is required")
+	}
+
+	if ttl < 0 {
+		return "", nil, fmt.Errorf("expiry must not be negative")
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return "", nil, err
+	}
+
+	tokens, err := client.ListAPITokens()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if slices.ContainsFunc(tokens, func(t model.APIToken) bool { return t.Name == name }) {
+		return "", nil, fmt.Errorf("API token %q already exists; revoke it first", name)
+	}
+
+	secret, hash, err := auth.NewAPIToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := &model.APIToken{
+		Name:      name,
+		Hash:      hash,
+		CreatedAt: time.Now(),
+	}
+
+	if ttl > 0 {
+		token.ExpiresAt = token.CreatedAt.Add(ttl)
+	}
+
+	if err := client.SaveAPIToken(token); err != nil {
+		return "", nil, err
+	}
+
+	return secret, token, nil
+}
+
+// ListAPITokens returns the API tokens of the gRPC server sorted by name
+func ListAPITokens() ([]model.APIToken, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := client.ListAPITokens()
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(tokens, func(a, b model.APIToken) int { return strings.Compare(a.Name, b.Name) })
+
+	return tokens, nil
+}
+
+// RevokeAPIToken deletes the API token called name
+func RevokeAPIToken(name string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	tokens, err := client.ListAPITokens()
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(tokens, func(t model.APIToken) bool { return t.Name == name }) {
+		return fmt.Errorf("API token %q not found", name)
+	}
+
+	return client.DeleteAPIToken(name)
+}
@@ -0,0 +1,457 @@
+package core
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// backupFormat versions the archive layout
+const backupFormat = 1
+
+// BackupMetadata is stored as metadata.json next to the bundle in every
+// backup archive
+type BackupMetadata struct {
+	Format     int               `json:"format"`
+	Repository model.Repository  `json:"repository"`
+	Head       string            `json:"head"`
+	Branch     string            `json:"branch,omitempty"`
+	Remotes    map[string]string `json:"remotes,omitempty"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// BackupOptions selects the repositories to back up and where to
+type BackupOptions struct {
+	Repos     []string           // Repositories by URL, path or name
+	All       bool               // Every tracked repository
+	Workspace string             // With All, only this workspace
+	Config    model.BackupConfig // Destination and retention policy
+	DryRun    bool               // Report without writing or deleting
+	Progress  func(BackupResult) // Called after each repository, if set
+}
+
+// BackupResult is the outcome of backing up one repository
+type BackupResult struct {
+	URL    string   `json:"url"`
+	Path   string   `json:"path"`
+	Key    string   `json:"key,omitempty"`
+	Size   int64    `json:"size,omitempty"`
+	Pruned []string `json:"pruned,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// GetBackupConfig returns the configured backup destination and retention
+func GetBackupConfig() (model.BackupConfig, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return model.BackupConfig{}, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return model.BackupConfig{}, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return cfg.Backup, nil
+}
+
+// SaveBackupConfig stores the backup destination and retention policy
+func SaveBackupConfig(backup model.BackupConfig) error {
+	if backup.KeepLast < 0 || backup.KeepDays < 0 {
+		return fmt.Errorf("retention values cannot be negative")
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	cfg.Backup = backup
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// BackupRepos bundles each selected repository (all refs, via git bundle)
+// with its metadata into a tar.gz archive, stores it at the destination and
+// prunes that repository's backups outside the retention policy. Failures
+// are reported per repository.
+func BackupRepos(ctx context.Context, opts BackupOptions) ([]BackupResult, error) {
+	if opts.Config.KeepLast < 0 || opts.Config.KeepDays < 0 {
+		return nil, fmt.Errorf("retention values cannot be negative")
+	}
+
+	repos, err := selectBackupRepos(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := openBackupTarget(opts.Config.Dest)
+	if err != nil {
+		return nil, err
+	}
+
+	var job *TrackedJob
+
+	if !opts.DryRun {
+		job, ctx = TrackJob(ctx, "backup", "to "+BackupDestination(opts.Config.Dest), len(repos))
+	}
+
+	results := make([]BackupResult, 0, len(repos))
+
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			job.Finish(context.Cause(ctx))
+			return results, context.Cause(ctx)
+		}
+
+		res := backupRepo(ctx, target, repo, opts)
+		if opts.Progress != nil {
+			opts.Progress(res)
+		}
+
+		var err error
+		if res.Error != "" {
+			err = errors.New(res.Error)
+		}
+
+		job.Step(repo.Path, err)
+
+		results = append(results, res)
+	}
+
+	job.Finish(nil)
+
+	return results, nil
+}
+
+// selectBackupRepos resolves the repositories named in opts
+func selectBackupRepos(opts BackupOptions) ([]*model.Repository, error) {
+	if opts.All {
+		client, err := grpc.GetClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to server: %w", err)
+		}
+
+		repos, err := client.GetRepos(opts.Workspace, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+
+		selected := make([]*model.Repository, len(repos))
+		for i := range repos {
+			selected[i] = &repos[i]
+		}
+
+		return selected, nil
+	}
+
+	queries := opts.Repos
+	if len(queries) == 0 {
+		queries = []string{""}
+	}
+
+	selected := make([]*model.Repository, 0, len(queries))
+
+	for _, q := range queries {
+		repo, err := ResolveRepo(q)
+		if err != nil {
+			return nil, err
+		}
+
+		selected = append(selected, repo)
+	}
+
+	return selected, nil
+}
+
+// backupRepo writes, stores and prunes the backups of one repository
+func backupRepo(ctx context.Context, target backupTarget, repo *model.Repository, opts BackupOptions) BackupResult {
+	now := time.Now()
+	dir := backupDir(repo)
+	res := BackupResult{URL: repo.URL, Path: repo.Path, Key: dir + "/" + backupName(path.Base(dir), now)}
+
+	fail := func(err error) BackupResult {
+		res.Error = err.Error()
+		return res
+	}
+
+	existing, err := target.List(ctx, dir+"/")
+	if err != nil {
+		return fail(err)
+	}
+
+	// Backups of monorepo subdirectories live below the repository's own
+	existing = slices.DeleteFunc(existing, func(obj BackupObject) bool { return path.Dir(obj.Key) != dir })
+
+	if !opts.DryRun {
+		file, size, err := createBackupArchive(ctx, repo, now)
+		if err != nil {
+			return fail(err)
+		}
+
+		defer func() { _ = os.Remove(file) }()
+
+		if err := target.Put(ctx, res.Key, file); err != nil {
+			return fail(err)
+		}
+
+		res.Size = size
+	}
+
+	existing = append(existing, BackupObject{Key: res.Key, Time: now.UTC().Truncate(time.Second)})
+
+	for _, obj := range expiredBackups(existing, opts.Config, now) {
+		if !opts.DryRun {
+			if err := target.Delete(ctx, obj.Key); err != nil {
+				return fail(fmt.Errorf("backup stored but pruning failed: %w", err))
+			}
+		}
+
+		res.Pruned = append(res.Pruned, obj.Key)
+	}
+
+	return res
+}
+
+// backupDir returns the slash-separated directory holding the backups of a
+// repository: host/owner/name, plus the subdirectory for monorepo entries
+func backupDir(repo *model.Repository) string {
+	repoURL, subdir, _ := strings.Cut(repo.URL, "#")
+
+	dir := RepoKey(repoURL)
+	if dir == "" {
+		dir = "local/" + filepath.Base(repo.Path)
+	}
+
+	if subdir = strings.Trim(subdir, "/"); subdir != "" {
+		dir += "/" + subdir
+	}
+
+	// Colons and backslashes are not valid in every file system
+	return strings.NewReplacer(":", "_", `\`, "_").Replace(dir)
+}
+
+// createBackupArchive writes the backup archive of a repository to a
+// temporary file and returns its path and size
+func createBackupArchive(ctx context.Context, repo *model.Repository, now time.Time) (string, int64, error) {
+	f, err := os.CreateTemp("", "clonr-backup-*"+backupSuffix)
+	if err != nil {
+		return "", 0, err
+	}
+
+	err = writeBackupArchive(ctx, f, repo, now)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", 0, err
+	}
+
+	info, err := os.Stat(f.Name())
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", 0, err
+	}
+
+	return f.Name(), info.Size(), nil
+}
+
+// writeBackupArchive writes metadata.json and <name>.bundle as tar.gz to w
+func writeBackupArchive(ctx context.Context, w io.Writer, repo *model.Repository, now time.Time) error {
+	if err := validateGitRepo(repo.Path); err != nil {
+		return err
+	}
+
+	meta, err := readBackupMetadata(repo, now)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "clonr-bundle-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	bundle := filepath.Join(tmpDir, "repo.bundle")
+
+	cmd := exec.CommandContext(ctx, "git", "-C", repo.Path, "bundle", "create", "--quiet", bundle, "--all")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git bundle failed: %w - %s", err, strings.TrimSpace(string(out)))
+	}
+
+	metaJSON, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	if err := tw.WriteHeader(&tar.Header{Name: "metadata.json", Mode: 0o644, Size: int64(len(metaJSON)), ModTime: now}); err != nil {
+		return err
+	}
+
+	if _, err := tw.Write(metaJSON); err != nil {
+		return err
+	}
+
+	if err := addFileToTar(tw, bundle, path.Base(backupDir(repo))+".bundle", now); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+// addFileToTar copies a file into the archive under name
+func addFileToTar(tw *tar.Writer, file, name string, modTime time.Time) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: info.Size(), ModTime: modTime}); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tw, f)
+
+	return err
+}
+
+// readBackupMetadata records HEAD, the current branch and the remotes
+func readBackupMetadata(repo *model.Repository, now time.Time) (*BackupMetadata, error) {
+	head, err := runGitCommand("-C", repo.Path, "rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("repository has no commits: %s", repo.Path)
+	}
+
+	meta := &BackupMetadata{
+		Format:     backupFormat,
+		Repository: *repo,
+		Head:       strings.TrimSpace(head),
+		CreatedAt:  now.UTC(),
+	}
+
+	if branch, err := runGitCommand("-C", repo.Path, "symbolic-ref", "--short", "-q", "HEAD"); err == nil {
+		meta.Branch = strings.TrimSpace(branch)
+	}
+
+	// --get-regexp fails when no remote is configured
+	if out, err := runGitCommand("-C", repo.Path, "config", "--get-regexp", `^remote\..*\.url$`); err == nil {
+		for line := range strings.Lines(out) {
+			key, value, ok := strings.Cut(strings.TrimSpace(line), " ")
+			if !ok {
+				continue
+			}
+
+			if meta.Remotes == nil {
+				meta.Remotes = make(map[string]string)
+			}
+
+			meta.Remotes[strings.TrimSuffix(strings.TrimPrefix(key, "remote."), ".url")] = value
+		}
+	}
+
+	return meta, nil
+}
+
+// ListBackups returns the backups stored at dest, optionally only those of
+// one repository, oldest first
+func ListBackups(ctx context.Context, dest string, repo *model.Repository) ([]BackupObject, error) {
+	target, err := openBackupTarget(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := ""
+	if repo != nil {
+		prefix = backupDir(repo) + "/"
+	}
+
+	objects, err := target.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo != nil {
+		objects = slices.DeleteFunc(objects, func(obj BackupObject) bool { return path.Dir(obj.Key) != backupDir(repo) })
+	}
+
+	slices.SortFunc(objects, func(a, b BackupObject) int {
+		if c := strings.Compare(path.Dir(a.Key), path.Dir(b.Key)); c != 0 {
+			return c
+		}
+
+		return a.Time.Compare(b.Time)
+	})
+
+	return objects, nil
+}
+
+// DescribeRetention summarizes a retention policy
+func DescribeRetention(policy model.BackupConfig) string {
+	var parts []string
+
+	if policy.KeepLast > 0 {
+		parts = append(parts, fmt.Sprintf("keep last %d", policy.KeepLast))
+	}
+
+	if policy.KeepDays > 0 {
+		parts = append(parts, fmt.Sprintf("keep %d days", policy.KeepDays))
+	}
+
+	if len(parts) == 0 {
+		return "keep all"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// BackupDestination returns a display form of dest (the absolute path for
+// local directories)
+func BackupDestination(dest string) string {
+	target, err := openBackupTarget(dest)
+	if err != nil {
+		return dest
+	}
+
+	return target.String()
+}
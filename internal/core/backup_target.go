@@ -0,0 +1,591 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// backupSuffix is the extension of backup archives
+const backupSuffix = ".tar.gz"
+
+// backupTimeFormat is the UTC timestamp embedded in backup names
+const backupTimeFormat = "20060102T150405Z"
+
+var backupNameRe = regexp.MustCompile(`(\d{8}T\d{6}Z)\.tar\.gz$`)
+
+// BackupObject is a stored backup archive
+type BackupObject struct {
+	Key  string    `json:"key"` // Slash-separated path below the destination
+	Size int64     `json:"size"`
+	Time time.Time `json:"time"` // Creation time recorded in the name
+}
+
+// backupTarget stores backup archives under slash-separated keys
+type backupTarget interface {
+	Put(ctx context.Context, key, file string) error
+	Get(ctx context.Context, key string, w io.Writer) error
+	List(ctx context.Context, prefix string) ([]BackupObject, error)
+	Delete(ctx context.Context, key string) error
+	String() string
+}
+
+// backupName returns the archive name of a backup created at t
+func backupName(name string, t time.Time) string {
+	return name + "-" + t.UTC().Format(backupTimeFormat) + backupSuffix
+}
+
+// backupTime parses the creation time from an archive key. Keys that are
+// not backup archives report false.
+func backupTime(key string) (time.Time, bool) {
+	m := backupNameRe.FindStringSubmatch(key)
+	if m == nil {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(backupTimeFormat, m[1])
+
+	return t, err == nil
+}
+
+// expiredBackups returns the backups of one repository that fall outside
+// the retention policy. A backup is kept while it is one of the newest
+// KeepLast or younger than KeepDays; the newest backup is always kept.
+func expiredBackups(objects []BackupObject, policy model.BackupConfig, now time.Time) []BackupObject {
+	if policy.KeepLast <= 0 && policy.KeepDays <= 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(objects)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time.After(sorted[j].Time) })
+
+	maxAge := time.Duration(policy.KeepDays) * 24 * time.Hour
+
+	var expired []BackupObject
+
+	for i, obj := range sorted {
+		if i == 0 || i < policy.KeepLast || (policy.KeepDays > 0 && now.Sub(obj.Time) < maxAge) {
+			continue
+		}
+
+		expired = append(expired, obj)
+	}
+
+	return expired
+}
+
+// openBackupTarget returns the target for a destination: s3://bucket/prefix
+// for S3-compatible storage, anything else is a local directory
+func openBackupTarget(dest string) (backupTarget, error) {
+	if dest == "" {
+		return nil, fmt.Errorf("no backup destination (use --dest or 'clonr backup config --dest')")
+	}
+
+	if rest, ok := strings.CutPrefix(dest, "s3://"); ok {
+		bucket, prefix, _ := strings.Cut(rest, "/")
+		if bucket == "" {
+			return nil, fmt.Errorf("invalid S3 destination %q: missing bucket", dest)
+		}
+
+		return newS3Target(bucket, strings.Trim(prefix, "/"))
+	}
+
+	root, err := filepath.Abs(expandTilde(dest))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dirTarget{root: root}, nil
+}
+
+// expandTilde replaces a leading ~ with the home directory
+func expandTilde(p string) string {
+	if p != "~" && !strings.HasPrefix(p, "~/") {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.Join(home, p[1:])
+}
+
+// dirTarget stores backups in a local directory
+type dirTarget struct {
+	root string
+}
+
+func (d *dirTarget) String() string {
+	return d.root
+}
+
+func (d *dirTarget) Put(_ context.Context, key, file string) error {
+	dst := filepath.Join(d.root, filepath.FromSlash(key))
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	src, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = src.Close() }()
+
+	// Write next to the destination and rename, so a failed copy never
+	// leaves a truncated archive that retention would count
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".backup-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := io.Copy(tmp, src); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
+
+func (d *dirTarget) Get(_ context.Context, key string, w io.Writer) error {
+	f, err := os.Open(filepath.Join(d.root, filepath.FromSlash(key)))
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+func (d *dirTarget) List(_ context.Context, prefix string) ([]BackupObject, error) {
+	var objects []BackupObject
+
+	err := filepath.WalkDir(d.root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if p == d.root && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(d.root, p)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+
+		t, ok := backupTime(key)
+		if !ok || !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, BackupObject{Key: key, Size: info.Size(), Time: t})
+
+		return nil
+	})
+
+	return objects, err
+}
+
+func (d *dirTarget) Delete(_ context.Context, key string) error {
+	return os.Remove(filepath.Join(d.root, filepath.FromSlash(key)))
+}
+
+// s3Credentials are the AWS-style keys used to sign S3 requests
+type s3Credentials struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// s3Target stores backups in an S3-compatible bucket. Credentials, region
+// and endpoint come from the standard AWS environment variables; setting
+// AWS_ENDPOINT_URL (or AWS_ENDPOINT_URL_S3) targets MinIO, R2 and similar.
+type s3Target struct {
+	endpoint  *url.URL
+	bucket    string
+	prefix    string
+	region    string
+	pathStyle bool
+	creds     s3Credentials
+	client    *http.Client
+}
+
+func newS3Target(bucket, prefix string) (*s3Target, error) {
+	creds := s3Credentials{
+		AccessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return nil, fmt.Errorf("S3 backups need AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+
+	region := cmp.Or(os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION"), "us-east-1")
+
+	t := &s3Target{
+		bucket: bucket,
+		prefix: prefix,
+		region: region,
+		creds:  creds,
+		client: &http.Client{Timeout: TimeoutXLong},
+	}
+
+	if endpoint := cmp.Or(os.Getenv("AWS_ENDPOINT_URL_S3"), os.Getenv("AWS_ENDPOINT_URL")); endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("invalid S3 endpoint %q", endpoint)
+		}
+
+		t.endpoint = u
+		t.pathStyle = true
+	} else {
+		t.endpoint = &url.URL{Scheme: "https", Host: "s3." + region + ".amazonaws.com"}
+		// Dotted bucket names do not match the wildcard certificate
+		t.pathStyle = strings.Contains(bucket, ".")
+	}
+
+	return t, nil
+}
+
+func (s *s3Target) String() string {
+	return "s3://" + path.Join(s.bucket, s.prefix)
+}
+
+// objectKey prefixes a key with the destination prefix
+func (s *s3Target) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+
+	return s.prefix + "/" + key
+}
+
+// objectURL returns the URL of an object key ("" for the bucket itself)
+func (s *s3Target) objectURL(key string) *url.URL {
+	u := *s.endpoint
+
+	p := "/" + key
+	if s.pathStyle {
+		p = "/" + s.bucket + p
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+
+	u.Path = strings.TrimSuffix(s.endpoint.Path, "/") + p
+	u.RawPath = s3EscapePath(u.Path)
+
+	return &u
+}
+
+func (s *s3Target) Put(ctx context.Context, key, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(s.objectKey(key)).String(), f)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/gzip")
+
+	resp, err := s.do(req, hex.EncodeToString(h.Sum(nil)))
+	if err != nil {
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+
+	return resp.Body.Close()
+}
+
+func (s *s3Target) Get(ctx context.Context, key string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(s.objectKey(key)).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", key, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	_, err = io.Copy(w, resp.Body)
+
+	return err
+}
+
+// s3ListResult is the part of a ListObjectsV2 response clonr uses
+type s3ListResult struct {
+	Contents []struct {
+		Key  string `xml:"Key"`
+		Size int64  `xml:"Size"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Target) List(ctx context.Context, prefix string) ([]BackupObject, error) {
+	var (
+		objects []BackupObject
+		token   string
+	)
+
+	fullPrefix := s.objectKey(prefix)
+
+	for {
+		query := map[string]string{"list-type": "2", "prefix": fullPrefix}
+		if token != "" {
+			query["continuation-token"] = token
+		}
+
+		u := s.objectURL("")
+		u.RawQuery = s3CanonicalQuery(query)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.do(req, emptyPayloadHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list backups: %w", err)
+		}
+
+		var result s3ListResult
+
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bucket listing: %w", err)
+		}
+
+		for _, c := range result.Contents {
+			key := c.Key
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+
+			if t, ok := backupTime(key); ok {
+				objects = append(objects, BackupObject{Key: key, Size: c.Size, Time: t})
+			}
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *s3Target) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(s.objectKey(key)).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+
+	return resp.Body.Close()
+}
+
+// s3Error is the error document returned by S3
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// do signs and sends a request. Responses other than 2xx are returned as
+// errors carrying the S3 error code.
+func (s *s3Target) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	signS3Request(req, s.creds, s.region, payloadHash, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	var e s3Error
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&e); err == nil && e.Code != "" {
+		return nil, fmt.Errorf("%s: %s", e.Code, e.Message)
+	}
+
+	return nil, fmt.Errorf("unexpected status %s", resp.Status)
+}
+
+// emptyPayloadHash is the SHA-256 of an empty request body
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// signS3Request adds AWS Signature Version 4 headers to req. All headers
+// already set on req are signed along with host.
+func signS3Request(req *http.Request, creds s3Credentials, region, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	if creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+creds.SecretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		creds.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// s3Escape percent-encodes everything except unreserved characters, and
+// slashes when keepSlash is set, as Signature Version 4 requires
+func s3Escape(s string, keepSlash bool) string {
+	var b strings.Builder
+
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && keepSlash:
+			b.WriteByte(c)
+		default:
+			_, _ = fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func s3EscapePath(p string) string {
+	if p == "" {
+		return "/"
+	}
+
+	return s3Escape(p, true)
+}
+
+// s3CanonicalQuery encodes query parameters sorted by name
+func s3CanonicalQuery(params map[string]string) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = s3Escape(name, false) + "=" + s3Escape(params[name], false)
+	}
+
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// RepoBranch is the checked out branch of a tracked repository
+type RepoBranch struct {
+	URL           string `json:"url"`
+	Path          string `json:"path"`
+	Workspace     string `json:"workspace,omitempty"`
+	Branch        string `json:"branch"`
+	DefaultBranch string `json:"default_branch,omitempty"`
+	Detached      bool   `json:"detached,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// OnDefault reports whether the repository is on its default branch.
+// Repositories whose default branch is unknown count as on default.
+func (b *RepoBranch) OnDefault() bool {
+	return b.Error == "" && !b.Detached && (b.DefaultBranch == "" || b.Branch == b.DefaultBranch)
+}
+
+// BranchSwitchResult is the outcome of switching one repository to a branch
+type BranchSwitchResult struct {
+	URL      string `json:"url"`
+	Path     string `json:"path"`
+	Previous string `json:"previous,omitempty"`
+	Switched bool   `json:"switched"`
+	Skipped  string `json:"skipped,omitempty"` // Reason the repository was left alone
+	Error    string `json:"error,omitempty"`
+}
+
+// BranchOverview returns the current and default branch of every repository,
+// in the order of repos. Repositories are inspected concurrently.
+func BranchOverview(repos []model.Repository) []RepoBranch {
+	results := make([]RepoBranch, len(repos))
+
+	forEachRepo(repos, func(i int, repo model.Repository) {
+		results[i] = repoBranch(repo)
+	})
+
+	return results
+}
+
+// repoBranch inspects the current and default branch of one repository
+func repoBranch(repo model.Repository) RepoBranch {
+	result := RepoBranch{URL: repo.URL, Path: repo.Path, Workspace: repo.Workspace}
+
+	branch, err := GetCurrentBranch(repo.Path)
+	if err != nil {
+		result.Error = "not a git repository or path missing"
+		return result
+	}
+
+	if branch == "HEAD" {
+		result.Detached = true
+	}
+
+	result.Branch = branch
+	result.DefaultBranch = DefaultBranch(repo.Path)
+
+	return result
+}
+
+// DefaultBranch returns the default branch of a repository as advertised by
+// origin/HEAD, falling back to a local main or master branch. It returns an
+// empty string when the default branch cannot be determined.
+func DefaultBranch(repoPath string) string {
+	output, err := exec.Command("git", "-C", repoPath, "symbolic-ref", "--short", "-q", "refs/remotes/origin/HEAD").Output()
+	if err == nil {
+		if name := strings.TrimPrefix(strings.TrimSpace(string(output)), "origin/"); name != "" {
+			return name
+		}
+	}
+
+	for _, name := range []string{"main", "master"} {
+		if branchExists(repoPath, "refs/heads/"+name) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// branchExists reports whether ref resolves in the repository
+func branchExists(repoPath, ref string) bool {
+	return exec.Command("git", "-C", repoPath, "rev-parse", "--verify", "-q", ref).Run() == nil
+}
+
+// SwitchBranchInRepos checks out branch in every repository. Repositories
+// with uncommitted changes, already on the branch, or without a local or
+// origin branch of that name are skipped. Remote-only branches are checked
+// out as new local tracking branches.
+func SwitchBranchInRepos(repos []model.Repository, branch string) []BranchSwitchResult {
+	results := make([]BranchSwitchResult, len(repos))
+
+	forEachRepo(repos, func(i int, repo model.Repository) {
+		results[i] = switchBranch(repo, branch)
+	})
+
+	return results
+}
+
+// switchBranch checks out branch in one repository
+func switchBranch(repo model.Repository, branch string) BranchSwitchResult {
+	result := BranchSwitchResult{URL: repo.URL, Path: repo.Path}
+
+	current, err := GetCurrentBranch(repo.Path)
+	if err != nil {
+		result.Error = "not a git repository or path missing"
+		return result
+	}
+
+	result.Previous = current
+
+	if current == branch {
+		result.Skipped = "already on branch"
+		return result
+	}
+
+	status, err := exec.Command("git", "-C", repo.Path, "status", "--porcelain", "--untracked-files=no").Output()
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to get status: %v", err)
+		return result
+	}
+
+	if strings.TrimSpace(string(status)) != "" {
+		result.Skipped = "uncommitted changes"
+		return result
+	}
+
+	if !branchExists(repo.Path, "refs/heads/"+branch) && !branchExists(repo.Path, "refs/remotes/origin/"+branch) {
+		result.Skipped = "branch not found"
+		return result
+	}
+
+	// git checkout creates a tracking branch when only origin/<branch> exists
+	if err := CheckoutBranch(repo.Path, branch); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Switched = true
+
+	return result
+}
+
+// forEachRepo calls fn for every repository, one goroutine per CPU
+func forEachRepo(repos []model.Repository, fn func(int, model.Repository)) {
+	work := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Go(func() {
+			for idx := range work {
+				fn(idx, repos[idx])
+			}
+		})
+	}
+
+	for i := range repos {
+		work <- i
+	}
+
+	close(work)
+	wg.Wait()
+}
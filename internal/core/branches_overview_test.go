@@ -0,0 +1,82 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// initBranchTestRepo creates a repository on main with one commit and a develop branch
+func initBranchTestRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+		{"commit", "-q", "--allow-empty", "-m", "initial"},
+		{"branch", "develop"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Skipf("git not available: %v - %s", err, out)
+		}
+	}
+
+	return dir
+}
+
+func TestBranchOverviewAndSwitch(t *testing.T) {
+	clean := initBranchTestRepo(t)
+	dirty := initBranchTestRepo(t)
+
+	if err := os.WriteFile(filepath.Join(dirty, "file.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if out, err := exec.Command("git", "-C", dirty, "add", "file.txt").CombinedOutput(); err != nil {
+		t.Fatalf("git add: %v - %s", err, out)
+	}
+
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/clean", Path: clean},
+		{URL: "https://github.com/acme/dirty", Path: dirty},
+		{URL: "https://github.com/acme/missing", Path: filepath.Join(t.TempDir(), "missing")},
+	}
+
+	results := SwitchBranchInRepos(repos, "develop")
+
+	if !results[0].Switched || results[0].Previous != "main" {
+		t.Errorf("clean repo: got %+v, want switched from main", results[0])
+	}
+
+	if results[1].Switched || results[1].Skipped != "uncommitted changes" {
+		t.Errorf("dirty repo: got %+v, want skipped for uncommitted changes", results[1])
+	}
+
+	if results[2].Error == "" {
+		t.Errorf("missing repo: got %+v, want error", results[2])
+	}
+
+	if got := SwitchBranchInRepos(repos[:1], "nope")[0]; got.Skipped != "branch not found" {
+		t.Errorf("unknown branch: got %+v, want skipped as not found", got)
+	}
+
+	overview := BranchOverview(repos)
+
+	if overview[0].Branch != "develop" || overview[0].DefaultBranch != "main" || overview[0].OnDefault() {
+		t.Errorf("clean repo overview = %+v, want develop off default main", overview[0])
+	}
+
+	if !overview[1].OnDefault() {
+		t.Errorf("dirty repo overview = %+v, want on default", overview[1])
+	}
+
+	if overview[2].Error == "" || overview[2].OnDefault() {
+		t.Errorf("missing repo overview = %+v, want error", overview[2])
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
 	"github.com/inovacc/clonr/internal/giturl"
 )
 
@@ -19,6 +21,10 @@ type CloneOptions struct {
 	GitArgs   []string // Additional git clone arguments
 	Protocol  string   // Preferred protocol (https or ssh), empty for auto-detect
 	Workspace string   // Workspace to clone into (empty for active workspace or default)
+	Subdir    string   // Track only this subdirectory of a monorepo (sparse clone)
+	Alias     string   // Alias to track the repository under (optional)
+
+	RecurseSubmodules bool // Clone the submodules as well
 }
 
 // CloneResult contains the result of a clone operation
@@ -28,6 +34,8 @@ type CloneResult struct {
 	TargetPath string
 	GitArgs    []string
 	Workspace  string // Workspace the repo was cloned into
+	Subdir     string // Monorepo subdirectory checked out via sparse checkout
+	Alias      string // Alias set once the repository is saved
 }
 
 // PrepareClone parses clone arguments and prepares for cloning.
@@ -46,31 +54,34 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 		return nil, fmt.Errorf("repository argument required")
 	}
 
-	// Parse arguments: <repository> [<directory>] [<gitflags>...]
-	// After Cobra processing, "--" is stripped so we detect git flags by the "-" prefix
-	repoArg := args[0]
-	remaining := args[1:]
+	repoArg, targetDir, gitArgs := splitCloneArgs(args)
 
-	var (
-		gitArgs   []string
-		targetDir string
-	)
+	// Merge with options git args
+	gitArgs = append(opts.GitArgs, gitArgs...)
 
-	// Separate directory from git flags
-	// If an arg starts with "-", it and all following args are git flags
-	for i, arg := range remaining {
-		if strings.HasPrefix(arg, "-") {
-			gitArgs = remaining[i:]
-			break
-		}
+	subdir, err := cleanSubdir(opts.Subdir)
+	if err != nil {
+		return nil, err
+	}
 
-		if i == 0 {
-			targetDir = arg
-		}
+	if subdir != "" {
+		// Partial clone without checkout; the subdirectory is set up afterwards
+		gitArgs = append(gitArgs, "--filter=blob:none", "--sparse")
 	}
 
-	// Merge with options git args
-	gitArgs = append(opts.GitArgs, gitArgs...)
+	if opts.RecurseSubmodules {
+		gitArgs = append(gitArgs, "--recurse-submodules")
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	// Expand prefixes imported from git's url.<base>.insteadOf
+	if cfg, err := client.GetConfig(); err == nil {
+		repoArg = ExpandURLRewrites(repoArg, cfg.URLRewrites)
+	}
 
 	// Get the current GitHub user for shorthand resolution
 	currentUser := getGitHubUsername()
@@ -97,17 +108,15 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 
 	cloneURL := repo.CloneURL(protocol)
 
-	client, err := grpc.GetClient()
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to server: %w", err)
-	}
-
 	// Build canonical URL for database operations
 	canonicalURL, err := fixURL(repo.Host, repo.Owner, repo.Name)
 	if err != nil {
 		return nil, fmt.Errorf("error building canonical URL: %w", err)
 	}
 
+	// Subdirectory entries are tracked separately from the full repository
+	canonicalURL.Fragment = subdir
+
 	// Check for repo existence in a database
 	ok, err := client.RepoExistsByURL(canonicalURL)
 	if err != nil {
@@ -127,6 +136,28 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 		log.Printf("Removed existing repo from database: %s\n", repo.FullName())
 	}
 
+	// A repository tracked as another clone's remote (an upstream or mirror)
+	// is already on disk
+	if !ok && subdir == "" && !opts.Force {
+		tracked, err := client.GetRepoByRemoteURL(canonicalURL.String())
+		if err != nil {
+			return nil, fmt.Errorf("error checking for repo remotes: %w", err)
+		}
+
+		if tracked != nil {
+			return nil, fmt.Errorf("repository %s is already a remote of %s at %s\n\nUse --force to clone it anyway", repo.FullName(), tracked.URL, tracked.Path)
+		}
+	}
+
+	// Refuse a taken alias before cloning
+	var alias string
+
+	if opts.Alias != "" {
+		if alias, err = checkRepoAlias(client, opts.Alias, canonicalURL.String()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get config to determine default clone directory
 	cfg, err := client.GetConfig()
 	if err != nil {
@@ -135,6 +166,16 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 
 	// Determine workspace
 	workspace := opts.Workspace
+	if workspace == "" {
+		// Route by workspace URL patterns first
+		if workspaces, err := client.ListWorkspaces(); err == nil {
+			if match := MatchWorkspace(workspaces, strings.ToLower(repo.Host+"/"+repo.Owner+"/"+repo.Name)); match != nil {
+				workspace = match.Workspace.Name
+				log.Printf("Workspace '%s' matched by pattern '%s'\n", workspace, match.Pattern)
+			}
+		}
+	}
+
 	if workspace == "" {
 		// Try to get an active workspace
 		activeWorkspace, err := client.GetActiveWorkspace()
@@ -160,6 +201,8 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 	// Determine a target path
 	var savePath string
 
+	dirName := cloneDirName(repo.Name, subdir)
+
 	switch {
 	case targetDir == "":
 		// No target specified - use workspace path or default clone directory
@@ -168,7 +211,7 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 			baseDir = workspacePath
 		}
 
-		savePath = filepath.Join(baseDir, repo.Name)
+		savePath = filepath.Join(baseDir, dirName)
 	case filepath.IsAbs(targetDir):
 		// Absolute path - use directly
 		savePath = targetDir
@@ -179,7 +222,7 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 			return nil, fmt.Errorf("error getting current working directory: %w", err)
 		}
 
-		savePath = filepath.Join(wd, repo.Name)
+		savePath = filepath.Join(wd, dirName)
 	default:
 		// Relative path - resolve to absolute
 		absPath, err := filepath.Abs(targetDir)
@@ -218,9 +261,68 @@ func PrepareClone(args []string, opts CloneOptions) (*CloneResult, error) {
 		TargetPath: savePath,
 		GitArgs:    gitArgs,
 		Workspace:  workspace,
+		Subdir:     subdir,
+		Alias:      alias,
 	}, nil
 }
 
+// splitCloneArgs parses <repository> [<directory>] [<gitflags>...].
+// After Cobra processing, "--" is stripped so git flags are detected by the "-" prefix.
+func splitCloneArgs(args []string) (repoArg, targetDir string, gitArgs []string) {
+	if len(args) == 0 {
+		return "", "", nil
+	}
+
+	repoArg = args[0]
+	remaining := args[1:]
+
+	// If an arg starts with "-", it and all following args are git flags
+	for i, arg := range remaining {
+		if strings.HasPrefix(arg, "-") {
+			gitArgs = remaining[i:]
+			break
+		}
+
+		if i == 0 {
+			targetDir = arg
+		}
+	}
+
+	return repoArg, targetDir, gitArgs
+}
+
+// cloneDirName returns the directory a repository is cloned into under a workspace
+func cloneDirName(repoName, subdir string) string {
+	if subdir == "" {
+		return repoName
+	}
+
+	return repoName + "-" + path.Base(subdir)
+}
+
+// CloneDirName returns the directory name the clone arguments resolve to
+// inside a workspace, or "" if an explicit target directory was given and
+// the workspace does not affect the destination.
+func CloneDirName(args []string, subdir string) string {
+	repoArg, targetDir, _ := splitCloneArgs(args)
+	if repoArg == "" || targetDir != "" {
+		return ""
+	}
+
+	name := strings.TrimSuffix(path.Base(strings.TrimSuffix(repoArg, "/")), ".git")
+
+	// The owner is only needed to resolve bare names, which keep their name
+	if repo, err := giturl.ParseRepository(repoArg, "-"); err == nil {
+		name = repo.Name
+	}
+
+	if subdir, err := cleanSubdir(subdir); err == nil {
+		name = cloneDirName(name, subdir)
+	}
+
+	return name
+}
+
 // getGitHubUsername tries to get the current GitHub username from git config or gh CLI
 func getGitHubUsername() string {
 	// Try gh CLI first
@@ -284,6 +386,9 @@ func SaveClonedRepoWithWorkspace(uri *url.URL, savePath string, workspace string
 		})
 	}
 
+	// Record whether it is a fork, mirror, archive or template (non-blocking)
+	classifyClonedRepo(uri.String(), savePath, token)
+
 	// Gather and save git statistics using git-nerds (non-blocking)
 	_ = FetchAndSaveGitStats(uri.String(), savePath, FetchGitStatsOptions{
 		IncludeTemporal: true,
@@ -292,6 +397,10 @@ func SaveClonedRepoWithWorkspace(uri *url.URL, savePath string, workspace string
 
 	log.Printf("Cloned repo at %s\n", savePath)
 
+	if hint := submoduleCloneHint(savePath); hint != "" {
+		log.Println(hint)
+	}
+
 	return nil
 }
 
@@ -302,7 +411,24 @@ func SaveClonedRepoFromResult(result *CloneResult) error {
 		return fmt.Errorf("error building URL: %w", err)
 	}
 
-	return SaveClonedRepoWithWorkspace(uri, result.TargetPath, result.Workspace)
+	uri.Fragment = result.Subdir
+
+	// Set the matching profile's git identity (errors are logged but don't fail clone)
+	if err := ApplyCloneIdentity(result); err != nil {
+		log.Printf("Warning: could not apply git identity: %v\n", err)
+	}
+
+	if err := SaveClonedRepoWithWorkspace(uri, result.TargetPath, result.Workspace); err != nil {
+		return err
+	}
+
+	if result.Alias != "" {
+		if err := SetRepoAlias(uri.String(), result.Alias); err != nil {
+			return fmt.Errorf("cloned, but failed to set the alias: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // CloneRepo is the legacy function that clones and saves in one operation
@@ -328,15 +454,19 @@ func CloneRepoWithOptions(args []string, opts CloneOptions) error {
 	runCmd.Stdout = os.Stdout
 	runCmd.Stderr = os.Stderr
 
-	if err := runCmd.Run(); err != nil {
+	if err := Jobs().Do(BaseContext(), JobGit, runCmd.Run); err != nil {
 		return fmt.Errorf("git clone error: %w", err)
 	}
 
+	if err := ApplySparseCheckout(result); err != nil {
+		return err
+	}
+
 	return SaveClonedRepoFromResult(result)
 }
 
 func PullRepo(path string) error {
-	cmd := exec.Command("git", "-C", path, "pull")
+	cmd := exec.CommandContext(BaseContext(), "git", "-C", path, "pull")
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -346,6 +476,51 @@ func PullRepo(path string) error {
 	return nil
 }
 
+// ApplySparseCheckout restricts a monorepo clone to its tracked subdirectory.
+// It is a no-op for regular clones.
+func ApplySparseCheckout(result *CloneResult) error {
+	if result.Subdir == "" {
+		return nil
+	}
+
+	ctx, cancel := WithLongTimeout()
+	defer cancel()
+
+	if err := git.NewClientForRepo(result.TargetPath).SparseCheckoutSet(ctx, result.Subdir); err != nil {
+		return fmt.Errorf("error setting sparse checkout for %s: %w", result.Subdir, err)
+	}
+
+	return nil
+}
+
+// SubdirFromURL returns the monorepo subdirectory encoded in a tracked URL
+func SubdirFromURL(urlStr string) string {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return ""
+	}
+
+	return u.Fragment
+}
+
+// cleanSubdir normalizes a monorepo subdirectory to a slash-separated relative path
+func cleanSubdir(subdir string) (string, error) {
+	if subdir == "" {
+		return "", nil
+	}
+
+	cleaned := strings.Trim(path.Clean(filepath.ToSlash(subdir)), "/")
+	if cleaned == "." || cleaned == "" {
+		return "", nil
+	}
+
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid subdirectory: %s", subdir)
+	}
+
+	return cleaned, nil
+}
+
 func fixURL(host, owner, repo string) (*url.URL, error) {
 	return url.Parse(fmt.Sprintf("https://%s/%s/%s", host, owner, repo))
 }
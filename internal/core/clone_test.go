@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestCleanSubdir(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "", want: ""},
+		{input: ".", want: ""},
+		{input: "services/api", want: "services/api"},
+		{input: "/services/api/", want: "services/api"},
+		{input: "services//api/./", want: "services/api"},
+		{input: "../outside", wantErr: true},
+		{input: "..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := cleanSubdir(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cleanSubdir(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("cleanSubdir(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubdirFromURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/org/mono#services/api", "services/api"},
+		{"https://github.com/org/mono", ""},
+	}
+
+	for _, tt := range tests {
+		if got := SubdirFromURL(tt.url); got != tt.want {
+			t.Errorf("SubdirFromURL(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestCloneDirName(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		subdir string
+		want   string
+	}{
+		{name: "owner/repo", args: []string{"cli/cli"}, want: "cli"},
+		{name: "bare name", args: []string{"myrepo"}, want: "myrepo"},
+		{name: "https url", args: []string{"https://github.com/owner/repo.git"}, want: "repo"},
+		{name: "ssh url", args: []string{"git@github.com:owner/repo.git"}, want: "repo"},
+		{name: "git flags only", args: []string{"owner/repo", "--depth=1"}, want: "repo"},
+		{name: "subdir", args: []string{"org/monorepo"}, subdir: "services/api/", want: "monorepo-api"},
+		{name: "explicit directory", args: []string{"owner/repo", "somewhere"}, want: ""},
+		{name: "no args", args: nil, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CloneDirName(tt.args, tt.subdir); got != tt.want {
+				t.Errorf("CloneDirName(%v, %q) = %q, want %q", tt.args, tt.subdir, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package core
+
+import (
+	"fmt"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+)
+
+// ListColumn is a column of the repository list
+type ListColumn string
+
+const (
+	ColumnName        ListColumn = "name"
+	ColumnPath        ListColumn = "path"
+	ColumnWorkspace   ListColumn = "workspace"
+	ColumnFavorite    ListColumn = "fav"
+	ColumnKind        ListColumn = "kind"
+	ColumnTags        ListColumn = "tags"
+	ColumnUpdated     ListColumn = "updated"
+	ColumnAheadBehind ListColumn = "ahead-behind"
+	ColumnSize        ListColumn = "size"
+	ColumnCI          ListColumn = "ci"
+	ColumnStats       ListColumn = "stats"
+)
+
+// Sort keys computed from repository details
+const (
+	SortBySize   SortBy = "size"
+	SortByAhead  SortBy = "ahead"
+	SortByBehind SortBy = "behind"
+)
+
+// ListColumns are all columns of the repository list, in display order
+var ListColumns = []ListColumn{
+	ColumnName, ColumnPath, ColumnWorkspace, ColumnFavorite, ColumnKind, ColumnTags,
+	ColumnUpdated, ColumnAheadBehind, ColumnSize, ColumnCI, ColumnStats,
+}
+
+// DefaultListColumns are shown when no columns are configured
+var DefaultListColumns = []ListColumn{ColumnName, ColumnPath, ColumnWorkspace, ColumnFavorite}
+
+// ListSorts are the sort keys accepted by repository lists
+var ListSorts = []SortBy{
+	SortByName, SortByFrecency, SortByClonedAt, SortByUpdatedAt, SortByCommits, SortByRecentCommits,
+	SortByChanges, SortBySize, SortByAhead, SortByBehind,
+}
+
+// columnAliases maps alternative spellings to column names
+var columnAliases = map[string]ListColumn{
+	"favorite":    ColumnFavorite,
+	"favorites":   ColumnFavorite,
+	"tag":         ColumnTags,
+	"last-update": ColumnUpdated,
+	"sync":        ColumnAheadBehind,
+	"ahead":       ColumnAheadBehind,
+	"behind":      ColumnAheadBehind,
+	"disk":        ColumnSize,
+}
+
+// RepoDetails holds per-repository information computed for list columns
+type RepoDetails struct {
+	LatestTag   string `json:"latest_tag,omitempty"`
+	Ahead       int    `json:"ahead"`
+	Behind      int    `json:"behind"`
+	HasUpstream bool   `json:"has_upstream"`
+	// UpstreamBehind counts the commits of the "upstream" remote's default
+	// branch (of a fork) missing from HEAD
+	UpstreamBehind int    `json:"upstream_behind,omitempty"`
+	Size           int64  `json:"size,omitempty"`
+	CI             string `json:"ci,omitempty"` // success, failure, running, ... or empty if unknown
+}
+
+// ParseListColumns validates column names, accepting comma-separated values
+func ParseListColumns(values []string) ([]ListColumn, error) {
+	var columns []ListColumn
+
+	for _, v := range values {
+		for name := range strings.SplitSeq(v, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+
+			col := ListColumn(name)
+			if alias, ok := columnAliases[name]; ok {
+				col = alias
+			}
+
+			if !slices.Contains(ListColumns, col) {
+				return nil, fmt.Errorf("invalid column %q (valid: %s)", name, joinColumns(ListColumns))
+			}
+
+			if !slices.Contains(columns, col) {
+				columns = append(columns, col)
+			}
+		}
+	}
+
+	return columns, nil
+}
+
+// ParseListSort validates a sort key. An empty key sorts by name.
+func ParseListSort(value string) (SortBy, error) {
+	if value == "" {
+		return SortByName, nil
+	}
+
+	sortBy := SortBy(strings.ToLower(value))
+	if !slices.Contains(ListSorts, sortBy) {
+		names := make([]string, len(ListSorts))
+		for i, s := range ListSorts {
+			names[i] = string(s)
+		}
+
+		return "", fmt.Errorf("invalid sort %q (valid: %s)", value, strings.Join(names, ", "))
+	}
+
+	return sortBy, nil
+}
+
+func joinColumns(columns []ListColumn) string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = string(c)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// SortNeedsStats reports whether a sort key needs commit statistics
+func SortNeedsStats(sortBy SortBy) bool {
+	return sortBy == SortByCommits || sortBy == SortByRecentCommits || sortBy == SortByChanges
+}
+
+// SortNeedsDetails reports whether a sort key needs repository details
+func SortNeedsDetails(sortBy SortBy) bool {
+	return sortBy == SortBySize || sortBy == SortByAhead || sortBy == SortByBehind
+}
+
+// ColumnsNeedDetails reports whether any column is computed from repository details
+func ColumnsNeedDetails(columns []ListColumn) bool {
+	return slices.ContainsFunc(columns, func(c ListColumn) bool {
+		return c == ColumnTags || c == ColumnAheadBehind || c == ColumnSize || c == ColumnCI
+	})
+}
+
+// ListPreferences returns the configured list columns and sort key. Columns
+// are nil when none are configured; invalid values are ignored.
+func ListPreferences() ([]ListColumn, SortBy) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, ""
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return nil, ""
+	}
+
+	columns, err := ParseListColumns(cfg.ListColumns)
+	if err != nil {
+		columns = nil
+	}
+
+	sortBy, err := ParseListSort(cfg.ListSort)
+	if err != nil || cfg.ListSort == "" {
+		sortBy = ""
+	}
+
+	return columns, sortBy
+}
+
+// SaveListPreferences persists the list columns and sort key in the config
+func SaveListPreferences(columns []ListColumn, sortBy SortBy) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	cfg.ListColumns = make([]string, len(columns))
+	for i, c := range columns {
+		cfg.ListColumns[i] = string(c)
+	}
+
+	cfg.ListSort = string(sortBy)
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// LoadRepoDetails computes the details needed by columns and sortBy for each
+// repository, concurrently. The CI status is looked up on GitHub only when
+// the ci column is shown.
+func LoadRepoDetails(repos []RepoWithStats, columns []ListColumn, sortBy SortBy) {
+	if !ColumnsNeedDetails(columns) && !SortNeedsDetails(sortBy) {
+		return
+	}
+
+	withTag := slices.Contains(columns, ColumnTags)
+	withSync := slices.Contains(columns, ColumnAheadBehind) || sortBy == SortByAhead || sortBy == SortByBehind
+	withSize := slices.Contains(columns, ColumnSize) || sortBy == SortBySize
+
+	var token string
+	if slices.Contains(columns, ColumnCI) {
+		token, _, _ = ResolveGitHubToken("", "")
+	}
+
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(filterCheckWorkers, len(repos)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				details := &RepoDetails{}
+				path := repos[i].Path
+
+				if withTag {
+					details.LatestTag = latestTag(path)
+				}
+
+				if withSync {
+					details.Ahead, details.Behind, details.HasUpstream = aheadBehind(path)
+
+					if repos[i].UpstreamURL != "" || repos[i].RemoteURL(UpstreamRemote) != "" {
+						details.UpstreamBehind = upstreamBehind(path)
+					}
+				}
+
+				if withSize {
+					details.Size = dirSize(path)
+				}
+
+				if token != "" {
+					details.CI = ciStatus(token, repos[i].URL)
+				}
+
+				repos[i].Details = details
+			}
+		}()
+	}
+
+	for i := range repos {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+// latestTag returns the most recent tag reachable from HEAD
+func latestTag(repoPath string) string {
+	output, err := exec.Command("git", "-C", repoPath, "describe", "--tags", "--abbrev=0").Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(output))
+}
+
+// aheadBehind returns how many commits HEAD is ahead of and behind its upstream
+func aheadBehind(repoPath string) (ahead, behind int, ok bool) {
+	output, err := exec.Command("git", "-C", repoPath, "rev-list", "--left-right", "--count", "HEAD...@{upstream}").Output()
+	if err != nil {
+		return 0, 0, false
+	}
+
+	fields := strings.Fields(string(output))
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+
+	ahead, _ = strconv.Atoi(fields[0])
+	behind, _ = strconv.Atoi(fields[1])
+
+	return ahead, behind, true
+}
+
+// upstreamBehind returns how many commits of the default branch of the
+// "upstream" remote, as last fetched, HEAD is missing
+func upstreamBehind(repoPath string) int {
+	output, err := exec.Command("git", "-C", repoPath, "rev-list", "--count", "HEAD..refs/remotes/"+UpstreamRemote+"/HEAD").Output()
+	if err != nil {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(strings.TrimSpace(string(output)))
+
+	return n
+}
+
+// dirSize returns the total size of the files below path
+func dirSize(path string) int64 {
+	var size int64
+
+	_ = filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil //nolint:nilerr // skip unreadable entries
+		}
+
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+
+		return nil
+	})
+
+	return size
+}
+
+// ciStatus returns the outcome of the latest GitHub Actions run of a repository
+func ciStatus(token, repoURL string) string {
+	repo, err := giturl.ParseRepository(repoURL, "")
+	if err != nil || repo.Host != "github.com" {
+		return ""
+	}
+
+	runs, err := ListWorkflowRuns(token, repo.Owner, repo.Name, ListWorkflowRunsOptions{Limit: 1})
+	if err != nil || len(runs.Runs) == 0 {
+		return ""
+	}
+
+	run := runs.Runs[0]
+	if run.Status != "completed" {
+		return "running"
+	}
+
+	return run.Conclusion
+}
+
+// FormatAheadBehind formats the sync state with its upstream, e.g. "↑2 ↓1"
+func FormatAheadBehind(d *RepoDetails) string {
+	switch {
+	case d == nil || !d.HasUpstream:
+		return "-"
+	case d.Ahead == 0 && d.Behind == 0 && d.UpstreamBehind == 0:
+		return "✓"
+	case d.UpstreamBehind > 0:
+		return fmt.Sprintf("↑%d ↓%d ⇣%d", d.Ahead, d.Behind, d.UpstreamBehind)
+	default:
+		return fmt.Sprintf("↑%d ↓%d", d.Ahead, d.Behind)
+	}
+}
+
+// FormatSize formats a byte count, e.g. "12.3 MB"
+func FormatSize(bytes int64) string {
+	const unit = 1024
+
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestParseListColumns(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []string
+		want    []ListColumn
+		wantErr bool
+	}{
+		{name: "comma separated", in: []string{"name,workspace,size"}, want: []ListColumn{ColumnName, ColumnWorkspace, ColumnSize}},
+		{name: "repeated flags", in: []string{"name", "ci"}, want: []ListColumn{ColumnName, ColumnCI}},
+		{name: "aliases and duplicates", in: []string{"Name, sync, ahead, tag"}, want: []ListColumn{ColumnName, ColumnAheadBehind, ColumnTags}},
+		{name: "empty"},
+		{name: "invalid", in: []string{"name,bogus"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseListColumns(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseListColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseListColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseListSort(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    SortBy
+		wantErr bool
+	}{
+		{in: "", want: SortByName},
+		{in: "size", want: SortBySize},
+		{in: "Behind", want: SortByBehind},
+		{in: "stars", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseListSort(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseListSort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ParseListSort() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortReposByDetails(t *testing.T) {
+	repo := func(url string, d *RepoDetails) RepoWithStats {
+		return RepoWithStats{Repository: model.Repository{URL: url}, Details: d}
+	}
+
+	tests := []struct {
+		sortBy SortBy
+		want   []string
+	}{
+		{sortBy: SortBySize, want: []string{"b", "c", "a", "d"}},
+		{sortBy: SortByAhead, want: []string{"a", "c", "b", "d"}},
+		{sortBy: SortByBehind, want: []string{"c", "a", "b", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sortBy), func(t *testing.T) {
+			repos := []RepoWithStats{
+				repo("a", &RepoDetails{Size: 10, Ahead: 5, Behind: 1}),
+				repo("b", &RepoDetails{Size: 300, Ahead: 0}),
+				repo("c", &RepoDetails{Size: 20, Ahead: 2, Behind: 4}),
+				repo("d", nil),
+			}
+
+			SortRepos(repos, tt.sortBy)
+
+			got := make([]string, len(repos))
+			for i, r := range repos {
+				got[i] = r.URL
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SortRepos(%s) = %v, want %v", tt.sortBy, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAheadBehind(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *RepoDetails
+		want string
+	}{
+		{name: "unknown", want: "-"},
+		{name: "no upstream", in: &RepoDetails{}, want: "-"},
+		{name: "in sync", in: &RepoDetails{HasUpstream: true}, want: "✓"},
+		{name: "diverged", in: &RepoDetails{HasUpstream: true, Ahead: 2, Behind: 1}, want: "↑2 ↓1"},
+		{name: "fork behind upstream", in: &RepoDetails{HasUpstream: true, UpstreamBehind: 3}, want: "↑0 ↓0 ⇣3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatAheadBehind(tt.in); got != tt.want {
+				t.Errorf("FormatAheadBehind() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want string
+	}{
+		{in: 512, want: "512 B"},
+		{in: 1536, want: "1.5 KB"},
+		{in: 5 * 1024 * 1024, want: "5.0 MB"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatSize(tt.in); got != tt.want {
+			t.Errorf("FormatSize(%d) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package core
 
 import (
+	"cmp"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +27,8 @@ type RepoWithStats struct {
 	model.Repository
 
 	Stats *RepoStats `json:"stats,omitempty"`
+
+	Details *RepoDetails `json:"details,omitempty"`
 }
 
 // GetRepoStats returns commit statistics for a repository
@@ -152,6 +156,8 @@ func sortRepos(repos []RepoWithStats, sortBy SortBy) {
 	switch sortBy {
 	case SortByName:
 		sortByName(repos)
+	case SortByFrecency:
+		sortByFrecency(repos, time.Now())
 	case SortByClonedAt:
 		sortByCloned(repos)
 	case SortByUpdatedAt:
@@ -162,7 +168,36 @@ func sortRepos(repos []RepoWithStats, sortBy SortBy) {
 		sortByRecentCommits(repos)
 	case SortByChanges:
 		sortByChanges(repos)
+	case SortBySize, SortByAhead, SortByBehind:
+		sortByDetails(repos, sortBy)
+	}
+}
+
+// SortRepos sorts repositories by the given key, e.g. after loading their details
+func SortRepos(repos []RepoWithStats, sortBy SortBy) {
+	sortRepos(repos, sortBy)
+}
+
+// sortByDetails sorts by a details value, highest first
+func sortByDetails(repos []RepoWithStats, sortBy SortBy) {
+	value := func(r RepoWithStats) int64 {
+		if r.Details == nil {
+			return 0
+		}
+
+		switch sortBy {
+		case SortBySize:
+			return r.Details.Size
+		case SortByAhead:
+			return int64(r.Details.Ahead)
+		default:
+			return int64(r.Details.Behind)
+		}
 	}
+
+	slices.SortStableFunc(repos, func(a, b RepoWithStats) int {
+		return cmp.Compare(value(b), value(a))
+	})
 }
 
 func sortByName(repos []RepoWithStats) {
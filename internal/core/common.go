@@ -214,7 +214,7 @@ func hasSchemePrefix(s string) bool {
 
 // DetectRepository detects the GitHub owner/repo from various sources.
 // Priority:
-//  1. Explicit argument (owner/repo format)
+//  1. Explicit argument (owner/repo, a GitHub URL or a tracked repository name)
 //  2. --repo flag value
 //  3. The current directory's git config (remote origin)
 //
@@ -222,18 +222,33 @@ func hasSchemePrefix(s string) bool {
 func DetectRepository(arg, repoFlag string) (owner, repo string, err error) {
 	// 1. Check explicit argument first
 	if arg != "" {
-		return parseOwnerRepo(arg)
+		return parseRepoArg(arg)
 	}
 
 	// 2. Check --repo flag
 	if repoFlag != "" {
-		return parseOwnerRepo(repoFlag)
+		return parseRepoArg(repoFlag)
 	}
 
 	// 3. Try to detect from the current directory
 	return detectFromCurrentDir()
 }
 
+// parseRepoArg parses an "owner/repo" string, a full GitHub URL or the name
+// of a tracked repository
+func parseRepoArg(s string) (owner, repo string, err error) {
+	if strings.Contains(s, "/") {
+		return parseOwnerRepo(s)
+	}
+
+	tracked, err := ResolveRepo(strings.TrimSpace(s))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository %q: not owner/repo or a tracked repository: %w", s, err)
+	}
+
+	return parseGitHubURL(tracked.URL)
+}
+
 // parseOwnerRepo parses an "owner/repo" string or a full GitHub URL
 func parseOwnerRepo(s string) (owner, repo string, err error) {
 	s = strings.TrimSpace(s)
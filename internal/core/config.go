@@ -3,6 +3,8 @@ package core
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/inovacc/clonr/internal/model"
@@ -29,6 +31,48 @@ func ShowConfig() error {
 	_, _ = fmt.Fprintf(os.Stdout, "Server Port:             %d\n", cfg.ServerPort)
 	_, _ = fmt.Fprintf(os.Stdout, "Key Rotation:            %d days\n", model.ValidateKeyRotationDays(cfg.KeyRotationDays))
 
+	if len(cfg.ListColumns) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "List Columns:            %s\n", strings.Join(cfg.ListColumns, ", "))
+	}
+
+	if cfg.ListSort != "" {
+		_, _ = fmt.Fprintf(os.Stdout, "List Sort:               %s\n", cfg.ListSort)
+	}
+
+	if !cfg.Backup.IsZero() {
+		_, _ = fmt.Fprintf(os.Stdout, "Backup:                  %s (%s)\n", cfg.Backup.Dest, DescribeRetention(cfg.Backup))
+	}
+
+	limits := EffectiveConcurrency(cfg.Concurrency)
+	_, _ = fmt.Fprintf(os.Stdout, "Concurrency:             %d git operations, %d API calls\n", limits.GitOps, limits.APICalls)
+
+	if len(cfg.NotifyRoutes) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Notification Routes:     %s\n", FormatNotifyRoutes(cfg.NotifyRoutes))
+	}
+
+	if !cfg.ServerTLS.IsZero() {
+		_, _ = fmt.Fprintf(os.Stdout, "Server TLS:              %s\n", cfg.ServerTLS.CertFile)
+	}
+
+	if !cfg.KeyMap.IsZero() {
+		keys := EffectiveKeys(cfg.KeyMap)
+
+		bindings := make([]string, len(KeyActions))
+		for i, action := range KeyActions {
+			bindings[i] = string(action) + "=" + strings.Join(keys[action], ",")
+		}
+
+		_, _ = fmt.Fprintf(os.Stdout, "Key Bindings:            %s\n", strings.Join(bindings, " "))
+	}
+
+	if len(cfg.Ignore.Paths) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Ignored Paths:           %s\n", strings.Join(cfg.Ignore.Paths, ", "))
+	}
+
+	if len(cfg.Ignore.URLs) > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "Ignored URLs:            %s\n", strings.Join(cfg.Ignore.URLs, ", "))
+	}
+
 	return nil
 }
 
@@ -56,3 +100,41 @@ func ResetConfig() error {
 
 	return nil
 }
+
+// ConfigChange is a setting that differs between two configs
+type ConfigChange struct {
+	Setting string
+	From    string
+	To      string
+}
+
+// DiffConfig lists the settings of 'clonr configure' changed from one
+// config to the other
+func DiffConfig(from, to *model.Config) []ConfigChange {
+	settings := []struct {
+		name  string
+		value func(*model.Config) string
+	}{
+		{"Default Clone Directory", func(c *model.Config) string { return c.DefaultCloneDir }},
+		{"Editor", func(c *model.Config) string { return c.Editor }},
+		{"Terminal", func(c *model.Config) string { return c.Terminal }},
+		{"Monitor Interval", func(c *model.Config) string { return strconv.Itoa(c.MonitorInterval) }},
+		{"Server Port", func(c *model.Config) string { return strconv.Itoa(c.ServerPort) }},
+		{"Concurrent Git Operations", func(c *model.Config) string { return strconv.Itoa(EffectiveConcurrency(c.Concurrency).GitOps) }},
+		{"Concurrent API Calls", func(c *model.Config) string { return strconv.Itoa(EffectiveConcurrency(c.Concurrency).APICalls) }},
+		{"Notification Routes", func(c *model.Config) string { return FormatNotifyRoutes(c.NotifyRoutes) }},
+		{"Server TLS Certificate", func(c *model.Config) string { return c.ServerTLS.CertFile }},
+		{"Server TLS Key", func(c *model.Config) string { return c.ServerTLS.KeyFile }},
+		{"Server TLS Client CA", func(c *model.Config) string { return c.ServerTLS.ClientCAFile }},
+	}
+
+	var changes []ConfigChange
+
+	for _, s := range settings {
+		if before, after := s.value(from), s.value(to); before != after {
+			changes = append(changes, ConfigChange{Setting: s.name, From: before, To: after})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestNotifyRoutesRoundTrip(t *testing.T) {
+	routes, err := ParseNotifyRoutes(" ci-fail = slack, gmail ;push=; ")
+	if err != nil {
+		t.Fatalf("ParseNotifyRoutes() error = %v", err)
+	}
+
+	want := []model.NotifyRoute{
+		{Event: "ci-fail", Channels: []string{"slack", "gmail"}},
+		{Event: "push"},
+	}
+
+	if !slices.EqualFunc(routes, want, func(a, b model.NotifyRoute) bool {
+		return a.Event == b.Event && slices.Equal(a.Channels, b.Channels)
+	}) {
+		t.Fatalf("ParseNotifyRoutes() = %+v, want %+v", routes, want)
+	}
+
+	if got := FormatNotifyRoutes(routes); got != "ci-fail=slack,gmail; push=" {
+		t.Errorf("FormatNotifyRoutes() = %q", got)
+	}
+
+	for _, bad := range []string{"ci-fail", "=slack", "ci fail=slack", "push=slack; push=gmail"} {
+		if _, err := ParseNotifyRoutes(bad); err == nil {
+			t.Errorf("ParseNotifyRoutes(%q) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	from := &model.Config{DefaultCloneDir: "/src", Editor: "vim", MonitorInterval: 300, ServerPort: 4000}
+
+	if changes := DiffConfig(from, from); len(changes) != 0 {
+		t.Errorf("DiffConfig() of the same config = %+v, want none", changes)
+	}
+
+	to := *from
+	to.Editor = "code"
+	to.Concurrency.GitOps = DefaultMaxGitJobs // same as unset
+	to.ServerTLS.CertFile = "/etc/clonr/cert.pem"
+
+	want := []ConfigChange{
+		{Setting: "Editor", From: "vim", To: "code"},
+		{Setting: "Server TLS Certificate", To: "/etc/clonr/cert.pem"},
+	}
+
+	if changes := DiffConfig(from, &to); !slices.Equal(changes, want) {
+		t.Errorf("DiffConfig() = %+v, want %+v", changes, want)
+	}
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -13,34 +14,58 @@ const (
 	TimeoutXLong  = 10 * time.Minute // For very long operations like downloads
 )
 
+var (
+	baseCtxMu sync.RWMutex
+	baseCtx   = context.Background()
+)
+
+// SetBaseContext sets the parent of the contexts created by the helpers
+// below and of the git commands core runs, so that cancelling the running
+// command (Ctrl+C, --max-time) stops them.
+func SetBaseContext(ctx context.Context) {
+	baseCtxMu.Lock()
+	defer baseCtxMu.Unlock()
+
+	baseCtx = ctx
+}
+
+// BaseContext returns the context set by SetBaseContext, or
+// context.Background() when none was set
+func BaseContext() context.Context {
+	baseCtxMu.RLock()
+	defer baseCtxMu.RUnlock()
+
+	return baseCtx
+}
+
 // WithShortTimeout creates a context with a 30-second timeout.
 // Use for quick API calls like fetching single resources.
 func WithShortTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), TimeoutShort)
+	return context.WithTimeout(BaseContext(), TimeoutShort)
 }
 
 // WithMediumTimeout creates a context with a 2-minute timeout.
 // Use for standard operations like listing resources.
 func WithMediumTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), TimeoutMedium)
+	return context.WithTimeout(BaseContext(), TimeoutMedium)
 }
 
 // WithLongTimeout creates a context with a 5-minute timeout.
 // Use for longer operations like file uploads.
 func WithLongTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), TimeoutLong)
+	return context.WithTimeout(BaseContext(), TimeoutLong)
 }
 
 // WithXLongTimeout creates a context with a 10-minute timeout.
 // Use for very long operations like large file downloads.
 func WithXLongTimeout() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), TimeoutXLong)
+	return context.WithTimeout(BaseContext(), TimeoutXLong)
 }
 
 // WithTimeout creates a context with a custom timeout duration.
 // Prefer the predefined timeout functions when applicable.
 func WithTimeout(d time.Duration) (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), d)
+	return context.WithTimeout(BaseContext(), d)
 }
 
 // WithTimeoutFrom creates a context with timeout derived from parent context.
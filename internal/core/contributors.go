@@ -0,0 +1,175 @@
+package core
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// ContributorTotals aggregates the commits of one author across repositories
+type ContributorTotals struct {
+	Name         string    `json:"name"`
+	Email        string    `json:"email"`
+	Commits      int       `json:"commits"`
+	LinesAdded   int       `json:"lines_added"`
+	LinesDeleted int       `json:"lines_deleted"`
+	Repos        int       `json:"repos"`
+	FirstCommit  time.Time `json:"first_commit"`
+	LastCommit   time.Time `json:"last_commit"`
+}
+
+// LinesChanged returns the lines added and deleted by the author
+func (c *ContributorTotals) LinesChanged() int {
+	return c.LinesAdded + c.LinesDeleted
+}
+
+// ContributorLeaderboardOptions configures BuildContributorLeaderboard
+type ContributorLeaderboardOptions struct {
+	Since time.Time // Only commits since (default: the whole history)
+	Limit int       // Keep the top contributors only (default: all)
+}
+
+// ContributorLeaderboard ranks authors across repositories
+type ContributorLeaderboard struct {
+	Repos        int                 `json:"repos"`
+	Contributors []ContributorTotals `json:"contributors"`
+	Skipped      []string            `json:"skipped,omitempty"` // Repositories whose history could not be read
+}
+
+// BuildContributorLeaderboard aggregates the non-merge commits reachable
+// from HEAD of each repository per author, identified by email after
+// .mailmap is applied, and ranks them by commits, then lines changed.
+func BuildContributorLeaderboard(repos []model.Repository, opts ContributorLeaderboardOptions) *ContributorLeaderboard {
+	board := &ContributorLeaderboard{}
+	byEmail := make(map[string]*ContributorTotals)
+
+	for _, repo := range repos {
+		args := []string{"-C", repo.Path, "log", "--no-merges", "--numstat", "--format=%x00%aN%x00%aE%x00%at"}
+		if !opts.Since.IsZero() {
+			args = append(args, "--since="+opts.Since.Format(time.RFC3339))
+		}
+
+		output, err := runGitCommand(args...)
+		if err != nil {
+			board.Skipped = append(board.Skipped, repo.Path)
+			continue
+		}
+
+		board.Repos++
+
+		for _, c := range parseContributorLog(output) {
+			key := strings.ToLower(c.Email)
+
+			total, ok := byEmail[key]
+			if !ok {
+				total = &ContributorTotals{Name: c.Name, Email: c.Email, FirstCommit: c.FirstCommit}
+				byEmail[key] = total
+			}
+
+			// The name of the most recent commit wins
+			if c.LastCommit.After(total.LastCommit) {
+				total.Name = c.Name
+				total.LastCommit = c.LastCommit
+			}
+
+			if c.FirstCommit.Before(total.FirstCommit) {
+				total.FirstCommit = c.FirstCommit
+			}
+
+			total.Commits += c.Commits
+			total.LinesAdded += c.LinesAdded
+			total.LinesDeleted += c.LinesDeleted
+			total.Repos += c.Repos
+		}
+	}
+
+	board.Contributors = make([]ContributorTotals, 0, len(byEmail))
+	for _, total := range byEmail {
+		board.Contributors = append(board.Contributors, *total)
+	}
+
+	sort.Slice(board.Contributors, func(i, j int) bool {
+		a, b := board.Contributors[i], board.Contributors[j]
+
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+
+		if a.LinesChanged() != b.LinesChanged() {
+			return a.LinesChanged() > b.LinesChanged()
+		}
+
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+
+	if opts.Limit > 0 && len(board.Contributors) > opts.Limit {
+		board.Contributors = board.Contributors[:opts.Limit]
+	}
+
+	return board
+}
+
+// parseContributorLog aggregates the output of 'git log --numstat' with
+// "%x00%aN%x00%aE%x00%at" headers per author email. Binary files count no
+// lines. The totals are those of a single repository.
+func parseContributorLog(output string) []ContributorTotals {
+	var (
+		order   []string
+		byEmail = make(map[string]*ContributorTotals)
+		current *ContributorTotals
+	)
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if header, ok := strings.CutPrefix(line, "\x00"); ok {
+			fields := strings.Split(header, "\x00")
+			if len(fields) != 3 {
+				current = nil
+				continue
+			}
+
+			unix, _ := strconv.ParseInt(fields[2], 10, 64)
+			at := time.Unix(unix, 0)
+			key := strings.ToLower(fields[1])
+
+			current = byEmail[key]
+			if current == nil {
+				current = &ContributorTotals{Name: fields[0], Email: fields[1], Repos: 1, FirstCommit: at, LastCommit: at}
+				byEmail[key] = current
+				order = append(order, key)
+			}
+
+			current.Commits++
+
+			if at.Before(current.FirstCommit) {
+				current.FirstCommit = at
+			}
+
+			if at.After(current.LastCommit) {
+				current.Name = fields[0]
+				current.LastCommit = at
+			}
+
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if current == nil || len(fields) != 3 {
+			continue
+		}
+
+		added, _ := strconv.Atoi(fields[0])
+		deleted, _ := strconv.Atoi(fields[1])
+		current.LinesAdded += added
+		current.LinesDeleted += deleted
+	}
+
+	contributors := make([]ContributorTotals, len(order))
+	for i, key := range order {
+		contributors[i] = *byEmail[key]
+	}
+
+	return contributors
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestParseContributorLog(t *testing.T) {
+	output := "\x00Alice\x00alice@example.com\x001700000200\n\n3\t1\tmain.go\n-\t-\tlogo.png\n" +
+		"\x00Bob\x00bob@example.com\x001700000100\n\n10\t0\tREADME.md\n" +
+		"\x00Alice Old\x00Alice@Example.com\x001700000000\n\n1\t1\tmain.go\n"
+
+	got := parseContributorLog(output)
+	if len(got) != 2 {
+		t.Fatalf("parseContributorLog() = %d contributors, want 2", len(got))
+	}
+
+	alice := got[0]
+	if alice.Name != "Alice" || alice.Commits != 2 || alice.LinesAdded != 4 || alice.LinesDeleted != 2 || alice.Repos != 1 {
+		t.Errorf("alice = %+v", alice)
+	}
+
+	if alice.FirstCommit.Unix() != 1700000000 || alice.LastCommit.Unix() != 1700000200 {
+		t.Errorf("alice commits span %v - %v", alice.FirstCommit, alice.LastCommit)
+	}
+
+	if bob := got[1]; bob.Commits != 1 || bob.LinesChanged() != 10 {
+		t.Errorf("bob = %+v", bob)
+	}
+}
+
+func TestBuildContributorLeaderboard(t *testing.T) {
+	upstream, _, fork := initForkTestRepos(t)
+
+	for i, dir := range []string{upstream, fork} {
+		if err := os.WriteFile(filepath.Join(dir, "file.txt"), []byte("a\nb\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		forkTestGit(t, dir, "add", "file.txt")
+		forkTestGit(t, dir, "commit", "-q", "-m", "add file")
+
+		if i == 1 {
+			forkTestGit(t, dir, "-c", "user.name=Other", "-c", "user.email=other@example.com", "commit", "-q", "--allow-empty", "-m", "other")
+		}
+	}
+
+	board := BuildContributorLeaderboard([]model.Repository{{Path: upstream}, {Path: fork}, {Path: t.TempDir()}}, ContributorLeaderboardOptions{})
+
+	if board.Repos != 2 || len(board.Skipped) != 1 || len(board.Contributors) != 2 {
+		t.Fatalf("board = %+v", board)
+	}
+
+	// The fork shares the initial commit of the upstream
+	top := board.Contributors[0]
+	if top.Email != "test@example.com" || top.Commits != 4 || top.LinesAdded != 4 || top.Repos != 2 {
+		t.Errorf("top contributor = %+v", top)
+	}
+
+	if limited := BuildContributorLeaderboard([]model.Repository{{Path: fork}}, ContributorLeaderboardOptions{Limit: 1}); len(limited.Contributors) != 1 {
+		t.Errorf("limit 1: %d contributors", len(limited.Contributors))
+	}
+}
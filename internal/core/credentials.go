@@ -0,0 +1,297 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+// ChannelExpiresAtKey is the notify channel config key holding the credential
+// expiration time in RFC 3339 format.
+const ChannelExpiresAtKey = "expires_at"
+
+// ExpiryWarningDays is how many days before expiry a credential is reported as expiring.
+const ExpiryWarningDays = 14
+
+const credentialRemindersFile = "credential_reminders.json"
+
+// reminderThresholds are the days-to-expiry at which a reminder is sent, largest first
+var reminderThresholds = []int{14, 7, 3, 1, 0}
+
+// CredentialState describes how close a credential is to expiring
+type CredentialState string
+
+const (
+	CredentialOK       CredentialState = "ok"
+	CredentialExpiring CredentialState = "expiring"
+	CredentialExpired  CredentialState = "expired"
+	CredentialNoExpiry CredentialState = "no expiry"
+)
+
+// CredentialStatus is the expiry status of a single stored credential
+type CredentialStatus struct {
+	Profile   string          `json:"profile"`
+	Type      string          `json:"type"`
+	Name      string          `json:"name"`
+	ExpiresAt time.Time       `json:"expires_at,omitzero"`
+	DaysLeft  int             `json:"days_left"`
+	State     CredentialState `json:"state"`
+}
+
+// Key identifies the credential across runs
+func (c CredentialStatus) Key() string {
+	return c.Profile + "/" + c.Type + "/" + c.Name
+}
+
+// credentialReminder records the last reminder sent for a credential
+type credentialReminder struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Threshold int       `json:"threshold"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// CredentialStatuses returns the expiry status of the GitHub token and every
+// notification channel credential stored in the given profiles.
+func CredentialStatuses(profiles []model.Profile, now time.Time) []CredentialStatus {
+	var statuses []CredentialStatus
+
+	for _, p := range profiles {
+		name := p.User
+		if name == "" {
+			name = p.Host
+		}
+
+		statuses = append(statuses, newCredentialStatus(p.Name, "github", name, p.TokenExpiresAt, now))
+
+		for _, ch := range p.NotifyChannels {
+			statuses = append(statuses, newCredentialStatus(p.Name, string(ch.Type), ch.ID, channelExpiresAt(ch), now))
+		}
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		a, b := statuses[i], statuses[j]
+		if a.ExpiresAt.IsZero() != b.ExpiresAt.IsZero() {
+			return !a.ExpiresAt.IsZero()
+		}
+
+		return a.ExpiresAt.Before(b.ExpiresAt)
+	})
+
+	return statuses
+}
+
+func newCredentialStatus(profile, credType, name string, expiresAt, now time.Time) CredentialStatus {
+	status := CredentialStatus{
+		Profile:   profile,
+		Type:      credType,
+		Name:      name,
+		ExpiresAt: expiresAt,
+		State:     CredentialNoExpiry,
+	}
+
+	if expiresAt.IsZero() {
+		return status
+	}
+
+	status.DaysLeft = daysUntil(expiresAt, now)
+
+	switch {
+	case !expiresAt.After(now):
+		status.State = CredentialExpired
+	case status.DaysLeft <= ExpiryWarningDays:
+		status.State = CredentialExpiring
+	default:
+		status.State = CredentialOK
+	}
+
+	return status
+}
+
+// daysUntil returns whole days from now until t (negative once t has passed)
+func daysUntil(t, now time.Time) int {
+	d := t.Sub(now)
+	if d < 0 {
+		return -int((-d).Hours()/24) - 1
+	}
+
+	return int(d.Hours() / 24)
+}
+
+// channelExpiresAt returns the expiry stored in a notify channel config
+func channelExpiresAt(ch model.NotifyChannel) time.Time {
+	value := ch.Config[ChannelExpiresAtKey]
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// ListCredentialStatus returns the expiry status of all stored credentials
+func ListCredentialStatus() ([]CredentialStatus, error) {
+	pm, err := NewProfileManager()
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := pm.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	return CredentialStatuses(profiles, time.Now()), nil
+}
+
+// RefreshTokenExpiry queries GitHub for the profile token's expiration and stores it.
+func (pm *ProfileManager) RefreshTokenExpiry(ctx context.Context, name string) (time.Time, error) {
+	profile, err := pm.GetProfile(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	token, err := pm.getTokenFromProfile(profile)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	info, err := InspectToken(ctx, token, profile.Host)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if info == nil {
+		return time.Time{}, fmt.Errorf("token for profile %q is invalid or expired", name)
+	}
+
+	if !info.ExpiresAt.Equal(profile.TokenExpiresAt) {
+		profile.TokenExpiresAt = info.ExpiresAt
+
+		if err := pm.UpdateProfile(profile); err != nil {
+			return time.Time{}, fmt.Errorf("failed to save profile: %w", err)
+		}
+	}
+
+	return info.ExpiresAt, nil
+}
+
+// reminderThreshold returns the reminder threshold a credential has reached,
+// or -1 if it is not yet due for a reminder.
+func reminderThreshold(daysLeft int) int {
+	threshold := -1
+
+	for _, t := range reminderThresholds {
+		if daysLeft <= t {
+			threshold = t
+		}
+	}
+
+	return threshold
+}
+
+// dueCredentialReminders returns credentials that crossed a reminder threshold
+// since the last reminder and records them in sent.
+func dueCredentialReminders(statuses []CredentialStatus, sent map[string]credentialReminder, now time.Time) []CredentialStatus {
+	var due []CredentialStatus
+
+	for _, s := range statuses {
+		if s.State != CredentialExpiring && s.State != CredentialExpired {
+			continue
+		}
+
+		threshold := reminderThreshold(s.DaysLeft)
+		if threshold < 0 {
+			continue
+		}
+
+		last, ok := sent[s.Key()]
+		if ok && last.ExpiresAt.Equal(s.ExpiresAt) && last.Threshold <= threshold {
+			continue
+		}
+
+		sent[s.Key()] = credentialReminder{ExpiresAt: s.ExpiresAt, Threshold: threshold, SentAt: now}
+		due = append(due, s)
+	}
+
+	return due
+}
+
+// SendCredentialReminders notifies about credentials that are expiring or expired.
+// Each credential is reminded once per threshold (14, 7, 3, 1 days and at expiry).
+// With dryRun, due reminders are returned without sending or recording them.
+func SendCredentialReminders(ctx context.Context, profiles []model.Profile, dispatcher *notify.Dispatcher, dryRun bool) ([]CredentialStatus, error) {
+	path := filepath.Join(params.AppdataDir, credentialRemindersFile)
+
+	loaded, err := encoding.LoadJSON[map[string]credentialReminder](path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reminder state: %w", err)
+	}
+
+	sent := make(map[string]credentialReminder)
+	if loaded != nil && *loaded != nil {
+		sent = *loaded
+	}
+
+	now := time.Now()
+	due := dueCredentialReminders(CredentialStatuses(profiles, now), sent, now)
+
+	if dryRun || len(due) == 0 {
+		return due, nil
+	}
+
+	for _, s := range due {
+		dispatcher.Dispatch(ctx, credentialExpiryEvent(s))
+	}
+
+	if err := encoding.SaveJSON(path, sent); err != nil {
+		return due, fmt.Errorf("failed to save reminder state: %w", err)
+	}
+
+	return due, nil
+}
+
+// credentialExpiryEvent builds the notification for an expiring credential
+func credentialExpiryEvent(s CredentialStatus) *notify.Event {
+	event := notify.NewEvent(notify.EventCredentialExpiry).
+		WithProfile(s.Profile).
+		WithExtra("type", s.Type).
+		WithExtra("name", s.Name).
+		WithExtra("expires_at", s.ExpiresAt.UTC().Format(time.RFC3339)).
+		WithExtra("days_left", strconv.Itoa(s.DaysLeft))
+
+	if s.State == CredentialExpired {
+		event.WithError(fmt.Sprintf("%s credential %q has expired", s.Type, s.Name))
+	}
+
+	return event
+}
+
+// NewReminderDispatcher returns a synchronous dispatcher for the configured
+// notification channels, or nil if none are configured.
+func NewReminderDispatcher() (*notify.Dispatcher, error) {
+	router, err := NewNotifier()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(router.Channels()) == 0 {
+		return nil, nil
+	}
+
+	dispatcher := notify.NewDispatcher(false)
+	dispatcher.Register(router)
+
+	return dispatcher, nil
+}
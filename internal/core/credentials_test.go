@@ -0,0 +1,122 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestCredentialStatuses(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	profiles := []model.Profile{
+		{Name: "work", User: "alice", TokenExpiresAt: now.Add(100 * 24 * time.Hour)},
+		{
+			Name: "personal",
+			User: "bob",
+			NotifyChannels: []model.NotifyChannel{
+				{ID: "slack-1", Type: model.ChannelSlack, Config: map[string]string{ChannelExpiresAtKey: now.Add(3 * 24 * time.Hour).Format(time.RFC3339)}},
+				{ID: "slack-2", Type: model.ChannelSlack, Config: map[string]string{ChannelExpiresAtKey: now.Add(-time.Hour).Format(time.RFC3339)}},
+				{ID: "slack-3", Type: model.ChannelSlack, Config: map[string]string{ChannelExpiresAtKey: "garbage"}},
+			},
+		},
+	}
+
+	got := CredentialStatuses(profiles, now)
+
+	want := []struct {
+		name     string
+		state    CredentialState
+		daysLeft int
+	}{
+		{name: "slack-2", state: CredentialExpired, daysLeft: -1},
+		{name: "slack-1", state: CredentialExpiring, daysLeft: 3},
+		{name: "alice", state: CredentialOK, daysLeft: 100},
+		{name: "bob", state: CredentialNoExpiry},
+		{name: "slack-3", state: CredentialNoExpiry},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("CredentialStatuses() returned %d statuses, want %d", len(got), len(want))
+	}
+
+	for i, w := range want {
+		if got[i].Name != w.name || got[i].State != w.state || got[i].DaysLeft != w.daysLeft {
+			t.Errorf("status[%d] = {%s %s %d}, want {%s %s %d}",
+				i, got[i].Name, got[i].State, got[i].DaysLeft, w.name, w.state, w.daysLeft)
+		}
+	}
+}
+
+func TestReminderThreshold(t *testing.T) {
+	tests := []struct {
+		daysLeft int
+		want     int
+	}{
+		{daysLeft: 30, want: -1},
+		{daysLeft: 14, want: 14},
+		{daysLeft: 10, want: 14},
+		{daysLeft: 7, want: 7},
+		{daysLeft: 2, want: 3},
+		{daysLeft: 1, want: 1},
+		{daysLeft: 0, want: 0},
+		{daysLeft: -5, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := reminderThreshold(tt.daysLeft); got != tt.want {
+			t.Errorf("reminderThreshold(%d) = %d, want %d", tt.daysLeft, got, tt.want)
+		}
+	}
+}
+
+func TestDueCredentialReminders(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	expires := now.Add(5 * 24 * time.Hour)
+	status := CredentialStatus{Profile: "work", Type: "github", Name: "alice", ExpiresAt: expires, DaysLeft: 5, State: CredentialExpiring}
+
+	tests := []struct {
+		name    string
+		status  CredentialStatus
+		sent    map[string]credentialReminder
+		wantDue bool
+	}{
+		{name: "first reminder", status: status, sent: map[string]credentialReminder{}, wantDue: true},
+		{
+			name:   "already reminded at threshold",
+			status: status,
+			sent:   map[string]credentialReminder{status.Key(): {ExpiresAt: expires, Threshold: 7}},
+		},
+		{
+			name:    "crossed next threshold",
+			status:  status,
+			sent:    map[string]credentialReminder{status.Key(): {ExpiresAt: expires, Threshold: 14}},
+			wantDue: true,
+		},
+		{
+			name:    "token renewed",
+			status:  status,
+			sent:    map[string]credentialReminder{status.Key(): {ExpiresAt: now, Threshold: 0}},
+			wantDue: true,
+		},
+		{
+			name:   "not expiring",
+			status: CredentialStatus{Profile: "work", Type: "github", Name: "alice", State: CredentialNoExpiry},
+			sent:   map[string]credentialReminder{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			due := dueCredentialReminders([]CredentialStatus{tt.status}, tt.sent, now)
+			if got := len(due) == 1; got != tt.wantDue {
+				t.Errorf("due = %v, want %v", got, tt.wantDue)
+			}
+
+			if tt.wantDue && tt.sent[tt.status.Key()].Threshold != 7 {
+				t.Errorf("recorded threshold = %d, want 7", tt.sent[tt.status.Key()].Threshold)
+			}
+		})
+	}
+}
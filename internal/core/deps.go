@@ -0,0 +1,506 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Dependency ecosystems reported by InventoryDependencies
+const (
+	EcosystemGo       = "go"
+	EcosystemNPM      = "npm"
+	EcosystemPyPI     = "pypi"
+	EcosystemCargo    = "cargo"
+	EcosystemComposer = "composer"
+	EcosystemRubyGems = "rubygems"
+)
+
+// Dependency change kinds reported by DiffDependencies
+const (
+	DependencyAdded   = "added"
+	DependencyRemoved = "removed"
+	DependencyChanged = "changed"
+)
+
+// dependencySkipDirs are directories holding vendored or generated code,
+// whose manifests are not the repository's own
+var dependencySkipDirs = map[string]bool{
+	"node_modules": true, "vendor": true, "target": true, "venv": true,
+	"__pycache__": true, "testdata": true, "dist": true, "build": true,
+}
+
+// manifestParser extracts the dependencies of a manifest file
+type manifestParser struct {
+	ecosystem string
+	match     func(name string) bool
+	parse     func(data []byte) ([]model.Dependency, error)
+}
+
+var manifestParsers = []manifestParser{
+	{EcosystemGo, exactName("go.mod"), parseGoMod},
+	{EcosystemNPM, exactName("package.json"), parsePackageJSON},
+	{EcosystemPyPI, isRequirementsFile, parseRequirements},
+	{EcosystemCargo, exactName("Cargo.toml"), parseCargoToml},
+	{EcosystemComposer, exactName("composer.json"), parseComposerJSON},
+	{EcosystemRubyGems, exactName("Gemfile"), parseGemfile},
+}
+
+func exactName(want string) func(string) bool {
+	return func(name string) bool { return name == want }
+}
+
+// isRequirementsFile matches requirements.txt and variants such as
+// requirements-dev.txt
+func isRequirementsFile(name string) bool {
+	return strings.HasPrefix(name, "requirements") && strings.HasSuffix(name, ".txt")
+}
+
+// InventoryDependencies reads the dependency manifests (go.mod,
+// package.json, requirements*.txt, Cargo.toml, composer.json, Gemfile) of
+// the clone at path, skipping hidden and vendored directories. Manifests
+// that cannot be parsed are returned as warnings.
+func InventoryDependencies(path string) ([]model.Dependency, []string, error) {
+	var (
+		deps     []model.Dependency
+		warnings []string
+	)
+
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if p != path && (strings.HasPrefix(d.Name(), ".") || dependencySkipDirs[d.Name()]) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		for _, parser := range manifestParsers {
+			if !parser.match(d.Name()) {
+				continue
+			}
+
+			rel, _ := filepath.Rel(path, p)
+			rel = filepath.ToSlash(rel)
+
+			data, err := os.ReadFile(p)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: %v", rel, err))
+				break
+			}
+
+			found, err := parser.parse(data)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: %v", rel, err))
+				break
+			}
+
+			for _, dep := range found {
+				dep.Ecosystem = parser.ecosystem
+				dep.Manifest = rel
+				deps = append(deps, dep)
+			}
+
+			break
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sortDependencies(deps)
+
+	return deps, warnings, nil
+}
+
+// sortDependencies orders dependencies by manifest, ecosystem and name
+func sortDependencies(deps []model.Dependency) {
+	sort.SliceStable(deps, func(i, j int) bool {
+		return dependencyKey(deps[i]) < dependencyKey(deps[j])
+	})
+}
+
+func dependencyKey(d model.Dependency) string {
+	return d.Manifest + "\x00" + d.Ecosystem + "\x00" + d.Name
+}
+
+// parseGoMod reads the require directives of a go.mod file
+func parseGoMod(data []byte) ([]model.Dependency, error) {
+	var (
+		deps    []model.Dependency
+		inBlock bool
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "//")
+		line = strings.TrimSpace(line)
+
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case line == "require (":
+			inBlock = true
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require "))
+		case !inBlock:
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			deps = append(deps, model.Dependency{Name: strings.Trim(fields[0], `"`), Version: fields[1]})
+		}
+	}
+
+	return deps, scanner.Err()
+}
+
+// parsePackageJSON reads the dependency sections of a package.json file
+func parsePackageJSON(data []byte) ([]model.Dependency, error) {
+	var manifest struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+
+	return dependencyMaps(nil, manifest.Dependencies, manifest.DevDependencies,
+		manifest.PeerDependencies, manifest.OptionalDependencies), nil
+}
+
+// parseComposerJSON reads the require sections of a composer.json file,
+// without the PHP platform requirements
+func parseComposerJSON(data []byte) ([]model.Dependency, error) {
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, err
+	}
+
+	isPlatform := func(name string) bool {
+		return name == "php" || strings.HasPrefix(name, "ext-") || strings.HasPrefix(name, "lib-")
+	}
+
+	return dependencyMaps(isPlatform, manifest.Require, manifest.RequireDev), nil
+}
+
+// dependencyMaps merges name to version maps; the first section declaring
+// a name wins. Names for which skip reports true are left out.
+func dependencyMaps(skip func(string) bool, sections ...map[string]string) []model.Dependency {
+	seen := make(map[string]bool)
+
+	var deps []model.Dependency
+
+	for _, section := range sections {
+		for name, version := range section {
+			if seen[name] || (skip != nil && skip(name)) {
+				continue
+			}
+
+			seen[name] = true
+			deps = append(deps, model.Dependency{Name: name, Version: version})
+		}
+	}
+
+	return deps
+}
+
+// requirementPattern splits a requirements.txt line into the package name
+// (extras dropped) and its version specifier
+var requirementPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)(?:\[[^\]]*\])?\s*(.*)$`)
+
+// parseRequirements reads a pip requirements file. Options, includes and
+// editable or URL requirements are skipped; "==" pins are reported as the
+// bare version.
+func parseRequirements(data []byte) ([]model.Dependency, error) {
+	var deps []model.Dependency
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line, _, _ = strings.Cut(line, ";") // environment markers
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "-") || strings.Contains(line, "://") {
+			continue
+		}
+
+		m := requirementPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		version := strings.ReplaceAll(m[2], " ", "")
+		if pinned, ok := strings.CutPrefix(version, "=="); ok && !strings.Contains(pinned, ",") {
+			version = pinned
+		}
+
+		deps = append(deps, model.Dependency{Name: strings.ToLower(m[1]), Version: version})
+	}
+
+	return deps, scanner.Err()
+}
+
+// cargoVersionPattern finds the version of an inline table dependency
+var cargoVersionPattern = regexp.MustCompile(`version\s*=\s*"([^"]*)"`)
+
+// parseCargoToml reads the dependency tables of a Cargo.toml file, including
+// target-specific ones. Dependencies declared as their own table
+// ([dependencies.name]) are reported with the version of that table.
+func parseCargoToml(data []byte) ([]model.Dependency, error) {
+	var (
+		deps    []model.Dependency
+		inTable bool
+		current *model.Dependency // [dependencies.name] table being read
+	)
+
+	isDependencyTable := func(name string) bool {
+		last := name[strings.LastIndex(name, ".")+1:]
+		return last == "dependencies" || last == "dev-dependencies" || last == "build-dependencies"
+	}
+
+	flush := func() {
+		if current != nil {
+			deps = append(deps, *current)
+			current = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			flush()
+
+			table := strings.Trim(line, "[] ")
+			inTable = isDependencyTable(table)
+
+			if !inTable {
+				if i := strings.LastIndex(table, "."); i > 0 && isDependencyTable(table[:i]) {
+					current = &model.Dependency{Name: strings.Trim(table[i+1:], `"`)}
+				}
+			}
+
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		key = strings.Trim(strings.TrimSpace(key), `"`)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case current != nil:
+			if key == "version" {
+				current.Version = strings.Trim(value, `"`)
+			}
+		case inTable:
+			dep := model.Dependency{Name: key}
+
+			if strings.HasPrefix(value, "{") {
+				if m := cargoVersionPattern.FindStringSubmatch(value); m != nil {
+					dep.Version = m[1]
+				}
+			} else {
+				dep.Version = strings.Trim(value, `"`)
+			}
+
+			deps = append(deps, dep)
+		}
+	}
+
+	flush()
+
+	return deps, scanner.Err()
+}
+
+// gemPattern matches a gem declaration with its optional version constraints
+var gemPattern = regexp.MustCompile(`^gem\s+["']([^"']+)["']((?:\s*,\s*["'][^"']*["'])*)`)
+
+// parseGemfile reads the gem declarations of a Gemfile
+func parseGemfile(data []byte) ([]model.Dependency, error) {
+	var deps []model.Dependency
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		m := gemPattern.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if m == nil {
+			continue
+		}
+
+		var constraints []string
+
+		for c := range strings.SplitSeq(m[2], ",") {
+			if c = strings.Trim(strings.TrimSpace(c), `"'`); c != "" {
+				constraints = append(constraints, c)
+			}
+		}
+
+		deps = append(deps, model.Dependency{Name: m[1], Version: strings.Join(constraints, ", ")})
+	}
+
+	return deps, scanner.Err()
+}
+
+// DependencyChange is a dependency added, removed or changed between two
+// inventories
+type DependencyChange struct {
+	Kind      string `json:"kind"`
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Manifest  string `json:"manifest"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+}
+
+// DiffDependencies compares two inventories of the same repository. A
+// dependency is identified by its manifest, ecosystem and name.
+func DiffDependencies(before, after []model.Dependency) []DependencyChange {
+	old := make(map[string]model.Dependency, len(before))
+	for _, d := range before {
+		old[dependencyKey(d)] = d
+	}
+
+	var changes []DependencyChange
+
+	for _, d := range after {
+		key := dependencyKey(d)
+
+		prev, ok := old[key]
+		delete(old, key)
+
+		switch {
+		case !ok:
+			changes = append(changes, DependencyChange{Kind: DependencyAdded, Ecosystem: d.Ecosystem, Name: d.Name, Manifest: d.Manifest, To: d.Version})
+		case prev.Version != d.Version:
+			changes = append(changes, DependencyChange{Kind: DependencyChanged, Ecosystem: d.Ecosystem, Name: d.Name, Manifest: d.Manifest, From: prev.Version, To: d.Version})
+		}
+	}
+
+	for _, d := range old {
+		changes = append(changes, DependencyChange{Kind: DependencyRemoved, Ecosystem: d.Ecosystem, Name: d.Name, Manifest: d.Manifest, From: d.Version})
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		return a.Manifest+"\x00"+a.Ecosystem+"\x00"+a.Name < b.Manifest+"\x00"+b.Ecosystem+"\x00"+b.Name
+	})
+
+	return changes
+}
+
+// DependencyScanOptions configures ScanDependencies
+type DependencyScanOptions struct {
+	DryRun bool // Report without saving the inventories
+}
+
+// DependencyScanResult is the dependency inventory of one repository and
+// its changes since the previous stored inventory
+type DependencyScanResult struct {
+	URL          string             `json:"url"`
+	Path         string             `json:"path"`
+	Dependencies []model.Dependency `json:"dependencies"`
+	Changes      []DependencyChange `json:"changes,omitempty"`
+	Previous     *time.Time         `json:"previous,omitempty"` // When the compared inventory was taken; nil for a first scan
+	Warnings     []string           `json:"warnings,omitempty"`
+	Error        string             `json:"error,omitempty"`
+}
+
+// dependencyInventoryStore is the subset of the server API used to keep
+// dependency inventories
+type dependencyInventoryStore interface {
+	SaveDependencyInventory(inventory *model.DependencyInventory) error
+	ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error)
+}
+
+// ScanDependencies inventories the dependency manifests of each repository,
+// diffs them against the last stored inventory and stores the new one when
+// it is the first or differs
+func ScanDependencies(ctx context.Context, repos []model.Repository, opts DependencyScanOptions) ([]DependencyScanResult, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return scanDependencies(ctx, client, repos, opts, time.Now())
+}
+
+func scanDependencies(ctx context.Context, db dependencyInventoryStore, repos []model.Repository, opts DependencyScanOptions, now time.Time) ([]DependencyScanResult, error) {
+	results := make([]DependencyScanResult, 0, len(repos))
+
+	for _, repo := range repos {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		res := DependencyScanResult{URL: repo.URL, Path: repo.Path}
+
+		deps, warnings, err := InventoryDependencies(repo.Path)
+		if err != nil {
+			res.Error = err.Error()
+			results = append(results, res)
+
+			continue
+		}
+
+		res.Dependencies = deps
+		res.Warnings = warnings
+
+		previous, err := db.ListDependencyInventories(repo.URL, 1)
+		if err != nil {
+			res.Error = fmt.Sprintf("failed to load previous inventory: %v", err)
+			results = append(results, res)
+
+			continue
+		}
+
+		if len(previous) > 0 {
+			res.Previous = &previous[0].ScannedAt
+			res.Changes = DiffDependencies(previous[0].Dependencies, deps)
+		}
+
+		if (res.Previous == nil || len(res.Changes) > 0) && !opts.DryRun {
+			if err := db.SaveDependencyInventory(&model.DependencyInventory{RepoURL: repo.URL, Dependencies: deps, ScannedAt: now}); err != nil {
+				res.Error = fmt.Sprintf("failed to save inventory: %v", err)
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
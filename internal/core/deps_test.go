@@ -0,0 +1,215 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func depsOf(deps []model.Dependency) map[string]string {
+	m := make(map[string]string, len(deps))
+	for _, d := range deps {
+		m[d.Name] = d.Version
+	}
+
+	return m
+}
+
+func TestParseManifests(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func([]byte) ([]model.Dependency, error)
+		data  string
+		want  map[string]string
+	}{
+		{"go.mod", parseGoMod, `module example.com/app
+
+go 1.25
+
+require github.com/spf13/cobra v1.10.1
+
+require (
+	github.com/google/uuid v1.6.0
+	golang.org/x/sys v0.40.0 // indirect
+)
+
+replace (
+	github.com/google/uuid => ../uuid
+)
+`, map[string]string{"github.com/spf13/cobra": "v1.10.1", "github.com/google/uuid": "v1.6.0", "golang.org/x/sys": "v0.40.0"}},
+		{"package.json", parsePackageJSON, `{
+  "name": "web",
+  "dependencies": {"react": "^18.2.0"},
+  "devDependencies": {"vite": "5.0.0", "react": "^17.0.0"}
+}`, map[string]string{"react": "^18.2.0", "vite": "5.0.0"}},
+		{"requirements.txt", parseRequirements, `# app
+-r base.txt
+Django==4.2.7
+requests[socks]>=2.31,<3 ; python_version >= "3.8"
+-e git+https://github.com/org/lib.git#egg=lib
+numpy
+`, map[string]string{"django": "4.2.7", "requests": ">=2.31,<3", "numpy": ""}},
+		{"Cargo.toml", parseCargoToml, `[package]
+name = "tool"
+version = "0.1.0"
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+anyhow = "1"
+
+[dev-dependencies]
+tempfile = "3.8"
+
+[dependencies.tokio]
+version = "1.35"
+features = ["full"]
+`, map[string]string{"serde": "1.0", "anyhow": "1", "tempfile": "3.8", "tokio": "1.35"}},
+		{"composer.json", parseComposerJSON, `{
+  "require": {"php": ">=8.1", "ext-json": "*", "laravel/framework": "^10.0"},
+  "require-dev": {"phpunit/phpunit": "^10.5"}
+}`, map[string]string{"laravel/framework": "^10.0", "phpunit/phpunit": "^10.5"}},
+		{"Gemfile", parseGemfile, `source "https://rubygems.org"
+
+gem "rails", "~> 7.1.0"
+gem 'puma', '>= 5.0', '< 7'
+gem "bootsnap", require: false
+`, map[string]string{"rails": "~> 7.1.0", "puma": ">= 5.0, < 7", "bootsnap": ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, err := tt.parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("parse error = %v", err)
+			}
+
+			if got := depsOf(deps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInventoryDependencies(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":                              "module example.com/app\n\nrequire github.com/google/uuid v1.6.0\n",
+		"web/package.json":                    `{"dependencies": {"react": "^18.2.0"}}`,
+		"web/node_modules/react/package.json": `{"dependencies": {"loose-envify": "^1.1.0"}}`,
+		".github/package.json":                `{"dependencies": {"ignored": "1"}}`,
+		"broken/package.json":                 `{`,
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deps, warnings, err := InventoryDependencies(dir)
+	if err != nil {
+		t.Fatalf("InventoryDependencies() error = %v", err)
+	}
+
+	want := []model.Dependency{
+		{Ecosystem: EcosystemGo, Name: "github.com/google/uuid", Version: "v1.6.0", Manifest: "go.mod"},
+		{Ecosystem: EcosystemNPM, Name: "react", Version: "^18.2.0", Manifest: "web/package.json"},
+	}
+
+	if !reflect.DeepEqual(deps, want) {
+		t.Errorf("InventoryDependencies() = %+v, want %+v", deps, want)
+	}
+
+	if len(warnings) != 1 {
+		t.Errorf("warnings = %v, want one for broken/package.json", warnings)
+	}
+}
+
+func TestDiffDependencies(t *testing.T) {
+	before := []model.Dependency{
+		{Ecosystem: EcosystemNPM, Name: "react", Version: "^17.0.0", Manifest: "package.json"},
+		{Ecosystem: EcosystemNPM, Name: "lodash", Version: "4.17.21", Manifest: "package.json"},
+		{Ecosystem: EcosystemNPM, Name: "vite", Version: "5.0.0", Manifest: "package.json"},
+	}
+	after := []model.Dependency{
+		{Ecosystem: EcosystemNPM, Name: "react", Version: "^18.2.0", Manifest: "package.json"},
+		{Ecosystem: EcosystemNPM, Name: "vite", Version: "5.0.0", Manifest: "package.json"},
+		{Ecosystem: EcosystemNPM, Name: "zod", Version: "3.22.4", Manifest: "package.json"},
+	}
+
+	want := []DependencyChange{
+		{Kind: DependencyRemoved, Ecosystem: EcosystemNPM, Name: "lodash", Manifest: "package.json", From: "4.17.21"},
+		{Kind: DependencyChanged, Ecosystem: EcosystemNPM, Name: "react", Manifest: "package.json", From: "^17.0.0", To: "^18.2.0"},
+		{Kind: DependencyAdded, Ecosystem: EcosystemNPM, Name: "zod", Manifest: "package.json", To: "3.22.4"},
+	}
+
+	if got := DiffDependencies(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffDependencies() = %+v, want %+v", got, want)
+	}
+}
+
+type memDependencyStore map[string][]model.DependencyInventory
+
+func (m memDependencyStore) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	m[inventory.RepoURL] = append([]model.DependencyInventory{*inventory}, m[inventory.RepoURL]...)
+	return nil
+}
+
+func (m memDependencyStore) ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error) {
+	inventories := m[repoURL]
+	if limit > 0 && len(inventories) > limit {
+		inventories = inventories[:limit]
+	}
+
+	return inventories, nil
+}
+
+func TestScanDependencies(t *testing.T) {
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "go.mod")
+	repos := []model.Repository{{URL: "https://github.com/org/app", Path: dir}}
+	db := memDependencyStore{}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	scan := func(content string, at time.Time) DependencyScanResult {
+		t.Helper()
+
+		if err := os.WriteFile(manifest, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		results, err := scanDependencies(context.Background(), db, repos, DependencyScanOptions{}, at)
+		if err != nil || len(results) != 1 || results[0].Error != "" {
+			t.Fatalf("scanDependencies() = %+v, %v", results, err)
+		}
+
+		return results[0]
+	}
+
+	first := scan("module app\n\nrequire github.com/google/uuid v1.5.0\n", now)
+	if first.Previous != nil || len(first.Changes) != 0 || len(db[repos[0].URL]) != 1 {
+		t.Errorf("first scan: previous = %v, changes = %v, stored = %d", first.Previous, first.Changes, len(db[repos[0].URL]))
+	}
+
+	// An unchanged inventory is not stored again
+	same := scan("module app\n\nrequire github.com/google/uuid v1.5.0\n", now.Add(time.Hour))
+	if same.Previous == nil || !same.Previous.Equal(now) || len(same.Changes) != 0 || len(db[repos[0].URL]) != 1 {
+		t.Errorf("unchanged scan: previous = %v, changes = %v, stored = %d", same.Previous, same.Changes, len(db[repos[0].URL]))
+	}
+
+	bumped := scan("module app\n\nrequire github.com/google/uuid v1.6.0\n", now.Add(2*time.Hour))
+	if len(bumped.Changes) != 1 || bumped.Changes[0].Kind != DependencyChanged || len(db[repos[0].URL]) != 2 {
+		t.Errorf("changed scan: changes = %+v, stored = %d", bumped.Changes, len(db[repos[0].URL]))
+	}
+}
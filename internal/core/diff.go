@@ -135,7 +135,7 @@ func validateGitRepo(repoPath string) error {
 
 // runGitCommand executes a git command and returns the output.
 func runGitCommand(args ...string) (string, error) {
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(BaseContext(), "git", args...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+const diskBudgetAlertsFile = "disk_budget_alerts.json"
+
+// budgetThresholds are the usage percentages at which an alert is sent, smallest first
+var budgetThresholds = []int{80, 90, 100}
+
+// pruneCandidateAge is how long a repository must be untouched before it is suggested for pruning
+const pruneCandidateAge = 30 * 24 * time.Hour
+
+// maxPruneCandidates limits how many repositories are suggested for pruning
+const maxPruneCandidates = 5
+
+// BudgetStatus is the disk usage of a workspace measured against its budget
+type BudgetStatus struct {
+	Workspace       string          `json:"workspace"`
+	Path            string          `json:"path"`
+	Budget          int64           `json:"budget"`
+	Used            int64           `json:"used"`
+	Percent         int             `json:"percent"`
+	Threshold       int             `json:"threshold"`
+	PruneCandidates []RepoDiskUsage `json:"prune_candidates,omitempty"`
+}
+
+// OverThreshold reports whether usage crossed at least the lowest alert threshold
+func (s BudgetStatus) OverThreshold() bool {
+	return s.Threshold > 0
+}
+
+// ParseSize parses a human-readable size such as "500MB", "20 GB" or "1.5T"
+// into bytes. Units are powers of 1024; a bare number is bytes.
+func ParseSize(s string) (int64, error) {
+	v := strings.ToUpper(strings.TrimSpace(s))
+	v = strings.TrimSuffix(strings.TrimSuffix(v, "IB"), "B")
+
+	multiplier := int64(1)
+
+	if n := len(v); n > 0 {
+		if i := strings.IndexByte("KMGTP", v[n-1]); i >= 0 {
+			multiplier = int64(1) << (10 * (i + 1))
+			v = v[:n-1]
+		}
+	}
+
+	f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid size %q (e.g. 500MB, 20GB)", s)
+	}
+
+	return int64(f * float64(multiplier)), nil
+}
+
+// CheckDiskBudgets measures every workspace that has a disk budget.
+// Workspaces over the lowest threshold get prune candidates: non-favorite
+// repositories not updated for 30 days, largest first.
+func CheckDiskBudgets(workspaces []model.Workspace, repos []model.Repository, now time.Time) []BudgetStatus {
+	var statuses []BudgetStatus
+
+	for _, ws := range workspaces {
+		if ws.DiskBudget <= 0 {
+			continue
+		}
+
+		var wsRepos []model.Repository
+
+		for _, r := range repos {
+			if r.Workspace == ws.Name {
+				wsRepos = append(wsRepos, r)
+			}
+		}
+
+		status := BudgetStatus{Workspace: ws.Name, Path: ws.Path, Budget: ws.DiskBudget}
+
+		sizes := make(map[string]int64, len(wsRepos))
+		if ws.Path != "" && encoding.DirExists(ws.Path) {
+			status.Used = dirSize(ws.Path)
+		}
+
+		for _, r := range wsRepos {
+			sizes[r.URL] = dirSize(r.Path)
+
+			if ws.Path == "" || !isUnder(r.Path, ws.Path) {
+				status.Used += sizes[r.URL]
+			}
+		}
+
+		status.Percent = int(status.Used * 100 / status.Budget)
+		status.Threshold = budgetThreshold(status.Percent)
+
+		if status.OverThreshold() {
+			status.PruneCandidates = pruneCandidates(wsRepos, sizes, now)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// budgetThreshold returns the highest alert threshold reached by percent, or 0
+func budgetThreshold(percent int) int {
+	threshold := 0
+
+	for _, t := range budgetThresholds {
+		if percent >= t {
+			threshold = t
+		}
+	}
+
+	return threshold
+}
+
+// pruneCandidates returns stale, non-favorite repositories ordered by size
+func pruneCandidates(repos []model.Repository, sizes map[string]int64, now time.Time) []RepoDiskUsage {
+	var candidates []RepoDiskUsage
+
+	for _, r := range repos {
+		if r.Favorite {
+			continue
+		}
+
+		last := r.UpdatedAt
+		if r.LastChecked.After(last) {
+			last = r.LastChecked
+		}
+
+		if now.Sub(last) < pruneCandidateAge {
+			continue
+		}
+
+		candidates = append(candidates, RepoDiskUsage{URL: r.URL, Path: r.Path, Bytes: sizes[r.URL]})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Bytes > candidates[j].Bytes
+	})
+
+	if len(candidates) > maxPruneCandidates {
+		candidates = candidates[:maxPruneCandidates]
+	}
+
+	return candidates
+}
+
+// isUnder reports whether path is inside dir
+func isUnder(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// SendDiskBudgetAlerts notifies about workspaces whose usage crossed a new
+// threshold since the last alert. Falling back under the lowest threshold
+// resets the workspace so a later crossing alerts again.
+func SendDiskBudgetAlerts(ctx context.Context, statuses []BudgetStatus, dispatcher *notify.Dispatcher) ([]BudgetStatus, error) {
+	path := filepath.Join(params.AppdataDir, diskBudgetAlertsFile)
+
+	loaded, err := encoding.LoadJSON[map[string]int](path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load disk budget alert state: %w", err)
+	}
+
+	sent := make(map[string]int)
+	if loaded != nil && *loaded != nil {
+		sent = *loaded
+	}
+
+	var due []BudgetStatus
+
+	for _, s := range statuses {
+		if !s.OverThreshold() {
+			delete(sent, s.Workspace)
+			continue
+		}
+
+		if sent[s.Workspace] >= s.Threshold {
+			continue
+		}
+
+		sent[s.Workspace] = s.Threshold
+		due = append(due, s)
+	}
+
+	if dispatcher != nil {
+		for _, s := range due {
+			dispatcher.Dispatch(ctx, diskBudgetEvent(s))
+		}
+	}
+
+	if err := encoding.SaveJSON(path, sent); err != nil {
+		return due, fmt.Errorf("failed to save disk budget alert state: %w", err)
+	}
+
+	return due, nil
+}
+
+// diskBudgetEvent builds the notification for a workspace over its disk budget
+func diskBudgetEvent(s BudgetStatus) *notify.Event {
+	candidates := make([]string, 0, len(s.PruneCandidates))
+	for _, c := range s.PruneCandidates {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", c.URL, FormatSize(c.Bytes)))
+	}
+
+	event := notify.NewEvent(notify.EventDiskBudget).
+		WithWorkspace(s.Workspace).
+		WithExtra("used", FormatSize(s.Used)).
+		WithExtra("budget", FormatSize(s.Budget)).
+		WithExtra("percent", strconv.Itoa(s.Percent)).
+		WithExtra("prune_candidates", strings.Join(candidates, "\n"))
+
+	if s.Percent >= 100 {
+		event.WithError(fmt.Sprintf("workspace %s exceeds its disk budget", s.Workspace))
+	}
+
+	return event
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestParseSize(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int64
+		wantErr bool
+	}{
+		{in: "1024", want: 1024},
+		{in: "500MB", want: 500 << 20},
+		{in: "20 GB", want: 20 << 30},
+		{in: "1.5g", want: 3 << 29},
+		{in: "2TiB", want: 2 << 40},
+		{in: "10K", want: 10 << 10},
+		{in: "", wantErr: true},
+		{in: "lots", wantErr: true},
+		{in: "-1GB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSize(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSize(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("ParseSize(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBudgetThreshold(t *testing.T) {
+	for percent, want := range map[int]int{0: 0, 79: 0, 80: 80, 95: 90, 100: 100, 250: 100} {
+		if got := budgetThreshold(percent); got != want {
+			t.Errorf("budgetThreshold(%d) = %d, want %d", percent, got, want)
+		}
+	}
+}
+
+func TestPruneCandidates(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, -2, 0)
+
+	repos := []model.Repository{
+		{URL: "small", UpdatedAt: old},
+		{URL: "big", UpdatedAt: old},
+		{URL: "fav", UpdatedAt: old, Favorite: true},
+		{URL: "recent", UpdatedAt: now.AddDate(0, 0, -1)},
+		{URL: "checked", UpdatedAt: old, LastChecked: now},
+	}
+	sizes := map[string]int64{"small": 10, "big": 100, "fav": 1000, "recent": 1000, "checked": 1000}
+
+	got := pruneCandidates(repos, sizes, now)
+	if len(got) != 2 || got[0].URL != "big" || got[1].URL != "small" {
+		t.Errorf("pruneCandidates() = %+v, want [big small]", got)
+	}
+}
@@ -0,0 +1,201 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// DiskUsageSort is a sort order of the disk usage report
+type DiskUsageSort string
+
+const (
+	DiskUsageSortSize      DiskUsageSort = "size"
+	DiskUsageSortName      DiskUsageSort = "name"
+	DiskUsageSortWorkspace DiskUsageSort = "workspace"
+	DiskUsageSortActivity  DiskUsageSort = "activity"
+)
+
+// DiskUsageSorts lists the accepted sort orders
+var DiskUsageSorts = []DiskUsageSort{DiskUsageSortSize, DiskUsageSortName, DiskUsageSortWorkspace, DiskUsageSortActivity}
+
+// RepoUsage is the on-disk size of one repository
+type RepoUsage struct {
+	URL          string    `json:"url"`
+	Path         string    `json:"path"`
+	Workspace    string    `json:"workspace"`
+	Favorite     bool      `json:"favorite,omitempty"`
+	Bytes        int64     `json:"bytes"`
+	GitBytes     int64     `json:"git_bytes"`
+	LastActivity time.Time `json:"last_activity,omitzero"`
+	Missing      bool      `json:"missing,omitempty"`
+}
+
+// WorkspaceUsage is the combined size of the repositories of a workspace
+type WorkspaceUsage struct {
+	Workspace string `json:"workspace"`
+	Repos     int    `json:"repos"`
+	Bytes     int64  `json:"bytes"`
+}
+
+// DiskUsageReport is the disk usage of a set of repositories
+type DiskUsageReport struct {
+	Repos      []RepoUsage      `json:"repos"`
+	Workspaces []WorkspaceUsage `json:"workspaces"`
+	Total      int64            `json:"total"`
+}
+
+// PruneSuggestion lists repositories untouched for a while, largest first
+type PruneSuggestion struct {
+	Since       time.Time   `json:"since"`
+	Repos       []RepoUsage `json:"repos"`
+	Reclaimable int64       `json:"reclaimable"`
+}
+
+// ParseDiskUsageSort validates a sort key. An empty key sorts by size.
+func ParseDiskUsageSort(value string) (DiskUsageSort, error) {
+	if value == "" {
+		return DiskUsageSortSize, nil
+	}
+
+	sortBy := DiskUsageSort(strings.ToLower(value))
+	if !slices.Contains(DiskUsageSorts, sortBy) {
+		names := make([]string, len(DiskUsageSorts))
+		for i, s := range DiskUsageSorts {
+			names[i] = string(s)
+		}
+
+		return "", fmt.Errorf("invalid sort %q (valid: %s)", value, strings.Join(names, ", "))
+	}
+
+	return sortBy, nil
+}
+
+// DiskUsage measures every repository, including its .git directory, and
+// totals the sizes per workspace. Repositories are measured concurrently.
+func DiskUsage(repos []model.Repository) *DiskUsageReport {
+	report := &DiskUsageReport{Repos: make([]RepoUsage, len(repos))}
+
+	forEachRepo(repos, func(i int, repo model.Repository) {
+		report.Repos[i] = repoUsage(repo)
+	})
+
+	totals := make(map[string]*WorkspaceUsage)
+
+	for _, u := range report.Repos {
+		report.Total += u.Bytes
+
+		ws, ok := totals[u.Workspace]
+		if !ok {
+			ws = &WorkspaceUsage{Workspace: u.Workspace}
+			totals[u.Workspace] = ws
+		}
+
+		ws.Repos++
+		ws.Bytes += u.Bytes
+	}
+
+	for _, ws := range totals {
+		report.Workspaces = append(report.Workspaces, *ws)
+	}
+
+	sort.Slice(report.Workspaces, func(i, j int) bool {
+		if report.Workspaces[i].Bytes != report.Workspaces[j].Bytes {
+			return report.Workspaces[i].Bytes > report.Workspaces[j].Bytes
+		}
+
+		return report.Workspaces[i].Workspace < report.Workspaces[j].Workspace
+	})
+
+	return report
+}
+
+// repoUsage measures one repository
+func repoUsage(repo model.Repository) RepoUsage {
+	usage := RepoUsage{
+		URL:       repo.URL,
+		Path:      repo.Path,
+		Workspace: repo.Workspace,
+		Favorite:  repo.Favorite,
+	}
+
+	if _, err := os.Stat(repo.Path); err != nil {
+		usage.Missing = true
+		return usage
+	}
+
+	usage.Bytes = dirSize(repo.Path)
+	usage.GitBytes = dirSize(filepath.Join(repo.Path, ".git"))
+	usage.LastActivity = lastActivity(repo.Path)
+
+	return usage
+}
+
+// lastActivity returns when a repository was last worked on: the later of
+// the HEAD commit date and the last change to the index (checkouts, staging).
+// Fetches by 'clonr update' do not count.
+func lastActivity(repoPath string) time.Time {
+	var last time.Time
+
+	if output, err := exec.Command("git", "-C", repoPath, "log", "-1", "--format=%ct").Output(); err == nil {
+		if ts, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64); err == nil {
+			last = time.Unix(ts, 0)
+		}
+	}
+
+	if info, err := os.Stat(filepath.Join(repoPath, ".git", "index")); err == nil && info.ModTime().After(last) {
+		last = info.ModTime()
+	}
+
+	return last
+}
+
+// SortRepoUsage sorts repositories in place. Size sorts largest first and
+// activity sorts least recently used first.
+func SortRepoUsage(usages []RepoUsage, sortBy DiskUsageSort) {
+	sort.SliceStable(usages, func(i, j int) bool {
+		a, b := usages[i], usages[j]
+
+		switch sortBy {
+		case DiskUsageSortName:
+			return a.URL < b.URL
+		case DiskUsageSortWorkspace:
+			if a.Workspace != b.Workspace {
+				return a.Workspace < b.Workspace
+			}
+
+			return a.Bytes > b.Bytes
+		case DiskUsageSortActivity:
+			return a.LastActivity.Before(b.LastActivity)
+		default:
+			return a.Bytes > b.Bytes
+		}
+	})
+}
+
+// SuggestPrune returns non-favorite repositories with no activity since
+// the given time, largest first. Missing repositories are not included.
+func SuggestPrune(usages []RepoUsage, since time.Time) *PruneSuggestion {
+	suggestion := &PruneSuggestion{Since: since}
+
+	for _, u := range usages {
+		if u.Favorite || u.Missing || !u.LastActivity.Before(since) {
+			continue
+		}
+
+		suggestion.Repos = append(suggestion.Repos, u)
+		suggestion.Reclaimable += u.Bytes
+	}
+
+	SortRepoUsage(suggestion.Repos, DiskUsageSortSize)
+
+	return suggestion
+}
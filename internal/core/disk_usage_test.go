@@ -0,0 +1,139 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestDiskUsage(t *testing.T) {
+	root := t.TempDir()
+
+	write := func(name string, size int) string {
+		t.Helper()
+
+		dir := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, "data"), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, ".git", "pack"), make([]byte, size/2), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		return dir
+	}
+
+	repos := []model.Repository{
+		{URL: "https://github.com/org/a", Path: write("a", 1000), Workspace: "work"},
+		{URL: "https://github.com/org/b", Path: write("b", 4000), Workspace: "work"},
+		{URL: "https://github.com/org/c", Path: write("c", 2000), Workspace: "personal"},
+		{URL: "https://github.com/org/gone", Path: filepath.Join(root, "gone"), Workspace: "personal"},
+	}
+
+	report := DiskUsage(repos)
+
+	if report.Total != 1500+6000+3000 {
+		t.Errorf("Total = %d, want %d", report.Total, 1500+6000+3000)
+	}
+
+	if report.Repos[1].GitBytes != 2000 {
+		t.Errorf("GitBytes = %d, want 2000", report.Repos[1].GitBytes)
+	}
+
+	if !report.Repos[3].Missing {
+		t.Error("missing repository not flagged")
+	}
+
+	want := []WorkspaceUsage{
+		{Workspace: "work", Repos: 2, Bytes: 7500},
+		{Workspace: "personal", Repos: 2, Bytes: 3000},
+	}
+
+	if len(report.Workspaces) != len(want) {
+		t.Fatalf("Workspaces = %+v, want %+v", report.Workspaces, want)
+	}
+
+	for i := range want {
+		if report.Workspaces[i] != want[i] {
+			t.Errorf("Workspaces[%d] = %+v, want %+v", i, report.Workspaces[i], want[i])
+		}
+	}
+}
+
+func TestSortRepoUsage(t *testing.T) {
+	now := time.Now()
+	usages := []RepoUsage{
+		{URL: "b", Workspace: "x", Bytes: 10, LastActivity: now},
+		{URL: "a", Workspace: "y", Bytes: 30, LastActivity: now.Add(-time.Hour)},
+		{URL: "c", Workspace: "x", Bytes: 20, LastActivity: now.Add(-2 * time.Hour)},
+	}
+
+	tests := []struct {
+		sortBy DiskUsageSort
+		want   []string
+	}{
+		{DiskUsageSortSize, []string{"a", "c", "b"}},
+		{DiskUsageSortName, []string{"a", "b", "c"}},
+		{DiskUsageSortWorkspace, []string{"c", "b", "a"}},
+		{DiskUsageSortActivity, []string{"c", "a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sortBy), func(t *testing.T) {
+			sorted := append([]RepoUsage(nil), usages...)
+			SortRepoUsage(sorted, tt.sortBy)
+
+			for i, u := range sorted {
+				if u.URL != tt.want[i] {
+					t.Errorf("order = %v, want %v", sorted, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestParseDiskUsageSort(t *testing.T) {
+	if got, err := ParseDiskUsageSort(""); err != nil || got != DiskUsageSortSize {
+		t.Errorf("ParseDiskUsageSort(\"\") = %q, %v", got, err)
+	}
+
+	if got, err := ParseDiskUsageSort("Activity"); err != nil || got != DiskUsageSortActivity {
+		t.Errorf("ParseDiskUsageSort(Activity) = %q, %v", got, err)
+	}
+
+	if _, err := ParseDiskUsageSort("age"); err == nil {
+		t.Error("ParseDiskUsageSort(age) expected error")
+	}
+}
+
+func TestSuggestPrune(t *testing.T) {
+	now := time.Now()
+	since := now.AddDate(0, -6, 0)
+
+	usages := []RepoUsage{
+		{URL: "recent", Bytes: 100, LastActivity: now},
+		{URL: "old-small", Bytes: 10, LastActivity: now.AddDate(-1, 0, 0)},
+		{URL: "old-large", Bytes: 50, LastActivity: now.AddDate(0, -7, 0)},
+		{URL: "old-favorite", Bytes: 90, Favorite: true, LastActivity: now.AddDate(-1, 0, 0)},
+		{URL: "missing", Missing: true},
+	}
+
+	s := SuggestPrune(usages, since)
+
+	if len(s.Repos) != 2 || s.Repos[0].URL != "old-large" || s.Repos[1].URL != "old-small" {
+		t.Errorf("Repos = %+v, want old-large, old-small", s.Repos)
+	}
+
+	if s.Reclaimable != 60 {
+		t.Errorf("Reclaimable = %d, want 60", s.Reclaimable)
+	}
+}
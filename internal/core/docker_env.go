@@ -0,0 +1,191 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// DockerProfileLabel is the container label holding the docker profile a
+// development container was started from
+const DockerProfileLabel = "clonr.profile"
+
+var dockerNameInvalid = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// DockerContainerName returns the name of the development container of a
+// docker profile
+func DockerContainerName(profile string) string {
+	return "clonr-" + strings.Trim(dockerNameInvalid.ReplaceAllString(profile, "-"), "-")
+}
+
+// ParseDockerMount parses a SOURCE:TARGET[:ro] mount. The target is split at
+// the last colon, so Windows drive letters in the source are kept.
+func ParseDockerMount(spec string) (model.DockerMount, error) {
+	var mount model.DockerMount
+
+	if rest, ok := strings.CutSuffix(spec, ":ro"); ok {
+		spec, mount.ReadOnly = rest, true
+	}
+
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return mount, fmt.Errorf("invalid mount %q: use SOURCE:TARGET[:ro]", spec)
+	}
+
+	mount.Source, mount.Target = spec[:i], spec[i+1:]
+
+	if !strings.HasPrefix(mount.Target, "/") {
+		return mount, fmt.Errorf("invalid mount %q: the target must be an absolute container path", spec)
+	}
+
+	return mount, nil
+}
+
+// FormatDockerMount formats a mount as SOURCE:TARGET[:ro]
+func FormatDockerMount(mount model.DockerMount) string {
+	s := mount.Source + ":" + mount.Target
+	if mount.ReadOnly {
+		s += ":ro"
+	}
+
+	return s
+}
+
+// ResolveDockerMounts resolves the sources of mounts to host directories: an
+// existing path is used as is, anything else is looked up as a tracked
+// repository
+func ResolveDockerMounts(mounts []model.DockerMount) ([]model.DockerMount, error) {
+	resolved := make([]model.DockerMount, len(mounts))
+
+	for i, mount := range mounts {
+		source, err := resolveDockerMountSource(mount.Source)
+		if err != nil {
+			return nil, err
+		}
+
+		mount.Source = source
+		resolved[i] = mount
+	}
+
+	return resolved, nil
+}
+
+func resolveDockerMountSource(source string) (string, error) {
+	path := expandTilde(source)
+	if _, err := os.Stat(path); err == nil {
+		return filepath.Abs(path)
+	}
+
+	repo, err := ResolveRepo(source)
+	if err != nil {
+		return "", fmt.Errorf("mount source %q is neither a directory nor a tracked repository: %w", source, err)
+	}
+
+	return repo.Path, nil
+}
+
+// DockerRunArgs returns the docker arguments starting the development
+// container of a profile in the background. Mounts must be resolved.
+func DockerRunArgs(profile string, env *model.DockerEnvironment, mounts []model.DockerMount) []string {
+	args := []string{
+		"run", "--detach",
+		"--name", DockerContainerName(profile),
+		"--label", DockerProfileLabel + "=" + profile,
+	}
+
+	for _, e := range env.Env {
+		args = append(args, "--env", e)
+	}
+
+	for _, mount := range mounts {
+		// --mount is comma separated; --volume takes any source path but
+		// creates missing ones instead of failing
+		if strings.Contains(mount.Source, ",") {
+			args = append(args, "--volume", FormatDockerMount(mount))
+			continue
+		}
+
+		spec := "type=bind,source=" + mount.Source + ",target=" + mount.Target
+		if mount.ReadOnly {
+			spec += ",readonly"
+		}
+
+		args = append(args, "--mount", spec)
+	}
+
+	for _, port := range env.Ports {
+		args = append(args, "--publish", port)
+	}
+
+	if env.Workdir != "" {
+		args = append(args, "--workdir", env.Workdir)
+	}
+
+	args = append(args, env.Image)
+
+	return append(args, env.Command...)
+}
+
+// RenderDockerCompose renders a compose file running the development
+// container of a profile. Mounts must be resolved.
+func RenderDockerCompose(profile string, env *model.DockerEnvironment, mounts []model.DockerMount) []byte {
+	var b bytes.Buffer
+
+	q := strconv.Quote
+
+	name := DockerContainerName(profile)
+
+	_, _ = fmt.Fprintf(&b, "# Development container of docker profile %s, generated by clonr\n", profile)
+	_, _ = fmt.Fprintf(&b, "services:\n  %s:\n", name)
+	_, _ = fmt.Fprintf(&b, "    image: %s\n", q(env.Image))
+	_, _ = fmt.Fprintf(&b, "    container_name: %s\n", q(name))
+	_, _ = fmt.Fprintf(&b, "    labels:\n      %s: %s\n", DockerProfileLabel, q(profile))
+
+	if env.Workdir != "" {
+		_, _ = fmt.Fprintf(&b, "    working_dir: %s\n", q(env.Workdir))
+	}
+
+	if len(env.Command) > 0 {
+		quoted := make([]string, len(env.Command))
+		for i, arg := range env.Command {
+			quoted[i] = q(arg)
+		}
+
+		_, _ = fmt.Fprintf(&b, "    command: [%s]\n", strings.Join(quoted, ", "))
+	}
+
+	writeList := func(key string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+
+		_, _ = fmt.Fprintf(&b, "    %s:\n", key)
+
+		for _, v := range values {
+			_, _ = fmt.Fprintf(&b, "      - %s\n", q(v))
+		}
+	}
+
+	writeList("environment", env.Env)
+	writeList("ports", env.Ports)
+
+	if len(mounts) > 0 {
+		b.WriteString("    volumes:\n")
+
+		for _, mount := range mounts {
+			_, _ = fmt.Fprintf(&b, "      - type: bind\n        source: %s\n        target: %s\n", q(mount.Source), q(mount.Target))
+
+			if mount.ReadOnly {
+				b.WriteString("        read_only: true\n")
+			}
+		}
+	}
+
+	return b.Bytes()
+}
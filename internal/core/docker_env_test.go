@@ -0,0 +1,87 @@
+package core
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestParseDockerMount(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    model.DockerMount
+		wantErr bool
+	}{
+		{spec: "clonr:/work", want: model.DockerMount{Source: "clonr", Target: "/work"}},
+		{spec: "~/cache:/root/.cache:ro", want: model.DockerMount{Source: "~/cache", Target: "/root/.cache", ReadOnly: true}},
+		{spec: `C:\src\app:/app`, want: model.DockerMount{Source: `C:\src\app`, Target: "/app"}},
+		{spec: "clonr", wantErr: true},
+		{spec: "clonr:work", wantErr: true},
+		{spec: ":/work", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDockerMount(tt.spec)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+			t.Errorf("ParseDockerMount(%q) = %+v, %v; want %+v, error %v", tt.spec, got, err, tt.want, tt.wantErr)
+		}
+
+		if !tt.wantErr && FormatDockerMount(got) != tt.spec {
+			t.Errorf("FormatDockerMount(%+v) = %q, want %q", got, FormatDockerMount(got), tt.spec)
+		}
+	}
+}
+
+func TestDockerRunArgs(t *testing.T) {
+	env := &model.DockerEnvironment{
+		Image:   "golang:1.25",
+		Env:     []string{"CGO_ENABLED=0"},
+		Ports:   []string{"8080:80"},
+		Workdir: "/work",
+		Command: []string{"sleep", "infinity"},
+	}
+	mounts := []model.DockerMount{{Source: "/home/dev/clonr", Target: "/work", ReadOnly: true}}
+
+	got := DockerRunArgs("go dev", env, mounts)
+	want := []string{
+		"run", "--detach", "--name", "clonr-go-dev", "--label", "clonr.profile=go dev",
+		"--env", "CGO_ENABLED=0",
+		"--mount", "type=bind,source=/home/dev/clonr,target=/work,readonly",
+		"--publish", "8080:80",
+		"--workdir", "/work",
+		"golang:1.25", "sleep", "infinity",
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("DockerRunArgs() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderDockerCompose(t *testing.T) {
+	env := &model.DockerEnvironment{
+		Image:   "node:22",
+		Env:     []string{`GREETING=say "hi"`},
+		Command: []string{"npm", "run", "dev"},
+	}
+	mounts := []model.DockerMount{{Source: "/src/web", Target: "/app"}}
+
+	compose := string(RenderDockerCompose("web", env, mounts))
+
+	for _, want := range []string{
+		"services:\n  clonr-web:\n",
+		`    image: "node:22"`,
+		`    command: ["npm", "run", "dev"]`,
+		`      - "GREETING=say \"hi\""`,
+		"      - type: bind\n        source: \"/src/web\"\n        target: \"/app\"\n",
+	} {
+		if !strings.Contains(compose, want) {
+			t.Errorf("RenderDockerCompose() missing %q in:\n%s", want, compose)
+		}
+	}
+
+	if strings.Contains(compose, "ports:") || strings.Contains(compose, "read_only") {
+		t.Errorf("RenderDockerCompose() rendered unset fields:\n%s", compose)
+	}
+}
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// DoctorIssueKind identifies the type of problem found for a repository
+type DoctorIssueKind string
+
+const (
+	IssueMissingPath       DoctorIssueKind = "missing_path"
+	IssueNotGitRepo        DoctorIssueKind = "not_git_repo"
+	IssueNoOriginRemote    DoctorIssueKind = "no_origin_remote"
+	IssueURLMismatch       DoctorIssueKind = "url_mismatch"
+	IssueRemoteUnreachable DoctorIssueKind = "remote_unreachable"
+)
+
+// DoctorOptions configures the repository health check
+type DoctorOptions struct {
+	Fix           bool          // Prune or re-map stale entries in the database
+	CheckRemote   bool          // Verify the remote is reachable with git ls-remote
+	RemoteTimeout time.Duration // Timeout for each remote reachability check
+}
+
+// DoctorIssue describes a single problem found for a repository
+type DoctorIssue struct {
+	Kind    DoctorIssueKind `json:"kind"`
+	Message string          `json:"message"`
+}
+
+// DoctorCheck holds the health check result for a single repository
+type DoctorCheck struct {
+	URL       string        `json:"url"`
+	Path      string        `json:"path"`
+	Workspace string        `json:"workspace,omitempty"`
+	OriginURL string        `json:"origin_url,omitempty"`
+	Issues    []DoctorIssue `json:"issues,omitempty"`
+	Fixed     string        `json:"fixed,omitempty"`
+	FixError  string        `json:"fix_error,omitempty"`
+}
+
+// Healthy reports whether no issues were found for the repository
+func (c *DoctorCheck) Healthy() bool {
+	return len(c.Issues) == 0
+}
+
+// HasIssue reports whether the check contains an issue of the given kind
+func (c *DoctorCheck) HasIssue(kind DoctorIssueKind) bool {
+	for _, issue := range c.Issues {
+		if issue.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DoctorReport summarizes the health of all tracked repositories
+type DoctorReport struct {
+	Checks    []DoctorCheck `json:"checks"`
+	Total     int           `json:"total"`
+	Healthy   int           `json:"healthy"`
+	Unhealthy int           `json:"unhealthy"`
+	Pruned    int           `json:"pruned"`
+	Remapped  int           `json:"remapped"`
+}
+
+// RunDoctor checks every tracked repository and optionally fixes stale entries
+func RunDoctor(opts DoctorOptions) (*DoctorReport, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	if opts.RemoteTimeout <= 0 {
+		opts.RemoteTimeout = TimeoutShort
+	}
+
+	report := &DoctorReport{
+		Checks: make([]DoctorCheck, 0, len(repos)),
+		Total:  len(repos),
+	}
+
+	for _, repo := range repos {
+		check := checkRepository(repo, opts)
+
+		if opts.Fix && !check.Healthy() {
+			fixRepository(client, repo, &check, report)
+		}
+
+		if check.Healthy() {
+			report.Healthy++
+		} else {
+			report.Unhealthy++
+		}
+
+		report.Checks = append(report.Checks, check)
+	}
+
+	return report, nil
+}
+
+// checkRepository runs all health checks for a single repository
+func checkRepository(repo model.Repository, opts DoctorOptions) DoctorCheck {
+	check := DoctorCheck{
+		URL:       repo.URL,
+		Path:      repo.Path,
+		Workspace: repo.Workspace,
+	}
+
+	info, err := os.Stat(repo.Path)
+	if err != nil || !info.IsDir() {
+		check.Issues = append(check.Issues, DoctorIssue{
+			Kind:    IssueMissingPath,
+			Message: fmt.Sprintf("path does not exist: %s", repo.Path),
+		})
+
+		return check
+	}
+
+	if _, err := os.Stat(filepath.Join(repo.Path, ".git")); err != nil {
+		check.Issues = append(check.Issues, DoctorIssue{
+			Kind:    IssueNotGitRepo,
+			Message: fmt.Sprintf("not a git repository: %s", repo.Path),
+		})
+
+		return check
+	}
+
+	gitClient := git.NewClientForRepo(repo.Path)
+
+	ctx, cancel := WithTimeout(opts.RemoteTimeout)
+	defer cancel()
+
+	originURL, err := gitClient.GetRemoteURL(ctx, "origin")
+	if err != nil || originURL == "" {
+		check.Issues = append(check.Issues, DoctorIssue{
+			Kind:    IssueNoOriginRemote,
+			Message: "repository has no origin remote",
+		})
+
+		return check
+	}
+
+	check.OriginURL = originURL
+
+	if !SameRepoURL(repo.URL, originURL) {
+		check.Issues = append(check.Issues, DoctorIssue{
+			Kind:    IssueURLMismatch,
+			Message: fmt.Sprintf("origin is %s, database has %s", originURL, repo.URL),
+		})
+	}
+
+	if opts.CheckRemote {
+		if err := gitClient.LsRemote(ctx, originURL); err != nil {
+			check.Issues = append(check.Issues, DoctorIssue{
+				Kind:    IssueRemoteUnreachable,
+				Message: fmt.Sprintf("remote not reachable: %s", originURL),
+			})
+		}
+	}
+
+	return check
+}
+
+// fixRepository prunes or re-maps a stale database entry
+func fixRepository(client *grpc.Client, repo model.Repository, check *DoctorCheck, report *DoctorReport) {
+	storedURL, err := url.Parse(repo.URL)
+	if err != nil {
+		check.FixError = fmt.Sprintf("invalid stored URL: %v", err)
+		return
+	}
+
+	switch {
+	case check.HasIssue(IssueMissingPath), check.HasIssue(IssueNotGitRepo):
+		if err := client.RemoveRepoByURL(storedURL); err != nil {
+			check.FixError = err.Error()
+			return
+		}
+
+		check.Fixed = "pruned"
+		check.Issues = nil
+		report.Pruned++
+
+	case check.HasIssue(IssueURLMismatch):
+		newURL, err := canonicalRepoURL(check.OriginURL)
+		if err != nil {
+			check.FixError = fmt.Sprintf("invalid origin URL: %v", err)
+			return
+		}
+
+		// Updated in place, so a failure leaves the old record tracked and
+		// the workspace, favorite flag and remotes are kept
+		if err := client.UpdateRepoURL(storedURL.String(), newURL.String()); err != nil {
+			check.FixError = err.Error()
+			return
+		}
+
+		check.Fixed = fmt.Sprintf("re-mapped to %s", newURL.String())
+		check.URL = newURL.String()
+		check.Issues = removeIssue(check.Issues, IssueURLMismatch)
+		report.Remapped++
+	}
+}
+
+// SameRepoURL reports whether two git URLs point to the same repository,
+// ignoring scheme, credentials, letter case and a trailing .git suffix.
+func SameRepoURL(a, b string) bool {
+	ua, err := git.ParseURL(a)
+	if err != nil {
+		return false
+	}
+
+	ub, err := git.ParseURL(b)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(ua.Hostname(), ub.Hostname()) &&
+		strings.EqualFold(strings.Trim(ua.Path, "/"), strings.Trim(ub.Path, "/"))
+}
+
+// canonicalRepoURL converts a git remote URL to the https form stored by clonr
+func canonicalRepoURL(remoteURL string) (*url.URL, error) {
+	u, err := git.ParseURL(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Parse(fmt.Sprintf("https://%s/%s", u.Hostname(), strings.Trim(u.Path, "/")))
+}
+
+func removeIssue(issues []DoctorIssue, kind DoctorIssueKind) []DoctorIssue {
+	var result []DoctorIssue
+
+	for _, issue := range issues {
+		if issue.Kind != kind {
+			result = append(result, issue)
+		}
+	}
+
+	return result
+}
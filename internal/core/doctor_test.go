@@ -0,0 +1,90 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestSameRepoURL(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{
+			name: "identical https",
+			a:    "https://github.com/user/repo",
+			b:    "https://github.com/user/repo",
+			want: true,
+		},
+		{
+			name: "git suffix",
+			a:    "https://github.com/user/repo",
+			b:    "https://github.com/user/repo.git",
+			want: true,
+		},
+		{
+			name: "scp-like ssh",
+			a:    "https://github.com/user/repo",
+			b:    "git@github.com:user/repo.git",
+			want: true,
+		},
+		{
+			name: "different case",
+			a:    "https://GitHub.com/User/Repo",
+			b:    "https://github.com/user/repo",
+			want: true,
+		},
+		{
+			name: "credentials in url",
+			a:    "https://github.com/user/repo",
+			b:    "https://token@github.com/user/repo.git",
+			want: true,
+		},
+		{
+			name: "different repo",
+			a:    "https://github.com/user/repo",
+			b:    "https://github.com/user/other",
+			want: false,
+		},
+		{
+			name: "different host",
+			a:    "https://github.com/user/repo",
+			b:    "https://gitlab.com/user/repo",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SameRepoURL(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameRepoURL(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalRepoURL(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"git@github.com:user/repo.git", "https://github.com/user/repo"},
+		{"ssh://git@github.com/user/repo.git", "https://github.com/user/repo"},
+		{"https://token@github.com/user/repo.git", "https://github.com/user/repo"},
+		{"https://github.com/user/repo", "https://github.com/user/repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := canonicalRepoURL(tt.input)
+			if err != nil {
+				t.Fatalf("canonicalRepoURL(%q) error = %v", tt.input, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("canonicalRepoURL(%q) = %q, want %q", tt.input, got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -32,6 +32,25 @@ var DefaultEditors = []EditorInfo{
 	{Name: "Zed", Command: "zed", Icon: ""},
 }
 
+// DefaultTerminals is a list of common terminal applications to check for.
+var DefaultTerminals = []string{
+	"wezterm", "kitty", "alacritty", "ghostty", "gnome-terminal", "konsole",
+	"xfce4-terminal", "tilix", "xterm", "wt",
+}
+
+// InstalledTerminals returns the DefaultTerminals available in PATH.
+func InstalledTerminals() []string {
+	var installed []string
+
+	for _, terminal := range DefaultTerminals {
+		if IsEditorInstalled(terminal) {
+			installed = append(installed, terminal)
+		}
+	}
+
+	return installed
+}
+
 // AddCustomEditor adds a custom editor to the configuration.
 func AddCustomEditor(editor model.Editor) error {
 	if editor.Name == "" {
@@ -0,0 +1,367 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Where a context value comes from
+const (
+	ContextSourceDefault    = "default"
+	ContextSourceConfig     = "config"
+	ContextSourceRepository = "repository"
+	ContextSourceActive     = "active"
+)
+
+// ContextSetting is one effective setting and where its value comes from
+type ContextSetting struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Source string `json:"source"` // default, config or workspace:<name>
+}
+
+// ContextEnv is an environment variable that changes what clonr does
+type ContextEnv struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"` // Secrets are masked
+	Purpose  string `json:"purpose"`
+	Injected bool   `json:"injected,omitempty"` // Set by clonr on git commands rather than read
+}
+
+// ContextIdentity is the git identity commits in the repository get
+type ContextIdentity struct {
+	Name        string `json:"name,omitempty"`
+	Email       string `json:"email,omitempty"`
+	SigningKey  string `json:"signing_key,omitempty"`
+	SignCommits bool   `json:"sign_commits,omitempty"`
+	Origin      string `json:"origin,omitempty"` // Git config file user.email (or user.name) is read from
+}
+
+// EffectiveContext is everything that applies to commands run in a directory
+type EffectiveContext struct {
+	Dir             string            `json:"dir"`
+	GitRoot         string            `json:"git_root,omitempty"`
+	RemoteURL       string            `json:"remote_url,omitempty"`
+	Repository      *model.Repository `json:"repository,omitempty"`
+	Workspace       string            `json:"workspace,omitempty"`
+	WorkspaceSource string            `json:"workspace_source,omitempty"` // repository, pattern:<glob> or active
+	Profile         string            `json:"profile,omitempty"`
+	ProfileSource   string            `json:"profile_source,omitempty"` // workspace:<name> or active
+	Identity        *ContextIdentity  `json:"identity,omitempty"`
+	TokenSource     string            `json:"token_source"`
+	Settings        []ContextSetting  `json:"settings"`
+	Env             []ContextEnv      `json:"env"`
+	Server          string            `json:"server"`
+	ServerSource    string            `json:"server_source"`
+	Warnings        []string          `json:"warnings,omitempty"`
+}
+
+// contextEnvVar describes an environment variable clonr reads
+type contextEnvVar struct {
+	name    string
+	purpose string
+	secret  bool
+}
+
+// contextEnvVars are the environment variables clonr reads, in the order shown
+var contextEnvVars = []contextEnvVar{
+	{"CLONR_SERVER", "server address", false},
+	{OfflineEnv, "air-gapped mode", false},
+	{"GITHUB_TOKEN", "GitHub token", true},
+	{"GH_TOKEN", "GitHub token", true},
+	{"AWS_ACCESS_KEY_ID", "S3 backups", true},
+	{"AWS_SECRET_ACCESS_KEY", "S3 backups", true},
+	{"AWS_SESSION_TOKEN", "S3 backups", true},
+	{"AWS_REGION", "S3 backups", false},
+	{"AWS_DEFAULT_REGION", "S3 backups", false},
+	{"AWS_ENDPOINT_URL_S3", "S3 backups", false},
+	{"AWS_ENDPOINT_URL", "S3 backups", false},
+	{"JIRA_URL", "Jira", false},
+	{"JIRA_EMAIL", "Jira", false},
+	{"JIRA_API_TOKEN", "Jira token", true},
+	{"ATLASSIAN_TOKEN", "Jira token", true},
+	{"ZENHUB_TOKEN", "ZenHub token", true},
+	{"GOOGLE_CLIENT_ID", "Gmail OAuth", false},
+	{"GOOGLE_CLIENT_SECRET", "Gmail OAuth", true},
+	{"AZURE_CLIENT_ID", "Outlook/Teams OAuth", false},
+	{"AZURE_CLIENT_SECRET", "Outlook/Teams OAuth", true},
+	{"SLACK_CLIENT_ID", "Slack OAuth", false},
+	{"SLACK_CLIENT_SECRET", "Slack OAuth", true},
+}
+
+// ResolveContext works out the repository, workspace, profile, settings,
+// environment and server that apply to commands run in dir ("" for the
+// current directory).
+func ResolveContext(dir string) (*EffectiveContext, error) {
+	if dir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		dir = wd
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	ec := &EffectiveContext{Dir: dir}
+	ec.Server, ec.ServerSource = client.Address()
+
+	if root, err := runGitCommand("-C", dir, "rev-parse", "--show-toplevel"); err == nil {
+		ec.GitRoot = filepath.Clean(strings.TrimSpace(root))
+
+		if remote, err := runGitCommand("-C", dir, "remote", "get-url", "origin"); err == nil {
+			ec.RemoteURL = strings.TrimSpace(remote)
+		}
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	ec.Repository = repoForDir(repos, dir)
+
+	workspaces, err := client.ListWorkspaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	key := RepoKey(ec.RemoteURL)
+	if ec.Repository != nil {
+		key = RepoKey(ec.Repository.URL)
+	}
+
+	ec.Workspace, ec.WorkspaceSource = contextWorkspace(ec.Repository, workspaces, key)
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	profile, profileSource := contextProfile(profiles, ec.Workspace)
+	if profile != nil {
+		ec.Profile, ec.ProfileSource = profile.Name, profileSource
+	}
+
+	host := model.DefaultHost()
+	if i := strings.Index(key, "/"); i > 0 {
+		host = key[:i]
+	}
+
+	_, tokenSource, _ := ResolveGitHubTokenForHost("", "", host)
+	ec.TokenSource = string(tokenSource)
+
+	if ec.GitRoot != "" {
+		ec.Identity = gitIdentity(ec.GitRoot)
+
+		if expected := IdentityProfile(profiles, ec.Workspace); expected != nil && expected.GitEmail != "" &&
+			!strings.EqualFold(expected.GitEmail, ec.Identity.Email) {
+			ec.Warnings = append(ec.Warnings, fmt.Sprintf("profile %s sets user.email %s but git uses %q (run 'clonr identity apply')",
+				expected.Name, expected.GitEmail, ec.Identity.Email))
+		}
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	ec.Settings = contextSettings(cfg, findWorkspace(workspaces, ec.Workspace))
+	ec.Env = contextEnv(os.Getenv)
+
+	if ec.GitRoot != "" && ec.Repository == nil {
+		ec.Warnings = append(ec.Warnings, "this git repository is not tracked by clonr (run 'clonr add')")
+	}
+
+	return ec, nil
+}
+
+// repoForDir returns the tracked repository containing dir. With nested
+// entries (monorepo subdirectories) the deepest one wins.
+func repoForDir(repos []model.Repository, dir string) *model.Repository {
+	var best *model.Repository
+
+	for i := range repos {
+		path := filepath.Clean(repos[i].Path)
+		if dir != path && !strings.HasPrefix(dir, path+string(filepath.Separator)) {
+			continue
+		}
+
+		if best == nil || len(path) > len(filepath.Clean(best.Path)) {
+			best = &repos[i]
+		}
+	}
+
+	return best
+}
+
+// contextWorkspace picks the workspace the same way clone does: the tracked
+// repository's workspace, then URL patterns, then the active workspace.
+func contextWorkspace(repo *model.Repository, workspaces []model.Workspace, repoKey string) (string, string) {
+	if repo != nil && repo.Workspace != "" {
+		return repo.Workspace, ContextSourceRepository
+	}
+
+	if match := MatchWorkspace(workspaces, repoKey); match != nil {
+		return match.Workspace.Name, "pattern:" + match.Pattern
+	}
+
+	for _, ws := range workspaces {
+		if ws.Active {
+			return ws.Name, ContextSourceActive
+		}
+	}
+
+	return "", ""
+}
+
+// contextProfile returns the profile bound to the workspace, otherwise the
+// active (default) profile
+func contextProfile(profiles []model.Profile, workspace string) (*model.Profile, string) {
+	var active *model.Profile
+
+	for i := range profiles {
+		if workspace != "" && profiles[i].Workspace == workspace {
+			return &profiles[i], "workspace:" + workspace
+		}
+
+		if profiles[i].Default && active == nil {
+			active = &profiles[i]
+		}
+	}
+
+	if active != nil {
+		return active, ContextSourceActive
+	}
+
+	return nil, ""
+}
+
+// gitIdentity reads the identity git itself resolves in a repository
+func gitIdentity(root string) *ContextIdentity {
+	get := func(key string) string {
+		out, err := runGitCommand("-C", root, "config", "--get", key)
+		if err != nil {
+			return ""
+		}
+
+		return strings.TrimSpace(out)
+	}
+
+	identity := &ContextIdentity{
+		Name:        get("user.name"),
+		Email:       get("user.email"),
+		SigningKey:  get("user.signingkey"),
+		SignCommits: get("commit.gpgsign") == "true",
+	}
+
+	for _, key := range []string{"user.email", "user.name"} {
+		out, err := runGitCommand("-C", root, "config", "--show-origin", "--get", key)
+		if err != nil {
+			continue
+		}
+
+		if origin, _, ok := strings.Cut(strings.TrimSpace(out), "\t"); ok {
+			identity.Origin = strings.TrimPrefix(origin, "file:")
+			break
+		}
+	}
+
+	return identity
+}
+
+// contextSettings lists the effective configuration values and whether each
+// is a default, set in the config or overridden by the workspace
+func contextSettings(cfg *model.Config, ws *model.Workspace) []ContextSetting {
+	defaults := model.DefaultConfig()
+
+	source := func(changed bool) string {
+		if changed {
+			return ContextSourceConfig
+		}
+
+		return ContextSourceDefault
+	}
+
+	cloneDir := ContextSetting{Key: "clone_dir", Value: cfg.DefaultCloneDir, Source: source(cfg.DefaultCloneDir != defaults.DefaultCloneDir)}
+	if ws != nil && ws.Path != "" {
+		cloneDir.Value, cloneDir.Source = ws.Path, "workspace:"+ws.Name
+	}
+
+	settings := []ContextSetting{
+		cloneDir,
+		{Key: "editor", Value: cfg.Editor, Source: source(cfg.Editor != defaults.Editor)},
+		{Key: "terminal", Value: cfg.Terminal, Source: source(cfg.Terminal != defaults.Terminal)},
+		{Key: "monitor_interval", Value: strconv.Itoa(cfg.MonitorInterval), Source: source(cfg.MonitorInterval != defaults.MonitorInterval)},
+		{Key: "server_port", Value: strconv.Itoa(cfg.ServerPort), Source: source(cfg.ServerPort != defaults.ServerPort)},
+		{Key: "key_rotation_days", Value: strconv.Itoa(cfg.KeyRotationDays), Source: source(cfg.KeyRotationDays != defaults.KeyRotationDays)},
+	}
+
+	if cfg.ListSort != "" {
+		settings = append(settings, ContextSetting{Key: "list_sort", Value: cfg.ListSort, Source: ContextSourceConfig})
+	}
+
+	if len(cfg.ListColumns) > 0 {
+		settings = append(settings, ContextSetting{Key: "list_columns", Value: strings.Join(cfg.ListColumns, ","), Source: ContextSourceConfig})
+	}
+
+	for _, rw := range cfg.URLRewrites {
+		settings = append(settings, ContextSetting{Key: "url_rewrite." + rw.InsteadOf, Value: rw.Base, Source: ContextSourceConfig})
+	}
+
+	if !cfg.Backup.IsZero() {
+		settings = append(settings, ContextSetting{Key: "backup", Value: BackupDestination(cfg.Backup.Dest) + " (" + DescribeRetention(cfg.Backup) + ")", Source: ContextSourceConfig})
+	}
+
+	if ws != nil && ws.DiskBudget > 0 {
+		settings = append(settings, ContextSetting{Key: "disk_budget", Value: strconv.FormatInt(ws.DiskBudget, 10), Source: "workspace:" + ws.Name})
+	}
+
+	return settings
+}
+
+// contextEnv lists the set environment variables clonr reads, and the ones it
+// injects into git commands
+func contextEnv(getenv func(string) string) []ContextEnv {
+	var env []ContextEnv
+
+	for _, v := range contextEnvVars {
+		value := getenv(v.name)
+		if value == "" {
+			continue
+		}
+
+		if v.secret {
+			value = maskSecret(value)
+		}
+
+		env = append(env, ContextEnv{Name: v.name, Value: value, Purpose: v.purpose})
+	}
+
+	return append(env, ContextEnv{Name: "GIT_TERMINAL_PROMPT", Value: "0", Purpose: "git never prompts for credentials", Injected: true})
+}
+
+// maskSecret keeps the first 4 characters of a secret when it is long enough
+// that they do not give much of it away
+func maskSecret(s string) string {
+	if len(s) < 12 {
+		return "****"
+	}
+
+	return s[:4] + "****"
+}
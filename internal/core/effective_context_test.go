@@ -0,0 +1,144 @@
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestRepoForDir(t *testing.T) {
+	base := t.TempDir()
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/mono", Path: filepath.Join(base, "mono")},
+		{URL: "https://github.com/acme/mono#services/billing", Path: filepath.Join(base, "mono", "services", "billing")},
+		{URL: "https://github.com/acme/monolith", Path: filepath.Join(base, "monolith")},
+	}
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{filepath.Join(base, "mono"), "https://github.com/acme/mono"},
+		{filepath.Join(base, "mono", "docs"), "https://github.com/acme/mono"},
+		{filepath.Join(base, "mono", "services", "billing", "cmd"), "https://github.com/acme/mono#services/billing"},
+		{filepath.Join(base, "monolith"), "https://github.com/acme/monolith"},
+		{filepath.Join(base, "other"), ""},
+	}
+
+	for _, tt := range tests {
+		got := ""
+		if repo := repoForDir(repos, tt.dir); repo != nil {
+			got = repo.URL
+		}
+
+		if got != tt.want {
+			t.Errorf("repoForDir(%q) = %q, want %q", tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestContextWorkspace(t *testing.T) {
+	workspaces := []model.Workspace{
+		{Name: "personal", Active: true},
+		{Name: "work", URLPatterns: []string{"github.com/acme/*"}},
+	}
+
+	tests := []struct {
+		name       string
+		repo       *model.Repository
+		key        string
+		want       string
+		wantSource string
+	}{
+		{"tracked repository", &model.Repository{Workspace: "personal"}, "github.com/acme/api", "personal", ContextSourceRepository},
+		{"url pattern", nil, "github.com/acme/api", "work", "pattern:github.com/acme/*"},
+		{"active workspace", nil, "github.com/other/api", "personal", ContextSourceActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, source := contextWorkspace(tt.repo, workspaces, tt.key)
+			if got != tt.want || source != tt.wantSource {
+				t.Errorf("contextWorkspace() = %q, %q, want %q, %q", got, source, tt.want, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestContextProfile(t *testing.T) {
+	profiles := []model.Profile{
+		{Name: "personal", Default: true},
+		{Name: "work", Workspace: "work"},
+	}
+
+	if p, source := contextProfile(profiles, "work"); p == nil || p.Name != "work" || source != "workspace:work" {
+		t.Errorf("contextProfile(work) = %v, %q", p, source)
+	}
+
+	if p, source := contextProfile(profiles, "oss"); p == nil || p.Name != "personal" || source != ContextSourceActive {
+		t.Errorf("contextProfile(oss) = %v, %q", p, source)
+	}
+
+	if p, _ := contextProfile(nil, "work"); p != nil {
+		t.Errorf("contextProfile() without profiles = %v", p)
+	}
+}
+
+func TestContextSettings(t *testing.T) {
+	cfg := model.DefaultConfig()
+	cfg.Editor = "vim"
+	cfg.URLRewrites = []model.URLRewrite{{Base: "git@github.com:", InsteadOf: "gh:"}}
+
+	settings := map[string]ContextSetting{}
+	for _, s := range contextSettings(&cfg, &model.Workspace{Name: "work", Path: "/src/work"}) {
+		settings[s.Key] = s
+	}
+
+	if s := settings["clone_dir"]; s.Value != "/src/work" || s.Source != "workspace:work" {
+		t.Errorf("clone_dir = %+v", s)
+	}
+
+	if s := settings["editor"]; s.Value != "vim" || s.Source != ContextSourceConfig {
+		t.Errorf("editor = %+v", s)
+	}
+
+	if s := settings["server_port"]; s.Source != ContextSourceDefault {
+		t.Errorf("server_port = %+v", s)
+	}
+
+	if s := settings["url_rewrite.gh:"]; s.Value != "git@github.com:" {
+		t.Errorf("url_rewrite = %+v", s)
+	}
+}
+
+func TestContextEnv(t *testing.T) {
+	vars := map[string]string{
+		"CLONR_SERVER": "localhost:50052",
+		"GITHUB_TOKEN": "ghp_0123456789abcdef",
+		"ZENHUB_TOKEN": "short",
+	}
+
+	env := contextEnv(func(name string) string { return vars[name] })
+
+	got := map[string]ContextEnv{}
+	for _, e := range env {
+		got[e.Name] = e
+	}
+
+	if len(env) != 4 {
+		t.Errorf("contextEnv() returned %d entries: %+v", len(env), env)
+	}
+
+	if got["CLONR_SERVER"].Value != "localhost:50052" {
+		t.Errorf("CLONR_SERVER = %+v", got["CLONR_SERVER"])
+	}
+
+	if got["GITHUB_TOKEN"].Value != "ghp_****" || got["ZENHUB_TOKEN"].Value != "****" {
+		t.Errorf("secrets not masked: %+v, %+v", got["GITHUB_TOKEN"], got["ZENHUB_TOKEN"])
+	}
+
+	if !got["GIT_TERMINAL_PROMPT"].Injected {
+		t.Error("GIT_TERMINAL_PROMPT not listed as injected")
+	}
+}
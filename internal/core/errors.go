@@ -49,6 +49,7 @@ const (
 	SkipReasonArchived
 	SkipReasonFiltered
 	SkipReasonNotGitRepo
+	SkipReasonIgnored
 )
 
 func (r SkipReason) String() string {
@@ -65,6 +66,8 @@ func (r SkipReason) String() string {
 		return "filtered out"
 	case SkipReasonNotGitRepo:
 		return "not a git repository"
+	case SkipReasonIgnored:
+		return "ignored"
 	}
 
 	return ""
@@ -71,6 +71,7 @@ func TestSkipReason_String(t *testing.T) {
 		{SkipReasonArchived, "archived"},
 		{SkipReasonFiltered, "filtered out"},
 		{SkipReasonNotGitRepo, "not a git repository"},
+		{SkipReasonIgnored, "ignored"},
 		{SkipReason(99), ""}, // Unknown reason
 	}
 
@@ -150,6 +151,10 @@ func TestSkipReasonConstants(t *testing.T) {
 	if SkipReasonNotGitRepo != 5 {
 		t.Errorf("SkipReasonNotGitRepo = %d, want 5", SkipReasonNotGitRepo)
 	}
+
+	if SkipReasonIgnored != 6 {
+		t.Errorf("SkipReasonIgnored = %d, want 6", SkipReasonIgnored)
+	}
 }
 
 func TestDirtyStrategyConstants(t *testing.T) {
@@ -0,0 +1,255 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// filterCheckWorkers bounds the concurrent on-disk checks of a saved filter
+const filterCheckWorkers = 8
+
+// projectMarkers maps manifest files to the project language they indicate.
+// More specific markers come first (tsconfig.json before package.json).
+var projectMarkers = []struct {
+	file     string
+	language string
+}{
+	{"go.mod", "go"},
+	{"Cargo.toml", "rust"},
+	{"tsconfig.json", "typescript"},
+	{"package.json", "javascript"},
+	{"pyproject.toml", "python"},
+	{"setup.py", "python"},
+	{"requirements.txt", "python"},
+	{"build.gradle.kts", "kotlin"},
+	{"pom.xml", "java"},
+	{"build.gradle", "java"},
+	{"Gemfile", "ruby"},
+	{"composer.json", "php"},
+	{"mix.exs", "elixir"},
+	{"Package.swift", "swift"},
+	{"pubspec.yaml", "dart"},
+	{"CMakeLists.txt", "c++"},
+}
+
+// languageAliases maps common shorthands to the names used by DetectLanguage
+var languageAliases = map[string]string{
+	"golang": "go",
+	"js":     "javascript",
+	"node":   "javascript",
+	"ts":     "typescript",
+	"py":     "python",
+	"rs":     "rust",
+	"kt":     "kotlin",
+	"rb":     "ruby",
+	"cpp":    "c++",
+}
+
+// filterSorts are the sort orders a saved filter accepts
+var filterSorts = []SortBy{SortByName, SortByClonedAt, SortByUpdatedAt, SortByCommits, SortByRecentCommits, SortByChanges}
+
+// DetectLanguage returns the project language of the repository at path,
+// based on the manifest files in its root, or "" if none is recognized.
+func DetectLanguage(path string) string {
+	for _, m := range projectMarkers {
+		if _, err := os.Stat(filepath.Join(path, m.file)); err == nil {
+			return m.language
+		}
+	}
+
+	return ""
+}
+
+// NormalizeLanguage lowercases a language name and resolves shorthands
+func NormalizeLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if alias, ok := languageAliases[language]; ok {
+		return alias
+	}
+
+	return language
+}
+
+// ValidateFilter normalizes a saved filter and checks its criteria
+func ValidateFilter(filter *model.SavedFilter) error {
+	if filter.Name == "" {
+		return fmt.Errorf("filter name is required")
+	}
+
+	if strings.ContainsAny(filter.Name, " \t/") {
+		return fmt.Errorf("invalid filter name %q: must not contain spaces or slashes", filter.Name)
+	}
+
+	filter.Language = NormalizeLanguage(filter.Language)
+
+	if filter.Kind != "" {
+		kind, err := model.ParseRepoKind(string(filter.Kind))
+		if err != nil {
+			return err
+		}
+
+		filter.Kind = kind
+	}
+
+	if filter.Sort != "" && !slices.Contains(filterSorts, SortBy(filter.Sort)) {
+		return fmt.Errorf("invalid sort %q: use name, cloned, updated, commits, recent or changes", filter.Sort)
+	}
+
+	return nil
+}
+
+// matchesLocal reports whether the repository on disk passes the filter's
+// dirty and language criteria. Missing repositories never match.
+func matchesLocal(filter *model.SavedFilter, repo model.Repository) bool {
+	if !filter.NeedsLocalCheck() {
+		return true
+	}
+
+	if _, err := os.Stat(repo.Path); err != nil {
+		return false
+	}
+
+	if filter.Language != "" && DetectLanguage(repo.Path) != filter.Language {
+		return false
+	}
+
+	if filter.Dirty && !isRepoDirty(repo.Path) {
+		return false
+	}
+
+	return true
+}
+
+// FilterRepos returns the repositories that pass the filter's on-disk
+// criteria, checking them concurrently and preserving order.
+func FilterRepos(filter *model.SavedFilter, repos []model.Repository) []model.Repository {
+	if !filter.NeedsLocalCheck() {
+		return repos
+	}
+
+	keep := make([]bool, len(repos))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(filterCheckWorkers, len(repos)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				keep[i] = matchesLocal(filter, repos[i])
+			}
+		}()
+	}
+
+	for i := range repos {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	matched := make([]model.Repository, 0, len(repos))
+
+	for i, repo := range repos {
+		if keep[i] {
+			matched = append(matched, repo)
+		}
+	}
+
+	return matched
+}
+
+// ViewRepos returns the repositories matching a saved filter, in its sort order
+func ViewRepos(filter *model.SavedFilter) ([]RepoWithStats, error) {
+	repos, err := ListReposPaged(filter.RepoFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	repos = FilterRepos(filter, repos)
+
+	sortBy := SortBy(filter.Sort)
+	if sortBy == "" {
+		sortBy = SortByName
+	}
+
+	withStats := sortBy == SortByCommits || sortBy == SortByRecentCommits || sortBy == SortByChanges
+
+	result := make([]RepoWithStats, len(repos))
+	for i, repo := range repos {
+		result[i] = RepoWithStats{Repository: repo}
+
+		if withStats {
+			if stats, err := GetRepoStats(repo.Path); err == nil {
+				result[i].Stats = stats
+			}
+		}
+	}
+
+	sortRepos(result, sortBy)
+
+	return result, nil
+}
+
+// SaveFilter validates and stores a saved filter
+func SaveFilter(filter *model.SavedFilter) error {
+	if err := ValidateFilter(filter); err != nil {
+		return err
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.SaveFilter(filter)
+}
+
+// GetFilter returns the saved filter with the given name
+func GetFilter(name string) (*model.SavedFilter, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	filter, err := client.GetFilter(name)
+	if err != nil {
+		return nil, fmt.Errorf("filter '%s': %w", name, err)
+	}
+
+	return filter, nil
+}
+
+// ListFilters returns all saved filters
+func ListFilters() ([]model.SavedFilter, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.ListFilters()
+}
+
+// DeleteFilter removes a saved filter
+func DeleteFilter(name string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if _, err := client.GetFilter(name); err != nil {
+		return fmt.Errorf("filter '%s': %w", name, err)
+	}
+
+	return client.DeleteFilter(name)
+}
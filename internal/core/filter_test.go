@@ -0,0 +1,136 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		want  string
+	}{
+		{name: "go", files: []string{"go.mod"}, want: "go"},
+		{name: "typescript wins over javascript", files: []string{"package.json", "tsconfig.json"}, want: "typescript"},
+		{name: "javascript", files: []string{"package.json"}, want: "javascript"},
+		{name: "python", files: []string{"requirements.txt"}, want: "python"},
+		{name: "unknown", files: []string{"README.md"}},
+		{name: "empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for _, f := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, f), nil, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := DetectLanguage(dir); got != tt.want {
+				t.Errorf("DetectLanguage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   model.SavedFilter
+		wantErr  bool
+		wantLang string
+	}{
+		{name: "valid", filter: model.SavedFilter{Name: "dirty-go", Language: "Golang", Sort: "updated"}, wantLang: "go"},
+		{name: "missing name", filter: model.SavedFilter{}, wantErr: true},
+		{name: "name with space", filter: model.SavedFilter{Name: "dirty go"}, wantErr: true},
+		{name: "bad sort", filter: model.SavedFilter{Name: "x", Sort: "size"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFilter(&tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && tt.filter.Language != tt.wantLang {
+				t.Errorf("Language = %q, want %q", tt.filter.Language, tt.wantLang)
+			}
+		})
+	}
+}
+
+func TestFilterRepos(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	newRepo := func(t *testing.T, manifest string, dirty bool) string {
+		t.Helper()
+
+		dir := t.TempDir()
+		if err := exec.Command("git", "init", "-q", dir).Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		if manifest != "" {
+			if err := os.WriteFile(filepath.Join(dir, manifest), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			if !dirty {
+				if err := exec.Command("git", "-C", dir, "add", "-A").Run(); err != nil {
+					t.Fatal(err)
+				}
+
+				commit := exec.Command("git", "-C", dir, "-c", "user.name=t", "-c", "user.email=t@t", "commit", "-qm", "init")
+				if err := commit.Run(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		return dir
+	}
+
+	repos := []model.Repository{
+		{URL: "dirty-go", Path: newRepo(t, "go.mod", true)},
+		{URL: "clean-go", Path: newRepo(t, "go.mod", false)},
+		{URL: "dirty-rust", Path: newRepo(t, "Cargo.toml", true)},
+		{URL: "missing", Path: filepath.Join(t.TempDir(), "gone")},
+	}
+
+	tests := []struct {
+		name   string
+		filter model.SavedFilter
+		want   []string
+	}{
+		{name: "no local criteria", filter: model.SavedFilter{}, want: []string{"dirty-go", "clean-go", "dirty-rust", "missing"}},
+		{name: "dirty", filter: model.SavedFilter{Dirty: true}, want: []string{"dirty-go", "dirty-rust"}},
+		{name: "language", filter: model.SavedFilter{Language: "go"}, want: []string{"dirty-go", "clean-go"}},
+		{name: "dirty go", filter: model.SavedFilter{Dirty: true, Language: "go"}, want: []string{"dirty-go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterRepos(&tt.filter, repos)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("FilterRepos() returned %d repos, want %d", len(got), len(tt.want))
+			}
+
+			for i, repo := range got {
+				if repo.URL != tt.want[i] {
+					t.Errorf("FilterRepos()[%d] = %s, want %s", i, repo.URL, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+const fleetReportStateFile = "fleet_report.json"
+
+// FleetReportInterval is how often the scheduled fleet report is delivered
+const FleetReportInterval = 7 * 24 * time.Hour
+
+// FleetReportOptions configures the fleet report
+type FleetReportOptions struct {
+	Period     time.Duration // Window for repository growth (default: 7 days)
+	StaleAfter time.Duration // Branches without commits for this long are stale (default: 90 days)
+	Top        int           // Number of largest repositories listed (default: 10)
+}
+
+// DefaultFleetReportOptions returns the options used by the scheduled report
+func DefaultFleetReportOptions() FleetReportOptions {
+	return FleetReportOptions{
+		Period:     FleetReportInterval,
+		StaleAfter: 90 * 24 * time.Hour,
+		Top:        10,
+	}
+}
+
+// StaleBranch is a local branch whose last commit is older than the stale threshold
+type StaleBranch struct {
+	URL        string    `json:"url"`
+	Branch     string    `json:"branch"`
+	LastCommit time.Time `json:"last_commit"`
+}
+
+// RepoDiskUsage is the on-disk size of a repository
+type RepoDiskUsage struct {
+	URL   string `json:"url"`
+	Path  string `json:"path"`
+	Bytes int64  `json:"bytes"`
+}
+
+// FleetReport summarizes the state of all tracked repositories
+type FleetReport struct {
+	GeneratedAt    time.Time       `json:"generated_at"`
+	Since          time.Time       `json:"since"`
+	TotalRepos     int             `json:"total_repos"`
+	PreviousTotal  int             `json:"previous_total"`
+	NewRepos       []string        `json:"new_repos,omitempty"`
+	UpdateFailures []UpdateFailure `json:"update_failures,omitempty"`
+	StaleBranches  []StaleBranch   `json:"stale_branches,omitempty"`
+	LargestRepos   []RepoDiskUsage `json:"largest_repos,omitempty"`
+	TotalBytes     int64           `json:"total_bytes"`
+}
+
+// Growth returns the change in tracked repositories since the previous report
+func (r *FleetReport) Growth() int {
+	return r.TotalRepos - r.PreviousTotal
+}
+
+// fleetReportState records the last delivered fleet report
+type fleetReportState struct {
+	SentAt     time.Time `json:"sent_at"`
+	TotalRepos int       `json:"total_repos"`
+}
+
+// loadFleetReportState reads the last delivered report state, if any
+func loadFleetReportState() (fleetReportState, error) {
+	loaded, err := encoding.LoadJSON[fleetReportState](filepath.Join(params.AppdataDir, fleetReportStateFile))
+	if err != nil {
+		return fleetReportState{}, fmt.Errorf("failed to load fleet report state: %w", err)
+	}
+
+	if loaded == nil {
+		return fleetReportState{}, nil
+	}
+
+	return *loaded, nil
+}
+
+// BuildFleetReport compiles repository growth, update failures, stale branches
+// and disk usage for the given repositories
+func BuildFleetReport(repos []model.Repository, opts FleetReportOptions) (*FleetReport, error) {
+	defaults := DefaultFleetReportOptions()
+	if opts.Period <= 0 {
+		opts.Period = defaults.Period
+	}
+
+	if opts.StaleAfter <= 0 {
+		opts.StaleAfter = defaults.StaleAfter
+	}
+
+	if opts.Top <= 0 {
+		opts.Top = defaults.Top
+	}
+
+	state, err := loadFleetReportState()
+	if err != nil {
+		return nil, err
+	}
+
+	failures, err := ListUpdateFailures()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	report := &FleetReport{
+		GeneratedAt:   now,
+		Since:         now.Add(-opts.Period),
+		TotalRepos:    len(repos),
+		PreviousTotal: state.TotalRepos,
+	}
+
+	tracked := make(map[string]bool, len(repos))
+
+	for _, repo := range repos {
+		tracked[repo.URL] = true
+
+		if repo.ClonedAt.After(report.Since) {
+			report.NewRepos = append(report.NewRepos, repo.URL)
+		}
+
+		size := dirSize(repo.Path)
+		report.TotalBytes += size
+		report.LargestRepos = append(report.LargestRepos, RepoDiskUsage{URL: repo.URL, Path: repo.Path, Bytes: size})
+
+		for _, b := range staleBranches(repo.Path, now.Add(-opts.StaleAfter)) {
+			b.URL = repo.URL
+			report.StaleBranches = append(report.StaleBranches, b)
+		}
+	}
+
+	if state.SentAt.IsZero() {
+		report.PreviousTotal = report.TotalRepos - len(report.NewRepos)
+	}
+
+	for _, f := range failures {
+		if tracked[f.URL] {
+			report.UpdateFailures = append(report.UpdateFailures, f)
+		}
+	}
+
+	sort.Slice(report.LargestRepos, func(i, j int) bool {
+		return report.LargestRepos[i].Bytes > report.LargestRepos[j].Bytes
+	})
+
+	if len(report.LargestRepos) > opts.Top {
+		report.LargestRepos = report.LargestRepos[:opts.Top]
+	}
+
+	sort.Slice(report.StaleBranches, func(i, j int) bool {
+		return report.StaleBranches[i].LastCommit.Before(report.StaleBranches[j].LastCommit)
+	})
+
+	return report, nil
+}
+
+// staleBranches returns local branches whose last commit is before cutoff
+func staleBranches(repoPath string, cutoff time.Time) []StaleBranch {
+	output, err := exec.Command("git", "-C", repoPath, "for-each-ref",
+		"--format=%(refname:short)%09%(committerdate:unix)", "refs/heads").Output()
+	if err != nil {
+		return nil
+	}
+
+	return parseStaleBranches(string(output), cutoff)
+}
+
+// parseStaleBranches parses "branch<TAB>unix-time" lines and keeps those older than cutoff
+func parseStaleBranches(output string, cutoff time.Time) []StaleBranch {
+	var stale []StaleBranch
+
+	for line := range strings.SplitSeq(strings.TrimSpace(output), "\n") {
+		name, ts, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+
+		sec, err := strconv.ParseInt(strings.TrimSpace(ts), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		last := time.Unix(sec, 0)
+		if last.Before(cutoff) {
+			stale = append(stale, StaleBranch{Branch: name, LastCommit: last})
+		}
+	}
+
+	return stale
+}
+
+// FormatFleetReport renders the report as a plain-text digest
+func FormatFleetReport(r *FleetReport) string {
+	var b strings.Builder
+
+	_, _ = fmt.Fprintf(&b, "Fleet report %s - %s\n\n",
+		r.Since.Local().Format("2006-01-02"), r.GeneratedAt.Local().Format("2006-01-02"))
+
+	_, _ = fmt.Fprintf(&b, "Repositories: %d (%+d since last report, %d new)\n", r.TotalRepos, r.Growth(), len(r.NewRepos))
+
+	for _, u := range r.NewRepos {
+		_, _ = fmt.Fprintf(&b, "  + %s\n", u)
+	}
+
+	_, _ = fmt.Fprintf(&b, "\nUpdate failures: %d\n", len(r.UpdateFailures))
+
+	for _, f := range r.UpdateFailures {
+		_, _ = fmt.Fprintf(&b, "  ! %s (%s): %s\n", f.URL, f.FailedAt.Local().Format("2006-01-02"), f.Error)
+	}
+
+	_, _ = fmt.Fprintf(&b, "\nStale branches: %d\n", len(r.StaleBranches))
+
+	for _, s := range r.StaleBranches {
+		_, _ = fmt.Fprintf(&b, "  - %s %s (last commit %s)\n", s.URL, s.Branch, s.LastCommit.Local().Format("2006-01-02"))
+	}
+
+	_, _ = fmt.Fprintf(&b, "\nDisk usage: %s\n", FormatSize(r.TotalBytes))
+
+	for _, d := range r.LargestRepos {
+		_, _ = fmt.Fprintf(&b, "  %10s  %s\n", FormatSize(d.Bytes), d.URL)
+	}
+
+	return b.String()
+}
+
+// FleetReportDue reports whether the scheduled fleet report should be sent
+func FleetReportDue(now time.Time) (bool, error) {
+	state, err := loadFleetReportState()
+	if err != nil {
+		return false, err
+	}
+
+	return state.SentAt.IsZero() || now.Sub(state.SentAt) >= FleetReportInterval, nil
+}
+
+// SendFleetReport delivers the report through dispatcher and records it as
+// the baseline for the next report's growth figures
+func SendFleetReport(ctx context.Context, r *FleetReport, dispatcher *notify.Dispatcher) error {
+	dispatcher.Dispatch(ctx, fleetReportEvent(r))
+
+	state := fleetReportState{SentAt: r.GeneratedAt, TotalRepos: r.TotalRepos}
+	if err := encoding.SaveJSON(filepath.Join(params.AppdataDir, fleetReportStateFile), state); err != nil {
+		return fmt.Errorf("failed to save fleet report state: %w", err)
+	}
+
+	return nil
+}
+
+// fleetReportEvent builds the notification carrying the fleet report digest
+func fleetReportEvent(r *FleetReport) *notify.Event {
+	return notify.NewEvent(notify.EventFleetReport).
+		WithExtra("digest", FormatFleetReport(r)).
+		WithExtra("total_repos", strconv.Itoa(r.TotalRepos)).
+		WithExtra("growth", fmt.Sprintf("%+d", r.Growth())).
+		WithExtra("update_failures", strconv.Itoa(len(r.UpdateFailures))).
+		WithExtra("stale_branches", strconv.Itoa(len(r.StaleBranches))).
+		WithExtra("disk_usage", FormatSize(r.TotalBytes))
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseStaleBranches(t *testing.T) {
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := cutoff.Add(-48 * time.Hour).Unix()
+	recent := cutoff.Add(48 * time.Hour).Unix()
+
+	output := fmt.Sprintf("main\t%d\nfeature/old\t%d\nbroken\nbad-time\tabc\n", recent, old)
+
+	got := parseStaleBranches(output, cutoff)
+	if len(got) != 1 {
+		t.Fatalf("parseStaleBranches() returned %d branches, want 1", len(got))
+	}
+
+	if got[0].Branch != "feature/old" || got[0].LastCommit.Unix() != old {
+		t.Errorf("parseStaleBranches()[0] = %+v, want feature/old at %d", got[0], old)
+	}
+}
+
+func TestFormatFleetReport(t *testing.T) {
+	now := time.Date(2026, 1, 8, 12, 0, 0, 0, time.UTC)
+
+	report := &FleetReport{
+		GeneratedAt:    now,
+		Since:          now.Add(-FleetReportInterval),
+		TotalRepos:     12,
+		PreviousTotal:  10,
+		NewRepos:       []string{"https://github.com/acme/new"},
+		UpdateFailures: []UpdateFailure{{URL: "https://github.com/acme/broken", Error: "not fast-forward", FailedAt: now}},
+		StaleBranches:  []StaleBranch{{URL: "https://github.com/acme/api", Branch: "wip", LastCommit: now.AddDate(0, -6, 0)}},
+		LargestRepos:   []RepoDiskUsage{{URL: "https://github.com/acme/big", Bytes: 2048}},
+		TotalBytes:     4096,
+	}
+
+	if got := report.Growth(); got != 2 {
+		t.Errorf("Growth() = %d, want 2", got)
+	}
+
+	digest := FormatFleetReport(report)
+
+	for _, want := range []string{
+		"Repositories: 12 (+2 since last report, 1 new)",
+		"+ https://github.com/acme/new",
+		"Update failures: 1",
+		"https://github.com/acme/broken",
+		"Stale branches: 1",
+		"https://github.com/acme/api wip",
+		"Disk usage: 4.0 KB",
+		"https://github.com/acme/big",
+	} {
+		if !strings.Contains(digest, want) {
+			t.Errorf("FormatFleetReport() missing %q in:\n%s", want, digest)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// forkCloneAttempts bounds the clone retries while GitHub creates a fork in
+// the background
+const forkCloneAttempts = 5
+
+// ForkOptions configures ForkRepo
+type ForkOptions struct {
+	Organization      string // Fork into this organization instead of the user account
+	Name              string // Name of the fork (default: the repository name)
+	DefaultBranchOnly bool   // Fork only the default branch
+	Workspace         string // Workspace to clone into (default: routing as for clones)
+	Protocol          string // Protocol of both remotes, https or ssh (default: https)
+}
+
+// ForkResult describes a forked and cloned repository
+type ForkResult struct {
+	URL         string `json:"url"`
+	UpstreamURL string `json:"upstream_url"`
+	Path        string `json:"path"`
+	Workspace   string `json:"workspace,omitempty"`
+}
+
+// ForkRepo forks a GitHub repository, clones the fork like 'clonr clone',
+// adds the original repository as the "upstream" remote and records it as
+// the upstream of the tracked fork.
+func ForkRepo(token, repoArg string, opts ForkOptions) (*ForkResult, error) {
+	upstream, err := giturl.ParseRepository(repoArg, getGitHubUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	if upstream.Host != "github.com" {
+		return nil, fmt.Errorf("forking is only supported on github.com, not %s", upstream.Host)
+	}
+
+	ctx, cancel := context.WithTimeout(BaseContext(), 5*time.Minute)
+	defer cancel()
+
+	fork, _, err := NewGitHubClient(ctx, token).Repositories.CreateFork(ctx, upstream.Owner, upstream.Name, &github.RepositoryCreateForkOptions{
+		Organization:      opts.Organization,
+		Name:              opts.Name,
+		DefaultBranchOnly: opts.DefaultBranchOnly,
+	})
+
+	// 202 Accepted: the fork is created in the background, its details are
+	// already known. An existing fork is returned as is.
+	var accepted *github.AcceptedError
+	if err != nil && !errors.As(err, &accepted) {
+		return nil, fmt.Errorf("failed to fork %s: %w", upstream.FullName(), err)
+	}
+
+	forkURL := fmt.Sprintf("https://%s/%s/%s", upstream.Host, fork.GetOwner().GetLogin(), fork.GetName())
+
+	result, err := PrepareClone([]string{forkURL}, CloneOptions{
+		Protocol:  opts.Protocol,
+		Workspace: opts.Workspace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cloneFork(ctx, result); err != nil {
+		return nil, err
+	}
+
+	if err := SaveClonedRepoFromResult(result); err != nil {
+		return nil, err
+	}
+
+	protocol := opts.Protocol
+	if protocol == "" {
+		protocol = "https"
+	}
+
+	if _, err := runGitCommand("-C", result.TargetPath, "remote", "add", UpstreamRemote, upstream.CloneURL(protocol)); err != nil {
+		return nil, fmt.Errorf("failed to add upstream remote: %w", err)
+	}
+
+	uri, err := fixURL(result.Repository.Host, result.Repository.Owner, result.Repository.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error building URL: %w", err)
+	}
+
+	upstreamURI, err := fixURL(upstream.Host, upstream.Owner, upstream.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error building URL: %w", err)
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if err := client.SetRepoUpstream(uri.String(), upstreamURI.String()); err != nil {
+		return nil, fmt.Errorf("failed to record upstream: %w", err)
+	}
+
+	if err := client.SetRepoRemotes(uri.String(), []model.RepoRemote{{Name: UpstreamRemote, URL: upstreamURI.String()}}); err != nil {
+		return nil, fmt.Errorf("failed to record remotes: %w", err)
+	}
+
+	return &ForkResult{
+		URL:         uri.String(),
+		UpstreamURL: upstreamURI.String(),
+		Path:        result.TargetPath,
+		Workspace:   result.Workspace,
+	}, nil
+}
+
+// cloneFork clones a fork, retrying while GitHub is still creating it
+func cloneFork(ctx context.Context, result *CloneResult) error {
+	args := append([]string{"clone"}, result.GitArgs...)
+	args = append(args, result.CloneURL, result.TargetPath)
+
+	var err error
+
+	for attempt := 1; attempt <= forkCloneAttempts; attempt++ {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		if err = cmd.Run(); err == nil {
+			return nil
+		}
+
+		if attempt < forkCloneAttempts {
+			log.Printf("Fork not ready yet, retrying clone (%d/%d)...\n", attempt, forkCloneAttempts)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * 3 * time.Second):
+			}
+		}
+	}
+
+	return fmt.Errorf("git clone error: %w", err)
+}
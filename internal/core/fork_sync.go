@@ -0,0 +1,138 @@
+package core
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// UpstreamRemote is the remote of the repository a fork was created from
+const UpstreamRemote = "upstream"
+
+// SyncForkOptions configures SyncFork
+type SyncForkOptions struct {
+	Branch string // Upstream branch (default: the current branch, else the upstream default branch)
+	Rebase bool   // Rebase onto the upstream branch instead of merging it
+	Push   bool   // Push the synced branch to origin
+}
+
+// SyncForkResult describes a synced fork
+type SyncForkResult struct {
+	Path     string `json:"path"`
+	Branch   string `json:"branch"`
+	Upstream string `json:"upstream"` // Remote-tracking ref synced from, e.g. upstream/main
+	Method   string `json:"method"`   // merge or rebase
+	Commits  int    `json:"commits"`  // Upstream commits brought in
+	Pushed   bool   `json:"pushed"`
+}
+
+// SyncFork brings the current branch of a fork up to date with its
+// upstream: the upstream remote is fetched (and added from the recorded
+// upstream URL if missing) and the upstream branch is merged or rebased
+// onto. Conflicts are left to resolve in the working tree.
+func SyncFork(repo *model.Repository, opts SyncForkOptions) (*SyncForkResult, error) {
+	path := repo.Path
+
+	if err := validateGitRepo(path); err != nil {
+		return nil, err
+	}
+
+	if _, err := runGitCommand("-C", path, "remote", "get-url", UpstreamRemote); err != nil {
+		if repo.UpstreamURL == "" {
+			return nil, fmt.Errorf("%s has no %s remote; add one with: git remote add %s <url>", path, UpstreamRemote, UpstreamRemote)
+		}
+
+		if _, err := runGitCommand("-C", path, "remote", "add", UpstreamRemote, repo.UpstreamURL); err != nil {
+			return nil, fmt.Errorf("failed to add %s remote: %w", UpstreamRemote, err)
+		}
+	}
+
+	branch, err := runGitCommand("-C", path, "symbolic-ref", "--quiet", "--short", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("HEAD is detached in %s; check out a branch to sync", path)
+	}
+
+	branch = strings.TrimSpace(branch)
+
+	if status, err := runGitCommand("-C", path, "status", "--porcelain", "--untracked-files=no"); err != nil {
+		return nil, err
+	} else if strings.TrimSpace(status) != "" {
+		return nil, fmt.Errorf("%s has uncommitted changes; commit or stash them first", path)
+	}
+
+	if _, err := runGitCommand("-C", path, "fetch", "--quiet", UpstreamRemote); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", UpstreamRemote, err)
+	}
+
+	upstreamRef, err := forkUpstreamRef(path, cmp.Or(opts.Branch, branch), opts.Branch != "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncForkResult{Path: path, Branch: branch, Upstream: upstreamRef, Method: "merge"}
+	if opts.Rebase {
+		result.Method = "rebase"
+	}
+
+	count, err := runGitCommand("-C", path, "rev-list", "--count", "HEAD.."+upstreamRef)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Commits, _ = strconv.Atoi(strings.TrimSpace(count))
+
+	if result.Commits > 0 {
+		args := []string{"-C", path, "merge", "--no-edit", upstreamRef}
+		if opts.Rebase {
+			args = []string{"-C", path, "rebase", upstreamRef}
+		}
+
+		if _, err := runGitCommand(args...); err != nil {
+			return nil, fmt.Errorf("%s of %s stopped; resolve the conflicts and run 'git %s --continue', or 'git %s --abort': %w",
+				result.Method, upstreamRef, result.Method, result.Method, err)
+		}
+	}
+
+	if opts.Push {
+		args := []string{"-C", path, "push", "--quiet", "origin", branch}
+		if opts.Rebase {
+			// Rebasing rewrites the commits the fork already has
+			args = append(args, "--force-with-lease")
+		}
+
+		if _, err := runGitCommand(args...); err != nil {
+			return nil, fmt.Errorf("failed to push %s: %w", branch, err)
+		}
+
+		result.Pushed = true
+	}
+
+	return result, nil
+}
+
+// forkUpstreamRef returns the remote-tracking ref of branch on the upstream
+// remote. Unless the branch was asked for explicitly, a branch the upstream
+// does not have falls back to the upstream default branch.
+func forkUpstreamRef(path, branch string, explicit bool) (string, error) {
+	ref := UpstreamRemote + "/" + branch
+	if _, err := runGitCommand("-C", path, "rev-parse", "--verify", "--quiet", "refs/remotes/"+ref); err == nil {
+		return ref, nil
+	}
+
+	if explicit {
+		return "", fmt.Errorf("%s has no branch %s", UpstreamRemote, branch)
+	}
+
+	// refs/remotes/upstream/HEAD is only set by clones; ask the remote
+	_, _ = runGitCommand("-C", path, "remote", "set-head", UpstreamRemote, "--auto")
+
+	head, err := runGitCommand("-C", path, "symbolic-ref", "--quiet", "--short", "refs/remotes/"+UpstreamRemote+"/HEAD")
+	if err != nil {
+		return "", fmt.Errorf("%s has no branch %s and its default branch is unknown; use --branch", UpstreamRemote, branch)
+	}
+
+	return strings.TrimSpace(head), nil
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// forkTestGit runs git in dir, skipping the test when git is unavailable
+func forkTestGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s: %v - %s", strings.Join(args, " "), err, out)
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// initForkTestRepos creates an upstream repository, a bare origin forked
+// from it and a clone of the origin
+func initForkTestRepos(t *testing.T) (upstream, origin, fork string) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	root := t.TempDir()
+	upstream = filepath.Join(root, "upstream")
+	origin = filepath.Join(root, "origin.git")
+	fork = filepath.Join(root, "fork")
+
+	if err := os.Mkdir(upstream, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	forkTestGit(t, upstream, "init", "-q", "-b", "main")
+	forkTestGit(t, upstream, "config", "user.name", "Test")
+	forkTestGit(t, upstream, "config", "user.email", "test@example.com")
+	forkTestGit(t, upstream, "commit", "-q", "--allow-empty", "-m", "initial")
+	forkTestGit(t, root, "clone", "-q", "--bare", upstream, origin)
+	forkTestGit(t, root, "clone", "-q", origin, fork)
+	forkTestGit(t, fork, "config", "user.name", "Test")
+	forkTestGit(t, fork, "config", "user.email", "test@example.com")
+
+	return upstream, origin, fork
+}
+
+func TestSyncForkMerge(t *testing.T) {
+	upstream, origin, fork := initForkTestRepos(t)
+
+	forkTestGit(t, upstream, "commit", "-q", "--allow-empty", "-m", "upstream change")
+
+	result, err := SyncFork(&model.Repository{Path: fork, UpstreamURL: upstream}, SyncForkOptions{Push: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Branch != "main" || result.Upstream != "upstream/main" || result.Method != "merge" || result.Commits != 1 || !result.Pushed {
+		t.Errorf("result = %+v", result)
+	}
+
+	if got, want := forkTestGit(t, origin, "rev-parse", "main"), forkTestGit(t, upstream, "rev-parse", "main"); got != want {
+		t.Errorf("origin main = %s, want upstream main %s", got, want)
+	}
+
+	// Nothing new upstream
+	result, err = SyncFork(&model.Repository{Path: fork}, SyncForkOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Commits != 0 {
+		t.Errorf("second sync commits = %d, want 0", result.Commits)
+	}
+}
+
+func TestSyncForkRebase(t *testing.T) {
+	upstream, _, fork := initForkTestRepos(t)
+
+	forkTestGit(t, upstream, "commit", "-q", "--allow-empty", "-m", "upstream change")
+
+	if err := os.WriteFile(filepath.Join(fork, "local.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	forkTestGit(t, fork, "add", "local.txt")
+	forkTestGit(t, fork, "commit", "-q", "-m", "local change")
+	forkTestGit(t, fork, "remote", "add", "upstream", upstream)
+
+	result, err := SyncFork(&model.Repository{Path: fork}, SyncForkOptions{Rebase: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Method != "rebase" || result.Commits != 1 {
+		t.Errorf("result = %+v", result)
+	}
+
+	if got := forkTestGit(t, fork, "log", "--format=%s"); got != "local change\nupstream change\ninitial" {
+		t.Errorf("history = %q", got)
+	}
+}
+
+func TestSyncForkErrors(t *testing.T) {
+	upstream, _, fork := initForkTestRepos(t)
+
+	if _, err := SyncFork(&model.Repository{Path: fork}, SyncForkOptions{}); err == nil || !strings.Contains(err.Error(), "no upstream remote") {
+		t.Errorf("without upstream: err = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(fork, "tracked.txt"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	forkTestGit(t, fork, "add", "tracked.txt")
+
+	if _, err := SyncFork(&model.Repository{Path: fork, UpstreamURL: upstream}, SyncForkOptions{}); err == nil || !strings.Contains(err.Error(), "uncommitted changes") {
+		t.Errorf("dirty tree: err = %v", err)
+	}
+
+	forkTestGit(t, fork, "commit", "-q", "-m", "tracked")
+
+	if _, err := SyncFork(&model.Repository{Path: fork}, SyncForkOptions{Branch: "missing"}); err == nil || !strings.Contains(err.Error(), "no branch missing") {
+		t.Errorf("missing branch: err = %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"cmp"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// SortByFrecency orders repositories by how often and how recently they
+// were opened or updated, the default of the interactive lists
+const SortByFrecency SortBy = "frecency"
+
+// FrecencyScore rates repo by its access count, weighted by the age of the
+// last access at now: four times within the hour, twice within the day, half
+// within the week and a quarter after that. Repositories never accessed
+// score zero.
+func FrecencyScore(repo model.Repository, now time.Time) float64 {
+	if repo.AccessCount == 0 || repo.LastAccessed.IsZero() {
+		return 0
+	}
+
+	weight := 0.25
+
+	switch age := now.Sub(repo.LastAccessed); {
+	case age < time.Hour:
+		weight = 4
+	case age < 24*time.Hour:
+		weight = 2
+	case age < 7*24*time.Hour:
+		weight = 0.5
+	}
+
+	return float64(repo.AccessCount) * weight
+}
+
+// sortByFrecency sorts by frecency score at now, highest first; ties, such
+// as repositories never accessed, are sorted by name
+func sortByFrecency(repos []RepoWithStats, now time.Time) {
+	slices.SortStableFunc(repos, func(a, b RepoWithStats) int {
+		if c := cmp.Compare(FrecencyScore(b.Repository, now), FrecencyScore(a.Repository, now)); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.URL, b.URL)
+	})
+}
+
+// RecordRepoAccess counts an access to the repository with the given URL
+// for frecency ordering. It is best effort: failures are ignored.
+func RecordRepoAccess(urlStr string) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return
+	}
+
+	_ = client.RecordRepoAccess(urlStr)
+}
+
+// RecordPathAccess counts an access to the repository cloned at path, if
+// one is tracked there
+func RecordPathAccess(path string) {
+	abs, err := filepath.Abs(expandTilde(path))
+	if err != nil {
+		return
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return
+	}
+
+	for _, repo := range repos {
+		if repo.Path == abs {
+			_ = client.RecordRepoAccess(repo.URL)
+			return
+		}
+	}
+}
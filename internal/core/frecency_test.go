@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestFrecencyScore(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		count int
+		age   time.Duration
+		want  float64
+	}{
+		{"never accessed", 0, 0, 0},
+		{"within the hour", 3, 10 * time.Minute, 12},
+		{"within the day", 3, 5 * time.Hour, 6},
+		{"within the week", 3, 3 * 24 * time.Hour, 1.5},
+		{"older", 3, 30 * 24 * time.Hour, 0.75},
+	}
+
+	for _, tt := range tests {
+		repo := model.Repository{AccessCount: tt.count}
+		if tt.count > 0 {
+			repo.LastAccessed = now.Add(-tt.age)
+		}
+
+		if got := FrecencyScore(repo, now); got != tt.want {
+			t.Errorf("%s: FrecencyScore() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSortByFrecency(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	repos := []RepoWithStats{
+		{Repository: model.Repository{URL: "https://github.com/acme/web"}},
+		{Repository: model.Repository{URL: "https://github.com/acme/old", AccessCount: 20, LastAccessed: now.AddDate(0, -2, 0)}},
+		{Repository: model.Repository{URL: "https://github.com/acme/api"}},
+		{Repository: model.Repository{URL: "https://github.com/acme/new", AccessCount: 2, LastAccessed: now.Add(-time.Minute)}},
+	}
+
+	sortByFrecency(repos, now)
+
+	want := []string{
+		"https://github.com/acme/new",
+		"https://github.com/acme/old",
+		"https://github.com/acme/api",
+		"https://github.com/acme/web",
+	}
+
+	for i, r := range repos {
+		if r.URL != want[i] {
+			t.Errorf("position %d = %s, want %s", i, r.URL, want[i])
+		}
+	}
+}
@@ -5,16 +5,14 @@ import (
 	"net/http"
 
 	"github.com/google/go-github/v82/github"
+	"github.com/inovacc/clonr/internal/ratelimit"
 	"golang.org/x/oauth2"
 )
 
 // NewGitHubClient creates a new authenticated GitHub client using the provided token.
 // This is the standard way to create GitHub API clients throughout the codebase.
 func NewGitHubClient(ctx context.Context, token string) *github.Client {
-	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	tc := oauth2.NewClient(ctx, ts)
-
-	return github.NewClient(tc)
+	return github.NewClient(NewOAuth2HTTPClient(ctx, token))
 }
 
 // NewGitHubClientWithContext creates a new authenticated GitHub client using background context.
@@ -28,5 +26,11 @@ func NewGitHubClientWithContext(token string) *github.Client {
 // such as downloading release assets directly.
 func NewOAuth2HTTPClient(ctx context.Context, token string) *http.Client {
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	return oauth2.NewClient(ctx, ts)
+	tc := oauth2.NewClient(ctx, ts)
+
+	// Throttle and retry rate-limited requests, sharing state with every
+	// other GitHub client of the process
+	tc.Transport = ratelimit.NewTransport(tc.Transport)
+
+	return tc
 }
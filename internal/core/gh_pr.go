@@ -14,6 +14,7 @@ import (
 type PRStatus struct {
 	Number       int        `json:"number"`
 	Title        string     `json:"title"`
+	Body         string     `json:"body,omitempty"`
 	State        string     `json:"state"` // open, closed
 	Merged       bool       `json:"merged"`
 	Draft        bool       `json:"draft"`
@@ -236,6 +237,7 @@ func convertPRToStatus(pr *github.PullRequest) *PRStatus {
 	status := &PRStatus{
 		Number:       pr.GetNumber(),
 		Title:        pr.GetTitle(),
+		Body:         pr.GetBody(),
 		State:        pr.GetState(),
 		Merged:       pr.GetMerged(),
 		Draft:        pr.GetDraft(),
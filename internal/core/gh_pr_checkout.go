@@ -0,0 +1,144 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/git"
+)
+
+// PRCheckoutOptions configures checking out a pull request
+type PRCheckoutOptions struct {
+	Branch string // Local branch name (default: the PR head branch)
+	Force  bool   // Reset the local branch to the PR head if it has diverged
+}
+
+// PRCheckoutResult describes a checked-out pull request
+type PRCheckoutResult struct {
+	PR      *PRStatus `json:"pull_request"`
+	Path    string    `json:"path"`
+	Branch  string    `json:"branch"`
+	Updated bool      `json:"updated"` // The local branch existed and was updated
+}
+
+// CheckoutPR fetches the head of a pull request into a local branch of a
+// clone of owner/repo and checks it out. The clone is the repository in the
+// current directory if one of its remotes is owner/repo, or else the tracked
+// repository. The head is fetched from refs/pull/<n>/head of the base
+// repository, so pull requests from forks need no extra remote.
+func CheckoutPR(token, owner, repo string, prNumber int, opts PRCheckoutOptions) (*PRCheckoutResult, error) {
+	repoPath, err := localGitHubClone(owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(BaseContext(), 5*time.Minute)
+	defer cancel()
+
+	pr, _, err := NewGitHubClient(ctx, token).PullRequests.Get(ctx, owner, repo, prNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR #%d: %w", prNumber, err)
+	}
+
+	status := convertPRToStatus(pr)
+
+	// Never check a PR out onto the branch it targets, e.g. a fork's main
+	branch := cmp.Or(opts.Branch, status.Branch)
+	if opts.Branch == "" && branch == status.BaseBranch {
+		branch = fmt.Sprintf("pr-%d", prNumber)
+	}
+
+	result := &PRCheckoutResult{PR: status, Path: repoPath, Branch: branch}
+
+	_, err = runGitCommand("-C", repoPath, "rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	result.Updated = err == nil
+
+	client := git.NewClientForRepo(repoPath)
+	remoteURL := fmt.Sprintf("https://github.com/%s/%s.git", owner, repo)
+	pullRef := fmt.Sprintf("refs/pull/%d/head", prNumber)
+
+	current, _ := client.CurrentBranch(ctx)
+
+	if current == branch {
+		// Git refuses to fetch into the checked-out branch; update it in place
+		if err := fetchWithToken(ctx, client, token, remoteURL, pullRef); err != nil {
+			return nil, err
+		}
+
+		args := []string{"-C", repoPath, "merge", "--ff-only", "FETCH_HEAD"}
+		if opts.Force {
+			args = []string{"-C", repoPath, "reset", "--hard", "FETCH_HEAD"}
+		}
+
+		if _, err := runGitCommand(args...); err != nil {
+			return nil, fmt.Errorf("branch %s has diverged from the PR; use --force to reset it: %w", branch, err)
+		}
+
+		return result, nil
+	}
+
+	refspec := pullRef + ":refs/heads/" + branch
+	if opts.Force {
+		refspec = "+" + refspec
+	}
+
+	if err := fetchWithToken(ctx, client, token, remoteURL, refspec); err != nil {
+		if result.Updated && !opts.Force {
+			return nil, fmt.Errorf("branch %s has diverged from the PR; use --force to reset it: %w", branch, err)
+		}
+
+		return nil, err
+	}
+
+	if _, err := runGitCommand("-C", repoPath, "checkout", branch); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// fetchWithToken fetches refspec from remoteURL, authenticating the clonr
+// credential helper with token through GITHUB_TOKEN so the token stays out
+// of the command line
+func fetchWithToken(ctx context.Context, client *git.Client, token, remoteURL, refspec string) error {
+	cmd := client.AuthenticatedCommand(ctx, git.AllMatchingCredentialsPattern, "fetch", remoteURL, refspec)
+	cmd.Env = append(os.Environ(), "GITHUB_TOKEN="+token)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w - %s", refspec, err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// localGitHubClone returns the path of a local clone of owner/repo: the
+// repository containing the current directory if any of its remotes points
+// to owner/repo, or else the tracked repository
+func localGitHubClone(owner, repo string) (string, error) {
+	if root, err := git.RepoRoot(BaseContext()); err == nil {
+		remotes, _ := runGitCommand("-C", root, "remote", "-v")
+
+		for line := range strings.Lines(remotes) {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+
+			o, r, err := parseGitHubURL(fields[1])
+			if err == nil && strings.EqualFold(o, owner) && strings.EqualFold(r, repo) {
+				return root, nil
+			}
+		}
+	}
+
+	tracked, err := ResolveRepo(owner + "/" + repo)
+	if err != nil {
+		return "", fmt.Errorf("no local clone of %s/%s: %w\nClone it with: clonr clone https://github.com/%s/%s", owner, repo, err, owner, repo)
+	}
+
+	return tracked.Path, nil
+}
@@ -0,0 +1,700 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// GitIdentity is the identity and signing part of a git config
+type GitIdentity struct {
+	Name          string `json:"name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	SigningKey    string `json:"signing_key,omitempty"`
+	SigningFormat string `json:"signing_format,omitempty"`
+	SignCommits   bool   `json:"sign_commits,omitempty"`
+}
+
+// IsZero reports whether no identity setting is present
+func (g GitIdentity) IsZero() bool {
+	return g == GitIdentity{}
+}
+
+// GitConfigInclude is a conditional include ([includeIf]) of a git config
+type GitConfigInclude struct {
+	Condition string      `json:"condition"` // e.g. "gitdir:~/work/"
+	Path      string      `json:"path"`      // included file, resolved
+	Identity  GitIdentity `json:"identity"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// GitCredentialHelper is a credential.helper setting, optionally scoped to a URL
+type GitCredentialHelper struct {
+	URL    string `json:"url,omitempty"`
+	Helper string `json:"helper"`
+}
+
+// GitConfigSettings is what clonr understands of a git config file
+type GitConfigSettings struct {
+	Source            string                `json:"source"`
+	Identity          GitIdentity           `json:"identity"`
+	Rewrites          []model.URLRewrite    `json:"rewrites,omitempty"`
+	Includes          []GitConfigInclude    `json:"includes,omitempty"`
+	CredentialHelpers []GitCredentialHelper `json:"credential_helpers,omitempty"`
+}
+
+// gitConfigEntry is one key/value pair of 'git config --list'
+type gitConfigEntry struct {
+	key   string
+	value string
+}
+
+// parseGitConfigList parses the output of 'git config --list -z': entries
+// separated by NUL, key and value separated by the first newline
+func parseGitConfigList(data []byte) []gitConfigEntry {
+	var entries []gitConfigEntry
+
+	for _, raw := range bytes.Split(data, []byte{0}) {
+		if len(raw) == 0 {
+			continue
+		}
+
+		key, value, _ := strings.Cut(string(raw), "\n")
+		entries = append(entries, gitConfigEntry{key: key, value: value})
+	}
+
+	return entries
+}
+
+// splitSubsection splits "section.subsection.name" into its parts. Section
+// and name are lowercase in git's output; the subsection keeps its case
+// and may contain dots.
+func splitSubsection(key string) (section, subsection, name string, ok bool) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+
+	if first < 0 || first == last {
+		return "", "", "", false
+	}
+
+	return key[:first], key[first+1 : last], key[last+1:], true
+}
+
+// gitBool parses a git boolean value; a key without a value is true
+func gitBool(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "true", "yes", "on", "1":
+		return true
+	default:
+		return false
+	}
+}
+
+// applyIdentityEntry sets the identity field for key, reporting whether
+// the key is an identity setting
+func applyIdentityEntry(id *GitIdentity, key, value string) bool {
+	switch key {
+	case "user.name":
+		id.Name = value
+	case "user.email":
+		id.Email = value
+	case "user.signingkey":
+		id.SigningKey = value
+	case "gpg.format":
+		id.SigningFormat = value
+	case "commit.gpgsign":
+		id.SignCommits = gitBool(value)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// parseGitConfig collects the settings clonr imports. Later entries win, as
+// in git. Include paths are resolved relative to configDir.
+func parseGitConfig(entries []gitConfigEntry, configDir string) GitConfigSettings {
+	var settings GitConfigSettings
+
+	for _, e := range entries {
+		if applyIdentityEntry(&settings.Identity, e.key, e.value) {
+			continue
+		}
+
+		if e.key == "credential.helper" {
+			settings.CredentialHelpers = append(settings.CredentialHelpers, GitCredentialHelper{Helper: e.value})
+			continue
+		}
+
+		section, subsection, name, ok := splitSubsection(e.key)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case section == "url" && name == "insteadof" && e.value != "":
+			settings.Rewrites = append(settings.Rewrites, model.URLRewrite{Base: subsection, InsteadOf: e.value})
+		case section == "credential" && name == "helper":
+			settings.CredentialHelpers = append(settings.CredentialHelpers, GitCredentialHelper{URL: subsection, Helper: e.value})
+		case section == "includeif" && name == "path" && e.value != "":
+			settings.Includes = append(settings.Includes, GitConfigInclude{
+				Condition: subsection,
+				Path:      resolveIncludePath(e.value, configDir),
+			})
+		}
+	}
+
+	return settings
+}
+
+// resolveIncludePath expands "~/" and makes relative include paths relative
+// to the including file, as git does
+func resolveIncludePath(p, configDir string) string {
+	if rest, ok := strings.CutPrefix(p, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+
+	if !filepath.IsAbs(p) && configDir != "" {
+		return filepath.Join(configDir, p)
+	}
+
+	return p
+}
+
+// readGitConfigList runs 'git config --list -z' on the global config or file
+func readGitConfigList(ctx context.Context, file string) ([]gitConfigEntry, error) {
+	args := []string{"config", "--list", "-z"}
+	if file != "" {
+		args = append(args, "--file", file)
+	} else {
+		args = append(args, "--global")
+	}
+
+	out, err := exec.CommandContext(ctx, "git", args...).Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("git config: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return nil, fmt.Errorf("git config: %w", err)
+	}
+
+	return parseGitConfigList(out), nil
+}
+
+// ReadGitConfig reads the user's global git config, or file when set, along
+// with the identity of each conditionally included file
+func ReadGitConfig(ctx context.Context, file string) (*GitConfigSettings, error) {
+	source := file
+	if source == "" {
+		source = "global git config"
+	}
+
+	entries, err := readGitConfigList(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	configDir := ""
+	if file != "" {
+		configDir = filepath.Dir(file)
+	} else if home, err := os.UserHomeDir(); err == nil {
+		configDir = home
+	}
+
+	settings := parseGitConfig(entries, configDir)
+	settings.Source = source
+
+	for i := range settings.Includes {
+		inc := &settings.Includes[i]
+
+		included, err := readGitConfigList(ctx, inc.Path)
+		if err != nil {
+			inc.Error = err.Error()
+			continue
+		}
+
+		for _, e := range included {
+			applyIdentityEntry(&inc.Identity, e.key, e.value)
+		}
+	}
+
+	return &settings, nil
+}
+
+// ExpandURLRewrites applies git url.<base>.insteadOf rules to a repository
+// argument. The longest matching prefix wins, as in git.
+func ExpandURLRewrites(arg string, rewrites []model.URLRewrite) string {
+	best := -1
+
+	for i, r := range rewrites {
+		if r.InsteadOf == "" || !strings.HasPrefix(arg, r.InsteadOf) {
+			continue
+		}
+
+		if best < 0 || len(r.InsteadOf) > len(rewrites[best].InsteadOf) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		return arg
+	}
+
+	return rewrites[best].Base + strings.TrimPrefix(arg, rewrites[best].InsteadOf)
+}
+
+// GitConfigImportOptions controls ImportGitConfig
+type GitConfigImportOptions struct {
+	File      string // Config file to read (empty reads the global config)
+	Profile   string // Profile receiving the global identity (empty uses the default)
+	Overwrite bool   // Replace identity fields that are already set
+	DryRun    bool   // Plan without saving
+}
+
+// GitConfigChange is one setting mapped into clonr, or skipped
+type GitConfigChange struct {
+	Kind    string `json:"kind"` // profile, workspace, rewrite or credential
+	Target  string `json:"target"`
+	Detail  string `json:"detail"`
+	Skipped string `json:"skipped,omitempty"` // reason the setting was not imported
+}
+
+// GitConfigImportResult is the outcome of ImportGitConfig
+type GitConfigImportResult struct {
+	Source   string            `json:"source"`
+	DryRun   bool              `json:"dry_run"`
+	Changes  []GitConfigChange `json:"changes"`
+	Warnings []string          `json:"warnings,omitempty"`
+
+	profiles   map[string]*model.Profile
+	workspaces map[string]*model.Workspace
+	config     *model.Config
+}
+
+// gitConfigState is the clonr state an import is planned against
+type gitConfigState struct {
+	config     *model.Config
+	profiles   []model.Profile
+	workspaces []model.Workspace
+}
+
+// planGitConfigImport maps git settings onto copies of the clonr state.
+// The global identity goes to the selected or default profile; conditional
+// includes go to the profile bound to the matching workspace, creating the
+// workspace when needed; URL rewrites are merged into the config.
+func planGitConfigImport(settings *GitConfigSettings, state gitConfigState, opts GitConfigImportOptions) (*GitConfigImportResult, error) {
+	res := &GitConfigImportResult{
+		Source:     settings.Source,
+		DryRun:     opts.DryRun,
+		profiles:   make(map[string]*model.Profile),
+		workspaces: make(map[string]*model.Workspace),
+	}
+
+	profiles := make([]model.Profile, len(state.profiles))
+	copy(profiles, state.profiles)
+
+	workspaces := make([]model.Workspace, len(state.workspaces))
+	for i, ws := range state.workspaces {
+		ws.URLPatterns = append([]string(nil), ws.URLPatterns...)
+		workspaces[i] = ws
+	}
+
+	findProfile := func(match func(*model.Profile) bool) *model.Profile {
+		for i := range profiles {
+			if match(&profiles[i]) {
+				return &profiles[i]
+			}
+		}
+
+		return nil
+	}
+
+	applyTo := func(p *model.Profile, id GitIdentity, source string) {
+		detail := mergeGitIdentity(p, id, opts.Overwrite)
+		if detail == "" {
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "profile", Target: p.Name, Detail: source,
+				Skipped: "already configured (use --overwrite to replace)"})
+
+			return
+		}
+
+		res.profiles[p.Name] = p
+		res.Changes = append(res.Changes, GitConfigChange{Kind: "profile", Target: p.Name, Detail: detail})
+	}
+
+	// Global identity
+	if !settings.Identity.IsZero() {
+		var target *model.Profile
+
+		if opts.Profile != "" {
+			target = findProfile(func(p *model.Profile) bool { return p.Name == opts.Profile })
+			if target == nil {
+				return nil, fmt.Errorf("profile '%s' not found", opts.Profile)
+			}
+		} else {
+			target = findProfile(func(p *model.Profile) bool { return p.Default })
+		}
+
+		if target == nil {
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "profile", Target: "-", Detail: describeGitIdentity(settings.Identity),
+				Skipped: "no default profile; create one with 'clonr profile add'"})
+		} else {
+			applyTo(target, settings.Identity, describeGitIdentity(settings.Identity))
+		}
+	}
+
+	// Conditional includes
+	for _, inc := range settings.Includes {
+		if inc.Error != "" {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("%s: %s", inc.Path, inc.Error))
+			continue
+		}
+
+		if inc.Identity.IsZero() {
+			continue
+		}
+
+		ws, created, reason := includeWorkspace(&workspaces, inc, state.config)
+		if ws == nil {
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "workspace", Target: inc.Condition, Detail: inc.Path, Skipped: reason})
+			continue
+		}
+
+		if created != "" {
+			res.workspaces[ws.Name] = ws
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "workspace", Target: ws.Name, Detail: created})
+		}
+
+		p := findProfile(func(p *model.Profile) bool { return p.Workspace == ws.Name })
+		if p == nil {
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "profile", Target: ws.Name, Detail: describeGitIdentity(inc.Identity),
+				Skipped: fmt.Sprintf("no profile bound to workspace '%s'; create one with 'clonr profile add <name> --workspace %s'", ws.Name, ws.Name)})
+
+			continue
+		}
+
+		applyTo(p, inc.Identity, describeGitIdentity(inc.Identity))
+	}
+
+	// URL rewrites
+	if len(settings.Rewrites) > 0 {
+		cfg := model.Config{}
+		if state.config != nil {
+			cfg = *state.config
+		}
+
+		cfg.URLRewrites = append([]model.URLRewrite(nil), cfg.URLRewrites...)
+
+		for _, r := range settings.Rewrites {
+			detail := fmt.Sprintf("%s -> %s", r.InsteadOf, r.Base)
+
+			if hasURLRewrite(cfg.URLRewrites, r) {
+				res.Changes = append(res.Changes, GitConfigChange{Kind: "rewrite", Target: r.InsteadOf, Detail: detail, Skipped: "already imported"})
+				continue
+			}
+
+			cfg.URLRewrites = append(cfg.URLRewrites, r)
+			res.config = &cfg
+			res.Changes = append(res.Changes, GitConfigChange{Kind: "rewrite", Target: r.InsteadOf, Detail: detail})
+		}
+	}
+
+	// Credential helpers are reported only; clonr authenticates with profile tokens
+	for _, h := range settings.CredentialHelpers {
+		target := h.URL
+		if target == "" {
+			target = "*"
+		}
+
+		res.Changes = append(res.Changes, GitConfigChange{Kind: "credential", Target: target, Detail: h.Helper,
+			Skipped: "git keeps using it; clonr authenticates with profile tokens"})
+	}
+
+	return res, nil
+}
+
+// mergeGitIdentity copies identity fields into the profile, keeping fields
+// that are already set unless overwrite is true. It returns a description
+// of the fields changed, or "" if none changed.
+func mergeGitIdentity(p *model.Profile, id GitIdentity, overwrite bool) string {
+	var changed []string
+
+	set := func(field *string, value, label string) {
+		if value == "" || *field == value || (*field != "" && !overwrite) {
+			return
+		}
+
+		*field = value
+		changed = append(changed, fmt.Sprintf("%s=%s", label, value))
+	}
+
+	set(&p.GitName, id.Name, "name")
+	set(&p.GitEmail, id.Email, "email")
+	set(&p.GitSigningKey, id.SigningKey, "signingkey")
+	set(&p.GitSigningFormat, id.SigningFormat, "format")
+
+	if id.SignCommits && !p.GitSignCommits {
+		p.GitSignCommits = true
+		changed = append(changed, "gpgsign=true")
+	}
+
+	return strings.Join(changed, ", ")
+}
+
+// describeGitIdentity summarizes an identity for display
+func describeGitIdentity(id GitIdentity) string {
+	var parts []string
+
+	if id.Name != "" || id.Email != "" {
+		parts = append(parts, strings.TrimSpace(fmt.Sprintf("%s <%s>", id.Name, id.Email)))
+	}
+
+	if id.SigningKey != "" {
+		parts = append(parts, "signingkey="+id.SigningKey)
+	}
+
+	if id.SignCommits {
+		parts = append(parts, "gpgsign=true")
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// hasURLRewrite reports whether the rewrite is already present
+func hasURLRewrite(rewrites []model.URLRewrite, r model.URLRewrite) bool {
+	for _, existing := range rewrites {
+		if existing == r {
+			return true
+		}
+	}
+
+	return false
+}
+
+// includeWorkspace finds or creates the workspace an includeIf condition
+// maps to. "gitdir:" conditions match the workspace path; "hasconfig:"
+// remote URL conditions become a URL pattern on a workspace named after the
+// included file. It returns a description when the workspace was created or
+// changed, or a reason when the condition cannot be mapped.
+func includeWorkspace(workspaces *[]model.Workspace, inc GitConfigInclude, cfg *model.Config) (*model.Workspace, string, string) {
+	cond := inc.Condition
+
+	for _, prefix := range []string{"gitdir:", "gitdir/i:"} {
+		dir, ok := strings.CutPrefix(cond, prefix)
+		if !ok {
+			continue
+		}
+
+		dir = filepath.Clean(resolveIncludePath(strings.TrimSuffix(dir, "**"), ""))
+
+		for i := range *workspaces {
+			ws := &(*workspaces)[i]
+			if ws.Path != "" && filepath.Clean(ws.Path) == dir {
+				return ws, "", ""
+			}
+		}
+
+		name := strings.ToLower(filepath.Base(dir))
+		if name == "" || name == "." || name == string(filepath.Separator) {
+			return nil, "", "cannot derive a workspace name from " + cond
+		}
+
+		if existing := findWorkspace(*workspaces, name); existing != nil {
+			return nil, "", fmt.Sprintf("workspace '%s' exists with path %s", name, existing.Path)
+		}
+
+		*workspaces = append(*workspaces, model.Workspace{Name: name, Path: dir, CreatedAt: time.Now()})
+
+		return &(*workspaces)[len(*workspaces)-1], "path " + dir, ""
+	}
+
+	if glob, ok := strings.CutPrefix(cond, "hasconfig:remote.*.url:"); ok {
+		pattern := NormalizeURLPattern(strings.TrimSuffix(strings.TrimSuffix(glob, "**"), "/"))
+		if pattern == "" {
+			return nil, "", "empty remote URL pattern"
+		}
+
+		name := includeWorkspaceName(inc.Path)
+		if name == "" {
+			return nil, "", "cannot derive a workspace name from " + inc.Path
+		}
+
+		ws := findWorkspace(*workspaces, name)
+		if ws == nil {
+			path := name
+			if cfg != nil && cfg.DefaultCloneDir != "" {
+				path = filepath.Join(cfg.DefaultCloneDir, name)
+			}
+
+			*workspaces = append(*workspaces, model.Workspace{Name: name, Path: path, CreatedAt: time.Now()})
+			ws = &(*workspaces)[len(*workspaces)-1]
+		}
+
+		for _, p := range ws.URLPatterns {
+			if NormalizeURLPattern(p) == pattern {
+				return ws, "", ""
+			}
+		}
+
+		ws.URLPatterns = append(ws.URLPatterns, pattern)
+
+		return ws, "url pattern " + pattern, ""
+	}
+
+	return nil, "", "unsupported condition " + cond
+}
+
+// includeWorkspaceName derives a workspace name from an included file,
+// e.g. "~/.gitconfig-work" becomes "work"
+func includeWorkspaceName(p string) string {
+	name := strings.TrimPrefix(filepath.Base(p), ".")
+
+	for _, prefix := range []string{"gitconfig-", "gitconfig_", "gitconfig."} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			return strings.ToLower(rest)
+		}
+	}
+
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	if name == "gitconfig" {
+		return ""
+	}
+
+	return strings.ToLower(name)
+}
+
+// findWorkspace returns the workspace with the given name, or nil
+func findWorkspace(workspaces []model.Workspace, name string) *model.Workspace {
+	for i := range workspaces {
+		if workspaces[i].Name == name {
+			return &workspaces[i]
+		}
+	}
+
+	return nil
+}
+
+// sshRewriteHosts returns the hosts of SSH rewrite targets
+func sshRewriteHosts(rewrites []model.URLRewrite) []string {
+	seen := make(map[string]bool)
+
+	for _, r := range rewrites {
+		if !strings.HasPrefix(r.Base, "ssh://") && !strings.Contains(strings.SplitN(r.Base, ":", 2)[0], "@") {
+			continue
+		}
+
+		host := NormalizeURLPattern(r.Base)
+		host, _, _ = strings.Cut(host, "/")
+		host, _, _ = strings.Cut(host, ":")
+
+		if host != "" {
+			seen[host] = true
+		}
+	}
+
+	hosts := make([]string, 0, len(seen))
+	for h := range seen {
+		hosts = append(hosts, h)
+	}
+
+	sort.Strings(hosts)
+
+	return hosts
+}
+
+// knownHost reports whether host has an entry in the user's known_hosts
+func knownHost(ctx context.Context, host string) bool {
+	return exec.CommandContext(ctx, "ssh-keygen", "-F", host).Run() == nil
+}
+
+// ImportGitConfig maps the identity, signing config, conditional includes
+// and URL rewrites of the user's git config into clonr profiles, workspaces
+// and config. Existing values are kept unless Overwrite is set.
+func ImportGitConfig(ctx context.Context, opts GitConfigImportOptions) (*GitConfigImportResult, error) {
+	settings, err := ReadGitConfig(ctx, opts.File)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	var state gitConfigState
+
+	if state.config, err = client.GetConfig(); err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if state.profiles, err = client.ListProfiles(); err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	if state.workspaces, err = client.ListWorkspaces(); err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	res, err := planGitConfigImport(settings, state, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, lookErr := exec.LookPath("ssh-keygen"); lookErr == nil {
+		for _, host := range sshRewriteHosts(settings.Rewrites) {
+			if !knownHost(ctx, host) {
+				res.Warnings = append(res.Warnings,
+					fmt.Sprintf("%s is not in known_hosts; add it with 'ssh-keyscan %s >> ~/.ssh/known_hosts' after verifying its fingerprint", host, host))
+			}
+		}
+	}
+
+	if opts.DryRun {
+		return res, nil
+	}
+
+	// Workspaces first, so profiles can be bound to them
+	for _, name := range sortedKeys(res.workspaces) {
+		if err := client.SaveWorkspace(res.workspaces[name]); err != nil {
+			return res, fmt.Errorf("failed to save workspace '%s': %w", name, err)
+		}
+	}
+
+	for _, name := range sortedKeys(res.profiles) {
+		if err := client.SaveProfile(res.profiles[name]); err != nil {
+			return res, fmt.Errorf("failed to save profile '%s': %w", name, err)
+		}
+	}
+
+	if res.config != nil {
+		if err := client.SaveConfig(res.config); err != nil {
+			return res, fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+// sortedKeys returns the keys of m in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestParseGitConfig(t *testing.T) {
+	data := []byte("user.name\nJane Doe\x00user.email\njane@example.com\x00" +
+		"user.signingkey\n~/.ssh/id_ed25519.pub\x00gpg.format\nssh\x00commit.gpgsign\ntrue\x00" +
+		"url.git@github.com:.insteadof\nhttps://github.com/\x00url.https://github.example.com/.insteadof\ngh:\x00" +
+		"credential.helper\ncache\x00credential.https://github.com.helper\n!gh auth git-credential\x00" +
+		"includeif.gitdir:~/work/.path\n.gitconfig-work\x00core.editor\nvim\x00")
+
+	settings := parseGitConfig(parseGitConfigList(data), "/home/jane")
+
+	want := GitIdentity{Name: "Jane Doe", Email: "jane@example.com", SigningKey: "~/.ssh/id_ed25519.pub", SigningFormat: "ssh", SignCommits: true}
+	if settings.Identity != want {
+		t.Errorf("Identity = %+v, want %+v", settings.Identity, want)
+	}
+
+	wantRewrites := []model.URLRewrite{
+		{Base: "git@github.com:", InsteadOf: "https://github.com/"},
+		{Base: "https://github.example.com/", InsteadOf: "gh:"},
+	}
+	if len(settings.Rewrites) != len(wantRewrites) {
+		t.Fatalf("Rewrites = %+v, want %+v", settings.Rewrites, wantRewrites)
+	}
+
+	for i, r := range wantRewrites {
+		if settings.Rewrites[i] != r {
+			t.Errorf("Rewrites[%d] = %+v, want %+v", i, settings.Rewrites[i], r)
+		}
+	}
+
+	if len(settings.CredentialHelpers) != 2 || settings.CredentialHelpers[1].URL != "https://github.com" {
+		t.Errorf("CredentialHelpers = %+v", settings.CredentialHelpers)
+	}
+
+	if len(settings.Includes) != 1 {
+		t.Fatalf("Includes = %+v, want 1", settings.Includes)
+	}
+
+	if inc := settings.Includes[0]; inc.Condition != "gitdir:~/work/" || inc.Path != filepath.Join("/home/jane", ".gitconfig-work") {
+		t.Errorf("Include = %+v", inc)
+	}
+}
+
+func TestExpandURLRewrites(t *testing.T) {
+	rewrites := []model.URLRewrite{
+		{Base: "git@github.com:", InsteadOf: "https://github.com/"},
+		{Base: "git@github.com:acme/", InsteadOf: "acme:"},
+		{Base: "https://git.example.com/", InsteadOf: "a"},
+		{Base: "https://git.example.com/team/", InsteadOf: "ab"},
+	}
+
+	tests := []struct {
+		arg  string
+		want string
+	}{
+		{"https://github.com/org/repo", "git@github.com:org/repo"},
+		{"acme:api", "git@github.com:acme/api"},
+		{"abc", "https://git.example.com/team/c"},
+		{"org/repo", "org/repo"},
+	}
+
+	for _, tt := range tests {
+		if got := ExpandURLRewrites(tt.arg, rewrites); got != tt.want {
+			t.Errorf("ExpandURLRewrites(%q) = %q, want %q", tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestPlanGitConfigImport(t *testing.T) {
+	settings := &GitConfigSettings{
+		Identity: GitIdentity{Name: "Jane", Email: "jane@example.com", SignCommits: true},
+		Rewrites: []model.URLRewrite{
+			{Base: "git@github.com:", InsteadOf: "https://github.com/"},
+			{Base: "git@gitlab.com:", InsteadOf: "gl:"},
+		},
+		Includes: []GitConfigInclude{
+			{Condition: "gitdir:/src/work/", Path: "/home/jane/.gitconfig-work", Identity: GitIdentity{Email: "jane@company.com"}},
+			{Condition: "hasconfig:remote.*.url:https://github.com/acme/**", Path: "/home/jane/.gitconfig-acme", Identity: GitIdentity{Email: "jane@acme.com"}},
+		},
+	}
+
+	state := gitConfigState{
+		config: &model.Config{
+			DefaultCloneDir: "/src",
+			URLRewrites:     []model.URLRewrite{{Base: "git@github.com:", InsteadOf: "https://github.com/"}},
+		},
+		profiles: []model.Profile{
+			{Name: "personal", Default: true, GitName: "J. Doe"},
+			{Name: "work", Workspace: "work"},
+		},
+		workspaces: []model.Workspace{{Name: "work", Path: "/src/work"}},
+	}
+
+	res, err := planGitConfigImport(settings, state, GitConfigImportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	personal := res.profiles["personal"]
+	if personal == nil || personal.GitName != "J. Doe" || personal.GitEmail != "jane@example.com" || !personal.GitSignCommits {
+		t.Errorf("personal profile = %+v, want name kept and email/signing added", personal)
+	}
+
+	if work := res.profiles["work"]; work == nil || work.GitEmail != "jane@company.com" {
+		t.Errorf("work profile = %+v, want email from gitdir include", work)
+	}
+
+	acme := res.workspaces["acme"]
+	if acme == nil || acme.Path != filepath.Join("/src", "acme") || len(acme.URLPatterns) != 1 || acme.URLPatterns[0] != "github.com/acme" {
+		t.Errorf("acme workspace = %+v", acme)
+	}
+
+	if res.config == nil || len(res.config.URLRewrites) != 2 {
+		t.Fatalf("config rewrites = %+v, want existing plus gl:", res.config)
+	}
+
+	if len(state.config.URLRewrites) != 1 || state.profiles[0].GitEmail != "" {
+		t.Error("planning modified the input state")
+	}
+
+	skipped := 0
+
+	for _, c := range res.Changes {
+		if c.Skipped != "" {
+			skipped++
+		}
+	}
+
+	// The duplicate rewrite and the acme identity without a bound profile
+	if skipped != 2 {
+		t.Errorf("skipped = %d, want 2: %+v", skipped, res.Changes)
+	}
+
+	overwritten, err := planGitConfigImport(settings, state, GitConfigImportOptions{Overwrite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p := overwritten.profiles["personal"]; p == nil || p.GitName != "Jane" {
+		t.Errorf("overwrite: personal profile = %+v, want name replaced", p)
+	}
+
+	if _, err := planGitConfigImport(settings, state, GitConfigImportOptions{Profile: "missing"}); err == nil {
+		t.Error("expected error for unknown profile")
+	}
+}
+
+func TestIncludeWorkspaceName(t *testing.T) {
+	tests := map[string]string{
+		"/home/jane/.gitconfig-work":  "work",
+		"/home/jane/.gitconfig_OSS":   "oss",
+		"/home/jane/git/client.inc":   "client",
+		"/home/jane/.gitconfig":       "",
+		"/home/jane/gitconfig.client": "client",
+	}
+
+	for path, want := range tests {
+		if got := includeWorkspaceName(path); got != want {
+			t.Errorf("includeWorkspaceName(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
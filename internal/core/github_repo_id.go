@@ -0,0 +1,92 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/store"
+)
+
+// GitHubRepoIDOptions configures a GitHub repository ID lookup
+type GitHubRepoIDOptions struct {
+	Refresh bool          // Bypass the cache and fetch the ID from GitHub
+	TTL     time.Duration // Age after which a cached ID is refetched (default: model.DefaultGitHubRepoIDTTL)
+	Logger  *slog.Logger
+}
+
+// repoIDCache is the part of the store caching repository IDs
+type repoIDCache interface {
+	SaveGitHubRepoID(entry *model.GitHubRepoID) error
+	GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error)
+}
+
+// GetGitHubRepoID returns the numeric ID of a GitHub repository. IDs are
+// cached in the store by owner/repo, so repeated ZenHub commands skip the
+// GitHub API call until the entry is older than the TTL.
+func GetGitHubRepoID(token, owner, repo string, opts GitHubRepoIDOptions) (int64, error) {
+	return getGitHubRepoID(store.GetDB(), func(ctx context.Context) (int64, error) {
+		repository, _, err := NewGitHubClient(ctx, token).Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return 0, err
+		}
+
+		return repository.GetID(), nil
+	}, owner, repo, opts)
+}
+
+// getGitHubRepoID looks owner/repo up in cache before calling fetch. Cache
+// failures are logged and otherwise ignored; the ID can always be refetched.
+func getGitHubRepoID(cache repoIDCache, fetch func(context.Context) (int64, error), owner, repo string, opts GitHubRepoIDOptions) (int64, error) {
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = model.DefaultGitHubRepoIDTTL
+	}
+
+	// GitHub names are case-insensitive
+	fullName := strings.ToLower(owner + "/" + repo)
+
+	if !opts.Refresh {
+		entry, err := cache.GetGitHubRepoID(fullName)
+
+		switch {
+		case err != nil:
+			logger.Debug("failed to read cached GitHub repo ID", slog.String("repo", fullName), slog.Any("error", err))
+		case entry != nil && !entry.Expired(time.Now(), ttl):
+			logger.Debug("using cached GitHub repo ID", slog.String("repo", fullName), slog.Int64("id", entry.RepoID))
+
+			return entry.RepoID, nil
+		}
+	}
+
+	logger.Debug("fetching GitHub repo ID",
+		slog.String("owner", owner),
+		slog.String("repo", repo),
+	)
+
+	ctx, cancel := context.WithTimeout(BaseContext(), 30*time.Second)
+	defer cancel()
+
+	id, err := fetch(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	if err := cache.SaveGitHubRepoID(&model.GitHubRepoID{
+		FullName: fullName,
+		RepoID:   id,
+		CachedAt: time.Now(),
+	}); err != nil {
+		logger.Debug("failed to cache GitHub repo ID", slog.String("repo", fullName), slog.Any("error", err))
+	}
+
+	return id, nil
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+type fakeRepoIDCache struct {
+	entries map[string]*model.GitHubRepoID
+}
+
+func (c *fakeRepoIDCache) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
+	c.entries[entry.FullName] = entry
+
+	return nil
+}
+
+func (c *fakeRepoIDCache) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
+	return c.entries[fullName], nil
+}
+
+func TestGetGitHubRepoID_Cache(t *testing.T) {
+	cache := &fakeRepoIDCache{entries: map[string]*model.GitHubRepoID{}}
+	fetches := 0
+
+	fetch := func(context.Context) (int64, error) {
+		fetches++
+
+		return 42, nil
+	}
+
+	for _, name := range []string{"Owner", "owner"} {
+		id, err := getGitHubRepoID(cache, fetch, name, "Repo", GitHubRepoIDOptions{})
+		if err != nil || id != 42 {
+			t.Fatalf("getGitHubRepoID(%s) = %d, %v, want 42", name, id, err)
+		}
+	}
+
+	if fetches != 1 {
+		t.Errorf("fetched %d times, want 1 (second lookup cached)", fetches)
+	}
+
+	if _, err := getGitHubRepoID(cache, fetch, "owner", "repo", GitHubRepoIDOptions{Refresh: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetches != 2 {
+		t.Errorf("fetched %d times after --refresh, want 2", fetches)
+	}
+}
+
+func TestGetGitHubRepoID_Expired(t *testing.T) {
+	cache := &fakeRepoIDCache{entries: map[string]*model.GitHubRepoID{
+		"owner/repo": {FullName: "owner/repo", RepoID: 1, CachedAt: time.Now().Add(-2 * time.Hour)},
+	}}
+
+	id, err := getGitHubRepoID(cache, func(context.Context) (int64, error) { return 2, nil }, "owner", "repo", GitHubRepoIDOptions{TTL: time.Hour})
+	if err != nil || id != 2 {
+		t.Fatalf("getGitHubRepoID() = %d, %v, want the refetched 2", id, err)
+	}
+
+	if cache.entries["owner/repo"].RepoID != 2 {
+		t.Errorf("cache not updated: %+v", cache.entries["owner/repo"])
+	}
+}
+
+func TestGetGitHubRepoID_FetchError(t *testing.T) {
+	cache := &fakeRepoIDCache{entries: map[string]*model.GitHubRepoID{}}
+
+	_, err := getGitHubRepoID(cache, func(context.Context) (int64, error) { return 0, errors.New("404") }, "owner", "repo", GitHubRepoIDOptions{})
+	if err == nil {
+		t.Fatal("getGitHubRepoID() error = nil, want the fetch error")
+	}
+
+	if len(cache.entries) != 0 {
+		t.Errorf("cached a failed lookup: %v", cache.entries)
+	}
+}
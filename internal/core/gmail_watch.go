@@ -0,0 +1,416 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/store"
+)
+
+const (
+	// gmailWatchSearchPages bounds the search pages a poll looks through
+	// for the messages that arrived since the previous poll
+	gmailWatchSearchPages = 5
+
+	// gmailWatchHookTimeout bounds a hook run for a matching message
+	gmailWatchHookTimeout = time.Minute
+)
+
+// gmailWatchNamePattern matches the characters not allowed in watch names
+var gmailWatchNamePattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// gmailWatchClient is the part of the Gmail client a watch polls with
+type gmailWatchClient interface {
+	GetProfile(ctx context.Context) (*gmail.Profile, error)
+	AddedMessageIDs(ctx context.Context, startHistoryID string) ([]string, string, error)
+	ListMessages(ctx context.Context, opts gmail.ListMessagesOptions) (*gmail.ListMessagesResponse, error)
+	GetMessage(ctx context.Context, id string, format string) (*gmail.Message, error)
+}
+
+// GmailWatchName derives a watch name from a Gmail search query.
+func GmailWatchName(query string) string {
+	name := strings.Trim(gmailWatchNamePattern.ReplaceAllString(strings.ToLower(query), "-"), "-")
+	if len(name) > 32 {
+		name = strings.TrimRight(name[:32], "-")
+	}
+
+	if name == "" {
+		return "gmail"
+	}
+
+	return name
+}
+
+// AddGmailWatch validates and saves a Gmail watch. Without a profile it
+// watches the Gmail account of the active profile.
+func AddGmailWatch(watch *model.GmailWatch) error {
+	if strings.TrimSpace(watch.Query) == "" {
+		return errors.New("a search query is required")
+	}
+
+	if watch.Name == "" {
+		watch.Name = GmailWatchName(watch.Query)
+	}
+
+	if watch.Interval == 0 {
+		watch.Interval = model.DefaultGmailWatchInterval
+	}
+
+	if watch.Interval < model.MinGmailWatchInterval {
+		return fmt.Errorf("interval must be at least %s", model.MinGmailWatchInterval)
+	}
+
+	if !watch.Notify && watch.Exec == "" {
+		return errors.New("a watch without notifications needs a hook to run")
+	}
+
+	pm, err := NewProfileManager()
+	if err != nil {
+		return err
+	}
+
+	if watch.Profile == "" {
+		profile, err := pm.GetActiveProfile()
+		if err != nil {
+			return fmt.Errorf("no active profile: %w", err)
+		}
+
+		watch.Profile = profile.Name
+	}
+
+	channel, err := pm.GetNotifyChannelByType(watch.Profile, model.ChannelGmail)
+	if err != nil {
+		return err
+	}
+
+	if channel == nil {
+		return fmt.Errorf("profile %s has no Gmail account; add one with: clonr gmail add", watch.Profile)
+	}
+
+	db := store.GetDB()
+
+	existing, err := db.GetGmailWatch(watch.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get Gmail watch: %w", err)
+	}
+
+	// Keep the position in the mailbox when a watch is changed
+	if existing != nil && existing.Profile == watch.Profile {
+		watch.HistoryID = existing.HistoryID
+		watch.CreatedAt = existing.CreatedAt
+	}
+
+	if err := db.SaveGmailWatch(watch); err != nil {
+		return fmt.Errorf("failed to save Gmail watch: %w", err)
+	}
+
+	return nil
+}
+
+// ListGmailWatches returns the Gmail watches.
+func ListGmailWatches() ([]model.GmailWatch, error) {
+	watches, err := store.GetDB().ListGmailWatches()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Gmail watches: %w", err)
+	}
+
+	return watches, nil
+}
+
+// RemoveGmailWatch removes a Gmail watch. It reports whether it existed.
+func RemoveGmailWatch(name string) (bool, error) {
+	db := store.GetDB()
+
+	watch, err := db.GetGmailWatch(name)
+	if err != nil {
+		return false, fmt.Errorf("failed to get Gmail watch: %w", err)
+	}
+
+	if watch == nil {
+		return false, nil
+	}
+
+	if err := db.DeleteGmailWatch(name); err != nil {
+		return false, fmt.Errorf("failed to remove Gmail watch: %w", err)
+	}
+
+	return true, nil
+}
+
+// PollGmailWatch returns the messages matching the query of watch that
+// arrived since its previous poll, oldest first, and moves the history ID
+// of the watch past them. The first poll only records the history ID, so
+// mail that was already there does not trigger. When the history ID has
+// expired, the watch starts over from the current mailbox.
+func PollGmailWatch(ctx context.Context, client gmailWatchClient, watch *model.GmailWatch) ([]*gmail.Message, error) {
+	if watch.HistoryID == "" {
+		return nil, resetGmailWatch(ctx, client, watch)
+	}
+
+	added, historyID, err := client.AddedMessageIDs(ctx, watch.HistoryID)
+	if errors.Is(err, gmail.ErrHistoryExpired) {
+		log.Printf("gmail watch %s: history expired, starting over", watch.Name)
+		return nil, resetGmailWatch(ctx, client, watch)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*gmail.Message
+
+	if len(added) > 0 {
+		matched, err := searchAddedMessages(ctx, client, watch.Query, added)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range matched {
+			msg, err := client.GetMessage(ctx, id, "metadata")
+			if err != nil {
+				return nil, fmt.Errorf("failed to get message %s: %w", id, err)
+			}
+
+			messages = append(messages, msg)
+		}
+	}
+
+	if historyID != "" {
+		watch.HistoryID = historyID
+	}
+
+	return messages, nil
+}
+
+// resetGmailWatch sets the history ID of watch to the current one of the mailbox
+func resetGmailWatch(ctx context.Context, client gmailWatchClient, watch *model.GmailWatch) error {
+	profile, err := client.GetProfile(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Gmail profile: %w", err)
+	}
+
+	watch.HistoryID = profile.HistoryID
+
+	return nil
+}
+
+// searchAddedMessages returns the IDs of added that match query, in the
+// order they were added. The history API cannot filter by query, so the
+// newest search results are compared with the added messages.
+func searchAddedMessages(ctx context.Context, client gmailWatchClient, query string, added []string) ([]string, error) {
+	found := make(map[string]bool)
+	opts := gmail.ListMessagesOptions{Query: query, MaxResults: 100}
+
+	for range gmailWatchSearchPages {
+		resp, err := client.ListMessages(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search messages: %w", err)
+		}
+
+		for _, ref := range resp.Messages {
+			if slices.Contains(added, ref.ID) {
+				found[ref.ID] = true
+			}
+		}
+
+		if len(found) == len(added) || resp.NextPageToken == "" {
+			break
+		}
+
+		opts.PageToken = resp.NextPageToken
+	}
+
+	var matched []string
+
+	for _, id := range added {
+		if found[id] && !slices.Contains(matched, id) {
+			matched = append(matched, id)
+		}
+	}
+
+	return matched, nil
+}
+
+// RunGmailWatches polls every due Gmail watch once and acts on the matching
+// messages. The server calls it periodically. Watches are paused in
+// air-gapped mode and catch up from their history ID once it is off.
+func RunGmailWatches(ctx context.Context, db store.Store) {
+	if IsOffline() {
+		return
+	}
+
+	watches, err := db.ListGmailWatches()
+	if err != nil {
+		log.Printf("Warning: failed to list Gmail watches: %v", err)
+		return
+	}
+
+	now := time.Now()
+
+	for i := range watches {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if watches[i].Due(now) {
+			runGmailWatch(ctx, db, &watches[i])
+		}
+	}
+}
+
+// runGmailWatch polls one watch, acts on its matches and saves its state
+func runGmailWatch(ctx context.Context, db store.Store, watch *model.GmailWatch) {
+	messages, err := pollGmailWatch(ctx, watch)
+
+	watch.LastCheckedAt = time.Now()
+	watch.LastError = ""
+
+	if err != nil {
+		log.Printf("Warning: gmail watch %s: %v", watch.Name, err)
+		watch.LastError = err.Error()
+	}
+
+	for _, msg := range messages {
+		handleGmailWatchMatch(ctx, watch, msg)
+		watch.LastMatchAt = watch.LastCheckedAt
+	}
+
+	// The watch may have been removed or changed while it was polled
+	current, err := db.GetGmailWatch(watch.Name)
+	if err != nil || current == nil || current.Profile != watch.Profile {
+		return
+	}
+
+	current.HistoryID = watch.HistoryID
+	current.LastCheckedAt = watch.LastCheckedAt
+	current.LastMatchAt = watch.LastMatchAt
+	current.LastError = watch.LastError
+
+	if err := db.SaveGmailWatch(current); err != nil {
+		log.Printf("Warning: failed to save Gmail watch %s: %v", watch.Name, err)
+	}
+}
+
+// pollGmailWatch polls a watch with the Gmail account of its profile
+func pollGmailWatch(ctx context.Context, watch *model.GmailWatch) ([]*gmail.Message, error) {
+	client, err := gmailClientForProfile(watch.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return PollGmailWatch(ctx, client, watch)
+}
+
+// gmailClientForProfile returns a client for the Gmail account of a profile
+func gmailClientForProfile(profileName string) (*gmail.Client, error) {
+	pm, err := NewProfileManager()
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := pm.GetNotifyChannelByType(profileName, model.ChannelGmail)
+	if err != nil {
+		return nil, err
+	}
+
+	if channel == nil {
+		return nil, fmt.Errorf("profile %s has no Gmail account", profileName)
+	}
+
+	config, err := pm.DecryptChannelConfig(profileName, channel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt Gmail config: %w", err)
+	}
+
+	if config["access_token"] == "" {
+		return nil, fmt.Errorf("no access token found in Gmail config")
+	}
+
+	return gmail.NewClient(config["access_token"], gmail.ClientOptions{
+		RefreshToken: config["refresh_token"],
+		ClientID:     config["client_id"],
+		ClientSecret: config["client_secret"],
+		OnTokenRefresh: func(token *gmail.OAuthResult) {
+			err := pm.UpdateNotifyChannelConfig(profileName, channel.ID, map[string]string{
+				"access_token":  token.AccessToken,
+				"refresh_token": token.RefreshToken,
+			})
+			if err != nil {
+				log.Printf("Warning: failed to save refreshed Gmail token: %v", err)
+			}
+		},
+	}), nil
+}
+
+// handleGmailWatchMatch notifies about a matching message and runs the hook
+// of the watch
+func handleGmailWatchMatch(ctx context.Context, watch *model.GmailWatch, msg *gmail.Message) {
+	if watch.Notify {
+		sendEvent(ctx, gmailWatchEvent(watch, msg))
+	}
+
+	if watch.Exec == "" {
+		return
+	}
+
+	if output, err := runGmailWatchHook(ctx, watch.Exec, gmailWatchEnv(watch, msg)); err != nil {
+		log.Printf("Warning: gmail watch %s: hook failed: %v: %s", watch.Name, err, strings.TrimSpace(output))
+	}
+}
+
+// gmailWatchEvent creates the notification of a matching message
+func gmailWatchEvent(watch *model.GmailWatch, msg *gmail.Message) *notify.Event {
+	event := notify.NewEvent(notify.EventGmailMessage)
+	event.Author = msg.Headers["from"]
+	event.Profile = watch.Profile
+	event.URL = "https://mail.google.com/mail/u/0/#all/" + msg.ID
+	event.Extra["watch"] = watch.Name
+	event.Extra["subject"] = msg.Headers["subject"]
+	event.Extra["snippet"] = msg.Snippet
+
+	return event
+}
+
+// gmailWatchEnv returns the environment a hook learns about the message from
+func gmailWatchEnv(watch *model.GmailWatch, msg *gmail.Message) []string {
+	return []string{
+		"CLONR_GMAIL_WATCH=" + watch.Name,
+		"CLONR_GMAIL_MESSAGE_ID=" + msg.ID,
+		"CLONR_GMAIL_THREAD_ID=" + msg.ThreadID,
+		"CLONR_GMAIL_FROM=" + msg.Headers["from"],
+		"CLONR_GMAIL_SUBJECT=" + msg.Headers["subject"],
+		"CLONR_GMAIL_DATE=" + msg.Headers["date"],
+		"CLONR_GMAIL_SNIPPET=" + msg.Snippet,
+	}
+}
+
+// runGmailWatchHook runs a hook with the platform shell
+func runGmailWatchHook(ctx context.Context, command string, env []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, gmailWatchHookTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	cmd.Env = append(os.Environ(), env...)
+
+	output, err := cmd.CombinedOutput()
+
+	return string(output), err
+}
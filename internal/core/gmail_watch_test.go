@@ -0,0 +1,135 @@
+package core
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// fakeGmailWatchClient serves a fixed mailbox to PollGmailWatch
+type fakeGmailWatchClient struct {
+	historyID  string
+	added      []string
+	historyErr error
+	matching   []string
+	fetched    []string
+}
+
+func (c *fakeGmailWatchClient) GetProfile(context.Context) (*gmail.Profile, error) {
+	return &gmail.Profile{HistoryID: c.historyID}, nil
+}
+
+func (c *fakeGmailWatchClient) AddedMessageIDs(context.Context, string) ([]string, string, error) {
+	if c.historyErr != nil {
+		return nil, "", c.historyErr
+	}
+
+	return c.added, c.historyID, nil
+}
+
+func (c *fakeGmailWatchClient) ListMessages(context.Context, gmail.ListMessagesOptions) (*gmail.ListMessagesResponse, error) {
+	resp := &gmail.ListMessagesResponse{}
+	for _, id := range c.matching {
+		resp.Messages = append(resp.Messages, gmail.MessageRef{ID: id})
+	}
+
+	return resp, nil
+}
+
+func (c *fakeGmailWatchClient) GetMessage(_ context.Context, id string, _ string) (*gmail.Message, error) {
+	c.fetched = append(c.fetched, id)
+
+	return &gmail.Message{ID: id}, nil
+}
+
+func TestPollGmailWatch_FirstPollSetsBaseline(t *testing.T) {
+	client := &fakeGmailWatchClient{historyID: "100", added: []string{"m1"}, matching: []string{"m1"}}
+	watch := &model.GmailWatch{Name: "gh", Query: "from:github"}
+
+	messages, err := PollGmailWatch(context.Background(), client, watch)
+	if err != nil {
+		t.Fatalf("PollGmailWatch() error = %v", err)
+	}
+
+	if len(messages) != 0 {
+		t.Errorf("first poll returned %d messages, want 0", len(messages))
+	}
+
+	if watch.HistoryID != "100" {
+		t.Errorf("HistoryID = %q, want %q", watch.HistoryID, "100")
+	}
+}
+
+func TestPollGmailWatch_ReturnsAddedMatches(t *testing.T) {
+	client := &fakeGmailWatchClient{
+		historyID: "120",
+		added:     []string{"m1", "m2", "m3"},
+		matching:  []string{"m3", "m1", "old"},
+	}
+	watch := &model.GmailWatch{Name: "gh", Query: "from:github", HistoryID: "100"}
+
+	messages, err := PollGmailWatch(context.Background(), client, watch)
+	if err != nil {
+		t.Fatalf("PollGmailWatch() error = %v", err)
+	}
+
+	var ids []string
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+
+	if want := []string{"m1", "m3"}; !slices.Equal(ids, want) {
+		t.Errorf("messages = %v, want %v", ids, want)
+	}
+
+	if watch.HistoryID != "120" {
+		t.Errorf("HistoryID = %q, want %q", watch.HistoryID, "120")
+	}
+}
+
+func TestPollGmailWatch_ExpiredHistoryResets(t *testing.T) {
+	client := &fakeGmailWatchClient{historyID: "500", historyErr: gmail.ErrHistoryExpired}
+	watch := &model.GmailWatch{Name: "gh", Query: "from:github", HistoryID: "1"}
+
+	messages, err := PollGmailWatch(context.Background(), client, watch)
+	if err != nil {
+		t.Fatalf("PollGmailWatch() error = %v", err)
+	}
+
+	if len(messages) != 0 || len(client.fetched) != 0 {
+		t.Errorf("expired history fetched %v, want nothing", client.fetched)
+	}
+
+	if watch.HistoryID != "500" {
+		t.Errorf("HistoryID = %q, want %q", watch.HistoryID, "500")
+	}
+}
+
+func TestGmailWatchName(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"from:notifications@github.com", "from-notifications-github-com"},
+		{"Subject:[CI] failed", "subject-ci-failed"},
+		{"from:notifications@github.com subject:failed", "from-notifications-github-com-su"},
+		{"!!!", "gmail"},
+	}
+
+	for _, tt := range tests {
+		if got := GmailWatchName(tt.query); got != tt.want {
+			t.Errorf("GmailWatchName(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestRunGmailWatches_PausedOffline(t *testing.T) {
+	useTempAppdata(t)
+	t.Setenv(OfflineEnv, "1")
+
+	// The store is not touched while air-gapped; a nil store would panic
+	RunGmailWatches(t.Context(), nil)
+}
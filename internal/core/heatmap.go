@@ -0,0 +1,146 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// heatmapDateLayout is the key format of CommitHeatmap.Days
+const heatmapDateLayout = "2006-01-02"
+
+// CommitHeatmapOptions configures BuildCommitHeatmap
+type CommitHeatmapOptions struct {
+	Since  time.Time // First day of the heatmap
+	Until  time.Time // Last day of the heatmap (default: today)
+	Author string    // Only commits whose author matches (git log --author)
+}
+
+// CommitHeatmap counts commits per day across one or more repositories
+type CommitHeatmap struct {
+	Since   time.Time      `json:"since"`
+	Until   time.Time      `json:"until"`
+	Author  string         `json:"author,omitempty"`
+	Repos   int            `json:"repos"`
+	Total   int            `json:"total"`
+	Max     int            `json:"max"`
+	Days    map[string]int `json:"days"`              // YYYY-MM-DD -> commits
+	Skipped []string       `json:"skipped,omitempty"` // Repositories whose history could not be read
+}
+
+// BuildCommitHeatmap counts the commits reachable from HEAD of each
+// repository per author day. Repositories whose log cannot be read are
+// listed in Skipped.
+func BuildCommitHeatmap(repos []model.Repository, opts CommitHeatmapOptions) *CommitHeatmap {
+	until := opts.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+
+	h := &CommitHeatmap{
+		Since:  heatmapDay(opts.Since),
+		Until:  heatmapDay(until),
+		Author: opts.Author,
+		Days:   make(map[string]int),
+	}
+
+	for _, repo := range repos {
+		args := []string{"-C", repo.Path, "log", "--format=%as", "--since=" + h.Since.Format(heatmapDateLayout)}
+		if opts.Author != "" {
+			args = append(args, "--author="+opts.Author)
+		}
+
+		output, err := runGitCommand(args...)
+		if err != nil {
+			h.Skipped = append(h.Skipped, repo.Path)
+			continue
+		}
+
+		h.Repos++
+
+		for day := range strings.SplitSeq(output, "\n") {
+			if day = strings.TrimSpace(day); day == "" || day > h.Until.Format(heatmapDateLayout) {
+				continue
+			}
+
+			h.Days[day]++
+			h.Total++
+			h.Max = max(h.Max, h.Days[day])
+		}
+	}
+
+	return h
+}
+
+// heatmapDay truncates t to midnight of its day in the local time zone
+func heatmapDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+// HeatmapLevel buckets a day count into 0 (no commits) to 4 (the busiest
+// days) relative to the busiest day
+func HeatmapLevel(count, maxCount int) int {
+	if count <= 0 || maxCount <= 0 {
+		return 0
+	}
+
+	return min((count*4+maxCount-1)/maxCount, 4)
+}
+
+// RenderCommitHeatmap draws the heatmap as a contribution-style grid: one
+// column per week, one row per weekday, month names above. glyphs holds
+// the cell for each level returned by HeatmapLevel.
+func RenderCommitHeatmap(h *CommitHeatmap, glyphs [5]string) string {
+	// Weeks start on Sunday
+	start := h.Since.AddDate(0, 0, -int(h.Since.Weekday()))
+	weeks := int(h.Until.Sub(start).Hours()/24)/7 + 1
+
+	var b strings.Builder
+
+	// Month labels, each cell being two columns wide
+	months := make([]byte, 0, weeks*2)
+	lastMonth := time.Month(0)
+
+	for w := range weeks {
+		day := start.AddDate(0, 0, 7*w)
+
+		if day.Month() == lastMonth {
+			continue
+		}
+
+		lastMonth = day.Month()
+
+		// Skip a label that would overlap the previous one
+		if len(months) <= 2*w {
+			months = append(months, strings.Repeat(" ", 2*w-len(months))...)
+			months = append(months, day.Format("Jan")...)
+		}
+	}
+
+	b.WriteString("     " + strings.TrimRight(string(months), " ") + "\n")
+
+	labels := [7]string{"", "Mon", "", "Wed", "", "Fri", ""}
+
+	for weekday := range 7 {
+		row := fmt.Sprintf("%-4s ", labels[weekday])
+
+		for w := range weeks {
+			day := start.AddDate(0, 0, 7*w+weekday)
+
+			if day.Before(h.Since) || day.After(h.Until) {
+				row += "  "
+				continue
+			}
+
+			row += glyphs[HeatmapLevel(h.Days[day.Format(heatmapDateLayout)], h.Max)] + " "
+		}
+
+		b.WriteString(strings.TrimRight(row, " ") + "\n")
+	}
+
+	return b.String()
+}
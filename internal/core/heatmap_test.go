@@ -0,0 +1,76 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestHeatmapLevel(t *testing.T) {
+	tests := []struct {
+		count, max, want int
+	}{
+		{0, 10, 0},
+		{1, 10, 1},
+		{3, 10, 2},
+		{5, 10, 2},
+		{7, 10, 3},
+		{10, 10, 4},
+		{1, 1, 4},
+		{3, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := HeatmapLevel(tt.count, tt.max); got != tt.want {
+			t.Errorf("HeatmapLevel(%d, %d) = %d, want %d", tt.count, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestRenderCommitHeatmap(t *testing.T) {
+	// Wednesday 2026-01-07 to Tuesday 2026-01-20
+	h := &CommitHeatmap{
+		Since: time.Date(2026, 1, 7, 0, 0, 0, 0, time.Local),
+		Until: time.Date(2026, 1, 20, 0, 0, 0, 0, time.Local),
+		Max:   4,
+		Days:  map[string]int{"2026-01-07": 4, "2026-01-12": 1},
+	}
+
+	got := RenderCommitHeatmap(h, [5]string{".", "1", "2", "3", "4"})
+
+	want := strings.Join([]string{
+		"     Jan",
+		"       . .",
+		"Mon    1 .",
+		"       . .",
+		"Wed  4 .",
+		"     . .",
+		"Fri  . .",
+		"     . .",
+		"",
+	}, "\n")
+
+	if got != want {
+		t.Errorf("RenderCommitHeatmap() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestBuildCommitHeatmap(t *testing.T) {
+	upstream, _, _ := initForkTestRepos(t)
+
+	forkTestGit(t, upstream, "commit", "-q", "--allow-empty", "-m", "second")
+
+	h := BuildCommitHeatmap([]model.Repository{{Path: upstream}, {Path: t.TempDir()}}, CommitHeatmapOptions{
+		Since: time.Now().AddDate(0, 0, -7),
+	})
+
+	if h.Total != 2 || h.Repos != 1 || h.Max != 2 || len(h.Skipped) != 1 {
+		t.Errorf("BuildCommitHeatmap() = %+v, want 2 commits today in 1 repository", h)
+	}
+
+	if h := BuildCommitHeatmap([]model.Repository{{Path: upstream}}, CommitHeatmapOptions{Author: "nobody"}); h.Total != 0 {
+		t.Errorf("BuildCommitHeatmap(author=nobody) total = %d, want 0", h.Total)
+	}
+}
@@ -0,0 +1,256 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// IdentityResult is the outcome of applying a git identity to one repository
+type IdentityResult struct {
+	Path    string `json:"path"`
+	Profile string `json:"profile,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// IdentityApplyOptions selects the repositories and profile for ApplyIdentities
+type IdentityApplyOptions struct {
+	Profile   string   // Profile to apply (empty resolves per repository)
+	Workspace string   // Only repositories in this workspace
+	Paths     []string // Explicit repository paths (empty means all tracked repos)
+}
+
+// hasGitIdentity reports whether the profile defines a user.name or user.email
+func hasGitIdentity(p *model.Profile) bool {
+	return p != nil && (p.GitName != "" || p.GitEmail != "")
+}
+
+// IdentityProfile picks the profile whose git identity applies to a repository
+// in the given workspace: the profile bound to that workspace, otherwise the
+// default profile. Profiles without a git identity are skipped.
+func IdentityProfile(profiles []model.Profile, workspace string) *model.Profile {
+	var fallback *model.Profile
+
+	for i := range profiles {
+		p := &profiles[i]
+		if !hasGitIdentity(p) {
+			continue
+		}
+
+		if workspace != "" && p.Workspace == workspace {
+			return p
+		}
+
+		if p.Default && fallback == nil {
+			fallback = p
+		}
+	}
+
+	return fallback
+}
+
+// ApplyIdentity writes the profile's user.name and user.email, and its commit
+// signing settings when set, to the repository-local git config.
+func ApplyIdentity(ctx context.Context, repoPath string, profile *model.Profile) error {
+	if !hasGitIdentity(profile) {
+		return fmt.Errorf("profile '%s' has no git identity configured", profile.Name)
+	}
+
+	client := git.NewClientForRepo(repoPath)
+
+	if profile.GitName != "" {
+		if err := client.SetConfig(ctx, "user.name", profile.GitName); err != nil {
+			return err
+		}
+	}
+
+	if profile.GitEmail != "" {
+		if err := client.SetConfig(ctx, "user.email", profile.GitEmail); err != nil {
+			return err
+		}
+	}
+
+	if profile.GitSigningKey != "" {
+		if err := client.SetConfig(ctx, "user.signingkey", profile.GitSigningKey); err != nil {
+			return err
+		}
+	}
+
+	if profile.GitSigningFormat != "" {
+		if err := client.SetConfig(ctx, "gpg.format", profile.GitSigningFormat); err != nil {
+			return err
+		}
+	}
+
+	if profile.GitSignCommits {
+		if err := client.SetConfig(ctx, "commit.gpgsign", "true"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyCloneIdentity sets the git identity of the profile matching the clone's
+// workspace on a freshly cloned repository. It is a no-op when no profile
+// defines an identity.
+func ApplyCloneIdentity(result *CloneResult) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	profile := IdentityProfile(profiles, result.Workspace)
+	if profile == nil {
+		return nil
+	}
+
+	ctx, cancel := WithShortTimeout()
+	defer cancel()
+
+	if err := ApplyIdentity(ctx, result.TargetPath, profile); err != nil {
+		return err
+	}
+
+	log.Printf("Applied git identity from profile '%s'\n", profile.Name)
+
+	return nil
+}
+
+// ApplyIdentities applies profile git identities to tracked repositories
+func ApplyIdentities(opts IdentityApplyOptions) ([]IdentityResult, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	profiles, err := client.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	var override *model.Profile
+
+	if opts.Profile != "" {
+		for i := range profiles {
+			if profiles[i].Name == opts.Profile {
+				override = &profiles[i]
+
+				break
+			}
+		}
+
+		if override == nil {
+			return nil, ErrProfileNotFound
+		}
+
+		if !hasGitIdentity(override) {
+			return nil, fmt.Errorf("profile '%s' has no git identity configured", override.Name)
+		}
+	}
+
+	repos, err := identityTargets(client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]IdentityResult, 0, len(repos))
+
+	for _, repo := range repos {
+		res := IdentityResult{Path: repo.Path}
+
+		profile := override
+		if profile == nil {
+			profile = IdentityProfile(profiles, repo.Workspace)
+		}
+
+		if profile == nil {
+			res.Error = "no profile with a git identity"
+			results = append(results, res)
+
+			continue
+		}
+
+		res.Profile, res.Name, res.Email = profile.Name, profile.GitName, profile.GitEmail
+
+		ctx, cancel := WithShortTimeout()
+		if err := ApplyIdentity(ctx, repo.Path, profile); err != nil {
+			res.Error = err.Error()
+		}
+
+		cancel()
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// identityTargets resolves the repositories selected by opts. Explicit paths
+// that are not tracked are still returned, without a workspace.
+func identityTargets(client *grpc.Client, opts IdentityApplyOptions) ([]model.Repository, error) {
+	repos, err := client.GetRepos(opts.Workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	if len(opts.Paths) == 0 {
+		return repos, nil
+	}
+
+	byPath := make(map[string]model.Repository, len(repos))
+	for _, repo := range repos {
+		byPath[filepath.Clean(repo.Path)] = repo
+	}
+
+	targets := make([]model.Repository, 0, len(opts.Paths))
+
+	for _, p := range opts.Paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %s: %w", p, err)
+		}
+
+		repo, ok := byPath[abs]
+		if !ok {
+			if opts.Workspace != "" {
+				continue
+			}
+
+			repo = model.Repository{Path: abs}
+		}
+
+		targets = append(targets, repo)
+	}
+
+	return targets, nil
+}
+
+// RepoIdentity returns the repository-local user.name and user.email
+func RepoIdentity(ctx context.Context, repoPath string) (string, string, error) {
+	client := git.NewClientForRepo(repoPath)
+
+	name, err := client.GetConfig(ctx, "user.name")
+	if err != nil {
+		return "", "", err
+	}
+
+	email, err := client.GetConfig(ctx, "user.email")
+	if err != nil {
+		return "", "", err
+	}
+
+	return name, email, nil
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestIdentityProfile(t *testing.T) {
+	profiles := []model.Profile{
+		{Name: "personal", Default: true, GitName: "Jane", GitEmail: "jane@example.com"},
+		{Name: "work", Workspace: "work", GitName: "Jane Doe", GitEmail: "jane@company.com"},
+		{Name: "oss", Workspace: "oss"},
+	}
+
+	tests := []struct {
+		name      string
+		profiles  []model.Profile
+		workspace string
+		want      string
+	}{
+		{name: "workspace profile", profiles: profiles, workspace: "work", want: "work"},
+		{name: "default profile", profiles: profiles, workspace: "other", want: "personal"},
+		{name: "no workspace", profiles: profiles, want: "personal"},
+		{name: "workspace profile without identity", profiles: profiles, workspace: "oss", want: "personal"},
+		{name: "no identities", profiles: []model.Profile{{Name: "a", Default: true}}, workspace: "work"},
+		{name: "no profiles", workspace: "work"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IdentityProfile(tt.profiles, tt.workspace)
+
+			name := ""
+			if got != nil {
+				name = got.Name
+			}
+
+			if name != tt.want {
+				t.Errorf("IdentityProfile(%q) = %q, want %q", tt.workspace, name, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyIdentity(t *testing.T) {
+	dir := t.TempDir()
+
+	ctx, cancel := WithShortTimeout()
+	defer cancel()
+
+	if err := exec.Command("git", "init", "-q", dir).Run(); err != nil {
+		t.Skipf("git not available: %v", err)
+	}
+
+	profile := &model.Profile{Name: "work", GitName: "Jane Doe", GitEmail: "jane@company.com"}
+	if err := ApplyIdentity(ctx, dir, profile); err != nil {
+		t.Fatalf("ApplyIdentity() error = %v", err)
+	}
+
+	name, email, err := RepoIdentity(ctx, dir)
+	if err != nil {
+		t.Fatalf("RepoIdentity() error = %v", err)
+	}
+
+	if name != profile.GitName || email != profile.GitEmail {
+		t.Errorf("RepoIdentity() = %q, %q, want %q, %q", name, email, profile.GitName, profile.GitEmail)
+	}
+
+	if err := ApplyIdentity(ctx, dir, &model.Profile{Name: "empty"}); err == nil {
+		t.Error("ApplyIdentity() with no identity should fail")
+	}
+}
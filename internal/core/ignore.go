@@ -0,0 +1,211 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// IgnoredPath returns the rule of rules matching the directory at p, or ""
+// when none does. A rule without a slash is matched against every element
+// of the path, e.g. "third_party" or "*.bak"; one with a slash against the
+// path and its ancestors, e.g. "~/archive" or "/src/*/vendor".
+func IgnoredPath(rules model.IgnoreConfig, p string) string {
+	p = filepath.Clean(p)
+
+	for _, rule := range rules.Paths {
+		if !strings.Contains(rule, "/") {
+			for _, elem := range strings.Split(p, string(os.PathSeparator)) {
+				if ok, _ := filepath.Match(rule, elem); ok {
+					return rule
+				}
+			}
+
+			continue
+		}
+
+		pattern := filepath.Clean(filepath.FromSlash(expandTilde(rule)))
+
+		for dir := p; ; dir = filepath.Dir(dir) {
+			if ok, _ := filepath.Match(pattern, dir); ok {
+				return rule
+			}
+
+			if dir == filepath.Dir(dir) {
+				break
+			}
+		}
+	}
+
+	return ""
+}
+
+// IgnoredURL returns the rule of rules matching the remote rawURL, or ""
+// when none does. URLs and rules are compared as host/owner/name, without
+// scheme, user or .git suffix and ignoring case; a rule also matches every
+// repository below it, e.g. "github.com/vendor-org" or "*/*/archive-*".
+func IgnoredURL(rules model.IgnoreConfig, rawURL string) string {
+	key := ignoreURLKey(rawURL)
+	if key == "" {
+		return ""
+	}
+
+	for _, rule := range rules.URLs {
+		pattern := ignoreURLKey(rule)
+
+		for prefix := key; ; prefix = path.Dir(prefix) {
+			if ok, _ := path.Match(pattern, prefix); ok {
+				return rule
+			}
+
+			if !strings.Contains(prefix, "/") {
+				break
+			}
+		}
+	}
+
+	return ""
+}
+
+// IgnoredRepo returns the rule of rules matching the path or the URL of
+// repo, or "" when none does
+func IgnoredRepo(rules model.IgnoreConfig, repo model.Repository) string {
+	if rule := IgnoredPath(rules, repo.Path); rule != "" {
+		return rule
+	}
+
+	return IgnoredURL(rules, repo.URL)
+}
+
+// FilterIgnoredRepos returns repos without those matching rules
+func FilterIgnoredRepos(repos []model.Repository, rules model.IgnoreConfig) []model.Repository {
+	if rules.IsZero() {
+		return repos
+	}
+
+	kept := make([]model.Repository, 0, len(repos))
+
+	for _, repo := range repos {
+		if IgnoredRepo(rules, repo) == "" {
+			kept = append(kept, repo)
+		}
+	}
+
+	return kept
+}
+
+// ignoreURLKey reduces a remote URL, or a rule written like one, to
+// host/path in lower case
+func ignoreURLKey(rawURL string) string {
+	key := strings.TrimSpace(rawURL)
+
+	if strings.Contains(key, "://") || strings.HasPrefix(key, "git@") {
+		if u, err := git.ParseURL(key); err == nil {
+			key = u.Host + u.Path
+		}
+	}
+
+	key = strings.Trim(strings.ToLower(key), "/")
+
+	return strings.TrimSuffix(key, ".git")
+}
+
+// ValidateIgnoreRule checks that rule is a valid glob pattern
+func ValidateIgnoreRule(rule string) error {
+	if strings.TrimSpace(rule) == "" {
+		return fmt.Errorf("empty ignore rule")
+	}
+
+	if _, err := path.Match(rule, ""); err != nil {
+		return fmt.Errorf("invalid ignore rule %q: %w", rule, err)
+	}
+
+	return nil
+}
+
+// LoadIgnoreRules returns the saved ignore list
+func LoadIgnoreRules() (model.IgnoreConfig, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return model.IgnoreConfig{}, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return model.IgnoreConfig{}, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return cfg.Ignore, nil
+}
+
+// AddIgnoreRules adds rules to the path rules, or to the URL rules when
+// urls is set. Rules already present are kept once.
+func AddIgnoreRules(urls bool, rules []string) error {
+	for _, rule := range rules {
+		if err := ValidateIgnoreRule(rule); err != nil {
+			return err
+		}
+	}
+
+	return updateIgnoreRules(func(cfg *model.IgnoreConfig) {
+		list := &cfg.Paths
+		if urls {
+			list = &cfg.URLs
+		}
+
+		for _, rule := range rules {
+			if !slices.Contains(*list, rule) {
+				*list = append(*list, rule)
+			}
+		}
+	})
+}
+
+// RemoveIgnoreRules removes rules from the path and URL rules, returning
+// those that were not found
+func RemoveIgnoreRules(rules []string) ([]string, error) {
+	var missing []string
+
+	err := updateIgnoreRules(func(cfg *model.IgnoreConfig) {
+		for _, rule := range rules {
+			paths := slices.DeleteFunc(cfg.Paths, func(r string) bool { return r == rule })
+			urls := slices.DeleteFunc(cfg.URLs, func(r string) bool { return r == rule })
+
+			if len(paths) == len(cfg.Paths) && len(urls) == len(cfg.URLs) {
+				missing = append(missing, rule)
+			}
+
+			cfg.Paths, cfg.URLs = paths, urls
+		}
+	})
+
+	return missing, err
+}
+
+// updateIgnoreRules applies change to the saved ignore list
+func updateIgnoreRules(change func(cfg *model.IgnoreConfig)) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	change(&cfg.Ignore)
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
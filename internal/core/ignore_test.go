@@ -0,0 +1,90 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestIgnoredPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+
+	rules := model.IgnoreConfig{Paths: []string{"third_party", "*.bak", "~/archive", "/src/*/vendor"}}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: filepath.Join(home, "code", "third_party", "zlib"), want: "third_party"},
+		{path: filepath.Join(home, "code", "api.bak"), want: "*.bak"},
+		{path: filepath.Join(home, "archive"), want: "~/archive"},
+		{path: filepath.Join(home, "archive", "2019", "old"), want: "~/archive"},
+		{path: filepath.FromSlash("/src/acme/vendor/lib"), want: "/src/*/vendor"},
+		{path: filepath.Join(home, "archives"), want: ""},
+		{path: filepath.Join(home, "code", "api"), want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := IgnoredPath(rules, tt.path); got != tt.want {
+			t.Errorf("IgnoredPath(%s) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIgnoredURL(t *testing.T) {
+	rules := model.IgnoreConfig{URLs: []string{"github.com/vendor-org", "*/*/archive-*", "https://gitlab.com/me/Scratch.git"}}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.com/vendor-org/lib.git", want: "github.com/vendor-org"},
+		{url: "git@github.com:Vendor-Org/lib.git", want: "github.com/vendor-org"},
+		{url: "https://github.com/me/archive-2019", want: "*/*/archive-*"},
+		{url: "ssh://git@gitlab.com/me/scratch", want: "https://gitlab.com/me/Scratch.git"},
+		{url: "https://github.com/vendor-organization/lib", want: ""},
+		{url: "https://github.com/me/api", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := IgnoredURL(rules, tt.url); got != tt.want {
+			t.Errorf("IgnoredURL(%s) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestFilterIgnoredRepos(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/me/api", Path: filepath.FromSlash("/src/api")},
+		{URL: "https://github.com/vendor-org/lib", Path: filepath.FromSlash("/src/lib")},
+		{URL: "https://github.com/me/zlib", Path: filepath.FromSlash("/src/third_party/zlib")},
+	}
+
+	rules := model.IgnoreConfig{Paths: []string{"third_party"}, URLs: []string{"github.com/vendor-org"}}
+
+	kept := FilterIgnoredRepos(repos, rules)
+	if len(kept) != 1 || kept[0].URL != "https://github.com/me/api" {
+		t.Errorf("FilterIgnoredRepos() = %v, want only the api repository", kept)
+	}
+
+	if got := FilterIgnoredRepos(repos, model.IgnoreConfig{}); len(got) != len(repos) {
+		t.Errorf("FilterIgnoredRepos() with no rules kept %d of %d", len(got), len(repos))
+	}
+}
+
+func TestValidateIgnoreRule(t *testing.T) {
+	for _, rule := range []string{"", "  ", "[a-"} {
+		if err := ValidateIgnoreRule(rule); err == nil {
+			t.Errorf("ValidateIgnoreRule(%q) = nil, want an error", rule)
+		}
+	}
+
+	if err := ValidateIgnoreRule("~/archive/*"); err != nil {
+		t.Errorf("ValidateIgnoreRule() = %v", err)
+	}
+}
@@ -0,0 +1,356 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// ErrJobCancelled is the cause of the context of a job cancelled with
+// 'clonr jobs cancel'
+var ErrJobCancelled = errors.New("job cancelled")
+
+var (
+	// jobPollInterval is how often a running job looks for a cancellation
+	// request and refreshes its heartbeat
+	jobPollInterval = 2 * time.Second
+
+	// jobSaveInterval is how often progress is written at most
+	jobSaveInterval = time.Second
+)
+
+// JobStaleAfter is how long a running job may go without a heartbeat before
+// its process is assumed gone
+const JobStaleAfter = time.Minute
+
+// JobStore is the part of the store that tracks jobs
+type JobStore interface {
+	SaveJob(job *model.Job) error
+	GetJob(id string) (*model.Job, error)
+	ListJobs(limit int) ([]model.Job, error)
+	CancelJob(id string) error
+}
+
+// TrackedJob records the progress of a running operation in the store.
+// Tracking is best effort: a nil TrackedJob, returned when the server
+// cannot be reached, ignores every call.
+type TrackedJob struct {
+	db     JobStore
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+
+	mu       sync.Mutex
+	job      model.Job
+	lastSave time.Time
+
+	// saveMu orders the saves, so an older snapshot never overwrites a newer
+	saveMu sync.Mutex
+
+	stop   chan struct{}
+	polled sync.WaitGroup
+	finish sync.Once
+}
+
+// TrackJob records a new job of kind with total items and returns it with
+// a context cancelled, with ErrJobCancelled as cause, when the job is
+// cancelled from another process. The operation must call Finish.
+func TrackJob(ctx context.Context, kind, description string, total int) (*TrackedJob, context.Context) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, ctx
+	}
+
+	return trackJob(ctx, client, kind, description, total)
+}
+
+func trackJob(ctx context.Context, db JobStore, kind, description string, total int) (*TrackedJob, context.Context) {
+	id, err := newJobID()
+	if err != nil {
+		return nil, ctx
+	}
+
+	now := time.Now()
+
+	t := &TrackedJob{
+		db: db,
+		job: model.Job{
+			ID:          id,
+			Kind:        kind,
+			Description: description,
+			State:       model.JobRunning,
+			Total:       total,
+			StartedAt:   now,
+			UpdatedAt:   now,
+		},
+		lastSave: now,
+		stop:     make(chan struct{}),
+	}
+
+	if err := db.SaveJob(&t.job); err != nil {
+		return nil, ctx
+	}
+
+	t.ctx, t.cancel = context.WithCancelCause(ctx)
+
+	t.polled.Go(t.poll)
+
+	return t, t.ctx
+}
+
+// newJobID returns a random job ID
+func newJobID() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// poll refreshes the heartbeat of the job and cancels it once a
+// cancellation is requested
+func (t *TrackedJob) poll() {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.stop:
+			return
+		case <-ticker.C:
+		}
+
+		t.save()
+
+		if job, err := t.db.GetJob(t.job.ID); err == nil && job != nil && job.CancelRequested {
+			t.Logf("cancellation requested")
+			t.cancel(ErrJobCancelled)
+		}
+	}
+}
+
+// ID returns the job ID, or "" when the job is not tracked
+func (t *TrackedJob) ID() string {
+	if t == nil {
+		return ""
+	}
+
+	return t.job.ID
+}
+
+// Step records that an item was processed, failing with err when not nil
+func (t *TrackedJob) Step(item string, err error) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+
+	t.job.Done++
+
+	if err != nil {
+		t.job.Failed++
+		t.appendLog(fmt.Sprintf("✗ %s: %v", item, err))
+	} else {
+		t.appendLog("✓ " + item)
+	}
+
+	due := time.Since(t.lastSave) >= jobSaveInterval
+
+	t.mu.Unlock()
+
+	if due {
+		t.save()
+	}
+}
+
+// Logf adds a line to the log of the job
+func (t *TrackedJob) Logf(format string, args ...any) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	t.appendLog(fmt.Sprintf(format, args...))
+	t.mu.Unlock()
+}
+
+// appendLog adds a timestamped line, keeping the newest MaxJobLogLines.
+// t.mu must be held.
+func (t *TrackedJob) appendLog(line string) {
+	t.job.Log = append(t.job.Log, time.Now().Format("15:04:05")+" "+line)
+
+	if extra := len(t.job.Log) - model.MaxJobLogLines; extra > 0 {
+		t.job.Log = slices.Delete(t.job.Log, 0, extra)
+	}
+}
+
+// Finish records the outcome of the job: err nil succeeds, an ended
+// context cancels and any other error fails it. Calls after the first are
+// ignored.
+func (t *TrackedJob) Finish(err error) {
+	if t == nil {
+		return
+	}
+
+	t.finish.Do(func() {
+		close(t.stop)
+		t.polled.Wait()
+
+		t.mu.Lock()
+
+		now := time.Now()
+		t.job.FinishedAt = &now
+
+		switch {
+		case err == nil:
+			t.job.State = model.JobSucceeded
+		case t.ctx.Err() != nil:
+			t.job.State = model.JobCancelled
+
+			if cause := context.Cause(t.ctx); !errors.Is(cause, ErrJobCancelled) {
+				t.job.Error = cause.Error()
+			}
+		default:
+			t.job.State = model.JobFailed
+			t.job.Error = err.Error()
+		}
+
+		t.mu.Unlock()
+
+		t.save()
+		t.cancel(nil)
+	})
+}
+
+// save writes the job with a fresh heartbeat; failures are ignored, the
+// operation itself must not fail because its progress cannot be recorded
+func (t *TrackedJob) save() {
+	t.saveMu.Lock()
+	defer t.saveMu.Unlock()
+
+	t.mu.Lock()
+
+	t.job.UpdatedAt = time.Now()
+	t.lastSave = t.job.UpdatedAt
+
+	job := t.job
+	job.Log = slices.Clone(t.job.Log)
+
+	t.mu.Unlock()
+
+	_ = t.db.SaveJob(&job)
+}
+
+// JobStale reports whether a job still marked running stopped sending
+// heartbeats, because its process was killed
+func JobStale(job *model.Job, now time.Time) bool {
+	return job.State == model.JobRunning && now.Sub(job.UpdatedAt) > JobStaleAfter
+}
+
+// ListJobs returns the newest jobs first, at most limit of them
+func ListJobs(limit int) ([]model.Job, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.ListJobs(limit)
+}
+
+// FindJob returns the job with an ID or a unique ID prefix
+func FindJob(query string) (*model.Job, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return findJob(client, query)
+}
+
+func findJob(db JobStore, query string) (*model.Job, error) {
+	if query == "" {
+		return nil, fmt.Errorf("job ID is required")
+	}
+
+	job, err := db.GetJob(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if job != nil {
+		return job, nil
+	}
+
+	jobs, err := db.ListJobs(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []model.Job
+
+	for _, j := range jobs {
+		if strings.HasPrefix(j.ID, query) {
+			matches = append(matches, j)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("job not found: %s", query)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("job ID %q is ambiguous: %d jobs match", query, len(matches))
+	}
+}
+
+// CancelJob asks the job with an ID or a unique ID prefix to stop and
+// returns it. A stale job, whose process is gone, is marked cancelled
+// right away.
+func CancelJob(query string) (*model.Job, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return cancelJob(client, query, time.Now())
+}
+
+func cancelJob(db JobStore, query string, now time.Time) (*model.Job, error) {
+	job, err := findJob(db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Finished() {
+		return nil, fmt.Errorf("job %s already %s", job.ID, job.State)
+	}
+
+	if err := db.CancelJob(job.ID); err != nil {
+		return nil, err
+	}
+
+	job.CancelRequested = true
+
+	if JobStale(job, now) {
+		job.State = model.JobCancelled
+		job.Error = "stopped reporting progress"
+		job.FinishedAt = &now
+
+		if err := db.SaveJob(job); err != nil {
+			return nil, err
+		}
+	}
+
+	return job, nil
+}
@@ -0,0 +1,228 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// memJobStore keeps jobs in memory, keeping cancellation requests on save
+// like the real stores
+type memJobStore struct {
+	mu   sync.Mutex
+	jobs map[string]model.Job
+}
+
+func newMemJobStore() *memJobStore {
+	return &memJobStore{jobs: make(map[string]model.Job)}
+}
+
+func (m *memJobStore) SaveJob(job *model.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	saved := *job
+	saved.CancelRequested = saved.CancelRequested || m.jobs[job.ID].CancelRequested
+	m.jobs[job.ID] = saved
+
+	return nil
+}
+
+func (m *memJobStore) GetJob(id string) (*model.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &job, nil
+}
+
+func (m *memJobStore) ListJobs(_ int) ([]model.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	jobs := make([]model.Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+func (m *memJobStore) CancelJob(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return errors.New("job not found")
+	}
+
+	job.CancelRequested = true
+	m.jobs[id] = job
+
+	return nil
+}
+
+func TestTrackJob(t *testing.T) {
+	db := newMemJobStore()
+
+	job, ctx := trackJob(context.Background(), db, "update", "all repositories", 2)
+	if job == nil || ctx.Err() != nil {
+		t.Fatalf("trackJob() = %v, ctx error %v", job, ctx.Err())
+	}
+
+	job.Step("api", nil)
+	job.Step("web", errors.New("merge conflict"))
+	job.Finish(nil)
+
+	got, _ := db.GetJob(job.ID())
+	if got == nil || got.State != model.JobSucceeded || got.Done != 2 || got.Failed != 1 || got.FinishedAt == nil {
+		t.Fatalf("finished job = %+v", got)
+	}
+
+	if len(got.Log) != 2 || !strings.HasSuffix(got.Log[1], "✗ web: merge conflict") {
+		t.Errorf("job log = %q", got.Log)
+	}
+
+	// A finished job's context is released
+	if ctx.Err() == nil {
+		t.Error("job context not cancelled after Finish()")
+	}
+}
+
+func TestTrackJobFailed(t *testing.T) {
+	db := newMemJobStore()
+
+	job, _ := trackJob(context.Background(), db, "backup", "", 1)
+	job.Finish(errors.New("bucket not found"))
+
+	got, _ := db.GetJob(job.ID())
+	if got.State != model.JobFailed || got.Error != "bucket not found" {
+		t.Errorf("failed job = %+v", got)
+	}
+}
+
+func TestTrackJobCancel(t *testing.T) {
+	defer func(interval time.Duration) { jobPollInterval = interval }(jobPollInterval)
+
+	jobPollInterval = 10 * time.Millisecond
+
+	db := newMemJobStore()
+
+	job, ctx := trackJob(context.Background(), db, "mirror", "acme", 10)
+
+	if _, err := cancelJob(db, job.ID()[:4], time.Now()); err != nil {
+		t.Fatalf("cancelJob() error = %v", err)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("job context not cancelled after cancelJob()")
+	}
+
+	if !errors.Is(context.Cause(ctx), ErrJobCancelled) {
+		t.Errorf("context cause = %v, want %v", context.Cause(ctx), ErrJobCancelled)
+	}
+
+	job.Finish(context.Cause(ctx))
+
+	got, _ := db.GetJob(job.ID())
+	if got.State != model.JobCancelled || got.Error != "" {
+		t.Errorf("cancelled job = %+v", got)
+	}
+
+	if _, err := cancelJob(db, job.ID(), time.Now()); err == nil {
+		t.Error("cancelJob() of a finished job succeeded")
+	}
+}
+
+func TestCancelStaleJob(t *testing.T) {
+	db := newMemJobStore()
+	started := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	_ = db.SaveJob(&model.Job{ID: "abc123", Kind: "update", State: model.JobRunning, StartedAt: started, UpdatedAt: started})
+
+	job, err := cancelJob(db, "abc", started.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("cancelJob() error = %v", err)
+	}
+
+	got, _ := db.GetJob("abc123")
+	if !job.Finished() || got.State != model.JobCancelled || got.FinishedAt == nil {
+		t.Errorf("stale job after cancelJob() = %+v", got)
+	}
+}
+
+func TestFindJob(t *testing.T) {
+	db := newMemJobStore()
+
+	for _, id := range []string{"3fa2c1", "3fb9d0", "77e0aa"} {
+		_ = db.SaveJob(&model.Job{ID: id, State: model.JobSucceeded})
+	}
+
+	tests := []struct {
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{"3fa2c1", "3fa2c1", false},
+		{"77", "77e0aa", false},
+		{"3f", "", true},
+		{"ff", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		job, err := findJob(db, tt.query)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("findJob(%q) = %s, want error", tt.query, job.ID)
+			}
+
+			continue
+		}
+
+		if err != nil || job.ID != tt.want {
+			t.Errorf("findJob(%q) = %v, %v, want %s", tt.query, job, err, tt.want)
+		}
+	}
+}
+
+func TestTrackedJobLogCapped(t *testing.T) {
+	db := newMemJobStore()
+
+	job, _ := trackJob(context.Background(), db, "update", "", model.MaxJobLogLines+10)
+	for i := range model.MaxJobLogLines + 10 {
+		job.Logf("line %d", i)
+	}
+
+	job.Finish(nil)
+
+	got, _ := db.GetJob(job.ID())
+	if len(got.Log) != model.MaxJobLogLines || !strings.HasSuffix(got.Log[len(got.Log)-1], "line 509") {
+		t.Errorf("job log has %d lines ending %q", len(got.Log), got.Log[len(got.Log)-1])
+	}
+}
+
+func TestTrackedJobNil(t *testing.T) {
+	var job *TrackedJob
+
+	// An untracked job ignores every call
+	job.Step("api", nil)
+	job.Logf("ignored")
+	job.Finish(nil)
+
+	if job.ID() != "" {
+		t.Errorf("ID() = %q, want empty", job.ID())
+	}
+}
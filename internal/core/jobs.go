@@ -0,0 +1,191 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// JobKind is the resource a job uses, each with its own concurrency limit
+type JobKind string
+
+const (
+	// JobGit is a git clone, pull or fetch: disk, file handles and bandwidth
+	JobGit JobKind = "git"
+
+	// JobAPI is a request to a hosting provider API, subject to rate limits
+	JobAPI JobKind = "api"
+)
+
+const (
+	// DefaultMaxGitJobs is how many git operations run at once by default
+	DefaultMaxGitJobs = 4
+
+	// DefaultMaxAPIJobs is how many API calls run at once by default
+	DefaultMaxAPIJobs = 8
+
+	// MaxJobLimit caps a configured limit, so a typo cannot open thousands
+	// of connections
+	MaxJobLimit = 64
+)
+
+// JobQueue bounds how many jobs of each kind run at once. Every bulk
+// operation in a process shares the global queue returned by Jobs, so
+// running update and mirror together does not double the load.
+type JobQueue struct {
+	slots map[JobKind]chan struct{}
+}
+
+// NewJobQueue returns a queue with the given limits; zero uses the default
+// limit of a kind.
+func NewJobQueue(limits model.ConcurrencyConfig) *JobQueue {
+	limits = EffectiveConcurrency(limits)
+
+	return &JobQueue{
+		slots: map[JobKind]chan struct{}{
+			JobGit: make(chan struct{}, limits.GitOps),
+			JobAPI: make(chan struct{}, limits.APICalls),
+		},
+	}
+}
+
+// Limit returns how many jobs of kind run at once
+func (q *JobQueue) Limit(kind JobKind) int {
+	return cap(q.slots[kind])
+}
+
+// Do runs fn once a slot of kind is free. It returns the cause of ctx
+// without running fn when ctx ends while waiting.
+func (q *JobQueue) Do(ctx context.Context, kind JobKind, fn func() error) error {
+	release, err := q.acquire(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	defer release()
+
+	return fn()
+}
+
+// acquire waits for a free slot of kind and returns the function freeing it
+func (q *JobQueue) acquire(ctx context.Context, kind JobKind) (func(), error) {
+	slots, ok := q.slots[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown job kind %q", kind)
+	}
+
+	// A free slot must not win over a context that already ended
+	if ctx.Err() != nil {
+		return nil, context.Cause(ctx)
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, context.Cause(ctx)
+	}
+}
+
+// RunJobs calls fn for every item in order, running as many at once as the
+// queue allows for kind, and waits for them. Items not started when ctx
+// ends are skipped; the number of items fn was called for is returned.
+func RunJobs[T any](ctx context.Context, q *JobQueue, kind JobKind, items []T, fn func(T)) (int, error) {
+	var wg sync.WaitGroup
+
+	started := 0
+
+	for _, item := range items {
+		release, err := q.acquire(ctx, kind)
+		if err != nil {
+			wg.Wait()
+			return started, err
+		}
+
+		started++
+
+		wg.Go(func() {
+			defer release()
+
+			fn(item)
+		})
+	}
+
+	wg.Wait()
+
+	return started, nil
+}
+
+var (
+	jobsOnce sync.Once
+	jobs     *JobQueue
+)
+
+// Jobs returns the job queue of this process, sized from the concurrency
+// limits in the configuration. The defaults are used when the server cannot
+// be reached.
+func Jobs() *JobQueue {
+	jobsOnce.Do(func() {
+		var limits model.ConcurrencyConfig
+
+		if client, err := grpc.GetClient(); err == nil {
+			if cfg, err := client.GetConfig(); err == nil {
+				limits = cfg.Concurrency
+			}
+		}
+
+		jobs = NewJobQueue(limits)
+	})
+
+	return jobs
+}
+
+// EffectiveConcurrency fills unset limits with the defaults and caps them
+// at MaxJobLimit
+func EffectiveConcurrency(limits model.ConcurrencyConfig) model.ConcurrencyConfig {
+	clamp := func(n, def int) int {
+		if n <= 0 {
+			return def
+		}
+
+		return min(n, MaxJobLimit)
+	}
+
+	return model.ConcurrencyConfig{
+		GitOps:   clamp(limits.GitOps, DefaultMaxGitJobs),
+		APICalls: clamp(limits.APICalls, DefaultMaxAPIJobs),
+	}
+}
+
+// SaveConcurrencyConfig stores the concurrency limits; zero restores the
+// default of a kind
+func SaveConcurrencyConfig(limits model.ConcurrencyConfig) error {
+	if limits.GitOps < 0 || limits.APICalls < 0 {
+		return fmt.Errorf("limits cannot be negative")
+	}
+
+	if limits.GitOps > MaxJobLimit || limits.APICalls > MaxJobLimit {
+		return fmt.Errorf("limits cannot exceed %d", MaxJobLimit)
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	cfg.Concurrency = limits
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestEffectiveConcurrency(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits model.ConcurrencyConfig
+		want   model.ConcurrencyConfig
+	}{
+		{"defaults", model.ConcurrencyConfig{}, model.ConcurrencyConfig{GitOps: DefaultMaxGitJobs, APICalls: DefaultMaxAPIJobs}},
+		{"configured", model.ConcurrencyConfig{GitOps: 2, APICalls: 16}, model.ConcurrencyConfig{GitOps: 2, APICalls: 16}},
+		{"capped", model.ConcurrencyConfig{GitOps: 1000, APICalls: -1}, model.ConcurrencyConfig{GitOps: MaxJobLimit, APICalls: DefaultMaxAPIJobs}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EffectiveConcurrency(tt.limits); got != tt.want {
+				t.Errorf("EffectiveConcurrency() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunJobsRespectsLimit(t *testing.T) {
+	q := NewJobQueue(model.ConcurrencyConfig{GitOps: 2})
+
+	var running, peak, done atomic.Int32
+
+	items := make([]int, 10)
+
+	started, err := RunJobs(context.Background(), q, JobGit, items, func(int) {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		done.Add(1)
+	})
+	if err != nil || started != len(items) || int(done.Load()) != len(items) {
+		t.Fatalf("RunJobs() = %d, %v; %d done, want all %d", started, err, done.Load(), len(items))
+	}
+
+	if peak.Load() > 2 {
+		t.Errorf("%d jobs ran at once, want at most 2", peak.Load())
+	}
+}
+
+func TestRunJobsStopsWhenCancelled(t *testing.T) {
+	q := NewJobQueue(model.ConcurrencyConfig{GitOps: 1})
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	started, err := RunJobs(ctx, q, JobGit, []string{"api", "web", "cli"}, func(string) {
+		cancel(errTestStopped)
+	})
+	if !errors.Is(err, errTestStopped) || started != 1 {
+		t.Errorf("RunJobs() = %d, %v, want 1 job started and %v", started, err, errTestStopped)
+	}
+
+	// The queue is free again for later jobs
+	if err := q.Do(context.Background(), JobGit, func() error { return nil }); err != nil {
+		t.Errorf("Do() error = %v", err)
+	}
+}
@@ -0,0 +1,466 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+// JS package managers supported by the linking helper
+const (
+	JSManagerNPM  = "npm"
+	JSManagerYarn = "yarn"
+	JSManagerPNPM = "pnpm"
+)
+
+const jsLinkStateFile = "jslinks.json"
+
+// JSPackage describes a JavaScript package found in a tracked repository
+type JSPackage struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version,omitempty"`
+	Path         string   `json:"path"`
+	RepoURL      string   `json:"repo_url"`
+	Manager      string   `json:"manager"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// JSLink is a single local link from a consumer package to a provider package
+type JSLink struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// JSLinkPlan describes the links to set up for one consumer package
+type JSLinkPlan struct {
+	Consumer JSPackage `json:"consumer"`
+	Links    []JSLink  `json:"links"`
+}
+
+// jsLinkState records what was changed in a consumer so it can be restored
+type jsLinkState struct {
+	Manager     string   `json:"manager"`
+	Links       []JSLink `json:"links"`
+	PackageJSON []byte   `json:"package_json,omitempty"`
+}
+
+// JSLinkOptions configures linking and unlinking of JS packages
+type JSLinkOptions struct {
+	Workspace string // Only consider repositories in this workspace
+	DryRun    bool   // Only compute the plan, do not change anything
+}
+
+type packageJSON struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Workspaces           json.RawMessage   `json:"workspaces"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// FindJSPackages detects JS packages across tracked repositories,
+// including packages declared through npm/yarn workspaces.
+func FindJSPackages(workspace string) ([]JSPackage, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repos, err := client.GetRepos(workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	var packages []JSPackage
+
+	for _, repo := range repos {
+		manager := detectJSManager(repo.Path)
+
+		for _, dir := range jsPackageDirs(repo.Path) {
+			pkg, err := readPackageJSON(dir)
+			if err != nil || pkg.Name == "" {
+				continue
+			}
+
+			packages = append(packages, JSPackage{
+				Name:         pkg.Name,
+				Version:      pkg.Version,
+				Path:         dir,
+				RepoURL:      repo.URL,
+				Manager:      manager,
+				Dependencies: pkg.dependencyNames(),
+			})
+		}
+	}
+
+	return packages, nil
+}
+
+// PlanJSLinks computes which packages depend on other tracked packages
+func PlanJSLinks(packages []JSPackage) []JSLinkPlan {
+	byName := make(map[string]JSPackage, len(packages))
+	for _, pkg := range packages {
+		byName[pkg.Name] = pkg
+	}
+
+	var plans []JSLinkPlan
+
+	for _, consumer := range packages {
+		var links []JSLink
+
+		for _, dep := range consumer.Dependencies {
+			provider, ok := byName[dep]
+			if !ok || provider.Path == consumer.Path {
+				continue
+			}
+
+			// Packages from the same repository are handled by the
+			// package manager's own workspace support.
+			if provider.RepoURL == consumer.RepoURL {
+				continue
+			}
+
+			links = append(links, JSLink{Name: provider.Name, Path: provider.Path})
+		}
+
+		if len(links) > 0 {
+			plans = append(plans, JSLinkPlan{Consumer: consumer, Links: links})
+		}
+	}
+
+	return plans
+}
+
+// LinkJSPackages sets up local links between JS packages across tracked repositories
+func LinkJSPackages(opts JSLinkOptions) ([]JSLinkPlan, error) {
+	packages, err := FindJSPackages(opts.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := PlanJSLinks(packages)
+	if opts.DryRun {
+		return plans, nil
+	}
+
+	state, err := loadJSLinkState()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, plan := range plans {
+		if _, linked := state[plan.Consumer.Path]; linked {
+			if err := unlinkJSPackage(plan.Consumer.Path, state[plan.Consumer.Path]); err != nil {
+				return nil, fmt.Errorf("failed to reset links in %s: %w", plan.Consumer.Path, err)
+			}
+		}
+
+		entry, err := linkJSPackage(plan)
+		if err != nil {
+			return nil, fmt.Errorf("failed to link %s: %w", plan.Consumer.Name, err)
+		}
+
+		state[plan.Consumer.Path] = entry
+
+		if err := saveJSLinkState(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+// UnlinkJSPackages restores all consumers previously linked by LinkJSPackages.
+// When a workspace is given, only consumers in that workspace are restored.
+func UnlinkJSPackages(opts JSLinkOptions) ([]string, error) {
+	state, err := loadJSLinkState()
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+
+	if opts.Workspace != "" {
+		packages, err := FindJSPackages(opts.Workspace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pkg := range packages {
+			if _, ok := state[pkg.Path]; ok {
+				paths = append(paths, pkg.Path)
+			}
+		}
+	} else {
+		for path := range state {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	if opts.DryRun {
+		return paths, nil
+	}
+
+	for _, path := range paths {
+		if err := unlinkJSPackage(path, state[path]); err != nil {
+			return nil, fmt.Errorf("failed to unlink %s: %w", path, err)
+		}
+
+		delete(state, path)
+
+		if err := saveJSLinkState(state); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+func linkJSPackage(plan JSLinkPlan) (jsLinkState, error) {
+	dir := plan.Consumer.Path
+	entry := jsLinkState{Manager: plan.Consumer.Manager, Links: plan.Links}
+
+	switch plan.Consumer.Manager {
+	case JSManagerPNPM:
+		original, err := os.ReadFile(filepath.Join(dir, "package.json"))
+		if err != nil {
+			return entry, fmt.Errorf("failed to read package.json: %w", err)
+		}
+
+		entry.PackageJSON = original
+
+		if err := writePNPMOverrides(dir, original, plan.Links); err != nil {
+			return entry, err
+		}
+
+		if err := runJSCommand(dir, "pnpm", "install"); err != nil {
+			_ = os.WriteFile(filepath.Join(dir, "package.json"), original, 0644)
+			return entry, err
+		}
+
+	case JSManagerYarn:
+		for _, link := range plan.Links {
+			if err := runJSCommand(link.Path, "yarn", "link"); err != nil {
+				return entry, err
+			}
+
+			if err := runJSCommand(dir, "yarn", "link", link.Name); err != nil {
+				return entry, err
+			}
+		}
+
+	default:
+		// npm link replaces previous links, so all paths are linked at once
+		args := []string{"link"}
+		for _, link := range plan.Links {
+			args = append(args, link.Path)
+		}
+
+		if err := runJSCommand(dir, "npm", args...); err != nil {
+			return entry, err
+		}
+	}
+
+	return entry, nil
+}
+
+func unlinkJSPackage(dir string, entry jsLinkState) error {
+	switch entry.Manager {
+	case JSManagerPNPM:
+		if len(entry.PackageJSON) > 0 {
+			if err := os.WriteFile(filepath.Join(dir, "package.json"), entry.PackageJSON, 0644); err != nil {
+				return fmt.Errorf("failed to restore package.json: %w", err)
+			}
+		}
+
+		return runJSCommand(dir, "pnpm", "install")
+
+	case JSManagerYarn:
+		for _, link := range entry.Links {
+			if err := runJSCommand(dir, "yarn", "unlink", link.Name); err != nil {
+				return err
+			}
+		}
+
+		return runJSCommand(dir, "yarn", "install", "--force")
+
+	default:
+		args := []string{"unlink", "--no-save"}
+		for _, link := range entry.Links {
+			args = append(args, link.Name)
+		}
+
+		if err := runJSCommand(dir, "npm", args...); err != nil {
+			return err
+		}
+
+		return runJSCommand(dir, "npm", "install")
+	}
+}
+
+// writePNPMOverrides adds link: overrides to the pnpm section of package.json
+func writePNPMOverrides(dir string, original []byte, links []JSLink) error {
+	var doc map[string]any
+	if err := json.Unmarshal(original, &doc); err != nil {
+		return fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	pnpmSection, _ := doc["pnpm"].(map[string]any)
+	if pnpmSection == nil {
+		pnpmSection = map[string]any{}
+	}
+
+	overrides, _ := pnpmSection["overrides"].(map[string]any)
+	if overrides == nil {
+		overrides = map[string]any{}
+	}
+
+	for _, link := range links {
+		overrides[link.Name] = "link:" + filepath.ToSlash(link.Path)
+	}
+
+	pnpmSection["overrides"] = overrides
+	doc["pnpm"] = pnpmSection
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode package.json: %w", err)
+	}
+
+	return os.WriteFile(filepath.Join(dir, "package.json"), append(data, '\n'), 0644)
+}
+
+func runJSCommand(dir, name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s: %w\n%s", name, strings.Join(args, " "), err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// detectJSManager guesses the package manager from the lock file in a repository
+func detectJSManager(dir string) string {
+	switch {
+	case encoding.FileExists(filepath.Join(dir, "pnpm-lock.yaml")):
+		return JSManagerPNPM
+	case encoding.FileExists(filepath.Join(dir, "yarn.lock")):
+		return JSManagerYarn
+	default:
+		return JSManagerNPM
+	}
+}
+
+// jsPackageDirs returns the repository root plus any npm/yarn workspace directories
+func jsPackageDirs(repoPath string) []string {
+	root, err := readPackageJSON(repoPath)
+	if err != nil {
+		return nil
+	}
+
+	dirs := []string{repoPath}
+
+	for _, pattern := range root.workspacePatterns() {
+		matches, err := filepath.Glob(filepath.Join(repoPath, filepath.FromSlash(pattern)))
+		if err != nil {
+			continue
+		}
+
+		for _, match := range matches {
+			if encoding.FileExists(filepath.Join(match, "package.json")) {
+				dirs = append(dirs, match)
+			}
+		}
+	}
+
+	return dirs
+}
+
+func readPackageJSON(dir string) (*packageJSON, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var pkg packageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Join(dir, "package.json"), err)
+	}
+
+	return &pkg, nil
+}
+
+// workspacePatterns supports both the array and the {packages: [...]} forms
+func (p *packageJSON) workspacePatterns() []string {
+	if len(p.Workspaces) == 0 {
+		return nil
+	}
+
+	var patterns []string
+	if err := json.Unmarshal(p.Workspaces, &patterns); err == nil {
+		return patterns
+	}
+
+	var obj struct {
+		Packages []string `json:"packages"`
+	}
+
+	if err := json.Unmarshal(p.Workspaces, &obj); err == nil {
+		return obj.Packages
+	}
+
+	return nil
+}
+
+func (p *packageJSON) dependencyNames() []string {
+	seen := make(map[string]bool)
+
+	for _, deps := range []map[string]string{
+		p.Dependencies, p.DevDependencies, p.PeerDependencies, p.OptionalDependencies,
+	} {
+		for name := range deps {
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func loadJSLinkState() (map[string]jsLinkState, error) {
+	state, err := encoding.LoadJSON[map[string]jsLinkState](filepath.Join(params.AppdataDir, jsLinkStateFile))
+	if err != nil {
+		return nil, err
+	}
+
+	if state == nil || *state == nil {
+		return map[string]jsLinkState{}, nil
+	}
+
+	return *state, nil
+}
+
+func saveJSLinkState(state map[string]jsLinkState) error {
+	return encoding.SaveJSON(filepath.Join(params.AppdataDir, jsLinkStateFile), state)
+}
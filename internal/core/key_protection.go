@@ -0,0 +1,82 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/inovacc/clonr/internal/store"
+)
+
+// SetKeyProtection changes how the keystore root key is protected on a
+// machine without a TPM: derived from the machine (tpm.ProtectionMachine)
+// or from a passphrase with Argon2id (tpm.ProtectionPassphrase).
+//
+// For a passphrase, command optionally prints it on later runs, for example
+// from the system keyring; passphrase may then be empty to take it from the
+// command. Every stored secret is decrypted first and sealed again in a new
+// keystore; on failure the previous keystore is kept.
+func SetKeyProtection(mode, passphrase, command string) error {
+	if tpm.IsTPMAvailable() {
+		return fmt.Errorf("this machine has a TPM, which protects the keystore; a passphrase is only used without one")
+	}
+
+	var (
+		protection *tpm.KeyProtection
+		rootKey    []byte
+	)
+
+	switch mode {
+	case tpm.ProtectionMachine:
+		protection = &tpm.KeyProtection{Mode: tpm.ProtectionMachine}
+	case tpm.ProtectionPassphrase:
+		if passphrase == "" && command != "" {
+			var err error
+
+			if passphrase, err = tpm.RunPassphraseCommand(command); err != nil {
+				return err
+			}
+		}
+
+		var err error
+
+		protection, rootKey, err = tpm.NewPassphraseProtection(passphrase, command)
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid key protection %q: use %s or %s", mode, tpm.ProtectionMachine, tpm.ProtectionPassphrase)
+	}
+
+	owners, err := collectSealedSecrets(store.GetDB())
+	if err != nil {
+		return err
+	}
+
+	return tpm.ChangeKeyProtection(protection, rootKey, func() error {
+		for i, owner := range owners {
+			err := reseal(owner)
+			if err == nil {
+				err = owner.save()
+			}
+
+			if err != nil {
+				restoreSealed(owners[:i+1])
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// restoreSealed stores the original ciphertexts of owners again, so they
+// match the previous keystore once it is restored
+func restoreSealed(owners []*sealedOwner) {
+	for _, owner := range owners {
+		for _, s := range owner.secrets {
+			s.set(s.original)
+		}
+
+		_ = owner.save()
+	}
+}
@@ -0,0 +1,390 @@
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/store"
+)
+
+// ErrKeystoreUnavailable is returned by RotateEncryptionKey when the
+// keystore holding the sealed keys cannot be opened
+var ErrKeystoreUnavailable = errors.New("key rotation requires the keystore, which is not available")
+
+// Storage formats of a sealed secret reported by RotateEncryptionKey
+const (
+	SecretFormatKeystore = "keystore" // KS: envelope encryption with per-scope keys
+	SecretFormatLegacy   = "legacy"   // ENC: encryption with the TPM master key
+	SecretFormatOpen     = "open"     // OPEN: plain text, stored without a TPM
+)
+
+// KeyRotationItem is one secret re-encrypted by a key rotation
+type KeyRotationItem struct {
+	Kind   string `json:"kind"`   // profile, channel, docker, slack, slack_account, vault
+	Name   string `json:"name"`   // Owner of the secret, with the config key for channels
+	Scope  string `json:"scope"`  // Keystore scope the secret is sealed under
+	Format string `json:"format"` // Storage format before the rotation
+	Error  string `json:"error,omitempty"`
+}
+
+// KeyRotationReport is the outcome of RotateEncryptionKey
+type KeyRotationReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Scopes  []string          `json:"scopes"`
+	Items   []KeyRotationItem `json:"items"`
+	Rotated int               `json:"rotated"`
+	Failed  int               `json:"failed"`
+
+	// LegacyKeyDeleted is set when the TPM master key of the legacy format
+	// was deleted because no secret uses it any more
+	LegacyKeyDeleted bool `json:"legacy_key_deleted"`
+}
+
+// sealedSecret is a value encrypted with tpm.EncryptToken, decrypted in
+// memory until it is sealed again under the new keys
+type sealedSecret struct {
+	item      KeyRotationItem
+	host      string // Second half of the encryption context
+	plaintext string
+	original  []byte // Ciphertext before the rotation
+	set       func(ciphertext []byte)
+}
+
+// sealedOwner is a stored record holding one or more sealed secrets
+type sealedOwner struct {
+	secrets []*sealedSecret
+	save    func() error
+}
+
+// RotateEncryptionKey generates new sealed keys and re-encrypts every
+// secret clonr stores with them: profile tokens, notification channel
+// configs, docker profile tokens, Slack credentials and vault entries.
+//
+// All secrets are decrypted before any key changes, so a secret that cannot
+// be read aborts the rotation without touching anything. Each keystore
+// scope then gets a new master key, every secret is sealed again and the
+// TPM master key of the legacy format is deleted once nothing uses it.
+//
+// Saved standalone connections are out of scope: their keys are encrypted
+// with the local password given when connecting, not with a keystore key, so
+// they cannot be sealed again without that password.
+func RotateEncryptionKey(dryRun bool) (*KeyRotationReport, error) {
+	if !tpm.IsKeystoreAvailable() {
+		return nil, ErrKeystoreUnavailable
+	}
+
+	owners, err := collectSealedSecrets(store.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	report := &KeyRotationReport{DryRun: dryRun}
+
+	for _, owner := range owners {
+		for _, s := range owner.secrets {
+			if !slices.Contains(report.Scopes, s.item.Scope) {
+				report.Scopes = append(report.Scopes, s.item.Scope)
+			}
+		}
+	}
+
+	sort.Strings(report.Scopes)
+
+	if dryRun {
+		for _, owner := range owners {
+			for _, s := range owner.secrets {
+				report.Items = append(report.Items, s.item)
+			}
+		}
+
+		report.Rotated = len(report.Items)
+
+		return report, nil
+	}
+
+	existing, err := tpm.ListKeystoreProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keystore scopes: %w", err)
+	}
+
+	// Rotating a scope re-wraps its data keys, so secrets sealed under the
+	// old master key stay readable until they are sealed again below
+	for _, scope := range report.Scopes {
+		if !slices.Contains(existing, scope) {
+			continue
+		}
+
+		if err := tpm.RotateProfileKey(scope); err != nil {
+			return nil, fmt.Errorf("failed to rotate the key of %s: %w", scope, err)
+		}
+	}
+
+	legacyInUse := false
+
+	for _, owner := range owners {
+		ownerErr := reseal(owner)
+		if ownerErr == nil {
+			ownerErr = owner.save()
+		}
+
+		for _, s := range owner.secrets {
+			if ownerErr != nil {
+				s.item.Error = ownerErr.Error()
+				report.Failed++
+
+				if s.item.Format == SecretFormatLegacy {
+					legacyInUse = true
+				}
+			} else {
+				report.Rotated++
+			}
+
+			report.Items = append(report.Items, s.item)
+		}
+	}
+
+	if !legacyInUse && report.Failed == 0 && tpm.HasTPMKey() {
+		if err := tpm.ResetTPMKey(); err != nil {
+			return report, fmt.Errorf("secrets were re-encrypted but the old TPM master key could not be deleted: %w", err)
+		}
+
+		report.LegacyKeyDeleted = true
+	}
+
+	return report, nil
+}
+
+// reseal encrypts the secrets of owner again with the current keys
+func reseal(owner *sealedOwner) error {
+	for _, s := range owner.secrets {
+		ciphertext, err := tpm.EncryptToken(s.plaintext, s.item.Scope, s.host)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt %s: %w", s.item.Name, err)
+		}
+
+		if !tpm.IsDataKeystore(ciphertext) {
+			return fmt.Errorf("failed to encrypt %s with the keystore", s.item.Name)
+		}
+
+		s.set(ciphertext)
+	}
+
+	return nil
+}
+
+// collectSealedSecrets decrypts every sealed secret in the store. It fails
+// on the first secret that cannot be decrypted.
+func collectSealedSecrets(db store.Store) ([]*sealedOwner, error) {
+	var owners []*sealedOwner
+
+	add := func(owner *sealedOwner) {
+		if len(owner.secrets) > 0 {
+			owners = append(owners, owner)
+		}
+	}
+
+	profiles, err := db.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	for i := range profiles {
+		p := &profiles[i]
+		owner := &sealedOwner{save: func() error { return db.SaveProfile(p) }}
+
+		if len(p.EncryptedToken) > 0 {
+			s, err := unseal("profile", p.Name, p.Name, p.Host, p.EncryptedToken, func(b []byte) { p.EncryptedToken = b })
+			if err != nil {
+				return nil, err
+			}
+
+			owner.secrets = append(owner.secrets, s)
+		}
+
+		for j := range p.NotifyChannels {
+			secrets, err := unsealChannel(p.Name, &p.NotifyChannels[j])
+			if err != nil {
+				return nil, err
+			}
+
+			owner.secrets = append(owner.secrets, secrets...)
+		}
+
+		add(owner)
+	}
+
+	dockerProfiles, err := db.ListDockerProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list docker profiles: %w", err)
+	}
+
+	for i := range dockerProfiles {
+		p := &dockerProfiles[i]
+		if len(p.EncryptedToken) == 0 {
+			continue
+		}
+
+		s, err := unseal("docker", p.Name, p.Name, p.Registry, p.EncryptedToken, func(b []byte) {
+			p.EncryptedToken = b
+			p.TokenStorage = model.TokenStorageEncrypted
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		add(&sealedOwner{secrets: []*sealedSecret{s}, save: func() error { return db.SaveDockerProfile(p) }})
+	}
+
+	slackConfig, err := db.GetSlackConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the Slack configuration: %w", err)
+	}
+
+	if slackConfig != nil {
+		owner := &sealedOwner{save: func() error { return db.SaveSlackConfig(slackConfig) }}
+
+		if len(slackConfig.EncryptedWebhookURL) > 0 {
+			s, err := unseal("slack", "webhook_url", slackProfileName, slackHost, slackConfig.EncryptedWebhookURL, func(b []byte) { slackConfig.EncryptedWebhookURL = b })
+			if err != nil {
+				return nil, err
+			}
+
+			owner.secrets = append(owner.secrets, s)
+		}
+
+		if len(slackConfig.EncryptedBotToken) > 0 {
+			s, err := unseal("slack", "bot_token", slackProfileName, slackHost, slackConfig.EncryptedBotToken, func(b []byte) { slackConfig.EncryptedBotToken = b })
+			if err != nil {
+				return nil, err
+			}
+
+			owner.secrets = append(owner.secrets, s)
+		}
+
+		add(owner)
+	}
+
+	accounts, err := db.ListSlackAccounts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Slack accounts: %w", err)
+	}
+
+	for _, account := range accounts {
+		if len(account.EncryptedBotToken) == 0 {
+			continue
+		}
+
+		s, err := unseal("slack_account", account.Name, account.Name, "slack", account.EncryptedBotToken, func(b []byte) { account.EncryptedBotToken = b })
+		if err != nil {
+			return nil, err
+		}
+
+		add(&sealedOwner{secrets: []*sealedSecret{s}, save: func() error { return db.SaveSlackAccount(account) }})
+	}
+
+	secrets, err := db.ListVaultSecrets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vault secrets: %w", err)
+	}
+
+	for i := range secrets {
+		v := &secrets[i]
+
+		s, err := unseal("vault", v.Key, vaultScope, v.Key, v.Value, func(b []byte) { v.Value = b })
+		if err != nil {
+			return nil, err
+		}
+
+		add(&sealedOwner{secrets: []*sealedSecret{s}, save: func() error { return db.SaveVaultSecret(v) }})
+	}
+
+	return owners, nil
+}
+
+// unseal decrypts one secret, keeping where to store it once sealed again
+func unseal(kind, name, scope, host string, ciphertext []byte, set func([]byte)) (*sealedSecret, error) {
+	plaintext, err := tpm.DecryptToken(ciphertext, scope, host)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt %s %s; nothing was changed: %w", kind, name, err)
+	}
+
+	return &sealedSecret{
+		item: KeyRotationItem{
+			Kind:   kind,
+			Name:   name,
+			Scope:  scope,
+			Format: secretFormat(ciphertext),
+		},
+		host:      host,
+		plaintext: plaintext,
+		original:  ciphertext,
+		set:       set,
+	}, nil
+}
+
+// unsealChannel decrypts the sensitive config values of a notification
+// channel. Values are stored base64-encoded or, by older versions, raw; the
+// encoding of each value is kept.
+func unsealChannel(profileName string, channel *model.NotifyChannel) ([]*sealedSecret, error) {
+	var secrets []*sealedSecret
+
+	for key, value := range channel.Config {
+		if !isSensitiveKey(key) || value == "" {
+			continue
+		}
+
+		ciphertext, encoded := []byte(value), false
+		if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && hasSealedPrefix(decoded) {
+			ciphertext, encoded = decoded, true
+		}
+
+		// Values written before encryption was added are left alone
+		if !hasSealedPrefix(ciphertext) {
+			continue
+		}
+
+		name := fmt.Sprintf("%s/%s:%s", profileName, channel.Name, key)
+
+		s, err := unseal("channel", name, profileName, string(channel.Type), ciphertext, func(b []byte) {
+			if encoded {
+				channel.Config[key] = base64.StdEncoding.EncodeToString(b)
+			} else {
+				channel.Config[key] = string(b)
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		secrets = append(secrets, s)
+	}
+
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].item.Name < secrets[j].item.Name })
+
+	return secrets, nil
+}
+
+// hasSealedPrefix reports whether data is in one of the tpm storage formats
+func hasSealedPrefix(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(tpm.KSPrefix)) ||
+		bytes.HasPrefix(data, []byte(tpm.EncPrefix)) ||
+		bytes.HasPrefix(data, []byte(tpm.OpenPrefix))
+}
+
+// secretFormat names the storage format of sealed data
+func secretFormat(data []byte) string {
+	switch {
+	case tpm.IsDataKeystore(data):
+		return SecretFormatKeystore
+	case tpm.IsDataOpen(data):
+		return SecretFormatOpen
+	default:
+		return SecretFormatLegacy
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestUnsealChannel(t *testing.T) {
+	channel := &model.NotifyChannel{
+		Name: "alerts",
+		Type: model.ChannelSlack,
+		Config: map[string]string{
+			"webhook_url": base64.StdEncoding.EncodeToString([]byte(tpm.OpenPrefix + "https://hooks.example.com/a")),
+			"bot_token":   tpm.OpenPrefix + "xoxb-raw",
+			"token":       "never-encrypted",
+			"channel":     "#dev",
+		},
+	}
+
+	secrets, err := unsealChannel("work", channel)
+	if err != nil {
+		t.Fatalf("unsealChannel() error = %v", err)
+	}
+
+	if len(secrets) != 2 {
+		t.Fatalf("unsealChannel() returned %d secrets, want 2", len(secrets))
+	}
+
+	if secrets[0].item.Name != "work/alerts:bot_token" || secrets[0].plaintext != "xoxb-raw" {
+		t.Errorf("first secret = %+v", secrets[0].item)
+	}
+
+	if secrets[1].plaintext != "https://hooks.example.com/a" || secrets[1].item.Format != SecretFormatOpen {
+		t.Errorf("second secret = %+v", secrets[1].item)
+	}
+
+	for _, s := range secrets {
+		s.set([]byte(tpm.KSPrefix + "sealed"))
+	}
+
+	if got := channel.Config["bot_token"]; got != tpm.KSPrefix+"sealed" {
+		t.Errorf("raw value resealed as %q", got)
+	}
+
+	if got := channel.Config["webhook_url"]; got != base64.StdEncoding.EncodeToString([]byte(tpm.KSPrefix+"sealed")) {
+		t.Errorf("base64 value resealed as %q", got)
+	}
+
+	if got := channel.Config["token"]; got != "never-encrypted" {
+		t.Errorf("unencrypted value changed to %q", got)
+	}
+}
+
+func TestSecretFormat(t *testing.T) {
+	tests := map[string]string{
+		tpm.KSPrefix + "x":   SecretFormatKeystore,
+		tpm.EncPrefix + "x":  SecretFormatLegacy,
+		tpm.OpenPrefix + "x": SecretFormatOpen,
+	}
+
+	for data, want := range tests {
+		if got := secretFormat([]byte(data)); got != want {
+			t.Errorf("secretFormat(%q) = %q, want %q", data, got, want)
+		}
+	}
+}
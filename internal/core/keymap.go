@@ -0,0 +1,188 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// KeyAction is an action of the interactive views whose keys can be remapped
+type KeyAction string
+
+const (
+	KeySelect   KeyAction = "select"   // Pick the highlighted item
+	KeyFavorite KeyAction = "favorite" // Mark or unmark the highlighted repository as favorite
+	KeyDelete   KeyAction = "delete"   // Remove the highlighted repository from clonr
+	KeyOpen     KeyAction = "open"     // Open the highlighted repository in the editor
+	KeyFilter   KeyAction = "filter"   // Start filtering the list
+)
+
+// KeyActions are the remappable actions, in display order
+var KeyActions = []KeyAction{KeySelect, KeyFavorite, KeyDelete, KeyOpen, KeyFilter}
+
+// DefaultKeys are the keys of each action when none are configured
+var DefaultKeys = map[KeyAction][]string{
+	KeySelect:   {"enter"},
+	KeyFavorite: {"*"},
+	KeyDelete:   {"x"},
+	KeyOpen:     {"o"},
+	KeyFilter:   {"/"},
+}
+
+// ReservedKeys are used by the views for navigation, grouping, sorting,
+// help and quitting, so they cannot be given to an action
+var ReservedKeys = []string{
+	"ctrl+c", "q", "esc", "?",
+	"up", "down", "left", "right", "k", "j", "h", "l",
+	"pgup", "pgdown", "b", "f", "u", "d", "home", "end", "g", "G",
+	"tab", "shift+tab", " ", "z", "v", "s", "a", "ctrl+a", "i",
+}
+
+// keyMapField returns the keys of action in cfg, or nil for an unknown action
+func keyMapField(cfg *model.KeyMapConfig, action KeyAction) *[]string {
+	switch action {
+	case KeySelect:
+		return &cfg.Select
+	case KeyFavorite:
+		return &cfg.Favorite
+	case KeyDelete:
+		return &cfg.Delete
+	case KeyOpen:
+		return &cfg.Open
+	case KeyFilter:
+		return &cfg.Filter
+	default:
+		return nil
+	}
+}
+
+// ParseKeyAction returns the action called name
+func ParseKeyAction(name string) (KeyAction, error) {
+	action := KeyAction(strings.ToLower(strings.TrimSpace(name)))
+	if !slices.Contains(KeyActions, action) {
+		return "", fmt.Errorf("unknown action %q (valid: select, favorite, delete, open, filter)", name)
+	}
+
+	return action, nil
+}
+
+// EffectiveKeys returns the keys of every action: the configured ones, or
+// the defaults of actions without any
+func EffectiveKeys(cfg model.KeyMapConfig) map[KeyAction][]string {
+	keys := make(map[KeyAction][]string, len(KeyActions))
+
+	for _, action := range KeyActions {
+		if configured := *keyMapField(&cfg, action); len(configured) > 0 {
+			keys[action] = configured
+		} else {
+			keys[action] = DefaultKeys[action]
+		}
+	}
+
+	return keys
+}
+
+// ValidateKeyMap checks that no key is empty, reserved by the views or
+// bound to two actions once the defaults are applied
+func ValidateKeyMap(cfg model.KeyMapConfig) error {
+	owner := make(map[string]KeyAction)
+	keys := EffectiveKeys(cfg)
+
+	for _, action := range KeyActions {
+		for _, k := range keys[action] {
+			if k == "" {
+				return fmt.Errorf("%s: empty key", action)
+			}
+
+			if slices.Contains(ReservedKeys, k) {
+				return fmt.Errorf("%s: key %q is reserved", action, k)
+			}
+
+			if other, ok := owner[k]; ok {
+				return fmt.Errorf("key %q is bound to both %s and %s", k, other, action)
+			}
+
+			owner[k] = action
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyMap returns the key map of the configuration, or no remapped keys
+// when it cannot be read
+func LoadKeyMap() model.KeyMapConfig {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return model.KeyMapConfig{}
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil || ValidateKeyMap(cfg.KeyMap) != nil {
+		return model.KeyMapConfig{}
+	}
+
+	return cfg.KeyMap
+}
+
+// SetKeyBinding binds keys to action, replacing its current keys
+func SetKeyBinding(action KeyAction, keys []string) error {
+	if _, err := ParseKeyAction(string(action)); err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("%s: at least one key is required", action)
+	}
+
+	return updateKeyMap(func(cfg *model.KeyMapConfig) {
+		*keyMapField(cfg, action) = keys
+	})
+}
+
+// ResetKeyBindings restores the default keys of action, or of every action
+// when action is empty
+func ResetKeyBindings(action KeyAction) error {
+	if action != "" {
+		if _, err := ParseKeyAction(string(action)); err != nil {
+			return err
+		}
+	}
+
+	return updateKeyMap(func(cfg *model.KeyMapConfig) {
+		if action == "" {
+			*cfg = model.KeyMapConfig{}
+			return
+		}
+
+		*keyMapField(cfg, action) = nil
+	})
+}
+
+// updateKeyMap applies change to the saved key map after validating it
+func updateKeyMap(change func(cfg *model.KeyMapConfig)) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	change(&cfg.KeyMap)
+
+	if err := ValidateKeyMap(cfg.KeyMap); err != nil {
+		return err
+	}
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestEffectiveKeys(t *testing.T) {
+	keys := EffectiveKeys(model.KeyMapConfig{Favorite: []string{"ctrl+f", "F"}})
+
+	if !slices.Equal(keys[KeyFavorite], []string{"ctrl+f", "F"}) {
+		t.Errorf("favorite = %v, want the configured keys", keys[KeyFavorite])
+	}
+
+	if !slices.Equal(keys[KeySelect], DefaultKeys[KeySelect]) {
+		t.Errorf("select = %v, want the default %v", keys[KeySelect], DefaultKeys[KeySelect])
+	}
+}
+
+func TestValidateKeyMap(t *testing.T) {
+	if err := ValidateKeyMap(model.KeyMapConfig{}); err != nil {
+		t.Fatalf("default key map: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     model.KeyMapConfig
+		wantErr bool
+	}{
+		{name: "remapped", cfg: model.KeyMapConfig{Open: []string{"e"}, Delete: []string{"ctrl+d"}}},
+		{name: "reserved key", cfg: model.KeyMapConfig{Open: []string{"q"}}, wantErr: true},
+		{name: "empty key", cfg: model.KeyMapConfig{Filter: []string{""}}, wantErr: true},
+		{name: "two actions", cfg: model.KeyMapConfig{Open: []string{"e"}, Favorite: []string{"e"}}, wantErr: true},
+		{name: "clashes with a default", cfg: model.KeyMapConfig{Open: []string{"x"}}, wantErr: true},
+		{name: "takes over a default", cfg: model.KeyMapConfig{Open: []string{"x"}, Delete: []string{"ctrl+d"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateKeyMap(tt.cfg); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateKeyMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseKeyAction(t *testing.T) {
+	if action, err := ParseKeyAction(" Favorite "); err != nil || action != KeyFavorite {
+		t.Errorf("ParseKeyAction() = %q, %v", action, err)
+	}
+
+	if _, err := ParseKeyAction("archive"); err == nil {
+		t.Error("ParseKeyAction(archive) succeeded, want an error")
+	}
+}
@@ -0,0 +1,237 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// License sources reported by ScanLicenses
+const (
+	LicenseSourceHost = "host"
+	LicenseSourceFile = "file"
+)
+
+// License issues reported by ScanLicenses
+const (
+	LicenseIssueMissing      = "missing"
+	LicenseIssueUnrecognized = "unrecognized"
+	LicenseIssueIncompatible = "incompatible"
+)
+
+// licenseFiles are the file names checked for a license, in order
+var licenseFiles = []string{
+	"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "LICENCE.md", "LICENCE.txt",
+	"COPYING", "COPYING.md", "COPYING.txt", "UNLICENSE", "LICENSE-MIT", "LICENSE-APACHE",
+}
+
+// licenseRule recognizes a license by phrases its text contains
+type licenseRule struct {
+	spdx    string
+	phrases []string
+}
+
+// licenseRules are checked in order; more specific texts come first
+var licenseRules = []licenseRule{
+	{"AGPL-3.0", []string{"gnu affero general public license", "version 3"}},
+	{"LGPL-3.0", []string{"gnu lesser general public license", "version 3"}},
+	{"LGPL-2.1", []string{"gnu lesser general public license", "version 2.1"}},
+	{"GPL-3.0", []string{"gnu general public license", "version 3"}},
+	{"GPL-2.0", []string{"gnu general public license", "version 2"}},
+	{"MPL-2.0", []string{"mozilla public license", "2.0"}},
+	{"Apache-2.0", []string{"apache license", "version 2.0"}},
+	{"Unlicense", []string{"free and unencumbered software released into the public domain"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{"BSD-2-Clause", []string{"redistribution and use in source and binary forms"}},
+	{"ISC", []string{"permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"MIT", []string{"permission is hereby granted, free of charge"}},
+}
+
+// DetectLicenseFile identifies the license of the clone at path from its
+// license file. It returns model.LicenseNone without a license file and
+// model.LicenseOther when the text is not recognized.
+func DetectLicenseFile(path string) (spdx, file string) {
+	for _, name := range licenseFiles {
+		data, err := os.ReadFile(filepath.Join(path, name))
+		if err != nil {
+			continue
+		}
+
+		return identifyLicense(string(data)), name
+	}
+
+	return model.LicenseNone, ""
+}
+
+// identifyLicense returns the SPDX identifier of a license text, or
+// model.LicenseOther
+func identifyLicense(text string) string {
+	text = strings.Join(strings.Fields(strings.ToLower(text)), " ")
+
+	for _, rule := range licenseRules {
+		if !slices.ContainsFunc(rule.phrases, func(p string) bool { return !strings.Contains(text, p) }) {
+			return rule.spdx
+		}
+	}
+
+	return model.LicenseOther
+}
+
+// fetchHostLicense asks the GitHub API for the repository license. Other
+// hosts are not queried and return "".
+func fetchHostLicense(ctx context.Context, gh *github.Client, repoURL string) (string, error) {
+	repoURL, _, _ = strings.Cut(repoURL, "#")
+
+	repo, err := giturl.ParseRepository(repoURL, "")
+	if err != nil {
+		return "", err
+	}
+
+	if gh == nil || !strings.EqualFold(repo.Host, "github.com") {
+		return "", nil
+	}
+
+	license, _, err := gh.Repositories.License(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		var errResp *github.ErrorResponse
+		if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound {
+			return model.LicenseNone, nil
+		}
+
+		return "", err
+	}
+
+	switch id := license.GetLicense().GetSPDXID(); id {
+	case "", "NOASSERTION":
+		return model.LicenseOther, nil
+	default:
+		return id, nil
+	}
+}
+
+// DetectLicense determines the license of a tracked repository. The
+// GitHub API (when gh is set) is preferred unless it does not recognize a
+// license the local license file heuristics do.
+func DetectLicense(ctx context.Context, gh *github.Client, repoURL, path string) (spdx, source string, err error) {
+	local, _ := DetectLicenseFile(path)
+
+	host, err := fetchHostLicense(ctx, gh, repoURL)
+	if host == "" || (host == model.LicenseOther && local != model.LicenseOther && local != model.LicenseNone) {
+		return local, LicenseSourceFile, err
+	}
+
+	return host, LicenseSourceHost, err
+}
+
+// LicenseIssue reports what is wrong with a license under a policy: the
+// deny list rejects licenses, a non-empty allow list permits those only
+// (including "none" or "other" when listed). It returns "" for an
+// acceptable license.
+func LicenseIssue(spdx string, allow, deny []string) string {
+	matches := func(list []string) bool {
+		return slices.ContainsFunc(list, func(id string) bool { return strings.EqualFold(id, spdx) })
+	}
+
+	switch {
+	case matches(deny):
+		return LicenseIssueIncompatible
+	case matches(allow):
+		return ""
+	case spdx == model.LicenseNone:
+		return LicenseIssueMissing
+	case len(allow) > 0:
+		return LicenseIssueIncompatible
+	case spdx == model.LicenseOther:
+		return LicenseIssueUnrecognized
+	default:
+		return ""
+	}
+}
+
+// LicenseScanOptions selects the repositories ScanLicenses checks
+type LicenseScanOptions struct {
+	Workspace string   // Only repositories in this workspace
+	Offline   bool     // Use license files only, without the GitHub API (implied by air-gapped mode)
+	Token     string   // GitHub token (empty resolves one, unauthenticated if none)
+	DryRun    bool     // Report without saving
+	Allow     []string // Licenses permitted; others are incompatible (default: all)
+	Deny      []string // Licenses that are incompatible
+}
+
+// LicenseScanResult is the license of one repository
+type LicenseScanResult struct {
+	URL      string `json:"url"`
+	Path     string `json:"path"`
+	License  string `json:"license"`
+	Previous string `json:"previous,omitempty"`
+	Source   string `json:"source"`
+	Issue    string `json:"issue,omitempty"`
+	Warning  string `json:"warning,omitempty"`
+}
+
+// Changed reports whether the license differs from the stored one
+func (r LicenseScanResult) Changed() bool {
+	return r.License != r.Previous
+}
+
+// ScanLicenses detects and stores the license of every tracked repository
+// and flags missing, unrecognized and, under the policy, incompatible ones
+func ScanLicenses(ctx context.Context, opts LicenseScanOptions) ([]LicenseScanResult, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repos, err := client.GetRepos(opts.Workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	var gh *github.Client
+	if !opts.Offline && !IsOffline() {
+		gh = newKindClient(ctx, opts.Token)
+	}
+
+	results := make([]LicenseScanResult, 0, len(repos))
+
+	for _, repo := range repos {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		spdx, source, detectErr := DetectLicense(ctx, gh, repo.URL, repo.Path)
+
+		res := LicenseScanResult{
+			URL:      repo.URL,
+			Path:     repo.Path,
+			License:  spdx,
+			Previous: repo.License,
+			Source:   source,
+			Issue:    LicenseIssue(spdx, opts.Allow, opts.Deny),
+		}
+
+		if detectErr != nil {
+			res.Warning = "host lookup failed: " + detectErr.Error()
+		}
+
+		if res.Changed() && !opts.DryRun {
+			if err := client.SetRepoLicense(repo.URL, spdx); err != nil {
+				res.Warning = fmt.Sprintf("failed to save license: %v", err)
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
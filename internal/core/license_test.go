@@ -0,0 +1,73 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestIdentifyLicense(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"mit", "MIT License\n\nPermission is hereby granted, free of charge, to any person", "MIT"},
+		{"apache", "                                 Apache License\n                           Version 2.0, January 2004", "Apache-2.0"},
+		{"gpl3", "GNU GENERAL PUBLIC LICENSE\n Version 3, 29 June 2007", "GPL-3.0"},
+		{"agpl3", "GNU AFFERO GENERAL PUBLIC LICENSE\n Version 3, 19 November 2007", "AGPL-3.0"},
+		{"lgpl21", "GNU LESSER GENERAL PUBLIC LICENSE\n Version 2.1, February 1999", "LGPL-2.1"},
+		{"bsd3", "Redistribution and use in source and binary forms, with or without\nmodification... Neither the name of the copyright holder", "BSD-3-Clause"},
+		{"bsd2", "Redistribution and use in source and binary forms, with or without modification", "BSD-2-Clause"},
+		{"unlicense", "This is free and unencumbered software released into the public domain.", "Unlicense"},
+		{"custom", "All rights reserved.", model.LicenseOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := identifyLicense(tt.text); got != tt.want {
+				t.Errorf("identifyLicense() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectLicenseFile(t *testing.T) {
+	dir := t.TempDir()
+
+	if spdx, file := DetectLicenseFile(dir); spdx != model.LicenseNone || file != "" {
+		t.Errorf("no license file: got %q, %q", spdx, file)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "COPYING"), []byte("GNU GENERAL PUBLIC LICENSE\nVersion 2, June 1991"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if spdx, file := DetectLicenseFile(dir); spdx != "GPL-2.0" || file != "COPYING" {
+		t.Errorf("COPYING: got %q, %q", spdx, file)
+	}
+}
+
+func TestLicenseIssue(t *testing.T) {
+	tests := []struct {
+		spdx        string
+		allow, deny []string
+		want        string
+	}{
+		{"MIT", nil, nil, ""},
+		{model.LicenseNone, nil, nil, LicenseIssueMissing},
+		{model.LicenseOther, nil, nil, LicenseIssueUnrecognized},
+		{"GPL-3.0", []string{"MIT", "apache-2.0"}, nil, LicenseIssueIncompatible},
+		{"Apache-2.0", []string{"MIT", "apache-2.0"}, nil, ""},
+		{"AGPL-3.0", nil, []string{"AGPL-3.0"}, LicenseIssueIncompatible},
+		{model.LicenseOther, []string{"other"}, nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := LicenseIssue(tt.spdx, tt.allow, tt.deny); got != tt.want {
+			t.Errorf("LicenseIssue(%q, %v, %v) = %q, want %q", tt.spdx, tt.allow, tt.deny, got, tt.want)
+		}
+	}
+}
@@ -27,6 +27,61 @@ func ListReposFiltered(favoritesOnly bool) ([]model.Repository, error) {
 	return client.GetRepos("", favoritesOnly)
 }
 
+// RepoPageSize is the number of repositories fetched per page by ListReposPage
+const RepoPageSize = 500
+
+// ListReposPage returns one page of repositories matching filter. If the
+// server does not support paginated listing, the first page holds everything.
+func ListReposPage(filter model.RepoFilter, pageToken string) (*grpc.RepoPage, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	page, err := client.ListRepos(filter, RepoPageSize, pageToken)
+	if err == nil || pageToken != "" {
+		return page, err
+	}
+
+	// Older servers only support unpaginated listing
+	repos, fallbackErr := client.GetRepos(filter.Workspace, filter.FavoritesOnly)
+	if fallbackErr != nil {
+		return nil, err
+	}
+
+	matched := make([]model.Repository, 0, len(repos))
+	for _, repo := range repos {
+		if filter.Matches(repo) {
+			matched = append(matched, repo)
+		}
+	}
+
+	return &grpc.RepoPage{Repositories: matched, TotalSize: len(matched)}, nil
+}
+
+// ListReposPaged returns all repositories matching filter, fetched page by page.
+func ListReposPaged(filter model.RepoFilter) ([]model.Repository, error) {
+	var (
+		repos []model.Repository
+		token string
+	)
+
+	for {
+		page, err := ListReposPage(filter, token)
+		if err != nil {
+			return nil, err
+		}
+
+		repos = append(repos, page.Repositories...)
+
+		if page.NextPageToken == "" {
+			return repos, nil
+		}
+
+		token = page.NextPageToken
+	}
+}
+
 // ListReposFilteredByWorkspace returns repos filtered by workspace.
 // Server-side filtering is used for efficiency.
 func ListReposFilteredByWorkspace(workspace string, favoritesOnly bool) ([]model.Repository, error) {
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/fs"
@@ -8,30 +9,55 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
 )
 
+// mapProgressInterval is how often a scan reports its progress
+const mapProgressInterval = 200 * time.Millisecond
+
 // MapOptions configures the repository mapping operation
 type MapOptions struct {
 	DryRun    bool     // Don't actually add repos, just show what would be added
 	MaxDepth  int      // Maximum directory depth to scan (0 = unlimited)
-	Exclude   []string // Directory names to skip (e.g., node_modules, vendor)
+	Exclude   []string // Directory names or glob patterns to skip (see matchExclude)
 	JSON      bool     // Output results as JSON
 	Verbose   bool     // Show verbose output
 	Workspace string   // Workspace to assign to found repos (empty = no workspace)
+
+	// Ignore is the ignore list; matching directories and remotes are skipped
+	Ignore model.IgnoreConfig
+
+	// OnProgress is called periodically while scanning (nil = no progress)
+	OnProgress func(MapProgress)
+}
+
+// MapProgress reports how far a scan has got
+type MapProgress struct {
+	Dirs  int    // Directories visited
+	Repos int    // Repositories found
+	Path  string // Directory being scanned
 }
 
 // MapResult contains the result of a mapping operation
 type MapResult struct {
 	ScannedDir   string          `json:"scanned_dir"`
+	Workspace    string          `json:"workspace,omitempty"`
+	DryRun       bool            `json:"dry_run,omitempty"`
 	Found        []MappedRepo    `json:"found"`
 	AlreadyAdded []MappedRepo    `json:"already_added"`
+	Moved        []MovedRepo     `json:"moved,omitempty"`
 	Errors       []MappedRepoErr `json:"errors,omitempty"`
 	TotalFound   int             `json:"total_found"`
 	TotalAdded   int             `json:"total_added"`
 	TotalSkipped int             `json:"total_skipped"`
+	TotalMoved   int             `json:"total_moved"`
 	TotalErrors  int             `json:"total_errors"`
+	TotalIgnored int             `json:"total_ignored,omitempty"`
+	TotalDirs    int             `json:"total_dirs"`
+	Interrupted  bool            `json:"interrupted,omitempty"`
 }
 
 // MappedRepo represents a discovered repository
@@ -40,6 +66,13 @@ type MappedRepo struct {
 	URL  string `json:"url"`
 }
 
+// MovedRepo represents a tracked repository found at a new location
+type MovedRepo struct {
+	URL     string `json:"url"`
+	OldPath string `json:"old_path"`
+	NewPath string `json:"new_path"`
+}
+
 // MappedRepoErr represents an error during mapping
 type MappedRepoErr struct {
 	Path  string `json:"path"`
@@ -71,16 +104,17 @@ var DefaultExcludeDirs = []string{
 }
 
 // MapRepos scans a directory for Git repositories and registers them
-func MapRepos(args []string) error {
+func MapRepos(ctx context.Context, args []string) error {
 	opts := MapOptions{
 		Exclude: DefaultExcludeDirs,
 	}
 
-	return MapReposWithOptions(args, opts)
+	return MapReposWithOptions(ctx, args, opts)
 }
 
-// MapReposWithOptions scans a directory with custom options
-func MapReposWithOptions(args []string, opts MapOptions) error {
+// MapReposWithOptions scans a directory with custom options. When ctx is
+// cancelled the scan stops and the repositories found so far are reported.
+func MapReposWithOptions(ctx context.Context, args []string, opts MapOptions) error {
 	rootDir := "."
 
 	if len(args) > 0 {
@@ -103,31 +137,61 @@ func MapReposWithOptions(args []string, opts MapOptions) error {
 		return fmt.Errorf("not a directory: %s", absRoot)
 	}
 
+	for _, pattern := range opts.Exclude {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid exclude pattern %q: %w", pattern, err)
+		}
+	}
+
 	result := &MapResult{
 		ScannedDir:   absRoot,
+		Workspace:    opts.Workspace,
+		DryRun:       opts.DryRun,
 		Found:        make([]MappedRepo, 0),
 		AlreadyAdded: make([]MappedRepo, 0),
 		Errors:       make([]MappedRepoErr, 0),
 	}
 
-	var client *grpc.Client
+	var (
+		client  *grpc.Client
+		tracked []model.Repository
+	)
 
+	// A dry run works without a server
 	if !opts.DryRun {
 		client, err = grpc.GetClient()
 		if err != nil {
 			return fmt.Errorf("failed to connect to server: %w", err)
 		}
-	}
 
-	// Build exclude a map for fast lookups
-	excludeMap := make(map[string]bool)
-	for _, dir := range opts.Exclude {
-		excludeMap[dir] = true
+		if opts.Workspace != "" {
+			exists, err := client.WorkspaceExists(opts.Workspace)
+			if err != nil {
+				return fmt.Errorf("failed to check workspace: %w", err)
+			}
+
+			if !exists {
+				return fmt.Errorf("workspace '%s' not found", opts.Workspace)
+			}
+		}
+
+		// Load tracked repositories once so moved repositories can be
+		// reconciled with their existing records instead of duplicated
+		tracked, err = client.GetAllRepos()
+		if err != nil {
+			return fmt.Errorf("failed to get repositories: %w", err)
+		}
 	}
 
 	rootDepth := strings.Count(absRoot, string(os.PathSeparator))
 
+	var lastProgress time.Time
+
 	err = filepath.WalkDir(absRoot, func(path string, d fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+
 		if err != nil {
 			if opts.Verbose {
 				log.Printf("Error accessing %s: %v\n", path, err)
@@ -140,6 +204,13 @@ func MapReposWithOptions(args []string, opts MapOptions) error {
 			return nil
 		}
 
+		result.TotalDirs++
+
+		if opts.OnProgress != nil && time.Since(lastProgress) >= mapProgressInterval {
+			lastProgress = time.Now()
+			opts.OnProgress(MapProgress{Dirs: result.TotalDirs, Repos: result.TotalFound + result.TotalMoved + result.TotalSkipped, Path: path})
+		}
+
 		// Check depth limit
 		if opts.MaxDepth > 0 {
 			currentDepth := strings.Count(path, string(os.PathSeparator)) - rootDepth
@@ -148,8 +219,9 @@ func MapReposWithOptions(args []string, opts MapOptions) error {
 			}
 		}
 
-		// Check exclusions
-		if excludeMap[d.Name()] {
+		// Check exclusions; the scanned directory itself and .git
+		// directories are never excluded
+		if path != absRoot && d.Name() != ".git" && matchExclude(opts.Exclude, absRoot, path) {
 			if opts.Verbose {
 				log.Printf("Skipping excluded directory: %s\n", path)
 			}
@@ -157,122 +229,292 @@ func MapReposWithOptions(args []string, opts MapOptions) error {
 			return fs.SkipDir
 		}
 
-		// Check if this is a .git directory
-		if d.Name() == ".git" {
-			repoPath := filepath.Dir(path)
-
-			dotGit, err := dotGitCheck(path)
-			if err != nil {
-				result.Errors = append(result.Errors, MappedRepoErr{
-					Path:  repoPath,
-					Error: err.Error(),
-				})
-				result.TotalErrors++
-
+		if path != absRoot && d.Name() != ".git" {
+			if rule := IgnoredPath(opts.Ignore, path); rule != "" {
 				if opts.Verbose {
-					log.Printf("Error checking %s: %v\n", repoPath, err)
+					log.Printf("Skipping ignored directory: %s (%s)\n", path, rule)
 				}
 
 				return fs.SkipDir
 			}
+		}
 
-			repo := MappedRepo{
-				Path: repoPath,
-				URL:  dotGit.URL.String(),
-			}
+		// Check if this is a .git directory
+		if d.Name() == ".git" {
+			mapFoundRepo(client, tracked, path, opts, result)
 
-			if opts.DryRun {
-				result.Found = append(result.Found, repo)
-				result.TotalFound++
+			return fs.SkipDir
+		}
 
-				if !opts.JSON {
-					log.Printf("Would add: %s (%s)\n", repoPath, dotGit.URL.String())
-				}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("error scanning directories: %w", err)
+	}
 
-				return fs.SkipDir
-			}
+	result.Interrupted = ctx.Err() != nil
 
-			// Check if already tracked
-			exists, err := client.RepoExistsByURL(dotGit.URL)
-			if err != nil {
-				result.Errors = append(result.Errors, MappedRepoErr{
-					Path:  repoPath,
-					Error: err.Error(),
-				})
-				result.TotalErrors++
+	// Report the final counts, the last progress shown
+	if opts.OnProgress != nil {
+		opts.OnProgress(MapProgress{Dirs: result.TotalDirs, Repos: result.TotalFound + result.TotalMoved + result.TotalSkipped, Path: absRoot})
+	}
 
-				if opts.Verbose {
-					log.Printf("DB check failed for %s: %v\n", repoPath, err)
-				}
+	// Output results
+	if opts.JSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
 
-				return fs.SkipDir
-			}
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
 
-			if exists {
-				result.AlreadyAdded = append(result.AlreadyAdded, repo)
-				result.TotalSkipped++
+		return mapStopped(ctx, result)
+	}
 
-				if opts.Verbose && !opts.JSON {
-					log.Printf("Already tracked: %s\n", repoPath)
-				}
+	// Summary
+	_, _ = fmt.Fprintln(os.Stdout)
 
-				return fs.SkipDir
-			}
+	if result.Interrupted {
+		_, _ = fmt.Fprintf(os.Stdout, "Scan stopped before finishing %s\n", absRoot)
+	}
 
-			// Add to database
-			var saveErr error
-			if opts.Workspace != "" {
-				saveErr = client.SaveRepoWithWorkspace(dotGit.URL, repoPath, opts.Workspace)
-			} else {
-				saveErr = client.SaveRepo(dotGit.URL, repoPath)
-			}
+	if opts.DryRun {
+		_, _ = fmt.Fprintf(os.Stdout, "Dry run complete: %d repositories found (%d directories scanned)\n",
+			result.TotalFound, result.TotalDirs)
+	} else {
+		_, _ = fmt.Fprintf(os.Stdout, "Mapping complete: %d added, %d moved, %d already tracked, %d errors (%d directories scanned)\n",
+			result.TotalAdded, result.TotalMoved, result.TotalSkipped, result.TotalErrors, result.TotalDirs)
+	}
 
-			if saveErr != nil {
-				result.Errors = append(result.Errors, MappedRepoErr{
-					Path:  repoPath,
-					Error: saveErr.Error(),
-				})
-				result.TotalErrors++
+	if result.TotalIgnored > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "%d repositories skipped by the ignore list\n", result.TotalIgnored)
+	}
 
-				if !opts.JSON {
-					log.Printf("Failed to add %s: %v\n", repoPath, saveErr)
-				}
-			} else {
-				result.Found = append(result.Found, repo)
-				result.TotalFound++
-				result.TotalAdded++
+	if opts.Workspace != "" && result.TotalFound > 0 {
+		verb := "were added"
+		if opts.DryRun {
+			verb = "would be added"
+		}
 
-				if !opts.JSON {
-					log.Printf("Added: %s\n", repoPath)
-				}
-			}
+		_, _ = fmt.Fprintf(os.Stdout, "New repositories %s to workspace '%s'\n", verb, opts.Workspace)
+	}
 
-			return fs.SkipDir
+	return mapStopped(ctx, result)
+}
+
+// mapFoundRepo registers the repository whose .git directory is gitDir,
+// reconciling it with the tracked repositories, and records the outcome in
+// result. A dry run only records it.
+func mapFoundRepo(client *grpc.Client, tracked []model.Repository, gitDir string, opts MapOptions, result *MapResult) {
+	repoPath := filepath.Dir(gitDir)
+
+	dotGit, err := dotGitCheck(gitDir)
+	if err != nil {
+		result.Errors = append(result.Errors, MappedRepoErr{
+			Path:  repoPath,
+			Error: err.Error(),
+		})
+		result.TotalErrors++
+
+		if opts.Verbose {
+			log.Printf("Error checking %s: %v\n", repoPath, err)
 		}
 
-		return nil
-	})
+		return
+	}
+
+	repo := MappedRepo{
+		Path: repoPath,
+		URL:  dotGit.URL.String(),
+	}
+
+	if rule := IgnoredURL(opts.Ignore, repo.URL); rule != "" {
+		result.TotalIgnored++
+
+		if opts.Verbose && !opts.JSON {
+			log.Printf("Ignored: %s (%s)\n", repoPath, rule)
+		}
+
+		return
+	}
+
+	if opts.DryRun {
+		result.Found = append(result.Found, repo)
+		result.TotalFound++
+
+		if !opts.JSON {
+			log.Printf("Would add: %s (%s)\n", repoPath, dotGit.URL.String())
+		}
+
+		return
+	}
+
+	// Reconcile repositories that were moved on disk
+	if moved := findMovedRepo(tracked, dotGit.URL.String(), repoPath); moved != nil {
+		if err := client.UpdateRepoPath(moved.URL, repoPath); err != nil {
+			result.Errors = append(result.Errors, MappedRepoErr{
+				Path:  repoPath,
+				Error: err.Error(),
+			})
+			result.TotalErrors++
+
+			return
+		}
+
+		result.Moved = append(result.Moved, MovedRepo{
+			URL:     moved.URL,
+			OldPath: moved.Path,
+			NewPath: repoPath,
+		})
+		result.TotalMoved++
+
+		if !opts.JSON {
+			log.Printf("Moved: %s -> %s\n", moved.Path, repoPath)
+		}
+
+		moved.Path = repoPath
+
+		return
+	}
+
+	// Check if already tracked
+	exists, err := client.RepoExistsByURL(dotGit.URL)
 	if err != nil {
-		return fmt.Errorf("error scanning directories: %w", err)
+		result.Errors = append(result.Errors, MappedRepoErr{
+			Path:  repoPath,
+			Error: err.Error(),
+		})
+		result.TotalErrors++
+
+		if opts.Verbose {
+			log.Printf("DB check failed for %s: %v\n", repoPath, err)
+		}
+
+		return
 	}
 
-	// Output results
-	if opts.JSON {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
+	// Monorepo subdirectory entries are keyed by URL#subdir, so also
+	// match on the path to avoid registering the clone a second time
+	if !exists {
+		exists = isPathTracked(tracked, repoPath)
+	}
+
+	if exists {
+		result.AlreadyAdded = append(result.AlreadyAdded, repo)
+		result.TotalSkipped++
 
-		return enc.Encode(result)
+		if opts.Verbose && !opts.JSON {
+			log.Printf("Already tracked: %s\n", repoPath)
+		}
+
+		return
 	}
 
-	// Summary
-	_, _ = fmt.Fprintln(os.Stdout)
+	// Add to database
+	var saveErr error
+	if opts.Workspace != "" {
+		saveErr = client.SaveRepoWithWorkspace(dotGit.URL, repoPath, opts.Workspace)
+	} else {
+		saveErr = client.SaveRepo(dotGit.URL, repoPath)
+	}
 
-	if opts.DryRun {
-		_, _ = fmt.Fprintf(os.Stdout, "Dry run complete: %d repositories found\n", result.TotalFound)
+	if saveErr != nil {
+		result.Errors = append(result.Errors, MappedRepoErr{
+			Path:  repoPath,
+			Error: saveErr.Error(),
+		})
+		result.TotalErrors++
+
+		if !opts.JSON {
+			log.Printf("Failed to add %s: %v\n", repoPath, saveErr)
+		}
 	} else {
-		_, _ = fmt.Fprintf(os.Stdout, "Mapping complete: %d added, %d already tracked, %d errors\n",
-			result.TotalAdded, result.TotalSkipped, result.TotalErrors)
+		result.Found = append(result.Found, repo)
+		result.TotalFound++
+		result.TotalAdded++
+
+		if !opts.JSON {
+			log.Printf("Added: %s\n", repoPath)
+		}
+	}
+}
+
+// mapStopped returns the cancellation cause of an interrupted scan
+func mapStopped(ctx context.Context, result *MapResult) error {
+	if !result.Interrupted {
+		return nil
+	}
+
+	return fmt.Errorf("scan stopped: %w", context.Cause(ctx))
+}
+
+// findMovedRepo returns the tracked repository that points to the same remote
+// as the one found at path, but whose stored path no longer exists on disk.
+// An exact URL match is preferred over an equivalent URL in a different form.
+func findMovedRepo(tracked []model.Repository, urlStr, path string) *model.Repository {
+	var candidate *model.Repository
+
+	for i := range tracked {
+		repo := &tracked[i]
+
+		if repo.Path == path {
+			return nil
+		}
+
+		if !SameRepoURL(repo.URL, urlStr) {
+			continue
+		}
+
+		if _, err := os.Stat(repo.Path); err == nil {
+			continue
+		}
+
+		if repo.URL == urlStr {
+			candidate = repo
+			continue
+		}
+
+		if candidate == nil {
+			candidate = repo
+		}
+	}
+
+	return candidate
+}
+
+// matchExclude reports whether the directory at path under root matches one
+// of patterns. A pattern without a slash is matched against the directory
+// name, e.g. "node_modules" or ".*"; one with a slash against the path
+// relative to root, e.g. "archive/*".
+func matchExclude(patterns []string, root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+
+	name := filepath.Base(path)
+
+	for _, pattern := range patterns {
+		target := name
+		if strings.Contains(pattern, "/") {
+			pattern, target = filepath.FromSlash(pattern), rel
+		}
+
+		if ok, _ := filepath.Match(pattern, target); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isPathTracked reports whether any tracked repository is stored at path
+func isPathTracked(tracked []model.Repository, path string) bool {
+	for _, repo := range tracked {
+		if repo.Path == path {
+			return true
+		}
 	}
 
-	return nil
+	return false
 }
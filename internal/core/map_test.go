@@ -0,0 +1,125 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestFindMovedRepo(t *testing.T) {
+	existing := t.TempDir()
+	missing := filepath.Join(t.TempDir(), "gone")
+	newPath := filepath.Join(t.TempDir(), "repo")
+
+	tests := []struct {
+		name    string
+		tracked []model.Repository
+		url     string
+		path    string
+		wantURL string
+	}{
+		{
+			name:    "stale path with same url",
+			tracked: []model.Repository{{URL: "https://github.com/user/repo", Path: missing}},
+			url:     "https://github.com/user/repo",
+			path:    newPath,
+			wantURL: "https://github.com/user/repo",
+		},
+		{
+			name:    "stale path with equivalent ssh url",
+			tracked: []model.Repository{{URL: "https://github.com/user/repo", Path: missing}},
+			url:     "ssh://git@github.com/user/repo.git",
+			path:    newPath,
+			wantURL: "https://github.com/user/repo",
+		},
+		{
+			name:    "existing path is not moved",
+			tracked: []model.Repository{{URL: "https://github.com/user/repo", Path: existing}},
+			url:     "https://github.com/user/repo",
+			path:    newPath,
+		},
+		{
+			name:    "path already tracked",
+			tracked: []model.Repository{{URL: "https://github.com/user/repo", Path: newPath}},
+			url:     "https://github.com/user/repo",
+			path:    newPath,
+		},
+		{
+			name:    "different repository",
+			tracked: []model.Repository{{URL: "https://github.com/user/other", Path: missing}},
+			url:     "https://github.com/user/repo",
+			path:    newPath,
+		},
+		{
+			name: "exact url preferred",
+			tracked: []model.Repository{
+				{URL: "https://github.com/user/repo.git", Path: missing},
+				{URL: "https://github.com/user/repo", Path: missing},
+			},
+			url:     "https://github.com/user/repo",
+			path:    newPath,
+			wantURL: "https://github.com/user/repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMovedRepo(tt.tracked, tt.url, tt.path)
+
+			switch {
+			case tt.wantURL == "" && got != nil:
+				t.Errorf("findMovedRepo() = %q, want nil", got.URL)
+			case tt.wantURL != "" && got == nil:
+				t.Errorf("findMovedRepo() = nil, want %q", tt.wantURL)
+			case got != nil && got.URL != tt.wantURL:
+				t.Errorf("findMovedRepo() = %q, want %q", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestMapReposCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errTestStopped)
+
+	err := MapReposWithOptions(ctx, []string{t.TempDir()}, MapOptions{DryRun: true, JSON: true})
+	if !errors.Is(err, errTestStopped) {
+		t.Errorf("MapReposWithOptions() error = %v, want %v", err, errTestStopped)
+	}
+}
+
+func TestMatchExclude(t *testing.T) {
+	root := filepath.Join("home", "me", "code")
+
+	tests := []struct {
+		name     string
+		patterns []string
+		path     string
+		want     bool
+	}{
+		{name: "exact name", patterns: []string{"node_modules"}, path: filepath.Join(root, "web", "node_modules"), want: true},
+		{name: "name glob", patterns: []string{"tmp-*"}, path: filepath.Join(root, "a", "tmp-old"), want: true},
+		{name: "hidden directories", patterns: []string{".*"}, path: filepath.Join(root, ".cache"), want: true},
+		{name: "relative path glob", patterns: []string{"archive/*"}, path: filepath.Join(root, "archive", "2019"), want: true},
+		{name: "relative path glob is anchored", patterns: []string{"archive/*"}, path: filepath.Join(root, "x", "archive", "2019"), want: false},
+		{name: "no match", patterns: []string{"vendor", "tmp-*"}, path: filepath.Join(root, "api"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchExclude(tt.patterns, root, tt.path); got != tt.want {
+				t.Errorf("matchExclude(%v, %s) = %v, want %v", tt.patterns, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapReposInvalidExclude(t *testing.T) {
+	err := MapReposWithOptions(context.Background(), []string{t.TempDir()}, MapOptions{DryRun: true, Exclude: []string{"[a-"}})
+	if err == nil {
+		t.Error("MapReposWithOptions() accepted an invalid exclude pattern")
+	}
+}
@@ -0,0 +1,279 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/inovacc/clonr/internal/client/grpc"
+)
+
+// mapWatchDebounce is how long a new repository must stay unchanged before
+// it is registered, so a clone in progress has written its remote
+const mapWatchDebounce = 2 * time.Second
+
+// WatchRepos maps dirs, then watches them and registers the repositories
+// cloned or moved into them until ctx is cancelled. Without dirs the
+// default clone directory is watched. The exclusions, depth limit and
+// workspace of opts apply to the new repositories too.
+func WatchRepos(ctx context.Context, dirs []string, opts MapOptions) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if len(dirs) == 0 {
+		cfg, err := client.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		if cfg.DefaultCloneDir == "" {
+			return errors.New("no directory given and no default clone directory configured")
+		}
+
+		dirs = []string{cfg.DefaultCloneDir}
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch directories: %w", err)
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	w := &repoWatcher{
+		watcher: watcher,
+		client:  client,
+		opts:    opts,
+		pending: make(map[string]time.Time),
+	}
+
+	for _, dir := range dirs {
+		if err := MapReposWithOptions(ctx, []string{dir}, opts); err != nil {
+			return err
+		}
+
+		root, err := filepath.Abs(dir)
+		if err != nil {
+			return fmt.Errorf("failed to resolve path: %w", err)
+		}
+
+		w.roots = append(w.roots, root)
+		w.addTree(root, root, false)
+	}
+
+	log.Printf("Watching %s for new repositories (Ctrl+C to stop)\n", strings.Join(w.roots, ", "))
+
+	return w.run(ctx)
+}
+
+// repoWatcher registers the repositories appearing under its roots
+type repoWatcher struct {
+	watcher *fsnotify.Watcher
+	client  *grpc.Client
+	opts    MapOptions
+	roots   []string
+
+	// pending holds the repositories to register, by path, with the time
+	// they last changed
+	pending map[string]time.Time
+}
+
+// run handles file system events until ctx is cancelled
+func (w *repoWatcher) run(ctx context.Context) error {
+	ticker := time.NewTicker(mapWatchDebounce / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			w.handle(event)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			log.Printf("Watch error: %v\n", err)
+		case <-ticker.C:
+			w.flush()
+		}
+	}
+}
+
+// handle queues the repository an event reveals, or watches a new directory
+func (w *repoWatcher) handle(event fsnotify.Event) {
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+		return
+	}
+
+	root := w.rootOf(event.Name)
+	if root == "" {
+		return
+	}
+
+	name, parent := filepath.Base(event.Name), filepath.Dir(event.Name)
+
+	switch {
+	case name == ".git":
+		// Nothing inside a repository needs watching
+		_ = w.watcher.Remove(parent)
+		w.queue(root, parent)
+	case name == "config" && filepath.Base(parent) == ".git":
+		// The remote of a repository registered without one was set
+		w.queue(root, filepath.Dir(parent))
+	case event.Has(fsnotify.Create) && !insideRepo(root, parent):
+		if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+			w.addTree(root, event.Name, true)
+		}
+	}
+}
+
+// addTree watches dir and the directories under it that are neither
+// excluded, too deep nor inside a repository. With queue set the
+// repositories met are registered; the initial scan covered the others.
+func (w *repoWatcher) addTree(root, dir string, queue bool) {
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+
+		if w.skipped(root, path) {
+			return fs.SkipDir
+		}
+
+		if isRepoDir(path) {
+			if queue {
+				w.queue(root, path)
+			}
+
+			return fs.SkipDir
+		}
+
+		if err := w.watcher.Add(path); err != nil && w.opts.Verbose {
+			log.Printf("Cannot watch %s: %v\n", path, err)
+		}
+
+		return nil
+	})
+}
+
+// queue schedules the repository at path for registration, unless it is
+// excluded or too deep
+func (w *repoWatcher) queue(root, path string) {
+	if w.skipped(root, path) || w.skipped(root, filepath.Join(path, ".git")) {
+		return
+	}
+
+	w.pending[path] = time.Now()
+}
+
+// flush registers the pending repositories that stopped changing
+func (w *repoWatcher) flush() {
+	var due []string
+
+	for path, changed := range w.pending {
+		if time.Since(changed) >= mapWatchDebounce {
+			due = append(due, path)
+		}
+	}
+
+	if len(due) == 0 {
+		return
+	}
+
+	// Reload the tracked repositories so moves are reconciled
+	tracked, err := w.client.GetAllRepos()
+	if err != nil {
+		log.Printf("Failed to get repositories: %v\n", err)
+		return
+	}
+
+	for _, path := range due {
+		delete(w.pending, path)
+
+		gitDir := filepath.Join(path, ".git")
+		if !isRepoDir(path) {
+			continue
+		}
+
+		result := &MapResult{}
+		mapFoundRepo(w.client, tracked, gitDir, w.opts, result)
+
+		// A repository without a usable remote yet is registered once
+		// its config changes
+		if result.TotalErrors > 0 {
+			if err := w.watcher.Add(gitDir); err == nil && w.opts.Verbose {
+				log.Printf("Waiting for a remote in %s\n", path)
+			}
+		} else {
+			_ = w.watcher.Remove(gitDir)
+		}
+	}
+}
+
+// skipped reports whether path under root is excluded, ignored or beyond
+// the depth limit
+func (w *repoWatcher) skipped(root, path string) bool {
+	if path == root {
+		return false
+	}
+
+	if w.opts.MaxDepth > 0 {
+		depth := strings.Count(path, string(os.PathSeparator)) - strings.Count(root, string(os.PathSeparator))
+		if depth > w.opts.MaxDepth {
+			return true
+		}
+	}
+
+	if filepath.Base(path) == ".git" {
+		return false
+	}
+
+	return matchExclude(w.opts.Exclude, root, path) || IgnoredPath(w.opts.Ignore, path) != ""
+}
+
+// rootOf returns the watched root containing path, or "" when none does
+func (w *repoWatcher) rootOf(path string) string {
+	var root string
+
+	for _, r := range w.roots {
+		if (path == r || strings.HasPrefix(path, r+string(os.PathSeparator))) && len(r) > len(root) {
+			root = r
+		}
+	}
+
+	return root
+}
+
+// isRepoDir reports whether dir holds a .git directory
+func isRepoDir(dir string) bool {
+	info, err := os.Stat(filepath.Join(dir, ".git"))
+
+	return err == nil && info.IsDir()
+}
+
+// insideRepo reports whether dir is a repository or lies inside one below
+// root
+func insideRepo(root, dir string) bool {
+	for ; dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if isRepoDir(dir) {
+			return true
+		}
+	}
+
+	return false
+}
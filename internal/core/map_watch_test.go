@@ -0,0 +1,80 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestRepoWatcherQueuesNewRepos(t *testing.T) {
+	root := t.TempDir()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	w := &repoWatcher{
+		watcher: watcher,
+		opts:    MapOptions{MaxDepth: 3, Exclude: []string{"tmp-*"}},
+		roots:   []string{root},
+		pending: make(map[string]time.Time),
+	}
+
+	mkdir := func(parts ...string) string {
+		t.Helper()
+
+		dir := filepath.Join(append([]string{root}, parts...)...)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		return dir
+	}
+
+	existing := mkdir("existing", ".git")
+	w.addTree(root, root, false)
+
+	if len(w.pending) != 0 {
+		t.Fatalf("pending = %v after the initial scan, want none", w.pending)
+	}
+
+	// A clone creates its directory, then .git inside it
+	api := mkdir("acme", "api")
+	w.handle(fsnotify.Event{Name: filepath.Join(root, "acme"), Op: fsnotify.Create})
+	mkdir("acme", "api", ".git")
+	w.handle(fsnotify.Event{Name: filepath.Join(api, ".git"), Op: fsnotify.Create})
+
+	// A repository moved in arrives whole
+	moved := mkdir("moved")
+	mkdir("moved", ".git")
+	w.handle(fsnotify.Event{Name: moved, Op: fsnotify.Create})
+
+	// Excluded, too deep, inside a repository or outside the roots
+	mkdir("tmp-scratch", ".git")
+	w.handle(fsnotify.Event{Name: filepath.Join(root, "tmp-scratch"), Op: fsnotify.Create})
+	mkdir("a", "b", "c", ".git")
+	w.handle(fsnotify.Event{Name: filepath.Join(root, "a"), Op: fsnotify.Create})
+	mkdir("existing", "vendor", "lib", ".git")
+	w.handle(fsnotify.Event{Name: filepath.Join(root, "existing", "vendor"), Op: fsnotify.Create})
+	w.handle(fsnotify.Event{Name: filepath.Join(t.TempDir(), ".git"), Op: fsnotify.Create})
+
+	if len(w.pending) != 2 {
+		t.Errorf("pending = %v, want %s and %s", w.pending, api, moved)
+	}
+
+	for _, path := range []string{api, moved} {
+		if _, ok := w.pending[path]; !ok {
+			t.Errorf("%s was not queued", path)
+		}
+	}
+
+	if _, ok := w.pending[filepath.Dir(existing)]; ok {
+		t.Error("the repository found by the initial scan was queued")
+	}
+}
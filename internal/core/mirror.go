@@ -17,6 +17,7 @@ import (
 
 	"github.com/google/go-github/v82/github"
 	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
 )
 
 // RateLimitConfig contains settings for GitHub API rate limiting
@@ -374,7 +375,7 @@ func (w *GitHubClientWrapper) fetchReposWithRetry(ctx context.Context, name stri
 }
 
 // PrepareMirror fetches repos from GitHub and determines actions
-func PrepareMirror(orgName, token string, opts MirrorOptions) (*MirrorPlan, error) {
+func PrepareMirror(ctx context.Context, orgName, token string, opts MirrorOptions) (*MirrorPlan, error) {
 	logger := opts.Logger
 	if logger == nil {
 		logger = slog.Default()
@@ -395,9 +396,15 @@ func PrepareMirror(orgName, token string, opts MirrorOptions) (*MirrorPlan, erro
 	clientWrapper := NewGitHubClientWrapper(token, rateCfg, logger)
 
 	// Fetch all repositories (tries org first, then user)
-	ctx := context.Background()
+	var (
+		repos  []*github.Repository
+		isUser bool
+	)
 
-	repos, isUser, err := clientWrapper.fetchReposWithRetry(ctx, orgName)
+	err := Jobs().Do(ctx, JobAPI, func() (err error) {
+		repos, isUser, err = clientWrapper.fetchReposWithRetry(ctx, orgName)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch repositories: %w", err)
 	}
@@ -439,6 +446,11 @@ func PrepareMirror(orgName, token string, opts MirrorOptions) (*MirrorPlan, erro
 		path := filepath.Join(baseDir, repo.GetName())
 		action, reason, skipReason := determineAction(repo, path, logger)
 
+		// Repositories on the ignore list are never cloned nor updated
+		if rule := IgnoredRepo(cfg.Ignore, model.Repository{URL: repo.GetCloneURL(), Path: path}); rule != "" {
+			action, reason, skipReason = "skip", "on the ignore list: "+rule, SkipReasonIgnored
+		}
+
 		mirrorRepos[i] = MirrorRepo{
 			Name:       repo.GetName(),
 			URL:        repo.GetCloneURL(),
@@ -602,8 +614,9 @@ func resetRepo(path string) error {
 	return nil
 }
 
-// MirrorCloneRepo clones a single repository for mirroring
-func MirrorCloneRepo(repoURL, path string, shallow bool) error {
+// MirrorCloneRepo clones a single repository for mirroring. A clone
+// cancelled through ctx is removed so the next run clones it again.
+func MirrorCloneRepo(ctx context.Context, repoURL, path string, shallow bool) error {
 	// Ensure parent directory exists
 	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
@@ -616,9 +629,14 @@ func MirrorCloneRepo(repoURL, path string, shallow bool) error {
 
 	args = append(args, repoURL, path)
 
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 
 	output, err := cmd.CombinedOutput()
+	if err != nil && ctx.Err() != nil {
+		_ = os.RemoveAll(path)
+		return context.Cause(ctx)
+	}
+
 	if err != nil {
 		return fmt.Errorf("git clone failed: %v - %s", err, string(output))
 	}
@@ -627,7 +645,20 @@ func MirrorCloneRepo(repoURL, path string, shallow bool) error {
 }
 
 // MirrorUpdateRepo pulls the latest changes for mirroring with dirty repo strategy support
-func MirrorUpdateRepo(repoURL, path string, strategy DirtyRepoStrategy, logger *slog.Logger) error {
+func MirrorUpdateRepo(ctx context.Context, repoURL, path string, strategy DirtyRepoStrategy, logger *slog.Logger) error {
+	err := mirrorUpdateRepo(ctx, repoURL, path, strategy, logger)
+	if ctx.Err() != nil {
+		// A cancelled pull is not a failure of the repository
+		return context.Cause(ctx)
+	}
+
+	RecordUpdateResult(repoURL, err)
+
+	return err
+}
+
+// mirrorUpdateRepo performs the pull for MirrorUpdateRepo
+func mirrorUpdateRepo(ctx context.Context, repoURL, path string, strategy DirtyRepoStrategy, logger *slog.Logger) error {
 	// Check for uncommitted changes
 	if isRepoDirty(path) {
 		switch strategy {
@@ -660,13 +691,23 @@ func MirrorUpdateRepo(repoURL, path string, strategy DirtyRepoStrategy, logger *
 		}
 	}
 
-	cmd := exec.Command("git", "-C", path, "pull", "--ff-only")
+	cmd := exec.CommandContext(ctx, "git", "-C", path, "pull", "--ff-only")
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("git pull failed: %v - %s", err, string(output))
 	}
 
+	// Keep the submodules already cloned at the commits the pull recorded
+	if HasSubmodules(path) {
+		if err := UpdateSubmodules(ctx, path, false); err != nil {
+			logger.Warn("failed to update submodules",
+				slog.String("path", path),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+
 	// Note: Stats gathering is handled by SaveMirroredRepo to avoid duplicate runs
 	return nil
 }
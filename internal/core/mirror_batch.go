@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"os"
@@ -13,20 +14,27 @@ import (
 type MirrorBatchOptions struct {
 	Plan   *MirrorPlan
 	Logger *slog.Logger
+
+	// Job records the progress, when the mirror is tracked
+	Job *TrackedJob
 }
 
 // MirrorBatchResult contains the results of a batch mirror operation
 type MirrorBatchResult struct {
-	Results  []MirrorResult
-	Cloned   int
-	Updated  int
-	Skipped  int
-	Failed   int
-	Duration time.Duration
+	Results     []MirrorResult
+	Cloned      int
+	Updated     int
+	Skipped     int
+	Failed      int
+	NotStarted  int // Repositories left alone because the run was cancelled
+	Interrupted bool
+	Duration    time.Duration
 }
 
-// ExecuteMirrorBatch runs the mirror operation without TUI
-func ExecuteMirrorBatch(opts MirrorBatchOptions) (*MirrorBatchResult, error) {
+// ExecuteMirrorBatch runs the mirror operation without TUI. When ctx is
+// cancelled, running clones and pulls are stopped and the repositories
+// processed so far are returned along with the cancellation cause.
+func ExecuteMirrorBatch(ctx context.Context, opts MirrorBatchOptions) (*MirrorBatchResult, error) {
 	plan := opts.Plan
 
 	logger := opts.Logger
@@ -100,9 +108,20 @@ func ExecuteMirrorBatch(opts MirrorBatchOptions) (*MirrorBatchResult, error) {
 	for i := 0; i < plan.Parallel; i++ {
 		wg.Go(func() {
 			for repo := range workQueue {
-				result := processRepoBatch(repo, plan, logger)
+				if ctx.Err() != nil {
+					continue
+				}
+
+				result := processRepoBatch(ctx, repo, plan, logger)
+				if ctx.Err() != nil && !result.Success {
+					// Stopped midway; reported as not started
+					continue
+				}
+
 				printProgress(repo.Name, repo.Action, result.Success, result.Error, result.RetryCount)
 
+				opts.Job.Step(repo.Name, result.Error)
+
 				resultsMu.Lock()
 
 				results = append(results, result)
@@ -124,18 +143,26 @@ func ExecuteMirrorBatch(opts MirrorBatchOptions) (*MirrorBatchResult, error) {
 
 	duration := time.Since(start)
 
-	return &MirrorBatchResult{
-		Results:  results,
-		Cloned:   int(cloned.Load()),
-		Updated:  int(updated.Load()),
-		Skipped:  int(skipped.Load()),
-		Failed:   int(failed.Load()),
-		Duration: duration,
-	}, nil
+	result := &MirrorBatchResult{
+		Results:     results,
+		Cloned:      int(cloned.Load()),
+		Updated:     int(updated.Load()),
+		Skipped:     int(skipped.Load()),
+		Failed:      int(failed.Load()),
+		NotStarted:  total - len(results),
+		Interrupted: ctx.Err() != nil,
+		Duration:    duration,
+	}
+
+	if result.Interrupted {
+		return result, context.Cause(ctx)
+	}
+
+	return result, nil
 }
 
 // processRepoBatch processes a single repo in batch mode
-func processRepoBatch(repo MirrorRepo, plan *MirrorPlan, logger *slog.Logger) MirrorResult {
+func processRepoBatch(ctx context.Context, repo MirrorRepo, plan *MirrorPlan, logger *slog.Logger) MirrorResult {
 	start := time.Now()
 
 	var err error
@@ -144,16 +171,20 @@ func processRepoBatch(repo MirrorRepo, plan *MirrorPlan, logger *slog.Logger) Mi
 
 	switch repo.Action {
 	case "clone":
-		err = executeWithNetworkRetryBatch(func() error {
-			return MirrorCloneRepo(repo.URL, repo.Path, plan.Shallow)
+		err = executeWithNetworkRetryBatch(ctx, func() error {
+			return Jobs().Do(ctx, JobGit, func() error {
+				return MirrorCloneRepo(ctx, repo.URL, repo.Path, plan.Shallow)
+			})
 		}, plan.NetworkRetries, &retryCount)
 		if err == nil {
 			err = SaveMirroredRepo(repo.URL, repo.Path)
 		}
 
 	case "update":
-		err = executeWithNetworkRetryBatch(func() error {
-			return MirrorUpdateRepo(repo.URL, repo.Path, plan.DirtyStrategy, logger)
+		err = executeWithNetworkRetryBatch(ctx, func() error {
+			return Jobs().Do(ctx, JobGit, func() error {
+				return MirrorUpdateRepo(ctx, repo.URL, repo.Path, plan.DirtyStrategy, logger)
+			})
 		}, plan.NetworkRetries, &retryCount)
 		if err == nil {
 			err = SaveMirroredRepo(repo.URL, repo.Path)
@@ -174,8 +205,9 @@ func processRepoBatch(repo MirrorRepo, plan *MirrorPlan, logger *slog.Logger) Mi
 	}
 }
 
-// executeWithNetworkRetryBatch wraps an operation with network retry logic
-func executeWithNetworkRetryBatch(op func() error, maxRetries int, retryCount *int) error {
+// executeWithNetworkRetryBatch wraps an operation with network retry logic.
+// Retries stop when ctx is cancelled.
+func executeWithNetworkRetryBatch(ctx context.Context, op func() error, maxRetries int, retryCount *int) error {
 	if maxRetries == 0 {
 		maxRetries = 3
 	}
@@ -189,7 +221,7 @@ func executeWithNetworkRetryBatch(op func() error, maxRetries int, retryCount *i
 		}
 
 		// Check if it's a network error
-		if !IsNetworkError(err) {
+		if !IsNetworkError(err) || ctx.Err() != nil {
 			return err // Non-retryable error
 		}
 
@@ -199,7 +231,11 @@ func executeWithNetworkRetryBatch(op func() error, maxRetries int, retryCount *i
 		// Exponential backoff: 1s, 2s, 4s...
 		backoff := min(time.Duration(1<<attempt)*time.Second, 30*time.Second)
 
-		time.Sleep(backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 	}
 
 	return &NetworkError{
@@ -213,12 +249,22 @@ func executeWithNetworkRetryBatch(op func() error, maxRetries int, retryCount *i
 func PrintBatchSummary(result *MirrorBatchResult) {
 	_, _ = fmt.Fprintln(os.Stdout)
 	_, _ = fmt.Fprintln(os.Stdout, "═══════════════════════════════════════════════════════════")
-	_, _ = fmt.Fprintln(os.Stdout, "                    Mirror Complete")
+
+	if result.Interrupted {
+		_, _ = fmt.Fprintln(os.Stdout, "                    Mirror Interrupted")
+	} else {
+		_, _ = fmt.Fprintln(os.Stdout, "                    Mirror Complete")
+	}
+
 	_, _ = fmt.Fprintln(os.Stdout, "═══════════════════════════════════════════════════════════")
 	_, _ = fmt.Fprintf(os.Stdout, "  Cloned:   %d\n", result.Cloned)
 	_, _ = fmt.Fprintf(os.Stdout, "  Updated:  %d\n", result.Updated)
 	_, _ = fmt.Fprintf(os.Stdout, "  Skipped:  %d\n", result.Skipped)
 	_, _ = fmt.Fprintf(os.Stdout, "  Failed:   %d\n", result.Failed)
+
+	if result.NotStarted > 0 {
+		_, _ = fmt.Fprintf(os.Stdout, "  Stopped:  %d (not mirrored)\n", result.NotStarted)
+	}
 	_, _ = fmt.Fprintln(os.Stdout, "───────────────────────────────────────────────────────────")
 	_, _ = fmt.Fprintf(os.Stdout, "  Total:    %d repositories in %s\n",
 		result.Cloned+result.Updated+result.Skipped+result.Failed,
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errTestStopped = errors.New("stopped")
+
+func TestExecuteWithNetworkRetryBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	calls := 0
+	op := func() error {
+		calls++
+		cancel(errTestStopped)
+
+		return errors.New("connection reset by peer")
+	}
+
+	retries := 0
+	start := time.Now()
+
+	err := executeWithNetworkRetryBatch(ctx, op, 3, &retries)
+	if err == nil {
+		t.Fatal("executeWithNetworkRetryBatch() succeeded after cancellation")
+	}
+
+	if calls != 1 {
+		t.Errorf("op called %d times, want 1", calls)
+	}
+
+	if time.Since(start) > time.Second {
+		t.Errorf("executeWithNetworkRetryBatch() waited %v for a backoff after cancellation", time.Since(start))
+	}
+}
+
+func TestExecuteMirrorBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(errTestStopped)
+
+	plan := &MirrorPlan{
+		Parallel: 2,
+		Repos: []MirrorRepo{
+			{Name: "api", Action: "skip"},
+			{Name: "web", Action: "skip"},
+			{Name: "cli", Action: "skip"},
+		},
+	}
+
+	result, err := ExecuteMirrorBatch(ctx, MirrorBatchOptions{Plan: plan})
+	if !errors.Is(err, errTestStopped) {
+		t.Fatalf("ExecuteMirrorBatch() error = %v, want %v", err, errTestStopped)
+	}
+
+	if !result.Interrupted || result.NotStarted != 3 || len(result.Results) != 0 {
+		t.Errorf("ExecuteMirrorBatch() = %+v, want 3 repositories not started", result)
+	}
+}
+
+func TestExecuteMirrorBatch(t *testing.T) {
+	plan := &MirrorPlan{
+		Parallel: 2,
+		Repos: []MirrorRepo{
+			{Name: "api", Action: "skip"},
+			{Name: "web", Action: "skip"},
+		},
+	}
+
+	result, err := ExecuteMirrorBatch(context.Background(), MirrorBatchOptions{Plan: plan})
+	if err != nil {
+		t.Fatalf("ExecuteMirrorBatch() error = %v", err)
+	}
+
+	if result.Interrupted || result.NotStarted != 0 || result.Skipped != 2 {
+		t.Errorf("ExecuteMirrorBatch() = %+v", result)
+	}
+}
@@ -0,0 +1,269 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// MoveResult contains the result of moving a repository on disk
+type MoveResult struct {
+	URL     string `json:"url"`
+	OldPath string `json:"old_path"`
+	NewPath string `json:"new_path"`
+}
+
+// matchRepo finds the repository matching query, trying exact URL, path,
+// alias, equivalent URL, owner/repo suffix and finally the bare repository name.
+func matchRepo(repos []model.Repository, query string) (*model.Repository, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("repository is required")
+	}
+
+	absQuery, _ := filepath.Abs(query)
+
+	for i := range repos {
+		if repos[i].URL == query || repos[i].Path == query || repos[i].Path == absQuery ||
+			(repos[i].Alias != "" && strings.EqualFold(repos[i].Alias, query)) {
+			return &repos[i], nil
+		}
+	}
+
+	matchers := []func(model.Repository) bool{
+		func(r model.Repository) bool {
+			return strings.Contains(query, "/") && SameRepoURL(r.URL, query)
+		},
+		func(r model.Repository) bool {
+			return strings.Contains(query, "/") &&
+				strings.HasSuffix(strings.ToLower(repoSlug(r.URL)), "/"+strings.ToLower(strings.Trim(query, "/")))
+		},
+		func(r model.Repository) bool {
+			return strings.EqualFold(filepath.Base(repoSlug(r.URL)), query)
+		},
+	}
+
+	for _, match := range matchers {
+		var found []*model.Repository
+
+		for i := range repos {
+			if match(repos[i]) {
+				found = append(found, &repos[i])
+			}
+		}
+
+		switch len(found) {
+		case 0:
+			continue
+		case 1:
+			return found[0], nil
+		default:
+			urls := make([]string, len(found))
+			for i, r := range found {
+				urls[i] = r.URL
+			}
+
+			return nil, fmt.Errorf("%q matches multiple repositories:\n  %s", query, strings.Join(urls, "\n  "))
+		}
+	}
+
+	return nil, fmt.Errorf("repository not found: %s", query)
+}
+
+// MatchRepos resolves every query like matchRepo, dropping duplicates
+func MatchRepos(repos []model.Repository, queries []string) ([]model.Repository, error) {
+	var (
+		matched []model.Repository
+		seen    = make(map[string]bool)
+	)
+
+	for _, query := range queries {
+		repo, err := matchRepo(repos, query)
+		if err != nil {
+			return nil, err
+		}
+
+		if !seen[repo.URL] {
+			seen[repo.URL] = true
+			matched = append(matched, *repo)
+		}
+	}
+
+	return matched, nil
+}
+
+// repoSlug returns host/owner/repo for a repository URL without the .git suffix
+func repoSlug(urlStr string) string {
+	u, err := gitHubURL(urlStr)
+	if err != nil {
+		return strings.TrimSuffix(urlStr, ".git")
+	}
+
+	return u.Host + strings.TrimSuffix(u.Path, ".git")
+}
+
+// MoveRepo moves a tracked repository to a new location on disk and updates
+// its stored path. If the database update fails, the move is rolled back.
+func MoveRepo(query, newPath string) (*MoveResult, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	repo, err := matchRepo(repos, query)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := filepath.Abs(newPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path: %w", err)
+	}
+
+	// Moving into an existing directory keeps the repository's directory name
+	if info, err := os.Stat(target); err == nil {
+		if !info.IsDir() {
+			return nil, fmt.Errorf("target exists and is not a directory: %s", target)
+		}
+
+		target = filepath.Join(target, filepath.Base(repo.Path))
+	}
+
+	if target == repo.Path {
+		return nil, fmt.Errorf("repository is already at %s", target)
+	}
+
+	if _, err := os.Stat(target); err == nil {
+		return nil, fmt.Errorf("target already exists: %s", target)
+	}
+
+	if rel, err := filepath.Rel(repo.Path, target); err == nil && !strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("cannot move a repository into itself: %s", target)
+	}
+
+	if isPathTracked(repos, target) {
+		return nil, fmt.Errorf("path already tracked by another repository: %s", target)
+	}
+
+	if _, err := os.Stat(repo.Path); err != nil {
+		return nil, fmt.Errorf("repository directory not found: %s\n\nRun 'clonr doctor --fix' to clean up stale entries", repo.Path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error creating directory %s: %w", filepath.Dir(target), err)
+	}
+
+	if err := moveDir(repo.Path, target); err != nil {
+		return nil, fmt.Errorf("failed to move repository: %w", err)
+	}
+
+	if err := client.UpdateRepoPath(repo.URL, target); err != nil {
+		if rbErr := moveDir(target, repo.Path); rbErr != nil {
+			return nil, fmt.Errorf("failed to update database: %w (rollback failed, repository left at %s: %v)", err, target, rbErr)
+		}
+
+		return nil, fmt.Errorf("failed to update database, move rolled back: %w", err)
+	}
+
+	return &MoveResult{
+		URL:     repo.URL,
+		OldPath: repo.Path,
+		NewPath: target,
+	}, nil
+}
+
+// renameDir renames a directory; replaced in tests
+var renameDir = os.Rename
+
+// moveDir moves the directory src to dst. A rename cannot cross file
+// systems, so across devices the tree is copied and src removed afterwards;
+// a failed copy removes the partial copy and leaves src untouched.
+func moveDir(src, dst string) error {
+	err := renameDir(src, dst)
+	if err == nil || !errors.Is(err, syscall.EXDEV) {
+		return err
+	}
+
+	if err := copyTree(src, dst); err != nil {
+		_ = os.RemoveAll(dst)
+		return fmt.Errorf("failed to copy %s to another device: %w", src, err)
+	}
+
+	if err := os.RemoveAll(src); err != nil {
+		return fmt.Errorf("copied to %s but failed to remove %s: %w", dst, src, err)
+	}
+
+	return nil
+}
+
+// copyTree copies the directory src to dst, keeping file modes and symlinks
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case d.Type()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+
+			return os.Symlink(link, target)
+		case d.Type().IsRegular():
+			return copyFileMode(path, target, info.Mode().Perm())
+		}
+
+		// Sockets, pipes and devices have no place in a repository
+		return nil
+	})
+}
+
+// copyFileMode copies the regular file src to dst with the given mode
+func copyFileMode(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	return out.Close()
+}
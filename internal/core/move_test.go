@@ -0,0 +1,95 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestMatchRepo(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/inovacc/clonr", Path: "/src/clonr"},
+		{URL: "https://github.com/inovacc/tools", Path: "/src/tools"},
+		{URL: "https://gitlab.com/other/tools", Path: "/src/other-tools", Alias: "gl-tools"},
+	}
+
+	tests := []struct {
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{query: "https://github.com/inovacc/clonr", want: "https://github.com/inovacc/clonr"},
+		{query: "git@github.com:inovacc/clonr.git", want: "https://github.com/inovacc/clonr"},
+		{query: "/src/tools", want: "https://github.com/inovacc/tools"},
+		{query: "inovacc/tools", want: "https://github.com/inovacc/tools"},
+		{query: "clonr", want: "https://github.com/inovacc/clonr"},
+		{query: "GL-Tools", want: "https://gitlab.com/other/tools"},
+		{query: "tools", wantErr: true},
+		{query: "missing", wantErr: true},
+		{query: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := matchRepo(repos, tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchRepo(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+
+			if err == nil && got.URL != tt.want {
+				t.Errorf("matchRepo(%q) = %q, want %q", tt.query, got.URL, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveDirAcrossDevices(t *testing.T) {
+	old := renameDir
+	renameDir = func(oldpath, newpath string) error {
+		return &os.LinkError{Op: "rename", Old: oldpath, New: newpath, Err: syscall.EXDEV}
+	}
+
+	t.Cleanup(func() { renameDir = old })
+
+	src := filepath.Join(t.TempDir(), "repo")
+	dst := filepath.Join(t.TempDir(), "repo")
+
+	if err := os.MkdirAll(filepath.Join(src, ".git", "hooks"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(src, "README.md"), []byte("# repo\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(src, ".git", "hooks", "pre-commit"), []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink("README.md", filepath.Join(src, "LINK.md")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	if err := moveDir(src, dst); err != nil {
+		t.Fatalf("moveDir() error = %v", err)
+	}
+
+	if _, err := os.Stat(src); !os.IsNotExist(err) {
+		t.Errorf("source still exists after moveDir(): %v", err)
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dst, "README.md")); err != nil || string(data) != "# repo\n" {
+		t.Errorf("README.md = %q, %v", data, err)
+	}
+
+	if info, err := os.Stat(filepath.Join(dst, ".git", "hooks", "pre-commit")); err != nil || info.Mode().Perm()&0o100 == 0 {
+		t.Errorf("pre-commit hook lost its executable bit: %v, %v", info, err)
+	}
+
+	if link, err := os.Readlink(filepath.Join(dst, "LINK.md")); err != nil || link != "README.md" {
+		t.Errorf("LINK.md = %q, %v, want a symlink to README.md", link, err)
+	}
+}
@@ -0,0 +1,368 @@
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/store"
+)
+
+// GlobalSlackChannelID identifies the Slack webhook configured with
+// 'clonr slack notify' in notification routes
+const GlobalSlackChannelID = "slack-notify"
+
+// NewNotifier returns a router delivering events to every enabled
+// notification channel: the channels of the active profile and the Slack
+// webhook of 'clonr slack notify', routed by the notify routes of the
+// config. Channels that cannot be loaded are skipped with a log message.
+func NewNotifier() (*notify.Router, error) {
+	cfg, err := store.GetDB().GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	routes := make([]notify.Route, 0, len(cfg.NotifyRoutes))
+	for _, r := range cfg.NotifyRoutes {
+		routes = append(routes, notify.Route{Event: r.Event, Channels: r.Channels})
+	}
+
+	router := notify.NewRouter(routes)
+
+	manager, err := NewSlackManager()
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := manager.GetSender()
+	if err != nil {
+		log.Printf("notify: skipping the Slack webhook: %v", err)
+	} else if sender != nil {
+		router.Add(notify.Channel{ID: GlobalSlackChannelID, Type: string(model.ChannelSlack), Sender: sender})
+	}
+
+	pm, err := NewProfileManager()
+	if err != nil {
+		return router, nil //nolint:nilerr // Profile channels need the server; the global channel still works
+	}
+
+	profile, err := pm.GetActiveProfile()
+	if err != nil {
+		return router, nil //nolint:nilerr // No active profile, no profile channels
+	}
+
+	for i := range profile.NotifyChannels {
+		ch := &profile.NotifyChannels[i]
+		if !ch.Enabled {
+			continue
+		}
+
+		sender, err := channelSender(pm, profile.Name, ch)
+		if err != nil {
+			log.Printf("notify: skipping channel %s: %v", ch.ID, err)
+			continue
+		}
+
+		if sender == nil {
+			continue
+		}
+
+		var events []string
+		for _, e := range ch.Events {
+			events = append(events, e.Event)
+		}
+
+		router.Add(notify.Channel{ID: ch.ID, Type: string(ch.Type), Sender: sender, Events: events})
+	}
+
+	return router, nil
+}
+
+// channelSender returns the sender of a profile notification channel, or
+// nil for channel types that cannot send notifications yet
+func channelSender(pm *ProfileManager, profileName string, ch *model.NotifyChannel) (notify.Sender, error) {
+	config, err := pm.DecryptChannelConfig(profileName, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ch.Type {
+	case model.ChannelSlack:
+		eventConfigs := make(map[string]notify.SlackEventConfig)
+		for _, e := range ch.Events {
+			eventConfigs[e.Event] = notify.SlackEventConfig{
+				Enabled:  true,
+				Channel:  e.Target,
+				Priority: e.Priority,
+				Filters:  e.Filters,
+			}
+		}
+
+		opts := []notify.SlackOption{
+			notify.WithDefaultChannel(config["default_channel"]),
+			notify.WithEventConfigs(eventConfigs),
+		}
+
+		switch {
+		case config["webhook_url"] != "":
+			opts = append(opts, notify.WithWebhook(config["webhook_url"]))
+		case config["bot_token"] != "":
+			opts = append(opts, notify.WithBotToken(config["bot_token"]))
+		default:
+			return nil, fmt.Errorf("no webhook URL or bot token configured")
+		}
+
+		return notify.NewSlackSender(opts...), nil
+
+	case model.ChannelGmail:
+		if config["access_token"] == "" {
+			return nil, fmt.Errorf("no access token configured")
+		}
+
+		client := gmail.NewClient(config["access_token"], gmail.ClientOptions{
+			RefreshToken: config["refresh_token"],
+			ClientID:     config["client_id"],
+			ClientSecret: config["client_secret"],
+			OnTokenRefresh: func(token *gmail.OAuthResult) {
+				err := pm.UpdateNotifyChannelConfig(profileName, ch.ID, map[string]string{
+					"access_token":  token.AccessToken,
+					"refresh_token": token.RefreshToken,
+				})
+				if err != nil {
+					log.Printf("notify: failed to save refreshed Gmail token: %v", err)
+				}
+			},
+		})
+
+		// Notifications go to the connected mailbox unless notify_to is set
+		to := cmp.Or(config["notify_to"], config["email"])
+
+		return notify.NewEmailSender(ch.ID, to, client.SendMessage), nil
+
+	case model.ChannelDiscord:
+		if config["webhook_url"] == "" {
+			return nil, fmt.Errorf("no webhook URL configured")
+		}
+
+		return notify.NewDiscordSender(config["webhook_url"], config["username"]), nil
+
+	case model.ChannelWebhook:
+		if config["webhook_url"] == "" {
+			return nil, fmt.Errorf("no webhook URL configured")
+		}
+
+		headers, err := notify.ParseWebhookHeaders(strings.Split(config["headers"], "\n"))
+		if err != nil {
+			return nil, err
+		}
+
+		return notify.NewWebhookSender(ch.ID, config["webhook_url"], config["method"], headers, config["hmac_secret"]), nil
+	}
+
+	return nil, nil
+}
+
+// liveNotifier is the sender of the global dispatcher. It loads the
+// notification channels for every event, so channels added or changed
+// while a server runs are used without a restart.
+type liveNotifier struct{}
+
+// Name returns the sender name.
+func (liveNotifier) Name() string {
+	return "notifier"
+}
+
+// Send sends the event to the routed notification channels.
+func (liveNotifier) Send(ctx context.Context, event *notify.Event) error {
+	router, err := NewNotifier()
+	if err != nil {
+		return err
+	}
+
+	return router.Send(ctx, event)
+}
+
+// Test sends a test notification to every notification channel.
+func (liveNotifier) Test(ctx context.Context) error {
+	router, err := NewNotifier()
+	if err != nil {
+		return err
+	}
+
+	return router.Test(ctx)
+}
+
+var (
+	notificationsOnce sync.Once
+
+	// notifications counts Notify calls still gathering their event, which
+	// commands start in goroutines
+	notifications sync.WaitGroup
+)
+
+// notifying marks a Notify call as running until the returned func is called
+func notifying() func() {
+	notifications.Add(1)
+	return notifications.Done
+}
+
+// InitializeNotifications sets up the global notification dispatcher with
+// every notification channel. It is safe to call more than once.
+func InitializeNotifications() {
+	notificationsOnce.Do(func() {
+		notify.GetDispatcher().Register(liveNotifier{})
+	})
+}
+
+// sendEvent dispatches event through the global dispatcher
+func sendEvent(ctx context.Context, event *notify.Event) {
+	InitializeNotifications()
+	notify.Send(ctx, event)
+}
+
+// FlushNotifications waits up to timeout for notifications still being
+// sent, so a command exiting right after an event does not drop it
+func FlushNotifications(timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	done := make(chan struct{})
+
+	go func() {
+		notifications.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		return
+	}
+
+	notify.GetDispatcher().Wait(time.Until(deadline))
+}
+
+// ListNotifyRoutes returns the notification routes of the config.
+func ListNotifyRoutes() ([]model.NotifyRoute, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	return cfg.NotifyRoutes, nil
+}
+
+// SetNotifyRoute routes events of the given type, or of every type with
+// notify.RouteAll, to the listed channel IDs or types only. No channels
+// mutes the event.
+func SetNotifyRoute(event string, channels []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	route := model.NotifyRoute{Event: event, Channels: channels}
+
+	i := slices.IndexFunc(cfg.NotifyRoutes, func(r model.NotifyRoute) bool { return r.Event == event })
+	if i >= 0 {
+		cfg.NotifyRoutes[i] = route
+	} else {
+		cfg.NotifyRoutes = append(cfg.NotifyRoutes, route)
+	}
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveNotifyRoute removes the route of an event type, so its events go to
+// every channel again. It reports whether a route was removed.
+func RemoveNotifyRoute(event string) (bool, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	n := len(cfg.NotifyRoutes)
+	cfg.NotifyRoutes = slices.DeleteFunc(cfg.NotifyRoutes, func(r model.NotifyRoute) bool { return r.Event == event })
+
+	if len(cfg.NotifyRoutes) == n {
+		return false, nil
+	}
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return false, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return true, nil
+}
+
+// FormatNotifyRoutes writes routes as "event=channel,channel; event=...",
+// the form ParseNotifyRoutes reads. A muted event has no channels.
+func FormatNotifyRoutes(routes []model.NotifyRoute) string {
+	parts := make([]string, len(routes))
+	for i, r := range routes {
+		parts[i] = r.Event + "=" + strings.Join(r.Channels, ",")
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// ParseNotifyRoutes reads routes written by FormatNotifyRoutes
+func ParseNotifyRoutes(s string) ([]model.NotifyRoute, error) {
+	var routes []model.NotifyRoute
+
+	for part := range strings.SplitSeq(s, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		event, list, ok := strings.Cut(part, "=")
+		event = strings.TrimSpace(event)
+
+		if !ok || event == "" || strings.ContainsAny(event, " ,") {
+			return nil, fmt.Errorf("route %q is not event=channel,...", part)
+		}
+
+		if slices.ContainsFunc(routes, func(r model.NotifyRoute) bool { return r.Event == event }) {
+			return nil, fmt.Errorf("event %s is routed twice", event)
+		}
+
+		route := model.NotifyRoute{Event: event}
+
+		for channel := range strings.SplitSeq(list, ",") {
+			if channel = strings.TrimSpace(channel); channel != "" {
+				route.Channels = append(route.Channels, channel)
+			}
+		}
+
+		routes = append(routes, route)
+	}
+
+	return routes, nil
+}
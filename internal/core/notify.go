@@ -7,10 +7,13 @@ import (
 
 	"github.com/inovacc/clonr/internal/git"
 	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/standalone"
 )
 
 // NotifyPush sends a notification for a push event.
 func NotifyPush(ctx context.Context, repoPath, remote, branch string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventPush)
 
 	// Get repository info
@@ -41,11 +44,13 @@ func NotifyPush(ctx context.Context, repoPath, remote, branch string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyClone sends a notification for a clone event.
 func NotifyClone(ctx context.Context, repoURL, targetPath string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventClone).
 		WithRepository(repoURL).
 		WithURL(repoURL).
@@ -56,11 +61,13 @@ func NotifyClone(ctx context.Context, repoURL, targetPath string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyPull sends a notification for a pull event.
 func NotifyPull(ctx context.Context, repoPath string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventPull)
 
 	// Get repository info
@@ -79,11 +86,13 @@ func NotifyPull(ctx context.Context, repoPath string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyCommit sends a notification for a commit event.
 func NotifyCommit(ctx context.Context, repoPath, sha, message string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventCommit).
 		WithCommit(sha, message)
 
@@ -102,11 +111,13 @@ func NotifyCommit(ctx context.Context, repoPath, sha, message string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyCIFail sends a notification for a CI failure event.
 func NotifyCIFail(ctx context.Context, repo, workflowURL, errorMsg string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventCIFail).
 		WithRepository(repo).
 		WithURL(workflowURL).
@@ -117,11 +128,13 @@ func NotifyCIFail(ctx context.Context, repo, workflowURL, errorMsg string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyCIPass sends a notification for a CI pass event.
 func NotifyCIPass(ctx context.Context, repo, workflowURL string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventCIPass).
 		WithRepository(repo).
 		WithURL(workflowURL)
@@ -131,11 +144,13 @@ func NotifyCIPass(ctx context.Context, repo, workflowURL string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
 }
 
 // NotifyError sends a notification for an error event.
 func NotifyError(ctx context.Context, repo, errorMsg string) {
+	defer notifying()()
+
 	event := notify.NewEvent(notify.EventError).
 		WithRepository(repo).
 		WithError(errorMsg)
@@ -145,7 +160,83 @@ func NotifyError(ctx context.Context, repo, errorMsg string) {
 		event.WithProfile(profile).WithWorkspace(workspace)
 	}
 
-	notify.Send(ctx, event)
+	sendEvent(ctx, event)
+}
+
+// NotifyUpdateAvailable sends a notification that a tracked repository has
+// new commits upstream that were not pulled.
+func NotifyUpdateAvailable(ctx context.Context, repoURL, branch, commitMessage string) {
+	defer notifying()()
+
+	event := notify.NewEvent(notify.EventUpdateAvailable).
+		WithRepository(repoURL).
+		WithURL(repoURL).
+		WithBranch(branch)
+
+	event.CommitMessage = commitMessage
+
+	// Get profile context
+	if profile, workspace := getCurrentProfileContext(); profile != "" {
+		event.WithProfile(profile).WithWorkspace(workspace)
+	}
+
+	sendEvent(ctx, event)
+}
+
+// NotifySyncFailure sends a notification for a repository that failed to update.
+func NotifySyncFailure(ctx context.Context, repoURL string, err error) {
+	defer notifying()()
+
+	event := notify.NewEvent(notify.EventSyncFail).
+		WithRepository(repoURL).
+		WithError(err.Error())
+
+	// Get profile context
+	if profile, workspace := getCurrentProfileContext(); profile != "" {
+		event.WithProfile(profile).WithWorkspace(workspace)
+	}
+
+	sendEvent(ctx, event)
+}
+
+// NotifyMonitorError sends a notification for a background monitor, such as
+// the GitHub Actions worker, that failed. repo may be empty.
+func NotifyMonitorError(ctx context.Context, monitor, repo string, err error) {
+	defer notifying()()
+
+	event := notify.NewEvent(notify.EventMonitorError).
+		WithRepository(repo).
+		WithError(err.Error()).
+		WithExtra("monitor", monitor)
+
+	sendEvent(ctx, event)
+}
+
+// NotifyClientRegistration sends a notification for a client asking to
+// register with the standalone server. client is the registered client when
+// the auto-approve policy approved it, or nil while it awaits approval.
+func NotifyClientRegistration(ctx context.Context, reg *standalone.ClientRegistration, client *standalone.RegisteredClient) {
+	defer notifying()()
+
+	id := reg.ClientID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+
+	status, fingerprint := "pending", reg.Fingerprint
+	if client != nil {
+		status, fingerprint = "approved", client.Fingerprint
+	}
+
+	event := notify.NewEvent(notify.EventClientRegistration).
+		WithExtra("client_id", id).
+		WithExtra("client_name", reg.ClientName).
+		WithExtra("hostname", reg.MachineInfo.Hostname).
+		WithExtra("platform", reg.MachineInfo.OS+"/"+reg.MachineInfo.Arch).
+		WithExtra("fingerprint", fingerprint).
+		WithExtra("status", status)
+
+	sendEvent(ctx, event)
 }
 
 // getRemoteURLFromPath gets the remote URL for a repository.
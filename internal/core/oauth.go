@@ -10,6 +10,7 @@ import (
 
 	"github.com/cli/oauth"
 	"github.com/google/go-github/v82/github"
+	"github.com/inovacc/clonr/internal/auth"
 )
 
 // OAuthConfig holds OAuth configuration
@@ -21,15 +22,24 @@ type OAuthConfig struct {
 
 // OAuthResult contains the result of an OAuth flow
 type OAuthResult struct {
-	Token    string
-	Username string
-	Scopes   []string
+	Token     string
+	Username  string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// GitHubTokenInfo describes a validated GitHub token
+type GitHubTokenInfo struct {
+	Username  string
+	Scopes    []string  // nil if GitHub did not report scopes
+	ExpiresAt time.Time // zero if the token does not expire
 }
 
 // OAuthFlow handles the OAuth device flow
 type OAuthFlow struct {
 	config       OAuthConfig
 	onDeviceCode func(code, verificationURL string)
+	deviceCode   bool
 }
 
 // DeviceCode represents the device code response from GitHub
@@ -69,8 +79,19 @@ func (f *OAuthFlow) OnDeviceCode(callback func(code, verificationURL string)) {
 	f.onDeviceCode = callback
 }
 
+// UseDeviceCode makes Run use only the device flow of the given OAuth App,
+// which must have device flow enabled, without falling back to a browser
+func (f *OAuthFlow) UseDeviceCode(clientID string) {
+	f.config.ClientID = clientID
+	f.deviceCode = true
+}
+
 // Run executes the OAuth device flow and returns the result
 func (f *OAuthFlow) Run(ctx context.Context) (*OAuthResult, error) {
+	if f.deviceCode {
+		return f.runDeviceCode(ctx)
+	}
+
 	// Create the oauth host
 	host, err := oauth.NewGitHubHost(f.getGitHubHost())
 	if err != nil {
@@ -102,15 +123,68 @@ func (f *OAuthFlow) Run(ctx context.Context) (*OAuthResult, error) {
 	}
 
 	// Get user info
-	username, err := f.getUsername(ctx, accessToken.Token)
+	info, err := inspectToken(ctx, accessToken.Token, f.config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get username: %w", err)
+	}
+
+	// Prefer the scopes GitHub actually granted over the requested ones
+	scopes := f.config.Scopes
+	if info.Scopes != nil {
+		scopes = info.Scopes
+	}
+
+	return &OAuthResult{
+		Token:     accessToken.Token,
+		Username:  info.Username,
+		Scopes:    scopes,
+		ExpiresAt: info.ExpiresAt,
+	}, nil
+}
+
+// runDeviceCode authorizes with the device flow and polls for the token
+func (f *OAuthFlow) runDeviceCode(ctx context.Context) (*OAuthResult, error) {
+	host := f.getGitHubHost()
+
+	token, err := auth.RunDeviceFlow(ctx, auth.DeviceFlowConfig{
+		DeviceCodeURL: host + "/login/device/code",
+		TokenURL:      host + "/login/oauth/access_token",
+		ClientID:      f.config.ClientID,
+		Scopes:        f.config.Scopes,
+	}, func(code *auth.DeviceCode) {
+		if f.onDeviceCode != nil {
+			f.onDeviceCode(code.UserCode, code.VerificationURI)
+		}
+	})
+
+	switch {
+	case errors.Is(err, auth.ErrDeviceCodeExpired):
+		return nil, ErrOAuthExpired
+	case errors.Is(err, auth.ErrDeviceAccessDenied):
+		return nil, ErrOAuthDenied
+	case errors.Is(err, context.Canceled):
+		return nil, ErrOAuthCanceled
+	case err != nil:
+		return nil, fmt.Errorf("OAuth flow failed: %w", err)
+	}
+
+	info, err := inspectToken(ctx, token.AccessToken, f.config.Host)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get username: %w", err)
 	}
 
+	scopes := f.config.Scopes
+	if info.Scopes != nil {
+		scopes = info.Scopes
+	} else if token.Scope != "" {
+		scopes = ParseScopes(token.Scope)
+	}
+
 	return &OAuthResult{
-		Token:    accessToken.Token,
-		Username: username,
-		Scopes:   f.config.Scopes,
+		Token:     token.AccessToken,
+		Username:  info.Username,
+		Scopes:    scopes,
+		ExpiresAt: info.ExpiresAt,
 	}, nil
 }
 
@@ -129,56 +203,101 @@ func (f *OAuthFlow) getGitHubHost() string {
 	return host
 }
 
-// getUsername fetches the authenticated user's username
-func (f *OAuthFlow) getUsername(ctx context.Context, token string) (string, error) {
+// newGitHubClient creates a GitHub client for the given host, handling enterprise URLs
+func newGitHubClient(token, host string) (*github.Client, error) {
 	client := github.NewClient(nil).WithAuthToken(token)
 
-	// Handle enterprise GitHub
-	if f.config.Host != "" && f.config.Host != "github.com" {
-		baseURL := fmt.Sprintf("https://%s/api/v3/", f.config.Host)
-		uploadURL := fmt.Sprintf("https://%s/api/uploads/", f.config.Host)
+	if host == "" || host == "github.com" {
+		return client, nil
+	}
 
-		var err error
+	baseURL := fmt.Sprintf("https://%s/api/v3/", host)
+	uploadURL := fmt.Sprintf("https://%s/api/uploads/", host)
 
-		client, err = client.WithEnterpriseURLs(baseURL, uploadURL)
-		if err != nil {
-			return "", fmt.Errorf("failed to configure enterprise client: %w", err)
-		}
+	client, err := client.WithEnterpriseURLs(baseURL, uploadURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure enterprise client: %w", err)
 	}
 
-	user, _, err := client.Users.Get(ctx, "")
-	if err != nil {
-		return "", fmt.Errorf("failed to get user: %w", err)
+	return client, nil
+}
+
+// scopesFromHeader returns the scopes listed in the X-OAuth-Scopes response header.
+// Returns nil when GitHub does not report scopes (e.g. fine-grained tokens).
+func scopesFromHeader(resp *github.Response) []string {
+	if resp == nil || resp.Response == nil {
+		return nil
 	}
 
-	return user.GetLogin(), nil
+	if _, ok := resp.Header["X-Oauth-Scopes"]; !ok {
+		return nil
+	}
+
+	return ParseScopes(resp.Header.Get("X-OAuth-Scopes"))
 }
 
 // ValidateToken checks if a token is still valid by making an API call
 func ValidateToken(ctx context.Context, token, host string) (bool, string, error) {
-	client := github.NewClient(nil).WithAuthToken(token)
-
-	// Handle enterprise GitHub
-	if host != "" && host != "github.com" {
-		baseURL := fmt.Sprintf("https://%s/api/v3/", host)
-		uploadURL := fmt.Sprintf("https://%s/api/uploads/", host)
+	info, err := InspectToken(ctx, token, host)
+	if err != nil || info == nil {
+		return false, "", err
+	}
 
-		var err error
+	return true, info.Username, nil
+}
 
-		client, err = client.WithEnterpriseURLs(baseURL, uploadURL)
-		if err != nil {
-			return false, "", fmt.Errorf("failed to configure enterprise client: %w", err)
+// InspectToken validates a token and returns its user, granted scopes and expiration.
+// Returns nil without error if the token is invalid or expired.
+func InspectToken(ctx context.Context, token, host string) (*GitHubTokenInfo, error) {
+	info, err := inspectToken(ctx, token, host)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response != nil && ghErr.Response.StatusCode == http.StatusUnauthorized {
+			return nil, nil
 		}
+
+		return nil, fmt.Errorf("token validation failed: %w", err)
+	}
+
+	return info, nil
+}
+
+// inspectToken fetches the authenticated user along with token metadata from response headers
+func inspectToken(ctx context.Context, token, host string) (*GitHubTokenInfo, error) {
+	client, err := newGitHubClient(token, host)
+	if err != nil {
+		return nil, err
 	}
 
 	user, resp, err := client.Users.Get(ctx, "")
 	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
-			return false, "", nil
-		}
+		return nil, err
+	}
 
-		return false, "", fmt.Errorf("token validation failed: %w", err)
+	return &GitHubTokenInfo{
+		Username:  user.GetLogin(),
+		Scopes:    scopesFromHeader(resp),
+		ExpiresAt: expiryFromHeader(resp),
+	}, nil
+}
+
+// expiryFromHeader parses the GitHub-Authentication-Token-Expiration response header.
+// Returns the zero time when the token does not expire.
+func expiryFromHeader(resp *github.Response) time.Time {
+	if resp == nil || resp.Response == nil {
+		return time.Time{}
+	}
+
+	value := resp.Header.Get("GitHub-Authentication-Token-Expiration")
+	if value == "" {
+		return time.Time{}
+	}
+
+	for _, layout := range []string{"2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700", time.RFC3339} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
 	}
 
-	return true, user.GetLogin(), nil
+	return time.Time{}
 }
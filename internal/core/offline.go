@@ -0,0 +1,99 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/notify"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+// offlineFile marks the data directory as air-gapped while it exists
+const offlineFile = "offline"
+
+// OfflineEnv enables air-gapped mode for a process when set to a true value
+const OfflineEnv = "CLONR_OFFLINE"
+
+// Sources of air-gapped mode reported by OfflineSource
+const (
+	OfflineSourceFlag    = "flag"
+	OfflineSourceEnv     = "env"
+	OfflineSourceSetting = "setting"
+)
+
+// ErrOffline is returned by network integrations while air-gapped mode is enabled
+var ErrOffline = errors.New("network integrations are disabled by air-gapped mode")
+
+// offlineForced is set by the --offline flag
+var offlineForced atomic.Bool
+
+func init() {
+	// Drop Slack and other outgoing notifications while air-gapped
+	notify.SetOfflineCheck(IsOffline)
+}
+
+// ForceOffline enables air-gapped mode for the current process only
+func ForceOffline() {
+	offlineForced.Store(true)
+}
+
+// IsOffline reports whether air-gapped mode is enabled. Network
+// integrations (GitHub API, Slack, Gmail, Microsoft, Jira, ZenHub and
+// notifications) are refused while it is; local git and database features
+// keep working.
+func IsOffline() bool {
+	return OfflineSource() != ""
+}
+
+// OfflineSource returns what enabled air-gapped mode: the --offline flag,
+// the CLONR_OFFLINE environment variable or the persistent setting. It
+// returns "" when the mode is off.
+func OfflineSource() string {
+	if offlineForced.Load() {
+		return OfflineSourceFlag
+	}
+
+	if v, err := strconv.ParseBool(os.Getenv(OfflineEnv)); err == nil && v {
+		return OfflineSourceEnv
+	}
+
+	if _, err := os.Stat(filepath.Join(params.AppdataDir, offlineFile)); err == nil {
+		return OfflineSourceSetting
+	}
+
+	return ""
+}
+
+// SetOffline persistently enables or disables air-gapped mode for every
+// clonr process using this data directory, including a running server.
+func SetOffline(enabled bool) error {
+	p := filepath.Join(params.AppdataDir, offlineFile)
+
+	if !enabled {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to disable air-gapped mode: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := encoding.WriteFileSecure(p, []byte("network integrations disabled\n")); err != nil {
+		return fmt.Errorf("failed to enable air-gapped mode: %w", err)
+	}
+
+	return nil
+}
+
+// CheckOnline returns an error naming integration when air-gapped mode is enabled
+func CheckOnline(integration string) error {
+	if !IsOffline() {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", integration, ErrOffline)
+}
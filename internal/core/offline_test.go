@@ -0,0 +1,60 @@
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOfflineSource(t *testing.T) {
+	useTempAppdata(t)
+	t.Setenv(OfflineEnv, "")
+
+	if IsOffline() {
+		t.Fatal("IsOffline() = true without any setting")
+	}
+
+	if err := CheckOnline("Slack"); err != nil {
+		t.Errorf("CheckOnline() error = %v while online", err)
+	}
+
+	t.Setenv(OfflineEnv, "1")
+
+	if got := OfflineSource(); got != OfflineSourceEnv {
+		t.Errorf("OfflineSource() = %q with %s set, want %q", got, OfflineEnv, OfflineSourceEnv)
+	}
+
+	t.Setenv(OfflineEnv, "no")
+
+	if err := SetOffline(true); err != nil {
+		t.Fatalf("SetOffline(true) error = %v", err)
+	}
+
+	if got := OfflineSource(); got != OfflineSourceSetting {
+		t.Errorf("OfflineSource() = %q after SetOffline(true), want %q", got, OfflineSourceSetting)
+	}
+
+	if err := CheckOnline("Slack"); !errors.Is(err, ErrOffline) {
+		t.Errorf("CheckOnline() error = %v, want %v", err, ErrOffline)
+	}
+
+	if err := SetOffline(false); err != nil {
+		t.Fatalf("SetOffline(false) error = %v", err)
+	}
+
+	if err := SetOffline(false); err != nil {
+		t.Errorf("SetOffline(false) twice error = %v", err)
+	}
+
+	if IsOffline() {
+		t.Error("IsOffline() = true after SetOffline(false)")
+	}
+}
+
+func TestNewKindClientOffline(t *testing.T) {
+	useTempAppdata(t)
+	t.Setenv(OfflineEnv, "1")
+
+	if gh := newKindClient(t.Context(), "token"); gh != nil {
+		t.Error("newKindClient() returned a GitHub client in air-gapped mode")
+	}
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// CodeDirCandidates are the directories under the home directory commonly
+// holding existing clones, offered to 'clonr map' on the first run
+var CodeDirCandidates = []string{
+	"code", "src", "dev", "git", "repos", "projects", "Projects",
+	"workspace", "Developer", "go/src", "Documents/GitHub",
+}
+
+// NeedsOnboarding reports whether clonr has never been set up: the
+// configuration was never saved and no repository is tracked
+func NeedsOnboarding() (bool, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return false, err
+	}
+
+	// The clone directory is empty until a configuration is first saved
+	if cfg.DefaultCloneDir != "" {
+		return false, nil
+	}
+
+	page, err := client.ListRepos(model.RepoFilter{}, 1, "")
+	if err != nil {
+		return false, err
+	}
+
+	return page.TotalSize == 0, nil
+}
+
+// FindCodeDirs returns the CodeDirCandidates that exist in the home directory
+func FindCodeDirs() []string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	var dirs []string
+
+	for _, name := range CodeDirCandidates {
+		dir := filepath.Join(home, filepath.FromSlash(name))
+
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
+
+// DetectEditor returns the editor to suggest: $VISUAL or $EDITOR when
+// installed, otherwise the first installed of DefaultEditors, or "" when
+// none is found
+func DetectEditor() string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); editor != "" && IsEditorInstalled(editor) {
+			return editor
+		}
+	}
+
+	for _, e := range DefaultEditors {
+		if IsEditorInstalled(e.Command) {
+			return e.Command
+		}
+	}
+
+	return ""
+}
+
+// SaveOnboardingConfig saves the clone directory and editor chosen on the
+// first run, creating the clone directory
+func SaveOnboardingConfig(cloneDir, editor string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cloneDir, err = filepath.Abs(expandTilde(cloneDir))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cloneDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create clone directory: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	cfg.DefaultCloneDir = cloneDir
+	cfg.Editor = editor
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save configuration: %w", err)
+	}
+
+	return nil
+}
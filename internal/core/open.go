@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os/exec"
 	"runtime"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
 )
 
 // OpenInFileManager opens the given path in the system's default file manager.
@@ -37,6 +39,26 @@ func OpenInEditor(editor, path string) error {
 	return nil
 }
 
+// OpenInConfiguredEditor opens the given path in the editor set with
+// 'clonr configure' and returns the editor command.
+func OpenInConfiguredEditor(path string) (string, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return "", fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if cfg.Editor == "" {
+		return "", fmt.Errorf("no editor configured. Run 'clonr configure' to set an editor")
+	}
+
+	return cfg.Editor, OpenInEditor(cfg.Editor, path)
+}
+
 // IsEditorInstalled checks if the given editor command is available in PATH.
 func IsEditorInstalled(editor string) bool {
 	_, err := exec.LookPath(editor)
@@ -27,10 +27,41 @@ type Organization struct {
 // ListOrganizationsOptions configures the organization listing
 type ListOrganizationsOptions struct {
 	IncludeUser bool // Include user's personal repos as pseudo-org
+
+	// Cache controls the response cache of the GitHub queries; the local
+	// mirror status is always checked afresh
+	Cache CacheOptions
 }
 
 // ListOrganizations fetches the user's GitHub organizations and checks mirror status
 func ListOrganizations(token string, opts ListOrganizationsOptions) ([]Organization, error) {
+	key := []string{CacheKeyHash(token), fmt.Sprint(opts.IncludeUser)}
+
+	orgs, err := CachedQuery("github-orgs", key, opts.Cache, func() ([]Organization, error) {
+		return fetchOrganizations(token, opts.IncludeUser)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Get a clone directory from config
+	cloneDir, err := getCloneDir()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check mirror status
+	for i := range orgs {
+		orgs[i].MirrorPath = filepath.Join(cloneDir, orgs[i].Login)
+		orgs[i].IsMirrored, orgs[i].LocalRepos = checkMirrorStatus(orgs[i].MirrorPath)
+	}
+
+	return orgs, nil
+}
+
+// fetchOrganizations fetches the user's GitHub organizations with their
+// repository counts, one request per organization
+func fetchOrganizations(token string, includeUser bool) ([]Organization, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -43,16 +74,10 @@ func ListOrganizations(token string, opts ListOrganizationsOptions) ([]Organizat
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
-	// Get a clone directory from config
-	cloneDir, err := getCloneDir()
-	if err != nil {
-		return nil, err
-	}
-
 	var orgs = make([]Organization, 0)
 
 	// Optionally include user's personal repos
-	if opts.IncludeUser {
+	if includeUser {
 		user, _, err := client.Users.Get(ctx, "")
 		if err == nil && user.Login != nil {
 			userOrg := Organization{
@@ -71,10 +96,6 @@ func ListOrganizations(token string, opts ListOrganizationsOptions) ([]Organizat
 				userOrg.RepoCount += int(*user.TotalPrivateRepos)
 			}
 
-			// Check mirror status
-			userOrg.MirrorPath = filepath.Join(cloneDir, *user.Login)
-			userOrg.IsMirrored, userOrg.LocalRepos = checkMirrorStatus(userOrg.MirrorPath)
-
 			orgs = append(orgs, userOrg)
 		}
 	}
@@ -105,10 +126,6 @@ func ListOrganizations(token string, opts ListOrganizationsOptions) ([]Organizat
 			org.Name = org.Login
 		}
 
-		// Check mirror status
-		org.MirrorPath = filepath.Join(cloneDir, org.Login)
-		org.IsMirrored, org.LocalRepos = checkMirrorStatus(org.MirrorPath)
-
 		orgs = append(orgs, org)
 	}
 
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// ListPinnedRepos returns the repositories pinned to the main menu, in pin
+// order. Pins of repositories no longer tracked are left out.
+func ListPinnedRepos() ([]model.Repository, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if len(cfg.PinnedRepos) == 0 {
+		return nil, nil
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	return pinnedRepos(repos, cfg.PinnedRepos), nil
+}
+
+// pinnedRepos returns the repositories of repos whose URL is in pins, in
+// the order of pins
+func pinnedRepos(repos []model.Repository, pins []string) []model.Repository {
+	pinned := make([]model.Repository, 0, len(pins))
+
+	for _, pin := range pins {
+		i := slices.IndexFunc(repos, func(r model.Repository) bool { return r.URL == pin })
+		if i >= 0 {
+			pinned = append(pinned, repos[i])
+		}
+	}
+
+	return pinned
+}
+
+// pinnedURLs returns the URLs of repos
+func pinnedURLs(repos []model.Repository) []string {
+	urls := make([]string, len(repos))
+	for i, repo := range repos {
+		urls[i] = repo.URL
+	}
+
+	return urls
+}
+
+// SetRepoPinned pins a tracked repository to the main menu or unpins it.
+// It reports whether the setting changed; pinning more than
+// model.MaxPinnedRepos repositories is refused.
+func SetRepoPinned(repo *model.Repository, pinned bool) (bool, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if slices.Contains(cfg.PinnedRepos, repo.URL) == pinned {
+		return false, nil
+	}
+
+	if pinned {
+		// Pins of repositories removed since do not take up a slot
+		repos, err := client.GetAllRepos()
+		if err != nil {
+			return false, fmt.Errorf("failed to get repositories: %w", err)
+		}
+
+		cfg.PinnedRepos = pinnedURLs(pinnedRepos(repos, cfg.PinnedRepos))
+
+		if len(cfg.PinnedRepos) >= model.MaxPinnedRepos {
+			return false, fmt.Errorf("at most %d repositories can be pinned; unpin one first", model.MaxPinnedRepos)
+		}
+
+		cfg.PinnedRepos = append(cfg.PinnedRepos, repo.URL)
+	} else {
+		cfg.PinnedRepos = slices.DeleteFunc(cfg.PinnedRepos, func(u string) bool { return u == repo.URL })
+	}
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return false, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,30 @@
+package core
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestPinnedRepos(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/api"},
+		{URL: "https://github.com/acme/web"},
+		{URL: "https://github.com/acme/docs"},
+	}
+
+	// Pin order is kept and pins of untracked repositories are dropped
+	pins := []string{"https://github.com/acme/docs", "https://github.com/acme/gone", "https://github.com/acme/api"}
+
+	got := pinnedURLs(pinnedRepos(repos, pins))
+	want := []string{"https://github.com/acme/docs", "https://github.com/acme/api"}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("pinnedRepos() = %v, want %v", got, want)
+	}
+
+	if got := pinnedRepos(repos, nil); len(got) != 0 {
+		t.Errorf("pinnedRepos() without pins = %v, want none", got)
+	}
+}
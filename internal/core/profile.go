@@ -5,9 +5,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
+	"sort"
 	"time"
 
+	"github.com/inovacc/clonr/internal/audit"
 	"github.com/inovacc/clonr/internal/client/grpc"
 	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
@@ -103,6 +106,7 @@ func (pm *ProfileManager) CreateProfile(ctx context.Context, name, host string,
 		EncryptedToken: encryptedToken,
 		CreatedAt:      time.Now(),
 		LastUsedAt:     time.Now(),
+		TokenExpiresAt: result.ExpiresAt,
 	}
 
 	// Save profile to BoltDB
@@ -299,6 +303,7 @@ func (pm *ProfileManager) RefreshProfile(ctx context.Context, name string) error
 
 	// Update profile
 	profile.User = result.Username
+	profile.TokenExpiresAt = result.ExpiresAt
 	profile.LastUsedAt = time.Now()
 
 	return pm.client.SaveProfile(profile) //nolint:contextcheck // a client manages its own timeout
@@ -350,6 +355,28 @@ func (pm *ProfileManager) AddNotifyChannel(profileName string, channel *model.No
 	return pm.client.SaveProfile(profile)
 }
 
+// UpdateNotifyChannelConfig sets config values of a notification channel,
+// such as a refreshed OAuth token. Sensitive values are encrypted again
+// along with the ones already stored.
+func (pm *ProfileManager) UpdateNotifyChannelConfig(profileName, channelID string, updates map[string]string) error {
+	channel, err := pm.GetNotifyChannel(profileName, channelID)
+	if err != nil {
+		return err
+	}
+
+	config, err := pm.DecryptChannelConfig(profileName, channel)
+	if err != nil {
+		return err
+	}
+
+	maps.Copy(config, updates)
+
+	channel.Config = config
+	channel.UpdatedAt = time.Now()
+
+	return pm.AddNotifyChannel(profileName, channel)
+}
+
 // RemoveNotifyChannel removes a notification channel from a profile.
 func (pm *ProfileManager) RemoveNotifyChannel(profileName, channelID string) error {
 	profile, err := pm.GetProfile(profileName)
@@ -406,8 +433,15 @@ func (pm *ProfileManager) GetNotifyChannelByType(profileName string, channelType
 func (pm *ProfileManager) DecryptChannelConfig(profileName string, channel *model.NotifyChannel) (map[string]string, error) {
 	decrypted := make(map[string]string)
 
+	var (
+		keys    []string
+		success = true
+	)
+
 	for key, value := range channel.Config {
 		if isSensitiveKey(key) && value != "" {
+			keys = append(keys, key)
+
 			// Decode base64 first
 			encryptedBytes, err := base64.StdEncoding.DecodeString(value)
 			if err != nil {
@@ -419,6 +453,7 @@ func (pm *ProfileManager) DecryptChannelConfig(profileName string, channel *mode
 			if err != nil {
 				// If decryption fails, the value might not be encrypted
 				decrypted[key] = value
+				success = false
 			} else {
 				decrypted[key] = plaintext
 			}
@@ -427,6 +462,11 @@ func (pm *ProfileManager) DecryptChannelConfig(profileName string, channel *mode
 		}
 	}
 
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		audit.RecordDecrypt("channel", profileName, string(channel.Type), channel.Name, keys, success)
+	}
+
 	return decrypted, nil
 }
 
@@ -435,7 +475,7 @@ func isSensitiveKey(key string) bool {
 	sensitiveKeys := []string{
 		"token", "secret", "password", "api_key", "webhook_url",
 		"bot_token", "client_secret", "hmac_secret", "refresh_token",
-		"access_token",
+		"access_token", "headers",
 	}
 
 	return slices.Contains(sensitiveKeys, key)
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// DetectRepoRemotes returns the remotes of the clone at path other than the
+// one pointing at primaryURL, sorted by name. Hosted remotes are recorded by
+// their canonical URL, other remotes (local paths) as configured.
+func DetectRepoRemotes(path, primaryURL string) ([]model.RepoRemote, error) {
+	output, err := runGitCommand("-C", path, "remote", "-v")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes of %s: %w", path, err)
+	}
+
+	primary := strings.SplitN(primaryURL, "#", 2)[0]
+
+	var remotes []model.RepoRemote
+
+	for line := range strings.SplitSeq(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[2] != "(fetch)" {
+			continue
+		}
+
+		remoteURL := canonicalRemoteURL(fields[1])
+		if remoteURL == primary {
+			continue
+		}
+
+		remotes = append(remotes, model.RepoRemote{Name: fields[0], URL: remoteURL})
+	}
+
+	sort.Slice(remotes, func(i, j int) bool {
+		return remotes[i].Name < remotes[j].Name
+	})
+
+	return remotes, nil
+}
+
+// canonicalRemoteURL returns the canonical URL of a hosted repository
+// remote, or the remote URL unchanged when it is not one
+func canonicalRemoteURL(remoteURL string) string {
+	if !strings.Contains(remoteURL, ":") {
+		return remoteURL
+	}
+
+	repo, err := giturl.ParseRepository(remoteURL, "")
+	if err != nil {
+		return remoteURL
+	}
+
+	u, err := fixURL(repo.Host, repo.Owner, repo.Name)
+	if err != nil {
+		return remoteURL
+	}
+
+	return u.String()
+}
+
+// RefreshRepoRemotes records the current remotes of a tracked clone. An
+// "upstream" remote also becomes the recorded upstream when none is set.
+func RefreshRepoRemotes(repo *model.Repository) ([]model.RepoRemote, error) {
+	remotes, err := DetectRepoRemotes(repo.Path, repo.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if err := client.SetRepoRemotes(repo.URL, remotes); err != nil {
+		return nil, fmt.Errorf("failed to record remotes: %w", err)
+	}
+
+	repo.Remotes = remotes
+
+	if upstream := repo.RemoteURL(UpstreamRemote); upstream != "" && repo.UpstreamURL == "" {
+		if err := client.SetRepoUpstream(repo.URL, upstream); err != nil {
+			return nil, fmt.Errorf("failed to record upstream: %w", err)
+		}
+
+		repo.UpstreamURL = upstream
+	}
+
+	return remotes, nil
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestDetectRepoRemotes(t *testing.T) {
+	upstream, _, fork := initForkTestRepos(t)
+
+	forkTestGit(t, fork, "remote", "set-url", "origin", "git@github.com:me/tool.git")
+	forkTestGit(t, fork, "remote", "add", "upstream", "https://github.com/acme/tool.git")
+	forkTestGit(t, fork, "remote", "add", "backup", upstream)
+
+	remotes, err := DetectRepoRemotes(fork, "https://github.com/me/tool")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []model.RepoRemote{
+		{Name: "backup", URL: upstream},
+		{Name: "upstream", URL: "https://github.com/acme/tool"},
+	}
+
+	if !reflect.DeepEqual(remotes, want) {
+		t.Errorf("DetectRepoRemotes() = %+v, want %+v", remotes, want)
+	}
+}
+
+func TestRepositoryRemoteURL(t *testing.T) {
+	repo := &model.Repository{Remotes: []model.RepoRemote{{Name: "upstream", URL: "https://github.com/acme/tool"}}}
+
+	if got := repo.RemoteURL("upstream"); got != "https://github.com/acme/tool" {
+		t.Errorf("RemoteURL(upstream) = %q", got)
+	}
+
+	if got := repo.RemoteURL("mirror"); got != "" {
+		t.Errorf("RemoteURL(mirror) = %q, want empty", got)
+	}
+}
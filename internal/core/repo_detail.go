@@ -0,0 +1,79 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// maxNotesLength bounds the notes kept with a repository
+const maxNotesLength = 4096
+
+// GetRepoDetail returns the repository with the given URL and the state of
+// its clone, as read by the server
+func GetRepoDetail(urlStr string) (*model.RepoDetail, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.GetRepoDetail(urlStr)
+}
+
+// SetRepoNotes replaces the notes of the repository with the given URL; empty
+// notes clear them
+func SetRepoNotes(urlStr, notes string) error {
+	notes = strings.TrimSpace(notes)
+	if len(notes) > maxNotesLength {
+		return fmt.Errorf("notes are longer than %d characters", maxNotesLength)
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.SetRepoNotes(urlStr, notes)
+}
+
+// SetRepoAlias sets the alias of the repository with the given URL, which
+// commands accept in place of it; an empty alias clears it
+func SetRepoAlias(urlStr, alias string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if alias != "" {
+		if alias, err = checkRepoAlias(client, alias, urlStr); err != nil {
+			return err
+		}
+	}
+
+	return client.SetRepoAlias(urlStr, alias)
+}
+
+// checkRepoAlias validates alias and checks that no repository other than
+// the one with the given URL uses it, returning it normalized
+func checkRepoAlias(client *grpc.Client, alias, urlStr string) (string, error) {
+	alias, err := model.ParseRepoAlias(alias)
+	if err != nil {
+		return "", err
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return "", fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	for _, r := range repos {
+		if r.Alias == alias && r.URL != git.CanonicalURL(urlStr) {
+			return "", fmt.Errorf("alias %q is already used by %s", alias, r.URL)
+		}
+	}
+
+	return alias, nil
+}
@@ -0,0 +1,240 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v82/github"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Classification sources reported by ClassifyRepos
+const (
+	KindSourceHost  = "host"
+	KindSourceLocal = "local"
+)
+
+// hostRepoInfo is what the host API reports about a repository
+type hostRepoInfo struct {
+	Archived  bool
+	Template  bool
+	Fork      bool
+	MirrorURL string
+}
+
+// kindFromHost picks the kind from host API flags. A repository can carry
+// several; the one that restricts behavior most wins.
+func kindFromHost(info hostRepoInfo) model.RepoKind {
+	switch {
+	case info.Archived:
+		return model.RepoKindArchive
+	case info.MirrorURL != "":
+		return model.RepoKindMirror
+	case info.Template:
+		return model.RepoKindTemplate
+	case info.Fork:
+		return model.RepoKindFork
+	default:
+		return model.RepoKindSource
+	}
+}
+
+// DetectLocalKind classifies a clone from its git config: a mirror clone
+// (remote.origin.mirror, or fetching all refs) is a mirror, a clone with an
+// "upstream" remote is a fork. It returns "" when nothing points either way.
+func DetectLocalKind(path string) model.RepoKind {
+	if out, err := exec.Command("git", "-C", path, "config", "--bool", "remote.origin.mirror").Output(); err == nil &&
+		strings.TrimSpace(string(out)) == "true" {
+		return model.RepoKindMirror
+	}
+
+	if out, err := exec.Command("git", "-C", path, "config", "--get-all", "remote.origin.fetch").Output(); err == nil &&
+		slices.Contains(strings.Fields(string(out)), "+refs/*:refs/*") {
+		return model.RepoKindMirror
+	}
+
+	if err := exec.Command("git", "-C", path, "remote", "get-url", "upstream").Run(); err == nil {
+		return model.RepoKindFork
+	}
+
+	return ""
+}
+
+// mergeKinds combines the host and local classification. A local mirror
+// clone of a regular repository is still a mirror; without host data the
+// local heuristics decide, defaulting to source.
+func mergeKinds(host, local model.RepoKind) model.RepoKind {
+	switch {
+	case host == "":
+		if local == "" {
+			return model.RepoKindSource
+		}
+
+		return local
+	case local == model.RepoKindMirror && (host == model.RepoKindSource || host == model.RepoKindFork):
+		return model.RepoKindMirror
+	default:
+		return host
+	}
+}
+
+// fetchHostKind asks the GitHub API for the repository's kind. Other hosts
+// are not queried and return "".
+func fetchHostKind(ctx context.Context, gh *github.Client, repoURL string) (model.RepoKind, error) {
+	// Subdirectory entries carry the subdirectory as URL fragment
+	repoURL, _, _ = strings.Cut(repoURL, "#")
+
+	repo, err := giturl.ParseRepository(repoURL, "")
+	if err != nil {
+		return "", err
+	}
+
+	if gh == nil || !strings.EqualFold(repo.Host, "github.com") {
+		return "", nil
+	}
+
+	var r *github.Repository
+
+	err = Jobs().Do(ctx, JobAPI, func() (err error) {
+		r, _, err = gh.Repositories.Get(ctx, repo.Owner, repo.Name)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return kindFromHost(hostRepoInfo{
+		Archived:  r.GetArchived(),
+		Template:  r.GetIsTemplate(),
+		Fork:      r.GetFork(),
+		MirrorURL: r.GetMirrorURL(),
+	}), nil
+}
+
+// ClassifyRepo determines the kind of a tracked repository from the host
+// API (when gh is set) and the local clone. The source reports where the
+// kind came from; a host API error falls back to the local heuristics.
+func ClassifyRepo(ctx context.Context, gh *github.Client, repoURL, path string) (kind model.RepoKind, source string, err error) {
+	host, err := fetchHostKind(ctx, gh, repoURL)
+
+	kind = mergeKinds(host, DetectLocalKind(path))
+
+	source = KindSourceLocal
+	if host != "" {
+		source = KindSourceHost
+	}
+
+	return kind, source, err
+}
+
+// ClassifyOptions selects the repositories ClassifyRepos updates
+type ClassifyOptions struct {
+	Workspace string // Only repositories in this workspace
+	Refresh   bool   // Reclassify repositories that already have a kind
+	Offline   bool   // Use local heuristics only (implied by air-gapped mode)
+	Token     string // GitHub token (empty resolves one, unauthenticated if none)
+	DryRun    bool   // Report without saving
+}
+
+// ClassifyResult is the classification of one repository
+type ClassifyResult struct {
+	URL      string         `json:"url"`
+	Path     string         `json:"path"`
+	Kind     model.RepoKind `json:"kind"`
+	Previous model.RepoKind `json:"previous,omitempty"`
+	Source   string         `json:"source"`
+	Warning  string         `json:"warning,omitempty"`
+}
+
+// Changed reports whether the classification differs from the stored kind
+func (r ClassifyResult) Changed() bool {
+	return r.Kind != r.Previous
+}
+
+// newKindClient returns a GitHub client for classification, authenticated
+// when a token is available. It returns nil in air-gapped mode, leaving the
+// local heuristics.
+func newKindClient(ctx context.Context, token string) *github.Client {
+	if CheckOnline("GitHub API") != nil {
+		return nil
+	}
+
+	if token == "" {
+		token, _, _ = ResolveGitHubToken("", "")
+	}
+
+	if token == "" {
+		return github.NewClient(nil)
+	}
+
+	return NewGitHubClient(ctx, token)
+}
+
+// ClassifyRepos classifies tracked repositories and stores their kind.
+// Repositories that already have a kind are skipped unless Refresh is set.
+func ClassifyRepos(ctx context.Context, opts ClassifyOptions) ([]ClassifyResult, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repos, err := client.GetRepos(opts.Workspace, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	var gh *github.Client
+	if !opts.Offline {
+		gh = newKindClient(ctx, opts.Token)
+	}
+
+	var results []ClassifyResult
+
+	for _, repo := range repos {
+		if repo.Kind != "" && !opts.Refresh {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		kind, source, classifyErr := ClassifyRepo(ctx, gh, repo.URL, repo.Path)
+
+		res := ClassifyResult{URL: repo.URL, Path: repo.Path, Kind: kind, Previous: repo.Kind, Source: source}
+		if classifyErr != nil {
+			res.Warning = "host lookup failed: " + classifyErr.Error()
+		}
+
+		if res.Changed() && !opts.DryRun {
+			if err := client.SetRepoKind(repo.URL, kind); err != nil {
+				res.Warning = fmt.Sprintf("failed to save kind: %v", err)
+			}
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// classifyClonedRepo stores the kind of a freshly cloned repository.
+// Errors are ignored; 'clonr repo classify' can fill the kind in later.
+func classifyClonedRepo(repoURL, path, token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), TimeoutShort)
+	defer cancel()
+
+	kind, _, err := ClassifyRepo(ctx, newKindClient(ctx, token), repoURL, path)
+	if err != nil {
+		return
+	}
+
+	if client, err := grpc.GetClient(); err == nil {
+		_ = client.SetRepoKind(repoURL, kind)
+	}
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestKindFromHost(t *testing.T) {
+	tests := []struct {
+		name string
+		info hostRepoInfo
+		want model.RepoKind
+	}{
+		{"regular", hostRepoInfo{}, model.RepoKindSource},
+		{"fork", hostRepoInfo{Fork: true}, model.RepoKindFork},
+		{"template", hostRepoInfo{Template: true, Fork: true}, model.RepoKindTemplate},
+		{"mirror", hostRepoInfo{MirrorURL: "https://git.example.com/a/b", Template: true}, model.RepoKindMirror},
+		{"archived fork", hostRepoInfo{Archived: true, Fork: true}, model.RepoKindArchive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := kindFromHost(tt.info); got != tt.want {
+				t.Errorf("kindFromHost() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeKinds(t *testing.T) {
+	tests := []struct {
+		host, local, want model.RepoKind
+	}{
+		{"", "", model.RepoKindSource},
+		{"", model.RepoKindFork, model.RepoKindFork},
+		{model.RepoKindSource, model.RepoKindFork, model.RepoKindSource},
+		{model.RepoKindSource, model.RepoKindMirror, model.RepoKindMirror},
+		{model.RepoKindArchive, model.RepoKindMirror, model.RepoKindArchive},
+		{model.RepoKindTemplate, "", model.RepoKindTemplate},
+	}
+
+	for _, tt := range tests {
+		if got := mergeKinds(tt.host, tt.local); got != tt.want {
+			t.Errorf("mergeKinds(%q, %q) = %q, want %q", tt.host, tt.local, got, tt.want)
+		}
+	}
+}
+
+func TestDetectLocalKind(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	git := func(dir string, args ...string) {
+		t.Helper()
+
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	plain := t.TempDir()
+	git(plain, "init", "-q")
+	git(plain, "remote", "add", "origin", "https://github.com/acme/app")
+
+	if got := DetectLocalKind(plain); got != "" {
+		t.Errorf("plain clone: DetectLocalKind() = %q, want empty", got)
+	}
+
+	git(plain, "remote", "add", "upstream", "https://github.com/upstream/app")
+
+	if got := DetectLocalKind(plain); got != model.RepoKindFork {
+		t.Errorf("clone with upstream: DetectLocalKind() = %q, want fork", got)
+	}
+
+	mirror := t.TempDir()
+	git(mirror, "init", "-q", "--bare")
+	git(mirror, "remote", "add", "--mirror=fetch", "origin", "https://github.com/acme/app")
+
+	if got := DetectLocalKind(mirror); got != model.RepoKindMirror {
+		t.Errorf("mirror clone: DetectLocalKind() = %q, want mirror", got)
+	}
+}
@@ -0,0 +1,296 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// snapshotRefPrefix namespaces the refs that keep snapshot stashes reachable
+// after they are dropped from the stash list
+const snapshotRefPrefix = "refs/clonr/snapshots/"
+
+// CreateRepoSnapshotOptions configures taking a repository snapshot
+type CreateRepoSnapshotOptions struct {
+	Message string // Optional description of the snapshot
+	Keep    bool   // Leave uncommitted changes in the working tree
+}
+
+// RestoreRepoSnapshotOptions configures restoring a repository snapshot
+type RestoreRepoSnapshotOptions struct {
+	Exact bool // Detach at the recorded HEAD even if the branch has moved since
+}
+
+// RestoreResult describes what restoring a snapshot did
+type RestoreResult struct {
+	Snapshot       *model.RepoSnapshot
+	BranchMoved    bool // The branch tip differs from the recorded HEAD
+	AppliedChanges bool // Uncommitted changes were re-applied
+}
+
+// ResolveRepo finds a tracked repository by URL, path or name.
+// An empty query uses the repository containing the current directory.
+func ResolveRepo(query string) (*model.Repository, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if query == "" {
+		query, err = git.RepoRoot(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("no repository given and the current directory is not a git repository")
+		}
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	return matchRepo(repos, query)
+}
+
+// CreateRepoSnapshot records the branch, HEAD and uncommitted changes
+// (including untracked files) of a repository. Changes are stashed and the
+// stash commit is kept under refs/clonr/snapshots so later stash operations
+// cannot lose it; the working tree is left clean unless opts.Keep is set.
+func CreateRepoSnapshot(repo *model.Repository, opts CreateRepoSnapshotOptions) (*model.RepoSnapshot, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if err := validateGitRepo(repo.Path); err != nil {
+		return nil, err
+	}
+
+	head, err := runGitCommand("-C", repo.Path, "rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("repository has no commits: %s", repo.Path)
+	}
+
+	snapshot := &model.RepoSnapshot{
+		ID:        uuid.New().String(),
+		RepoURL:   repo.URL,
+		Path:      repo.Path,
+		Message:   opts.Message,
+		Head:      strings.TrimSpace(head),
+		CreatedAt: time.Now(),
+	}
+
+	// symbolic-ref fails when HEAD is detached, which leaves Branch empty
+	if branch, err := runGitCommand("-C", repo.Path, "symbolic-ref", "--short", "-q", "HEAD"); err == nil {
+		snapshot.Branch = strings.TrimSpace(branch)
+	}
+
+	status, err := runGitCommand("-C", repo.Path, "status", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(status) != "" {
+		stash, err := stashSnapshotChanges(repo.Path, snapshot.ID, opts.Keep)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Stash = stash
+	}
+
+	if err := client.SaveRepoSnapshot(snapshot); err != nil {
+		if snapshot.Stash != "" {
+			_, _ = runGitCommand("-C", repo.Path, "update-ref", "-d", snapshotRefPrefix+snapshot.ID)
+
+			if !opts.Keep {
+				_, _ = runGitCommand("-C", repo.Path, "stash", "apply", "--index", snapshot.Stash)
+			}
+		}
+
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// stashSnapshotChanges stashes all changes, pins the stash commit under a
+// snapshot ref and drops it from the stash list. It returns the stash SHA.
+func stashSnapshotChanges(repoPath, id string, keep bool) (string, error) {
+	if _, err := runGitCommand("-C", repoPath, "stash", "push", "--include-untracked", "-m", "clonr snapshot "+id); err != nil {
+		return "", fmt.Errorf("failed to stash changes: %w", err)
+	}
+
+	out, err := runGitCommand("-C", repoPath, "rev-parse", "refs/stash")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve stash: %w", err)
+	}
+
+	stash := strings.TrimSpace(out)
+
+	if _, err := runGitCommand("-C", repoPath, "update-ref", snapshotRefPrefix+id, stash); err != nil {
+		return "", fmt.Errorf("failed to record snapshot ref: %w", err)
+	}
+
+	_, _ = runGitCommand("-C", repoPath, "stash", "drop", "--quiet")
+
+	if keep {
+		if _, err := runGitCommand("-C", repoPath, "stash", "apply", "--index", stash); err != nil {
+			return "", fmt.Errorf("snapshot saved but failed to re-apply changes (recover with 'git stash apply %s'): %w", stash, err)
+		}
+	}
+
+	return stash, nil
+}
+
+// ListRepoSnapshots returns the snapshots of a repository, newest first.
+// A nil repo lists the snapshots of all repositories.
+func ListRepoSnapshots(repo *model.Repository) ([]model.RepoSnapshot, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	repoURL := ""
+	if repo != nil {
+		repoURL = repo.URL
+	}
+
+	snapshots, err := client.ListRepoSnapshots(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// FindRepoSnapshot picks a snapshot by ID or unique ID prefix.
+// An empty id picks the newest snapshot.
+func FindRepoSnapshot(snapshots []model.RepoSnapshot, id string) (*model.RepoSnapshot, error) {
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("no snapshots found")
+	}
+
+	if id == "" {
+		newest := &snapshots[0]
+		for i := range snapshots {
+			if snapshots[i].CreatedAt.After(newest.CreatedAt) {
+				newest = &snapshots[i]
+			}
+		}
+
+		return newest, nil
+	}
+
+	var found []*model.RepoSnapshot
+
+	for i := range snapshots {
+		if snapshots[i].ID == id {
+			return &snapshots[i], nil
+		}
+
+		if strings.HasPrefix(snapshots[i].ID, id) {
+			found = append(found, &snapshots[i])
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("snapshot '%s' not found", id)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("snapshot ID '%s' is ambiguous (%d matches)", id, len(found))
+	}
+}
+
+// RestoreRepoSnapshot checks out the recorded branch (or detached HEAD) and
+// re-applies the recorded uncommitted changes. The working tree must be
+// clean. If the branch moved since the snapshot, it is checked out at its
+// current tip unless opts.Exact is set, which detaches at the recorded HEAD.
+func RestoreRepoSnapshot(snapshot *model.RepoSnapshot, opts RestoreRepoSnapshotOptions) (*RestoreResult, error) {
+	path := snapshot.Path
+
+	if err := validateGitRepo(path); err != nil {
+		return nil, err
+	}
+
+	status, err := runGitCommand("-C", path, "status", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(status) != "" {
+		return nil, &DirtyRepoError{Path: path}
+	}
+
+	if _, err := runGitCommand("-C", path, "cat-file", "-e", snapshot.Head+"^{commit}"); err != nil {
+		return nil, fmt.Errorf("recorded HEAD %s no longer exists in %s", shortSHA(snapshot.Head), path)
+	}
+
+	result := &RestoreResult{Snapshot: snapshot}
+
+	target := snapshot.Head
+
+	if snapshot.Branch != "" && !opts.Exact {
+		tip, err := runGitCommand("-C", path, "rev-parse", "--verify", "refs/heads/"+snapshot.Branch)
+		if err != nil {
+			return nil, fmt.Errorf("branch '%s' no longer exists (use --exact to restore at %s)", snapshot.Branch, shortSHA(snapshot.Head))
+		}
+
+		result.BranchMoved = strings.TrimSpace(tip) != snapshot.Head
+		target = snapshot.Branch
+	}
+
+	checkoutArgs := []string{"-C", path, "checkout", "--quiet", target}
+	if target == snapshot.Head {
+		checkoutArgs = []string{"-C", path, "checkout", "--quiet", "--detach", target}
+	}
+
+	if _, err := runGitCommand(checkoutArgs...); err != nil {
+		return nil, fmt.Errorf("failed to check out %s: %w", target, err)
+	}
+
+	if snapshot.Stash != "" {
+		if _, err := runGitCommand("-C", path, "stash", "apply", "--index", snapshot.Stash); err != nil {
+			return result, fmt.Errorf("failed to re-apply changes (resolve conflicts, or retry with 'git stash apply %s'): %w", snapshot.Stash, err)
+		}
+
+		result.AppliedChanges = true
+	}
+
+	return result, nil
+}
+
+// DeleteRepoSnapshot removes a snapshot and the ref pinning its stash
+func DeleteRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if snapshot.Stash != "" {
+		// The repository may have been moved or removed; the store entry goes regardless
+		_, _ = runGitCommand("-C", snapshot.Path, "update-ref", "-d", snapshotRefPrefix+snapshot.ID)
+	}
+
+	if err := client.DeleteRepoSnapshot(snapshot.ID); err != nil {
+		return fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// shortSHA abbreviates a commit SHA for display
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+
+	return sha
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestFindRepoSnapshot(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	snapshots := []model.RepoSnapshot{
+		{ID: "3f2a9c10-aaaa", CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: "3f2b0d22-bbbb", CreatedAt: now},
+		{ID: "91c4e5f6-cccc", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{id: "", want: "3f2b0d22-bbbb"},
+		{id: "91c4e5f6-cccc", want: "91c4e5f6-cccc"},
+		{id: "3f2a", want: "3f2a9c10-aaaa"},
+		{id: "3f2", wantErr: true},
+		{id: "ffff", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := FindRepoSnapshot(snapshots, tt.id)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("FindRepoSnapshot(%q) = %s, want error", tt.id, got.ID)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("FindRepoSnapshot(%q) error = %v", tt.id, err)
+			continue
+		}
+
+		if got.ID != tt.want {
+			t.Errorf("FindRepoSnapshot(%q) = %s, want %s", tt.id, got.ID, tt.want)
+		}
+	}
+
+	if _, err := FindRepoSnapshot(nil, ""); err == nil {
+		t.Error("FindRepoSnapshot(nil) should fail")
+	}
+}
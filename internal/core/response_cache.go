@@ -0,0 +1,173 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/application"
+)
+
+// DefaultResponseCacheTTL is how long a cached API response is served
+// before the query runs again.
+const DefaultResponseCacheTTL = 5 * time.Minute
+
+// CacheOptions controls the response cache of a read query.
+type CacheOptions struct {
+	// NoCache runs the query even when a fresh response is cached; the new
+	// response still replaces the cached one
+	NoCache bool
+
+	// TTL is the age at which a cached response is refetched
+	// (default: DefaultResponseCacheTTL)
+	TTL time.Duration
+}
+
+// cachedResponse is the file format of a cached response
+type cachedResponse struct {
+	CachedAt time.Time       `json:"cached_at"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// responseCacheDir returns the directory of the response cache; replaced in tests
+var responseCacheDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, application.AppName, "responses"), nil
+}
+
+// CachedQuery returns the response of a read-only API query from the
+// on-disk cache while it is fresh, and otherwise runs fetch and caches its
+// result. The query is identified by a namespace, such as "slack-channels",
+// and key parts covering everything the response depends on, including the
+// credentials, so accounts never see each other's data. Cache failures only
+// cost the cache; the query result is returned regardless.
+func CachedQuery[T any](namespace string, key []string, opts CacheOptions, fetch func() (T, error)) (T, error) {
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = DefaultResponseCacheTTL
+	}
+
+	path, pathErr := responseCachePath(namespace, key)
+
+	if pathErr == nil && !opts.NoCache {
+		if value, ok := readCachedResponse[T](path, ttl); ok {
+			return value, nil
+		}
+	}
+
+	value, err := fetch()
+	if err != nil || pathErr != nil {
+		return value, err
+	}
+
+	_ = writeCachedResponse(path, value)
+
+	return value, nil
+}
+
+// ClearResponseCache removes every cached response.
+func ClearResponseCache() error {
+	dir, err := responseCacheDir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear response cache: %w", err)
+	}
+
+	return nil
+}
+
+// CacheKeyHash returns a digest of a secret, such as a token, for use as a
+// cache key part without writing the secret to disk.
+func CacheKeyHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// responseCachePath returns the file caching the response of a query
+func responseCachePath(namespace string, key []string) (string, error) {
+	dir, err := responseCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(key, "\x00")))
+
+	return filepath.Join(dir, namespace, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+// readCachedResponse returns the response cached at path if it is younger than ttl
+func readCachedResponse[T any](path string, ttl time.Duration) (T, bool) {
+	var value T
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return value, false
+	}
+
+	var cached cachedResponse
+	if err := json.Unmarshal(data, &cached); err != nil || time.Since(cached.CachedAt) >= ttl {
+		return value, false
+	}
+
+	if err := json.Unmarshal(cached.Data, &value); err != nil {
+		return value, false
+	}
+
+	return value, true
+}
+
+// writeCachedResponse caches value at path. Responses can hold private
+// data, so the cache is readable by the owner only; the file is replaced
+// atomically so concurrent commands never read a partial response.
+func writeCachedResponse(path string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	data, err = json.Marshal(cachedResponse{CachedAt: time.Now(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".response-*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func useTempResponseCache(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	orig := responseCacheDir
+	responseCacheDir = func() (string, error) { return dir, nil }
+
+	t.Cleanup(func() { responseCacheDir = orig })
+
+	return dir
+}
+
+func TestCachedQuery(t *testing.T) {
+	useTempResponseCache(t)
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+
+		return []string{"general", "random"}, nil
+	}
+
+	for range 2 {
+		got, err := CachedQuery("slack-channels", []string{"token-a"}, CacheOptions{}, fetch)
+		if err != nil || len(got) != 2 || got[1] != "random" {
+			t.Fatalf("CachedQuery() = %v, %v", got, err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("fetched %d times, want 1", calls)
+	}
+
+	// Other credentials and --no-cache both miss the cache
+	_, _ = CachedQuery("slack-channels", []string{"token-b"}, CacheOptions{}, fetch)
+	_, _ = CachedQuery("slack-channels", []string{"token-a"}, CacheOptions{NoCache: true}, fetch)
+
+	if calls != 3 {
+		t.Errorf("fetched %d times, want 3", calls)
+	}
+}
+
+func TestCachedQuery_Expired(t *testing.T) {
+	useTempResponseCache(t)
+
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+
+		return calls, nil
+	}
+
+	_, _ = CachedQuery("boards", []string{"42"}, CacheOptions{}, fetch)
+
+	time.Sleep(5 * time.Millisecond)
+
+	got, err := CachedQuery("boards", []string{"42"}, CacheOptions{TTL: time.Millisecond}, fetch)
+	if err != nil || got != 2 {
+		t.Errorf("CachedQuery() after the TTL = %d, %v, want a refetched 2", got, err)
+	}
+}
+
+func TestCachedQuery_ErrorsAreNotCached(t *testing.T) {
+	dir := useTempResponseCache(t)
+
+	_, err := CachedQuery("boards", []string{"42"}, CacheOptions{}, func() (int, error) {
+		return 0, errors.New("rate limited")
+	})
+	if err == nil {
+		t.Fatal("CachedQuery() error = nil, want the fetch error")
+	}
+
+	if entries, _ := os.ReadDir(filepath.Join(dir, "boards")); len(entries) != 0 {
+		t.Errorf("cached %d responses of a failed query", len(entries))
+	}
+}
+
+func TestClearResponseCache(t *testing.T) {
+	dir := useTempResponseCache(t)
+
+	_, _ = CachedQuery("boards", []string{"42"}, CacheOptions{}, func() (int, error) { return 1, nil })
+
+	if err := ClearResponseCache(); err != nil {
+		t.Fatalf("ClearResponseCache() error = %v", err)
+	}
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("cache directory still exists: %v", err)
+	}
+}
@@ -0,0 +1,478 @@
+package core
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// RestoreOptions configures restoring a repository from a backup
+type RestoreOptions struct {
+	Source     string // Archive or bundle file, backup key, or host/owner/repo for the newest backup
+	Dest       string // Backup destination for keys (default: configured)
+	Path       string // Where to restore (default: the original path)
+	Workspace  string // Workspace to register in (default: the original workspace)
+	NoRegister bool   // Restore the files only
+}
+
+// RestoreBackupResult describes a restored repository
+type RestoreBackupResult struct {
+	Source     string   `json:"source"`
+	URL        string   `json:"url"`
+	Path       string   `json:"path"`
+	Workspace  string   `json:"workspace,omitempty"`
+	Branch     string   `json:"branch,omitempty"`
+	Head       string   `json:"head"`
+	Registered bool     `json:"registered"`
+	Warnings   []string `json:"warnings,omitempty"`
+}
+
+// RestoreBackup re-creates a repository from a backup made by BackupRepos
+// (or a bundle with a metadata JSON sidecar) and registers it again with
+// its original URL, workspace, favorite flag and kind.
+func RestoreBackup(ctx context.Context, opts RestoreOptions) (*RestoreBackupResult, error) {
+	tmpDir, err := os.MkdirTemp("", "clonr-restore-*")
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	bundle, meta, source, err := loadRestoreSource(ctx, opts, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	repoPath, root, err := restorePaths(meta, opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := restoreRepository(ctx, bundle, meta, root); err != nil {
+		return nil, err
+	}
+
+	res := &RestoreBackupResult{
+		Source:    source,
+		URL:       meta.Repository.URL,
+		Path:      repoPath,
+		Workspace: meta.Repository.Workspace,
+		Branch:    meta.Branch,
+		Head:      meta.Head,
+	}
+
+	if opts.Workspace != "" {
+		res.Workspace = opts.Workspace
+	}
+
+	if opts.NoRegister || meta.Repository.URL == "" {
+		return res, nil
+	}
+
+	if err := registerRestoredRepo(meta, res); err != nil {
+		return res, fmt.Errorf("repository restored to %s but not registered: %w", root, err)
+	}
+
+	res.Registered = true
+
+	return res, nil
+}
+
+// loadRestoreSource extracts the bundle and metadata of opts.Source into
+// tmpDir. Local files are used as is; anything else is looked up at the
+// backup destination.
+func loadRestoreSource(ctx context.Context, opts RestoreOptions, tmpDir string) (string, *BackupMetadata, string, error) {
+	if info, err := os.Stat(opts.Source); err == nil && !info.IsDir() {
+		source, _ := filepath.Abs(opts.Source)
+
+		if strings.HasSuffix(source, backupSuffix) || strings.HasSuffix(source, ".tgz") {
+			f, err := os.Open(source)
+			if err != nil {
+				return "", nil, "", err
+			}
+
+			defer func() { _ = f.Close() }()
+
+			bundle, meta, err := extractBackupArchive(f, tmpDir)
+
+			return bundle, meta, source, err
+		}
+
+		meta, err := readBundleSidecar(source)
+
+		return source, meta, source, err
+	}
+
+	dest := opts.Dest
+	if dest == "" {
+		cfg, err := GetBackupConfig()
+		if err != nil {
+			return "", nil, "", err
+		}
+
+		dest = cfg.Dest
+	}
+
+	target, err := openBackupTarget(dest)
+	if err != nil {
+		return "", nil, "", fmt.Errorf("%s is not a file and %w", opts.Source, err)
+	}
+
+	key, err := findBackupKey(ctx, target, opts.Source)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	archive := filepath.Join(tmpDir, "backup"+backupSuffix)
+
+	f, err := os.Create(archive)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	if err := target.Get(ctx, key, f); err != nil {
+		return "", nil, "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", nil, "", err
+	}
+
+	bundle, meta, err := extractBackupArchive(f, tmpDir)
+
+	return bundle, meta, target.String() + "/" + key, err
+}
+
+// findBackupKey resolves a backup key, or a repository directory such as
+// github.com/acme/api to its newest backup
+func findBackupKey(ctx context.Context, target backupTarget, query string) (string, error) {
+	query = strings.Trim(filepath.ToSlash(query), "/")
+
+	objects, err := target.List(ctx, query)
+	if err != nil {
+		return "", err
+	}
+
+	var newest *BackupObject
+
+	for i := range objects {
+		if objects[i].Key == query {
+			return query, nil
+		}
+
+		if path.Dir(objects[i].Key) == query {
+			if newest == nil || objects[i].Time.After(newest.Time) {
+				newest = &objects[i]
+			}
+		}
+	}
+
+	if newest == nil {
+		return "", fmt.Errorf("no backup %q in %s (see 'clonr backup list')", query, target)
+	}
+
+	return newest.Key, nil
+}
+
+// extractBackupArchive writes the bundle of a backup archive to dir and
+// returns its path with the decoded metadata
+func extractBackupArchive(r io.Reader, dir string) (string, *BackupMetadata, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", nil, fmt.Errorf("not a backup archive: %w", err)
+	}
+
+	defer func() { _ = gz.Close() }()
+
+	var (
+		bundle string
+		meta   *BackupMetadata
+	)
+
+	tr := tar.NewReader(gz)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read backup archive: %w", err)
+		}
+
+		switch {
+		case hdr.Name == "metadata.json":
+			meta = &BackupMetadata{}
+			if err := json.NewDecoder(tr).Decode(meta); err != nil {
+				return "", nil, fmt.Errorf("invalid backup metadata: %w", err)
+			}
+		case strings.HasSuffix(hdr.Name, ".bundle"):
+			// The name in the archive is not trusted as a path
+			bundle = filepath.Join(dir, "repo.bundle")
+
+			if err := writeFileFrom(bundle, tr); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+
+	if bundle == "" || meta == nil {
+		return "", nil, fmt.Errorf("backup archive is missing the bundle or metadata.json")
+	}
+
+	if meta.Format > backupFormat {
+		return "", nil, fmt.Errorf("backup format %d is newer than supported (%d); upgrade clonr", meta.Format, backupFormat)
+	}
+
+	return bundle, meta, nil
+}
+
+func writeFileFrom(name string, r io.Reader) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// readBundleSidecar reads the metadata of a bare bundle file from
+// <bundle>.json or a metadata.json next to it
+func readBundleSidecar(bundle string) (*BackupMetadata, error) {
+	candidates := []string{
+		bundle + ".json",
+		strings.TrimSuffix(bundle, ".bundle") + ".json",
+		filepath.Join(filepath.Dir(bundle), "metadata.json"),
+	}
+
+	for _, name := range candidates {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			continue
+		}
+
+		var meta BackupMetadata
+		if err := json.Unmarshal(data, &meta); err != nil {
+			return nil, fmt.Errorf("invalid metadata in %s: %w", name, err)
+		}
+
+		return &meta, nil
+	}
+
+	return nil, fmt.Errorf("no metadata found for %s (expected %s or metadata.json next to it)", bundle, filepath.Base(candidates[0]))
+}
+
+// restorePaths returns the tracked path of the restored repository and the
+// root of the clone to create. Monorepo entries are tracked at a
+// subdirectory of the clone; override names the tracked path.
+func restorePaths(meta *BackupMetadata, override string) (repoPath, root string, err error) {
+	_, subdir, _ := strings.Cut(meta.Repository.URL, "#")
+	subdir = filepath.FromSlash(strings.Trim(subdir, "/"))
+
+	repoPath = meta.Repository.Path
+	if override != "" {
+		repoPath = override
+	}
+
+	if repoPath == "" {
+		return "", "", fmt.Errorf("backup has no path; use --path")
+	}
+
+	repoPath, err = filepath.Abs(expandTilde(repoPath))
+	if err != nil {
+		return "", "", err
+	}
+
+	root = repoPath
+	if subdir != "" && strings.HasSuffix(repoPath, string(filepath.Separator)+subdir) {
+		root = strings.TrimSuffix(repoPath, string(filepath.Separator)+subdir)
+	}
+
+	entries, err := os.ReadDir(root)
+	if err == nil && len(entries) > 0 {
+		return "", "", fmt.Errorf("%s already exists and is not empty; use --path", root)
+	}
+
+	return repoPath, root, nil
+}
+
+// restoreRepository creates a repository at root with every ref of the
+// bundle, checks out the recorded branch (or HEAD) and re-adds the remotes
+func restoreRepository(ctx context.Context, bundle string, meta *BackupMetadata, root string) (err error) {
+	_, statErr := os.Stat(root)
+	created := os.IsNotExist(statErr)
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", root, err)
+	}
+
+	// Leave nothing half-restored behind; a directory that existed (empty)
+	// before is only emptied
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		if created {
+			_ = os.RemoveAll(root)
+		} else {
+			_ = os.RemoveAll(filepath.Join(root, ".git"))
+		}
+	}()
+
+	if _, err := runGitCommand("init", "-q", root); err != nil {
+		return err
+	}
+
+	// --update-head-ok: the unborn default branch may be among the refs
+	cmd := exec.CommandContext(ctx, "git", "-C", root, "fetch", "-q", "--update-head-ok", bundle, "refs/*:refs/*")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to restore refs from bundle: %w - %s", err, strings.TrimSpace(string(out)))
+	}
+
+	if meta.Branch != "" {
+		if _, err := runGitCommand("-C", root, "symbolic-ref", "HEAD", "refs/heads/"+meta.Branch); err != nil {
+			return err
+		}
+
+		if _, err := runGitCommand("-C", root, "reset", "-q", "--hard"); err != nil {
+			return fmt.Errorf("failed to check out %s: %w", meta.Branch, err)
+		}
+	} else if _, err := runGitCommand("-C", root, "-c", "advice.detachedHead=false", "checkout", "-q", "--detach", meta.Head); err != nil {
+		return fmt.Errorf("failed to check out %s: %w", meta.Head, err)
+	}
+
+	remotes := meta.Remotes
+	if len(remotes) == 0 && meta.Repository.URL != "" {
+		repoURL, _, _ := strings.Cut(meta.Repository.URL, "#")
+		remotes = map[string]string{"origin": repoURL}
+	}
+
+	names := make([]string, 0, len(remotes))
+	for name := range remotes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err := runGitCommand("-C", root, "remote", "add", name, remotes[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerRestoredRepo tracks the restored repository with the metadata of
+// the backup. A repository still tracked at a path that no longer exists is
+// pointed at the restored clone.
+func registerRestoredRepo(meta *BackupMetadata, res *RestoreBackupResult) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	u, err := url.Parse(meta.Repository.URL)
+	if err != nil {
+		return fmt.Errorf("invalid repository URL %q: %w", meta.Repository.URL, err)
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	idx := slices.IndexFunc(repos, func(r model.Repository) bool { return r.URL == u.String() })
+
+	switch {
+	case idx >= 0 && repos[idx].Path != res.Path:
+		if _, err := os.Stat(repos[idx].Path); err == nil {
+			return fmt.Errorf("already tracked at %s", repos[idx].Path)
+		}
+
+		if err := client.UpdateRepoPath(u.String(), res.Path); err != nil {
+			return err
+		}
+
+		res.Warnings = append(res.Warnings, fmt.Sprintf("tracked path moved from %s", repos[idx].Path))
+	case idx < 0:
+		if res.Workspace != "" {
+			if exists, err := client.WorkspaceExists(res.Workspace); err != nil || !exists {
+				res.Warnings = append(res.Warnings, fmt.Sprintf("workspace %q not found; registered without a workspace", res.Workspace))
+				res.Workspace = ""
+			}
+		}
+
+		if err := client.SaveRepoWithWorkspace(u, res.Path, res.Workspace); err != nil {
+			return err
+		}
+	}
+
+	if meta.Repository.Favorite {
+		if err := client.SetFavoriteByURL(u.String(), true); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore favorite: "+err.Error())
+		}
+	}
+
+	if meta.Repository.Kind != "" {
+		if err := client.SetRepoKind(u.String(), meta.Repository.Kind); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore kind: "+err.Error())
+		}
+	}
+
+	if meta.Repository.UpstreamURL != "" {
+		if err := client.SetRepoUpstream(u.String(), meta.Repository.UpstreamURL); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore upstream: "+err.Error())
+		}
+	}
+
+	if meta.Repository.License != "" {
+		if err := client.SetRepoLicense(u.String(), meta.Repository.License); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore license: "+err.Error())
+		}
+	}
+
+	if len(meta.Repository.Tags) > 0 {
+		if err := client.SetRepoTags(u.String(), meta.Repository.Tags); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore tags: "+err.Error())
+		}
+	}
+
+	if meta.Repository.Notes != "" {
+		if err := client.SetRepoNotes(u.String(), meta.Repository.Notes); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore notes: "+err.Error())
+		}
+	}
+
+	if meta.Repository.Alias != "" {
+		if err := client.SetRepoAlias(u.String(), meta.Repository.Alias); err != nil {
+			res.Warnings = append(res.Warnings, "failed to restore alias: "+err.Error())
+		}
+	}
+
+	return nil
+}
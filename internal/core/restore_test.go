@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v: %s", args, err, out)
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+func TestBackupRestoreRoundTrip(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	src := t.TempDir()
+	commit := []string{"-c", "user.name=T", "-c", "user.email=t@example.com", "commit", "-q", "--allow-empty", "-m"}
+
+	gitOutput(t, src, "init", "-q", "-b", "main")
+
+	if err := os.WriteFile(filepath.Join(src, "README"), []byte("hello\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	gitOutput(t, src, "add", "README")
+	gitOutput(t, src, append(commit, "init")...)
+	gitOutput(t, src, "tag", "v1.0.0")
+	gitOutput(t, src, "checkout", "-q", "-b", "feature")
+	gitOutput(t, src, append(commit, "feature work")...)
+	gitOutput(t, src, "remote", "add", "origin", "https://github.com/acme/api.git")
+	gitOutput(t, src, "remote", "add", "upstream", "https://github.com/upstream/api.git")
+
+	repo := &model.Repository{URL: "https://github.com/acme/api", Path: src, Workspace: "work", Favorite: true}
+
+	archive := filepath.Join(t.TempDir(), "api"+backupSuffix)
+
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeBackupArchive(context.Background(), f, repo, time.Now()); err != nil {
+		t.Fatalf("writeBackupArchive() error = %v", err)
+	}
+
+	if _, err := f.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, meta, err := extractBackupArchive(f, t.TempDir())
+	_ = f.Close()
+
+	if err != nil {
+		t.Fatalf("extractBackupArchive() error = %v", err)
+	}
+
+	if meta.Repository.Workspace != "work" || !meta.Repository.Favorite {
+		t.Errorf("metadata repository = %+v", meta.Repository)
+	}
+
+	dst := filepath.Join(t.TempDir(), "restored")
+
+	repoPath, root, err := restorePaths(meta, dst)
+	if err != nil || repoPath != dst || root != dst {
+		t.Fatalf("restorePaths() = %q, %q, %v", repoPath, root, err)
+	}
+
+	if err := restoreRepository(context.Background(), bundle, meta, root); err != nil {
+		t.Fatalf("restoreRepository() error = %v", err)
+	}
+
+	if got := gitOutput(t, dst, "symbolic-ref", "--short", "HEAD"); got != "feature" {
+		t.Errorf("branch = %q, want feature", got)
+	}
+
+	if got := gitOutput(t, dst, "rev-parse", "HEAD"); got != meta.Head {
+		t.Errorf("HEAD = %q, want %q", got, meta.Head)
+	}
+
+	if got := gitOutput(t, dst, "status", "--porcelain"); got != "" {
+		t.Errorf("working tree not clean:\n%s", got)
+	}
+
+	if got := gitOutput(t, dst, "tag"); got != "v1.0.0" {
+		t.Errorf("tags = %q", got)
+	}
+
+	if got := gitOutput(t, dst, "branch", "--format=%(refname:short)"); got != "feature\nmain" {
+		t.Errorf("branches = %q", got)
+	}
+
+	if got := gitOutput(t, dst, "remote", "get-url", "upstream"); got != "https://github.com/upstream/api.git" {
+		t.Errorf("upstream = %q", got)
+	}
+
+	if _, _, err := restorePaths(meta, dst); err == nil {
+		t.Error("restorePaths() into a non-empty directory: expected error")
+	}
+}
+
+func TestRestorePathsSubdirectory(t *testing.T) {
+	base := t.TempDir()
+	meta := &BackupMetadata{Repository: model.Repository{
+		URL:  "https://github.com/acme/mono#services/billing",
+		Path: filepath.Join(base, "mono", "services", "billing"),
+	}}
+
+	repoPath, root, err := restorePaths(meta, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repoPath != meta.Repository.Path || root != filepath.Join(base, "mono") {
+		t.Errorf("restorePaths() = %q, %q", repoPath, root)
+	}
+}
+
+func TestReadBundleSidecar(t *testing.T) {
+	dir := t.TempDir()
+	bundle := filepath.Join(dir, "api.bundle")
+
+	if _, err := readBundleSidecar(bundle); err == nil {
+		t.Error("expected error without sidecar")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "api.json"), []byte(`{"format":1,"repository":{"url":"https://github.com/acme/api"},"head":"abc"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := readBundleSidecar(bundle)
+	if err != nil || meta.Repository.URL != "https://github.com/acme/api" {
+		t.Errorf("readBundleSidecar() = %+v, %v", meta, err)
+	}
+}
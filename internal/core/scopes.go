@@ -0,0 +1,95 @@
+package core
+
+import (
+	"slices"
+	"strings"
+)
+
+// ScopeCheck is the result of comparing the scopes granted to a token
+// against the scopes clonr needs.
+type ScopeCheck struct {
+	// Granted are the scopes reported by the provider
+	Granted []string
+
+	// Missing are required scopes the token does not have
+	Missing []string
+
+	// Excess are granted scopes clonr does not need
+	Excess []string
+
+	// Unknown is true when the provider did not report scopes
+	Unknown bool
+}
+
+// OK reports whether all required scopes are granted.
+func (c ScopeCheck) OK() bool {
+	return !c.Unknown && len(c.Missing) == 0
+}
+
+// GitHubImpliedScopes maps GitHub OAuth scopes to the narrower scopes they include.
+var GitHubImpliedScopes = map[string][]string{
+	"repo":             {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:org":        {"write:org", "read:org", "manage_runners:org"},
+	"write:org":        {"read:org"},
+	"admin:public_key": {"write:public_key", "read:public_key"},
+	"write:public_key": {"read:public_key"},
+	"admin:repo_hook":  {"write:repo_hook", "read:repo_hook"},
+	"write:repo_hook":  {"read:repo_hook"},
+	"user":             {"read:user", "user:email", "user:follow"},
+	"write:packages":   {"read:packages"},
+	"admin:gpg_key":    {"write:gpg_key", "read:gpg_key"},
+	"write:gpg_key":    {"read:gpg_key"},
+	"write:discussion": {"read:discussion"},
+}
+
+// ParseScopes splits a scope list separated by commas and/or whitespace.
+func ParseScopes(s string) []string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+
+	scopes := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !slices.Contains(scopes, f) {
+			scopes = append(scopes, f)
+		}
+	}
+
+	return scopes
+}
+
+// CheckScopes compares granted scopes against required ones. A required scope
+// is satisfied when granted directly or through a broader scope in implied;
+// granted scopes broader than required are reported as excess.
+// A nil granted list means the provider did not report scopes.
+func CheckScopes(granted, required []string, implied map[string][]string) ScopeCheck {
+	check := ScopeCheck{Granted: granted}
+
+	if granted == nil {
+		check.Unknown = true
+
+		return check
+	}
+
+	covers := func(have, want string) bool {
+		return have == want || slices.Contains(implied[have], want)
+	}
+
+	for _, want := range required {
+		if !slices.ContainsFunc(granted, func(have string) bool { return covers(have, want) }) {
+			check.Missing = append(check.Missing, want)
+		}
+	}
+
+	for _, have := range granted {
+		needed := slices.ContainsFunc(required, func(want string) bool {
+			return covers(want, have)
+		})
+
+		if !needed {
+			check.Excess = append(check.Excess, have)
+		}
+	}
+
+	return check
+}
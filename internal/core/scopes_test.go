@@ -0,0 +1,63 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseScopes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{input: "", want: []string{}},
+		{input: "repo, read:org", want: []string{"repo", "read:org"}},
+		{input: "a b,c  a", want: []string{"a", "b", "c"}},
+	}
+
+	for _, tt := range tests {
+		if got := ParseScopes(tt.input); !slices.Equal(got, tt.want) {
+			t.Errorf("ParseScopes(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestCheckScopes(t *testing.T) {
+	required := []string{"repo", "read:org", "read:user"}
+
+	tests := []struct {
+		name        string
+		granted     []string
+		wantMissing []string
+		wantExcess  []string
+		wantUnknown bool
+	}{
+		{name: "exact", granted: []string{"repo", "read:org", "read:user"}},
+		{name: "implied", granted: []string{"repo", "write:org", "user"}, wantExcess: []string{"write:org", "user"}},
+		{name: "missing", granted: []string{"public_repo", "read:org"}, wantMissing: []string{"repo", "read:user"}},
+		{name: "excess", granted: []string{"repo", "read:org", "read:user", "delete_repo"}, wantExcess: []string{"delete_repo"}},
+		{name: "unknown", granted: nil, wantUnknown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CheckScopes(tt.granted, required, GitHubImpliedScopes)
+
+			if got.Unknown != tt.wantUnknown {
+				t.Errorf("Unknown = %v, want %v", got.Unknown, tt.wantUnknown)
+			}
+
+			if !slices.Equal(got.Missing, tt.wantMissing) {
+				t.Errorf("Missing = %v, want %v", got.Missing, tt.wantMissing)
+			}
+
+			if !slices.Equal(got.Excess, tt.wantExcess) {
+				t.Errorf("Excess = %v, want %v", got.Excess, tt.wantExcess)
+			}
+
+			if got.OK() != (len(tt.wantMissing) == 0 && !tt.wantUnknown) {
+				t.Errorf("OK() = %v", got.OK())
+			}
+		})
+	}
+}
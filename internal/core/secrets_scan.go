@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/security"
+)
+
+// SecretScanOptions configures ScanRepoSecrets
+type SecretScanOptions struct {
+	History bool // Scan the git history instead of the working tree
+}
+
+// SecretScanResult lists the secrets found in one repository
+type SecretScanResult struct {
+	URL      string             `json:"url"`
+	Path     string             `json:"path"`
+	Findings []security.Finding `json:"findings"`
+	Error    string             `json:"error,omitempty"`
+}
+
+// ScanRepoSecrets scans the working tree, or the history, of each
+// repository with the built-in gitleaks rules (regular expressions and
+// entropy checks for cloud keys, tokens, private keys and the like),
+// honoring each repository's .gitleaksignore. Finding paths are relative
+// to the repository.
+func ScanRepoSecrets(ctx context.Context, repos []model.Repository, opts SecretScanOptions) ([]SecretScanResult, error) {
+	results := make([]SecretScanResult, 0, len(repos))
+
+	for _, repo := range repos {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		res := SecretScanResult{URL: repo.URL, Path: repo.Path, Findings: []security.Finding{}}
+
+		// The scanner skips missing paths silently; a repository that was not
+		// scanned must not pass as clean
+		if err := checkSecretScanPath(repo.Path, opts.History); err != nil {
+			res.Error = err.Error()
+			results = append(results, res)
+
+			continue
+		}
+
+		// A scanner per repository keeps .gitleaksignore entries apart
+		scanner, err := security.NewLeakScanner()
+		if err != nil {
+			return results, err
+		}
+
+		_ = scanner.LoadGitleaksIgnore(repo.Path)
+
+		var scan *security.ScanResult
+		if opts.History {
+			scan, err = scanner.ScanGitRepo(ctx, repo.Path)
+		} else {
+			scan, err = scanner.ScanDirectory(ctx, repo.Path)
+		}
+
+		if err != nil {
+			res.Error = err.Error()
+			results = append(results, res)
+
+			continue
+		}
+
+		for _, f := range scan.Findings {
+			if rel, err := filepath.Rel(scan.ScannedPath, f.File); err == nil && filepath.IsAbs(f.File) {
+				f.File = rel
+			}
+
+			res.Findings = append(res.Findings, f)
+		}
+
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// checkSecretScanPath verifies that path is a directory and, to scan the
+// history, a git repository
+func checkSecretScanPath(path string, history bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("repository not found: %w", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("repository path is not a directory: %s", path)
+	}
+
+	if history {
+		if _, err := os.Stat(filepath.Join(path, ".git")); err != nil {
+			return fmt.Errorf("not a git repository: %s", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+const shareSecretFile = "share_secret"
+
+// DefaultShareTTL is how long a share link stays valid by default
+const DefaultShareTTL = 24 * time.Hour
+
+// ErrShareLinkInvalid is returned when a share link is unknown, tampered
+// with, expired or already opened.
+var ErrShareLinkInvalid = errors.New("share link is invalid, expired or already used")
+
+// ShareLinkStore keeps share links. Links are created by the CLI and opened
+// through the web server, so both go through the server's store, which
+// consumes a link atomically.
+type ShareLinkStore interface {
+	SaveShareLink(link *model.ShareLink) error
+	GetShareLink(id string) (*model.ShareLink, error)
+	ConsumeShareLink(id string) (*model.ShareLink, error)
+}
+
+// ShareView is what a share link shows
+type ShareView struct {
+	URL           string    `json:"url"`
+	Name          string    `json:"name"`
+	Branch        string    `json:"branch,omitempty"`
+	DefaultBranch string    `json:"default_branch,omitempty"`
+	Language      string    `json:"language,omitempty"`
+	Note          string    `json:"note,omitempty"`
+	Setup         []string  `json:"setup"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// setupCommands maps a project language to the commands that prepare a fresh clone
+var setupCommands = map[string][]string{
+	"go":         {"go mod download", "go build ./..."},
+	"rust":       {"cargo build"},
+	"typescript": {"npm install"},
+	"javascript": {"npm install"},
+	"kotlin":     {"./gradlew build"},
+	"ruby":       {"bundle install"},
+	"php":        {"composer install"},
+	"elixir":     {"mix deps.get"},
+	"swift":      {"swift build"},
+	"dart":       {"dart pub get"},
+	"c++":        {"cmake -B build", "cmake --build build"},
+}
+
+// CreateShareLink records a one-time share link for repo valid for ttl
+func CreateShareLink(repo model.Repository, note string, ttl time.Duration) (*model.ShareLink, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return createShareLink(client, repo, note, ttl)
+}
+
+func createShareLink(db ShareLinkStore, repo model.Repository, note string, ttl time.Duration) (*model.ShareLink, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("expiry must be positive")
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate share link ID: %w", err)
+	}
+
+	now := time.Now()
+	link := &model.ShareLink{
+		ID:        hex.EncodeToString(id),
+		RepoURL:   repo.URL,
+		Note:      strings.TrimSpace(note),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	if err := db.SaveShareLink(link); err != nil {
+		return nil, fmt.Errorf("failed to save share link: %w", err)
+	}
+
+	return link, nil
+}
+
+// ShareURL returns the signed URL of link served under baseURL,
+// e.g. http://10.0.0.5:8080/share/<id>?exp=...&sig=...
+func ShareURL(baseURL string, link *model.ShareLink) (string, error) {
+	secret, err := loadShareSecret()
+	if err != nil {
+		return "", err
+	}
+
+	exp := strconv.FormatInt(link.ExpiresAt.Unix(), 10)
+
+	q := url.Values{}
+	q.Set("exp", exp)
+	q.Set("sig", signShareLink(secret, link.ID, exp))
+
+	return strings.TrimRight(baseURL, "/") + "/share/" + link.ID + "?" + q.Encode(), nil
+}
+
+// CheckShareLink verifies the signature and expiry of a share link without
+// consuming it. Link previews (chat unfurling, mail scanners) fetch links
+// too, so opening a share link takes a second, explicit step.
+func CheckShareLink(db ShareLinkStore, id, exp, sig string) error {
+	_, err := findShareLink(db, id, exp, sig, false)
+	return err
+}
+
+// RedeemShareLink verifies the signature and expiry of a share link and
+// consumes it, so it can be opened only once.
+func RedeemShareLink(db ShareLinkStore, id, exp, sig string) (*model.ShareLink, error) {
+	return findShareLink(db, id, exp, sig, true)
+}
+
+// findShareLink looks up a valid share link, removing it when consume is set
+func findShareLink(db ShareLinkStore, id, exp, sig string, consume bool) (*model.ShareLink, error) {
+	secret, err := loadShareSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if !hmac.Equal([]byte(sig), []byte(signShareLink(secret, id, exp))) {
+		return nil, ErrShareLinkInvalid
+	}
+
+	var link *model.ShareLink
+
+	if consume {
+		link, err = db.ConsumeShareLink(id)
+	} else {
+		link, err = db.GetShareLink(id)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up share link: %w", err)
+	}
+
+	if link == nil || !time.Now().Before(link.ExpiresAt) || strconv.FormatInt(link.ExpiresAt.Unix(), 10) != exp {
+		return nil, ErrShareLinkInvalid
+	}
+
+	return link, nil
+}
+
+// BuildShareView collects the read-only information a share link shows
+func BuildShareView(link *model.ShareLink, repo model.Repository) ShareView {
+	view := ShareView{
+		URL:       repo.URL,
+		Name:      shareRepoName(repo.URL),
+		Note:      link.Note,
+		ExpiresAt: link.ExpiresAt,
+	}
+
+	if branch, err := GetCurrentBranch(repo.Path); err == nil && branch != "HEAD" {
+		view.Branch = branch
+	}
+
+	view.DefaultBranch = DefaultBranch(repo.Path)
+	view.Language = DetectLanguage(repo.Path)
+	view.Setup = ShareSetupSteps(repo, view.Language)
+
+	return view
+}
+
+// ShareSetupSteps returns the commands to clone repo and prepare it for
+// development, based on its project language.
+func ShareSetupSteps(repo model.Repository, language string) []string {
+	steps := []string{
+		"git clone " + repo.URL,
+		"cd " + shareRepoName(repo.URL),
+	}
+
+	switch language {
+	case "python":
+		if _, err := os.Stat(filepath.Join(repo.Path, "requirements.txt")); err == nil {
+			return append(steps, "pip install -r requirements.txt")
+		}
+
+		return append(steps, "pip install -e .")
+	case "java":
+		if _, err := os.Stat(filepath.Join(repo.Path, "pom.xml")); err == nil {
+			return append(steps, "mvn install")
+		}
+
+		return append(steps, "./gradlew build")
+	}
+
+	return append(steps, setupCommands[language]...)
+}
+
+// shareRepoName returns the directory name git clone creates for rawURL
+func shareRepoName(rawURL string) string {
+	name := strings.TrimSuffix(strings.TrimRight(rawURL, "/"), ".git")
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+// signShareLink signs a share link ID and expiry with the server secret
+func signShareLink(secret []byte, id, exp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id + "." + exp))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// loadShareSecret returns the share link signing key, creating it on first use
+func loadShareSecret() ([]byte, error) {
+	p := filepath.Join(params.AppdataDir, shareSecretFile)
+
+	secret, err := os.ReadFile(p)
+	if err == nil && len(secret) > 0 {
+		return secret, nil
+	}
+
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read share secret: %w", err)
+	}
+
+	secret = make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate share secret: %w", err)
+	}
+
+	if err := encoding.WriteFileSecure(p, secret); err != nil {
+		return nil, fmt.Errorf("failed to save share secret: %w", err)
+	}
+
+	return secret, nil
+}
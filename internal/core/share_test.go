@@ -0,0 +1,197 @@
+package core
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+func useTempAppdata(t *testing.T) {
+	t.Helper()
+
+	old := params.AppdataDir
+	params.AppdataDir = t.TempDir()
+
+	t.Cleanup(func() { params.AppdataDir = old })
+}
+
+// memShareStore is an in-memory ShareLinkStore
+type memShareStore map[string]model.ShareLink
+
+func (m memShareStore) SaveShareLink(link *model.ShareLink) error {
+	m[link.ID] = *link
+	return nil
+}
+
+func (m memShareStore) GetShareLink(id string) (*model.ShareLink, error) {
+	link, ok := m[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &link, nil
+}
+
+func (m memShareStore) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	link, ok := m[id]
+	if !ok {
+		return nil, nil
+	}
+
+	delete(m, id)
+
+	return &link, nil
+}
+
+// parseShareURL splits a share URL into its ID, expiry and signature
+func parseShareURL(t *testing.T, raw string) (id, exp, sig string) {
+	t.Helper()
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("url.Parse(%q) error = %v", raw, err)
+	}
+
+	return strings.TrimPrefix(u.Path, "/share/"), u.Query().Get("exp"), u.Query().Get("sig")
+}
+
+func TestShareLinkOpensOnce(t *testing.T) {
+	useTempAppdata(t)
+
+	db := memShareStore{}
+	repo := model.Repository{URL: "https://github.com/org/api", Path: t.TempDir()}
+
+	link, err := createShareLink(db, repo, "ask for the .env file", time.Hour)
+	if err != nil {
+		t.Fatalf("createShareLink() error = %v", err)
+	}
+
+	raw, err := ShareURL("http://10.0.0.5:8080/", link)
+	if err != nil {
+		t.Fatalf("ShareURL() error = %v", err)
+	}
+
+	if !strings.HasPrefix(raw, "http://10.0.0.5:8080/share/"+link.ID+"?") {
+		t.Errorf("ShareURL() = %q", raw)
+	}
+
+	id, exp, sig := parseShareURL(t, raw)
+
+	if err := CheckShareLink(db, id, exp, sig); err != nil {
+		t.Fatalf("CheckShareLink() error = %v", err)
+	}
+
+	got, err := RedeemShareLink(db, id, exp, sig)
+	if err != nil {
+		t.Fatalf("RedeemShareLink() error = %v", err)
+	}
+
+	if got.RepoURL != repo.URL || got.Note != "ask for the .env file" {
+		t.Errorf("RedeemShareLink() = %+v", got)
+	}
+
+	if _, err := RedeemShareLink(db, id, exp, sig); !errors.Is(err, ErrShareLinkInvalid) {
+		t.Errorf("second RedeemShareLink() error = %v, want ErrShareLinkInvalid", err)
+	}
+}
+
+func TestShareLinkRejectsTampering(t *testing.T) {
+	useTempAppdata(t)
+
+	db := memShareStore{}
+
+	link, err := createShareLink(db, model.Repository{URL: "https://github.com/org/api"}, "", time.Hour)
+	if err != nil {
+		t.Fatalf("createShareLink() error = %v", err)
+	}
+
+	raw, err := ShareURL("http://localhost:8080", link)
+	if err != nil {
+		t.Fatalf("ShareURL() error = %v", err)
+	}
+
+	id, exp, sig := parseShareURL(t, raw)
+
+	tests := []struct {
+		name         string
+		id, exp, sig string
+	}{
+		{"bad signature", id, exp, strings.Repeat("0", len(sig))},
+		{"extended expiry", id, "9999999999", sig},
+		{"other ID", strings.Repeat("a", len(id)), exp, sig},
+		{"missing signature", id, exp, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckShareLink(db, tt.id, tt.exp, tt.sig); !errors.Is(err, ErrShareLinkInvalid) {
+				t.Errorf("CheckShareLink() error = %v, want ErrShareLinkInvalid", err)
+			}
+		})
+	}
+
+	// The untampered link is still usable
+	if _, err := RedeemShareLink(db, id, exp, sig); err != nil {
+		t.Errorf("RedeemShareLink() error = %v", err)
+	}
+}
+
+func TestShareLinkExpires(t *testing.T) {
+	useTempAppdata(t)
+
+	db := memShareStore{}
+
+	link, err := createShareLink(db, model.Repository{URL: "https://github.com/org/api"}, "", time.Millisecond)
+	if err != nil {
+		t.Fatalf("createShareLink() error = %v", err)
+	}
+
+	raw, err := ShareURL("http://localhost:8080", link)
+	if err != nil {
+		t.Fatalf("ShareURL() error = %v", err)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	id, exp, sig := parseShareURL(t, raw)
+
+	if _, err := RedeemShareLink(db, id, exp, sig); !errors.Is(err, ErrShareLinkInvalid) {
+		t.Errorf("RedeemShareLink() error = %v, want ErrShareLinkInvalid", err)
+	}
+}
+
+func TestShareSetupSteps(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte("requests\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		url      string
+		language string
+		want     []string
+	}{
+		{"go", "https://github.com/org/api.git", "go", []string{"git clone https://github.com/org/api.git", "cd api", "go mod download", "go build ./..."}},
+		{"ssh url", "git@github.com:org/web.git", "javascript", []string{"git clone git@github.com:org/web.git", "cd web", "npm install"}},
+		{"python requirements", "https://github.com/org/ml", "python", []string{"git clone https://github.com/org/ml", "cd ml", "pip install -r requirements.txt"}},
+		{"unknown", "https://github.com/org/docs", "", []string{"git clone https://github.com/org/docs", "cd docs"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ShareSetupSteps(model.Repository{URL: tt.url, Path: dir}, tt.language)
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("ShareSetupSteps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
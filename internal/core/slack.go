@@ -234,22 +234,3 @@ func (m *SlackManager) GetSender() (notify.Sender, error) {
 
 	return notify.NewSlackSender(opts...), nil
 }
-
-// InitializeNotifications sets up the global notification dispatcher with Slack.
-func InitializeNotifications() error {
-	manager, err := NewSlackManager()
-	if err != nil {
-		return err
-	}
-
-	sender, err := manager.GetSender()
-	if err != nil {
-		return err
-	}
-
-	if sender != nil {
-		notify.GetDispatcher().Register(sender)
-	}
-
-	return nil
-}
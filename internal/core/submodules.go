@@ -0,0 +1,189 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// SubmoduleState describes a submodule checkout relative to the commit
+// recorded by the superproject
+type SubmoduleState string
+
+const (
+	// SubmoduleInSync is checked out at the recorded commit
+	SubmoduleInSync SubmoduleState = "in-sync"
+
+	// SubmoduleOutOfSync is checked out at another commit
+	SubmoduleOutOfSync SubmoduleState = "out-of-sync"
+
+	// SubmoduleUninitialized has not been cloned
+	SubmoduleUninitialized SubmoduleState = "uninitialized"
+
+	// SubmoduleConflict has merge conflicts
+	SubmoduleConflict SubmoduleState = "conflict"
+)
+
+// SubmoduleStatus is the state of one submodule of a repository
+type SubmoduleStatus struct {
+	Path   string         `json:"path"`
+	Commit string         `json:"commit"`
+	State  SubmoduleState `json:"state"`
+}
+
+// RepoStatus summarizes the working tree of a tracked repository
+type RepoStatus struct {
+	URL        string            `json:"url"`
+	Path       string            `json:"path"`
+	Branch     string            `json:"branch,omitempty"`
+	Changes    int               `json:"changes"`
+	Submodules []SubmoduleStatus `json:"submodules,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// SubmodulesOutOfSync returns the submodules that are not checked out at
+// their recorded commit, including uninitialized ones
+func (s *RepoStatus) SubmodulesOutOfSync() []SubmoduleStatus {
+	var out []SubmoduleStatus
+
+	for _, sub := range s.Submodules {
+		if sub.State != SubmoduleInSync {
+			out = append(out, sub)
+		}
+	}
+
+	return out
+}
+
+// HasSubmodules reports whether the repository at path declares submodules
+func HasSubmodules(path string) bool {
+	_, err := os.Stat(filepath.Join(path, ".gitmodules"))
+
+	return err == nil
+}
+
+// ListSubmodules returns the state of the submodules of the repository at
+// path, recursively. A repository without submodules has none.
+func ListSubmodules(path string) ([]SubmoduleStatus, error) {
+	if !HasSubmodules(path) {
+		return nil, nil
+	}
+
+	output, err := runGitCommand("-C", path, "submodule", "status", "--recursive")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSubmoduleStatus(output), nil
+}
+
+// parseSubmoduleStatus parses the output of 'git submodule status', whose
+// lines are a state prefix, the commit, the path and an optional describe
+func parseSubmoduleStatus(output string) []SubmoduleStatus {
+	var subs []SubmoduleStatus
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		fields := strings.Fields(line[1:])
+		if len(fields) < 2 {
+			continue
+		}
+
+		sub := SubmoduleStatus{Commit: fields[0], Path: fields[1], State: SubmoduleInSync}
+
+		switch line[0] {
+		case '-':
+			sub.State = SubmoduleUninitialized
+		case '+':
+			sub.State = SubmoduleOutOfSync
+		case 'U':
+			sub.State = SubmoduleConflict
+		}
+
+		subs = append(subs, sub)
+	}
+
+	return subs
+}
+
+// UpdateSubmodules checks out the recorded commit of every submodule of the
+// repository at path, recursively. With init, uninitialized submodules are
+// cloned as well; otherwise only the ones already cloned are updated.
+func UpdateSubmodules(ctx context.Context, path string, init bool) error {
+	args := []string{"-C", path, "submodule", "update", "--recursive"}
+	if init {
+		args = append(args, "--init")
+	}
+
+	output, err := exec.CommandContext(ctx, "git", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git submodule update failed: %v - %s", err, output)
+	}
+
+	return nil
+}
+
+// GetRepoStatus returns the branch, uncommitted changes and submodule
+// states of a tracked repository. Unreadable repositories are reported in
+// the Error field.
+func GetRepoStatus(repo *model.Repository) RepoStatus {
+	status := RepoStatus{URL: repo.URL, Path: repo.Path}
+
+	if err := validateGitRepo(repo.Path); err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	if branch, err := runGitCommand("-C", repo.Path, "symbolic-ref", "--quiet", "--short", "HEAD"); err == nil {
+		status.Branch = strings.TrimSpace(branch)
+	}
+
+	// Submodules are reported separately
+	if output, err := runGitCommand("-C", repo.Path, "status", "--porcelain", "--ignore-submodules=all"); err == nil {
+		for line := range strings.SplitSeq(output, "\n") {
+			if strings.TrimSpace(line) != "" {
+				status.Changes++
+			}
+		}
+	}
+
+	subs, err := ListSubmodules(repo.Path)
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	status.Submodules = subs
+
+	return status
+}
+
+// submoduleCloneHint tells how to fetch the submodules of a repository
+// cloned without them, or returns "" when there is nothing to fetch
+func submoduleCloneHint(path string) string {
+	subs, err := ListSubmodules(path)
+	if err != nil {
+		return ""
+	}
+
+	uninitialized := 0
+
+	for _, sub := range subs {
+		if sub.State == SubmoduleUninitialized {
+			uninitialized++
+		}
+	}
+
+	if uninitialized == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("Repository has %d uninitialized submodule(s); fetch them with: git -C %s submodule update --init --recursive (or clone with --recurse-submodules)", uninitialized, path)
+}
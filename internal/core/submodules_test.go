@@ -0,0 +1,51 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSubmoduleStatus(t *testing.T) {
+	output := ` 1111111111111111111111111111111111111111 lib/in-sync (v1.0.0)
++2222222222222222222222222222222222222222 lib/moved (v1.0.0-3-g2222222)
+-3333333333333333333333333333333333333333 lib/missing
+U4444444444444444444444444444444444444444 lib/conflict
+`
+
+	want := []SubmoduleStatus{
+		{Path: "lib/in-sync", Commit: "1111111111111111111111111111111111111111", State: SubmoduleInSync},
+		{Path: "lib/moved", Commit: "2222222222222222222222222222222222222222", State: SubmoduleOutOfSync},
+		{Path: "lib/missing", Commit: "3333333333333333333333333333333333333333", State: SubmoduleUninitialized},
+		{Path: "lib/conflict", Commit: "4444444444444444444444444444444444444444", State: SubmoduleConflict},
+	}
+
+	got := parseSubmoduleStatus(output)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSubmoduleStatus() = %+v, want %+v", got, want)
+	}
+
+	status := RepoStatus{Submodules: got}
+	if n := len(status.SubmodulesOutOfSync()); n != 3 {
+		t.Errorf("SubmodulesOutOfSync() = %d submodules, want 3", n)
+	}
+}
+
+func TestListSubmodules(t *testing.T) {
+	upstream, _, fork := initForkTestRepos(t)
+
+	if subs, err := ListSubmodules(fork); err != nil || subs != nil {
+		t.Fatalf("ListSubmodules(no submodules) = %v, %v", subs, err)
+	}
+
+	forkTestGit(t, fork, "-c", "protocol.file.allow=always", "submodule", "add", "-q", upstream, "lib/upstream")
+	forkTestGit(t, fork, "commit", "-q", "-m", "add submodule")
+
+	subs, err := ListSubmodules(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subs) != 1 || subs[0].Path != "lib/upstream" || subs[0].State != SubmoduleInSync {
+		t.Errorf("ListSubmodules() = %+v, want lib/upstream in sync", subs)
+	}
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+)
+
+// maxTagLength bounds a tag so it fits the list views
+const maxTagLength = 32
+
+// ParseTagChanges parses a comma or space separated list of tags. Tags
+// prefixed with '-' are to be removed, the others added. Tags are lowercased.
+func ParseTagChanges(s string) (add, remove []string, err error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+	for _, field := range fields {
+		tag, removed := strings.CutPrefix(field, "-")
+		tag = strings.ToLower(tag)
+
+		if err := validateTag(tag); err != nil {
+			return nil, nil, err
+		}
+
+		if removed {
+			remove = append(remove, tag)
+		} else {
+			add = append(add, tag)
+		}
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, nil, fmt.Errorf("no tags given")
+	}
+
+	return add, remove, nil
+}
+
+// validateTag accepts letters, digits and - _ . / : up to maxTagLength
+func validateTag(tag string) error {
+	if tag == "" {
+		return fmt.Errorf("empty tag")
+	}
+
+	if len(tag) > maxTagLength {
+		return fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+	}
+
+	for _, r := range tag {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', strings.ContainsRune("-_./:", r):
+		default:
+			return fmt.Errorf("tag %q: invalid character %q", tag, r)
+		}
+	}
+
+	return nil
+}
+
+// ApplyTagChanges returns current with add included and remove left out,
+// sorted and without duplicates
+func ApplyTagChanges(current, add, remove []string) []string {
+	tags := make([]string, 0, len(current)+len(add))
+
+	for _, tag := range slices.Concat(current, add) {
+		if !slices.Contains(remove, tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	slices.Sort(tags)
+
+	return slices.Compact(tags)
+}
+
+// SetRepoTags replaces the tags of the repository with the given URL
+func SetRepoTags(urlStr string, tags []string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	return client.SetRepoTags(urlStr, tags)
+}
@@ -0,0 +1,31 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseTagChanges(t *testing.T) {
+	add, remove, err := ParseTagChanges("Go, work -old,cli")
+	if err != nil {
+		t.Fatalf("ParseTagChanges() error = %v", err)
+	}
+
+	if !slices.Equal(add, []string{"go", "work", "cli"}) || !slices.Equal(remove, []string{"old"}) {
+		t.Errorf("ParseTagChanges() = %v, %v; want [go work cli], [old]", add, remove)
+	}
+
+	for _, input := range []string{"", " , ", "-", "bad tag!", "has#hash"} {
+		if _, _, err := ParseTagChanges(input); err == nil {
+			t.Errorf("ParseTagChanges(%q) error = nil, want an error", input)
+		}
+	}
+}
+
+func TestApplyTagChanges(t *testing.T) {
+	got := ApplyTagChanges([]string{"work", "old"}, []string{"go", "work"}, []string{"old", "missing"})
+
+	if want := []string{"go", "work"}; !slices.Equal(got, want) {
+		t.Errorf("ApplyTagChanges() = %v, want %v", got, want)
+	}
+}
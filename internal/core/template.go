@@ -0,0 +1,327 @@
+package core
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Placeholders substituted in the files and file names of a template
+const (
+	TemplateProjectName = "{{project_name}}"
+	TemplateModulePath  = "{{module_path}}"
+)
+
+// templateBinarySniff is how much of a file is checked for NUL bytes to
+// leave binary files untouched
+const templateBinarySniff = 8000
+
+var (
+	repoNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+	goModulePattern = regexp.MustCompile(`(?m)^module\s+"?([^"\s]+)"?`)
+)
+
+// NewFromTemplateOptions configures NewFromTemplate
+type NewFromTemplateOptions struct {
+	Owner     string // Owner of the new repository (default: the template's)
+	Module    string // Go module path (default: host/owner/name)
+	Workspace string // Workspace to create the repository in (default: active workspace)
+	Dir       string // Target directory (default: <workspace or clone dir>/<name>)
+	AnyRepo   bool   // Accept a template not classified as one
+}
+
+// NewFromTemplateResult describes a repository created from a template
+type NewFromTemplateResult struct {
+	Template  string `json:"template"`
+	URL       string `json:"url"`
+	Path      string `json:"path"`
+	Module    string `json:"module"`
+	Workspace string `json:"workspace,omitempty"`
+	Files     int    `json:"files"` // Files with substitutions
+}
+
+// NewFromTemplate creates a repository from a tracked template repository:
+// the template is cloned without its history, the placeholders
+// {{project_name}} and {{module_path}} and the Go module path of the
+// template are substituted, and the result is committed to a new git
+// repository whose origin is host/owner/name. The new repository is
+// registered but not pushed.
+func NewFromTemplate(templateQuery, name string, opts NewFromTemplateOptions) (*NewFromTemplateResult, error) {
+	if !repoNamePattern.MatchString(name) || name == "." || name == ".." {
+		return nil, fmt.Errorf("invalid repository name %q: use letters, digits, '.', '-' and '_'", name)
+	}
+
+	tmpl, err := ResolveRepo(templateQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if tmpl.Kind != model.RepoKindTemplate && !opts.AnyRepo {
+		return nil, fmt.Errorf("%s is not a template repository (kind %q); run 'clonr repo classify --refresh' after marking it as a template, or use --any-repo", tmpl.URL, tmpl.Kind)
+	}
+
+	tmplRepo, err := giturl.ParseRepository(tmpl.URL, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template URL: %w", err)
+	}
+
+	repo := &giturl.Repository{Host: tmplRepo.Host, Owner: cmp.Or(opts.Owner, tmplRepo.Owner), Name: name}
+
+	uri, err := fixURL(repo.Host, repo.Owner, repo.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error building URL: %w", err)
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	if exists, err := client.RepoExistsByURL(uri); err != nil {
+		return nil, fmt.Errorf("error checking for repo existence: %w", err)
+	} else if exists {
+		return nil, fmt.Errorf("repository already exists: %s", repo.FullName())
+	}
+
+	workspace, target, err := templateTarget(client, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(target); err == nil {
+		return nil, fmt.Errorf("directory already exists: %s", target)
+	}
+
+	result := &NewFromTemplateResult{
+		Template:  tmpl.URL,
+		URL:       uri.String(),
+		Path:      target,
+		Module:    cmp.Or(opts.Module, repo.Host+"/"+repo.Owner+"/"+repo.Name),
+		Workspace: workspace,
+	}
+
+	if err := scaffoldFromTemplate(tmpl, repo, result); err != nil {
+		// Leave nothing half-created behind
+		_ = os.RemoveAll(target)
+		return nil, err
+	}
+
+	if err := client.SaveRepoWithWorkspace(uri, target, workspace); err != nil {
+		return nil, fmt.Errorf("error saving repo to database: %w", err)
+	}
+
+	return result, nil
+}
+
+// scaffoldFromTemplate copies the template to result.Path and commits it as
+// a new repository
+func scaffoldFromTemplate(tmpl *model.Repository, repo *giturl.Repository, result *NewFromTemplateResult) error {
+	if err := os.MkdirAll(filepath.Dir(result.Path), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating directory %s: %w", filepath.Dir(result.Path), err)
+	}
+
+	// A local clone of the tracked copy: fast and needs no credentials
+	if _, err := runGitCommand("clone", "--quiet", tmpl.Path, result.Path); err != nil {
+		return fmt.Errorf("failed to clone template: %w", err)
+	}
+
+	if err := os.RemoveAll(filepath.Join(result.Path, ".git")); err != nil {
+		return fmt.Errorf("failed to remove template history: %w", err)
+	}
+
+	replacer := templateReplacer(repo.Name, result.Module, readGoModule(result.Path))
+
+	files, err := applyTemplate(result.Path, replacer)
+	if err != nil {
+		return err
+	}
+
+	result.Files = files
+
+	if _, err := runGitCommand("-C", result.Path, "init", "--quiet"); err != nil {
+		return fmt.Errorf("failed to initialize repository: %w", err)
+	}
+
+	// Commit with the workspace profile's identity, like clones
+	if err := ApplyCloneIdentity(&CloneResult{TargetPath: result.Path, Workspace: result.Workspace}); err != nil {
+		log.Printf("Warning: could not apply git identity: %v\n", err)
+	}
+
+	if _, err := runGitCommand("-C", result.Path, "add", "--all"); err != nil {
+		return fmt.Errorf("failed to stage files: %w", err)
+	}
+
+	message := "Initial commit from template " + tmplRepoName(tmpl.URL)
+	if _, err := runGitCommand("-C", result.Path, "commit", "--quiet", "--allow-empty", "-m", message); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+
+	if _, err := runGitCommand("-C", result.Path, "remote", "add", "origin", repo.CloneURL("https")); err != nil {
+		return fmt.Errorf("failed to add origin: %w", err)
+	}
+
+	return nil
+}
+
+// templateTarget picks the workspace and directory of a new repository
+func templateTarget(client *grpc.Client, name string, opts NewFromTemplateOptions) (string, string, error) {
+	workspace := opts.Workspace
+	if workspace == "" {
+		if active, err := client.GetActiveWorkspace(); err == nil && active != nil {
+			workspace = active.Name
+		}
+	}
+
+	if opts.Dir != "" {
+		target, err := filepath.Abs(expandTilde(opts.Dir))
+		if err != nil {
+			return "", "", fmt.Errorf("error determining absolute path: %w", err)
+		}
+
+		return workspace, target, nil
+	}
+
+	var baseDir string
+
+	if workspace != "" {
+		ws, err := client.GetWorkspace(workspace)
+		if err != nil {
+			return "", "", fmt.Errorf("error getting workspace: %w", err)
+		}
+
+		if ws != nil {
+			baseDir = ws.Path
+		}
+	}
+
+	if baseDir == "" {
+		cfg, err := client.GetConfig()
+		if err != nil {
+			return "", "", fmt.Errorf("error getting config: %w", err)
+		}
+
+		baseDir = cfg.DefaultCloneDir
+	}
+
+	return workspace, filepath.Join(baseDir, name), nil
+}
+
+// templateReplacer returns the substitutions of a template: the
+// placeholders and, for Go templates, the module path of the template
+func templateReplacer(name, module, templateModule string) *strings.Replacer {
+	pairs := []string{TemplateProjectName, name, TemplateModulePath, module}
+	if templateModule != "" && templateModule != module {
+		pairs = append(pairs, templateModule, module)
+	}
+
+	return strings.NewReplacer(pairs...)
+}
+
+// readGoModule returns the module path declared in the go.mod of dir, or ""
+func readGoModule(dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return ""
+	}
+
+	if m := goModulePattern.FindSubmatch(data); m != nil {
+		return string(m[1])
+	}
+
+	return ""
+}
+
+// applyTemplate substitutes the contents and names of the files under dir,
+// leaving binary files untouched. It returns the number of files changed.
+func applyTemplate(dir string, r *strings.Replacer) (int, error) {
+	var (
+		changed int
+		renames []string
+	)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		if path != dir && r.Replace(d.Name()) != d.Name() {
+			renames = append(renames, path)
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		ok, err := substituteFile(path, r)
+		if ok {
+			changed++
+		}
+
+		return err
+	})
+	if err != nil {
+		return changed, fmt.Errorf("failed to apply template: %w", err)
+	}
+
+	// Deepest paths first, so parent renames do not move pending children
+	slices.Reverse(renames)
+
+	for _, path := range renames {
+		renamed := filepath.Join(filepath.Dir(path), r.Replace(filepath.Base(path)))
+		if err := os.Rename(path, renamed); err != nil {
+			return changed, fmt.Errorf("failed to rename %s: %w", path, err)
+		}
+	}
+
+	return changed, nil
+}
+
+// substituteFile rewrites a text file with the substitutions and reports
+// whether it changed
+func substituteFile(path string, r *strings.Replacer) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	if bytes.IndexByte(data[:min(len(data), templateBinarySniff)], 0) >= 0 {
+		return false, nil
+	}
+
+	replaced := r.Replace(string(data))
+	if replaced == string(data) {
+		return false, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+
+	return true, os.WriteFile(path, []byte(replaced), info.Mode().Perm())
+}
+
+// tmplRepoName returns owner/name of a repository URL, or the URL itself
+func tmplRepoName(rawURL string) string {
+	repo, err := giturl.ParseRepository(rawURL, "")
+	if err != nil {
+		return rawURL
+	}
+
+	return repo.FullName()
+}
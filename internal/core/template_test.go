@@ -0,0 +1,101 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":                        "module github.com/acme/tmpl\n\ngo 1.25\n",
+		"main.go":                       "package main\n\nimport \"github.com/acme/tmpl/internal/app\"\n",
+		"README.md":                     "# {{project_name}}\n\ngo get {{module_path}}\n",
+		"cmd/{{project_name}}/main.go":  "package main\n",
+		"LICENSE":                       "MIT\n",
+		"assets/logo.bin":               "{{project_name}}\x00binary",
+		".git/config":                   "{{project_name}}",
+		"{{project_name}}.service.conf": "ExecStart=/usr/bin/{{project_name}}\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	module := readGoModule(dir)
+	if module != "github.com/acme/tmpl" {
+		t.Fatalf("readGoModule() = %q", module)
+	}
+
+	changed, err := applyTemplate(dir, templateReplacer("widget", "example.com/me/widget", module))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changed != 4 {
+		t.Errorf("changed = %d, want 4", changed)
+	}
+
+	want := map[string]string{
+		"go.mod":              "module example.com/me/widget\n\ngo 1.25\n",
+		"main.go":             "package main\n\nimport \"example.com/me/widget/internal/app\"\n",
+		"README.md":           "# widget\n\ngo get example.com/me/widget\n",
+		"cmd/widget/main.go":  "package main\n",
+		"LICENSE":             "MIT\n",
+		"assets/logo.bin":     "{{project_name}}\x00binary",
+		".git/config":         "{{project_name}}",
+		"widget.service.conf": "ExecStart=/usr/bin/widget\n",
+	}
+
+	for name, content := range want {
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+
+		if string(data) != content {
+			t.Errorf("%s = %q, want %q", name, data, content)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "cmd", "{{project_name}}")); !os.IsNotExist(err) {
+		t.Errorf("placeholder directory was not renamed")
+	}
+}
+
+func TestReadGoModule(t *testing.T) {
+	tests := []struct {
+		name  string
+		gomod string
+		want  string
+	}{
+		{"plain", "module example.com/a\n", "example.com/a"},
+		{"quoted", "// comment\nmodule \"example.com/b\"\n", "example.com/b"},
+		{"missing", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.gomod != "" {
+				if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(tt.gomod), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := readGoModule(dir); got != tt.want {
+				t.Errorf("readGoModule() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
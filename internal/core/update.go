@@ -1,15 +1,42 @@
 package core
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/params"
 )
 
-// UpdateAllRepos pulls the latest changes for all repositories in the clonr database.
-func UpdateAllRepos() {
+const updateFailuresFile = "update_failures.json"
+
+// updateFailuresMu serializes access to the update failures file across
+// parallel mirror workers
+var updateFailuresMu sync.Mutex
+
+// UpdateFailure records the last failed update of a repository
+type UpdateFailure struct {
+	URL      string    `json:"url"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// UpdateAllRepos pulls the latest changes for all repositories in the clonr
+// database, as many at once as the git job limit allows. Archived
+// repositories are skipped. When ctx is cancelled the running pulls are
+// stopped, the remaining repositories are left alone and the number updated
+// so far is logged.
+func UpdateAllRepos(ctx context.Context) {
 	client, err := grpc.GetClient()
 	if err != nil {
 		log.Printf("Failed to connect to server: %v\n", err)
@@ -23,26 +50,148 @@ func UpdateAllRepos() {
 		return
 	}
 
+	pending := make([]model.Repository, 0, len(repos))
+
 	for _, repo := range repos {
-		_ = UpdateRepo(repo.URL, repo.Path)
+		if repo.Kind.SkipsUpdate() {
+			log.Printf("Skipping %s (%s)\n", repo.Path, repo.Kind)
+			continue
+		}
+
+		pending = append(pending, repo)
 	}
+
+	job, ctx := TrackJob(ctx, "update", "all repositories", len(pending))
+
+	var updated atomic.Int64
+
+	started, _ := RunJobs(ctx, Jobs(), JobGit, pending, func(repo model.Repository) {
+		err := UpdateRepo(ctx, repo.URL, repo.Path)
+		if err == nil {
+			updated.Add(1)
+
+			fetchRepoRemotes(ctx, &repo)
+		}
+
+		if ctx.Err() == nil {
+			job.Step(repo.Path, err)
+		}
+	})
+
+	if ctx.Err() != nil {
+		log.Printf("Stopped: %d updated, %d of %d repositories not processed\n", updated.Load(), len(pending)-started, len(pending))
+
+		job.Finish(context.Cause(ctx))
+
+		return
+	}
+
+	job.Finish(nil)
 }
 
-func UpdateRepo(url, path string) error {
+// UpdateRepos pulls the latest changes of the given repositories, as many at
+// once as the git job limit allows. Archived repositories are skipped and
+// each updated one counts as accessed. It returns the number updated and the
+// errors of the others, one per repository.
+func UpdateRepos(ctx context.Context, repos []model.Repository) (int, error) {
+	pending := make([]model.Repository, 0, len(repos))
+
+	for _, repo := range repos {
+		if !repo.Kind.SkipsUpdate() {
+			pending = append(pending, repo)
+		}
+	}
+
+	job, ctx := TrackJob(ctx, "update", fmt.Sprintf("%d repositories", len(pending)), len(pending))
+
+	var (
+		mu      sync.Mutex
+		errs    []error
+		updated atomic.Int64
+	)
+
+	_, _ = RunJobs(ctx, Jobs(), JobGit, pending, func(repo model.Repository) {
+		err := UpdateRepo(ctx, repo.URL, repo.Path)
+		if err == nil {
+			updated.Add(1)
+
+			fetchRepoRemotes(ctx, &repo)
+			RecordRepoAccess(repo.URL)
+		} else {
+			mu.Lock()
+			errs = append(errs, fmt.Errorf("%s: %w", repo.URL, err))
+			mu.Unlock()
+		}
+
+		job.Step(repo.Path, err)
+	})
+
+	if ctx.Err() != nil {
+		errs = append(errs, context.Cause(ctx))
+	}
+
+	err := errors.Join(errs...)
+	job.Finish(err)
+
+	return int(updated.Load()), err
+}
+
+// fetchRepoRemotes records the remotes of an updated repository and fetches
+// the ones other than origin, such as the upstream of a fork. Errors are
+// logged but don't fail the update.
+func fetchRepoRemotes(ctx context.Context, repo *model.Repository) {
+	remotes, err := RefreshRepoRemotes(repo)
+	if err != nil {
+		log.Printf("Failed to refresh remotes of %s: %v\n", repo.Path, err)
+		return
+	}
+
+	for _, remote := range remotes {
+		if remote.Name == "origin" {
+			continue
+		}
+
+		output, err := exec.CommandContext(ctx, "git", "-C", repo.Path, "fetch", "--quiet", "--prune", remote.Name).CombinedOutput()
+		if err != nil {
+			log.Printf("[fetch error] %s %s: %v: %s\n", repo.Path, remote.Name, err, output)
+		}
+	}
+}
+
+func UpdateRepo(ctx context.Context, url, path string) error {
 	log.Printf("Updating %s...", path)
 
-	cmd := exec.Command("git", "pull", "origin")
+	cmd := exec.CommandContext(ctx, "git", "pull", "origin")
 	cmd.Dir = path
 
 	output, err := cmd.CombinedOutput()
+	if err != nil && ctx.Err() != nil {
+		// A cancelled pull is not a failure of the repository
+		return context.Cause(ctx)
+	}
+
 	if err != nil {
 		log.Printf("[pull error] %v: %s\n", err, string(output))
 
+		failure := fmt.Errorf("%w: %s", err, output)
+
+		RecordUpdateResult(url, failure)
+		NotifySyncFailure(ctx, url, failure)
+
 		return err
 	}
 
 	log.Printf("[updated] %s\n", output)
 
+	// Keep the submodules already cloned at the commits the pull recorded
+	if HasSubmodules(path) {
+		if err := UpdateSubmodules(ctx, path, false); err != nil {
+			log.Printf("[submodule error] %s: %v\n", path, err)
+		}
+	}
+
+	RecordUpdateResult(url, nil)
+
 	// Update the timestamp in the database
 	client, err := grpc.GetClient()
 	if err != nil {
@@ -55,3 +204,68 @@ func UpdateRepo(url, path string) error {
 
 	return nil
 }
+
+// RecordUpdateResult records a failed update of a repository, or clears the
+// recorded failure when err is nil. Skipped dirty repositories are not failures.
+func RecordUpdateResult(url string, err error) {
+	var dirtyErr *DirtyRepoError
+	if errors.As(err, &dirtyErr) {
+		return
+	}
+
+	updateFailuresMu.Lock()
+	defer updateFailuresMu.Unlock()
+
+	path := filepath.Join(params.AppdataDir, updateFailuresFile)
+
+	loaded, loadErr := encoding.LoadJSON[map[string]UpdateFailure](path)
+	if loadErr != nil {
+		log.Printf("Failed to load update failures: %v\n", loadErr)
+		return
+	}
+
+	failures := make(map[string]UpdateFailure)
+	if loaded != nil && *loaded != nil {
+		failures = *loaded
+	}
+
+	if err == nil {
+		if _, ok := failures[url]; !ok {
+			return
+		}
+
+		delete(failures, url)
+	} else {
+		failures[url] = UpdateFailure{URL: url, Error: err.Error(), FailedAt: time.Now()}
+	}
+
+	if saveErr := encoding.SaveJSON(path, failures); saveErr != nil {
+		log.Printf("Failed to save update failures: %v\n", saveErr)
+	}
+}
+
+// ListUpdateFailures returns repositories whose last update failed, most recent first
+func ListUpdateFailures() ([]UpdateFailure, error) {
+	updateFailuresMu.Lock()
+	defer updateFailuresMu.Unlock()
+
+	loaded, err := encoding.LoadJSON[map[string]UpdateFailure](filepath.Join(params.AppdataDir, updateFailuresFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load update failures: %w", err)
+	}
+
+	if loaded == nil {
+		return nil, nil
+	}
+
+	failures := make([]UpdateFailure, 0, len(*loaded))
+	for _, f := range *loaded {
+		failures = append(failures, f)
+	}
+
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].FailedAt.After(failures[j].FailedAt)
+	})
+
+	return failures, nil
+}
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/audit"
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/crypto/tpm"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// vaultScope is the profile name vault secrets are sealed under; the key
+// of each secret completes its encryption context
+const vaultScope = "vault"
+
+// ErrVaultSecretNotFound is returned for a key that is not in the vault
+var ErrVaultSecretNotFound = errors.New("secret not found in vault")
+
+// vaultKeyPattern matches keys that are valid environment variable names,
+// so every secret can be injected into the environment of a command
+var vaultKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateVaultKey checks that key can be used as an environment variable name
+func ValidateVaultKey(key string) error {
+	if !vaultKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid vault key %q: use letters, digits and underscores, not starting with a digit", key)
+	}
+
+	return nil
+}
+
+// SetVaultSecret seals value with the TPM (or the keystore) and stores it
+// under key, replacing any previous value
+func SetVaultSecret(key, value string) error {
+	if err := ValidateVaultKey(key); err != nil {
+		return err
+	}
+
+	if value == "" {
+		return fmt.Errorf("secret value is required")
+	}
+
+	sealed, err := tpm.EncryptToken(value, vaultScope, key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetVaultSecret(key)
+	if err != nil {
+		return err
+	}
+
+	secret := &model.VaultSecret{Key: key, Value: sealed}
+	if existing != nil {
+		secret.CreatedAt = existing.CreatedAt
+	}
+
+	return client.SaveVaultSecret(secret)
+}
+
+// GetVaultSecret returns the decrypted value stored under key. Every
+// decryption is recorded in the audit log.
+func GetVaultSecret(key string) (string, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := client.GetVaultSecret(key)
+	if err != nil {
+		return "", err
+	}
+
+	if secret == nil {
+		return "", fmt.Errorf("%w: %s", ErrVaultSecretNotFound, key)
+	}
+
+	value, err := tpm.DecryptToken(secret.Value, vaultScope, key)
+	audit.RecordDecrypt(vaultScope, "", "", key, []string{key}, err == nil)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+// ListVaultSecrets returns the stored secrets without decrypting them
+func ListVaultSecrets() ([]model.VaultSecret, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.ListVaultSecrets()
+}
+
+// RemoveVaultSecret deletes the secret stored under key
+func RemoveVaultSecret(key string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	secret, err := client.GetVaultSecret(key)
+	if err != nil {
+		return err
+	}
+
+	if secret == nil {
+		return fmt.Errorf("%w: %s", ErrVaultSecretNotFound, key)
+	}
+
+	return client.DeleteVaultSecret(key)
+}
+
+// VaultEnv decrypts the secrets under keys and returns them as KEY=value
+// entries to append to the environment of a command
+func VaultEnv(keys []string) ([]string, error) {
+	env := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+
+		value, err := GetVaultSecret(key)
+		if err != nil {
+			return nil, err
+		}
+
+		env = append(env, key+"="+value)
+	}
+
+	return env, nil
+}
+
+// IsVaultSealed reports whether a stored value is encrypted, as opposed to
+// kept in plain text because no TPM or keystore was available
+func IsVaultSealed(secret *model.VaultSecret) bool {
+	return !tpm.IsDataOpen(secret.Value)
+}
@@ -0,0 +1,27 @@
+package core
+
+import "testing"
+
+func TestValidateVaultKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{"NPM_TOKEN", false},
+		{"_private", false},
+		{"registry2", false},
+		{"", true},
+		{"2FA", true},
+		{"MY-TOKEN", true},
+		{"A=B", true},
+		{"WITH SPACE", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if err := ValidateVaultKey(tt.key); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateVaultKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/encoding"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/params"
+)
+
+const webhookSecretFile = "webhook_secret"
+
+// Webhook providers accepted at /webhooks/{provider}
+const (
+	WebhookGitHub = "github"
+	WebhookGitLab = "gitlab"
+)
+
+var (
+	// ErrWebhookSignature is returned when a webhook's signature or token
+	// does not match the webhook secret
+	ErrWebhookSignature = errors.New("webhook signature does not match")
+
+	// ErrWebhookIgnored is returned for webhook events other than pushes
+	ErrWebhookIgnored = errors.New("webhook event is not a push")
+)
+
+// WebhookPush is a push event received from a Git host
+type WebhookPush struct {
+	Provider string   `json:"provider"`
+	URLs     []string `json:"urls"` // Clone and web URLs of the pushed repository
+	Ref      string   `json:"ref"`
+}
+
+// githubPushPayload is the part of a GitHub push event clonr reads
+type githubPushPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"repository"`
+}
+
+// gitlabPushPayload is the part of a GitLab push hook clonr reads
+type gitlabPushPayload struct {
+	Ref     string `json:"ref"`
+	Project struct {
+		HTTPURL string `json:"git_http_url"`
+		SSHURL  string `json:"git_ssh_url"`
+		WebURL  string `json:"web_url"`
+	} `json:"project"`
+}
+
+// WebhookSecret returns the secret webhooks are validated with, generating
+// it on first use
+func WebhookSecret() (string, error) {
+	p := filepath.Join(params.AppdataDir, webhookSecretFile)
+
+	secret, err := os.ReadFile(p)
+	if err == nil && len(secret) > 0 {
+		return strings.TrimSpace(string(secret)), nil
+	}
+
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read webhook secret: %w", err)
+	}
+
+	return RotateWebhookSecret()
+}
+
+// RotateWebhookSecret replaces the webhook secret with a new random one.
+// Webhooks configured with the old secret are rejected afterwards.
+func RotateWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	secret := hex.EncodeToString(b)
+
+	if err := encoding.WriteFileSecure(filepath.Join(params.AppdataDir, webhookSecretFile), []byte(secret)); err != nil {
+		return "", fmt.Errorf("failed to save webhook secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// ParseWebhookPush validates a webhook request from provider against secret
+// and returns the push it describes. GitHub requests are checked against
+// the X-Hub-Signature-256 HMAC of the body, GitLab requests against the
+// X-Gitlab-Token header. Valid events other than pushes (such as GitHub's
+// ping) return ErrWebhookIgnored.
+func ParseWebhookPush(provider string, header http.Header, body []byte, secret string) (*WebhookPush, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("webhook secret is empty")
+	}
+
+	push := &WebhookPush{Provider: provider}
+
+	switch provider {
+	case WebhookGitHub:
+		if !validGitHubSignature(header.Get("X-Hub-Signature-256"), body, secret) {
+			return nil, ErrWebhookSignature
+		}
+
+		if header.Get("X-GitHub-Event") != "push" {
+			return nil, ErrWebhookIgnored
+		}
+
+		var payload githubPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid push payload: %w", err)
+		}
+
+		push.Ref = payload.Ref
+		push.URLs = nonEmpty(payload.Repository.CloneURL, payload.Repository.SSHURL, payload.Repository.HTMLURL)
+
+	case WebhookGitLab:
+		token := header.Get("X-Gitlab-Token")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return nil, ErrWebhookSignature
+		}
+
+		if header.Get("X-Gitlab-Event") != "Push Hook" {
+			return nil, ErrWebhookIgnored
+		}
+
+		var payload gitlabPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, fmt.Errorf("invalid push payload: %w", err)
+		}
+
+		push.Ref = payload.Ref
+		push.URLs = nonEmpty(payload.Project.HTTPURL, payload.Project.SSHURL, payload.Project.WebURL)
+
+	default:
+		return nil, fmt.Errorf("unknown webhook provider %q (use %s or %s)", provider, WebhookGitHub, WebhookGitLab)
+	}
+
+	if len(push.URLs) == 0 {
+		return nil, fmt.Errorf("push payload has no repository URL")
+	}
+
+	return push, nil
+}
+
+// validGitHubSignature checks a "sha256=<hex>" signature of body
+func validGitHubSignature(signature string, body []byte, secret string) bool {
+	sig, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// WebhookEnabled reports whether repoURL is in the list of repositories with
+// webhooks enabled, however either URL was typed
+func WebhookEnabled(enabled []string, repoURL string) bool {
+	canonical := git.CanonicalURL(repoURL)
+
+	return slices.ContainsFunc(enabled, func(u string) bool { return git.CanonicalURL(u) == canonical })
+}
+
+// WebhookTargets returns the tracked repositories a push applies to: those
+// with webhooks enabled whose remote is the pushed repository
+func WebhookTargets(repos []model.Repository, enabled []string, push *WebhookPush) []model.Repository {
+	keys := make(map[string]bool)
+
+	for _, u := range push.URLs {
+		if key := RepoKey(u); key != "" {
+			keys[key] = true
+		}
+	}
+
+	var targets []model.Repository
+
+	for _, repo := range repos {
+		if WebhookEnabled(enabled, repo.URL) && keys[RepoKey(repo.URL)] {
+			targets = append(targets, repo)
+		}
+	}
+
+	return targets
+}
+
+// ListWebhookRepos returns the tracked repositories with webhooks enabled
+func ListWebhookRepos() ([]model.Repository, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	repos, err := client.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	var enabled []model.Repository
+
+	for _, repo := range repos {
+		if WebhookEnabled(cfg.Webhooks, repo.URL) {
+			enabled = append(enabled, repo)
+		}
+	}
+
+	return enabled, nil
+}
+
+// SetWebhookEnabled turns push-triggered pulls of a tracked repository on or
+// off. It reports whether the setting changed.
+func SetWebhookEnabled(repo *model.Repository, enabled bool) (bool, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	cfg, err := client.GetConfig()
+	if err != nil {
+		return false, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if WebhookEnabled(cfg.Webhooks, repo.URL) == enabled {
+		return false, nil
+	}
+
+	if enabled {
+		cfg.Webhooks = append(cfg.Webhooks, repo.URL)
+	} else {
+		cfg.Webhooks = slices.DeleteFunc(cfg.Webhooks, func(u string) bool { return WebhookEnabled([]string{u}, repo.URL) })
+	}
+
+	if err := client.SaveConfig(cfg); err != nil {
+		return false, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return true, nil
+}
+
+// nonEmpty returns the non-empty values
+func nonEmpty(values ...string) []string {
+	return slices.DeleteFunc(values, func(v string) bool { return v == "" })
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+const testWebhookSecret = "s3cret"
+
+const githubPushBody = `{
+  "ref": "refs/heads/main",
+  "repository": {
+    "clone_url": "https://github.com/acme/api.git",
+    "ssh_url": "git@github.com:acme/api.git",
+    "html_url": "https://github.com/acme/api"
+  }
+}`
+
+const gitlabPushBody = `{
+  "ref": "refs/heads/main",
+  "project": {
+    "git_http_url": "https://gitlab.com/acme/web.git",
+    "git_ssh_url": "git@gitlab.com:acme/web.git",
+    "web_url": "https://gitlab.com/acme/web"
+  }
+}`
+
+func githubSignature(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhookPush(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		header   map[string]string
+		body     string
+		wantErr  error
+		wantURL  string
+	}{
+		{
+			name:     "github push",
+			provider: WebhookGitHub,
+			header:   map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": githubSignature(githubPushBody, testWebhookSecret)},
+			body:     githubPushBody,
+			wantURL:  "https://github.com/acme/api.git",
+		},
+		{
+			name:     "github wrong secret",
+			provider: WebhookGitHub,
+			header:   map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": githubSignature(githubPushBody, "other")},
+			body:     githubPushBody,
+			wantErr:  ErrWebhookSignature,
+		},
+		{
+			name:     "github unsigned",
+			provider: WebhookGitHub,
+			header:   map[string]string{"X-GitHub-Event": "push"},
+			body:     githubPushBody,
+			wantErr:  ErrWebhookSignature,
+		},
+		{
+			name:     "github ping",
+			provider: WebhookGitHub,
+			header:   map[string]string{"X-GitHub-Event": "ping", "X-Hub-Signature-256": githubSignature(`{}`, testWebhookSecret)},
+			body:     `{}`,
+			wantErr:  ErrWebhookIgnored,
+		},
+		{
+			name:     "gitlab push",
+			provider: WebhookGitLab,
+			header:   map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": testWebhookSecret},
+			body:     gitlabPushBody,
+			wantURL:  "https://gitlab.com/acme/web.git",
+		},
+		{
+			name:     "gitlab wrong token",
+			provider: WebhookGitLab,
+			header:   map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": "other"},
+			body:     gitlabPushBody,
+			wantErr:  ErrWebhookSignature,
+		},
+		{
+			name:     "gitlab tag push",
+			provider: WebhookGitLab,
+			header:   map[string]string{"X-Gitlab-Event": "Tag Push Hook", "X-Gitlab-Token": testWebhookSecret},
+			body:     gitlabPushBody,
+			wantErr:  ErrWebhookIgnored,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+
+			push, err := ParseWebhookPush(tt.provider, header, []byte(tt.body), testWebhookSecret)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ParseWebhookPush() error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseWebhookPush() error = %v", err)
+			}
+
+			if push.Ref != "refs/heads/main" || len(push.URLs) != 3 || push.URLs[0] != tt.wantURL {
+				t.Errorf("ParseWebhookPush() = %+v", push)
+			}
+		})
+	}
+
+	if _, err := ParseWebhookPush("bitbucket", http.Header{}, nil, testWebhookSecret); err == nil {
+		t.Error("ParseWebhookPush() accepted an unknown provider")
+	}
+}
+
+func TestWebhookTargets(t *testing.T) {
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/api", Path: "/src/api"},
+		{URL: "https://github.com/acme/web", Path: "/src/web"},
+		{URL: "https://github.com/acme/docs", Path: "/src/docs"},
+	}
+	push := &WebhookPush{URLs: []string{"https://github.com/acme/api.git", "git@github.com:acme/api.git"}}
+
+	targets := WebhookTargets(repos, []string{"https://github.com/acme/api", "https://github.com/acme/web"}, push)
+	if len(targets) != 1 || targets[0].Path != "/src/api" {
+		t.Errorf("WebhookTargets() = %+v, want only /src/api", targets)
+	}
+
+	// Enabled before URLs were stored canonical
+	targets = WebhookTargets(repos, []string{"git@github.com:acme/api.git"}, push)
+	if len(targets) != 1 || targets[0].Path != "/src/api" {
+		t.Errorf("WebhookTargets() with a non-canonical URL = %+v, want /src/api", targets)
+	}
+
+	if targets := WebhookTargets(repos, nil, push); len(targets) != 0 {
+		t.Errorf("WebhookTargets() without enabled repos = %+v", targets)
+	}
+}
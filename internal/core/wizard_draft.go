@@ -0,0 +1,86 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// Draft names of the interactive flows that can be resumed
+const (
+	ConfigureDraft = "configure"
+	ConnectDraft   = "connect"
+)
+
+// wizardDraftMaxAge is how long an unfinished flow can be resumed; older
+// drafts are discarded when loaded
+const wizardDraftMaxAge = 7 * 24 * time.Hour
+
+// wizardDraftStore is the subset of the store used for drafts
+type wizardDraftStore interface {
+	SaveWizardDraft(draft *model.WizardDraft) error
+	GetWizardDraft(name string) (*model.WizardDraft, error)
+	DeleteWizardDraft(name string) error
+}
+
+// OrgMirrorDraft returns the draft name of an interrupted mirror of an organization
+func OrgMirrorDraft(org string) string {
+	return "org-mirror:" + org
+}
+
+// SaveWizardDraft stores the state of an unfinished interactive flow so it
+// can be resumed on the next launch. The state is encoded as JSON.
+func SaveWizardDraft(name string, state any) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	return saveWizardDraft(client, name, state, time.Now())
+}
+
+// LoadWizardDraft decodes the saved state of an interactive flow into state
+// and returns when it was saved. It returns false when there is nothing to
+// resume; expired or unreadable drafts are deleted.
+func LoadWizardDraft(name string, state any) (time.Time, bool, error) {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return loadWizardDraft(client, name, state, time.Now())
+}
+
+// DeleteWizardDraft discards the saved state of an interactive flow
+func DeleteWizardDraft(name string) error {
+	client, err := grpc.GetClient()
+	if err != nil {
+		return err
+	}
+
+	return client.DeleteWizardDraft(name)
+}
+
+func saveWizardDraft(db wizardDraftStore, name string, state any, now time.Time) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	return db.SaveWizardDraft(&model.WizardDraft{Name: name, Data: data, UpdatedAt: now})
+}
+
+func loadWizardDraft(db wizardDraftStore, name string, state any, now time.Time) (time.Time, bool, error) {
+	draft, err := db.GetWizardDraft(name)
+	if err != nil || draft == nil {
+		return time.Time{}, false, err
+	}
+
+	if now.Sub(draft.UpdatedAt) > wizardDraftMaxAge || json.Unmarshal(draft.Data, state) != nil {
+		return time.Time{}, false, db.DeleteWizardDraft(name)
+	}
+
+	return draft.UpdatedAt, true, nil
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+type memDraftStore map[string]*model.WizardDraft
+
+func (m memDraftStore) SaveWizardDraft(draft *model.WizardDraft) error {
+	m[draft.Name] = draft
+	return nil
+}
+
+func (m memDraftStore) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	return m[name], nil
+}
+
+func (m memDraftStore) DeleteWizardDraft(name string) error {
+	delete(m, name)
+	return nil
+}
+
+func TestWizardDraftRoundTrip(t *testing.T) {
+	db := memDraftStore{}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	type state struct {
+		Done []string `json:"done"`
+	}
+
+	var got state
+	if _, ok, err := loadWizardDraft(db, "org-mirror:acme", &got, now); ok || err != nil {
+		t.Fatalf("loadWizardDraft() without draft = %v, %v", ok, err)
+	}
+
+	if err := saveWizardDraft(db, "org-mirror:acme", state{Done: []string{"api", "web"}}, now); err != nil {
+		t.Fatal(err)
+	}
+
+	savedAt, ok, err := loadWizardDraft(db, "org-mirror:acme", &got, now.Add(time.Hour))
+	if err != nil || !ok || !savedAt.Equal(now) || len(got.Done) != 2 {
+		t.Errorf("loadWizardDraft() = %v, %v, %v, %+v", savedAt, ok, err, got)
+	}
+}
+
+func TestWizardDraftDiscarded(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		draft model.WizardDraft
+	}{
+		{"expired", model.WizardDraft{Name: "configure", Data: []byte(`{}`), UpdatedAt: now.Add(-wizardDraftMaxAge - time.Minute)}},
+		{"unreadable", model.WizardDraft{Name: "configure", Data: []byte(`{"values":1}`), UpdatedAt: now}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := memDraftStore{"configure": &tt.draft}
+
+			var got struct {
+				Values []string `json:"values"`
+			}
+
+			if _, ok, err := loadWizardDraft(db, "configure", &got, now); ok || err != nil {
+				t.Errorf("loadWizardDraft() = %v, %v, want no draft", ok, err)
+			}
+
+			if _, exists := db["configure"]; exists {
+				t.Error("draft was not deleted")
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// ExecOptions configures running a command across repositories
+type ExecOptions struct {
+	Parallel int      // Number of repositories processed concurrently (default: number of CPUs)
+	FailFast bool     // Stop starting new repositories and cancel running ones after the first failure
+	Env      []string // Extra KEY=value entries added to the environment of the command
+}
+
+// ExecResult is the outcome of running a command in one repository
+type ExecResult struct {
+	URL      string `json:"url"`
+	Path     string `json:"path"`
+	ExitCode int    `json:"exit_code"`
+	Output   string `json:"output"`
+	Error    string `json:"error,omitempty"`
+	Skipped  bool   `json:"skipped,omitempty"`
+	Duration int64  `json:"duration_ms"`
+}
+
+// Success reports whether the command ran and exited with status 0
+func (r *ExecResult) Success() bool {
+	return !r.Skipped && r.ExitCode == 0 && r.Error == ""
+}
+
+// ExecSummary aggregates the results of running a command across repositories
+type ExecSummary struct {
+	Command   string       `json:"command"`
+	Results   []ExecResult `json:"results"`
+	Succeeded int          `json:"succeeded"`
+	Failed    int          `json:"failed"`
+	Skipped   int          `json:"skipped"`
+	Duration  int64        `json:"duration_ms"`
+}
+
+// ExecInRepos runs a shell command in every repository concurrently.
+// Results are returned in the order of repos.
+func ExecInRepos(ctx context.Context, repos []model.Repository, command string, opts ExecOptions) *ExecSummary {
+	if opts.Parallel <= 0 {
+		opts.Parallel = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := time.Now()
+	results := make([]ExecResult, len(repos))
+	work := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < opts.Parallel; i++ {
+		wg.Go(func() {
+			for idx := range work {
+				results[idx] = execInRepo(ctx, repos[idx], command, opts.Env)
+
+				if opts.FailFast && !results[idx].Success() {
+					cancel()
+				}
+			}
+		})
+	}
+
+	for i, repo := range repos {
+		if ctx.Err() != nil {
+			results[i] = ExecResult{URL: repo.URL, Path: repo.Path, Skipped: true}
+			continue
+		}
+
+		work <- i
+	}
+
+	close(work)
+	wg.Wait()
+
+	summary := &ExecSummary{
+		Command:  command,
+		Results:  results,
+		Duration: time.Since(start).Milliseconds(),
+	}
+
+	for i := range results {
+		switch {
+		case results[i].Skipped:
+			summary.Skipped++
+		case results[i].Success():
+			summary.Succeeded++
+		default:
+			summary.Failed++
+		}
+	}
+
+	return summary
+}
+
+// execInRepo runs command with the platform shell in the repository directory
+func execInRepo(ctx context.Context, repo model.Repository, command string, env []string) ExecResult {
+	result := ExecResult{URL: repo.URL, Path: repo.Path}
+
+	if ctx.Err() != nil {
+		result.Skipped = true
+		return result
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	cmd.Dir = repo.Path
+
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+
+	start := time.Now()
+	output, err := cmd.CombinedOutput()
+	result.Duration = time.Since(start).Milliseconds()
+	result.Output = string(output)
+
+	var exitErr *exec.ExitError
+
+	switch {
+	case err == nil:
+	case errors.As(err, &exitErr):
+		result.ExitCode = exitErr.ExitCode()
+		if ctx.Err() != nil {
+			result.Error = "cancelled"
+		}
+	default:
+		result.ExitCode = -1
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// IsPushCommand reports whether a shell command pushes to a remote, so bulk
+// runs can leave mirrors and archives alone
+func IsPushCommand(command string) bool {
+	fields := strings.Fields(command)
+
+	for i := 0; i+1 < len(fields); i++ {
+		if (fields[i] == "git" || fields[i] == "clonr") && fields[i+1] == "push" {
+			return true
+		}
+	}
+
+	return false
+}
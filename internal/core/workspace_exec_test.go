@@ -0,0 +1,78 @@
+package core
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestExecInRepos(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/a", Path: t.TempDir()},
+		{URL: "https://github.com/acme/b", Path: t.TempDir()},
+	}
+
+	summary := ExecInRepos(context.Background(), repos, "pwd", ExecOptions{Parallel: 2})
+
+	if summary.Succeeded != 2 || summary.Failed != 0 {
+		t.Fatalf("ExecInRepos() succeeded=%d failed=%d, want 2/0", summary.Succeeded, summary.Failed)
+	}
+
+	for i, r := range summary.Results {
+		if r.URL != repos[i].URL {
+			t.Errorf("Results[%d].URL = %s, want %s", i, r.URL, repos[i].URL)
+		}
+
+		if !strings.Contains(r.Output, repos[i].Path) {
+			t.Errorf("Results[%d].Output = %q, want working directory %s", i, r.Output, repos[i].Path)
+		}
+	}
+}
+
+func TestExecInReposFailFast(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	repos := []model.Repository{
+		{URL: "https://github.com/acme/a", Path: t.TempDir()},
+		{URL: "https://github.com/acme/b", Path: t.TempDir()},
+		{URL: "https://github.com/acme/c", Path: t.TempDir()},
+	}
+
+	summary := ExecInRepos(context.Background(), repos, "exit 3", ExecOptions{Parallel: 1, FailFast: true})
+
+	if summary.Results[0].ExitCode != 3 {
+		t.Errorf("Results[0].ExitCode = %d, want 3", summary.Results[0].ExitCode)
+	}
+
+	if summary.Failed != 1 || summary.Skipped != 2 {
+		t.Errorf("ExecInRepos() failed=%d skipped=%d, want 1/2", summary.Failed, summary.Skipped)
+	}
+}
+
+func TestIsPushCommand(t *testing.T) {
+	tests := map[string]bool{
+		"git push":               true,
+		"git push --tags origin": true,
+		"git fetch && git push":  true,
+		"clonr push":             true,
+		"git status":             false,
+		"echo push":              false,
+		"git log --grep push":    false,
+		"":                       false,
+	}
+
+	for command, want := range tests {
+		if got := IsPushCommand(command); got != want {
+			t.Errorf("IsPushCommand(%q) = %v, want %v", command, got, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/inovacc/clonr/internal/giturl"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// WorkspaceMatch is the workspace a repository URL was routed to
+type WorkspaceMatch struct {
+	Workspace *model.Workspace
+	Pattern   string
+}
+
+// NormalizeURLPattern turns a URL or glob into the host/owner/repo form used
+// for matching: lowercased, without scheme, user, ".git" or trailing slash.
+// SCP-style "git@host:owner" is accepted as well.
+func NormalizeURLPattern(pattern string) string {
+	p := strings.ToLower(strings.TrimSpace(pattern))
+
+	if i := strings.Index(p, "://"); i >= 0 {
+		p = p[i+3:]
+	}
+
+	if i := strings.Index(p, "@"); i >= 0 && !strings.ContainsAny(p[:i], "/*?[") {
+		p = p[i+1:]
+	}
+
+	if i := strings.Index(p, ":"); i >= 0 && !strings.Contains(p[:i], "/") {
+		p = p[:i] + "/" + p[i+1:]
+	}
+
+	p = strings.TrimSuffix(strings.TrimSuffix(p, "/"), ".git")
+
+	return strings.TrimSuffix(p, "/")
+}
+
+// ValidateURLPattern normalizes a workspace URL pattern and checks its syntax
+func ValidateURLPattern(pattern string) (string, error) {
+	p := NormalizeURLPattern(pattern)
+	if p == "" {
+		return "", fmt.Errorf("URL pattern must not be empty")
+	}
+
+	if _, err := path.Match(p, ""); err != nil {
+		return "", fmt.Errorf("invalid URL pattern %q: %w", pattern, err)
+	}
+
+	return p, nil
+}
+
+// matchURLPattern reports whether repoKey (host/owner/repo) matches pattern.
+// A pattern without wildcards also matches everything below it, so
+// "github.com/my-company" covers all of that owner's repositories.
+func matchURLPattern(pattern, repoKey string) bool {
+	p := NormalizeURLPattern(pattern)
+	if p == "" {
+		return false
+	}
+
+	if ok, err := path.Match(p, repoKey); err == nil && ok {
+		return true
+	}
+
+	if !strings.ContainsAny(p, "*?[") {
+		return strings.HasPrefix(repoKey, p+"/")
+	}
+
+	return false
+}
+
+// patternSpecificity ranks patterns so the most specific match wins: more
+// literal characters beat wildcards.
+func patternSpecificity(pattern string) int {
+	n := 0
+
+	for _, r := range NormalizeURLPattern(pattern) {
+		if r != '*' && r != '?' {
+			n++
+		}
+	}
+
+	return n
+}
+
+// MatchWorkspace returns the workspace whose URL patterns best match the
+// repository key (host/owner/repo), or nil if none matches.
+func MatchWorkspace(workspaces []model.Workspace, repoKey string) *WorkspaceMatch {
+	repoKey = strings.ToLower(repoKey)
+	if repoKey == "" {
+		return nil
+	}
+
+	var (
+		best      *WorkspaceMatch
+		bestScore = -1
+	)
+
+	for i := range workspaces {
+		for _, pattern := range workspaces[i].URLPatterns {
+			if !matchURLPattern(pattern, repoKey) {
+				continue
+			}
+
+			if score := patternSpecificity(pattern); score > bestScore {
+				best = &WorkspaceMatch{Workspace: &workspaces[i], Pattern: pattern}
+				bestScore = score
+			}
+		}
+	}
+
+	return best
+}
+
+// RepoKey returns the host/owner/repo key a repository argument (URL or
+// owner/repo) is matched by, or "" if it cannot be parsed.
+func RepoKey(repoArg string) string {
+	repo, err := giturl.ParseRepository(repoArg, "")
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(repo.Host + "/" + repo.Owner + "/" + repo.Name)
+}
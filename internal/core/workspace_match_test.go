@@ -0,0 +1,94 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestNormalizeURLPattern(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "github.com/my-company/*", want: "github.com/my-company/*"},
+		{in: "https://GitHub.com/My-Company/", want: "github.com/my-company"},
+		{in: "git@github.com:my-company/api.git", want: "github.com/my-company/api"},
+		{in: "ssh://git@gitlab.com/team/*", want: "gitlab.com/team/*"},
+		{in: "  ", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := NormalizeURLPattern(tt.in); got != tt.want {
+				t.Errorf("NormalizeURLPattern(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchWorkspace(t *testing.T) {
+	workspaces := []model.Workspace{
+		{Name: "personal"},
+		{Name: "work", URLPatterns: []string{"github.com/my-company/*", "gitlab.com/my-company"}},
+		{Name: "infra", URLPatterns: []string{"github.com/my-company/infra-*"}},
+		{Name: "oss", URLPatterns: []string{"github.com/*/*"}},
+	}
+
+	tests := []struct {
+		name        string
+		repoKey     string
+		wantName    string
+		wantPattern string
+	}{
+		{name: "owner glob", repoKey: "github.com/my-company/api", wantName: "work", wantPattern: "github.com/my-company/*"},
+		{name: "most specific wins", repoKey: "github.com/my-company/infra-dns", wantName: "infra", wantPattern: "github.com/my-company/infra-*"},
+		{name: "case insensitive", repoKey: "GitHub.com/My-Company/API", wantName: "work", wantPattern: "github.com/my-company/*"},
+		{name: "plain prefix", repoKey: "gitlab.com/my-company/tools", wantName: "work", wantPattern: "gitlab.com/my-company"},
+		{name: "catch-all", repoKey: "github.com/someone/lib", wantName: "oss", wantPattern: "github.com/*/*"},
+		{name: "no match", repoKey: "bitbucket.org/team/repo"},
+		{name: "empty key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MatchWorkspace(workspaces, tt.repoKey)
+
+			if tt.wantName == "" {
+				if got != nil {
+					t.Errorf("MatchWorkspace() = %s, want no match", got.Workspace.Name)
+				}
+
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("MatchWorkspace() = nil, want %s", tt.wantName)
+			}
+
+			if got.Workspace.Name != tt.wantName || got.Pattern != tt.wantPattern {
+				t.Errorf("MatchWorkspace() = %s (%s), want %s (%s)", got.Workspace.Name, got.Pattern, tt.wantName, tt.wantPattern)
+			}
+		})
+	}
+}
+
+func TestRepoKey(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "https://github.com/My-Company/API.git", want: "github.com/my-company/api"},
+		{in: "git@gitlab.com:team/infra.git", want: "gitlab.com/team/infra"},
+		{in: "owner/repo", want: "github.com/owner/repo"},
+		{in: "repo", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := RepoKey(tt.in); got != tt.want {
+				t.Errorf("RepoKey(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
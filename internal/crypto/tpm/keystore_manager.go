@@ -19,40 +19,53 @@ var (
 	globalKeystore *sealbox.Keystore
 	keystoreMu     sync.RWMutex
 	keystoreErr    error
-	keystoreOnce   sync.Once
+	keystoreOpened bool
 
 	// getAppDirectory is a function variable for testing
 	getAppDirectory = application.GetApplicationDirectory
 )
 
-// initKeystore initializes the global keystore instance
+// initKeystore initializes the global keystore instance. The outcome is
+// kept until CloseKeystore, so a passphrase is asked for at most once.
 func initKeystore() (*sealbox.Keystore, error) {
-	keystoreOnce.Do(func() {
-		appDir, err := getAppDirectory()
-		if err != nil {
-			keystoreErr = fmt.Errorf("failed to get application directory: %w", err)
-			return
-		}
+	keystoreMu.Lock()
+	defer keystoreMu.Unlock()
+
+	if keystoreOpened {
+		return globalKeystore, keystoreErr
+	}
+
+	keystoreOpened = true
+	globalKeystore, keystoreErr = openKeystore()
+
+	return globalKeystore, keystoreErr
+}
+
+// openKeystore opens the keystore file with the TPM as root, or with a key
+// derived from the machine or a passphrase when there is no TPM
+func openKeystore() (*sealbox.Keystore, error) {
+	appDir, err := getAppDirectory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application directory: %w", err)
+	}
+
+	keystorePath := filepath.Join(appDir, ".clonr_keystore")
 
-		keystorePath := filepath.Join(appDir, ".clonr_keystore")
-
-		// Try TPM first, fall back to password-less mode if unavailable
-		var opts []sealbox.KeystoreOption
-		if sealbox.IsAvailable() {
-			opts = append(opts, sealbox.WithTPMRoot())
-		} else {
-			// Use a derived key from machine-specific data as fallback
-			// This is less secure than TPM but better than nothing
-			machineKey := getMachineKey()
-			opts = append(opts, sealbox.WithPasswordRoot(machineKey))
+	var opts []sealbox.KeystoreOption
+	if sealbox.IsAvailable() {
+		opts = append(opts, sealbox.WithTPMRoot())
+	} else {
+		rootKey, err := keystoreRootKey()
+		if err != nil {
+			return nil, err
 		}
 
-		opts = append(opts, sealbox.WithAutoSave())
+		opts = append(opts, sealbox.WithPasswordRoot(rootKey))
+	}
 
-		globalKeystore, keystoreErr = sealbox.Open(keystorePath, opts...)
-	})
+	opts = append(opts, sealbox.WithAutoSave())
 
-	return globalKeystore, keystoreErr
+	return sealbox.Open(keystorePath, opts...)
 }
 
 // getMachineKey returns a machine-specific key for non-TPM systems
@@ -139,6 +152,9 @@ func CloseKeystore() error {
 	keystoreMu.Lock()
 	defer keystoreMu.Unlock()
 
+	keystoreOpened = false
+	keystoreErr = nil
+
 	if globalKeystore != nil {
 		err := globalKeystore.Close()
 		globalKeystore = nil
@@ -2,7 +2,6 @@ package tpm
 
 import (
 	"os"
-	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -275,5 +274,5 @@ func resetKeystoreForTest() {
 	}
 
 	keystoreErr = nil
-	keystoreOnce = sync.Once{}
+	keystoreOpened = false
 }
@@ -0,0 +1,334 @@
+package tpm
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Protections of the keystore root key on machines without a TPM
+const (
+	// ProtectionMachine derives the root key from machine-specific data
+	ProtectionMachine = "machine"
+
+	// ProtectionPassphrase derives the root key from a user passphrase with Argon2id
+	ProtectionPassphrase = "passphrase"
+)
+
+// PassphraseEnv holds the keystore passphrase for non-interactive use
+const PassphraseEnv = "CLONR_PASSPHRASE"
+
+// keyProtectionFile stores the KeyProtection next to the keystore
+const keyProtectionFile = ".clonr_keystore.json"
+
+// Argon2id parameters of the passphrase root key
+const (
+	passphraseTime    = 3
+	passphraseMemory  = 64 * 1024
+	passphraseThreads = 4
+	passphraseKeyLen  = 32
+	passphraseSaltLen = 16
+)
+
+// passphraseCommandTimeout bounds a passphrase command, which may wait on a
+// keyring unlock dialog
+const passphraseCommandTimeout = 2 * time.Minute
+
+var (
+	// ErrPassphraseRequired is returned when the keystore is protected by a
+	// passphrase and none could be obtained
+	ErrPassphraseRequired = errors.New("the keystore is protected by a passphrase: set " + PassphraseEnv + " or run clonr in a terminal")
+
+	// ErrWrongPassphrase is returned when a passphrase does not open the keystore
+	ErrWrongPassphrase = errors.New("wrong keystore passphrase")
+
+	// passphrasePrompt asks the user for the passphrase; nil when there is
+	// no terminal to ask on
+	passphrasePrompt func() (string, error)
+
+	// passphraseKey caches the derived root key for the process
+	passphraseKey   []byte
+	passphraseKeyMu sync.Mutex
+)
+
+// KeyProtection describes how the keystore root key is protected when no
+// TPM is available
+type KeyProtection struct {
+	Mode string `json:"mode"`
+
+	// Salt of the Argon2id derivation
+	Salt []byte `json:"salt,omitempty"`
+
+	// Verifier is a SHA-256 of the derived key, used to report a wrong
+	// passphrase instead of failing to decrypt
+	Verifier []byte `json:"verifier,omitempty"`
+
+	// PassphraseCommand prints the passphrase, for example from the system
+	// keyring ("secret-tool lookup clonr keystore"). When empty the
+	// passphrase is read from CLONR_PASSPHRASE or prompted for.
+	PassphraseCommand string `json:"passphrase_command,omitempty"`
+}
+
+// SetPassphrasePrompt sets the function asking for the keystore passphrase
+func SetPassphrasePrompt(prompt func() (string, error)) {
+	passphrasePrompt = prompt
+}
+
+// keyProtectionPath returns the path of the KeyProtection file
+func keyProtectionPath() (string, error) {
+	appDir, err := getAppDirectory()
+	if err != nil {
+		return "", fmt.Errorf("failed to get application directory: %w", err)
+	}
+
+	return filepath.Join(appDir, keyProtectionFile), nil
+}
+
+// LoadKeyProtection returns how the keystore root key is protected. Without
+// a configuration it is derived from the machine.
+func LoadKeyProtection() (*KeyProtection, error) {
+	p, err := keyProtectionPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return &KeyProtection{Mode: ProtectionMachine}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var protection KeyProtection
+	if err := json.Unmarshal(data, &protection); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", p, err)
+	}
+
+	return &protection, nil
+}
+
+// saveKeyProtection writes the KeyProtection file, or removes it for the
+// default machine protection
+func saveKeyProtection(protection *KeyProtection) error {
+	p, err := keyProtectionPath()
+	if err != nil {
+		return err
+	}
+
+	if protection.Mode == ProtectionMachine {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	}
+
+	data, err := json.MarshalIndent(protection, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(p, data, 0o600)
+}
+
+// NewPassphraseProtection derives a root key from passphrase with a new
+// salt. command, when set, is how later runs obtain the passphrase.
+func NewPassphraseProtection(passphrase, command string) (*KeyProtection, []byte, error) {
+	if passphrase == "" {
+		return nil, nil, errors.New("passphrase must not be empty")
+	}
+
+	salt := make([]byte, passphraseSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, err
+	}
+
+	key := derivePassphraseKey(passphrase, salt)
+	verifier := sha256.Sum256(key)
+
+	return &KeyProtection{
+		Mode:              ProtectionPassphrase,
+		Salt:              salt,
+		Verifier:          verifier[:],
+		PassphraseCommand: command,
+	}, key, nil
+}
+
+// derivePassphraseKey derives the keystore root key with Argon2id
+func derivePassphraseKey(passphrase string, salt []byte) []byte {
+	return argon2.IDKey([]byte(passphrase), salt, passphraseTime, passphraseMemory, passphraseThreads, passphraseKeyLen)
+}
+
+// passphraseRootKey returns the root key of a passphrase-protected keystore,
+// asking for the passphrase once per process
+func passphraseRootKey(protection *KeyProtection) ([]byte, error) {
+	passphraseKeyMu.Lock()
+	defer passphraseKeyMu.Unlock()
+
+	if passphraseKey != nil {
+		return passphraseKey, nil
+	}
+
+	passphrase, err := readPassphrase(protection)
+	if err != nil {
+		return nil, err
+	}
+
+	key := derivePassphraseKey(passphrase, protection.Salt)
+
+	verifier := sha256.Sum256(key)
+	if subtle.ConstantTimeCompare(verifier[:], protection.Verifier) != 1 {
+		return nil, ErrWrongPassphrase
+	}
+
+	passphraseKey = key
+
+	return key, nil
+}
+
+// readPassphrase obtains the passphrase from CLONR_PASSPHRASE, the
+// configured command or the terminal, in that order
+func readPassphrase(protection *KeyProtection) (string, error) {
+	if v := os.Getenv(PassphraseEnv); v != "" {
+		return v, nil
+	}
+
+	if protection.PassphraseCommand != "" {
+		return RunPassphraseCommand(protection.PassphraseCommand)
+	}
+
+	if passphrasePrompt == nil {
+		return "", ErrPassphraseRequired
+	}
+
+	return passphrasePrompt()
+}
+
+// RunPassphraseCommand runs command with the platform shell and returns its
+// output without the trailing newline
+func RunPassphraseCommand(command string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), passphraseCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stderr bytes.Buffer
+
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("passphrase command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	passphrase := strings.TrimRight(string(out), "\r\n")
+	if passphrase == "" {
+		return "", errors.New("passphrase command printed nothing")
+	}
+
+	return passphrase, nil
+}
+
+// keystoreRootKey returns the root key of the keystore on a machine without
+// a TPM
+func keystoreRootKey() ([]byte, error) {
+	protection, err := LoadKeyProtection()
+	if err != nil {
+		return nil, err
+	}
+
+	if protection.Mode == ProtectionPassphrase {
+		return passphraseRootKey(protection)
+	}
+
+	return getMachineKey(), nil
+}
+
+// ChangeKeyProtection switches the protection of the keystore root key on
+// a machine without a TPM. rootKey is the key derived for protection
+// (nil for machine protection).
+//
+// Secrets cannot be moved between keystores by the keystore itself, so
+// reseal is called with the new keystore open and must encrypt and store
+// every secret again; they have to be decrypted before calling. If reseal
+// fails, the previous keystore and protection are restored.
+func ChangeKeyProtection(protection *KeyProtection, rootKey []byte, reseal func() error) error {
+	if IsTPMAvailable() {
+		return errors.New("this machine has a TPM, which protects the keystore instead")
+	}
+
+	previous, err := LoadKeyProtection()
+	if err != nil {
+		return err
+	}
+
+	appDir, err := getAppDirectory()
+	if err != nil {
+		return fmt.Errorf("failed to get application directory: %w", err)
+	}
+
+	keystorePath := filepath.Join(appDir, ".clonr_keystore")
+	backupPath := keystorePath + ".bak"
+
+	if err := CloseKeystore(); err != nil {
+		return fmt.Errorf("failed to close the keystore: %w", err)
+	}
+
+	if err := os.Rename(keystorePath, backupPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to back up the keystore: %w", err)
+	}
+
+	restore := func(cause error) error {
+		_ = CloseKeystore()
+		_ = os.Remove(keystorePath)
+		_ = os.Rename(backupPath, keystorePath)
+		_ = saveKeyProtection(previous)
+
+		setPassphraseKey(nil)
+
+		return cause
+	}
+
+	if err := saveKeyProtection(protection); err != nil {
+		return restore(fmt.Errorf("failed to save the key protection: %w", err))
+	}
+
+	setPassphraseKey(rootKey)
+
+	if err := reseal(); err != nil {
+		return restore(err)
+	}
+
+	_ = os.Remove(backupPath)
+
+	return nil
+}
+
+// setPassphraseKey replaces the cached passphrase root key
+func setPassphraseKey(key []byte) {
+	passphraseKeyMu.Lock()
+	defer passphraseKeyMu.Unlock()
+
+	passphraseKey = key
+}
@@ -0,0 +1,61 @@
+package tpm
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassphraseRootKey(t *testing.T) {
+	t.Cleanup(func() { setPassphraseKey(nil) })
+
+	protection, key, err := NewPassphraseProtection("correct horse", "")
+	require.NoError(t, err)
+	assert.Equal(t, ProtectionPassphrase, protection.Mode)
+	assert.Len(t, key, passphraseKeyLen)
+
+	t.Setenv(PassphraseEnv, "wrong horse")
+	setPassphraseKey(nil)
+
+	_, err = passphraseRootKey(protection)
+	require.ErrorIs(t, err, ErrWrongPassphrase)
+
+	t.Setenv(PassphraseEnv, "correct horse")
+
+	got, err := passphraseRootKey(protection)
+	require.NoError(t, err)
+	assert.Equal(t, key, got)
+}
+
+func TestPassphraseRequiredWithoutPrompt(t *testing.T) {
+	t.Cleanup(func() { setPassphraseKey(nil) })
+
+	protection, _, err := NewPassphraseProtection("secret", "")
+	require.NoError(t, err)
+
+	t.Setenv(PassphraseEnv, "")
+	setPassphraseKey(nil)
+
+	old := passphrasePrompt
+	passphrasePrompt = nil
+
+	t.Cleanup(func() { passphrasePrompt = old })
+
+	_, err = passphraseRootKey(protection)
+	require.ErrorIs(t, err, ErrPassphraseRequired)
+}
+
+func TestRunPassphraseCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses sh")
+	}
+
+	got, err := RunPassphraseCommand("echo from-keyring")
+	require.NoError(t, err)
+	assert.Equal(t, "from-keyring", got)
+
+	_, err = RunPassphraseCommand("true")
+	require.Error(t, err)
+}
@@ -116,6 +116,11 @@ func EncryptToken(token, profileName, host string) ([]byte, error) {
 
 			return result, nil
 		}
+
+		// A keystore locked by a passphrase must not downgrade to plain text
+		if errors.Is(err, ErrPassphraseRequired) || errors.Is(err, ErrWrongPassphrase) {
+			return nil, fmt.Errorf("%w: %w", ErrEncryptionFailed, err)
+		}
 		// Fall through to legacy encryption if keystore fails
 	}
 
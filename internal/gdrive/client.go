@@ -7,11 +7,15 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
 const (
 	driveAPIBaseURL = "https://www.googleapis.com/drive/v3"
+
+	// FolderMimeType is the MIME type of Drive folders
+	FolderMimeType = "application/vnd.google-apps.folder"
 )
 
 // Client is a Google Drive API client.
@@ -51,6 +55,17 @@ type File struct {
 	Shared       bool      `json:"shared"`
 }
 
+// IsFolder reports whether the file is a folder.
+func (f *File) IsFolder() bool {
+	return f.MimeType == FolderMimeType
+}
+
+// IsGoogleDoc reports whether the file is a Google Workspace document,
+// which DownloadFile exports instead of downloading.
+func (f *File) IsGoogleDoc() bool {
+	return isGoogleDoc(f.MimeType)
+}
+
 // Owner represents a file owner.
 type Owner struct {
 	DisplayName  string `json:"displayName"`
@@ -195,26 +210,86 @@ func (c *Client) downloadBinary(ctx context.Context, fileID string) ([]byte, err
 
 // ListFiles lists files in the user's Drive.
 func (c *Client) ListFiles(ctx context.Context, query string, maxResults int) ([]File, error) {
+	page, err := c.ListFilesPage(ctx, ListOptions{Query: query, PageSize: maxResults})
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Files, nil
+}
+
+// ListOptions configures a file listing.
+type ListOptions struct {
+	Query     string // Drive search query
+	OrderBy   string // Sort order, e.g. "folder,name"
+	PageSize  int    // Files per page, at most 1000
+	PageToken string // NextPageToken of the previous page
+}
+
+// FileList is a page of files.
+type FileList struct {
+	Files         []File `json:"files"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// ListFilesPage lists one page of files.
+func (c *Client) ListFilesPage(ctx context.Context, opts ListOptions) (*FileList, error) {
 	params := url.Values{}
-	params.Set("fields", "files(id,name,mimeType,size,createdTime,modifiedTime,webViewLink)")
+	params.Set("fields", "nextPageToken,files(id,name,mimeType,size,createdTime,modifiedTime,webViewLink)")
+
+	if opts.Query != "" {
+		params.Set("q", opts.Query)
+	}
 
-	if query != "" {
-		params.Set("q", query)
+	if opts.OrderBy != "" {
+		params.Set("orderBy", opts.OrderBy)
 	}
 
-	if maxResults > 0 {
-		params.Set("pageSize", fmt.Sprintf("%d", maxResults))
+	if opts.PageSize > 0 {
+		params.Set("pageSize", fmt.Sprintf("%d", opts.PageSize))
 	}
 
-	var resp struct {
-		Files []File `json:"files"`
+	if opts.PageToken != "" {
+		params.Set("pageToken", opts.PageToken)
 	}
 
-	if err := c.get(ctx, "files", params, &resp); err != nil {
+	var page FileList
+	if err := c.get(ctx, "files", params, &page); err != nil {
 		return nil, err
 	}
 
-	return resp.Files, nil
+	return &page, nil
+}
+
+// ListFolder lists all files in a folder, folders first and then by name,
+// following every page. An empty folder ID lists the root of My Drive.
+func (c *Client) ListFolder(ctx context.Context, folderID string) ([]File, error) {
+	if folderID == "" {
+		folderID = "root"
+	}
+
+	opts := ListOptions{
+		Query:    fmt.Sprintf("'%s' in parents and trashed = false", strings.ReplaceAll(folderID, "'", "\\'")),
+		OrderBy:  "folder,name",
+		PageSize: 1000,
+	}
+
+	var files []File
+
+	for {
+		page, err := c.ListFilesPage(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, page.Files...)
+
+		if page.NextPageToken == "" {
+			return files, nil
+		}
+
+		opts.PageToken = page.NextPageToken
+	}
 }
 
 // get performs a GET request to the Drive API.
@@ -0,0 +1,64 @@
+package gdrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// rewriteTransport sends every request to a test server
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestListFolder(t *testing.T) {
+	var queries []url.Values
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+
+		if r.URL.Query().Get("pageToken") == "" {
+			_, _ = w.Write([]byte(`{"files":[{"id":"f1","name":"docs","mimeType":"application/vnd.google-apps.folder"}],"nextPageToken":"p2"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"files":[{"id":"f2","name":"backup.tar","mimeType":"application/x-tar","size":"42"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	target, _ := url.Parse(srv.URL)
+
+	c := NewClient("token", ClientOptions{})
+	c.httpClient.Transport = rewriteTransport{target: target}
+
+	files, err := c.ListFolder(context.Background(), "")
+	if err != nil {
+		t.Fatalf("ListFolder() error = %v", err)
+	}
+
+	if len(files) != 2 || !files[0].IsFolder() || files[1].IsFolder() || files[1].Size != 42 {
+		t.Errorf("files = %+v, want a folder and a 42 byte file", files)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(queries))
+	}
+
+	if got, want := queries[0].Get("q"), "'root' in parents and trashed = false"; got != want {
+		t.Errorf("q = %q, want %q", got, want)
+	}
+
+	if got := queries[1].Get("pageToken"); got != "p2" {
+		t.Errorf("second pageToken = %q, want p2", got)
+	}
+}
@@ -0,0 +1,286 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	driveUploadBaseURL = "https://www.googleapis.com/upload/drive/v3"
+
+	// DefaultChunkSize is the size of the chunks a resumable upload sends
+	DefaultChunkSize = 8 << 20
+
+	// chunkGranularity is the multiple Drive requires chunk sizes to be
+	chunkGranularity = 256 << 10
+
+	// uploadRetries bounds the retries of a failed chunk
+	uploadRetries = 5
+
+	// uploadFields are the file fields returned by uploads
+	uploadFields = "id,name,mimeType,size,createdTime,modifiedTime,webViewLink"
+)
+
+// retryDelay is the delay before the first retry of a failed chunk; it
+// doubles with every further failure
+var retryDelay = time.Second
+
+// transientError is an upload failure worth retrying: a network error, rate
+// limiting or a server error.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string { return e.err.Error() }
+
+func (e *transientError) Unwrap() error { return e.err }
+
+// UploadOptions configures an upload.
+type UploadOptions struct {
+	// Name is the file name in Drive; UpdateFile keeps the name when empty
+	Name string
+
+	// MimeType is the content type; detected from Name when empty
+	MimeType string
+
+	// FolderID is the parent folder of a new file; empty for My Drive
+	FolderID string
+
+	// ChunkSize is rounded down to a multiple of 256 KiB; DefaultChunkSize when zero
+	ChunkSize int64
+
+	// OnProgress is called after every chunk with the bytes sent so far
+	OnProgress func(sent, total int64)
+}
+
+// UploadFile uploads content of the given size as a new file with a
+// resumable upload, retrying failed chunks from where Drive left off.
+func (c *Client) UploadFile(ctx context.Context, content io.ReadSeeker, size int64, opts UploadOptions) (*File, error) {
+	if opts.Name == "" {
+		return nil, errors.New("file name is required")
+	}
+
+	metadata := map[string]any{"name": opts.Name}
+	if opts.FolderID != "" {
+		metadata["parents"] = []string{opts.FolderID}
+	}
+
+	sessionURL, err := c.startUpload(ctx, http.MethodPost, driveUploadBaseURL+"/files", metadata, size, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.uploadChunks(ctx, sessionURL, content, size, opts)
+}
+
+// UpdateFile replaces the content of an existing file with a resumable
+// upload. Drive keeps the previous content as a revision.
+func (c *Client) UpdateFile(ctx context.Context, fileID string, content io.ReadSeeker, size int64, opts UploadOptions) (*File, error) {
+	metadata := map[string]any{}
+	if opts.Name != "" {
+		metadata["name"] = opts.Name
+	}
+
+	sessionURL, err := c.startUpload(ctx, http.MethodPatch, driveUploadBaseURL+"/files/"+url.PathEscape(fileID), metadata, size, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.uploadChunks(ctx, sessionURL, content, size, opts)
+}
+
+// startUpload creates a resumable upload session and returns its URL.
+func (c *Client) startUpload(ctx context.Context, method, endpoint string, metadata map[string]any, size int64, opts UploadOptions) (string, error) {
+	mimeType := opts.MimeType
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(opts.Name))
+	}
+
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	if method == http.MethodPost || opts.MimeType != "" {
+		metadata["mimeType"] = mimeType
+	}
+
+	body, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode metadata: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("uploadType", "resumable")
+	params.Set("fields", uploadFields)
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint+"?"+params.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Type", mimeType)
+	req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(size, 10))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+
+		return "", fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	sessionURL := resp.Header.Get("Location")
+	if sessionURL == "" {
+		return "", errors.New("no upload session URL in response")
+	}
+
+	return sessionURL, nil
+}
+
+// uploadChunks sends content to an upload session. After a failed chunk it
+// asks Drive how much it received and resumes from there.
+func (c *Client) uploadChunks(ctx context.Context, sessionURL string, content io.ReadSeeker, size int64, opts UploadOptions) (*File, error) {
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	chunkSize = max(chunkSize-chunkSize%chunkGranularity, chunkGranularity)
+	buf := make([]byte, min(chunkSize, max(size, 1)))
+
+	var offset int64
+
+	failures := 0
+
+	for {
+		n := min(int64(len(buf)), size-offset)
+
+		if _, err := content.Seek(offset, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("failed to seek: %w", err)
+		}
+
+		if _, err := io.ReadFull(content, buf[:n]); err != nil {
+			return nil, fmt.Errorf("failed to read content: %w", err)
+		}
+
+		file, next, err := c.putChunk(ctx, sessionURL, buf[:n], offset, size)
+		if err == nil {
+			failures = 0
+		} else {
+			var transient *transientError
+			if !errors.As(err, &transient) || ctx.Err() != nil || failures >= uploadRetries {
+				return nil, err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(retryDelay << failures):
+			}
+
+			failures++
+
+			file, next, err = c.putChunk(ctx, sessionURL, nil, -1, size)
+			if err != nil {
+				continue
+			}
+		}
+
+		if file != nil {
+			if opts.OnProgress != nil {
+				opts.OnProgress(size, size)
+			}
+
+			return file, nil
+		}
+
+		offset = next
+
+		if opts.OnProgress != nil {
+			opts.OnProgress(offset, size)
+		}
+	}
+}
+
+// putChunk sends a chunk starting at offset, or only asks for the upload
+// status when offset is negative. It returns the file once the upload is
+// complete, or else the offset to continue from.
+func (c *Client) putChunk(ctx context.Context, sessionURL string, chunk []byte, offset, size int64) (*File, int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sessionURL, bytes.NewReader(chunk))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	switch {
+	case offset < 0 || size == 0:
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+	default:
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(len(chunk))-1, size))
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, &transientError{fmt.Errorf("request failed: %w", err)}
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		var file File
+		if err := json.NewDecoder(resp.Body).Decode(&file); err != nil {
+			return nil, 0, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		return &file, size, nil
+	case http.StatusPermanentRedirect: // "Resume Incomplete"
+		return nil, uploadedBytes(resp.Header.Get("Range")), nil
+	case http.StatusNotFound, http.StatusGone:
+		return nil, 0, errors.New("upload session expired")
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	err = fmt.Errorf("API error %d: %s", resp.StatusCode, string(respBody))
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return nil, 0, &transientError{err}
+	}
+
+	return nil, 0, err
+}
+
+// uploadedBytes returns how many bytes Drive has received from the Range
+// header of a resume response, "bytes=0-<last byte>".
+func uploadedBytes(rangeHeader string) int64 {
+	_, last, ok := strings.Cut(strings.TrimPrefix(rangeHeader, "bytes="), "-")
+	if !ok {
+		return 0
+	}
+
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return n + 1
+}
@@ -0,0 +1,169 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSession is a resumable upload session that stores the bytes it receives
+type fakeSession struct {
+	mu       sync.Mutex
+	data     []byte
+	ranges   []string
+	failNext int // Respond 503 to this many chunk requests
+}
+
+func (s *fakeSession) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	contentRange := r.Header.Get("Content-Range")
+	s.ranges = append(s.ranges, contentRange)
+
+	body, _ := io.ReadAll(r.Body)
+
+	var total int
+
+	if strings.HasPrefix(contentRange, "bytes */") {
+		_, _ = fmt.Sscanf(contentRange, "bytes */%d", &total)
+	} else {
+		if s.failNext > 0 {
+			s.failNext--
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		var start, end int
+
+		_, _ = fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &total)
+
+		if start != len(s.data) || end-start+1 != len(body) {
+			http.Error(w, "unexpected range "+contentRange, http.StatusBadRequest)
+			return
+		}
+
+		s.data = append(s.data, body...)
+	}
+
+	if len(s.data) < total {
+		if len(s.data) > 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(s.data)-1))
+		}
+
+		w.WriteHeader(http.StatusPermanentRedirect)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, `{"id":"file-1","name":"backup.tar","size":"%d"}`, len(s.data))
+}
+
+func TestUploadChunks(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 60<<10) // 600 KiB, three chunks
+	session := &fakeSession{}
+
+	srv := httptest.NewServer(session)
+	t.Cleanup(srv.Close)
+
+	var progress []int64
+
+	c := NewClient("token", ClientOptions{})
+
+	file, err := c.uploadChunks(context.Background(), srv.URL, bytes.NewReader(content), int64(len(content)), UploadOptions{
+		ChunkSize:  chunkGranularity,
+		OnProgress: func(sent, _ int64) { progress = append(progress, sent) },
+	})
+	if err != nil {
+		t.Fatalf("uploadChunks() error = %v", err)
+	}
+
+	if file.ID != "file-1" || file.Size != int64(len(content)) {
+		t.Errorf("file = %+v, want file-1 of %d bytes", file, len(content))
+	}
+
+	if !bytes.Equal(session.data, content) {
+		t.Error("uploaded content differs")
+	}
+
+	if len(session.ranges) != 3 {
+		t.Errorf("sent %d requests %v, want 3", len(session.ranges), session.ranges)
+	}
+
+	if want := []int64{256 << 10, 512 << 10, int64(len(content))}; fmt.Sprint(progress) != fmt.Sprint(want) {
+		t.Errorf("progress = %v, want %v", progress, want)
+	}
+}
+
+func TestUploadChunks_ResumesAfterServerError(t *testing.T) {
+	retryDelay = time.Millisecond
+
+	t.Cleanup(func() { retryDelay = time.Second })
+
+	content := bytes.Repeat([]byte("x"), 300<<10)
+	session := &fakeSession{failNext: 1}
+
+	srv := httptest.NewServer(session)
+	t.Cleanup(srv.Close)
+
+	c := NewClient("token", ClientOptions{})
+
+	if _, err := c.uploadChunks(context.Background(), srv.URL, bytes.NewReader(content), int64(len(content)), UploadOptions{
+		ChunkSize: chunkGranularity,
+	}); err != nil {
+		t.Fatalf("uploadChunks() error = %v", err)
+	}
+
+	if !bytes.Equal(session.data, content) {
+		t.Error("uploaded content differs")
+	}
+
+	if session.ranges[1] != fmt.Sprintf("bytes */%d", len(content)) {
+		t.Errorf("request after the failure = %q, want a status query", session.ranges[1])
+	}
+}
+
+func TestUploadChunks_StopsOnClientError(t *testing.T) {
+	requests := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient("token", ClientOptions{})
+
+	_, err := c.uploadChunks(context.Background(), srv.URL, strings.NewReader("data"), 4, UploadOptions{})
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("uploadChunks() error = %v, want an API error 403", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}
+
+func TestUploadedBytes(t *testing.T) {
+	tests := map[string]int64{
+		"":                0,
+		"bytes=0-262143":  262144,
+		"bytes=0-0":       1,
+		"bytes=0-garbage": 0,
+	}
+
+	for header, want := range tests {
+		if got := uploadedBytes(header); got != want {
+			t.Errorf("uploadedBytes(%q) = %d, want %d", header, got, want)
+		}
+	}
+}
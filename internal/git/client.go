@@ -5,12 +5,14 @@ package git
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 )
 
@@ -126,14 +128,20 @@ func (c *Client) AuthenticatedCommand(ctx context.Context, pattern CredentialPat
 }
 
 // Clone clones a repository with authentication
-func (c *Client) Clone(ctx context.Context, cloneURL, targetPath string) error {
+// Extra git clone flags (e.g. --sparse) are placed before the URL
+func (c *Client) Clone(ctx context.Context, cloneURL, targetPath string, flags ...string) error {
 	pattern, err := CredentialPatternFromGitURL(cloneURL)
 	if err != nil {
 		// Fallback to all-matching pattern
 		pattern = AllMatchingCredentialsPattern
 	}
 
-	cmd := c.AuthenticatedCommand(ctx, pattern, "clone", cloneURL, targetPath)
+	args := make([]string, 0, len(flags)+3)
+	args = append(args, "clone")
+	args = append(args, flags...)
+	args = append(args, cloneURL, targetPath)
+
+	cmd := c.AuthenticatedCommand(ctx, pattern, args...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -293,6 +301,79 @@ func (c *Client) GetRemoteURL(ctx context.Context, remote string) (string, error
 	return strings.TrimSpace(string(output)), nil
 }
 
+// SetConfig sets a repository-local config value
+func (c *Client) SetConfig(ctx context.Context, key, value string) error {
+	args := []string{"config", "--local", key, value}
+	cmd := c.Command(ctx, args...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &GitError{
+			Stderr: string(output),
+			Args:   args[:3],
+			err:    err,
+		}
+	}
+
+	return nil
+}
+
+// GetConfig returns a repository-local config value, or "" when it is not set
+func (c *Client) GetConfig(ctx context.Context, key string) (string, error) {
+	cmd := c.Command(ctx, "config", "--local", "--get", key)
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+
+		return "", &GitError{Args: []string{"config", "--get", key}, err: err}
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// SparseCheckoutSet restricts the working tree to the given directories (cone mode)
+func (c *Client) SparseCheckoutSet(ctx context.Context, dirs ...string) error {
+	args := append([]string{"sparse-checkout", "set", "--cone"}, dirs...)
+	cmd := c.Command(ctx, args...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &GitError{
+			Stderr: string(output),
+			Args:   args,
+			err:    err,
+		}
+	}
+
+	return nil
+}
+
+// LsRemote checks that a remote URL is reachable by listing its HEAD reference
+func (c *Client) LsRemote(ctx context.Context, remoteURL string) error {
+	pattern, err := CredentialPatternFromGitURL(remoteURL)
+	if err != nil {
+		pattern = AllMatchingCredentialsPattern
+	}
+
+	cmd := c.AuthenticatedCommand(ctx, pattern, "ls-remote", "--exit-code", remoteURL, "HEAD")
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &GitError{
+			Stderr: string(output),
+			Args:   []string{"ls-remote", remoteURL},
+			err:    err,
+		}
+	}
+
+	return nil
+}
+
 // IsRepository checks if the current directory is a git repository
 func (c *Client) IsRepository(ctx context.Context) bool {
 	cmd := c.Command(ctx, "rev-parse", "--git-dir")
@@ -561,6 +642,52 @@ func ParseURL(rawURL string) (*url.URL, error) {
 	return u, nil
 }
 
+// CanonicalURL returns the form repository URLs are stored and looked up
+// in, so a repository is found however its URL was typed: ssh, git and
+// SCP-like remotes become https, the host is lower case, and credentials,
+// the query, trailing slashes and the .git suffix are dropped. The fragment
+// naming a monorepo subdirectory is kept. Local paths and URLs that cannot
+// be parsed are returned unchanged.
+func CanonicalURL(rawURL string) string {
+	u, err := ParseURL(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+	case "ssh", "git":
+		// The port of an ssh or git remote is not the one of its web host
+		u.Scheme = "https"
+		u.Host = u.Hostname()
+	default:
+		return rawURL
+	}
+
+	u.User = nil
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(strings.TrimRight(u.Path, "/"), ".git")
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.ForceQuery = false
+
+	return u.String()
+}
+
+// CanonicalURLs returns the canonical form of urls in their order, without
+// the duplicates the rewrite creates
+func CanonicalURLs(urls []string) []string {
+	out := make([]string, 0, len(urls))
+
+	for _, u := range urls {
+		if c := CanonicalURL(u); !slices.Contains(out, c) {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
 // ExtractRepoName extracts the repository name from a URL
 func ExtractRepoName(rawURL string) string {
 	u, err := ParseURL(rawURL)
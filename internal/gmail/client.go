@@ -9,7 +9,10 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/inovacc/clonr/internal/ratelimit"
 )
 
 const (
@@ -18,9 +21,7 @@ const (
 
 // Client is a Gmail API client.
 type Client struct {
-	accessToken  string
-	refreshToken string
-	httpClient   *http.Client
+	httpClient *http.Client
 }
 
 // ClientOptions configures a Gmail client.
@@ -28,15 +29,29 @@ type ClientOptions struct {
 	RefreshToken string
 	ClientID     string
 	ClientSecret string
+
+	// OnTokenRefresh is called after an expired access token was refreshed,
+	// so the new token can be stored. The result always carries the refresh
+	// token in use.
+	OnTokenRefresh func(token *OAuthResult)
 }
 
-// NewClient creates a new Gmail API client.
+// NewClient creates a new Gmail API client. With a refresh token, client ID
+// and client secret, an access token rejected by the API is refreshed and
+// the request retried transparently.
 func NewClient(accessToken string, opts ClientOptions) *Client {
 	return &Client{
-		accessToken:  accessToken,
-		refreshToken: opts.RefreshToken,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
+			Transport: &refreshTransport{
+				base:         ratelimit.NewTransport(http.DefaultTransport),
+				clientID:     opts.ClientID,
+				clientSecret: opts.ClientSecret,
+				refresh:      RefreshAccessToken,
+				onRefresh:    opts.OnTokenRefresh,
+				accessToken:  accessToken,
+				refreshToken: opts.RefreshToken,
+			},
 		},
 	}
 }
@@ -201,6 +216,35 @@ func (c *Client) GetMessage(ctx context.Context, id string, format string) (*Mes
 	return &msg, nil
 }
 
+// messageFetchWorkers bounds the concurrent requests of GetMessages
+const messageFetchWorkers = 8
+
+// GetMessages retrieves messages by ID concurrently, in the order of ids.
+// Messages that fail to load are nil.
+func (c *Client) GetMessages(ctx context.Context, ids []string, format string) []*Message {
+	messages := make([]*Message, len(ids))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(messageFetchWorkers, len(ids)) {
+		wg.Go(func() {
+			for i := range jobs {
+				messages[i], _ = c.GetMessage(ctx, ids[i], format)
+			}
+		})
+	}
+
+	for i := range ids {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return messages
+}
+
 // SearchMessages searches for messages using Gmail query syntax.
 func (c *Client) SearchMessages(ctx context.Context, query string, maxResults int) (*ListMessagesResponse, error) {
 	return c.ListMessages(ctx, ListMessagesOptions{
@@ -701,6 +745,16 @@ func extractBody(payload *MessagePayload, mimeType string) string {
 	return ""
 }
 
+// APIError is an error response of the Gmail API.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Body)
+}
+
 // get performs a GET request to the Gmail API.
 func (c *Client) get(ctx context.Context, endpoint string, params url.Values, result any) error {
 	reqURL := fmt.Sprintf("%s/%s", gmailAPIBaseURL, endpoint)
@@ -713,7 +767,6 @@ func (c *Client) get(ctx context.Context, endpoint string, params url.Values, re
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.accessToken)
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := c.httpClient.Do(req)
@@ -726,7 +779,7 @@ func (c *Client) get(ctx context.Context, endpoint string, params url.Values, re
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 
-		return fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+		return &APIError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
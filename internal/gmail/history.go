@@ -0,0 +1,88 @@
+package gmail
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ErrHistoryExpired is returned by ListHistory when the start history ID is
+// too old; Gmail keeps history for about a week.
+var ErrHistoryExpired = errors.New("gmail history ID expired")
+
+// History is a change to the mailbox.
+type History struct {
+	ID            string         `json:"id"`
+	MessagesAdded []MessageAdded `json:"messagesAdded"`
+}
+
+// MessageAdded is a message added to the mailbox.
+type MessageAdded struct {
+	Message MessageRef `json:"message"`
+}
+
+// ListHistoryResponse contains the list history response.
+type ListHistoryResponse struct {
+	History       []History `json:"history"`
+	NextPageToken string    `json:"nextPageToken"`
+
+	// HistoryID is the current history ID of the mailbox
+	HistoryID string `json:"historyId"`
+}
+
+// ListHistory lists the messages added to the mailbox after the history ID
+// startHistoryID, one page at a time.
+func (c *Client) ListHistory(ctx context.Context, startHistoryID, pageToken string) (*ListHistoryResponse, error) {
+	params := url.Values{}
+	params.Set("startHistoryId", startHistoryID)
+	params.Set("historyTypes", "messageAdded")
+	params.Set("maxResults", "500")
+
+	if pageToken != "" {
+		params.Set("pageToken", pageToken)
+	}
+
+	var resp ListHistoryResponse
+	if err := c.get(ctx, "users/me/history", params, &resp); err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, ErrHistoryExpired
+		}
+
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AddedMessageIDs returns the IDs of the messages added after the history ID
+// startHistoryID, following every page, and the current history ID.
+func (c *Client) AddedMessageIDs(ctx context.Context, startHistoryID string) ([]string, string, error) {
+	var (
+		ids       []string
+		historyID string
+		pageToken string
+	)
+
+	for {
+		resp, err := c.ListHistory(ctx, startHistoryID, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, h := range resp.History {
+			for _, added := range h.MessagesAdded {
+				ids = append(ids, added.Message.ID)
+			}
+		}
+
+		historyID = resp.HistoryID
+
+		if resp.NextPageToken == "" {
+			return ids, historyID, nil
+		}
+
+		pageToken = resp.NextPageToken
+	}
+}
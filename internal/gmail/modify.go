@@ -0,0 +1,96 @@
+package gmail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ModifyScope allows changing the labels of messages, which archiving and
+// marking messages read is done with. It is not part of DefaultScopes, so
+// accounts are read-only unless it was requested explicitly.
+const ModifyScope = "https://www.googleapis.com/auth/gmail.modify"
+
+// System labels changed by archiving and marking messages read.
+const (
+	LabelInbox  = "INBOX"
+	LabelUnread = "UNREAD"
+)
+
+// ModifyMessage adds and removes labels of a message and returns the message
+// with its new labels. It needs the gmail.modify scope.
+func (c *Client) ModifyMessage(ctx context.Context, id string, addLabelIDs, removeLabelIDs []string) (*Message, error) {
+	payload, err := json.Marshal(struct {
+		AddLabelIDs    []string `json:"addLabelIds,omitempty"`
+		RemoveLabelIDs []string `json:"removeLabelIds,omitempty"`
+	}{addLabelIDs, removeLabelIDs})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode labels: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/users/me/messages/%s/modify", gmailAPIBaseURL, url.PathEscape(id))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var msg Message
+	if err := json.NewDecoder(resp.Body).Decode(&msg); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &msg, nil
+}
+
+// ArchiveMessage removes a message from the inbox.
+func (c *Client) ArchiveMessage(ctx context.Context, id string) (*Message, error) {
+	return c.ModifyMessage(ctx, id, nil, []string{LabelInbox})
+}
+
+// MarkRead marks a message as read, or as unread when read is false.
+func (c *Client) MarkRead(ctx context.Context, id string, read bool) (*Message, error) {
+	if read {
+		return c.ModifyMessage(ctx, id, nil, []string{LabelUnread})
+	}
+
+	return c.ModifyMessage(ctx, id, []string{LabelUnread}, nil)
+}
+
+// FindLabel returns the label with the given ID or name. Names are matched
+// case-insensitively, since system labels are listed in upper case.
+func FindLabel(labels []Label, idOrName string) (Label, bool) {
+	for _, label := range labels {
+		if label.ID == idOrName {
+			return label, true
+		}
+	}
+
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, idOrName) {
+			return label, true
+		}
+	}
+
+	return Label{}, false
+}
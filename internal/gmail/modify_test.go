@@ -0,0 +1,78 @@
+package gmail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+// labelServer applies modify requests to the labels of a single message
+type labelServer struct {
+	labels []string
+	path   string
+}
+
+func (s *labelServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.path = req.URL.Path
+
+	var body struct {
+		AddLabelIDs    []string `json:"addLabelIds"`
+		RemoveLabelIDs []string `json:"removeLabelIds"`
+	}
+
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	s.labels = slices.DeleteFunc(s.labels, func(label string) bool {
+		return slices.Contains(body.RemoveLabelIDs, label)
+	})
+	s.labels = append(s.labels, body.AddLabelIDs...)
+
+	data, _ := json.Marshal(Message{ID: "m1", LabelIDs: s.labels})
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(data)),
+		Request:    req,
+	}, nil
+}
+
+func TestModifyMessage(t *testing.T) {
+	server := &labelServer{labels: []string{LabelInbox, LabelUnread}}
+	c := &Client{httpClient: &http.Client{Transport: server}}
+
+	msg, err := c.ArchiveMessage(context.Background(), "m1")
+	if err != nil {
+		t.Fatalf("ArchiveMessage() error = %v", err)
+	}
+
+	if server.path != "/gmail/v1/users/me/messages/m1/modify" || slices.Contains(msg.LabelIDs, LabelInbox) {
+		t.Errorf("ArchiveMessage() posted to %s, labels %v", server.path, msg.LabelIDs)
+	}
+
+	if msg, _ = c.MarkRead(context.Background(), "m1", true); !slices.Equal(msg.LabelIDs, []string{}) {
+		t.Errorf("MarkRead(true) labels = %v, want none", msg.LabelIDs)
+	}
+
+	if msg, _ = c.ModifyMessage(context.Background(), "m1", []string{"Label_1"}, nil); !slices.Equal(msg.LabelIDs, []string{"Label_1"}) {
+		t.Errorf("ModifyMessage() labels = %v, want [Label_1]", msg.LabelIDs)
+	}
+}
+
+func TestFindLabel(t *testing.T) {
+	labels := []Label{{ID: "INBOX", Name: "INBOX"}, {ID: "Label_1", Name: "Work"}, {ID: "Label_2", Name: "Label_1"}}
+
+	tests := map[string]string{"inbox": "INBOX", "work": "Label_1", "Label_1": "Label_1"}
+	for name, want := range tests {
+		if label, ok := FindLabel(labels, name); !ok || label.ID != want {
+			t.Errorf("FindLabel(%q) = %q, %v; want %q", name, label.ID, ok, want)
+		}
+	}
+
+	if _, ok := FindLabel(labels, "missing"); ok {
+		t.Error("FindLabel(missing) found a label")
+	}
+}
@@ -7,20 +7,26 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/inovacc/clonr/internal/auth"
 )
 
 const (
-	googleAuthURL  = "https://accounts.google.com/o/oauth2/v2/auth"
-	googleTokenURL = "https://oauth2.googleapis.com/token"
+	googleAuthURL       = "https://accounts.google.com/o/oauth2/v2/auth"
+	googleTokenURL      = "https://oauth2.googleapis.com/token"
+	googleDeviceCodeURL = "https://oauth2.googleapis.com/device/code"
 )
 
 // DefaultScopes are the default Gmail API scopes.
 var DefaultScopes = []string{
 	"https://www.googleapis.com/auth/gmail.readonly",
+	"https://www.googleapis.com/auth/gmail.send",
 	"https://www.googleapis.com/auth/userinfo.email",
 	"https://www.googleapis.com/auth/drive.readonly",
+	"https://www.googleapis.com/auth/drive.file",
 }
 
 // OAuthConfig configures the OAuth flow.
@@ -144,6 +150,36 @@ func RunOAuthFlow(ctx context.Context, config OAuthConfig, openBrowser func(stri
 	}
 }
 
+// RunDeviceFlow runs the OAuth2 device authorization flow for Gmail, for
+// machines without a browser. The client must be of type "TVs and Limited
+// Input devices", and Google only grants a limited set of scopes to such
+// clients; scopes it does not allow are rejected with invalid_scope.
+func RunDeviceFlow(ctx context.Context, config OAuthConfig, display func(*auth.DeviceCode)) (*OAuthResult, error) {
+	if len(config.Scopes) == 0 {
+		config.Scopes = DefaultScopes
+	}
+
+	token, err := auth.RunDeviceFlow(ctx, auth.DeviceFlowConfig{
+		DeviceCodeURL: googleDeviceCodeURL,
+		TokenURL:      googleTokenURL,
+		ClientID:      config.ClientID,
+		ClientSecret:  config.ClientSecret,
+		Scopes:        config.Scopes,
+	}, display)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OAuthResult{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    token.ExpiresIn,
+		Scope:        token.Scope,
+		IDToken:      token.IDToken,
+	}, nil
+}
+
 // buildAuthURL constructs the Google OAuth authorization URL.
 func buildAuthURL(config OAuthConfig) string {
 	params := url.Values{}
@@ -234,7 +270,72 @@ func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToke
 	return &result, nil
 }
 
-// ValidateToken validates an access token by calling the tokeninfo endpoint.
+// AccessTokenInfo describes an access token as reported by the tokeninfo endpoint.
+type AccessTokenInfo struct {
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// InspectToken returns the scopes and expiration of an access token using the tokeninfo endpoint.
+func InspectToken(ctx context.Context, accessToken string) (*AccessTokenInfo, error) {
+	u := "https://oauth2.googleapis.com/tokeninfo?" + url.Values{"access_token": {accessToken}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tokeninfo request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+
+		return nil, fmt.Errorf("tokeninfo error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var info struct {
+		Scope string `json:"scope"`
+		Exp   string `json:"exp"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("failed to decode tokeninfo response: %w", err)
+	}
+
+	result := &AccessTokenInfo{Scopes: ParseScopes(info.Scope)}
+
+	if exp, err := strconv.ParseInt(info.Exp, 10, 64); err == nil && exp > 0 {
+		result.ExpiresAt = time.Unix(exp, 0)
+	}
+
+	return result, nil
+}
+
+// ParseScopes splits a space-separated scope string, dropping OpenID identity
+// scopes that Google adds implicitly.
+func ParseScopes(scope string) []string {
+	scopes := []string{}
+
+	for _, s := range strings.Fields(scope) {
+		switch s {
+		case "openid", "email", "profile":
+			continue
+		}
+
+		scopes = append(scopes, s)
+	}
+
+	return scopes
+}
+
+// ValidateToken validates an access token by calling the userinfo endpoint.
 func ValidateToken(ctx context.Context, accessToken string) (*TokenInfo, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
 		"https://www.googleapis.com/oauth2/v3/userinfo", nil)
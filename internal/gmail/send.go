@@ -0,0 +1,303 @@
+package gmail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// OutgoingMessage is an email to send from the authenticated account.
+type OutgoingMessage struct {
+	To          []string
+	Cc          []string
+	Bcc         []string
+	Subject     string
+	Body        string // Plain text body
+	HTMLBody    string // Optional HTML alternative of Body
+	Attachments []OutgoingAttachment
+}
+
+// OutgoingAttachment is a file attached to an outgoing message.
+type OutgoingAttachment struct {
+	Filename string
+	MimeType string // Detected from Filename when empty
+	Data     []byte
+}
+
+// SentMessage identifies a message sent with Send.
+type SentMessage struct {
+	ID       string   `json:"id"`
+	ThreadID string   `json:"threadId"`
+	LabelIDs []string `json:"labelIds"`
+}
+
+// Send sends a message from the authenticated account. It needs the
+// gmail.send scope.
+func (c *Client) Send(ctx context.Context, msg *OutgoingMessage) (*SentMessage, error) {
+	raw, err := BuildRawMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"raw": base64.RawURLEncoding.EncodeToString(raw),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, gmailAPIBaseURL+"/users/me/messages/send", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	var sent SentMessage
+	if err := json.NewDecoder(resp.Body).Decode(&sent); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &sent, nil
+}
+
+// SendMessage sends a plain text email from the authenticated account.
+// It needs the gmail.send scope.
+func (c *Client) SendMessage(ctx context.Context, to, subject, body string) error {
+	_, err := c.Send(ctx, &OutgoingMessage{
+		To:      []string{to},
+		Subject: subject,
+		Body:    body,
+	})
+
+	return err
+}
+
+// BuildRawMessage encodes msg as an RFC 5322 message. Text parts are
+// quoted-printable, attachments base64; an HTML body becomes a
+// multipart/alternative and attachments a multipart/mixed message.
+func BuildRawMessage(msg *OutgoingMessage) ([]byte, error) {
+	if len(msg.To)+len(msg.Cc)+len(msg.Bcc) == 0 {
+		return nil, errors.New("message has no recipients")
+	}
+
+	var buf bytes.Buffer
+
+	for _, field := range []struct {
+		name  string
+		addrs []string
+	}{{"To", msg.To}, {"Cc", msg.Cc}, {"Bcc", msg.Bcc}} {
+		if len(field.addrs) == 0 {
+			continue
+		}
+
+		list, err := formatAddressList(field.addrs)
+		if err != nil {
+			return nil, err
+		}
+
+		_, _ = fmt.Fprintf(&buf, "%s: %s\r\n", field.name, list)
+	}
+
+	_, _ = fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	header, body, err := bodyPart(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Attachments) == 0 {
+		writeMIMEHeader(&buf, header)
+		buf.Write(body)
+
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	writeMIMEHeader(&buf, textproto.MIMEHeader{
+		"Content-Type": {mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mw.Boundary()})},
+	})
+
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := part.Write(body); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range msg.Attachments {
+		if err := writeAttachment(mw, attachment); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatAddressList validates addresses and joins them for a header
+func formatAddressList(addrs []string) (string, error) {
+	formatted := make([]string, 0, len(addrs))
+
+	for _, addr := range addrs {
+		parsed, err := mail.ParseAddress(addr)
+		if err != nil {
+			return "", fmt.Errorf("invalid email address %q: %w", addr, err)
+		}
+
+		formatted = append(formatted, parsed.String())
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// bodyPart returns the header and encoded content of the message body:
+// the text part, or a multipart/alternative of the text and HTML parts
+func bodyPart(msg *OutgoingMessage) (textproto.MIMEHeader, []byte, error) {
+	if msg.HTMLBody == "" {
+		return textPart("text/plain", msg.Body)
+	}
+
+	var buf bytes.Buffer
+
+	mw := multipart.NewWriter(&buf)
+
+	for _, alt := range []struct{ contentType, text string }{
+		{"text/plain", msg.Body},
+		{"text/html", msg.HTMLBody},
+	} {
+		header, body, err := textPart(alt.contentType, alt.text)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if _, err := part.Write(body); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type": {mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": mw.Boundary()})},
+	}
+
+	return header, buf.Bytes(), nil
+}
+
+// textPart encodes text as a quoted-printable UTF-8 part
+func textPart(contentType, text string) (textproto.MIMEHeader, []byte, error) {
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\n", "\r\n")
+
+	var buf bytes.Buffer
+
+	qp := quotedprintable.NewWriter(&buf)
+	if _, err := qp.Write([]byte(text)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := qp.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {contentType + "; charset=\"UTF-8\""},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	}
+
+	return header, buf.Bytes(), nil
+}
+
+// writeAttachment adds an attachment part, base64 encoded in 76 character lines
+func writeAttachment(mw *multipart.Writer, attachment OutgoingAttachment) error {
+	filename := filepath.Base(attachment.Filename)
+
+	mimeType := attachment.MimeType
+	if mimeType == "" {
+		mimeType = mime.TypeByExtension(filepath.Ext(filename))
+	}
+
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mimeType},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": filename})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+
+		encoded = encoded[76:]
+	}
+
+	_, err = io.WriteString(part, encoded+"\r\n")
+
+	return err
+}
+
+// writeMIMEHeader writes header fields in sorted order and ends the header
+func writeMIMEHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		for _, value := range header[key] {
+			_, _ = fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,147 @@
+package gmail
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestBuildRawMessage_PlainText(t *testing.T) {
+	raw, err := BuildRawMessage(&OutgoingMessage{
+		To:      []string{"Dev Team <dev@example.com>", "ops@example.com"},
+		Subject: "Fleet report – week 42",
+		Body:    "3 repositories failed to update\nsee below",
+	})
+	if err != nil {
+		t.Fatalf("BuildRawMessage() error = %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+
+	if got, want := msg.Header.Get("To"), `"Dev Team" <dev@example.com>, <ops@example.com>`; got != want {
+		t.Errorf("To = %q, want %q", got, want)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Fleet report – week 42" {
+		t.Errorf("Subject = %q (%v), want the original subject", subject, err)
+	}
+
+	body := readPart(t, msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if body != "3 repositories failed to update\r\nsee below" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestBuildRawMessage_HTMLAndAttachments(t *testing.T) {
+	raw, err := BuildRawMessage(&OutgoingMessage{
+		To:       []string{"dev@example.com"},
+		Subject:  "Report",
+		Body:     "plain",
+		HTMLBody: "<p>html</p>",
+		Attachments: []OutgoingAttachment{
+			{Filename: "/tmp/report.json", Data: []byte(`{"repos":3}`)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BuildRawMessage() error = %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if mediaType != "multipart/mixed" {
+		t.Fatalf("Content-Type = %q, want multipart/mixed", mediaType)
+	}
+
+	mixed := multipart.NewReader(msg.Body, params["boundary"])
+
+	alternative, err := mixed.NextPart()
+	if err != nil {
+		t.Fatalf("NextPart() error = %v", err)
+	}
+
+	mediaType, params, _ = mime.ParseMediaType(alternative.Header.Get("Content-Type"))
+	if mediaType != "multipart/alternative" {
+		t.Fatalf("body Content-Type = %q, want multipart/alternative", mediaType)
+	}
+
+	var texts []string
+
+	alternatives := multipart.NewReader(alternative, params["boundary"])
+	for {
+		part, err := alternatives.NextPart()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("NextPart() error = %v", err)
+		}
+
+		texts = append(texts, readPart(t, part.Header.Get("Content-Transfer-Encoding"), part))
+	}
+
+	if strings.Join(texts, "|") != "plain|<p>html</p>" {
+		t.Errorf("alternatives = %q, want plain and HTML", texts)
+	}
+
+	attachment, err := mixed.NextPart()
+	if err != nil {
+		t.Fatalf("NextPart() error = %v", err)
+	}
+
+	if attachment.FileName() != "report.json" {
+		t.Errorf("FileName() = %q, want report.json", attachment.FileName())
+	}
+
+	if got := attachment.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("attachment Content-Type = %q, want application/json", got)
+	}
+
+	if data := readPart(t, "base64", attachment); data != `{"repos":3}` {
+		t.Errorf("attachment data = %q", data)
+	}
+}
+
+func TestBuildRawMessage_Invalid(t *testing.T) {
+	if _, err := BuildRawMessage(&OutgoingMessage{Subject: "none"}); err == nil {
+		t.Error("expected an error without recipients")
+	}
+
+	if _, err := BuildRawMessage(&OutgoingMessage{To: []string{"a@example.com\r\nBcc: x@example.com"}}); err == nil {
+		t.Error("expected an error for an address with a line break")
+	}
+}
+
+// readPart decodes a part body with the given transfer encoding. Parts read
+// with multipart.Reader are already decoded from quoted-printable.
+func readPart(t *testing.T, encoding string, r io.Reader) string {
+	t.Helper()
+
+	switch encoding {
+	case "quoted-printable":
+		r = quotedprintable.NewReader(r)
+	case "base64":
+		r = base64.NewDecoder(base64.StdEncoding, r)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read part: %v", err)
+	}
+
+	return string(data)
+}
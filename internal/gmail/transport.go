@@ -0,0 +1,115 @@
+package gmail
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// refreshTransport authorizes requests with the current access token and,
+// when the API rejects it, refreshes the token once and retries the request
+type refreshTransport struct {
+	base http.RoundTripper
+
+	clientID     string
+	clientSecret string
+
+	// refresh exchanges a refresh token; RefreshAccessToken outside tests
+	refresh func(ctx context.Context, clientID, clientSecret, refreshToken string) (*OAuthResult, error)
+
+	// onRefresh is called with every new token so it can be persisted
+	onRefresh func(*OAuthResult)
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *refreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := t.token()
+
+	resp, err := t.base.RoundTrip(authorize(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !t.canRefresh() {
+		return resp, err
+	}
+
+	// Only requests without a body, or with a replayable one, are retried
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	newToken, refreshErr := t.refreshIfCurrent(req.Context(), token)
+	if refreshErr != nil {
+		return resp, nil
+	}
+
+	_ = resp.Body.Close()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		retry.Body = body
+	}
+
+	return t.base.RoundTrip(authorize(retry, newToken))
+}
+
+// token returns the current access token
+func (t *refreshTransport) token() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.accessToken
+}
+
+// canRefresh reports whether the credentials allow refreshing the token
+func (t *refreshTransport) canRefresh() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.refreshToken != "" && t.clientID != "" && t.clientSecret != ""
+}
+
+// refreshIfCurrent refreshes the access token unless a concurrent request
+// already replaced the rejected one, and returns the token to retry with
+func (t *refreshTransport) refreshIfCurrent(ctx context.Context, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != rejected {
+		return t.accessToken, nil
+	}
+
+	result, err := t.refresh(ctx, t.clientID, t.clientSecret, t.refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	t.accessToken = result.AccessToken
+
+	// Google only returns a refresh token when it rotates it
+	if result.RefreshToken == "" {
+		result.RefreshToken = t.refreshToken
+	}
+
+	t.refreshToken = result.RefreshToken
+
+	if t.onRefresh != nil {
+		t.onRefresh(result)
+	}
+
+	return t.accessToken, nil
+}
+
+// authorize returns a copy of req carrying token
+func authorize(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+
+	return r
+}
@@ -0,0 +1,92 @@
+package gmail
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRefreshTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"emailAddress":"me@example.com"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	var saved *OAuthResult
+
+	refreshes := 0
+	transport := &refreshTransport{
+		base:         http.DefaultTransport,
+		clientID:     "id",
+		clientSecret: "secret",
+		refresh: func(_ context.Context, _, _, refreshToken string) (*OAuthResult, error) {
+			refreshes++
+
+			if refreshToken != "refresh" {
+				return nil, errors.New("bad refresh token")
+			}
+
+			return &OAuthResult{AccessToken: "new"}, nil
+		},
+		onRefresh:    func(token *OAuthResult) { saved = token },
+		accessToken:  "old",
+		refreshToken: "refresh",
+	}
+
+	client := &http.Client{Transport: transport}
+
+	for range 2 {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Get() status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+	}
+
+	if refreshes != 1 {
+		t.Errorf("token refreshed %d times, want 1", refreshes)
+	}
+
+	if saved == nil || saved.AccessToken != "new" || saved.RefreshToken != "refresh" {
+		t.Errorf("onRefresh got %+v, want the new token with the refresh token kept", saved)
+	}
+}
+
+func TestRefreshTransportWithoutCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	transport := &refreshTransport{
+		base: http.DefaultTransport,
+		refresh: func(context.Context, string, string, string) (*OAuthResult, error) {
+			t.Error("refresh called without a refresh token")
+			return nil, errors.New("unexpected")
+		},
+		accessToken: "old",
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Get() status = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,324 @@
+// Package inbox merges unread email and Slack conversations addressed to the
+// user into one chronological inbox.
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/inovacc/clonr/internal/gmail"
+	"github.com/inovacc/clonr/internal/slack"
+)
+
+// Source is the integration an inbox item comes from.
+type Source string
+
+const (
+	SourceGmail Source = "gmail"
+	SourceSlack Source = "slack"
+)
+
+// Icon returns the icon shown before items of the source.
+func (s Source) Icon() string {
+	switch s {
+	case SourceGmail:
+		return "📧"
+	case SourceSlack:
+		return "💬"
+	default:
+		return "•"
+	}
+}
+
+// Item is a message in the inbox.
+type Item struct {
+	Source Source    `json:"source"`
+	ID     string    `json:"id"` // Gmail message ID or Slack channel/timestamp
+	From   string    `json:"from"`
+	Where  string    `json:"where"` // Mailbox label or Slack conversation
+	Title  string    `json:"title"`
+	Text   string    `json:"text,omitempty"`
+	Time   time.Time `json:"time"`
+	Link   string    `json:"link,omitempty"`
+}
+
+// dmHistoryWorkers bounds the direct message histories read at the same time
+const dmHistoryWorkers = 8
+
+// titleLength is the length Slack messages are cut to in titles
+const titleLength = 80
+
+// Merge combines items of several sources, newest first, dropping the copies
+// of a message found more than once (a mention in a direct message).
+func Merge(sources ...[]Item) []Item {
+	seen := make(map[string]bool)
+
+	var items []Item
+
+	for _, source := range sources {
+		for _, item := range source {
+			key := string(item.Source) + "/" + item.ID
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			items = append(items, item)
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b Item) int {
+		return b.Time.Compare(a.Time)
+	})
+
+	return items
+}
+
+// GmailItems returns the unread inbox messages received since the given time.
+func GmailItems(ctx context.Context, client *gmail.Client, since time.Time, limit int) ([]Item, error) {
+	resp, err := client.ListMessages(ctx, gmail.ListMessagesOptions{
+		MaxResults: limit,
+		LabelIDs:   []string{gmail.LabelInbox, gmail.LabelUnread},
+		Query:      "after:" + strconv.FormatInt(since.Unix(), 10),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unread messages: %w", err)
+	}
+
+	ids := make([]string, len(resp.Messages))
+	for i, ref := range resp.Messages {
+		ids[i] = ref.ID
+	}
+
+	var items []Item
+
+	for _, msg := range client.GetMessages(ctx, ids, "metadata") {
+		if msg != nil {
+			items = append(items, gmailItem(msg))
+		}
+	}
+
+	return items, nil
+}
+
+// gmailItem converts a message fetched with its headers
+func gmailItem(msg *gmail.Message) Item {
+	item := Item{
+		Source: SourceGmail,
+		ID:     msg.ID,
+		From:   msg.Headers["from"],
+		Where:  "Inbox",
+		Title:  msg.Headers["subject"],
+		Text:   msg.Snippet,
+		Link:   "https://mail.google.com/mail/u/0/#inbox/" + msg.ID,
+	}
+
+	if item.Title == "" {
+		item.Title = "(no subject)"
+	}
+
+	// internalDate is the receive time in milliseconds
+	if ms, err := strconv.ParseInt(msg.InternalDate, 10, 64); err == nil {
+		item.Time = time.UnixMilli(ms)
+	}
+
+	return item
+}
+
+// SlackItems returns the messages mentioning the user and the direct
+// messages from others since the given time, at most limit of each.
+// Mentions are found with search, which needs a user token; with a bot
+// token they are skipped and only direct messages are returned, along
+// with the search error.
+func SlackItems(ctx context.Context, client *slack.Client, since time.Time, limit int) ([]Item, error) {
+	auth, err := client.AuthTest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check Slack token: %w", err)
+	}
+
+	dms, err := slackDirectMessages(ctx, client, auth, since, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, searchErr := slackMentions(ctx, client, auth, since, limit)
+
+	items := append(mentions, dms...)
+
+	names := resolveSlackNames(ctx, client, items)
+	for i := range items {
+		if name, ok := names[items[i].From]; ok {
+			items[i].From = name
+		}
+	}
+
+	if searchErr != nil {
+		return items, fmt.Errorf("failed to search Slack mentions: %w", searchErr)
+	}
+
+	return items, nil
+}
+
+// slackMentions searches the messages mentioning the user
+func slackMentions(ctx context.Context, client *slack.Client, auth *slack.AuthTestResult, since time.Time, limit int) ([]Item, error) {
+	// after: is exclusive and only takes a date
+	query := fmt.Sprintf("<@%s> after:%s", auth.UserID, since.AddDate(0, 0, -1).Format(time.DateOnly))
+
+	result, err := client.SearchMessages(ctx, slack.SearchMessagesOptions{
+		Query: query,
+		Sort:  "timestamp",
+		Dir:   "desc",
+		Count: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []Item
+
+	for _, match := range result.Matches {
+		item := slackItem(auth, match.Channel.ID, match.User, match.Timestamp, match.Text)
+		if item.Time.Before(since) || match.User == auth.UserID {
+			continue
+		}
+
+		item.Where = "#" + match.Channel.Name
+		item.Link = match.Permalink
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// slackDirectMessages reads the direct message histories since the given
+// time, keeping the messages of others
+func slackDirectMessages(ctx context.Context, client *slack.Client, auth *slack.AuthTestResult, since time.Time, limit int) ([]Item, error) {
+	result, err := client.ListChannels(ctx, slack.ListChannelsOptions{
+		Types:           slack.DirectMessageTypes,
+		ExcludeArchived: true,
+		Limit:           1000,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list direct messages: %w", err)
+	}
+
+	histories := make([][]Item, len(result.Channels))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for range min(dmHistoryWorkers, len(result.Channels)) {
+		wg.Go(func() {
+			for i := range jobs {
+				histories[i] = slackHistoryItems(ctx, client, auth, result.Channels[i], since, limit)
+			}
+		})
+	}
+
+	for i := range result.Channels {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	var items []Item
+	for _, history := range histories {
+		items = append(items, history...)
+	}
+
+	slices.SortFunc(items, func(a, b Item) int {
+		return b.Time.Compare(a.Time)
+	})
+
+	return items[:min(limit, len(items))], nil
+}
+
+// slackHistoryItems returns the messages of others in a direct message; a
+// conversation that cannot be read is left out
+func slackHistoryItems(ctx context.Context, client *slack.Client, auth *slack.AuthTestResult, ch slack.Channel, since time.Time, limit int) []Item {
+	history, err := client.GetChannelHistory(ctx, slack.GetChannelHistoryOptions{
+		Channel: ch.ID,
+		Oldest:  slack.FormatTimestamp(since),
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil
+	}
+
+	where := "DM"
+	if ch.IsMpIM {
+		where = "Group DM: " + strings.Join(ch.GroupMembers(), ", ")
+	}
+
+	var items []Item
+
+	for _, msg := range history.Messages {
+		if msg.User == "" || msg.User == auth.UserID || msg.BotID != "" {
+			continue
+		}
+
+		item := slackItem(auth, ch.ID, msg.User, msg.Timestamp, msg.Text)
+		item.Where = where
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// slackItem builds an item of a Slack message; From holds the user ID until
+// names are resolved
+func slackItem(auth *slack.AuthTestResult, channelID, user, ts, text string) Item {
+	item := Item{
+		Source: SourceSlack,
+		ID:     channelID + "/" + ts,
+		From:   user,
+		Title:  firstLine(text, titleLength),
+		Text:   text,
+		Link:   fmt.Sprintf("slack://channel?team=%s&id=%s", auth.TeamID, channelID),
+	}
+
+	item.Time, _ = slack.ParseTimestamp(ts)
+
+	return item
+}
+
+// resolveSlackNames maps the user IDs of the items to display names
+func resolveSlackNames(ctx context.Context, client *slack.Client, items []Item) map[string]string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.From)
+	}
+
+	names := make(map[string]string)
+	for id, user := range client.GetUsers(ctx, ids) {
+		names[id] = user.DisplayName()
+	}
+
+	return names
+}
+
+// firstLine returns the first non-empty line of text, cut to n runes
+func firstLine(text string, n int) string {
+	for line := range strings.SplitSeq(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if runes := []rune(line); len(runes) > n {
+			return string(runes[:n-1]) + "…"
+		}
+
+		return line
+	}
+
+	return "(no text)"
+}
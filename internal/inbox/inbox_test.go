@@ -0,0 +1,60 @@
+package inbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/gmail"
+)
+
+func TestMerge(t *testing.T) {
+	now := time.Now()
+
+	gmailItems := []Item{{Source: SourceGmail, ID: "m1", Time: now.Add(-2 * time.Hour)}}
+	slackItems := []Item{
+		{Source: SourceSlack, ID: "D1/1.0", Time: now.Add(-time.Hour)},
+		{Source: SourceSlack, ID: "D1/1.0", Time: now.Add(-time.Hour)},
+		{Source: SourceSlack, ID: "C1/2.0", Time: now.Add(-3 * time.Hour)},
+	}
+
+	items := Merge(gmailItems, slackItems)
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	if len(ids) != 3 || ids[0] != "D1/1.0" || ids[1] != "m1" || ids[2] != "C1/2.0" {
+		t.Errorf("Merge() = %v, want [D1/1.0 m1 C1/2.0]", ids)
+	}
+}
+
+func TestGmailItem(t *testing.T) {
+	item := gmailItem(&gmail.Message{
+		ID:           "m1",
+		InternalDate: "1700000000000",
+		Snippet:      "Your invoice",
+		Headers:      map[string]string{"from": "billing@example.com"},
+	})
+
+	if item.Title != "(no subject)" || item.From != "billing@example.com" || !item.Time.Equal(time.UnixMilli(1700000000000)) {
+		t.Errorf("gmailItem() = %+v", item)
+	}
+}
+
+func TestFirstLine(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"\n  hello\nworld", "hello"},
+		{"", "(no text)"},
+		{"abcdefghij", "abcd…"},
+	}
+
+	for _, tt := range tests {
+		if got := firstLine(tt.text, 5); got != tt.want {
+			t.Errorf("firstLine(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
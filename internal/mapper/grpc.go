@@ -3,8 +3,11 @@
 package mapper
 
 import (
+	"time"
+
 	v1 "github.com/inovacc/clonr/internal/api/v1"
 	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/standalone"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,16 +20,38 @@ func ModelToProtoRepository(repo *model.Repository) *v1.Repository {
 	}
 
 	return &v1.Repository{
-		Id:          uint32(repo.ID),
-		Uid:         repo.UID,
-		Url:         repo.URL,
-		Path:        repo.Path,
-		Workspace:   repo.Workspace,
-		Favorite:    repo.Favorite,
-		ClonedAt:    timestamppb.New(repo.ClonedAt),
-		UpdatedAt:   timestamppb.New(repo.UpdatedAt),
-		LastChecked: timestamppb.New(repo.LastChecked),
+		Id:           uint32(repo.ID),
+		Uid:          repo.UID,
+		Url:          repo.URL,
+		Path:         repo.Path,
+		Workspace:    repo.Workspace,
+		Favorite:     repo.Favorite,
+		ClonedAt:     timestamppb.New(repo.ClonedAt),
+		UpdatedAt:    timestamppb.New(repo.UpdatedAt),
+		LastChecked:  timestamppb.New(repo.LastChecked),
+		Kind:         string(repo.Kind),
+		UpstreamUrl:  repo.UpstreamURL,
+		Remotes:      modelToProtoRemotes(repo.Remotes),
+		License:      repo.License,
+		Tags:         repo.Tags,
+		Notes:        repo.Notes,
+		Alias:        repo.Alias,
+		AccessCount:  int32(repo.AccessCount),
+		LastAccessed: timestamppb.New(repo.LastAccessed),
+	}
+}
+
+func modelToProtoRemotes(remotes []model.RepoRemote) []*v1.RepoRemote {
+	if len(remotes) == 0 {
+		return nil
 	}
+
+	out := make([]*v1.RepoRemote, len(remotes))
+	for i, r := range remotes {
+		out[i] = &v1.RepoRemote{Name: r.Name, Url: r.URL}
+	}
+
+	return out
 }
 
 // ProtoToModelRepository converts a proto Repository to a model.Repository
@@ -36,15 +61,105 @@ func ProtoToModelRepository(protoRepo *v1.Repository) model.Repository {
 	}
 
 	return model.Repository{
-		ID:          uint(protoRepo.GetId()),
-		UID:         protoRepo.GetUid(),
-		URL:         protoRepo.GetUrl(),
-		Path:        protoRepo.GetPath(),
-		Workspace:   protoRepo.GetWorkspace(),
-		Favorite:    protoRepo.GetFavorite(),
-		ClonedAt:    protoRepo.GetClonedAt().AsTime(),
-		UpdatedAt:   protoRepo.GetUpdatedAt().AsTime(),
-		LastChecked: protoRepo.GetLastChecked().AsTime(),
+		ID:           uint(protoRepo.GetId()),
+		UID:          protoRepo.GetUid(),
+		URL:          protoRepo.GetUrl(),
+		Path:         protoRepo.GetPath(),
+		Workspace:    protoRepo.GetWorkspace(),
+		Favorite:     protoRepo.GetFavorite(),
+		ClonedAt:     protoRepo.GetClonedAt().AsTime(),
+		UpdatedAt:    protoRepo.GetUpdatedAt().AsTime(),
+		LastChecked:  protoRepo.GetLastChecked().AsTime(),
+		Kind:         model.RepoKind(protoRepo.GetKind()),
+		UpstreamURL:  protoRepo.GetUpstreamUrl(),
+		Remotes:      protoToModelRemotes(protoRepo.GetRemotes()),
+		License:      protoRepo.GetLicense(),
+		Tags:         protoRepo.GetTags(),
+		Notes:        protoRepo.GetNotes(),
+		Alias:        protoRepo.GetAlias(),
+		AccessCount:  int(protoRepo.GetAccessCount()),
+		LastAccessed: protoRepo.GetLastAccessed().AsTime(),
+	}
+}
+
+func protoToModelRemotes(remotes []*v1.RepoRemote) []model.RepoRemote {
+	if len(remotes) == 0 {
+		return nil
+	}
+
+	out := make([]model.RepoRemote, len(remotes))
+	for i, r := range remotes {
+		out[i] = model.RepoRemote{Name: r.GetName(), URL: r.GetUrl()}
+	}
+
+	return out
+}
+
+// ModelToProtoRepoDetail converts a model.RepoDetail to a proto RepoDetail
+func ModelToProtoRepoDetail(detail *model.RepoDetail) *v1.RepoDetail {
+	if detail == nil {
+		return nil
+	}
+
+	protoDetail := &v1.RepoDetail{
+		Repository:        ModelToProtoRepository(&detail.Repository),
+		Branch:            detail.Branch,
+		LastCommit:        detail.LastCommit,
+		LastCommitSubject: detail.LastCommitSubject,
+		LastCommitAuthor:  detail.LastCommitAuthor,
+		LatestTag:         detail.LatestTag,
+		HasUpstream:       detail.HasUpstream,
+		Ahead:             int32(detail.Ahead),
+		Behind:            int32(detail.Behind),
+		GitError:          detail.GitError,
+	}
+
+	if !detail.LastCommitAt.IsZero() {
+		protoDetail.LastCommitAt = timestamppb.New(detail.LastCommitAt)
+	}
+
+	return protoDetail
+}
+
+// ProtoToModelRepoDetail converts a proto RepoDetail to a model.RepoDetail
+func ProtoToModelRepoDetail(protoDetail *v1.RepoDetail) *model.RepoDetail {
+	if protoDetail == nil {
+		return nil
+	}
+
+	detail := &model.RepoDetail{
+		Branch:            protoDetail.GetBranch(),
+		LastCommit:        protoDetail.GetLastCommit(),
+		LastCommitSubject: protoDetail.GetLastCommitSubject(),
+		LastCommitAuthor:  protoDetail.GetLastCommitAuthor(),
+		LatestTag:         protoDetail.GetLatestTag(),
+		HasUpstream:       protoDetail.GetHasUpstream(),
+		Ahead:             int(protoDetail.GetAhead()),
+		Behind:            int(protoDetail.GetBehind()),
+		GitError:          protoDetail.GetGitError(),
+	}
+
+	if protoDetail.GetRepository() != nil {
+		detail.Repository = ProtoToModelRepository(protoDetail.GetRepository())
+	}
+
+	if protoDetail.GetLastCommitAt() != nil {
+		detail.LastCommitAt = protoDetail.GetLastCommitAt().AsTime()
+	}
+
+	return detail
+}
+
+// ProtoToModelRepoEvent converts a proto RepoEvent to a model.RepoEvent
+func ProtoToModelRepoEvent(protoEvent *v1.RepoEvent) model.RepoEvent {
+	if protoEvent == nil {
+		return model.RepoEvent{}
+	}
+
+	return model.RepoEvent{
+		Type: protoEvent.GetType(),
+		URL:  protoEvent.GetUrl(),
+		Time: protoEvent.GetTime().AsTime(),
 	}
 }
 
@@ -62,6 +177,37 @@ func ModelToProtoConfig(cfg *model.Config) *v1.Config {
 		Terminal:        cfg.Terminal,
 		MonitorInterval: int32(cfg.MonitorInterval),
 		ServerPort:      int32(cfg.ServerPort),
+		ListColumns:     cfg.ListColumns,
+		ListSort:        cfg.ListSort,
+		UrlRewrites:     modelToProtoURLRewrites(cfg.URLRewrites),
+		Backup: &v1.BackupConfig{
+			Dest:     cfg.Backup.Dest,
+			KeepLast: int32(cfg.Backup.KeepLast),
+			KeepDays: int32(cfg.Backup.KeepDays),
+		},
+		Webhooks:     cfg.Webhooks,
+		NotifyRoutes: modelToProtoNotifyRoutes(cfg.NotifyRoutes),
+		Concurrency: &v1.ConcurrencyConfig{
+			GitOps:   int32(cfg.Concurrency.GitOps),
+			ApiCalls: int32(cfg.Concurrency.APICalls),
+		},
+		ServerTls: &v1.ServerTLSConfig{
+			CertFile:     cfg.ServerTLS.CertFile,
+			KeyFile:      cfg.ServerTLS.KeyFile,
+			ClientCaFile: cfg.ServerTLS.ClientCAFile,
+		},
+		Keymap: &v1.KeyMapConfig{
+			Select:   cfg.KeyMap.Select,
+			Favorite: cfg.KeyMap.Favorite,
+			Delete:   cfg.KeyMap.Delete,
+			Open:     cfg.KeyMap.Open,
+			Filter:   cfg.KeyMap.Filter,
+		},
+		Ignore: &v1.IgnoreConfig{
+			Paths: cfg.Ignore.Paths,
+			Urls:  cfg.Ignore.URLs,
+		},
+		PinnedRepos: cfg.PinnedRepos,
 	}
 }
 
@@ -77,7 +223,82 @@ func ProtoToModelConfig(protoCfg *v1.Config) *model.Config {
 		Terminal:        protoCfg.GetTerminal(),
 		MonitorInterval: int(protoCfg.GetMonitorInterval()),
 		ServerPort:      int(protoCfg.GetServerPort()),
+		ListColumns:     protoCfg.GetListColumns(),
+		ListSort:        protoCfg.GetListSort(),
+		URLRewrites:     protoToModelURLRewrites(protoCfg.GetUrlRewrites()),
+		Backup: model.BackupConfig{
+			Dest:     protoCfg.GetBackup().GetDest(),
+			KeepLast: int(protoCfg.GetBackup().GetKeepLast()),
+			KeepDays: int(protoCfg.GetBackup().GetKeepDays()),
+		},
+		Webhooks:     protoCfg.GetWebhooks(),
+		NotifyRoutes: protoToModelNotifyRoutes(protoCfg.GetNotifyRoutes()),
+		Concurrency: model.ConcurrencyConfig{
+			GitOps:   int(protoCfg.GetConcurrency().GetGitOps()),
+			APICalls: int(protoCfg.GetConcurrency().GetApiCalls()),
+		},
+		ServerTLS: model.ServerTLSConfig{
+			CertFile:     protoCfg.GetServerTls().GetCertFile(),
+			KeyFile:      protoCfg.GetServerTls().GetKeyFile(),
+			ClientCAFile: protoCfg.GetServerTls().GetClientCaFile(),
+		},
+		KeyMap: model.KeyMapConfig{
+			Select:   protoCfg.GetKeymap().GetSelect(),
+			Favorite: protoCfg.GetKeymap().GetFavorite(),
+			Delete:   protoCfg.GetKeymap().GetDelete(),
+			Open:     protoCfg.GetKeymap().GetOpen(),
+			Filter:   protoCfg.GetKeymap().GetFilter(),
+		},
+		Ignore: model.IgnoreConfig{
+			Paths: protoCfg.GetIgnore().GetPaths(),
+			URLs:  protoCfg.GetIgnore().GetUrls(),
+		},
+		PinnedRepos: protoCfg.GetPinnedRepos(),
+	}
+}
+
+func modelToProtoURLRewrites(rewrites []model.URLRewrite) []*v1.URLRewrite {
+	out := make([]*v1.URLRewrite, 0, len(rewrites))
+	for _, r := range rewrites {
+		out = append(out, &v1.URLRewrite{Base: r.Base, InsteadOf: r.InsteadOf})
 	}
+
+	return out
+}
+
+func protoToModelURLRewrites(rewrites []*v1.URLRewrite) []model.URLRewrite {
+	if len(rewrites) == 0 {
+		return nil
+	}
+
+	out := make([]model.URLRewrite, 0, len(rewrites))
+	for _, r := range rewrites {
+		out = append(out, model.URLRewrite{Base: r.GetBase(), InsteadOf: r.GetInsteadOf()})
+	}
+
+	return out
+}
+
+func modelToProtoNotifyRoutes(routes []model.NotifyRoute) []*v1.NotifyRoute {
+	out := make([]*v1.NotifyRoute, 0, len(routes))
+	for _, r := range routes {
+		out = append(out, &v1.NotifyRoute{Event: r.Event, Channels: r.Channels})
+	}
+
+	return out
+}
+
+func protoToModelNotifyRoutes(routes []*v1.NotifyRoute) []model.NotifyRoute {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	out := make([]model.NotifyRoute, 0, len(routes))
+	for _, r := range routes {
+		out = append(out, model.NotifyRoute{Event: r.GetEvent(), Channels: r.GetChannels()})
+	}
+
+	return out
 }
 
 // Profile conversions
@@ -94,19 +315,30 @@ func ModelToProtoProfile(profile *model.Profile) *v1.Profile {
 		protoChannels = append(protoChannels, ModelToProtoNotifyChannel(&ch))
 	}
 
-	return &v1.Profile{
-		Name:           profile.Name,
-		Host:           profile.Host,
-		User:           profile.User,
-		TokenStorage:   string(profile.TokenStorage),
-		Scopes:         profile.Scopes,
-		Active:         profile.Default, // Map Default to Active for proto compatibility
-		EncryptedToken: profile.EncryptedToken,
-		CreatedAt:      timestamppb.New(profile.CreatedAt),
-		LastUsedAt:     timestamppb.New(profile.LastUsedAt),
-		Workspace:      profile.Workspace,
-		NotifyChannels: protoChannels,
+	protoProfile := &v1.Profile{
+		Name:             profile.Name,
+		Host:             profile.Host,
+		User:             profile.User,
+		TokenStorage:     string(profile.TokenStorage),
+		Scopes:           profile.Scopes,
+		Active:           profile.Default, // Map Default to Active for proto compatibility
+		EncryptedToken:   profile.EncryptedToken,
+		CreatedAt:        timestamppb.New(profile.CreatedAt),
+		LastUsedAt:       timestamppb.New(profile.LastUsedAt),
+		Workspace:        profile.Workspace,
+		NotifyChannels:   protoChannels,
+		GitName:          profile.GitName,
+		GitEmail:         profile.GitEmail,
+		GitSigningKey:    profile.GitSigningKey,
+		GitSigningFormat: profile.GitSigningFormat,
+		GitSignCommits:   profile.GitSignCommits,
+	}
+
+	if !profile.TokenExpiresAt.IsZero() {
+		protoProfile.TokenExpiresAt = timestamppb.New(profile.TokenExpiresAt)
 	}
+
+	return protoProfile
 }
 
 // ProtoToModelProfile converts a proto Profile to a model.Profile
@@ -121,18 +353,29 @@ func ProtoToModelProfile(protoProfile *v1.Profile) *model.Profile {
 		channels = append(channels, *ProtoToModelNotifyChannel(ch))
 	}
 
+	var tokenExpiresAt time.Time
+	if ts := protoProfile.GetTokenExpiresAt(); ts != nil {
+		tokenExpiresAt = ts.AsTime()
+	}
+
 	return &model.Profile{
-		Name:           protoProfile.GetName(),
-		Host:           protoProfile.GetHost(),
-		User:           protoProfile.GetUser(),
-		TokenStorage:   model.TokenStorage(protoProfile.GetTokenStorage()),
-		Scopes:         protoProfile.GetScopes(),
-		Default:        protoProfile.GetActive(), // Map Active to Default from proto
-		EncryptedToken: protoProfile.GetEncryptedToken(),
-		CreatedAt:      protoProfile.GetCreatedAt().AsTime(),
-		LastUsedAt:     protoProfile.GetLastUsedAt().AsTime(),
-		Workspace:      protoProfile.GetWorkspace(),
-		NotifyChannels: channels,
+		Name:             protoProfile.GetName(),
+		Host:             protoProfile.GetHost(),
+		User:             protoProfile.GetUser(),
+		TokenStorage:     model.TokenStorage(protoProfile.GetTokenStorage()),
+		Scopes:           protoProfile.GetScopes(),
+		Default:          protoProfile.GetActive(), // Map Active to Default from proto
+		EncryptedToken:   protoProfile.GetEncryptedToken(),
+		CreatedAt:        protoProfile.GetCreatedAt().AsTime(),
+		LastUsedAt:       protoProfile.GetLastUsedAt().AsTime(),
+		Workspace:        protoProfile.GetWorkspace(),
+		NotifyChannels:   channels,
+		TokenExpiresAt:   tokenExpiresAt,
+		GitName:          protoProfile.GetGitName(),
+		GitEmail:         protoProfile.GetGitEmail(),
+		GitSigningKey:    protoProfile.GetGitSigningKey(),
+		GitSigningFormat: protoProfile.GetGitSigningFormat(),
+		GitSignCommits:   protoProfile.GetGitSignCommits(),
 	}
 }
 
@@ -181,12 +424,14 @@ func ModelToProtoWorkspace(workspace *model.Workspace) *v1.Workspace {
 	}
 
 	return &v1.Workspace{
-		Name:        workspace.Name,
-		Description: workspace.Description,
-		Path:        workspace.Path,
-		Active:      workspace.Active,
-		CreatedAt:   timestamppb.New(workspace.CreatedAt),
-		UpdatedAt:   timestamppb.New(workspace.UpdatedAt),
+		Name:            workspace.Name,
+		Description:     workspace.Description,
+		Path:            workspace.Path,
+		Active:          workspace.Active,
+		UrlPatterns:     workspace.URLPatterns,
+		DiskBudgetBytes: workspace.DiskBudget,
+		CreatedAt:       timestamppb.New(workspace.CreatedAt),
+		UpdatedAt:       timestamppb.New(workspace.UpdatedAt),
 	}
 }
 
@@ -201,6 +446,8 @@ func ProtoToModelWorkspace(protoWorkspace *v1.Workspace) *model.Workspace {
 		Description: protoWorkspace.GetDescription(),
 		Path:        protoWorkspace.GetPath(),
 		Active:      protoWorkspace.GetActive(),
+		URLPatterns: protoWorkspace.GetUrlPatterns(),
+		DiskBudget:  protoWorkspace.GetDiskBudgetBytes(),
 		CreatedAt:   protoWorkspace.GetCreatedAt().AsTime(),
 		UpdatedAt:   protoWorkspace.GetUpdatedAt().AsTime(),
 	}
@@ -222,6 +469,28 @@ func ModelToProtoDockerProfile(profile *model.DockerProfile) *v1.DockerProfile {
 		TokenStorage:   string(profile.TokenStorage),
 		CreatedAt:      timestamppb.New(profile.CreatedAt),
 		LastUsedAt:     timestamppb.New(profile.LastUsedAt),
+		Environment:    modelToProtoDockerEnvironment(profile.Environment),
+	}
+}
+
+// modelToProtoDockerEnvironment converts a model.DockerEnvironment to a proto DockerEnvironment
+func modelToProtoDockerEnvironment(env *model.DockerEnvironment) *v1.DockerEnvironment {
+	if env == nil {
+		return nil
+	}
+
+	mounts := make([]*v1.DockerMount, len(env.Mounts))
+	for i, m := range env.Mounts {
+		mounts[i] = &v1.DockerMount{Source: m.Source, Target: m.Target, ReadOnly: m.ReadOnly}
+	}
+
+	return &v1.DockerEnvironment{
+		Image:   env.Image,
+		Env:     env.Env,
+		Mounts:  mounts,
+		Ports:   env.Ports,
+		Workdir: env.Workdir,
+		Command: env.Command,
 	}
 }
 
@@ -239,5 +508,569 @@ func ProtoToModelDockerProfile(protoProfile *v1.DockerProfile) *model.DockerProf
 		TokenStorage:   model.TokenStorage(protoProfile.GetTokenStorage()),
 		CreatedAt:      protoProfile.GetCreatedAt().AsTime(),
 		LastUsedAt:     protoProfile.GetLastUsedAt().AsTime(),
+		Environment:    protoToModelDockerEnvironment(protoProfile.GetEnvironment()),
+	}
+}
+
+// protoToModelDockerEnvironment converts a proto DockerEnvironment to a model.DockerEnvironment
+func protoToModelDockerEnvironment(env *v1.DockerEnvironment) *model.DockerEnvironment {
+	if env == nil {
+		return nil
+	}
+
+	var mounts []model.DockerMount
+	for _, m := range env.GetMounts() {
+		mounts = append(mounts, model.DockerMount{Source: m.GetSource(), Target: m.GetTarget(), ReadOnly: m.GetReadOnly()})
+	}
+
+	return &model.DockerEnvironment{
+		Image:   env.GetImage(),
+		Env:     env.GetEnv(),
+		Mounts:  mounts,
+		Ports:   env.GetPorts(),
+		Workdir: env.GetWorkdir(),
+		Command: env.GetCommand(),
+	}
+}
+
+// Saved Filter conversions
+
+// ModelToProtoSavedFilter converts a model.SavedFilter to a proto SavedFilter
+func ModelToProtoSavedFilter(filter *model.SavedFilter) *v1.SavedFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return &v1.SavedFilter{
+		Name:          filter.Name,
+		Description:   filter.Description,
+		Workspace:     filter.Workspace,
+		FavoritesOnly: filter.FavoritesOnly,
+		Query:         filter.Query,
+		Dirty:         filter.Dirty,
+		Language:      filter.Language,
+		Sort:          filter.Sort,
+		Kind:          string(filter.Kind),
+		CreatedAt:     timestamppb.New(filter.CreatedAt),
+		UpdatedAt:     timestamppb.New(filter.UpdatedAt),
+	}
+}
+
+// ProtoToModelSavedFilter converts a proto SavedFilter to a model.SavedFilter
+func ProtoToModelSavedFilter(protoFilter *v1.SavedFilter) *model.SavedFilter {
+	if protoFilter == nil {
+		return nil
+	}
+
+	return &model.SavedFilter{
+		Name:          protoFilter.GetName(),
+		Description:   protoFilter.GetDescription(),
+		Workspace:     protoFilter.GetWorkspace(),
+		FavoritesOnly: protoFilter.GetFavoritesOnly(),
+		Query:         protoFilter.GetQuery(),
+		Dirty:         protoFilter.GetDirty(),
+		Language:      protoFilter.GetLanguage(),
+		Sort:          protoFilter.GetSort(),
+		Kind:          model.RepoKind(protoFilter.GetKind()),
+		CreatedAt:     protoFilter.GetCreatedAt().AsTime(),
+		UpdatedAt:     protoFilter.GetUpdatedAt().AsTime(),
+	}
+}
+
+// Repository Snapshot conversions
+
+// ModelToProtoRepoSnapshot converts a model.RepoSnapshot to a proto RepoSnapshot
+func ModelToProtoRepoSnapshot(snapshot *model.RepoSnapshot) *v1.RepoSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+
+	return &v1.RepoSnapshot{
+		Id:        snapshot.ID,
+		RepoUrl:   snapshot.RepoURL,
+		Path:      snapshot.Path,
+		Message:   snapshot.Message,
+		Branch:    snapshot.Branch,
+		Head:      snapshot.Head,
+		Stash:     snapshot.Stash,
+		CreatedAt: timestamppb.New(snapshot.CreatedAt),
+	}
+}
+
+// ProtoToModelRepoSnapshot converts a proto RepoSnapshot to a model.RepoSnapshot
+func ProtoToModelRepoSnapshot(protoSnapshot *v1.RepoSnapshot) *model.RepoSnapshot {
+	if protoSnapshot == nil {
+		return nil
 	}
+
+	return &model.RepoSnapshot{
+		ID:        protoSnapshot.GetId(),
+		RepoURL:   protoSnapshot.GetRepoUrl(),
+		Path:      protoSnapshot.GetPath(),
+		Message:   protoSnapshot.GetMessage(),
+		Branch:    protoSnapshot.GetBranch(),
+		Head:      protoSnapshot.GetHead(),
+		Stash:     protoSnapshot.GetStash(),
+		CreatedAt: protoSnapshot.GetCreatedAt().AsTime(),
+	}
+}
+
+// Wizard Draft conversions
+
+// ModelToProtoWizardDraft converts a model.WizardDraft to a proto WizardDraft
+func ModelToProtoWizardDraft(draft *model.WizardDraft) *v1.WizardDraft {
+	if draft == nil {
+		return nil
+	}
+
+	return &v1.WizardDraft{
+		Name:      draft.Name,
+		Data:      draft.Data,
+		UpdatedAt: timestamppb.New(draft.UpdatedAt),
+	}
+}
+
+// ProtoToModelWizardDraft converts a proto WizardDraft to a model.WizardDraft
+func ProtoToModelWizardDraft(protoDraft *v1.WizardDraft) *model.WizardDraft {
+	if protoDraft == nil {
+		return nil
+	}
+
+	return &model.WizardDraft{
+		Name:      protoDraft.GetName(),
+		Data:      protoDraft.GetData(),
+		UpdatedAt: protoDraft.GetUpdatedAt().AsTime(),
+	}
+}
+
+// API Token conversions
+
+// ModelToProtoAPIToken converts a model.APIToken to a proto APIToken
+func ModelToProtoAPIToken(token *model.APIToken) *v1.APIToken {
+	if token == nil {
+		return nil
+	}
+
+	protoToken := &v1.APIToken{
+		Name:      token.Name,
+		Hash:      token.Hash,
+		CreatedAt: timestamppb.New(token.CreatedAt),
+	}
+
+	if !token.ExpiresAt.IsZero() {
+		protoToken.ExpiresAt = timestamppb.New(token.ExpiresAt)
+	}
+
+	if !token.LastUsedAt.IsZero() {
+		protoToken.LastUsedAt = timestamppb.New(token.LastUsedAt)
+	}
+
+	return protoToken
+}
+
+// ProtoToModelAPIToken converts a proto APIToken to a model.APIToken
+func ProtoToModelAPIToken(protoToken *v1.APIToken) *model.APIToken {
+	if protoToken == nil {
+		return nil
+	}
+
+	token := &model.APIToken{
+		Name:      protoToken.GetName(),
+		Hash:      protoToken.GetHash(),
+		CreatedAt: protoToken.GetCreatedAt().AsTime(),
+	}
+
+	if ts := protoToken.GetExpiresAt(); ts != nil {
+		token.ExpiresAt = ts.AsTime()
+	}
+
+	if ts := protoToken.GetLastUsedAt(); ts != nil {
+		token.LastUsedAt = ts.AsTime()
+	}
+
+	return token
+}
+
+// Vault Secret conversions
+
+// ModelToProtoVaultSecret converts a model.VaultSecret to a proto VaultSecret
+func ModelToProtoVaultSecret(secret *model.VaultSecret) *v1.VaultSecret {
+	if secret == nil {
+		return nil
+	}
+
+	return &v1.VaultSecret{
+		Key:       secret.Key,
+		Value:     secret.Value,
+		CreatedAt: timestamppb.New(secret.CreatedAt),
+		UpdatedAt: timestamppb.New(secret.UpdatedAt),
+	}
+}
+
+// ProtoToModelVaultSecret converts a proto VaultSecret to a model.VaultSecret
+func ProtoToModelVaultSecret(protoSecret *v1.VaultSecret) *model.VaultSecret {
+	if protoSecret == nil {
+		return nil
+	}
+
+	secret := &model.VaultSecret{
+		Key:   protoSecret.GetKey(),
+		Value: protoSecret.GetValue(),
+	}
+
+	if ts := protoSecret.GetCreatedAt(); ts != nil {
+		secret.CreatedAt = ts.AsTime()
+	}
+
+	if ts := protoSecret.GetUpdatedAt(); ts != nil {
+		secret.UpdatedAt = ts.AsTime()
+	}
+
+	return secret
+}
+
+// GitHub Repository ID conversions
+
+// ModelToProtoGitHubRepoID converts a model.GitHubRepoID to a proto GitHubRepoID
+func ModelToProtoGitHubRepoID(entry *model.GitHubRepoID) *v1.GitHubRepoID {
+	if entry == nil {
+		return nil
+	}
+
+	return &v1.GitHubRepoID{
+		FullName: entry.FullName,
+		RepoId:   entry.RepoID,
+		CachedAt: timestamppb.New(entry.CachedAt),
+	}
+}
+
+// ProtoToModelGitHubRepoID converts a proto GitHubRepoID to a model.GitHubRepoID
+func ProtoToModelGitHubRepoID(protoEntry *v1.GitHubRepoID) *model.GitHubRepoID {
+	if protoEntry == nil {
+		return nil
+	}
+
+	entry := &model.GitHubRepoID{
+		FullName: protoEntry.GetFullName(),
+		RepoID:   protoEntry.GetRepoId(),
+	}
+
+	if ts := protoEntry.GetCachedAt(); ts != nil {
+		entry.CachedAt = ts.AsTime()
+	}
+
+	return entry
+}
+
+// Dependency Inventory conversions
+
+// ModelToProtoDependencyInventory converts a model.DependencyInventory to a
+// proto DependencyInventory
+func ModelToProtoDependencyInventory(inventory *model.DependencyInventory) *v1.DependencyInventory {
+	if inventory == nil {
+		return nil
+	}
+
+	dependencies := make([]*v1.Dependency, len(inventory.Dependencies))
+	for i, d := range inventory.Dependencies {
+		dependencies[i] = &v1.Dependency{
+			Ecosystem: d.Ecosystem,
+			Name:      d.Name,
+			Version:   d.Version,
+			Manifest:  d.Manifest,
+		}
+	}
+
+	return &v1.DependencyInventory{
+		RepoUrl:      inventory.RepoURL,
+		Dependencies: dependencies,
+		ScannedAt:    timestamppb.New(inventory.ScannedAt),
+	}
+}
+
+// ProtoToModelDependencyInventory converts a proto DependencyInventory to a
+// model.DependencyInventory
+func ProtoToModelDependencyInventory(protoInventory *v1.DependencyInventory) *model.DependencyInventory {
+	if protoInventory == nil {
+		return nil
+	}
+
+	inventory := &model.DependencyInventory{
+		RepoURL:      protoInventory.GetRepoUrl(),
+		Dependencies: make([]model.Dependency, len(protoInventory.GetDependencies())),
+	}
+
+	for i, d := range protoInventory.GetDependencies() {
+		inventory.Dependencies[i] = model.Dependency{
+			Ecosystem: d.GetEcosystem(),
+			Name:      d.GetName(),
+			Version:   d.GetVersion(),
+			Manifest:  d.GetManifest(),
+		}
+	}
+
+	if ts := protoInventory.GetScannedAt(); ts != nil {
+		inventory.ScannedAt = ts.AsTime()
+	}
+
+	return inventory
+}
+
+// Gmail Watch conversions
+
+// ModelToProtoGmailWatch converts a model.GmailWatch to a proto GmailWatch
+func ModelToProtoGmailWatch(watch *model.GmailWatch) *v1.GmailWatch {
+	if watch == nil {
+		return nil
+	}
+
+	protoWatch := &v1.GmailWatch{
+		Name:            watch.Name,
+		Profile:         watch.Profile,
+		Query:           watch.Query,
+		IntervalSeconds: int64(watch.Interval / time.Second),
+		Notify:          watch.Notify,
+		Exec:            watch.Exec,
+		HistoryId:       watch.HistoryID,
+		LastError:       watch.LastError,
+		CreatedAt:       timestamppb.New(watch.CreatedAt),
+		UpdatedAt:       timestamppb.New(watch.UpdatedAt),
+	}
+
+	if !watch.LastCheckedAt.IsZero() {
+		protoWatch.LastCheckedAt = timestamppb.New(watch.LastCheckedAt)
+	}
+
+	if !watch.LastMatchAt.IsZero() {
+		protoWatch.LastMatchAt = timestamppb.New(watch.LastMatchAt)
+	}
+
+	return protoWatch
+}
+
+// ProtoToModelGmailWatch converts a proto GmailWatch to a model.GmailWatch
+func ProtoToModelGmailWatch(protoWatch *v1.GmailWatch) *model.GmailWatch {
+	if protoWatch == nil {
+		return nil
+	}
+
+	watch := &model.GmailWatch{
+		Name:      protoWatch.GetName(),
+		Profile:   protoWatch.GetProfile(),
+		Query:     protoWatch.GetQuery(),
+		Interval:  time.Duration(protoWatch.GetIntervalSeconds()) * time.Second,
+		Notify:    protoWatch.GetNotify(),
+		Exec:      protoWatch.GetExec(),
+		HistoryID: protoWatch.GetHistoryId(),
+		LastError: protoWatch.GetLastError(),
+	}
+
+	if ts := protoWatch.GetLastCheckedAt(); ts != nil {
+		watch.LastCheckedAt = ts.AsTime()
+	}
+
+	if ts := protoWatch.GetLastMatchAt(); ts != nil {
+		watch.LastMatchAt = ts.AsTime()
+	}
+
+	if ts := protoWatch.GetCreatedAt(); ts != nil {
+		watch.CreatedAt = ts.AsTime()
+	}
+
+	if ts := protoWatch.GetUpdatedAt(); ts != nil {
+		watch.UpdatedAt = ts.AsTime()
+	}
+
+	return watch
+}
+
+// Share Link conversions
+
+// ModelToProtoShareLink converts a model.ShareLink to a proto ShareLink
+func ModelToProtoShareLink(link *model.ShareLink) *v1.ShareLink {
+	if link == nil {
+		return nil
+	}
+
+	return &v1.ShareLink{
+		Id:        link.ID,
+		RepoUrl:   link.RepoURL,
+		Note:      link.Note,
+		CreatedAt: timestamppb.New(link.CreatedAt),
+		ExpiresAt: timestamppb.New(link.ExpiresAt),
+	}
+}
+
+// ProtoToModelShareLink converts a proto ShareLink to a model.ShareLink
+func ProtoToModelShareLink(protoLink *v1.ShareLink) *model.ShareLink {
+	if protoLink == nil {
+		return nil
+	}
+
+	link := &model.ShareLink{
+		ID:      protoLink.GetId(),
+		RepoURL: protoLink.GetRepoUrl(),
+		Note:    protoLink.GetNote(),
+	}
+
+	if ts := protoLink.GetCreatedAt(); ts != nil {
+		link.CreatedAt = ts.AsTime()
+	}
+
+	if ts := protoLink.GetExpiresAt(); ts != nil {
+		link.ExpiresAt = ts.AsTime()
+	}
+
+	return link
+}
+
+// Job conversions
+
+// ModelToProtoJob converts a model.Job to a proto Job
+func ModelToProtoJob(job *model.Job) *v1.Job {
+	if job == nil {
+		return nil
+	}
+
+	protoJob := &v1.Job{
+		Id:              job.ID,
+		Kind:            job.Kind,
+		Description:     job.Description,
+		State:           string(job.State),
+		Total:           int32(job.Total),
+		Done:            int32(job.Done),
+		Failed:          int32(job.Failed),
+		Log:             job.Log,
+		Error:           job.Error,
+		CancelRequested: job.CancelRequested,
+		StartedAt:       timestamppb.New(job.StartedAt),
+		UpdatedAt:       timestamppb.New(job.UpdatedAt),
+	}
+
+	if job.FinishedAt != nil {
+		protoJob.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+
+	return protoJob
+}
+
+// ProtoToModelJob converts a proto Job to a model.Job
+func ProtoToModelJob(protoJob *v1.Job) *model.Job {
+	if protoJob == nil {
+		return nil
+	}
+
+	job := &model.Job{
+		ID:              protoJob.GetId(),
+		Kind:            protoJob.GetKind(),
+		Description:     protoJob.GetDescription(),
+		State:           model.JobState(protoJob.GetState()),
+		Total:           int(protoJob.GetTotal()),
+		Done:            int(protoJob.GetDone()),
+		Failed:          int(protoJob.GetFailed()),
+		Log:             protoJob.GetLog(),
+		Error:           protoJob.GetError(),
+		CancelRequested: protoJob.GetCancelRequested(),
+	}
+
+	if ts := protoJob.GetStartedAt(); ts != nil {
+		job.StartedAt = ts.AsTime()
+	}
+
+	if ts := protoJob.GetUpdatedAt(); ts != nil {
+		job.UpdatedAt = ts.AsTime()
+	}
+
+	if ts := protoJob.GetFinishedAt(); ts != nil {
+		finishedAt := ts.AsTime()
+		job.FinishedAt = &finishedAt
+	}
+
+	return job
+}
+
+// Standalone sync conversions
+
+// SyncRequestFor builds the sync request of a connection. It carries the
+// filter of the connection, so the source sends only the data it selects,
+// and the revisions it acknowledged, so only the changes since are sent.
+func SyncRequestFor(conn *standalone.StandaloneConnection, sessionToken string) *v1.SyncRequest {
+	return &v1.SyncRequest{
+		SessionToken:   sessionToken,
+		ItemTypes:      conn.SyncFilter.ItemTypes(),
+		Workspaces:     conn.SyncFilter.Workspaces,
+		SinceRevisions: conn.AckedRevisions,
+	}
+}
+
+// SyncRequestToFilter returns the filter the source serves a sync request
+// with
+func SyncRequestToFilter(req *v1.SyncRequest) standalone.SyncFilter {
+	return standalone.SyncFilter{
+		DataTypes:  req.GetItemTypes(),
+		Workspaces: req.GetWorkspaces(),
+	}
+}
+
+// SyncPackageItemToProto converts a standalone.SyncPackageItem to the proto
+// EncryptedData the source streams
+func SyncPackageItemToProto(item standalone.SyncPackageItem) *v1.EncryptedData {
+	return &v1.EncryptedData{
+		Id:            item.ID,
+		Type:          item.Type,
+		EncryptedData: item.EncryptedData,
+		UpdatedAt:     item.UpdatedAt.Unix(),
+		Workspace:     item.Workspace,
+		Revision:      item.Revision,
+		Deleted:       item.Deleted,
+	}
+}
+
+// ProtoToSyncPackageItem converts a received proto EncryptedData to a
+// standalone.SyncPackageItem
+func ProtoToSyncPackageItem(data *v1.EncryptedData) standalone.SyncPackageItem {
+	return standalone.SyncPackageItem{
+		Type:          data.GetType(),
+		ID:            data.GetId(),
+		Name:          data.GetId(),
+		EncryptedData: data.GetEncryptedData(),
+		UpdatedAt:     time.Unix(data.GetUpdatedAt(), 0),
+		Workspace:     data.GetWorkspace(),
+		Revision:      data.GetRevision(),
+		Deleted:       data.GetDeleted(),
+	}
+}
+
+// RegisterRequestFor builds the registration request a destination sends
+// with the key it generated for reg
+func RegisterRequestFor(reg *standalone.ClientRegistration, apiKey, displayKey string) *v1.RegisterRequest {
+	return &v1.RegisterRequest{
+		ApiKey:       apiKey,
+		ClientId:     reg.ClientID,
+		ClientName:   reg.ClientName,
+		DisplayKey:   displayKey,
+		Hostname:     reg.MachineInfo.Hostname,
+		Os:           reg.MachineInfo.OS,
+		Arch:         reg.MachineInfo.Arch,
+		ClonrVersion: reg.MachineInfo.ClonrVersion,
+	}
+}
+
+// RegisterRequestToRegistration returns the client registration and the
+// display key of a received registration request
+func RegisterRequestToRegistration(req *v1.RegisterRequest) (*standalone.ClientRegistration, string) {
+	reg := &standalone.ClientRegistration{
+		ClientID:   req.GetClientId(),
+		ClientName: req.GetClientName(),
+		MachineInfo: standalone.MachineInfo{
+			Hostname:     req.GetHostname(),
+			OS:           req.GetOs(),
+			Arch:         req.GetArch(),
+			ClonrVersion: req.GetClonrVersion(),
+		},
+		State:       standalone.HandshakeStateInitiated,
+		InitiatedAt: time.Now(),
+	}
+
+	return reg, req.GetDisplayKey()
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// APIToken authorizes remote clients of the gRPC server. Only a hash of the
+// token is stored; the token itself is shown once when it is created.
+type APIToken struct {
+	// Name identifies the token (e.g. the machine or user it was issued to)
+	Name string `json:"name"`
+
+	// Hash is the hex-encoded SHA-256 hash of the token
+	Hash string `json:"hash,omitempty"`
+
+	// CreatedAt is when the token was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt is when the token stops being accepted (zero if it never expires)
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+
+	// LastUsedAt is when the token last authenticated a request (zero if never)
+	LastUsedAt time.Time `json:"last_used_at,omitzero"`
+}
+
+// Expired reports whether the token is past its expiration time
+func (t *APIToken) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && now.After(t.ExpiresAt)
+}
@@ -42,6 +42,133 @@ type Config struct {
 	// KeyRotationDays is the number of days before encryption keys are auto-rotated.
 	// Minimum is 7 days, maximum is 365 days. Default is 30 days.
 	KeyRotationDays int `json:"key_rotation_days"`
+
+	// ListColumns are the columns shown by 'clonr list' (empty uses the defaults)
+	ListColumns []string `json:"list_columns,omitempty"`
+
+	// ListSort is the default sort key for repository lists
+	ListSort string `json:"list_sort,omitempty"`
+
+	// URLRewrites expand URL prefixes in clone arguments, like git's
+	// url.<base>.insteadOf
+	URLRewrites []URLRewrite `json:"url_rewrites,omitempty"`
+
+	// Backup is the default destination and retention policy of 'clonr backup'
+	Backup BackupConfig `json:"backup,omitzero"`
+
+	// Webhooks are the URLs of tracked repositories pulled when a push
+	// webhook for them arrives at the web server
+	Webhooks []string `json:"webhooks,omitempty"`
+
+	// NotifyRoutes choose the notification channels of each event type;
+	// events without a route go to every enabled channel
+	NotifyRoutes []NotifyRoute `json:"notify_routes,omitempty"`
+
+	// Concurrency limits the git operations and API calls bulk commands
+	// run at once
+	Concurrency ConcurrencyConfig `json:"concurrency,omitzero"`
+
+	// ServerTLS is the certificate 'clonr server start' serves gRPC with
+	// when no --tls-cert flag is given
+	ServerTLS ServerTLSConfig `json:"server_tls,omitzero"`
+
+	// KeyMap remaps the keys of the interactive views
+	KeyMap KeyMapConfig `json:"keymap,omitzero"`
+
+	// Ignore lists the checkouts map, the server monitors and org mirrors
+	// leave alone
+	Ignore IgnoreConfig `json:"ignore,omitzero"`
+
+	// PinnedRepos are the URLs of the repositories shown first in the main
+	// menu, in pin order; at most MaxPinnedRepos
+	PinnedRepos []string `json:"pinned_repos,omitempty"`
+}
+
+// NotifyRoute sends the events of one type to the listed notification
+// channels only
+type NotifyRoute struct {
+	// Event is the event type (clone, sync-fail, ...) or "*" for every type
+	Event string `json:"event"`
+
+	// Channels are channel IDs or channel types (slack, gmail); empty
+	// mutes the event
+	Channels []string `json:"channels,omitempty"`
+}
+
+// URLRewrite replaces the InsteadOf prefix of a repository URL with Base
+type URLRewrite struct {
+	Base      string `json:"base"`
+	InsteadOf string `json:"instead_of"`
+}
+
+// BackupConfig is the destination and retention policy for repository backups.
+// A backup is kept while it is one of the newest KeepLast backups of its
+// repository or younger than KeepDays; zero disables that rule, and with
+// both zero every backup is kept.
+type BackupConfig struct {
+	Dest     string `json:"dest,omitempty"` // Local directory or s3://bucket/prefix
+	KeepLast int    `json:"keep_last,omitempty"`
+	KeepDays int    `json:"keep_days,omitempty"`
+}
+
+// IsZero reports whether no backup settings are configured
+func (b BackupConfig) IsZero() bool {
+	return b == BackupConfig{}
+}
+
+// ConcurrencyConfig limits how many jobs of each kind run at once in a clonr
+// process; zero uses the default limit.
+type ConcurrencyConfig struct {
+	GitOps   int `json:"git_ops,omitempty"`   // git clones, pulls and fetches
+	APICalls int `json:"api_calls,omitempty"` // Requests to hosting provider APIs
+}
+
+// IsZero reports whether no concurrency limits are configured
+func (c ConcurrencyConfig) IsZero() bool {
+	return c == ConcurrencyConfig{}
+}
+
+// ServerTLSConfig is the certificate, key and optional client CA bundle
+// (mTLS) of the gRPC server
+type ServerTLSConfig struct {
+	CertFile     string `json:"cert_file,omitempty"`
+	KeyFile      string `json:"key_file,omitempty"`
+	ClientCAFile string `json:"client_ca_file,omitempty"`
+}
+
+// IsZero reports whether no server certificate is configured
+func (t ServerTLSConfig) IsZero() bool {
+	return t == ServerTLSConfig{}
+}
+
+// KeyMapConfig lists the keys of each remappable action of the interactive
+// views, in bubbletea notation (enter, ctrl+f, x); an empty list keeps the
+// default keys of the action.
+type KeyMapConfig struct {
+	Select   []string `json:"select,omitempty"`
+	Favorite []string `json:"favorite,omitempty"`
+	Delete   []string `json:"delete,omitempty"`
+	Open     []string `json:"open,omitempty"`
+	Filter   []string `json:"filter,omitempty"`
+}
+
+// IsZero reports whether no key is remapped
+func (k KeyMapConfig) IsZero() bool {
+	return len(k.Select)+len(k.Favorite)+len(k.Delete)+len(k.Open)+len(k.Filter) == 0
+}
+
+// IgnoreConfig lists glob patterns of the checkouts clonr skips: Paths
+// match clone paths (~/src/vendor/*, or a directory name like third_party),
+// URLs match repository URLs without their scheme (github.com/vendor/*).
+// A pattern matching a directory or URL prefix covers everything below it.
+type IgnoreConfig struct {
+	Paths []string `json:"paths,omitempty"`
+	URLs  []string `json:"urls,omitempty"`
+}
+
+// IsZero reports whether nothing is ignored
+func (c IgnoreConfig) IsZero() bool {
+	return len(c.Paths)+len(c.URLs) == 0
 }
 
 const (
@@ -51,6 +178,9 @@ const (
 	MaxKeyRotationDays = 365
 	// DefaultKeyRotationDays is the default key rotation interval
 	DefaultKeyRotationDays = 30
+	// MaxPinnedRepos is the number of repositories that can be pinned to
+	// the main menu, each opened with a digit key
+	MaxPinnedRepos = 9
 )
 
 // DefaultConfig returns a Config with sensible defaults
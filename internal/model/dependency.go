@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Dependency is one dependency declared in a manifest of a repository
+type Dependency struct {
+	// Ecosystem is the package ecosystem, e.g. go, npm, pypi, cargo
+	Ecosystem string `json:"ecosystem"`
+
+	// Name is the package or module name
+	Name string `json:"name"`
+
+	// Version is the declared version or constraint (empty when unpinned)
+	Version string `json:"version,omitempty"`
+
+	// Manifest is the path of the manifest, relative to the repository
+	Manifest string `json:"manifest"`
+}
+
+// DependencyInventory is the dependencies of a repository at one point in
+// time, kept to diff against later scans
+type DependencyInventory struct {
+	// RepoURL is the URL of the repository
+	RepoURL string `json:"repo_url"`
+
+	// Dependencies are the declared dependencies, sorted by manifest,
+	// ecosystem and name
+	Dependencies []Dependency `json:"dependencies"`
+
+	// ScannedAt is when the manifests were read
+	ScannedAt time.Time `json:"scanned_at"`
+}
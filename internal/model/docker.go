@@ -24,6 +24,39 @@ type DockerProfile struct {
 
 	// LastUsedAt is when the profile was last used for login
 	LastUsedAt time.Time `json:"last_used_at"`
+
+	// Environment is the development container started by 'clonr docker up'
+	Environment *DockerEnvironment `json:"environment,omitempty"`
+}
+
+// DockerEnvironment is a development container run from a docker profile
+type DockerEnvironment struct {
+	// Image is the container image (e.g., "golang:1.25")
+	Image string `json:"image"`
+
+	// Env are KEY=VALUE environment variables
+	Env []string `json:"env,omitempty"`
+
+	// Mounts bind host directories or tracked repositories into the container
+	Mounts []DockerMount `json:"mounts,omitempty"`
+
+	// Ports are published ports in docker's [host_ip:]host:container form
+	Ports []string `json:"ports,omitempty"`
+
+	// Workdir is the working directory in the container
+	Workdir string `json:"workdir,omitempty"`
+
+	// Command overrides the command of the image
+	Command []string `json:"command,omitempty"`
+}
+
+// DockerMount binds Source into the container at Target. Source is a host
+// path or a tracked repository (name, URL or path) resolved when the
+// container starts.
+type DockerMount struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	ReadOnly bool   `json:"read_only,omitempty"`
 }
 
 // Common container registries
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SavedFilter is a named repository filter ("smart list") that can be
+// recalled with 'clonr list --view <name>' or from the list TUI.
+type SavedFilter struct {
+	// Name is the unique identifier for this filter
+	Name string `json:"name"`
+
+	// Description is an optional human readable summary
+	Description string `json:"description,omitempty"`
+
+	// Workspace limits results to one workspace (empty = all)
+	Workspace string `json:"workspace,omitempty"`
+
+	// FavoritesOnly limits results to favorite repositories
+	FavoritesOnly bool `json:"favorites_only,omitempty"`
+
+	// Query is a case-insensitive substring matched against URL and path
+	Query string `json:"query,omitempty"`
+
+	// Dirty limits results to repositories with uncommitted changes
+	Dirty bool `json:"dirty,omitempty"`
+
+	// Language limits results to repositories of a project language (e.g. "go")
+	Language string `json:"language,omitempty"`
+
+	// Sort is the list order: name, cloned or updated (empty = name)
+	Sort string `json:"sort,omitempty"`
+
+	// Kind limits results to one repository kind (empty = all)
+	Kind RepoKind `json:"kind,omitempty"`
+
+	// CreatedAt is when the filter was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the filter was last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RepoFilter returns the part of the filter evaluated by the server
+func (f SavedFilter) RepoFilter() RepoFilter {
+	return RepoFilter{
+		Workspace:     f.Workspace,
+		FavoritesOnly: f.FavoritesOnly,
+		Query:         f.Query,
+		Kind:          f.Kind,
+	}
+}
+
+// NeedsLocalCheck reports whether the filter inspects repositories on disk
+func (f SavedFilter) NeedsLocalCheck() bool {
+	return f.Dirty || f.Language != ""
+}
+
+// Summary describes the filter criteria in one line
+func (f SavedFilter) Summary() string {
+	var parts []string
+
+	if f.Dirty {
+		parts = append(parts, "dirty")
+	}
+
+	if f.Language != "" {
+		parts = append(parts, f.Language)
+	}
+
+	if f.FavoritesOnly {
+		parts = append(parts, "favorite")
+	}
+
+	if f.Kind != "" {
+		parts = append(parts, string(f.Kind))
+	}
+
+	parts = append(parts, "repos")
+
+	if f.Workspace != "" {
+		parts = append(parts, fmt.Sprintf("in %s", f.Workspace))
+	}
+
+	if f.Query != "" {
+		parts = append(parts, fmt.Sprintf("matching %q", f.Query))
+	}
+
+	if f.Sort != "" {
+		parts = append(parts, fmt.Sprintf("by %s", f.Sort))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// DefaultGitHubRepoIDTTL is how long a cached GitHub repository ID is used
+// before it is looked up again. IDs never change, but a renamed or
+// transferred repository leaves a stale owner/repo mapping behind.
+const DefaultGitHubRepoIDTTL = 7 * 24 * time.Hour
+
+// GitHubRepoID is a cached mapping from a GitHub owner/repo to the numeric
+// repository ID, as needed by ZenHub.
+type GitHubRepoID struct {
+	// FullName is the lowercased "owner/repo"
+	FullName string `json:"full_name"`
+
+	// RepoID is the GitHub repository ID
+	RepoID int64 `json:"repo_id"`
+
+	// CachedAt is when the ID was fetched from GitHub
+	CachedAt time.Time `json:"cached_at"`
+}
+
+// Expired reports whether the entry is older than ttl at now.
+func (r *GitHubRepoID) Expired(now time.Time, ttl time.Duration) bool {
+	return now.Sub(r.CachedAt) >= ttl
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// DefaultGmailWatchInterval is how often a Gmail watch polls without an
+// interval of its own.
+const DefaultGmailWatchInterval = 5 * time.Minute
+
+// MinGmailWatchInterval is the shortest poll interval of a Gmail watch,
+// keeping the server well inside the Gmail API quota.
+const MinGmailWatchInterval = time.Minute
+
+// GmailWatch is a Gmail search the server polls for new messages, such as
+// GitHub notification emails, to notify or run a hook when they arrive.
+type GmailWatch struct {
+	// Name identifies the watch
+	Name string `json:"name"`
+
+	// Profile is the profile whose Gmail account is polled
+	Profile string `json:"profile"`
+
+	// Query is the Gmail search new messages must match
+	Query string `json:"query"`
+
+	// Interval is the time between polls
+	Interval time.Duration `json:"interval"`
+
+	// Notify sends a notification for every matching message
+	Notify bool `json:"notify"`
+
+	// Exec is a shell command run for every matching message; empty for none
+	Exec string `json:"exec,omitempty"`
+
+	// HistoryID is the mailbox history ID the next poll starts after
+	HistoryID string `json:"history_id,omitempty"`
+
+	// LastCheckedAt is when the watch last polled
+	LastCheckedAt time.Time `json:"last_checked_at,omitzero"`
+
+	// LastMatchAt is when a matching message last arrived
+	LastMatchAt time.Time `json:"last_match_at,omitzero"`
+
+	// LastError is the error of the last poll; empty when it succeeded
+	LastError string `json:"last_error,omitempty"`
+
+	// CreatedAt is when the watch was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the watch was last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Due reports whether the watch should poll at now.
+func (w *GmailWatch) Due(now time.Time) bool {
+	interval := max(w.Interval, MinGmailWatchInterval)
+
+	return w.LastCheckedAt.IsZero() || !now.Before(w.LastCheckedAt.Add(interval))
+}
@@ -0,0 +1,60 @@
+package model
+
+import "time"
+
+// JobState is the lifecycle state of a tracked job
+type JobState string
+
+const (
+	JobRunning   JobState = "running"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+	JobCancelled JobState = "cancelled"
+)
+
+// MaxJobLogLines is how many of the newest log lines a job keeps
+const MaxJobLogLines = 500
+
+// Job is a long-running operation, such as a bulk update, an organization
+// mirror or a backup, tracked so its progress can be followed and it can be
+// cancelled from another terminal.
+type Job struct {
+	// ID is the random job identifier
+	ID string `json:"id"`
+
+	// Kind is the operation, e.g. update, mirror or backup
+	Kind string `json:"kind"`
+
+	// Description says what the job works on, e.g. the organization mirrored
+	Description string `json:"description,omitempty"`
+
+	// State is running until the job finishes
+	State JobState `json:"state"`
+
+	// Total is the number of items to process, Done how many were processed
+	// so far and Failed how many of those failed
+	Total  int `json:"total"`
+	Done   int `json:"done"`
+	Failed int `json:"failed"`
+
+	// Log holds the newest MaxJobLogLines lines the job logged
+	Log []string `json:"log,omitempty"`
+
+	// Error is why a failed job stopped
+	Error string `json:"error,omitempty"`
+
+	// CancelRequested is set by 'clonr jobs cancel'; the job stops once it
+	// notices
+	CancelRequested bool `json:"cancel_requested,omitempty"`
+
+	// StartedAt is when the job started, UpdatedAt when it last reported
+	// progress and FinishedAt when it finished
+	StartedAt  time.Time  `json:"started_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// Finished reports whether the job is no longer running
+func (j *Job) Finished() bool {
+	return j.State != JobRunning
+}
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 type Repository struct {
 	// ID is the primary key
@@ -29,4 +33,230 @@ type Repository struct {
 
 	// LastChecked is the last time the repository was checked for updates
 	LastChecked time.Time `json:"last_checked"`
+
+	// Kind classifies the repository (empty until classified)
+	Kind RepoKind `json:"kind,omitempty"`
+
+	// UpstreamURL is the repository a fork was created from
+	UpstreamURL string `json:"upstream_url,omitempty"`
+
+	// License is the SPDX identifier of the repository license, LicenseNone
+	// or LicenseOther (empty until scanned)
+	License string `json:"license,omitempty"`
+
+	// Tags are free-form labels given to the repository, sorted
+	Tags []string `json:"tags,omitempty"`
+
+	// Notes is free text kept with the repository
+	Notes string `json:"notes,omitempty"`
+
+	// Alias is a unique short name commands accept instead of the URL
+	Alias string `json:"alias,omitempty"`
+
+	// AccessCount is how many times the repository was opened or updated
+	AccessCount int `json:"access_count,omitempty"`
+
+	// LastAccessed is the last time the repository was opened or updated
+	LastAccessed time.Time `json:"last_accessed"`
+
+	// Remotes are the git remotes of the clone other than the primary URL,
+	// such as the upstream of a fork or the push mirrors
+	Remotes []RepoRemote `json:"remotes,omitempty"`
+}
+
+// RepoDetail is a repository with the state of its clone, read by the
+// server that hosts it
+type RepoDetail struct {
+	Repository Repository `json:"repository"`
+
+	// Branch is the checked out branch, HEAD when detached
+	Branch string `json:"branch,omitempty"`
+
+	// LastCommit is the short hash of HEAD, empty without commits
+	LastCommit        string    `json:"last_commit,omitempty"`
+	LastCommitSubject string    `json:"last_commit_subject,omitempty"`
+	LastCommitAuthor  string    `json:"last_commit_author,omitempty"`
+	LastCommitAt      time.Time `json:"last_commit_at,omitzero"`
+
+	// LatestTag is the newest git tag reachable from HEAD
+	LatestTag string `json:"latest_tag,omitempty"`
+
+	// Ahead and Behind count the commits HEAD and its upstream branch have
+	// that the other lacks; both are zero without an upstream
+	HasUpstream bool `json:"has_upstream"`
+	Ahead       int  `json:"ahead"`
+	Behind      int  `json:"behind"`
+
+	// GitError tells why the clone could not be read, e.g. it is missing;
+	// the git fields are then empty
+	GitError string `json:"git_error,omitempty"`
+}
+
+// RepoRemote is a git remote of a repository
+type RepoRemote struct {
+	// Name is the git remote name, e.g. upstream
+	Name string `json:"name"`
+
+	// URL is the canonical URL of the remote repository, or the raw remote
+	// URL when it is not a hosted repository
+	URL string `json:"url"`
+}
+
+// RemoteURL returns the URL of the named remote, or "" when the repository
+// has no such remote
+func (r *Repository) RemoteURL(name string) string {
+	for _, remote := range r.Remotes {
+		if remote.Name == name {
+			return remote.URL
+		}
+	}
+
+	return ""
+}
+
+// MaxRepoAliasLength bounds the length of a repository alias
+const MaxRepoAliasLength = 64
+
+// ParseRepoAlias validates a repository alias, returning it in lower case.
+// An alias is a letter or digit followed by letters, digits, '.', '_' or
+// '-', so it cannot be mistaken for a URL, an owner/name pair or a path.
+func ParseRepoAlias(s string) (string, error) {
+	alias := strings.ToLower(strings.TrimSpace(s))
+
+	if alias == "" {
+		return "", fmt.Errorf("alias is required")
+	}
+
+	if len(alias) > MaxRepoAliasLength {
+		return "", fmt.Errorf("alias %q is longer than %d characters", s, MaxRepoAliasLength)
+	}
+
+	for i, c := range alias {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		case i > 0 && (c == '.' || c == '_' || c == '-'):
+		default:
+			return "", fmt.Errorf("invalid alias %q: use letters, digits, '.', '_' and '-', starting with a letter or digit", s)
+		}
+	}
+
+	return alias, nil
+}
+
+const (
+	// LicenseNone marks a repository without a license
+	LicenseNone = "none"
+
+	// LicenseOther marks a license that was not recognized
+	LicenseOther = "other"
+)
+
+// RepoKind classifies a repository by how it relates to its origin
+type RepoKind string
+
+const (
+	// RepoKindSource is a regular repository
+	RepoKindSource RepoKind = "source"
+
+	// RepoKindFork is a fork of another repository
+	RepoKindFork RepoKind = "fork"
+
+	// RepoKindMirror is a read-only copy of a repository hosted elsewhere
+	RepoKindMirror RepoKind = "mirror"
+
+	// RepoKindArchive is an archived, read-only repository
+	RepoKindArchive RepoKind = "archive"
+
+	// RepoKindTemplate is a template used to generate new repositories
+	RepoKindTemplate RepoKind = "template"
+)
+
+// RepoKinds lists the repository kinds
+var RepoKinds = []RepoKind{RepoKindSource, RepoKindFork, RepoKindMirror, RepoKindArchive, RepoKindTemplate}
+
+// ParseRepoKind validates a repository kind name
+func ParseRepoKind(s string) (RepoKind, error) {
+	kind := RepoKind(strings.ToLower(strings.TrimSpace(s)))
+
+	for _, k := range RepoKinds {
+		if k == kind {
+			return kind, nil
+		}
+	}
+
+	names := make([]string, len(RepoKinds))
+	for i, k := range RepoKinds {
+		names[i] = string(k)
+	}
+
+	return "", fmt.Errorf("invalid repository kind %q (valid: %s)", s, strings.Join(names, ", "))
+}
+
+// SkipsUpdate reports whether bulk updates leave repositories of this kind
+// alone: archives no longer change upstream.
+func (k RepoKind) SkipsUpdate() bool {
+	return k == RepoKindArchive
+}
+
+// SkipsPush reports whether bulk pushes leave repositories of this kind
+// alone: mirrors are overwritten from their source and archives reject pushes.
+func (k RepoKind) SkipsPush() bool {
+	return k == RepoKindMirror || k == RepoKindArchive
+}
+
+// Repository event types published when tracked repositories change
+const (
+	RepoEventAdded   = "added"
+	RepoEventRemoved = "removed"
+	RepoEventUpdated = "updated"
+)
+
+// RepoEvent describes a change to a tracked repository
+type RepoEvent struct {
+	// Type is one of RepoEventAdded, RepoEventRemoved or RepoEventUpdated
+	Type string `json:"type"`
+
+	// URL is the repository URL, empty if the change affected unknown repositories
+	URL string `json:"url"`
+
+	// Time is when the change happened
+	Time time.Time `json:"time"`
+}
+
+// RepoFilter selects repositories for paginated listing
+type RepoFilter struct {
+	// Workspace limits results to one workspace (empty = all)
+	Workspace string `json:"workspace,omitempty"`
+
+	// FavoritesOnly limits results to favorite repositories
+	FavoritesOnly bool `json:"favorites_only,omitempty"`
+
+	// Query is a case-insensitive substring matched against URL and path
+	Query string `json:"query,omitempty"`
+
+	// Kind limits results to one repository kind (empty = all)
+	Kind RepoKind `json:"kind,omitempty"`
+}
+
+// Matches reports whether repo passes the filter
+func (f RepoFilter) Matches(repo Repository) bool {
+	if f.Workspace != "" && repo.Workspace != f.Workspace {
+		return false
+	}
+
+	if f.FavoritesOnly && !repo.Favorite {
+		return false
+	}
+
+	if f.Kind != "" && repo.Kind != f.Kind {
+		return false
+	}
+
+	if f.Query == "" {
+		return true
+	}
+
+	q := strings.ToLower(f.Query)
+
+	return strings.Contains(strings.ToLower(repo.URL), q) || strings.Contains(strings.ToLower(repo.Path), q)
 }
@@ -34,7 +34,7 @@ type NotifyChannel struct {
 	// For Email: provider, host, port, username, password, api_key, from, to
 	// For Gmail: access_token, refresh_token, email, client_id, client_secret
 	// For Outlook: access_token, refresh_token, client_id, client_secret, tenant_id, user_email
-	// For Webhook: url, method, headers, hmac_secret, template
+	// For Webhook: webhook_url, method, headers ("Name: value" lines), hmac_secret
 	Config map[string]string `json:"config"`
 
 	// Events contains the event configuration for this channel
@@ -81,6 +81,10 @@ const (
 	EventRelease  = "release"
 	EventSync     = "sync"
 	EventError    = "error"
+
+	EventUpdateAvailable = "update-available"
+	EventSyncFail        = "sync-fail"
+	EventMonitorError    = "monitor-error"
 )
 
 // Notification priorities.
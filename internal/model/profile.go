@@ -42,12 +42,30 @@ type Profile struct {
 	// LastUsedAt is when the profile was last used
 	LastUsedAt time.Time `json:"last_used_at"`
 
+	// TokenExpiresAt is when the token expires (zero if it does not expire)
+	TokenExpiresAt time.Time `json:"token_expires_at,omitzero"`
+
 	// Workspace is the associated workspace for this profile
 	Workspace string `json:"workspace"`
 
 	// NotifyChannels contains notification channels for this profile.
 	// All channel credentials are encrypted with the profile's encryption key.
 	NotifyChannels []NotifyChannel `json:"notify_channels,omitempty"`
+
+	// GitName is the user.name set on repositories cloned under this profile
+	GitName string `json:"git_name,omitempty"`
+
+	// GitEmail is the user.email set on repositories cloned under this profile
+	GitEmail string `json:"git_email,omitempty"`
+
+	// GitSigningKey is the user.signingkey set on repositories cloned under this profile
+	GitSigningKey string `json:"git_signing_key,omitempty"`
+
+	// GitSigningFormat is the gpg.format of the signing key (openpgp, ssh or x509)
+	GitSigningFormat string `json:"git_signing_format,omitempty"`
+
+	// GitSignCommits sets commit.gpgsign on repositories cloned under this profile
+	GitSignCommits bool `json:"git_sign_commits,omitempty"`
 }
 
 // DefaultHost returns the default GitHub host
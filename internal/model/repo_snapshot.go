@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// RepoSnapshot records the working state of a repository so it can be
+// restored later with 'clonr snapshot restore'.
+type RepoSnapshot struct {
+	// ID is the unique identifier for this snapshot
+	ID string `json:"id"`
+
+	// RepoURL is the URL of the repository the snapshot belongs to
+	RepoURL string `json:"repo_url"`
+
+	// Path is the local path of the repository when the snapshot was taken
+	Path string `json:"path"`
+
+	// Message is an optional description of the snapshot
+	Message string `json:"message,omitempty"`
+
+	// Branch is the checked out branch (empty when HEAD was detached)
+	Branch string `json:"branch,omitempty"`
+
+	// Head is the commit SHA of HEAD
+	Head string `json:"head"`
+
+	// Stash is the stash commit SHA holding uncommitted changes (empty if clean)
+	Stash string `json:"stash,omitempty"`
+
+	// CreatedAt is when the snapshot was taken
+	CreatedAt time.Time `json:"created_at"`
+}
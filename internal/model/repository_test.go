@@ -188,3 +188,81 @@ func TestRepository_JSONFields(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRepoKind(t *testing.T) {
+	for _, kind := range RepoKinds {
+		got, err := ParseRepoKind(" " + strings.ToUpper(string(kind)) + " ")
+		if err != nil || got != kind {
+			t.Errorf("ParseRepoKind(%q) = %q, %v; want %q", kind, got, err, kind)
+		}
+	}
+
+	if _, err := ParseRepoKind("bogus"); err == nil {
+		t.Error("ParseRepoKind(bogus) error = nil, want error")
+	}
+}
+
+func TestParseRepoAlias(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "api", want: "api"},
+		{in: " API-v2 ", want: "api-v2"},
+		{in: "go.tools_1", want: "go.tools_1"},
+		{in: "", wantErr: true},
+		{in: "-api", wantErr: true},
+		{in: "acme/api", wantErr: true},
+		{in: "my api", wantErr: true},
+		{in: strings.Repeat("a", MaxRepoAliasLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseRepoAlias(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseRepoAlias(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestRepoKind_Skips(t *testing.T) {
+	tests := []struct {
+		kind       RepoKind
+		skipUpdate bool
+		skipPush   bool
+	}{
+		{"", false, false},
+		{RepoKindSource, false, false},
+		{RepoKindFork, false, false},
+		{RepoKindTemplate, false, false},
+		{RepoKindMirror, false, true},
+		{RepoKindArchive, true, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.kind.SkipsUpdate(); got != tt.skipUpdate {
+			t.Errorf("%q.SkipsUpdate() = %v, want %v", tt.kind, got, tt.skipUpdate)
+		}
+
+		if got := tt.kind.SkipsPush(); got != tt.skipPush {
+			t.Errorf("%q.SkipsPush() = %v, want %v", tt.kind, got, tt.skipPush)
+		}
+	}
+}
+
+func TestRepoFilter_Kind(t *testing.T) {
+	filter := RepoFilter{Kind: RepoKindFork}
+
+	if !filter.Matches(Repository{URL: "https://github.com/a/b", Kind: RepoKindFork}) {
+		t.Error("fork does not match kind filter")
+	}
+
+	if filter.Matches(Repository{URL: "https://github.com/a/c", Kind: RepoKindSource}) {
+		t.Error("source matches fork filter")
+	}
+
+	if filter.Matches(Repository{URL: "https://github.com/a/d"}) {
+		t.Error("unclassified repository matches fork filter")
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// ShareLink is a one-time link showing read-only information about a
+// repository to someone without access to the clonr server.
+type ShareLink struct {
+	// ID is the random link identifier
+	ID string `json:"id"`
+
+	// RepoURL is the URL of the shared repository
+	RepoURL string `json:"repo_url"`
+
+	// Note is an optional message shown with the repository
+	Note string `json:"note,omitempty"`
+
+	// CreatedAt is when the link was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt is when the link stops working
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// VaultSecret is an arbitrary secret stored by 'clonr vault', such as an
+// API token or a registry password. The value is encrypted with the same
+// keystore as profile tokens before it reaches the store.
+type VaultSecret struct {
+	// Key names the secret; it is also the environment variable the secret
+	// is injected as
+	Key string `json:"key"`
+
+	// Value is the encrypted secret
+	Value []byte `json:"value"`
+
+	// CreatedAt is when the secret was first stored
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is when the secret was last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
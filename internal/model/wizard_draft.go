@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// WizardDraft is the saved input of an interrupted interactive flow, kept so
+// the flow can resume where it left off on the next launch.
+type WizardDraft struct {
+	// Name identifies the flow (e.g. "configure", "connect", "org-mirror:acme")
+	Name string `json:"name"`
+
+	// Data is the flow-specific state as JSON
+	Data []byte `json:"data"`
+
+	// UpdatedAt is when the draft was last saved
+	UpdatedAt time.Time `json:"updated_at"`
+}
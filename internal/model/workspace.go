@@ -16,6 +16,14 @@ type Workspace struct {
 	// Active indicates if this is the currently active workspace
 	Active bool `json:"active"`
 
+	// URLPatterns route clones to this workspace automatically. Each is a glob
+	// matched against host/owner/repo, e.g. "github.com/my-company/*".
+	URLPatterns []string `json:"url_patterns,omitempty"`
+
+	// DiskBudget is the disk space in bytes the workspace's repositories may
+	// use before the server warns (0 = no budget)
+	DiskBudget int64 `json:"disk_budget,omitempty"`
+
 	// CreatedAt is when the workspace was created
 	CreatedAt time.Time `json:"created_at"`
 
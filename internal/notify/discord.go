@@ -0,0 +1,231 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// discordWebhookPrefixes are the URL prefixes of Discord webhooks.
+var discordWebhookPrefixes = []string{
+	"https://discord.com/api/webhooks/",
+	"https://discordapp.com/api/webhooks/",
+	"https://ptb.discord.com/api/webhooks/",
+	"https://canary.discord.com/api/webhooks/",
+}
+
+// DiscordMessage is the payload of a Discord webhook.
+type DiscordMessage struct {
+	// Content is the plain text of the message
+	Content string `json:"content,omitempty"`
+
+	// Username overrides the name of the webhook
+	Username string `json:"username,omitempty"`
+
+	// Embeds contain rich formatting
+	Embeds []DiscordEmbed `json:"embeds,omitempty"`
+}
+
+// DiscordEmbed is a rich embed of a Discord message.
+type DiscordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color,omitempty"`
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+	Footer      *DiscordEmbedFooter `json:"footer,omitempty"`
+	Timestamp   string              `json:"timestamp,omitempty"`
+}
+
+// DiscordEmbedField is a name and value shown in an embed.
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// DiscordEmbedFooter is the footer of an embed.
+type DiscordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// DiscordSender sends notifications to a Discord channel via webhook.
+type DiscordSender struct {
+	webhookURL string
+	username   string
+	httpClient *http.Client
+}
+
+// NewDiscordSender creates a sender posting to a Discord webhook. The
+// username overrides the name of the webhook when not empty.
+func NewDiscordSender(webhookURL, username string) *DiscordSender {
+	return &DiscordSender{
+		webhookURL: webhookURL,
+		username:   username,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name returns the sender name.
+func (s *DiscordSender) Name() string {
+	return "discord"
+}
+
+// Send sends a notification for the given event.
+func (s *DiscordSender) Send(ctx context.Context, event *Event) error {
+	msg := FormatDiscordMessage(event)
+	msg.Username = s.username
+
+	return s.post(ctx, msg)
+}
+
+// Test sends a test notification.
+func (s *DiscordSender) Test(ctx context.Context) error {
+	return s.post(ctx, &DiscordMessage{
+		Username: s.username,
+		Embeds: []DiscordEmbed{{
+			Title:       "Test notification from clonr",
+			Description: "Your Discord integration is working correctly!",
+			Color:       discordColor("#2EB67D"),
+			Footer:      &DiscordEmbedFooter{Text: "Sent by clonr discord notify test"},
+			Timestamp:   time.Now().UTC().Format(time.RFC3339),
+		}},
+	})
+}
+
+// post sends a message to the webhook.
+func (s *DiscordSender) post(ctx context.Context, msg *DiscordMessage) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	// Discord answers 204 No Content, or 200 with the message for ?wait=true
+	if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if retryAfter := resp.Header.Get("Retry-After"); resp.StatusCode == http.StatusTooManyRequests && retryAfter != "" {
+		return fmt.Errorf("webhook rate limited, retry after %s seconds", retryAfter)
+	}
+
+	return fmt.Errorf("webhook returned %d: %s", resp.StatusCode, string(respBody))
+}
+
+// FormatDiscordMessage creates a Discord webhook message from an event.
+func FormatDiscordMessage(event *Event) *DiscordMessage {
+	embed := DiscordEmbed{
+		Title: truncate(FormatSlackMessage(event, "").Text, 256),
+		URL:   event.URL,
+		Color: discordColor(getEventColor(event)),
+	}
+
+	if event.CommitMessage != "" {
+		embed.Description = clip(event.CommitMessage, 4096)
+	}
+
+	if event.Error != "" {
+		embed.Description = "```\n" + clip(event.Error, 4000) + "\n```"
+	}
+
+	field := func(name, value string) {
+		if value != "" {
+			embed.Fields = append(embed.Fields, DiscordEmbedField{Name: name, Value: truncate(value, 1024), Inline: true})
+		}
+	}
+
+	field("Repository", event.Repository)
+	field("Branch", event.Branch)
+
+	if len(event.Commit) > 7 {
+		field("Commit", event.Commit[:7])
+	} else {
+		field("Commit", event.Commit)
+	}
+
+	field("Author", event.Author)
+
+	for _, key := range slices.Sorted(maps.Keys(event.Extra)) {
+		field(toTitle(strings.ReplaceAll(key, "_", " ")), event.Extra[key])
+	}
+
+	var footer []string
+	if event.Profile != "" {
+		footer = append(footer, "Profile: "+event.Profile)
+	}
+
+	if event.Workspace != "" {
+		footer = append(footer, "Workspace: "+event.Workspace)
+	}
+
+	if len(footer) > 0 {
+		embed.Footer = &DiscordEmbedFooter{Text: strings.Join(footer, " | ")}
+	}
+
+	if !event.Timestamp.IsZero() {
+		embed.Timestamp = event.Timestamp.UTC().Format(time.RFC3339)
+	}
+
+	return &DiscordMessage{Embeds: []DiscordEmbed{embed}}
+}
+
+// clip shortens a multi-line string to at most maxLen bytes.
+func clip(s string, maxLen int) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= maxLen {
+		return s
+	}
+
+	return s[:maxLen-3] + "..."
+}
+
+// discordColor converts a "#RRGGBB" color to the integer Discord expects.
+func discordColor(hex string) int {
+	color, err := strconv.ParseInt(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		return 0
+	}
+
+	return int(color)
+}
+
+// ValidateDiscordWebhookURL checks if a Discord webhook URL is valid.
+func ValidateDiscordWebhookURL(url string) error {
+	if url == "" {
+		return fmt.Errorf("webhook URL is required")
+	}
+
+	for _, prefix := range discordWebhookPrefixes {
+		if strings.HasPrefix(url, prefix) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid Discord webhook URL: must start with https://discord.com/api/webhooks/")
+}
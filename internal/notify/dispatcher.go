@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -12,6 +13,7 @@ type Dispatcher struct {
 	senders []Sender
 	mu      sync.RWMutex
 	async   bool
+	pending sync.WaitGroup
 }
 
 // NewDispatcher creates a new notification dispatcher.
@@ -46,8 +48,29 @@ func (d *Dispatcher) Unregister(name string) {
 	d.senders = filtered
 }
 
+// offlineCheck reports whether notifications must not leave the machine.
+var offlineCheck atomic.Pointer[func() bool]
+
+// SetOfflineCheck installs the check that makes every dispatcher drop
+// events while air-gapped mode is enabled.
+func SetOfflineCheck(check func() bool) {
+	offlineCheck.Store(&check)
+}
+
+// isOffline reports whether the installed offline check is true.
+func isOffline() bool {
+	check := offlineCheck.Load()
+
+	return check != nil && (*check)()
+}
+
 // Dispatch sends an event to all registered senders.
+// Events are dropped while air-gapped mode is enabled.
 func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) {
+	if isOffline() {
+		return
+	}
+
 	d.mu.RLock()
 	senders := make([]Sender, len(d.senders))
 	copy(senders, d.senders)
@@ -59,7 +82,7 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) {
 
 	if d.async {
 		for _, sender := range senders {
-			go d.sendWithRecover(ctx, sender, event)
+			d.pending.Go(func() { d.sendWithRecover(ctx, sender, event) })
 		}
 	} else {
 		for _, sender := range senders {
@@ -85,6 +108,21 @@ func (d *Dispatcher) sendWithRecover(ctx context.Context, sender Sender, event *
 	}
 }
 
+// Wait waits up to timeout for events still being sent asynchronously.
+func (d *Dispatcher) Wait(timeout time.Duration) {
+	done := make(chan struct{})
+
+	go func() {
+		d.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
 // HasSenders returns true if any senders are registered.
 func (d *Dispatcher) HasSenders() bool {
 	d.mu.RLock()
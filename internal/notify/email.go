@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// SendMailFunc sends a plain text email.
+type SendMailFunc func(ctx context.Context, to, subject, body string) error
+
+// EmailSender sends notifications as plain text emails through a mail
+// provider such as Gmail.
+type EmailSender struct {
+	name string
+	to   string
+	send SendMailFunc
+}
+
+// NewEmailSender creates a sender mailing events to the address to.
+func NewEmailSender(name, to string, send SendMailFunc) *EmailSender {
+	return &EmailSender{name: name, to: to, send: send}
+}
+
+// Name returns the sender name.
+func (s *EmailSender) Name() string {
+	return s.name
+}
+
+// Send sends a notification for the given event.
+func (s *EmailSender) Send(ctx context.Context, event *Event) error {
+	if s.to == "" {
+		return errors.New("no recipient configured")
+	}
+
+	subject, body := FormatEmail(event)
+
+	return s.send(ctx, s.to, subject, body)
+}
+
+// Test sends a test notification to verify configuration.
+func (s *EmailSender) Test(ctx context.Context) error {
+	if s.to == "" {
+		return errors.New("no recipient configured")
+	}
+
+	return s.send(ctx, s.to, "Test notification from clonr",
+		"Your clonr email notifications are working correctly.\n")
+}
+
+// FormatEmail creates the subject and plain text body of an email for an event.
+func FormatEmail(event *Event) (subject, body string) {
+	subject = FormatSlackMessage(event, "").Text
+
+	var b strings.Builder
+
+	line := func(label, value string) {
+		if value != "" {
+			_, _ = fmt.Fprintf(&b, "%-12s %s\n", label+":", value)
+		}
+	}
+
+	line("Event", event.Type)
+	line("Repository", event.Repository)
+	line("Branch", event.Branch)
+	line("Commit", event.Commit)
+	line("Message", event.CommitMessage)
+	line("Author", event.Author)
+	line("URL", event.URL)
+	line("Error", event.Error)
+	line("Profile", event.Profile)
+	line("Workspace", event.Workspace)
+	line("Time", event.Timestamp.Format(time.RFC1123))
+
+	for _, key := range slices.Sorted(maps.Keys(event.Extra)) {
+		line(key, event.Extra[key])
+	}
+
+	b.WriteString("\nSent by clonr\n")
+
+	return subject, b.String()
+}
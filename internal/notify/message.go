@@ -114,6 +114,48 @@ func FormatSlackMessage(event *Event, channel string) *SlackMessage {
 			Color:  color,
 			Blocks: formatErrorBlocks(event),
 		}}
+	case EventUpdateAvailable:
+		msg.Text = formatUpdateAvailableText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatUpdateAvailableBlocks(event),
+		}}
+	case EventSyncFail, EventMonitorError:
+		msg.Text = formatFailureText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatFailureBlocks(event),
+		}}
+	case EventCredentialExpiry:
+		msg.Text = formatCredentialExpiryText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatCredentialExpiryBlocks(event),
+		}}
+	case EventDiskBudget:
+		msg.Text = formatDiskBudgetText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatDiskBudgetBlocks(event),
+		}}
+	case EventFleetReport:
+		msg.Text = formatFleetReportText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatFleetReportBlocks(event),
+		}}
+	case EventClientRegistration:
+		msg.Text = formatClientRegistrationText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatClientRegistrationBlocks(event),
+		}}
+	case EventGmailMessage:
+		msg.Text = formatGmailMessageText(event)
+		msg.Attachments = []Attachment{{
+			Color:  color,
+			Blocks: formatGmailMessageBlocks(event),
+		}}
 	default:
 		msg.Text = formatGenericText(event)
 		msg.Attachments = []Attachment{{
@@ -441,6 +483,331 @@ func formatErrorBlocks(event *Event) []Block {
 	return blocks
 }
 
+// formatUpdateAvailableText creates the fallback text for an update available event.
+func formatUpdateAvailableText(event *Event) string {
+	if event.Branch != "" {
+		return fmt.Sprintf("[%s] Update available on %s", event.Repository, event.Branch)
+	}
+
+	return fmt.Sprintf("[%s] Update available", event.Repository)
+}
+
+// formatUpdateAvailableBlocks creates Block Kit blocks for an update available event.
+func formatUpdateAvailableBlocks(event *Event) []Block {
+	fields := []TextObject{
+		{Type: "mrkdwn", Text: fmt.Sprintf("*Repository*\n%s", event.Repository)},
+	}
+
+	if event.Branch != "" {
+		fields = append(fields, TextObject{Type: "mrkdwn", Text: fmt.Sprintf("*Branch*\n`%s`", event.Branch)})
+	}
+
+	blocks := []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: ":arrow_down: *Update Available*",
+			},
+		},
+		{
+			Type:   "section",
+			Fields: fields,
+		},
+	}
+
+	if event.CommitMessage != "" {
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("> %s", truncate(event.CommitMessage, 200)),
+			},
+		})
+	}
+
+	blocks = append(blocks, formatContextBlock(event))
+
+	return blocks
+}
+
+// formatFailureText creates the fallback text for a sync failure or monitor error event.
+func formatFailureText(event *Event) string {
+	title := toTitle(strings.ReplaceAll(event.Type, "-", " "))
+	if event.Repository != "" {
+		return fmt.Sprintf("[%s] %s: %s", event.Repository, title, event.Error)
+	}
+
+	return fmt.Sprintf("[clonr] %s: %s", title, event.Error)
+}
+
+// formatFailureBlocks creates Block Kit blocks for a sync failure or monitor error event.
+func formatFailureBlocks(event *Event) []Block {
+	title := "Sync Failed"
+	if event.Type == EventMonitorError {
+		title = "Monitor Error"
+		if event.Extra["monitor"] != "" {
+			title = fmt.Sprintf("Monitor Error: %s", event.Extra["monitor"])
+		}
+	}
+
+	blocks := []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf(":x: *%s*", title),
+			},
+		},
+	}
+
+	if event.Repository != "" {
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*Repository*\n%s", event.Repository),
+			},
+		})
+	}
+
+	if event.Error != "" {
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("```%s```", truncate(event.Error, 500)),
+			},
+		})
+	}
+
+	blocks = append(blocks, formatContextBlock(event))
+
+	return blocks
+}
+
+// formatGmailMessageText creates the fallback text for a Gmail watch match.
+func formatGmailMessageText(event *Event) string {
+	return fmt.Sprintf("[%s] New email from %s: %s", event.Extra["watch"], event.Author, event.Extra["subject"])
+}
+
+// formatGmailMessageBlocks creates Block Kit blocks for a Gmail watch match.
+func formatGmailMessageBlocks(event *Event) []Block {
+	subject := event.Extra["subject"]
+	if event.URL != "" {
+		subject = fmt.Sprintf("<%s|%s>", event.URL, subject)
+	}
+
+	blocks := []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf(":email: *New email matching %s*", event.Extra["watch"]),
+			},
+		},
+		{
+			Type: "section",
+			Fields: []TextObject{
+				{Type: "mrkdwn", Text: fmt.Sprintf("*From*\n%s", event.Author)},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Subject*\n%s", subject)},
+			},
+		},
+	}
+
+	if event.Extra["snippet"] != "" {
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("> %s", truncate(event.Extra["snippet"], 200)),
+			},
+		})
+	}
+
+	blocks = append(blocks, formatContextBlock(event))
+
+	return blocks
+}
+
+// formatCredentialExpiryText creates the fallback text for a credential expiry event.
+func formatCredentialExpiryText(event *Event) string {
+	credType := event.Extra["type"]
+	name := event.Extra["name"]
+
+	if !event.Success {
+		return fmt.Sprintf("[clonr] %s credential %s has expired", credType, name)
+	}
+
+	return fmt.Sprintf("[clonr] %s credential %s expires in %s day(s)", credType, name, event.Extra["days_left"])
+}
+
+// formatCredentialExpiryBlocks creates Block Kit blocks for a credential expiry event.
+func formatCredentialExpiryBlocks(event *Event) []Block {
+	title := ":hourglass: *Credential expiring soon*"
+	if !event.Success {
+		title = ":no_entry: *Credential expired*"
+	}
+
+	details := fmt.Sprintf("*Type*\n%s\n*Name*\n%s", event.Extra["type"], event.Extra["name"])
+	if expiresAt := event.Extra["expires_at"]; expiresAt != "" {
+		details += fmt.Sprintf("\n*Expires*\n%s", expiresAt)
+	}
+
+	return []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: title,
+			},
+		},
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: details,
+			},
+		},
+		formatContextBlock(event),
+	}
+}
+
+// formatDiskBudgetText creates the fallback text for a disk budget event.
+func formatDiskBudgetText(event *Event) string {
+	return fmt.Sprintf("[clonr] Workspace %s uses %s of its %s disk budget (%s%%)",
+		event.Workspace, event.Extra["used"], event.Extra["budget"], event.Extra["percent"])
+}
+
+// formatDiskBudgetBlocks creates Block Kit blocks for a disk budget event.
+func formatDiskBudgetBlocks(event *Event) []Block {
+	title := fmt.Sprintf(":floppy_disk: *Workspace %s is at %s%% of its disk budget*", event.Workspace, event.Extra["percent"])
+	if !event.Success {
+		title = fmt.Sprintf(":rotating_light: *Workspace %s exceeds its disk budget*", event.Workspace)
+	}
+
+	blocks := []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: title,
+			},
+		},
+		{
+			Type: "section",
+			Fields: []TextObject{
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Used*\n%s", event.Extra["used"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Budget*\n%s", event.Extra["budget"])},
+			},
+		},
+	}
+
+	if candidates := event.Extra["prune_candidates"]; candidates != "" {
+		blocks = append(blocks, Block{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("*Prune candidates*\n%s", candidates),
+			},
+		})
+	}
+
+	return append(blocks, formatContextBlock(event))
+}
+
+// formatFleetReportText creates the fallback text for a fleet report event.
+func formatFleetReportText(event *Event) string {
+	return fmt.Sprintf("[clonr] Fleet report: %s repositories (%s), %s update failure(s), %s stale branch(es), %s on disk",
+		event.Extra["total_repos"], event.Extra["growth"], event.Extra["update_failures"],
+		event.Extra["stale_branches"], event.Extra["disk_usage"])
+}
+
+// formatFleetReportBlocks creates Block Kit blocks for a fleet report event.
+func formatFleetReportBlocks(event *Event) []Block {
+	// Section text is limited to 3000 characters; keep the digest's line breaks
+	digest := event.Extra["digest"]
+	if len(digest) > 2900 {
+		digest = digest[:2897] + "..."
+	}
+
+	return []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: ":bar_chart: *Weekly fleet report*",
+			},
+		},
+		{
+			Type: "section",
+			Fields: []TextObject{
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Repositories*\n%s (%s)", event.Extra["total_repos"], event.Extra["growth"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Disk usage*\n%s", event.Extra["disk_usage"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Update failures*\n%s", event.Extra["update_failures"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Stale branches*\n%s", event.Extra["stale_branches"])},
+			},
+		},
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: fmt.Sprintf("```%s```", digest),
+			},
+		},
+		formatContextBlock(event),
+	}
+}
+
+// formatClientRegistrationText creates the fallback text for a client registration event.
+func formatClientRegistrationText(event *Event) string {
+	if event.Extra["status"] == "approved" {
+		return fmt.Sprintf("[clonr] Standalone client %s (%s) was approved automatically",
+			event.Extra["client_name"], event.Extra["hostname"])
+	}
+
+	return fmt.Sprintf("[clonr] Standalone client %s (%s) requests registration",
+		event.Extra["client_name"], event.Extra["hostname"])
+}
+
+// formatClientRegistrationBlocks creates Block Kit blocks for a client registration event.
+func formatClientRegistrationBlocks(event *Event) []Block {
+	title := ":key: *New standalone client awaiting approval*"
+	footer := fmt.Sprintf("Approve with `clonr standalone clients approve %s` or reject with `clonr standalone clients reject %s`",
+		event.Extra["client_id"], event.Extra["client_id"])
+
+	if event.Extra["status"] == "approved" {
+		title = ":white_check_mark: *New standalone client approved automatically*"
+		footer = fmt.Sprintf("Revoke with `clonr standalone clients revoke %s`", event.Extra["client_id"])
+	}
+
+	return []Block{
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: title,
+			},
+		},
+		{
+			Type: "section",
+			Fields: []TextObject{
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Client*\n%s (%s)", event.Extra["client_name"], event.Extra["client_id"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Machine*\n%s (%s)", event.Extra["hostname"], event.Extra["platform"])},
+				{Type: "mrkdwn", Text: fmt.Sprintf("*Fingerprint*\n%s", event.Extra["fingerprint"])},
+			},
+		},
+		{
+			Type: "section",
+			Text: &TextObject{
+				Type: "mrkdwn",
+				Text: footer,
+			},
+		},
+		formatContextBlock(event),
+	}
+}
+
 // formatGenericText creates the fallback text for a generic event.
 func formatGenericText(event *Event) string {
 	if event.Repository != "" {
@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// RouteAll is the event type of a route applying to every event type.
+const RouteAll = "*"
+
+// Channel is a notification channel a Router delivers to.
+type Channel struct {
+	// ID and Type identify the channel in routes
+	ID   string
+	Type string
+
+	Sender Sender
+
+	// Events limits the channel to these event types; empty receives all
+	Events []string
+}
+
+// Route sends the events of one type to the listed channels only.
+type Route struct {
+	// Event is the event type, or RouteAll
+	Event string
+
+	// Channels are channel IDs or types; empty mutes the event
+	Channels []string
+}
+
+// Router is a Sender fanning events out to every enabled notification
+// channel, following per-event-type routes.
+type Router struct {
+	channels []Channel
+	routes   []Route
+}
+
+// NewRouter creates a router applying routes.
+func NewRouter(routes []Route) *Router {
+	return &Router{routes: routes}
+}
+
+// Add adds a channel to the router.
+func (r *Router) Add(ch Channel) {
+	r.channels = append(r.channels, ch)
+}
+
+// Channels returns the channels of the router.
+func (r *Router) Channels() []Channel {
+	return slices.Clone(r.channels)
+}
+
+// Targets returns the channels receiving events of eventType. A route for
+// the event type wins over a RouteAll route; without either, every channel
+// accepting the event type receives it.
+func (r *Router) Targets(eventType string) []Channel {
+	route, routed := r.route(eventType)
+
+	var targets []Channel
+
+	for _, ch := range r.channels {
+		if len(ch.Events) > 0 && !slices.Contains(ch.Events, eventType) {
+			continue
+		}
+
+		if routed && !slices.Contains(route.Channels, ch.ID) && !slices.Contains(route.Channels, ch.Type) {
+			continue
+		}
+
+		targets = append(targets, ch)
+	}
+
+	return targets
+}
+
+// route returns the route applying to eventType.
+func (r *Router) route(eventType string) (Route, bool) {
+	var fallback *Route
+
+	for i, route := range r.routes {
+		if route.Event == eventType {
+			return route, true
+		}
+
+		if route.Event == RouteAll && fallback == nil {
+			fallback = &r.routes[i]
+		}
+	}
+
+	if fallback != nil {
+		return *fallback, true
+	}
+
+	return Route{}, false
+}
+
+// Name returns the sender name.
+func (r *Router) Name() string {
+	return "router"
+}
+
+// Send sends the event to every target channel. A failing channel does not
+// stop delivery to the others; all failures are returned together.
+func (r *Router) Send(ctx context.Context, event *Event) error {
+	var errs []error
+
+	for _, ch := range r.Targets(event.Type) {
+		if err := ch.Sender.Send(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ch.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Test sends a test notification to every channel.
+func (r *Router) Test(ctx context.Context) error {
+	var errs []error
+
+	for _, ch := range r.channels {
+		if err := ch.Sender.Test(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ch.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -87,6 +87,18 @@ const (
 	EventRelease  = "release"
 	EventSync     = "sync"
 	EventError    = "error"
+
+	EventUpdateAvailable = "update-available"
+	EventSyncFail        = "sync-fail"
+	EventMonitorError    = "monitor-error"
+
+	EventCredentialExpiry = "credential-expiry"
+	EventFleetReport      = "fleet-report"
+	EventDiskBudget       = "disk-budget"
+
+	EventClientRegistration = "client-registration"
+
+	EventGmailMessage = "gmail-message"
 )
 
 // NewEvent creates a new event with the given type and sets the timestamp.
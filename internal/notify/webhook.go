@@ -0,0 +1,208 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers of webhook notification requests.
+const (
+	// WebhookEventHeader carries the event type
+	WebhookEventHeader = "X-Clonr-Event"
+
+	// WebhookTimestampHeader carries the Unix time the request was signed at
+	WebhookTimestampHeader = "X-Clonr-Timestamp"
+
+	// WebhookSignatureHeader carries "sha256=" and the hex HMAC-SHA256 of
+	// the timestamp, a dot and the body, keyed with the secret
+	WebhookSignatureHeader = "X-Clonr-Signature-256"
+)
+
+// WebhookPayload is the JSON body of a webhook notification.
+type WebhookPayload struct {
+	Event         string            `json:"event"`
+	Text          string            `json:"text"`
+	Repository    string            `json:"repository,omitempty"`
+	Branch        string            `json:"branch,omitempty"`
+	Commit        string            `json:"commit,omitempty"`
+	CommitMessage string            `json:"commit_message,omitempty"`
+	Author        string            `json:"author,omitempty"`
+	URL           string            `json:"url,omitempty"`
+	Profile       string            `json:"profile,omitempty"`
+	Workspace     string            `json:"workspace,omitempty"`
+	Timestamp     time.Time         `json:"timestamp"`
+	Success       bool              `json:"success"`
+	Error         string            `json:"error,omitempty"`
+	Extra         map[string]string `json:"extra,omitempty"`
+}
+
+// WebhookSender posts notifications as JSON to any URL, optionally signed
+// with an HMAC secret, for self-hosted automation.
+type WebhookSender struct {
+	name       string
+	url        string
+	method     string
+	headers    http.Header
+	secret     string
+	httpClient *http.Client
+}
+
+// NewWebhookSender creates a sender posting events to url. An empty method
+// means POST; a non-empty secret signs every request.
+func NewWebhookSender(name, url, method string, headers http.Header, secret string) *WebhookSender {
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	return &WebhookSender{
+		name:    name,
+		url:     url,
+		method:  method,
+		headers: headers,
+		secret:  secret,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// Name returns the sender name.
+func (s *WebhookSender) Name() string {
+	return s.name
+}
+
+// Send sends a notification for the given event.
+func (s *WebhookSender) Send(ctx context.Context, event *Event) error {
+	return s.post(ctx, NewWebhookPayload(event))
+}
+
+// Test sends a test notification to verify configuration.
+func (s *WebhookSender) Test(ctx context.Context) error {
+	return s.post(ctx, &WebhookPayload{
+		Event:     "test",
+		Text:      "Test notification from clonr",
+		Timestamp: time.Now(),
+		Success:   true,
+	})
+}
+
+// post sends a payload to the webhook.
+func (s *WebhookSender) post(ctx context.Context, payload *WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, s.method, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, values := range s.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "clonr-webhook")
+	req.Header.Set(WebhookEventHeader, payload.Event)
+
+	if s.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, SignWebhook(s.secret, timestamp, body))
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
+
+// NewWebhookPayload creates the webhook payload of an event.
+func NewWebhookPayload(event *Event) *WebhookPayload {
+	return &WebhookPayload{
+		Event:         event.Type,
+		Text:          FormatSlackMessage(event, "").Text,
+		Repository:    event.Repository,
+		Branch:        event.Branch,
+		Commit:        event.Commit,
+		CommitMessage: event.CommitMessage,
+		Author:        event.Author,
+		URL:           event.URL,
+		Profile:       event.Profile,
+		Workspace:     event.Workspace,
+		Timestamp:     event.Timestamp,
+		Success:       event.Success,
+		Error:         event.Error,
+		Extra:         event.Extra,
+	}
+}
+
+// SignWebhook returns the WebhookSignatureHeader value of a request body.
+// Receivers recompute it to verify the request came from clonr, and reject
+// old timestamps to prevent replays.
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ParseWebhookHeaders parses "Name: value" lines into request headers.
+func ParseWebhookHeaders(lines []string) (http.Header, error) {
+	headers := make(http.Header)
+
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid header %q: want Name: value", line)
+		}
+
+		headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	return headers, nil
+}
+
+// ValidateNotifyWebhookURL checks if the URL of a webhook notification
+// channel is valid.
+func ValidateNotifyWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return fmt.Errorf("webhook URL is required")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return fmt.Errorf("invalid webhook URL %q: must be an http or https URL", rawURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,356 @@
+// Package ratelimit provides the HTTP transport shared by the GitHub,
+// ZenHub, Slack and Gmail clients. It reads the rate-limit headers of every
+// response, holds back requests to an API that is close to its limit, and
+// retries rate-limited requests with exponential backoff and jitter.
+//
+// Throttling state is kept per host and shared by all transports, so
+// concurrent clients of the same API pause together instead of each
+// running into the limit.
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Config configures retries and waits.
+type Config struct {
+	// MaxRetries bounds the retries of a rate-limited request
+	MaxRetries int
+
+	// InitialBackoff is the backoff before the first retry when the
+	// response does not say how long to wait; it doubles with every retry
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the backoff between retries
+	MaxBackoff time.Duration
+
+	// MaxWait is the longest wait for a limit to reset. A request that
+	// would wait longer fails with the rate-limit response instead.
+	MaxWait time.Duration
+}
+
+// DefaultConfig returns the configuration of the API clients.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries:     4,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxWait:        2 * time.Minute,
+	}
+}
+
+// lowRemaining is the number of remaining requests below which requests
+// are spread evenly over the time left until the limit resets
+const lowRemaining = 10
+
+// Event describes a wait caused by rate limiting.
+type Event struct {
+	// Host is the API host, e.g. api.github.com
+	Host string
+
+	// Wait is how long the request waits
+	Wait time.Duration
+
+	// Attempt is the retry about to be made; 0 when a request is throttled
+	// before it is sent
+	Attempt int
+
+	// MaxRetries is the retry limit
+	MaxRetries int
+}
+
+// Retrying reports whether the event is a retry of a rate-limited request.
+func (e Event) Retrying() bool {
+	return e.Attempt > 0
+}
+
+// notifier receives the events of all transports
+var notifier atomic.Pointer[func(Event)]
+
+// SetNotifier sets the function told about every wait of a second or more,
+// so a CLI can show "rate limited, retrying" progress. Nil disables it.
+func SetNotifier(fn func(Event)) {
+	if fn == nil {
+		notifier.Store(nil)
+		return
+	}
+
+	notifier.Store(&fn)
+}
+
+func notify(e Event) {
+	if e.Wait < time.Second {
+		return
+	}
+
+	if fn := notifier.Load(); fn != nil {
+		(*fn)(e)
+	}
+}
+
+// Transport is an http.RoundTripper that throttles and retries
+// rate-limited requests.
+type Transport struct {
+	// Base performs the requests; http.DefaultTransport when nil
+	Base http.RoundTripper
+
+	Config Config
+}
+
+// NewTransport wraps base with the default configuration.
+func NewTransport(base http.RoundTripper) *Transport {
+	return &Transport{Base: base, Config: DefaultConfig()}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	limiter := limiterFor(req.URL.Host)
+
+	// Only requests without a body, or with a replayable one, are retried
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		if wait := limiter.delay(time.Now()); wait > 0 && wait <= t.Config.MaxWait {
+			notify(Event{Host: req.URL.Host, Wait: wait, MaxRetries: t.Config.MaxRetries})
+
+			if err := sleep(req.Context(), wait); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(req.Context())
+
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := base.RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		limiter.observe(resp.Header, now)
+
+		limited, wait := t.rateLimited(resp, attempt, now)
+		if !limited || !replayable || attempt >= t.Config.MaxRetries || wait > t.Config.MaxWait {
+			return resp, nil
+		}
+
+		// Waiting past the request deadline only turns the rate-limit
+		// response into a timeout
+		if deadline, ok := req.Context().Deadline(); ok && now.Add(wait).After(deadline) {
+			return resp, nil
+		}
+
+		limiter.pause(now.Add(wait))
+
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		_ = resp.Body.Close()
+
+		notify(Event{Host: req.URL.Host, Wait: wait, Attempt: attempt + 1, MaxRetries: t.Config.MaxRetries})
+
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimited reports whether resp rejected the request for rate limiting
+// and how long to wait before retrying it
+func (t *Transport) rateLimited(resp *http.Response, attempt int, now time.Time) (bool, time.Duration) {
+	retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), now)
+	remaining, reset, hasLimit := parseLimit(resp.Header, now)
+	exhausted := hasLimit && remaining == 0
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusForbidden:
+		// GitHub reports its primary and secondary limits with 403, Google
+		// APIs with a rateLimitExceeded reason in the body
+		if !hasRetryAfter && !exhausted && !bodyMentionsRateLimit(resp) {
+			return false, 0
+		}
+	case http.StatusServiceUnavailable:
+		if !hasRetryAfter {
+			return false, 0
+		}
+	default:
+		return false, 0
+	}
+
+	switch {
+	case hasRetryAfter:
+		return true, retryAfter
+	case exhausted:
+		return true, max(reset.Sub(now), 0) + time.Second
+	default:
+		return true, t.backoff(attempt)
+	}
+}
+
+// backoff returns the exponential backoff before retry attempt+1 with
+// jitter, between half and all of the exponential delay
+func (t *Transport) backoff(attempt int) time.Duration {
+	d := t.Config.InitialBackoff << min(attempt, 16)
+	if d <= 0 || d > t.Config.MaxBackoff {
+		d = t.Config.MaxBackoff
+	}
+
+	half := d / 2
+
+	return half + rand.N(half+1)
+}
+
+// bodyMentionsRateLimit reports whether the body of a 403 names a rate
+// limit, restoring the body for the caller
+func bodyMentionsRateLimit(resp *http.Response) bool {
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	_ = resp.Body.Close()
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return false
+	}
+
+	body := strings.ToLower(string(data))
+
+	return strings.Contains(body, "ratelimitexceeded") || strings.Contains(body, "rate limit")
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+		return time.Duration(max(secs, 0)) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// parseLimit reads the remaining requests and the reset time from the
+// X-RateLimit headers of GitHub and ZenHub, whose reset is a Unix time, or
+// the RateLimit headers of the IETF draft, whose reset is in seconds
+func parseLimit(h http.Header, now time.Time) (remaining int, reset time.Time, ok bool) {
+	for _, prefix := range []string{"X-Ratelimit-", "Ratelimit-"} {
+		value := h.Get(prefix + "Remaining")
+		if value == "" {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+
+		secs, err := strconv.ParseInt(strings.TrimSpace(h.Get(prefix+"Reset")), 10, 64)
+		if err != nil {
+			return remaining, time.Time{}, true
+		}
+
+		// A reset of less than a year is a delay rather than a Unix time
+		if secs < 365*24*60*60 {
+			return remaining, now.Add(time.Duration(secs) * time.Second), true
+		}
+
+		return remaining, time.Unix(secs, 0), true
+	}
+
+	return 0, time.Time{}, false
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// limiter is the throttling state of one API host
+type limiter struct {
+	mu sync.Mutex
+
+	// next is the earliest time the next request may be sent
+	next time.Time
+}
+
+// limiters holds a limiter per host
+var limiters sync.Map
+
+func limiterFor(host string) *limiter {
+	l, _ := limiters.LoadOrStore(host, &limiter{})
+
+	return l.(*limiter)
+}
+
+// delay returns how long a request must wait before it is sent
+func (l *limiter) delay(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return max(l.next.Sub(now), 0)
+}
+
+// pause holds back all requests until at least until
+func (l *limiter) pause(until time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until.After(l.next) {
+		l.next = until
+	}
+}
+
+// observe updates the state from the rate-limit headers of a response.
+// With few requests left, the rest are spread over the time to the reset;
+// with none left, requests wait for the reset.
+func (l *limiter) observe(h http.Header, now time.Time) {
+	remaining, reset, ok := parseLimit(h, now)
+	if !ok || reset.IsZero() || !reset.After(now) || remaining > lowRemaining {
+		return
+	}
+
+	if remaining == 0 {
+		l.pause(reset.Add(time.Second))
+		return
+	}
+
+	l.pause(now.Add(reset.Sub(now) / time.Duration(remaining+1)))
+}
@@ -0,0 +1,247 @@
+package ratelimit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testTransport() *Transport {
+	return &Transport{Config: Config{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+		MaxWait:        time.Second,
+	}}
+}
+
+func TestTransport_RetriesTooManyRequests(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("request %d body = %q, want the replayed payload", requests.Load(), body)
+		}
+
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := &http.Client{Transport: testTransport()}
+
+	resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || requests.Load() != 3 {
+		t.Errorf("status %d after %d requests, want 200 after 3", resp.StatusCode, requests.Load())
+	}
+}
+
+func TestTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+
+	resp, err := (&http.Client{Transport: testTransport()}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests || requests.Load() != 4 {
+		t.Errorf("status %d after %d requests, want 429 after 4", resp.StatusCode, requests.Load())
+	}
+}
+
+func TestTransport_ForbiddenWithoutRateLimitIsNotRetried(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		http.Error(w, `{"message":"Resource not accessible by integration"}`, http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	resp, err := (&http.Client{Transport: testTransport()}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if requests.Load() != 1 || !strings.Contains(string(body), "not accessible") {
+		t.Errorf("%d requests, body %q; want 1 request and the original body", requests.Load(), body)
+	}
+}
+
+func TestTransport_RetriesGoogleRateLimitExceeded(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			http.Error(w, `{"error":{"errors":[{"reason":"userRateLimitExceeded"}]}}`, http.StatusForbidden)
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+
+	resp, err := (&http.Client{Transport: testTransport()}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || requests.Load() != 2 {
+		t.Errorf("status %d after %d requests, want 200 after 2", resp.StatusCode, requests.Load())
+	}
+}
+
+func TestTransport_NotifiesRetries(t *testing.T) {
+	var events []Event
+
+	SetNotifier(func(e Event) { events = append(events, e) })
+	t.Cleanup(func() { SetNotifier(nil) })
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	resp, err := (&http.Client{Transport: testTransport()}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if len(events) != 1 || !events[0].Retrying() || events[0].Wait != time.Second || events[0].Attempt != 1 {
+		t.Errorf("events = %+v, want one 1s retry", events)
+	}
+}
+
+func TestTransport_SkipsWaitsBeyondMaxWait(t *testing.T) {
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(srv.Close)
+
+	start := time.Now()
+
+	resp, err := (&http.Client{Transport: testTransport()}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	_ = resp.Body.Close()
+
+	if requests.Load() != 1 || time.Since(start) > 500*time.Millisecond {
+		t.Errorf("%d requests in %v, want an immediate 429", requests.Load(), time.Since(start))
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	h := http.Header{}
+	h.Set("X-RateLimit-Remaining", "0")
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(now.Unix()+30, 10))
+
+	remaining, reset, ok := parseLimit(h, now)
+	if !ok || remaining != 0 || !reset.Equal(now.Add(30*time.Second)) {
+		t.Errorf("parseLimit(GitHub) = %d, %v, %v", remaining, reset, ok)
+	}
+
+	h = http.Header{}
+	h.Set("RateLimit-Remaining", "5")
+	h.Set("RateLimit-Reset", "12")
+
+	remaining, reset, ok = parseLimit(h, now)
+	if !ok || remaining != 5 || !reset.Equal(now.Add(12*time.Second)) {
+		t.Errorf("parseLimit(draft) = %d, %v, %v", remaining, reset, ok)
+	}
+
+	if _, _, ok := parseLimit(http.Header{}, now); ok {
+		t.Error("parseLimit(no headers) ok = true")
+	}
+}
+
+func TestLimiter_Observe(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	reset := strconv.FormatInt(now.Unix()+60, 10)
+
+	tests := []struct {
+		remaining string
+		want      time.Duration
+	}{
+		{"100", 0},
+		{"5", 10 * time.Second}, // 60s spread over the last 5 requests
+		{"0", 61 * time.Second},
+	}
+
+	for _, tt := range tests {
+		h := http.Header{}
+		h.Set("X-RateLimit-Remaining", tt.remaining)
+		h.Set("X-RateLimit-Reset", reset)
+
+		l := &limiter{}
+		l.observe(h, now)
+
+		if got := l.delay(now); got != tt.want {
+			t.Errorf("remaining %s: delay = %v, want %v", tt.remaining, got, tt.want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]time.Duration{
+		"120":                           2 * time.Minute,
+		"Fri, 16 Oct 2026 12:00:30 GMT": 30 * time.Second,
+	}
+
+	for value, want := range tests {
+		if got, ok := parseRetryAfter(value, now); !ok || got != want {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want %v", value, got, ok, want)
+		}
+	}
+
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error(`parseRetryAfter("soon") ok = true`)
+	}
+}
@@ -27,15 +27,15 @@ type ScanResult struct {
 
 // Finding represents a detected secret
 type Finding struct {
-	RuleID      string
-	Description string
-	File        string
-	Line        int
-	Secret      string // Redacted
-	Match       string
-	Commit      string
-	Author      string
-	Date        string
+	RuleID      string `json:"rule_id"`
+	Description string `json:"description"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Secret      string `json:"secret"` // Redacted
+	Match       string `json:"-"`
+	Commit      string `json:"commit,omitempty"`
+	Author      string `json:"author,omitempty"`
+	Date        string `json:"date,omitempty"`
 }
 
 // NewLeakScanner creates a new leak scanner with default gitleaks rules
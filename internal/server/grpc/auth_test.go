@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/auth"
+	"github.com/inovacc/clonr/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// peerContext returns a context of a call from addr carrying an API token
+func peerContext(addr, token string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	return ctx
+}
+
+func TestAuthenticatorAuthorize(t *testing.T) {
+	token, hash, err := auth.NewAPIToken()
+	if err != nil {
+		t.Fatalf("NewAPIToken() error = %v", err)
+	}
+
+	unknownToken, _, _ := auth.NewAPIToken()
+
+	db := &mockStore{apiToken: &model.APIToken{Name: "laptop", Hash: hash, CreatedAt: time.Now()}}
+	a := &authenticator{db: db, require: true}
+
+	listRepos := v1.ClonrService_GetRepos_FullMethodName
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"local client", peerContext("127.0.0.1", ""), listRepos, codes.OK},
+		{"remote without token", peerContext("192.0.2.10", ""), listRepos, codes.Unauthenticated},
+		{"remote with token", peerContext("192.0.2.10", token), listRepos, codes.OK},
+		{"remote with unknown token", peerContext("192.0.2.10", unknownToken), listRepos, codes.Unauthenticated},
+		{"remote pairing", peerContext("192.0.2.10", ""), v1.ClonrService_PairDevice_FullMethodName, codes.OK},
+		{"remote token management", peerContext("192.0.2.10", token), v1.ClonrService_ListAPITokens_FullMethodName, codes.PermissionDenied},
+		{"local token management", peerContext("::1", ""), v1.ClonrService_ListAPITokens_FullMethodName, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(a.authorize(tt.ctx, tt.method)); got != tt.want {
+				t.Errorf("authorize() code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if db.savedAPIToken == nil || db.savedAPIToken.LastUsedAt.IsZero() {
+		t.Error("authorize() did not record the use of the token")
+	}
+}
+
+func TestAuthenticatorExpiredToken(t *testing.T) {
+	token, hash, _ := auth.NewAPIToken()
+
+	db := &mockStore{apiToken: &model.APIToken{Name: "ci", Hash: hash, ExpiresAt: time.Now().Add(-time.Hour)}}
+	a := &authenticator{db: db, require: true}
+
+	err := a.authorize(peerContext("192.0.2.10", token), v1.ClonrService_GetRepos_FullMethodName)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("authorize() error = %v, want Unauthenticated for an expired token", err)
+	}
+}
+
+func TestAuthenticatorOptional(t *testing.T) {
+	a := &authenticator{db: &mockStore{}}
+
+	if err := a.authorize(peerContext("192.0.2.10", ""), v1.ClonrService_GetRepos_FullMethodName); err != nil {
+		t.Errorf("authorize() error = %v without --require-auth", err)
+	}
+}
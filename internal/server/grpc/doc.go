@@ -9,7 +9,7 @@
 // The server is started via [NewServer] which creates a gRPC server with
 // health checking, logging, recovery, and timeout interceptors:
 //
-//	srv := grpcserver.NewServer(db)
+//	srv := grpcserver.NewServer(db, 0, grpcserver.SecurityConfig{})
 //	srv.GRPCServer.Serve(listener)
 //
 // # Server Discovery
@@ -31,8 +31,16 @@
 //
 // # Interceptors
 //
-// The server includes three interceptors:
+// The server includes these interceptors:
+//   - Auth: Requires a client certificate or API token from remote clients
+//     when SecurityConfig.RequireAuth is set; local clients are trusted
 //   - Logging: Logs all RPC calls with method name, status, and duration
 //   - Recovery: Catches panics and converts them to gRPC errors
 //   - Timeout: Enforces a 30-second timeout on all requests
+//
+// # Security
+//
+// The server is plaintext and unauthenticated by default, which is only safe
+// on loopback. SecurityConfig enables TLS, client certificates verified
+// against a CA (mTLS) and bearer API tokens, stored as SHA-256 hashes.
 package grpc
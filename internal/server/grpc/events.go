@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"sync"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// repoEventBuffer is the number of events queued per watcher before dropping
+const repoEventBuffer = 16
+
+// repoEvents fans out repository change events to watching clients.
+// Slow watchers miss events instead of blocking writers.
+type repoEvents struct {
+	mu   sync.Mutex
+	subs map[chan *v1.RepoEvent]struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func newRepoEvents() *repoEvents {
+	return &repoEvents{
+		subs: make(map[chan *v1.RepoEvent]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// subscribe registers a watcher and returns its channel and an unsubscribe func
+func (e *repoEvents) subscribe() (<-chan *v1.RepoEvent, func()) {
+	ch := make(chan *v1.RepoEvent, repoEventBuffer)
+
+	e.mu.Lock()
+	e.subs[ch] = struct{}{}
+	e.mu.Unlock()
+
+	return ch, func() {
+		e.mu.Lock()
+		delete(e.subs, ch)
+		e.mu.Unlock()
+	}
+}
+
+// publish sends an event to all watchers
+func (e *repoEvents) publish(eventType, url string) {
+	event := &v1.RepoEvent{
+		Type: eventType,
+		Url:  url,
+		Time: timestamppb.Now(),
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for ch := range e.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// close ends all watch streams so the server can stop gracefully
+func (e *repoEvents) close() {
+	e.once.Do(func() { close(e.done) })
+}
@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestService_RepoEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(svc *Service) error
+		wantType string
+		wantURL  string
+	}{
+		{
+			name: "save publishes added",
+			call: func(svc *Service) error {
+				_, err := svc.SaveRepo(context.Background(), &v1.SaveRepoRequest{Url: "https://github.com/user/repo", Path: "/tmp/repo"})
+				return err
+			},
+			wantType: model.RepoEventAdded,
+			wantURL:  "https://github.com/user/repo",
+		},
+		{
+			name: "favorite publishes updated",
+			call: func(svc *Service) error {
+				_, err := svc.SetFavoriteByURL(context.Background(), &v1.SetFavoriteRequest{Url: "https://github.com/user/repo", Favorite: true})
+				return err
+			},
+			wantType: model.RepoEventUpdated,
+			wantURL:  "https://github.com/user/repo",
+		},
+		{
+			name: "remove publishes removed",
+			call: func(svc *Service) error {
+				_, err := svc.RemoveRepoByURL(context.Background(), &v1.RemoveRepoByURLRequest{Url: "https://github.com/user/repo"})
+				return err
+			},
+			wantType: model.RepoEventRemoved,
+			wantURL:  "https://github.com/user/repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(&mockStore{})
+
+			events, unsubscribe := svc.events.subscribe()
+			defer unsubscribe()
+
+			if err := tt.call(svc); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+
+			select {
+			case event := <-events:
+				if event.GetType() != tt.wantType || event.GetUrl() != tt.wantURL {
+					t.Errorf("event = {%s %s}, want {%s %s}", event.GetType(), event.GetUrl(), tt.wantType, tt.wantURL)
+				}
+			default:
+				t.Fatal("no event published")
+			}
+		})
+	}
+}
+
+func TestService_RepoEventsNotPublishedOnError(t *testing.T) {
+	svc := NewService(&mockStore{saveRepoWithWorkspaceErr: errors.New("db error")})
+
+	events, unsubscribe := svc.events.subscribe()
+	defer unsubscribe()
+
+	_, _ = svc.SaveRepo(context.Background(), &v1.SaveRepoRequest{Url: "https://github.com/user/repo", Path: "/tmp/repo"})
+
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event %s %s", event.GetType(), event.GetUrl())
+	default:
+	}
+}
+
+func TestRepoEvents_SlowWatcherDoesNotBlock(t *testing.T) {
+	e := newRepoEvents()
+
+	_, unsubscribe := e.subscribe()
+	defer unsubscribe()
+
+	// Publishing more than the buffer must not block
+	for range repoEventBuffer * 2 {
+		e.publish(model.RepoEventUpdated, "https://github.com/user/repo")
+	}
+
+	e.close()
+	e.close()
+
+	select {
+	case <-e.done:
+	default:
+		t.Error("close() did not signal done")
+	}
+}
@@ -58,3 +58,103 @@ func ModelToProtoDockerProfile(profile *model.DockerProfile) *v1.DockerProfile {
 func ProtoToModelDockerProfile(protoProfile *v1.DockerProfile) *model.DockerProfile {
 	return mapper.ProtoToModelDockerProfile(protoProfile)
 }
+
+// ModelToProtoSavedFilter converts a model.SavedFilter to a proto SavedFilter
+func ModelToProtoSavedFilter(filter *model.SavedFilter) *v1.SavedFilter {
+	return mapper.ModelToProtoSavedFilter(filter)
+}
+
+// ProtoToModelSavedFilter converts a proto SavedFilter to a model.SavedFilter
+func ProtoToModelSavedFilter(protoFilter *v1.SavedFilter) *model.SavedFilter {
+	return mapper.ProtoToModelSavedFilter(protoFilter)
+}
+
+// ModelToProtoRepoSnapshot converts a model.RepoSnapshot to a proto RepoSnapshot
+func ModelToProtoRepoSnapshot(snapshot *model.RepoSnapshot) *v1.RepoSnapshot {
+	return mapper.ModelToProtoRepoSnapshot(snapshot)
+}
+
+// ProtoToModelRepoSnapshot converts a proto RepoSnapshot to a model.RepoSnapshot
+func ProtoToModelRepoSnapshot(protoSnapshot *v1.RepoSnapshot) *model.RepoSnapshot {
+	return mapper.ProtoToModelRepoSnapshot(protoSnapshot)
+}
+
+// ModelToProtoWizardDraft converts a model.WizardDraft to a proto WizardDraft
+func ModelToProtoWizardDraft(draft *model.WizardDraft) *v1.WizardDraft {
+	return mapper.ModelToProtoWizardDraft(draft)
+}
+
+// ProtoToModelWizardDraft converts a proto WizardDraft to a model.WizardDraft
+func ProtoToModelWizardDraft(protoDraft *v1.WizardDraft) *model.WizardDraft {
+	return mapper.ProtoToModelWizardDraft(protoDraft)
+}
+
+// ModelToProtoAPIToken converts a model.APIToken to a proto APIToken
+func ModelToProtoAPIToken(token *model.APIToken) *v1.APIToken {
+	return mapper.ModelToProtoAPIToken(token)
+}
+
+// ProtoToModelAPIToken converts a proto APIToken to a model.APIToken
+func ProtoToModelAPIToken(protoToken *v1.APIToken) *model.APIToken {
+	return mapper.ProtoToModelAPIToken(protoToken)
+}
+
+// ModelToProtoVaultSecret converts a model.VaultSecret to a proto VaultSecret
+func ModelToProtoVaultSecret(secret *model.VaultSecret) *v1.VaultSecret {
+	return mapper.ModelToProtoVaultSecret(secret)
+}
+
+// ProtoToModelVaultSecret converts a proto VaultSecret to a model.VaultSecret
+func ProtoToModelVaultSecret(protoSecret *v1.VaultSecret) *model.VaultSecret {
+	return mapper.ProtoToModelVaultSecret(protoSecret)
+}
+
+// ModelToProtoGmailWatch converts a model.GmailWatch to a proto GmailWatch
+func ModelToProtoGmailWatch(watch *model.GmailWatch) *v1.GmailWatch {
+	return mapper.ModelToProtoGmailWatch(watch)
+}
+
+// ProtoToModelGmailWatch converts a proto GmailWatch to a model.GmailWatch
+func ProtoToModelGmailWatch(protoWatch *v1.GmailWatch) *model.GmailWatch {
+	return mapper.ProtoToModelGmailWatch(protoWatch)
+}
+
+// ModelToProtoGitHubRepoID converts a model.GitHubRepoID to a proto GitHubRepoID
+func ModelToProtoGitHubRepoID(entry *model.GitHubRepoID) *v1.GitHubRepoID {
+	return mapper.ModelToProtoGitHubRepoID(entry)
+}
+
+// ModelToProtoDependencyInventory converts a model.DependencyInventory to a proto DependencyInventory
+func ModelToProtoDependencyInventory(inventory *model.DependencyInventory) *v1.DependencyInventory {
+	return mapper.ModelToProtoDependencyInventory(inventory)
+}
+
+// ProtoToModelDependencyInventory converts a proto DependencyInventory to a model.DependencyInventory
+func ProtoToModelDependencyInventory(protoInventory *v1.DependencyInventory) *model.DependencyInventory {
+	return mapper.ProtoToModelDependencyInventory(protoInventory)
+}
+
+// ModelToProtoShareLink converts a model.ShareLink to a proto ShareLink
+func ModelToProtoShareLink(link *model.ShareLink) *v1.ShareLink {
+	return mapper.ModelToProtoShareLink(link)
+}
+
+// ProtoToModelShareLink converts a proto ShareLink to a model.ShareLink
+func ProtoToModelShareLink(protoLink *v1.ShareLink) *model.ShareLink {
+	return mapper.ProtoToModelShareLink(protoLink)
+}
+
+// ModelToProtoJob converts a model.Job to a proto Job
+func ModelToProtoJob(job *model.Job) *v1.Job {
+	return mapper.ModelToProtoJob(job)
+}
+
+// ProtoToModelJob converts a proto Job to a model.Job
+func ProtoToModelJob(protoJob *v1.Job) *model.Job {
+	return mapper.ProtoToModelJob(protoJob)
+}
+
+// ProtoToModelGitHubRepoID converts a proto GitHubRepoID to a model.GitHubRepoID
+func ProtoToModelGitHubRepoID(protoEntry *v1.GitHubRepoID) *model.GitHubRepoID {
+	return mapper.ProtoToModelGitHubRepoID(protoEntry)
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/params"
+	"github.com/inovacc/clonr/internal/standalone"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PairDevice registers a second machine that redeemed a one-time pairing
+// token. The token stands in for typing the client's key on the server, so
+// the client is registered immediately instead of waiting for 'standalone accept'.
+func (s *Service) PairDevice(ctx context.Context, req *v1.PairDeviceRequest) (*v1.PairDeviceResponse, error) {
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "pairing token is required")
+	}
+
+	if req.GetClientId() == "" || req.GetDisplayKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "client ID and key are required")
+	}
+
+	config, err := s.db.GetStandaloneConfig()
+	if err != nil || config == nil || !config.Enabled || !config.IsServer {
+		return nil, status.Error(codes.FailedPrecondition, "standalone server mode is not enabled")
+	}
+
+	reg := &standalone.ClientRegistration{
+		ClientID:   req.GetClientId(),
+		ClientName: req.GetClientName(),
+		MachineInfo: standalone.MachineInfo{
+			Hostname:     req.GetHostname(),
+			OS:           req.GetOs(),
+			Arch:         req.GetArch(),
+			ClonrVersion: req.GetClonrVersion(),
+		},
+	}
+
+	handshake := standalone.NewServerHandshake()
+	if _, err := handshake.InitiateHandshake(reg); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start handshake: %v", err)
+	}
+
+	client, err := handshake.RegisterClient(reg.ClientID, req.GetDisplayKey())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid client key: %v", err)
+	}
+
+	path := filepath.Join(params.AppdataDir, standalone.PairingTokensFile)
+	if err := standalone.ConsumePairingToken(path, req.GetToken()); err != nil {
+		if errors.Is(err, standalone.ErrPairingTokenInvalid) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to redeem pairing token: %v", err)
+	}
+
+	if host := peerHost(ctx); host != "" {
+		client.LastIP = host
+	}
+
+	if err := s.db.SaveRegisteredClient(client); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to register client: %v", err)
+	}
+
+	return &v1.PairDeviceResponse{
+		InstanceId:  config.InstanceID,
+		Fingerprint: standalone.InstanceFingerprint(config),
+		SyncPort:    int32(config.SyncPort()),
+	}, nil
+}
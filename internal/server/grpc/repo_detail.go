@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/mapper"
+	"github.com/inovacc/clonr/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// repoDetailLookupLimit bounds the repositories scanned for the exact URL
+// among those whose URL or path contains it
+const repoDetailLookupLimit = 100
+
+// gitLogDateLayout is the layout of the %ci dates printed by git log
+const gitLogDateLayout = "2006-01-02 15:04:05 -0700"
+
+// GetRepoDetail returns a repository with the state of its clone. The clone
+// is read here because its path is only meaningful on this machine; when it
+// cannot be read the repository is returned with GitError set.
+func (s *Service) GetRepoDetail(ctx context.Context, req *v1.GetRepoDetailRequest) (*v1.GetRepoDetailResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	candidates, _, err := s.db.ListRepos(model.RepoFilter{Query: req.GetUrl()}, 0, repoDetailLookupLimit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get repository: %v", err)
+	}
+
+	var repo *model.Repository
+
+	for i := range candidates {
+		if candidates[i].URL == req.GetUrl() {
+			repo = &candidates[i]
+
+			break
+		}
+	}
+
+	if repo == nil {
+		return nil, status.Errorf(codes.NotFound, "repository %s not found", req.GetUrl())
+	}
+
+	detail := readRepoDetail(ctx, *repo)
+
+	return &v1.GetRepoDetailResponse{Detail: mapper.ModelToProtoRepoDetail(detail)}, nil
+}
+
+// readRepoDetail reads the branch, last commit, latest tag and upstream
+// distance of the clone of repo
+func readRepoDetail(ctx context.Context, repo model.Repository) *model.RepoDetail {
+	detail := &model.RepoDetail{Repository: repo}
+
+	if _, err := os.Stat(repo.Path); err != nil {
+		detail.GitError = fmt.Sprintf("clone not found at %s", repo.Path)
+
+		return detail
+	}
+
+	client := git.NewClientForRepo(repo.Path)
+
+	branch, err := client.CurrentBranch(ctx)
+	if err != nil {
+		detail.GitError = fmt.Sprintf("not a git repository: %s", repo.Path)
+
+		return detail
+	}
+
+	detail.Branch = branch
+
+	// A repository without commits has no log; the other fields stay empty
+	if commits, err := client.Log(ctx, git.LogOptions{Limit: 1}); err == nil && len(commits) > 0 {
+		detail.LastCommit = commits[0].ShortSHA
+		detail.LastCommitSubject = commits[0].Subject
+		detail.LastCommitAuthor = commits[0].Author
+		detail.LastCommitAt, _ = time.Parse(gitLogDateLayout, commits[0].Date)
+	}
+
+	if output, err := client.Command(ctx, "describe", "--tags", "--abbrev=0").Output(); err == nil {
+		detail.LatestTag = strings.TrimSpace(string(output))
+	}
+
+	if output, err := client.Command(ctx, "rev-list", "--left-right", "--count", "HEAD...@{upstream}").Output(); err == nil {
+		if ahead, behind, ok := strings.Cut(strings.TrimSpace(string(output)), "\t"); ok {
+			detail.HasUpstream = true
+			detail.Ahead, _ = strconv.Atoi(ahead)
+			detail.Behind, _ = strconv.Atoi(behind)
+		}
+	}
+
+	return detail
+}
@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+func TestReadRepoDetail(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+		{"commit", "-q", "--allow-empty", "-m", "initial"},
+		{"tag", "v1.0.0"},
+		{"commit", "-q", "--allow-empty", "-m", "second"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput(); err != nil {
+			t.Skipf("git not available: %v - %s", err, out)
+		}
+	}
+
+	repo := model.Repository{URL: "https://github.com/acme/api", Path: dir, Notes: "keep"}
+
+	detail := readRepoDetail(context.Background(), repo)
+
+	if detail.GitError != "" {
+		t.Fatalf("GitError = %q", detail.GitError)
+	}
+
+	if detail.Branch != "main" || detail.LastCommitSubject != "second" || detail.LastCommitAuthor != "Test" {
+		t.Errorf("detail = %+v, want main at the second commit by Test", detail)
+	}
+
+	if detail.LastCommit == "" || detail.LastCommitAt.IsZero() {
+		t.Errorf("LastCommit = %q at %v, want the hash and date", detail.LastCommit, detail.LastCommitAt)
+	}
+
+	if detail.LatestTag != "v1.0.0" || detail.HasUpstream || detail.Repository.Notes != "keep" {
+		t.Errorf("detail = %+v, want tag v1.0.0, no upstream and the notes", detail)
+	}
+
+	repo.Path = filepath.Join(t.TempDir(), "missing")
+	if detail := readRepoDetail(context.Background(), repo); detail.GitError == "" || detail.Branch != "" {
+		t.Errorf("missing clone: detail = %+v, want only GitError", detail)
+	}
+}
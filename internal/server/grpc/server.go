@@ -6,6 +6,7 @@ import (
 	v1 "github.com/inovacc/clonr/internal/api/v1"
 	"github.com/inovacc/clonr/internal/store"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
@@ -16,11 +17,12 @@ type ServerWithHealth struct {
 	GRPCServer   *grpc.Server
 	HealthServer *health.Server
 	IdleTracker  *IdleTracker
+	Service      *Service
 }
 
 // NewServer creates a new gRPC server with all interceptors, health service, and registered services.
 // If idleTimeout is > 0, the server will track activity and signal shutdown after being idle.
-func NewServer(db store.Store, idleTimeout time.Duration) *ServerWithHealth {
+func NewServer(db store.Store, idleTimeout time.Duration, security SecurityConfig) *ServerWithHealth {
 	// Create idle tracker
 	idleTracker := NewIdleTracker(idleTimeout)
 
@@ -38,10 +40,15 @@ func NewServer(db store.Store, idleTimeout time.Duration) *ServerWithHealth {
 		interceptors = append([]grpc.UnaryServerInterceptor{activityInterceptor(idleTracker)}, interceptors...)
 	}
 
+	// Authentication runs first so rejected calls do no work
+	authn := &authenticator{db: db, require: security.RequireAuth}
+	interceptors = append([]grpc.UnaryServerInterceptor{authInterceptor(authn)}, interceptors...)
+
 	// Server options
 	opts := []grpc.ServerOption{
-		// Chain interceptors in order: activity -> recovery -> logging -> timeout
+		// Chain interceptors in order: auth -> activity -> recovery -> logging -> timeout
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(authStreamInterceptor(authn)),
 		// Connection timeout (per guide)
 		grpc.ConnectionTimeout(10 * time.Second),
 		// Keepalive settings
@@ -55,6 +62,11 @@ func NewServer(db store.Store, idleTimeout time.Duration) *ServerWithHealth {
 		grpc.MaxSendMsgSize(4 * 1024 * 1024),
 	}
 
+	// Serve over TLS (and verify client certificates) when configured
+	if security.TLS != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(security.TLS)))
+	}
+
 	// Create gRPC server
 	srv := grpc.NewServer(opts...)
 
@@ -71,5 +83,6 @@ func NewServer(db store.Store, idleTimeout time.Duration) *ServerWithHealth {
 		GRPCServer:   srv,
 		HealthServer: healthServer,
 		IdleTracker:  idleTracker,
+		Service:      svc,
 	}
 }
@@ -20,10 +20,18 @@ var (
 
 // ServerInfo contains information about a running server
 type ServerInfo struct {
-	Address   string    `json:"address"`
-	Port      int       `json:"port"`
-	PID       int       `json:"pid"`
-	StartedAt time.Time `json:"started_at"`
+	Address    string    `json:"address"`
+	Port       int       `json:"port"`
+	PID        int       `json:"pid"`
+	StartedAt  time.Time `json:"started_at"`
+	WebAddress string    `json:"web_address,omitempty"`
+	// TLSCertFile is the server certificate, so local clients can verify it
+	TLSCertFile string `json:"tls_cert_file,omitempty"`
+	// Socket is the unix socket the server also listens on
+	Socket string `json:"socket,omitempty"`
+	// Flags are the options the server was started with, reused by
+	// 'clonr server restart'
+	Flags []string `json:"flags,omitempty"`
 }
 
 // getServerInfoPath returns the path to the server.json file
@@ -131,6 +139,55 @@ func WriteServerInfo(port int) error {
 	return nil
 }
 
+// SetServerWebAddress records the base URL of the running web server in the
+// server info file, so commands like 'clonr share' can build links to it
+func SetServerWebAddress(address string) error {
+	return updateServerInfo(func(info *ServerInfo) { info.WebAddress = address })
+}
+
+// SetServerTLSCert records the certificate of a server serving TLS in the
+// server info file, so local clients trust it without configuration
+func SetServerTLSCert(certFile string) error {
+	abs, err := filepath.Abs(certFile)
+	if err != nil {
+		return fmt.Errorf("failed to resolve certificate path: %w", err)
+	}
+
+	return updateServerInfo(func(info *ServerInfo) { info.TLSCertFile = abs })
+}
+
+// SetServerFlags records the options the server was started with in the
+// server info file
+func SetServerFlags(flags []string) error {
+	return updateServerInfo(func(info *ServerInfo) { info.Flags = flags })
+}
+
+// updateServerInfo rewrites the server info file with the change applied
+func updateServerInfo(change func(*ServerInfo)) error {
+	info, err := ReadServerInfo()
+	if err != nil {
+		return err
+	}
+
+	change(info)
+
+	data, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal server info: %w", err)
+	}
+
+	path, err := getServerInfoPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write server info file: %w", err)
+	}
+
+	return nil
+}
+
 // RemoveServerInfo removes the server info file (called when the server stops)
 func RemoveServerInfo() {
 	dataDir, err := os.UserCacheDir()
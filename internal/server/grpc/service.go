@@ -2,9 +2,16 @@ package grpc
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 
 	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/store"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,12 +21,16 @@ import (
 type Service struct {
 	v1.UnimplementedClonrServiceServer
 
-	db store.Store
+	db     store.Store
+	events *repoEvents
+
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
 }
 
 // NewService creates a new gRPC service instance
 func NewService(db store.Store) *Service {
-	return &Service{db: db}
+	return &Service{db: db, events: newRepoEvents(), shutdown: make(chan struct{})}
 }
 
 // Ping verifies database connectivity
@@ -31,6 +42,19 @@ func (s *Service) Ping(ctx context.Context, req *v1.Empty) (*v1.Empty, error) {
 	return &v1.Empty{}, nil
 }
 
+// Shutdown asks the server to drain in-flight requests and stop. It returns
+// before the server stops, so the reply reaches the caller.
+func (s *Service) Shutdown(_ context.Context, _ *v1.Empty) (*v1.Empty, error) {
+	s.shutdownOnce.Do(func() { close(s.shutdown) })
+
+	return &v1.Empty{}, nil
+}
+
+// ShutdownRequested is closed when a client requested a shutdown
+func (s *Service) ShutdownRequested() <-chan struct{} {
+	return s.shutdown
+}
+
 // SaveRepo saves a repository to the database
 func (s *Service) SaveRepo(_ context.Context, req *v1.SaveRepoRequest) (*v1.SaveRepoResponse, error) {
 	if req.GetUrl() == "" {
@@ -50,6 +74,8 @@ func (s *Service) SaveRepo(_ context.Context, req *v1.SaveRepoRequest) (*v1.Save
 		return nil, status.Errorf(codes.Internal, "failed to save repository: %v", err)
 	}
 
+	s.events.publish(model.RepoEventAdded, u.String())
+
 	return &v1.SaveRepoResponse{Success: true}, nil
 }
 
@@ -108,6 +134,8 @@ func (s *Service) InsertRepoIfNotExists(_ context.Context, req *v1.InsertRepoIfN
 		return nil, status.Errorf(codes.Internal, "failed to insert repository: %v", err)
 	}
 
+	s.events.publish(model.RepoEventAdded, req.GetUrl())
+
 	return &v1.InsertRepoIfNotExistsResponse{Inserted: true}, nil
 }
 
@@ -141,6 +169,89 @@ func (s *Service) GetRepos(_ context.Context, req *v1.GetReposRequest) (*v1.GetR
 	return &v1.GetReposResponse{Repositories: protoRepos}, nil
 }
 
+// Page sizes for ListRepos
+const (
+	defaultRepoPageSize = 100
+	maxRepoPageSize     = 1000
+)
+
+// ListRepos returns one page of repositories matching the request filter.
+// Page tokens are opaque offsets valid for the same filter.
+func (s *Service) ListRepos(_ context.Context, req *v1.ListReposRequest) (*v1.ListReposResponse, error) {
+	pageSize := int(req.GetPageSize())
+
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultRepoPageSize
+	case pageSize > maxRepoPageSize:
+		pageSize = maxRepoPageSize
+	}
+
+	offset, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_token: %v", err)
+	}
+
+	filter := model.RepoFilter{
+		Workspace:     req.GetWorkspace(),
+		FavoritesOnly: req.GetFavoritesOnly(),
+		Query:         req.GetQuery(),
+		Kind:          model.RepoKind(req.GetKind()),
+	}
+
+	repos, total, err := s.db.ListRepos(filter, offset, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list repositories: %v", err)
+	}
+
+	protoRepos := make([]*v1.Repository, len(repos))
+	for i, repo := range repos {
+		protoRepos[i] = ModelToProtoRepository(&repo)
+	}
+
+	resp := &v1.ListReposResponse{
+		Repositories: protoRepos,
+		TotalSize:    int32(total),
+	}
+
+	if next := offset + len(repos); len(repos) > 0 && next < total {
+		resp.NextPageToken = encodePageToken(next)
+	}
+
+	return resp, nil
+}
+
+// encodePageToken encodes a list offset as an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("offset:" + strconv.Itoa(offset)))
+}
+
+// decodePageToken returns the list offset for a page token (0 for the first page)
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := strings.CutPrefix(string(raw), "offset:")
+	if !ok {
+		return 0, errors.New("malformed token")
+	}
+
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, errors.New("malformed token")
+	}
+
+	return offset, nil
+}
+
 // SetFavoriteByURL marks or unmarks a repository as favorite
 func (s *Service) SetFavoriteByURL(_ context.Context, req *v1.SetFavoriteRequest) (*v1.SetFavoriteResponse, error) {
 	if req.GetUrl() == "" {
@@ -156,9 +267,186 @@ func (s *Service) SetFavoriteByURL(_ context.Context, req *v1.SetFavoriteRequest
 		return nil, status.Errorf(codes.Internal, "failed to set favorite: %v", err)
 	}
 
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
 	return &v1.SetFavoriteResponse{Success: true}, nil
 }
 
+// SetRepoKind records the classification of a repository
+func (s *Service) SetRepoKind(_ context.Context, req *v1.SetRepoKindRequest) (*v1.SetRepoKindResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	kind := model.RepoKind(req.GetKind())
+	if kind != "" {
+		if _, err := model.ParseRepoKind(req.GetKind()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := s.db.SetRepoKind(req.GetUrl(), kind); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set kind: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoKindResponse{Success: true}, nil
+}
+
+// SetRepoUpstream records the repository a fork was created from
+func (s *Service) SetRepoUpstream(_ context.Context, req *v1.SetRepoUpstreamRequest) (*v1.SetRepoUpstreamResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if err := s.db.SetRepoUpstream(req.GetUrl(), req.GetUpstreamUrl()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set upstream: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoUpstreamResponse{Success: true}, nil
+}
+
+// SetRepoLicense records the detected license of a repository
+func (s *Service) SetRepoLicense(_ context.Context, req *v1.SetRepoLicenseRequest) (*v1.SetRepoLicenseResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if err := s.db.SetRepoLicense(req.GetUrl(), req.GetLicense()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set license: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoLicenseResponse{Success: true}, nil
+}
+
+// SetRepoTags replaces the tags of a repository
+func (s *Service) SetRepoTags(_ context.Context, req *v1.SetRepoTagsRequest) (*v1.SetRepoTagsResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if err := s.db.SetRepoTags(req.GetUrl(), req.GetTags()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set tags: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoTagsResponse{Success: true}, nil
+}
+
+// SetRepoNotes replaces the notes of a repository
+func (s *Service) SetRepoNotes(_ context.Context, req *v1.SetRepoNotesRequest) (*v1.SetRepoNotesResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if err := s.db.SetRepoNotes(req.GetUrl(), req.GetNotes()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set notes: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoNotesResponse{Success: true}, nil
+}
+
+// SetRepoAlias sets or removes the alias of a repository
+func (s *Service) SetRepoAlias(_ context.Context, req *v1.SetRepoAliasRequest) (*v1.SetRepoAliasResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	alias := req.GetAlias()
+
+	if alias != "" {
+		parsed, err := model.ParseRepoAlias(alias)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		alias = parsed
+
+		repos, err := s.db.GetAllRepos()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get repositories: %v", err)
+		}
+
+		if owner := repoWithAlias(repos, alias); owner != nil && owner.URL != git.CanonicalURL(req.GetUrl()) {
+			return nil, status.Errorf(codes.AlreadyExists, "alias %q is used by %s", alias, owner.URL)
+		}
+	}
+
+	if err := s.db.SetRepoAlias(req.GetUrl(), alias); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set alias: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoAliasResponse{Success: true}, nil
+}
+
+// RecordRepoAccess counts an access to a repository and records its time
+func (s *Service) RecordRepoAccess(_ context.Context, req *v1.RecordRepoAccessRequest) (*v1.RecordRepoAccessResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if err := s.db.RecordRepoAccess(req.GetUrl()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record access: %v", err)
+	}
+
+	return &v1.RecordRepoAccessResponse{Success: true}, nil
+}
+
+// repoWithAlias returns the repository of repos having alias, or nil
+func repoWithAlias(repos []model.Repository, alias string) *model.Repository {
+	for i := range repos {
+		if repos[i].Alias == alias {
+			return &repos[i]
+		}
+	}
+
+	return nil
+}
+
+// SetRepoRemotes replaces the additional remotes recorded for a repository
+func (s *Service) SetRepoRemotes(_ context.Context, req *v1.SetRepoRemotesRequest) (*v1.SetRepoRemotesResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	remotes := make([]model.RepoRemote, len(req.GetRemotes()))
+	for i, r := range req.GetRemotes() {
+		remotes[i] = model.RepoRemote{Name: r.GetName(), URL: r.GetUrl()}
+	}
+
+	if err := s.db.SetRepoRemotes(req.GetUrl(), remotes); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set remotes: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.SetRepoRemotesResponse{Success: true}, nil
+}
+
+// GetRepoByRemoteURL finds the repository having a remote with the given URL
+func (s *Service) GetRepoByRemoteURL(_ context.Context, req *v1.GetRepoByRemoteURLRequest) (*v1.GetRepoByRemoteURLResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	repo, err := s.db.GetRepoByRemoteURL(req.GetUrl())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to look up remote: %v", err)
+	}
+
+	return &v1.GetRepoByRemoteURLResponse{Repository: ModelToProtoRepository(repo)}, nil
+}
+
 // UpdateRepoTimestamp updates the timestamp for a repository
 func (s *Service) UpdateRepoTimestamp(_ context.Context, req *v1.UpdateRepoTimestampRequest) (*v1.UpdateRepoTimestampResponse, error) {
 	if req.GetUrl() == "" {
@@ -169,6 +457,8 @@ func (s *Service) UpdateRepoTimestamp(_ context.Context, req *v1.UpdateRepoTimes
 		return nil, status.Errorf(codes.Internal, "failed to update timestamp: %v", err)
 	}
 
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
 	return &v1.UpdateRepoTimestampResponse{Success: true}, nil
 }
 
@@ -187,9 +477,86 @@ func (s *Service) RemoveRepoByURL(_ context.Context, req *v1.RemoveRepoByURLRequ
 		return nil, status.Errorf(codes.Internal, "failed to remove repository: %v", err)
 	}
 
+	s.events.publish(model.RepoEventRemoved, u.String())
+
 	return &v1.RemoveRepoByURLResponse{Success: true}, nil
 }
 
+// UpdateRepoURL changes the URL of a tracked repository
+func (s *Service) UpdateRepoURL(_ context.Context, req *v1.UpdateRepoURLRequest) (*v1.UpdateRepoURLResponse, error) {
+	if req.GetOldUrl() == "" || req.GetNewUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "old and new URL are required")
+	}
+
+	if _, err := url.Parse(req.GetNewUrl()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid URL: %v", err)
+	}
+
+	if err := s.db.UpdateRepoURL(req.GetOldUrl(), req.GetNewUrl()); err != nil {
+		if err.Error() == "repository not found" {
+			return nil, status.Error(codes.NotFound, "repository not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update repository URL: %v", err)
+	}
+
+	s.events.publish(model.RepoEventRemoved, req.GetOldUrl())
+	s.events.publish(model.RepoEventAdded, req.GetNewUrl())
+
+	return &v1.UpdateRepoURLResponse{Success: true}, nil
+}
+
+// UpdateRepoPath updates the local path of a tracked repository
+func (s *Service) UpdateRepoPath(_ context.Context, req *v1.UpdateRepoPathRequest) (*v1.UpdateRepoPathResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	if req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "path is required")
+	}
+
+	if _, err := url.Parse(req.GetUrl()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid URL: %v", err)
+	}
+
+	if err := s.db.UpdateRepoPath(req.GetUrl(), req.GetPath()); err != nil {
+		if err.Error() == "repository not found" {
+			return nil, status.Error(codes.NotFound, "repository not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update repository path: %v", err)
+	}
+
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
+	return &v1.UpdateRepoPathResponse{Success: true}, nil
+}
+
+// WatchRepoEvents streams repository changes until the client disconnects
+func (s *Service) WatchRepoEvents(_ *v1.WatchRepoEventsRequest, stream v1.ClonrService_WatchRepoEventsServer) error {
+	events, unsubscribe := s.events.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.events.done:
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StopWatchers ends all open WatchRepoEvents streams
+func (s *Service) StopWatchers() {
+	s.events.close()
+}
+
 // GetConfig retrieves the application configuration
 func (s *Service) GetConfig(_ context.Context, _ *v1.GetConfigRequest) (*v1.GetConfigResponse, error) {
 	cfg, err := s.db.GetConfig()
@@ -397,6 +764,507 @@ func (s *Service) DockerProfileExists(_ context.Context, req *v1.DockerProfileEx
 	return &v1.DockerProfileExistsResponse{Exists: exists}, nil
 }
 
+// SaveFilter saves or updates a saved repository filter
+func (s *Service) SaveFilter(_ context.Context, req *v1.SaveFilterRequest) (*v1.SaveFilterResponse, error) {
+	if req.GetFilter() == nil {
+		return nil, status.Error(codes.InvalidArgument, "filter is required")
+	}
+
+	if req.GetFilter().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "filter name is required")
+	}
+
+	filter := ProtoToModelSavedFilter(req.GetFilter())
+	if err := s.db.SaveFilter(filter); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save filter: %v", err)
+	}
+
+	return &v1.SaveFilterResponse{Success: true}, nil
+}
+
+// GetFilter retrieves a saved repository filter by name
+func (s *Service) GetFilter(_ context.Context, req *v1.GetFilterRequest) (*v1.GetFilterResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	filter, err := s.db.GetFilter(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get filter: %v", err)
+	}
+
+	if filter == nil {
+		return nil, status.Error(codes.NotFound, "filter not found")
+	}
+
+	return &v1.GetFilterResponse{Filter: ModelToProtoSavedFilter(filter)}, nil
+}
+
+// ListFilters retrieves all saved repository filters
+func (s *Service) ListFilters(_ context.Context, _ *v1.ListFiltersRequest) (*v1.ListFiltersResponse, error) {
+	filters, err := s.db.ListFilters()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list filters: %v", err)
+	}
+
+	protoFilters := make([]*v1.SavedFilter, len(filters))
+	for i, filter := range filters {
+		protoFilters[i] = ModelToProtoSavedFilter(&filter)
+	}
+
+	return &v1.ListFiltersResponse{Filters: protoFilters}, nil
+}
+
+// DeleteFilter removes a saved repository filter by name
+func (s *Service) DeleteFilter(_ context.Context, req *v1.DeleteFilterRequest) (*v1.DeleteFilterResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if err := s.db.DeleteFilter(req.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete filter: %v", err)
+	}
+
+	return &v1.DeleteFilterResponse{Success: true}, nil
+}
+
+// SaveRepoSnapshot saves a repository snapshot
+func (s *Service) SaveRepoSnapshot(_ context.Context, req *v1.SaveRepoSnapshotRequest) (*v1.SaveRepoSnapshotResponse, error) {
+	if req.GetSnapshot() == nil {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+
+	if req.GetSnapshot().GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot ID is required")
+	}
+
+	if req.GetSnapshot().GetRepoUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository URL is required")
+	}
+
+	snapshot := ProtoToModelRepoSnapshot(req.GetSnapshot())
+	if err := s.db.SaveRepoSnapshot(snapshot); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save snapshot: %v", err)
+	}
+
+	return &v1.SaveRepoSnapshotResponse{Success: true}, nil
+}
+
+// GetRepoSnapshot retrieves a repository snapshot by ID
+func (s *Service) GetRepoSnapshot(_ context.Context, req *v1.GetRepoSnapshotRequest) (*v1.GetRepoSnapshotResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	snapshot, err := s.db.GetRepoSnapshot(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get snapshot: %v", err)
+	}
+
+	if snapshot == nil {
+		return nil, status.Error(codes.NotFound, "snapshot not found")
+	}
+
+	return &v1.GetRepoSnapshotResponse{Snapshot: ModelToProtoRepoSnapshot(snapshot)}, nil
+}
+
+// ListRepoSnapshots retrieves the snapshots of a repository, or of all repositories
+func (s *Service) ListRepoSnapshots(_ context.Context, req *v1.ListRepoSnapshotsRequest) (*v1.ListRepoSnapshotsResponse, error) {
+	snapshots, err := s.db.ListRepoSnapshots(req.GetRepoUrl())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list snapshots: %v", err)
+	}
+
+	protoSnapshots := make([]*v1.RepoSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		protoSnapshots[i] = ModelToProtoRepoSnapshot(&snapshot)
+	}
+
+	return &v1.ListRepoSnapshotsResponse{Snapshots: protoSnapshots}, nil
+}
+
+// DeleteRepoSnapshot removes a repository snapshot by ID
+func (s *Service) DeleteRepoSnapshot(_ context.Context, req *v1.DeleteRepoSnapshotRequest) (*v1.DeleteRepoSnapshotResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	if err := s.db.DeleteRepoSnapshot(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete snapshot: %v", err)
+	}
+
+	return &v1.DeleteRepoSnapshotResponse{Success: true}, nil
+}
+
+// SaveWizardDraft saves or replaces the draft state of an interactive flow
+func (s *Service) SaveWizardDraft(_ context.Context, req *v1.SaveWizardDraftRequest) (*v1.SaveWizardDraftResponse, error) {
+	if req.GetDraft() == nil {
+		return nil, status.Error(codes.InvalidArgument, "draft is required")
+	}
+
+	if req.GetDraft().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "draft name is required")
+	}
+
+	if err := s.db.SaveWizardDraft(ProtoToModelWizardDraft(req.GetDraft())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save draft: %v", err)
+	}
+
+	return &v1.SaveWizardDraftResponse{Success: true}, nil
+}
+
+// GetWizardDraft retrieves the draft state of an interactive flow. A missing
+// draft is not an error; the response has no draft.
+func (s *Service) GetWizardDraft(_ context.Context, req *v1.GetWizardDraftRequest) (*v1.GetWizardDraftResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	draft, err := s.db.GetWizardDraft(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get draft: %v", err)
+	}
+
+	return &v1.GetWizardDraftResponse{Draft: ModelToProtoWizardDraft(draft)}, nil
+}
+
+// DeleteWizardDraft removes the draft state of an interactive flow
+func (s *Service) DeleteWizardDraft(_ context.Context, req *v1.DeleteWizardDraftRequest) (*v1.DeleteWizardDraftResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if err := s.db.DeleteWizardDraft(req.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete draft: %v", err)
+	}
+
+	return &v1.DeleteWizardDraftResponse{Success: true}, nil
+}
+
+// SaveAPIToken saves or replaces an API token. Only its hash is received.
+func (s *Service) SaveAPIToken(_ context.Context, req *v1.SaveAPITokenRequest) (*v1.SaveAPITokenResponse, error) {
+	if req.GetToken() == nil {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if req.GetToken().GetName() == "" || req.GetToken().GetHash() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token name and hash are required")
+	}
+
+	if err := s.db.SaveAPIToken(ProtoToModelAPIToken(req.GetToken())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save token: %v", err)
+	}
+
+	return &v1.SaveAPITokenResponse{Success: true}, nil
+}
+
+// GetAPITokenByHash retrieves the API token with a hash. A missing token is
+// not an error; the response has no token.
+func (s *Service) GetAPITokenByHash(_ context.Context, req *v1.GetAPITokenByHashRequest) (*v1.GetAPITokenByHashResponse, error) {
+	if req.GetHash() == "" {
+		return nil, status.Error(codes.InvalidArgument, "hash is required")
+	}
+
+	token, err := s.db.GetAPITokenByHash(req.GetHash())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get token: %v", err)
+	}
+
+	return &v1.GetAPITokenByHashResponse{Token: ModelToProtoAPIToken(token)}, nil
+}
+
+// ListAPITokens returns all API tokens
+func (s *Service) ListAPITokens(_ context.Context, _ *v1.ListAPITokensRequest) (*v1.ListAPITokensResponse, error) {
+	tokens, err := s.db.ListAPITokens()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tokens: %v", err)
+	}
+
+	protoTokens := make([]*v1.APIToken, 0, len(tokens))
+	for i := range tokens {
+		protoTokens = append(protoTokens, ModelToProtoAPIToken(&tokens[i]))
+	}
+
+	return &v1.ListAPITokensResponse{Tokens: protoTokens}, nil
+}
+
+// DeleteAPIToken revokes an API token by name
+func (s *Service) DeleteAPIToken(_ context.Context, req *v1.DeleteAPITokenRequest) (*v1.DeleteAPITokenResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if err := s.db.DeleteAPIToken(req.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete token: %v", err)
+	}
+
+	return &v1.DeleteAPITokenResponse{Success: true}, nil
+}
+
+// SaveVaultSecret saves or replaces a vault secret. The value is sealed by
+// the client.
+func (s *Service) SaveVaultSecret(_ context.Context, req *v1.SaveVaultSecretRequest) (*v1.SaveVaultSecretResponse, error) {
+	if req.GetSecret().GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "secret key is required")
+	}
+
+	if err := s.db.SaveVaultSecret(ProtoToModelVaultSecret(req.GetSecret())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save secret: %v", err)
+	}
+
+	return &v1.SaveVaultSecretResponse{Success: true}, nil
+}
+
+// GetVaultSecret retrieves a vault secret by key. A missing secret is not
+// an error; the response has no secret.
+func (s *Service) GetVaultSecret(_ context.Context, req *v1.GetVaultSecretRequest) (*v1.GetVaultSecretResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	secret, err := s.db.GetVaultSecret(req.GetKey())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get secret: %v", err)
+	}
+
+	return &v1.GetVaultSecretResponse{Secret: ModelToProtoVaultSecret(secret)}, nil
+}
+
+// ListVaultSecrets returns all vault secrets
+func (s *Service) ListVaultSecrets(_ context.Context, _ *v1.ListVaultSecretsRequest) (*v1.ListVaultSecretsResponse, error) {
+	secrets, err := s.db.ListVaultSecrets()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list secrets: %v", err)
+	}
+
+	protoSecrets := make([]*v1.VaultSecret, 0, len(secrets))
+	for i := range secrets {
+		protoSecrets = append(protoSecrets, ModelToProtoVaultSecret(&secrets[i]))
+	}
+
+	return &v1.ListVaultSecretsResponse{Secrets: protoSecrets}, nil
+}
+
+// DeleteVaultSecret removes a vault secret by key
+func (s *Service) DeleteVaultSecret(_ context.Context, req *v1.DeleteVaultSecretRequest) (*v1.DeleteVaultSecretResponse, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key is required")
+	}
+
+	if err := s.db.DeleteVaultSecret(req.GetKey()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete secret: %v", err)
+	}
+
+	return &v1.DeleteVaultSecretResponse{Success: true}, nil
+}
+
+// SaveGmailWatch saves or replaces a Gmail watch
+func (s *Service) SaveGmailWatch(_ context.Context, req *v1.SaveGmailWatchRequest) (*v1.SaveGmailWatchResponse, error) {
+	if req.GetWatch().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "watch name is required")
+	}
+
+	if err := s.db.SaveGmailWatch(ProtoToModelGmailWatch(req.GetWatch())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save Gmail watch: %v", err)
+	}
+
+	return &v1.SaveGmailWatchResponse{Success: true}, nil
+}
+
+// GetGmailWatch retrieves a Gmail watch by name. A missing watch is not an
+// error; the response has no watch.
+func (s *Service) GetGmailWatch(_ context.Context, req *v1.GetGmailWatchRequest) (*v1.GetGmailWatchResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	watch, err := s.db.GetGmailWatch(req.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get Gmail watch: %v", err)
+	}
+
+	return &v1.GetGmailWatchResponse{Watch: ModelToProtoGmailWatch(watch)}, nil
+}
+
+// ListGmailWatches returns all Gmail watches
+func (s *Service) ListGmailWatches(_ context.Context, _ *v1.ListGmailWatchesRequest) (*v1.ListGmailWatchesResponse, error) {
+	watches, err := s.db.ListGmailWatches()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list Gmail watches: %v", err)
+	}
+
+	protoWatches := make([]*v1.GmailWatch, 0, len(watches))
+	for i := range watches {
+		protoWatches = append(protoWatches, ModelToProtoGmailWatch(&watches[i]))
+	}
+
+	return &v1.ListGmailWatchesResponse{Watches: protoWatches}, nil
+}
+
+// DeleteGmailWatch removes a Gmail watch by name
+func (s *Service) DeleteGmailWatch(_ context.Context, req *v1.DeleteGmailWatchRequest) (*v1.DeleteGmailWatchResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if err := s.db.DeleteGmailWatch(req.GetName()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete Gmail watch: %v", err)
+	}
+
+	return &v1.DeleteGmailWatchResponse{Success: true}, nil
+}
+
+// SaveGitHubRepoID caches a GitHub repository ID
+func (s *Service) SaveGitHubRepoID(_ context.Context, req *v1.SaveGitHubRepoIDRequest) (*v1.SaveGitHubRepoIDResponse, error) {
+	if req.GetEntry().GetFullName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository name is required")
+	}
+
+	if err := s.db.SaveGitHubRepoID(ProtoToModelGitHubRepoID(req.GetEntry())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save GitHub repository ID: %v", err)
+	}
+
+	return &v1.SaveGitHubRepoIDResponse{Success: true}, nil
+}
+
+// GetGitHubRepoID retrieves a cached GitHub repository ID. A repository that
+// is not cached is not an error; the response has no entry.
+func (s *Service) GetGitHubRepoID(_ context.Context, req *v1.GetGitHubRepoIDRequest) (*v1.GetGitHubRepoIDResponse, error) {
+	if req.GetFullName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository name is required")
+	}
+
+	entry, err := s.db.GetGitHubRepoID(req.GetFullName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get GitHub repository ID: %v", err)
+	}
+
+	return &v1.GetGitHubRepoIDResponse{Entry: ModelToProtoGitHubRepoID(entry)}, nil
+}
+
+// SaveDependencyInventory records the dependencies of a repository
+func (s *Service) SaveDependencyInventory(_ context.Context, req *v1.SaveDependencyInventoryRequest) (*v1.SaveDependencyInventoryResponse, error) {
+	if req.GetInventory().GetRepoUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository URL is required")
+	}
+
+	if err := s.db.SaveDependencyInventory(ProtoToModelDependencyInventory(req.GetInventory())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save dependency inventory: %v", err)
+	}
+
+	return &v1.SaveDependencyInventoryResponse{Success: true}, nil
+}
+
+// ListDependencyInventories retrieves the dependency inventories of a
+// repository, newest first
+func (s *Service) ListDependencyInventories(_ context.Context, req *v1.ListDependencyInventoriesRequest) (*v1.ListDependencyInventoriesResponse, error) {
+	if req.GetRepoUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository URL is required")
+	}
+
+	inventories, err := s.db.ListDependencyInventories(req.GetRepoUrl(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list dependency inventories: %v", err)
+	}
+
+	protoInventories := make([]*v1.DependencyInventory, len(inventories))
+	for i, inventory := range inventories {
+		protoInventories[i] = ModelToProtoDependencyInventory(&inventory)
+	}
+
+	return &v1.ListDependencyInventoriesResponse{Inventories: protoInventories}, nil
+}
+
+// SaveShareLink records a share link
+func (s *Service) SaveShareLink(_ context.Context, req *v1.SaveShareLinkRequest) (*v1.SaveShareLinkResponse, error) {
+	if req.GetLink().GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "share link ID is required")
+	}
+
+	if err := s.db.SaveShareLink(ProtoToModelShareLink(req.GetLink())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save share link: %v", err)
+	}
+
+	return &v1.SaveShareLinkResponse{Success: true}, nil
+}
+
+// GetShareLink retrieves a share link by ID
+func (s *Service) GetShareLink(_ context.Context, req *v1.GetShareLinkRequest) (*v1.GetShareLinkResponse, error) {
+	link, err := s.db.GetShareLink(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get share link: %v", err)
+	}
+
+	return &v1.GetShareLinkResponse{Link: ModelToProtoShareLink(link)}, nil
+}
+
+// ConsumeShareLink removes a share link and returns it, so it is handed
+// out at most once
+func (s *Service) ConsumeShareLink(_ context.Context, req *v1.ConsumeShareLinkRequest) (*v1.ConsumeShareLinkResponse, error) {
+	link, err := s.db.ConsumeShareLink(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to consume share link: %v", err)
+	}
+
+	return &v1.ConsumeShareLinkResponse{Link: ModelToProtoShareLink(link)}, nil
+}
+
+// SaveJob records the progress of a job
+func (s *Service) SaveJob(_ context.Context, req *v1.SaveJobRequest) (*v1.SaveJobResponse, error) {
+	if req.GetJob().GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job ID is required")
+	}
+
+	if err := s.db.SaveJob(ProtoToModelJob(req.GetJob())); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save job: %v", err)
+	}
+
+	return &v1.SaveJobResponse{Success: true}, nil
+}
+
+// GetJob retrieves a job by ID
+func (s *Service) GetJob(_ context.Context, req *v1.GetJobRequest) (*v1.GetJobResponse, error) {
+	job, err := s.db.GetJob(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+
+	return &v1.GetJobResponse{Job: ModelToProtoJob(job)}, nil
+}
+
+// ListJobs returns the newest jobs first
+func (s *Service) ListJobs(_ context.Context, req *v1.ListJobsRequest) (*v1.ListJobsResponse, error) {
+	jobs, err := s.db.ListJobs(int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list jobs: %v", err)
+	}
+
+	protoJobs := make([]*v1.Job, len(jobs))
+	for i, job := range jobs {
+		protoJobs[i] = ModelToProtoJob(&job)
+	}
+
+	return &v1.ListJobsResponse{Jobs: protoJobs}, nil
+}
+
+// CancelJob asks a job to stop
+func (s *Service) CancelJob(_ context.Context, req *v1.CancelJobRequest) (*v1.CancelJobResponse, error) {
+	job, err := s.db.GetJob(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+
+	if job == nil {
+		return nil, status.Error(codes.NotFound, "job not found")
+	}
+
+	if err := s.db.CancelJob(req.GetId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel job: %v", err)
+	}
+
+	return &v1.CancelJobResponse{Success: true}, nil
+}
+
 // SaveWorkspace saves or updates a workspace
 func (s *Service) SaveWorkspace(_ context.Context, req *v1.SaveWorkspaceRequest) (*v1.SaveWorkspaceResponse, error) {
 	if req.GetWorkspace() == nil {
@@ -549,5 +1417,7 @@ func (s *Service) UpdateRepoWorkspace(_ context.Context, req *v1.UpdateRepoWorks
 		return nil, status.Errorf(codes.Internal, "failed to update repository workspace: %v", err)
 	}
 
+	s.events.publish(model.RepoEventUpdated, req.GetUrl())
+
 	return &v1.UpdateRepoWorkspaceResponse{Success: true}, nil
 }
@@ -3,7 +3,9 @@ package grpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/url"
+	"slices"
 	"testing"
 
 	v1 "github.com/inovacc/clonr/internal/api/v1"
@@ -30,10 +32,15 @@ type mockStore struct {
 	setFavoriteErr      error
 	updateTimestampErr  error
 	removeRepoErr       error
+	updateRepoPathErr   error
 	getConfigResult     *model.Config
 	getConfigErr        error
 	saveConfigErr       error
 
+	// API token fields
+	apiToken      *model.APIToken
+	savedAPIToken *model.APIToken
+
 	// Profile fields
 	saveProfileErr      error
 	getProfileResult    *model.Profile
@@ -63,6 +70,12 @@ type mockStore struct {
 	getReposByWorkspaceErr   error
 	updateRepoWorkspaceErr   error
 	saveRepoWithWorkspaceErr error
+
+	// Saved filter fields
+	getFilterResult   *model.SavedFilter
+	listFiltersResult []model.SavedFilter
+	saveFilterErr     error
+	deleteFilterErr   error
 }
 
 func (m *mockStore) Ping() error {
@@ -93,10 +106,65 @@ func (m *mockStore) GetRepos(_ string, _ bool) ([]model.Repository, error) {
 	return m.getReposResult, m.getReposErr
 }
 
+func (m *mockStore) ListRepos(filter model.RepoFilter, offset, limit int) ([]model.Repository, int, error) {
+	if m.getReposErr != nil {
+		return nil, 0, m.getReposErr
+	}
+
+	var matched []model.Repository
+
+	for _, r := range m.getReposResult {
+		if filter.Matches(r) {
+			matched = append(matched, r)
+		}
+	}
+
+	start := min(offset, len(matched))
+	end := min(start+limit, len(matched))
+
+	return matched[start:end], len(matched), nil
+}
+
 func (m *mockStore) SetFavoriteByURL(_ string, _ bool) error {
 	return m.setFavoriteErr
 }
 
+func (m *mockStore) SetRepoKind(_ string, _ model.RepoKind) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoUpstream(_, _ string) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoLicense(_, _ string) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoTags(_ string, _ []string) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoNotes(_, _ string) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoAlias(_, _ string) error {
+	return nil
+}
+
+func (m *mockStore) RecordRepoAccess(_ string) error {
+	return nil
+}
+
+func (m *mockStore) SetRepoRemotes(_ string, _ []model.RepoRemote) error {
+	return nil
+}
+
+func (m *mockStore) GetRepoByRemoteURL(_ string) (*model.Repository, error) {
+	return nil, nil
+}
+
 func (m *mockStore) UpdateRepoTimestamp(_ string) error {
 	return m.updateTimestampErr
 }
@@ -105,6 +173,14 @@ func (m *mockStore) RemoveRepoByURL(_ *url.URL) error {
 	return m.removeRepoErr
 }
 
+func (m *mockStore) UpdateRepoURL(_ string, _ string) error {
+	return nil
+}
+
+func (m *mockStore) UpdateRepoPath(_ string, _ string) error {
+	return m.updateRepoPathErr
+}
+
 func (m *mockStore) GetConfig() (*model.Config, error) {
 	return m.getConfigResult, m.getConfigErr
 }
@@ -166,6 +242,153 @@ func (m *mockStore) DockerProfileExists(_ string) (bool, error) {
 	return false, nil
 }
 
+// Saved filter operations
+func (m *mockStore) SaveFilter(_ *model.SavedFilter) error {
+	return m.saveFilterErr
+}
+
+func (m *mockStore) GetFilter(_ string) (*model.SavedFilter, error) {
+	return m.getFilterResult, nil
+}
+
+func (m *mockStore) ListFilters() ([]model.SavedFilter, error) {
+	return m.listFiltersResult, nil
+}
+
+func (m *mockStore) DeleteFilter(_ string) error {
+	return m.deleteFilterErr
+}
+
+// Repository snapshot operations
+func (m *mockStore) SaveRepoSnapshot(_ *model.RepoSnapshot) error {
+	return nil
+}
+
+func (m *mockStore) GetRepoSnapshot(_ string) (*model.RepoSnapshot, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ListRepoSnapshots(_ string) ([]model.RepoSnapshot, error) {
+	return nil, nil
+}
+
+func (m *mockStore) DeleteRepoSnapshot(_ string) error {
+	return nil
+}
+
+// Wizard draft operations
+func (m *mockStore) SaveWizardDraft(_ *model.WizardDraft) error {
+	return nil
+}
+
+func (m *mockStore) GetWizardDraft(_ string) (*model.WizardDraft, error) {
+	return nil, nil
+}
+
+func (m *mockStore) DeleteWizardDraft(_ string) error {
+	return nil
+}
+
+// API token operations
+func (m *mockStore) SaveAPIToken(token *model.APIToken) error {
+	m.savedAPIToken = token
+	return nil
+}
+
+func (m *mockStore) GetAPITokenByHash(hash string) (*model.APIToken, error) {
+	if m.apiToken == nil || m.apiToken.Hash != hash {
+		return nil, nil
+	}
+
+	t := *m.apiToken
+
+	return &t, nil
+}
+
+func (m *mockStore) ListAPITokens() ([]model.APIToken, error) {
+	return nil, nil
+}
+
+func (m *mockStore) DeleteAPIToken(_ string) error {
+	return nil
+}
+
+func (m *mockStore) SaveVaultSecret(_ *model.VaultSecret) error {
+	return nil
+}
+
+func (m *mockStore) GetVaultSecret(_ string) (*model.VaultSecret, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ListVaultSecrets() ([]model.VaultSecret, error) {
+	return nil, nil
+}
+
+func (m *mockStore) DeleteVaultSecret(_ string) error {
+	return nil
+}
+
+func (m *mockStore) SaveGmailWatch(_ *model.GmailWatch) error {
+	return nil
+}
+
+func (m *mockStore) GetGmailWatch(_ string) (*model.GmailWatch, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ListGmailWatches() ([]model.GmailWatch, error) {
+	return nil, nil
+}
+
+func (m *mockStore) DeleteGmailWatch(_ string) error {
+	return nil
+}
+
+func (m *mockStore) SaveGitHubRepoID(_ *model.GitHubRepoID) error {
+	return nil
+}
+
+func (m *mockStore) GetGitHubRepoID(_ string) (*model.GitHubRepoID, error) {
+	return nil, nil
+}
+
+func (m *mockStore) SaveDependencyInventory(_ *model.DependencyInventory) error {
+	return nil
+}
+
+func (m *mockStore) ListDependencyInventories(_ string, _ int) ([]model.DependencyInventory, error) {
+	return nil, nil
+}
+
+func (m *mockStore) SaveShareLink(_ *model.ShareLink) error {
+	return nil
+}
+
+func (m *mockStore) GetShareLink(_ string) (*model.ShareLink, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ConsumeShareLink(_ string) (*model.ShareLink, error) {
+	return nil, nil
+}
+
+func (m *mockStore) SaveJob(_ *model.Job) error {
+	return nil
+}
+
+func (m *mockStore) GetJob(_ string) (*model.Job, error) {
+	return nil, nil
+}
+
+func (m *mockStore) ListJobs(_ int) ([]model.Job, error) {
+	return nil, nil
+}
+
+func (m *mockStore) CancelJob(_ string) error {
+	return nil
+}
+
 func (m *mockStore) SaveRepoWithWorkspace(_ *url.URL, _ string, _ string) error {
 	return m.saveRepoWithWorkspaceErr
 }
@@ -247,6 +470,14 @@ func (m *mockStore) DeleteStandaloneConnection(_ string) error {
 	return nil
 }
 
+func (m *mockStore) GetSyncChangeLog(_ string) (*standalone.BucketLog, error) {
+	return &standalone.BucketLog{}, nil
+}
+
+func (m *mockStore) SaveSyncChangeLog(_ string, _ *standalone.BucketLog) error {
+	return nil
+}
+
 func (m *mockStore) GetServerEncryptionConfig() (*standalone.ServerEncryptionConfig, error) {
 	return nil, nil
 }
@@ -410,6 +641,29 @@ func TestService_Ping(t *testing.T) {
 	}
 }
 
+func TestService_Shutdown(t *testing.T) {
+	svc := NewService(&mockStore{})
+
+	select {
+	case <-svc.ShutdownRequested():
+		t.Fatal("ShutdownRequested() closed before Shutdown()")
+	default:
+	}
+
+	// Repeated requests must not panic on a closed channel
+	for range 2 {
+		if _, err := svc.Shutdown(context.Background(), &v1.Empty{}); err != nil {
+			t.Fatalf("Shutdown() error = %v", err)
+		}
+	}
+
+	select {
+	case <-svc.ShutdownRequested():
+	default:
+		t.Error("ShutdownRequested() not closed after Shutdown()")
+	}
+}
+
 func TestService_SaveRepo(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -634,6 +888,97 @@ func TestService_GetRepos(t *testing.T) {
 	}
 }
 
+func TestService_ListRepos(t *testing.T) {
+	var repos []model.Repository
+	for i := range 250 {
+		repos = append(repos, model.Repository{
+			ID:       uint(i + 1),
+			URL:      fmt.Sprintf("https://github.com/user/repo%d", i),
+			Favorite: i%10 == 0,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		req       *v1.ListReposRequest
+		dbErr     error
+		wantPages []int
+		wantTotal int
+		wantCode  codes.Code
+	}{
+		{name: "default page size", req: &v1.ListReposRequest{}, wantPages: []int{100, 100, 50}, wantTotal: 250},
+		{name: "custom page size", req: &v1.ListReposRequest{PageSize: 200}, wantPages: []int{200, 50}, wantTotal: 250},
+		{name: "clamped page size", req: &v1.ListReposRequest{PageSize: 5000}, wantPages: []int{250}, wantTotal: 250},
+		{name: "favorites", req: &v1.ListReposRequest{FavoritesOnly: true, PageSize: 10}, wantPages: []int{10, 10, 5}, wantTotal: 25},
+		{name: "query", req: &v1.ListReposRequest{Query: "REPO24"}, wantPages: []int{11}, wantTotal: 11},
+		{name: "no matches", req: &v1.ListReposRequest{Query: "nothing"}, wantPages: []int{0}, wantTotal: 0},
+		{name: "negative page size", req: &v1.ListReposRequest{PageSize: -1}, wantCode: codes.InvalidArgument},
+		{name: "bad token", req: &v1.ListReposRequest{PageToken: "!!"}, wantCode: codes.InvalidArgument},
+		{name: "db error", req: &v1.ListReposRequest{}, dbErr: errors.New("db error"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(&mockStore{getReposResult: repos, getReposErr: tt.dbErr})
+
+			var pages []int
+
+			req := tt.req
+
+			for {
+				resp, err := svc.ListRepos(context.Background(), req)
+				if err != nil {
+					if st, _ := status.FromError(err); st.Code() != tt.wantCode {
+						t.Fatalf("ListRepos() code = %v, want %v", st.Code(), tt.wantCode)
+					}
+
+					return
+				}
+
+				if resp.GetTotalSize() != int32(tt.wantTotal) {
+					t.Errorf("ListRepos() total = %d, want %d", resp.GetTotalSize(), tt.wantTotal)
+				}
+
+				pages = append(pages, len(resp.GetRepositories()))
+
+				if resp.GetNextPageToken() == "" {
+					break
+				}
+
+				req = &v1.ListReposRequest{
+					PageSize:      tt.req.GetPageSize(),
+					FavoritesOnly: tt.req.GetFavoritesOnly(),
+					Query:         tt.req.GetQuery(),
+					PageToken:     resp.GetNextPageToken(),
+				}
+			}
+
+			if tt.wantCode != codes.OK {
+				t.Fatalf("ListRepos() succeeded, want code %v", tt.wantCode)
+			}
+
+			if !slices.Equal(pages, tt.wantPages) {
+				t.Errorf("ListRepos() pages = %v, want %v", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	for _, offset := range []int{0, 1, 100, 123456} {
+		got, err := decodePageToken(encodePageToken(offset))
+		if err != nil || got != offset {
+			t.Errorf("decodePageToken(encodePageToken(%d)) = %d, %v", offset, got, err)
+		}
+	}
+
+	for _, token := range []string{"!!", "b2Zmc2V0Oi0x", "Zm9v"} {
+		if _, err := decodePageToken(token); err == nil {
+			t.Errorf("decodePageToken(%q) succeeded, want error", token)
+		}
+	}
+}
+
 func TestService_SetFavoriteByURL(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -798,5 +1143,63 @@ func TestService_SaveConfig(t *testing.T) {
 	}
 }
 
+func TestService_SaveFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   *v1.SavedFilter
+		dbErr    error
+		wantCode codes.Code
+	}{
+		{"success", &v1.SavedFilter{Name: "dirty-go", Dirty: true, Language: "go"}, nil, codes.OK},
+		{"nil filter", nil, nil, codes.InvalidArgument},
+		{"missing name", &v1.SavedFilter{Dirty: true}, nil, codes.InvalidArgument},
+		{"db error", &v1.SavedFilter{Name: "x"}, errors.New("db error"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(&mockStore{saveFilterErr: tt.dbErr})
+
+			_, err := svc.SaveFilter(context.Background(), &v1.SaveFilterRequest{Filter: tt.filter})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("SaveFilter() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestService_GetFilter(t *testing.T) {
+	filter := &model.SavedFilter{Name: "dirty-go", Workspace: "work", Dirty: true, Language: "go"}
+
+	tests := []struct {
+		name     string
+		req      string
+		stored   *model.SavedFilter
+		wantCode codes.Code
+	}{
+		{"found", "dirty-go", filter, codes.OK},
+		{"not found", "other", nil, codes.NotFound},
+		{"missing name", "", nil, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewService(&mockStore{getFilterResult: tt.stored})
+
+			resp, err := svc.GetFilter(context.Background(), &v1.GetFilterRequest{Name: tt.req})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetFilter() code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+
+			if err == nil {
+				got := ProtoToModelSavedFilter(resp.GetFilter())
+				if got.Name != filter.Name || got.Workspace != filter.Workspace || !got.Dirty || got.Language != filter.Language {
+					t.Errorf("GetFilter() = %+v, want %+v", got, filter)
+				}
+			}
+		})
+	}
+}
+
 // Note: Context cancellation is now handled by contextCheckInterceptor (see interceptors_test.go)
 // This provides fast-fail behavior at the interceptor level before any service method is called.
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/inovacc/clonr/internal/application"
+)
+
+// socketFile is the unix socket local clients connect to, next to server.json
+const socketFile = "clonr.sock"
+
+// ErrSocketInUse is returned when another server listens on the socket
+var ErrSocketInUse = errors.New("another server is listening on the socket")
+
+// SocketPath returns the default path of the unix socket of the server.
+// Windows 10 (1803) and later support unix sockets as well.
+func SocketPath() (string, error) {
+	dataDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get local data directory: %w", err)
+	}
+
+	return filepath.Join(dataDir, application.AppName, socketFile), nil
+}
+
+// ListenSocket listens on the unix socket at path, replacing a socket file
+// left behind by a server that crashed. Only the current user can connect,
+// which makes the socket safer than a TCP port other local users can reach.
+func ListenSocket(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create socket directory: %w", err)
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if conn, err := net.DialTimeout("unix", path, 500*time.Millisecond); err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("%w: %s", ErrSocketInUse, path)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	}
+
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", path, err)
+	}
+
+	if err := os.Chmod(path, 0600); err != nil {
+		_ = lis.Close()
+		return nil, fmt.Errorf("failed to restrict socket permissions: %w", err)
+	}
+
+	return lis, nil
+}
+
+// SetServerSocket records the unix socket of the running server in the
+// server info file, so clients connect to it instead of the TCP port
+func SetServerSocket(path string) error {
+	return updateServerInfo(func(info *ServerInfo) { info.Socket = path })
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestListenSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clonr.sock")
+
+	// A socket file left behind by a crashed server is replaced
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	lis, err := ListenSocket(path)
+	if err != nil {
+		t.Fatalf("ListenSocket() error = %v with a stale socket file", err)
+	}
+
+	defer func() { _ = lis.Close() }()
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if perm := info.Mode().Perm(); perm != 0600 {
+			t.Errorf("socket permissions = %o, want 600", perm)
+		}
+	}
+
+	if _, err := ListenSocket(path); !errors.Is(err, ErrSocketInUse) {
+		t.Errorf("ListenSocket() error = %v while in use, want %v", err, ErrSocketInUse)
+	}
+}
@@ -0,0 +1,332 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/mapper"
+	"github.com/inovacc/clonr/internal/standalone"
+	"github.com/inovacc/clonr/internal/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// standaloneSessionTTL is how long the session of an authenticated
+// destination lasts
+const standaloneSessionTTL = time.Hour
+
+// standaloneSession is an authenticated destination. Its key encrypts the
+// data sent to it and is only kept in memory.
+type standaloneSession struct {
+	clientID  string
+	clientKey []byte
+	expiresAt time.Time
+}
+
+// StandaloneService serves the standalone sync protocol to the destinations
+// registered with this instance.
+type StandaloneService struct {
+	v1.UnimplementedStandaloneServiceServer
+
+	db store.Store
+
+	// notify announces registration requests; replaced in tests
+	notify func(ctx context.Context, reg *standalone.ClientRegistration, client *standalone.RegisteredClient)
+
+	mu       sync.Mutex
+	sessions map[string]*standaloneSession
+
+	// recordMu serializes the change log updates of concurrent syncs
+	recordMu sync.Mutex
+}
+
+// NewStandaloneService creates the standalone sync service
+func NewStandaloneService(db store.Store) *StandaloneService {
+	return &StandaloneService{
+		db:       db,
+		notify:   core.NotifyClientRegistration,
+		sessions: make(map[string]*standaloneSession),
+	}
+}
+
+// NewStandaloneServer creates the gRPC server of the standalone sync
+// service. It runs on its own port so it can be reached from other machines
+// without exposing the local API. Destinations authenticate with their
+// client key, so client certificates are not requested.
+func NewStandaloneServer(db store.Store, security SecurityConfig) *grpc.Server {
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(recoveryInterceptor(), loggingInterceptor()),
+		grpc.ConnectionTimeout(10 * time.Second),
+		grpc.MaxRecvMsgSize(4 * 1024 * 1024),
+		grpc.MaxSendMsgSize(4 * 1024 * 1024),
+	}
+
+	if security.TLS != nil {
+		tlsConfig := security.TLS.Clone()
+		tlsConfig.ClientAuth = tls.NoClientCert
+		tlsConfig.ClientCAs = nil
+
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	srv := grpc.NewServer(opts...)
+	v1.RegisterStandaloneServiceServer(srv, NewStandaloneService(db))
+
+	return srv
+}
+
+// Register receives the encryption key of a client holding the standalone
+// key. A client the auto-approve policy allows is registered at once; any
+// other request is kept for 'clonr standalone clients approve'. Either way a
+// client-registration notification is sent.
+func (s *StandaloneService) Register(_ context.Context, req *v1.RegisterRequest) (*v1.RegisterResponse, error) {
+	if req.GetClientId() == "" || req.GetDisplayKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "client ID and key are required")
+	}
+
+	config, err := s.serverConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if !standalone.VerifyAPIKey(config, req.GetApiKey()) {
+		return nil, status.Error(codes.Unauthenticated, "invalid standalone key")
+	}
+
+	reg, displayKey := mapper.RegisterRequestToRegistration(req)
+
+	client, err := standalone.ReceiveRegistration(s.db, reg, displayKey)
+	if err != nil {
+		switch {
+		case errors.Is(err, standalone.ErrClientRevoked), errors.Is(err, standalone.ErrClientRegistered):
+			return &v1.RegisterResponse{Error: err.Error()}, nil
+		case errors.Is(err, standalone.ErrInvalidDisplayKey):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid client key: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to register client: %v", err)
+		}
+	}
+
+	go s.notify(context.Background(), reg, client)
+
+	if client == nil {
+		return &v1.RegisterResponse{Fingerprint: reg.Fingerprint}, nil
+	}
+
+	return &v1.RegisterResponse{Approved: true, Fingerprint: client.Fingerprint}, nil
+}
+
+// Authenticate starts a sync session for a registered client. The client
+// proves it holds the key it registered with: the fingerprint of the key
+// must match the one recorded when it was approved.
+func (s *StandaloneService) Authenticate(ctx context.Context, req *v1.AuthenticateRequest) (*v1.AuthenticateResponse, error) {
+	if req.GetClientId() == "" || len(req.GetClientKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "client ID and key are required")
+	}
+
+	if _, err := s.serverConfig(); err != nil {
+		return nil, err
+	}
+
+	client, err := s.activeClient(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := standalone.ClientFingerprint(client.ClientID, req.GetClientKey())
+	if client.Fingerprint == "" || subtle.ConstantTimeCompare([]byte(fingerprint), []byte(client.Fingerprint)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "client key does not match the key the client registered with")
+	}
+
+	token, err := standalone.GenerateChallengeToken()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create session: %v", err)
+	}
+
+	expiresAt := time.Now().Add(standaloneSessionTTL)
+
+	s.mu.Lock()
+
+	for t, session := range s.sessions {
+		if time.Now().After(session.expiresAt) {
+			delete(s.sessions, t)
+		}
+	}
+
+	s.sessions[token] = &standaloneSession{
+		clientID:  client.ClientID,
+		clientKey: slices.Clone(req.GetClientKey()),
+		expiresAt: expiresAt,
+	}
+
+	s.mu.Unlock()
+
+	client.LastSeenAt = time.Now()
+	client.SyncCount++
+
+	if host := peerHost(ctx); host != "" {
+		client.LastIP = host
+	}
+
+	if err := s.db.SaveRegisteredClient(client); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update client: %v", err)
+	}
+
+	return &v1.AuthenticateResponse{
+		Success:      true,
+		SessionToken: token,
+		ExpiresAt:    expiresAt.Unix(),
+	}, nil
+}
+
+// SyncProfiles streams the profiles changed since the revision the
+// destination acknowledged
+func (s *StandaloneService) SyncProfiles(req *v1.SyncRequest, stream v1.StandaloneService_SyncProfilesServer) error {
+	return s.syncBucket(stream.Context(), req, standalone.CapabilityProfiles, stream.Send)
+}
+
+// SyncWorkspaces streams the workspaces changed since the revision the
+// destination acknowledged
+func (s *StandaloneService) SyncWorkspaces(req *v1.SyncRequest, stream v1.StandaloneService_SyncWorkspacesServer) error {
+	return s.syncBucket(stream.Context(), req, standalone.CapabilityWorkspaces, stream.Send)
+}
+
+// SyncRepos streams the repositories changed since the revision the
+// destination acknowledged
+func (s *StandaloneService) SyncRepos(req *v1.SyncRequest, stream v1.StandaloneService_SyncReposServer) error {
+	return s.syncBucket(stream.Context(), req, standalone.CapabilityRepos, stream.Send)
+}
+
+// SyncConfig returns the config when it changed since the revision the
+// destination acknowledged, and an empty message otherwise
+func (s *StandaloneService) SyncConfig(ctx context.Context, req *v1.SyncRequest) (*v1.EncryptedData, error) {
+	resp := &v1.EncryptedData{}
+
+	err := s.syncBucket(ctx, req, standalone.CapabilityConfig, func(data *v1.EncryptedData) error {
+		resp = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// syncBucket sends the records of one bucket changed since the revision the
+// destination acknowledged, encrypted with its key. The filter carried by
+// the request selects what is sent.
+func (s *StandaloneService) syncBucket(ctx context.Context, req *v1.SyncRequest, bucket string, send func(*v1.EncryptedData) error) error {
+	session, err := s.session(req.GetSessionToken())
+	if err != nil {
+		return err
+	}
+
+	config, err := s.serverConfig()
+	if err != nil {
+		return err
+	}
+
+	// The client may have been revoked since it authenticated
+	if _, err := s.activeClient(session.clientID); err != nil {
+		return err
+	}
+
+	filter := mapper.SyncRequestToFilter(req)
+	if !filter.AllowsType(bucket) {
+		return nil
+	}
+
+	filter.DataTypes = []string{bucket}
+
+	s.recordMu.Lock()
+	logs, records, err := standalone.RecordChanges(s.db)
+	s.recordMu.Unlock()
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to record changes: %v", err)
+	}
+
+	pkg, err := standalone.CreateDeltaPackage(config.InstanceID, session.clientKey, logs, records, req.GetSinceRevisions(), filter)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create sync package: %v", err)
+	}
+
+	for _, item := range standalone.FilterSyncItems(pkg.Items, filter) {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		if err := send(mapper.SyncPackageItemToProto(item)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// serverConfig returns the standalone config when this instance is a
+// standalone server
+func (s *StandaloneService) serverConfig() (*standalone.StandaloneConfig, error) {
+	config, err := s.db.GetStandaloneConfig()
+	if err != nil || config == nil || !config.Enabled || !config.IsServer {
+		return nil, status.Error(codes.FailedPrecondition, "standalone server mode is not enabled")
+	}
+
+	return config, nil
+}
+
+// activeClient returns a registered client whose access was not revoked
+func (s *StandaloneService) activeClient(clientID string) (*standalone.RegisteredClient, error) {
+	client, err := s.db.GetRegisteredClient(clientID)
+	if err != nil || client == nil {
+		return nil, status.Error(codes.PermissionDenied, "client is not registered with this server")
+	}
+
+	if client.Status != "active" {
+		return nil, status.Errorf(codes.PermissionDenied, "client access is %s", client.Status)
+	}
+
+	return client, nil
+}
+
+// session returns the live session of a token
+func (s *StandaloneService) session(token string) (*standaloneSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[token]
+	if !ok || time.Now().After(session.expiresAt) {
+		delete(s.sessions, token)
+		return nil, status.Error(codes.Unauthenticated, "session is invalid or expired, authenticate again")
+	}
+
+	return session, nil
+}
+
+// peerHost returns the address of the caller without its port, or "" when
+// it is unknown
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+
+	return host
+}
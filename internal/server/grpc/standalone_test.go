@@ -0,0 +1,344 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	v1 "github.com/inovacc/clonr/internal/api/v1"
+	"github.com/inovacc/clonr/internal/mapper"
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/standalone"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// standaloneStore is a mockStore keeping the standalone server state
+type standaloneStore struct {
+	mockStore
+
+	config  *standalone.StandaloneConfig
+	clients map[string]*standalone.RegisteredClient
+	pending []*standalone.ClientRegistration
+	logs    map[string]*standalone.BucketLog
+}
+
+func newStandaloneStore() *standaloneStore {
+	return &standaloneStore{
+		config:  &standalone.StandaloneConfig{Enabled: true, IsServer: true, InstanceID: "source"},
+		clients: make(map[string]*standalone.RegisteredClient),
+		logs:    make(map[string]*standalone.BucketLog),
+	}
+}
+
+func (s *standaloneStore) GetStandaloneConfig() (*standalone.StandaloneConfig, error) {
+	return s.config, nil
+}
+
+func (s *standaloneStore) GetRegisteredClient(clientID string) (*standalone.RegisteredClient, error) {
+	return s.clients[clientID], nil
+}
+
+func (s *standaloneStore) SaveRegisteredClient(client *standalone.RegisteredClient) error {
+	s.clients[client.ClientID] = client
+	return nil
+}
+
+func (s *standaloneStore) ListRegisteredClients() ([]*standalone.RegisteredClient, error) {
+	var clients []*standalone.RegisteredClient
+	for _, c := range s.clients {
+		clients = append(clients, c)
+	}
+
+	return clients, nil
+}
+
+func (s *standaloneStore) SavePendingRegistration(reg *standalone.ClientRegistration) error {
+	s.pending = append(s.pending, reg)
+	return nil
+}
+
+func (s *standaloneStore) GetSyncChangeLog(bucket string) (*standalone.BucketLog, error) {
+	if log, ok := s.logs[bucket]; ok {
+		return log, nil
+	}
+
+	return &standalone.BucketLog{}, nil
+}
+
+func (s *standaloneStore) SaveSyncChangeLog(bucket string, log *standalone.BucketLog) error {
+	s.logs[bucket] = log
+	return nil
+}
+
+// syncStream collects the items a sync handler streams
+type syncStream struct {
+	grpc.ServerStream
+
+	items []*v1.EncryptedData
+}
+
+func (s *syncStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *syncStream) Send(data *v1.EncryptedData) error {
+	s.items = append(s.items, data)
+	return nil
+}
+
+// registerTestClient registers an approved client and returns its ID and key
+func registerTestClient(t *testing.T, db *standaloneStore) (string, []byte) {
+	t.Helper()
+
+	_, displayKey, err := standalone.GenerateClientKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := &standalone.ClientRegistration{ClientID: standalone.GenerateClientID(), ClientName: "laptop"}
+
+	client, err := standalone.ApproveRegistration(reg, displayKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db.clients[client.ClientID] = client
+
+	return client.ClientID, standalone.DeriveClientKey(displayKey)
+}
+
+func TestStandaloneService_Authenticate(t *testing.T) {
+	db := newStandaloneStore()
+	svc := NewStandaloneService(db)
+	clientID, clientKey := registerTestClient(t, db)
+	_, otherKey := registerTestClient(t, db)
+
+	_, err := svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: clientID, ClientKey: otherKey})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Authenticate() with another key error = %v, want Unauthenticated", err)
+	}
+
+	_, err = svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: "unknown", ClientKey: clientKey})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Authenticate() of an unknown client error = %v, want PermissionDenied", err)
+	}
+
+	resp, err := svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: clientID, ClientKey: clientKey})
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	if !resp.GetSuccess() || resp.GetSessionToken() == "" {
+		t.Errorf("Authenticate() = %+v, want a session", resp)
+	}
+
+	if db.clients[clientID].SyncCount != 1 {
+		t.Errorf("SyncCount = %d, want 1", db.clients[clientID].SyncCount)
+	}
+
+	// A revoked client loses its session
+	db.clients[clientID].Status = "revoked"
+
+	err = svc.SyncRepos(&v1.SyncRequest{SessionToken: resp.GetSessionToken()}, &syncStream{})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("SyncRepos() of a revoked client error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestStandaloneService_SyncRepos(t *testing.T) {
+	db := newStandaloneStore()
+	db.getConfigResult = &model.Config{}
+	db.getAllReposResult = []model.Repository{
+		{URL: "https://github.com/org/api", Workspace: "work"},
+		{URL: "https://github.com/me/dotfiles", Workspace: "personal"},
+	}
+
+	svc := NewStandaloneService(db)
+	clientID, clientKey := registerTestClient(t, db)
+
+	auth, err := svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: clientID, ClientKey: clientKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := svc.SyncRepos(&v1.SyncRequest{}, &syncStream{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("SyncRepos() without a session error = %v, want Unauthenticated", err)
+	}
+
+	// The filter of the request selects the workspaces sent
+	req := &v1.SyncRequest{SessionToken: auth.GetSessionToken(), Workspaces: []string{"work"}}
+	stream := &syncStream{}
+
+	if err := svc.SyncRepos(req, stream); err != nil {
+		t.Fatalf("SyncRepos() error = %v", err)
+	}
+
+	if len(stream.items) != 1 || stream.items[0].GetId() != "https://github.com/org/api" {
+		t.Fatalf("SyncRepos() sent %v, want the work repository", stream.items)
+	}
+
+	item := stream.items[0]
+
+	data, err := standalone.DecryptWithKey(item.GetEncryptedData(), clientKey)
+	if err != nil || len(data) == 0 {
+		t.Errorf("item is not encrypted with the client key: %v", err)
+	}
+
+	// Nothing changed since the acknowledged revision
+	req.SinceRevisions = map[string]int64{standalone.CapabilityRepos: item.GetRevision()}
+	stream = &syncStream{}
+
+	if err := svc.SyncRepos(req, stream); err != nil {
+		t.Fatalf("SyncRepos() error = %v", err)
+	}
+
+	if len(stream.items) != 0 {
+		t.Errorf("SyncRepos() sent %d unchanged items", len(stream.items))
+	}
+
+	// A filter without repositories sends none
+	req = &v1.SyncRequest{SessionToken: auth.GetSessionToken(), ItemTypes: []string{standalone.CapabilityProfiles}}
+	stream = &syncStream{}
+
+	if err := svc.SyncRepos(req, stream); err != nil || len(stream.items) != 0 {
+		t.Errorf("SyncRepos() with a profiles filter = %d items, %v; want none", len(stream.items), err)
+	}
+}
+
+func TestStandaloneService_SyncConfig(t *testing.T) {
+	db := newStandaloneStore()
+	db.getConfigResult = &model.Config{}
+
+	svc := NewStandaloneService(db)
+	clientID, clientKey := registerTestClient(t, db)
+
+	auth, err := svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: clientID, ClientKey: clientKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &v1.SyncRequest{SessionToken: auth.GetSessionToken()}
+
+	data, err := svc.SyncConfig(context.Background(), req)
+	if err != nil || data.GetId() != "config" {
+		t.Fatalf("SyncConfig() = %v, %v; want the config", data, err)
+	}
+
+	req.SinceRevisions = map[string]int64{standalone.CapabilityConfig: data.GetRevision()}
+
+	data, err = svc.SyncConfig(context.Background(), req)
+	if err != nil || data.GetId() != "" {
+		t.Errorf("SyncConfig() = %v, %v; want an empty message for an unchanged config", data, err)
+	}
+}
+
+func TestStandaloneService_Register(t *testing.T) {
+	key, config, err := standalone.GenerateStandaloneKey("localhost", standalone.DefaultPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.AutoApprove = standalone.AutoApprovePolicy{Hostnames: []string{"build-*"}}
+
+	db := newStandaloneStore()
+	db.config = config
+
+	svc := NewStandaloneService(db)
+
+	var (
+		mu       sync.Mutex
+		notified []string
+		done     = make(chan struct{}, 4)
+	)
+
+	svc.notify = func(_ context.Context, reg *standalone.ClientRegistration, client *standalone.RegisteredClient) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		state := "pending"
+		if client != nil {
+			state = "approved"
+		}
+
+		notified = append(notified, reg.MachineInfo.Hostname+" "+state)
+		done <- struct{}{}
+	}
+
+	register := func(apiKey, hostname string) (*v1.RegisterResponse, []byte, error) {
+		_, displayKey, err := standalone.GenerateClientKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reg := &standalone.ClientRegistration{
+			ClientID:    standalone.GenerateClientID(),
+			ClientName:  hostname,
+			MachineInfo: standalone.MachineInfo{Hostname: hostname},
+		}
+
+		resp, err := svc.Register(context.Background(), mapper.RegisterRequestFor(reg, apiKey, standalone.FormatDisplayKey(displayKey)))
+
+		return resp, standalone.DeriveClientKey(displayKey), err
+	}
+
+	if _, _, err := register("wrong", "laptop"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Register() with a wrong standalone key error = %v, want Unauthenticated", err)
+	}
+
+	// Allowed by the auto-approve policy: registered and able to sync
+	resp, clientKey, err := register(key.APIKey, "build-01")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if !resp.GetApproved() || len(db.clients) != 1 {
+		t.Fatalf("Register() = %+v, want the client approved", resp)
+	}
+
+	var clientID string
+	for id := range db.clients {
+		clientID = id
+	}
+
+	if want := standalone.ClientFingerprint(clientID, clientKey); resp.GetFingerprint() != want {
+		t.Errorf("Fingerprint = %s, want %s", resp.GetFingerprint(), want)
+	}
+
+	if _, err := svc.Authenticate(context.Background(), &v1.AuthenticateRequest{ClientId: clientID, ClientKey: clientKey}); err != nil {
+		t.Errorf("Authenticate() of the registered client error = %v", err)
+	}
+
+	// Any other client waits for approval
+	resp, _, err = register(key.APIKey, "laptop")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if resp.GetApproved() || resp.GetFingerprint() == "" || len(db.pending) != 1 {
+		t.Errorf("Register() = %+v, want the client pending with its fingerprint", resp)
+	}
+
+	// A revoked client is refused
+	db.clients[clientID].Status = "revoked"
+
+	_, displayKey, _ := standalone.GenerateClientKey()
+	req := mapper.RegisterRequestFor(&standalone.ClientRegistration{ClientID: clientID}, key.APIKey, displayKey)
+
+	if resp, err := svc.Register(context.Background(), req); err != nil || resp.GetError() == "" {
+		t.Errorf("Register() of a revoked client = %+v, %v; want a refusal", resp, err)
+	}
+
+	for range 2 {
+		<-done
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(notified) != 2 || !slices.Contains(notified, "build-01 approved") || !slices.Contains(notified, "laptop pending") {
+		t.Errorf("notifications = %v, want one per registration request", notified)
+	}
+}
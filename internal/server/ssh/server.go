@@ -0,0 +1,348 @@
+// Package sshserver serves the read-only repository catalog TUI over SSH.
+//
+// Guests connect with any SSH client; no clonr installation is needed.
+// Only public keys listed in the authorized keys file are accepted, and the
+// file is re-read for every connection, so keys can be added or revoked
+// without restarting the server.
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/crypto/ssh"
+)
+
+// handshakeTimeout bounds the SSH handshake of a new connection
+const handshakeTimeout = 30 * time.Second
+
+// Config holds the SSH server configuration
+type Config struct {
+	Host               string
+	Port               int
+	HostKeyPath        string
+	AuthorizedKeysPath string
+}
+
+// ModelFunc creates the TUI model for a new guest session
+type ModelFunc func() (tea.Model, error)
+
+// Server serves a TUI to authenticated SSH users
+type Server struct {
+	config   Config
+	ssh      *ssh.ServerConfig
+	newModel ModelFunc
+	sessions sync.WaitGroup
+}
+
+// New creates an SSH server. The host key is created on first use.
+func New(config Config, newModel ModelFunc) (*Server, error) {
+	signer, err := loadHostKey(config.HostKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{config: config, newModel: newModel}
+
+	s.ssh = &ssh.ServerConfig{
+		PublicKeyCallback: s.authenticate,
+		ServerVersion:     "SSH-2.0-clonr",
+	}
+	s.ssh.AddHostKey(signer)
+
+	return s, nil
+}
+
+// Address returns the address the server listens on
+func (s *Server) Address() string {
+	return net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+}
+
+// Start accepts connections until ctx is cancelled
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.Address())
+	if err != nil {
+		return fmt.Errorf("failed to start SSH server: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				s.sessions.Wait()
+				return nil
+			}
+
+			log.Printf("SSH accept error: %v", err)
+
+			continue
+		}
+
+		go s.handleConn(ctx, conn)
+	}
+}
+
+// authenticate accepts public keys listed in the authorized keys file
+func (s *Server) authenticate(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+	keys, err := LoadAuthorizedKeys(s.config.AuthorizedKeysPath)
+	if err != nil {
+		log.Printf("SSH: %v", err)
+		return nil, errors.New("unauthorized")
+	}
+
+	marshaled := key.Marshal()
+
+	for _, k := range keys {
+		if bytes.Equal(k.Key.Marshal(), marshaled) {
+			return &ssh.Permissions{Extensions: map[string]string{"guest": k.Comment}}, nil
+		}
+	}
+
+	log.Printf("SSH: rejected key %s for %s from %s", ssh.FingerprintSHA256(key), conn.User(), conn.RemoteAddr())
+
+	return nil, errors.New("unauthorized")
+}
+
+// handleConn performs the SSH handshake and serves the connection's sessions
+func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
+	_ = conn.SetDeadline(time.Now().Add(handshakeTimeout))
+
+	sconn, chans, reqs, err := ssh.NewServerConn(conn, s.ssh)
+	if err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	_ = conn.SetDeadline(time.Time{})
+
+	defer func() { _ = sconn.Close() }()
+
+	// Drop the connection when the server shuts down
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = sconn.Close()
+		case <-done:
+		}
+	}()
+
+	guest := sconn.Permissions.Extensions["guest"]
+	if guest == "" {
+		guest = sconn.User()
+	}
+
+	log.Printf("SSH: %s connected from %s", guest, sconn.RemoteAddr())
+
+	go ssh.DiscardRequests(reqs)
+
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			_ = newChan.Reject(ssh.UnknownChannelType, "only sessions are supported")
+			continue
+		}
+
+		channel, requests, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+
+		s.sessions.Add(1)
+
+		go func() {
+			defer s.sessions.Done()
+			s.serveSession(ctx, channel, requests)
+		}()
+	}
+
+	log.Printf("SSH: %s disconnected", guest)
+}
+
+// serveSession runs the TUI on an interactive session. Only a terminal
+// shell is supported: exec, subsystems and port forwarding are refused.
+func (s *Server) serveSession(ctx context.Context, channel ssh.Channel, requests <-chan *ssh.Request) {
+	defer func() { _ = channel.Close() }()
+
+	var (
+		term    = "xterm-256color"
+		width   int
+		height  int
+		hasPty  bool
+		program *tea.Program
+	)
+
+	for req := range requests {
+		switch req.Type {
+		case "pty-req":
+			term, width, height = parsePtyRequest(req.Payload, term)
+			hasPty = true
+
+			_ = req.Reply(true, nil)
+		case "window-change":
+			width, height = parseWindowChange(req.Payload)
+
+			if program != nil {
+				go program.Send(tea.WindowSizeMsg{Width: width, Height: height})
+			}
+
+			_ = req.Reply(true, nil)
+		case "env":
+			_ = req.Reply(true, nil)
+		case "shell":
+			if !hasPty || program != nil {
+				_ = req.Reply(false, nil)
+				_, _ = fmt.Fprint(channel.Stderr(), "clonr: an interactive terminal is required (ssh -t)\r\n")
+
+				return
+			}
+
+			m, err := s.newModel()
+			if err != nil {
+				_ = req.Reply(false, nil)
+				_, _ = fmt.Fprintf(channel.Stderr(), "clonr: %v\r\n", err)
+
+				return
+			}
+
+			_ = req.Reply(true, nil)
+
+			program = tea.NewProgram(m,
+				tea.WithContext(ctx),
+				tea.WithInput(channel),
+				tea.WithOutput(channel),
+				tea.WithEnvironment([]string{"TERM=" + term}),
+				tea.WithAltScreen(),
+				tea.WithoutSignalHandler(),
+			)
+
+			go func(w, h int) {
+				program.Send(tea.WindowSizeMsg{Width: w, Height: h})
+			}(width, height)
+
+			go func() {
+				_, _ = program.Run()
+				_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				_ = channel.Close()
+			}()
+		default:
+			_ = req.Reply(false, nil)
+		}
+	}
+
+	if program != nil {
+		program.Kill()
+	}
+}
+
+// parsePtyRequest decodes the terminal name and size of a pty-req payload
+func parsePtyRequest(payload []byte, fallback string) (term string, width, height int) {
+	var req struct {
+		Term     string
+		Columns  uint32
+		Rows     uint32
+		Width    uint32
+		Height   uint32
+		Modelist string
+	}
+
+	if err := ssh.Unmarshal(payload, &req); err != nil {
+		return fallback, 80, 24
+	}
+
+	if req.Term == "" {
+		req.Term = fallback
+	}
+
+	return req.Term, int(req.Columns), int(req.Rows)
+}
+
+// parseWindowChange decodes the size of a window-change payload
+func parseWindowChange(payload []byte) (width, height int) {
+	if len(payload) < 8 {
+		return 80, 24
+	}
+
+	return int(binary.BigEndian.Uint32(payload)), int(binary.BigEndian.Uint32(payload[4:]))
+}
+
+// AuthorizedKey is a public key allowed to connect
+type AuthorizedKey struct {
+	Key     ssh.PublicKey
+	Comment string
+}
+
+// LoadAuthorizedKeys reads public keys in authorized_keys format
+func LoadAuthorizedKeys(path string) ([]AuthorizedKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorized keys: %w", err)
+	}
+
+	var keys []AuthorizedKey
+
+	for len(bytes.TrimSpace(data)) > 0 {
+		key, comment, _, rest, err := ssh.ParseAuthorizedKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse authorized keys %s: %w", path, err)
+		}
+
+		keys = append(keys, AuthorizedKey{Key: key, Comment: comment})
+		data = rest
+	}
+
+	return keys, nil
+}
+
+// loadHostKey reads the server's host key, generating an ed25519 key if
+// the file does not exist
+func loadHostKey(path string) (ssh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		signer, err := ssh.ParsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse host key %s: %w", path, err)
+		}
+
+		return signer, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read host key: %w", err)
+	}
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate host key: %w", err)
+	}
+
+	block, err := ssh.MarshalPrivateKey(priv, "clonr ssh host key")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode host key: %w", err)
+	}
+
+	if err := os.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+		return nil, fmt.Errorf("failed to save host key: %w", err)
+	}
+
+	return ssh.NewSignerFromKey(priv)
+}
@@ -0,0 +1,248 @@
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/crypto/ssh"
+)
+
+// helloModel renders a fixed view and quits on "q"
+type helloModel struct{}
+
+func (helloModel) Init() tea.Cmd { return nil }
+
+func (m helloModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if k, ok := msg.(tea.KeyMsg); ok && k.String() == "q" {
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (helloModel) View() string { return "hello guest" }
+
+func newTestKey(t *testing.T) (ssh.Signer, []byte) {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signer, ssh.MarshalAuthorizedKey(signer.PublicKey())
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() { _ = l.Close() }()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// startTestServer starts a server accepting authorizedKeys and returns its address
+func startTestServer(t *testing.T, authorizedKeys []byte) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	keysPath := filepath.Join(dir, "authorized_keys")
+
+	if err := os.WriteFile(keysPath, authorizedKeys, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := New(Config{
+		Host:               "127.0.0.1",
+		Port:               freePort(t),
+		HostKeyPath:        filepath.Join(dir, "host_key"),
+		AuthorizedKeysPath: keysPath,
+	}, func() (tea.Model, error) { return helloModel{}, nil })
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() { _ = srv.Start(ctx) }()
+
+	// Wait for the listener
+	for range 50 {
+		if conn, err := net.Dial("tcp", srv.Address()); err == nil {
+			_ = conn.Close()
+			break
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	return srv.Address()
+}
+
+func dial(addr string, signer ssh.Signer) (*ssh.Client, error) {
+	return ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            "guest",
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), //nolint:gosec // test server
+		Timeout:         5 * time.Second,
+	})
+}
+
+func TestLoadAuthorizedKeys(t *testing.T) {
+	_, key1 := newTestKey(t)
+	_, key2 := newTestKey(t)
+
+	path := filepath.Join(t.TempDir(), "authorized_keys")
+	data := "# team keys\n" + strings.TrimSpace(string(key1)) + " alice@laptop\n\n" + string(key2)
+
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadAuthorizedKeys(path)
+	if err != nil {
+		t.Fatalf("LoadAuthorizedKeys() error = %v", err)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("LoadAuthorizedKeys() returned %d keys, want 2", len(keys))
+	}
+
+	if keys[0].Comment != "alice@laptop" {
+		t.Errorf("Comment = %q, want alice@laptop", keys[0].Comment)
+	}
+
+	if _, err := LoadAuthorizedKeys(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("LoadAuthorizedKeys(missing) expected error")
+	}
+}
+
+func TestLoadHostKeyPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "host_key")
+
+	first, err := loadHostKey(path)
+	if err != nil {
+		t.Fatalf("loadHostKey() error = %v", err)
+	}
+
+	second, err := loadHostKey(path)
+	if err != nil {
+		t.Fatalf("loadHostKey() error = %v", err)
+	}
+
+	if !bytes.Equal(first.PublicKey().Marshal(), second.PublicKey().Marshal()) {
+		t.Error("host key changed between starts")
+	}
+}
+
+func TestServerRejectsUnknownKey(t *testing.T) {
+	_, authorized := newTestKey(t)
+	stranger, _ := newTestKey(t)
+
+	addr := startTestServer(t, authorized)
+
+	if client, err := dial(addr, stranger); err == nil {
+		_ = client.Close()
+		t.Fatal("connection with an unknown key succeeded")
+	}
+}
+
+func TestServerServesTUI(t *testing.T) {
+	signer, authorized := newTestKey(t)
+
+	addr := startTestServer(t, authorized)
+
+	client, err := dial(addr, signer)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	defer func() { _ = client.Close() }()
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+
+	defer func() { _ = session.Close() }()
+
+	var out bytes.Buffer
+
+	session.Stdout = &out
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := session.RequestPty("xterm-256color", 24, 80, ssh.TerminalModes{}); err != nil {
+		t.Fatalf("RequestPty() error = %v", err)
+	}
+
+	if err := session.Shell(); err != nil {
+		t.Fatalf("Shell() error = %v", err)
+	}
+
+	time.Sleep(300 * time.Millisecond)
+
+	if _, err := stdin.Write([]byte("q")); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- session.Wait() }()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("session did not end after quitting the TUI")
+	}
+
+	if !strings.Contains(out.String(), "hello guest") {
+		t.Errorf("output = %q, want it to contain the view", out.String())
+	}
+}
+
+func TestServerRequiresTerminal(t *testing.T) {
+	signer, authorized := newTestKey(t)
+
+	addr := startTestServer(t, authorized)
+
+	client, err := dial(addr, signer)
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+
+	defer func() { _ = client.Close() }()
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+
+	defer func() { _ = session.Close() }()
+
+	if err := session.Shell(); err == nil {
+		t.Error("Shell() without a pty succeeded")
+	}
+}
@@ -0,0 +1,29 @@
+package web
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/inovacc/clonr/internal/store"
+)
+
+// handleMetrics serves the store metrics in the Prometheus text format
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	if err := store.DefaultMetrics().Snapshot().WritePrometheus(w); err != nil {
+		log.Printf("Failed to write metrics: %v", err)
+	}
+}
+
+// handleStoreMetrics returns per-method store timings and recent slow calls
+func (s *Server) handleStoreMetrics(w http.ResponseWriter, _ *http.Request) {
+	s.jsonResponse(w, store.DefaultMetrics().Snapshot())
+}
+
+// handleResetStoreMetrics clears the collected store metrics
+func (s *Server) handleResetStoreMetrics(w http.ResponseWriter, _ *http.Request) {
+	store.DefaultMetrics().Reset()
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,95 @@
+package web
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/inovacc/clonr/internal/core"
+)
+
+// shareLinkUnavailable is shown for unknown, expired and already opened links
+const shareLinkUnavailable = "This link is invalid, has expired or was already opened."
+
+// SharePageData holds data for the share page
+type SharePageData struct {
+	View  *core.ShareView
+	Error string
+
+	// Pending links have not been opened yet; the page asks to open them
+	Pending bool
+	Exp     string
+	Sig     string
+}
+
+// handleSharePage asks for confirmation before opening a one-time share link,
+// so link previews do not use it up
+func (s *Server) handleSharePage(w http.ResponseWriter, r *http.Request) {
+	exp, sig := r.URL.Query().Get("exp"), r.URL.Query().Get("sig")
+
+	if err := core.CheckShareLink(s.store, r.PathValue("id"), exp, sig); err != nil {
+		if !errors.Is(err, core.ErrShareLinkInvalid) {
+			log.Printf("Failed to check share link: %v", err)
+		}
+
+		s.renderShare(w, http.StatusNotFound, SharePageData{Error: shareLinkUnavailable})
+
+		return
+	}
+
+	s.renderShare(w, http.StatusOK, SharePageData{Pending: true, Exp: exp, Sig: sig})
+}
+
+// handleShareOpen redeems a one-time share link and renders the read-only
+// repository information
+func (s *Server) handleShareOpen(w http.ResponseWriter, r *http.Request) {
+	link, err := core.RedeemShareLink(s.store, r.PathValue("id"), r.FormValue("exp"), r.FormValue("sig"))
+	if err != nil {
+		if !errors.Is(err, core.ErrShareLinkInvalid) {
+			log.Printf("Failed to redeem share link: %v", err)
+		}
+
+		s.renderShare(w, http.StatusNotFound, SharePageData{Error: shareLinkUnavailable})
+
+		return
+	}
+
+	repos, err := s.store.GetAllRepos()
+	if err != nil {
+		log.Printf("Failed to list repositories: %v", err)
+		s.renderShare(w, http.StatusInternalServerError, SharePageData{Error: "Repository information is unavailable."})
+
+		return
+	}
+
+	for _, repo := range repos {
+		if repo.URL == link.RepoURL {
+			view := core.BuildShareView(link, repo)
+			s.renderShare(w, http.StatusOK, SharePageData{View: &view})
+
+			return
+		}
+	}
+
+	s.renderShare(w, http.StatusNotFound, SharePageData{Error: "The shared repository is no longer tracked."})
+}
+
+// renderShare renders the standalone share page
+func (s *Server) renderShare(w http.ResponseWriter, code int, data SharePageData) {
+	tmpl, ok := s.templates["share.html"]
+	if !ok {
+		log.Printf("Template not found: share.html")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(code)
+
+	if err := tmpl.ExecuteTemplate(w, "share", data); err != nil {
+		log.Printf("Template error: %v", err)
+	}
+}
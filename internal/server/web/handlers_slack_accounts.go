@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/slack"
@@ -127,6 +128,10 @@ func (s *Server) handleCreateSlackAccount(w http.ResponseWriter, r *http.Request
 
 	log.Printf("Slack token validated for workspace %q (user: %s)", authInfo.Team, authInfo.User)
 
+	if check := core.CheckScopes(authInfo.Scopes, core.ParseScopes(slack.DefaultScopes), nil); len(check.Missing) > 0 {
+		log.Printf("Slack token for %q is missing scopes: %s", name, strings.Join(check.Missing, ", "))
+	}
+
 	// Create account with workspace info from auth test
 	account, err := s.slackAccountService.CreateAccountWithInfo(
 		name,
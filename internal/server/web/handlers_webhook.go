@@ -0,0 +1,135 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// maxWebhookBody limits the size of webhook payloads read into memory
+const maxWebhookBody = 5 << 20
+
+// WebhookResponse lists the repositories a push webhook pulls
+type WebhookResponse struct {
+	Pulling []string `json:"pulling"`
+}
+
+// handleWebhook receives a GitHub or GitLab push webhook and pulls the
+// tracked repositories of the pushed remote that have webhooks enabled.
+// Pulls run in the background so the Git host does not time out.
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		s.jsonError(w, "Payload too large or unreadable", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	secret, err := core.WebhookSecret()
+	if err != nil {
+		log.Printf("Failed to load webhook secret: %v", err)
+		s.jsonError(w, "Webhook secret is unavailable", http.StatusInternalServerError)
+
+		return
+	}
+
+	push, err := core.ParseWebhookPush(r.PathValue("provider"), r.Header, body, secret)
+	switch {
+	case errors.Is(err, core.ErrWebhookIgnored):
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case errors.Is(err, core.ErrWebhookSignature):
+		log.Printf("Rejected %s webhook from %s: %v", r.PathValue("provider"), r.RemoteAddr, err)
+		s.jsonError(w, "Invalid signature", http.StatusUnauthorized)
+
+		return
+	case err != nil:
+		s.jsonError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cfg, err := s.store.GetConfig()
+	if err != nil {
+		log.Printf("Failed to get config: %v", err)
+		s.jsonError(w, "Configuration is unavailable", http.StatusInternalServerError)
+
+		return
+	}
+
+	repos, err := s.store.GetAllRepos()
+	if err != nil {
+		log.Printf("Failed to list repositories: %v", err)
+		s.jsonError(w, "Repositories are unavailable", http.StatusInternalServerError)
+
+		return
+	}
+
+	resp := WebhookResponse{Pulling: []string{}}
+
+	for _, repo := range core.WebhookTargets(repos, cfg.Webhooks, push) {
+		resp.Pulling = append(resp.Pulling, repo.URL)
+
+		go s.webhookPull(repo, push.Ref)
+	}
+
+	// Tracked repositories that are not pulled automatically get an update
+	// available notification instead
+	for _, repo := range core.WebhookTargets(repos, trackedURLs(repos), push) {
+		if !core.WebhookEnabled(cfg.Webhooks, repo.URL) {
+			go core.NotifyUpdateAvailable(context.Background(), repo.URL, strings.TrimPrefix(push.Ref, "refs/heads/"), "")
+		}
+	}
+
+	// Headers set after WriteHeader are dropped, so the content type
+	// jsonResponse sets must be set first
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	s.jsonResponse(w, resp)
+}
+
+// webhookPull pulls a repository for a push webhook. Pulls of the same
+// repository are serialized so a burst of pushes does not run git
+// concurrently in one working tree.
+func (s *Server) webhookPull(repo model.Repository, ref string) {
+	lock, _ := s.webhookPulls.LoadOrStore(repo.Path, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if err := core.PullRepo(repo.Path); err != nil {
+		log.Printf("Webhook pull of %s failed: %v", repo.URL, err)
+		core.NotifySyncFailure(context.Background(), repo.URL, err)
+
+		s.BroadcastEvent(EventNotification, "Webhook pull failed", map[string]any{
+			"url":   repo.URL,
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	if err := s.store.UpdateRepoTimestamp(repo.URL); err != nil {
+		log.Printf("Failed to update timestamp of %s: %v", repo.URL, err)
+	}
+
+	log.Printf("Webhook pulled %s (%s)", repo.URL, ref)
+	s.BroadcastEvent(EventRepoPulled, "Repository pulled", map[string]any{
+		"url": repo.URL,
+		"ref": ref,
+	})
+}
+
+// trackedURLs returns the URLs of repos
+func trackedURLs(repos []model.Repository) []string {
+	urls := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		urls = append(urls, repo.URL)
+	}
+
+	return urls
+}
@@ -654,8 +654,13 @@ func (s *Server) handleSlackMessages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Fetch user info for messages
-	userCache := make(map[string]*slack.User)
+	// Resolve the authors of all messages at once
+	userIDs := make([]string, 0, len(result.Messages))
+	for _, msg := range result.Messages {
+		userIDs = append(userIDs, msg.User)
+	}
+
+	users := s.slackService.GetUsers(r.Context(), userIDs)
 	messages := make([]map[string]any, 0, len(result.Messages))
 
 	for _, msg := range result.Messages {
@@ -675,18 +680,10 @@ func (s *Server) handleSlackMessages(w http.ResponseWriter, r *http.Request) {
 			msgData["FormattedTime"] = t.Format("Jan 2, 3:04 PM")
 		}
 
-		// Get user info
-		if msg.User != "" {
-			if user, ok := userCache[msg.User]; ok {
-				msgData["UserName"] = getUserDisplayName(user)
-				msgData["UserAvatar"] = user.Profile.Image48
-			} else {
-				if user, err := s.slackService.GetUser(r.Context(), msg.User); err == nil {
-					userCache[msg.User] = user
-					msgData["UserName"] = getUserDisplayName(user)
-					msgData["UserAvatar"] = user.Profile.Image48
-				}
-			}
+		// User info
+		if user, ok := users[msg.User]; ok {
+			msgData["UserName"] = user.DisplayName()
+			msgData["UserAvatar"] = user.Profile.Image48
 		}
 
 		// Bot info
@@ -777,16 +774,3 @@ func (s *Server) handleSlackSearch(w http.ResponseWriter, r *http.Request) {
 		"query":    query,
 	})
 }
-
-// getUserDisplayName returns the best display name for a user
-func getUserDisplayName(user *slack.User) string {
-	if user.Profile.DisplayName != "" {
-		return user.Profile.DisplayName
-	}
-
-	if user.RealName != "" {
-		return user.RealName
-	}
-
-	return user.Name
-}
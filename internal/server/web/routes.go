@@ -21,6 +21,11 @@ func (s *Server) setupRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET /slack/messages", s.handleSlackMessagesPage)
 	mux.HandleFunc("GET /slack/accounts", s.handleSlackAccountsPage)
 	mux.HandleFunc("GET /slack/accounts/add", s.handleSlackAccountAddPage)
+	mux.HandleFunc("GET /share/{id}", s.handleSharePage)
+	mux.HandleFunc("POST /share/{id}", s.handleShareOpen)
+
+	// Push webhooks from GitHub and GitLab
+	mux.HandleFunc("POST /webhooks/{provider}", s.handleWebhook)
 
 	// Profile API
 	mux.HandleFunc("GET /api/profiles", s.handleListProfiles)
@@ -37,18 +42,18 @@ func (s *Server) setupRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("DELETE /api/workspaces/{name}", s.handleDeleteWorkspace)
 
 	// OAuth flows
-	mux.HandleFunc("GET /oauth/github/start", s.handleGitHubOAuthStart)
+	mux.HandleFunc("GET /oauth/github/start", s.online(s.handleGitHubOAuthStart))
 	mux.HandleFunc("GET /oauth/github/status", s.handleGitHubOAuthStatus)
-	mux.HandleFunc("GET /oauth/slack/start", s.handleSlackOAuthStart)
-	mux.HandleFunc("GET /oauth/slack/callback", s.handleSlackOAuthCallback)
+	mux.HandleFunc("GET /oauth/slack/start", s.online(s.handleSlackOAuthStart))
+	mux.HandleFunc("GET /oauth/slack/callback", s.online(s.handleSlackOAuthCallback))
 
 	// Slack API
 	mux.HandleFunc("GET /api/slack/status", s.handleSlackStatus)
-	mux.HandleFunc("POST /api/slack/add", s.handleSlackAdd)
+	mux.HandleFunc("POST /api/slack/add", s.online(s.handleSlackAdd))
 	mux.HandleFunc("DELETE /api/slack/remove", s.handleSlackRemove)
-	mux.HandleFunc("GET /api/slack/channels", s.handleSlackChannels)
-	mux.HandleFunc("GET /api/slack/messages", s.handleSlackMessages)
-	mux.HandleFunc("GET /api/slack/search", s.handleSlackSearch)
+	mux.HandleFunc("GET /api/slack/channels", s.online(s.handleSlackChannels))
+	mux.HandleFunc("GET /api/slack/messages", s.online(s.handleSlackMessages))
+	mux.HandleFunc("GET /api/slack/search", s.online(s.handleSlackSearch))
 
 	// Slack Accounts API
 	mux.HandleFunc("GET /api/slack/accounts", s.handleListSlackAccounts)
@@ -59,6 +64,9 @@ func (s *Server) setupRoutes(mux *http.ServeMux) {
 	// System
 	mux.HandleFunc("GET /api/status", s.handleStatus)
 	mux.HandleFunc("GET /health", s.handleHealth)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+	mux.HandleFunc("GET /api/store/metrics", s.handleStoreMetrics)
+	mux.HandleFunc("DELETE /api/store/metrics", s.handleResetStoreMetrics)
 
 	// SSE (Server-Sent Events)
 	mux.HandleFunc("GET /events", s.handleSSE)
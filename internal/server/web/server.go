@@ -11,8 +11,10 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/inovacc/clonr/internal/core"
 	"github.com/inovacc/clonr/internal/service"
 	"github.com/inovacc/clonr/internal/store"
 )
@@ -50,6 +52,9 @@ type Server struct {
 	slackService        *service.SlackService
 	slackAccountService *service.SlackAccountService
 	store               store.Store
+
+	// webhookPulls holds a *sync.Mutex per repository path being pulled
+	webhookPulls sync.Map
 }
 
 // New creates a new web server with direct database access
@@ -181,10 +186,19 @@ func parseTemplates() (map[string]*template.Template, error) {
 		templates[page] = tmpl
 	}
 
+	// The share page is shown to people without access to the web UI,
+	// so it is parsed without the navigation layout
+	shareTmpl, err := template.New("").Funcs(funcMap).ParseFS(templatesFS, "templates/share.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse share.html: %w", err)
+	}
+
+	templates["share.html"] = shareTmpl
+
 	// Parse partial templates separately (for HTMX responses)
 	partialTmpl := template.New("").Funcs(funcMap)
 
-	partialTmpl, err := partialTmpl.ParseFS(templatesFS, "templates/partials/*.html")
+	partialTmpl, err = partialTmpl.ParseFS(templatesFS, "templates/partials/*.html")
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse partials: %w", err)
 	}
@@ -203,7 +217,7 @@ func (s *Server) Start(ctx context.Context) error {
 
 	s.httpServer = &http.Server{
 		Addr:              addr,
-		Handler:           s.loggingMiddleware(mux),
+		Handler:           s.loggingMiddleware(s.remoteAccessMiddleware(mux)),
 		ReadHeaderTimeout: 10 * time.Second,
 		ReadTimeout:       30 * time.Second,
 		WriteTimeout:      30 * time.Second,
@@ -270,6 +284,51 @@ func (s *Server) loggingMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// remoteAccessMiddleware limits clients on other machines to share links
+// and webhooks. The web UI has no authentication of its own, so when the
+// server listens on a non-loopback address only /share/ pages, their assets
+// and the signed /webhooks/ endpoints are reachable from outside.
+func (s *Server) remoteAccessMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isLoopbackRequest(r) && !remotePath(r.URL.Path) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// online refuses requests to a network integration with 503 while
+// air-gapped mode is enabled
+func (s *Server) online(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if core.IsOffline() {
+			s.jsonError(w, core.ErrOffline.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// remotePath reports whether a path may be requested from other machines
+func remotePath(path string) bool {
+	return strings.HasPrefix(path, "/share/") || strings.HasPrefix(path, "/static/") || strings.HasPrefix(path, "/webhooks/")
+}
+
+// isLoopbackRequest reports whether r comes from the local machine
+func isLoopbackRequest(r *http.Request) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
 // openBrowser opens the default browser to the given URL
 func openBrowser(url string) error {
 	var cmd *exec.Cmd
@@ -189,6 +189,7 @@ const (
 	EventSlackAccountCreated   = "slack:account:created"
 	EventSlackAccountDeleted   = "slack:account:deleted"
 	EventSlackAccountActivated = "slack:account:activated"
+	EventRepoPulled            = "repo:pulled"
 	EventServerStatus          = "server:status"
 	EventNotification          = "notification"
 )
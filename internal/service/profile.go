@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"sort"
 	"time"
 
+	"github.com/inovacc/clonr/internal/audit"
 	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/store"
@@ -265,12 +267,20 @@ func (ps *ProfileService) GetNotifyChannelByType(profileName string, channelType
 func (ps *ProfileService) DecryptChannelConfig(profileName string, channel *model.NotifyChannel) (map[string]string, error) {
 	decrypted := make(map[string]string)
 
+	var (
+		keys    []string
+		success = true
+	)
+
 	for key, value := range channel.Config {
 		if isSensitiveKey(key) && value != "" {
+			keys = append(keys, key)
+
 			plaintext, err := tpm.DecryptToken([]byte(value), profileName, string(channel.Type))
 			if err != nil {
 				// If decryption fails, the value might not be encrypted
 				decrypted[key] = value
+				success = false
 			} else {
 				decrypted[key] = plaintext
 			}
@@ -279,6 +289,11 @@ func (ps *ProfileService) DecryptChannelConfig(profileName string, channel *mode
 		}
 	}
 
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		audit.RecordDecrypt("channel", profileName, string(channel.Type), channel.Name, keys, success)
+	}
+
 	return decrypted, nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/inovacc/clonr/internal/audit"
 	grpcserver "github.com/inovacc/clonr/internal/server/grpc"
 	"github.com/inovacc/clonr/internal/store"
 	"github.com/spf13/cobra"
@@ -19,6 +20,8 @@ func Service(cmd *cobra.Command, args []string, port int) error {
 	// Initialize database
 	db := store.GetDB()
 
+	audit.SetRole("server")
+
 	// If port not specified via flag, try to get from config
 	if port == 50051 {
 		cfg, err := db.GetConfig()
@@ -36,7 +39,7 @@ func Service(cmd *cobra.Command, args []string, port int) error {
 	}
 
 	// Create gRPC server (no idle timeout for service mode - run forever)
-	srv := grpcserver.NewServer(db, 0)
+	srv := grpcserver.NewServer(db, 0, grpcserver.SecurityConfig{})
 
 	// Start server in background
 	go func() {
@@ -47,10 +50,28 @@ func Service(cmd *cobra.Command, args []string, port int) error {
 		}
 	}()
 
+	// Serve local clients on the default unix socket, which they probe
+	// before TCP ports
+	if path, err := grpcserver.SocketPath(); err == nil {
+		if socketLis, err := grpcserver.ListenSocket(path); err != nil {
+			log.Printf("Warning: unix socket disabled: %v", err)
+		} else {
+			go func() {
+				if err := srv.GRPCServer.Serve(socketLis); err != nil {
+					log.Printf("Unix socket server error: %v", err)
+				}
+			}()
+		}
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
-	<-quit
+
+	select {
+	case <-quit:
+	case <-srv.Service.ShutdownRequested():
+	}
 
 	// Graceful shutdown
 	log.Println("Shutting down server...")
@@ -113,6 +113,17 @@ func (ss *SlackService) GetUser(ctx context.Context, userID string) (*slack.User
 	return client.GetUser(ctx, userID)
 }
 
+// GetUsers returns the users with the given IDs, keyed by ID; users that
+// cannot be resolved are left out.
+func (ss *SlackService) GetUsers(ctx context.Context, userIDs []string) map[string]*slack.User {
+	client, err := ss.GetSlackClient()
+	if err != nil {
+		return nil
+	}
+
+	return client.GetUsers(ctx, userIDs)
+}
+
 // SearchMessages searches Slack messages.
 func (ss *SlackService) SearchMessages(ctx context.Context, query string, count int) (*slack.SearchResult, error) {
 	client, err := ss.GetSlackClient()
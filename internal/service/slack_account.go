@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/inovacc/clonr/internal/audit"
 	"github.com/inovacc/clonr/internal/crypto/tpm"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/store"
@@ -271,6 +272,9 @@ func (sas *SlackAccountService) getTokenFromAccount(account *model.SlackAccount)
 	}
 
 	token, err := tpm.DecryptToken(account.EncryptedBotToken, account.Name, "slack")
+
+	audit.RecordDecrypt("slack_account", account.Name, "slack", account.WorkspaceName, []string{"bot_token"}, err == nil)
+
 	if err != nil {
 		return "", fmt.Errorf("failed to decrypt token: %w", err)
 	}
@@ -1,6 +1,7 @@
 package slack
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -10,6 +11,9 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/inovacc/clonr/internal/core"
+	"github.com/inovacc/clonr/internal/ratelimit"
 )
 
 const (
@@ -18,7 +22,7 @@ const (
 
 // Client is a Slack API client for reading data.
 type Client struct {
-	token      string
+	tokens     *tokenSource
 	httpClient *http.Client
 	logger     *slog.Logger
 }
@@ -26,9 +30,24 @@ type Client struct {
 // ClientOptions configures a Slack client.
 type ClientOptions struct {
 	Logger *slog.Logger
+
+	// Token rotation: an app with rotation enabled gets expiring xoxe
+	// tokens that are refreshed with the refresh token and app credentials
+	RefreshToken string
+	ClientID     string
+	ClientSecret string
+
+	// ExpiresAt is when the token expires; zero when unknown
+	ExpiresAt time.Time
+
+	// OnTokenRefresh is called after the token was rotated, so the new
+	// token can be stored. The result always carries the refresh token in use.
+	OnTokenRefresh func(token *OAuthResult)
 }
 
-// NewClient creates a new Slack API client.
+// NewClient creates a new Slack API client. With a refresh token, client ID
+// and client secret, a rotating token is refreshed before it expires or when
+// the API reports it expired, and the request retried transparently.
 func NewClient(token string, opts ClientOptions) *Client {
 	logger := opts.Logger
 	if logger == nil {
@@ -36,14 +55,32 @@ func NewClient(token string, opts ClientOptions) *Client {
 	}
 
 	return &Client{
-		token: token,
+		tokens: &tokenSource{
+			clientID:     opts.ClientID,
+			clientSecret: opts.ClientSecret,
+			refresh:      RefreshAccessToken,
+			onRefresh:    opts.OnTokenRefresh,
+			accessToken:  token,
+			refreshToken: opts.RefreshToken,
+			expiresAt:    opts.ExpiresAt,
+		},
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: ratelimit.NewTransport(http.DefaultTransport),
 		},
 		logger: logger,
 	}
 }
 
+// CacheScope identifies the account of the client in response cache keys
+// without revealing its token.
+func (c *Client) CacheScope() string {
+	c.tokens.mu.Lock()
+	defer c.tokens.mu.Unlock()
+
+	return core.CacheKeyHash(c.tokens.accessToken)
+}
+
 // Channel represents a Slack channel.
 type Channel struct {
 	ID             string   `json:"id"`
@@ -64,6 +101,7 @@ type Channel struct {
 	Updated        int64    `json:"updated"`
 	PreviousNames  []string `json:"previous_names"`
 	NameNormalized string   `json:"name_normalized"`
+	User           string   `json:"user,omitempty"` // The other member of a direct message
 }
 
 // Topic represents a channel topic.
@@ -623,7 +661,8 @@ func (c *Client) AuthTest(ctx context.Context) (*AuthTestResult, error) {
 		BotID  string `json:"bot_id"`
 	}
 
-	if err := c.get(ctx, "auth.test", nil, &resp); err != nil {
+	header, err := c.getWithHeader(ctx, "auth.test", nil, &resp)
+	if err != nil {
 		return nil, err
 	}
 
@@ -631,14 +670,21 @@ func (c *Client) AuthTest(ctx context.Context) (*AuthTestResult, error) {
 		return nil, fmt.Errorf("slack API error: %s", resp.Error)
 	}
 
-	return &AuthTestResult{
+	result := &AuthTestResult{
 		URL:    resp.URL,
 		Team:   resp.Team,
 		User:   resp.User,
 		TeamID: resp.TeamID,
 		UserID: resp.UserID,
 		BotID:  resp.BotID,
-	}, nil
+	}
+
+	// Slack reports the token's scopes in the X-OAuth-Scopes header
+	if _, ok := header["X-Oauth-Scopes"]; ok {
+		result.Scopes = core.ParseScopes(header.Get("X-OAuth-Scopes"))
+	}
+
+	return result, nil
 }
 
 // AuthTestResult contains auth test information.
@@ -649,43 +695,163 @@ type AuthTestResult struct {
 	TeamID string `json:"team_id"`
 	UserID string `json:"user_id"`
 	BotID  string `json:"bot_id"`
+
+	// Scopes are the scopes granted to the token (nil if not reported)
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// PostMessageOptions configures PostMessage.
+type PostMessageOptions struct {
+	Channel string `json:"channel"`
+
+	// Text is the message, or the notification fallback when blocks are set
+	Text string `json:"text,omitempty"`
+
+	// ThreadTS posts the message as a reply in the thread of that message
+	ThreadTS string `json:"thread_ts,omitempty"`
+
+	// ReplyBroadcast also shows a thread reply in the channel
+	ReplyBroadcast bool `json:"reply_broadcast,omitempty"`
+
+	// Blocks and Attachments are Block Kit blocks and legacy attachments,
+	// passed to the API as JSON
+	Blocks      any `json:"blocks,omitempty"`
+	Attachments any `json:"attachments,omitempty"`
+
+	UnfurlLinks bool `json:"unfurl_links"`
+	UnfurlMedia bool `json:"unfurl_media"`
+}
+
+// PostMessageResult contains the posted message.
+type PostMessageResult struct {
+	Channel   string  `json:"channel"`
+	Timestamp string  `json:"ts"`
+	Message   Message `json:"message"`
+}
+
+// PostMessage sends a message to a channel, or a reply when ThreadTS is set.
+func (c *Client) PostMessage(ctx context.Context, opts PostMessageOptions) (*PostMessageResult, error) {
+	if opts.Channel == "" {
+		return nil, fmt.Errorf("channel is required")
+	}
+
+	if opts.Text == "" && opts.Blocks == nil && opts.Attachments == nil {
+		return nil, fmt.Errorf("text, blocks or attachments are required")
+	}
+
+	var resp struct {
+		slackResponse
+		PostMessageResult
+	}
+
+	if err := c.post(ctx, "chat.postMessage", opts, &resp); err != nil {
+		return nil, err
+	}
+
+	if !resp.OK {
+		return nil, fmt.Errorf("slack API error: %s", resp.Error)
+	}
+
+	return &resp.PostMessageResult, nil
 }
 
 // get makes a GET request to the Slack API.
 func (c *Client) get(ctx context.Context, method string, params url.Values, result any) error {
+	_, err := c.getWithHeader(ctx, method, params, result)
+
+	return err
+}
+
+// getWithHeader makes a GET request to the Slack API and returns the response headers.
+func (c *Client) getWithHeader(ctx context.Context, method string, params url.Values, result any) (http.Header, error) {
 	u := fmt.Sprintf("%s/%s", slackAPIBaseURL, method)
 
 	if params != nil {
 		u = u + "?" + params.Encode()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	c.logger.Debug("slack API request", "method", method, "params", params)
+
+	return c.call(ctx, http.MethodGet, u, nil, result)
+}
+
+// post makes a POST request with a JSON payload to the Slack API.
+func (c *Client) post(ctx context.Context, method string, payload, result any) error {
+	body, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
+	c.logger.Debug("slack API request", "method", method)
 
-	c.logger.Debug("slack API request", "method", method, "params", params)
+	_, err = c.call(ctx, http.MethodPost, fmt.Sprintf("%s/%s", slackAPIBaseURL, method), body, result)
+
+	return err
+}
+
+// call sends a request with the current token, retrying once with a rotated
+// token when the API reports it expired, and decodes the response.
+func (c *Client) call(ctx context.Context, httpMethod, u string, body []byte, result any) (http.Header, error) {
+	token := c.tokens.Token(ctx)
+
+	header, respBody, err := c.do(ctx, httpMethod, u, token, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if tokenRejected(respBody) && c.tokens.canRefresh() {
+		newToken, refreshErr := c.tokens.refreshIfCurrent(ctx, token)
+		if refreshErr != nil {
+			c.logger.Warn("failed to refresh slack token", "error", refreshErr)
+		} else {
+			c.logger.Debug("slack token rotated", "url", u)
+
+			header, respBody, err = c.do(ctx, httpMethod, u, newToken, body)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := json.Unmarshal(respBody, result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return header, nil
+}
+
+// do sends a request to u with token and returns the response headers and body.
+func (c *Client) do(ctx context.Context, httpMethod, u, token string, body []byte) (http.Header, []byte, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u, reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to make request: %w", err)
+		return nil, nil, fmt.Errorf("failed to make request: %w", err)
 	}
 
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned %d: %s", resp.StatusCode, string(body))
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("API returned %d: %s", resp.StatusCode, string(respBody))
 	}
 
-	return nil
+	return resp.Header, respBody, nil
 }
 
 // ParseTimestamp parses a Slack timestamp to time.Time.
@@ -0,0 +1,87 @@
+package slack
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// DirectMessageTypes are the conversation types of direct messages and
+// group direct messages.
+const DirectMessageTypes = "im,mpim"
+
+// mpimName matches the generated name of a group direct message, e.g.
+// mpdm-alice--bob--carol-1
+var mpimName = regexp.MustCompile(`^mpdm-(.+)-\d+$`)
+
+// GroupMembers returns the usernames of the members of a group direct
+// message, parsed from its generated name, or nil for other conversations.
+func (ch *Channel) GroupMembers() []string {
+	if !ch.IsMpIM {
+		return nil
+	}
+
+	m := mpimName.FindStringSubmatch(ch.Name)
+	if m == nil {
+		return nil
+	}
+
+	return strings.Split(m[1], "--")
+}
+
+// FindDirectMessage returns the conversation with the named users: the
+// direct message with a single user, or the smallest group direct message
+// including all of them. Users of direct messages are named by ID,
+// username, display name or full name, looked up in users; members of
+// group direct messages by username.
+func FindDirectMessage(conversations []Channel, users map[string]*User, names []string) (*Channel, bool) {
+	if len(names) == 0 {
+		return nil, false
+	}
+
+	if len(names) == 1 {
+		for i, ch := range conversations {
+			if ch.IsIM && userMatches(ch.User, users[ch.User], names[0]) {
+				return &conversations[i], true
+			}
+		}
+	}
+
+	var found *Channel
+
+	for i, ch := range conversations {
+		members := ch.GroupMembers()
+		if members == nil {
+			continue
+		}
+
+		includesAll := !slices.ContainsFunc(names, func(name string) bool {
+			return !slices.ContainsFunc(members, func(member string) bool {
+				return strings.EqualFold(member, strings.TrimPrefix(name, "@"))
+			})
+		})
+
+		if includesAll && (found == nil || len(members) < len(found.GroupMembers())) {
+			found = &conversations[i]
+		}
+	}
+
+	return found, found != nil
+}
+
+// userMatches reports whether name names the user with the given ID
+func userMatches(id string, user *User, name string) bool {
+	name = strings.TrimPrefix(name, "@")
+
+	if strings.EqualFold(id, name) {
+		return true
+	}
+
+	if user == nil {
+		return false
+	}
+
+	return strings.EqualFold(user.Name, name) ||
+		strings.EqualFold(user.Profile.DisplayName, name) ||
+		strings.EqualFold(user.RealName, name)
+}
@@ -0,0 +1,57 @@
+package slack
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChannelGroupMembers(t *testing.T) {
+	group := Channel{Name: "mpdm-alice--bob.smith--carol-1", IsMpIM: true}
+	if got := group.GroupMembers(); !slices.Equal(got, []string{"alice", "bob.smith", "carol"}) {
+		t.Errorf("GroupMembers() = %v", got)
+	}
+
+	if got := (&Channel{Name: "general", IsChannel: true}).GroupMembers(); got != nil {
+		t.Errorf("GroupMembers() of a channel = %v, want nil", got)
+	}
+}
+
+func TestFindDirectMessage(t *testing.T) {
+	conversations := []Channel{
+		{ID: "D1", IsIM: true, User: "U1"},
+		{ID: "D2", IsIM: true, User: "U2"},
+		{ID: "G1", IsMpIM: true, Name: "mpdm-me--alice--bob--carol-1"},
+		{ID: "G2", IsMpIM: true, Name: "mpdm-me--alice--bob-1"},
+	}
+	users := map[string]*User{
+		"U1": {ID: "U1", Name: "alice", RealName: "Alice Doe"},
+		"U2": {ID: "U2", Name: "bob", Profile: UserProfile{DisplayName: "Bobby"}},
+	}
+
+	tests := []struct {
+		names []string
+		want  string
+	}{
+		{[]string{"alice"}, "D1"},
+		{[]string{"@Alice Doe"}, "D1"},
+		{[]string{"bobby"}, "D2"},
+		{[]string{"U2"}, "D2"},
+		{[]string{"alice", "bob"}, "G2"},
+		{[]string{"carol"}, "G1"},
+		{[]string{"dave"}, ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		ch, ok := FindDirectMessage(conversations, users, tt.names)
+
+		got := ""
+		if ok {
+			got = ch.ID
+		}
+
+		if got != tt.want {
+			t.Errorf("FindDirectMessage(%v) = %q, want %q", tt.names, got, tt.want)
+		}
+	}
+}
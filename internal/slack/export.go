@@ -0,0 +1,232 @@
+package slack
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// exportPageSize is the page size of the history and thread requests of an
+// export
+const exportPageSize = 200
+
+// ExportOptions configures ExportChannel.
+type ExportOptions struct {
+	Channel string
+	Oldest  string // Unix timestamp
+	Latest  string // Unix timestamp
+
+	// SkipThreads exports only the top-level messages
+	SkipThreads bool
+
+	// OnProgress is called with the number of messages fetched so far
+	OnProgress func(messages int)
+}
+
+// ExportedMessage is a message of an export, with its author and time
+// resolved.
+type ExportedMessage struct {
+	Message
+
+	Channel  string    `json:"channel"`
+	UserName string    `json:"user_name,omitempty"`
+	Time     time.Time `json:"time"`
+	IsReply  bool      `json:"is_reply,omitempty"`
+}
+
+// ExportChannel fetches every message of a channel in the time range,
+// following all history pages and, unless skipped, the replies of every
+// thread. Messages are returned oldest first, each thread's replies right
+// after their parent, with author names resolved.
+func (c *Client) ExportChannel(ctx context.Context, opts ExportOptions) ([]ExportedMessage, error) {
+	var (
+		messages []ExportedMessage
+		cursor   string
+	)
+
+	progress := func(count int) {
+		if opts.OnProgress != nil {
+			opts.OnProgress(count)
+		}
+	}
+
+	for {
+		page, err := c.GetChannelHistory(ctx, GetChannelHistoryOptions{
+			Channel: opts.Channel,
+			Limit:   exportPageSize,
+			Oldest:  opts.Oldest,
+			Latest:  opts.Latest,
+			Cursor:  cursor,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get history: %w", err)
+		}
+
+		for _, msg := range page.Messages {
+			messages = append(messages, ExportedMessage{Message: msg, Channel: opts.Channel})
+		}
+
+		progress(len(messages))
+
+		if !page.HasMore || page.NextCursor == "" {
+			break
+		}
+
+		cursor = page.NextCursor
+	}
+
+	// History pages run newest first
+	slices.SortStableFunc(messages, func(a, b ExportedMessage) int {
+		return compareTimestamps(a.Timestamp, b.Timestamp)
+	})
+
+	if !opts.SkipThreads {
+		withReplies := make([]ExportedMessage, 0, len(messages))
+		fetched := len(messages)
+
+		for _, msg := range messages {
+			withReplies = append(withReplies, msg)
+
+			if msg.ReplyCount == 0 || msg.ThreadTS != msg.Timestamp {
+				continue
+			}
+
+			replies, err := c.threadReplies(ctx, opts.Channel, msg.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+
+			withReplies = append(withReplies, replies...)
+
+			fetched += len(replies)
+			progress(fetched)
+		}
+
+		messages = withReplies
+	}
+
+	userIDs := make([]string, 0, len(messages))
+	for _, msg := range messages {
+		userIDs = append(userIDs, msg.User)
+	}
+
+	users := c.GetUsers(ctx, userIDs)
+
+	for i := range messages {
+		msg := &messages[i]
+		msg.Time, _ = ParseTimestamp(msg.Timestamp)
+
+		switch user, ok := users[msg.User]; {
+		case ok:
+			msg.UserName = user.DisplayName()
+		case msg.BotProfile != nil:
+			msg.UserName = msg.BotProfile.Name
+		}
+	}
+
+	return messages, nil
+}
+
+// threadReplies fetches all replies of a thread, without its parent
+func (c *Client) threadReplies(ctx context.Context, channel, threadTS string) ([]ExportedMessage, error) {
+	var (
+		replies []ExportedMessage
+		cursor  string
+	)
+
+	for {
+		page, err := c.GetThreadReplies(ctx, GetThreadRepliesOptions{
+			Channel:  channel,
+			ThreadTS: threadTS,
+			Limit:    exportPageSize,
+			Cursor:   cursor,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get replies of thread %s: %w", threadTS, err)
+		}
+
+		for _, msg := range page.Messages {
+			if msg.Timestamp != threadTS {
+				replies = append(replies, ExportedMessage{Message: msg, Channel: channel, IsReply: true})
+			}
+		}
+
+		if !page.HasMore || page.NextCursor == "" {
+			return replies, nil
+		}
+
+		cursor = page.NextCursor
+	}
+}
+
+// compareTimestamps orders Slack timestamps, which are seconds and
+// microseconds separated by a dot
+func compareTimestamps(a, b string) int {
+	aSec, aMicro, _ := strings.Cut(a, ".")
+	bSec, bMicro, _ := strings.Cut(b, ".")
+
+	return cmp.Or(
+		cmp.Compare(len(aSec), len(bSec)),
+		cmp.Compare(aSec, bSec),
+		cmp.Compare(aMicro, bMicro),
+	)
+}
+
+// WriteJSONL writes messages as JSON Lines, one message per line.
+func WriteJSONL(w io.Writer, messages []ExportedMessage) error {
+	enc := json.NewEncoder(w)
+
+	for _, msg := range messages {
+		if err := enc.Encode(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteMarkdown writes messages as a Markdown document titled title, with
+// thread replies quoted under their parent.
+func WriteMarkdown(w io.Writer, title string, messages []ExportedMessage) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", title)
+
+	for _, msg := range messages {
+		prefix := ""
+		if msg.IsReply {
+			prefix = "> "
+		}
+
+		author := cmp.Or(msg.UserName, msg.User, "unknown")
+		fmt.Fprintf(&b, "%s**%s** · %s\n%s\n", prefix, author, msg.Time.Format("2006-01-02 15:04"), strings.TrimSpace(prefix))
+
+		for line := range strings.SplitSeq(msg.Text, "\n") {
+			fmt.Fprintf(&b, "%s%s\n", prefix, line)
+		}
+
+		for _, f := range msg.Files {
+			fmt.Fprintf(&b, "%s\n%s📎 [%s](%s)\n", strings.TrimSpace(prefix), prefix, f.Name, cmp.Or(f.Permalink, f.URLPrivate))
+		}
+
+		if len(msg.Reactions) > 0 {
+			reactions := make([]string, 0, len(msg.Reactions))
+			for _, r := range msg.Reactions {
+				reactions = append(reactions, fmt.Sprintf(":%s: %d", r.Name, r.Count))
+			}
+
+			fmt.Fprintf(&b, "%s\n%s%s\n", strings.TrimSpace(prefix), prefix, strings.Join(reactions, "  "))
+		}
+
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
@@ -0,0 +1,122 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// historyServer serves a two-page channel history with one thread
+type historyServer struct{}
+
+func (historyServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	q := req.URL.Query()
+
+	var body any
+
+	switch req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:] {
+	case "conversations.history":
+		if q.Get("cursor") == "" {
+			body = map[string]any{
+				"ok": true, "has_more": true, "response_metadata": map[string]string{"next_cursor": "page2"},
+				"messages": []Message{{User: "U1", Text: "third", Timestamp: "1700000300.000100"}},
+			}
+		} else {
+			body = map[string]any{
+				"ok": true,
+				"messages": []Message{
+					{User: "U2", Text: "second", Timestamp: "1700000200.000100"},
+					{User: "U1", Text: "first", Timestamp: "1700000100.000100", ThreadTS: "1700000100.000100", ReplyCount: 1},
+				},
+			}
+		}
+	case "conversations.replies":
+		body = map[string]any{
+			"ok": true,
+			"messages": []Message{
+				{User: "U1", Text: "first", Timestamp: q.Get("ts"), ThreadTS: q.Get("ts")},
+				{User: "U2", Text: "reply", Timestamp: "1700000150.000100", ThreadTS: q.Get("ts")},
+			},
+		}
+	case "users.info":
+		body = map[string]any{"ok": true, "user": User{ID: q.Get("user"), Name: "name-" + q.Get("user")}}
+	}
+
+	data, _ := json.Marshal(body)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(data)),
+		Request:    req,
+	}, nil
+}
+
+func TestExportChannel(t *testing.T) {
+	c := NewClient("xoxb-test", ClientOptions{})
+	c.httpClient = &http.Client{Transport: historyServer{}}
+
+	messages, err := c.ExportChannel(context.Background(), ExportOptions{Channel: "C1"})
+	if err != nil {
+		t.Fatalf("ExportChannel() error = %v", err)
+	}
+
+	var texts []string
+	for _, msg := range messages {
+		texts = append(texts, msg.Text)
+	}
+
+	if got := strings.Join(texts, ","); got != "first,reply,second,third" {
+		t.Fatalf("ExportChannel() order = %s, want first,reply,second,third", got)
+	}
+
+	if !messages[1].IsReply || messages[1].UserName != "name-U2" || messages[0].Channel != "C1" || messages[0].Time.IsZero() {
+		t.Errorf("ExportChannel() reply = %+v", messages[1])
+	}
+
+	messages, err = c.ExportChannel(context.Background(), ExportOptions{Channel: "C1", SkipThreads: true})
+	if err != nil || len(messages) != 3 {
+		t.Errorf("ExportChannel(SkipThreads) = %d messages, %v; want 3", len(messages), err)
+	}
+}
+
+func TestWriteJSONLAndMarkdown(t *testing.T) {
+	messages := []ExportedMessage{
+		{Message: Message{User: "U1", Text: "hello", Timestamp: "1700000100.000100"}, Channel: "C1", UserName: "alice"},
+		{Message: Message{User: "U2", Text: "hi\nthere", Timestamp: "1700000150.000100"}, Channel: "C1", UserName: "bob", IsReply: true},
+	}
+
+	var jsonl bytes.Buffer
+	if err := WriteJSONL(&jsonl, messages); err != nil {
+		t.Fatalf("WriteJSONL() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(jsonl.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"user_name":"alice"`) || !strings.Contains(lines[0], `"ts":"1700000100.000100"`) {
+		t.Errorf("WriteJSONL() = %s", jsonl.String())
+	}
+
+	var md bytes.Buffer
+	if err := WriteMarkdown(&md, "#general", messages); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+
+	for _, want := range []string{"# #general\n", "**alice**", "> **bob**", "> hi\n> there\n"} {
+		if !strings.Contains(md.String(), want) {
+			t.Errorf("WriteMarkdown() missing %q in:\n%s", want, md.String())
+		}
+	}
+}
+
+func TestCompareTimestamps(t *testing.T) {
+	if compareTimestamps("999999999.000001", "1000000000.000000") >= 0 {
+		t.Error("shorter second counts must sort first")
+	}
+
+	if compareTimestamps("1700000000.000002", "1700000000.000010") >= 0 {
+		t.Error("microseconds must break ties")
+	}
+}
@@ -0,0 +1,188 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultMaxDownloadSize is the largest file DownloadFile accepts unless
+// told otherwise.
+const DefaultMaxDownloadSize = 100 << 20
+
+// ErrFileTooLarge is returned for files above the download size limit.
+var ErrFileTooLarge = errors.New("file exceeds the download size limit")
+
+// GetFile gets the metadata of a file.
+func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
+	params := url.Values{}
+	params.Set("file", fileID)
+
+	var resp struct {
+		slackResponse
+
+		File File `json:"file"`
+	}
+
+	if err := c.get(ctx, "files.info", params, &resp); err != nil {
+		return nil, err
+	}
+
+	if !resp.OK {
+		return nil, fmt.Errorf("slack API error: %s", resp.Error)
+	}
+
+	return &resp.File, nil
+}
+
+// GetMessage gets a single message of a channel by its timestamp, looking
+// in the thread of the message when it is a thread reply.
+func (c *Client) GetMessage(ctx context.Context, channel, ts string) (*Message, error) {
+	params := url.Values{}
+	params.Set("channel", channel)
+	params.Set("oldest", ts)
+	params.Set("latest", ts)
+	params.Set("inclusive", "true")
+	params.Set("limit", "1")
+
+	for _, method := range []string{"conversations.history", "conversations.replies"} {
+		if method == "conversations.replies" {
+			params.Set("ts", ts)
+		}
+
+		var resp struct {
+			slackResponse
+
+			Messages []Message `json:"messages"`
+		}
+
+		if err := c.get(ctx, method, params, &resp); err != nil {
+			return nil, err
+		}
+
+		if !resp.OK {
+			return nil, fmt.Errorf("slack API error: %s", resp.Error)
+		}
+
+		for i := range resp.Messages {
+			if resp.Messages[i].Timestamp == ts {
+				return &resp.Messages[i], nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("message %s not found", ts)
+}
+
+// DownloadFile writes the content of a file hosted by Slack to w and
+// returns the number of bytes written. Files larger than maxSize are
+// refused, both by their reported size and while downloading, and the
+// token is only ever sent to Slack hosts. A response that is an HTML page
+// in place of a file, which is how Slack answers tokens without the
+// files:read scope, is reported as an error rather than saved.
+func (c *Client) DownloadFile(ctx context.Context, file *File, w io.Writer, maxSize int64) (int64, error) {
+	switch {
+	case file.IsExternal:
+		return 0, fmt.Errorf("%s is stored in %s, not in Slack: %s", file.Name, file.ExternalType, file.Permalink)
+	case file.Mode == "tombstone" || file.Mode == "hidden_by_limit":
+		return 0, fmt.Errorf("%s is no longer available (%s)", file.Name, file.Mode)
+	case file.Size > maxSize:
+		return 0, fmt.Errorf("%s is %d bytes: %w", file.Name, file.Size, ErrFileTooLarge)
+	}
+
+	downloadURL := file.URLPrivateDownload
+	if downloadURL == "" {
+		downloadURL = file.URLPrivate
+	}
+
+	u, err := url.Parse(downloadURL)
+	if err != nil || u.Scheme != "https" || !isSlackHost(u.Hostname()) {
+		return 0, fmt.Errorf("refusing to download %s from %q", file.Name, downloadURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.tokens.Token(ctx))
+
+	// Downloads take as long as they take; the context bounds them
+	client := &http.Client{
+		Transport: c.httpClient.Transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 || req.URL.Scheme != "https" || !isSlackHost(req.URL.Hostname()) {
+				return fmt.Errorf("refusing redirect to %s", req.URL.Redacted())
+			}
+
+			return nil
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", file.Name, err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to download %s: %s", file.Name, resp.Status)
+	}
+
+	if isHTML(resp.Header.Get("Content-Type")) && !isHTML(file.Mimetype) {
+		return 0, fmt.Errorf("slack returned a web page instead of %s; the token needs the files:read scope", file.Name)
+	}
+
+	n, err := io.Copy(w, io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return n, fmt.Errorf("failed to download %s: %w", file.Name, err)
+	}
+
+	if n > maxSize {
+		return n, fmt.Errorf("%s is larger than %d bytes: %w", file.Name, maxSize, ErrFileTooLarge)
+	}
+
+	return n, nil
+}
+
+// SafeFileName returns name reduced to a plain file name that cannot escape
+// the download directory, falling back to fallback.
+func SafeFileName(name, fallback string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+
+		return r
+	}, name)
+
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." {
+		return fallback
+	}
+
+	return name
+}
+
+// isSlackHost reports whether host belongs to Slack
+func isSlackHost(host string) bool {
+	host = strings.ToLower(host)
+
+	return host == "slack.com" || strings.HasSuffix(host, ".slack.com") ||
+		strings.HasSuffix(host, ".slack-edge.com") || strings.HasSuffix(host, ".slack-files.com")
+}
+
+// isHTML reports whether a media type is HTML
+func isHTML(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	return mediaType == "text/html"
+}
@@ -0,0 +1,139 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// fileServer serves one download, recording the authorization it was sent
+type fileServer struct {
+	contentType string
+	body        string
+	auth        string
+	requests    int
+}
+
+func (f *fileServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.requests++
+	f.auth = req.Header.Get("Authorization")
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"Content-Type": {f.contentType}},
+		Body:       io.NopCloser(strings.NewReader(f.body)),
+		Request:    req,
+	}, nil
+}
+
+func newFileClient(f *fileServer) *Client {
+	c := NewClient("xoxb-test", ClientOptions{})
+	c.httpClient = &http.Client{Transport: f}
+
+	return c
+}
+
+func TestDownloadFile(t *testing.T) {
+	f := &fileServer{contentType: "application/pdf", body: "%PDF-1.7"}
+
+	var buf bytes.Buffer
+
+	n, err := newFileClient(f).DownloadFile(context.Background(), &File{
+		Name:               "report.pdf",
+		Mimetype:           "application/pdf",
+		Size:               8,
+		URLPrivateDownload: "https://files.slack.com/files-pri/T1-F1/download/report.pdf",
+	}, &buf, DefaultMaxDownloadSize)
+	if err != nil {
+		t.Fatalf("DownloadFile() error = %v", err)
+	}
+
+	if n != 8 || buf.String() != "%PDF-1.7" || f.auth != "Bearer xoxb-test" {
+		t.Errorf("DownloadFile() wrote %d bytes %q with auth %q", n, buf.String(), f.auth)
+	}
+}
+
+func TestDownloadFile_Refusals(t *testing.T) {
+	tests := map[string]struct {
+		file        File
+		contentType string
+		body        string
+		maxSize     int64
+		tooLarge    bool
+	}{
+		"non-Slack host": {
+			file: File{Name: "a.txt", URLPrivateDownload: "https://attacker.example/a.txt"},
+		},
+		"plain http": {
+			file: File{Name: "a.txt", URLPrivateDownload: "http://files.slack.com/a.txt"},
+		},
+		"external file": {
+			file: File{Name: "doc", IsExternal: true, ExternalType: "gdrive"},
+		},
+		"reported size over limit": {
+			file:     File{Name: "big.iso", Size: 2048, URLPrivateDownload: "https://files.slack.com/big.iso"},
+			maxSize:  1024,
+			tooLarge: true,
+		},
+		"actual size over limit": {
+			file:        File{Name: "big.iso", URLPrivateDownload: "https://files.slack.com/big.iso"},
+			contentType: "application/octet-stream",
+			body:        strings.Repeat("x", 2048),
+			maxSize:     1024,
+			tooLarge:    true,
+		},
+		"login page": {
+			file:        File{Name: "a.png", Mimetype: "image/png", URLPrivateDownload: "https://files.slack.com/a.png"},
+			contentType: "text/html; charset=utf-8",
+			body:        "<html>sign in</html>",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			maxSize := tt.maxSize
+			if maxSize == 0 {
+				maxSize = DefaultMaxDownloadSize
+			}
+
+			f := &fileServer{contentType: tt.contentType, body: tt.body}
+
+			_, err := newFileClient(f).DownloadFile(context.Background(), &tt.file, io.Discard, maxSize)
+			if err == nil {
+				t.Fatal("DownloadFile() error = nil, want a refusal")
+			}
+
+			if errors.Is(err, ErrFileTooLarge) != tt.tooLarge {
+				t.Errorf("DownloadFile() error = %v, ErrFileTooLarge = %v", err, tt.tooLarge)
+			}
+
+			if tt.contentType == "" && f.requests != 0 {
+				t.Errorf("DownloadFile() sent %d requests, want none", f.requests)
+			}
+		})
+	}
+}
+
+func TestSafeFileName(t *testing.T) {
+	tests := map[string]string{
+		"report.pdf":          "report.pdf",
+		"../../etc/passwd":    "passwd",
+		`..\..\boot.ini`:      "boot.ini",
+		"a:b*c?.txt":          "a_b_c_.txt",
+		"..":                  "F1",
+		"":                    "F1",
+		"line\nbreak.txt":     "line_break.txt",
+		"  spaced name.txt  ": "spaced name.txt",
+	}
+
+	for name, want := range tests {
+		if got := SafeFileName(name, "F1"); got != want {
+			t.Errorf("SafeFileName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -26,7 +26,7 @@ const (
 	OAuthCallbackPath = "/slack/callback"
 
 	// DefaultScopes are the bot token scopes needed for pm slack commands.
-	DefaultScopes = "channels:read,channels:history,groups:read,groups:history,im:history,mpim:history,search:read,users:read"
+	DefaultScopes = "channels:read,channels:history,groups:read,groups:history,im:read,im:history,mpim:read,mpim:history,files:read,search:read,users:read"
 )
 
 // OAuthConfig holds the OAuth configuration.
@@ -199,6 +199,34 @@ func (h *OAuthHandler) ExchangeCode(ctx context.Context, code string) (*OAuthRes
 	data.Set("code", code)
 	data.Set("redirect_uri", h.config.RedirectURI)
 
+	result, err := requestToken(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	return result, nil
+}
+
+// RefreshAccessToken exchanges the refresh token of an app with token
+// rotation for a new access token. Slack rotates the refresh token with
+// every exchange, so the returned one replaces refreshToken.
+func RefreshAccessToken(ctx context.Context, clientID, clientSecret, refreshToken string) (*OAuthResult, error) {
+	data := url.Values{}
+	data.Set("client_id", clientID)
+	data.Set("client_secret", clientSecret)
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", refreshToken)
+
+	result, err := requestToken(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	return result, nil
+}
+
+// requestToken posts data to the oauth.v2.access endpoint
+func requestToken(ctx context.Context, data url.Values) (*OAuthResult, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, SlackOAuthTokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -210,7 +238,7 @@ func (h *OAuthHandler) ExchangeCode(ctx context.Context, code string) (*OAuthRes
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to exchange code: %w", err)
+		return nil, err
 	}
 
 	defer func() { _ = resp.Body.Close() }()
@@ -0,0 +1,119 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway refreshes a rotating token shortly before it expires,
+// so a request started just in time does not fail
+const tokenExpiryLeeway = time.Minute
+
+// tokenSource hands out the access token of a client. For apps with token
+// rotation it refreshes the expiring token with the refresh token, before
+// it expires or when the API reports it expired.
+type tokenSource struct {
+	clientID     string
+	clientSecret string
+
+	// refresh exchanges a refresh token; RefreshAccessToken outside tests
+	refresh func(ctx context.Context, clientID, clientSecret, refreshToken string) (*OAuthResult, error)
+
+	// onRefresh is called with every new token so it can be persisted
+	onRefresh func(*OAuthResult)
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	expiresAt    time.Time
+}
+
+// Token returns a valid access token, refreshing it first when it is about
+// to expire. A failed refresh returns the current token and lets the API
+// reject it.
+func (s *tokenSource) Token(ctx context.Context) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.canRefreshLocked() && !s.expiresAt.IsZero() && time.Until(s.expiresAt) < tokenExpiryLeeway {
+		_ = s.refreshLocked(ctx)
+	}
+
+	return s.accessToken
+}
+
+// canRefresh reports whether the credentials allow refreshing the token
+func (s *tokenSource) canRefresh() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.canRefreshLocked()
+}
+
+func (s *tokenSource) canRefreshLocked() bool {
+	return s.refreshToken != "" && s.clientID != "" && s.clientSecret != ""
+}
+
+// refreshIfCurrent refreshes the access token unless a concurrent request
+// already replaced the rejected one, and returns the token to retry with
+func (s *tokenSource) refreshIfCurrent(ctx context.Context, rejected string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.accessToken != rejected {
+		return s.accessToken, nil
+	}
+
+	if err := s.refreshLocked(ctx); err != nil {
+		return "", err
+	}
+
+	return s.accessToken, nil
+}
+
+func (s *tokenSource) refreshLocked(ctx context.Context) error {
+	result, err := s.refresh(ctx, s.clientID, s.clientSecret, s.refreshToken)
+	if err != nil {
+		return err
+	}
+
+	s.accessToken = result.AccessToken
+
+	if result.RefreshToken == "" {
+		result.RefreshToken = s.refreshToken
+	}
+
+	s.refreshToken = result.RefreshToken
+
+	if result.ObtainedAt.IsZero() {
+		result.ObtainedAt = time.Now()
+	}
+
+	s.expiresAt = time.Time{}
+	if result.ExpiresIn > 0 {
+		s.expiresAt = result.ObtainedAt.Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+
+	if s.onRefresh != nil {
+		s.onRefresh(result)
+	}
+
+	return nil
+}
+
+// tokenRejected reports whether a Slack API response body says the token
+// has expired or was revoked by a rotation
+func tokenRejected(body []byte) bool {
+	var resp struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil || resp.OK {
+		return false
+	}
+
+	return resp.Error == "token_expired" || resp.Error == "invalid_auth"
+}
@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenSourceRefreshesExpiringToken(t *testing.T) {
+	var saved *OAuthResult
+
+	calls := 0
+	s := &tokenSource{
+		clientID:     "id",
+		clientSecret: "secret",
+		refresh: func(_ context.Context, _, _, refreshToken string) (*OAuthResult, error) {
+			calls++
+			if refreshToken != "xoxe-1-old" {
+				t.Errorf("refresh token = %q, want xoxe-1-old", refreshToken)
+			}
+
+			return &OAuthResult{AccessToken: "xoxe.xoxb-new", RefreshToken: "xoxe-1-new", ExpiresIn: 43200}, nil
+		},
+		onRefresh:    func(r *OAuthResult) { saved = r },
+		accessToken:  "xoxe.xoxb-old",
+		refreshToken: "xoxe-1-old",
+		expiresAt:    time.Now().Add(10 * time.Second),
+	}
+
+	if got := s.Token(context.Background()); got != "xoxe.xoxb-new" {
+		t.Fatalf("Token() = %q, want the refreshed token", got)
+	}
+
+	if got := s.Token(context.Background()); got != "xoxe.xoxb-new" || calls != 1 {
+		t.Errorf("Token() = %q after %d refreshes, want one refresh", got, calls)
+	}
+
+	if saved == nil || saved.RefreshToken != "xoxe-1-new" {
+		t.Errorf("onRefresh got %+v, want the rotated refresh token", saved)
+	}
+}
+
+func TestTokenSourceRefreshIfCurrent(t *testing.T) {
+	calls := 0
+	s := &tokenSource{
+		clientID:     "id",
+		clientSecret: "secret",
+		refresh: func(context.Context, string, string, string) (*OAuthResult, error) {
+			calls++
+			return &OAuthResult{AccessToken: "new"}, nil
+		},
+		accessToken:  "old",
+		refreshToken: "refresh",
+	}
+
+	if got, err := s.refreshIfCurrent(context.Background(), "old"); err != nil || got != "new" {
+		t.Fatalf("refreshIfCurrent(old) = %q, %v", got, err)
+	}
+
+	if got, _ := s.refreshIfCurrent(context.Background(), "old"); got != "new" || calls != 1 {
+		t.Errorf("refreshIfCurrent of a replaced token = %q after %d refreshes, want no new refresh", got, calls)
+	}
+
+	if s.refreshToken != "refresh" {
+		t.Errorf("refresh token = %q, want it kept when none is returned", s.refreshToken)
+	}
+}
+
+func TestTokenRejected(t *testing.T) {
+	tests := map[string]bool{
+		`{"ok":false,"error":"token_expired"}`:     true,
+		`{"ok":false,"error":"invalid_auth"}`:      true,
+		`{"ok":false,"error":"channel_not_found"}`: false,
+		`{"ok":true}`: false,
+		`not json`:    false,
+	}
+
+	for body, want := range tests {
+		if got := tokenRejected([]byte(body)); got != want {
+			t.Errorf("tokenRejected(%s) = %v, want %v", body, got, want)
+		}
+	}
+}
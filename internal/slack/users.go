@@ -0,0 +1,116 @@
+package slack
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+)
+
+const (
+	// userLookupWorkers bounds the concurrent users.info requests of GetUsers
+	userLookupWorkers = 8
+
+	// userDirectoryThreshold is the number of users from which GetUsers
+	// pages through users.list instead of looking every user up
+	userDirectoryThreshold = 10
+
+	// userDirectoryPageSize is the users.list page size used by GetUsers
+	userDirectoryPageSize = 200
+)
+
+// GetUsers returns the users with the given IDs, keyed by ID. Many users are
+// resolved in bulk from users.list, which stops paging as soon as every ID
+// is found; the rest, such as members of other workspaces in shared
+// channels, are looked up concurrently with users.info. Users that cannot
+// be resolved are left out of the result.
+func (c *Client) GetUsers(ctx context.Context, userIDs []string) map[string]*User {
+	users := make(map[string]*User, len(userIDs))
+
+	pending := make(map[string]bool)
+	for _, id := range userIDs {
+		if id != "" {
+			pending[id] = true
+		}
+	}
+
+	if len(pending) >= userDirectoryThreshold {
+		c.resolveFromDirectory(ctx, pending, users)
+	}
+
+	ids := slices.Collect(maps.Keys(pending))
+	jobs := make(chan string)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for range min(userLookupWorkers, len(ids)) {
+		wg.Go(func() {
+			for id := range jobs {
+				user, err := c.GetUser(ctx, id)
+				if err != nil {
+					c.logger.Debug("failed to look up Slack user", slog.String("user", id), slog.String("error", err.Error()))
+					continue
+				}
+
+				mu.Lock()
+				users[id] = user
+				mu.Unlock()
+			}
+		})
+	}
+
+	for _, id := range ids {
+		jobs <- id
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return users
+}
+
+// resolveFromDirectory moves the pending users found in users.list to users.
+// A failing page ends the listing; the users still pending are then looked
+// up one by one.
+func (c *Client) resolveFromDirectory(ctx context.Context, pending map[string]bool, users map[string]*User) {
+	cursor := ""
+
+	for len(pending) > 0 {
+		page, err := c.ListUsers(ctx, ListUsersOptions{Cursor: cursor, Limit: userDirectoryPageSize})
+		if err != nil {
+			c.logger.Debug("failed to list Slack users", slog.String("error", err.Error()))
+			return
+		}
+
+		for i := range page.Users {
+			if id := page.Users[i].ID; pending[id] {
+				users[id] = &page.Users[i]
+				delete(pending, id)
+			}
+		}
+
+		if page.NextCursor == "" {
+			return
+		}
+
+		cursor = page.NextCursor
+	}
+}
+
+// DisplayName returns the name the Slack clients show for the user: the
+// display name, else the full name, else the username.
+func (u *User) DisplayName() string {
+	if u.Profile.DisplayName != "" {
+		return u.Profile.DisplayName
+	}
+
+	if u.RealName != "" {
+		return u.RealName
+	}
+
+	return u.Name
+}
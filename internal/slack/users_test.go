@@ -0,0 +1,120 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSlack answers users.list from a directory of users and users.info for
+// any user, recording the methods called
+type fakeSlack struct {
+	mu        sync.Mutex
+	directory []string
+	calls     map[string]int
+}
+
+func (f *fakeSlack) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+
+	f.mu.Lock()
+	f.calls[method]++
+	f.mu.Unlock()
+
+	var body any
+
+	switch method {
+	case "users.list":
+		members := make([]User, 0, len(f.directory))
+		for _, id := range f.directory {
+			members = append(members, User{ID: id, Name: "list-" + id})
+		}
+
+		body = map[string]any{"ok": true, "members": members}
+	case "users.info":
+		id := req.URL.Query().Get("user")
+		if id == "UMISSING" {
+			body = map[string]any{"ok": false, "error": "user_not_found"}
+		} else {
+			body = map[string]any{"ok": true, "user": User{ID: id, Name: "info-" + id}}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected method %s", method)
+	}
+
+	data, _ := json.Marshal(body)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(string(data))),
+		Request:    req,
+	}, nil
+}
+
+func newFakeClient(f *fakeSlack) *Client {
+	c := NewClient("xoxb-test", ClientOptions{})
+	c.httpClient = &http.Client{Transport: f}
+
+	return c
+}
+
+func TestGetUsers_FewUsersAreLookedUp(t *testing.T) {
+	f := &fakeSlack{calls: map[string]int{}}
+
+	users := newFakeClient(f).GetUsers(context.Background(), []string{"U1", "U2", "U1", "", "UMISSING"})
+
+	if len(users) != 2 || users["U1"].Name != "info-U1" || users["U2"].Name != "info-U2" {
+		t.Errorf("GetUsers() = %v, want U1 and U2 from users.info", users)
+	}
+
+	if f.calls["users.list"] != 0 || f.calls["users.info"] != 3 {
+		t.Errorf("calls = %v, want 3 users.info lookups", f.calls)
+	}
+}
+
+func TestGetUsers_ManyUsersComeFromDirectory(t *testing.T) {
+	var ids []string
+	for i := range userDirectoryThreshold {
+		ids = append(ids, fmt.Sprintf("U%d", i))
+	}
+
+	// The last user is missing from the directory, e.g. a Slack Connect member
+	f := &fakeSlack{directory: ids[:len(ids)-1], calls: map[string]int{}}
+
+	users := newFakeClient(f).GetUsers(context.Background(), ids)
+
+	if len(users) != len(ids) {
+		t.Fatalf("GetUsers() resolved %d users, want %d", len(users), len(ids))
+	}
+
+	if users["U0"].Name != "list-U0" || users[ids[len(ids)-1]].Name != "info-"+ids[len(ids)-1] {
+		t.Errorf("GetUsers() = %v, want directory users and one lookup", users)
+	}
+
+	if f.calls["users.list"] != 1 || f.calls["users.info"] != 1 {
+		t.Errorf("calls = %v, want one users.list page and one users.info lookup", f.calls)
+	}
+}
+
+func TestUserDisplayName(t *testing.T) {
+	tests := []struct {
+		user User
+		want string
+	}{
+		{User{Name: "jdoe", RealName: "Jane Doe", Profile: UserProfile{DisplayName: "jane"}}, "jane"},
+		{User{Name: "jdoe", RealName: "Jane Doe"}, "Jane Doe"},
+		{User{Name: "jdoe"}, "jdoe"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.user.DisplayName(); got != tt.want {
+			t.Errorf("DisplayName() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package standalone
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrClientRevoked is returned when a revoked client asks to register again.
+var ErrClientRevoked = errors.New("client access was revoked")
+
+// ErrClientRegistered is returned when a registered client asks to register again.
+var ErrClientRegistered = errors.New("client is already registered")
+
+// ErrInvalidDisplayKey is returned for a client key of the wrong length.
+var ErrInvalidDisplayKey = errors.New("invalid key length")
+
+// ErrFingerprintMismatch is returned when the key entered to approve a
+// client is not the key the client registered with.
+var ErrFingerprintMismatch = errors.New("key does not match the fingerprint the client registered with")
+
+// ClientFingerprint returns a short fingerprint of a client and its
+// encryption key, e.g. "3F2A-9C10-77B4-E5D2". The client shows it next to
+// its key, so the server operator can check they approve the right machine
+// with the right key.
+func ClientFingerprint(clientID string, fullKey []byte) string {
+	h := sha256.New()
+	h.Write([]byte(clientID))
+	h.Write(fullKey)
+
+	sum := strings.ToUpper(hex.EncodeToString(h.Sum(nil)[:8]))
+
+	return sum[0:4] + "-" + sum[4:8] + "-" + sum[8:12] + "-" + sum[12:16]
+}
+
+// AutoApprovePolicy selects the clients registered without waiting for
+// 'clonr standalone clients approve'. Hostnames are reported by the client
+// itself, so the policy is meant for trusted networks.
+type AutoApprovePolicy struct {
+	// Hostnames are glob patterns of the client hostnames approved
+	// automatically, e.g. "build-*" or "*"; empty disables the policy
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// MaxClients stops automatic approval once this many clients are
+	// active; 0 means no limit
+	MaxClients int `json:"max_clients,omitempty"`
+}
+
+// IsZero reports whether the policy approves nothing automatically
+func (p AutoApprovePolicy) IsZero() bool {
+	return len(p.Hostnames) == 0 && p.MaxClients == 0
+}
+
+// Validate checks the hostname patterns and the client limit
+func (p AutoApprovePolicy) Validate() error {
+	for _, pattern := range p.Hostnames {
+		if strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("empty hostname pattern")
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid hostname pattern %q: %w", pattern, err)
+		}
+	}
+
+	if p.MaxClients < 0 {
+		return fmt.Errorf("client limit cannot be negative")
+	}
+
+	if p.MaxClients > 0 && len(p.Hostnames) == 0 {
+		return fmt.Errorf("a client limit needs at least one hostname pattern")
+	}
+
+	return nil
+}
+
+// Allows reports whether reg is approved automatically while active
+// clients are registered
+func (p AutoApprovePolicy) Allows(reg *ClientRegistration, active int) bool {
+	if p.MaxClients > 0 && active >= p.MaxClients {
+		return false
+	}
+
+	hostname := strings.ToLower(reg.MachineInfo.Hostname)
+	if hostname == "" {
+		return false
+	}
+
+	for _, pattern := range p.Hostnames {
+		if ok, _ := path.Match(strings.ToLower(pattern), hostname); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String describes the policy for display
+func (p AutoApprovePolicy) String() string {
+	if len(p.Hostnames) == 0 {
+		return "off (every client waits for approval)"
+	}
+
+	s := "hosts " + strings.Join(p.Hostnames, ", ")
+	if p.MaxClients > 0 {
+		s += fmt.Sprintf(", up to %d active clients", p.MaxClients)
+	}
+
+	return s
+}
+
+// RegistrationStore is the storage ReceiveRegistration needs.
+type RegistrationStore interface {
+	GetStandaloneConfig() (*StandaloneConfig, error)
+	SavePendingRegistration(reg *ClientRegistration) error
+	ListRegisteredClients() ([]*RegisteredClient, error)
+	SaveRegisteredClient(client *RegisteredClient) error
+}
+
+// ReceiveRegistration handles the registration request of a client on the
+// server. A client the auto-approve policy allows is registered at once and
+// returned. Any other request is kept pending, with the fingerprint of its
+// key but not the key, and nil is returned; the operator then approves it
+// by entering the key shown on the client.
+func ReceiveRegistration(db RegistrationStore, reg *ClientRegistration, displayKey string) (*RegisteredClient, error) {
+	config, err := db.GetStandaloneConfig()
+	if err != nil || config == nil || !config.Enabled || !config.IsServer {
+		return nil, fmt.Errorf("standalone server mode is not enabled")
+	}
+
+	cleanKey, err := cleanDisplayKey(displayKey)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := db.ListRegisteredClients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clients: %w", err)
+	}
+
+	active := 0
+
+	for _, c := range clients {
+		if c.ClientID == reg.ClientID {
+			if c.Status == "revoked" {
+				return nil, ErrClientRevoked
+			}
+
+			return nil, fmt.Errorf("%w: %s", ErrClientRegistered, reg.ClientID)
+		}
+
+		if c.Status == "active" {
+			active++
+		}
+	}
+
+	if config.AutoApprove.Allows(reg, active) {
+		client, err := ApproveRegistration(reg, cleanKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := db.SaveRegisteredClient(client); err != nil {
+			return nil, fmt.Errorf("failed to save client registration: %w", err)
+		}
+
+		return client, nil
+	}
+
+	reg.Fingerprint = ClientFingerprint(reg.ClientID, DeriveClientKey(cleanKey))
+	reg.State = HandshakeStateKeyPending
+	reg.InitiatedAt = time.Now()
+
+	if err := db.SavePendingRegistration(reg); err != nil {
+		return nil, fmt.Errorf("failed to save pending registration: %w", err)
+	}
+
+	return nil, nil
+}
+
+// ApproveRegistration registers a pending client with the key entered by
+// the operator. When the client sent the fingerprint of its key, the
+// entered key must match it.
+func ApproveRegistration(reg *ClientRegistration, displayKey string) (*RegisteredClient, error) {
+	cleanKey, err := cleanDisplayKey(displayKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if reg.Fingerprint != "" && ClientFingerprint(reg.ClientID, DeriveClientKey(cleanKey)) != reg.Fingerprint {
+		return nil, ErrFingerprintMismatch
+	}
+
+	handshake := NewServerHandshake()
+	if _, err := handshake.InitiateHandshake(reg); err != nil {
+		return nil, fmt.Errorf("failed to start handshake: %w", err)
+	}
+
+	return handshake.RegisterClient(reg.ClientID, cleanKey)
+}
+
+// cleanDisplayKey removes the formatting of a display key and checks its length
+func cleanDisplayKey(displayKey string) (string, error) {
+	cleanKey := ParseDisplayKey(displayKey)
+	if len(cleanKey) != DisplayKeySize*2 { // hex encoded
+		return "", fmt.Errorf("%w: expected %d characters", ErrInvalidDisplayKey, DisplayKeySize*2)
+	}
+
+	return cleanKey, nil
+}
@@ -0,0 +1,156 @@
+package standalone
+
+import (
+	"errors"
+	"testing"
+)
+
+type fakeRegistrationStore struct {
+	config  *StandaloneConfig
+	pending []*ClientRegistration
+	clients []*RegisteredClient
+}
+
+func (f *fakeRegistrationStore) GetStandaloneConfig() (*StandaloneConfig, error) {
+	return f.config, nil
+}
+
+func (f *fakeRegistrationStore) SavePendingRegistration(reg *ClientRegistration) error {
+	f.pending = append(f.pending, reg)
+	return nil
+}
+
+func (f *fakeRegistrationStore) ListRegisteredClients() ([]*RegisteredClient, error) {
+	return f.clients, nil
+}
+
+func (f *fakeRegistrationStore) SaveRegisteredClient(client *RegisteredClient) error {
+	f.clients = append(f.clients, client)
+	return nil
+}
+
+func TestAutoApprovePolicyAllows(t *testing.T) {
+	reg := func(hostname string) *ClientRegistration {
+		return &ClientRegistration{MachineInfo: MachineInfo{Hostname: hostname}}
+	}
+
+	tests := []struct {
+		name   string
+		policy AutoApprovePolicy
+		reg    *ClientRegistration
+		active int
+		want   bool
+	}{
+		{"no policy", AutoApprovePolicy{}, reg("laptop"), 0, false},
+		{"matching host", AutoApprovePolicy{Hostnames: []string{"build-*"}}, reg("build-01"), 0, true},
+		{"case insensitive", AutoApprovePolicy{Hostnames: []string{"Build-*"}}, reg("BUILD-02"), 0, true},
+		{"other host", AutoApprovePolicy{Hostnames: []string{"build-*"}}, reg("laptop"), 0, false},
+		{"any host", AutoApprovePolicy{Hostnames: []string{"*"}}, reg("laptop"), 0, true},
+		{"no hostname", AutoApprovePolicy{Hostnames: []string{"*"}}, reg(""), 0, false},
+		{"under the limit", AutoApprovePolicy{Hostnames: []string{"*"}, MaxClients: 3}, reg("laptop"), 2, true},
+		{"at the limit", AutoApprovePolicy{Hostnames: []string{"*"}, MaxClients: 3}, reg("laptop"), 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Allows(tt.reg, tt.active); got != tt.want {
+				t.Errorf("Allows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutoApprovePolicyValidate(t *testing.T) {
+	if err := (AutoApprovePolicy{Hostnames: []string{"build-*"}, MaxClients: 5}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	if err := (AutoApprovePolicy{Hostnames: []string{"[build"}}).Validate(); err == nil {
+		t.Error("Validate() accepted an invalid pattern")
+	}
+
+	if err := (AutoApprovePolicy{MaxClients: 5}).Validate(); err == nil {
+		t.Error("Validate() accepted a limit without hostname patterns")
+	}
+}
+
+func TestReceiveRegistration(t *testing.T) {
+	_, displayKey, err := GenerateClientKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := &fakeRegistrationStore{config: &StandaloneConfig{
+		Enabled:     true,
+		IsServer:    true,
+		AutoApprove: AutoApprovePolicy{Hostnames: []string{"build-*"}, MaxClients: 1},
+	}}
+
+	newReg := func(hostname string) *ClientRegistration {
+		return &ClientRegistration{ClientID: GenerateClientID(), ClientName: hostname, MachineInfo: MachineInfo{Hostname: hostname}}
+	}
+
+	// Allowed by the policy: registered at once
+	build := newReg("build-01")
+
+	client, err := ReceiveRegistration(db, build, FormatDisplayKey(displayKey))
+	if err != nil {
+		t.Fatalf("ReceiveRegistration() error = %v", err)
+	}
+
+	if client == nil || client.Status != "active" || len(db.clients) != 1 {
+		t.Fatalf("ReceiveRegistration() = %+v, want the client registered", client)
+	}
+
+	if want := ClientFingerprint(build.ClientID, DeriveClientKey(displayKey)); client.Fingerprint != want {
+		t.Errorf("Fingerprint = %s, want %s", client.Fingerprint, want)
+	}
+
+	// The client limit is reached: kept pending
+	second := newReg("build-02")
+
+	client, err = ReceiveRegistration(db, second, displayKey)
+	if err != nil || client != nil {
+		t.Fatalf("ReceiveRegistration() = %v, %v; want the client pending", client, err)
+	}
+
+	if len(db.pending) != 1 || db.pending[0].Fingerprint == "" || db.pending[0].State != HandshakeStateKeyPending {
+		t.Fatalf("pending = %+v, want one registration with a fingerprint", db.pending)
+	}
+
+	// A registered client cannot register again, a revoked one even less
+	if _, err := ReceiveRegistration(db, build, displayKey); !errors.Is(err, ErrClientRegistered) {
+		t.Errorf("ReceiveRegistration() of a registered client error = %v, want ErrClientRegistered", err)
+	}
+
+	db.clients[0].Status = "revoked"
+
+	if _, err := ReceiveRegistration(db, build, displayKey); !errors.Is(err, ErrClientRevoked) {
+		t.Errorf("ReceiveRegistration() error = %v, want ErrClientRevoked", err)
+	}
+
+	if _, err := ReceiveRegistration(db, newReg("laptop"), "abc"); !errors.Is(err, ErrInvalidDisplayKey) {
+		t.Errorf("ReceiveRegistration() with a short key error = %v, want ErrInvalidDisplayKey", err)
+	}
+}
+
+func TestApproveRegistrationChecksFingerprint(t *testing.T) {
+	_, displayKey, _ := GenerateClientKey()
+	_, otherKey, _ := GenerateClientKey()
+
+	reg := &ClientRegistration{ClientID: GenerateClientID(), ClientName: "laptop"}
+	reg.Fingerprint = ClientFingerprint(reg.ClientID, DeriveClientKey(displayKey))
+
+	if _, err := ApproveRegistration(reg, otherKey); !errors.Is(err, ErrFingerprintMismatch) {
+		t.Errorf("ApproveRegistration() with another key error = %v, want ErrFingerprintMismatch", err)
+	}
+
+	client, err := ApproveRegistration(reg, FormatDisplayKey(displayKey))
+	if err != nil {
+		t.Fatalf("ApproveRegistration() error = %v", err)
+	}
+
+	if client.Fingerprint != reg.Fingerprint || !VerifyClientKey(client, displayKey) {
+		t.Errorf("ApproveRegistration() = %+v, want the client registered with its key", client)
+	}
+}
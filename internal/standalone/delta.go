@@ -0,0 +1,365 @@
+package standalone
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// SyncBuckets are the buckets the source keeps a change log for, one per
+// capability
+func SyncBuckets() []string {
+	return DefaultCapabilities()
+}
+
+// BucketLog is the change log the source keeps for one bucket. Revision is
+// a counter bumped for every record added, changed or removed; each record
+// remembers the revision it last changed at, so a destination asks only for
+// the records changed since the revision it acknowledged.
+type BucketLog struct {
+	Revision int64                `json:"revision"`
+	Records  map[string]RecordRev `json:"records,omitempty"`
+}
+
+// RecordRev is the state of one record in a change log. PrevWorkspace is
+// the workspace a record was in before it moved, so a destination syncing
+// only that workspace is told to drop its copy.
+type RecordRev struct {
+	Revision      int64  `json:"revision"`
+	Checksum      string `json:"checksum,omitempty"`
+	Workspace     string `json:"workspace,omitempty"`
+	PrevWorkspace string `json:"prev_workspace,omitempty"`
+	Deleted       bool   `json:"deleted,omitempty"`
+}
+
+// SyncRecord is the current plaintext of a record offered for sync
+type SyncRecord struct {
+	Workspace string // Workspace of a repo, name of a workspace
+	Data      []byte // JSON of the record
+}
+
+// Record compares the current records of the bucket with the log and gives
+// every added, changed or removed record a new revision. It reports whether
+// anything changed, i.e. whether the log must be saved.
+func (l *BucketLog) Record(current map[string]SyncRecord) bool {
+	if l.Records == nil {
+		l.Records = make(map[string]RecordRev)
+	}
+
+	changed := false
+
+	// Sorted so revisions are assigned deterministically
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		rec := current[key]
+		sum := recordChecksum(rec.Data)
+
+		prev, ok := l.Records[key]
+		if ok && !prev.Deleted && prev.Checksum == sum && prev.Workspace == rec.Workspace {
+			continue
+		}
+
+		l.Revision++
+		next := RecordRev{Revision: l.Revision, Checksum: sum, Workspace: rec.Workspace}
+
+		if ok && !prev.Deleted {
+			next.PrevWorkspace = prev.PrevWorkspace
+			if prev.Workspace != rec.Workspace {
+				next.PrevWorkspace = prev.Workspace
+			}
+		}
+
+		l.Records[key] = next
+		changed = true
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(l.Records)) {
+		prev := l.Records[key]
+		if _, ok := current[key]; ok || prev.Deleted {
+			continue
+		}
+
+		l.Revision++
+		l.Records[key] = RecordRev{Revision: l.Revision, Workspace: prev.Workspace, Deleted: true}
+		changed = true
+	}
+
+	return changed
+}
+
+// ChangedSince returns the keys of the records changed after revision, in
+// revision order. A revision ahead of the log, left by a source whose
+// database was reset, returns every record.
+func (l *BucketLog) ChangedSince(revision int64) []string {
+	if revision > l.Revision {
+		revision = 0
+	}
+
+	var keys []string
+
+	for key, rec := range l.Records {
+		if rec.Revision > revision && (revision > 0 || !rec.Deleted) {
+			keys = append(keys, key)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b string) int {
+		return cmp.Compare(l.Records[a].Revision, l.Records[b].Revision)
+	})
+
+	return keys
+}
+
+// SyncSource is the storage a source reads the records to sync from and
+// keeps its change logs in
+type SyncSource interface {
+	ListProfiles() ([]model.Profile, error)
+	ListWorkspaces() ([]model.Workspace, error)
+	GetAllRepos() ([]model.Repository, error)
+	GetConfig() (*model.Config, error)
+	GetSyncChangeLog(bucket string) (*BucketLog, error)
+	SaveSyncChangeLog(bucket string, log *BucketLog) error
+}
+
+// RecordChanges reads the current records of every bucket from src and
+// records their changes in the change logs, saving those that changed. It
+// returns the logs and records for CreateDeltaPackage.
+func RecordChanges(src SyncSource) (map[string]*BucketLog, map[string]map[string]SyncRecord, error) {
+	records, err := currentRecords(src)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs := make(map[string]*BucketLog, len(records))
+
+	for _, bucket := range SyncBuckets() {
+		log, err := src.GetSyncChangeLog(bucket)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get the change log of %s: %w", bucket, err)
+		}
+
+		if log.Record(records[bucket]) {
+			if err := src.SaveSyncChangeLog(bucket, log); err != nil {
+				return nil, nil, fmt.Errorf("failed to save the change log of %s: %w", bucket, err)
+			}
+		}
+
+		logs[bucket] = log
+	}
+
+	return logs, records, nil
+}
+
+// currentRecords returns the records of every bucket by key: profiles and
+// workspaces by name, repositories by URL and the config as "config"
+func currentRecords(src SyncSource) (map[string]map[string]SyncRecord, error) {
+	records := make(map[string]map[string]SyncRecord)
+
+	add := func(bucket, key, workspace string, v any) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s %s: %w", bucket, key, err)
+		}
+
+		if records[bucket] == nil {
+			records[bucket] = make(map[string]SyncRecord)
+		}
+
+		records[bucket][key] = SyncRecord{Workspace: workspace, Data: data}
+
+		return nil
+	}
+
+	profiles, err := src.ListProfiles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	for _, p := range profiles {
+		if err := add(CapabilityProfiles, p.Name, "", p); err != nil {
+			return nil, err
+		}
+	}
+
+	workspaces, err := src.ListWorkspaces()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	for _, w := range workspaces {
+		if err := add(CapabilityWorkspaces, w.Name, w.Name, w); err != nil {
+			return nil, err
+		}
+	}
+
+	repos, err := src.GetAllRepos()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	for _, r := range repos {
+		if err := add(CapabilityRepos, r.URL, r.Workspace, r); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := src.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	if err := add(CapabilityConfig, "config", "", cfg); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// CreateDeltaPackage builds the sync package bringing a destination from the
+// revisions it acknowledged to the current ones. logs and records are the
+// change logs and current records of each bucket, the logs already updated
+// with Record. Only the records changed since the acknowledged revision of
+// their bucket are included, with tombstones for the removed ones, and only
+// those the filter allows; a record that moved out of the workspaces the
+// filter allows is sent as a tombstone. A bucket without an acknowledged
+// revision is sent whole.
+func CreateDeltaPackage(instanceID string, encryptionKey []byte, logs map[string]*BucketLog, records map[string]map[string]SyncRecord, acked map[string]int64, filter SyncFilter) (*SyncPackage, error) {
+	pkg := &SyncPackage{
+		Version:       2,
+		InstanceID:    instanceID,
+		CreatedAt:     time.Now(),
+		EncryptionKey: ComputeKeyHint(encryptionKey),
+		Revisions:     make(map[string]int64),
+	}
+
+	for _, bucket := range SyncBuckets() {
+		log, ok := logs[bucket]
+		if !ok || !filter.AllowsType(bucket) {
+			continue
+		}
+
+		pkg.Revisions[bucket] = log.Revision
+		itemType := capabilityItemType(bucket)
+
+		for _, key := range log.ChangedSince(acked[bucket]) {
+			rev := log.Records[key]
+			if !filter.Allows(itemType, rev.Workspace) {
+				if rev.Deleted || rev.PrevWorkspace == "" || !filter.Allows(itemType, rev.PrevWorkspace) {
+					continue
+				}
+
+				// Moved out of the filter: the destination drops its copy
+				rev.Workspace, rev.Deleted = rev.PrevWorkspace, true
+			}
+
+			item := SyncPackageItem{
+				Type:      itemType,
+				ID:        key,
+				Name:      key,
+				Workspace: rev.Workspace,
+				Revision:  rev.Revision,
+				Deleted:   rev.Deleted,
+				UpdatedAt: time.Now(),
+			}
+
+			if !rev.Deleted {
+				encrypted, err := EncryptWithKey(records[bucket][key].Data, encryptionKey)
+				if err != nil {
+					return nil, fmt.Errorf("failed to encrypt %s %s: %w", itemType, key, err)
+				}
+
+				item.EncryptedData = encrypted
+			}
+
+			pkg.Items = append(pkg.Items, item)
+		}
+	}
+
+	return pkg, nil
+}
+
+// SyncedDataStore is the storage a destination applies sync packages to
+type SyncedDataStore interface {
+	SaveSyncedData(data *SyncedData) error
+	DeleteSyncedData(connectionName, dataType, name string) error
+}
+
+// ApplySyncPackage stores the items of a package received on conn, removes
+// the records it marks deleted and acknowledges its revisions on conn, which
+// the caller saves. Items the filter of conn excludes are dropped. It
+// returns the number of records stored and removed.
+func ApplySyncPackage(db SyncedDataStore, conn *StandaloneConnection, pkg *SyncPackage) (stored, removed int, err error) {
+	now := time.Now()
+
+	for _, item := range FilterSyncItems(pkg.Items, conn.SyncFilter) {
+		if item.Deleted {
+			if err := db.DeleteSyncedData(conn.Name, item.Type, item.Name); err != nil {
+				return stored, removed, fmt.Errorf("failed to remove %s %s: %w", item.Type, item.Name, err)
+			}
+
+			removed++
+
+			continue
+		}
+
+		if err := db.SaveSyncedData(&SyncedData{
+			ID:             item.ID,
+			ConnectionName: conn.Name,
+			InstanceID:     pkg.InstanceID,
+			DataType:       item.Type,
+			Name:           item.Name,
+			EncryptedData:  item.EncryptedData,
+			State:          SyncStateEncrypted,
+			SyncedAt:       now,
+			Checksum:       recordChecksum(item.EncryptedData),
+		}); err != nil {
+			return stored, removed, fmt.Errorf("failed to store %s %s: %w", item.Type, item.Name, err)
+		}
+
+		stored++
+	}
+
+	if conn.AckedRevisions == nil {
+		conn.AckedRevisions = make(map[string]int64)
+	}
+
+	maps.Copy(conn.AckedRevisions, pkg.Revisions)
+	conn.LastSync = now
+
+	return stored, removed, nil
+}
+
+// SetSyncFilter changes the filter of the connection. The acknowledged
+// revisions are dropped, as they also cover the records the old filter
+// excluded, so the next sync is full.
+func (c *StandaloneConnection) SetSyncFilter(f SyncFilter) {
+	c.SyncFilter = f
+	c.AckedRevisions = nil
+}
+
+// recordChecksum returns the SHA-256 of data in hex
+func recordChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// capabilityItemType returns the item type of the records of a capability
+func capabilityItemType(capability string) string {
+	switch capability {
+	case CapabilityProfiles:
+		return "profile"
+	case CapabilityWorkspaces:
+		return "workspace"
+	case CapabilityRepos:
+		return "repo"
+	default:
+		return "config"
+	}
+}
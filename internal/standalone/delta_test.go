@@ -0,0 +1,212 @@
+package standalone
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// fakeSource is an in-memory source and destination of delta syncs
+type fakeSource struct {
+	repos  []model.Repository
+	logs   map[string]*BucketLog
+	saves  int
+	synced map[string]SyncedData
+}
+
+func (f *fakeSource) ListProfiles() ([]model.Profile, error)     { return nil, nil }
+func (f *fakeSource) ListWorkspaces() ([]model.Workspace, error) { return nil, nil }
+func (f *fakeSource) GetAllRepos() ([]model.Repository, error)   { return f.repos, nil }
+func (f *fakeSource) GetConfig() (*model.Config, error)          { return &model.Config{Editor: "vim"}, nil }
+
+func (f *fakeSource) GetSyncChangeLog(bucket string) (*BucketLog, error) {
+	if log, ok := f.logs[bucket]; ok {
+		// A copy, as a store returns
+		return &BucketLog{Revision: log.Revision, Records: maps.Clone(log.Records)}, nil
+	}
+
+	return &BucketLog{}, nil
+}
+
+func (f *fakeSource) SaveSyncChangeLog(bucket string, log *BucketLog) error {
+	f.logs[bucket] = log
+	f.saves++
+
+	return nil
+}
+
+func (f *fakeSource) SaveSyncedData(data *SyncedData) error {
+	f.synced[data.DataType+":"+data.Name] = *data
+	return nil
+}
+
+func (f *fakeSource) DeleteSyncedData(_, dataType, name string) error {
+	if _, ok := f.synced[dataType+":"+name]; !ok {
+		return errors.New("not found")
+	}
+
+	delete(f.synced, dataType+":"+name)
+
+	return nil
+}
+
+func TestBucketLogRecord(t *testing.T) {
+	var log BucketLog
+
+	if !log.Record(map[string]SyncRecord{"a": {Data: []byte("1")}, "b": {Data: []byte("2")}}) {
+		t.Fatal("Record() of new records reported no change")
+	}
+
+	if log.Revision != 2 {
+		t.Errorf("Revision = %d after two new records, want 2", log.Revision)
+	}
+
+	if log.Record(map[string]SyncRecord{"a": {Data: []byte("1")}, "b": {Data: []byte("2")}}) {
+		t.Error("Record() of unchanged records reported a change")
+	}
+
+	// b changes, a is removed, c is added
+	log.Record(map[string]SyncRecord{"b": {Data: []byte("3")}, "c": {Data: []byte("4")}})
+
+	if got := log.ChangedSince(2); !slices.Equal(got, []string{"b", "c", "a"}) {
+		t.Errorf("ChangedSince(2) = %v, want [b c a]", got)
+	}
+
+	if !log.Records["a"].Deleted {
+		t.Error("the removed record has no tombstone")
+	}
+
+	// A new destination gets the live records only
+	if got := log.ChangedSince(0); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("ChangedSince(0) = %v, want [b c]", got)
+	}
+
+	// A revision from before a reset of the source is a full sync
+	if got := log.ChangedSince(99); len(got) != 2 {
+		t.Errorf("ChangedSince(99) = %v, want every live record", got)
+	}
+}
+
+func TestDeltaSync(t *testing.T) {
+	key := make([]byte, keySize)
+
+	src := &fakeSource{
+		repos: []model.Repository{
+			{URL: "https://github.com/acme/api", Workspace: "work"},
+			{URL: "https://github.com/me/dotfiles", Workspace: "home"},
+		},
+		logs: make(map[string]*BucketLog),
+	}
+	dst := &fakeSource{synced: make(map[string]SyncedData)}
+	conn := &StandaloneConnection{Name: "home-server"}
+
+	sync := func() *SyncPackage {
+		t.Helper()
+
+		logs, records, err := RecordChanges(src)
+		if err != nil {
+			t.Fatalf("RecordChanges() error = %v", err)
+		}
+
+		pkg, err := CreateDeltaPackage("source", key, logs, records, conn.AckedRevisions, conn.SyncFilter)
+		if err != nil {
+			t.Fatalf("CreateDeltaPackage() error = %v", err)
+		}
+
+		if _, _, err := ApplySyncPackage(dst, conn, pkg); err != nil {
+			t.Fatalf("ApplySyncPackage() error = %v", err)
+		}
+
+		return pkg
+	}
+
+	// The first sync is full
+	if pkg := sync(); len(pkg.Items) != 3 || len(dst.synced) != 3 {
+		t.Fatalf("first sync sent %d items, stored %d; want the 2 repos and the config", len(pkg.Items), len(dst.synced))
+	}
+
+	if conn.AckedRevisions[CapabilityRepos] != 2 {
+		t.Errorf("acknowledged repos revision = %d, want 2", conn.AckedRevisions[CapabilityRepos])
+	}
+
+	// Nothing changed: an empty delta, and the logs are not rewritten
+	saves := src.saves
+	if pkg := sync(); len(pkg.Items) != 0 || src.saves != saves {
+		t.Errorf("unchanged sync sent %d items and saved %d logs, want none", len(pkg.Items), src.saves-saves)
+	}
+
+	// One repo changes and one is removed
+	src.repos = []model.Repository{{URL: "https://github.com/acme/api", Workspace: "work", Favorite: true}}
+
+	pkg := sync()
+	if len(pkg.Items) != 2 {
+		t.Fatalf("delta sent %d items, want the changed repo and a tombstone", len(pkg.Items))
+	}
+
+	if _, ok := dst.synced["repo:https://github.com/me/dotfiles"]; ok {
+		t.Error("the removed repository is still synced")
+	}
+
+	// A filter limits the delta to its workspaces
+	src.repos = append(src.repos, model.Repository{URL: "https://github.com/me/notes", Workspace: "home"})
+	conn.SetSyncFilter(SyncFilter{DataTypes: []string{CapabilityRepos}, Workspaces: []string{"work"}})
+
+	if pkg := sync(); len(pkg.Items) != 1 || pkg.Items[0].ID != "https://github.com/acme/api" {
+		t.Errorf("filtered sync sent %v, want only the repo of the work workspace", pkg.Items)
+	}
+
+	// A repo moved out of the filtered workspaces is removed from the destination
+	src.repos[0].Workspace = "home"
+
+	if pkg := sync(); len(pkg.Items) != 1 || !pkg.Items[0].Deleted || pkg.Items[0].Workspace != "work" {
+		t.Errorf("sync after a move sent %+v, want a tombstone in the old workspace", pkg.Items)
+	}
+
+	if _, ok := dst.synced["repo:https://github.com/acme/api"]; ok {
+		t.Error("the repository moved out of the filter is still synced")
+	}
+
+	// Widening the filter resyncs what it excluded
+	conn.SetSyncFilter(SyncFilter{})
+
+	sync()
+
+	if _, ok := dst.synced["repo:https://github.com/me/notes"]; !ok {
+		t.Error("the repository excluded by the old filter was not synced after widening it")
+	}
+}
+
+func TestApplySyncPackageDropsFilteredItems(t *testing.T) {
+	dst := &fakeSource{synced: make(map[string]SyncedData)}
+	conn := &StandaloneConnection{
+		Name:       "home-server",
+		SyncFilter: SyncFilter{DataTypes: []string{CapabilityRepos}, Workspaces: []string{"work"}},
+	}
+
+	// A source ignoring the filter of the request
+	pkg := &SyncPackage{
+		InstanceID: "source",
+		Items: []SyncPackageItem{
+			{Type: "repo", ID: "https://github.com/acme/api", Name: "https://github.com/acme/api", Workspace: "work"},
+			{Type: "repo", ID: "https://github.com/me/dotfiles", Name: "https://github.com/me/dotfiles", Workspace: "home"},
+			{Type: "config", ID: "config", Name: "config"},
+		},
+		Revisions: map[string]int64{CapabilityRepos: 2},
+	}
+
+	stored, removed, err := ApplySyncPackage(dst, conn, pkg)
+	if err != nil {
+		t.Fatalf("ApplySyncPackage() error = %v", err)
+	}
+
+	if stored != 1 || removed != 0 {
+		t.Errorf("ApplySyncPackage() = %d stored, %d removed; want 1 stored", stored, removed)
+	}
+
+	if _, ok := dst.synced["repo:https://github.com/acme/api"]; !ok || len(dst.synced) != 1 {
+		t.Errorf("synced = %v, want only the repo of the work workspace", slices.Sorted(maps.Keys(dst.synced)))
+	}
+}
@@ -0,0 +1,124 @@
+package standalone
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SyncFilter selects the data a connection syncs. It is stored on the
+// destination's connection, sent in every sync request and applied by both
+// sides: the source sends only what it allows and the destination drops
+// anything else it receives.
+type SyncFilter struct {
+	// DataTypes are the capabilities to sync (profiles, workspaces, repos,
+	// config); empty syncs all of them
+	DataTypes []string `json:"data_types,omitempty"`
+
+	// Workspaces limits the workspaces, and the repositories in them, to
+	// the named ones; empty syncs every workspace
+	Workspaces []string `json:"workspaces,omitempty"`
+}
+
+// IsZero reports whether the filter lets everything through
+func (f SyncFilter) IsZero() bool {
+	return len(f.DataTypes)+len(f.Workspaces) == 0
+}
+
+// Validate checks that every data type is a known capability
+func (f SyncFilter) Validate() error {
+	for _, t := range f.DataTypes {
+		if !slices.Contains(DefaultCapabilities(), t) {
+			return fmt.Errorf("unknown data type %q (valid: %s)", t, strings.Join(DefaultCapabilities(), ", "))
+		}
+	}
+
+	for _, w := range f.Workspaces {
+		if strings.TrimSpace(w) == "" {
+			return fmt.Errorf("empty workspace name")
+		}
+	}
+
+	return nil
+}
+
+// AllowsType reports whether the capability is synced
+func (f SyncFilter) AllowsType(capability string) bool {
+	return len(f.DataTypes) == 0 || slices.Contains(f.DataTypes, capability)
+}
+
+// Allows reports whether an item is synced. dataType is the item type of
+// the sync protocol ("profile", "workspace", "repo", "config") and
+// workspace the workspace of a repository, or the name of a workspace.
+func (f SyncFilter) Allows(dataType, workspace string) bool {
+	capability := itemCapability(dataType)
+	if capability == "" || !f.AllowsType(capability) {
+		return false
+	}
+
+	if len(f.Workspaces) == 0 || (capability != CapabilityWorkspaces && capability != CapabilityRepos) {
+		return true
+	}
+
+	return slices.Contains(f.Workspaces, workspace)
+}
+
+// ItemTypes returns the capabilities to request, all of them when the
+// filter does not limit the data types
+func (f SyncFilter) ItemTypes() []string {
+	if len(f.DataTypes) == 0 {
+		return DefaultCapabilities()
+	}
+
+	return slices.Clone(f.DataTypes)
+}
+
+// String describes the filter for display
+func (f SyncFilter) String() string {
+	types, workspaces := "all data", "all workspaces"
+
+	if len(f.DataTypes) > 0 {
+		types = strings.Join(f.DataTypes, ", ")
+	}
+
+	if len(f.Workspaces) > 0 {
+		workspaces = "workspaces " + strings.Join(f.Workspaces, ", ")
+	}
+
+	return types + "; " + workspaces
+}
+
+// FilterSyncItems returns the items of a sync package that f allows. The
+// source applies it to what it sends, the destination to what it receives.
+func FilterSyncItems(items []SyncPackageItem, f SyncFilter) []SyncPackageItem {
+	if f.IsZero() {
+		return items
+	}
+
+	kept := make([]SyncPackageItem, 0, len(items))
+
+	for _, item := range items {
+		if f.Allows(item.Type, item.Workspace) {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept
+}
+
+// itemCapability returns the capability covering an item type, or "" for
+// an unknown type
+func itemCapability(dataType string) string {
+	switch dataType {
+	case "profile":
+		return CapabilityProfiles
+	case "workspace":
+		return CapabilityWorkspaces
+	case "repo":
+		return CapabilityRepos
+	case "config":
+		return CapabilityConfig
+	default:
+		return ""
+	}
+}
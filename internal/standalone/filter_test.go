@@ -0,0 +1,74 @@
+package standalone
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSyncFilterAllows(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    SyncFilter
+		dataType  string
+		workspace string
+		want      bool
+	}{
+		{"empty filter syncs everything", SyncFilter{}, "repo", "any", true},
+		{"unknown type", SyncFilter{}, "secret", "", false},
+		{"type excluded", SyncFilter{DataTypes: []string{CapabilityRepos}}, "profile", "", false},
+		{"type included", SyncFilter{DataTypes: []string{CapabilityRepos}}, "repo", "", true},
+		{"repo in selected workspace", SyncFilter{Workspaces: []string{"work"}}, "repo", "work", true},
+		{"repo in other workspace", SyncFilter{Workspaces: []string{"work"}}, "repo", "home", false},
+		{"workspace not selected", SyncFilter{Workspaces: []string{"work"}}, "workspace", "home", false},
+		{"profiles ignore workspaces", SyncFilter{Workspaces: []string{"work"}}, "profile", "", true},
+		{"config ignores workspaces", SyncFilter{Workspaces: []string{"work"}}, "config", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Allows(tt.dataType, tt.workspace); got != tt.want {
+				t.Errorf("Allows(%q, %q) = %v, want %v", tt.dataType, tt.workspace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncFilterValidate(t *testing.T) {
+	if err := (SyncFilter{DataTypes: []string{"repos", "config"}, Workspaces: []string{"work"}}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	if err := (SyncFilter{DataTypes: []string{"secrets"}}).Validate(); err == nil {
+		t.Error("Validate() accepted an unknown data type")
+	}
+
+	if err := (SyncFilter{Workspaces: []string{" "}}).Validate(); err == nil {
+		t.Error("Validate() accepted an empty workspace")
+	}
+}
+
+func TestFilterSyncItems(t *testing.T) {
+	items := []SyncPackageItem{
+		{Type: "profile", Name: "work-gh"},
+		{Type: "workspace", Name: "work", Workspace: "work"},
+		{Type: "workspace", Name: "home", Workspace: "home"},
+		{Type: "repo", Name: "api", Workspace: "work"},
+		{Type: "repo", Name: "dotfiles", Workspace: "home"},
+		{Type: "config", Name: "config"},
+	}
+
+	if got := FilterSyncItems(items, SyncFilter{}); len(got) != len(items) {
+		t.Errorf("FilterSyncItems() without filter kept %d items, want %d", len(got), len(items))
+	}
+
+	got := FilterSyncItems(items, SyncFilter{DataTypes: []string{CapabilityWorkspaces, CapabilityRepos}, Workspaces: []string{"work"}})
+
+	var names []string
+	for _, item := range got {
+		names = append(names, item.Name)
+	}
+
+	if want := []string{"work", "api"}; !slices.Equal(names, want) {
+		t.Errorf("FilterSyncItems() = %v, want %v", names, want)
+	}
+}
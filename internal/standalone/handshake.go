@@ -38,6 +38,10 @@ type ClientRegistration struct {
 	// Machine information for metrics
 	MachineInfo MachineInfo `json:"machine_info"`
 
+	// Fingerprint of the client and its key, when the client sent its key
+	// with the request
+	Fingerprint string `json:"fingerprint,omitempty"`
+
 	// Handshake state
 	State       HandshakeState `json:"state"`
 	InitiatedAt time.Time      `json:"initiated_at"`
@@ -69,6 +73,7 @@ type RegisteredClient struct {
 	EncryptionKeyHash []byte `json:"encryption_key_hash"` // Argon2 hash for verification
 	EncryptionSalt    []byte `json:"encryption_salt"`     // Salt for key derivation
 	KeyHint           string `json:"key_hint"`            // First 4 chars for identification
+	Fingerprint       string `json:"fingerprint"`         // ClientFingerprint of the client and its key
 
 	// Status
 	Status       string    `json:"status"` // "active", "suspended", "revoked"
@@ -201,6 +206,22 @@ func NewHandshake(clientName string, machineInfo MachineInfo) *Handshake {
 	}
 }
 
+// ResumeHandshake recreates a handshake whose key was already generated and
+// entered on the server, so an interrupted connect can finish with it.
+func ResumeHandshake(clientID, clientName string, machineInfo MachineInfo, displayKey string) *Handshake {
+	return &Handshake{
+		registration: &ClientRegistration{
+			ClientID:    clientID,
+			ClientName:  clientName,
+			MachineInfo: machineInfo,
+			State:       HandshakeStateKeyGenerated,
+			InitiatedAt: time.Now(),
+		},
+		clientKey:  DeriveClientKey(displayKey),
+		displayKey: displayKey,
+	}
+}
+
 // GetRegistration returns the client registration info.
 func (h *Handshake) GetRegistration() *ClientRegistration {
 	return h.registration
@@ -234,6 +255,11 @@ func (h *Handshake) GetFullKey() []byte {
 	return h.clientKey
 }
 
+// GetDisplayKey returns the unformatted display key (after GenerateKey was called).
+func (h *Handshake) GetDisplayKey() string {
+	return h.displayKey
+}
+
 // Complete marks the handshake as completed.
 func (h *Handshake) Complete() {
 	h.registration.State = HandshakeStateCompleted
@@ -293,9 +319,9 @@ func (s *ServerHandshake) RegisterClient(clientID, displayKey string) (*Register
 	}
 
 	// Parse and validate display key
-	cleanKey := ParseDisplayKey(displayKey)
-	if len(cleanKey) != DisplayKeySize*2 { // hex encoded
-		return nil, fmt.Errorf("invalid key length: expected %d characters", DisplayKeySize*2)
+	cleanKey, err := cleanDisplayKey(displayKey)
+	if err != nil {
+		return nil, err
 	}
 
 	// Derive the full encryption key
@@ -317,6 +343,7 @@ func (s *ServerHandshake) RegisterClient(clientID, displayKey string) (*Register
 		EncryptionKeyHash: keyHash,
 		EncryptionSalt:    salt,
 		KeyHint:           ComputeKeyHint(fullKey),
+		Fingerprint:       ClientFingerprint(reg.ClientID, fullKey),
 		Status:            "active",
 		RegisteredAt:      time.Now(),
 		LastSeenAt:        time.Now(),
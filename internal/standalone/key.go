@@ -329,6 +329,16 @@ func CreateConnection(name string, key *StandaloneKey, localPassword string) (*S
 	}, nil
 }
 
+// VerifyAPIKey reports whether apiKey, base58-encoded as in the standalone
+// key, is the API key of config.
+func VerifyAPIKey(config *StandaloneConfig, apiKey string) bool {
+	if apiKey == "" || len(config.APIKeyHash) == 0 {
+		return false
+	}
+
+	return VerifyPassword(string(base58.Decode(apiKey)), config.Salt, config.APIKeyHash)
+}
+
 // DecryptConnection decrypts the connection credentials using the local password.
 func DecryptConnection(conn *StandaloneConnection, localPassword string) (apiKey, refreshToken []byte, err error) {
 	// Verify password
@@ -354,3 +364,16 @@ func DecryptConnection(conn *StandaloneConnection, localPassword string) (apiKey
 
 	return apiKey, refreshToken, nil
 }
+
+// DecryptClientKey decrypts the client encryption key stored on a connection
+// by 'standalone connect' or 'standalone pair' using the local password.
+func DecryptClientKey(conn *StandaloneConnection, localPassword string) ([]byte, error) {
+	localKey := DeriveKeyArgon2(localPassword, conn.LocalSalt)
+
+	clientKey, err := DecryptWithKey(conn.APIKeyEncrypted, localKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid password")
+	}
+
+	return clientKey, nil
+}
@@ -1,6 +1,7 @@
 package standalone
 
 import (
+	"bytes"
 	"encoding/json"
 	"testing"
 	"time"
@@ -397,6 +398,53 @@ func TestDecryptConnection(t *testing.T) {
 	})
 }
 
+func TestVerifyAPIKey(t *testing.T) {
+	key, config, err := GenerateStandaloneKey("localhost", DefaultPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !VerifyAPIKey(config, key.APIKey) {
+		t.Error("VerifyAPIKey() rejected the API key of the config")
+	}
+
+	other, _, _ := GenerateStandaloneKey("localhost", DefaultPort)
+
+	for _, apiKey := range []string{other.APIKey, ""} {
+		if VerifyAPIKey(config, apiKey) {
+			t.Errorf("VerifyAPIKey(%q) accepted another key", apiKey)
+		}
+	}
+}
+
+func TestDecryptClientKey(t *testing.T) {
+	_, displayKey, err := GenerateClientKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientKey := DeriveClientKey(displayKey)
+	invite := &PairingInvite{Host: "localhost", Port: 50051}
+
+	conn, err := NewPairedConnection("test", "test-instance", invite, clientKey, "local_password")
+	if err != nil {
+		t.Fatalf("NewPairedConnection() error = %v", err)
+	}
+
+	got, err := DecryptClientKey(conn, "local_password")
+	if err != nil {
+		t.Fatalf("DecryptClientKey() error = %v", err)
+	}
+
+	if !bytes.Equal(got, clientKey) {
+		t.Error("DecryptClientKey() returned another key")
+	}
+
+	if _, err := DecryptClientKey(conn, "wrong_password"); err == nil {
+		t.Error("DecryptClientKey() expected error with wrong password")
+	}
+}
+
 func TestGetLocalIP(t *testing.T) {
 	ip, err := GetLocalIP()
 	if err != nil {
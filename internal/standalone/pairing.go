@@ -0,0 +1,297 @@
+package standalone
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// PairingScheme is the URI scheme of the pairing links encoded in QR codes.
+const PairingScheme = "clonr-pair"
+
+// DefaultPairingTTL is how long a pairing link can be redeemed.
+const DefaultPairingTTL = 10 * time.Minute
+
+// PairingTokensFile stores the hashes of pairing tokens not yet redeemed.
+const PairingTokensFile = "pairing_tokens.json"
+
+// pairingTokenSize is the size of a pairing token (16 bytes = 128 bits).
+const pairingTokenSize = 16
+
+// ErrPairingTokenInvalid is returned when a pairing token is unknown,
+// already redeemed or expired.
+var ErrPairingTokenInvalid = errors.New("pairing token is invalid, expired or already used")
+
+// pairingMu serializes access to the pairing tokens file within a process.
+var pairingMu sync.Mutex
+
+// PairingInvite is everything a second machine needs to register with a
+// standalone server: where to reach it, how to recognize it, and a
+// one-time token proving the user had access to the server's screen.
+type PairingInvite struct {
+	Host        string    `json:"host"`
+	Port        int       `json:"port"`
+	Fingerprint string    `json:"fingerprint"`
+	Token       string    `json:"token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// pendingPairing is a pairing token waiting to be redeemed.
+type pendingPairing struct {
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// InstanceFingerprint returns a short fingerprint identifying a standalone
+// server instance, e.g. "3F2A-9C10-77B4-E5D2". It is derived from secrets
+// only the server holds, so a client can detect an impostor.
+func InstanceFingerprint(config *StandaloneConfig) string {
+	h := sha256.New()
+	h.Write([]byte(config.InstanceID))
+	h.Write(config.APIKeyHash)
+
+	sum := strings.ToUpper(hex.EncodeToString(h.Sum(nil)[:8]))
+
+	return sum[0:4] + "-" + sum[4:8] + "-" + sum[8:12] + "-" + sum[12:16]
+}
+
+// NewPairingInvite creates a pairing invite with a fresh one-time token.
+func NewPairingInvite(config *StandaloneConfig, host string, port int, ttl time.Duration) (*PairingInvite, error) {
+	token, err := GenerateRandomBytes(pairingTokenSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate pairing token: %w", err)
+	}
+
+	return &PairingInvite{
+		Host:        host,
+		Port:        port,
+		Fingerprint: InstanceFingerprint(config),
+		Token:       base58.Encode(token),
+		ExpiresAt:   time.Now().Add(ttl),
+	}, nil
+}
+
+// URI encodes the invite as a pairing link, e.g.
+// clonr-pair://192.168.1.10:50051?fp=3F2A-9C10-77B4-E5D2&token=...&exp=1767225600
+func (i *PairingInvite) URI() string {
+	q := url.Values{}
+	q.Set("fp", i.Fingerprint)
+	q.Set("token", i.Token)
+	q.Set("exp", strconv.FormatInt(i.ExpiresAt.Unix(), 10))
+
+	u := url.URL{
+		Scheme:   PairingScheme,
+		Host:     net.JoinHostPort(i.Host, strconv.Itoa(i.Port)),
+		RawQuery: q.Encode(),
+	}
+
+	return u.String()
+}
+
+// Address returns the host:port of the server to pair with.
+func (i *PairingInvite) Address() string {
+	return net.JoinHostPort(i.Host, strconv.Itoa(i.Port))
+}
+
+// IsExpired checks if the invite can no longer be redeemed.
+func (i *PairingInvite) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
+
+// ParsePairingURI parses a pairing link produced by PairingInvite.URI.
+func ParsePairingURI(raw string) (*PairingInvite, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pairing link: %w", err)
+	}
+
+	if u.Scheme != PairingScheme {
+		return nil, fmt.Errorf("invalid pairing link: expected %s:// scheme", PairingScheme)
+	}
+
+	host, portStr, err := net.SplitHostPort(u.Host)
+	if err != nil || host == "" {
+		return nil, fmt.Errorf("invalid pairing link: missing server address")
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid pairing link: invalid port %q", portStr)
+	}
+
+	q := u.Query()
+
+	invite := &PairingInvite{
+		Host:        host,
+		Port:        port,
+		Fingerprint: q.Get("fp"),
+		Token:       q.Get("token"),
+	}
+
+	if len(invite.Fingerprint) != len("XXXX-XXXX-XXXX-XXXX") {
+		return nil, fmt.Errorf("invalid pairing link: missing or malformed fingerprint")
+	}
+
+	if invite.Token == "" {
+		return nil, fmt.Errorf("invalid pairing link: missing token")
+	}
+
+	exp, err := strconv.ParseInt(q.Get("exp"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pairing link: missing expiry")
+	}
+
+	invite.ExpiresAt = time.Unix(exp, 0)
+
+	return invite, nil
+}
+
+// SavePairingToken records the invite's token so the server can redeem it
+// once. Expired tokens are pruned from the file.
+func SavePairingToken(path string, invite *PairingInvite) error {
+	pairingMu.Lock()
+	defer pairingMu.Unlock()
+
+	pending, err := loadPendingPairings(path)
+	if err != nil {
+		return err
+	}
+
+	pending = append(pending, pendingPairing{
+		TokenHash: hashPairingToken(invite.Token),
+		ExpiresAt: invite.ExpiresAt,
+	})
+
+	return savePendingPairings(path, pending)
+}
+
+// ConsumePairingToken redeems a pairing token. A token can be redeemed only
+// once and only before it expires; otherwise ErrPairingTokenInvalid is returned.
+func ConsumePairingToken(path, token string) error {
+	pairingMu.Lock()
+	defer pairingMu.Unlock()
+
+	pending, err := loadPendingPairings(path)
+	if err != nil {
+		return err
+	}
+
+	hash := hashPairingToken(token)
+	found := false
+
+	for i, p := range pending {
+		if subtle.ConstantTimeCompare([]byte(p.TokenHash), []byte(hash)) == 1 {
+			found = true
+
+			pending = append(pending[:i], pending[i+1:]...)
+
+			break
+		}
+	}
+
+	if err := savePendingPairings(path, pending); err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrPairingTokenInvalid
+	}
+
+	return nil
+}
+
+// NewPairedConnection creates the client-side connection for a server paired
+// through a pairing invite. The client encryption key is stored encrypted
+// with the local password.
+func NewPairedConnection(name, instanceID string, invite *PairingInvite, clientKey []byte, localPassword string) (*StandaloneConnection, error) {
+	localSalt, err := GenerateSalt()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	localKey := DeriveKeyArgon2(localPassword, localSalt)
+
+	clientKeyEncrypted, err := EncryptWithKey(clientKey, localKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt client key: %w", err)
+	}
+
+	now := time.Now()
+
+	return &StandaloneConnection{
+		Name:              name,
+		InstanceID:        instanceID,
+		Host:              invite.Host,
+		Port:              invite.Port,
+		APIKeyEncrypted:   clientKeyEncrypted,
+		LocalPasswordHash: HashPassword(localPassword, localSalt),
+		LocalSalt:         localSalt,
+		SyncStatus:        StatusConnected,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+// hashPairingToken hashes a token for storage; only hashes are kept on disk.
+func hashPairingToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// loadPendingPairings reads the unexpired pairing tokens from path.
+func loadPendingPairings(path string) ([]pendingPairing, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read pairing tokens: %w", err)
+	}
+
+	var all []pendingPairing
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to parse pairing tokens: %w", err)
+	}
+
+	now := time.Now()
+	pending := all[:0]
+
+	for _, p := range all {
+		if now.Before(p.ExpiresAt) {
+			pending = append(pending, p)
+		}
+	}
+
+	return pending, nil
+}
+
+// savePendingPairings writes the pairing tokens to path.
+func savePendingPairings(path string, pending []pendingPairing) error {
+	if pending == nil {
+		pending = []pendingPairing{}
+	}
+
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write pairing tokens: %w", err)
+	}
+
+	return nil
+}
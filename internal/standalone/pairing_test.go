@@ -0,0 +1,134 @@
+package standalone
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testPairingConfig() *StandaloneConfig {
+	return &StandaloneConfig{
+		Enabled:    true,
+		IsServer:   true,
+		InstanceID: "instance-1234",
+		APIKeyHash: []byte("api-key-hash"),
+	}
+}
+
+func TestInstanceFingerprint(t *testing.T) {
+	config := testPairingConfig()
+
+	fp := InstanceFingerprint(config)
+	if len(fp) != len("XXXX-XXXX-XXXX-XXXX") || strings.Count(fp, "-") != 3 {
+		t.Errorf("InstanceFingerprint() = %q, want XXXX-XXXX-XXXX-XXXX format", fp)
+	}
+
+	if InstanceFingerprint(config) != fp {
+		t.Error("InstanceFingerprint() is not deterministic")
+	}
+
+	other := testPairingConfig()
+	other.InstanceID = "instance-5678"
+
+	if InstanceFingerprint(other) == fp {
+		t.Error("InstanceFingerprint() should differ between instances")
+	}
+}
+
+func TestPairingURIRoundTrip(t *testing.T) {
+	invite, err := NewPairingInvite(testPairingConfig(), "192.168.1.10", 50051, DefaultPairingTTL)
+	if err != nil {
+		t.Fatalf("NewPairingInvite() error = %v", err)
+	}
+
+	parsed, err := ParsePairingURI(invite.URI())
+	if err != nil {
+		t.Fatalf("ParsePairingURI() error = %v", err)
+	}
+
+	if parsed.Host != invite.Host || parsed.Port != invite.Port {
+		t.Errorf("address = %s, want %s", parsed.Address(), invite.Address())
+	}
+
+	if parsed.Fingerprint != invite.Fingerprint {
+		t.Errorf("Fingerprint = %q, want %q", parsed.Fingerprint, invite.Fingerprint)
+	}
+
+	if parsed.Token != invite.Token {
+		t.Errorf("Token = %q, want %q", parsed.Token, invite.Token)
+	}
+
+	if parsed.ExpiresAt.Unix() != invite.ExpiresAt.Unix() {
+		t.Errorf("ExpiresAt = %v, want %v", parsed.ExpiresAt, invite.ExpiresAt)
+	}
+
+	if parsed.IsExpired() {
+		t.Error("IsExpired() = true for a fresh invite")
+	}
+}
+
+func TestParsePairingURIInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		uri  string
+	}{
+		{"wrong scheme", "https://192.168.1.10:50051?fp=3F2A-9C10-77B4-E5D2&token=abc&exp=1767225600"},
+		{"missing port", "clonr-pair://192.168.1.10?fp=3F2A-9C10-77B4-E5D2&token=abc&exp=1767225600"},
+		{"invalid port", "clonr-pair://192.168.1.10:99999?fp=3F2A-9C10-77B4-E5D2&token=abc&exp=1767225600"},
+		{"missing fingerprint", "clonr-pair://192.168.1.10:50051?token=abc&exp=1767225600"},
+		{"missing token", "clonr-pair://192.168.1.10:50051?fp=3F2A-9C10-77B4-E5D2&exp=1767225600"},
+		{"missing expiry", "clonr-pair://192.168.1.10:50051?fp=3F2A-9C10-77B4-E5D2&token=abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePairingURI(tt.uri); err == nil {
+				t.Errorf("ParsePairingURI(%q) expected error", tt.uri)
+			}
+		})
+	}
+}
+
+func TestConsumePairingToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PairingTokensFile)
+
+	invite, err := NewPairingInvite(testPairingConfig(), "127.0.0.1", 50051, DefaultPairingTTL)
+	if err != nil {
+		t.Fatalf("NewPairingInvite() error = %v", err)
+	}
+
+	if err := SavePairingToken(path, invite); err != nil {
+		t.Fatalf("SavePairingToken() error = %v", err)
+	}
+
+	if err := ConsumePairingToken(path, "unknown-token"); !errors.Is(err, ErrPairingTokenInvalid) {
+		t.Errorf("ConsumePairingToken(unknown) error = %v, want ErrPairingTokenInvalid", err)
+	}
+
+	if err := ConsumePairingToken(path, invite.Token); err != nil {
+		t.Fatalf("ConsumePairingToken() error = %v", err)
+	}
+
+	if err := ConsumePairingToken(path, invite.Token); !errors.Is(err, ErrPairingTokenInvalid) {
+		t.Errorf("second ConsumePairingToken() error = %v, want ErrPairingTokenInvalid", err)
+	}
+}
+
+func TestConsumePairingTokenExpired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), PairingTokensFile)
+
+	invite, err := NewPairingInvite(testPairingConfig(), "127.0.0.1", 50051, -time.Minute)
+	if err != nil {
+		t.Fatalf("NewPairingInvite() error = %v", err)
+	}
+
+	if err := SavePairingToken(path, invite); err != nil {
+		t.Fatalf("SavePairingToken() error = %v", err)
+	}
+
+	if err := ConsumePairingToken(path, invite.Token); !errors.Is(err, ErrPairingTokenInvalid) {
+		t.Errorf("ConsumePairingToken(expired) error = %v, want ErrPairingTokenInvalid", err)
+	}
+}
@@ -95,6 +95,7 @@ type SyncPackage struct {
 	CreatedAt     time.Time              `json:"created_at"`
 	EncryptionKey string                 `json:"encryption_key_hint"` // Hint for verification
 	Items         []SyncPackageItem      `json:"items"`
+	Revisions     map[string]int64       `json:"revisions,omitempty"` // Bucket revisions a delta package brings the destination to
 	Metadata      map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -103,6 +104,9 @@ type SyncPackageItem struct {
 	Type          string    `json:"type"` // "profile", "workspace", "repo", "config"
 	ID            string    `json:"id"`
 	Name          string    `json:"name"`
+	Workspace     string    `json:"workspace,omitempty"` // Workspace of a repo, name of a workspace
+	Revision      int64     `json:"revision,omitempty"`  // Bucket revision the record last changed at
+	Deleted       bool      `json:"deleted,omitempty"`   // Tombstone of a removed record, without data
 	EncryptedData []byte    `json:"encrypted_data"`
 	UpdatedAt     time.Time `json:"updated_at"`
 }
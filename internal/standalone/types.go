@@ -38,23 +38,38 @@ type StandaloneConfig struct {
 	CreatedAt    time.Time `json:"created_at"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	Capabilities []string  `json:"capabilities"`
+
+	// AutoApprove selects the clients registered without manual approval
+	AutoApprove AutoApprovePolicy `json:"auto_approve,omitzero"`
+}
+
+// SyncPort returns the port the standalone sync service listens on
+func (c *StandaloneConfig) SyncPort() int {
+	if c.Port > 0 {
+		return c.Port
+	}
+
+	return DefaultPort
 }
 
 // StandaloneConnection represents a connection stored at the destination instance.
 type StandaloneConnection struct {
-	Name                  string    `json:"name"`
-	InstanceID            string    `json:"instance_id"`
-	Host                  string    `json:"host"`
-	Port                  int       `json:"port"`
-	APIKeyEncrypted       []byte    `json:"api_key_encrypted"`
-	RefreshTokenEncrypted []byte    `json:"refresh_token_encrypted"`
-	LocalPasswordHash     []byte    `json:"local_password_hash"` // Argon2 hash for verification
-	LocalSalt             []byte    `json:"local_salt"`          // Salt for local encryption
-	LastSync              time.Time `json:"last_sync"`
-	SyncStatus            string    `json:"sync_status"` // "connected", "disconnected", "error"
-	SyncedItems           SyncStats `json:"synced_items"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	Name                  string           `json:"name"`
+	InstanceID            string           `json:"instance_id"`
+	ClientID              string           `json:"client_id,omitempty"` // ID this instance registered with on the source
+	Host                  string           `json:"host"`
+	Port                  int              `json:"port"`
+	APIKeyEncrypted       []byte           `json:"api_key_encrypted"`
+	RefreshTokenEncrypted []byte           `json:"refresh_token_encrypted"`
+	LocalPasswordHash     []byte           `json:"local_password_hash"` // Argon2 hash for verification
+	LocalSalt             []byte           `json:"local_salt"`          // Salt for local encryption
+	LastSync              time.Time        `json:"last_sync"`
+	SyncStatus            string           `json:"sync_status"` // "connected", "disconnected", "error"
+	SyncedItems           SyncStats        `json:"synced_items"`
+	SyncFilter            SyncFilter       `json:"sync_filter,omitzero"`      // What to sync; empty syncs everything
+	AckedRevisions        map[string]int64 `json:"acked_revisions,omitempty"` // Last applied revision of each bucket
+	CreatedAt             time.Time        `json:"created_at"`
+	UpdatedAt             time.Time        `json:"updated_at"`
 }
 
 // SyncStats tracks what has been synchronized.
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/inovacc/clonr/internal/model"
+)
+
+// benchBackend opens a storage backend for the benchmarks. Backends register
+// themselves from build-tagged files, so run the suite once per tag and
+// compare the results with benchstat:
+//
+//	go test -run '^$' -bench . ./internal/store > sqlite.txt
+//	go test -tags bolt -run '^$' -bench . ./internal/store > bolt.txt
+//	benchstat sqlite.txt bolt.txt
+type benchBackend struct {
+	name string
+	open func(path string) (Store, func(), error)
+}
+
+var benchBackends []benchBackend
+
+// benchCatalogSizes are the numbers of repositories benchmarked
+var benchCatalogSizes = []int{100, 1000, 10000}
+
+func benchRepoURL(i int) *url.URL {
+	return &url.URL{Scheme: "https", Host: "github.com", Path: fmt.Sprintf("/org%d/repo%d", i%50, i)}
+}
+
+// openBenchStore opens a backend seeded with n repositories spread over
+// five workspaces
+func openBenchStore(b *testing.B, backend benchBackend, n int) Store {
+	b.Helper()
+
+	s, closeFn, err := backend.open(filepath.Join(b.TempDir(), "bench.db"))
+	if err != nil {
+		b.Fatalf("failed to open %s: %v", backend.name, err)
+	}
+
+	b.Cleanup(closeFn)
+
+	for i := range n {
+		if err := s.SaveRepoWithWorkspace(benchRepoURL(i), fmt.Sprintf("/src/repo%d", i), fmt.Sprintf("ws%d", i%5)); err != nil {
+			b.Fatalf("failed to seed %s: %v", backend.name, err)
+		}
+	}
+
+	return s
+}
+
+// runBackendBenchmark runs fn against every backend and catalog size
+func runBackendBenchmark(b *testing.B, fn func(b *testing.B, s Store, n int)) {
+	for _, backend := range benchBackends {
+		for _, n := range benchCatalogSizes {
+			b.Run(fmt.Sprintf("%s/repos=%d", backend.name, n), func(b *testing.B) {
+				s := openBenchStore(b, backend, n)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				fn(b, s, n)
+			})
+		}
+	}
+}
+
+func BenchmarkSaveRepo(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, n int) {
+		for i := 0; b.Loop(); i++ {
+			if err := s.SaveRepo(benchRepoURL(n+i), fmt.Sprintf("/src/new%d", i)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkRepoExistsByURL(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, n int) {
+		for i := 0; b.Loop(); i++ {
+			if _, err := s.RepoExistsByURL(benchRepoURL(i % n)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkGetAllRepos(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, _ int) {
+		for b.Loop() {
+			if _, err := s.GetAllRepos(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkGetReposByWorkspace(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, _ int) {
+		for b.Loop() {
+			if _, err := s.GetRepos("ws2", false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkListReposPage(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, n int) {
+		filter := model.RepoFilter{}
+
+		for i := 0; b.Loop(); i++ {
+			if _, _, err := s.ListRepos(filter, (i*50)%n, 50); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkSetFavoriteByURL(b *testing.B) {
+	runBackendBenchmark(b, func(b *testing.B, s Store, n int) {
+		for i := 0; b.Loop(); i++ {
+			if err := s.SetFavoriteByURL(benchRepoURL(i%n).String(), i%2 == 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
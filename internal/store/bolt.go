@@ -3,14 +3,19 @@
 package store
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/inovacc/clonr/internal/git"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/params"
 	"github.com/inovacc/clonr/internal/standalone"
@@ -24,128 +29,203 @@ const (
 	boltBucketProfiles       = "profiles"        // key: name -> Profile JSON
 	boltBucketDockerProfiles = "docker_profiles" // key: name -> DockerProfile JSON
 	boltBucketWorkspaces     = "workspaces"      // key: name -> Workspace JSON
-	boltBucketStandalone     = "standalone"      // key: "config" -> StandaloneConfig, "client:<id>" -> Client, "encryption" -> ServerEncryptionConfig
+	boltBucketStandalone     = "standalone"      // key: "config" -> StandaloneConfig, "client:<id>" -> Client, "encryption" -> ServerEncryptionConfig, "changelog:<bucket>" -> BucketLog
 	boltBucketConnections    = "connections"     // key: name -> StandaloneConnection (destination side)
 	boltBucketSyncedData     = "synced_data"     // key: "connection:type:name" -> SyncedData (encrypted until decrypted)
+	boltBucketFilters        = "filters"         // key: name -> SavedFilter JSON
+	boltBucketSnapshots      = "repo_snapshots"  // key: ID -> RepoSnapshot JSON
+	boltBucketWizardDrafts   = "wizard_drafts"   // key: name -> WizardDraft JSON
+	boltBucketAPITokens      = "api_tokens"      // key: name -> APIToken JSON
+	boltBucketVaultSecrets   = "vault_secrets"   // key: key -> VaultSecret JSON
+	boltBucketGmailWatches   = "gmail_watches"   // key: name -> GmailWatch JSON
+	boltBucketGitHubRepoIDs  = "github_repo_ids" // key: owner/repo -> GitHubRepoID JSON
+	boltBucketRepoRemotes    = "repo_remotes"    // key: "<remote URL> <repo URL>" -> repo URL
+	boltBucketDependencies   = "dependencies"    // key: "<repo URL> <scan time>" -> DependencyInventory JSON
+	boltBucketSlackAccounts  = "slack_accounts"  // key: name -> SlackAccount JSON
+	boltBucketShareLinks     = "share_links"     // key: ID -> ShareLink JSON
+	boltBucketJobs           = "jobs"            // key: ID -> Job JSON
+	boltBucketAliases        = "aliases"         // key: alias -> repo URL
 )
 
 type Bolt struct {
 	storage *bbolt.DB
+	path    string
+
+	// snapshot is the temporary copy backing a read-only snapshot
+	snapshot string
+	// readOnly is returned by writes when the database is a snapshot
+	readOnly error
 }
 
 // NewBolt creates a new Bolt database at the specified path.
 // This is primarily exposed for testing purposes.
 func NewBolt(path string) (*Bolt, error) {
+	return openBolt(path, false)
+}
+
+// openBolt opens the database read-write and records this process as its
+// holder. A *LockedError is returned when another process holds the file.
+func openBolt(path string, server bool) (*Bolt, error) {
 	instance, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: 1 * time.Second})
 	if err != nil {
+		if errors.Is(err, bbolt.ErrTimeout) {
+			return nil, &LockedError{Path: path, Holder: readLockHolder(path)}
+		}
+
 		return nil, err
 	}
 
-	if err := instance.Update(func(tx *bbolt.Tx) error {
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketRepos)); err != nil {
+	err = instance.Update(func(tx *bbolt.Tx) error {
+		if err := createBuckets(tx); err != nil {
 			return err
 		}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketPaths)); err != nil {
-			return err
-		}
+		return canonicalizeRepoURLs(tx)
+	})
+	if err != nil {
+		_ = instance.Close()
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConfig)); err != nil {
-			return err
-		}
+		return nil, err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketProfiles)); err != nil {
-			return err
-		}
+	_ = writeLockHolder(path, server)
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketDockerProfiles)); err != nil {
-			return err
-		}
+	return &Bolt{storage: instance, path: path}, nil
+}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketWorkspaces)); err != nil {
-			return err
-		}
+func createBuckets(tx *bbolt.Tx) error {
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketRepos)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketStandalone)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketPaths)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConnections)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConfig)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketSyncedData)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketProfiles)); err != nil {
+		return err
+	}
 
-		return nil
-	}); err != nil {
-		_ = instance.Close()
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketDockerProfiles)); err != nil {
+		return err
+	}
 
-		return nil, err
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketFilters)); err != nil {
+		return err
 	}
 
-	return &Bolt{storage: instance}, nil
-}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketSnapshots)); err != nil {
+		return err
+	}
 
-// Close closes the database.
-func (b *Bolt) Close() error {
-	return b.storage.Close()
-}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketWizardDrafts)); err != nil {
+		return err
+	}
 
-func initDB() (Store, error) {
-	path := filepath.Join(params.AppdataDir, "clonr.bolt")
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketVaultSecrets)); err != nil {
+		return err
+	}
 
-	instance, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: 1 * time.Second})
-	if err != nil {
-		return nil, err
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketGmailWatches)); err != nil {
+		return err
 	}
 
-	if err := instance.Update(func(tx *bbolt.Tx) error {
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketRepos)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketGitHubRepoIDs)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketPaths)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketRepoRemotes)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConfig)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketDependencies)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketProfiles)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketSlackAccounts)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketDockerProfiles)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketShareLinks)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketWorkspaces)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketJobs)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketStandalone)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketAliases)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConnections)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketAPITokens)); err != nil {
+		return err
+	}
 
-		if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketSyncedData)); err != nil {
-			return err
-		}
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketWorkspaces)); err != nil {
+		return err
+	}
 
-		return nil
-	}); err != nil {
-		_ = instance.Close()
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketStandalone)); err != nil {
+		return err
+	}
 
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketConnections)); err != nil {
+		return err
+	}
+
+	if _, err := tx.CreateBucketIfNotExists([]byte(boltBucketSyncedData)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the database.
+func (b *Bolt) Close() error {
+	err := b.storage.Close()
+
+	if b.snapshot != "" {
+		_ = os.Remove(b.snapshot)
+	} else {
+		removeLockHolder(b.path)
+	}
+
+	return err
+}
+
+// update runs a read-write transaction, failing on read-only snapshots
+func (b *Bolt) update(fn func(*bbolt.Tx) error) error {
+	if b.readOnly != nil {
+		return b.readOnly
+	}
+
+	return b.storage.Update(fn)
+}
+
+func initDB(mode OpenMode) (Store, error) {
+	path := filepath.Join(params.AppdataDir, "clonr.bolt")
+
+	if mode == OpenReadOnly {
+		return OpenSnapshot(path)
+	}
+
+	instance, err := openBolt(path, mode == OpenExclusive)
+
+	var locked *LockedError
+	if mode == OpenAuto && errors.As(err, &locked) {
+		return openLocked(locked)
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
-	return &Bolt{storage: instance}, nil
+	return instance, nil
 }
 
 func (b *Bolt) Ping() error {
@@ -165,7 +245,7 @@ func (b *Bolt) SaveRepoWithWorkspace(u *url.URL, path string, workspace string)
 
 	repo := model.Repository{
 		UID:       uuid.New().String(),
-		URL:       u.String(),
+		URL:       git.CanonicalURL(u.String()),
 		Path:      path,
 		Workspace: workspace,
 		ClonedAt:  time.Now(),
@@ -176,7 +256,7 @@ func (b *Bolt) SaveRepoWithWorkspace(u *url.URL, path string, workspace string)
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		var (
 			repos = tx.Bucket([]byte(boltBucketRepos))
 			paths = tx.Bucket([]byte(boltBucketPaths))
@@ -207,7 +287,7 @@ func (b *Bolt) RepoExistsByURL(u *url.URL) (bool, error) {
 
 	err := b.storage.View(func(tx *bbolt.Tx) error {
 		repos := tx.Bucket([]byte(boltBucketRepos))
-		exists = repos.Get([]byte(u.String())) != nil
+		exists = repos.Get([]byte(git.CanonicalURL(u.String()))) != nil
 
 		return nil
 	})
@@ -306,8 +386,45 @@ func (b *Bolt) GetRepos(workspace string, favoritesOnly bool) ([]model.Repositor
 	return out, err
 }
 
+func (b *Bolt) ListRepos(filter model.RepoFilter, offset, limit int) ([]model.Repository, int, error) {
+	var matched []model.Repository
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+
+		return repos.ForEach(func(k, v []byte) error {
+			var r model.Repository
+
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+
+			if filter.Matches(r) {
+				matched = append(matched, r)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].UpdatedAt.After(matched[j].UpdatedAt)
+	})
+
+	total := len(matched)
+	start := min(offset, total)
+	end := min(start+limit, total)
+
+	return matched[start:end], total, nil
+}
+
 func (b *Bolt) SetFavoriteByURL(urlStr string, fav bool) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
 		repos := tx.Bucket([]byte(boltBucketRepos))
 
 		v := repos.Get([]byte(urlStr))
@@ -333,8 +450,10 @@ func (b *Bolt) SetFavoriteByURL(urlStr string, fav bool) error {
 	})
 }
 
-func (b *Bolt) UpdateRepoTimestamp(urlStr string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+func (b *Bolt) SetRepoKind(urlStr string, kind model.RepoKind) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
 		repos := tx.Bucket([]byte(boltBucketRepos))
 
 		v := repos.Get([]byte(urlStr))
@@ -349,7 +468,7 @@ func (b *Bolt) UpdateRepoTimestamp(urlStr string) error {
 			return err
 		}
 
-		r.UpdatedAt = time.Now()
+		r.Kind = kind
 
 		data, err := json.Marshal(&r)
 		if err != nil {
@@ -360,12 +479,14 @@ func (b *Bolt) UpdateRepoTimestamp(urlStr string) error {
 	})
 }
 
-func (b *Bolt) RemoveRepoByURL(u *url.URL) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+func (b *Bolt) SetRepoUpstream(urlStr, upstreamURL string) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
 		repos := tx.Bucket([]byte(boltBucketRepos))
-		paths := tx.Bucket([]byte(boltBucketPaths))
 
-		v := repos.Get([]byte(u.String()))
+		v := repos.Get([]byte(urlStr))
+
 		if v == nil {
 			return nil
 		}
@@ -376,108 +497,523 @@ func (b *Bolt) RemoveRepoByURL(u *url.URL) error {
 			return err
 		}
 
-		if err := repos.Delete([]byte(u.String())); err != nil {
-			return err
-		}
+		r.UpstreamURL = upstreamURL
 
-		if r.Path != "" {
-			_ = paths.Delete([]byte(r.Path))
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
 		}
 
-		return nil
+		return repos.Put([]byte(urlStr), data)
 	})
 }
 
-func (b *Bolt) GetConfig() (*model.Config, error) {
-	var cfg *model.Config
+// SetRepoLicense records the detected license of a repository
+func (b *Bolt) SetRepoLicense(urlStr, license string) error {
+	urlStr = git.CanonicalURL(urlStr)
 
-	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketConfig))
-		v := bucket.Get([]byte("config"))
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
 
-		if v == nil {
-			// Return default config if not found
-			defaultCfg := model.DefaultConfig()
-			cfg = &defaultCfg
+		v := repos.Get([]byte(urlStr))
 
+		if v == nil {
 			return nil
 		}
 
-		var c model.Config
-		if err := json.Unmarshal(v, &c); err != nil {
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
 			return err
 		}
 
-		cfg = &c
+		r.License = license
 
-		return nil
-	})
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
 
-	return cfg, err
+		return repos.Put([]byte(urlStr), data)
+	})
 }
 
-func (b *Bolt) SaveConfig(cfg *model.Config) error {
-	if cfg == nil {
-		return errors.New("config is required")
-	}
+// SetRepoTags replaces the tags of a repository
+func (b *Bolt) SetRepoTags(urlStr string, tags []string) error {
+	urlStr = git.CanonicalURL(urlStr)
 
-	data, err := json.Marshal(cfg)
-	if err != nil {
-		return err
-	}
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketConfig))
+		v := repos.Get([]byte(urlStr))
 
-		return bucket.Put([]byte("config"), data)
-	})
-}
+		if v == nil {
+			return nil
+		}
 
-// SaveProfile saves or updates a profile
-func (b *Bolt) SaveProfile(profile *model.Profile) error {
-	if profile == nil {
-		return errors.New("profile is required")
-	}
+		var r model.Repository
 
-	if profile.Name == "" {
-		return errors.New("profile name is required")
-	}
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
 
-	data, err := json.Marshal(profile)
-	if err != nil {
-		return err
-	}
+		r.Tags = tags
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
 
-		return bucket.Put([]byte(profile.Name), data)
+		return repos.Put([]byte(urlStr), data)
 	})
 }
 
-// GetProfile retrieves a profile by name
-func (b *Bolt) GetProfile(name string) (*model.Profile, error) {
-	var profile *model.Profile
+// SetRepoNotes replaces the notes of a repository
+func (b *Bolt) SetRepoNotes(urlStr, notes string) error {
+	urlStr = git.CanonicalURL(urlStr)
 
-	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
-		v := bucket.Get([]byte(name))
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+
+		v := repos.Get([]byte(urlStr))
 
 		if v == nil {
 			return nil
 		}
 
-		var p model.Profile
-		if err := json.Unmarshal(v, &p); err != nil {
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
 			return err
 		}
 
-		profile = &p
+		r.Notes = notes
 
-		return nil
-	})
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
 
-	return profile, err
+		return repos.Put([]byte(urlStr), data)
+	})
+}
+
+// SetRepoAlias sets the alias of a repository, keeping the aliases bucket
+// in sync; an empty alias removes it
+func (b *Bolt) SetRepoAlias(urlStr, alias string) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+		aliases := tx.Bucket([]byte(boltBucketAliases))
+
+		v := repos.Get([]byte(urlStr))
+
+		if v == nil {
+			return nil
+		}
+
+		if alias != "" {
+			if owner := aliases.Get([]byte(alias)); owner != nil && string(owner) != urlStr {
+				return fmt.Errorf("alias %q already used by %s", alias, string(owner))
+			}
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		if r.Alias != "" {
+			_ = aliases.Delete([]byte(r.Alias))
+		}
+
+		r.Alias = alias
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Put([]byte(urlStr), data); err != nil {
+			return err
+		}
+
+		if alias == "" {
+			return nil
+		}
+
+		return aliases.Put([]byte(alias), []byte(urlStr))
+	})
+}
+
+// RecordRepoAccess counts an access to a repository and records its time
+func (b *Bolt) RecordRepoAccess(urlStr string) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+
+		v := repos.Get([]byte(urlStr))
+
+		if v == nil {
+			return nil
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		r.AccessCount++
+		r.LastAccessed = time.Now()
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		return repos.Put([]byte(urlStr), data)
+	})
+}
+
+// SetRepoRemotes replaces the remotes recorded for a repository
+func (b *Bolt) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+
+		v := repos.Get([]byte(urlStr))
+
+		if v == nil {
+			return nil
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		if err := indexRepoRemotes(tx, urlStr, r.Remotes, remotes); err != nil {
+			return err
+		}
+
+		r.Remotes = remotes
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		return repos.Put([]byte(urlStr), data)
+	})
+}
+
+// indexRepoRemotes replaces the remote URL index entries of a repository
+func indexRepoRemotes(tx *bbolt.Tx, repoURL string, old, remotes []model.RepoRemote) error {
+	index := tx.Bucket([]byte(boltBucketRepoRemotes))
+
+	for _, r := range old {
+		if err := index.Delete([]byte(r.URL + " " + repoURL)); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range remotes {
+		if err := index.Put([]byte(r.URL+" "+repoURL), []byte(repoURL)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRepoByRemoteURL returns the repository having a remote with the given
+// URL, or nil when there is none
+func (b *Bolt) GetRepoByRemoteURL(urlStr string) (*model.Repository, error) {
+	var repo *model.Repository
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		prefix := []byte(urlStr + " ")
+
+		k, repoURL := tx.Bucket([]byte(boltBucketRepoRemotes)).Cursor().Seek(prefix)
+		if k == nil || !bytes.HasPrefix(k, prefix) {
+			return nil
+		}
+
+		v := tx.Bucket([]byte(boltBucketRepos)).Get(repoURL)
+		if v == nil {
+			return nil
+		}
+
+		repo = &model.Repository{}
+
+		return json.Unmarshal(v, repo)
+	})
+
+	return repo, err
+}
+
+func (b *Bolt) UpdateRepoTimestamp(urlStr string) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+
+		v := repos.Get([]byte(urlStr))
+
+		if v == nil {
+			return nil
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		r.UpdatedAt = time.Now()
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		return repos.Put([]byte(urlStr), data)
+	})
+}
+
+func (b *Bolt) RemoveRepoByURL(u *url.URL) error {
+	urlStr := git.CanonicalURL(u.String())
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+		paths := tx.Bucket([]byte(boltBucketPaths))
+
+		v := repos.Get([]byte(urlStr))
+		if v == nil {
+			return nil
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		if err := repos.Delete([]byte(urlStr)); err != nil {
+			return err
+		}
+
+		if r.Path != "" {
+			_ = paths.Delete([]byte(r.Path))
+		}
+
+		if r.Alias != "" {
+			_ = tx.Bucket([]byte(boltBucketAliases)).Delete([]byte(r.Alias))
+		}
+
+		return indexRepoRemotes(tx, r.URL, r.Remotes, nil)
+	})
+}
+
+// UpdateRepoPath moves a repository to a new path, keeping the paths bucket in sync
+func (b *Bolt) UpdateRepoPath(urlStr string, path string) error {
+	urlStr = git.CanonicalURL(urlStr)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+		paths := tx.Bucket([]byte(boltBucketPaths))
+
+		v := repos.Get([]byte(urlStr))
+		if v == nil {
+			return errors.New("repository not found")
+		}
+
+		if owner := paths.Get([]byte(path)); owner != nil && string(owner) != urlStr {
+			return fmt.Errorf("path already tracked by %s", string(owner))
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		if r.Path != "" && r.Path != path {
+			_ = paths.Delete([]byte(r.Path))
+		}
+
+		r.Path = path
+		r.UpdatedAt = time.Now()
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Put([]byte(urlStr), data); err != nil {
+			return err
+		}
+
+		return paths.Put([]byte(path), []byte(urlStr))
+	})
+}
+
+// UpdateRepoURL changes the URL of a repository, keeping the paths and
+// remotes buckets in sync
+func (b *Bolt) UpdateRepoURL(oldURL, newURL string) error {
+	oldURL, newURL = git.CanonicalURL(oldURL), git.CanonicalURL(newURL)
+
+	return b.update(func(tx *bbolt.Tx) error {
+		repos := tx.Bucket([]byte(boltBucketRepos))
+		paths := tx.Bucket([]byte(boltBucketPaths))
+
+		v := repos.Get([]byte(oldURL))
+		if v == nil {
+			return errors.New("repository not found")
+		}
+
+		if repos.Get([]byte(newURL)) != nil {
+			return fmt.Errorf("repository %s already tracked", newURL)
+		}
+
+		var r model.Repository
+
+		if err := json.Unmarshal(v, &r); err != nil {
+			return err
+		}
+
+		r.URL = newURL
+		r.UpdatedAt = time.Now()
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Delete([]byte(oldURL)); err != nil {
+			return err
+		}
+
+		if err := repos.Put([]byte(newURL), data); err != nil {
+			return err
+		}
+
+		if r.Path != "" {
+			if err := paths.Put([]byte(r.Path), []byte(newURL)); err != nil {
+				return err
+			}
+		}
+
+		if r.Alias != "" {
+			if err := tx.Bucket([]byte(boltBucketAliases)).Put([]byte(r.Alias), []byte(newURL)); err != nil {
+				return err
+			}
+		}
+
+		if err := indexRepoRemotes(tx, oldURL, r.Remotes, nil); err != nil {
+			return err
+		}
+
+		return indexRepoRemotes(tx, newURL, nil, r.Remotes)
+	})
+}
+
+func (b *Bolt) GetConfig() (*model.Config, error) {
+	var cfg *model.Config
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketConfig))
+		v := bucket.Get([]byte("config"))
+
+		if v == nil {
+			// Return default config if not found
+			defaultCfg := model.DefaultConfig()
+			cfg = &defaultCfg
+
+			return nil
+		}
+
+		var c model.Config
+		if err := json.Unmarshal(v, &c); err != nil {
+			return err
+		}
+
+		cfg = &c
+
+		return nil
+	})
+
+	return cfg, err
+}
+
+func (b *Bolt) SaveConfig(cfg *model.Config) error {
+	if cfg == nil {
+		return errors.New("config is required")
+	}
+
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketConfig))
+
+		return bucket.Put([]byte("config"), data)
+	})
+}
+
+// SaveProfile saves or updates a profile
+func (b *Bolt) SaveProfile(profile *model.Profile) error {
+	if profile == nil {
+		return errors.New("profile is required")
+	}
+
+	if profile.Name == "" {
+		return errors.New("profile name is required")
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+
+		return bucket.Put([]byte(profile.Name), data)
+	})
+}
+
+// GetProfile retrieves a profile by name
+func (b *Bolt) GetProfile(name string) (*model.Profile, error) {
+	var profile *model.Profile
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+		v := bucket.Get([]byte(name))
+
+		if v == nil {
+			return nil
+		}
+
+		var p model.Profile
+		if err := json.Unmarshal(v, &p); err != nil {
+			return err
+		}
+
+		profile = &p
+
+		return nil
+	})
+
+	return profile, err
 }
 
 // GetActiveProfile retrieves the default profile (called "active" for gRPC compatibility)
@@ -485,202 +1021,987 @@ func (b *Bolt) GetActiveProfile() (*model.Profile, error) {
 	var profile *model.Profile
 
 	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var p model.Profile
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+
+			if p.Default {
+				profile = &p
+
+				return nil
+			}
+
+			return nil
+		})
+	})
+
+	return profile, err
+}
+
+// SetActiveProfile sets the default profile by name (called "active" for gRPC compatibility)
+func (b *Bolt) SetActiveProfile(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+
+		// First, verify the profile exists
+		v := bucket.Get([]byte(name))
+		if v == nil {
+			return errors.New("profile not found")
+		}
+
+		// Clear default from all profiles and set on the specified one
+		if err := bucket.ForEach(func(k, val []byte) error {
+			var p model.Profile
+			if err := json.Unmarshal(val, &p); err != nil {
+				return err
+			}
+
+			p.Default = string(k) == name
+
+			if p.Default {
+				p.LastUsedAt = time.Now()
+			}
+
+			data, err := json.Marshal(&p)
+			if err != nil {
+				return err
+			}
+
+			return bucket.Put(k, data)
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ListProfiles retrieves all profiles
+func (b *Bolt) ListProfiles() ([]model.Profile, error) {
+	var profiles []model.Profile
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var p model.Profile
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+
+			profiles = append(profiles, p)
+
+			return nil
+		})
+	})
+
+	return profiles, err
+}
+
+// DeleteProfile removes a profile by name
+func (b *Bolt) DeleteProfile(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// ProfileExists checks if a profile exists by name
+func (b *Bolt) ProfileExists(name string) (bool, error) {
+	var exists bool
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketProfiles))
+		exists = bucket.Get([]byte(name)) != nil
+
+		return nil
+	})
+
+	return exists, err
+}
+
+// Docker profile operations
+
+// SaveDockerProfile saves or updates a docker profile
+func (b *Bolt) SaveDockerProfile(profile *model.DockerProfile) error {
+	if profile == nil {
+		return errors.New("docker profile is required")
+	}
+
+	if profile.Name == "" {
+		return errors.New("docker profile name is required")
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+
+		return bucket.Put([]byte(profile.Name), data)
+	})
+}
+
+// GetDockerProfile retrieves a docker profile by name
+func (b *Bolt) GetDockerProfile(name string) (*model.DockerProfile, error) {
+	var profile *model.DockerProfile
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+		v := bucket.Get([]byte(name))
+
+		if v == nil {
+			return nil
+		}
+
+		var p model.DockerProfile
+		if err := json.Unmarshal(v, &p); err != nil {
+			return err
+		}
+
+		profile = &p
+
+		return nil
+	})
+
+	return profile, err
+}
+
+// ListDockerProfiles retrieves all docker profiles
+func (b *Bolt) ListDockerProfiles() ([]model.DockerProfile, error) {
+	var profiles []model.DockerProfile
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var p model.DockerProfile
+			if err := json.Unmarshal(v, &p); err != nil {
+				return err
+			}
+
+			profiles = append(profiles, p)
+
+			return nil
+		})
+	})
+
+	return profiles, err
+}
+
+// DeleteDockerProfile removes a docker profile by name
+func (b *Bolt) DeleteDockerProfile(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// DockerProfileExists checks if a docker profile exists by name
+func (b *Bolt) DockerProfileExists(name string) (bool, error) {
+	var exists bool
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+		exists = bucket.Get([]byte(name)) != nil
+
+		return nil
+	})
+
+	return exists, err
+}
+
+// Saved filter operations
+
+// SaveFilter saves or updates a saved filter
+func (b *Bolt) SaveFilter(filter *model.SavedFilter) error {
+	if filter == nil {
+		return errors.New("filter is required")
+	}
+
+	if filter.Name == "" {
+		return errors.New("filter name is required")
+	}
+
+	now := time.Now()
+	if filter.CreatedAt.IsZero() {
+		filter.CreatedAt = now
+	}
+
+	filter.UpdatedAt = now
+
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketFilters))
+
+		return bucket.Put([]byte(filter.Name), data)
+	})
+}
+
+// GetFilter retrieves a saved filter by name
+func (b *Bolt) GetFilter(name string) (*model.SavedFilter, error) {
+	var filter *model.SavedFilter
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketFilters))
+		v := bucket.Get([]byte(name))
+
+		if v == nil {
+			return nil
+		}
+
+		var f model.SavedFilter
+		if err := json.Unmarshal(v, &f); err != nil {
+			return err
+		}
+
+		filter = &f
+
+		return nil
+	})
+
+	return filter, err
+}
+
+// ListFilters retrieves all saved filters
+func (b *Bolt) ListFilters() ([]model.SavedFilter, error) {
+	var filters []model.SavedFilter
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketFilters))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var f model.SavedFilter
+			if err := json.Unmarshal(v, &f); err != nil {
+				return err
+			}
+
+			filters = append(filters, f)
+
+			return nil
+		})
+	})
+
+	return filters, err
+}
+
+// DeleteFilter removes a saved filter by name
+func (b *Bolt) DeleteFilter(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketFilters))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// Repository snapshot operations
+
+// SaveRepoSnapshot saves or updates a repository snapshot
+func (b *Bolt) SaveRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	if snapshot == nil {
+		return errors.New("snapshot is required")
+	}
+
+	if snapshot.ID == "" {
+		return errors.New("snapshot ID is required")
+	}
+
+	if snapshot.CreatedAt.IsZero() {
+		snapshot.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSnapshots))
+
+		return bucket.Put([]byte(snapshot.ID), data)
+	})
+}
+
+// GetRepoSnapshot retrieves a repository snapshot by ID
+func (b *Bolt) GetRepoSnapshot(id string) (*model.RepoSnapshot, error) {
+	var snapshot *model.RepoSnapshot
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSnapshots))
+		v := bucket.Get([]byte(id))
+
+		if v == nil {
+			return nil
+		}
+
+		var s model.RepoSnapshot
+		if err := json.Unmarshal(v, &s); err != nil {
+			return err
+		}
+
+		snapshot = &s
+
+		return nil
+	})
+
+	return snapshot, err
+}
+
+// ListRepoSnapshots retrieves the snapshots of a repository, newest first.
+// An empty repoURL lists the snapshots of all repositories.
+func (b *Bolt) ListRepoSnapshots(repoURL string) ([]model.RepoSnapshot, error) {
+	var snapshots []model.RepoSnapshot
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSnapshots))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var s model.RepoSnapshot
+			if err := json.Unmarshal(v, &s); err != nil {
+				return err
+			}
+
+			if repoURL == "" || s.RepoURL == repoURL {
+				snapshots = append(snapshots, s)
+			}
+
+			return nil
+		})
+	})
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.After(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, err
+}
+
+// DeleteRepoSnapshot removes a repository snapshot by ID
+func (b *Bolt) DeleteRepoSnapshot(id string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSnapshots))
+
+		return bucket.Delete([]byte(id))
+	})
+}
+
+// Wizard draft operations
+
+// SaveWizardDraft saves or replaces the draft state of an interactive flow
+func (b *Bolt) SaveWizardDraft(draft *model.WizardDraft) error {
+	if draft == nil {
+		return errors.New("draft is required")
+	}
+
+	if draft.Name == "" {
+		return errors.New("draft name is required")
+	}
+
+	if draft.UpdatedAt.IsZero() {
+		draft.UpdatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(draft)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketWizardDrafts))
+
+		return bucket.Put([]byte(draft.Name), data)
+	})
+}
+
+// GetWizardDraft retrieves the draft state of an interactive flow
+func (b *Bolt) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	var draft *model.WizardDraft
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketWizardDrafts))
+		v := bucket.Get([]byte(name))
+
+		if v == nil {
+			return nil
+		}
+
+		var d model.WizardDraft
+		if err := json.Unmarshal(v, &d); err != nil {
+			return err
+		}
+
+		draft = &d
+
+		return nil
+	})
+
+	return draft, err
+}
+
+// DeleteWizardDraft removes the draft state of an interactive flow
+func (b *Bolt) DeleteWizardDraft(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketWizardDrafts))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// API token operations
+
+// SaveAPIToken saves or replaces an API token by name
+func (b *Bolt) SaveAPIToken(token *model.APIToken) error {
+	if token == nil {
+		return errors.New("token is required")
+	}
+
+	if token.Name == "" || token.Hash == "" {
+		return errors.New("token name and hash are required")
+	}
+
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketAPITokens))
+
+		return bucket.Put([]byte(token.Name), data)
+	})
+}
+
+// GetAPITokenByHash retrieves the API token with the given hash, or nil
+func (b *Bolt) GetAPITokenByHash(hash string) (*model.APIToken, error) {
+	var token *model.APIToken
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketAPITokens))
 
 		return bucket.ForEach(func(k, v []byte) error {
-			var p model.Profile
-			if err := json.Unmarshal(v, &p); err != nil {
+			var t model.APIToken
+			if err := json.Unmarshal(v, &t); err != nil {
 				return err
 			}
 
-			if p.Default {
-				profile = &p
-
-				return nil
+			if t.Hash == hash {
+				token = &t
 			}
 
 			return nil
 		})
 	})
 
-	return profile, err
+	return token, err
 }
 
-// SetActiveProfile sets the default profile by name (called "active" for gRPC compatibility)
-func (b *Bolt) SetActiveProfile(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
+// ListAPITokens returns all API tokens sorted by name
+func (b *Bolt) ListAPITokens() ([]model.APIToken, error) {
+	var tokens []model.APIToken
 
-		// First, verify the profile exists
-		v := bucket.Get([]byte(name))
-		if v == nil {
-			return errors.New("profile not found")
-		}
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketAPITokens))
 
-		// Clear default from all profiles and set on the specified one
-		if err := bucket.ForEach(func(k, val []byte) error {
-			var p model.Profile
-			if err := json.Unmarshal(val, &p); err != nil {
+		return bucket.ForEach(func(k, v []byte) error {
+			var t model.APIToken
+			if err := json.Unmarshal(v, &t); err != nil {
 				return err
 			}
 
-			p.Default = string(k) == name
+			tokens = append(tokens, t)
 
-			if p.Default {
-				p.LastUsedAt = time.Now()
-			}
+			return nil
+		})
+	})
 
-			data, err := json.Marshal(&p)
-			if err != nil {
+	return tokens, err
+}
+
+// DeleteAPIToken removes an API token by name
+func (b *Bolt) DeleteAPIToken(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketAPITokens))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// Vault secret operations
+
+// SaveVaultSecret saves or replaces a vault secret by key
+func (b *Bolt) SaveVaultSecret(secret *model.VaultSecret) error {
+	if secret == nil || secret.Key == "" {
+		return errors.New("secret key is required")
+	}
+
+	now := time.Now()
+	if secret.CreatedAt.IsZero() {
+		secret.CreatedAt = now
+	}
+
+	secret.UpdatedAt = now
+
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketVaultSecrets))
+
+		return bucket.Put([]byte(secret.Key), data)
+	})
+}
+
+// GetVaultSecret retrieves a vault secret by key, or nil
+func (b *Bolt) GetVaultSecret(key string) (*model.VaultSecret, error) {
+	var secret *model.VaultSecret
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketVaultSecrets))
+
+		data := bucket.Get([]byte(key))
+		if data == nil {
+			return nil
+		}
+
+		secret = &model.VaultSecret{}
+
+		return json.Unmarshal(data, secret)
+	})
+
+	return secret, err
+}
+
+// ListVaultSecrets returns all vault secrets sorted by key
+func (b *Bolt) ListVaultSecrets() ([]model.VaultSecret, error) {
+	var secrets []model.VaultSecret
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketVaultSecrets))
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var s model.VaultSecret
+			if err := json.Unmarshal(v, &s); err != nil {
 				return err
 			}
 
-			return bucket.Put(k, data)
-		}); err != nil {
-			return err
+			secrets = append(secrets, s)
+
+			return nil
+		})
+	})
+
+	return secrets, err
+}
+
+// DeleteVaultSecret removes a vault secret by key
+func (b *Bolt) DeleteVaultSecret(key string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketVaultSecrets))
+
+		return bucket.Delete([]byte(key))
+	})
+}
+
+// Gmail watch operations
+
+// SaveGmailWatch saves or replaces a Gmail watch by name
+func (b *Bolt) SaveGmailWatch(watch *model.GmailWatch) error {
+	if watch == nil || watch.Name == "" {
+		return errors.New("watch name is required")
+	}
+
+	now := time.Now()
+	if watch.CreatedAt.IsZero() {
+		watch.CreatedAt = now
+	}
+
+	watch.UpdatedAt = now
+
+	data, err := json.Marshal(watch)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketGmailWatches))
+
+		return bucket.Put([]byte(watch.Name), data)
+	})
+}
+
+// GetGmailWatch retrieves a Gmail watch by name, or nil
+func (b *Bolt) GetGmailWatch(name string) (*model.GmailWatch, error) {
+	var watch *model.GmailWatch
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketGmailWatches))
+
+		data := bucket.Get([]byte(name))
+		if data == nil {
+			return nil
 		}
 
-		return nil
+		watch = &model.GmailWatch{}
+
+		return json.Unmarshal(data, watch)
 	})
+
+	return watch, err
 }
 
-// ListProfiles retrieves all profiles
-func (b *Bolt) ListProfiles() ([]model.Profile, error) {
-	var profiles []model.Profile
+// ListGmailWatches returns all Gmail watches sorted by name
+func (b *Bolt) ListGmailWatches() ([]model.GmailWatch, error) {
+	var watches []model.GmailWatch
 
 	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
+		bucket := tx.Bucket([]byte(boltBucketGmailWatches))
 
 		return bucket.ForEach(func(k, v []byte) error {
-			var p model.Profile
-			if err := json.Unmarshal(v, &p); err != nil {
+			var w model.GmailWatch
+			if err := json.Unmarshal(v, &w); err != nil {
 				return err
 			}
 
-			profiles = append(profiles, p)
+			watches = append(watches, w)
 
 			return nil
 		})
 	})
 
-	return profiles, err
+	return watches, err
 }
 
-// DeleteProfile removes a profile by name
-func (b *Bolt) DeleteProfile(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
+// DeleteGmailWatch removes a Gmail watch by name
+func (b *Bolt) DeleteGmailWatch(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketGmailWatches))
+
+		return bucket.Delete([]byte(name))
+	})
+}
+
+// GitHub repository ID cache operations
+
+// SaveGitHubRepoID caches a GitHub repository ID by owner/repo
+func (b *Bolt) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
+	if entry == nil || entry.FullName == "" {
+		return errors.New("repository name is required")
+	}
+
+	if entry.CachedAt.IsZero() {
+		entry.CachedAt = time.Now()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketGitHubRepoIDs))
+
+		return bucket.Put([]byte(entry.FullName), data)
+	})
+}
+
+// GetGitHubRepoID retrieves a cached GitHub repository ID by owner/repo, or nil
+func (b *Bolt) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
+	var entry *model.GitHubRepoID
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketGitHubRepoIDs))
+
+		data := bucket.Get([]byte(fullName))
+		if data == nil {
+			return nil
+		}
+
+		entry = &model.GitHubRepoID{}
+
+		return json.Unmarshal(data, entry)
+	})
+
+	return entry, err
+}
+
+// Dependency inventory operations
+
+// dependencyInventoryKey orders the inventories of a repository by scan time
+func dependencyInventoryKey(repoURL string, scannedAt time.Time) []byte {
+	return []byte(repoURL + " " + scannedAt.UTC().Format("20060102T150405.000000000"))
+}
+
+// SaveDependencyInventory records the dependencies of a repository
+func (b *Bolt) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	if inventory == nil || inventory.RepoURL == "" {
+		return errors.New("repository URL is required")
+	}
+
+	if inventory.ScannedAt.IsZero() {
+		inventory.ScannedAt = time.Now()
+	}
+
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketDependencies))
+
+		return bucket.Put(dependencyInventoryKey(inventory.RepoURL, inventory.ScannedAt), data)
+	})
+}
+
+// ListDependencyInventories retrieves the dependency inventories of a
+// repository, newest first, keeping at most limit (all when limit <= 0)
+func (b *Bolt) ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error) {
+	var inventories []model.DependencyInventory
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(boltBucketDependencies)).Cursor()
+		prefix := []byte(repoURL + " ")
+
+		// Walk backwards from the end of the prefix range for newest first
+		k, v := c.Seek(append(bytes.Clone(prefix), 0xff))
+		if k == nil {
+			k, v = c.Last()
+		} else {
+			k, v = c.Prev()
+		}
+
+		for ; k != nil && bytes.HasPrefix(k, prefix); k, v = c.Prev() {
+			if limit > 0 && len(inventories) >= limit {
+				break
+			}
+
+			var inv model.DependencyInventory
+			if err := json.Unmarshal(v, &inv); err != nil {
+				return err
+			}
+
+			inventories = append(inventories, inv)
+		}
+
+		return nil
+	})
+
+	return inventories, err
+}
+
+// Share link operations
+
+// SaveShareLink records a share link and drops the expired ones
+func (b *Bolt) SaveShareLink(link *model.ShareLink) error {
+	if link == nil || link.ID == "" {
+		return errors.New("share link ID is required")
+	}
+
+	now := time.Now()
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = now
+	}
+
+	data, err := json.Marshal(link)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketShareLinks))
+
+		var expired [][]byte
+
+		if err := bucket.ForEach(func(k, v []byte) error {
+			var l model.ShareLink
+			if err := json.Unmarshal(v, &l); err != nil || !now.Before(l.ExpiresAt) {
+				expired = append(expired, bytes.Clone(k))
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for _, k := range expired {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return bucket.Put([]byte(link.ID), data)
+	})
+}
+
+// GetShareLink retrieves a share link by ID, or nil
+func (b *Bolt) GetShareLink(id string) (*model.ShareLink, error) {
+	var link *model.ShareLink
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(boltBucketShareLinks)).Get([]byte(id))
+		if data == nil {
+			return nil
+		}
 
-		return bucket.Delete([]byte(name))
+		link = &model.ShareLink{}
+
+		return json.Unmarshal(data, link)
 	})
+
+	return link, err
 }
 
-// ProfileExists checks if a profile exists by name
-func (b *Bolt) ProfileExists(name string) (bool, error) {
-	var exists bool
+// ConsumeShareLink removes a share link by ID and returns it, or nil when
+// it does not exist
+func (b *Bolt) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	var link *model.ShareLink
 
-	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketProfiles))
-		exists = bucket.Get([]byte(name)) != nil
+	err := b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketShareLinks))
 
-		return nil
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return nil
+		}
+
+		link = &model.ShareLink{}
+		if err := json.Unmarshal(data, link); err != nil {
+			return err
+		}
+
+		return bucket.Delete([]byte(id))
 	})
 
-	return exists, err
+	return link, err
 }
 
-// Docker profile operations
+// Job operations
 
-// SaveDockerProfile saves or updates a docker profile
-func (b *Bolt) SaveDockerProfile(profile *model.DockerProfile) error {
-	if profile == nil {
-		return errors.New("docker profile is required")
+// SaveJob inserts or updates a job. A cancellation requested meanwhile is
+// kept, so a job reporting progress cannot clear it.
+func (b *Bolt) SaveJob(job *model.Job) error {
+	if job == nil || job.ID == "" {
+		return errors.New("job ID is required")
 	}
 
-	if profile.Name == "" {
-		return errors.New("docker profile name is required")
-	}
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketJobs))
 
-	data, err := json.Marshal(profile)
-	if err != nil {
-		return err
-	}
+		saved := *job
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+		if data := bucket.Get([]byte(job.ID)); data != nil {
+			var existing model.Job
+			if err := json.Unmarshal(data, &existing); err != nil {
+				return err
+			}
 
-		return bucket.Put([]byte(profile.Name), data)
+			saved.CancelRequested = saved.CancelRequested || existing.CancelRequested
+		}
+
+		data, err := json.Marshal(&saved)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(job.ID), data)
 	})
 }
 
-// GetDockerProfile retrieves a docker profile by name
-func (b *Bolt) GetDockerProfile(name string) (*model.DockerProfile, error) {
-	var profile *model.DockerProfile
+// GetJob retrieves a job by ID, or nil
+func (b *Bolt) GetJob(id string) (*model.Job, error) {
+	var job *model.Job
 
 	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
-		v := bucket.Get([]byte(name))
-
-		if v == nil {
+		data := tx.Bucket([]byte(boltBucketJobs)).Get([]byte(id))
+		if data == nil {
 			return nil
 		}
 
-		var p model.DockerProfile
-		if err := json.Unmarshal(v, &p); err != nil {
-			return err
-		}
-
-		profile = &p
+		job = &model.Job{}
 
-		return nil
+		return json.Unmarshal(data, job)
 	})
 
-	return profile, err
+	return job, err
 }
 
-// ListDockerProfiles retrieves all docker profiles
-func (b *Bolt) ListDockerProfiles() ([]model.DockerProfile, error) {
-	var profiles []model.DockerProfile
+// ListJobs returns the newest jobs first, at most limit of them
+func (b *Bolt) ListJobs(limit int) ([]model.Job, error) {
+	var jobs []model.Job
 
 	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
-
-		return bucket.ForEach(func(k, v []byte) error {
-			var p model.DockerProfile
-			if err := json.Unmarshal(v, &p); err != nil {
+		return tx.Bucket([]byte(boltBucketJobs)).ForEach(func(_, v []byte) error {
+			var job model.Job
+			if err := json.Unmarshal(v, &job); err != nil {
 				return err
 			}
 
-			profiles = append(profiles, p)
+			jobs = append(jobs, job)
 
 			return nil
 		})
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return profiles, err
-}
-
-// DeleteDockerProfile removes a docker profile by name
-func (b *Bolt) DeleteDockerProfile(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
+	sort.Slice(jobs, func(i, j int) bool {
+		if !jobs[i].StartedAt.Equal(jobs[j].StartedAt) {
+			return jobs[i].StartedAt.After(jobs[j].StartedAt)
+		}
 
-		return bucket.Delete([]byte(name))
+		return jobs[i].ID > jobs[j].ID
 	})
+
+	if limit > 0 && len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+
+	return jobs, nil
 }
 
-// DockerProfileExists checks if a docker profile exists by name
-func (b *Bolt) DockerProfileExists(name string) (bool, error) {
-	var exists bool
+// CancelJob asks a job to stop
+func (b *Bolt) CancelJob(id string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketJobs))
 
-	err := b.storage.View(func(tx *bbolt.Tx) error {
-		bucket := tx.Bucket([]byte(boltBucketDockerProfiles))
-		exists = bucket.Get([]byte(name)) != nil
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return fmt.Errorf("job not found: %s", id)
+		}
 
-		return nil
-	})
+		var job model.Job
+		if err := json.Unmarshal(data, &job); err != nil {
+			return err
+		}
 
-	return exists, err
+		job.CancelRequested = true
+
+		data, err := json.Marshal(&job)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(id), data)
+	})
 }
 
 // SaveWorkspace saves or updates a workspace
@@ -698,7 +2019,7 @@ func (b *Bolt) SaveWorkspace(workspace *model.Workspace) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketWorkspaces))
 
 		return bucket.Put([]byte(workspace.Name), data)
@@ -758,7 +2079,7 @@ func (b *Bolt) GetActiveWorkspace() (*model.Workspace, error) {
 
 // SetActiveWorkspace sets the active workspace by name
 func (b *Bolt) SetActiveWorkspace(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketWorkspaces))
 
 		// First, verify the workspace exists
@@ -818,7 +2139,7 @@ func (b *Bolt) ListWorkspaces() ([]model.Workspace, error) {
 
 // DeleteWorkspace removes a workspace by name
 func (b *Bolt) DeleteWorkspace(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketWorkspaces))
 
 		return bucket.Delete([]byte(name))
@@ -866,7 +2187,7 @@ func (b *Bolt) GetReposByWorkspace(workspace string) ([]string, error) {
 
 // UpdateRepoWorkspace updates the workspace for a repository
 func (b *Bolt) UpdateRepoWorkspace(urlStr string, workspace string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		repos := tx.Bucket([]byte(boltBucketRepos))
 
 		v := repos.Get([]byte(urlStr))
@@ -930,7 +2251,7 @@ func (b *Bolt) SaveStandaloneConfig(config *standalone.StandaloneConfig) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Put([]byte("config"), data)
 	})
@@ -938,7 +2259,7 @@ func (b *Bolt) SaveStandaloneConfig(config *standalone.StandaloneConfig) error {
 
 // DeleteStandaloneConfig removes the standalone configuration
 func (b *Bolt) DeleteStandaloneConfig() error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Delete([]byte("config"))
 	})
@@ -985,7 +2306,7 @@ func (b *Bolt) SaveStandaloneClient(client *standalone.Client) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Put([]byte("client:"+client.ID), data)
 	})
@@ -993,7 +2314,7 @@ func (b *Bolt) SaveStandaloneClient(client *standalone.Client) error {
 
 // DeleteStandaloneClient removes a connected client
 func (b *Bolt) DeleteStandaloneClient(id string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Delete([]byte("client:" + id))
 	})
@@ -1061,7 +2382,7 @@ func (b *Bolt) SaveStandaloneConnection(conn *standalone.StandaloneConnection) e
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketConnections))
 		return bucket.Put([]byte(conn.Name), data)
 	})
@@ -1069,12 +2390,56 @@ func (b *Bolt) SaveStandaloneConnection(conn *standalone.StandaloneConnection) e
 
 // DeleteStandaloneConnection removes a connection by name
 func (b *Bolt) DeleteStandaloneConnection(name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketConnections))
 		return bucket.Delete([]byte(name))
 	})
 }
 
+// Sync change log
+
+// syncChangeLogKey is the key of the change log of bucket in the standalone
+// bucket
+func syncChangeLogKey(bucket string) string {
+	return "changelog:" + bucket
+}
+
+// GetSyncChangeLog retrieves the change log of a sync bucket, empty when none
+// was saved
+func (b *Bolt) GetSyncChangeLog(bucket string) (*standalone.BucketLog, error) {
+	log := &standalone.BucketLog{}
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		v := tx.Bucket([]byte(boltBucketStandalone)).Get([]byte(syncChangeLogKey(bucket)))
+		if v == nil {
+			return nil
+		}
+
+		return json.Unmarshal(v, log)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+// SaveSyncChangeLog saves the change log of a sync bucket
+func (b *Bolt) SaveSyncChangeLog(bucket string, log *standalone.BucketLog) error {
+	if log == nil {
+		return errors.New("change log is required")
+	}
+
+	data, err := json.Marshal(log)
+	if err != nil {
+		return err
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(boltBucketStandalone)).Put([]byte(syncChangeLogKey(bucket)), data)
+	})
+}
+
 // Server encryption config
 
 // GetServerEncryptionConfig retrieves the server encryption configuration
@@ -1112,7 +2477,7 @@ func (b *Bolt) SaveServerEncryptionConfig(config *standalone.ServerEncryptionCon
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Put([]byte("encryption"), data)
 	})
@@ -1214,7 +2579,7 @@ func (b *Bolt) SaveSyncedData(data *standalone.SyncedData) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketSyncedData))
 		key := syncedDataKey(data.ConnectionName, data.DataType, data.Name)
 		return bucket.Put([]byte(key), jsonData)
@@ -1223,7 +2588,7 @@ func (b *Bolt) SaveSyncedData(data *standalone.SyncedData) error {
 
 // DeleteSyncedData removes synced data
 func (b *Bolt) DeleteSyncedData(connectionName, dataType, name string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketSyncedData))
 		key := syncedDataKey(connectionName, dataType, name)
 		return bucket.Delete([]byte(key))
@@ -1247,7 +2612,7 @@ func (b *Bolt) SavePendingRegistration(reg *standalone.ClientRegistration) error
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Put([]byte("pending:"+reg.ClientID), data)
 	})
@@ -1305,7 +2670,7 @@ func (b *Bolt) ListPendingRegistrations() ([]*standalone.ClientRegistration, err
 
 // RemovePendingRegistration removes a pending registration
 func (b *Bolt) RemovePendingRegistration(clientID string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Delete([]byte("pending:" + clientID))
 	})
@@ -1328,7 +2693,7 @@ func (b *Bolt) SaveRegisteredClient(client *standalone.RegisteredClient) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Put([]byte("registered:"+client.ClientID), data)
 	})
@@ -1386,7 +2751,7 @@ func (b *Bolt) ListRegisteredClients() ([]*standalone.RegisteredClient, error) {
 
 // DeleteRegisteredClient removes a registered client
 func (b *Bolt) DeleteRegisteredClient(clientID string) error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketStandalone))
 		return bucket.Delete([]byte("registered:" + clientID))
 	})
@@ -1432,7 +2797,7 @@ func (b *Bolt) SaveSealedKey(data *SealedKeyData) error {
 		return err
 	}
 
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketConfig))
 		return bucket.Put([]byte("sealed_key"), jsonData)
 	})
@@ -1440,7 +2805,7 @@ func (b *Bolt) SaveSealedKey(data *SealedKeyData) error {
 
 // DeleteSealedKey removes the sealed key from the database
 func (b *Bolt) DeleteSealedKey() error {
-	return b.storage.Update(func(tx *bbolt.Tx) error {
+	return b.update(func(tx *bbolt.Tx) error {
 		bucket := tx.Bucket([]byte(boltBucketConfig))
 		return bucket.Delete([]byte("sealed_key"))
 	})
@@ -1458,3 +2823,247 @@ func (b *Bolt) HasSealedKey() (bool, error) {
 
 	return exists, err
 }
+
+// Slack configuration operations
+
+// GetSlackConfig retrieves the Slack integration configuration, or nil
+func (b *Bolt) GetSlackConfig() (*model.SlackConfig, error) {
+	var config *model.SlackConfig
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(boltBucketConfig)).Get([]byte("slack"))
+		if data == nil {
+			return nil
+		}
+
+		config = &model.SlackConfig{}
+
+		return json.Unmarshal(data, config)
+	})
+
+	return config, err
+}
+
+// SaveSlackConfig saves or replaces the Slack integration configuration
+func (b *Bolt) SaveSlackConfig(config *model.SlackConfig) error {
+	if config == nil {
+		return errors.New("slack config is required")
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketConfig))
+
+		now := time.Now()
+		config.ID = 1
+		config.UpdatedAt = now
+
+		if config.CreatedAt.IsZero() {
+			config.CreatedAt = now
+
+			if data := bucket.Get([]byte("slack")); data != nil {
+				var existing model.SlackConfig
+				if err := json.Unmarshal(data, &existing); err == nil {
+					config.CreatedAt = existing.CreatedAt
+				}
+			}
+		}
+
+		data, err := json.Marshal(config)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte("slack"), data)
+	})
+}
+
+// DeleteSlackConfig removes the Slack integration configuration
+func (b *Bolt) DeleteSlackConfig() error {
+	return b.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(boltBucketConfig)).Delete([]byte("slack"))
+	})
+}
+
+// EnableSlackNotifications turns Slack notifications on
+func (b *Bolt) EnableSlackNotifications() error {
+	return b.setSlackNotifications(true)
+}
+
+// DisableSlackNotifications turns Slack notifications off
+func (b *Bolt) DisableSlackNotifications() error {
+	return b.setSlackNotifications(false)
+}
+
+// setSlackNotifications updates the enabled flag of the Slack configuration;
+// without a configuration there is nothing to update
+func (b *Bolt) setSlackNotifications(enabled bool) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketConfig))
+
+		data := bucket.Get([]byte("slack"))
+		if data == nil {
+			return nil
+		}
+
+		var config model.SlackConfig
+		if err := json.Unmarshal(data, &config); err != nil {
+			return err
+		}
+
+		config.Enabled = enabled
+		config.UpdatedAt = time.Now()
+
+		data, err := json.Marshal(&config)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte("slack"), data)
+	})
+}
+
+// Slack account operations
+
+// SaveSlackAccount saves a Slack account. Updating an existing account
+// keeps its default flag and timestamps.
+func (b *Bolt) SaveSlackAccount(account *model.SlackAccount) error {
+	if account == nil || account.Name == "" {
+		return errors.New("slack account name is required")
+	}
+
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSlackAccounts))
+
+		saved := *account
+
+		if data := bucket.Get([]byte(account.Name)); data != nil {
+			var existing model.SlackAccount
+			if err := json.Unmarshal(data, &existing); err != nil {
+				return err
+			}
+
+			saved.Default = existing.Default
+			saved.CreatedAt = existing.CreatedAt
+			saved.LastUsedAt = existing.LastUsedAt
+		} else if saved.CreatedAt.IsZero() {
+			saved.CreatedAt = time.Now()
+		}
+
+		data, err := json.Marshal(&saved)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(saved.Name), data)
+	})
+}
+
+// GetSlackAccount retrieves a Slack account by name, or nil
+func (b *Bolt) GetSlackAccount(name string) (*model.SlackAccount, error) {
+	var account *model.SlackAccount
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		data := tx.Bucket([]byte(boltBucketSlackAccounts)).Get([]byte(name))
+		if data == nil {
+			return nil
+		}
+
+		account = &model.SlackAccount{}
+
+		return json.Unmarshal(data, account)
+	})
+
+	return account, err
+}
+
+// GetActiveSlackAccount retrieves the default Slack account, or nil
+func (b *Bolt) GetActiveSlackAccount() (*model.SlackAccount, error) {
+	accounts, err := b.ListSlackAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, account := range accounts {
+		if account.Default {
+			return account, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// SetActiveSlackAccount makes the named Slack account the default one
+func (b *Bolt) SetActiveSlackAccount(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBucketSlackAccounts))
+
+		updates := make(map[string][]byte)
+
+		if err := bucket.ForEach(func(k, v []byte) error {
+			var account model.SlackAccount
+			if err := json.Unmarshal(v, &account); err != nil {
+				return err
+			}
+
+			account.Default = string(k) == name
+
+			data, err := json.Marshal(&account)
+			if err != nil {
+				return err
+			}
+
+			updates[string(k)] = data
+
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		for k, data := range updates {
+			if err := bucket.Put([]byte(k), data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListSlackAccounts retrieves all Slack accounts by name
+func (b *Bolt) ListSlackAccounts() ([]*model.SlackAccount, error) {
+	var accounts []*model.SlackAccount
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(boltBucketSlackAccounts)).ForEach(func(_, v []byte) error {
+			var account model.SlackAccount
+			if err := json.Unmarshal(v, &account); err != nil {
+				return err
+			}
+
+			accounts = append(accounts, &account)
+
+			return nil
+		})
+	})
+
+	return accounts, err
+}
+
+// DeleteSlackAccount removes a Slack account by name
+func (b *Bolt) DeleteSlackAccount(name string) error {
+	return b.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket([]byte(boltBucketSlackAccounts)).Delete([]byte(name))
+	})
+}
+
+// SlackAccountExists checks if a Slack account exists by name
+func (b *Bolt) SlackAccountExists(name string) (bool, error) {
+	var exists bool
+
+	err := b.storage.View(func(tx *bbolt.Tx) error {
+		exists = tx.Bucket([]byte(boltBucketSlackAccounts)).Get([]byte(name)) != nil
+		return nil
+	})
+
+	return exists, err
+}
@@ -0,0 +1,17 @@
+//go:build bolt
+
+package store
+
+func init() {
+	benchBackends = append(benchBackends, benchBackend{
+		name: "bolt",
+		open: func(path string) (Store, func(), error) {
+			db, err := NewBolt(path)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return db, func() { _ = db.Close() }, nil
+		},
+	})
+}
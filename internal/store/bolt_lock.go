@@ -0,0 +1,441 @@
+//go:build bolt
+
+package store
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	clientgrpc "github.com/inovacc/clonr/internal/client/grpc"
+	"github.com/inovacc/clonr/internal/model"
+	"go.etcd.io/bbolt"
+)
+
+// snapshotAttempts bounds the retries when the database changes while it is
+// being copied
+const snapshotAttempts = 3
+
+// OpenSnapshot opens a read-only copy of the database at path. Bolt locks
+// the file even for readers, so the copy lets commands read while another
+// process holds it. Writes fail; closing the snapshot removes the copy.
+func OpenSnapshot(path string) (*Bolt, error) {
+	var lastErr error
+
+	for range snapshotAttempts {
+		tmp, err := copySnapshot(path)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		instance, err := bbolt.Open(tmp, 0600, &bbolt.Options{Timeout: 1 * time.Second, ReadOnly: true})
+		if err != nil {
+			_ = os.Remove(tmp)
+			lastErr = err
+
+			continue
+		}
+
+		return &Bolt{
+			storage:  instance,
+			path:     path,
+			snapshot: tmp,
+			readOnly: fmt.Errorf("%w: %s is open as a read-only snapshot", ErrDatabaseLocked, path),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("failed to open database snapshot: %w", lastErr)
+}
+
+// copySnapshot copies the database to a temporary file, failing if the file
+// was modified during the copy
+func copySnapshot(path string) (string, error) {
+	before, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() { _ = src.Close() }()
+
+	dst, err := os.CreateTemp("", "clonr-snapshot-*.bolt")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(dst, src)
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		after, statErr := os.Stat(path)
+		if statErr != nil {
+			err = statErr
+		} else if !after.ModTime().Equal(before.ModTime()) || after.Size() != before.Size() {
+			err = fmt.Errorf("%s changed while copying", path)
+		}
+	}
+
+	if err != nil {
+		_ = os.Remove(dst.Name())
+		return "", err
+	}
+
+	return dst.Name(), nil
+}
+
+// openLocked serves a database held by another process. When the holder is
+// the clonr server, operations it exposes go over gRPC and see live data;
+// everything else reads from a snapshot. Writes the server cannot take fail
+// with the lock error instead of waiting on the file.
+func openLocked(locked *LockedError) (Store, error) {
+	snapshot, err := OpenSnapshot(locked.Path)
+	if err != nil {
+		return nil, locked
+	}
+
+	snapshot.readOnly = locked
+
+	if locked.Holder == nil || !locked.Holder.Server {
+		return snapshot, nil
+	}
+
+	client, err := clientgrpc.GetClient()
+	if err != nil {
+		return snapshot, nil
+	}
+
+	return &serverStore{Bolt: snapshot, client: client}, nil
+}
+
+// serverStore forwards operations to the running server, falling back to
+// the embedded snapshot for the ones the gRPC API does not cover
+type serverStore struct {
+	*Bolt
+
+	client *clientgrpc.Client
+}
+
+func (s *serverStore) Ping() error {
+	return s.client.Ping()
+}
+
+func (s *serverStore) SaveRepo(u *url.URL, path string) error {
+	return s.client.SaveRepo(u, path)
+}
+
+func (s *serverStore) SaveRepoWithWorkspace(u *url.URL, path, workspace string) error {
+	return s.client.SaveRepoWithWorkspace(u, path, workspace)
+}
+
+func (s *serverStore) RepoExistsByURL(u *url.URL) (bool, error) {
+	return s.client.RepoExistsByURL(u)
+}
+
+func (s *serverStore) RepoExistsByPath(path string) (bool, error) {
+	return s.client.RepoExistsByPath(path)
+}
+
+func (s *serverStore) InsertRepoIfNotExists(u *url.URL, path string) error {
+	return s.client.InsertRepoIfNotExists(u, path)
+}
+
+func (s *serverStore) GetAllRepos() ([]model.Repository, error) {
+	return s.client.GetAllRepos()
+}
+
+func (s *serverStore) GetRepos(workspace string, favoritesOnly bool) ([]model.Repository, error) {
+	return s.client.GetRepos(workspace, favoritesOnly)
+}
+
+func (s *serverStore) SetFavoriteByURL(urlStr string, fav bool) error {
+	return s.client.SetFavoriteByURL(urlStr, fav)
+}
+
+func (s *serverStore) SetRepoKind(urlStr string, kind model.RepoKind) error {
+	return s.client.SetRepoKind(urlStr, kind)
+}
+
+func (s *serverStore) SetRepoUpstream(urlStr, upstreamURL string) error {
+	return s.client.SetRepoUpstream(urlStr, upstreamURL)
+}
+
+func (s *serverStore) SetRepoLicense(urlStr, license string) error {
+	return s.client.SetRepoLicense(urlStr, license)
+}
+
+func (s *serverStore) SetRepoTags(urlStr string, tags []string) error {
+	return s.client.SetRepoTags(urlStr, tags)
+}
+
+func (s *serverStore) SetRepoNotes(urlStr, notes string) error {
+	return s.client.SetRepoNotes(urlStr, notes)
+}
+
+func (s *serverStore) SetRepoAlias(urlStr, alias string) error {
+	return s.client.SetRepoAlias(urlStr, alias)
+}
+
+func (s *serverStore) RecordRepoAccess(urlStr string) error {
+	return s.client.RecordRepoAccess(urlStr)
+}
+
+func (s *serverStore) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error {
+	return s.client.SetRepoRemotes(urlStr, remotes)
+}
+
+func (s *serverStore) GetRepoByRemoteURL(urlStr string) (*model.Repository, error) {
+	return s.client.GetRepoByRemoteURL(urlStr)
+}
+
+func (s *serverStore) UpdateRepoTimestamp(urlStr string) error {
+	return s.client.UpdateRepoTimestamp(urlStr)
+}
+
+func (s *serverStore) RemoveRepoByURL(u *url.URL) error {
+	return s.client.RemoveRepoByURL(u)
+}
+
+func (s *serverStore) UpdateRepoURL(oldURL, newURL string) error {
+	return s.client.UpdateRepoURL(oldURL, newURL)
+}
+
+func (s *serverStore) UpdateRepoPath(urlStr, path string) error {
+	return s.client.UpdateRepoPath(urlStr, path)
+}
+
+func (s *serverStore) GetConfig() (*model.Config, error) {
+	return s.client.GetConfig()
+}
+
+func (s *serverStore) SaveConfig(cfg *model.Config) error {
+	return s.client.SaveConfig(cfg)
+}
+
+func (s *serverStore) SaveProfile(profile *model.Profile) error {
+	return s.client.SaveProfile(profile)
+}
+
+func (s *serverStore) GetProfile(name string) (*model.Profile, error) {
+	return s.client.GetProfile(name)
+}
+
+func (s *serverStore) GetActiveProfile() (*model.Profile, error) {
+	return s.client.GetActiveProfile()
+}
+
+func (s *serverStore) SetActiveProfile(name string) error {
+	return s.client.SetActiveProfile(name)
+}
+
+func (s *serverStore) ListProfiles() ([]model.Profile, error) {
+	return s.client.ListProfiles()
+}
+
+func (s *serverStore) DeleteProfile(name string) error {
+	return s.client.DeleteProfile(name)
+}
+
+func (s *serverStore) ProfileExists(name string) (bool, error) {
+	return s.client.ProfileExists(name)
+}
+
+func (s *serverStore) SaveDockerProfile(profile *model.DockerProfile) error {
+	return s.client.SaveDockerProfile(profile)
+}
+
+func (s *serverStore) GetDockerProfile(name string) (*model.DockerProfile, error) {
+	return s.client.GetDockerProfile(name)
+}
+
+func (s *serverStore) ListDockerProfiles() ([]model.DockerProfile, error) {
+	return s.client.ListDockerProfiles()
+}
+
+func (s *serverStore) DeleteDockerProfile(name string) error {
+	return s.client.DeleteDockerProfile(name)
+}
+
+func (s *serverStore) DockerProfileExists(name string) (bool, error) {
+	return s.client.DockerProfileExists(name)
+}
+
+func (s *serverStore) SaveFilter(filter *model.SavedFilter) error {
+	return s.client.SaveFilter(filter)
+}
+
+func (s *serverStore) GetFilter(name string) (*model.SavedFilter, error) {
+	return s.client.GetFilter(name)
+}
+
+func (s *serverStore) ListFilters() ([]model.SavedFilter, error) {
+	return s.client.ListFilters()
+}
+
+func (s *serverStore) DeleteFilter(name string) error {
+	return s.client.DeleteFilter(name)
+}
+
+func (s *serverStore) SaveRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	return s.client.SaveRepoSnapshot(snapshot)
+}
+
+func (s *serverStore) GetRepoSnapshot(id string) (*model.RepoSnapshot, error) {
+	return s.client.GetRepoSnapshot(id)
+}
+
+func (s *serverStore) ListRepoSnapshots(repoURL string) ([]model.RepoSnapshot, error) {
+	return s.client.ListRepoSnapshots(repoURL)
+}
+
+func (s *serverStore) DeleteRepoSnapshot(id string) error {
+	return s.client.DeleteRepoSnapshot(id)
+}
+
+func (s *serverStore) SaveWizardDraft(draft *model.WizardDraft) error {
+	return s.client.SaveWizardDraft(draft)
+}
+
+func (s *serverStore) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	return s.client.GetWizardDraft(name)
+}
+
+func (s *serverStore) DeleteWizardDraft(name string) error {
+	return s.client.DeleteWizardDraft(name)
+}
+
+func (s *serverStore) SaveAPIToken(token *model.APIToken) error {
+	return s.client.SaveAPIToken(token)
+}
+
+func (s *serverStore) GetAPITokenByHash(hash string) (*model.APIToken, error) {
+	return s.client.GetAPITokenByHash(hash)
+}
+
+func (s *serverStore) ListAPITokens() ([]model.APIToken, error) {
+	return s.client.ListAPITokens()
+}
+
+func (s *serverStore) DeleteAPIToken(name string) error {
+	return s.client.DeleteAPIToken(name)
+}
+
+func (s *serverStore) SaveVaultSecret(secret *model.VaultSecret) error {
+	return s.client.SaveVaultSecret(secret)
+}
+
+func (s *serverStore) GetVaultSecret(key string) (*model.VaultSecret, error) {
+	return s.client.GetVaultSecret(key)
+}
+
+func (s *serverStore) ListVaultSecrets() ([]model.VaultSecret, error) {
+	return s.client.ListVaultSecrets()
+}
+
+func (s *serverStore) DeleteVaultSecret(key string) error {
+	return s.client.DeleteVaultSecret(key)
+}
+
+func (s *serverStore) SaveGmailWatch(watch *model.GmailWatch) error {
+	return s.client.SaveGmailWatch(watch)
+}
+
+func (s *serverStore) GetGmailWatch(name string) (*model.GmailWatch, error) {
+	return s.client.GetGmailWatch(name)
+}
+
+func (s *serverStore) ListGmailWatches() ([]model.GmailWatch, error) {
+	return s.client.ListGmailWatches()
+}
+
+func (s *serverStore) DeleteGmailWatch(name string) error {
+	return s.client.DeleteGmailWatch(name)
+}
+
+func (s *serverStore) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
+	return s.client.SaveGitHubRepoID(entry)
+}
+
+func (s *serverStore) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
+	return s.client.GetGitHubRepoID(fullName)
+}
+
+func (s *serverStore) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	return s.client.SaveDependencyInventory(inventory)
+}
+
+func (s *serverStore) ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error) {
+	return s.client.ListDependencyInventories(repoURL, limit)
+}
+
+func (s *serverStore) SaveShareLink(link *model.ShareLink) error {
+	return s.client.SaveShareLink(link)
+}
+
+func (s *serverStore) GetShareLink(id string) (*model.ShareLink, error) {
+	return s.client.GetShareLink(id)
+}
+
+func (s *serverStore) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	return s.client.ConsumeShareLink(id)
+}
+
+func (s *serverStore) SaveJob(job *model.Job) error {
+	return s.client.SaveJob(job)
+}
+
+func (s *serverStore) GetJob(id string) (*model.Job, error) {
+	return s.client.GetJob(id)
+}
+
+func (s *serverStore) ListJobs(limit int) ([]model.Job, error) {
+	return s.client.ListJobs(limit)
+}
+
+func (s *serverStore) CancelJob(id string) error {
+	return s.client.CancelJob(id)
+}
+
+func (s *serverStore) SaveWorkspace(workspace *model.Workspace) error {
+	return s.client.SaveWorkspace(workspace)
+}
+
+func (s *serverStore) GetWorkspace(name string) (*model.Workspace, error) {
+	return s.client.GetWorkspace(name)
+}
+
+func (s *serverStore) GetActiveWorkspace() (*model.Workspace, error) {
+	return s.client.GetActiveWorkspace()
+}
+
+func (s *serverStore) SetActiveWorkspace(name string) error {
+	return s.client.SetActiveWorkspace(name)
+}
+
+func (s *serverStore) ListWorkspaces() ([]model.Workspace, error) {
+	return s.client.ListWorkspaces()
+}
+
+func (s *serverStore) DeleteWorkspace(name string) error {
+	return s.client.DeleteWorkspace(name)
+}
+
+func (s *serverStore) WorkspaceExists(name string) (bool, error) {
+	return s.client.WorkspaceExists(name)
+}
+
+func (s *serverStore) GetReposByWorkspace(workspace string) ([]string, error) {
+	return s.client.GetReposByWorkspace(workspace)
+}
+
+func (s *serverStore) UpdateRepoWorkspace(urlStr, workspace string) error {
+	return s.client.UpdateRepoWorkspace(urlStr, workspace)
+}
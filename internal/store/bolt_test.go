@@ -3,12 +3,20 @@
 package store
 
 import (
+	"encoding/json"
+	"errors"
+	"maps"
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/standalone"
+	"go.etcd.io/bbolt"
 )
 
 func setupTestDB(t *testing.T) (*Bolt, func()) {
@@ -120,6 +128,95 @@ func TestBolt_RepoExistsByURL(t *testing.T) {
 	}
 }
 
+func TestBolt_RepoExistsByURL_Equivalent(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	u, _ := url.Parse("ssh://git@GitHub.com/test/repo.git")
+	if err := db.SaveRepo(u, "/tmp/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	for _, typed := range []string{"https://github.com/test/repo", "https://github.com/test/repo.git", "https://user@github.com/test/repo/"} {
+		u, _ := url.Parse(typed)
+
+		exists, err := db.RepoExistsByURL(u)
+		if err != nil || !exists {
+			t.Errorf("RepoExistsByURL(%s) = %v, %v; want true", typed, exists, err)
+		}
+	}
+
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].URL != "https://github.com/test/repo" {
+		t.Errorf("GetAllRepos() = %+v, want the canonical URL", repos)
+	}
+}
+
+func TestBolt_CanonicalizeRepoURLsOnOpen(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	// Records written before URLs were canonical
+	err := db.storage.Update(func(tx *bbolt.Tx) error {
+		for _, r := range []model.Repository{
+			{URL: "https://github.com/test/api", Path: "/src/api"},
+			{URL: "git@github.com:test/api.git", Path: "/old/api"},
+			{URL: "https://GitHub.com/test/web.git", Path: "/src/web"},
+		} {
+			data, _ := json.Marshal(&r)
+			if err := tx.Bucket([]byte(boltBucketRepos)).Put([]byte(r.URL), data); err != nil {
+				return err
+			}
+
+			if err := tx.Bucket([]byte(boltBucketPaths)).Put([]byte(r.Path), []byte(r.URL)); err != nil {
+				return err
+			}
+		}
+
+		cfg, _ := json.Marshal(&model.Config{Webhooks: []string{"git@github.com:test/api.git", "https://github.com/test/api"}})
+		if err := tx.Bucket([]byte(boltBucketConfig)).Put([]byte("config"), cfg); err != nil {
+			return err
+		}
+
+		return canonicalizeRepoURLs(tx)
+	})
+	if err != nil {
+		t.Fatalf("canonicalizeRepoURLs() error = %v", err)
+	}
+
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	got := make(map[string]string, len(repos))
+	for _, r := range repos {
+		got[r.URL] = r.Path
+	}
+
+	want := map[string]string{"https://github.com/test/api": "/src/api", "https://github.com/test/web": "/src/web"}
+	if !maps.Equal(got, want) {
+		t.Errorf("repositories = %v, want %v", got, want)
+	}
+
+	if exists, _ := db.RepoExistsByPath("/old/api"); exists {
+		t.Error("the path of the dropped duplicate is still tracked")
+	}
+
+	cfg, err := db.GetConfig()
+	if err != nil {
+		t.Fatalf("GetConfig() error = %v", err)
+	}
+
+	if !slices.Equal(cfg.Webhooks, []string{"https://github.com/test/api"}) {
+		t.Errorf("webhooks = %v, want the canonical URL once", cfg.Webhooks)
+	}
+}
+
 func TestBolt_RepoExistsByPath(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -1036,3 +1133,425 @@ func TestBolt_GetRepos_WorkspaceFilter(t *testing.T) {
 		t.Errorf("GetRepos('', true) returned %d repos, want 1", len(allFavRepos))
 	}
 }
+
+func TestNewBolt_LockedByAnotherHandle(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.storage")
+
+	db, err := NewBolt(dbPath)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	defer func() { _ = db.Close() }()
+
+	_, err = NewBolt(dbPath)
+
+	var locked *LockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("NewBolt() on a held database error = %v, want *LockedError", err)
+	}
+
+	if !errors.Is(err, ErrDatabaseLocked) {
+		t.Error("LockedError does not wrap ErrDatabaseLocked")
+	}
+
+	if locked.Holder == nil || locked.Holder.PID != os.Getpid() {
+		t.Errorf("Holder = %+v, want pid %d", locked.Holder, os.Getpid())
+	}
+}
+
+func TestBolt_CloseRemovesOwnerFile(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.storage")
+
+	db, err := NewBolt(dbPath)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	if _, err := os.Stat(ownerPath(dbPath)); err != nil {
+		t.Errorf("owner file missing while open: %v", err)
+	}
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if _, err := os.Stat(ownerPath(dbPath)); !os.IsNotExist(err) {
+		t.Error("owner file not removed on Close()")
+	}
+}
+
+func TestOpenSnapshot(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.storage")
+
+	db, err := NewBolt(dbPath)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	defer func() { _ = db.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := db.SaveRepo(u, "/path/to/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	// The snapshot opens while the writer still holds the file
+	snapshot, err := OpenSnapshot(dbPath)
+	if err != nil {
+		t.Fatalf("OpenSnapshot() error = %v", err)
+	}
+
+	repos, err := snapshot.GetAllRepos()
+	if err != nil || len(repos) != 1 {
+		t.Errorf("GetAllRepos() = %d repos, %v; want 1", len(repos), err)
+	}
+
+	other, _ := url.Parse("https://github.com/user/other")
+	if err := snapshot.SaveRepo(other, "/path/to/other"); !errors.Is(err, ErrDatabaseLocked) {
+		t.Errorf("SaveRepo() on snapshot error = %v, want ErrDatabaseLocked", err)
+	}
+
+	copyPath := snapshot.snapshot
+
+	if err := snapshot.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if _, err := os.Stat(copyPath); !os.IsNotExist(err) {
+		t.Error("snapshot copy not removed on Close()")
+	}
+
+	if _, err := os.Stat(ownerPath(dbPath)); err != nil {
+		t.Error("closing the snapshot removed the writer's owner file")
+	}
+}
+
+func TestLockedError_Message(t *testing.T) {
+	since := time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+
+	tests := []struct {
+		name   string
+		holder *LockHolder
+		want   string
+	}{
+		{"unknown", nil, "locked by another process"},
+		{"server", &LockHolder{PID: 42, Server: true, Since: since}, "clonr server stop"},
+		{"command", &LockHolder{PID: 42, Command: "list", Since: since}, "'clonr list' (pid 42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &LockedError{Path: "clonr.bolt", Holder: tt.holder}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Error() = %q, want it to contain %q", err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestBolt_SlackAccounts(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	for _, name := range []string{"work", "personal"} {
+		if err := db.SaveSlackAccount(&model.SlackAccount{Name: name, WorkspaceName: name}); err != nil {
+			t.Fatalf("SaveSlackAccount(%s) error = %v", name, err)
+		}
+	}
+
+	if err := db.SetActiveSlackAccount("personal"); err != nil {
+		t.Fatalf("SetActiveSlackAccount() error = %v", err)
+	}
+
+	// Updating an account keeps it the default one
+	if err := db.SaveSlackAccount(&model.SlackAccount{Name: "personal", WorkspaceName: "home"}); err != nil {
+		t.Fatalf("SaveSlackAccount() error = %v", err)
+	}
+
+	active, err := db.GetActiveSlackAccount()
+	if err != nil || active == nil || active.Name != "personal" || active.WorkspaceName != "home" {
+		t.Errorf("GetActiveSlackAccount() = %+v, %v, want the updated personal account", active, err)
+	}
+
+	if err := db.DeleteSlackAccount("work"); err != nil {
+		t.Fatalf("DeleteSlackAccount() error = %v", err)
+	}
+
+	if exists, _ := db.SlackAccountExists("work"); exists {
+		t.Error("SlackAccountExists(work) = true after deleting it")
+	}
+
+	accounts, err := db.ListSlackAccounts()
+	if err != nil || len(accounts) != 1 {
+		t.Errorf("ListSlackAccounts() = %d accounts, %v, want 1", len(accounts), err)
+	}
+}
+
+func TestBolt_SlackConfig(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	if config, err := db.GetSlackConfig(); err != nil || config != nil {
+		t.Fatalf("GetSlackConfig() without config = %+v, %v", config, err)
+	}
+
+	if err := db.SaveSlackConfig(&model.SlackConfig{DefaultChannel: "#dev"}); err != nil {
+		t.Fatalf("SaveSlackConfig() error = %v", err)
+	}
+
+	if err := db.EnableSlackNotifications(); err != nil {
+		t.Fatalf("EnableSlackNotifications() error = %v", err)
+	}
+
+	config, err := db.GetSlackConfig()
+	if err != nil || config == nil || !config.Enabled || config.DefaultChannel != "#dev" {
+		t.Errorf("GetSlackConfig() = %+v, %v, want enabled with #dev", config, err)
+	}
+
+	if err := db.DeleteSlackConfig(); err != nil {
+		t.Fatalf("DeleteSlackConfig() error = %v", err)
+	}
+
+	if config, _ := db.GetSlackConfig(); config != nil {
+		t.Errorf("GetSlackConfig() after delete = %+v, want nil", config)
+	}
+}
+
+func TestBolt_DependencyInventories(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	scannedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	for i, repoURL := range []string{"https://github.com/user/repo", "https://github.com/user/repo", "https://github.com/user/repo-two"} {
+		inventory := &model.DependencyInventory{
+			RepoURL:      repoURL,
+			Dependencies: []model.Dependency{{Ecosystem: "go", Name: "github.com/google/uuid", Version: "v1." + string(rune('0'+i)) + ".0", Manifest: "go.mod"}},
+			ScannedAt:    scannedAt.Add(time.Duration(i) * time.Hour),
+		}
+
+		if err := db.SaveDependencyInventory(inventory); err != nil {
+			t.Fatalf("SaveDependencyInventory() error = %v", err)
+		}
+	}
+
+	all, err := db.ListDependencyInventories("https://github.com/user/repo", 0)
+	if err != nil {
+		t.Fatalf("ListDependencyInventories() error = %v", err)
+	}
+
+	// The inventories of repo-two share the key prefix but are not listed
+	if len(all) != 2 || all[0].Dependencies[0].Version != "v1.1.0" {
+		t.Errorf("ListDependencyInventories() = %+v, want two inventories, newest first", all)
+	}
+
+	latest, err := db.ListDependencyInventories("https://github.com/user/repo-two", 1)
+	if err != nil || len(latest) != 1 || latest[0].Dependencies[0].Version != "v1.2.0" {
+		t.Errorf("ListDependencyInventories(repo-two, 1) = %+v, %v", latest, err)
+	}
+}
+
+func TestBolt_ShareLinks(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	if err := db.SaveShareLink(&model.ShareLink{ID: "old", RepoURL: "https://github.com/user/repo", ExpiresAt: now.Add(-time.Minute)}); err != nil {
+		t.Fatalf("SaveShareLink() error = %v", err)
+	}
+
+	if err := db.SaveShareLink(&model.ShareLink{ID: "abc", RepoURL: "https://github.com/user/repo", ExpiresAt: now.Add(time.Hour)}); err != nil {
+		t.Fatalf("SaveShareLink() error = %v", err)
+	}
+
+	if got, err := db.GetShareLink("old"); err != nil || got != nil {
+		t.Errorf("GetShareLink(old) = %+v, %v, want nil", got, err)
+	}
+
+	consumed, err := db.ConsumeShareLink("abc")
+	if err != nil || consumed == nil || consumed.RepoURL != "https://github.com/user/repo" {
+		t.Fatalf("ConsumeShareLink() = %+v, %v", consumed, err)
+	}
+
+	if again, err := db.ConsumeShareLink("abc"); err != nil || again != nil {
+		t.Errorf("second ConsumeShareLink() = %+v, %v, want nil", again, err)
+	}
+}
+
+func TestBolt_UpdateRepoURL(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	u, _ := url.Parse("https://github.com/user/old-name")
+	if err := db.SaveRepoWithWorkspace(u, "/src/tool", "work"); err != nil {
+		t.Fatalf("SaveRepoWithWorkspace() error = %v", err)
+	}
+
+	newURL := "https://github.com/user/new-name"
+	if err := db.UpdateRepoURL(u.String(), newURL); err != nil {
+		t.Fatalf("UpdateRepoURL() error = %v", err)
+	}
+
+	if exists, _ := db.RepoExistsByURL(u); exists {
+		t.Error("old URL still tracked after UpdateRepoURL()")
+	}
+
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].URL != newURL || repos[0].Path != "/src/tool" || repos[0].Workspace != "work" {
+		t.Errorf("GetAllRepos() = %+v, want the record under the new URL", repos)
+	}
+
+	if err := db.UpdateRepoURL(u.String(), newURL); err == nil {
+		t.Error("UpdateRepoURL() of an untracked URL succeeded")
+	}
+}
+
+func TestBolt_SetRepoAlias(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	api, _ := url.Parse("https://github.com/acme/api-gateway")
+	web, _ := url.Parse("https://github.com/acme/web")
+
+	for _, u := range []*url.URL{api, web} {
+		if err := db.SaveRepo(u, "/src"+u.Path); err != nil {
+			t.Fatalf("SaveRepo() error = %v", err)
+		}
+	}
+
+	if err := db.SetRepoAlias(api.String(), "api"); err != nil {
+		t.Fatalf("SetRepoAlias() error = %v", err)
+	}
+
+	if err := db.SetRepoAlias(web.String(), "api"); err == nil {
+		t.Error("SetRepoAlias() of a taken alias succeeded")
+	}
+
+	// Renaming the alias frees the old one
+	if err := db.SetRepoAlias(api.String(), "gateway"); err != nil {
+		t.Fatalf("SetRepoAlias() rename error = %v", err)
+	}
+
+	if err := db.SetRepoAlias(web.String(), "api"); err != nil {
+		t.Fatalf("SetRepoAlias() of a freed alias error = %v", err)
+	}
+
+	// Removing a repository frees its alias
+	if err := db.RemoveRepoByURL(api); err != nil {
+		t.Fatalf("RemoveRepoByURL() error = %v", err)
+	}
+
+	if err := db.SetRepoAlias(web.String(), "gateway"); err != nil {
+		t.Fatalf("SetRepoAlias() after removal error = %v", err)
+	}
+
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].Alias != "gateway" {
+		t.Errorf("GetAllRepos() = %+v, want %s aliased gateway", repos, web)
+	}
+}
+
+func TestBolt_RecordRepoAccess(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := db.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	for range 2 {
+		if err := db.RecordRepoAccess("git@github.com:user/repo.git"); err != nil {
+			t.Fatalf("RecordRepoAccess() error = %v", err)
+		}
+	}
+
+	if err := db.RecordRepoAccess("https://github.com/user/untracked"); err != nil {
+		t.Errorf("RecordRepoAccess() of an untracked URL error = %v, want nil", err)
+	}
+
+	repos, err := db.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].AccessCount != 2 || time.Since(repos[0].LastAccessed) > time.Minute {
+		t.Errorf("GetAllRepos() = %+v, want 2 accesses recorded just now", repos)
+	}
+}
+
+func TestBolt_SyncChangeLog(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	log, err := db.GetSyncChangeLog(standalone.CapabilityRepos)
+	if err != nil || log.Revision != 0 || len(log.Records) != 0 {
+		t.Fatalf("GetSyncChangeLog() on empty store = %+v, %v", log, err)
+	}
+
+	log.Record(map[string]standalone.SyncRecord{"https://github.com/user/repo": {Workspace: "work", Data: []byte(`{}`)}})
+
+	if err := db.SaveSyncChangeLog(standalone.CapabilityRepos, log); err != nil {
+		t.Fatalf("SaveSyncChangeLog() error = %v", err)
+	}
+
+	got, err := db.GetSyncChangeLog(standalone.CapabilityRepos)
+	if err != nil || got.Revision != 1 || got.Records["https://github.com/user/repo"].Workspace != "work" {
+		t.Errorf("GetSyncChangeLog() = %+v, %v", got, err)
+	}
+
+	// The change log does not show up as a standalone client
+	clients, err := db.GetStandaloneClients()
+	if err != nil || len(clients) != 0 {
+		t.Errorf("GetStandaloneClients() = %v, %v; want none", clients, err)
+	}
+}
+
+func TestBolt_Jobs(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	started := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	for _, job := range []*model.Job{
+		{ID: "a1", Kind: "backup", State: model.JobSucceeded, StartedAt: started},
+		{ID: "b2", Kind: "update", State: model.JobRunning, StartedAt: started.Add(time.Hour)},
+	} {
+		if err := db.SaveJob(job); err != nil {
+			t.Fatalf("SaveJob() error = %v", err)
+		}
+	}
+
+	if err := db.CancelJob("b2"); err != nil {
+		t.Fatalf("CancelJob() error = %v", err)
+	}
+
+	// Progress saved after the cancellation request keeps it
+	if err := db.SaveJob(&model.Job{ID: "b2", Kind: "update", State: model.JobRunning, Done: 1, StartedAt: started.Add(time.Hour)}); err != nil {
+		t.Fatalf("SaveJob() error = %v", err)
+	}
+
+	got, err := db.GetJob("b2")
+	if err != nil || got == nil || !got.CancelRequested || got.Done != 1 {
+		t.Fatalf("GetJob() = %+v, %v", got, err)
+	}
+
+	jobs, err := db.ListJobs(0)
+	if err != nil || len(jobs) != 2 || jobs[0].ID != "b2" {
+		t.Errorf("ListJobs() = %+v, %v, want newest first", jobs, err)
+	}
+
+	if err := db.CancelJob("zz"); err == nil {
+		t.Error("CancelJob() of a missing job succeeded")
+	}
+}
@@ -0,0 +1,261 @@
+//go:build bolt
+
+package store
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/inovacc/clonr/internal/git"
+	"github.com/inovacc/clonr/internal/model"
+	"go.etcd.io/bbolt"
+)
+
+// canonicalizeRepoURLs rewrites the repository URLs stored by older
+// versions to their canonical form, as the SQLite migration 035 does. A
+// repository tracked twice keeps the record already canonical, else the
+// first in URL order, and the records of the others move to it. The
+// repositories with webhooks enabled in the config are rewritten too.
+func canonicalizeRepoURLs(tx *bbolt.Tx) error {
+	if err := canonicalizeWebhooks(tx); err != nil {
+		return err
+	}
+
+	repos := tx.Bucket([]byte(boltBucketRepos))
+	paths := tx.Bucket([]byte(boltBucketPaths))
+
+	renamed := make(map[string]string)
+
+	_ = repos.ForEach(func(k, _ []byte) error {
+		if canonical := git.CanonicalURL(string(k)); canonical != string(k) {
+			renamed[string(k)] = canonical
+		}
+
+		return nil
+	})
+
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	oldURLs := make([]string, 0, len(renamed))
+	for oldURL := range renamed {
+		oldURLs = append(oldURLs, oldURL)
+	}
+
+	sort.Strings(oldURLs)
+
+	for _, oldURL := range oldURLs {
+		newURL := renamed[oldURL]
+
+		var r model.Repository
+
+		if err := json.Unmarshal(repos.Get([]byte(oldURL)), &r); err != nil {
+			return err
+		}
+
+		if err := indexRepoRemotes(tx, oldURL, r.Remotes, nil); err != nil {
+			return err
+		}
+
+		if err := repos.Delete([]byte(oldURL)); err != nil {
+			return err
+		}
+
+		// A duplicate of a repository already tracked is dropped
+		if repos.Get([]byte(newURL)) != nil {
+			if r.Path != "" && string(paths.Get([]byte(r.Path))) == oldURL {
+				_ = paths.Delete([]byte(r.Path))
+			}
+
+			continue
+		}
+
+		r.URL = newURL
+
+		data, err := json.Marshal(&r)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Put([]byte(newURL), data); err != nil {
+			return err
+		}
+
+		if r.Path != "" {
+			if err := paths.Put([]byte(r.Path), []byte(newURL)); err != nil {
+				return err
+			}
+		}
+
+		if err := indexRepoRemotes(tx, newURL, nil, r.Remotes); err != nil {
+			return err
+		}
+	}
+
+	if err := renameSnapshotRepoURLs(tx, renamed); err != nil {
+		return err
+	}
+
+	if err := renameShareLinkRepoURLs(tx, renamed); err != nil {
+		return err
+	}
+
+	return renameDependencyRepoURLs(tx, renamed)
+}
+
+// canonicalizeWebhooks rewrites the config's list of repositories with
+// webhooks enabled to canonical URLs
+func canonicalizeWebhooks(tx *bbolt.Tx) error {
+	bucket := tx.Bucket([]byte(boltBucketConfig))
+
+	data := bucket.Get([]byte("config"))
+	if data == nil {
+		return nil
+	}
+
+	var cfg model.Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+
+	canonical := git.CanonicalURLs(cfg.Webhooks)
+	if slices.Equal(canonical, cfg.Webhooks) {
+		return nil
+	}
+
+	cfg.Webhooks = canonical
+
+	data, err := json.Marshal(&cfg)
+	if err != nil {
+		return err
+	}
+
+	return bucket.Put([]byte("config"), data)
+}
+
+// renameSnapshotRepoURLs points the snapshots of renamed repositories to
+// their new URL
+func renameSnapshotRepoURLs(tx *bbolt.Tx, renamed map[string]string) error {
+	bucket := tx.Bucket([]byte(boltBucketSnapshots))
+	updates := make(map[string][]byte)
+
+	err := bucket.ForEach(func(k, v []byte) error {
+		var s model.RepoSnapshot
+		if err := json.Unmarshal(v, &s); err != nil {
+			return err
+		}
+
+		newURL, ok := renamed[s.RepoURL]
+		if !ok {
+			return nil
+		}
+
+		s.RepoURL = newURL
+
+		data, err := json.Marshal(&s)
+		if err != nil {
+			return err
+		}
+
+		updates[string(k)] = data
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return putAll(bucket, updates)
+}
+
+// renameShareLinkRepoURLs points the share links of renamed repositories
+// to their new URL
+func renameShareLinkRepoURLs(tx *bbolt.Tx, renamed map[string]string) error {
+	bucket := tx.Bucket([]byte(boltBucketShareLinks))
+	updates := make(map[string][]byte)
+
+	err := bucket.ForEach(func(k, v []byte) error {
+		var l model.ShareLink
+		if err := json.Unmarshal(v, &l); err != nil {
+			return err
+		}
+
+		newURL, ok := renamed[l.RepoURL]
+		if !ok {
+			return nil
+		}
+
+		l.RepoURL = newURL
+
+		data, err := json.Marshal(&l)
+		if err != nil {
+			return err
+		}
+
+		updates[string(k)] = data
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return putAll(bucket, updates)
+}
+
+// renameDependencyRepoURLs moves the dependency inventories of renamed
+// repositories to keys under their new URL
+func renameDependencyRepoURLs(tx *bbolt.Tx, renamed map[string]string) error {
+	bucket := tx.Bucket([]byte(boltBucketDependencies))
+	updates := make(map[string][]byte)
+
+	var stale [][]byte
+
+	err := bucket.ForEach(func(k, v []byte) error {
+		var inv model.DependencyInventory
+		if err := json.Unmarshal(v, &inv); err != nil {
+			return err
+		}
+
+		newURL, ok := renamed[inv.RepoURL]
+		if !ok {
+			return nil
+		}
+
+		inv.RepoURL = newURL
+
+		data, err := json.Marshal(&inv)
+		if err != nil {
+			return err
+		}
+
+		stale = append(stale, k)
+		updates[string(dependencyInventoryKey(newURL, inv.ScannedAt))] = data
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, k := range stale {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return putAll(bucket, updates)
+}
+
+// putAll stores updates in bucket, by key
+func putAll(bucket *bbolt.Bucket, updates map[string][]byte) error {
+	for k, v := range updates {
+		if err := bucket.Put([]byte(k), v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,17 @@
 //	storage := database.GetDB()
 //	repos, err := storage.GetAllRepos()
 //
+// # Concurrent Access
+//
+// Bolt locks its file exclusively, so only one process can open it
+// read-write. The holder records itself in an owner file next to the
+// database, and a process that finds the file held gets a [*LockedError]
+// naming it. By default [GetDB] then falls back instead of failing: when the
+// holder is the clonr server, operations the gRPC API covers are forwarded
+// to it, and everything else reads from a read-only snapshot (see
+// [OpenSnapshot]). Use [SetOpenMode] to require exclusive access or to
+// always open a snapshot.
+//
 // # Server-Side Only
 //
 // This package should only be used by server-side code (internal/grpcserver).
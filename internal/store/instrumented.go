@@ -0,0 +1,759 @@
+package store
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/standalone"
+)
+
+// instrumentedStore records the duration of every Store call in a Metrics
+// collector. New Store methods must be added here as well.
+type instrumentedStore struct {
+	next    Store
+	metrics *Metrics
+}
+
+// Instrument wraps s so every call is timed and slow calls are logged
+func Instrument(s Store, m *Metrics) Store {
+	return &instrumentedStore{next: s, metrics: m}
+}
+
+func (s *instrumentedStore) Ping() (err error) {
+	defer s.metrics.observe("Ping", time.Now(), &err)
+
+	return s.next.Ping()
+}
+
+func (s *instrumentedStore) SaveRepo(u *url.URL, path string) (err error) {
+	defer s.metrics.observe("SaveRepo", time.Now(), &err)
+
+	return s.next.SaveRepo(u, path)
+}
+
+func (s *instrumentedStore) SaveRepoWithWorkspace(u *url.URL, path string, workspace string) (err error) {
+	defer s.metrics.observe("SaveRepoWithWorkspace", time.Now(), &err)
+
+	return s.next.SaveRepoWithWorkspace(u, path, workspace)
+}
+
+func (s *instrumentedStore) RepoExistsByURL(u *url.URL) (result bool, err error) {
+	defer s.metrics.observe("RepoExistsByURL", time.Now(), &err)
+
+	return s.next.RepoExistsByURL(u)
+}
+
+func (s *instrumentedStore) RepoExistsByPath(path string) (result bool, err error) {
+	defer s.metrics.observe("RepoExistsByPath", time.Now(), &err)
+
+	return s.next.RepoExistsByPath(path)
+}
+
+func (s *instrumentedStore) InsertRepoIfNotExists(u *url.URL, path string) (err error) {
+	defer s.metrics.observe("InsertRepoIfNotExists", time.Now(), &err)
+
+	return s.next.InsertRepoIfNotExists(u, path)
+}
+
+func (s *instrumentedStore) GetAllRepos() (result []model.Repository, err error) {
+	defer s.metrics.observe("GetAllRepos", time.Now(), &err)
+
+	return s.next.GetAllRepos()
+}
+
+func (s *instrumentedStore) GetRepos(workspace string, favoritesOnly bool) (result []model.Repository, err error) {
+	defer s.metrics.observe("GetRepos", time.Now(), &err)
+
+	return s.next.GetRepos(workspace, favoritesOnly)
+}
+
+func (s *instrumentedStore) ListRepos(filter model.RepoFilter, offset, limit int) (repos []model.Repository, total int, err error) {
+	defer s.metrics.observe("ListRepos", time.Now(), &err)
+
+	return s.next.ListRepos(filter, offset, limit)
+}
+
+func (s *instrumentedStore) SetFavoriteByURL(urlStr string, fav bool) (err error) {
+	defer s.metrics.observe("SetFavoriteByURL", time.Now(), &err)
+
+	return s.next.SetFavoriteByURL(urlStr, fav)
+}
+
+func (s *instrumentedStore) SetRepoKind(urlStr string, kind model.RepoKind) (err error) {
+	defer s.metrics.observe("SetRepoKind", time.Now(), &err)
+
+	return s.next.SetRepoKind(urlStr, kind)
+}
+
+func (s *instrumentedStore) SetRepoUpstream(urlStr, upstreamURL string) (err error) {
+	defer s.metrics.observe("SetRepoUpstream", time.Now(), &err)
+
+	return s.next.SetRepoUpstream(urlStr, upstreamURL)
+}
+
+func (s *instrumentedStore) SetRepoLicense(urlStr, license string) (err error) {
+	defer s.metrics.observe("SetRepoLicense", time.Now(), &err)
+
+	return s.next.SetRepoLicense(urlStr, license)
+}
+
+func (s *instrumentedStore) SetRepoTags(urlStr string, tags []string) (err error) {
+	defer s.metrics.observe("SetRepoTags", time.Now(), &err)
+
+	return s.next.SetRepoTags(urlStr, tags)
+}
+
+func (s *instrumentedStore) SetRepoNotes(urlStr, notes string) (err error) {
+	defer s.metrics.observe("SetRepoNotes", time.Now(), &err)
+
+	return s.next.SetRepoNotes(urlStr, notes)
+}
+
+func (s *instrumentedStore) SetRepoAlias(urlStr, alias string) (err error) {
+	defer s.metrics.observe("SetRepoAlias", time.Now(), &err)
+
+	return s.next.SetRepoAlias(urlStr, alias)
+}
+
+func (s *instrumentedStore) RecordRepoAccess(urlStr string) (err error) {
+	defer s.metrics.observe("RecordRepoAccess", time.Now(), &err)
+
+	return s.next.RecordRepoAccess(urlStr)
+}
+
+func (s *instrumentedStore) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) (err error) {
+	defer s.metrics.observe("SetRepoRemotes", time.Now(), &err)
+
+	return s.next.SetRepoRemotes(urlStr, remotes)
+}
+
+func (s *instrumentedStore) GetRepoByRemoteURL(urlStr string) (_ *model.Repository, err error) {
+	defer s.metrics.observe("GetRepoByRemoteURL", time.Now(), &err)
+
+	return s.next.GetRepoByRemoteURL(urlStr)
+}
+
+func (s *instrumentedStore) UpdateRepoTimestamp(urlStr string) (err error) {
+	defer s.metrics.observe("UpdateRepoTimestamp", time.Now(), &err)
+
+	return s.next.UpdateRepoTimestamp(urlStr)
+}
+
+func (s *instrumentedStore) RemoveRepoByURL(u *url.URL) (err error) {
+	defer s.metrics.observe("RemoveRepoByURL", time.Now(), &err)
+
+	return s.next.RemoveRepoByURL(u)
+}
+
+func (s *instrumentedStore) UpdateRepoURL(oldURL, newURL string) (err error) {
+	defer s.metrics.observe("UpdateRepoURL", time.Now(), &err)
+
+	return s.next.UpdateRepoURL(oldURL, newURL)
+}
+
+func (s *instrumentedStore) UpdateRepoPath(urlStr string, path string) (err error) {
+	defer s.metrics.observe("UpdateRepoPath", time.Now(), &err)
+
+	return s.next.UpdateRepoPath(urlStr, path)
+}
+
+func (s *instrumentedStore) GetConfig() (result *model.Config, err error) {
+	defer s.metrics.observe("GetConfig", time.Now(), &err)
+
+	return s.next.GetConfig()
+}
+
+func (s *instrumentedStore) SaveConfig(cfg *model.Config) (err error) {
+	defer s.metrics.observe("SaveConfig", time.Now(), &err)
+
+	return s.next.SaveConfig(cfg)
+}
+
+func (s *instrumentedStore) SaveProfile(profile *model.Profile) (err error) {
+	defer s.metrics.observe("SaveProfile", time.Now(), &err)
+
+	return s.next.SaveProfile(profile)
+}
+
+func (s *instrumentedStore) GetProfile(name string) (result *model.Profile, err error) {
+	defer s.metrics.observe("GetProfile", time.Now(), &err)
+
+	return s.next.GetProfile(name)
+}
+
+func (s *instrumentedStore) GetActiveProfile() (result *model.Profile, err error) {
+	defer s.metrics.observe("GetActiveProfile", time.Now(), &err)
+
+	return s.next.GetActiveProfile()
+}
+
+func (s *instrumentedStore) SetActiveProfile(name string) (err error) {
+	defer s.metrics.observe("SetActiveProfile", time.Now(), &err)
+
+	return s.next.SetActiveProfile(name)
+}
+
+func (s *instrumentedStore) ListProfiles() (result []model.Profile, err error) {
+	defer s.metrics.observe("ListProfiles", time.Now(), &err)
+
+	return s.next.ListProfiles()
+}
+
+func (s *instrumentedStore) DeleteProfile(name string) (err error) {
+	defer s.metrics.observe("DeleteProfile", time.Now(), &err)
+
+	return s.next.DeleteProfile(name)
+}
+
+func (s *instrumentedStore) ProfileExists(name string) (result bool, err error) {
+	defer s.metrics.observe("ProfileExists", time.Now(), &err)
+
+	return s.next.ProfileExists(name)
+}
+
+func (s *instrumentedStore) SaveDockerProfile(profile *model.DockerProfile) (err error) {
+	defer s.metrics.observe("SaveDockerProfile", time.Now(), &err)
+
+	return s.next.SaveDockerProfile(profile)
+}
+
+func (s *instrumentedStore) GetDockerProfile(name string) (result *model.DockerProfile, err error) {
+	defer s.metrics.observe("GetDockerProfile", time.Now(), &err)
+
+	return s.next.GetDockerProfile(name)
+}
+
+func (s *instrumentedStore) ListDockerProfiles() (result []model.DockerProfile, err error) {
+	defer s.metrics.observe("ListDockerProfiles", time.Now(), &err)
+
+	return s.next.ListDockerProfiles()
+}
+
+func (s *instrumentedStore) DeleteDockerProfile(name string) (err error) {
+	defer s.metrics.observe("DeleteDockerProfile", time.Now(), &err)
+
+	return s.next.DeleteDockerProfile(name)
+}
+
+func (s *instrumentedStore) DockerProfileExists(name string) (result bool, err error) {
+	defer s.metrics.observe("DockerProfileExists", time.Now(), &err)
+
+	return s.next.DockerProfileExists(name)
+}
+
+func (s *instrumentedStore) SaveFilter(filter *model.SavedFilter) (err error) {
+	defer s.metrics.observe("SaveFilter", time.Now(), &err)
+
+	return s.next.SaveFilter(filter)
+}
+
+func (s *instrumentedStore) GetFilter(name string) (result *model.SavedFilter, err error) {
+	defer s.metrics.observe("GetFilter", time.Now(), &err)
+
+	return s.next.GetFilter(name)
+}
+
+func (s *instrumentedStore) ListFilters() (result []model.SavedFilter, err error) {
+	defer s.metrics.observe("ListFilters", time.Now(), &err)
+
+	return s.next.ListFilters()
+}
+
+func (s *instrumentedStore) DeleteFilter(name string) (err error) {
+	defer s.metrics.observe("DeleteFilter", time.Now(), &err)
+
+	return s.next.DeleteFilter(name)
+}
+
+func (s *instrumentedStore) SaveRepoSnapshot(snapshot *model.RepoSnapshot) (err error) {
+	defer s.metrics.observe("SaveRepoSnapshot", time.Now(), &err)
+
+	return s.next.SaveRepoSnapshot(snapshot)
+}
+
+func (s *instrumentedStore) GetRepoSnapshot(id string) (result *model.RepoSnapshot, err error) {
+	defer s.metrics.observe("GetRepoSnapshot", time.Now(), &err)
+
+	return s.next.GetRepoSnapshot(id)
+}
+
+func (s *instrumentedStore) ListRepoSnapshots(repoURL string) (result []model.RepoSnapshot, err error) {
+	defer s.metrics.observe("ListRepoSnapshots", time.Now(), &err)
+
+	return s.next.ListRepoSnapshots(repoURL)
+}
+
+func (s *instrumentedStore) DeleteRepoSnapshot(id string) (err error) {
+	defer s.metrics.observe("DeleteRepoSnapshot", time.Now(), &err)
+
+	return s.next.DeleteRepoSnapshot(id)
+}
+
+func (s *instrumentedStore) SaveWizardDraft(draft *model.WizardDraft) (err error) {
+	defer s.metrics.observe("SaveWizardDraft", time.Now(), &err)
+
+	return s.next.SaveWizardDraft(draft)
+}
+
+func (s *instrumentedStore) GetWizardDraft(name string) (result *model.WizardDraft, err error) {
+	defer s.metrics.observe("GetWizardDraft", time.Now(), &err)
+
+	return s.next.GetWizardDraft(name)
+}
+
+func (s *instrumentedStore) DeleteWizardDraft(name string) (err error) {
+	defer s.metrics.observe("DeleteWizardDraft", time.Now(), &err)
+
+	return s.next.DeleteWizardDraft(name)
+}
+
+func (s *instrumentedStore) SaveAPIToken(token *model.APIToken) (err error) {
+	defer s.metrics.observe("SaveAPIToken", time.Now(), &err)
+
+	return s.next.SaveAPIToken(token)
+}
+
+func (s *instrumentedStore) GetAPITokenByHash(hash string) (result *model.APIToken, err error) {
+	defer s.metrics.observe("GetAPITokenByHash", time.Now(), &err)
+
+	return s.next.GetAPITokenByHash(hash)
+}
+
+func (s *instrumentedStore) ListAPITokens() (result []model.APIToken, err error) {
+	defer s.metrics.observe("ListAPITokens", time.Now(), &err)
+
+	return s.next.ListAPITokens()
+}
+
+func (s *instrumentedStore) DeleteAPIToken(name string) (err error) {
+	defer s.metrics.observe("DeleteAPIToken", time.Now(), &err)
+
+	return s.next.DeleteAPIToken(name)
+}
+
+func (s *instrumentedStore) SaveVaultSecret(secret *model.VaultSecret) (err error) {
+	defer s.metrics.observe("SaveVaultSecret", time.Now(), &err)
+
+	return s.next.SaveVaultSecret(secret)
+}
+
+func (s *instrumentedStore) GetVaultSecret(key string) (result *model.VaultSecret, err error) {
+	defer s.metrics.observe("GetVaultSecret", time.Now(), &err)
+
+	return s.next.GetVaultSecret(key)
+}
+
+func (s *instrumentedStore) ListVaultSecrets() (result []model.VaultSecret, err error) {
+	defer s.metrics.observe("ListVaultSecrets", time.Now(), &err)
+
+	return s.next.ListVaultSecrets()
+}
+
+func (s *instrumentedStore) DeleteVaultSecret(key string) (err error) {
+	defer s.metrics.observe("DeleteVaultSecret", time.Now(), &err)
+
+	return s.next.DeleteVaultSecret(key)
+}
+
+func (s *instrumentedStore) SaveGmailWatch(watch *model.GmailWatch) (err error) {
+	defer s.metrics.observe("SaveGmailWatch", time.Now(), &err)
+
+	return s.next.SaveGmailWatch(watch)
+}
+
+func (s *instrumentedStore) GetGmailWatch(name string) (result *model.GmailWatch, err error) {
+	defer s.metrics.observe("GetGmailWatch", time.Now(), &err)
+
+	return s.next.GetGmailWatch(name)
+}
+
+func (s *instrumentedStore) ListGmailWatches() (result []model.GmailWatch, err error) {
+	defer s.metrics.observe("ListGmailWatches", time.Now(), &err)
+
+	return s.next.ListGmailWatches()
+}
+
+func (s *instrumentedStore) DeleteGmailWatch(name string) (err error) {
+	defer s.metrics.observe("DeleteGmailWatch", time.Now(), &err)
+
+	return s.next.DeleteGmailWatch(name)
+}
+
+func (s *instrumentedStore) SaveGitHubRepoID(entry *model.GitHubRepoID) (err error) {
+	defer s.metrics.observe("SaveGitHubRepoID", time.Now(), &err)
+
+	return s.next.SaveGitHubRepoID(entry)
+}
+
+func (s *instrumentedStore) GetGitHubRepoID(fullName string) (result *model.GitHubRepoID, err error) {
+	defer s.metrics.observe("GetGitHubRepoID", time.Now(), &err)
+
+	return s.next.GetGitHubRepoID(fullName)
+}
+
+func (s *instrumentedStore) SaveDependencyInventory(inventory *model.DependencyInventory) (err error) {
+	defer s.metrics.observe("SaveDependencyInventory", time.Now(), &err)
+
+	return s.next.SaveDependencyInventory(inventory)
+}
+
+func (s *instrumentedStore) ListDependencyInventories(repoURL string, limit int) (result []model.DependencyInventory, err error) {
+	defer s.metrics.observe("ListDependencyInventories", time.Now(), &err)
+
+	return s.next.ListDependencyInventories(repoURL, limit)
+}
+
+func (s *instrumentedStore) SaveShareLink(link *model.ShareLink) (err error) {
+	defer s.metrics.observe("SaveShareLink", time.Now(), &err)
+
+	return s.next.SaveShareLink(link)
+}
+
+func (s *instrumentedStore) GetShareLink(id string) (result *model.ShareLink, err error) {
+	defer s.metrics.observe("GetShareLink", time.Now(), &err)
+
+	return s.next.GetShareLink(id)
+}
+
+func (s *instrumentedStore) ConsumeShareLink(id string) (result *model.ShareLink, err error) {
+	defer s.metrics.observe("ConsumeShareLink", time.Now(), &err)
+
+	return s.next.ConsumeShareLink(id)
+}
+
+func (s *instrumentedStore) SaveJob(job *model.Job) (err error) {
+	defer s.metrics.observe("SaveJob", time.Now(), &err)
+
+	return s.next.SaveJob(job)
+}
+
+func (s *instrumentedStore) GetJob(id string) (result *model.Job, err error) {
+	defer s.metrics.observe("GetJob", time.Now(), &err)
+
+	return s.next.GetJob(id)
+}
+
+func (s *instrumentedStore) ListJobs(limit int) (result []model.Job, err error) {
+	defer s.metrics.observe("ListJobs", time.Now(), &err)
+
+	return s.next.ListJobs(limit)
+}
+
+func (s *instrumentedStore) CancelJob(id string) (err error) {
+	defer s.metrics.observe("CancelJob", time.Now(), &err)
+
+	return s.next.CancelJob(id)
+}
+
+func (s *instrumentedStore) SaveWorkspace(workspace *model.Workspace) (err error) {
+	defer s.metrics.observe("SaveWorkspace", time.Now(), &err)
+
+	return s.next.SaveWorkspace(workspace)
+}
+
+func (s *instrumentedStore) GetWorkspace(name string) (result *model.Workspace, err error) {
+	defer s.metrics.observe("GetWorkspace", time.Now(), &err)
+
+	return s.next.GetWorkspace(name)
+}
+
+func (s *instrumentedStore) GetActiveWorkspace() (result *model.Workspace, err error) {
+	defer s.metrics.observe("GetActiveWorkspace", time.Now(), &err)
+
+	return s.next.GetActiveWorkspace()
+}
+
+func (s *instrumentedStore) SetActiveWorkspace(name string) (err error) {
+	defer s.metrics.observe("SetActiveWorkspace", time.Now(), &err)
+
+	return s.next.SetActiveWorkspace(name)
+}
+
+func (s *instrumentedStore) ListWorkspaces() (result []model.Workspace, err error) {
+	defer s.metrics.observe("ListWorkspaces", time.Now(), &err)
+
+	return s.next.ListWorkspaces()
+}
+
+func (s *instrumentedStore) DeleteWorkspace(name string) (err error) {
+	defer s.metrics.observe("DeleteWorkspace", time.Now(), &err)
+
+	return s.next.DeleteWorkspace(name)
+}
+
+func (s *instrumentedStore) WorkspaceExists(name string) (result bool, err error) {
+	defer s.metrics.observe("WorkspaceExists", time.Now(), &err)
+
+	return s.next.WorkspaceExists(name)
+}
+
+func (s *instrumentedStore) GetReposByWorkspace(workspace string) (result []string, err error) {
+	defer s.metrics.observe("GetReposByWorkspace", time.Now(), &err)
+
+	return s.next.GetReposByWorkspace(workspace)
+}
+
+func (s *instrumentedStore) UpdateRepoWorkspace(urlStr string, workspace string) (err error) {
+	defer s.metrics.observe("UpdateRepoWorkspace", time.Now(), &err)
+
+	return s.next.UpdateRepoWorkspace(urlStr, workspace)
+}
+
+func (s *instrumentedStore) GetStandaloneConfig() (result *standalone.StandaloneConfig, err error) {
+	defer s.metrics.observe("GetStandaloneConfig", time.Now(), &err)
+
+	return s.next.GetStandaloneConfig()
+}
+
+func (s *instrumentedStore) SaveStandaloneConfig(config *standalone.StandaloneConfig) (err error) {
+	defer s.metrics.observe("SaveStandaloneConfig", time.Now(), &err)
+
+	return s.next.SaveStandaloneConfig(config)
+}
+
+func (s *instrumentedStore) DeleteStandaloneConfig() (err error) {
+	defer s.metrics.observe("DeleteStandaloneConfig", time.Now(), &err)
+
+	return s.next.DeleteStandaloneConfig()
+}
+
+func (s *instrumentedStore) GetStandaloneClients() (result []standalone.Client, err error) {
+	defer s.metrics.observe("GetStandaloneClients", time.Now(), &err)
+
+	return s.next.GetStandaloneClients()
+}
+
+func (s *instrumentedStore) SaveStandaloneClient(client *standalone.Client) (err error) {
+	defer s.metrics.observe("SaveStandaloneClient", time.Now(), &err)
+
+	return s.next.SaveStandaloneClient(client)
+}
+
+func (s *instrumentedStore) DeleteStandaloneClient(id string) (err error) {
+	defer s.metrics.observe("DeleteStandaloneClient", time.Now(), &err)
+
+	return s.next.DeleteStandaloneClient(id)
+}
+
+func (s *instrumentedStore) GetStandaloneConnection(name string) (result *standalone.StandaloneConnection, err error) {
+	defer s.metrics.observe("GetStandaloneConnection", time.Now(), &err)
+
+	return s.next.GetStandaloneConnection(name)
+}
+
+func (s *instrumentedStore) ListStandaloneConnections() (result []standalone.StandaloneConnection, err error) {
+	defer s.metrics.observe("ListStandaloneConnections", time.Now(), &err)
+
+	return s.next.ListStandaloneConnections()
+}
+
+func (s *instrumentedStore) SaveStandaloneConnection(conn *standalone.StandaloneConnection) (err error) {
+	defer s.metrics.observe("SaveStandaloneConnection", time.Now(), &err)
+
+	return s.next.SaveStandaloneConnection(conn)
+}
+
+func (s *instrumentedStore) DeleteStandaloneConnection(name string) (err error) {
+	defer s.metrics.observe("DeleteStandaloneConnection", time.Now(), &err)
+
+	return s.next.DeleteStandaloneConnection(name)
+}
+
+func (s *instrumentedStore) GetSyncChangeLog(bucket string) (result *standalone.BucketLog, err error) {
+	defer s.metrics.observe("GetSyncChangeLog", time.Now(), &err)
+
+	return s.next.GetSyncChangeLog(bucket)
+}
+
+func (s *instrumentedStore) SaveSyncChangeLog(bucket string, log *standalone.BucketLog) (err error) {
+	defer s.metrics.observe("SaveSyncChangeLog", time.Now(), &err)
+
+	return s.next.SaveSyncChangeLog(bucket, log)
+}
+
+func (s *instrumentedStore) GetServerEncryptionConfig() (result *standalone.ServerEncryptionConfig, err error) {
+	defer s.metrics.observe("GetServerEncryptionConfig", time.Now(), &err)
+
+	return s.next.GetServerEncryptionConfig()
+}
+
+func (s *instrumentedStore) SaveServerEncryptionConfig(config *standalone.ServerEncryptionConfig) (err error) {
+	defer s.metrics.observe("SaveServerEncryptionConfig", time.Now(), &err)
+
+	return s.next.SaveServerEncryptionConfig(config)
+}
+
+func (s *instrumentedStore) GetSyncedData(connectionName string, dataType string, name string) (result *standalone.SyncedData, err error) {
+	defer s.metrics.observe("GetSyncedData", time.Now(), &err)
+
+	return s.next.GetSyncedData(connectionName, dataType, name)
+}
+
+func (s *instrumentedStore) ListSyncedData(connectionName string) (result []standalone.SyncedData, err error) {
+	defer s.metrics.observe("ListSyncedData", time.Now(), &err)
+
+	return s.next.ListSyncedData(connectionName)
+}
+
+func (s *instrumentedStore) ListSyncedDataByState(state standalone.SyncState) (result []standalone.SyncedData, err error) {
+	defer s.metrics.observe("ListSyncedDataByState", time.Now(), &err)
+
+	return s.next.ListSyncedDataByState(state)
+}
+
+func (s *instrumentedStore) SaveSyncedData(data *standalone.SyncedData) (err error) {
+	defer s.metrics.observe("SaveSyncedData", time.Now(), &err)
+
+	return s.next.SaveSyncedData(data)
+}
+
+func (s *instrumentedStore) DeleteSyncedData(connectionName string, dataType string, name string) (err error) {
+	defer s.metrics.observe("DeleteSyncedData", time.Now(), &err)
+
+	return s.next.DeleteSyncedData(connectionName, dataType, name)
+}
+
+func (s *instrumentedStore) SavePendingRegistration(reg *standalone.ClientRegistration) (err error) {
+	defer s.metrics.observe("SavePendingRegistration", time.Now(), &err)
+
+	return s.next.SavePendingRegistration(reg)
+}
+
+func (s *instrumentedStore) GetPendingRegistration(clientID string) (result *standalone.ClientRegistration, err error) {
+	defer s.metrics.observe("GetPendingRegistration", time.Now(), &err)
+
+	return s.next.GetPendingRegistration(clientID)
+}
+
+func (s *instrumentedStore) ListPendingRegistrations() (result []*standalone.ClientRegistration, err error) {
+	defer s.metrics.observe("ListPendingRegistrations", time.Now(), &err)
+
+	return s.next.ListPendingRegistrations()
+}
+
+func (s *instrumentedStore) RemovePendingRegistration(clientID string) (err error) {
+	defer s.metrics.observe("RemovePendingRegistration", time.Now(), &err)
+
+	return s.next.RemovePendingRegistration(clientID)
+}
+
+func (s *instrumentedStore) SaveRegisteredClient(client *standalone.RegisteredClient) (err error) {
+	defer s.metrics.observe("SaveRegisteredClient", time.Now(), &err)
+
+	return s.next.SaveRegisteredClient(client)
+}
+
+func (s *instrumentedStore) GetRegisteredClient(clientID string) (result *standalone.RegisteredClient, err error) {
+	defer s.metrics.observe("GetRegisteredClient", time.Now(), &err)
+
+	return s.next.GetRegisteredClient(clientID)
+}
+
+func (s *instrumentedStore) ListRegisteredClients() (result []*standalone.RegisteredClient, err error) {
+	defer s.metrics.observe("ListRegisteredClients", time.Now(), &err)
+
+	return s.next.ListRegisteredClients()
+}
+
+func (s *instrumentedStore) DeleteRegisteredClient(clientID string) (err error) {
+	defer s.metrics.observe("DeleteRegisteredClient", time.Now(), &err)
+
+	return s.next.DeleteRegisteredClient(clientID)
+}
+
+func (s *instrumentedStore) GetSealedKey() (result *SealedKeyData, err error) {
+	defer s.metrics.observe("GetSealedKey", time.Now(), &err)
+
+	return s.next.GetSealedKey()
+}
+
+func (s *instrumentedStore) SaveSealedKey(data *SealedKeyData) (err error) {
+	defer s.metrics.observe("SaveSealedKey", time.Now(), &err)
+
+	return s.next.SaveSealedKey(data)
+}
+
+func (s *instrumentedStore) DeleteSealedKey() (err error) {
+	defer s.metrics.observe("DeleteSealedKey", time.Now(), &err)
+
+	return s.next.DeleteSealedKey()
+}
+
+func (s *instrumentedStore) HasSealedKey() (result bool, err error) {
+	defer s.metrics.observe("HasSealedKey", time.Now(), &err)
+
+	return s.next.HasSealedKey()
+}
+
+func (s *instrumentedStore) GetSlackConfig() (result *model.SlackConfig, err error) {
+	defer s.metrics.observe("GetSlackConfig", time.Now(), &err)
+
+	return s.next.GetSlackConfig()
+}
+
+func (s *instrumentedStore) SaveSlackConfig(config *model.SlackConfig) (err error) {
+	defer s.metrics.observe("SaveSlackConfig", time.Now(), &err)
+
+	return s.next.SaveSlackConfig(config)
+}
+
+func (s *instrumentedStore) DeleteSlackConfig() (err error) {
+	defer s.metrics.observe("DeleteSlackConfig", time.Now(), &err)
+
+	return s.next.DeleteSlackConfig()
+}
+
+func (s *instrumentedStore) EnableSlackNotifications() (err error) {
+	defer s.metrics.observe("EnableSlackNotifications", time.Now(), &err)
+
+	return s.next.EnableSlackNotifications()
+}
+
+func (s *instrumentedStore) DisableSlackNotifications() (err error) {
+	defer s.metrics.observe("DisableSlackNotifications", time.Now(), &err)
+
+	return s.next.DisableSlackNotifications()
+}
+
+func (s *instrumentedStore) SaveSlackAccount(account *model.SlackAccount) (err error) {
+	defer s.metrics.observe("SaveSlackAccount", time.Now(), &err)
+
+	return s.next.SaveSlackAccount(account)
+}
+
+func (s *instrumentedStore) GetSlackAccount(name string) (result *model.SlackAccount, err error) {
+	defer s.metrics.observe("GetSlackAccount", time.Now(), &err)
+
+	return s.next.GetSlackAccount(name)
+}
+
+func (s *instrumentedStore) GetActiveSlackAccount() (result *model.SlackAccount, err error) {
+	defer s.metrics.observe("GetActiveSlackAccount", time.Now(), &err)
+
+	return s.next.GetActiveSlackAccount()
+}
+
+func (s *instrumentedStore) SetActiveSlackAccount(name string) (err error) {
+	defer s.metrics.observe("SetActiveSlackAccount", time.Now(), &err)
+
+	return s.next.SetActiveSlackAccount(name)
+}
+
+func (s *instrumentedStore) ListSlackAccounts() (result []*model.SlackAccount, err error) {
+	defer s.metrics.observe("ListSlackAccounts", time.Now(), &err)
+
+	return s.next.ListSlackAccounts()
+}
+
+func (s *instrumentedStore) DeleteSlackAccount(name string) (err error) {
+	defer s.metrics.observe("DeleteSlackAccount", time.Now(), &err)
+
+	return s.next.DeleteSlackAccount(name)
+}
+
+func (s *instrumentedStore) SlackAccountExists(name string) (result bool, err error) {
+	defer s.metrics.observe("SlackAccountExists", time.Now(), &err)
+
+	return s.next.SlackAccountExists(name)
+}
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrDatabaseLocked is returned when another clonr process holds the database
+var ErrDatabaseLocked = errors.New("database is locked by another clonr process")
+
+// OpenMode controls how GetDB opens the database
+type OpenMode int
+
+const (
+	// OpenAuto opens the database read-write. When another process holds it,
+	// reads are served from a read-only snapshot and repository, profile and
+	// workspace operations are forwarded to the running server.
+	OpenAuto OpenMode = iota
+
+	// OpenExclusive requires read-write access and fails with a *LockedError
+	// when the database is held. Used by 'clonr server start'.
+	OpenExclusive
+
+	// OpenReadOnly always opens a read-only snapshot and never takes the lock
+	OpenReadOnly
+)
+
+var (
+	modeMu   sync.Mutex
+	openMode = OpenAuto
+)
+
+// SetOpenMode sets how GetDB opens the database. It has no effect once the
+// database has been opened.
+func SetOpenMode(mode OpenMode) {
+	modeMu.Lock()
+	defer modeMu.Unlock()
+
+	openMode = mode
+}
+
+func currentOpenMode() OpenMode {
+	modeMu.Lock()
+	defer modeMu.Unlock()
+
+	return openMode
+}
+
+// LockHolder describes the process holding the database, as recorded in
+// the owner file next to it
+type LockHolder struct {
+	PID     int       `json:"pid"`
+	Command string    `json:"command"`
+	Server  bool      `json:"server"`
+	Since   time.Time `json:"since"`
+}
+
+// LockedError reports that the database at Path is held by another process
+type LockedError struct {
+	Path   string
+	Holder *LockHolder
+}
+
+func (e *LockedError) Error() string {
+	if e.Holder == nil {
+		return fmt.Sprintf("database %s is locked by another process; close other clonr commands or use 'clonr server start' so they share one server", e.Path)
+	}
+
+	if e.Holder.Server {
+		return fmt.Sprintf("database %s is held by the clonr server (pid %d, running since %s); stop it with 'clonr server stop' first",
+			e.Path, e.Holder.PID, e.Holder.Since.Format(time.DateTime))
+	}
+
+	return fmt.Sprintf("database %s is locked by 'clonr %s' (pid %d, running since %s); wait for it to finish or use 'clonr server start' so commands share one server",
+		e.Path, e.Holder.Command, e.Holder.PID, e.Holder.Since.Format(time.DateTime))
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrDatabaseLocked
+}
+
+// ownerPath returns the owner file recording who holds the database
+func ownerPath(dbPath string) string {
+	return dbPath + ".owner"
+}
+
+// writeLockHolder records the current process as the database holder
+func writeLockHolder(dbPath string, server bool) error {
+	command := ""
+	if len(os.Args) > 1 {
+		command = strings.Join(os.Args[1:], " ")
+	}
+
+	data, err := json.Marshal(LockHolder{
+		PID:     os.Getpid(),
+		Command: command,
+		Server:  server,
+		Since:   time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(ownerPath(dbPath), data, 0600)
+}
+
+// readLockHolder returns the recorded database holder, or nil if unknown
+func readLockHolder(dbPath string) *LockHolder {
+	data, err := os.ReadFile(ownerPath(dbPath))
+	if err != nil {
+		return nil
+	}
+
+	var holder LockHolder
+	if err := json.Unmarshal(data, &holder); err != nil || holder.PID == 0 {
+		return nil
+	}
+
+	return &holder
+}
+
+// removeLockHolder deletes the owner file if it still names this process
+func removeLockHolder(dbPath string) {
+	if holder := readLockHolder(dbPath); holder != nil && holder.PID != os.Getpid() {
+		return
+	}
+
+	_ = os.Remove(ownerPath(dbPath))
+}
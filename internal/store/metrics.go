@@ -0,0 +1,207 @@
+package store
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSlowThreshold is the duration above which a Store call is logged
+	DefaultSlowThreshold = 200 * time.Millisecond
+
+	// maxSlowOps is the number of recent slow calls kept for inspection
+	maxSlowOps = 50
+)
+
+// OpStats aggregates the calls to one Store method
+type OpStats struct {
+	Method string        `json:"method"`
+	Calls  int64         `json:"calls"`
+	Errors int64         `json:"errors"`
+	Slow   int64         `json:"slow"`
+	Total  time.Duration `json:"total"`
+	Max    time.Duration `json:"max"`
+}
+
+// Avg returns the mean call duration
+func (o OpStats) Avg() time.Duration {
+	if o.Calls == 0 {
+		return 0
+	}
+
+	return o.Total / time.Duration(o.Calls)
+}
+
+// SlowOp is a single Store call that exceeded the slow threshold
+type SlowOp struct {
+	Method   string        `json:"method"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+	At       time.Time     `json:"at"`
+}
+
+// MetricsSnapshot is a point-in-time copy of the collected metrics
+type MetricsSnapshot struct {
+	Since     time.Time     `json:"since"`
+	Threshold time.Duration `json:"threshold"`
+	Ops       []OpStats     `json:"ops"`  // sorted by total time, highest first
+	Slow      []SlowOp      `json:"slow"` // most recent first
+}
+
+// Metrics collects call durations for an instrumented Store
+type Metrics struct {
+	mu        sync.Mutex
+	since     time.Time
+	threshold time.Duration
+	ops       map[string]*OpStats
+	slow      []SlowOp
+}
+
+// NewMetrics creates a collector logging calls slower than threshold.
+// A threshold of zero disables slow-call logging.
+func NewMetrics(threshold time.Duration) *Metrics {
+	return &Metrics{
+		since:     time.Now(),
+		threshold: threshold,
+		ops:       make(map[string]*OpStats),
+	}
+}
+
+var defaultMetrics = NewMetrics(DefaultSlowThreshold)
+
+// DefaultMetrics returns the collector used by the store returned from GetDB
+func DefaultMetrics() *Metrics {
+	return defaultMetrics
+}
+
+// SetSlowThreshold changes the duration above which calls are logged
+func (m *Metrics) SetSlowThreshold(threshold time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.threshold = threshold
+}
+
+// observe records a call to method that started at start. It is deferred
+// by the instrumented methods, so err holds the call's final error.
+func (m *Metrics) observe(method string, start time.Time, err *error) {
+	elapsed := time.Since(start)
+
+	m.mu.Lock()
+
+	op, ok := m.ops[method]
+	if !ok {
+		op = &OpStats{Method: method}
+		m.ops[method] = op
+	}
+
+	op.Calls++
+	op.Total += elapsed
+	op.Max = max(op.Max, elapsed)
+
+	if *err != nil {
+		op.Errors++
+	}
+
+	slow := m.threshold > 0 && elapsed >= m.threshold
+	if slow {
+		op.Slow++
+
+		entry := SlowOp{Method: method, Duration: elapsed, At: start}
+		if *err != nil {
+			entry.Error = (*err).Error()
+		}
+
+		m.slow = append(m.slow, entry)
+		if len(m.slow) > maxSlowOps {
+			m.slow = m.slow[len(m.slow)-maxSlowOps:]
+		}
+	}
+
+	m.mu.Unlock()
+
+	if slow {
+		log.Printf("store: slow %s took %s", method, elapsed.Round(time.Millisecond))
+	}
+}
+
+// Snapshot returns a copy of the collected metrics
+func (m *Metrics) Snapshot() MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snap := MetricsSnapshot{
+		Since:     m.since,
+		Threshold: m.threshold,
+		Ops:       make([]OpStats, 0, len(m.ops)),
+		Slow:      make([]SlowOp, 0, len(m.slow)),
+	}
+
+	for _, op := range m.ops {
+		snap.Ops = append(snap.Ops, *op)
+	}
+
+	sort.Slice(snap.Ops, func(i, j int) bool {
+		if snap.Ops[i].Total != snap.Ops[j].Total {
+			return snap.Ops[i].Total > snap.Ops[j].Total
+		}
+
+		return snap.Ops[i].Method < snap.Ops[j].Method
+	})
+
+	for i := len(m.slow) - 1; i >= 0; i-- {
+		snap.Slow = append(snap.Slow, m.slow[i])
+	}
+
+	return snap
+}
+
+// Reset clears the collected metrics
+func (m *Metrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.since = time.Now()
+	m.ops = make(map[string]*OpStats)
+	m.slow = nil
+}
+
+// WritePrometheus writes the snapshot in the Prometheus text exposition format
+func (s MetricsSnapshot) WritePrometheus(w io.Writer) error {
+	metrics := []struct {
+		name, kind, help string
+		value            func(OpStats) string
+	}{
+		{"clonr_store_calls_total", "counter", "Store calls by method.",
+			func(o OpStats) string { return fmt.Sprint(o.Calls) }},
+		{"clonr_store_errors_total", "counter", "Store calls that returned an error.",
+			func(o OpStats) string { return fmt.Sprint(o.Errors) }},
+		{"clonr_store_slow_calls_total", "counter", "Store calls slower than the slow threshold.",
+			func(o OpStats) string { return fmt.Sprint(o.Slow) }},
+		{"clonr_store_call_seconds_total", "counter", "Total time spent in Store calls.",
+			func(o OpStats) string { return fmt.Sprintf("%g", o.Total.Seconds()) }},
+		{"clonr_store_call_seconds_max", "gauge", "Slowest Store call.",
+			func(o OpStats) string { return fmt.Sprintf("%g", o.Max.Seconds()) }},
+	}
+
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric.name, metric.help, metric.name, metric.kind); err != nil {
+			return err
+		}
+
+		for _, op := range s.Ops {
+			if _, err := fmt.Fprintf(w, "%s{method=%q} %s\n", metric.name, op.Method, metric.value(op)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "# HELP clonr_store_slow_threshold_seconds Duration above which Store calls are logged.\n# TYPE clonr_store_slow_threshold_seconds gauge\nclonr_store_slow_threshold_seconds %g\n",
+		s.Threshold.Seconds())
+
+	return err
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func observeAt(m *Metrics, method string, elapsed time.Duration, err error) {
+	m.observe(method, time.Now().Add(-elapsed), &err)
+}
+
+func TestMetricsObserve(t *testing.T) {
+	m := NewMetrics(100 * time.Millisecond)
+
+	observeAt(m, "GetAllRepos", 10*time.Millisecond, nil)
+	observeAt(m, "GetAllRepos", 300*time.Millisecond, nil)
+	observeAt(m, "GetConfig", time.Millisecond, errors.New("boom"))
+
+	snap := m.Snapshot()
+
+	if len(snap.Ops) != 2 || snap.Ops[0].Method != "GetAllRepos" {
+		t.Fatalf("Ops = %+v, want GetAllRepos first", snap.Ops)
+	}
+
+	op := snap.Ops[0]
+	if op.Calls != 2 || op.Slow != 1 || op.Max < 300*time.Millisecond {
+		t.Errorf("GetAllRepos = %+v, want 2 calls, 1 slow, max >= 300ms", op)
+	}
+
+	if op.Avg() != op.Total/2 {
+		t.Errorf("Avg() = %s, want %s", op.Avg(), op.Total/2)
+	}
+
+	if snap.Ops[1].Errors != 1 {
+		t.Errorf("GetConfig errors = %d, want 1", snap.Ops[1].Errors)
+	}
+
+	if len(snap.Slow) != 1 || snap.Slow[0].Method != "GetAllRepos" {
+		t.Errorf("Slow = %+v, want one GetAllRepos call", snap.Slow)
+	}
+}
+
+func TestMetricsSlowThreshold(t *testing.T) {
+	m := NewMetrics(0)
+
+	observeAt(m, "Ping", time.Second, nil)
+
+	if snap := m.Snapshot(); len(snap.Slow) != 0 || snap.Ops[0].Slow != 0 {
+		t.Error("slow call recorded with threshold disabled")
+	}
+
+	m.SetSlowThreshold(time.Millisecond)
+
+	for range maxSlowOps + 10 {
+		observeAt(m, "Ping", time.Second, errors.New("locked"))
+	}
+
+	snap := m.Snapshot()
+	if len(snap.Slow) != maxSlowOps {
+		t.Errorf("kept %d slow calls, want %d", len(snap.Slow), maxSlowOps)
+	}
+
+	if snap.Slow[0].Error != "locked" {
+		t.Errorf("Slow[0].Error = %q, want locked", snap.Slow[0].Error)
+	}
+
+	m.Reset()
+
+	if snap := m.Snapshot(); len(snap.Ops) != 0 || len(snap.Slow) != 0 {
+		t.Error("Reset() did not clear metrics")
+	}
+}
+
+func TestWritePrometheus(t *testing.T) {
+	m := NewMetrics(time.Second)
+	observeAt(m, "GetAllRepos", 2*time.Second, nil)
+
+	var b strings.Builder
+	if err := m.Snapshot().WritePrometheus(&b); err != nil {
+		t.Fatalf("WritePrometheus() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# TYPE clonr_store_calls_total counter",
+		`clonr_store_calls_total{method="GetAllRepos"} 1`,
+		`clonr_store_slow_calls_total{method="GetAllRepos"} 1`,
+		"clonr_store_slow_threshold_seconds 1",
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -40,16 +40,29 @@ func derefTime(t *time.Time) time.Time {
 
 // sqlcRepoToModel converts a sqlc Repository to a model.Repository.
 func sqlcRepoToModel(row sqlc.Repository) *model.Repository {
+	var tags []string
+	if row.Tags != nil && *row.Tags != "" {
+		_ = json.Unmarshal([]byte(*row.Tags), &tags)
+	}
+
 	return &model.Repository{
-		ID:          uint(row.ID),
-		UID:         row.Uid,
-		URL:         row.Url,
-		Path:        row.Path,
-		Workspace:   derefString(row.Workspace),
-		Favorite:    derefInt64ToBool(row.Favorite),
-		ClonedAt:    row.ClonedAt,
-		UpdatedAt:   row.UpdatedAt,
-		LastChecked: row.LastChecked,
+		ID:           uint(row.ID),
+		UID:          row.Uid,
+		URL:          row.Url,
+		Path:         row.Path,
+		Workspace:    derefString(row.Workspace),
+		Favorite:     derefInt64ToBool(row.Favorite),
+		ClonedAt:     row.ClonedAt,
+		UpdatedAt:    row.UpdatedAt,
+		LastChecked:  row.LastChecked,
+		Kind:         model.RepoKind(derefString(row.Kind)),
+		UpstreamURL:  derefString(row.UpstreamUrl),
+		License:      derefString(row.License),
+		Tags:         tags,
+		Notes:        derefString(row.Notes),
+		Alias:        derefString(row.Alias),
+		AccessCount:  int(row.AccessCount),
+		LastAccessed: derefTime(row.LastAccessed),
 	}
 }
 
@@ -66,27 +79,40 @@ func sqlcProfileToModel(row sqlc.Profile) *model.Profile {
 	}
 
 	return &model.Profile{
-		Name:           row.Name,
-		Host:           derefString(row.Host),
-		User:           derefString(row.Username),
-		TokenStorage:   model.TokenStorage(derefString(row.TokenStorage)),
-		Scopes:         scopes,
-		Default:        derefInt64ToBool(row.IsDefault),
-		EncryptedToken: row.EncryptedToken,
-		Workspace:      derefString(row.Workspace),
-		NotifyChannels: notifyChannels,
-		CreatedAt:      row.CreatedAt,
-		LastUsedAt:     derefTime(row.LastUsedAt),
+		Name:             row.Name,
+		Host:             derefString(row.Host),
+		User:             derefString(row.Username),
+		TokenStorage:     model.TokenStorage(derefString(row.TokenStorage)),
+		Scopes:           scopes,
+		Default:          derefInt64ToBool(row.IsDefault),
+		EncryptedToken:   row.EncryptedToken,
+		Workspace:        derefString(row.Workspace),
+		NotifyChannels:   notifyChannels,
+		CreatedAt:        row.CreatedAt,
+		LastUsedAt:       derefTime(row.LastUsedAt),
+		TokenExpiresAt:   derefTime(row.TokenExpiresAt),
+		GitName:          derefString(row.GitName),
+		GitEmail:         derefString(row.GitEmail),
+		GitSigningKey:    derefString(row.GitSigningKey),
+		GitSigningFormat: derefString(row.GitSigningFormat),
+		GitSignCommits:   derefInt64ToBool(row.GitSignCommits),
 	}
 }
 
 // sqlcWorkspaceToModel converts a sqlc Workspace to a model.Workspace.
 func sqlcWorkspaceToModel(row sqlc.Workspace) *model.Workspace {
+	var urlPatterns []string
+	if row.UrlPatterns != nil && *row.UrlPatterns != "" {
+		_ = json.Unmarshal([]byte(*row.UrlPatterns), &urlPatterns)
+	}
+
 	return &model.Workspace{
 		Name:        row.Name,
 		Description: derefString(row.Description),
 		Path:        derefString(row.Path),
 		Active:      derefInt64ToBool(row.IsActive),
+		URLPatterns: urlPatterns,
+		DiskBudget:  derefInt64(row.DiskBudgetBytes),
 		CreatedAt:   row.CreatedAt,
 		UpdatedAt:   row.UpdatedAt,
 	}
@@ -99,9 +125,20 @@ func sqlcConnectionToModel(row sqlc.StandaloneConnection) *standalone.Standalone
 		_ = json.Unmarshal([]byte(*row.SyncedItems), &syncedItems)
 	}
 
+	var syncFilter standalone.SyncFilter
+	if row.SyncFilter != nil && *row.SyncFilter != "" {
+		_ = json.Unmarshal([]byte(*row.SyncFilter), &syncFilter)
+	}
+
+	var ackedRevisions map[string]int64
+	if row.AckedRevisions != nil && *row.AckedRevisions != "" {
+		_ = json.Unmarshal([]byte(*row.AckedRevisions), &ackedRevisions)
+	}
+
 	return &standalone.StandaloneConnection{
 		Name:                  row.Name,
 		InstanceID:            derefString(row.InstanceID),
+		ClientID:              derefString(row.ClientID),
 		Host:                  row.Host,
 		Port:                  int(derefInt64(row.Port)),
 		APIKeyEncrypted:       row.ApiKeyEncrypted,
@@ -110,6 +147,8 @@ func sqlcConnectionToModel(row sqlc.StandaloneConnection) *standalone.Standalone
 		LocalSalt:             row.LocalSalt,
 		SyncStatus:            derefString(row.SyncStatus),
 		SyncedItems:           syncedItems,
+		SyncFilter:            syncFilter,
+		AckedRevisions:        ackedRevisions,
 		LastSync:              row.LastSync,
 		CreatedAt:             row.CreatedAt,
 		UpdatedAt:             row.UpdatedAt,
@@ -151,12 +190,20 @@ func sqlcRegisteredClientToModel(row sqlc.RegisteredClient) *standalone.Register
 		SyncCount:         int(derefInt64(row.SyncCount)),
 		LastIP:            derefString(row.LastIp),
 		RegisteredAt:      row.RegisteredAt,
-		LastSeenAt:        row.LastSeenAt,
+		LastSeenAt:        derefTime(row.LastSeenAt),
+		Fingerprint:       derefString(row.Fingerprint),
 	}
 }
 
 // sqlcDockerProfileToModel converts a sqlc DockerProfile to a model.DockerProfile.
 func sqlcDockerProfileToModel(row sqlc.DockerProfile) *model.DockerProfile {
+	var environment *model.DockerEnvironment
+	if row.Environment != nil && *row.Environment != "" {
+		if err := json.Unmarshal([]byte(*row.Environment), &environment); err != nil {
+			environment = nil
+		}
+	}
+
 	return &model.DockerProfile{
 		Name:           row.Name,
 		Registry:       derefString(row.Registry),
@@ -165,6 +212,145 @@ func sqlcDockerProfileToModel(row sqlc.DockerProfile) *model.DockerProfile {
 		TokenStorage:   model.TokenStorage(derefString(row.TokenStorage)),
 		CreatedAt:      row.CreatedAt,
 		LastUsedAt:     derefTime(row.LastUsedAt),
+		Environment:    environment,
+	}
+}
+
+// sqlcSavedFilterToModel converts a sqlc SavedFilter to a model.SavedFilter.
+func sqlcSavedFilterToModel(row sqlc.SavedFilter) *model.SavedFilter {
+	return &model.SavedFilter{
+		Name:          row.Name,
+		Description:   derefString(row.Description),
+		Workspace:     derefString(row.Workspace),
+		FavoritesOnly: derefInt64ToBool(row.FavoritesOnly),
+		Query:         derefString(row.Query),
+		Dirty:         derefInt64ToBool(row.Dirty),
+		Language:      derefString(row.Language),
+		Sort:          derefString(row.Sort),
+		Kind:          model.RepoKind(derefString(row.Kind)),
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+// sqlcRepoSnapshotToModel converts a sqlc RepoSnapshot to a model.RepoSnapshot.
+func sqlcRepoSnapshotToModel(row sqlc.RepoSnapshot) *model.RepoSnapshot {
+	return &model.RepoSnapshot{
+		ID:        row.ID,
+		RepoURL:   row.RepoUrl,
+		Path:      row.Path,
+		Message:   derefString(row.Message),
+		Branch:    derefString(row.Branch),
+		Head:      row.Head,
+		Stash:     derefString(row.Stash),
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+// sqlcWizardDraftToModel converts a sqlc WizardDraft to a model.WizardDraft.
+func sqlcWizardDraftToModel(row sqlc.WizardDraft) *model.WizardDraft {
+	return &model.WizardDraft{
+		Name:      row.Name,
+		Data:      row.Data,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+// sqlcAPITokenToModel converts a sqlc ApiToken to a model.APIToken.
+func sqlcAPITokenToModel(row sqlc.ApiToken) *model.APIToken {
+	return &model.APIToken{
+		Name:       row.Name,
+		Hash:       row.Hash,
+		CreatedAt:  row.CreatedAt,
+		ExpiresAt:  derefTime(row.ExpiresAt),
+		LastUsedAt: derefTime(row.LastUsedAt),
+	}
+}
+
+// sqlcVaultSecretToModel converts a sqlc VaultSecret to a model.VaultSecret.
+func sqlcVaultSecretToModel(row sqlc.VaultSecret) *model.VaultSecret {
+	return &model.VaultSecret{
+		Key:       row.Key,
+		Value:     row.Value,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+// sqlcGmailWatchToModel converts a sqlc GmailWatch to a model.GmailWatch.
+func sqlcGmailWatchToModel(row sqlc.GmailWatch) *model.GmailWatch {
+	return &model.GmailWatch{
+		Name:          row.Name,
+		Profile:       row.Profile,
+		Query:         row.Query,
+		Interval:      time.Duration(row.IntervalSeconds) * time.Second,
+		Notify:        row.Notify != 0,
+		Exec:          row.Exec,
+		HistoryID:     row.HistoryID,
+		LastCheckedAt: derefTime(row.LastCheckedAt),
+		LastMatchAt:   derefTime(row.LastMatchAt),
+		LastError:     row.LastError,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+// sqlcGitHubRepoIDToModel converts a sqlc GithubRepoID to a model.GitHubRepoID.
+func sqlcGitHubRepoIDToModel(row sqlc.GithubRepoID) *model.GitHubRepoID {
+	return &model.GitHubRepoID{
+		FullName: row.FullName,
+		RepoID:   row.RepoID,
+		CachedAt: row.CachedAt,
+	}
+}
+
+// sqlcDependencyInventoryToModel converts a sqlc DependencyInventory to a
+// model.DependencyInventory.
+func sqlcDependencyInventoryToModel(row sqlc.DependencyInventory) *model.DependencyInventory {
+	var dependencies []model.Dependency
+	if row.Dependencies != "" {
+		_ = json.Unmarshal([]byte(row.Dependencies), &dependencies)
+	}
+
+	return &model.DependencyInventory{
+		RepoURL:      row.RepoUrl,
+		Dependencies: dependencies,
+		ScannedAt:    row.ScannedAt,
+	}
+}
+
+// sqlcShareLinkToModel converts a sqlc ShareLink to a model.ShareLink.
+func sqlcShareLinkToModel(row sqlc.ShareLink) *model.ShareLink {
+	return &model.ShareLink{
+		ID:        row.ID,
+		RepoURL:   row.RepoUrl,
+		Note:      row.Note,
+		CreatedAt: row.CreatedAt,
+		ExpiresAt: row.ExpiresAt,
+	}
+}
+
+// sqlcJobToModel converts a sqlc Job to a model.Job.
+func sqlcJobToModel(row sqlc.Job) *model.Job {
+	var log []string
+	if row.Log != "" {
+		_ = json.Unmarshal([]byte(row.Log), &log)
+	}
+
+	return &model.Job{
+		ID:              row.ID,
+		Kind:            row.Kind,
+		Description:     row.Description,
+		State:           model.JobState(row.State),
+		Total:           int(row.Total),
+		Done:            int(row.Done),
+		Failed:          int(row.Failed),
+		Log:             log,
+		Error:           row.Error,
+		CancelRequested: row.CancelRequested != 0,
+		StartedAt:       row.StartedAt,
+		UpdatedAt:       row.UpdatedAt,
+		FinishedAt:      row.FinishedAt,
 	}
 }
 
@@ -17,6 +17,12 @@ import (
 //go:embed migrations/*.sql
 var migrationsFS embed.FS
 
+// dataMigrations rewrite existing rows in Go, for changes SQL cannot
+// express. Each runs in the transaction of the migration with its version.
+var dataMigrations = map[int]func(tx *sql.Tx) error{
+	35: canonicalizeRepoURLs,
+}
+
 // Migration represents a database migration.
 type Migration struct {
 	Version     int
@@ -196,7 +202,7 @@ func (m *Migrator) MigrateUp() error {
 			return fmt.Errorf("migration %d has no up SQL", mig.Version)
 		}
 
-		if err := m.runMigration(mig.UpSQL); err != nil {
+		if err := m.runMigration(mig.UpSQL, dataMigrations[mig.Version]); err != nil {
 			return fmt.Errorf("applying migration %d (%s): %w", mig.Version, mig.Description, err)
 		}
 	}
@@ -238,7 +244,7 @@ func (m *Migrator) MigrateDown() error {
 		return fmt.Errorf("migration %d has no down SQL", currentVersion)
 	}
 
-	if err := m.runMigration(currentMigration.DownSQL); err != nil {
+	if err := m.runMigration(currentMigration.DownSQL, nil); err != nil {
 		return fmt.Errorf("rolling back migration %d (%s): %w",
 			currentVersion, currentMigration.Description, err)
 	}
@@ -273,7 +279,7 @@ func (m *Migrator) MigrateTo(targetVersion int) error {
 				return fmt.Errorf("migration %d has no up SQL", mig.Version)
 			}
 
-			if err := m.runMigration(mig.UpSQL); err != nil {
+			if err := m.runMigration(mig.UpSQL, dataMigrations[mig.Version]); err != nil {
 				return fmt.Errorf("applying migration %d: %w", mig.Version, err)
 			}
 		}
@@ -295,7 +301,7 @@ func (m *Migrator) MigrateTo(targetVersion int) error {
 				return fmt.Errorf("migration %d has no down SQL", mig.Version)
 			}
 
-			if err := m.runMigration(mig.DownSQL); err != nil {
+			if err := m.runMigration(mig.DownSQL, nil); err != nil {
 				return fmt.Errorf("rolling back migration %d: %w", mig.Version, err)
 			}
 		}
@@ -304,8 +310,9 @@ func (m *Migrator) MigrateTo(targetVersion int) error {
 	return nil
 }
 
-// runMigration executes a migration SQL script.
-func (m *Migrator) runMigration(sqlScript string) error {
+// runMigration executes a migration SQL script, followed by its data
+// migration when there is one.
+func (m *Migrator) runMigration(sqlScript string, data func(tx *sql.Tx) error) error {
 	tx, err := m.db.Begin()
 	if err != nil {
 		return fmt.Errorf("beginning transaction: %w", err)
@@ -321,6 +328,12 @@ func (m *Migrator) runMigration(sqlScript string) error {
 		return fmt.Errorf("executing migration: %w", err)
 	}
 
+	if data != nil {
+		if err = data(tx); err != nil {
+			return fmt.Errorf("migrating data: %w", err)
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
 	}
@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"slices"
+
+	"github.com/inovacc/clonr/internal/git"
+)
+
+// repoURLTables are the tables keyed by the URL of a repository besides
+// repositories itself
+var repoURLTables = []string{"repo_remotes", "repo_snapshots", "dependency_inventories", "share_links"}
+
+// canonicalizeRepoURLs rewrites the repository URLs to their canonical form.
+// A repository whose canonical URL is already tracked was registered twice;
+// the record kept is the one already canonical, else the oldest, and the
+// rows of the others move to it. The repositories with webhooks enabled in
+// the config are rewritten too.
+func canonicalizeRepoURLs(tx *sql.Tx) error {
+	rows, err := tx.Query(`SELECT url FROM repositories ORDER BY id`)
+	if err != nil {
+		return err
+	}
+
+	var urls []string
+
+	for rows.Next() {
+		var u string
+		if err := rows.Scan(&u); err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		urls = append(urls, u)
+	}
+
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	taken := make(map[string]bool, len(urls))
+
+	for _, u := range urls {
+		if git.CanonicalURL(u) == u {
+			taken[u] = true
+		}
+	}
+
+	for _, oldURL := range urls {
+		newURL := git.CanonicalURL(oldURL)
+		if newURL == oldURL {
+			continue
+		}
+
+		if taken[newURL] {
+			if _, err := tx.Exec(`DELETE FROM repo_remotes WHERE repo_url = ?`, oldURL); err != nil {
+				return err
+			}
+
+			if _, err := tx.Exec(`DELETE FROM repositories WHERE url = ?`, oldURL); err != nil {
+				return err
+			}
+		} else {
+			if _, err := tx.Exec(`UPDATE repositories SET url = ? WHERE url = ?`, newURL, oldURL); err != nil {
+				return err
+			}
+
+			taken[newURL] = true
+		}
+
+		for _, table := range repoURLTables {
+			//nolint:gosec // table names are constants
+			if _, err := tx.Exec(`UPDATE OR IGNORE `+table+` SET repo_url = ? WHERE repo_url = ?`, newURL, oldURL); err != nil {
+				return err
+			}
+
+			// Rows the update skipped on a conflict belong to no repository
+			//nolint:gosec // table names are constants
+			if _, err := tx.Exec(`DELETE FROM `+table+` WHERE repo_url = ?`, oldURL); err != nil {
+				return err
+			}
+		}
+	}
+
+	return canonicalizeWebhooks(tx)
+}
+
+// canonicalizeWebhooks rewrites the config's list of repositories with
+// webhooks enabled to canonical URLs
+func canonicalizeWebhooks(tx *sql.Tx) error {
+	var data sql.NullString
+	if err := tx.QueryRow(`SELECT webhooks FROM config WHERE id = 1`).Scan(&data); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	var urls []string
+	if data.String == "" || json.Unmarshal([]byte(data.String), &urls) != nil {
+		return nil
+	}
+
+	canonical := git.CanonicalURLs(urls)
+	if slices.Equal(canonical, urls) {
+		return nil
+	}
+
+	out, err := json.Marshal(canonical)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE config SET webhooks = ? WHERE id = 1`, string(out))
+
+	return err
+}
@@ -0,0 +1,6 @@
+-- Migration: 005_token_expiry (rollback)
+-- Description: Remove profile token expiration tracking
+
+ALTER TABLE profiles DROP COLUMN token_expires_at;
+
+DELETE FROM schema_migrations WHERE version = 5;
@@ -0,0 +1,9 @@
+-- Migration: 005_token_expiry
+-- Description: Track profile token expiration for credential reminders
+-- Created: 2026-10-16
+
+-- Token expiration time (NULL = no known expiry)
+ALTER TABLE profiles ADD COLUMN token_expires_at DATETIME;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (5, 'Token expiry');
@@ -0,0 +1,7 @@
+-- Migration: 006_profile_git_identity (rollback)
+-- Description: Remove per-profile git identity
+
+ALTER TABLE profiles DROP COLUMN git_email;
+ALTER TABLE profiles DROP COLUMN git_name;
+
+DELETE FROM schema_migrations WHERE version = 6;
@@ -0,0 +1,10 @@
+-- Migration: 006_profile_git_identity
+-- Description: Per-profile git commit identity applied to cloned repositories
+-- Created: 2026-10-16
+
+-- Git user.name / user.email (NULL = not set)
+ALTER TABLE profiles ADD COLUMN git_name TEXT;
+ALTER TABLE profiles ADD COLUMN git_email TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (6, 'Profile git identity');
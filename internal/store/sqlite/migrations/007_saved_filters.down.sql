@@ -0,0 +1,7 @@
+-- Migration: 007_saved_filters (rollback)
+-- Description: Remove saved repository filters
+
+DROP INDEX IF EXISTS idx_saved_filters_name;
+DROP TABLE IF EXISTS saved_filters;
+
+DELETE FROM schema_migrations WHERE version = 7;
@@ -0,0 +1,22 @@
+-- Migration: 007_saved_filters
+-- Description: Add saved repository filters (smart lists)
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS saved_filters (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT UNIQUE NOT NULL,
+    description TEXT,
+    workspace TEXT,
+    favorites_only INTEGER DEFAULT 0,
+    query TEXT,
+    dirty INTEGER DEFAULT 0,
+    language TEXT,                           -- go, rust, python, ...
+    sort TEXT,                               -- name, cloned, updated
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_filters_name ON saved_filters(name);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (7, 'Saved filters');
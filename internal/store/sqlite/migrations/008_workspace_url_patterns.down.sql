@@ -0,0 +1,6 @@
+-- Migration: 008_workspace_url_patterns (rollback)
+-- Description: Remove workspace URL patterns
+
+ALTER TABLE workspaces DROP COLUMN url_patterns;
+
+DELETE FROM schema_migrations WHERE version = 8;
@@ -0,0 +1,9 @@
+-- Migration: 008_workspace_url_patterns
+-- Description: URL patterns that route clones to a workspace automatically
+-- Created: 2026-10-16
+
+-- JSON array of glob patterns, e.g. ["github.com/my-company/*"]
+ALTER TABLE workspaces ADD COLUMN url_patterns TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (8, 'Workspace URL patterns');
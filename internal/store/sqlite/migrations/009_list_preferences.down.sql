@@ -0,0 +1,7 @@
+-- Migration: 009_list_preferences (rollback)
+-- Description: Remove list column and sort preferences
+
+ALTER TABLE config DROP COLUMN list_sort;
+ALTER TABLE config DROP COLUMN list_columns;
+
+DELETE FROM schema_migrations WHERE version = 9;
@@ -0,0 +1,10 @@
+-- Migration: 009_list_preferences
+-- Description: Persisted columns and sort order for repository lists
+-- Created: 2026-10-16
+
+-- JSON array of column names, e.g. ["name","workspace","size"]
+ALTER TABLE config ADD COLUMN list_columns TEXT;
+ALTER TABLE config ADD COLUMN list_sort TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (9, 'List preferences');
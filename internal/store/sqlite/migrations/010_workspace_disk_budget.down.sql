@@ -0,0 +1,6 @@
+-- Migration: 010_workspace_disk_budget (rollback)
+-- Description: Remove workspace disk budget
+
+ALTER TABLE workspaces DROP COLUMN disk_budget_bytes;
+
+DELETE FROM schema_migrations WHERE version = 10;
@@ -0,0 +1,9 @@
+-- Migration: 010_workspace_disk_budget
+-- Description: Disk budget per workspace for clone directory usage alerts
+-- Created: 2026-10-16
+
+-- Budget in bytes; NULL or 0 means no budget
+ALTER TABLE workspaces ADD COLUMN disk_budget_bytes INTEGER;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (10, 'Workspace disk budget');
@@ -0,0 +1,7 @@
+-- Migration: 011_repo_snapshots (rollback)
+-- Description: Remove repository snapshots
+
+DROP INDEX IF EXISTS idx_repo_snapshots_repo_url;
+DROP TABLE IF EXISTS repo_snapshots;
+
+DELETE FROM schema_migrations WHERE version = 11;
@@ -0,0 +1,19 @@
+-- Migration: 011_repo_snapshots
+-- Description: Add repository snapshots (branch, HEAD and stashed changes)
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS repo_snapshots (
+    id TEXT PRIMARY KEY,
+    repo_url TEXT NOT NULL,
+    path TEXT NOT NULL,
+    message TEXT,
+    branch TEXT,                             -- empty when HEAD was detached
+    head TEXT NOT NULL,
+    stash TEXT,                              -- stash commit SHA, empty if clean
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_repo_snapshots_repo_url ON repo_snapshots(repo_url);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (11, 'Repository snapshots');
@@ -0,0 +1,9 @@
+-- Migration: 012_gitconfig_import (rollback)
+-- Description: Remove commit signing and URL rewrites
+
+ALTER TABLE config DROP COLUMN url_rewrites;
+ALTER TABLE profiles DROP COLUMN git_sign_commits;
+ALTER TABLE profiles DROP COLUMN git_signing_format;
+ALTER TABLE profiles DROP COLUMN git_signing_key;
+
+DELETE FROM schema_migrations WHERE version = 12;
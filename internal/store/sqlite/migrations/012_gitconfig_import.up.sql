@@ -0,0 +1,14 @@
+-- Migration: 012_gitconfig_import
+-- Description: Commit signing per profile and URL rewrites imported from git config
+-- Created: 2026-10-16
+
+-- Git user.signingkey / gpg.format (NULL = not set), commit.gpgsign (0/1)
+ALTER TABLE profiles ADD COLUMN git_signing_key TEXT;
+ALTER TABLE profiles ADD COLUMN git_signing_format TEXT;
+ALTER TABLE profiles ADD COLUMN git_sign_commits INTEGER DEFAULT 0;
+
+-- JSON array of {"base","instead_of"} rewrites applied to clone arguments
+ALTER TABLE config ADD COLUMN url_rewrites TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (12, 'Git config import');
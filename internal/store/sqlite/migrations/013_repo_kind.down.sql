@@ -0,0 +1,9 @@
+-- Migration: 013_repo_kind (rollback)
+-- Description: Remove repository kind
+
+DROP INDEX IF EXISTS idx_repositories_kind;
+
+ALTER TABLE saved_filters DROP COLUMN kind;
+ALTER TABLE repositories DROP COLUMN kind;
+
+DELETE FROM schema_migrations WHERE version = 13;
@@ -0,0 +1,12 @@
+-- Migration: 013_repo_kind
+-- Description: Repository kind (source, fork, mirror, archive, template)
+-- Created: 2026-10-16
+
+-- NULL or '' means not classified yet
+ALTER TABLE repositories ADD COLUMN kind TEXT;
+ALTER TABLE saved_filters ADD COLUMN kind TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_repositories_kind ON repositories(kind);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (13, 'Repository kind');
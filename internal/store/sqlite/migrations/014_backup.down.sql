@@ -0,0 +1,6 @@
+-- Migration: 014_backup (rollback)
+-- Description: Remove backup configuration
+
+ALTER TABLE config DROP COLUMN backup;
+
+DELETE FROM schema_migrations WHERE version = 14;
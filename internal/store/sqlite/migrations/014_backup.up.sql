@@ -0,0 +1,9 @@
+-- Migration: 014_backup
+-- Description: Backup destination and retention policy
+-- Created: 2026-10-16
+
+-- JSON object {"dest","keep_last","keep_days"} used by 'clonr backup'
+ALTER TABLE config ADD COLUMN backup TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (14, 'Backup configuration');
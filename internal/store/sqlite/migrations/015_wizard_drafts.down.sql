@@ -0,0 +1,6 @@
+-- Migration: 015_wizard_drafts (rollback)
+-- Description: Remove wizard drafts
+
+DROP TABLE IF EXISTS wizard_drafts;
+
+DELETE FROM schema_migrations WHERE version = 15;
@@ -0,0 +1,12 @@
+-- Migration: 015_wizard_drafts
+-- Description: Draft state of interrupted interactive flows
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS wizard_drafts (
+    name TEXT PRIMARY KEY,                   -- flow name, e.g. configure, connect
+    data BLOB NOT NULL,                      -- flow-specific JSON state
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (15, 'Wizard drafts');
@@ -0,0 +1,6 @@
+-- Migration: 016_webhooks (rollback)
+-- Description: Remove push webhook settings
+
+ALTER TABLE config DROP COLUMN webhooks;
+
+DELETE FROM schema_migrations WHERE version = 16;
@@ -0,0 +1,9 @@
+-- Migration: 016_webhooks
+-- Description: Repositories pulled on push webhooks
+-- Created: 2026-10-16
+
+-- JSON array of tracked repository URLs pulled when a push webhook arrives
+ALTER TABLE config ADD COLUMN webhooks TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (16, 'Push webhooks');
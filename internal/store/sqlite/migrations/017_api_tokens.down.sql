@@ -0,0 +1,6 @@
+-- Migration: 017_api_tokens (rollback)
+-- Description: Remove API tokens
+
+DROP TABLE IF EXISTS api_tokens;
+
+DELETE FROM schema_migrations WHERE version = 17;
@@ -0,0 +1,14 @@
+-- Migration: 017_api_tokens
+-- Description: Hashed bearer tokens of remote gRPC clients
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+    name TEXT PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,               -- hex SHA-256 of the token
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME,                     -- NULL if the token never expires
+    last_used_at DATETIME
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (17, 'API tokens');
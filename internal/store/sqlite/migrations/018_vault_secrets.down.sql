@@ -0,0 +1,6 @@
+-- Migration: 018_vault_secrets (rollback)
+-- Description: Remove vault secrets
+
+DROP TABLE IF EXISTS vault_secrets;
+
+DELETE FROM schema_migrations WHERE version = 18;
@@ -0,0 +1,13 @@
+-- Migration: 018_vault_secrets
+-- Description: Encrypted secrets of 'clonr vault'
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS vault_secrets (
+    key TEXT PRIMARY KEY,
+    value BLOB NOT NULL,                     -- encrypted with the keystore
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (18, 'Vault secrets');
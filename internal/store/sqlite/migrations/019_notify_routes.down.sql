@@ -0,0 +1,6 @@
+-- Migration: 019_notify_routes (rollback)
+-- Description: Remove notification routing rules
+
+ALTER TABLE config DROP COLUMN notify_routes;
+
+DELETE FROM schema_migrations WHERE version = 19;
@@ -0,0 +1,9 @@
+-- Migration: 019_notify_routes
+-- Description: Notification routing rules per event type
+-- Created: 2026-10-16
+
+-- JSON array of {event, channels} routes choosing the notification channels of an event type
+ALTER TABLE config ADD COLUMN notify_routes TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (19, 'Notification routes');
@@ -0,0 +1,6 @@
+-- Migration: 020_gmail_watches (rollback)
+-- Description: Remove Gmail watches
+
+DROP TABLE IF EXISTS gmail_watches;
+
+DELETE FROM schema_migrations WHERE version = 20;
@@ -0,0 +1,21 @@
+-- Migration: 020_gmail_watches
+-- Description: Gmail searches polled by the server
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS gmail_watches (
+    name TEXT PRIMARY KEY,
+    profile TEXT NOT NULL,
+    query TEXT NOT NULL,
+    interval_seconds INTEGER NOT NULL DEFAULT 300,
+    notify INTEGER NOT NULL DEFAULT 1,      -- 0 = hook only, 1 = notify
+    exec TEXT NOT NULL DEFAULT '',
+    history_id TEXT NOT NULL DEFAULT '',     -- mailbox history ID of the last poll
+    last_checked_at DATETIME,
+    last_match_at DATETIME,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (20, 'Gmail watches');
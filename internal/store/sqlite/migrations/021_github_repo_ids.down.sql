@@ -0,0 +1,6 @@
+-- Migration: 021_github_repo_ids (rollback)
+-- Description: Remove the GitHub repository ID cache
+
+DROP TABLE IF EXISTS github_repo_ids;
+
+DELETE FROM schema_migrations WHERE version = 21;
@@ -0,0 +1,12 @@
+-- Migration: 021_github_repo_ids
+-- Description: Cache of GitHub repository IDs by owner/repo
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS github_repo_ids (
+    full_name TEXT PRIMARY KEY,              -- lowercased owner/repo
+    repo_id INTEGER NOT NULL,
+    cached_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (21, 'GitHub repository ID cache');
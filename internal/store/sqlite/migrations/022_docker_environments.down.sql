@@ -0,0 +1,6 @@
+-- Migration: 022_docker_environments (rollback)
+-- Description: Remove development containers of docker profiles
+
+ALTER TABLE docker_profiles DROP COLUMN environment;
+
+DELETE FROM schema_migrations WHERE version = 22;
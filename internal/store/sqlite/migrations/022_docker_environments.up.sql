@@ -0,0 +1,9 @@
+-- Migration: 022_docker_environments
+-- Description: Development containers of docker profiles
+-- Created: 2026-10-16
+
+-- JSON object {image, env, mounts, ports, workdir, command} run by 'clonr docker up'
+ALTER TABLE docker_profiles ADD COLUMN environment TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (22, 'Docker profile environments');
@@ -0,0 +1,6 @@
+-- Migration: 023_repo_upstream (rollback)
+-- Description: Remove the upstream repository of forks
+
+ALTER TABLE repositories DROP COLUMN upstream_url;
+
+DELETE FROM schema_migrations WHERE version = 23;
@@ -0,0 +1,9 @@
+-- Migration: 023_repo_upstream
+-- Description: Upstream repository of forks
+-- Created: 2026-10-16
+
+-- URL of the repository a fork was created from; NULL or '' for other repositories
+ALTER TABLE repositories ADD COLUMN upstream_url TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (23, 'Repository upstream');
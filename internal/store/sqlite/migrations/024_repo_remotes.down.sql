@@ -0,0 +1,7 @@
+-- Migration: 024_repo_remotes (rollback)
+-- Description: Remove repository remotes
+
+DROP INDEX IF EXISTS idx_repo_remotes_url;
+DROP TABLE IF EXISTS repo_remotes;
+
+DELETE FROM schema_migrations WHERE version = 24;
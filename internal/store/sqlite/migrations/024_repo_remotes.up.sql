@@ -0,0 +1,20 @@
+-- Migration: 024_repo_remotes
+-- Description: Remotes of repositories besides their primary URL
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS repo_remotes (
+    repo_url TEXT NOT NULL,                  -- primary URL of the repository
+    name TEXT NOT NULL,                      -- git remote name, e.g. upstream
+    url TEXT NOT NULL,                       -- normalized remote URL
+    PRIMARY KEY (repo_url, name)
+);
+
+-- Exists-by-URL checks also match remotes
+CREATE INDEX IF NOT EXISTS idx_repo_remotes_url ON repo_remotes(url);
+
+-- Forks recorded before remotes were tracked
+INSERT OR IGNORE INTO repo_remotes (repo_url, name, url)
+SELECT url, 'upstream', upstream_url FROM repositories WHERE upstream_url IS NOT NULL AND upstream_url <> '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (24, 'Repository remotes');
@@ -0,0 +1,6 @@
+-- Migration: 025_repo_license (rollback)
+-- Description: Remove the detected license of repositories
+
+ALTER TABLE repositories DROP COLUMN license;
+
+DELETE FROM schema_migrations WHERE version = 25;
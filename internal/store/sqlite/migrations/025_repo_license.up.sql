@@ -0,0 +1,10 @@
+-- Migration: 025_repo_license
+-- Description: Detected license of repositories
+-- Created: 2026-10-16
+
+-- SPDX identifier, 'none' when no license was found, 'other' when it was not
+-- recognized; NULL or '' until scanned
+ALTER TABLE repositories ADD COLUMN license TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (25, 'Repository license');
@@ -0,0 +1,7 @@
+-- Migration: 026_dependency_inventories (rollback)
+-- Description: Remove dependency inventories
+
+DROP INDEX IF EXISTS idx_dependency_inventories_repo_url;
+DROP TABLE IF EXISTS dependency_inventories;
+
+DELETE FROM schema_migrations WHERE version = 26;
@@ -0,0 +1,15 @@
+-- Migration: 026_dependency_inventories
+-- Description: Dependency inventories of repositories over time
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS dependency_inventories (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    repo_url TEXT NOT NULL,
+    dependencies TEXT NOT NULL,              -- JSON array of dependencies
+    scanned_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_dependency_inventories_repo_url ON dependency_inventories(repo_url, scanned_at);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (26, 'Dependency inventories');
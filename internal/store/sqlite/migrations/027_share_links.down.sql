@@ -0,0 +1,6 @@
+-- Migration: 027_share_links (rollback)
+-- Description: Remove share links
+
+DROP TABLE IF EXISTS share_links;
+
+DELETE FROM schema_migrations WHERE version = 27;
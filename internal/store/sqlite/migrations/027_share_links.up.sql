@@ -0,0 +1,14 @@
+-- Migration: 027_share_links
+-- Description: One-time repository share links
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS share_links (
+    id TEXT PRIMARY KEY,
+    repo_url TEXT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at DATETIME NOT NULL
+);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (27, 'Share links');
@@ -0,0 +1,6 @@
+-- Migration: 028_concurrency (rollback)
+-- Description: Remove the concurrency limits
+
+ALTER TABLE config DROP COLUMN concurrency;
+
+DELETE FROM schema_migrations WHERE version = 28;
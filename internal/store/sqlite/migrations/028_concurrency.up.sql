@@ -0,0 +1,9 @@
+-- Migration: 028_concurrency
+-- Description: Limits of parallel git operations and API calls
+-- Created: 2026-10-16
+
+-- JSON object {git_ops, api_calls}; missing or zero values use the defaults
+ALTER TABLE config ADD COLUMN concurrency TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (28, 'Concurrency limits');
@@ -0,0 +1,7 @@
+-- Migration: 029_jobs (rollback)
+-- Description: Remove tracked jobs
+
+DROP INDEX IF EXISTS idx_jobs_started_at;
+DROP TABLE IF EXISTS jobs;
+
+DELETE FROM schema_migrations WHERE version = 29;
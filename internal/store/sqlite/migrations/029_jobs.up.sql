@@ -0,0 +1,24 @@
+-- Migration: 029_jobs
+-- Description: Long-running operations with their progress and logs
+-- Created: 2026-10-16
+
+CREATE TABLE IF NOT EXISTS jobs (
+    id TEXT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    state TEXT NOT NULL,                     -- running, succeeded, failed or cancelled
+    total INTEGER NOT NULL DEFAULT 0,
+    done INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    log TEXT NOT NULL DEFAULT '[]',          -- JSON array of the newest log lines
+    error TEXT NOT NULL DEFAULT '',
+    cancel_requested INTEGER NOT NULL DEFAULT 0,
+    started_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    finished_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_started_at ON jobs(started_at);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (29, 'Jobs');
@@ -0,0 +1,6 @@
+-- Migration: 030_server_tls (rollback)
+-- Description: Remove the default server certificate
+
+ALTER TABLE config DROP COLUMN server_tls;
+
+DELETE FROM schema_migrations WHERE version = 30;
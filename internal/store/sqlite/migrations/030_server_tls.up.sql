@@ -0,0 +1,9 @@
+-- Migration: 030_server_tls
+-- Description: Default certificate of the gRPC server
+-- Created: 2026-10-16
+
+-- JSON object {cert_file, key_file, client_ca_file}; flags of 'clonr server start' win
+ALTER TABLE config ADD COLUMN server_tls TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (30, 'Server TLS');
@@ -0,0 +1,6 @@
+-- Migration: 031_keymap (rollback)
+-- Description: Remove the remapped keys
+
+ALTER TABLE config DROP COLUMN keymap;
+
+DELETE FROM schema_migrations WHERE version = 31;
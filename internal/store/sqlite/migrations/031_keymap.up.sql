@@ -0,0 +1,9 @@
+-- Migration: 031_keymap
+-- Description: Remapped keys of the interactive views
+-- Created: 2026-10-16
+
+-- JSON object {select, favorite, delete, open, filter}, each a list of keys
+ALTER TABLE config ADD COLUMN keymap TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (31, 'Key map');
@@ -0,0 +1,6 @@
+-- Migration: 032_repo_tags (rollback)
+-- Description: Remove the tags of repositories
+
+ALTER TABLE repositories DROP COLUMN tags;
+
+DELETE FROM schema_migrations WHERE version = 32;
@@ -0,0 +1,9 @@
+-- Migration: 032_repo_tags
+-- Description: Tags of repositories
+-- Created: 2026-10-16
+
+-- JSON array of sorted tags; NULL when the repository has none
+ALTER TABLE repositories ADD COLUMN tags TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (32, 'Repository tags');
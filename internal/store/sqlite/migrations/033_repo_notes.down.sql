@@ -0,0 +1,6 @@
+-- Migration: 033_repo_notes (rollback)
+-- Description: Remove the notes of repositories
+
+ALTER TABLE repositories DROP COLUMN notes;
+
+DELETE FROM schema_migrations WHERE version = 33;
@@ -0,0 +1,9 @@
+-- Migration: 033_repo_notes
+-- Description: Notes of repositories
+-- Created: 2026-10-16
+
+-- Free text kept with the repository; NULL or '' when there are none
+ALTER TABLE repositories ADD COLUMN notes TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (33, 'Repository notes');
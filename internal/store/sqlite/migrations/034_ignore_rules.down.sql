@@ -0,0 +1,6 @@
+-- Migration: 034_ignore_rules (rollback)
+-- Description: Remove the ignore list
+
+ALTER TABLE config DROP COLUMN ignore_rules;
+
+DELETE FROM schema_migrations WHERE version = 34;
@@ -0,0 +1,9 @@
+-- Migration: 034_ignore_rules
+-- Description: Ignore list of map, the server monitors and org mirrors
+-- Created: 2026-10-16
+
+-- JSON object {paths, urls}, each a list of glob patterns
+ALTER TABLE config ADD COLUMN ignore_rules TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (34, 'Ignore rules');
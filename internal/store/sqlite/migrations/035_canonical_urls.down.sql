@@ -0,0 +1,4 @@
+-- Migration: 035_canonical_urls (rollback)
+-- Description: The original URLs are not kept; only the version is removed
+
+DELETE FROM schema_migrations WHERE version = 35;
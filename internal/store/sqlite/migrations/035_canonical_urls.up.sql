@@ -0,0 +1,10 @@
+-- Migration: 035_canonical_urls
+-- Description: Canonical repository URLs
+-- Created: 2026-10-16
+
+-- The URLs are rewritten by the data migration run with this version
+-- (canonicalizeRepoURLs): https scheme, lower-case host, no credentials
+-- and no .git suffix. Duplicates of a repository are merged.
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (35, 'Canonical repository URLs');
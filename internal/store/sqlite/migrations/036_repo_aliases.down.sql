@@ -0,0 +1,8 @@
+-- Migration: 036_repo_aliases (rollback)
+-- Description: Remove the aliases of repositories
+
+DROP INDEX IF EXISTS idx_repositories_alias;
+
+ALTER TABLE repositories DROP COLUMN alias;
+
+DELETE FROM schema_migrations WHERE version = 36;
@@ -0,0 +1,11 @@
+-- Migration: 036_repo_aliases
+-- Description: Aliases of repositories
+-- Created: 2026-10-16
+
+-- Short name accepted by commands instead of the URL; NULL when unset
+ALTER TABLE repositories ADD COLUMN alias TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_repositories_alias ON repositories(alias);
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (36, 'Repository aliases');
@@ -0,0 +1,7 @@
+-- Migration: 037_repo_access (rollback)
+-- Description: Remove the access counts of repositories
+
+ALTER TABLE repositories DROP COLUMN last_accessed;
+ALTER TABLE repositories DROP COLUMN access_count;
+
+DELETE FROM schema_migrations WHERE version = 37;
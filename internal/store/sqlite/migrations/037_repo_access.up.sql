@@ -0,0 +1,10 @@
+-- Migration: 037_repo_access
+-- Description: Access counts of repositories for frecency ordering
+-- Created: 2026-10-16
+
+-- Times the repository was opened or updated, and when it last was
+ALTER TABLE repositories ADD COLUMN access_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE repositories ADD COLUMN last_accessed DATETIME;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (37, 'Repository access counts');
@@ -0,0 +1,6 @@
+-- Migration: 038_pinned_repos (rollback)
+-- Description: Remove the pinned repositories
+
+ALTER TABLE config DROP COLUMN pinned_repos;
+
+DELETE FROM schema_migrations WHERE version = 38;
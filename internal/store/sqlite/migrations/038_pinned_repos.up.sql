@@ -0,0 +1,9 @@
+-- Migration: 038_pinned_repos
+-- Description: Repositories pinned to the main menu
+-- Created: 2026-10-16
+
+-- JSON array of repository URLs, in pin order
+ALTER TABLE config ADD COLUMN pinned_repos TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (38, 'Pinned repositories');
@@ -0,0 +1,6 @@
+-- Migration: 039_sync_filter (rollback)
+-- Description: Remove the sync filter of standalone connections
+
+ALTER TABLE standalone_connections DROP COLUMN sync_filter;
+
+DELETE FROM schema_migrations WHERE version = 39;
@@ -0,0 +1,9 @@
+-- Migration: 039_sync_filter
+-- Description: Selective sync filter of standalone connections
+-- Created: 2026-10-16
+
+-- JSON object {data_types, workspaces}; NULL syncs everything
+ALTER TABLE standalone_connections ADD COLUMN sync_filter TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (39, 'Standalone sync filter');
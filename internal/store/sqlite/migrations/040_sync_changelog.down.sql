@@ -0,0 +1,7 @@
+-- Migration: 040_sync_changelog (rollback)
+-- Description: Remove the delta sync change log
+
+ALTER TABLE standalone_connections DROP COLUMN acked_revisions;
+DROP TABLE IF EXISTS sync_changelog;
+
+DELETE FROM schema_migrations WHERE version = 40;
@@ -0,0 +1,18 @@
+-- Migration: 040_sync_changelog
+-- Description: Change log of the standalone delta sync protocol
+-- Created: 2026-10-16
+
+-- Source side: revision counter of each bucket (profiles, workspaces, repos,
+-- config) and the revision each record last changed at
+CREATE TABLE IF NOT EXISTS sync_changelog (
+    bucket TEXT PRIMARY KEY,
+    revision INTEGER NOT NULL DEFAULT 0,
+    records TEXT,                            -- JSON object key -> {revision, checksum, workspace, deleted}
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Destination side: last revision of each bucket applied from the connection
+ALTER TABLE standalone_connections ADD COLUMN acked_revisions TEXT;
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (40, 'Sync change log');
@@ -0,0 +1,8 @@
+-- Migration: 041_client_approval (rollback)
+-- Description: Remove the auto-approve policy and client key fingerprints
+
+ALTER TABLE registered_clients DROP COLUMN fingerprint;
+ALTER TABLE pending_registrations DROP COLUMN fingerprint;
+ALTER TABLE standalone_config DROP COLUMN auto_approve;
+
+DELETE FROM schema_migrations WHERE version = 41;
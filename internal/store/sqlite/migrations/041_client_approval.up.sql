@@ -0,0 +1,13 @@
+-- Migration: 041_client_approval
+-- Description: Auto-approve policy and client key fingerprints of standalone servers
+-- Created: 2026-10-16
+
+-- JSON object {hostnames, max_clients}; NULL approves every client manually
+ALTER TABLE standalone_config ADD COLUMN auto_approve TEXT;
+
+-- Fingerprint of the client ID and encryption key
+ALTER TABLE pending_registrations ADD COLUMN fingerprint TEXT DEFAULT '';
+ALTER TABLE registered_clients ADD COLUMN fingerprint TEXT DEFAULT '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (41, 'Standalone client approval');
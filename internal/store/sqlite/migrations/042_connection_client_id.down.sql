@@ -0,0 +1,6 @@
+-- Migration: 042_connection_client_id (rollback)
+-- Description: Remove the client ID of standalone connections
+
+ALTER TABLE standalone_connections DROP COLUMN client_id;
+
+DELETE FROM schema_migrations WHERE version = 42;
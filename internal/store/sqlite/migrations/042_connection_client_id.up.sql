@@ -0,0 +1,9 @@
+-- Migration: 042_connection_client_id
+-- Description: Client ID of standalone connections
+-- Created: 2026-10-16
+
+-- ID the destination registered with on the source; it authenticates syncs
+ALTER TABLE standalone_connections ADD COLUMN client_id TEXT DEFAULT '';
+
+-- Record this migration
+INSERT INTO schema_migrations (version, description) VALUES (42, 'Standalone connection client ID');
@@ -0,0 +1,23 @@
+-- API token queries
+
+-- name: UpsertAPIToken :exec
+INSERT INTO api_tokens (name, hash, created_at, expires_at, last_used_at)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(name) DO UPDATE SET
+    hash = excluded.hash,
+    created_at = excluded.created_at,
+    expires_at = excluded.expires_at,
+    last_used_at = excluded.last_used_at;
+
+-- name: GetAPITokenByHash :one
+SELECT name, hash, created_at, expires_at, last_used_at
+FROM api_tokens
+WHERE hash = ?;
+
+-- name: ListAPITokens :many
+SELECT name, hash, created_at, expires_at, last_used_at
+FROM api_tokens
+ORDER BY name;
+
+-- name: DeleteAPIToken :exec
+DELETE FROM api_tokens WHERE name = ?;
@@ -9,6 +9,17 @@ UPDATE config SET
     monitor_interval = ?,
     server_port = ?,
     custom_editors = ?,
+    list_columns = ?,
+    list_sort = ?,
+    url_rewrites = ?,
+    backup = ?,
+    webhooks = ?,
+    notify_routes = ?,
+    concurrency = ?,
+    server_tls = ?,
+    keymap = ?,
+    ignore_rules = ?,
+    pinned_repos = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = 1;
 
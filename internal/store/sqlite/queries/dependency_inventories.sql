@@ -0,0 +1,12 @@
+-- Dependency inventory queries
+
+-- name: InsertDependencyInventory :exec
+INSERT INTO dependency_inventories (repo_url, dependencies, scanned_at)
+VALUES (?, ?, ?);
+
+-- name: ListDependencyInventories :many
+SELECT id, repo_url, dependencies, scanned_at
+FROM dependency_inventories
+WHERE repo_url = ?
+ORDER BY scanned_at DESC, id DESC
+LIMIT ?;
@@ -1,8 +1,8 @@
 -- Docker profile queries
 
 -- name: InsertDockerProfile :execlastid
-INSERT INTO docker_profiles (name, registry, username, encrypted_token, token_storage)
-VALUES (?, ?, ?, ?, ?);
+INSERT INTO docker_profiles (name, registry, username, encrypted_token, token_storage, environment)
+VALUES (?, ?, ?, ?, ?, ?);
 
 -- name: GetDockerProfile :one
 SELECT
@@ -13,7 +13,8 @@ SELECT
     encrypted_token,
     token_storage,
     created_at,
-    last_used_at
+    last_used_at,
+    environment
 FROM docker_profiles
 WHERE name = ?;
 
@@ -26,7 +27,8 @@ SELECT
     encrypted_token,
     token_storage,
     created_at,
-    last_used_at
+    last_used_at,
+    environment
 FROM docker_profiles
 ORDER BY name;
 
@@ -37,6 +39,7 @@ SET
     username = ?,
     encrypted_token = ?,
     token_storage = ?,
+    environment = ?,
     last_used_at = CURRENT_TIMESTAMP
 WHERE name = ?;
 
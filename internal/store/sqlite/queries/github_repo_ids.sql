@@ -0,0 +1,13 @@
+-- GitHub repository ID cache queries
+
+-- name: UpsertGitHubRepoID :exec
+INSERT INTO github_repo_ids (full_name, repo_id, cached_at)
+VALUES (?, ?, ?)
+ON CONFLICT(full_name) DO UPDATE SET
+    repo_id = excluded.repo_id,
+    cached_at = excluded.cached_at;
+
+-- name: GetGitHubRepoID :one
+SELECT full_name, repo_id, cached_at
+FROM github_repo_ids
+WHERE full_name = ?;
@@ -0,0 +1,34 @@
+-- Gmail watch queries
+
+-- name: UpsertGmailWatch :exec
+INSERT INTO gmail_watches (
+    name, profile, query, interval_seconds, notify, exec, history_id,
+    last_checked_at, last_match_at, last_error, created_at, updated_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(name) DO UPDATE SET
+    profile = excluded.profile,
+    query = excluded.query,
+    interval_seconds = excluded.interval_seconds,
+    notify = excluded.notify,
+    exec = excluded.exec,
+    history_id = excluded.history_id,
+    last_checked_at = excluded.last_checked_at,
+    last_match_at = excluded.last_match_at,
+    last_error = excluded.last_error,
+    updated_at = excluded.updated_at;
+
+-- name: GetGmailWatch :one
+SELECT name, profile, query, interval_seconds, notify, exec, history_id,
+       last_checked_at, last_match_at, last_error, created_at, updated_at
+FROM gmail_watches
+WHERE name = ?;
+
+-- name: ListGmailWatches :many
+SELECT name, profile, query, interval_seconds, notify, exec, history_id,
+       last_checked_at, last_match_at, last_error, created_at, updated_at
+FROM gmail_watches
+ORDER BY name;
+
+-- name: DeleteGmailWatch :exec
+DELETE FROM gmail_watches WHERE name = ?;
@@ -0,0 +1,30 @@
+-- Job queries
+
+-- name: UpsertJob :exec
+INSERT INTO jobs (id, kind, description, state, total, done, failed, log, error, started_at, updated_at, finished_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    kind = excluded.kind,
+    description = excluded.description,
+    state = excluded.state,
+    total = excluded.total,
+    done = excluded.done,
+    failed = excluded.failed,
+    log = excluded.log,
+    error = excluded.error,
+    updated_at = excluded.updated_at,
+    finished_at = excluded.finished_at;
+
+-- name: GetJob :one
+SELECT id, kind, description, state, total, done, failed, log, error, cancel_requested, started_at, updated_at, finished_at
+FROM jobs
+WHERE id = ?;
+
+-- name: ListJobs :many
+SELECT id, kind, description, state, total, done, failed, log, error, cancel_requested, started_at, updated_at, finished_at
+FROM jobs
+ORDER BY started_at DESC, id DESC
+LIMIT ?;
+
+-- name: RequestJobCancel :execrows
+UPDATE jobs SET cancel_requested = 1 WHERE id = ?;
@@ -13,8 +13,9 @@ SELECT EXISTS(SELECT 1 FROM profiles WHERE name = ?) AS exists_flag;
 -- name: InsertProfile :one
 INSERT INTO profiles (
     name, host, username, token_storage, scopes, is_default,
-    encrypted_token, workspace, notify_channels, created_at, last_used_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+    encrypted_token, workspace, notify_channels, token_expires_at, git_name, git_email,
+    git_signing_key, git_signing_format, git_sign_commits, created_at, last_used_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
 RETURNING *;
 
 -- name: UpdateProfile :exec
@@ -25,7 +26,13 @@ UPDATE profiles SET
     scopes = ?,
     encrypted_token = ?,
     workspace = ?,
-    notify_channels = ?
+    notify_channels = ?,
+    token_expires_at = ?,
+    git_name = ?,
+    git_email = ?,
+    git_signing_key = ?,
+    git_signing_format = ?,
+    git_sign_commits = ?
 WHERE name = ?;
 
 -- name: UpdateProfileLastUsed :exec
@@ -0,0 +1,11 @@
+-- name: ListRepoRemotes :many
+SELECT repo_url, name, url FROM repo_remotes ORDER BY repo_url, name;
+
+-- name: InsertRepoRemote :exec
+INSERT INTO repo_remotes (repo_url, name, url) VALUES (?, ?, ?);
+
+-- name: DeleteRepoRemotes :exec
+DELETE FROM repo_remotes WHERE repo_url = ?;
+
+-- name: GetRepoURLByRemoteURL :one
+SELECT repo_url FROM repo_remotes WHERE url = ? ORDER BY repo_url LIMIT 1;
@@ -0,0 +1,31 @@
+-- Repository snapshot queries
+
+-- name: InsertRepoSnapshot :exec
+INSERT INTO repo_snapshots (id, repo_url, path, message, branch, head, stash, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+    repo_url = excluded.repo_url,
+    path = excluded.path,
+    message = excluded.message,
+    branch = excluded.branch,
+    head = excluded.head,
+    stash = excluded.stash;
+
+-- name: GetRepoSnapshot :one
+SELECT id, repo_url, path, message, branch, head, stash, created_at
+FROM repo_snapshots
+WHERE id = ?;
+
+-- name: ListRepoSnapshots :many
+SELECT id, repo_url, path, message, branch, head, stash, created_at
+FROM repo_snapshots
+ORDER BY created_at DESC;
+
+-- name: ListRepoSnapshotsByURL :many
+SELECT id, repo_url, path, message, branch, head, stash, created_at
+FROM repo_snapshots
+WHERE repo_url = ?
+ORDER BY created_at DESC;
+
+-- name: DeleteRepoSnapshot :exec
+DELETE FROM repo_snapshots WHERE id = ?;
@@ -16,6 +16,22 @@ WHERE (workspace = ? OR ? = '')
   AND (favorite = 1 OR ? = 0)
 ORDER BY updated_at DESC;
 
+-- name: ListReposPage :many
+SELECT * FROM repositories
+WHERE (workspace = ? OR ? = '')
+  AND (favorite = 1 OR ? = 0)
+  AND (url LIKE ? ESCAPE '\' OR path LIKE ? ESCAPE '\')
+  AND (kind = ? OR ? = '')
+ORDER BY updated_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountReposPage :one
+SELECT COUNT(*) FROM repositories
+WHERE (workspace = ? OR ? = '')
+  AND (favorite = 1 OR ? = 0)
+  AND (url LIKE ? ESCAPE '\' OR path LIKE ? ESCAPE '\')
+  AND (kind = ? OR ? = '');
+
 -- name: RepoExistsByURL :one
 SELECT EXISTS(SELECT 1 FROM repositories WHERE url = ?) AS exists_flag;
 
@@ -23,8 +39,8 @@ SELECT EXISTS(SELECT 1 FROM repositories WHERE url = ?) AS exists_flag;
 SELECT EXISTS(SELECT 1 FROM repositories WHERE path = ?) AS exists_flag;
 
 -- name: InsertRepo :one
-INSERT INTO repositories (uid, url, path, workspace, favorite, cloned_at, updated_at)
-VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+INSERT INTO repositories (uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked)
+VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateRepoWorkspace :exec
@@ -36,6 +52,36 @@ UPDATE repositories SET favorite = ?, updated_at = CURRENT_TIMESTAMP WHERE url =
 -- name: UpdateRepoTimestamp :exec
 UPDATE repositories SET updated_at = CURRENT_TIMESTAMP WHERE url = ?;
 
+-- name: UpdateRepoPath :exec
+UPDATE repositories SET path = ?, updated_at = CURRENT_TIMESTAMP WHERE url = ?;
+
+-- name: UpdateRepoURL :exec
+UPDATE repositories SET url = ?, updated_at = CURRENT_TIMESTAMP WHERE url = ?;
+
+-- name: UpdateRepoRemotesRepoURL :exec
+UPDATE repo_remotes SET repo_url = ? WHERE repo_url = ?;
+
+-- name: UpdateRepoKind :exec
+UPDATE repositories SET kind = ? WHERE url = ?;
+
+-- name: UpdateRepoUpstream :exec
+UPDATE repositories SET upstream_url = ? WHERE url = ?;
+
+-- name: UpdateRepoLicense :exec
+UPDATE repositories SET license = ? WHERE url = ?;
+
+-- name: UpdateRepoTags :exec
+UPDATE repositories SET tags = ? WHERE url = ?;
+
+-- name: UpdateRepoNotes :exec
+UPDATE repositories SET notes = ? WHERE url = ?;
+
+-- name: UpdateRepoAlias :exec
+UPDATE repositories SET alias = ? WHERE url = ?;
+
+-- name: UpdateRepoAccess :exec
+UPDATE repositories SET access_count = access_count + 1, last_accessed = CURRENT_TIMESTAMP WHERE url = ?;
+
 -- name: UpdateRepoLastChecked :exec
 UPDATE repositories SET last_checked = CURRENT_TIMESTAMP WHERE url = ?;
 
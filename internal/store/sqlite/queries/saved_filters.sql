@@ -0,0 +1,59 @@
+-- Saved filter queries
+
+-- name: InsertSavedFilter :execlastid
+INSERT INTO saved_filters (name, description, workspace, favorites_only, query, dirty, language, sort, kind)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetSavedFilter :one
+SELECT
+    id,
+    name,
+    description,
+    workspace,
+    favorites_only,
+    query,
+    dirty,
+    language,
+    sort,
+    kind,
+    created_at,
+    updated_at
+FROM saved_filters
+WHERE name = ?;
+
+-- name: ListSavedFilters :many
+SELECT
+    id,
+    name,
+    description,
+    workspace,
+    favorites_only,
+    query,
+    dirty,
+    language,
+    sort,
+    kind,
+    created_at,
+    updated_at
+FROM saved_filters
+ORDER BY name;
+
+-- name: UpdateSavedFilter :exec
+UPDATE saved_filters
+SET
+    description = ?,
+    workspace = ?,
+    favorites_only = ?,
+    query = ?,
+    dirty = ?,
+    language = ?,
+    sort = ?,
+    kind = ?,
+    updated_at = CURRENT_TIMESTAMP
+WHERE name = ?;
+
+-- name: DeleteSavedFilter :exec
+DELETE FROM saved_filters WHERE name = ?;
+
+-- name: SavedFilterExists :one
+SELECT COUNT(*) FROM saved_filters WHERE name = ?;
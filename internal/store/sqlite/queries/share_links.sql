@@ -0,0 +1,18 @@
+-- Share link queries
+
+-- name: InsertShareLink :exec
+INSERT INTO share_links (id, repo_url, note, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: DeleteExpiredShareLinks :exec
+DELETE FROM share_links WHERE expires_at <= ?;
+
+-- name: GetShareLink :one
+SELECT id, repo_url, note, created_at, expires_at
+FROM share_links
+WHERE id = ?;
+
+-- name: ConsumeShareLink :one
+DELETE FROM share_links
+WHERE id = ?
+RETURNING id, repo_url, note, created_at, expires_at;
@@ -3,8 +3,8 @@
 SELECT * FROM standalone_config WHERE id = 1;
 
 -- name: UpsertStandaloneConfig :exec
-INSERT INTO standalone_config (id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at)
-VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, ?)
+INSERT INTO standalone_config (id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at, auto_approve)
+VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     enabled = excluded.enabled,
     is_server = excluded.is_server,
@@ -14,7 +14,8 @@ ON CONFLICT(id) DO UPDATE SET
     refresh_token = excluded.refresh_token,
     salt = excluded.salt,
     capabilities = excluded.capabilities,
-    expires_at = excluded.expires_at;
+    expires_at = excluded.expires_at,
+    auto_approve = excluded.auto_approve;
 
 -- name: DeleteStandaloneConfig :exec
 DELETE FROM standalone_config WHERE id = 1;
@@ -50,8 +51,8 @@ SELECT * FROM standalone_connections ORDER BY name ASC;
 -- name: InsertStandaloneConnection :one
 INSERT INTO standalone_connections (
     name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted,
-    local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+    local_password_hash, local_salt, sync_status, synced_items, last_sync, sync_filter, acked_revisions, client_id, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateStandaloneConnection :exec
@@ -66,6 +67,9 @@ UPDATE standalone_connections SET
     sync_status = ?,
     synced_items = ?,
     last_sync = ?,
+    sync_filter = ?,
+    acked_revisions = ?,
+    client_id = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE name = ?;
 
@@ -95,3 +99,15 @@ ON CONFLICT(id) DO UPDATE SET
 
 -- name: DeleteServerEncryptionConfig :exec
 DELETE FROM server_encryption_config WHERE id = 1;
+
+-- Sync Change Log (server mode)
+-- name: GetSyncChangelog :one
+SELECT * FROM sync_changelog WHERE bucket = ?;
+
+-- name: UpsertSyncChangelog :exec
+INSERT INTO sync_changelog (bucket, revision, records, updated_at)
+VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+ON CONFLICT(bucket) DO UPDATE SET
+    revision = excluded.revision,
+    records = excluded.records,
+    updated_at = excluded.updated_at;
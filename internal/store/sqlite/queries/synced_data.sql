@@ -62,14 +62,15 @@ SELECT * FROM pending_registrations ORDER BY initiated_at DESC;
 -- name: InsertPendingRegistration :exec
 INSERT INTO pending_registrations (
     client_id, client_name, machine_info, state,
-    challenge_token, challenge_at, initiated_at, completed_at
-) VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+    challenge_token, challenge_at, initiated_at, completed_at, fingerprint
+) VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL, ?)
 ON CONFLICT(client_id) DO UPDATE SET
     client_name = excluded.client_name,
     machine_info = excluded.machine_info,
     state = excluded.state,
     challenge_token = excluded.challenge_token,
-    challenge_at = excluded.challenge_at;
+    challenge_at = excluded.challenge_at,
+    fingerprint = excluded.fingerprint;
 
 -- name: UpdatePendingRegistrationState :exec
 UPDATE pending_registrations SET
@@ -93,15 +94,16 @@ SELECT * FROM registered_clients WHERE status = ? ORDER BY registered_at DESC;
 -- name: InsertRegisteredClient :exec
 INSERT INTO registered_clients (
     client_id, client_name, machine_info, encryption_key_hash, encryption_salt,
-    key_hint, status, sync_count, last_ip, registered_at, last_seen_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+    key_hint, status, sync_count, last_ip, registered_at, last_seen_at, fingerprint
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL, ?)
 ON CONFLICT(client_id) DO UPDATE SET
     client_name = excluded.client_name,
     machine_info = excluded.machine_info,
     encryption_key_hash = excluded.encryption_key_hash,
     encryption_salt = excluded.encryption_salt,
     key_hint = excluded.key_hint,
-    status = excluded.status;
+    status = excluded.status,
+    fingerprint = excluded.fingerprint;
 
 -- name: UpdateRegisteredClientLastSeen :exec
 UPDATE registered_clients SET
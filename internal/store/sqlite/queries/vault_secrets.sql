@@ -0,0 +1,21 @@
+-- Vault secret queries
+
+-- name: UpsertVaultSecret :exec
+INSERT INTO vault_secrets (key, value, created_at, updated_at)
+VALUES (?, ?, ?, ?)
+ON CONFLICT(key) DO UPDATE SET
+    value = excluded.value,
+    updated_at = excluded.updated_at;
+
+-- name: GetVaultSecret :one
+SELECT key, value, created_at, updated_at
+FROM vault_secrets
+WHERE key = ?;
+
+-- name: ListVaultSecrets :many
+SELECT key, value, created_at, updated_at
+FROM vault_secrets
+ORDER BY key;
+
+-- name: DeleteVaultSecret :exec
+DELETE FROM vault_secrets WHERE key = ?;
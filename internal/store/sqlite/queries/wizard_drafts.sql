@@ -0,0 +1,16 @@
+-- Wizard draft queries
+
+-- name: UpsertWizardDraft :exec
+INSERT INTO wizard_drafts (name, data, updated_at)
+VALUES (?, ?, ?)
+ON CONFLICT(name) DO UPDATE SET
+    data = excluded.data,
+    updated_at = excluded.updated_at;
+
+-- name: GetWizardDraft :one
+SELECT name, data, updated_at
+FROM wizard_drafts
+WHERE name = ?;
+
+-- name: DeleteWizardDraft :exec
+DELETE FROM wizard_drafts WHERE name = ?;
@@ -11,14 +11,16 @@ SELECT * FROM workspaces ORDER BY name ASC;
 SELECT EXISTS(SELECT 1 FROM workspaces WHERE name = ?) AS exists_flag;
 
 -- name: InsertWorkspace :one
-INSERT INTO workspaces (name, description, path, is_active, created_at, updated_at)
-VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+INSERT INTO workspaces (name, description, path, is_active, url_patterns, disk_budget_bytes, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 RETURNING *;
 
 -- name: UpdateWorkspace :exec
 UPDATE workspaces SET
     description = ?,
     path = ?,
+    url_patterns = ?,
+    disk_budget_bytes = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE name = ?;
 
@@ -10,7 +10,7 @@ import (
 )
 
 const getConfig = `-- name: GetConfig :one
-SELECT id, default_clone_dir, editor, terminal, monitor_interval, server_port, custom_editors, updated_at, key_rotation_days FROM config WHERE id = 1
+SELECT id, default_clone_dir, editor, terminal, monitor_interval, server_port, custom_editors, updated_at, key_rotation_days, list_columns, list_sort, url_rewrites, backup, webhooks, notify_routes, concurrency, server_tls, keymap, ignore_rules, pinned_repos FROM config WHERE id = 1
 `
 
 func (q *Queries) GetConfig(ctx context.Context) (Config, error) {
@@ -26,6 +26,17 @@ func (q *Queries) GetConfig(ctx context.Context) (Config, error) {
 		&i.CustomEditors,
 		&i.UpdatedAt,
 		&i.KeyRotationDays,
+		&i.ListColumns,
+		&i.ListSort,
+		&i.UrlRewrites,
+		&i.Backup,
+		&i.Webhooks,
+		&i.NotifyRoutes,
+		&i.Concurrency,
+		&i.ServerTls,
+		&i.Keymap,
+		&i.IgnoreRules,
+		&i.PinnedRepos,
 	)
 	return i, err
 }
@@ -38,6 +49,17 @@ UPDATE config SET
     monitor_interval = ?,
     server_port = ?,
     custom_editors = ?,
+    list_columns = ?,
+    list_sort = ?,
+    url_rewrites = ?,
+    backup = ?,
+    webhooks = ?,
+    notify_routes = ?,
+    concurrency = ?,
+    server_tls = ?,
+    keymap = ?,
+    ignore_rules = ?,
+    pinned_repos = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = 1
 `
@@ -49,6 +71,17 @@ type UpdateConfigParams struct {
 	MonitorInterval *int64  `json:"monitor_interval"`
 	ServerPort      *int64  `json:"server_port"`
 	CustomEditors   *string `json:"custom_editors"`
+	ListColumns     *string `json:"list_columns"`
+	ListSort        *string `json:"list_sort"`
+	UrlRewrites     *string `json:"url_rewrites"`
+	Backup          *string `json:"backup"`
+	Webhooks        *string `json:"webhooks"`
+	NotifyRoutes    *string `json:"notify_routes"`
+	Concurrency     *string `json:"concurrency"`
+	ServerTls       *string `json:"server_tls"`
+	Keymap          *string `json:"keymap"`
+	IgnoreRules     *string `json:"ignore_rules"`
+	PinnedRepos     *string `json:"pinned_repos"`
 }
 
 func (q *Queries) UpdateConfig(ctx context.Context, arg UpdateConfigParams) error {
@@ -59,6 +92,17 @@ func (q *Queries) UpdateConfig(ctx context.Context, arg UpdateConfigParams) erro
 		arg.MonitorInterval,
 		arg.ServerPort,
 		arg.CustomEditors,
+		arg.ListColumns,
+		arg.ListSort,
+		arg.UrlRewrites,
+		arg.Backup,
+		arg.Webhooks,
+		arg.NotifyRoutes,
+		arg.Concurrency,
+		arg.ServerTls,
+		arg.Keymap,
+		arg.IgnoreRules,
+		arg.PinnedRepos,
 	)
 	return err
 }
@@ -38,7 +38,8 @@ SELECT
     encrypted_token,
     token_storage,
     created_at,
-    last_used_at
+    last_used_at,
+    environment
 FROM docker_profiles
 WHERE name = ?
 `
@@ -55,14 +56,15 @@ func (q *Queries) GetDockerProfile(ctx context.Context, name string) (DockerProf
 		&i.TokenStorage,
 		&i.CreatedAt,
 		&i.LastUsedAt,
+		&i.Environment,
 	)
 	return i, err
 }
 
 const insertDockerProfile = `-- name: InsertDockerProfile :execlastid
 
-INSERT INTO docker_profiles (name, registry, username, encrypted_token, token_storage)
-VALUES (?, ?, ?, ?, ?)
+INSERT INTO docker_profiles (name, registry, username, encrypted_token, token_storage, environment)
+VALUES (?, ?, ?, ?, ?, ?)
 `
 
 type InsertDockerProfileParams struct {
@@ -71,6 +73,7 @@ type InsertDockerProfileParams struct {
 	Username       string  `json:"username"`
 	EncryptedToken []byte  `json:"encrypted_token"`
 	TokenStorage   *string `json:"token_storage"`
+	Environment    *string `json:"environment"`
 }
 
 // Docker profile queries
@@ -81,6 +84,7 @@ func (q *Queries) InsertDockerProfile(ctx context.Context, arg InsertDockerProfi
 		arg.Username,
 		arg.EncryptedToken,
 		arg.TokenStorage,
+		arg.Environment,
 	)
 	if err != nil {
 		return 0, err
@@ -97,7 +101,8 @@ SELECT
     encrypted_token,
     token_storage,
     created_at,
-    last_used_at
+    last_used_at,
+    environment
 FROM docker_profiles
 ORDER BY name
 `
@@ -120,6 +125,7 @@ func (q *Queries) ListDockerProfiles(ctx context.Context) ([]DockerProfile, erro
 			&i.TokenStorage,
 			&i.CreatedAt,
 			&i.LastUsedAt,
+			&i.Environment,
 		); err != nil {
 			return nil, err
 		}
@@ -141,6 +147,7 @@ SET
     username = ?,
     encrypted_token = ?,
     token_storage = ?,
+    environment = ?,
     last_used_at = CURRENT_TIMESTAMP
 WHERE name = ?
 `
@@ -150,6 +157,7 @@ type UpdateDockerProfileParams struct {
 	Username       string  `json:"username"`
 	EncryptedToken []byte  `json:"encrypted_token"`
 	TokenStorage   *string `json:"token_storage"`
+	Environment    *string `json:"environment"`
 	Name           string  `json:"name"`
 }
 
@@ -159,6 +167,7 @@ func (q *Queries) UpdateDockerProfile(ctx context.Context, arg UpdateDockerProfi
 		arg.Username,
 		arg.EncryptedToken,
 		arg.TokenStorage,
+		arg.Environment,
 		arg.Name,
 	)
 	return err
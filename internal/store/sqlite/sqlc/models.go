@@ -18,6 +18,17 @@ type Config struct {
 	CustomEditors   *string   `json:"custom_editors"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	KeyRotationDays *int64    `json:"key_rotation_days"`
+	ListColumns     *string   `json:"list_columns"`
+	ListSort        *string   `json:"list_sort"`
+	UrlRewrites     *string   `json:"url_rewrites"`
+	Backup          *string   `json:"backup"`
+	Webhooks        *string   `json:"webhooks"`
+	NotifyRoutes    *string   `json:"notify_routes"`
+	Concurrency     *string   `json:"concurrency"`
+	ServerTls       *string   `json:"server_tls"`
+	Keymap          *string   `json:"keymap"`
+	IgnoreRules     *string   `json:"ignore_rules"`
+	PinnedRepos     *string   `json:"pinned_repos"`
 }
 
 type DockerProfile struct {
@@ -29,58 +40,107 @@ type DockerProfile struct {
 	TokenStorage   *string    `json:"token_storage"`
 	CreatedAt      time.Time  `json:"created_at"`
 	LastUsedAt     *time.Time `json:"last_used_at"`
+	Environment    *string    `json:"environment"`
 }
 
 type PendingRegistration struct {
-	ClientID       string    `json:"client_id"`
-	ClientName     string    `json:"client_name"`
-	MachineInfo    *string   `json:"machine_info"`
-	State          *string   `json:"state"`
-	ChallengeToken *string   `json:"challenge_token"`
-	ChallengeAt    time.Time `json:"challenge_at"`
-	InitiatedAt    time.Time `json:"initiated_at"`
-	CompletedAt    time.Time `json:"completed_at"`
+	ClientID       string     `json:"client_id"`
+	ClientName     string     `json:"client_name"`
+	MachineInfo    *string    `json:"machine_info"`
+	State          *string    `json:"state"`
+	ChallengeToken *string    `json:"challenge_token"`
+	ChallengeAt    time.Time  `json:"challenge_at"`
+	InitiatedAt    time.Time  `json:"initiated_at"`
+	CompletedAt    *time.Time `json:"completed_at"`
+	Fingerprint    *string    `json:"fingerprint"`
 }
 
 type Profile struct {
-	ID             int64      `json:"id"`
-	Name           string     `json:"name"`
-	Host           *string    `json:"host"`
-	Username       *string    `json:"username"`
-	TokenStorage   *string    `json:"token_storage"`
-	Scopes         *string    `json:"scopes"`
-	IsDefault      *int64     `json:"is_default"`
-	EncryptedToken []byte     `json:"encrypted_token"`
-	Workspace      *string    `json:"workspace"`
-	NotifyChannels *string    `json:"notify_channels"`
-	CreatedAt      time.Time  `json:"created_at"`
-	LastUsedAt     *time.Time `json:"last_used_at"`
+	ID               int64      `json:"id"`
+	Name             string     `json:"name"`
+	Host             *string    `json:"host"`
+	Username         *string    `json:"username"`
+	TokenStorage     *string    `json:"token_storage"`
+	Scopes           *string    `json:"scopes"`
+	IsDefault        *int64     `json:"is_default"`
+	EncryptedToken   []byte     `json:"encrypted_token"`
+	Workspace        *string    `json:"workspace"`
+	NotifyChannels   *string    `json:"notify_channels"`
+	CreatedAt        time.Time  `json:"created_at"`
+	LastUsedAt       *time.Time `json:"last_used_at"`
+	TokenExpiresAt   *time.Time `json:"token_expires_at"`
+	GitName          *string    `json:"git_name"`
+	GitEmail         *string    `json:"git_email"`
+	GitSigningKey    *string    `json:"git_signing_key"`
+	GitSigningFormat *string    `json:"git_signing_format"`
+	GitSignCommits   *int64     `json:"git_sign_commits"`
 }
 
 type RegisteredClient struct {
-	ClientID          string    `json:"client_id"`
-	ClientName        string    `json:"client_name"`
-	MachineInfo       *string   `json:"machine_info"`
-	EncryptionKeyHash []byte    `json:"encryption_key_hash"`
-	EncryptionSalt    []byte    `json:"encryption_salt"`
-	KeyHint           *string   `json:"key_hint"`
-	Status            *string   `json:"status"`
-	SyncCount         *int64    `json:"sync_count"`
-	LastIp            *string   `json:"last_ip"`
-	RegisteredAt      time.Time `json:"registered_at"`
-	LastSeenAt        time.Time `json:"last_seen_at"`
+	ClientID          string     `json:"client_id"`
+	ClientName        string     `json:"client_name"`
+	MachineInfo       *string    `json:"machine_info"`
+	EncryptionKeyHash []byte     `json:"encryption_key_hash"`
+	EncryptionSalt    []byte     `json:"encryption_salt"`
+	KeyHint           *string    `json:"key_hint"`
+	Status            *string    `json:"status"`
+	SyncCount         *int64     `json:"sync_count"`
+	LastIp            *string    `json:"last_ip"`
+	RegisteredAt      time.Time  `json:"registered_at"`
+	LastSeenAt        *time.Time `json:"last_seen_at"`
+	Fingerprint       *string    `json:"fingerprint"`
+}
+
+type RepoRemote struct {
+	RepoUrl string `json:"repo_url"`
+	Name    string `json:"name"`
+	Url     string `json:"url"`
+}
+
+type RepoSnapshot struct {
+	ID        string    `json:"id"`
+	RepoUrl   string    `json:"repo_url"`
+	Path      string    `json:"path"`
+	Message   *string   `json:"message"`
+	Branch    *string   `json:"branch"`
+	Head      string    `json:"head"`
+	Stash     *string   `json:"stash"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Repository struct {
-	ID          int64     `json:"id"`
-	Uid         string    `json:"uid"`
-	Url         string    `json:"url"`
-	Path        string    `json:"path"`
-	Workspace   *string   `json:"workspace"`
-	Favorite    *int64    `json:"favorite"`
-	ClonedAt    time.Time `json:"cloned_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	LastChecked time.Time `json:"last_checked"`
+	ID           int64      `json:"id"`
+	Uid          string     `json:"uid"`
+	Url          string     `json:"url"`
+	Path         string     `json:"path"`
+	Workspace    *string    `json:"workspace"`
+	Favorite     *int64     `json:"favorite"`
+	ClonedAt     time.Time  `json:"cloned_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	LastChecked  time.Time  `json:"last_checked"`
+	Kind         *string    `json:"kind"`
+	UpstreamUrl  *string    `json:"upstream_url"`
+	License      *string    `json:"license"`
+	Tags         *string    `json:"tags"`
+	Notes        *string    `json:"notes"`
+	Alias        *string    `json:"alias"`
+	AccessCount  int64      `json:"access_count"`
+	LastAccessed *time.Time `json:"last_accessed"`
+}
+
+type SavedFilter struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	Description   *string   `json:"description"`
+	Workspace     *string   `json:"workspace"`
+	FavoritesOnly *int64    `json:"favorites_only"`
+	Query         *string   `json:"query"`
+	Dirty         *int64    `json:"dirty"`
+	Language      *string   `json:"language"`
+	Sort          *string   `json:"sort"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Kind          *string   `json:"kind"`
 }
 
 type SchemaMigration struct {
@@ -156,6 +216,7 @@ type StandaloneConfig struct {
 	Capabilities *string   `json:"capabilities"`
 	CreatedAt    time.Time `json:"created_at"`
 	ExpiresAt    time.Time `json:"expires_at"`
+	AutoApprove  *string   `json:"auto_approve"`
 }
 
 type StandaloneConnection struct {
@@ -173,6 +234,16 @@ type StandaloneConnection struct {
 	LastSync              time.Time `json:"last_sync"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
+	SyncFilter            *string   `json:"sync_filter"`
+	AckedRevisions        *string   `json:"acked_revisions"`
+	ClientID              *string   `json:"client_id"`
+}
+
+type SyncChangelog struct {
+	Bucket    string    `json:"bucket"`
+	Revision  int64     `json:"revision"`
+	Records   *string   `json:"records"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type SyncedDatum struct {
@@ -190,11 +261,86 @@ type SyncedDatum struct {
 }
 
 type Workspace struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Description *string   `json:"description"`
-	Path        *string   `json:"path"`
-	IsActive    *int64    `json:"is_active"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	Description     *string   `json:"description"`
+	Path            *string   `json:"path"`
+	IsActive        *int64    `json:"is_active"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	UrlPatterns     *string   `json:"url_patterns"`
+	DiskBudgetBytes *int64    `json:"disk_budget_bytes"`
+}
+
+type WizardDraft struct {
+	Name      string    `json:"name"`
+	Data      []byte    `json:"data"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ApiToken struct {
+	Name       string     `json:"name"`
+	Hash       string     `json:"hash"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type VaultSecret struct {
+	Key       string    `json:"key"`
+	Value     []byte    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type GmailWatch struct {
+	Name            string     `json:"name"`
+	Profile         string     `json:"profile"`
+	Query           string     `json:"query"`
+	IntervalSeconds int64      `json:"interval_seconds"`
+	Notify          int64      `json:"notify"`
+	Exec            string     `json:"exec"`
+	HistoryID       string     `json:"history_id"`
+	LastCheckedAt   *time.Time `json:"last_checked_at"`
+	LastMatchAt     *time.Time `json:"last_match_at"`
+	LastError       string     `json:"last_error"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+type GithubRepoID struct {
+	FullName string    `json:"full_name"`
+	RepoID   int64     `json:"repo_id"`
+	CachedAt time.Time `json:"cached_at"`
+}
+
+type DependencyInventory struct {
+	ID           int64     `json:"id"`
+	RepoUrl      string    `json:"repo_url"`
+	Dependencies string    `json:"dependencies"`
+	ScannedAt    time.Time `json:"scanned_at"`
+}
+
+type ShareLink struct {
+	ID        string    `json:"id"`
+	RepoUrl   string    `json:"repo_url"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type Job struct {
+	ID              string     `json:"id"`
+	Kind            string     `json:"kind"`
+	Description     string     `json:"description"`
+	State           string     `json:"state"`
+	Total           int64      `json:"total"`
+	Done            int64      `json:"done"`
+	Failed          int64      `json:"failed"`
+	Log             string     `json:"log"`
+	Error           string     `json:"error"`
+	CancelRequested int64      `json:"cancel_requested"`
+	StartedAt       time.Time  `json:"started_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	FinishedAt      *time.Time `json:"finished_at"`
 }
@@ -7,6 +7,7 @@ package sqlc
 
 import (
 	"context"
+	"time"
 )
 
 const clearActiveProfile = `-- name: ClearActiveProfile :exec
@@ -28,7 +29,7 @@ func (q *Queries) DeleteProfile(ctx context.Context, name string) error {
 }
 
 const getActiveProfile = `-- name: GetActiveProfile :one
-SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at FROM profiles WHERE is_default = 1 LIMIT 1
+SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at, token_expires_at, git_name, git_email, git_signing_key, git_signing_format, git_sign_commits FROM profiles WHERE is_default = 1 LIMIT 1
 `
 
 func (q *Queries) GetActiveProfile(ctx context.Context) (Profile, error) {
@@ -47,12 +48,18 @@ func (q *Queries) GetActiveProfile(ctx context.Context) (Profile, error) {
 		&i.NotifyChannels,
 		&i.CreatedAt,
 		&i.LastUsedAt,
+		&i.TokenExpiresAt,
+		&i.GitName,
+		&i.GitEmail,
+		&i.GitSigningKey,
+		&i.GitSigningFormat,
+		&i.GitSignCommits,
 	)
 	return i, err
 }
 
 const getProfile = `-- name: GetProfile :one
-SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at FROM profiles WHERE name = ? LIMIT 1
+SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at, token_expires_at, git_name, git_email, git_signing_key, git_signing_format, git_sign_commits FROM profiles WHERE name = ? LIMIT 1
 `
 
 func (q *Queries) GetProfile(ctx context.Context, name string) (Profile, error) {
@@ -71,6 +78,12 @@ func (q *Queries) GetProfile(ctx context.Context, name string) (Profile, error)
 		&i.NotifyChannels,
 		&i.CreatedAt,
 		&i.LastUsedAt,
+		&i.TokenExpiresAt,
+		&i.GitName,
+		&i.GitEmail,
+		&i.GitSigningKey,
+		&i.GitSigningFormat,
+		&i.GitSignCommits,
 	)
 	return i, err
 }
@@ -78,21 +91,28 @@ func (q *Queries) GetProfile(ctx context.Context, name string) (Profile, error)
 const insertProfile = `-- name: InsertProfile :one
 INSERT INTO profiles (
     name, host, username, token_storage, scopes, is_default,
-    encrypted_token, workspace, notify_channels, created_at, last_used_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
-RETURNING id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at
+    encrypted_token, workspace, notify_channels, token_expires_at, git_name, git_email,
+    git_signing_key, git_signing_format, git_sign_commits, created_at, last_used_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+RETURNING id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at, token_expires_at, git_name, git_email, git_signing_key, git_signing_format, git_sign_commits
 `
 
 type InsertProfileParams struct {
-	Name           string  `json:"name"`
-	Host           *string `json:"host"`
-	Username       *string `json:"username"`
-	TokenStorage   *string `json:"token_storage"`
-	Scopes         *string `json:"scopes"`
-	IsDefault      *int64  `json:"is_default"`
-	EncryptedToken []byte  `json:"encrypted_token"`
-	Workspace      *string `json:"workspace"`
-	NotifyChannels *string `json:"notify_channels"`
+	Name             string     `json:"name"`
+	Host             *string    `json:"host"`
+	Username         *string    `json:"username"`
+	TokenStorage     *string    `json:"token_storage"`
+	Scopes           *string    `json:"scopes"`
+	IsDefault        *int64     `json:"is_default"`
+	EncryptedToken   []byte     `json:"encrypted_token"`
+	Workspace        *string    `json:"workspace"`
+	NotifyChannels   *string    `json:"notify_channels"`
+	TokenExpiresAt   *time.Time `json:"token_expires_at"`
+	GitName          *string    `json:"git_name"`
+	GitEmail         *string    `json:"git_email"`
+	GitSigningKey    *string    `json:"git_signing_key"`
+	GitSigningFormat *string    `json:"git_signing_format"`
+	GitSignCommits   *int64     `json:"git_sign_commits"`
 }
 
 func (q *Queries) InsertProfile(ctx context.Context, arg InsertProfileParams) (Profile, error) {
@@ -106,6 +126,12 @@ func (q *Queries) InsertProfile(ctx context.Context, arg InsertProfileParams) (P
 		arg.EncryptedToken,
 		arg.Workspace,
 		arg.NotifyChannels,
+		arg.TokenExpiresAt,
+		arg.GitName,
+		arg.GitEmail,
+		arg.GitSigningKey,
+		arg.GitSigningFormat,
+		arg.GitSignCommits,
 	)
 	var i Profile
 	err := row.Scan(
@@ -121,12 +147,18 @@ func (q *Queries) InsertProfile(ctx context.Context, arg InsertProfileParams) (P
 		&i.NotifyChannels,
 		&i.CreatedAt,
 		&i.LastUsedAt,
+		&i.TokenExpiresAt,
+		&i.GitName,
+		&i.GitEmail,
+		&i.GitSigningKey,
+		&i.GitSigningFormat,
+		&i.GitSignCommits,
 	)
 	return i, err
 }
 
 const listProfiles = `-- name: ListProfiles :many
-SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at FROM profiles ORDER BY name ASC
+SELECT id, name, host, username, token_storage, scopes, is_default, encrypted_token, workspace, notify_channels, created_at, last_used_at, token_expires_at, git_name, git_email, git_signing_key, git_signing_format, git_sign_commits FROM profiles ORDER BY name ASC
 `
 
 func (q *Queries) ListProfiles(ctx context.Context) ([]Profile, error) {
@@ -151,6 +183,12 @@ func (q *Queries) ListProfiles(ctx context.Context) ([]Profile, error) {
 			&i.NotifyChannels,
 			&i.CreatedAt,
 			&i.LastUsedAt,
+			&i.TokenExpiresAt,
+			&i.GitName,
+			&i.GitEmail,
+			&i.GitSigningKey,
+			&i.GitSigningFormat,
+			&i.GitSignCommits,
 		); err != nil {
 			return nil, err
 		}
@@ -193,19 +231,31 @@ UPDATE profiles SET
     scopes = ?,
     encrypted_token = ?,
     workspace = ?,
-    notify_channels = ?
+    notify_channels = ?,
+    token_expires_at = ?,
+    git_name = ?,
+    git_email = ?,
+    git_signing_key = ?,
+    git_signing_format = ?,
+    git_sign_commits = ?
 WHERE name = ?
 `
 
 type UpdateProfileParams struct {
-	Host           *string `json:"host"`
-	Username       *string `json:"username"`
-	TokenStorage   *string `json:"token_storage"`
-	Scopes         *string `json:"scopes"`
-	EncryptedToken []byte  `json:"encrypted_token"`
-	Workspace      *string `json:"workspace"`
-	NotifyChannels *string `json:"notify_channels"`
-	Name           string  `json:"name"`
+	Host             *string    `json:"host"`
+	Username         *string    `json:"username"`
+	TokenStorage     *string    `json:"token_storage"`
+	Scopes           *string    `json:"scopes"`
+	EncryptedToken   []byte     `json:"encrypted_token"`
+	Workspace        *string    `json:"workspace"`
+	NotifyChannels   *string    `json:"notify_channels"`
+	TokenExpiresAt   *time.Time `json:"token_expires_at"`
+	GitName          *string    `json:"git_name"`
+	GitEmail         *string    `json:"git_email"`
+	GitSigningKey    *string    `json:"git_signing_key"`
+	GitSigningFormat *string    `json:"git_signing_format"`
+	GitSignCommits   *int64     `json:"git_sign_commits"`
+	Name             string     `json:"name"`
 }
 
 func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) error {
@@ -217,6 +267,12 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) er
 		arg.EncryptedToken,
 		arg.Workspace,
 		arg.NotifyChannels,
+		arg.TokenExpiresAt,
+		arg.GitName,
+		arg.GitEmail,
+		arg.GitSigningKey,
+		arg.GitSigningFormat,
+		arg.GitSignCommits,
 		arg.Name,
 	)
 	return err
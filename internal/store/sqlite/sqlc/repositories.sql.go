@@ -28,7 +28,7 @@ func (q *Queries) DeleteRepoByURL(ctx context.Context, url string) error {
 }
 
 const getAllRepos = `-- name: GetAllRepos :many
-SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked FROM repositories ORDER BY updated_at DESC
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories ORDER BY updated_at DESC
 `
 
 func (q *Queries) GetAllRepos(ctx context.Context) ([]Repository, error) {
@@ -50,6 +50,14 @@ func (q *Queries) GetAllRepos(ctx context.Context) ([]Repository, error) {
 			&i.ClonedAt,
 			&i.UpdatedAt,
 			&i.LastChecked,
+			&i.Kind,
+			&i.UpstreamUrl,
+			&i.License,
+			&i.Tags,
+			&i.Notes,
+			&i.Alias,
+			&i.AccessCount,
+			&i.LastAccessed,
 		); err != nil {
 			return nil, err
 		}
@@ -65,7 +73,7 @@ func (q *Queries) GetAllRepos(ctx context.Context) ([]Repository, error) {
 }
 
 const getRepoByPath = `-- name: GetRepoByPath :one
-SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked FROM repositories WHERE path = ? LIMIT 1
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories WHERE path = ? LIMIT 1
 `
 
 func (q *Queries) GetRepoByPath(ctx context.Context, path string) (Repository, error) {
@@ -81,12 +89,20 @@ func (q *Queries) GetRepoByPath(ctx context.Context, path string) (Repository, e
 		&i.ClonedAt,
 		&i.UpdatedAt,
 		&i.LastChecked,
+		&i.Kind,
+		&i.UpstreamUrl,
+		&i.License,
+		&i.Tags,
+		&i.Notes,
+		&i.Alias,
+		&i.AccessCount,
+		&i.LastAccessed,
 	)
 	return i, err
 }
 
 const getRepoByURL = `-- name: GetRepoByURL :one
-SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked FROM repositories WHERE url = ? LIMIT 1
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories WHERE url = ? LIMIT 1
 `
 
 func (q *Queries) GetRepoByURL(ctx context.Context, url string) (Repository, error) {
@@ -102,12 +118,20 @@ func (q *Queries) GetRepoByURL(ctx context.Context, url string) (Repository, err
 		&i.ClonedAt,
 		&i.UpdatedAt,
 		&i.LastChecked,
+		&i.Kind,
+		&i.UpstreamUrl,
+		&i.License,
+		&i.Tags,
+		&i.Notes,
+		&i.Alias,
+		&i.AccessCount,
+		&i.LastAccessed,
 	)
 	return i, err
 }
 
 const getReposByWorkspace = `-- name: GetReposByWorkspace :many
-SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked FROM repositories WHERE workspace = ? ORDER BY updated_at DESC
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories WHERE workspace = ? ORDER BY updated_at DESC
 `
 
 func (q *Queries) GetReposByWorkspace(ctx context.Context, workspace *string) ([]Repository, error) {
@@ -129,6 +153,14 @@ func (q *Queries) GetReposByWorkspace(ctx context.Context, workspace *string) ([
 			&i.ClonedAt,
 			&i.UpdatedAt,
 			&i.LastChecked,
+			&i.Kind,
+			&i.UpstreamUrl,
+			&i.License,
+			&i.Tags,
+			&i.Notes,
+			&i.Alias,
+			&i.AccessCount,
+			&i.LastAccessed,
 		); err != nil {
 			return nil, err
 		}
@@ -144,7 +176,7 @@ func (q *Queries) GetReposByWorkspace(ctx context.Context, workspace *string) ([
 }
 
 const getReposByWorkspaceAndFavorites = `-- name: GetReposByWorkspaceAndFavorites :many
-SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked FROM repositories
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories
 WHERE (workspace = ? OR ? = '')
   AND (favorite = 1 OR ? = 0)
 ORDER BY updated_at DESC
@@ -175,6 +207,14 @@ func (q *Queries) GetReposByWorkspaceAndFavorites(ctx context.Context, arg GetRe
 			&i.ClonedAt,
 			&i.UpdatedAt,
 			&i.LastChecked,
+			&i.Kind,
+			&i.UpstreamUrl,
+			&i.License,
+			&i.Tags,
+			&i.Notes,
+			&i.Alias,
+			&i.AccessCount,
+			&i.LastAccessed,
 		); err != nil {
 			return nil, err
 		}
@@ -189,10 +229,116 @@ func (q *Queries) GetReposByWorkspaceAndFavorites(ctx context.Context, arg GetRe
 	return items, nil
 }
 
+const listReposPage = `-- name: ListReposPage :many
+SELECT id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed FROM repositories
+WHERE (workspace = ? OR ? = '')
+  AND (favorite = 1 OR ? = 0)
+  AND (url LIKE ? ESCAPE '\' OR path LIKE ? ESCAPE '\')
+  AND (kind = ? OR ? = '')
+ORDER BY updated_at DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListReposPageParams struct {
+	Workspace *string     `json:"workspace"`
+	Column2   interface{} `json:"column_2"`
+	Column3   interface{} `json:"column_3"`
+	Url       string      `json:"url"`
+	Path      string      `json:"path"`
+	Kind      *string     `json:"kind"`
+	Column7   interface{} `json:"column_7"`
+	Limit     int64       `json:"limit"`
+	Offset    int64       `json:"offset"`
+}
+
+func (q *Queries) ListReposPage(ctx context.Context, arg ListReposPageParams) ([]Repository, error) {
+	rows, err := q.db.QueryContext(ctx, listReposPage,
+		arg.Workspace,
+		arg.Column2,
+		arg.Column3,
+		arg.Url,
+		arg.Path,
+		arg.Kind,
+		arg.Column7,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Repository{}
+	for rows.Next() {
+		var i Repository
+		if err := rows.Scan(
+			&i.ID,
+			&i.Uid,
+			&i.Url,
+			&i.Path,
+			&i.Workspace,
+			&i.Favorite,
+			&i.ClonedAt,
+			&i.UpdatedAt,
+			&i.LastChecked,
+			&i.Kind,
+			&i.UpstreamUrl,
+			&i.License,
+			&i.Tags,
+			&i.Notes,
+			&i.Alias,
+			&i.AccessCount,
+			&i.LastAccessed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countReposPage = `-- name: CountReposPage :one
+SELECT COUNT(*) FROM repositories
+WHERE (workspace = ? OR ? = '')
+  AND (favorite = 1 OR ? = 0)
+  AND (url LIKE ? ESCAPE '\' OR path LIKE ? ESCAPE '\')
+  AND (kind = ? OR ? = '')
+`
+
+type CountReposPageParams struct {
+	Workspace *string     `json:"workspace"`
+	Column2   interface{} `json:"column_2"`
+	Column3   interface{} `json:"column_3"`
+	Url       string      `json:"url"`
+	Path      string      `json:"path"`
+	Kind      *string     `json:"kind"`
+	Column7   interface{} `json:"column_7"`
+}
+
+func (q *Queries) CountReposPage(ctx context.Context, arg CountReposPageParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countReposPage,
+		arg.Workspace,
+		arg.Column2,
+		arg.Column3,
+		arg.Url,
+		arg.Path,
+		arg.Kind,
+		arg.Column7,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const insertRepo = `-- name: InsertRepo :one
-INSERT INTO repositories (uid, url, path, workspace, favorite, cloned_at, updated_at)
-VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked
+INSERT INTO repositories (uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked)
+VALUES (?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+RETURNING id, uid, url, path, workspace, favorite, cloned_at, updated_at, last_checked, kind, upstream_url, license, tags, notes, alias, access_count, last_accessed
 `
 
 type InsertRepoParams struct {
@@ -222,6 +368,14 @@ func (q *Queries) InsertRepo(ctx context.Context, arg InsertRepoParams) (Reposit
 		&i.ClonedAt,
 		&i.UpdatedAt,
 		&i.LastChecked,
+		&i.Kind,
+		&i.UpstreamUrl,
+		&i.License,
+		&i.Tags,
+		&i.Notes,
+		&i.Alias,
+		&i.AccessCount,
+		&i.LastAccessed,
 	)
 	return i, err
 }
@@ -262,6 +416,99 @@ func (q *Queries) UpdateRepoFavorite(ctx context.Context, arg UpdateRepoFavorite
 	return err
 }
 
+const updateRepoKind = `-- name: UpdateRepoKind :exec
+UPDATE repositories SET kind = ? WHERE url = ?
+`
+
+type UpdateRepoKindParams struct {
+	Kind *string `json:"kind"`
+	Url  string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoKind(ctx context.Context, arg UpdateRepoKindParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoKind, arg.Kind, arg.Url)
+	return err
+}
+
+const updateRepoUpstream = `-- name: UpdateRepoUpstream :exec
+UPDATE repositories SET upstream_url = ? WHERE url = ?
+`
+
+type UpdateRepoUpstreamParams struct {
+	UpstreamUrl *string `json:"upstream_url"`
+	Url         string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoUpstream(ctx context.Context, arg UpdateRepoUpstreamParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoUpstream, arg.UpstreamUrl, arg.Url)
+	return err
+}
+
+const updateRepoLicense = `-- name: UpdateRepoLicense :exec
+UPDATE repositories SET license = ? WHERE url = ?
+`
+
+type UpdateRepoLicenseParams struct {
+	License *string `json:"license"`
+	Url     string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoLicense(ctx context.Context, arg UpdateRepoLicenseParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoLicense, arg.License, arg.Url)
+	return err
+}
+
+const updateRepoTags = `-- name: UpdateRepoTags :exec
+UPDATE repositories SET tags = ? WHERE url = ?
+`
+
+type UpdateRepoTagsParams struct {
+	Tags *string `json:"tags"`
+	Url  string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoTags(ctx context.Context, arg UpdateRepoTagsParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoTags, arg.Tags, arg.Url)
+	return err
+}
+
+const updateRepoNotes = `-- name: UpdateRepoNotes :exec
+UPDATE repositories SET notes = ? WHERE url = ?
+`
+
+type UpdateRepoNotesParams struct {
+	Notes *string `json:"notes"`
+	Url   string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoNotes(ctx context.Context, arg UpdateRepoNotesParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoNotes, arg.Notes, arg.Url)
+	return err
+}
+
+const updateRepoAlias = `-- name: UpdateRepoAlias :exec
+UPDATE repositories SET alias = ? WHERE url = ?
+`
+
+type UpdateRepoAliasParams struct {
+	Alias *string `json:"alias"`
+	Url   string  `json:"url"`
+}
+
+func (q *Queries) UpdateRepoAlias(ctx context.Context, arg UpdateRepoAliasParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoAlias, arg.Alias, arg.Url)
+	return err
+}
+
+const updateRepoAccess = `-- name: UpdateRepoAccess :exec
+UPDATE repositories SET access_count = access_count + 1, last_accessed = CURRENT_TIMESTAMP WHERE url = ?
+`
+
+func (q *Queries) UpdateRepoAccess(ctx context.Context, url string) error {
+	_, err := q.db.ExecContext(ctx, updateRepoAccess, url)
+	return err
+}
+
 const updateRepoLastChecked = `-- name: UpdateRepoLastChecked :exec
 UPDATE repositories SET last_checked = CURRENT_TIMESTAMP WHERE url = ?
 `
@@ -271,6 +518,34 @@ func (q *Queries) UpdateRepoLastChecked(ctx context.Context, url string) error {
 	return err
 }
 
+const updateRepoPath = `-- name: UpdateRepoPath :exec
+UPDATE repositories SET path = ?, updated_at = CURRENT_TIMESTAMP WHERE url = ?
+`
+
+type UpdateRepoPathParams struct {
+	Path string `json:"path"`
+	Url  string `json:"url"`
+}
+
+func (q *Queries) UpdateRepoPath(ctx context.Context, arg UpdateRepoPathParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoPath, arg.Path, arg.Url)
+	return err
+}
+
+const updateRepoRemotesRepoURL = `-- name: UpdateRepoRemotesRepoURL :exec
+UPDATE repo_remotes SET repo_url = ? WHERE repo_url = ?
+`
+
+type UpdateRepoRemotesRepoURLParams struct {
+	RepoUrl   string `json:"repo_url"`
+	RepoUrl_2 string `json:"repo_url_2"`
+}
+
+func (q *Queries) UpdateRepoRemotesRepoURL(ctx context.Context, arg UpdateRepoRemotesRepoURLParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoRemotesRepoURL, arg.RepoUrl, arg.RepoUrl_2)
+	return err
+}
+
 const updateRepoTimestamp = `-- name: UpdateRepoTimestamp :exec
 UPDATE repositories SET updated_at = CURRENT_TIMESTAMP WHERE url = ?
 `
@@ -280,6 +555,20 @@ func (q *Queries) UpdateRepoTimestamp(ctx context.Context, url string) error {
 	return err
 }
 
+const updateRepoURL = `-- name: UpdateRepoURL :exec
+UPDATE repositories SET url = ?, updated_at = CURRENT_TIMESTAMP WHERE url = ?
+`
+
+type UpdateRepoURLParams struct {
+	Url   string `json:"url"`
+	Url_2 string `json:"url_2"`
+}
+
+func (q *Queries) UpdateRepoURL(ctx context.Context, arg UpdateRepoURLParams) error {
+	_, err := q.db.ExecContext(ctx, updateRepoURL, arg.Url, arg.Url_2)
+	return err
+}
+
 const updateRepoWorkspace = `-- name: UpdateRepoWorkspace :exec
 UPDATE repositories SET workspace = ?, updated_at = CURRENT_TIMESTAMP WHERE url = ?
 `
@@ -84,7 +84,7 @@ func (q *Queries) GetStandaloneClient(ctx context.Context, id string) (Standalon
 }
 
 const getStandaloneConfig = `-- name: GetStandaloneConfig :one
-SELECT id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at FROM standalone_config WHERE id = 1
+SELECT id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at, auto_approve FROM standalone_config WHERE id = 1
 `
 
 // Standalone Config
@@ -103,12 +103,13 @@ func (q *Queries) GetStandaloneConfig(ctx context.Context) (StandaloneConfig, er
 		&i.Capabilities,
 		&i.CreatedAt,
 		&i.ExpiresAt,
+		&i.AutoApprove,
 	)
 	return i, err
 }
 
 const getStandaloneConnection = `-- name: GetStandaloneConnection :one
-SELECT id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at FROM standalone_connections WHERE name = ? LIMIT 1
+SELECT id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at, sync_filter, acked_revisions, client_id FROM standalone_connections WHERE name = ? LIMIT 1
 `
 
 // Standalone Connections (client mode)
@@ -130,6 +131,26 @@ func (q *Queries) GetStandaloneConnection(ctx context.Context, name string) (Sta
 		&i.LastSync,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SyncFilter,
+		&i.AckedRevisions,
+		&i.ClientID,
+	)
+	return i, err
+}
+
+const getSyncChangelog = `-- name: GetSyncChangelog :one
+SELECT bucket, revision, records, updated_at FROM sync_changelog WHERE bucket = ?
+`
+
+// Sync Change Log (server mode)
+func (q *Queries) GetSyncChangelog(ctx context.Context, bucket string) (SyncChangelog, error) {
+	row := q.db.QueryRowContext(ctx, getSyncChangelog, bucket)
+	var i SyncChangelog
+	err := row.Scan(
+		&i.Bucket,
+		&i.Revision,
+		&i.Records,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -157,9 +178,9 @@ func (q *Queries) InsertStandaloneClient(ctx context.Context, arg InsertStandalo
 const insertStandaloneConnection = `-- name: InsertStandaloneConnection :one
 INSERT INTO standalone_connections (
     name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted,
-    local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at
+    local_password_hash, local_salt, sync_status, synced_items, last_sync, sync_filter, acked_revisions, client_id, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+RETURNING id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at, sync_filter, acked_revisions, client_id
 `
 
 type InsertStandaloneConnectionParams struct {
@@ -174,6 +195,9 @@ type InsertStandaloneConnectionParams struct {
 	SyncStatus            *string   `json:"sync_status"`
 	SyncedItems           *string   `json:"synced_items"`
 	LastSync              time.Time `json:"last_sync"`
+	SyncFilter            *string   `json:"sync_filter"`
+	AckedRevisions        *string   `json:"acked_revisions"`
+	ClientID              *string   `json:"client_id"`
 }
 
 func (q *Queries) InsertStandaloneConnection(ctx context.Context, arg InsertStandaloneConnectionParams) (StandaloneConnection, error) {
@@ -189,6 +213,9 @@ func (q *Queries) InsertStandaloneConnection(ctx context.Context, arg InsertStan
 		arg.SyncStatus,
 		arg.SyncedItems,
 		arg.LastSync,
+		arg.SyncFilter,
+		arg.AckedRevisions,
+		arg.ClientID,
 	)
 	var i StandaloneConnection
 	err := row.Scan(
@@ -206,6 +233,9 @@ func (q *Queries) InsertStandaloneConnection(ctx context.Context, arg InsertStan
 		&i.LastSync,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SyncFilter,
+		&i.AckedRevisions,
+		&i.ClientID,
 	)
 	return i, err
 }
@@ -244,7 +274,7 @@ func (q *Queries) ListStandaloneClients(ctx context.Context) ([]StandaloneClient
 }
 
 const listStandaloneConnections = `-- name: ListStandaloneConnections :many
-SELECT id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at FROM standalone_connections ORDER BY name ASC
+SELECT id, name, instance_id, host, port, api_key_encrypted, refresh_token_encrypted, local_password_hash, local_salt, sync_status, synced_items, last_sync, created_at, updated_at, sync_filter, acked_revisions, client_id FROM standalone_connections ORDER BY name ASC
 `
 
 func (q *Queries) ListStandaloneConnections(ctx context.Context) ([]StandaloneConnection, error) {
@@ -271,6 +301,9 @@ func (q *Queries) ListStandaloneConnections(ctx context.Context) ([]StandaloneCo
 			&i.LastSync,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SyncFilter,
+			&i.AckedRevisions,
+			&i.ClientID,
 		); err != nil {
 			return nil, err
 		}
@@ -306,6 +339,9 @@ UPDATE standalone_connections SET
     sync_status = ?,
     synced_items = ?,
     last_sync = ?,
+    sync_filter = ?,
+    acked_revisions = ?,
+    client_id = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE name = ?
 `
@@ -321,6 +357,9 @@ type UpdateStandaloneConnectionParams struct {
 	SyncStatus            *string   `json:"sync_status"`
 	SyncedItems           *string   `json:"synced_items"`
 	LastSync              time.Time `json:"last_sync"`
+	SyncFilter            *string   `json:"sync_filter"`
+	AckedRevisions        *string   `json:"acked_revisions"`
+	ClientID              *string   `json:"client_id"`
 	Name                  string    `json:"name"`
 }
 
@@ -336,6 +375,9 @@ func (q *Queries) UpdateStandaloneConnection(ctx context.Context, arg UpdateStan
 		arg.SyncStatus,
 		arg.SyncedItems,
 		arg.LastSync,
+		arg.SyncFilter,
+		arg.AckedRevisions,
+		arg.ClientID,
 		arg.Name,
 	)
 	return err
@@ -388,8 +430,8 @@ func (q *Queries) UpsertServerEncryptionConfig(ctx context.Context, arg UpsertSe
 }
 
 const upsertStandaloneConfig = `-- name: UpsertStandaloneConfig :exec
-INSERT INTO standalone_config (id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at)
-VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, ?)
+INSERT INTO standalone_config (id, enabled, is_server, instance_id, port, api_key_hash, refresh_token, salt, capabilities, created_at, expires_at, auto_approve)
+VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
     enabled = excluded.enabled,
     is_server = excluded.is_server,
@@ -399,7 +441,8 @@ ON CONFLICT(id) DO UPDATE SET
     refresh_token = excluded.refresh_token,
     salt = excluded.salt,
     capabilities = excluded.capabilities,
-    expires_at = excluded.expires_at
+    expires_at = excluded.expires_at,
+    auto_approve = excluded.auto_approve
 `
 
 type UpsertStandaloneConfigParams struct {
@@ -412,6 +455,7 @@ type UpsertStandaloneConfigParams struct {
 	Salt         []byte    `json:"salt"`
 	Capabilities *string   `json:"capabilities"`
 	ExpiresAt    time.Time `json:"expires_at"`
+	AutoApprove  *string   `json:"auto_approve"`
 }
 
 func (q *Queries) UpsertStandaloneConfig(ctx context.Context, arg UpsertStandaloneConfigParams) error {
@@ -425,6 +469,27 @@ func (q *Queries) UpsertStandaloneConfig(ctx context.Context, arg UpsertStandalo
 		arg.Salt,
 		arg.Capabilities,
 		arg.ExpiresAt,
+		arg.AutoApprove,
 	)
 	return err
 }
+
+const upsertSyncChangelog = `-- name: UpsertSyncChangelog :exec
+INSERT INTO sync_changelog (bucket, revision, records, updated_at)
+VALUES (?, ?, ?, CURRENT_TIMESTAMP)
+ON CONFLICT(bucket) DO UPDATE SET
+    revision = excluded.revision,
+    records = excluded.records,
+    updated_at = excluded.updated_at
+`
+
+type UpsertSyncChangelogParams struct {
+	Bucket   string  `json:"bucket"`
+	Revision int64   `json:"revision"`
+	Records  *string `json:"records"`
+}
+
+func (q *Queries) UpsertSyncChangelog(ctx context.Context, arg UpsertSyncChangelogParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSyncChangelog, arg.Bucket, arg.Revision, arg.Records)
+	return err
+}
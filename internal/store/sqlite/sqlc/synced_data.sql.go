@@ -54,7 +54,7 @@ func (q *Queries) DeleteSyncedDataByConnection(ctx context.Context, connectionNa
 }
 
 const getPendingRegistration = `-- name: GetPendingRegistration :one
-SELECT client_id, client_name, machine_info, state, challenge_token, challenge_at, initiated_at, completed_at FROM pending_registrations WHERE client_id = ? LIMIT 1
+SELECT client_id, client_name, machine_info, state, challenge_token, challenge_at, initiated_at, completed_at, fingerprint FROM pending_registrations WHERE client_id = ? LIMIT 1
 `
 
 // Pending Registrations (server side)
@@ -70,12 +70,13 @@ func (q *Queries) GetPendingRegistration(ctx context.Context, clientID string) (
 		&i.ChallengeAt,
 		&i.InitiatedAt,
 		&i.CompletedAt,
+		&i.Fingerprint,
 	)
 	return i, err
 }
 
 const getRegisteredClient = `-- name: GetRegisteredClient :one
-SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at FROM registered_clients WHERE client_id = ? LIMIT 1
+SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at, fingerprint FROM registered_clients WHERE client_id = ? LIMIT 1
 `
 
 // Registered Clients (server side)
@@ -94,6 +95,7 @@ func (q *Queries) GetRegisteredClient(ctx context.Context, clientID string) (Reg
 		&i.LastIp,
 		&i.RegisteredAt,
 		&i.LastSeenAt,
+		&i.Fingerprint,
 	)
 	return i, err
 }
@@ -133,14 +135,15 @@ func (q *Queries) GetSyncedData(ctx context.Context, arg GetSyncedDataParams) (S
 const insertPendingRegistration = `-- name: InsertPendingRegistration :exec
 INSERT INTO pending_registrations (
     client_id, client_name, machine_info, state,
-    challenge_token, challenge_at, initiated_at, completed_at
-) VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+    challenge_token, challenge_at, initiated_at, completed_at, fingerprint
+) VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL, ?)
 ON CONFLICT(client_id) DO UPDATE SET
     client_name = excluded.client_name,
     machine_info = excluded.machine_info,
     state = excluded.state,
     challenge_token = excluded.challenge_token,
-    challenge_at = excluded.challenge_at
+    challenge_at = excluded.challenge_at,
+    fingerprint = excluded.fingerprint
 `
 
 type InsertPendingRegistrationParams struct {
@@ -150,6 +153,7 @@ type InsertPendingRegistrationParams struct {
 	State          *string   `json:"state"`
 	ChallengeToken *string   `json:"challenge_token"`
 	ChallengeAt    time.Time `json:"challenge_at"`
+	Fingerprint    *string   `json:"fingerprint"`
 }
 
 func (q *Queries) InsertPendingRegistration(ctx context.Context, arg InsertPendingRegistrationParams) error {
@@ -160,6 +164,7 @@ func (q *Queries) InsertPendingRegistration(ctx context.Context, arg InsertPendi
 		arg.State,
 		arg.ChallengeToken,
 		arg.ChallengeAt,
+		arg.Fingerprint,
 	)
 	return err
 }
@@ -167,15 +172,16 @@ func (q *Queries) InsertPendingRegistration(ctx context.Context, arg InsertPendi
 const insertRegisteredClient = `-- name: InsertRegisteredClient :exec
 INSERT INTO registered_clients (
     client_id, client_name, machine_info, encryption_key_hash, encryption_salt,
-    key_hint, status, sync_count, last_ip, registered_at, last_seen_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL)
+    key_hint, status, sync_count, last_ip, registered_at, last_seen_at, fingerprint
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, NULL, ?)
 ON CONFLICT(client_id) DO UPDATE SET
     client_name = excluded.client_name,
     machine_info = excluded.machine_info,
     encryption_key_hash = excluded.encryption_key_hash,
     encryption_salt = excluded.encryption_salt,
     key_hint = excluded.key_hint,
-    status = excluded.status
+    status = excluded.status,
+    fingerprint = excluded.fingerprint
 `
 
 type InsertRegisteredClientParams struct {
@@ -188,6 +194,7 @@ type InsertRegisteredClientParams struct {
 	Status            *string `json:"status"`
 	SyncCount         *int64  `json:"sync_count"`
 	LastIp            *string `json:"last_ip"`
+	Fingerprint       *string `json:"fingerprint"`
 }
 
 func (q *Queries) InsertRegisteredClient(ctx context.Context, arg InsertRegisteredClientParams) error {
@@ -201,6 +208,7 @@ func (q *Queries) InsertRegisteredClient(ctx context.Context, arg InsertRegister
 		arg.Status,
 		arg.SyncCount,
 		arg.LastIp,
+		arg.Fingerprint,
 	)
 	return err
 }
@@ -255,7 +263,7 @@ func (q *Queries) InsertSyncedData(ctx context.Context, arg InsertSyncedDataPara
 }
 
 const listPendingRegistrations = `-- name: ListPendingRegistrations :many
-SELECT client_id, client_name, machine_info, state, challenge_token, challenge_at, initiated_at, completed_at FROM pending_registrations ORDER BY initiated_at DESC
+SELECT client_id, client_name, machine_info, state, challenge_token, challenge_at, initiated_at, completed_at, fingerprint FROM pending_registrations ORDER BY initiated_at DESC
 `
 
 func (q *Queries) ListPendingRegistrations(ctx context.Context) ([]PendingRegistration, error) {
@@ -276,6 +284,7 @@ func (q *Queries) ListPendingRegistrations(ctx context.Context) ([]PendingRegist
 			&i.ChallengeAt,
 			&i.InitiatedAt,
 			&i.CompletedAt,
+			&i.Fingerprint,
 		); err != nil {
 			return nil, err
 		}
@@ -291,7 +300,7 @@ func (q *Queries) ListPendingRegistrations(ctx context.Context) ([]PendingRegist
 }
 
 const listRegisteredClients = `-- name: ListRegisteredClients :many
-SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at FROM registered_clients ORDER BY registered_at DESC
+SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at, fingerprint FROM registered_clients ORDER BY registered_at DESC
 `
 
 func (q *Queries) ListRegisteredClients(ctx context.Context) ([]RegisteredClient, error) {
@@ -315,6 +324,7 @@ func (q *Queries) ListRegisteredClients(ctx context.Context) ([]RegisteredClient
 			&i.LastIp,
 			&i.RegisteredAt,
 			&i.LastSeenAt,
+			&i.Fingerprint,
 		); err != nil {
 			return nil, err
 		}
@@ -330,7 +340,7 @@ func (q *Queries) ListRegisteredClients(ctx context.Context) ([]RegisteredClient
 }
 
 const listRegisteredClientsByStatus = `-- name: ListRegisteredClientsByStatus :many
-SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at FROM registered_clients WHERE status = ? ORDER BY registered_at DESC
+SELECT client_id, client_name, machine_info, encryption_key_hash, encryption_salt, key_hint, status, sync_count, last_ip, registered_at, last_seen_at, fingerprint FROM registered_clients WHERE status = ? ORDER BY registered_at DESC
 `
 
 func (q *Queries) ListRegisteredClientsByStatus(ctx context.Context, status *string) ([]RegisteredClient, error) {
@@ -354,6 +364,7 @@ func (q *Queries) ListRegisteredClientsByStatus(ctx context.Context, status *str
 			&i.LastIp,
 			&i.RegisteredAt,
 			&i.LastSeenAt,
+			&i.Fingerprint,
 		); err != nil {
 			return nil, err
 		}
@@ -28,7 +28,7 @@ func (q *Queries) DeleteWorkspace(ctx context.Context, name string) error {
 }
 
 const getActiveWorkspace = `-- name: GetActiveWorkspace :one
-SELECT id, name, description, path, is_active, created_at, updated_at FROM workspaces WHERE is_active = 1 LIMIT 1
+SELECT id, name, description, path, is_active, created_at, updated_at, url_patterns, disk_budget_bytes FROM workspaces WHERE is_active = 1 LIMIT 1
 `
 
 func (q *Queries) GetActiveWorkspace(ctx context.Context) (Workspace, error) {
@@ -42,12 +42,14 @@ func (q *Queries) GetActiveWorkspace(ctx context.Context) (Workspace, error) {
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UrlPatterns,
+		&i.DiskBudgetBytes,
 	)
 	return i, err
 }
 
 const getWorkspace = `-- name: GetWorkspace :one
-SELECT id, name, description, path, is_active, created_at, updated_at FROM workspaces WHERE name = ? LIMIT 1
+SELECT id, name, description, path, is_active, created_at, updated_at, url_patterns, disk_budget_bytes FROM workspaces WHERE name = ? LIMIT 1
 `
 
 func (q *Queries) GetWorkspace(ctx context.Context, name string) (Workspace, error) {
@@ -61,21 +63,25 @@ func (q *Queries) GetWorkspace(ctx context.Context, name string) (Workspace, err
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UrlPatterns,
+		&i.DiskBudgetBytes,
 	)
 	return i, err
 }
 
 const insertWorkspace = `-- name: InsertWorkspace :one
-INSERT INTO workspaces (name, description, path, is_active, created_at, updated_at)
-VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING id, name, description, path, is_active, created_at, updated_at
+INSERT INTO workspaces (name, description, path, is_active, url_patterns, disk_budget_bytes, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+RETURNING id, name, description, path, is_active, created_at, updated_at, url_patterns, disk_budget_bytes
 `
 
 type InsertWorkspaceParams struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
-	Path        *string `json:"path"`
-	IsActive    *int64  `json:"is_active"`
+	Name            string  `json:"name"`
+	Description     *string `json:"description"`
+	Path            *string `json:"path"`
+	IsActive        *int64  `json:"is_active"`
+	UrlPatterns     *string `json:"url_patterns"`
+	DiskBudgetBytes *int64  `json:"disk_budget_bytes"`
 }
 
 func (q *Queries) InsertWorkspace(ctx context.Context, arg InsertWorkspaceParams) (Workspace, error) {
@@ -84,6 +90,8 @@ func (q *Queries) InsertWorkspace(ctx context.Context, arg InsertWorkspaceParams
 		arg.Description,
 		arg.Path,
 		arg.IsActive,
+		arg.UrlPatterns,
+		arg.DiskBudgetBytes,
 	)
 	var i Workspace
 	err := row.Scan(
@@ -94,12 +102,14 @@ func (q *Queries) InsertWorkspace(ctx context.Context, arg InsertWorkspaceParams
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.UrlPatterns,
+		&i.DiskBudgetBytes,
 	)
 	return i, err
 }
 
 const listWorkspaces = `-- name: ListWorkspaces :many
-SELECT id, name, description, path, is_active, created_at, updated_at FROM workspaces ORDER BY name ASC
+SELECT id, name, description, path, is_active, created_at, updated_at, url_patterns, disk_budget_bytes FROM workspaces ORDER BY name ASC
 `
 
 func (q *Queries) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
@@ -119,6 +129,8 @@ func (q *Queries) ListWorkspaces(ctx context.Context) ([]Workspace, error) {
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.UrlPatterns,
+			&i.DiskBudgetBytes,
 		); err != nil {
 			return nil, err
 		}
@@ -146,18 +158,28 @@ const updateWorkspace = `-- name: UpdateWorkspace :exec
 UPDATE workspaces SET
     description = ?,
     path = ?,
+    url_patterns = ?,
+    disk_budget_bytes = ?,
     updated_at = CURRENT_TIMESTAMP
 WHERE name = ?
 `
 
 type UpdateWorkspaceParams struct {
-	Description *string `json:"description"`
-	Path        *string `json:"path"`
-	Name        string  `json:"name"`
+	Description     *string `json:"description"`
+	Path            *string `json:"path"`
+	UrlPatterns     *string `json:"url_patterns"`
+	DiskBudgetBytes *int64  `json:"disk_budget_bytes"`
+	Name            string  `json:"name"`
 }
 
 func (q *Queries) UpdateWorkspace(ctx context.Context, arg UpdateWorkspaceParams) error {
-	_, err := q.db.ExecContext(ctx, updateWorkspace, arg.Description, arg.Path, arg.Name)
+	_, err := q.db.ExecContext(ctx, updateWorkspace,
+		arg.Description,
+		arg.Path,
+		arg.UrlPatterns,
+		arg.DiskBudgetBytes,
+		arg.Name,
+	)
 	return err
 }
 
@@ -2,16 +2,19 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/inovacc/clonr/internal/git"
 	"github.com/inovacc/clonr/internal/model"
 	"github.com/inovacc/clonr/internal/store/sqlite/sqlc"
 	_ "modernc.org/sqlite" // Pure Go SQLite driver
@@ -33,6 +36,7 @@ func ptrInt64(i int64) *int64 {
 // Store implements the store.Store interface using SQLite.
 type Store struct {
 	db      *sql.DB
+	stmts   *stmtCache
 	queries *sqlc.Queries
 	mu      sync.RWMutex
 }
@@ -43,16 +47,39 @@ var (
 	initErr  error
 )
 
+// Options tunes the SQLite connection.
+type Options struct {
+	// BusyTimeout is how long a query waits for a lock held by another
+	// process before failing with SQLITE_BUSY.
+	BusyTimeout time.Duration
+
+	// CacheStatements prepares each query once and reuses the statement.
+	CacheStatements bool
+}
+
+// DefaultOptions returns the options used by New.
+func DefaultOptions() Options {
+	return Options{
+		BusyTimeout:     5 * time.Second,
+		CacheStatements: true,
+	}
+}
+
 // New creates a new SQLite store with the given database path.
 func New(dbPath string) (*Store, error) {
+	return NewWithOptions(dbPath, DefaultOptions())
+}
+
+// NewWithOptions creates a new SQLite store with the given database path and
+// connection options.
+func NewWithOptions(dbPath string, opts Options) (*Store, error) {
 	// Ensure directory exists
 	dir := filepath.Dir(dbPath)
 	if err := os.MkdirAll(dir, 0700); err != nil {
 		return nil, fmt.Errorf("creating database directory: %w", err)
 	}
 
-	// Open database with WAL mode for better concurrency
-	db, err := sql.Open("sqlite", dbPath+"?_journal_mode=WAL&_busy_timeout=5000&_foreign_keys=ON")
+	db, err := sql.Open("sqlite", dsn(dbPath, opts))
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
 	}
@@ -69,10 +96,31 @@ func New(dbPath string) (*Store, error) {
 		return nil, fmt.Errorf("running migrations: %w", err)
 	}
 
-	return &Store{
-		db:      db,
-		queries: sqlc.New(db),
-	}, nil
+	s := &Store{db: db, queries: sqlc.New(db)}
+
+	if opts.CacheStatements {
+		s.stmts = newStmtCache(db)
+		s.queries = sqlc.New(s.stmts)
+	}
+
+	return s, nil
+}
+
+// dsn builds the connection string. The pragmas are applied to every new
+// connection: WAL lets readers proceed while a write is in progress,
+// synchronous=NORMAL is durable under WAL, and immediate transactions take
+// the write lock up front so busy_timeout applies instead of failing on a
+// lock upgrade.
+func dsn(dbPath string, opts Options) string {
+	params := []string{
+		"_pragma=journal_mode(WAL)",
+		"_pragma=synchronous(NORMAL)",
+		"_pragma=foreign_keys(1)",
+		fmt.Sprintf("_pragma=busy_timeout(%d)", opts.BusyTimeout.Milliseconds()),
+		"_txlock=immediate",
+	}
+
+	return dbPath + "?" + strings.Join(params, "&")
 }
 
 // GetDB returns the singleton SQLite store instance.
@@ -101,9 +149,23 @@ func getDefaultDBPath() string {
 
 // Close closes the database connection.
 func (s *Store) Close() error {
+	if s.stmts != nil {
+		s.stmts.Close()
+	}
+
 	return s.db.Close()
 }
 
+// JournalMode returns the journal mode in effect, "wal" when WAL is enabled.
+func (s *Store) JournalMode() (string, error) {
+	var mode string
+	if err := s.db.QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		return "", err
+	}
+
+	return mode, nil
+}
+
 // Ping checks if the database is accessible.
 func (s *Store) Ping() error {
 	return s.db.Ping()
@@ -124,7 +186,7 @@ func (s *Store) SaveRepoWithWorkspace(u *url.URL, path, workspace string) error
 	ctx := newContext()
 	_, err := s.queries.InsertRepo(ctx, sqlc.InsertRepoParams{
 		Uid:       uuid.New().String(),
-		Url:       u.String(),
+		Url:       git.CanonicalURL(u.String()),
 		Path:      path,
 		Workspace: ptrString(workspace),
 		Favorite:  ptrInt64(0),
@@ -139,7 +201,7 @@ func (s *Store) RepoExistsByURL(u *url.URL) (bool, error) {
 
 	ctx := newContext()
 
-	result, err := s.queries.RepoExistsByURL(ctx, u.String())
+	result, err := s.queries.RepoExistsByURL(ctx, git.CanonicalURL(u.String()))
 	if err != nil {
 		return false, err
 	}
@@ -185,12 +247,7 @@ func (s *Store) GetAllRepos() ([]*model.Repository, error) {
 		return nil, err
 	}
 
-	repos := make([]*model.Repository, 0, len(rows))
-	for _, row := range rows {
-		repos = append(repos, sqlcRepoToModel(row))
-	}
-
-	return repos, nil
+	return s.reposWithRemotes(ctx, rows)
 }
 
 func (s *Store) GetRepos(workspace string, favoritesOnly bool) ([]*model.Repository, error) {
@@ -213,14 +270,87 @@ func (s *Store) GetRepos(workspace string, favoritesOnly bool) ([]*model.Reposit
 		return nil, err
 	}
 
+	return s.reposWithRemotes(ctx, rows)
+}
+
+// ListRepos returns one page of repositories matching filter, newest first,
+// along with the total number of matches.
+func (s *Store) ListRepos(filter model.RepoFilter, offset, limit int) ([]*model.Repository, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	favInt := int64(0)
+	if filter.FavoritesOnly {
+		favInt = 1
+	}
+
+	pattern := "%" + escapeLike(filter.Query) + "%"
+
+	total, err := s.queries.CountReposPage(ctx, sqlc.CountReposPageParams{
+		Workspace: ptrString(filter.Workspace),
+		Column2:   filter.Workspace,
+		Column3:   favInt,
+		Url:       pattern,
+		Path:      pattern,
+		Kind:      ptrString(string(filter.Kind)),
+		Column7:   string(filter.Kind),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := s.queries.ListReposPage(ctx, sqlc.ListReposPageParams{
+		Workspace: ptrString(filter.Workspace),
+		Column2:   filter.Workspace,
+		Column3:   favInt,
+		Url:       pattern,
+		Path:      pattern,
+		Kind:      ptrString(string(filter.Kind)),
+		Column7:   string(filter.Kind),
+		Limit:     int64(limit),
+		Offset:    int64(offset),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	repos, err := s.reposWithRemotes(ctx, rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return repos, int(total), nil
+}
+
+// reposWithRemotes converts repository rows, attaching their remotes
+func (s *Store) reposWithRemotes(ctx context.Context, rows []sqlc.Repository) ([]*model.Repository, error) {
+	remotes, err := s.queries.ListRepoRemotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byRepo := make(map[string][]model.RepoRemote)
+	for _, r := range remotes {
+		byRepo[r.RepoUrl] = append(byRepo[r.RepoUrl], model.RepoRemote{Name: r.Name, URL: r.Url})
+	}
+
 	repos := make([]*model.Repository, 0, len(rows))
 	for _, row := range rows {
-		repos = append(repos, sqlcRepoToModel(row))
+		repo := sqlcRepoToModel(row)
+		repo.Remotes = byRepo[repo.URL]
+		repos = append(repos, repo)
 	}
 
 	return repos, nil
 }
 
+// escapeLike escapes LIKE wildcards so s matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (s *Store) GetReposByWorkspace(workspace string) ([]*model.Repository, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -232,12 +362,7 @@ func (s *Store) GetReposByWorkspace(workspace string) ([]*model.Repository, erro
 		return nil, err
 	}
 
-	repos := make([]*model.Repository, 0, len(rows))
-	for _, row := range rows {
-		repos = append(repos, sqlcRepoToModel(row))
-	}
-
-	return repos, nil
+	return s.reposWithRemotes(ctx, rows)
 }
 
 func (s *Store) SetFavoriteByURL(urlStr string, fav bool) error {
@@ -253,393 +378,1380 @@ func (s *Store) SetFavoriteByURL(urlStr string, fav bool) error {
 
 	return s.queries.UpdateRepoFavorite(ctx, sqlc.UpdateRepoFavoriteParams{
 		Favorite: ptrInt64(favInt),
-		Url:      urlStr,
+		Url:      git.CanonicalURL(urlStr),
 	})
 }
 
-func (s *Store) UpdateRepoTimestamp(urlStr string) error {
+// SetRepoKind records the classification of a repository
+func (s *Store) SetRepoKind(urlStr string, kind model.RepoKind) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	ctx := newContext()
-
-	return s.queries.UpdateRepoTimestamp(ctx, urlStr)
+	return s.queries.UpdateRepoKind(newContext(), sqlc.UpdateRepoKindParams{
+		Kind: ptrString(string(kind)),
+		Url:  git.CanonicalURL(urlStr),
+	})
 }
 
-func (s *Store) UpdateRepoWorkspace(urlStr, workspace string) error {
+// SetRepoUpstream records the repository a fork was created from
+func (s *Store) SetRepoUpstream(urlStr, upstreamURL string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	ctx := newContext()
-
-	return s.queries.UpdateRepoWorkspace(ctx, sqlc.UpdateRepoWorkspaceParams{
-		Workspace: ptrString(workspace),
-		Url:       urlStr,
+	return s.queries.UpdateRepoUpstream(newContext(), sqlc.UpdateRepoUpstreamParams{
+		UpstreamUrl: ptrString(upstreamURL),
+		Url:         git.CanonicalURL(urlStr),
 	})
 }
 
-func (s *Store) RemoveRepoByURL(u *url.URL) error {
+// SetRepoLicense records the detected license of a repository
+func (s *Store) SetRepoLicense(urlStr, license string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	ctx := newContext()
-
-	return s.queries.DeleteRepoByURL(ctx, u.String())
+	return s.queries.UpdateRepoLicense(newContext(), sqlc.UpdateRepoLicenseParams{
+		License: ptrString(license),
+		Url:     git.CanonicalURL(urlStr),
+	})
 }
 
-// ============================================================================
-// Configuration Operations
-// ============================================================================
-
-func (s *Store) GetConfig() (*model.Config, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	ctx := newContext()
+// SetRepoTags replaces the tags of a repository
+func (s *Store) SetRepoTags(urlStr string, tags []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	row, err := s.queries.GetConfig(ctx)
-	if err != nil {
-		return nil, err
-	}
+	var encoded *string
 
-	var customEditors []model.Editor
-	if row.CustomEditors != nil && *row.CustomEditors != "" {
-		if err := json.Unmarshal([]byte(*row.CustomEditors), &customEditors); err != nil {
-			customEditors = nil
+	if len(tags) > 0 {
+		data, err := json.Marshal(tags)
+		if err != nil {
+			return err
 		}
+
+		encoded = ptrString(string(data))
 	}
 
-	return &model.Config{
-		DefaultCloneDir: derefString(row.DefaultCloneDir),
-		Editor:          derefString(row.Editor),
-		Terminal:        derefString(row.Terminal),
-		MonitorInterval: int(derefInt64(row.MonitorInterval)),
-		ServerPort:      int(derefInt64(row.ServerPort)),
-		CustomEditors:   customEditors,
-	}, nil
+	return s.queries.UpdateRepoTags(newContext(), sqlc.UpdateRepoTagsParams{
+		Tags: encoded,
+		Url:  git.CanonicalURL(urlStr),
+	})
 }
 
-func (s *Store) SaveConfig(cfg *model.Config) error {
+// SetRepoNotes replaces the notes of a repository
+func (s *Store) SetRepoNotes(urlStr, notes string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	ctx := newContext()
-
-	customEditorsJSON, err := json.Marshal(cfg.CustomEditors)
-	if err != nil {
-		customEditorsJSON = []byte("[]")
-	}
+	return s.queries.UpdateRepoNotes(newContext(), sqlc.UpdateRepoNotesParams{
+		Notes: ptrString(notes),
+		Url:   git.CanonicalURL(urlStr),
+	})
+}
 
-	customEditorsStr := string(customEditorsJSON)
+// SetRepoAlias sets the alias of a repository; an empty alias removes it.
+// The unique index on aliases refuses one already in use.
+func (s *Store) SetRepoAlias(urlStr, alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	return s.queries.UpdateConfig(ctx, sqlc.UpdateConfigParams{
-		DefaultCloneDir: ptrString(cfg.DefaultCloneDir),
-		Editor:          ptrString(cfg.Editor),
-		Terminal:        ptrString(cfg.Terminal),
-		MonitorInterval: ptrInt64(int64(cfg.MonitorInterval)),
-		ServerPort:      ptrInt64(int64(cfg.ServerPort)),
-		CustomEditors:   &customEditorsStr,
+	return s.queries.UpdateRepoAlias(newContext(), sqlc.UpdateRepoAliasParams{
+		Alias: ptrString(alias),
+		Url:   git.CanonicalURL(urlStr),
 	})
 }
 
-// ============================================================================
-// Profile Operations
-// ============================================================================
+// RecordRepoAccess counts an access to a repository and records its time
+func (s *Store) RecordRepoAccess(urlStr string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-func (s *Store) SaveProfile(profile *model.Profile) error {
+	return s.queries.UpdateRepoAccess(newContext(), git.CanonicalURL(urlStr))
+}
+
+// SetRepoRemotes replaces the remotes recorded for a repository
+func (s *Store) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
+	urlStr = git.CanonicalURL(urlStr)
 
-	scopesJSON, _ := json.Marshal(profile.Scopes)
-	notifyJSON, _ := json.Marshal(profile.NotifyChannels)
-	scopesStr := string(scopesJSON)
-	notifyStr := string(notifyJSON)
-	tokenStorageStr := string(profile.TokenStorage)
-
-	exists, _ := s.queries.ProfileExists(ctx, profile.Name)
-	if exists == 1 {
-		return s.queries.UpdateProfile(ctx, sqlc.UpdateProfileParams{
-			Host:           ptrString(profile.Host),
-			Username:       ptrString(profile.User),
-			TokenStorage:   ptrString(tokenStorageStr),
-			Scopes:         &scopesStr,
-			EncryptedToken: profile.EncryptedToken,
-			Workspace:      ptrString(profile.Workspace),
-			NotifyChannels: &notifyStr,
-			Name:           profile.Name,
-		})
+	if err := s.queries.DeleteRepoRemotes(ctx, urlStr); err != nil {
+		return err
 	}
 
-	isDefault := int64(0)
-	if profile.Default {
-		isDefault = 1
+	for _, r := range remotes {
+		if err := s.queries.InsertRepoRemote(ctx, sqlc.InsertRepoRemoteParams{
+			RepoUrl: urlStr,
+			Name:    r.Name,
+			Url:     r.URL,
+		}); err != nil {
+			return err
+		}
 	}
 
-	_, err := s.queries.InsertProfile(ctx, sqlc.InsertProfileParams{
-		Name:           profile.Name,
-		Host:           ptrString(profile.Host),
-		Username:       ptrString(profile.User),
-		TokenStorage:   ptrString(tokenStorageStr),
-		Scopes:         &scopesStr,
-		IsDefault:      ptrInt64(isDefault),
-		EncryptedToken: profile.EncryptedToken,
-		Workspace:      ptrString(profile.Workspace),
-		NotifyChannels: &notifyStr,
-	})
-
-	return err
+	return nil
 }
 
-func (s *Store) GetProfile(name string) (*model.Profile, error) {
+// GetRepoByRemoteURL returns the repository having a remote with the given
+// URL, or nil when there is none
+func (s *Store) GetRepoByRemoteURL(urlStr string) (*model.Repository, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	row, err := s.queries.GetProfile(ctx, name)
+	repoURL, err := s.queries.GetRepoURLByRemoteURL(ctx, urlStr)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("profile %q not found", name)
+			return nil, nil
 		}
 
 		return nil, err
 	}
 
-	return sqlcProfileToModel(row), nil
-}
-
-func (s *Store) GetActiveProfile() (*model.Profile, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	ctx := newContext()
-
-	row, err := s.queries.GetActiveProfile(ctx)
+	row, err := s.queries.GetRepoByURL(ctx, repoURL)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("no active profile")
+			return nil, nil
 		}
 
 		return nil, err
 	}
 
-	return sqlcProfileToModel(row), nil
+	repos, err := s.reposWithRemotes(ctx, []sqlc.Repository{row})
+	if err != nil {
+		return nil, err
+	}
+
+	return repos[0], nil
 }
 
-func (s *Store) SetActiveProfile(name string) error {
+func (s *Store) UpdateRepoTimestamp(urlStr string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
 
-	return s.queries.SetActiveProfile(ctx, name)
+	return s.queries.UpdateRepoTimestamp(ctx, git.CanonicalURL(urlStr))
 }
 
-func (s *Store) ListProfiles() ([]*model.Profile, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+func (s *Store) UpdateRepoWorkspace(urlStr, workspace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	ctx := newContext()
 
-	rows, err := s.queries.ListProfiles(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	profiles := make([]*model.Profile, 0, len(rows))
-	for _, row := range rows {
-		profiles = append(profiles, sqlcProfileToModel(row))
-	}
-
-	return profiles, nil
+	return s.queries.UpdateRepoWorkspace(ctx, sqlc.UpdateRepoWorkspaceParams{
+		Workspace: ptrString(workspace),
+		Url:       git.CanonicalURL(urlStr),
+	})
 }
 
-func (s *Store) DeleteProfile(name string) error {
+func (s *Store) UpdateRepoPath(urlStr, path string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
 
-	return s.queries.DeleteProfile(ctx, name)
+	return s.queries.UpdateRepoPath(ctx, sqlc.UpdateRepoPathParams{
+		Path: path,
+		Url:  git.CanonicalURL(urlStr),
+	})
 }
 
-func (s *Store) ProfileExists(name string) (bool, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// UpdateRepoURL changes the URL of a tracked repository, keeping its path,
+// workspace, favorite flag and remotes
+func (s *Store) UpdateRepoURL(oldURL, newURL string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	ctx := newContext()
+	oldURL, newURL = git.CanonicalURL(oldURL), git.CanonicalURL(newURL)
 
-	result, err := s.queries.ProfileExists(ctx, name)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	return result == 1, nil
-}
+	defer func() { _ = tx.Rollback() }()
 
-// ============================================================================
-// Workspace Operations
-// ============================================================================
+	q := s.queries.WithTx(tx)
 
-func (s *Store) SaveWorkspace(workspace *model.Workspace) error {
+	if err := q.UpdateRepoURL(ctx, sqlc.UpdateRepoURLParams{Url: newURL, Url_2: oldURL}); err != nil {
+		return err
+	}
+
+	if err := q.UpdateRepoRemotesRepoURL(ctx, sqlc.UpdateRepoRemotesRepoURLParams{RepoUrl: newURL, RepoUrl_2: oldURL}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Store) RemoveRepoByURL(u *url.URL) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
+	urlStr := git.CanonicalURL(u.String())
 
-	exists, _ := s.queries.WorkspaceExists(ctx, workspace.Name)
-	if exists == 1 {
-		return s.queries.UpdateWorkspace(ctx, sqlc.UpdateWorkspaceParams{
-			Description: ptrString(workspace.Description),
-			Path:        ptrString(workspace.Path),
-			Name:        workspace.Name,
-		})
-	}
-
-	isActive := int64(0)
-	if workspace.Active {
-		isActive = 1
+	if err := s.queries.DeleteRepoRemotes(ctx, urlStr); err != nil {
+		return err
 	}
 
-	_, err := s.queries.InsertWorkspace(ctx, sqlc.InsertWorkspaceParams{
-		Name:        workspace.Name,
-		Description: ptrString(workspace.Description),
-		Path:        ptrString(workspace.Path),
-		IsActive:    ptrInt64(isActive),
-	})
-
-	return err
+	return s.queries.DeleteRepoByURL(ctx, urlStr)
 }
 
-func (s *Store) GetWorkspace(name string) (*model.Workspace, error) {
+// ============================================================================
+// Configuration Operations
+// ============================================================================
+
+func (s *Store) GetConfig() (*model.Config, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	row, err := s.queries.GetWorkspace(ctx, name)
+	row, err := s.queries.GetConfig(ctx)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("workspace %q not found", name)
-		}
+		return nil, err
+	}
+
+	var customEditors []model.Editor
+	if row.CustomEditors != nil && *row.CustomEditors != "" {
+		if err := json.Unmarshal([]byte(*row.CustomEditors), &customEditors); err != nil {
+			customEditors = nil
+		}
+	}
+
+	var listColumns []string
+	if row.ListColumns != nil && *row.ListColumns != "" {
+		if err := json.Unmarshal([]byte(*row.ListColumns), &listColumns); err != nil {
+			listColumns = nil
+		}
+	}
+
+	var urlRewrites []model.URLRewrite
+	if row.UrlRewrites != nil && *row.UrlRewrites != "" {
+		if err := json.Unmarshal([]byte(*row.UrlRewrites), &urlRewrites); err != nil {
+			urlRewrites = nil
+		}
+	}
+
+	var backup model.BackupConfig
+	if row.Backup != nil && *row.Backup != "" {
+		if err := json.Unmarshal([]byte(*row.Backup), &backup); err != nil {
+			backup = model.BackupConfig{}
+		}
+	}
+
+	var webhooks []string
+	if row.Webhooks != nil && *row.Webhooks != "" {
+		if err := json.Unmarshal([]byte(*row.Webhooks), &webhooks); err != nil {
+			webhooks = nil
+		}
+	}
+
+	var notifyRoutes []model.NotifyRoute
+	if row.NotifyRoutes != nil && *row.NotifyRoutes != "" {
+		if err := json.Unmarshal([]byte(*row.NotifyRoutes), &notifyRoutes); err != nil {
+			notifyRoutes = nil
+		}
+	}
+
+	var concurrency model.ConcurrencyConfig
+	if row.Concurrency != nil && *row.Concurrency != "" {
+		if err := json.Unmarshal([]byte(*row.Concurrency), &concurrency); err != nil {
+			concurrency = model.ConcurrencyConfig{}
+		}
+	}
+
+	var serverTLS model.ServerTLSConfig
+	if row.ServerTls != nil && *row.ServerTls != "" {
+		if err := json.Unmarshal([]byte(*row.ServerTls), &serverTLS); err != nil {
+			serverTLS = model.ServerTLSConfig{}
+		}
+	}
+
+	var keyMap model.KeyMapConfig
+	if row.Keymap != nil && *row.Keymap != "" {
+		if err := json.Unmarshal([]byte(*row.Keymap), &keyMap); err != nil {
+			keyMap = model.KeyMapConfig{}
+		}
+	}
+
+	var ignore model.IgnoreConfig
+	if row.IgnoreRules != nil && *row.IgnoreRules != "" {
+		if err := json.Unmarshal([]byte(*row.IgnoreRules), &ignore); err != nil {
+			ignore = model.IgnoreConfig{}
+		}
+	}
+
+	var pinnedRepos []string
+	if row.PinnedRepos != nil && *row.PinnedRepos != "" {
+		if err := json.Unmarshal([]byte(*row.PinnedRepos), &pinnedRepos); err != nil {
+			pinnedRepos = nil
+		}
+	}
+
+	return &model.Config{
+		DefaultCloneDir: derefString(row.DefaultCloneDir),
+		Editor:          derefString(row.Editor),
+		Terminal:        derefString(row.Terminal),
+		MonitorInterval: int(derefInt64(row.MonitorInterval)),
+		ServerPort:      int(derefInt64(row.ServerPort)),
+		CustomEditors:   customEditors,
+		ListColumns:     listColumns,
+		ListSort:        derefString(row.ListSort),
+		URLRewrites:     urlRewrites,
+		Backup:          backup,
+		Webhooks:        webhooks,
+		NotifyRoutes:    notifyRoutes,
+		Concurrency:     concurrency,
+		ServerTLS:       serverTLS,
+		KeyMap:          keyMap,
+		Ignore:          ignore,
+		PinnedRepos:     pinnedRepos,
+	}, nil
+}
+
+func (s *Store) SaveConfig(cfg *model.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	customEditorsJSON, err := json.Marshal(cfg.CustomEditors)
+	if err != nil {
+		customEditorsJSON = []byte("[]")
+	}
+
+	customEditorsStr := string(customEditorsJSON)
+
+	var listColumns *string
+
+	if len(cfg.ListColumns) > 0 {
+		data, err := json.Marshal(cfg.ListColumns)
+		if err != nil {
+			return err
+		}
+
+		listColumns = ptrString(string(data))
+	}
+
+	var urlRewrites *string
+
+	if len(cfg.URLRewrites) > 0 {
+		data, err := json.Marshal(cfg.URLRewrites)
+		if err != nil {
+			return err
+		}
+
+		urlRewrites = ptrString(string(data))
+	}
+
+	var backup *string
+
+	if !cfg.Backup.IsZero() {
+		data, err := json.Marshal(cfg.Backup)
+		if err != nil {
+			return err
+		}
+
+		backup = ptrString(string(data))
+	}
+
+	var webhooks *string
+
+	if len(cfg.Webhooks) > 0 {
+		data, err := json.Marshal(cfg.Webhooks)
+		if err != nil {
+			return err
+		}
+
+		webhooks = ptrString(string(data))
+	}
+
+	var notifyRoutes *string
+
+	if len(cfg.NotifyRoutes) > 0 {
+		data, err := json.Marshal(cfg.NotifyRoutes)
+		if err != nil {
+			return err
+		}
+
+		notifyRoutes = ptrString(string(data))
+	}
+
+	var concurrency *string
+
+	if !cfg.Concurrency.IsZero() {
+		data, err := json.Marshal(cfg.Concurrency)
+		if err != nil {
+			return err
+		}
+
+		concurrency = ptrString(string(data))
+	}
+
+	var serverTLS *string
+
+	if !cfg.ServerTLS.IsZero() {
+		data, err := json.Marshal(cfg.ServerTLS)
+		if err != nil {
+			return err
+		}
+
+		serverTLS = ptrString(string(data))
+	}
+
+	var keyMap *string
+
+	if !cfg.KeyMap.IsZero() {
+		data, err := json.Marshal(cfg.KeyMap)
+		if err != nil {
+			return err
+		}
+
+		keyMap = ptrString(string(data))
+	}
+
+	var ignore *string
+
+	if !cfg.Ignore.IsZero() {
+		data, err := json.Marshal(cfg.Ignore)
+		if err != nil {
+			return err
+		}
+
+		ignore = ptrString(string(data))
+	}
+
+	var pinnedRepos *string
+
+	if len(cfg.PinnedRepos) > 0 {
+		data, err := json.Marshal(cfg.PinnedRepos)
+		if err != nil {
+			return err
+		}
+
+		pinnedRepos = ptrString(string(data))
+	}
+
+	return s.queries.UpdateConfig(ctx, sqlc.UpdateConfigParams{
+		DefaultCloneDir: ptrString(cfg.DefaultCloneDir),
+		Editor:          ptrString(cfg.Editor),
+		Terminal:        ptrString(cfg.Terminal),
+		MonitorInterval: ptrInt64(int64(cfg.MonitorInterval)),
+		ServerPort:      ptrInt64(int64(cfg.ServerPort)),
+		CustomEditors:   &customEditorsStr,
+		ListColumns:     listColumns,
+		ListSort:        ptrString(cfg.ListSort),
+		UrlRewrites:     urlRewrites,
+		Backup:          backup,
+		Webhooks:        webhooks,
+		NotifyRoutes:    notifyRoutes,
+		Concurrency:     concurrency,
+		ServerTls:       serverTLS,
+		Keymap:          keyMap,
+		IgnoreRules:     ignore,
+		PinnedRepos:     pinnedRepos,
+	})
+}
+
+// ============================================================================
+// Profile Operations
+// ============================================================================
+
+func (s *Store) SaveProfile(profile *model.Profile) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	scopesJSON, _ := json.Marshal(profile.Scopes)
+	notifyJSON, _ := json.Marshal(profile.NotifyChannels)
+	scopesStr := string(scopesJSON)
+	notifyStr := string(notifyJSON)
+	tokenStorageStr := string(profile.TokenStorage)
+
+	var tokenExpiresAt *time.Time
+	if !profile.TokenExpiresAt.IsZero() {
+		tokenExpiresAt = &profile.TokenExpiresAt
+	}
+
+	signCommits := int64(0)
+	if profile.GitSignCommits {
+		signCommits = 1
+	}
+
+	exists, _ := s.queries.ProfileExists(ctx, profile.Name)
+	if exists == 1 {
+		return s.queries.UpdateProfile(ctx, sqlc.UpdateProfileParams{
+			Host:             ptrString(profile.Host),
+			Username:         ptrString(profile.User),
+			TokenStorage:     ptrString(tokenStorageStr),
+			Scopes:           &scopesStr,
+			EncryptedToken:   profile.EncryptedToken,
+			Workspace:        ptrString(profile.Workspace),
+			NotifyChannels:   &notifyStr,
+			TokenExpiresAt:   tokenExpiresAt,
+			GitName:          ptrString(profile.GitName),
+			GitEmail:         ptrString(profile.GitEmail),
+			GitSigningKey:    ptrString(profile.GitSigningKey),
+			GitSigningFormat: ptrString(profile.GitSigningFormat),
+			GitSignCommits:   ptrInt64(signCommits),
+			Name:             profile.Name,
+		})
+	}
+
+	isDefault := int64(0)
+	if profile.Default {
+		isDefault = 1
+	}
+
+	_, err := s.queries.InsertProfile(ctx, sqlc.InsertProfileParams{
+		Name:             profile.Name,
+		Host:             ptrString(profile.Host),
+		Username:         ptrString(profile.User),
+		TokenStorage:     ptrString(tokenStorageStr),
+		Scopes:           &scopesStr,
+		IsDefault:        ptrInt64(isDefault),
+		EncryptedToken:   profile.EncryptedToken,
+		Workspace:        ptrString(profile.Workspace),
+		NotifyChannels:   &notifyStr,
+		TokenExpiresAt:   tokenExpiresAt,
+		GitName:          ptrString(profile.GitName),
+		GitEmail:         ptrString(profile.GitEmail),
+		GitSigningKey:    ptrString(profile.GitSigningKey),
+		GitSigningFormat: ptrString(profile.GitSigningFormat),
+		GitSignCommits:   ptrInt64(signCommits),
+	})
+
+	return err
+}
+
+func (s *Store) GetProfile(name string) (*model.Profile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetProfile(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("profile %q not found", name)
+		}
+
+		return nil, err
+	}
+
+	return sqlcProfileToModel(row), nil
+}
+
+func (s *Store) GetActiveProfile() (*model.Profile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetActiveProfile(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no active profile")
+		}
+
+		return nil, err
+	}
+
+	return sqlcProfileToModel(row), nil
+}
+
+func (s *Store) SetActiveProfile(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.SetActiveProfile(ctx, name)
+}
+
+func (s *Store) ListProfiles() ([]*model.Profile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*model.Profile, 0, len(rows))
+	for _, row := range rows {
+		profiles = append(profiles, sqlcProfileToModel(row))
+	}
+
+	return profiles, nil
+}
+
+func (s *Store) DeleteProfile(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteProfile(ctx, name)
+}
+
+func (s *Store) ProfileExists(name string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	result, err := s.queries.ProfileExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
+// ============================================================================
+// Workspace Operations
+// ============================================================================
+
+func (s *Store) SaveWorkspace(workspace *model.Workspace) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	var urlPatterns *string
+	if len(workspace.URLPatterns) > 0 {
+		patternsJSON, _ := json.Marshal(workspace.URLPatterns)
+		patternsStr := string(patternsJSON)
+		urlPatterns = &patternsStr
+	}
+
+	exists, _ := s.queries.WorkspaceExists(ctx, workspace.Name)
+	if exists == 1 {
+		return s.queries.UpdateWorkspace(ctx, sqlc.UpdateWorkspaceParams{
+			Description:     ptrString(workspace.Description),
+			Path:            ptrString(workspace.Path),
+			UrlPatterns:     urlPatterns,
+			DiskBudgetBytes: ptrInt64(workspace.DiskBudget),
+			Name:            workspace.Name,
+		})
+	}
+
+	isActive := int64(0)
+	if workspace.Active {
+		isActive = 1
+	}
+
+	_, err := s.queries.InsertWorkspace(ctx, sqlc.InsertWorkspaceParams{
+		Name:            workspace.Name,
+		Description:     ptrString(workspace.Description),
+		Path:            ptrString(workspace.Path),
+		IsActive:        ptrInt64(isActive),
+		UrlPatterns:     urlPatterns,
+		DiskBudgetBytes: ptrInt64(workspace.DiskBudget),
+	})
+
+	return err
+}
+
+func (s *Store) GetWorkspace(name string) (*model.Workspace, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetWorkspace(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("workspace %q not found", name)
+		}
+
+		return nil, err
+	}
+
+	return sqlcWorkspaceToModel(row), nil
+}
+
+func (s *Store) GetActiveWorkspace() (*model.Workspace, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetActiveWorkspace(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("no active workspace")
+		}
+
+		return nil, err
+	}
+
+	return sqlcWorkspaceToModel(row), nil
+}
+
+func (s *Store) SetActiveWorkspace(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.SetActiveWorkspace(ctx, name)
+}
+
+func (s *Store) ListWorkspaces() ([]*model.Workspace, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListWorkspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*model.Workspace, 0, len(rows))
+	for _, row := range rows {
+		workspaces = append(workspaces, sqlcWorkspaceToModel(row))
+	}
+
+	return workspaces, nil
+}
+
+func (s *Store) DeleteWorkspace(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteWorkspace(ctx, name)
+}
+
+func (s *Store) WorkspaceExists(name string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	result, err := s.queries.WorkspaceExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
+// ============================================================================
+// Docker Profile Operations
+// ============================================================================
+
+func (s *Store) SaveDockerProfile(profile *model.DockerProfile) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+	tokenStorageStr := string(profile.TokenStorage)
+
+	var environment *string
+
+	if profile.Environment != nil {
+		data, err := json.Marshal(profile.Environment)
+		if err != nil {
+			return err
+		}
+
+		environment = ptrString(string(data))
+	}
+
+	exists, _ := s.queries.DockerProfileExists(ctx, profile.Name)
+	if exists == 1 {
+		return s.queries.UpdateDockerProfile(ctx, sqlc.UpdateDockerProfileParams{
+			Registry:       ptrString(profile.Registry),
+			Username:       profile.Username,
+			EncryptedToken: profile.EncryptedToken,
+			TokenStorage:   ptrString(tokenStorageStr),
+			Environment:    environment,
+			Name:           profile.Name,
+		})
+	}
+
+	_, err := s.queries.InsertDockerProfile(ctx, sqlc.InsertDockerProfileParams{
+		Name:           profile.Name,
+		Registry:       ptrString(profile.Registry),
+		Username:       profile.Username,
+		EncryptedToken: profile.EncryptedToken,
+		TokenStorage:   ptrString(tokenStorageStr),
+		Environment:    environment,
+	})
+
+	return err
+}
+
+func (s *Store) GetDockerProfile(name string) (*model.DockerProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetDockerProfile(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcDockerProfileToModel(row), nil
+}
+
+func (s *Store) ListDockerProfiles() ([]*model.DockerProfile, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListDockerProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]*model.DockerProfile, 0, len(rows))
+	for _, row := range rows {
+		profiles = append(profiles, sqlcDockerProfileToModel(row))
+	}
+
+	return profiles, nil
+}
+
+func (s *Store) DeleteDockerProfile(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteDockerProfile(ctx, name)
+}
+
+func (s *Store) DockerProfileExists(name string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	result, err := s.queries.DockerProfileExists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	return result == 1, nil
+}
+
+// ============================================================================
+// Saved Filter Operations
+// ============================================================================
+
+func (s *Store) SaveFilter(filter *model.SavedFilter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	favoritesOnly, dirty := int64(0), int64(0)
+	if filter.FavoritesOnly {
+		favoritesOnly = 1
+	}
+
+	if filter.Dirty {
+		dirty = 1
+	}
+
+	exists, _ := s.queries.SavedFilterExists(ctx, filter.Name)
+	if exists == 1 {
+		return s.queries.UpdateSavedFilter(ctx, sqlc.UpdateSavedFilterParams{
+			Description:   ptrString(filter.Description),
+			Workspace:     ptrString(filter.Workspace),
+			FavoritesOnly: &favoritesOnly,
+			Query:         ptrString(filter.Query),
+			Dirty:         &dirty,
+			Language:      ptrString(filter.Language),
+			Sort:          ptrString(filter.Sort),
+			Kind:          ptrString(string(filter.Kind)),
+			Name:          filter.Name,
+		})
+	}
+
+	_, err := s.queries.InsertSavedFilter(ctx, sqlc.InsertSavedFilterParams{
+		Name:          filter.Name,
+		Description:   ptrString(filter.Description),
+		Workspace:     ptrString(filter.Workspace),
+		FavoritesOnly: &favoritesOnly,
+		Query:         ptrString(filter.Query),
+		Dirty:         &dirty,
+		Language:      ptrString(filter.Language),
+		Sort:          ptrString(filter.Sort),
+		Kind:          ptrString(string(filter.Kind)),
+	})
+
+	return err
+}
+
+func (s *Store) GetFilter(name string) (*model.SavedFilter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetSavedFilter(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcSavedFilterToModel(row), nil
+}
+
+func (s *Store) ListFilters() ([]*model.SavedFilter, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListSavedFilters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]*model.SavedFilter, 0, len(rows))
+	for _, row := range rows {
+		filters = append(filters, sqlcSavedFilterToModel(row))
+	}
+
+	return filters, nil
+}
+
+func (s *Store) DeleteFilter(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteSavedFilter(ctx, name)
+}
+
+// ============================================================================
+// Repository Snapshot Operations
+// ============================================================================
+
+func (s *Store) SaveRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	if snapshot.CreatedAt.IsZero() {
+		snapshot.CreatedAt = time.Now()
+	}
+
+	return s.queries.InsertRepoSnapshot(ctx, sqlc.InsertRepoSnapshotParams{
+		ID:        snapshot.ID,
+		RepoUrl:   snapshot.RepoURL,
+		Path:      snapshot.Path,
+		Message:   ptrString(snapshot.Message),
+		Branch:    ptrString(snapshot.Branch),
+		Head:      snapshot.Head,
+		Stash:     ptrString(snapshot.Stash),
+		CreatedAt: snapshot.CreatedAt,
+	})
+}
+
+func (s *Store) GetRepoSnapshot(id string) (*model.RepoSnapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetRepoSnapshot(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcRepoSnapshotToModel(row), nil
+}
+
+func (s *Store) ListRepoSnapshots(repoURL string) ([]*model.RepoSnapshot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	var (
+		rows []sqlc.RepoSnapshot
+		err  error
+	)
+
+	if repoURL == "" {
+		rows, err = s.queries.ListRepoSnapshots(ctx)
+	} else {
+		rows, err = s.queries.ListRepoSnapshotsByURL(ctx, repoURL)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*model.RepoSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, sqlcRepoSnapshotToModel(row))
+	}
+
+	return snapshots, nil
+}
+
+func (s *Store) DeleteRepoSnapshot(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteRepoSnapshot(ctx, id)
+}
+
+// ============================================================================
+// Wizard Draft Operations
+// ============================================================================
+
+func (s *Store) SaveWizardDraft(draft *model.WizardDraft) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	if draft.UpdatedAt.IsZero() {
+		draft.UpdatedAt = time.Now()
+	}
+
+	return s.queries.UpsertWizardDraft(ctx, sqlc.UpsertWizardDraftParams{
+		Name:      draft.Name,
+		Data:      draft.Data,
+		UpdatedAt: draft.UpdatedAt,
+	})
+}
+
+func (s *Store) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetWizardDraft(ctx, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcWizardDraftToModel(row), nil
+}
+
+func (s *Store) DeleteWizardDraft(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteWizardDraft(ctx, name)
+}
+
+// ============================================================================
+// API Token Operations
+// ============================================================================
+
+func (s *Store) SaveAPIToken(token *model.APIToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		return nil, err
+	ctx := newContext()
+
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
 	}
 
-	return sqlcWorkspaceToModel(row), nil
+	var expiresAt, lastUsedAt *time.Time
+	if !token.ExpiresAt.IsZero() {
+		expiresAt = &token.ExpiresAt
+	}
+
+	if !token.LastUsedAt.IsZero() {
+		lastUsedAt = &token.LastUsedAt
+	}
+
+	return s.queries.UpsertAPIToken(ctx, sqlc.UpsertAPITokenParams{
+		Name:       token.Name,
+		Hash:       token.Hash,
+		CreatedAt:  token.CreatedAt,
+		ExpiresAt:  expiresAt,
+		LastUsedAt: lastUsedAt,
+	})
 }
 
-func (s *Store) GetActiveWorkspace() (*model.Workspace, error) {
+func (s *Store) GetAPITokenByHash(hash string) (*model.APIToken, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	row, err := s.queries.GetActiveWorkspace(ctx)
+	row, err := s.queries.GetAPITokenByHash(ctx, hash)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("no active workspace")
+			return nil, nil
 		}
 
 		return nil, err
 	}
 
-	return sqlcWorkspaceToModel(row), nil
+	return sqlcAPITokenToModel(row), nil
 }
 
-func (s *Store) SetActiveWorkspace(name string) error {
+func (s *Store) ListAPITokens() ([]model.APIToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListAPITokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]model.APIToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, *sqlcAPITokenToModel(row))
+	}
+
+	return tokens, nil
+}
+
+func (s *Store) DeleteAPIToken(name string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
 
-	return s.queries.SetActiveWorkspace(ctx, name)
+	return s.queries.DeleteAPIToken(ctx, name)
 }
 
-func (s *Store) ListWorkspaces() ([]*model.Workspace, error) {
+// ============================================================================
+// Vault Secret Operations
+// ============================================================================
+
+func (s *Store) SaveVaultSecret(secret *model.VaultSecret) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	now := time.Now()
+	if secret.CreatedAt.IsZero() {
+		secret.CreatedAt = now
+	}
+
+	secret.UpdatedAt = now
+
+	return s.queries.UpsertVaultSecret(ctx, sqlc.UpsertVaultSecretParams{
+		Key:       secret.Key,
+		Value:     secret.Value,
+		CreatedAt: secret.CreatedAt,
+		UpdatedAt: secret.UpdatedAt,
+	})
+}
+
+func (s *Store) GetVaultSecret(key string) (*model.VaultSecret, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	rows, err := s.queries.ListWorkspaces(ctx)
+	row, err := s.queries.GetVaultSecret(ctx, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcVaultSecretToModel(row), nil
+}
+
+func (s *Store) ListVaultSecrets() ([]model.VaultSecret, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListVaultSecrets(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	workspaces := make([]*model.Workspace, 0, len(rows))
+	secrets := make([]model.VaultSecret, 0, len(rows))
 	for _, row := range rows {
-		workspaces = append(workspaces, sqlcWorkspaceToModel(row))
+		secrets = append(secrets, *sqlcVaultSecretToModel(row))
 	}
 
-	return workspaces, nil
+	return secrets, nil
 }
 
-func (s *Store) DeleteWorkspace(name string) error {
+func (s *Store) DeleteVaultSecret(key string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
 
-	return s.queries.DeleteWorkspace(ctx, name)
+	return s.queries.DeleteVaultSecret(ctx, key)
 }
 
-func (s *Store) WorkspaceExists(name string) (bool, error) {
+// ============================================================================
+// Gmail Watch Operations
+// ============================================================================
+
+func (s *Store) SaveGmailWatch(watch *model.GmailWatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	now := time.Now()
+	if watch.CreatedAt.IsZero() {
+		watch.CreatedAt = now
+	}
+
+	watch.UpdatedAt = now
+
+	var lastCheckedAt, lastMatchAt *time.Time
+	if !watch.LastCheckedAt.IsZero() {
+		lastCheckedAt = &watch.LastCheckedAt
+	}
+
+	if !watch.LastMatchAt.IsZero() {
+		lastMatchAt = &watch.LastMatchAt
+	}
+
+	var notify int64
+	if watch.Notify {
+		notify = 1
+	}
+
+	return s.queries.UpsertGmailWatch(ctx, sqlc.UpsertGmailWatchParams{
+		Name:            watch.Name,
+		Profile:         watch.Profile,
+		Query:           watch.Query,
+		IntervalSeconds: int64(watch.Interval / time.Second),
+		Notify:          notify,
+		Exec:            watch.Exec,
+		HistoryID:       watch.HistoryID,
+		LastCheckedAt:   lastCheckedAt,
+		LastMatchAt:     lastMatchAt,
+		LastError:       watch.LastError,
+		CreatedAt:       watch.CreatedAt,
+		UpdatedAt:       watch.UpdatedAt,
+	})
+}
+
+func (s *Store) GetGmailWatch(name string) (*model.GmailWatch, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	result, err := s.queries.WorkspaceExists(ctx, name)
+	row, err := s.queries.GetGmailWatch(ctx, name)
 	if err != nil {
-		return false, err
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
 	}
 
-	return result == 1, nil
+	return sqlcGmailWatchToModel(row), nil
+}
+
+func (s *Store) ListGmailWatches() ([]model.GmailWatch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	rows, err := s.queries.ListGmailWatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watches := make([]model.GmailWatch, 0, len(rows))
+	for _, row := range rows {
+		watches = append(watches, *sqlcGmailWatchToModel(row))
+	}
+
+	return watches, nil
+}
+
+func (s *Store) DeleteGmailWatch(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	return s.queries.DeleteGmailWatch(ctx, name)
 }
 
 // ============================================================================
-// Docker Profile Operations
+// GitHub Repository ID Cache Operations
 // ============================================================================
 
-func (s *Store) SaveDockerProfile(profile *model.DockerProfile) error {
+func (s *Store) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
-	tokenStorageStr := string(profile.TokenStorage)
 
-	exists, _ := s.queries.DockerProfileExists(ctx, profile.Name)
-	if exists == 1 {
-		return s.queries.UpdateDockerProfile(ctx, sqlc.UpdateDockerProfileParams{
-			Registry:       ptrString(profile.Registry),
-			Username:       profile.Username,
-			EncryptedToken: profile.EncryptedToken,
-			TokenStorage:   ptrString(tokenStorageStr),
-			Name:           profile.Name,
-		})
+	if entry.CachedAt.IsZero() {
+		entry.CachedAt = time.Now()
 	}
 
-	_, err := s.queries.InsertDockerProfile(ctx, sqlc.InsertDockerProfileParams{
-		Name:           profile.Name,
-		Registry:       ptrString(profile.Registry),
-		Username:       profile.Username,
-		EncryptedToken: profile.EncryptedToken,
-		TokenStorage:   ptrString(tokenStorageStr),
+	return s.queries.UpsertGitHubRepoID(ctx, sqlc.UpsertGitHubRepoIDParams{
+		FullName: entry.FullName,
+		RepoID:   entry.RepoID,
+		CachedAt: entry.CachedAt,
 	})
-
-	return err
 }
 
-func (s *Store) GetDockerProfile(name string) (*model.DockerProfile, error) {
+func (s *Store) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	row, err := s.queries.GetDockerProfile(ctx, name)
+	row, err := s.queries.GetGitHubRepoID(ctx, fullName)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -648,49 +1760,219 @@ func (s *Store) GetDockerProfile(name string) (*model.DockerProfile, error) {
 		return nil, err
 	}
 
-	return sqlcDockerProfileToModel(row), nil
+	return sqlcGitHubRepoIDToModel(row), nil
 }
 
-func (s *Store) ListDockerProfiles() ([]*model.DockerProfile, error) {
+// ============================================================================
+// Dependency Inventory Operations
+// ============================================================================
+
+func (s *Store) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	if inventory.ScannedAt.IsZero() {
+		inventory.ScannedAt = time.Now()
+	}
+
+	dependencies, err := json.Marshal(inventory.Dependencies)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dependencies: %w", err)
+	}
+
+	return s.queries.InsertDependencyInventory(ctx, sqlc.InsertDependencyInventoryParams{
+		RepoUrl:      inventory.RepoURL,
+		Dependencies: string(dependencies),
+		ScannedAt:    inventory.ScannedAt,
+	})
+}
+
+func (s *Store) ListDependencyInventories(repoURL string, limit int) ([]*model.DependencyInventory, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	rows, err := s.queries.ListDockerProfiles(ctx)
+	// A negative LIMIT is no limit in SQLite
+	rowLimit := int64(limit)
+	if limit <= 0 {
+		rowLimit = -1
+	}
+
+	rows, err := s.queries.ListDependencyInventories(ctx, sqlc.ListDependencyInventoriesParams{
+		RepoUrl: repoURL,
+		Limit:   rowLimit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	profiles := make([]*model.DockerProfile, 0, len(rows))
+	inventories := make([]*model.DependencyInventory, 0, len(rows))
 	for _, row := range rows {
-		profiles = append(profiles, sqlcDockerProfileToModel(row))
+		inventories = append(inventories, sqlcDependencyInventoryToModel(row))
 	}
 
-	return profiles, nil
+	return inventories, nil
 }
 
-func (s *Store) DeleteDockerProfile(name string) error {
+// ============================================================================
+// Share Link Operations
+// ============================================================================
+
+func (s *Store) SaveShareLink(link *model.ShareLink) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	ctx := newContext()
+	now := time.Now()
 
-	return s.queries.DeleteDockerProfile(ctx, name)
+	if link.CreatedAt.IsZero() {
+		link.CreatedAt = now
+	}
+
+	if err := s.queries.DeleteExpiredShareLinks(ctx, now); err != nil {
+		return err
+	}
+
+	return s.queries.InsertShareLink(ctx, sqlc.InsertShareLinkParams{
+		ID:        link.ID,
+		RepoUrl:   link.RepoURL,
+		Note:      link.Note,
+		CreatedAt: link.CreatedAt,
+		ExpiresAt: link.ExpiresAt,
+	})
 }
 
-func (s *Store) DockerProfileExists(name string) (bool, error) {
+func (s *Store) GetShareLink(id string) (*model.ShareLink, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	ctx := newContext()
 
-	result, err := s.queries.DockerProfileExists(ctx, name)
+	row, err := s.queries.GetShareLink(ctx, id)
 	if err != nil {
-		return false, err
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
 	}
 
-	return result == 1, nil
+	return sqlcShareLinkToModel(row), nil
+}
+
+func (s *Store) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.ConsumeShareLink(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcShareLinkToModel(row), nil
+}
+
+// ============================================================================
+// Job Operations
+// ============================================================================
+
+// SaveJob inserts or updates a job. A cancellation requested meanwhile is
+// kept, so a job reporting progress cannot clear it.
+func (s *Store) SaveJob(job *model.Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	log, err := json.Marshal(job.Log)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job log: %w", err)
+	}
+
+	return s.queries.UpsertJob(ctx, sqlc.UpsertJobParams{
+		ID:          job.ID,
+		Kind:        job.Kind,
+		Description: job.Description,
+		State:       string(job.State),
+		Total:       int64(job.Total),
+		Done:        int64(job.Done),
+		Failed:      int64(job.Failed),
+		Log:         string(log),
+		Error:       job.Error,
+		StartedAt:   job.StartedAt,
+		UpdatedAt:   job.UpdatedAt,
+		FinishedAt:  job.FinishedAt,
+	})
+}
+
+func (s *Store) GetJob(id string) (*model.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetJob(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return sqlcJobToModel(row), nil
+}
+
+// ListJobs returns the newest jobs first, at most limit of them
+func (s *Store) ListJobs(limit int) ([]model.Job, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := s.queries.ListJobs(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]model.Job, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, *sqlcJobToModel(row))
+	}
+
+	return jobs, nil
+}
+
+// CancelJob asks a job to stop
+func (s *Store) CancelJob(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	n, err := s.queries.RequestJobCancel(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return fmt.Errorf("job not found: %s", id)
+	}
+
+	return nil
 }
 
 // ============================================================================
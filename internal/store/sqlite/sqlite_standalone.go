@@ -35,6 +35,11 @@ func (s *Store) GetStandaloneConfig() (*standalone.StandaloneConfig, error) {
 		_ = json.Unmarshal([]byte(*row.Capabilities), &capabilities)
 	}
 
+	var autoApprove standalone.AutoApprovePolicy
+	if row.AutoApprove != nil && *row.AutoApprove != "" {
+		_ = json.Unmarshal([]byte(*row.AutoApprove), &autoApprove)
+	}
+
 	config := &standalone.StandaloneConfig{
 		Enabled:      derefInt64ToBool(row.Enabled),
 		IsServer:     derefInt64ToBool(row.IsServer),
@@ -46,6 +51,7 @@ func (s *Store) GetStandaloneConfig() (*standalone.StandaloneConfig, error) {
 		Capabilities: capabilities,
 		CreatedAt:    row.CreatedAt,
 		ExpiresAt:    row.ExpiresAt,
+		AutoApprove:  autoApprove,
 	}
 
 	return config, nil
@@ -70,6 +76,17 @@ func (s *Store) SaveStandaloneConfig(config *standalone.StandaloneConfig) error
 		isServer = 1
 	}
 
+	var autoApprove *string
+
+	if !config.AutoApprove.IsZero() {
+		data, err := json.Marshal(config.AutoApprove)
+		if err != nil {
+			return err
+		}
+
+		autoApprove = ptrString(string(data))
+	}
+
 	return s.queries.UpsertStandaloneConfig(ctx, sqlc.UpsertStandaloneConfigParams{
 		Enabled:      ptrInt64(enabled),
 		IsServer:     ptrInt64(isServer),
@@ -80,6 +97,7 @@ func (s *Store) SaveStandaloneConfig(config *standalone.StandaloneConfig) error
 		Salt:         config.Salt,
 		Capabilities: &capabilitiesStr,
 		ExpiresAt:    config.ExpiresAt,
+		AutoApprove:  autoApprove,
 	})
 }
 
@@ -199,6 +217,28 @@ func (s *Store) SaveStandaloneConnection(conn *standalone.StandaloneConnection)
 	syncedItemsStr := string(syncedItemsJSON)
 	syncStatusStr := conn.SyncStatus
 
+	var syncFilter *string
+
+	if !conn.SyncFilter.IsZero() {
+		data, err := json.Marshal(conn.SyncFilter)
+		if err != nil {
+			return err
+		}
+
+		syncFilter = ptrString(string(data))
+	}
+
+	var ackedRevisions *string
+
+	if len(conn.AckedRevisions) > 0 {
+		data, err := json.Marshal(conn.AckedRevisions)
+		if err != nil {
+			return err
+		}
+
+		ackedRevisions = ptrString(string(data))
+	}
+
 	// Check if exists
 	_, err := s.queries.GetStandaloneConnection(ctx, conn.Name)
 	if err == sql.ErrNoRows {
@@ -215,6 +255,9 @@ func (s *Store) SaveStandaloneConnection(conn *standalone.StandaloneConnection)
 			SyncStatus:            ptrString(syncStatusStr),
 			SyncedItems:           &syncedItemsStr,
 			LastSync:              conn.LastSync,
+			SyncFilter:            syncFilter,
+			AckedRevisions:        ackedRevisions,
+			ClientID:              ptrString(conn.ClientID),
 		})
 
 		return err
@@ -234,6 +277,9 @@ func (s *Store) SaveStandaloneConnection(conn *standalone.StandaloneConnection)
 		SyncStatus:            ptrString(syncStatusStr),
 		SyncedItems:           &syncedItemsStr,
 		LastSync:              conn.LastSync,
+		SyncFilter:            syncFilter,
+		AckedRevisions:        ackedRevisions,
+		ClientID:              ptrString(conn.ClientID),
 		Name:                  conn.Name,
 	})
 }
@@ -247,6 +293,54 @@ func (s *Store) DeleteStandaloneConnection(name string) error {
 	return s.queries.DeleteStandaloneConnection(ctx, name)
 }
 
+// ============================================================================
+// Sync Change Log Operations
+// ============================================================================
+
+func (s *Store) GetSyncChangeLog(bucket string) (*standalone.BucketLog, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ctx := newContext()
+
+	row, err := s.queries.GetSyncChangelog(ctx, bucket)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &standalone.BucketLog{}, nil
+		}
+
+		return nil, err
+	}
+
+	log := &standalone.BucketLog{Revision: row.Revision}
+
+	if row.Records != nil && *row.Records != "" {
+		if err := json.Unmarshal([]byte(*row.Records), &log.Records); err != nil {
+			return nil, fmt.Errorf("invalid change log of %s: %w", bucket, err)
+		}
+	}
+
+	return log, nil
+}
+
+func (s *Store) SaveSyncChangeLog(bucket string, log *standalone.BucketLog) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx := newContext()
+
+	records, err := json.Marshal(log.Records)
+	if err != nil {
+		return err
+	}
+
+	return s.queries.UpsertSyncChangelog(ctx, sqlc.UpsertSyncChangelogParams{
+		Bucket:   bucket,
+		Revision: log.Revision,
+		Records:  ptrString(string(records)),
+	})
+}
+
 // ============================================================================
 // Server Encryption Config Operations
 // ============================================================================
@@ -415,6 +509,7 @@ func (s *Store) SavePendingRegistration(reg *standalone.ClientRegistration) erro
 		State:          &stateStr,
 		ChallengeToken: ptrString(reg.ChallengeToken),
 		ChallengeAt:    reg.ChallengeAt,
+		Fingerprint:    ptrString(reg.Fingerprint),
 	})
 }
 
@@ -446,7 +541,8 @@ func (s *Store) GetPendingRegistration(clientID string) (*standalone.ClientRegis
 		ChallengeToken: derefString(row.ChallengeToken),
 		InitiatedAt:    row.InitiatedAt,
 		ChallengeAt:    row.ChallengeAt,
-		CompletedAt:    row.CompletedAt,
+		CompletedAt:    derefTime(row.CompletedAt),
+		Fingerprint:    derefString(row.Fingerprint),
 	}
 
 	return reg, nil
@@ -478,7 +574,8 @@ func (s *Store) ListPendingRegistrations() ([]*standalone.ClientRegistration, er
 			ChallengeToken: derefString(row.ChallengeToken),
 			InitiatedAt:    row.InitiatedAt,
 			ChallengeAt:    row.ChallengeAt,
-			CompletedAt:    row.CompletedAt,
+			CompletedAt:    derefTime(row.CompletedAt),
+			Fingerprint:    derefString(row.Fingerprint),
 		}
 		regs = append(regs, reg)
 	}
@@ -519,6 +616,7 @@ func (s *Store) SaveRegisteredClient(client *standalone.RegisteredClient) error
 		Status:            &statusStr,
 		SyncCount:         ptrInt64(int64(client.SyncCount)),
 		LastIp:            ptrString(client.LastIP),
+		Fingerprint:       ptrString(client.Fingerprint),
 	})
 }
 
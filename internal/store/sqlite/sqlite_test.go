@@ -0,0 +1,779 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"maps"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/inovacc/clonr/internal/model"
+	"github.com/inovacc/clonr/internal/standalone"
+)
+
+func TestNewEnablesWAL(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	mode, err := s.JournalMode()
+	if err != nil {
+		t.Fatalf("JournalMode() error = %v", err)
+	}
+
+	if mode != "wal" {
+		t.Errorf("JournalMode() = %q, want wal", mode)
+	}
+
+	var timeout int
+	if err := s.db.QueryRow("PRAGMA busy_timeout").Scan(&timeout); err != nil {
+		t.Fatal(err)
+	}
+
+	if timeout != 5000 {
+		t.Errorf("busy_timeout = %d, want 5000", timeout)
+	}
+}
+
+func TestDSN(t *testing.T) {
+	got := dsn("/data/clonr.db", Options{BusyTimeout: 2 * time.Second})
+
+	for _, want := range []string{"/data/clonr.db?", "_pragma=journal_mode(WAL)", "_pragma=busy_timeout(2000)", "_txlock=immediate"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("dsn() = %q, want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestStatementCache(t *testing.T) {
+	for _, cached := range []bool{true, false} {
+		opts := DefaultOptions()
+		opts.CacheStatements = cached
+
+		s, err := NewWithOptions(filepath.Join(t.TempDir(), "clonr.db"), opts)
+		if err != nil {
+			t.Fatalf("NewWithOptions() error = %v", err)
+		}
+
+		u, _ := url.Parse("https://github.com/user/repo")
+
+		if err := s.SaveRepo(u, "/src/repo"); err != nil {
+			t.Fatalf("SaveRepo() error = %v", err)
+		}
+
+		for range 3 {
+			exists, err := s.RepoExistsByURL(u)
+			if err != nil || !exists {
+				t.Errorf("RepoExistsByURL() = %v, %v; want true", exists, err)
+			}
+		}
+
+		if cached && len(s.stmts.stmts) == 0 {
+			t.Error("no statements cached")
+		}
+
+		if err := s.Close(); err != nil {
+			t.Errorf("Close() error = %v", err)
+		}
+	}
+}
+
+func TestSetRepoKind(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	for _, raw := range []string{"https://github.com/user/repo", "https://github.com/user/fork"} {
+		u, _ := url.Parse(raw)
+		if err := s.SaveRepo(u, "/src/"+filepath.Base(raw)); err != nil {
+			t.Fatalf("SaveRepo() error = %v", err)
+		}
+	}
+
+	if err := s.SetRepoKind("https://github.com/user/fork", model.RepoKindFork); err != nil {
+		t.Fatalf("SetRepoKind() error = %v", err)
+	}
+
+	repos, total, err := s.ListRepos(model.RepoFilter{Kind: model.RepoKindFork}, 0, 10)
+	if err != nil {
+		t.Fatalf("ListRepos() error = %v", err)
+	}
+
+	if total != 1 || len(repos) != 1 || repos[0].Kind != model.RepoKindFork {
+		t.Errorf("ListRepos(kind=fork) = %d repos (total %d), want the fork only", len(repos), total)
+	}
+}
+
+func TestSetRepoLicense(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := s.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	if err := s.SetRepoLicense(u.String(), "MIT"); err != nil {
+		t.Fatalf("SetRepoLicense() error = %v", err)
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].License != "MIT" {
+		t.Errorf("GetAllRepos() = %+v, want the repository licensed MIT", repos)
+	}
+}
+
+func TestSetRepoTags(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := s.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	if err := s.SetRepoTags(u.String(), []string{"go", "work"}); err != nil {
+		t.Fatalf("SetRepoTags() error = %v", err)
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || !slices.Equal(repos[0].Tags, []string{"go", "work"}) {
+		t.Errorf("GetAllRepos() = %+v, want the repository tagged go and work", repos)
+	}
+
+	if err := s.SetRepoTags(u.String(), nil); err != nil {
+		t.Fatalf("SetRepoTags(nil) error = %v", err)
+	}
+
+	if repos, err = s.GetAllRepos(); err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos[0].Tags) != 0 {
+		t.Errorf("Tags = %v after clearing, want none", repos[0].Tags)
+	}
+}
+
+func TestSetRepoNotes(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := s.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	if err := s.SetRepoNotes(u.String(), "Rebase on upstream weekly"); err != nil {
+		t.Fatalf("SetRepoNotes() error = %v", err)
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].Notes != "Rebase on upstream weekly" {
+		t.Errorf("GetAllRepos() = %+v, want the repository with its notes", repos)
+	}
+}
+
+func TestSetRepoAlias(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	api, _ := url.Parse("https://github.com/acme/api-gateway")
+	web, _ := url.Parse("https://github.com/acme/web")
+
+	for _, u := range []*url.URL{api, web} {
+		if err := s.SaveRepo(u, "/src"+u.Path); err != nil {
+			t.Fatalf("SaveRepo() error = %v", err)
+		}
+	}
+
+	if err := s.SetRepoAlias("git@github.com:acme/api-gateway.git", "api"); err != nil {
+		t.Fatalf("SetRepoAlias() error = %v", err)
+	}
+
+	if err := s.SetRepoAlias(web.String(), "api"); err == nil {
+		t.Error("SetRepoAlias() of a taken alias succeeded")
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	for _, r := range repos {
+		if want := map[string]string{api.String(): "api"}[r.URL]; r.Alias != want {
+			t.Errorf("alias of %s = %q, want %q", r.URL, r.Alias, want)
+		}
+	}
+
+	// Cleared aliases do not collide
+	if err := s.SetRepoAlias(api.String(), ""); err != nil {
+		t.Fatalf("SetRepoAlias() clearing error = %v", err)
+	}
+
+	if err := s.SetRepoAlias(web.String(), ""); err != nil {
+		t.Fatalf("SetRepoAlias() clearing a second alias error = %v", err)
+	}
+}
+
+func TestRecordRepoAccess(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/repo")
+	if err := s.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	for range 2 {
+		if err := s.RecordRepoAccess("git@github.com:user/repo.git"); err != nil {
+			t.Fatalf("RecordRepoAccess() error = %v", err)
+		}
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].AccessCount != 2 || time.Since(repos[0].LastAccessed) > time.Minute {
+		t.Errorf("GetAllRepos() = %+v, want 2 accesses recorded just now", repos)
+	}
+}
+
+func TestRepoRemotes(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/fork")
+	if err := s.SaveRepo(u, "/src/fork"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	remotes := []model.RepoRemote{{Name: "upstream", URL: "https://github.com/acme/tool"}}
+	if err := s.SetRepoRemotes(u.String(), remotes); err != nil {
+		t.Fatalf("SetRepoRemotes() error = %v", err)
+	}
+
+	repo, err := s.GetRepoByRemoteURL("https://github.com/acme/tool")
+	if err != nil {
+		t.Fatalf("GetRepoByRemoteURL() error = %v", err)
+	}
+
+	if repo == nil || repo.URL != u.String() || len(repo.Remotes) != 1 || repo.Remotes[0] != remotes[0] {
+		t.Errorf("GetRepoByRemoteURL() = %+v, want the fork with its upstream", repo)
+	}
+
+	if repo, _ := s.GetRepoByRemoteURL("https://github.com/other/tool"); repo != nil {
+		t.Errorf("GetRepoByRemoteURL(unknown) = %+v, want nil", repo)
+	}
+
+	if err := s.RemoveRepoByURL(u); err != nil {
+		t.Fatalf("RemoveRepoByURL() error = %v", err)
+	}
+
+	if repo, _ := s.GetRepoByRemoteURL("https://github.com/acme/tool"); repo != nil {
+		t.Errorf("GetRepoByRemoteURL() after removal = %+v, want nil", repo)
+	}
+}
+
+func TestUpdateRepoURL(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("https://github.com/user/old-name")
+	if err := s.SaveRepoWithWorkspace(u, "/src/tool", "work"); err != nil {
+		t.Fatalf("SaveRepoWithWorkspace() error = %v", err)
+	}
+
+	if err := s.SetFavoriteByURL(u.String(), true); err != nil {
+		t.Fatalf("SetFavoriteByURL() error = %v", err)
+	}
+
+	remotes := []model.RepoRemote{{Name: "upstream", URL: "https://github.com/acme/tool"}}
+	if err := s.SetRepoRemotes(u.String(), remotes); err != nil {
+		t.Fatalf("SetRepoRemotes() error = %v", err)
+	}
+
+	newURL := "https://github.com/user/new-name"
+	if err := s.UpdateRepoURL(u.String(), newURL); err != nil {
+		t.Fatalf("UpdateRepoURL() error = %v", err)
+	}
+
+	repo, err := s.GetRepoByRemoteURL("https://github.com/acme/tool")
+	if err != nil {
+		t.Fatalf("GetRepoByRemoteURL() error = %v", err)
+	}
+
+	if repo == nil || repo.URL != newURL || repo.Path != "/src/tool" || repo.Workspace != "work" || !repo.Favorite || len(repo.Remotes) != 1 {
+		t.Errorf("repository after UpdateRepoURL() = %+v, want the record under the new URL", repo)
+	}
+
+	if exists, _ := s.RepoExistsByURL(u); exists {
+		t.Error("old URL still tracked after UpdateRepoURL()")
+	}
+}
+
+func TestCanonicalRepoURLs(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	u, _ := url.Parse("ssh://git@GitHub.com/user/repo.git")
+	if err := s.SaveRepo(u, "/src/repo"); err != nil {
+		t.Fatalf("SaveRepo() error = %v", err)
+	}
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	if len(repos) != 1 || repos[0].URL != "https://github.com/user/repo" {
+		t.Fatalf("GetAllRepos() = %+v, want the canonical URL", repos)
+	}
+
+	for _, typed := range []string{"https://github.com/user/repo.git", "https://github.com/user/repo/"} {
+		u, _ := url.Parse(typed)
+
+		exists, err := s.RepoExistsByURL(u)
+		if err != nil || !exists {
+			t.Errorf("RepoExistsByURL(%s) = %v, %v; want true", typed, exists, err)
+		}
+	}
+
+	if err := s.SetFavoriteByURL("https://GITHUB.com/user/repo.git", true); err != nil {
+		t.Fatalf("SetFavoriteByURL() error = %v", err)
+	}
+
+	repos, _ = s.GetAllRepos()
+	if !repos[0].Favorite {
+		t.Error("SetFavoriteByURL() did not find the repository by an equivalent URL")
+	}
+}
+
+func TestCanonicalizeRepoURLsMigration(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clonr.db")
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewMigrator(db).MigrateTo(34); err != nil {
+		t.Fatalf("MigrateTo(34) error = %v", err)
+	}
+
+	for i, rec := range [][2]string{
+		{"https://github.com/user/api", "/src/api"},
+		{"git@github.com:user/api.git", "/old/api"},
+		{"https://GitHub.com/user/web.git", "/src/web"},
+	} {
+		if _, err := db.Exec(`INSERT INTO repositories (uid, url, path, last_checked) VALUES (?, ?, ?, CURRENT_TIMESTAMP)`, fmt.Sprint(i), rec[0], rec[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := db.Exec(`INSERT INTO repo_remotes (repo_url, name, url) VALUES (?, 'upstream', 'https://github.com/acme/web')`, "https://GitHub.com/user/web.git"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`UPDATE config SET webhooks = ? WHERE id = 1`, `["https://GitHub.com/user/web.git","git@github.com:user/web.git"]`); err != nil {
+		t.Fatal(err)
+	}
+
+	_ = db.Close()
+
+	s, err := New(path)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	repos, err := s.GetAllRepos()
+	if err != nil {
+		t.Fatalf("GetAllRepos() error = %v", err)
+	}
+
+	got := make(map[string]string, len(repos))
+	for _, r := range repos {
+		got[r.URL] = r.Path
+	}
+
+	want := map[string]string{"https://github.com/user/api": "/src/api", "https://github.com/user/web": "/src/web"}
+	if !maps.Equal(got, want) {
+		t.Errorf("repositories after the migration = %v, want %v", got, want)
+	}
+
+	for _, r := range repos {
+		if r.URL == "https://github.com/user/web" && len(r.Remotes) != 1 {
+			t.Errorf("remotes of %s = %v, want the upstream moved with it", r.URL, r.Remotes)
+		}
+	}
+
+	cfg, err := s.GetConfig()
+	if err != nil {
+		t.Fatalf("GetConfig() error = %v", err)
+	}
+
+	if !slices.Equal(cfg.Webhooks, []string{"https://github.com/user/web"}) {
+		t.Errorf("webhooks after the migration = %v, want the canonical URL", cfg.Webhooks)
+	}
+}
+
+func TestWizardDraft(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	if draft, err := s.GetWizardDraft("configure"); err != nil || draft != nil {
+		t.Fatalf("GetWizardDraft() on empty store = %v, %v", draft, err)
+	}
+
+	for _, data := range []string{`{"values":["a"]}`, `{"values":["b"]}`} {
+		if err := s.SaveWizardDraft(&model.WizardDraft{Name: "configure", Data: []byte(data)}); err != nil {
+			t.Fatalf("SaveWizardDraft() error = %v", err)
+		}
+	}
+
+	draft, err := s.GetWizardDraft("configure")
+	if err != nil || draft == nil || string(draft.Data) != `{"values":["b"]}` || draft.UpdatedAt.IsZero() {
+		t.Fatalf("GetWizardDraft() = %+v, %v", draft, err)
+	}
+
+	if err := s.DeleteWizardDraft("configure"); err != nil {
+		t.Fatalf("DeleteWizardDraft() error = %v", err)
+	}
+
+	if draft, _ := s.GetWizardDraft("configure"); draft != nil {
+		t.Errorf("GetWizardDraft() after delete = %+v", draft)
+	}
+}
+
+func TestStandaloneConnectionSyncFilter(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	conn := &standalone.StandaloneConnection{
+		Name:       "home",
+		InstanceID: "abc",
+		ClientID:   "client-1",
+		Host:       "10.0.0.2",
+		Port:       50052,
+		SyncFilter: standalone.SyncFilter{DataTypes: []string{"repos", "workspaces"}, Workspaces: []string{"work"}},
+	}
+
+	if err := s.SaveStandaloneConnection(conn); err != nil {
+		t.Fatalf("SaveStandaloneConnection() error = %v", err)
+	}
+
+	got, err := s.GetStandaloneConnection("home")
+	if err != nil || got == nil {
+		t.Fatalf("GetStandaloneConnection() = %v, %v", got, err)
+	}
+
+	if !slices.Equal(got.SyncFilter.DataTypes, conn.SyncFilter.DataTypes) || !slices.Equal(got.SyncFilter.Workspaces, []string{"work"}) {
+		t.Errorf("SyncFilter = %+v, want %+v", got.SyncFilter, conn.SyncFilter)
+	}
+
+	if got.ClientID != "client-1" {
+		t.Errorf("ClientID = %q, want client-1", got.ClientID)
+	}
+
+	// Clearing the filter on update syncs everything again
+	conn.SyncFilter = standalone.SyncFilter{}
+	if err := s.SaveStandaloneConnection(conn); err != nil {
+		t.Fatalf("SaveStandaloneConnection() error = %v", err)
+	}
+
+	conns, err := s.ListStandaloneConnections()
+	if err != nil || len(conns) != 1 || !conns[0].SyncFilter.IsZero() {
+		t.Errorf("ListStandaloneConnections() = %+v, %v; want one connection without filter", conns, err)
+	}
+}
+
+func TestStandaloneClientApproval(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	config := &standalone.StandaloneConfig{
+		Enabled:     true,
+		IsServer:    true,
+		InstanceID:  "abc",
+		AutoApprove: standalone.AutoApprovePolicy{Hostnames: []string{"build-*"}, MaxClients: 3},
+	}
+
+	if err := s.SaveStandaloneConfig(config); err != nil {
+		t.Fatalf("SaveStandaloneConfig() error = %v", err)
+	}
+
+	got, err := s.GetStandaloneConfig()
+	if err != nil || got == nil {
+		t.Fatalf("GetStandaloneConfig() = %v, %v", got, err)
+	}
+
+	if !slices.Equal(got.AutoApprove.Hostnames, []string{"build-*"}) || got.AutoApprove.MaxClients != 3 {
+		t.Errorf("AutoApprove = %+v, want %+v", got.AutoApprove, config.AutoApprove)
+	}
+
+	reg := &standalone.ClientRegistration{ClientID: "client-1", ClientName: "laptop", Fingerprint: "3F2A-9C10-77B4-E5D2"}
+	if err := s.SavePendingRegistration(reg); err != nil {
+		t.Fatalf("SavePendingRegistration() error = %v", err)
+	}
+
+	pending, err := s.ListPendingRegistrations()
+	if err != nil || len(pending) != 1 || pending[0].Fingerprint != reg.Fingerprint {
+		t.Errorf("ListPendingRegistrations() = %+v, %v; want the fingerprint kept", pending, err)
+	}
+
+	client := &standalone.RegisteredClient{ClientID: "client-1", ClientName: "laptop", Status: "active", Fingerprint: reg.Fingerprint}
+	if err := s.SaveRegisteredClient(client); err != nil {
+		t.Fatalf("SaveRegisteredClient() error = %v", err)
+	}
+
+	client.Status = "revoked"
+	if err := s.SaveRegisteredClient(client); err != nil {
+		t.Fatalf("SaveRegisteredClient() error = %v", err)
+	}
+
+	saved, err := s.GetRegisteredClient("client-1")
+	if err != nil || saved.Status != "revoked" || saved.Fingerprint != reg.Fingerprint {
+		t.Errorf("GetRegisteredClient() = %+v, %v; want the client revoked with its fingerprint", saved, err)
+	}
+}
+
+func TestSyncChangeLog(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	log, err := s.GetSyncChangeLog(standalone.CapabilityRepos)
+	if err != nil || log.Revision != 0 || len(log.Records) != 0 {
+		t.Fatalf("GetSyncChangeLog() on empty store = %+v, %v", log, err)
+	}
+
+	for _, data := range []string{`{"a":1}`, `{"a":2}`} {
+		log.Record(map[string]standalone.SyncRecord{"https://github.com/user/repo": {Workspace: "work", Data: []byte(data)}})
+
+		if err := s.SaveSyncChangeLog(standalone.CapabilityRepos, log); err != nil {
+			t.Fatalf("SaveSyncChangeLog() error = %v", err)
+		}
+	}
+
+	got, err := s.GetSyncChangeLog(standalone.CapabilityRepos)
+	if err != nil || got.Revision != 2 || got.Records["https://github.com/user/repo"].Revision != 2 {
+		t.Errorf("GetSyncChangeLog() = %+v, %v; want revision 2", got, err)
+	}
+
+	// Acknowledged revisions are kept on the connection
+	conn := &standalone.StandaloneConnection{Name: "home", Host: "10.0.0.2", AckedRevisions: map[string]int64{"repos": 2, "config": 1}}
+	if err := s.SaveStandaloneConnection(conn); err != nil {
+		t.Fatalf("SaveStandaloneConnection() error = %v", err)
+	}
+
+	saved, err := s.GetStandaloneConnection("home")
+	if err != nil || !maps.Equal(saved.AckedRevisions, conn.AckedRevisions) {
+		t.Errorf("AckedRevisions = %v, %v; want %v", saved.AckedRevisions, err, conn.AckedRevisions)
+	}
+}
+
+func TestDependencyInventories(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	repoURL := "https://github.com/user/repo"
+	scannedAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	for i, version := range []string{"v1.5.0", "v1.6.0"} {
+		inventory := &model.DependencyInventory{
+			RepoURL:      repoURL,
+			Dependencies: []model.Dependency{{Ecosystem: "go", Name: "github.com/google/uuid", Version: version, Manifest: "go.mod"}},
+			ScannedAt:    scannedAt.Add(time.Duration(i) * time.Hour),
+		}
+
+		if err := s.SaveDependencyInventory(inventory); err != nil {
+			t.Fatalf("SaveDependencyInventory() error = %v", err)
+		}
+	}
+
+	all, err := s.ListDependencyInventories(repoURL, 0)
+	if err != nil {
+		t.Fatalf("ListDependencyInventories() error = %v", err)
+	}
+
+	if len(all) != 2 || all[0].Dependencies[0].Version != "v1.6.0" {
+		t.Errorf("ListDependencyInventories() = %+v, want two inventories, newest first", all)
+	}
+
+	latest, err := s.ListDependencyInventories(repoURL, 1)
+	if err != nil {
+		t.Fatalf("ListDependencyInventories() error = %v", err)
+	}
+
+	if len(latest) != 1 || !latest[0].ScannedAt.Equal(scannedAt.Add(time.Hour)) {
+		t.Errorf("ListDependencyInventories(limit 1) = %+v, want the latest inventory", latest)
+	}
+}
+
+func TestShareLinks(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	now := time.Now()
+
+	expired := &model.ShareLink{ID: "old", RepoURL: "https://github.com/user/repo", ExpiresAt: now.Add(-time.Minute)}
+	if err := s.SaveShareLink(expired); err != nil {
+		t.Fatalf("SaveShareLink() error = %v", err)
+	}
+
+	link := &model.ShareLink{ID: "abc", RepoURL: "https://github.com/user/repo", Note: "read the README", ExpiresAt: now.Add(time.Hour)}
+	if err := s.SaveShareLink(link); err != nil {
+		t.Fatalf("SaveShareLink() error = %v", err)
+	}
+
+	// Saving a link drops the expired ones
+	if got, err := s.GetShareLink("old"); err != nil || got != nil {
+		t.Errorf("GetShareLink(old) = %+v, %v, want nil", got, err)
+	}
+
+	got, err := s.GetShareLink("abc")
+	if err != nil || got == nil || got.Note != "read the README" {
+		t.Fatalf("GetShareLink() = %+v, %v", got, err)
+	}
+
+	consumed, err := s.ConsumeShareLink("abc")
+	if err != nil || consumed == nil || consumed.RepoURL != link.RepoURL {
+		t.Fatalf("ConsumeShareLink() = %+v, %v", consumed, err)
+	}
+
+	if again, err := s.ConsumeShareLink("abc"); err != nil || again != nil {
+		t.Errorf("second ConsumeShareLink() = %+v, %v, want nil", again, err)
+	}
+}
+
+func TestJobs(t *testing.T) {
+	s, err := New(filepath.Join(t.TempDir(), "clonr.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	defer func() { _ = s.Close() }()
+
+	started := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	older := &model.Job{ID: "a1", Kind: "backup", State: model.JobSucceeded, StartedAt: started, UpdatedAt: started}
+	job := &model.Job{ID: "b2", Kind: "update", State: model.JobRunning, Total: 3, Log: []string{"12:00:00 ✓ api"}, StartedAt: started.Add(time.Hour), UpdatedAt: started.Add(time.Hour)}
+
+	for _, j := range []*model.Job{older, job} {
+		if err := s.SaveJob(j); err != nil {
+			t.Fatalf("SaveJob() error = %v", err)
+		}
+	}
+
+	if err := s.CancelJob("b2"); err != nil {
+		t.Fatalf("CancelJob() error = %v", err)
+	}
+
+	// Progress saved after the cancellation request keeps it
+	job.Done = 1
+	if err := s.SaveJob(job); err != nil {
+		t.Fatalf("SaveJob() error = %v", err)
+	}
+
+	got, err := s.GetJob("b2")
+	if err != nil || got == nil || !got.CancelRequested || got.Done != 1 || len(got.Log) != 1 || got.FinishedAt != nil {
+		t.Fatalf("GetJob() = %+v, %v", got, err)
+	}
+
+	jobs, err := s.ListJobs(0)
+	if err != nil || len(jobs) != 2 || jobs[0].ID != "b2" {
+		t.Errorf("ListJobs() = %+v, %v, want newest first", jobs, err)
+	}
+
+	if jobs, err := s.ListJobs(1); err != nil || len(jobs) != 1 {
+		t.Errorf("ListJobs(1) = %+v, %v", jobs, err)
+	}
+
+	if missing, err := s.GetJob("zz"); err != nil || missing != nil {
+		t.Errorf("GetJob(zz) = %+v, %v, want nil", missing, err)
+	}
+
+	if err := s.CancelJob("zz"); err == nil {
+		t.Error("CancelJob() of a missing job succeeded")
+	}
+}
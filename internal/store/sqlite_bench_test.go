@@ -0,0 +1,26 @@
+//go:build !bolt
+
+package store
+
+import "github.com/inovacc/clonr/internal/store/sqlite"
+
+func init() {
+	openSQLite := func(opts sqlite.Options) func(path string) (Store, func(), error) {
+		return func(path string) (Store, func(), error) {
+			s, err := sqlite.NewWithOptions(path, opts)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return &SQLiteWrapper{store: s}, func() { _ = s.Close() }, nil
+		}
+	}
+
+	uncached := sqlite.DefaultOptions()
+	uncached.CacheStatements = false
+
+	benchBackends = append(benchBackends,
+		benchBackend{name: "sqlite", open: openSQLite(sqlite.DefaultOptions())},
+		benchBackend{name: "sqlite-nocache", open: openSQLite(uncached)},
+	)
+}
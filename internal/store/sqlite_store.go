@@ -17,7 +17,9 @@ type SQLiteWrapper struct {
 	store *sqlite.Store
 }
 
-func initDB() (Store, error) {
+// initDB opens the SQLite database. SQLite coordinates concurrent processes
+// itself, so the open mode is ignored.
+func initDB(_ OpenMode) (Store, error) {
 	path := filepath.Join(params.AppdataDir, "clonr.db")
 
 	store, err := sqlite.New(path)
@@ -80,10 +82,60 @@ func (w *SQLiteWrapper) GetRepos(workspace string, favoritesOnly bool) ([]model.
 	return result, nil
 }
 
+func (w *SQLiteWrapper) ListRepos(filter model.RepoFilter, offset, limit int) ([]model.Repository, int, error) {
+	repos, total, err := w.store.ListRepos(filter, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]model.Repository, len(repos))
+	for i, r := range repos {
+		result[i] = *r
+	}
+
+	return result, total, nil
+}
+
 func (w *SQLiteWrapper) SetFavoriteByURL(urlStr string, fav bool) error {
 	return w.store.SetFavoriteByURL(urlStr, fav)
 }
 
+func (w *SQLiteWrapper) SetRepoKind(urlStr string, kind model.RepoKind) error {
+	return w.store.SetRepoKind(urlStr, kind)
+}
+
+func (w *SQLiteWrapper) SetRepoUpstream(urlStr, upstreamURL string) error {
+	return w.store.SetRepoUpstream(urlStr, upstreamURL)
+}
+
+func (w *SQLiteWrapper) SetRepoLicense(urlStr, license string) error {
+	return w.store.SetRepoLicense(urlStr, license)
+}
+
+func (w *SQLiteWrapper) SetRepoTags(urlStr string, tags []string) error {
+	return w.store.SetRepoTags(urlStr, tags)
+}
+
+func (w *SQLiteWrapper) SetRepoNotes(urlStr, notes string) error {
+	return w.store.SetRepoNotes(urlStr, notes)
+}
+
+func (w *SQLiteWrapper) SetRepoAlias(urlStr, alias string) error {
+	return w.store.SetRepoAlias(urlStr, alias)
+}
+
+func (w *SQLiteWrapper) RecordRepoAccess(urlStr string) error {
+	return w.store.RecordRepoAccess(urlStr)
+}
+
+func (w *SQLiteWrapper) SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error {
+	return w.store.SetRepoRemotes(urlStr, remotes)
+}
+
+func (w *SQLiteWrapper) GetRepoByRemoteURL(urlStr string) (*model.Repository, error) {
+	return w.store.GetRepoByRemoteURL(urlStr)
+}
+
 func (w *SQLiteWrapper) UpdateRepoTimestamp(urlStr string) error {
 	return w.store.UpdateRepoTimestamp(urlStr)
 }
@@ -92,6 +144,14 @@ func (w *SQLiteWrapper) RemoveRepoByURL(u *url.URL) error {
 	return w.store.RemoveRepoByURL(u)
 }
 
+func (w *SQLiteWrapper) UpdateRepoURL(oldURL, newURL string) error {
+	return w.store.UpdateRepoURL(oldURL, newURL)
+}
+
+func (w *SQLiteWrapper) UpdateRepoPath(urlStr, path string) error {
+	return w.store.UpdateRepoPath(urlStr, path)
+}
+
 func (w *SQLiteWrapper) GetConfig() (*model.Config, error) {
 	return w.store.GetConfig()
 }
@@ -256,6 +316,14 @@ func (w *SQLiteWrapper) DeleteStandaloneConnection(name string) error {
 	return w.store.DeleteStandaloneConnection(name)
 }
 
+func (w *SQLiteWrapper) GetSyncChangeLog(bucket string) (*standalone.BucketLog, error) {
+	return w.store.GetSyncChangeLog(bucket)
+}
+
+func (w *SQLiteWrapper) SaveSyncChangeLog(bucket string, log *standalone.BucketLog) error {
+	return w.store.SaveSyncChangeLog(bucket, log)
+}
+
 func (w *SQLiteWrapper) GetServerEncryptionConfig() (*standalone.ServerEncryptionConfig, error) {
 	return w.store.GetServerEncryptionConfig()
 }
@@ -368,6 +436,186 @@ func (w *SQLiteWrapper) DockerProfileExists(name string) (bool, error) {
 	return w.store.DockerProfileExists(name)
 }
 
+// Saved filter operations
+
+func (w *SQLiteWrapper) SaveFilter(filter *model.SavedFilter) error {
+	return w.store.SaveFilter(filter)
+}
+
+func (w *SQLiteWrapper) GetFilter(name string) (*model.SavedFilter, error) {
+	return w.store.GetFilter(name)
+}
+
+func (w *SQLiteWrapper) ListFilters() ([]model.SavedFilter, error) {
+	filters, err := w.store.ListFilters()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.SavedFilter, len(filters))
+	for i, f := range filters {
+		result[i] = *f
+	}
+
+	return result, nil
+}
+
+func (w *SQLiteWrapper) DeleteFilter(name string) error {
+	return w.store.DeleteFilter(name)
+}
+
+// Repository snapshot operations
+
+func (w *SQLiteWrapper) SaveRepoSnapshot(snapshot *model.RepoSnapshot) error {
+	return w.store.SaveRepoSnapshot(snapshot)
+}
+
+func (w *SQLiteWrapper) GetRepoSnapshot(id string) (*model.RepoSnapshot, error) {
+	return w.store.GetRepoSnapshot(id)
+}
+
+func (w *SQLiteWrapper) ListRepoSnapshots(repoURL string) ([]model.RepoSnapshot, error) {
+	snapshots, err := w.store.ListRepoSnapshots(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.RepoSnapshot, len(snapshots))
+	for i, s := range snapshots {
+		result[i] = *s
+	}
+
+	return result, nil
+}
+
+func (w *SQLiteWrapper) DeleteRepoSnapshot(id string) error {
+	return w.store.DeleteRepoSnapshot(id)
+}
+
+// Wizard draft operations
+
+func (w *SQLiteWrapper) SaveWizardDraft(draft *model.WizardDraft) error {
+	return w.store.SaveWizardDraft(draft)
+}
+
+func (w *SQLiteWrapper) GetWizardDraft(name string) (*model.WizardDraft, error) {
+	return w.store.GetWizardDraft(name)
+}
+
+func (w *SQLiteWrapper) DeleteWizardDraft(name string) error {
+	return w.store.DeleteWizardDraft(name)
+}
+
+// API token operations
+
+func (w *SQLiteWrapper) SaveAPIToken(token *model.APIToken) error {
+	return w.store.SaveAPIToken(token)
+}
+
+func (w *SQLiteWrapper) GetAPITokenByHash(hash string) (*model.APIToken, error) {
+	return w.store.GetAPITokenByHash(hash)
+}
+
+func (w *SQLiteWrapper) ListAPITokens() ([]model.APIToken, error) {
+	return w.store.ListAPITokens()
+}
+
+func (w *SQLiteWrapper) DeleteAPIToken(name string) error {
+	return w.store.DeleteAPIToken(name)
+}
+
+func (w *SQLiteWrapper) SaveVaultSecret(secret *model.VaultSecret) error {
+	return w.store.SaveVaultSecret(secret)
+}
+
+func (w *SQLiteWrapper) GetVaultSecret(key string) (*model.VaultSecret, error) {
+	return w.store.GetVaultSecret(key)
+}
+
+func (w *SQLiteWrapper) ListVaultSecrets() ([]model.VaultSecret, error) {
+	return w.store.ListVaultSecrets()
+}
+
+func (w *SQLiteWrapper) DeleteVaultSecret(key string) error {
+	return w.store.DeleteVaultSecret(key)
+}
+
+func (w *SQLiteWrapper) SaveGmailWatch(watch *model.GmailWatch) error {
+	return w.store.SaveGmailWatch(watch)
+}
+
+func (w *SQLiteWrapper) GetGmailWatch(name string) (*model.GmailWatch, error) {
+	return w.store.GetGmailWatch(name)
+}
+
+func (w *SQLiteWrapper) ListGmailWatches() ([]model.GmailWatch, error) {
+	return w.store.ListGmailWatches()
+}
+
+func (w *SQLiteWrapper) DeleteGmailWatch(name string) error {
+	return w.store.DeleteGmailWatch(name)
+}
+
+func (w *SQLiteWrapper) SaveGitHubRepoID(entry *model.GitHubRepoID) error {
+	return w.store.SaveGitHubRepoID(entry)
+}
+
+func (w *SQLiteWrapper) GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error) {
+	return w.store.GetGitHubRepoID(fullName)
+}
+
+// Dependency inventory operations
+
+func (w *SQLiteWrapper) SaveDependencyInventory(inventory *model.DependencyInventory) error {
+	return w.store.SaveDependencyInventory(inventory)
+}
+
+func (w *SQLiteWrapper) ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error) {
+	inventories, err := w.store.ListDependencyInventories(repoURL, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]model.DependencyInventory, len(inventories))
+	for i, inv := range inventories {
+		result[i] = *inv
+	}
+
+	return result, nil
+}
+
+// Share link operations
+
+func (w *SQLiteWrapper) SaveShareLink(link *model.ShareLink) error {
+	return w.store.SaveShareLink(link)
+}
+
+func (w *SQLiteWrapper) GetShareLink(id string) (*model.ShareLink, error) {
+	return w.store.GetShareLink(id)
+}
+
+func (w *SQLiteWrapper) ConsumeShareLink(id string) (*model.ShareLink, error) {
+	return w.store.ConsumeShareLink(id)
+}
+
+// Job operations
+
+func (w *SQLiteWrapper) SaveJob(job *model.Job) error {
+	return w.store.SaveJob(job)
+}
+
+func (w *SQLiteWrapper) GetJob(id string) (*model.Job, error) {
+	return w.store.GetJob(id)
+}
+
+func (w *SQLiteWrapper) ListJobs(limit int) ([]model.Job, error) {
+	return w.store.ListJobs(limit)
+}
+
+func (w *SQLiteWrapper) CancelJob(id string) error {
+	return w.store.CancelJob(id)
+}
+
 // Sealed key operations
 
 func (w *SQLiteWrapper) GetSealedKey() (*SealedKeyData, error) {
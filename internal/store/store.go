@@ -1,7 +1,10 @@
 package store
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
+	"os"
 	"sync"
 	"time"
 
@@ -32,9 +35,21 @@ type Store interface {
 	InsertRepoIfNotExists(u *url.URL, path string) error
 	GetAllRepos() ([]model.Repository, error)
 	GetRepos(workspace string, favoritesOnly bool) ([]model.Repository, error)
+	ListRepos(filter model.RepoFilter, offset, limit int) ([]model.Repository, int, error)
 	SetFavoriteByURL(urlStr string, fav bool) error
+	SetRepoKind(urlStr string, kind model.RepoKind) error
+	SetRepoUpstream(urlStr, upstreamURL string) error
+	SetRepoLicense(urlStr, license string) error
+	SetRepoTags(urlStr string, tags []string) error
+	SetRepoNotes(urlStr, notes string) error
+	SetRepoAlias(urlStr, alias string) error
+	RecordRepoAccess(urlStr string) error
+	SetRepoRemotes(urlStr string, remotes []model.RepoRemote) error
+	GetRepoByRemoteURL(urlStr string) (*model.Repository, error)
 	UpdateRepoTimestamp(urlStr string) error
 	RemoveRepoByURL(u *url.URL) error
+	UpdateRepoPath(urlStr string, path string) error
+	UpdateRepoURL(oldURL, newURL string) error
 	GetConfig() (*model.Config, error)
 	SaveConfig(cfg *model.Config) error
 
@@ -54,6 +69,60 @@ type Store interface {
 	DeleteDockerProfile(name string) error
 	DockerProfileExists(name string) (bool, error)
 
+	// Saved filter operations
+	SaveFilter(filter *model.SavedFilter) error
+	GetFilter(name string) (*model.SavedFilter, error)
+	ListFilters() ([]model.SavedFilter, error)
+	DeleteFilter(name string) error
+
+	// Repository snapshot operations
+	SaveRepoSnapshot(snapshot *model.RepoSnapshot) error
+	GetRepoSnapshot(id string) (*model.RepoSnapshot, error)
+	ListRepoSnapshots(repoURL string) ([]model.RepoSnapshot, error)
+	DeleteRepoSnapshot(id string) error
+
+	// Wizard draft operations
+	SaveWizardDraft(draft *model.WizardDraft) error
+	GetWizardDraft(name string) (*model.WizardDraft, error)
+	DeleteWizardDraft(name string) error
+
+	// API token operations
+	SaveAPIToken(token *model.APIToken) error
+	GetAPITokenByHash(hash string) (*model.APIToken, error)
+	ListAPITokens() ([]model.APIToken, error)
+	DeleteAPIToken(name string) error
+
+	// Vault secret operations
+	SaveVaultSecret(secret *model.VaultSecret) error
+	GetVaultSecret(key string) (*model.VaultSecret, error)
+	ListVaultSecrets() ([]model.VaultSecret, error)
+	DeleteVaultSecret(key string) error
+
+	// Gmail watch operations
+	SaveGmailWatch(watch *model.GmailWatch) error
+	GetGmailWatch(name string) (*model.GmailWatch, error)
+	ListGmailWatches() ([]model.GmailWatch, error)
+	DeleteGmailWatch(name string) error
+
+	// GitHub repository ID cache operations
+	SaveGitHubRepoID(entry *model.GitHubRepoID) error
+	GetGitHubRepoID(fullName string) (*model.GitHubRepoID, error)
+
+	// Dependency inventory operations
+	SaveDependencyInventory(inventory *model.DependencyInventory) error
+	ListDependencyInventories(repoURL string, limit int) ([]model.DependencyInventory, error)
+
+	// Share link operations
+	SaveShareLink(link *model.ShareLink) error
+	GetShareLink(id string) (*model.ShareLink, error)
+	ConsumeShareLink(id string) (*model.ShareLink, error)
+
+	// Job operations
+	SaveJob(job *model.Job) error
+	GetJob(id string) (*model.Job, error)
+	ListJobs(limit int) ([]model.Job, error)
+	CancelJob(id string) error
+
 	// Workspace operations
 	SaveWorkspace(workspace *model.Workspace) error
 	GetWorkspace(name string) (*model.Workspace, error)
@@ -79,6 +148,10 @@ type Store interface {
 	SaveStandaloneConnection(conn *standalone.StandaloneConnection) error
 	DeleteStandaloneConnection(name string) error
 
+	// Sync change log (source side of delta syncs)
+	GetSyncChangeLog(bucket string) (*standalone.BucketLog, error)
+	SaveSyncChangeLog(bucket string, log *standalone.BucketLog) error
+
 	// Server encryption config
 	GetServerEncryptionConfig() (*standalone.ServerEncryptionConfig, error)
 	SaveServerEncryptionConfig(config *standalone.ServerEncryptionConfig) error
@@ -138,11 +211,17 @@ func GetDB() Store {
 }
 
 func lazyInit() {
-	instance, err := initDB()
+	instance, err := initDB(currentOpenMode())
 	if err != nil {
+		var locked *LockedError
+		if errors.As(err, &locked) {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
 		panic(err)
 	}
 
 	_ = instance.Ping()
-	db = instance
+	db = Instrument(instance, defaultMetrics)
 }
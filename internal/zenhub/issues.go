@@ -246,32 +246,6 @@ func GetZenHubWorkspaces(client *ZenHubClient, repoID int64, repoName string, op
 	}, nil
 }
 
-// GetGitHubRepoID attempts to get a GitHub repository ID
-// This requires a GitHub API call
-func GetGitHubRepoID(token, owner, repo string, logger *slog.Logger) (int64, error) {
-	if logger == nil {
-		logger = slog.Default()
-	}
-
-	logger.Debug("fetching GitHub repo ID",
-		slog.String("owner", owner),
-		slog.String("repo", repo),
-	)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	// Use go-GitHub client
-	client := core.NewGitHubClient(ctx, token)
-
-	repository, _, err := client.Repositories.Get(ctx, owner, repo)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get repository: %w", err)
-	}
-
-	return repository.GetID(), nil
-}
-
 // EnrichedIssue combines GitHub issue with ZenHub metadata
 type EnrichedIssue struct {
 	// GitHub fields
@@ -9,6 +9,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/inovacc/clonr/internal/ratelimit"
 )
 
 const (
@@ -43,7 +45,8 @@ func CreateZenHubClient(token string, opts ZenHubClientOptions) (*ZenHubClient,
 
 	return &ZenHubClient{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: ratelimit.NewTransport(http.DefaultTransport),
 		},
 		token:   token,
 		baseURL: zenHubAPIBaseURL,